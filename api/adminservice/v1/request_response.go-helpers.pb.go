@@ -1916,6 +1916,43 @@ func (this *MergeDLQMessagesResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type ReplicationDLQTaskPreview to the protobuf v3 wire format
+func (val *ReplicationDLQTaskPreview) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ReplicationDLQTaskPreview from the protobuf v3 wire format
+func (val *ReplicationDLQTaskPreview) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ReplicationDLQTaskPreview) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ReplicationDLQTaskPreview values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ReplicationDLQTaskPreview) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ReplicationDLQTaskPreview
+	switch t := that.(type) {
+	case *ReplicationDLQTaskPreview:
+		that1 = t
+	case ReplicationDLQTaskPreview:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type RefreshWorkflowTasksRequest to the protobuf v3 wire format
 func (val *RefreshWorkflowTasksRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -2360,6 +2397,43 @@ func (this *GetNamespaceResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type NamespaceHandoverProgress to the protobuf v3 wire format
+func (val *NamespaceHandoverProgress) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type NamespaceHandoverProgress from the protobuf v3 wire format
+func (val *NamespaceHandoverProgress) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *NamespaceHandoverProgress) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two NamespaceHandoverProgress values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *NamespaceHandoverProgress) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *NamespaceHandoverProgress
+	switch t := that.(type) {
+	case *NamespaceHandoverProgress:
+		that1 = t
+	case NamespaceHandoverProgress:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type GetDLQTasksRequest to the protobuf v3 wire format
 func (val *GetDLQTasksRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -3321,3 +3395,2334 @@ func (this *ForceUnloadTaskQueuePartitionResponse) Equal(that interface{}) bool
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type ReArchiveWorkflowExecutionsRequest to the protobuf v3 wire format
+func (val *ReArchiveWorkflowExecutionsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ReArchiveWorkflowExecutionsRequest from the protobuf v3 wire format
+func (val *ReArchiveWorkflowExecutionsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ReArchiveWorkflowExecutionsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ReArchiveWorkflowExecutionsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ReArchiveWorkflowExecutionsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ReArchiveWorkflowExecutionsRequest
+	switch t := that.(type) {
+	case *ReArchiveWorkflowExecutionsRequest:
+		that1 = t
+	case ReArchiveWorkflowExecutionsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ReArchiveWorkflowExecutionsResponse to the protobuf v3 wire format
+func (val *ReArchiveWorkflowExecutionsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ReArchiveWorkflowExecutionsResponse from the protobuf v3 wire format
+func (val *ReArchiveWorkflowExecutionsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ReArchiveWorkflowExecutionsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ReArchiveWorkflowExecutionsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ReArchiveWorkflowExecutionsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ReArchiveWorkflowExecutionsResponse
+	switch t := that.(type) {
+	case *ReArchiveWorkflowExecutionsResponse:
+		that1 = t
+	case ReArchiveWorkflowExecutionsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeArchivalStatusRequest to the protobuf v3 wire format
+func (val *DescribeArchivalStatusRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeArchivalStatusRequest from the protobuf v3 wire format
+func (val *DescribeArchivalStatusRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeArchivalStatusRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeArchivalStatusRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeArchivalStatusRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeArchivalStatusRequest
+	switch t := that.(type) {
+	case *DescribeArchivalStatusRequest:
+		that1 = t
+	case DescribeArchivalStatusRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeArchivalStatusResponse to the protobuf v3 wire format
+func (val *DescribeArchivalStatusResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeArchivalStatusResponse from the protobuf v3 wire format
+func (val *DescribeArchivalStatusResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeArchivalStatusResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeArchivalStatusResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeArchivalStatusResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeArchivalStatusResponse
+	switch t := that.(type) {
+	case *DescribeArchivalStatusResponse:
+		that1 = t
+	case DescribeArchivalStatusResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeReplicationLagRequest to the protobuf v3 wire format
+func (val *DescribeReplicationLagRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeReplicationLagRequest from the protobuf v3 wire format
+func (val *DescribeReplicationLagRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeReplicationLagRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeReplicationLagRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeReplicationLagRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeReplicationLagRequest
+	switch t := that.(type) {
+	case *DescribeReplicationLagRequest:
+		that1 = t
+	case DescribeReplicationLagRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeReplicationLagResponse to the protobuf v3 wire format
+func (val *DescribeReplicationLagResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeReplicationLagResponse from the protobuf v3 wire format
+func (val *DescribeReplicationLagResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeReplicationLagResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeReplicationLagResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeReplicationLagResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeReplicationLagResponse
+	switch t := that.(type) {
+	case *DescribeReplicationLagResponse:
+		that1 = t
+	case DescribeReplicationLagResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ShardReplicationLag to the protobuf v3 wire format
+func (val *ShardReplicationLag) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ShardReplicationLag from the protobuf v3 wire format
+func (val *ShardReplicationLag) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ShardReplicationLag) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ShardReplicationLag values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ShardReplicationLag) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ShardReplicationLag
+	switch t := that.(type) {
+	case *ShardReplicationLag:
+		that1 = t
+	case ShardReplicationLag:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type NamespaceReplicationLag to the protobuf v3 wire format
+func (val *NamespaceReplicationLag) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type NamespaceReplicationLag from the protobuf v3 wire format
+func (val *NamespaceReplicationLag) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *NamespaceReplicationLag) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two NamespaceReplicationLag values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *NamespaceReplicationLag) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *NamespaceReplicationLag
+	switch t := that.(type) {
+	case *NamespaceReplicationLag:
+		that1 = t
+	case NamespaceReplicationLag:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StartSignalWithStartBatchOperationRequest to the protobuf v3 wire format
+func (val *StartSignalWithStartBatchOperationRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StartSignalWithStartBatchOperationRequest from the protobuf v3 wire format
+func (val *StartSignalWithStartBatchOperationRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StartSignalWithStartBatchOperationRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StartSignalWithStartBatchOperationRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StartSignalWithStartBatchOperationRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StartSignalWithStartBatchOperationRequest
+	switch t := that.(type) {
+	case *StartSignalWithStartBatchOperationRequest:
+		that1 = t
+	case StartSignalWithStartBatchOperationRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StartSignalWithStartBatchOperationResponse to the protobuf v3 wire format
+func (val *StartSignalWithStartBatchOperationResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StartSignalWithStartBatchOperationResponse from the protobuf v3 wire format
+func (val *StartSignalWithStartBatchOperationResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StartSignalWithStartBatchOperationResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StartSignalWithStartBatchOperationResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StartSignalWithStartBatchOperationResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StartSignalWithStartBatchOperationResponse
+	switch t := that.(type) {
+	case *StartSignalWithStartBatchOperationResponse:
+		that1 = t
+	case StartSignalWithStartBatchOperationResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type CancelScheduleBackfillRequest to the protobuf v3 wire format
+func (val *CancelScheduleBackfillRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CancelScheduleBackfillRequest from the protobuf v3 wire format
+func (val *CancelScheduleBackfillRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CancelScheduleBackfillRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CancelScheduleBackfillRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CancelScheduleBackfillRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CancelScheduleBackfillRequest
+	switch t := that.(type) {
+	case *CancelScheduleBackfillRequest:
+		that1 = t
+	case CancelScheduleBackfillRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type CancelScheduleBackfillResponse to the protobuf v3 wire format
+func (val *CancelScheduleBackfillResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CancelScheduleBackfillResponse from the protobuf v3 wire format
+func (val *CancelScheduleBackfillResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CancelScheduleBackfillResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CancelScheduleBackfillResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CancelScheduleBackfillResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CancelScheduleBackfillResponse
+	switch t := that.(type) {
+	case *CancelScheduleBackfillResponse:
+		that1 = t
+	case CancelScheduleBackfillResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateSchedulePoliciesRequest to the protobuf v3 wire format
+func (val *UpdateSchedulePoliciesRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateSchedulePoliciesRequest from the protobuf v3 wire format
+func (val *UpdateSchedulePoliciesRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateSchedulePoliciesRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateSchedulePoliciesRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateSchedulePoliciesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateSchedulePoliciesRequest
+	switch t := that.(type) {
+	case *UpdateSchedulePoliciesRequest:
+		that1 = t
+	case UpdateSchedulePoliciesRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateSchedulePoliciesResponse to the protobuf v3 wire format
+func (val *UpdateSchedulePoliciesResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateSchedulePoliciesResponse from the protobuf v3 wire format
+func (val *UpdateSchedulePoliciesResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateSchedulePoliciesResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateSchedulePoliciesResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateSchedulePoliciesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateSchedulePoliciesResponse
+	switch t := that.(type) {
+	case *UpdateSchedulePoliciesResponse:
+		that1 = t
+	case UpdateSchedulePoliciesResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateNexusEndpointOperationDefaultsRequest to the protobuf v3 wire format
+func (val *UpdateNexusEndpointOperationDefaultsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateNexusEndpointOperationDefaultsRequest from the protobuf v3 wire format
+func (val *UpdateNexusEndpointOperationDefaultsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateNexusEndpointOperationDefaultsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateNexusEndpointOperationDefaultsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateNexusEndpointOperationDefaultsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateNexusEndpointOperationDefaultsRequest
+	switch t := that.(type) {
+	case *UpdateNexusEndpointOperationDefaultsRequest:
+		that1 = t
+	case UpdateNexusEndpointOperationDefaultsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateNexusEndpointOperationDefaultsResponse to the protobuf v3 wire format
+func (val *UpdateNexusEndpointOperationDefaultsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateNexusEndpointOperationDefaultsResponse from the protobuf v3 wire format
+func (val *UpdateNexusEndpointOperationDefaultsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateNexusEndpointOperationDefaultsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateNexusEndpointOperationDefaultsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateNexusEndpointOperationDefaultsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateNexusEndpointOperationDefaultsResponse
+	switch t := that.(type) {
+	case *UpdateNexusEndpointOperationDefaultsResponse:
+		that1 = t
+	case UpdateNexusEndpointOperationDefaultsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListNexusEndpointHealthRequest to the protobuf v3 wire format
+func (val *ListNexusEndpointHealthRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListNexusEndpointHealthRequest from the protobuf v3 wire format
+func (val *ListNexusEndpointHealthRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListNexusEndpointHealthRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListNexusEndpointHealthRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListNexusEndpointHealthRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListNexusEndpointHealthRequest
+	switch t := that.(type) {
+	case *ListNexusEndpointHealthRequest:
+		that1 = t
+	case ListNexusEndpointHealthRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListNexusEndpointHealthResponse to the protobuf v3 wire format
+func (val *ListNexusEndpointHealthResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListNexusEndpointHealthResponse from the protobuf v3 wire format
+func (val *ListNexusEndpointHealthResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListNexusEndpointHealthResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListNexusEndpointHealthResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListNexusEndpointHealthResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListNexusEndpointHealthResponse
+	switch t := that.(type) {
+	case *ListNexusEndpointHealthResponse:
+		that1 = t
+	case ListNexusEndpointHealthResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateNexusEndpointOutboundAuthRequest to the protobuf v3 wire format
+func (val *UpdateNexusEndpointOutboundAuthRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateNexusEndpointOutboundAuthRequest from the protobuf v3 wire format
+func (val *UpdateNexusEndpointOutboundAuthRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateNexusEndpointOutboundAuthRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateNexusEndpointOutboundAuthRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateNexusEndpointOutboundAuthRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateNexusEndpointOutboundAuthRequest
+	switch t := that.(type) {
+	case *UpdateNexusEndpointOutboundAuthRequest:
+		that1 = t
+	case UpdateNexusEndpointOutboundAuthRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateNexusEndpointOutboundAuthResponse to the protobuf v3 wire format
+func (val *UpdateNexusEndpointOutboundAuthResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateNexusEndpointOutboundAuthResponse from the protobuf v3 wire format
+func (val *UpdateNexusEndpointOutboundAuthResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateNexusEndpointOutboundAuthResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateNexusEndpointOutboundAuthResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateNexusEndpointOutboundAuthResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateNexusEndpointOutboundAuthResponse
+	switch t := that.(type) {
+	case *UpdateNexusEndpointOutboundAuthResponse:
+		that1 = t
+	case UpdateNexusEndpointOutboundAuthResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ReplicateNexusEndpointsRequest to the protobuf v3 wire format
+func (val *ReplicateNexusEndpointsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ReplicateNexusEndpointsRequest from the protobuf v3 wire format
+func (val *ReplicateNexusEndpointsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ReplicateNexusEndpointsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ReplicateNexusEndpointsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ReplicateNexusEndpointsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ReplicateNexusEndpointsRequest
+	switch t := that.(type) {
+	case *ReplicateNexusEndpointsRequest:
+		that1 = t
+	case ReplicateNexusEndpointsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ReplicateNexusEndpointsResponse to the protobuf v3 wire format
+func (val *ReplicateNexusEndpointsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ReplicateNexusEndpointsResponse from the protobuf v3 wire format
+func (val *ReplicateNexusEndpointsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ReplicateNexusEndpointsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ReplicateNexusEndpointsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ReplicateNexusEndpointsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ReplicateNexusEndpointsResponse
+	switch t := that.(type) {
+	case *ReplicateNexusEndpointsResponse:
+		that1 = t
+	case ReplicateNexusEndpointsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListPendingNexusOperationsRequest to the protobuf v3 wire format
+func (val *ListPendingNexusOperationsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListPendingNexusOperationsRequest from the protobuf v3 wire format
+func (val *ListPendingNexusOperationsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListPendingNexusOperationsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListPendingNexusOperationsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListPendingNexusOperationsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListPendingNexusOperationsRequest
+	switch t := that.(type) {
+	case *ListPendingNexusOperationsRequest:
+		that1 = t
+	case ListPendingNexusOperationsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListPendingNexusOperationsResponse to the protobuf v3 wire format
+func (val *ListPendingNexusOperationsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListPendingNexusOperationsResponse from the protobuf v3 wire format
+func (val *ListPendingNexusOperationsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListPendingNexusOperationsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListPendingNexusOperationsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListPendingNexusOperationsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListPendingNexusOperationsResponse
+	switch t := that.(type) {
+	case *ListPendingNexusOperationsResponse:
+		that1 = t
+	case ListPendingNexusOperationsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SignalWorkflowExecutionsByQueryRequest to the protobuf v3 wire format
+func (val *SignalWorkflowExecutionsByQueryRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SignalWorkflowExecutionsByQueryRequest from the protobuf v3 wire format
+func (val *SignalWorkflowExecutionsByQueryRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SignalWorkflowExecutionsByQueryRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SignalWorkflowExecutionsByQueryRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SignalWorkflowExecutionsByQueryRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SignalWorkflowExecutionsByQueryRequest
+	switch t := that.(type) {
+	case *SignalWorkflowExecutionsByQueryRequest:
+		that1 = t
+	case SignalWorkflowExecutionsByQueryRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SignalWorkflowExecutionsByQueryResponse to the protobuf v3 wire format
+func (val *SignalWorkflowExecutionsByQueryResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SignalWorkflowExecutionsByQueryResponse from the protobuf v3 wire format
+func (val *SignalWorkflowExecutionsByQueryResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SignalWorkflowExecutionsByQueryResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SignalWorkflowExecutionsByQueryResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SignalWorkflowExecutionsByQueryResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SignalWorkflowExecutionsByQueryResponse
+	switch t := that.(type) {
+	case *SignalWorkflowExecutionsByQueryResponse:
+		that1 = t
+	case SignalWorkflowExecutionsByQueryResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type MultiSignalWorkflowExecutionRequest to the protobuf v3 wire format
+func (val *MultiSignalWorkflowExecutionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type MultiSignalWorkflowExecutionRequest from the protobuf v3 wire format
+func (val *MultiSignalWorkflowExecutionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *MultiSignalWorkflowExecutionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two MultiSignalWorkflowExecutionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *MultiSignalWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *MultiSignalWorkflowExecutionRequest
+	switch t := that.(type) {
+	case *MultiSignalWorkflowExecutionRequest:
+		that1 = t
+	case MultiSignalWorkflowExecutionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type MultiSignalWorkflowExecutionResponse to the protobuf v3 wire format
+func (val *MultiSignalWorkflowExecutionResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type MultiSignalWorkflowExecutionResponse from the protobuf v3 wire format
+func (val *MultiSignalWorkflowExecutionResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *MultiSignalWorkflowExecutionResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two MultiSignalWorkflowExecutionResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *MultiSignalWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *MultiSignalWorkflowExecutionResponse
+	switch t := that.(type) {
+	case *MultiSignalWorkflowExecutionResponse:
+		that1 = t
+	case MultiSignalWorkflowExecutionResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PauseActivityTypeRequest to the protobuf v3 wire format
+func (val *PauseActivityTypeRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PauseActivityTypeRequest from the protobuf v3 wire format
+func (val *PauseActivityTypeRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PauseActivityTypeRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PauseActivityTypeRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PauseActivityTypeRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PauseActivityTypeRequest
+	switch t := that.(type) {
+	case *PauseActivityTypeRequest:
+		that1 = t
+	case PauseActivityTypeRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PauseActivityTypeResponse to the protobuf v3 wire format
+func (val *PauseActivityTypeResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PauseActivityTypeResponse from the protobuf v3 wire format
+func (val *PauseActivityTypeResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PauseActivityTypeResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PauseActivityTypeResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PauseActivityTypeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PauseActivityTypeResponse
+	switch t := that.(type) {
+	case *PauseActivityTypeResponse:
+		that1 = t
+	case PauseActivityTypeResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UnpauseActivityTypeRequest to the protobuf v3 wire format
+func (val *UnpauseActivityTypeRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UnpauseActivityTypeRequest from the protobuf v3 wire format
+func (val *UnpauseActivityTypeRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UnpauseActivityTypeRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UnpauseActivityTypeRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UnpauseActivityTypeRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UnpauseActivityTypeRequest
+	switch t := that.(type) {
+	case *UnpauseActivityTypeRequest:
+		that1 = t
+	case UnpauseActivityTypeRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UnpauseActivityTypeResponse to the protobuf v3 wire format
+func (val *UnpauseActivityTypeResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UnpauseActivityTypeResponse from the protobuf v3 wire format
+func (val *UnpauseActivityTypeResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UnpauseActivityTypeResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UnpauseActivityTypeResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UnpauseActivityTypeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UnpauseActivityTypeResponse
+	switch t := that.(type) {
+	case *UnpauseActivityTypeResponse:
+		that1 = t
+	case UnpauseActivityTypeResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type CaptureProfileRequest to the protobuf v3 wire format
+func (val *CaptureProfileRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CaptureProfileRequest from the protobuf v3 wire format
+func (val *CaptureProfileRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CaptureProfileRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CaptureProfileRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CaptureProfileRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CaptureProfileRequest
+	switch t := that.(type) {
+	case *CaptureProfileRequest:
+		that1 = t
+	case CaptureProfileRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type CaptureProfileResponse to the protobuf v3 wire format
+func (val *CaptureProfileResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CaptureProfileResponse from the protobuf v3 wire format
+func (val *CaptureProfileResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CaptureProfileResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CaptureProfileResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CaptureProfileResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CaptureProfileResponse
+	switch t := that.(type) {
+	case *CaptureProfileResponse:
+		that1 = t
+	case CaptureProfileResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeShardOwnershipRequest to the protobuf v3 wire format
+func (val *DescribeShardOwnershipRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeShardOwnershipRequest from the protobuf v3 wire format
+func (val *DescribeShardOwnershipRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeShardOwnershipRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeShardOwnershipRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeShardOwnershipRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeShardOwnershipRequest
+	switch t := that.(type) {
+	case *DescribeShardOwnershipRequest:
+		that1 = t
+	case DescribeShardOwnershipRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeShardOwnershipResponse to the protobuf v3 wire format
+func (val *DescribeShardOwnershipResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeShardOwnershipResponse from the protobuf v3 wire format
+func (val *DescribeShardOwnershipResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeShardOwnershipResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeShardOwnershipResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeShardOwnershipResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeShardOwnershipResponse
+	switch t := that.(type) {
+	case *DescribeShardOwnershipResponse:
+		that1 = t
+	case DescribeShardOwnershipResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListHotspotsRequest to the protobuf v3 wire format
+func (val *ListHotspotsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListHotspotsRequest from the protobuf v3 wire format
+func (val *ListHotspotsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListHotspotsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListHotspotsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListHotspotsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListHotspotsRequest
+	switch t := that.(type) {
+	case *ListHotspotsRequest:
+		that1 = t
+	case ListHotspotsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListHotspotsResponse to the protobuf v3 wire format
+func (val *ListHotspotsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListHotspotsResponse from the protobuf v3 wire format
+func (val *ListHotspotsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListHotspotsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListHotspotsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListHotspotsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListHotspotsResponse
+	switch t := that.(type) {
+	case *ListHotspotsResponse:
+		that1 = t
+	case ListHotspotsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetDebugTraceRequest to the protobuf v3 wire format
+func (val *GetDebugTraceRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetDebugTraceRequest from the protobuf v3 wire format
+func (val *GetDebugTraceRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetDebugTraceRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetDebugTraceRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetDebugTraceRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetDebugTraceRequest
+	switch t := that.(type) {
+	case *GetDebugTraceRequest:
+		that1 = t
+	case GetDebugTraceRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetDebugTraceResponse to the protobuf v3 wire format
+func (val *GetDebugTraceResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetDebugTraceResponse from the protobuf v3 wire format
+func (val *GetDebugTraceResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetDebugTraceResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetDebugTraceResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetDebugTraceResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetDebugTraceResponse
+	switch t := that.(type) {
+	case *GetDebugTraceResponse:
+		that1 = t
+	case GetDebugTraceResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DynamicConfigOverride to the protobuf v3 wire format
+func (val *DynamicConfigOverride) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DynamicConfigOverride from the protobuf v3 wire format
+func (val *DynamicConfigOverride) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DynamicConfigOverride) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DynamicConfigOverride values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DynamicConfigOverride) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DynamicConfigOverride
+	switch t := that.(type) {
+	case *DynamicConfigOverride:
+		that1 = t
+	case DynamicConfigOverride:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetDynamicConfigRequest to the protobuf v3 wire format
+func (val *GetDynamicConfigRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetDynamicConfigRequest from the protobuf v3 wire format
+func (val *GetDynamicConfigRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetDynamicConfigRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetDynamicConfigRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetDynamicConfigRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetDynamicConfigRequest
+	switch t := that.(type) {
+	case *GetDynamicConfigRequest:
+		that1 = t
+	case GetDynamicConfigRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetDynamicConfigResponse to the protobuf v3 wire format
+func (val *GetDynamicConfigResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetDynamicConfigResponse from the protobuf v3 wire format
+func (val *GetDynamicConfigResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetDynamicConfigResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetDynamicConfigResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetDynamicConfigResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetDynamicConfigResponse
+	switch t := that.(type) {
+	case *GetDynamicConfigResponse:
+		that1 = t
+	case GetDynamicConfigResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SetDynamicConfigRequest to the protobuf v3 wire format
+func (val *SetDynamicConfigRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SetDynamicConfigRequest from the protobuf v3 wire format
+func (val *SetDynamicConfigRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SetDynamicConfigRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SetDynamicConfigRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SetDynamicConfigRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SetDynamicConfigRequest
+	switch t := that.(type) {
+	case *SetDynamicConfigRequest:
+		that1 = t
+	case SetDynamicConfigRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SetDynamicConfigResponse to the protobuf v3 wire format
+func (val *SetDynamicConfigResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SetDynamicConfigResponse from the protobuf v3 wire format
+func (val *SetDynamicConfigResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SetDynamicConfigResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SetDynamicConfigResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SetDynamicConfigResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SetDynamicConfigResponse
+	switch t := that.(type) {
+	case *SetDynamicConfigResponse:
+		that1 = t
+	case SetDynamicConfigResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type NamespaceConfigBundle to the protobuf v3 wire format
+func (val *NamespaceConfigBundle) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type NamespaceConfigBundle from the protobuf v3 wire format
+func (val *NamespaceConfigBundle) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *NamespaceConfigBundle) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two NamespaceConfigBundle values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *NamespaceConfigBundle) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *NamespaceConfigBundle
+	switch t := that.(type) {
+	case *NamespaceConfigBundle:
+		that1 = t
+	case NamespaceConfigBundle:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type NamespaceConfigSchedule to the protobuf v3 wire format
+func (val *NamespaceConfigSchedule) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type NamespaceConfigSchedule from the protobuf v3 wire format
+func (val *NamespaceConfigSchedule) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *NamespaceConfigSchedule) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two NamespaceConfigSchedule values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *NamespaceConfigSchedule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *NamespaceConfigSchedule
+	switch t := that.(type) {
+	case *NamespaceConfigSchedule:
+		that1 = t
+	case NamespaceConfigSchedule:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ExportNamespaceConfigRequest to the protobuf v3 wire format
+func (val *ExportNamespaceConfigRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ExportNamespaceConfigRequest from the protobuf v3 wire format
+func (val *ExportNamespaceConfigRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ExportNamespaceConfigRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ExportNamespaceConfigRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ExportNamespaceConfigRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ExportNamespaceConfigRequest
+	switch t := that.(type) {
+	case *ExportNamespaceConfigRequest:
+		that1 = t
+	case ExportNamespaceConfigRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ExportNamespaceConfigResponse to the protobuf v3 wire format
+func (val *ExportNamespaceConfigResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ExportNamespaceConfigResponse from the protobuf v3 wire format
+func (val *ExportNamespaceConfigResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ExportNamespaceConfigResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ExportNamespaceConfigResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ExportNamespaceConfigResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ExportNamespaceConfigResponse
+	switch t := that.(type) {
+	case *ExportNamespaceConfigResponse:
+		that1 = t
+	case ExportNamespaceConfigResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ImportNamespaceConfigRequest to the protobuf v3 wire format
+func (val *ImportNamespaceConfigRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ImportNamespaceConfigRequest from the protobuf v3 wire format
+func (val *ImportNamespaceConfigRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ImportNamespaceConfigRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ImportNamespaceConfigRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ImportNamespaceConfigRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ImportNamespaceConfigRequest
+	switch t := that.(type) {
+	case *ImportNamespaceConfigRequest:
+		that1 = t
+	case ImportNamespaceConfigRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ImportNamespaceConfigResponse to the protobuf v3 wire format
+func (val *ImportNamespaceConfigResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ImportNamespaceConfigResponse from the protobuf v3 wire format
+func (val *ImportNamespaceConfigResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ImportNamespaceConfigResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ImportNamespaceConfigResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ImportNamespaceConfigResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ImportNamespaceConfigResponse
+	switch t := that.(type) {
+	case *ImportNamespaceConfigResponse:
+		that1 = t
+	case ImportNamespaceConfigResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ExportWorkflowExecutionsRequest to the protobuf v3 wire format
+func (val *ExportWorkflowExecutionsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ExportWorkflowExecutionsRequest from the protobuf v3 wire format
+func (val *ExportWorkflowExecutionsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ExportWorkflowExecutionsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ExportWorkflowExecutionsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ExportWorkflowExecutionsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ExportWorkflowExecutionsRequest
+	switch t := that.(type) {
+	case *ExportWorkflowExecutionsRequest:
+		that1 = t
+	case ExportWorkflowExecutionsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ExportWorkflowExecutionsResponse to the protobuf v3 wire format
+func (val *ExportWorkflowExecutionsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ExportWorkflowExecutionsResponse from the protobuf v3 wire format
+func (val *ExportWorkflowExecutionsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ExportWorkflowExecutionsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ExportWorkflowExecutionsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ExportWorkflowExecutionsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ExportWorkflowExecutionsResponse
+	switch t := that.(type) {
+	case *ExportWorkflowExecutionsResponse:
+		that1 = t
+	case ExportWorkflowExecutionsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ExportedWorkflowExecution to the protobuf v3 wire format
+func (val *ExportedWorkflowExecution) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ExportedWorkflowExecution from the protobuf v3 wire format
+func (val *ExportedWorkflowExecution) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ExportedWorkflowExecution) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ExportedWorkflowExecution values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ExportedWorkflowExecution) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ExportedWorkflowExecution
+	switch t := that.(type) {
+	case *ExportedWorkflowExecution:
+		that1 = t
+	case ExportedWorkflowExecution:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateClusterMetadataRequest to the protobuf v3 wire format
+func (val *UpdateClusterMetadataRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateClusterMetadataRequest from the protobuf v3 wire format
+func (val *UpdateClusterMetadataRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateClusterMetadataRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateClusterMetadataRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateClusterMetadataRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateClusterMetadataRequest
+	switch t := that.(type) {
+	case *UpdateClusterMetadataRequest:
+		that1 = t
+	case UpdateClusterMetadataRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateClusterMetadataResponse to the protobuf v3 wire format
+func (val *UpdateClusterMetadataResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateClusterMetadataResponse from the protobuf v3 wire format
+func (val *UpdateClusterMetadataResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateClusterMetadataResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateClusterMetadataResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateClusterMetadataResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateClusterMetadataResponse
+	switch t := that.(type) {
+	case *UpdateClusterMetadataResponse:
+		that1 = t
+	case UpdateClusterMetadataResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type MoveShardsRequest to the protobuf v3 wire format
+func (val *MoveShardsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type MoveShardsRequest from the protobuf v3 wire format
+func (val *MoveShardsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *MoveShardsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two MoveShardsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *MoveShardsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *MoveShardsRequest
+	switch t := that.(type) {
+	case *MoveShardsRequest:
+		that1 = t
+	case MoveShardsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type MoveShardsResponse to the protobuf v3 wire format
+func (val *MoveShardsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type MoveShardsResponse from the protobuf v3 wire format
+func (val *MoveShardsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *MoveShardsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two MoveShardsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *MoveShardsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *MoveShardsResponse
+	switch t := that.(type) {
+	case *MoveShardsResponse:
+		that1 = t
+	case MoveShardsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ShardMove to the protobuf v3 wire format
+func (val *ShardMove) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ShardMove from the protobuf v3 wire format
+func (val *ShardMove) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ShardMove) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ShardMove values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ShardMove) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ShardMove
+	switch t := that.(type) {
+	case *ShardMove:
+		that1 = t
+	case ShardMove:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StartRebuildMutableStateBatchOperationRequest to the protobuf v3 wire format
+func (val *StartRebuildMutableStateBatchOperationRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StartRebuildMutableStateBatchOperationRequest from the protobuf v3 wire format
+func (val *StartRebuildMutableStateBatchOperationRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StartRebuildMutableStateBatchOperationRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StartRebuildMutableStateBatchOperationRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StartRebuildMutableStateBatchOperationRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StartRebuildMutableStateBatchOperationRequest
+	switch t := that.(type) {
+	case *StartRebuildMutableStateBatchOperationRequest:
+		that1 = t
+	case StartRebuildMutableStateBatchOperationRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StartRebuildMutableStateBatchOperationResponse to the protobuf v3 wire format
+func (val *StartRebuildMutableStateBatchOperationResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StartRebuildMutableStateBatchOperationResponse from the protobuf v3 wire format
+func (val *StartRebuildMutableStateBatchOperationResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StartRebuildMutableStateBatchOperationResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StartRebuildMutableStateBatchOperationResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StartRebuildMutableStateBatchOperationResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StartRebuildMutableStateBatchOperationResponse
+	switch t := that.(type) {
+	case *StartRebuildMutableStateBatchOperationResponse:
+		that1 = t
+	case StartRebuildMutableStateBatchOperationResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StartRefreshWorkflowTasksBatchOperationRequest to the protobuf v3 wire format
+func (val *StartRefreshWorkflowTasksBatchOperationRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StartRefreshWorkflowTasksBatchOperationRequest from the protobuf v3 wire format
+func (val *StartRefreshWorkflowTasksBatchOperationRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StartRefreshWorkflowTasksBatchOperationRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StartRefreshWorkflowTasksBatchOperationRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StartRefreshWorkflowTasksBatchOperationRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StartRefreshWorkflowTasksBatchOperationRequest
+	switch t := that.(type) {
+	case *StartRefreshWorkflowTasksBatchOperationRequest:
+		that1 = t
+	case StartRefreshWorkflowTasksBatchOperationRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StartRefreshWorkflowTasksBatchOperationResponse to the protobuf v3 wire format
+func (val *StartRefreshWorkflowTasksBatchOperationResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StartRefreshWorkflowTasksBatchOperationResponse from the protobuf v3 wire format
+func (val *StartRefreshWorkflowTasksBatchOperationResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StartRefreshWorkflowTasksBatchOperationResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StartRefreshWorkflowTasksBatchOperationResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StartRefreshWorkflowTasksBatchOperationResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StartRefreshWorkflowTasksBatchOperationResponse
+	switch t := that.(type) {
+	case *StartRefreshWorkflowTasksBatchOperationResponse:
+		that1 = t
+	case StartRefreshWorkflowTasksBatchOperationResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
@@ -42,49 +42,77 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	AdminService_RebuildMutableState_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/RebuildMutableState"
-	AdminService_ImportWorkflowExecution_FullMethodName             = "/temporal.server.api.adminservice.v1.AdminService/ImportWorkflowExecution"
-	AdminService_DescribeMutableState_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/DescribeMutableState"
-	AdminService_DescribeHistoryHost_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/DescribeHistoryHost"
-	AdminService_GetShard_FullMethodName                            = "/temporal.server.api.adminservice.v1.AdminService/GetShard"
-	AdminService_CloseShard_FullMethodName                          = "/temporal.server.api.adminservice.v1.AdminService/CloseShard"
-	AdminService_ListHistoryTasks_FullMethodName                    = "/temporal.server.api.adminservice.v1.AdminService/ListHistoryTasks"
-	AdminService_RemoveTask_FullMethodName                          = "/temporal.server.api.adminservice.v1.AdminService/RemoveTask"
-	AdminService_GetWorkflowExecutionRawHistoryV2_FullMethodName    = "/temporal.server.api.adminservice.v1.AdminService/GetWorkflowExecutionRawHistoryV2"
-	AdminService_GetWorkflowExecutionRawHistory_FullMethodName      = "/temporal.server.api.adminservice.v1.AdminService/GetWorkflowExecutionRawHistory"
-	AdminService_GetReplicationMessages_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/GetReplicationMessages"
-	AdminService_GetNamespaceReplicationMessages_FullMethodName     = "/temporal.server.api.adminservice.v1.AdminService/GetNamespaceReplicationMessages"
-	AdminService_GetDLQReplicationMessages_FullMethodName           = "/temporal.server.api.adminservice.v1.AdminService/GetDLQReplicationMessages"
-	AdminService_ReapplyEvents_FullMethodName                       = "/temporal.server.api.adminservice.v1.AdminService/ReapplyEvents"
-	AdminService_AddSearchAttributes_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/AddSearchAttributes"
-	AdminService_RemoveSearchAttributes_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/RemoveSearchAttributes"
-	AdminService_GetSearchAttributes_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/GetSearchAttributes"
-	AdminService_DescribeCluster_FullMethodName                     = "/temporal.server.api.adminservice.v1.AdminService/DescribeCluster"
-	AdminService_ListClusters_FullMethodName                        = "/temporal.server.api.adminservice.v1.AdminService/ListClusters"
-	AdminService_ListClusterMembers_FullMethodName                  = "/temporal.server.api.adminservice.v1.AdminService/ListClusterMembers"
-	AdminService_AddOrUpdateRemoteCluster_FullMethodName            = "/temporal.server.api.adminservice.v1.AdminService/AddOrUpdateRemoteCluster"
-	AdminService_RemoveRemoteCluster_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/RemoveRemoteCluster"
-	AdminService_GetDLQMessages_FullMethodName                      = "/temporal.server.api.adminservice.v1.AdminService/GetDLQMessages"
-	AdminService_PurgeDLQMessages_FullMethodName                    = "/temporal.server.api.adminservice.v1.AdminService/PurgeDLQMessages"
-	AdminService_MergeDLQMessages_FullMethodName                    = "/temporal.server.api.adminservice.v1.AdminService/MergeDLQMessages"
-	AdminService_RefreshWorkflowTasks_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/RefreshWorkflowTasks"
-	AdminService_ResendReplicationTasks_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/ResendReplicationTasks"
-	AdminService_GetTaskQueueTasks_FullMethodName                   = "/temporal.server.api.adminservice.v1.AdminService/GetTaskQueueTasks"
-	AdminService_DeleteWorkflowExecution_FullMethodName             = "/temporal.server.api.adminservice.v1.AdminService/DeleteWorkflowExecution"
-	AdminService_StreamWorkflowReplicationMessages_FullMethodName   = "/temporal.server.api.adminservice.v1.AdminService/StreamWorkflowReplicationMessages"
-	AdminService_GetNamespace_FullMethodName                        = "/temporal.server.api.adminservice.v1.AdminService/GetNamespace"
-	AdminService_GetDLQTasks_FullMethodName                         = "/temporal.server.api.adminservice.v1.AdminService/GetDLQTasks"
-	AdminService_PurgeDLQTasks_FullMethodName                       = "/temporal.server.api.adminservice.v1.AdminService/PurgeDLQTasks"
-	AdminService_MergeDLQTasks_FullMethodName                       = "/temporal.server.api.adminservice.v1.AdminService/MergeDLQTasks"
-	AdminService_DescribeDLQJob_FullMethodName                      = "/temporal.server.api.adminservice.v1.AdminService/DescribeDLQJob"
-	AdminService_CancelDLQJob_FullMethodName                        = "/temporal.server.api.adminservice.v1.AdminService/CancelDLQJob"
-	AdminService_AddTasks_FullMethodName                            = "/temporal.server.api.adminservice.v1.AdminService/AddTasks"
-	AdminService_ListQueues_FullMethodName                          = "/temporal.server.api.adminservice.v1.AdminService/ListQueues"
-	AdminService_DeepHealthCheck_FullMethodName                     = "/temporal.server.api.adminservice.v1.AdminService/DeepHealthCheck"
-	AdminService_SyncWorkflowState_FullMethodName                   = "/temporal.server.api.adminservice.v1.AdminService/SyncWorkflowState"
-	AdminService_GenerateLastHistoryReplicationTasks_FullMethodName = "/temporal.server.api.adminservice.v1.AdminService/GenerateLastHistoryReplicationTasks"
-	AdminService_DescribeTaskQueuePartition_FullMethodName          = "/temporal.server.api.adminservice.v1.AdminService/DescribeTaskQueuePartition"
-	AdminService_ForceUnloadTaskQueuePartition_FullMethodName       = "/temporal.server.api.adminservice.v1.AdminService/ForceUnloadTaskQueuePartition"
+	AdminService_RebuildMutableState_FullMethodName                     = "/temporal.server.api.adminservice.v1.AdminService/RebuildMutableState"
+	AdminService_ImportWorkflowExecution_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/ImportWorkflowExecution"
+	AdminService_DescribeMutableState_FullMethodName                    = "/temporal.server.api.adminservice.v1.AdminService/DescribeMutableState"
+	AdminService_DescribeHistoryHost_FullMethodName                     = "/temporal.server.api.adminservice.v1.AdminService/DescribeHistoryHost"
+	AdminService_GetShard_FullMethodName                                = "/temporal.server.api.adminservice.v1.AdminService/GetShard"
+	AdminService_CloseShard_FullMethodName                              = "/temporal.server.api.adminservice.v1.AdminService/CloseShard"
+	AdminService_ListHistoryTasks_FullMethodName                        = "/temporal.server.api.adminservice.v1.AdminService/ListHistoryTasks"
+	AdminService_RemoveTask_FullMethodName                              = "/temporal.server.api.adminservice.v1.AdminService/RemoveTask"
+	AdminService_GetWorkflowExecutionRawHistoryV2_FullMethodName        = "/temporal.server.api.adminservice.v1.AdminService/GetWorkflowExecutionRawHistoryV2"
+	AdminService_GetWorkflowExecutionRawHistory_FullMethodName          = "/temporal.server.api.adminservice.v1.AdminService/GetWorkflowExecutionRawHistory"
+	AdminService_GetReplicationMessages_FullMethodName                  = "/temporal.server.api.adminservice.v1.AdminService/GetReplicationMessages"
+	AdminService_GetNamespaceReplicationMessages_FullMethodName         = "/temporal.server.api.adminservice.v1.AdminService/GetNamespaceReplicationMessages"
+	AdminService_GetDLQReplicationMessages_FullMethodName               = "/temporal.server.api.adminservice.v1.AdminService/GetDLQReplicationMessages"
+	AdminService_ReapplyEvents_FullMethodName                           = "/temporal.server.api.adminservice.v1.AdminService/ReapplyEvents"
+	AdminService_AddSearchAttributes_FullMethodName                     = "/temporal.server.api.adminservice.v1.AdminService/AddSearchAttributes"
+	AdminService_RemoveSearchAttributes_FullMethodName                  = "/temporal.server.api.adminservice.v1.AdminService/RemoveSearchAttributes"
+	AdminService_GetSearchAttributes_FullMethodName                     = "/temporal.server.api.adminservice.v1.AdminService/GetSearchAttributes"
+	AdminService_DescribeCluster_FullMethodName                         = "/temporal.server.api.adminservice.v1.AdminService/DescribeCluster"
+	AdminService_ListClusters_FullMethodName                            = "/temporal.server.api.adminservice.v1.AdminService/ListClusters"
+	AdminService_ListClusterMembers_FullMethodName                      = "/temporal.server.api.adminservice.v1.AdminService/ListClusterMembers"
+	AdminService_AddOrUpdateRemoteCluster_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/AddOrUpdateRemoteCluster"
+	AdminService_RemoveRemoteCluster_FullMethodName                     = "/temporal.server.api.adminservice.v1.AdminService/RemoveRemoteCluster"
+	AdminService_GetDLQMessages_FullMethodName                          = "/temporal.server.api.adminservice.v1.AdminService/GetDLQMessages"
+	AdminService_PurgeDLQMessages_FullMethodName                        = "/temporal.server.api.adminservice.v1.AdminService/PurgeDLQMessages"
+	AdminService_MergeDLQMessages_FullMethodName                        = "/temporal.server.api.adminservice.v1.AdminService/MergeDLQMessages"
+	AdminService_RefreshWorkflowTasks_FullMethodName                    = "/temporal.server.api.adminservice.v1.AdminService/RefreshWorkflowTasks"
+	AdminService_ResendReplicationTasks_FullMethodName                  = "/temporal.server.api.adminservice.v1.AdminService/ResendReplicationTasks"
+	AdminService_GetTaskQueueTasks_FullMethodName                       = "/temporal.server.api.adminservice.v1.AdminService/GetTaskQueueTasks"
+	AdminService_DeleteWorkflowExecution_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/DeleteWorkflowExecution"
+	AdminService_StreamWorkflowReplicationMessages_FullMethodName       = "/temporal.server.api.adminservice.v1.AdminService/StreamWorkflowReplicationMessages"
+	AdminService_GetNamespace_FullMethodName                            = "/temporal.server.api.adminservice.v1.AdminService/GetNamespace"
+	AdminService_GetDLQTasks_FullMethodName                             = "/temporal.server.api.adminservice.v1.AdminService/GetDLQTasks"
+	AdminService_PurgeDLQTasks_FullMethodName                           = "/temporal.server.api.adminservice.v1.AdminService/PurgeDLQTasks"
+	AdminService_MergeDLQTasks_FullMethodName                           = "/temporal.server.api.adminservice.v1.AdminService/MergeDLQTasks"
+	AdminService_DescribeDLQJob_FullMethodName                          = "/temporal.server.api.adminservice.v1.AdminService/DescribeDLQJob"
+	AdminService_CancelDLQJob_FullMethodName                            = "/temporal.server.api.adminservice.v1.AdminService/CancelDLQJob"
+	AdminService_AddTasks_FullMethodName                                = "/temporal.server.api.adminservice.v1.AdminService/AddTasks"
+	AdminService_ListQueues_FullMethodName                              = "/temporal.server.api.adminservice.v1.AdminService/ListQueues"
+	AdminService_DeepHealthCheck_FullMethodName                         = "/temporal.server.api.adminservice.v1.AdminService/DeepHealthCheck"
+	AdminService_SyncWorkflowState_FullMethodName                       = "/temporal.server.api.adminservice.v1.AdminService/SyncWorkflowState"
+	AdminService_GenerateLastHistoryReplicationTasks_FullMethodName     = "/temporal.server.api.adminservice.v1.AdminService/GenerateLastHistoryReplicationTasks"
+	AdminService_DescribeTaskQueuePartition_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/DescribeTaskQueuePartition"
+	AdminService_ForceUnloadTaskQueuePartition_FullMethodName           = "/temporal.server.api.adminservice.v1.AdminService/ForceUnloadTaskQueuePartition"
+	AdminService_ReArchiveWorkflowExecutions_FullMethodName             = "/temporal.server.api.adminservice.v1.AdminService/ReArchiveWorkflowExecutions"
+	AdminService_DescribeArchivalStatus_FullMethodName                  = "/temporal.server.api.adminservice.v1.AdminService/DescribeArchivalStatus"
+	AdminService_DescribeReplicationLag_FullMethodName                  = "/temporal.server.api.adminservice.v1.AdminService/DescribeReplicationLag"
+	AdminService_StartSignalWithStartBatchOperation_FullMethodName      = "/temporal.server.api.adminservice.v1.AdminService/StartSignalWithStartBatchOperation"
+	AdminService_CancelScheduleBackfill_FullMethodName                  = "/temporal.server.api.adminservice.v1.AdminService/CancelScheduleBackfill"
+	AdminService_UpdateSchedulePolicies_FullMethodName                  = "/temporal.server.api.adminservice.v1.AdminService/UpdateSchedulePolicies"
+	AdminService_UpdateNexusEndpointOperationDefaults_FullMethodName    = "/temporal.server.api.adminservice.v1.AdminService/UpdateNexusEndpointOperationDefaults"
+	AdminService_ListNexusEndpointHealth_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/ListNexusEndpointHealth"
+	AdminService_UpdateNexusEndpointOutboundAuth_FullMethodName         = "/temporal.server.api.adminservice.v1.AdminService/UpdateNexusEndpointOutboundAuth"
+	AdminService_ReplicateNexusEndpoints_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/ReplicateNexusEndpoints"
+	AdminService_ListPendingNexusOperations_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/ListPendingNexusOperations"
+	AdminService_SignalWorkflowExecutionsByQuery_FullMethodName         = "/temporal.server.api.adminservice.v1.AdminService/SignalWorkflowExecutionsByQuery"
+	AdminService_MultiSignalWorkflowExecution_FullMethodName            = "/temporal.server.api.adminservice.v1.AdminService/MultiSignalWorkflowExecution"
+	AdminService_PauseActivityType_FullMethodName                       = "/temporal.server.api.adminservice.v1.AdminService/PauseActivityType"
+	AdminService_UnpauseActivityType_FullMethodName                     = "/temporal.server.api.adminservice.v1.AdminService/UnpauseActivityType"
+	AdminService_CaptureProfile_FullMethodName                          = "/temporal.server.api.adminservice.v1.AdminService/CaptureProfile"
+	AdminService_DescribeShardOwnership_FullMethodName                  = "/temporal.server.api.adminservice.v1.AdminService/DescribeShardOwnership"
+	AdminService_ListHotspots_FullMethodName                            = "/temporal.server.api.adminservice.v1.AdminService/ListHotspots"
+	AdminService_GetDebugTrace_FullMethodName                           = "/temporal.server.api.adminservice.v1.AdminService/GetDebugTrace"
+	AdminService_GetDynamicConfig_FullMethodName                        = "/temporal.server.api.adminservice.v1.AdminService/GetDynamicConfig"
+	AdminService_SetDynamicConfig_FullMethodName                        = "/temporal.server.api.adminservice.v1.AdminService/SetDynamicConfig"
+	AdminService_ExportNamespaceConfig_FullMethodName                   = "/temporal.server.api.adminservice.v1.AdminService/ExportNamespaceConfig"
+	AdminService_ImportNamespaceConfig_FullMethodName                   = "/temporal.server.api.adminservice.v1.AdminService/ImportNamespaceConfig"
+	AdminService_ExportWorkflowExecutions_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/ExportWorkflowExecutions"
+	AdminService_UpdateClusterMetadata_FullMethodName                   = "/temporal.server.api.adminservice.v1.AdminService/UpdateClusterMetadata"
+	AdminService_MoveShards_FullMethodName                              = "/temporal.server.api.adminservice.v1.AdminService/MoveShards"
+	AdminService_StartRebuildMutableStateBatchOperation_FullMethodName  = "/temporal.server.api.adminservice.v1.AdminService/StartRebuildMutableStateBatchOperation"
+	AdminService_StartRefreshWorkflowTasksBatchOperation_FullMethodName = "/temporal.server.api.adminservice.v1.AdminService/StartRefreshWorkflowTasksBatchOperation"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -175,6 +203,104 @@ type AdminServiceClient interface {
 	GenerateLastHistoryReplicationTasks(ctx context.Context, in *GenerateLastHistoryReplicationTasksRequest, opts ...grpc.CallOption) (*GenerateLastHistoryReplicationTasksResponse, error)
 	DescribeTaskQueuePartition(ctx context.Context, in *DescribeTaskQueuePartitionRequest, opts ...grpc.CallOption) (*DescribeTaskQueuePartitionResponse, error)
 	ForceUnloadTaskQueuePartition(ctx context.Context, in *ForceUnloadTaskQueuePartitionRequest, opts ...grpc.CallOption) (*ForceUnloadTaskQueuePartitionResponse, error)
+	// ReArchiveWorkflowExecutions starts a system workflow which re-archives the closed executions matching the given
+	// visibility query. Existing archives are overwritten, so the operation is safe to repeat.
+	ReArchiveWorkflowExecutions(ctx context.Context, in *ReArchiveWorkflowExecutionsRequest, opts ...grpc.CallOption) (*ReArchiveWorkflowExecutionsResponse, error)
+	// DescribeArchivalStatus returns the archival configuration of a namespace together with its archival backlog,
+	// i.e. the archival tasks which are still pending across all history shards.
+	DescribeArchivalStatus(ctx context.Context, in *DescribeArchivalStatusRequest, opts ...grpc.CallOption) (*DescribeArchivalStatusResponse, error)
+	// DescribeReplicationLag returns how far behind remote clusters are on replication, per history shard and
+	// optionally for a single namespace.
+	DescribeReplicationLag(ctx context.Context, in *DescribeReplicationLagRequest, opts ...grpc.CallOption) (*DescribeReplicationLagResponse, error)
+	// StartSignalWithStartBatchOperation starts a batch operation which signal-with-starts a list of workflows, either
+	// given explicitly or derived from a visibility query, possibly on another namespace. It is tracked like any other
+	// batch operation, e.g. DescribeBatchOperation and StopBatchOperation work with its job id.
+	StartSignalWithStartBatchOperation(ctx context.Context, in *StartSignalWithStartBatchOperationRequest, opts ...grpc.CallOption) (*StartSignalWithStartBatchOperationResponse, error)
+	// CancelScheduleBackfill cancels an ongoing backfill of a schedule. Actions the backfill has buffered but not
+	// started yet are dropped, workflows it already started are left alone.
+	CancelScheduleBackfill(ctx context.Context, in *CancelScheduleBackfillRequest, opts ...grpc.CallOption) (*CancelScheduleBackfillResponse, error)
+	// UpdateSchedulePolicies replaces the policies of a schedule which the schedule API doesn't have, like pausing the
+	// schedule after consecutive failed actions or how calendar specs treat daylight saving transitions. They are
+	// applied asynchronously and kept by UpdateSchedule.
+	UpdateSchedulePolicies(ctx context.Context, in *UpdateSchedulePoliciesRequest, opts ...grpc.CallOption) (*UpdateSchedulePoliciesResponse, error)
+	// UpdateNexusEndpointOperationDefaults sets the default schedule-to-close timeout and retry policy applied to
+	// Nexus operations targeting an endpoint when the caller doesn't specify them.
+	UpdateNexusEndpointOperationDefaults(ctx context.Context, in *UpdateNexusEndpointOperationDefaultsRequest, opts ...grpc.CallOption) (*UpdateNexusEndpointOperationDefaultsResponse, error)
+	// ListNexusEndpointHealth returns recent success and failure counts of requests to Nexus endpoints and the state of
+	// their circuit breakers, aggregated across all history hosts.
+	ListNexusEndpointHealth(ctx context.Context, in *ListNexusEndpointHealthRequest, opts ...grpc.CallOption) (*ListNexusEndpointHealthResponse, error)
+	// UpdateNexusEndpointOutboundAuth sets the credentials history attaches to requests sent to an external Nexus
+	// endpoint target, i.e. a bearer token, an OAuth2 client credentials flow and/or a TLS client certificate.
+	UpdateNexusEndpointOutboundAuth(ctx context.Context, in *UpdateNexusEndpointOutboundAuthRequest, opts ...grpc.CallOption) (*UpdateNexusEndpointOutboundAuthResponse, error)
+	// ReplicateNexusEndpoints publishes the current state of Nexus endpoints to the namespace replication queue. Use it
+	// to copy endpoints created before endpoint replication was enabled, or before a remote cluster was connected.
+	ReplicateNexusEndpoints(ctx context.Context, in *ReplicateNexusEndpointsRequest, opts ...grpc.CallOption) (*ReplicateNexusEndpointsResponse, error)
+	// ListPendingNexusOperations lists the running executions of a namespace with pending Nexus operations, e.g. to find
+	// all executions blocked on an endpoint. Requires the system.enablePendingNexusOperationsSearchAttribute dynamic
+	// config to be enabled for the namespace.
+	ListPendingNexusOperations(ctx context.Context, in *ListPendingNexusOperationsRequest, opts ...grpc.CallOption) (*ListPendingNexusOperationsResponse, error)
+	// SignalWorkflowExecutionsByQuery synchronously signals the executions matching a visibility query and reports the
+	// outcome per execution. It is meant for small, targeted fan-outs: at most admin.signalByQueryMaxExecutions
+	// executions are signaled. Use a batch operation for anything larger.
+	SignalWorkflowExecutionsByQuery(ctx context.Context, in *SignalWorkflowExecutionsByQueryRequest, opts ...grpc.CallOption) (*SignalWorkflowExecutionsByQueryResponse, error)
+	// MultiSignalWorkflowExecution delivers a list of signals to a running execution atomically: either all or none of
+	// the signals are recorded, in order, in a single history transaction and delivered in the same workflow task.
+	MultiSignalWorkflowExecution(ctx context.Context, in *MultiSignalWorkflowExecutionRequest, opts ...grpc.CallOption) (*MultiSignalWorkflowExecutionResponse, error)
+	// PauseActivityType pauses the dispatch of the activities of a type across a namespace, e.g. while a dependency
+	// of the activities is down. History postpones the activities instead of dispatching them, extending their
+	// schedule-to-start and schedule-to-close timeouts meanwhile. Activities already started are not affected.
+	PauseActivityType(ctx context.Context, in *PauseActivityTypeRequest, opts ...grpc.CallOption) (*PauseActivityTypeResponse, error)
+	// UnpauseActivityType resumes the dispatch of the activities of a type paused by PauseActivityType. Postponed
+	// activities are dispatched within history.pausedActivityTypeRecheckInterval.
+	UnpauseActivityType(ctx context.Context, in *UnpauseActivityTypeRequest, opts ...grpc.CallOption) (*UnpauseActivityTypeResponse, error)
+	// CaptureProfile captures cpu, heap, goroutine or other runtime profiles of a host, or of every host of a role, and
+	// returns them or writes them to the configured profile store. Hosts other than the frontend serving the request
+	// are profiled through their pprof endpoint, which must listen on an address reachable from the frontend.
+	CaptureProfile(ctx context.Context, in *CaptureProfileRequest, opts ...grpc.CallOption) (*CaptureProfileResponse, error)
+	// DescribeShardOwnership returns the history host owning each shard, when it acquired the shard and the range ID
+	// of its lease, along with the shard counts per host and the recent shard movements reported by the hosts.
+	DescribeShardOwnership(ctx context.Context, in *DescribeShardOwnershipRequest, opts ...grpc.CallOption) (*DescribeShardOwnershipResponse, error)
+	// ListHotspots returns the shards and workflows using a disproportionate share of the lock wait time, task
+	// processing and history writes of their history hosts, as measured over the recent detection windows.
+	ListHotspots(ctx context.Context, in *ListHotspotsRequest, opts ...grpc.CallOption) (*ListHotspotsResponse, error)
+	// GetDebugTrace returns the spans recorded for a request that was sent with the debug trace header, as held by
+	// the frontend serving this call and by all history hosts. Spans are only kept for a short time.
+	GetDebugTrace(ctx context.Context, in *GetDebugTraceRequest, opts ...grpc.CallOption) (*GetDebugTraceResponse, error)
+	// GetDynamicConfig returns the dynamic config values set at runtime through SetDynamicConfig.
+	GetDynamicConfig(ctx context.Context, in *GetDynamicConfigRequest, opts ...grpc.CallOption) (*GetDynamicConfigResponse, error)
+	// SetDynamicConfig sets the values of a dynamic config key at runtime, on top of the dynamic config file. Values are
+	// persisted and picked up by all hosts of the cluster within two namespace cache refresh intervals. Each change is
+	// logged along with the identity of the caller.
+	SetDynamicConfig(ctx context.Context, in *SetDynamicConfigRequest, opts ...grpc.CallOption) (*SetDynamicConfigResponse, error)
+	// ExportNamespaceConfig returns the configuration of a namespace as one document: the namespace record, its
+	// custom search attributes, the runtime dynamic config overrides constrained to it, its schedules and the Nexus
+	// endpoints targeting it.
+	ExportNamespaceConfig(ctx context.Context, in *ExportNamespaceConfigRequest, opts ...grpc.CallOption) (*ExportNamespaceConfigResponse, error)
+	// ImportNamespaceConfig applies a document returned by ExportNamespaceConfig, typically from another cluster. The
+	// namespace is registered or updated, missing search attributes are added and dynamic config overrides for the
+	// namespace are replaced. Schedules and Nexus endpoints are only created if they don't exist yet.
+	ImportNamespaceConfig(ctx context.Context, in *ImportNamespaceConfigRequest, opts ...grpc.CallOption) (*ImportNamespaceConfigResponse, error)
+	// ExportWorkflowExecutions returns a page of the executions of a namespace which were started before the snapshot
+	// time, each with its mutable state and the history up to the last event of that mutable state. The snapshot time
+	// of the first page is kept in the page token, so all pages list the same executions. It's used to export namespace
+	// snapshots which seed the executions of a new cluster.
+	ExportWorkflowExecutions(ctx context.Context, in *ExportWorkflowExecutionsRequest, opts ...grpc.CallOption) (*ExportWorkflowExecutionsResponse, error)
+	// UpdateClusterMetadata changes the failover version increment or the addresses of a cluster metadata record after
+	// checking that the change is compatible with the connected clusters and the existing namespaces. With dry_run set,
+	// only the check is run. A changed failover version increment of the current cluster takes effect on restart.
+	UpdateClusterMetadata(ctx context.Context, in *UpdateClusterMetadataRequest, opts ...grpc.CallOption) (*UpdateClusterMetadataResponse, error)
+	// MoveShards pins history shards to a host other than the one chosen by the membership ring, e.g. to take load off
+	// a hot host without restarting it. Pinned shards return to the ring's choice if their host leaves the ring or is
+	// draining. Moves take effect once the override reached all hosts, within the namespace cache refresh interval.
+	MoveShards(ctx context.Context, in *MoveShardsRequest, opts ...grpc.CallOption) (*MoveShardsResponse, error)
+	// StartRebuildMutableStateBatchOperation starts a batch operation which rebuilds the mutable state of all executions
+	// matching a visibility query from their history, like RebuildMutableState does for a single execution. It is
+	// tracked like any other batch operation, e.g. DescribeBatchOperation and StopBatchOperation work with its job id.
+	StartRebuildMutableStateBatchOperation(ctx context.Context, in *StartRebuildMutableStateBatchOperationRequest, opts ...grpc.CallOption) (*StartRebuildMutableStateBatchOperationResponse, error)
+	// StartRefreshWorkflowTasksBatchOperation starts a batch operation which regenerates the tasks of all executions
+	// matching a visibility query or given explicitly, like RefreshWorkflowTasks does for a single execution, e.g. to
+	// recover from lost tasks. Its progress is reported by DescribeBatchOperation.
+	StartRefreshWorkflowTasksBatchOperation(ctx context.Context, in *StartRefreshWorkflowTasksBatchOperationRequest, opts ...grpc.CallOption) (*StartRefreshWorkflowTasksBatchOperationResponse, error)
 }
 
 type adminServiceClient struct {
@@ -594,6 +720,258 @@ func (c *adminServiceClient) ForceUnloadTaskQueuePartition(ctx context.Context,
 	return out, nil
 }
 
+func (c *adminServiceClient) ReArchiveWorkflowExecutions(ctx context.Context, in *ReArchiveWorkflowExecutionsRequest, opts ...grpc.CallOption) (*ReArchiveWorkflowExecutionsResponse, error) {
+	out := new(ReArchiveWorkflowExecutionsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ReArchiveWorkflowExecutions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeArchivalStatus(ctx context.Context, in *DescribeArchivalStatusRequest, opts ...grpc.CallOption) (*DescribeArchivalStatusResponse, error) {
+	out := new(DescribeArchivalStatusResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeArchivalStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeReplicationLag(ctx context.Context, in *DescribeReplicationLagRequest, opts ...grpc.CallOption) (*DescribeReplicationLagResponse, error) {
+	out := new(DescribeReplicationLagResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeReplicationLag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StartSignalWithStartBatchOperation(ctx context.Context, in *StartSignalWithStartBatchOperationRequest, opts ...grpc.CallOption) (*StartSignalWithStartBatchOperationResponse, error) {
+	out := new(StartSignalWithStartBatchOperationResponse)
+	err := c.cc.Invoke(ctx, AdminService_StartSignalWithStartBatchOperation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CancelScheduleBackfill(ctx context.Context, in *CancelScheduleBackfillRequest, opts ...grpc.CallOption) (*CancelScheduleBackfillResponse, error) {
+	out := new(CancelScheduleBackfillResponse)
+	err := c.cc.Invoke(ctx, AdminService_CancelScheduleBackfill_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateSchedulePolicies(ctx context.Context, in *UpdateSchedulePoliciesRequest, opts ...grpc.CallOption) (*UpdateSchedulePoliciesResponse, error) {
+	out := new(UpdateSchedulePoliciesResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateSchedulePolicies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateNexusEndpointOperationDefaults(ctx context.Context, in *UpdateNexusEndpointOperationDefaultsRequest, opts ...grpc.CallOption) (*UpdateNexusEndpointOperationDefaultsResponse, error) {
+	out := new(UpdateNexusEndpointOperationDefaultsResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateNexusEndpointOperationDefaults_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListNexusEndpointHealth(ctx context.Context, in *ListNexusEndpointHealthRequest, opts ...grpc.CallOption) (*ListNexusEndpointHealthResponse, error) {
+	out := new(ListNexusEndpointHealthResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListNexusEndpointHealth_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateNexusEndpointOutboundAuth(ctx context.Context, in *UpdateNexusEndpointOutboundAuthRequest, opts ...grpc.CallOption) (*UpdateNexusEndpointOutboundAuthResponse, error) {
+	out := new(UpdateNexusEndpointOutboundAuthResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateNexusEndpointOutboundAuth_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ReplicateNexusEndpoints(ctx context.Context, in *ReplicateNexusEndpointsRequest, opts ...grpc.CallOption) (*ReplicateNexusEndpointsResponse, error) {
+	out := new(ReplicateNexusEndpointsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ReplicateNexusEndpoints_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListPendingNexusOperations(ctx context.Context, in *ListPendingNexusOperationsRequest, opts ...grpc.CallOption) (*ListPendingNexusOperationsResponse, error) {
+	out := new(ListPendingNexusOperationsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListPendingNexusOperations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SignalWorkflowExecutionsByQuery(ctx context.Context, in *SignalWorkflowExecutionsByQueryRequest, opts ...grpc.CallOption) (*SignalWorkflowExecutionsByQueryResponse, error) {
+	out := new(SignalWorkflowExecutionsByQueryResponse)
+	err := c.cc.Invoke(ctx, AdminService_SignalWorkflowExecutionsByQuery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) MultiSignalWorkflowExecution(ctx context.Context, in *MultiSignalWorkflowExecutionRequest, opts ...grpc.CallOption) (*MultiSignalWorkflowExecutionResponse, error) {
+	out := new(MultiSignalWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, AdminService_MultiSignalWorkflowExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PauseActivityType(ctx context.Context, in *PauseActivityTypeRequest, opts ...grpc.CallOption) (*PauseActivityTypeResponse, error) {
+	out := new(PauseActivityTypeResponse)
+	err := c.cc.Invoke(ctx, AdminService_PauseActivityType_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UnpauseActivityType(ctx context.Context, in *UnpauseActivityTypeRequest, opts ...grpc.CallOption) (*UnpauseActivityTypeResponse, error) {
+	out := new(UnpauseActivityTypeResponse)
+	err := c.cc.Invoke(ctx, AdminService_UnpauseActivityType_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CaptureProfile(ctx context.Context, in *CaptureProfileRequest, opts ...grpc.CallOption) (*CaptureProfileResponse, error) {
+	out := new(CaptureProfileResponse)
+	err := c.cc.Invoke(ctx, AdminService_CaptureProfile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeShardOwnership(ctx context.Context, in *DescribeShardOwnershipRequest, opts ...grpc.CallOption) (*DescribeShardOwnershipResponse, error) {
+	out := new(DescribeShardOwnershipResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeShardOwnership_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListHotspots(ctx context.Context, in *ListHotspotsRequest, opts ...grpc.CallOption) (*ListHotspotsResponse, error) {
+	out := new(ListHotspotsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListHotspots_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetDebugTrace(ctx context.Context, in *GetDebugTraceRequest, opts ...grpc.CallOption) (*GetDebugTraceResponse, error) {
+	out := new(GetDebugTraceResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetDebugTrace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetDynamicConfig(ctx context.Context, in *GetDynamicConfigRequest, opts ...grpc.CallOption) (*GetDynamicConfigResponse, error) {
+	out := new(GetDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetDynamicConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetDynamicConfig(ctx context.Context, in *SetDynamicConfigRequest, opts ...grpc.CallOption) (*SetDynamicConfigResponse, error) {
+	out := new(SetDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetDynamicConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ExportNamespaceConfig(ctx context.Context, in *ExportNamespaceConfigRequest, opts ...grpc.CallOption) (*ExportNamespaceConfigResponse, error) {
+	out := new(ExportNamespaceConfigResponse)
+	err := c.cc.Invoke(ctx, AdminService_ExportNamespaceConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ImportNamespaceConfig(ctx context.Context, in *ImportNamespaceConfigRequest, opts ...grpc.CallOption) (*ImportNamespaceConfigResponse, error) {
+	out := new(ImportNamespaceConfigResponse)
+	err := c.cc.Invoke(ctx, AdminService_ImportNamespaceConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ExportWorkflowExecutions(ctx context.Context, in *ExportWorkflowExecutionsRequest, opts ...grpc.CallOption) (*ExportWorkflowExecutionsResponse, error) {
+	out := new(ExportWorkflowExecutionsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ExportWorkflowExecutions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateClusterMetadata(ctx context.Context, in *UpdateClusterMetadataRequest, opts ...grpc.CallOption) (*UpdateClusterMetadataResponse, error) {
+	out := new(UpdateClusterMetadataResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateClusterMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) MoveShards(ctx context.Context, in *MoveShardsRequest, opts ...grpc.CallOption) (*MoveShardsResponse, error) {
+	out := new(MoveShardsResponse)
+	err := c.cc.Invoke(ctx, AdminService_MoveShards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StartRebuildMutableStateBatchOperation(ctx context.Context, in *StartRebuildMutableStateBatchOperationRequest, opts ...grpc.CallOption) (*StartRebuildMutableStateBatchOperationResponse, error) {
+	out := new(StartRebuildMutableStateBatchOperationResponse)
+	err := c.cc.Invoke(ctx, AdminService_StartRebuildMutableStateBatchOperation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StartRefreshWorkflowTasksBatchOperation(ctx context.Context, in *StartRefreshWorkflowTasksBatchOperationRequest, opts ...grpc.CallOption) (*StartRefreshWorkflowTasksBatchOperationResponse, error) {
+	out := new(StartRefreshWorkflowTasksBatchOperationResponse)
+	err := c.cc.Invoke(ctx, AdminService_StartRefreshWorkflowTasksBatchOperation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility
@@ -682,6 +1060,104 @@ type AdminServiceServer interface {
 	GenerateLastHistoryReplicationTasks(context.Context, *GenerateLastHistoryReplicationTasksRequest) (*GenerateLastHistoryReplicationTasksResponse, error)
 	DescribeTaskQueuePartition(context.Context, *DescribeTaskQueuePartitionRequest) (*DescribeTaskQueuePartitionResponse, error)
 	ForceUnloadTaskQueuePartition(context.Context, *ForceUnloadTaskQueuePartitionRequest) (*ForceUnloadTaskQueuePartitionResponse, error)
+	// ReArchiveWorkflowExecutions starts a system workflow which re-archives the closed executions matching the given
+	// visibility query. Existing archives are overwritten, so the operation is safe to repeat.
+	ReArchiveWorkflowExecutions(context.Context, *ReArchiveWorkflowExecutionsRequest) (*ReArchiveWorkflowExecutionsResponse, error)
+	// DescribeArchivalStatus returns the archival configuration of a namespace together with its archival backlog,
+	// i.e. the archival tasks which are still pending across all history shards.
+	DescribeArchivalStatus(context.Context, *DescribeArchivalStatusRequest) (*DescribeArchivalStatusResponse, error)
+	// DescribeReplicationLag returns how far behind remote clusters are on replication, per history shard and
+	// optionally for a single namespace.
+	DescribeReplicationLag(context.Context, *DescribeReplicationLagRequest) (*DescribeReplicationLagResponse, error)
+	// StartSignalWithStartBatchOperation starts a batch operation which signal-with-starts a list of workflows, either
+	// given explicitly or derived from a visibility query, possibly on another namespace. It is tracked like any other
+	// batch operation, e.g. DescribeBatchOperation and StopBatchOperation work with its job id.
+	StartSignalWithStartBatchOperation(context.Context, *StartSignalWithStartBatchOperationRequest) (*StartSignalWithStartBatchOperationResponse, error)
+	// CancelScheduleBackfill cancels an ongoing backfill of a schedule. Actions the backfill has buffered but not
+	// started yet are dropped, workflows it already started are left alone.
+	CancelScheduleBackfill(context.Context, *CancelScheduleBackfillRequest) (*CancelScheduleBackfillResponse, error)
+	// UpdateSchedulePolicies replaces the policies of a schedule which the schedule API doesn't have, like pausing the
+	// schedule after consecutive failed actions or how calendar specs treat daylight saving transitions. They are
+	// applied asynchronously and kept by UpdateSchedule.
+	UpdateSchedulePolicies(context.Context, *UpdateSchedulePoliciesRequest) (*UpdateSchedulePoliciesResponse, error)
+	// UpdateNexusEndpointOperationDefaults sets the default schedule-to-close timeout and retry policy applied to
+	// Nexus operations targeting an endpoint when the caller doesn't specify them.
+	UpdateNexusEndpointOperationDefaults(context.Context, *UpdateNexusEndpointOperationDefaultsRequest) (*UpdateNexusEndpointOperationDefaultsResponse, error)
+	// ListNexusEndpointHealth returns recent success and failure counts of requests to Nexus endpoints and the state of
+	// their circuit breakers, aggregated across all history hosts.
+	ListNexusEndpointHealth(context.Context, *ListNexusEndpointHealthRequest) (*ListNexusEndpointHealthResponse, error)
+	// UpdateNexusEndpointOutboundAuth sets the credentials history attaches to requests sent to an external Nexus
+	// endpoint target, i.e. a bearer token, an OAuth2 client credentials flow and/or a TLS client certificate.
+	UpdateNexusEndpointOutboundAuth(context.Context, *UpdateNexusEndpointOutboundAuthRequest) (*UpdateNexusEndpointOutboundAuthResponse, error)
+	// ReplicateNexusEndpoints publishes the current state of Nexus endpoints to the namespace replication queue. Use it
+	// to copy endpoints created before endpoint replication was enabled, or before a remote cluster was connected.
+	ReplicateNexusEndpoints(context.Context, *ReplicateNexusEndpointsRequest) (*ReplicateNexusEndpointsResponse, error)
+	// ListPendingNexusOperations lists the running executions of a namespace with pending Nexus operations, e.g. to find
+	// all executions blocked on an endpoint. Requires the system.enablePendingNexusOperationsSearchAttribute dynamic
+	// config to be enabled for the namespace.
+	ListPendingNexusOperations(context.Context, *ListPendingNexusOperationsRequest) (*ListPendingNexusOperationsResponse, error)
+	// SignalWorkflowExecutionsByQuery synchronously signals the executions matching a visibility query and reports the
+	// outcome per execution. It is meant for small, targeted fan-outs: at most admin.signalByQueryMaxExecutions
+	// executions are signaled. Use a batch operation for anything larger.
+	SignalWorkflowExecutionsByQuery(context.Context, *SignalWorkflowExecutionsByQueryRequest) (*SignalWorkflowExecutionsByQueryResponse, error)
+	// MultiSignalWorkflowExecution delivers a list of signals to a running execution atomically: either all or none of
+	// the signals are recorded, in order, in a single history transaction and delivered in the same workflow task.
+	MultiSignalWorkflowExecution(context.Context, *MultiSignalWorkflowExecutionRequest) (*MultiSignalWorkflowExecutionResponse, error)
+	// PauseActivityType pauses the dispatch of the activities of a type across a namespace, e.g. while a dependency
+	// of the activities is down. History postpones the activities instead of dispatching them, extending their
+	// schedule-to-start and schedule-to-close timeouts meanwhile. Activities already started are not affected.
+	PauseActivityType(context.Context, *PauseActivityTypeRequest) (*PauseActivityTypeResponse, error)
+	// UnpauseActivityType resumes the dispatch of the activities of a type paused by PauseActivityType. Postponed
+	// activities are dispatched within history.pausedActivityTypeRecheckInterval.
+	UnpauseActivityType(context.Context, *UnpauseActivityTypeRequest) (*UnpauseActivityTypeResponse, error)
+	// CaptureProfile captures cpu, heap, goroutine or other runtime profiles of a host, or of every host of a role, and
+	// returns them or writes them to the configured profile store. Hosts other than the frontend serving the request
+	// are profiled through their pprof endpoint, which must listen on an address reachable from the frontend.
+	CaptureProfile(context.Context, *CaptureProfileRequest) (*CaptureProfileResponse, error)
+	// DescribeShardOwnership returns the history host owning each shard, when it acquired the shard and the range ID
+	// of its lease, along with the shard counts per host and the recent shard movements reported by the hosts.
+	DescribeShardOwnership(context.Context, *DescribeShardOwnershipRequest) (*DescribeShardOwnershipResponse, error)
+	// ListHotspots returns the shards and workflows using a disproportionate share of the lock wait time, task
+	// processing and history writes of their history hosts, as measured over the recent detection windows.
+	ListHotspots(context.Context, *ListHotspotsRequest) (*ListHotspotsResponse, error)
+	// GetDebugTrace returns the spans recorded for a request that was sent with the debug trace header, as held by
+	// the frontend serving this call and by all history hosts. Spans are only kept for a short time.
+	GetDebugTrace(context.Context, *GetDebugTraceRequest) (*GetDebugTraceResponse, error)
+	// GetDynamicConfig returns the dynamic config values set at runtime through SetDynamicConfig.
+	GetDynamicConfig(context.Context, *GetDynamicConfigRequest) (*GetDynamicConfigResponse, error)
+	// SetDynamicConfig sets the values of a dynamic config key at runtime, on top of the dynamic config file. Values are
+	// persisted and picked up by all hosts of the cluster within two namespace cache refresh intervals. Each change is
+	// logged along with the identity of the caller.
+	SetDynamicConfig(context.Context, *SetDynamicConfigRequest) (*SetDynamicConfigResponse, error)
+	// ExportNamespaceConfig returns the configuration of a namespace as one document: the namespace record, its
+	// custom search attributes, the runtime dynamic config overrides constrained to it, its schedules and the Nexus
+	// endpoints targeting it.
+	ExportNamespaceConfig(context.Context, *ExportNamespaceConfigRequest) (*ExportNamespaceConfigResponse, error)
+	// ImportNamespaceConfig applies a document returned by ExportNamespaceConfig, typically from another cluster. The
+	// namespace is registered or updated, missing search attributes are added and dynamic config overrides for the
+	// namespace are replaced. Schedules and Nexus endpoints are only created if they don't exist yet.
+	ImportNamespaceConfig(context.Context, *ImportNamespaceConfigRequest) (*ImportNamespaceConfigResponse, error)
+	// ExportWorkflowExecutions returns a page of the executions of a namespace which were started before the snapshot
+	// time, each with its mutable state and the history up to the last event of that mutable state. The snapshot time
+	// of the first page is kept in the page token, so all pages list the same executions. It's used to export namespace
+	// snapshots which seed the executions of a new cluster.
+	ExportWorkflowExecutions(context.Context, *ExportWorkflowExecutionsRequest) (*ExportWorkflowExecutionsResponse, error)
+	// UpdateClusterMetadata changes the failover version increment or the addresses of a cluster metadata record after
+	// checking that the change is compatible with the connected clusters and the existing namespaces. With dry_run set,
+	// only the check is run. A changed failover version increment of the current cluster takes effect on restart.
+	UpdateClusterMetadata(context.Context, *UpdateClusterMetadataRequest) (*UpdateClusterMetadataResponse, error)
+	// MoveShards pins history shards to a host other than the one chosen by the membership ring, e.g. to take load off
+	// a hot host without restarting it. Pinned shards return to the ring's choice if their host leaves the ring or is
+	// draining. Moves take effect once the override reached all hosts, within the namespace cache refresh interval.
+	MoveShards(context.Context, *MoveShardsRequest) (*MoveShardsResponse, error)
+	// StartRebuildMutableStateBatchOperation starts a batch operation which rebuilds the mutable state of all executions
+	// matching a visibility query from their history, like RebuildMutableState does for a single execution. It is
+	// tracked like any other batch operation, e.g. DescribeBatchOperation and StopBatchOperation work with its job id.
+	StartRebuildMutableStateBatchOperation(context.Context, *StartRebuildMutableStateBatchOperationRequest) (*StartRebuildMutableStateBatchOperationResponse, error)
+	// StartRefreshWorkflowTasksBatchOperation starts a batch operation which regenerates the tasks of all executions
+	// matching a visibility query or given explicitly, like RefreshWorkflowTasks does for a single execution, e.g. to
+	// recover from lost tasks. Its progress is reported by DescribeBatchOperation.
+	StartRefreshWorkflowTasksBatchOperation(context.Context, *StartRefreshWorkflowTasksBatchOperationRequest) (*StartRefreshWorkflowTasksBatchOperationResponse, error)
 	mustEmbedUnimplementedAdminServiceServer()
 }
 
@@ -818,6 +1294,90 @@ func (UnimplementedAdminServiceServer) DescribeTaskQueuePartition(context.Contex
 func (UnimplementedAdminServiceServer) ForceUnloadTaskQueuePartition(context.Context, *ForceUnloadTaskQueuePartitionRequest) (*ForceUnloadTaskQueuePartitionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForceUnloadTaskQueuePartition not implemented")
 }
+func (UnimplementedAdminServiceServer) ReArchiveWorkflowExecutions(context.Context, *ReArchiveWorkflowExecutionsRequest) (*ReArchiveWorkflowExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReArchiveWorkflowExecutions not implemented")
+}
+func (UnimplementedAdminServiceServer) DescribeArchivalStatus(context.Context, *DescribeArchivalStatusRequest) (*DescribeArchivalStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeArchivalStatus not implemented")
+}
+func (UnimplementedAdminServiceServer) DescribeReplicationLag(context.Context, *DescribeReplicationLagRequest) (*DescribeReplicationLagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeReplicationLag not implemented")
+}
+func (UnimplementedAdminServiceServer) StartSignalWithStartBatchOperation(context.Context, *StartSignalWithStartBatchOperationRequest) (*StartSignalWithStartBatchOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartSignalWithStartBatchOperation not implemented")
+}
+func (UnimplementedAdminServiceServer) CancelScheduleBackfill(context.Context, *CancelScheduleBackfillRequest) (*CancelScheduleBackfillResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduleBackfill not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateSchedulePolicies(context.Context, *UpdateSchedulePoliciesRequest) (*UpdateSchedulePoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSchedulePolicies not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateNexusEndpointOperationDefaults(context.Context, *UpdateNexusEndpointOperationDefaultsRequest) (*UpdateNexusEndpointOperationDefaultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNexusEndpointOperationDefaults not implemented")
+}
+func (UnimplementedAdminServiceServer) ListNexusEndpointHealth(context.Context, *ListNexusEndpointHealthRequest) (*ListNexusEndpointHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNexusEndpointHealth not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateNexusEndpointOutboundAuth(context.Context, *UpdateNexusEndpointOutboundAuthRequest) (*UpdateNexusEndpointOutboundAuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNexusEndpointOutboundAuth not implemented")
+}
+func (UnimplementedAdminServiceServer) ReplicateNexusEndpoints(context.Context, *ReplicateNexusEndpointsRequest) (*ReplicateNexusEndpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplicateNexusEndpoints not implemented")
+}
+func (UnimplementedAdminServiceServer) ListPendingNexusOperations(context.Context, *ListPendingNexusOperationsRequest) (*ListPendingNexusOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingNexusOperations not implemented")
+}
+func (UnimplementedAdminServiceServer) SignalWorkflowExecutionsByQuery(context.Context, *SignalWorkflowExecutionsByQueryRequest) (*SignalWorkflowExecutionsByQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalWorkflowExecutionsByQuery not implemented")
+}
+func (UnimplementedAdminServiceServer) MultiSignalWorkflowExecution(context.Context, *MultiSignalWorkflowExecutionRequest) (*MultiSignalWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiSignalWorkflowExecution not implemented")
+}
+func (UnimplementedAdminServiceServer) PauseActivityType(context.Context, *PauseActivityTypeRequest) (*PauseActivityTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseActivityType not implemented")
+}
+func (UnimplementedAdminServiceServer) UnpauseActivityType(context.Context, *UnpauseActivityTypeRequest) (*UnpauseActivityTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseActivityType not implemented")
+}
+func (UnimplementedAdminServiceServer) CaptureProfile(context.Context, *CaptureProfileRequest) (*CaptureProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CaptureProfile not implemented")
+}
+func (UnimplementedAdminServiceServer) DescribeShardOwnership(context.Context, *DescribeShardOwnershipRequest) (*DescribeShardOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeShardOwnership not implemented")
+}
+func (UnimplementedAdminServiceServer) ListHotspots(context.Context, *ListHotspotsRequest) (*ListHotspotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHotspots not implemented")
+}
+func (UnimplementedAdminServiceServer) GetDebugTrace(context.Context, *GetDebugTraceRequest) (*GetDebugTraceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDebugTrace not implemented")
+}
+func (UnimplementedAdminServiceServer) GetDynamicConfig(context.Context, *GetDynamicConfigRequest) (*GetDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDynamicConfig not implemented")
+}
+func (UnimplementedAdminServiceServer) SetDynamicConfig(context.Context, *SetDynamicConfigRequest) (*SetDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDynamicConfig not implemented")
+}
+func (UnimplementedAdminServiceServer) ExportNamespaceConfig(context.Context, *ExportNamespaceConfigRequest) (*ExportNamespaceConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportNamespaceConfig not implemented")
+}
+func (UnimplementedAdminServiceServer) ImportNamespaceConfig(context.Context, *ImportNamespaceConfigRequest) (*ImportNamespaceConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportNamespaceConfig not implemented")
+}
+func (UnimplementedAdminServiceServer) ExportWorkflowExecutions(context.Context, *ExportWorkflowExecutionsRequest) (*ExportWorkflowExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportWorkflowExecutions not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateClusterMetadata(context.Context, *UpdateClusterMetadataRequest) (*UpdateClusterMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClusterMetadata not implemented")
+}
+func (UnimplementedAdminServiceServer) MoveShards(context.Context, *MoveShardsRequest) (*MoveShardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveShards not implemented")
+}
+func (UnimplementedAdminServiceServer) StartRebuildMutableStateBatchOperation(context.Context, *StartRebuildMutableStateBatchOperationRequest) (*StartRebuildMutableStateBatchOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartRebuildMutableStateBatchOperation not implemented")
+}
+func (UnimplementedAdminServiceServer) StartRefreshWorkflowTasksBatchOperation(context.Context, *StartRefreshWorkflowTasksBatchOperationRequest) (*StartRefreshWorkflowTasksBatchOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartRefreshWorkflowTasksBatchOperation not implemented")
+}
 func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 
 // UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1613,66 +2173,570 @@ func _AdminService_ForceUnloadTaskQueuePartition_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
-// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var AdminService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "temporal.server.api.adminservice.v1.AdminService",
-	HandlerType: (*AdminServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RebuildMutableState",
-			Handler:    _AdminService_RebuildMutableState_Handler,
-		},
-		{
-			MethodName: "ImportWorkflowExecution",
-			Handler:    _AdminService_ImportWorkflowExecution_Handler,
-		},
-		{
-			MethodName: "DescribeMutableState",
-			Handler:    _AdminService_DescribeMutableState_Handler,
-		},
-		{
-			MethodName: "DescribeHistoryHost",
-			Handler:    _AdminService_DescribeHistoryHost_Handler,
-		},
-		{
-			MethodName: "GetShard",
-			Handler:    _AdminService_GetShard_Handler,
-		},
-		{
-			MethodName: "CloseShard",
-			Handler:    _AdminService_CloseShard_Handler,
-		},
-		{
-			MethodName: "ListHistoryTasks",
-			Handler:    _AdminService_ListHistoryTasks_Handler,
-		},
-		{
-			MethodName: "RemoveTask",
-			Handler:    _AdminService_RemoveTask_Handler,
-		},
-		{
-			MethodName: "GetWorkflowExecutionRawHistoryV2",
-			Handler:    _AdminService_GetWorkflowExecutionRawHistoryV2_Handler,
-		},
-		{
-			MethodName: "GetWorkflowExecutionRawHistory",
-			Handler:    _AdminService_GetWorkflowExecutionRawHistory_Handler,
-		},
-		{
-			MethodName: "GetReplicationMessages",
-			Handler:    _AdminService_GetReplicationMessages_Handler,
-		},
-		{
-			MethodName: "GetNamespaceReplicationMessages",
-			Handler:    _AdminService_GetNamespaceReplicationMessages_Handler,
-		},
-		{
-			MethodName: "GetDLQReplicationMessages",
-			Handler:    _AdminService_GetDLQReplicationMessages_Handler,
-		},
-		{
+func _AdminService_ReArchiveWorkflowExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReArchiveWorkflowExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReArchiveWorkflowExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ReArchiveWorkflowExecutions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReArchiveWorkflowExecutions(ctx, req.(*ReArchiveWorkflowExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeArchivalStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeArchivalStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeArchivalStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeArchivalStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeArchivalStatus(ctx, req.(*DescribeArchivalStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeReplicationLag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeReplicationLagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeReplicationLag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeReplicationLag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeReplicationLag(ctx, req.(*DescribeReplicationLagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_StartSignalWithStartBatchOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartSignalWithStartBatchOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).StartSignalWithStartBatchOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_StartSignalWithStartBatchOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).StartSignalWithStartBatchOperation(ctx, req.(*StartSignalWithStartBatchOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CancelScheduleBackfill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduleBackfillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CancelScheduleBackfill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CancelScheduleBackfill_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CancelScheduleBackfill(ctx, req.(*CancelScheduleBackfillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateSchedulePolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSchedulePoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateSchedulePolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateSchedulePolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateSchedulePolicies(ctx, req.(*UpdateSchedulePoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateNexusEndpointOperationDefaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNexusEndpointOperationDefaultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateNexusEndpointOperationDefaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateNexusEndpointOperationDefaults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateNexusEndpointOperationDefaults(ctx, req.(*UpdateNexusEndpointOperationDefaultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListNexusEndpointHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNexusEndpointHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListNexusEndpointHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListNexusEndpointHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListNexusEndpointHealth(ctx, req.(*ListNexusEndpointHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateNexusEndpointOutboundAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNexusEndpointOutboundAuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateNexusEndpointOutboundAuth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateNexusEndpointOutboundAuth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateNexusEndpointOutboundAuth(ctx, req.(*UpdateNexusEndpointOutboundAuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ReplicateNexusEndpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplicateNexusEndpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReplicateNexusEndpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ReplicateNexusEndpoints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReplicateNexusEndpoints(ctx, req.(*ReplicateNexusEndpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListPendingNexusOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingNexusOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListPendingNexusOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListPendingNexusOperations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListPendingNexusOperations(ctx, req.(*ListPendingNexusOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SignalWorkflowExecutionsByQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignalWorkflowExecutionsByQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SignalWorkflowExecutionsByQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SignalWorkflowExecutionsByQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SignalWorkflowExecutionsByQuery(ctx, req.(*SignalWorkflowExecutionsByQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_MultiSignalWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiSignalWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).MultiSignalWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_MultiSignalWorkflowExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).MultiSignalWorkflowExecution(ctx, req.(*MultiSignalWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PauseActivityType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseActivityTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PauseActivityType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_PauseActivityType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PauseActivityType(ctx, req.(*PauseActivityTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UnpauseActivityType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpauseActivityTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UnpauseActivityType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UnpauseActivityType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UnpauseActivityType(ctx, req.(*UnpauseActivityTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CaptureProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CaptureProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CaptureProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CaptureProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CaptureProfile(ctx, req.(*CaptureProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeShardOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeShardOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeShardOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeShardOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeShardOwnership(ctx, req.(*DescribeShardOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListHotspots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHotspotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListHotspots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListHotspots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListHotspots(ctx, req.(*ListHotspotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetDebugTrace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDebugTraceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetDebugTrace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetDebugTrace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetDebugTrace(ctx, req.(*GetDebugTraceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetDynamicConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetDynamicConfig(ctx, req.(*GetDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetDynamicConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetDynamicConfig(ctx, req.(*SetDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ExportNamespaceConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportNamespaceConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ExportNamespaceConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ExportNamespaceConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ExportNamespaceConfig(ctx, req.(*ExportNamespaceConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ImportNamespaceConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportNamespaceConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ImportNamespaceConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ImportNamespaceConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ImportNamespaceConfig(ctx, req.(*ImportNamespaceConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ExportWorkflowExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportWorkflowExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ExportWorkflowExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ExportWorkflowExecutions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ExportWorkflowExecutions(ctx, req.(*ExportWorkflowExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateClusterMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateClusterMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateClusterMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateClusterMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateClusterMetadata(ctx, req.(*UpdateClusterMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_MoveShards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveShardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).MoveShards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_MoveShards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).MoveShards(ctx, req.(*MoveShardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_StartRebuildMutableStateBatchOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartRebuildMutableStateBatchOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).StartRebuildMutableStateBatchOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_StartRebuildMutableStateBatchOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).StartRebuildMutableStateBatchOperation(ctx, req.(*StartRebuildMutableStateBatchOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_StartRefreshWorkflowTasksBatchOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartRefreshWorkflowTasksBatchOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).StartRefreshWorkflowTasksBatchOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_StartRefreshWorkflowTasksBatchOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).StartRefreshWorkflowTasksBatchOperation(ctx, req.(*StartRefreshWorkflowTasksBatchOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "temporal.server.api.adminservice.v1.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RebuildMutableState",
+			Handler:    _AdminService_RebuildMutableState_Handler,
+		},
+		{
+			MethodName: "ImportWorkflowExecution",
+			Handler:    _AdminService_ImportWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "DescribeMutableState",
+			Handler:    _AdminService_DescribeMutableState_Handler,
+		},
+		{
+			MethodName: "DescribeHistoryHost",
+			Handler:    _AdminService_DescribeHistoryHost_Handler,
+		},
+		{
+			MethodName: "GetShard",
+			Handler:    _AdminService_GetShard_Handler,
+		},
+		{
+			MethodName: "CloseShard",
+			Handler:    _AdminService_CloseShard_Handler,
+		},
+		{
+			MethodName: "ListHistoryTasks",
+			Handler:    _AdminService_ListHistoryTasks_Handler,
+		},
+		{
+			MethodName: "RemoveTask",
+			Handler:    _AdminService_RemoveTask_Handler,
+		},
+		{
+			MethodName: "GetWorkflowExecutionRawHistoryV2",
+			Handler:    _AdminService_GetWorkflowExecutionRawHistoryV2_Handler,
+		},
+		{
+			MethodName: "GetWorkflowExecutionRawHistory",
+			Handler:    _AdminService_GetWorkflowExecutionRawHistory_Handler,
+		},
+		{
+			MethodName: "GetReplicationMessages",
+			Handler:    _AdminService_GetReplicationMessages_Handler,
+		},
+		{
+			MethodName: "GetNamespaceReplicationMessages",
+			Handler:    _AdminService_GetNamespaceReplicationMessages_Handler,
+		},
+		{
+			MethodName: "GetDLQReplicationMessages",
+			Handler:    _AdminService_GetDLQReplicationMessages_Handler,
+		},
+		{
 			MethodName: "ReapplyEvents",
 			Handler:    _AdminService_ReapplyEvents_Handler,
 		},
@@ -1788,6 +2852,118 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ForceUnloadTaskQueuePartition",
 			Handler:    _AdminService_ForceUnloadTaskQueuePartition_Handler,
 		},
+		{
+			MethodName: "ReArchiveWorkflowExecutions",
+			Handler:    _AdminService_ReArchiveWorkflowExecutions_Handler,
+		},
+		{
+			MethodName: "DescribeArchivalStatus",
+			Handler:    _AdminService_DescribeArchivalStatus_Handler,
+		},
+		{
+			MethodName: "DescribeReplicationLag",
+			Handler:    _AdminService_DescribeReplicationLag_Handler,
+		},
+		{
+			MethodName: "StartSignalWithStartBatchOperation",
+			Handler:    _AdminService_StartSignalWithStartBatchOperation_Handler,
+		},
+		{
+			MethodName: "CancelScheduleBackfill",
+			Handler:    _AdminService_CancelScheduleBackfill_Handler,
+		},
+		{
+			MethodName: "UpdateSchedulePolicies",
+			Handler:    _AdminService_UpdateSchedulePolicies_Handler,
+		},
+		{
+			MethodName: "UpdateNexusEndpointOperationDefaults",
+			Handler:    _AdminService_UpdateNexusEndpointOperationDefaults_Handler,
+		},
+		{
+			MethodName: "ListNexusEndpointHealth",
+			Handler:    _AdminService_ListNexusEndpointHealth_Handler,
+		},
+		{
+			MethodName: "UpdateNexusEndpointOutboundAuth",
+			Handler:    _AdminService_UpdateNexusEndpointOutboundAuth_Handler,
+		},
+		{
+			MethodName: "ReplicateNexusEndpoints",
+			Handler:    _AdminService_ReplicateNexusEndpoints_Handler,
+		},
+		{
+			MethodName: "ListPendingNexusOperations",
+			Handler:    _AdminService_ListPendingNexusOperations_Handler,
+		},
+		{
+			MethodName: "SignalWorkflowExecutionsByQuery",
+			Handler:    _AdminService_SignalWorkflowExecutionsByQuery_Handler,
+		},
+		{
+			MethodName: "MultiSignalWorkflowExecution",
+			Handler:    _AdminService_MultiSignalWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "PauseActivityType",
+			Handler:    _AdminService_PauseActivityType_Handler,
+		},
+		{
+			MethodName: "UnpauseActivityType",
+			Handler:    _AdminService_UnpauseActivityType_Handler,
+		},
+		{
+			MethodName: "CaptureProfile",
+			Handler:    _AdminService_CaptureProfile_Handler,
+		},
+		{
+			MethodName: "DescribeShardOwnership",
+			Handler:    _AdminService_DescribeShardOwnership_Handler,
+		},
+		{
+			MethodName: "ListHotspots",
+			Handler:    _AdminService_ListHotspots_Handler,
+		},
+		{
+			MethodName: "GetDebugTrace",
+			Handler:    _AdminService_GetDebugTrace_Handler,
+		},
+		{
+			MethodName: "GetDynamicConfig",
+			Handler:    _AdminService_GetDynamicConfig_Handler,
+		},
+		{
+			MethodName: "SetDynamicConfig",
+			Handler:    _AdminService_SetDynamicConfig_Handler,
+		},
+		{
+			MethodName: "ExportNamespaceConfig",
+			Handler:    _AdminService_ExportNamespaceConfig_Handler,
+		},
+		{
+			MethodName: "ImportNamespaceConfig",
+			Handler:    _AdminService_ImportNamespaceConfig_Handler,
+		},
+		{
+			MethodName: "ExportWorkflowExecutions",
+			Handler:    _AdminService_ExportWorkflowExecutions_Handler,
+		},
+		{
+			MethodName: "UpdateClusterMetadata",
+			Handler:    _AdminService_UpdateClusterMetadata_Handler,
+		},
+		{
+			MethodName: "MoveShards",
+			Handler:    _AdminService_MoveShards_Handler,
+		},
+		{
+			MethodName: "StartRebuildMutableStateBatchOperation",
+			Handler:    _AdminService_StartRebuildMutableStateBatchOperation_Handler,
+		},
+		{
+			MethodName: "StartRefreshWorkflowTasksBatchOperation",
+			Handler:    _AdminService_StartRefreshWorkflowTasksBatchOperation_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -146,6 +146,46 @@ func (mr *MockAdminServiceClientMockRecorder) CancelDLQJob(ctx, in any, opts ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelDLQJob", reflect.TypeOf((*MockAdminServiceClient)(nil).CancelDLQJob), varargs...)
 }
 
+// CancelScheduleBackfill mocks base method.
+func (m *MockAdminServiceClient) CancelScheduleBackfill(ctx context.Context, in *adminservice.CancelScheduleBackfillRequest, opts ...grpc.CallOption) (*adminservice.CancelScheduleBackfillResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CancelScheduleBackfill", varargs...)
+	ret0, _ := ret[0].(*adminservice.CancelScheduleBackfillResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelScheduleBackfill indicates an expected call of CancelScheduleBackfill.
+func (mr *MockAdminServiceClientMockRecorder) CancelScheduleBackfill(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelScheduleBackfill", reflect.TypeOf((*MockAdminServiceClient)(nil).CancelScheduleBackfill), varargs...)
+}
+
+// CaptureProfile mocks base method.
+func (m *MockAdminServiceClient) CaptureProfile(ctx context.Context, in *adminservice.CaptureProfileRequest, opts ...grpc.CallOption) (*adminservice.CaptureProfileResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CaptureProfile", varargs...)
+	ret0, _ := ret[0].(*adminservice.CaptureProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CaptureProfile indicates an expected call of CaptureProfile.
+func (mr *MockAdminServiceClientMockRecorder) CaptureProfile(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CaptureProfile", reflect.TypeOf((*MockAdminServiceClient)(nil).CaptureProfile), varargs...)
+}
+
 // CloseShard mocks base method.
 func (m *MockAdminServiceClient) CloseShard(ctx context.Context, in *adminservice.CloseShardRequest, opts ...grpc.CallOption) (*adminservice.CloseShardResponse, error) {
 	m.ctrl.T.Helper()
@@ -206,6 +246,26 @@ func (mr *MockAdminServiceClientMockRecorder) DeleteWorkflowExecution(ctx, in an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).DeleteWorkflowExecution), varargs...)
 }
 
+// DescribeArchivalStatus mocks base method.
+func (m *MockAdminServiceClient) DescribeArchivalStatus(ctx context.Context, in *adminservice.DescribeArchivalStatusRequest, opts ...grpc.CallOption) (*adminservice.DescribeArchivalStatusResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeArchivalStatus", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeArchivalStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeArchivalStatus indicates an expected call of DescribeArchivalStatus.
+func (mr *MockAdminServiceClientMockRecorder) DescribeArchivalStatus(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeArchivalStatus", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeArchivalStatus), varargs...)
+}
+
 // DescribeCluster mocks base method.
 func (m *MockAdminServiceClient) DescribeCluster(ctx context.Context, in *adminservice.DescribeClusterRequest, opts ...grpc.CallOption) (*adminservice.DescribeClusterResponse, error) {
 	m.ctrl.T.Helper()
@@ -286,6 +346,46 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeMutableState(ctx, in any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeMutableState), varargs...)
 }
 
+// DescribeReplicationLag mocks base method.
+func (m *MockAdminServiceClient) DescribeReplicationLag(ctx context.Context, in *adminservice.DescribeReplicationLagRequest, opts ...grpc.CallOption) (*adminservice.DescribeReplicationLagResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeReplicationLag", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeReplicationLagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeReplicationLag indicates an expected call of DescribeReplicationLag.
+func (mr *MockAdminServiceClientMockRecorder) DescribeReplicationLag(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeReplicationLag", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeReplicationLag), varargs...)
+}
+
+// DescribeShardOwnership mocks base method.
+func (m *MockAdminServiceClient) DescribeShardOwnership(ctx context.Context, in *adminservice.DescribeShardOwnershipRequest, opts ...grpc.CallOption) (*adminservice.DescribeShardOwnershipResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeShardOwnership", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeShardOwnershipResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeShardOwnership indicates an expected call of DescribeShardOwnership.
+func (mr *MockAdminServiceClientMockRecorder) DescribeShardOwnership(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeShardOwnership", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeShardOwnership), varargs...)
+}
+
 // DescribeTaskQueuePartition mocks base method.
 func (m *MockAdminServiceClient) DescribeTaskQueuePartition(ctx context.Context, in *adminservice.DescribeTaskQueuePartitionRequest, opts ...grpc.CallOption) (*adminservice.DescribeTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -306,6 +406,46 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeTaskQueuePartition(ctx, in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueuePartition", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeTaskQueuePartition), varargs...)
 }
 
+// ExportNamespaceConfig mocks base method.
+func (m *MockAdminServiceClient) ExportNamespaceConfig(ctx context.Context, in *adminservice.ExportNamespaceConfigRequest, opts ...grpc.CallOption) (*adminservice.ExportNamespaceConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExportNamespaceConfig", varargs...)
+	ret0, _ := ret[0].(*adminservice.ExportNamespaceConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportNamespaceConfig indicates an expected call of ExportNamespaceConfig.
+func (mr *MockAdminServiceClientMockRecorder) ExportNamespaceConfig(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportNamespaceConfig", reflect.TypeOf((*MockAdminServiceClient)(nil).ExportNamespaceConfig), varargs...)
+}
+
+// ExportWorkflowExecutions mocks base method.
+func (m *MockAdminServiceClient) ExportWorkflowExecutions(ctx context.Context, in *adminservice.ExportWorkflowExecutionsRequest, opts ...grpc.CallOption) (*adminservice.ExportWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExportWorkflowExecutions", varargs...)
+	ret0, _ := ret[0].(*adminservice.ExportWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportWorkflowExecutions indicates an expected call of ExportWorkflowExecutions.
+func (mr *MockAdminServiceClientMockRecorder) ExportWorkflowExecutions(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportWorkflowExecutions", reflect.TypeOf((*MockAdminServiceClient)(nil).ExportWorkflowExecutions), varargs...)
+}
+
 // ForceUnloadTaskQueuePartition mocks base method.
 func (m *MockAdminServiceClient) ForceUnloadTaskQueuePartition(ctx context.Context, in *adminservice.ForceUnloadTaskQueuePartitionRequest, opts ...grpc.CallOption) (*adminservice.ForceUnloadTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -406,6 +546,46 @@ func (mr *MockAdminServiceClientMockRecorder) GetDLQTasks(ctx, in any, opts ...a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).GetDLQTasks), varargs...)
 }
 
+// GetDebugTrace mocks base method.
+func (m *MockAdminServiceClient) GetDebugTrace(ctx context.Context, in *adminservice.GetDebugTraceRequest, opts ...grpc.CallOption) (*adminservice.GetDebugTraceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDebugTrace", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetDebugTraceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDebugTrace indicates an expected call of GetDebugTrace.
+func (mr *MockAdminServiceClientMockRecorder) GetDebugTrace(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDebugTrace", reflect.TypeOf((*MockAdminServiceClient)(nil).GetDebugTrace), varargs...)
+}
+
+// GetDynamicConfig mocks base method.
+func (m *MockAdminServiceClient) GetDynamicConfig(ctx context.Context, in *adminservice.GetDynamicConfigRequest, opts ...grpc.CallOption) (*adminservice.GetDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDynamicConfig", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDynamicConfig indicates an expected call of GetDynamicConfig.
+func (mr *MockAdminServiceClientMockRecorder) GetDynamicConfig(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicConfig", reflect.TypeOf((*MockAdminServiceClient)(nil).GetDynamicConfig), varargs...)
+}
+
 // GetNamespace mocks base method.
 func (m *MockAdminServiceClient) GetNamespace(ctx context.Context, in *adminservice.GetNamespaceRequest, opts ...grpc.CallOption) (*adminservice.GetNamespaceResponse, error) {
 	m.ctrl.T.Helper()
@@ -566,6 +746,26 @@ func (mr *MockAdminServiceClientMockRecorder) GetWorkflowExecutionRawHistoryV2(c
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionRawHistoryV2", reflect.TypeOf((*MockAdminServiceClient)(nil).GetWorkflowExecutionRawHistoryV2), varargs...)
 }
 
+// ImportNamespaceConfig mocks base method.
+func (m *MockAdminServiceClient) ImportNamespaceConfig(ctx context.Context, in *adminservice.ImportNamespaceConfigRequest, opts ...grpc.CallOption) (*adminservice.ImportNamespaceConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ImportNamespaceConfig", varargs...)
+	ret0, _ := ret[0].(*adminservice.ImportNamespaceConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportNamespaceConfig indicates an expected call of ImportNamespaceConfig.
+func (mr *MockAdminServiceClientMockRecorder) ImportNamespaceConfig(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportNamespaceConfig", reflect.TypeOf((*MockAdminServiceClient)(nil).ImportNamespaceConfig), varargs...)
+}
+
 // ImportWorkflowExecution mocks base method.
 func (m *MockAdminServiceClient) ImportWorkflowExecution(ctx context.Context, in *adminservice.ImportWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.ImportWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -646,6 +846,66 @@ func (mr *MockAdminServiceClientMockRecorder) ListHistoryTasks(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistoryTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ListHistoryTasks), varargs...)
 }
 
+// ListHotspots mocks base method.
+func (m *MockAdminServiceClient) ListHotspots(ctx context.Context, in *adminservice.ListHotspotsRequest, opts ...grpc.CallOption) (*adminservice.ListHotspotsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListHotspots", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListHotspotsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHotspots indicates an expected call of ListHotspots.
+func (mr *MockAdminServiceClientMockRecorder) ListHotspots(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHotspots", reflect.TypeOf((*MockAdminServiceClient)(nil).ListHotspots), varargs...)
+}
+
+// ListNexusEndpointHealth mocks base method.
+func (m *MockAdminServiceClient) ListNexusEndpointHealth(ctx context.Context, in *adminservice.ListNexusEndpointHealthRequest, opts ...grpc.CallOption) (*adminservice.ListNexusEndpointHealthResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNexusEndpointHealth", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListNexusEndpointHealthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNexusEndpointHealth indicates an expected call of ListNexusEndpointHealth.
+func (mr *MockAdminServiceClientMockRecorder) ListNexusEndpointHealth(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNexusEndpointHealth", reflect.TypeOf((*MockAdminServiceClient)(nil).ListNexusEndpointHealth), varargs...)
+}
+
+// ListPendingNexusOperations mocks base method.
+func (m *MockAdminServiceClient) ListPendingNexusOperations(ctx context.Context, in *adminservice.ListPendingNexusOperationsRequest, opts ...grpc.CallOption) (*adminservice.ListPendingNexusOperationsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPendingNexusOperations", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListPendingNexusOperationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingNexusOperations indicates an expected call of ListPendingNexusOperations.
+func (mr *MockAdminServiceClientMockRecorder) ListPendingNexusOperations(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingNexusOperations", reflect.TypeOf((*MockAdminServiceClient)(nil).ListPendingNexusOperations), varargs...)
+}
+
 // ListQueues mocks base method.
 func (m *MockAdminServiceClient) ListQueues(ctx context.Context, in *adminservice.ListQueuesRequest, opts ...grpc.CallOption) (*adminservice.ListQueuesResponse, error) {
 	m.ctrl.T.Helper()
@@ -706,6 +966,66 @@ func (mr *MockAdminServiceClientMockRecorder) MergeDLQTasks(ctx, in any, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).MergeDLQTasks), varargs...)
 }
 
+// MoveShards mocks base method.
+func (m *MockAdminServiceClient) MoveShards(ctx context.Context, in *adminservice.MoveShardsRequest, opts ...grpc.CallOption) (*adminservice.MoveShardsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MoveShards", varargs...)
+	ret0, _ := ret[0].(*adminservice.MoveShardsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveShards indicates an expected call of MoveShards.
+func (mr *MockAdminServiceClientMockRecorder) MoveShards(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveShards", reflect.TypeOf((*MockAdminServiceClient)(nil).MoveShards), varargs...)
+}
+
+// MultiSignalWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) MultiSignalWorkflowExecution(ctx context.Context, in *adminservice.MultiSignalWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.MultiSignalWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MultiSignalWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.MultiSignalWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MultiSignalWorkflowExecution indicates an expected call of MultiSignalWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) MultiSignalWorkflowExecution(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MultiSignalWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).MultiSignalWorkflowExecution), varargs...)
+}
+
+// PauseActivityType mocks base method.
+func (m *MockAdminServiceClient) PauseActivityType(ctx context.Context, in *adminservice.PauseActivityTypeRequest, opts ...grpc.CallOption) (*adminservice.PauseActivityTypeResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PauseActivityType", varargs...)
+	ret0, _ := ret[0].(*adminservice.PauseActivityTypeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseActivityType indicates an expected call of PauseActivityType.
+func (mr *MockAdminServiceClientMockRecorder) PauseActivityType(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseActivityType", reflect.TypeOf((*MockAdminServiceClient)(nil).PauseActivityType), varargs...)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminServiceClient) PurgeDLQMessages(ctx context.Context, in *adminservice.PurgeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -746,6 +1066,26 @@ func (mr *MockAdminServiceClientMockRecorder) PurgeDLQTasks(ctx, in any, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDLQTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).PurgeDLQTasks), varargs...)
 }
 
+// ReArchiveWorkflowExecutions mocks base method.
+func (m *MockAdminServiceClient) ReArchiveWorkflowExecutions(ctx context.Context, in *adminservice.ReArchiveWorkflowExecutionsRequest, opts ...grpc.CallOption) (*adminservice.ReArchiveWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReArchiveWorkflowExecutions", varargs...)
+	ret0, _ := ret[0].(*adminservice.ReArchiveWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReArchiveWorkflowExecutions indicates an expected call of ReArchiveWorkflowExecutions.
+func (mr *MockAdminServiceClientMockRecorder) ReArchiveWorkflowExecutions(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReArchiveWorkflowExecutions", reflect.TypeOf((*MockAdminServiceClient)(nil).ReArchiveWorkflowExecutions), varargs...)
+}
+
 // ReapplyEvents mocks base method.
 func (m *MockAdminServiceClient) ReapplyEvents(ctx context.Context, in *adminservice.ReapplyEventsRequest, opts ...grpc.CallOption) (*adminservice.ReapplyEventsResponse, error) {
 	m.ctrl.T.Helper()
@@ -846,84 +1186,304 @@ func (mr *MockAdminServiceClientMockRecorder) RemoveSearchAttributes(ctx, in any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSearchAttributes", reflect.TypeOf((*MockAdminServiceClient)(nil).RemoveSearchAttributes), varargs...)
 }
 
-// RemoveTask mocks base method.
-func (m *MockAdminServiceClient) RemoveTask(ctx context.Context, in *adminservice.RemoveTaskRequest, opts ...grpc.CallOption) (*adminservice.RemoveTaskResponse, error) {
+// RemoveTask mocks base method.
+func (m *MockAdminServiceClient) RemoveTask(ctx context.Context, in *adminservice.RemoveTaskRequest, opts ...grpc.CallOption) (*adminservice.RemoveTaskResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveTask", varargs...)
+	ret0, _ := ret[0].(*adminservice.RemoveTaskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveTask indicates an expected call of RemoveTask.
+func (mr *MockAdminServiceClientMockRecorder) RemoveTask(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTask", reflect.TypeOf((*MockAdminServiceClient)(nil).RemoveTask), varargs...)
+}
+
+// ReplicateNexusEndpoints mocks base method.
+func (m *MockAdminServiceClient) ReplicateNexusEndpoints(ctx context.Context, in *adminservice.ReplicateNexusEndpointsRequest, opts ...grpc.CallOption) (*adminservice.ReplicateNexusEndpointsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReplicateNexusEndpoints", varargs...)
+	ret0, _ := ret[0].(*adminservice.ReplicateNexusEndpointsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplicateNexusEndpoints indicates an expected call of ReplicateNexusEndpoints.
+func (mr *MockAdminServiceClientMockRecorder) ReplicateNexusEndpoints(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateNexusEndpoints", reflect.TypeOf((*MockAdminServiceClient)(nil).ReplicateNexusEndpoints), varargs...)
+}
+
+// ResendReplicationTasks mocks base method.
+func (m *MockAdminServiceClient) ResendReplicationTasks(ctx context.Context, in *adminservice.ResendReplicationTasksRequest, opts ...grpc.CallOption) (*adminservice.ResendReplicationTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResendReplicationTasks", varargs...)
+	ret0, _ := ret[0].(*adminservice.ResendReplicationTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResendReplicationTasks indicates an expected call of ResendReplicationTasks.
+func (mr *MockAdminServiceClientMockRecorder) ResendReplicationTasks(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ResendReplicationTasks), varargs...)
+}
+
+// SetDynamicConfig mocks base method.
+func (m *MockAdminServiceClient) SetDynamicConfig(ctx context.Context, in *adminservice.SetDynamicConfigRequest, opts ...grpc.CallOption) (*adminservice.SetDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetDynamicConfig", varargs...)
+	ret0, _ := ret[0].(*adminservice.SetDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDynamicConfig indicates an expected call of SetDynamicConfig.
+func (mr *MockAdminServiceClientMockRecorder) SetDynamicConfig(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDynamicConfig", reflect.TypeOf((*MockAdminServiceClient)(nil).SetDynamicConfig), varargs...)
+}
+
+// SignalWorkflowExecutionsByQuery mocks base method.
+func (m *MockAdminServiceClient) SignalWorkflowExecutionsByQuery(ctx context.Context, in *adminservice.SignalWorkflowExecutionsByQueryRequest, opts ...grpc.CallOption) (*adminservice.SignalWorkflowExecutionsByQueryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SignalWorkflowExecutionsByQuery", varargs...)
+	ret0, _ := ret[0].(*adminservice.SignalWorkflowExecutionsByQueryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignalWorkflowExecutionsByQuery indicates an expected call of SignalWorkflowExecutionsByQuery.
+func (mr *MockAdminServiceClientMockRecorder) SignalWorkflowExecutionsByQuery(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignalWorkflowExecutionsByQuery", reflect.TypeOf((*MockAdminServiceClient)(nil).SignalWorkflowExecutionsByQuery), varargs...)
+}
+
+// StartRebuildMutableStateBatchOperation mocks base method.
+func (m *MockAdminServiceClient) StartRebuildMutableStateBatchOperation(ctx context.Context, in *adminservice.StartRebuildMutableStateBatchOperationRequest, opts ...grpc.CallOption) (*adminservice.StartRebuildMutableStateBatchOperationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartRebuildMutableStateBatchOperation", varargs...)
+	ret0, _ := ret[0].(*adminservice.StartRebuildMutableStateBatchOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartRebuildMutableStateBatchOperation indicates an expected call of StartRebuildMutableStateBatchOperation.
+func (mr *MockAdminServiceClientMockRecorder) StartRebuildMutableStateBatchOperation(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartRebuildMutableStateBatchOperation", reflect.TypeOf((*MockAdminServiceClient)(nil).StartRebuildMutableStateBatchOperation), varargs...)
+}
+
+// StartRefreshWorkflowTasksBatchOperation mocks base method.
+func (m *MockAdminServiceClient) StartRefreshWorkflowTasksBatchOperation(ctx context.Context, in *adminservice.StartRefreshWorkflowTasksBatchOperationRequest, opts ...grpc.CallOption) (*adminservice.StartRefreshWorkflowTasksBatchOperationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartRefreshWorkflowTasksBatchOperation", varargs...)
+	ret0, _ := ret[0].(*adminservice.StartRefreshWorkflowTasksBatchOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartRefreshWorkflowTasksBatchOperation indicates an expected call of StartRefreshWorkflowTasksBatchOperation.
+func (mr *MockAdminServiceClientMockRecorder) StartRefreshWorkflowTasksBatchOperation(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartRefreshWorkflowTasksBatchOperation", reflect.TypeOf((*MockAdminServiceClient)(nil).StartRefreshWorkflowTasksBatchOperation), varargs...)
+}
+
+// StartSignalWithStartBatchOperation mocks base method.
+func (m *MockAdminServiceClient) StartSignalWithStartBatchOperation(ctx context.Context, in *adminservice.StartSignalWithStartBatchOperationRequest, opts ...grpc.CallOption) (*adminservice.StartSignalWithStartBatchOperationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartSignalWithStartBatchOperation", varargs...)
+	ret0, _ := ret[0].(*adminservice.StartSignalWithStartBatchOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartSignalWithStartBatchOperation indicates an expected call of StartSignalWithStartBatchOperation.
+func (mr *MockAdminServiceClientMockRecorder) StartSignalWithStartBatchOperation(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSignalWithStartBatchOperation", reflect.TypeOf((*MockAdminServiceClient)(nil).StartSignalWithStartBatchOperation), varargs...)
+}
+
+// StreamWorkflowReplicationMessages mocks base method.
+func (m *MockAdminServiceClient) StreamWorkflowReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (adminservice.AdminService_StreamWorkflowReplicationMessagesClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamWorkflowReplicationMessages", varargs...)
+	ret0, _ := ret[0].(adminservice.AdminService_StreamWorkflowReplicationMessagesClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamWorkflowReplicationMessages indicates an expected call of StreamWorkflowReplicationMessages.
+func (mr *MockAdminServiceClientMockRecorder) StreamWorkflowReplicationMessages(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamWorkflowReplicationMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).StreamWorkflowReplicationMessages), varargs...)
+}
+
+// SyncWorkflowState mocks base method.
+func (m *MockAdminServiceClient) SyncWorkflowState(ctx context.Context, in *adminservice.SyncWorkflowStateRequest, opts ...grpc.CallOption) (*adminservice.SyncWorkflowStateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SyncWorkflowState", varargs...)
+	ret0, _ := ret[0].(*adminservice.SyncWorkflowStateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncWorkflowState indicates an expected call of SyncWorkflowState.
+func (mr *MockAdminServiceClientMockRecorder) SyncWorkflowState(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncWorkflowState", reflect.TypeOf((*MockAdminServiceClient)(nil).SyncWorkflowState), varargs...)
+}
+
+// UnpauseActivityType mocks base method.
+func (m *MockAdminServiceClient) UnpauseActivityType(ctx context.Context, in *adminservice.UnpauseActivityTypeRequest, opts ...grpc.CallOption) (*adminservice.UnpauseActivityTypeResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnpauseActivityType", varargs...)
+	ret0, _ := ret[0].(*adminservice.UnpauseActivityTypeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseActivityType indicates an expected call of UnpauseActivityType.
+func (mr *MockAdminServiceClientMockRecorder) UnpauseActivityType(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseActivityType", reflect.TypeOf((*MockAdminServiceClient)(nil).UnpauseActivityType), varargs...)
+}
+
+// UpdateClusterMetadata mocks base method.
+func (m *MockAdminServiceClient) UpdateClusterMetadata(ctx context.Context, in *adminservice.UpdateClusterMetadataRequest, opts ...grpc.CallOption) (*adminservice.UpdateClusterMetadataResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "RemoveTask", varargs...)
-	ret0, _ := ret[0].(*adminservice.RemoveTaskResponse)
+	ret := m.ctrl.Call(m, "UpdateClusterMetadata", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateClusterMetadataResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RemoveTask indicates an expected call of RemoveTask.
-func (mr *MockAdminServiceClientMockRecorder) RemoveTask(ctx, in any, opts ...any) *gomock.Call {
+// UpdateClusterMetadata indicates an expected call of UpdateClusterMetadata.
+func (mr *MockAdminServiceClientMockRecorder) UpdateClusterMetadata(ctx, in any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTask", reflect.TypeOf((*MockAdminServiceClient)(nil).RemoveTask), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterMetadata", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateClusterMetadata), varargs...)
 }
 
-// ResendReplicationTasks mocks base method.
-func (m *MockAdminServiceClient) ResendReplicationTasks(ctx context.Context, in *adminservice.ResendReplicationTasksRequest, opts ...grpc.CallOption) (*adminservice.ResendReplicationTasksResponse, error) {
+// UpdateNexusEndpointOperationDefaults mocks base method.
+func (m *MockAdminServiceClient) UpdateNexusEndpointOperationDefaults(ctx context.Context, in *adminservice.UpdateNexusEndpointOperationDefaultsRequest, opts ...grpc.CallOption) (*adminservice.UpdateNexusEndpointOperationDefaultsResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ResendReplicationTasks", varargs...)
-	ret0, _ := ret[0].(*adminservice.ResendReplicationTasksResponse)
+	ret := m.ctrl.Call(m, "UpdateNexusEndpointOperationDefaults", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateNexusEndpointOperationDefaultsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ResendReplicationTasks indicates an expected call of ResendReplicationTasks.
-func (mr *MockAdminServiceClientMockRecorder) ResendReplicationTasks(ctx, in any, opts ...any) *gomock.Call {
+// UpdateNexusEndpointOperationDefaults indicates an expected call of UpdateNexusEndpointOperationDefaults.
+func (mr *MockAdminServiceClientMockRecorder) UpdateNexusEndpointOperationDefaults(ctx, in any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ResendReplicationTasks), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNexusEndpointOperationDefaults", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateNexusEndpointOperationDefaults), varargs...)
 }
 
-// StreamWorkflowReplicationMessages mocks base method.
-func (m *MockAdminServiceClient) StreamWorkflowReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (adminservice.AdminService_StreamWorkflowReplicationMessagesClient, error) {
+// UpdateNexusEndpointOutboundAuth mocks base method.
+func (m *MockAdminServiceClient) UpdateNexusEndpointOutboundAuth(ctx context.Context, in *adminservice.UpdateNexusEndpointOutboundAuthRequest, opts ...grpc.CallOption) (*adminservice.UpdateNexusEndpointOutboundAuthResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
+	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "StreamWorkflowReplicationMessages", varargs...)
-	ret0, _ := ret[0].(adminservice.AdminService_StreamWorkflowReplicationMessagesClient)
+	ret := m.ctrl.Call(m, "UpdateNexusEndpointOutboundAuth", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateNexusEndpointOutboundAuthResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// StreamWorkflowReplicationMessages indicates an expected call of StreamWorkflowReplicationMessages.
-func (mr *MockAdminServiceClientMockRecorder) StreamWorkflowReplicationMessages(ctx any, opts ...any) *gomock.Call {
+// UpdateNexusEndpointOutboundAuth indicates an expected call of UpdateNexusEndpointOutboundAuth.
+func (mr *MockAdminServiceClientMockRecorder) UpdateNexusEndpointOutboundAuth(ctx, in any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamWorkflowReplicationMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).StreamWorkflowReplicationMessages), varargs...)
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNexusEndpointOutboundAuth", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateNexusEndpointOutboundAuth), varargs...)
 }
 
-// SyncWorkflowState mocks base method.
-func (m *MockAdminServiceClient) SyncWorkflowState(ctx context.Context, in *adminservice.SyncWorkflowStateRequest, opts ...grpc.CallOption) (*adminservice.SyncWorkflowStateResponse, error) {
+// UpdateSchedulePolicies mocks base method.
+func (m *MockAdminServiceClient) UpdateSchedulePolicies(ctx context.Context, in *adminservice.UpdateSchedulePoliciesRequest, opts ...grpc.CallOption) (*adminservice.UpdateSchedulePoliciesResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "SyncWorkflowState", varargs...)
-	ret0, _ := ret[0].(*adminservice.SyncWorkflowStateResponse)
+	ret := m.ctrl.Call(m, "UpdateSchedulePolicies", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateSchedulePoliciesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SyncWorkflowState indicates an expected call of SyncWorkflowState.
-func (mr *MockAdminServiceClientMockRecorder) SyncWorkflowState(ctx, in any, opts ...any) *gomock.Call {
+// UpdateSchedulePolicies indicates an expected call of UpdateSchedulePolicies.
+func (mr *MockAdminServiceClientMockRecorder) UpdateSchedulePolicies(ctx, in any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncWorkflowState", reflect.TypeOf((*MockAdminServiceClient)(nil).SyncWorkflowState), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSchedulePolicies", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateSchedulePolicies), varargs...)
 }
 
 // MockAdminService_StreamWorkflowReplicationMessagesClient is a mock of AdminService_StreamWorkflowReplicationMessagesClient interface.
@@ -1146,6 +1706,36 @@ func (mr *MockAdminServiceServerMockRecorder) CancelDLQJob(arg0, arg1 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelDLQJob", reflect.TypeOf((*MockAdminServiceServer)(nil).CancelDLQJob), arg0, arg1)
 }
 
+// CancelScheduleBackfill mocks base method.
+func (m *MockAdminServiceServer) CancelScheduleBackfill(arg0 context.Context, arg1 *adminservice.CancelScheduleBackfillRequest) (*adminservice.CancelScheduleBackfillResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelScheduleBackfill", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.CancelScheduleBackfillResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelScheduleBackfill indicates an expected call of CancelScheduleBackfill.
+func (mr *MockAdminServiceServerMockRecorder) CancelScheduleBackfill(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelScheduleBackfill", reflect.TypeOf((*MockAdminServiceServer)(nil).CancelScheduleBackfill), arg0, arg1)
+}
+
+// CaptureProfile mocks base method.
+func (m *MockAdminServiceServer) CaptureProfile(arg0 context.Context, arg1 *adminservice.CaptureProfileRequest) (*adminservice.CaptureProfileResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CaptureProfile", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.CaptureProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CaptureProfile indicates an expected call of CaptureProfile.
+func (mr *MockAdminServiceServerMockRecorder) CaptureProfile(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CaptureProfile", reflect.TypeOf((*MockAdminServiceServer)(nil).CaptureProfile), arg0, arg1)
+}
+
 // CloseShard mocks base method.
 func (m *MockAdminServiceServer) CloseShard(arg0 context.Context, arg1 *adminservice.CloseShardRequest) (*adminservice.CloseShardResponse, error) {
 	m.ctrl.T.Helper()
@@ -1191,6 +1781,21 @@ func (mr *MockAdminServiceServerMockRecorder) DeleteWorkflowExecution(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).DeleteWorkflowExecution), arg0, arg1)
 }
 
+// DescribeArchivalStatus mocks base method.
+func (m *MockAdminServiceServer) DescribeArchivalStatus(arg0 context.Context, arg1 *adminservice.DescribeArchivalStatusRequest) (*adminservice.DescribeArchivalStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeArchivalStatus", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeArchivalStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeArchivalStatus indicates an expected call of DescribeArchivalStatus.
+func (mr *MockAdminServiceServerMockRecorder) DescribeArchivalStatus(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeArchivalStatus", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeArchivalStatus), arg0, arg1)
+}
+
 // DescribeCluster mocks base method.
 func (m *MockAdminServiceServer) DescribeCluster(arg0 context.Context, arg1 *adminservice.DescribeClusterRequest) (*adminservice.DescribeClusterResponse, error) {
 	m.ctrl.T.Helper()
@@ -1251,6 +1856,36 @@ func (mr *MockAdminServiceServerMockRecorder) DescribeMutableState(arg0, arg1 an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeMutableState), arg0, arg1)
 }
 
+// DescribeReplicationLag mocks base method.
+func (m *MockAdminServiceServer) DescribeReplicationLag(arg0 context.Context, arg1 *adminservice.DescribeReplicationLagRequest) (*adminservice.DescribeReplicationLagResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeReplicationLag", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeReplicationLagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeReplicationLag indicates an expected call of DescribeReplicationLag.
+func (mr *MockAdminServiceServerMockRecorder) DescribeReplicationLag(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeReplicationLag", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeReplicationLag), arg0, arg1)
+}
+
+// DescribeShardOwnership mocks base method.
+func (m *MockAdminServiceServer) DescribeShardOwnership(arg0 context.Context, arg1 *adminservice.DescribeShardOwnershipRequest) (*adminservice.DescribeShardOwnershipResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeShardOwnership", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeShardOwnershipResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeShardOwnership indicates an expected call of DescribeShardOwnership.
+func (mr *MockAdminServiceServerMockRecorder) DescribeShardOwnership(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeShardOwnership", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeShardOwnership), arg0, arg1)
+}
+
 // DescribeTaskQueuePartition mocks base method.
 func (m *MockAdminServiceServer) DescribeTaskQueuePartition(arg0 context.Context, arg1 *adminservice.DescribeTaskQueuePartitionRequest) (*adminservice.DescribeTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1266,6 +1901,36 @@ func (mr *MockAdminServiceServerMockRecorder) DescribeTaskQueuePartition(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueuePartition", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeTaskQueuePartition), arg0, arg1)
 }
 
+// ExportNamespaceConfig mocks base method.
+func (m *MockAdminServiceServer) ExportNamespaceConfig(arg0 context.Context, arg1 *adminservice.ExportNamespaceConfigRequest) (*adminservice.ExportNamespaceConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportNamespaceConfig", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ExportNamespaceConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportNamespaceConfig indicates an expected call of ExportNamespaceConfig.
+func (mr *MockAdminServiceServerMockRecorder) ExportNamespaceConfig(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportNamespaceConfig", reflect.TypeOf((*MockAdminServiceServer)(nil).ExportNamespaceConfig), arg0, arg1)
+}
+
+// ExportWorkflowExecutions mocks base method.
+func (m *MockAdminServiceServer) ExportWorkflowExecutions(arg0 context.Context, arg1 *adminservice.ExportWorkflowExecutionsRequest) (*adminservice.ExportWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportWorkflowExecutions", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ExportWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportWorkflowExecutions indicates an expected call of ExportWorkflowExecutions.
+func (mr *MockAdminServiceServerMockRecorder) ExportWorkflowExecutions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportWorkflowExecutions", reflect.TypeOf((*MockAdminServiceServer)(nil).ExportWorkflowExecutions), arg0, arg1)
+}
+
 // ForceUnloadTaskQueuePartition mocks base method.
 func (m *MockAdminServiceServer) ForceUnloadTaskQueuePartition(arg0 context.Context, arg1 *adminservice.ForceUnloadTaskQueuePartitionRequest) (*adminservice.ForceUnloadTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1341,6 +2006,36 @@ func (mr *MockAdminServiceServerMockRecorder) GetDLQTasks(arg0, arg1 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).GetDLQTasks), arg0, arg1)
 }
 
+// GetDebugTrace mocks base method.
+func (m *MockAdminServiceServer) GetDebugTrace(arg0 context.Context, arg1 *adminservice.GetDebugTraceRequest) (*adminservice.GetDebugTraceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDebugTrace", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetDebugTraceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDebugTrace indicates an expected call of GetDebugTrace.
+func (mr *MockAdminServiceServerMockRecorder) GetDebugTrace(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDebugTrace", reflect.TypeOf((*MockAdminServiceServer)(nil).GetDebugTrace), arg0, arg1)
+}
+
+// GetDynamicConfig mocks base method.
+func (m *MockAdminServiceServer) GetDynamicConfig(arg0 context.Context, arg1 *adminservice.GetDynamicConfigRequest) (*adminservice.GetDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDynamicConfig indicates an expected call of GetDynamicConfig.
+func (mr *MockAdminServiceServerMockRecorder) GetDynamicConfig(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicConfig", reflect.TypeOf((*MockAdminServiceServer)(nil).GetDynamicConfig), arg0, arg1)
+}
+
 // GetNamespace mocks base method.
 func (m *MockAdminServiceServer) GetNamespace(arg0 context.Context, arg1 *adminservice.GetNamespaceRequest) (*adminservice.GetNamespaceResponse, error) {
 	m.ctrl.T.Helper()
@@ -1461,6 +2156,21 @@ func (mr *MockAdminServiceServerMockRecorder) GetWorkflowExecutionRawHistoryV2(a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionRawHistoryV2", reflect.TypeOf((*MockAdminServiceServer)(nil).GetWorkflowExecutionRawHistoryV2), arg0, arg1)
 }
 
+// ImportNamespaceConfig mocks base method.
+func (m *MockAdminServiceServer) ImportNamespaceConfig(arg0 context.Context, arg1 *adminservice.ImportNamespaceConfigRequest) (*adminservice.ImportNamespaceConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportNamespaceConfig", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ImportNamespaceConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportNamespaceConfig indicates an expected call of ImportNamespaceConfig.
+func (mr *MockAdminServiceServerMockRecorder) ImportNamespaceConfig(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportNamespaceConfig", reflect.TypeOf((*MockAdminServiceServer)(nil).ImportNamespaceConfig), arg0, arg1)
+}
+
 // ImportWorkflowExecution mocks base method.
 func (m *MockAdminServiceServer) ImportWorkflowExecution(arg0 context.Context, arg1 *adminservice.ImportWorkflowExecutionRequest) (*adminservice.ImportWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1521,6 +2231,51 @@ func (mr *MockAdminServiceServerMockRecorder) ListHistoryTasks(arg0, arg1 any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistoryTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ListHistoryTasks), arg0, arg1)
 }
 
+// ListHotspots mocks base method.
+func (m *MockAdminServiceServer) ListHotspots(arg0 context.Context, arg1 *adminservice.ListHotspotsRequest) (*adminservice.ListHotspotsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHotspots", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListHotspotsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHotspots indicates an expected call of ListHotspots.
+func (mr *MockAdminServiceServerMockRecorder) ListHotspots(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHotspots", reflect.TypeOf((*MockAdminServiceServer)(nil).ListHotspots), arg0, arg1)
+}
+
+// ListNexusEndpointHealth mocks base method.
+func (m *MockAdminServiceServer) ListNexusEndpointHealth(arg0 context.Context, arg1 *adminservice.ListNexusEndpointHealthRequest) (*adminservice.ListNexusEndpointHealthResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNexusEndpointHealth", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListNexusEndpointHealthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNexusEndpointHealth indicates an expected call of ListNexusEndpointHealth.
+func (mr *MockAdminServiceServerMockRecorder) ListNexusEndpointHealth(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNexusEndpointHealth", reflect.TypeOf((*MockAdminServiceServer)(nil).ListNexusEndpointHealth), arg0, arg1)
+}
+
+// ListPendingNexusOperations mocks base method.
+func (m *MockAdminServiceServer) ListPendingNexusOperations(arg0 context.Context, arg1 *adminservice.ListPendingNexusOperationsRequest) (*adminservice.ListPendingNexusOperationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingNexusOperations", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListPendingNexusOperationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingNexusOperations indicates an expected call of ListPendingNexusOperations.
+func (mr *MockAdminServiceServerMockRecorder) ListPendingNexusOperations(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingNexusOperations", reflect.TypeOf((*MockAdminServiceServer)(nil).ListPendingNexusOperations), arg0, arg1)
+}
+
 // ListQueues mocks base method.
 func (m *MockAdminServiceServer) ListQueues(arg0 context.Context, arg1 *adminservice.ListQueuesRequest) (*adminservice.ListQueuesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1566,6 +2321,51 @@ func (mr *MockAdminServiceServerMockRecorder) MergeDLQTasks(arg0, arg1 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).MergeDLQTasks), arg0, arg1)
 }
 
+// MoveShards mocks base method.
+func (m *MockAdminServiceServer) MoveShards(arg0 context.Context, arg1 *adminservice.MoveShardsRequest) (*adminservice.MoveShardsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveShards", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.MoveShardsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveShards indicates an expected call of MoveShards.
+func (mr *MockAdminServiceServerMockRecorder) MoveShards(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveShards", reflect.TypeOf((*MockAdminServiceServer)(nil).MoveShards), arg0, arg1)
+}
+
+// MultiSignalWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) MultiSignalWorkflowExecution(arg0 context.Context, arg1 *adminservice.MultiSignalWorkflowExecutionRequest) (*adminservice.MultiSignalWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MultiSignalWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.MultiSignalWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MultiSignalWorkflowExecution indicates an expected call of MultiSignalWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) MultiSignalWorkflowExecution(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MultiSignalWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).MultiSignalWorkflowExecution), arg0, arg1)
+}
+
+// PauseActivityType mocks base method.
+func (m *MockAdminServiceServer) PauseActivityType(arg0 context.Context, arg1 *adminservice.PauseActivityTypeRequest) (*adminservice.PauseActivityTypeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseActivityType", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.PauseActivityTypeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseActivityType indicates an expected call of PauseActivityType.
+func (mr *MockAdminServiceServerMockRecorder) PauseActivityType(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseActivityType", reflect.TypeOf((*MockAdminServiceServer)(nil).PauseActivityType), arg0, arg1)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminServiceServer) PurgeDLQMessages(arg0 context.Context, arg1 *adminservice.PurgeDLQMessagesRequest) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1596,6 +2396,21 @@ func (mr *MockAdminServiceServerMockRecorder) PurgeDLQTasks(arg0, arg1 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDLQTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).PurgeDLQTasks), arg0, arg1)
 }
 
+// ReArchiveWorkflowExecutions mocks base method.
+func (m *MockAdminServiceServer) ReArchiveWorkflowExecutions(arg0 context.Context, arg1 *adminservice.ReArchiveWorkflowExecutionsRequest) (*adminservice.ReArchiveWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReArchiveWorkflowExecutions", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ReArchiveWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReArchiveWorkflowExecutions indicates an expected call of ReArchiveWorkflowExecutions.
+func (mr *MockAdminServiceServerMockRecorder) ReArchiveWorkflowExecutions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReArchiveWorkflowExecutions", reflect.TypeOf((*MockAdminServiceServer)(nil).ReArchiveWorkflowExecutions), arg0, arg1)
+}
+
 // ReapplyEvents mocks base method.
 func (m *MockAdminServiceServer) ReapplyEvents(arg0 context.Context, arg1 *adminservice.ReapplyEventsRequest) (*adminservice.ReapplyEventsResponse, error) {
 	m.ctrl.T.Helper()
@@ -1686,6 +2501,21 @@ func (mr *MockAdminServiceServerMockRecorder) RemoveTask(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTask", reflect.TypeOf((*MockAdminServiceServer)(nil).RemoveTask), arg0, arg1)
 }
 
+// ReplicateNexusEndpoints mocks base method.
+func (m *MockAdminServiceServer) ReplicateNexusEndpoints(arg0 context.Context, arg1 *adminservice.ReplicateNexusEndpointsRequest) (*adminservice.ReplicateNexusEndpointsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateNexusEndpoints", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ReplicateNexusEndpointsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplicateNexusEndpoints indicates an expected call of ReplicateNexusEndpoints.
+func (mr *MockAdminServiceServerMockRecorder) ReplicateNexusEndpoints(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateNexusEndpoints", reflect.TypeOf((*MockAdminServiceServer)(nil).ReplicateNexusEndpoints), arg0, arg1)
+}
+
 // ResendReplicationTasks mocks base method.
 func (m *MockAdminServiceServer) ResendReplicationTasks(arg0 context.Context, arg1 *adminservice.ResendReplicationTasksRequest) (*adminservice.ResendReplicationTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -1701,6 +2531,81 @@ func (mr *MockAdminServiceServerMockRecorder) ResendReplicationTasks(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ResendReplicationTasks), arg0, arg1)
 }
 
+// SetDynamicConfig mocks base method.
+func (m *MockAdminServiceServer) SetDynamicConfig(arg0 context.Context, arg1 *adminservice.SetDynamicConfigRequest) (*adminservice.SetDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.SetDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDynamicConfig indicates an expected call of SetDynamicConfig.
+func (mr *MockAdminServiceServerMockRecorder) SetDynamicConfig(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDynamicConfig", reflect.TypeOf((*MockAdminServiceServer)(nil).SetDynamicConfig), arg0, arg1)
+}
+
+// SignalWorkflowExecutionsByQuery mocks base method.
+func (m *MockAdminServiceServer) SignalWorkflowExecutionsByQuery(arg0 context.Context, arg1 *adminservice.SignalWorkflowExecutionsByQueryRequest) (*adminservice.SignalWorkflowExecutionsByQueryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignalWorkflowExecutionsByQuery", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.SignalWorkflowExecutionsByQueryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignalWorkflowExecutionsByQuery indicates an expected call of SignalWorkflowExecutionsByQuery.
+func (mr *MockAdminServiceServerMockRecorder) SignalWorkflowExecutionsByQuery(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignalWorkflowExecutionsByQuery", reflect.TypeOf((*MockAdminServiceServer)(nil).SignalWorkflowExecutionsByQuery), arg0, arg1)
+}
+
+// StartRebuildMutableStateBatchOperation mocks base method.
+func (m *MockAdminServiceServer) StartRebuildMutableStateBatchOperation(arg0 context.Context, arg1 *adminservice.StartRebuildMutableStateBatchOperationRequest) (*adminservice.StartRebuildMutableStateBatchOperationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartRebuildMutableStateBatchOperation", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.StartRebuildMutableStateBatchOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartRebuildMutableStateBatchOperation indicates an expected call of StartRebuildMutableStateBatchOperation.
+func (mr *MockAdminServiceServerMockRecorder) StartRebuildMutableStateBatchOperation(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartRebuildMutableStateBatchOperation", reflect.TypeOf((*MockAdminServiceServer)(nil).StartRebuildMutableStateBatchOperation), arg0, arg1)
+}
+
+// StartRefreshWorkflowTasksBatchOperation mocks base method.
+func (m *MockAdminServiceServer) StartRefreshWorkflowTasksBatchOperation(arg0 context.Context, arg1 *adminservice.StartRefreshWorkflowTasksBatchOperationRequest) (*adminservice.StartRefreshWorkflowTasksBatchOperationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartRefreshWorkflowTasksBatchOperation", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.StartRefreshWorkflowTasksBatchOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartRefreshWorkflowTasksBatchOperation indicates an expected call of StartRefreshWorkflowTasksBatchOperation.
+func (mr *MockAdminServiceServerMockRecorder) StartRefreshWorkflowTasksBatchOperation(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartRefreshWorkflowTasksBatchOperation", reflect.TypeOf((*MockAdminServiceServer)(nil).StartRefreshWorkflowTasksBatchOperation), arg0, arg1)
+}
+
+// StartSignalWithStartBatchOperation mocks base method.
+func (m *MockAdminServiceServer) StartSignalWithStartBatchOperation(arg0 context.Context, arg1 *adminservice.StartSignalWithStartBatchOperationRequest) (*adminservice.StartSignalWithStartBatchOperationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartSignalWithStartBatchOperation", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.StartSignalWithStartBatchOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartSignalWithStartBatchOperation indicates an expected call of StartSignalWithStartBatchOperation.
+func (mr *MockAdminServiceServerMockRecorder) StartSignalWithStartBatchOperation(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSignalWithStartBatchOperation", reflect.TypeOf((*MockAdminServiceServer)(nil).StartSignalWithStartBatchOperation), arg0, arg1)
+}
+
 // StreamWorkflowReplicationMessages mocks base method.
 func (m *MockAdminServiceServer) StreamWorkflowReplicationMessages(arg0 adminservice.AdminService_StreamWorkflowReplicationMessagesServer) error {
 	m.ctrl.T.Helper()
@@ -1730,6 +2635,81 @@ func (mr *MockAdminServiceServerMockRecorder) SyncWorkflowState(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncWorkflowState", reflect.TypeOf((*MockAdminServiceServer)(nil).SyncWorkflowState), arg0, arg1)
 }
 
+// UnpauseActivityType mocks base method.
+func (m *MockAdminServiceServer) UnpauseActivityType(arg0 context.Context, arg1 *adminservice.UnpauseActivityTypeRequest) (*adminservice.UnpauseActivityTypeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseActivityType", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UnpauseActivityTypeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseActivityType indicates an expected call of UnpauseActivityType.
+func (mr *MockAdminServiceServerMockRecorder) UnpauseActivityType(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseActivityType", reflect.TypeOf((*MockAdminServiceServer)(nil).UnpauseActivityType), arg0, arg1)
+}
+
+// UpdateClusterMetadata mocks base method.
+func (m *MockAdminServiceServer) UpdateClusterMetadata(arg0 context.Context, arg1 *adminservice.UpdateClusterMetadataRequest) (*adminservice.UpdateClusterMetadataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClusterMetadata", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateClusterMetadataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateClusterMetadata indicates an expected call of UpdateClusterMetadata.
+func (mr *MockAdminServiceServerMockRecorder) UpdateClusterMetadata(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterMetadata", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateClusterMetadata), arg0, arg1)
+}
+
+// UpdateNexusEndpointOperationDefaults mocks base method.
+func (m *MockAdminServiceServer) UpdateNexusEndpointOperationDefaults(arg0 context.Context, arg1 *adminservice.UpdateNexusEndpointOperationDefaultsRequest) (*adminservice.UpdateNexusEndpointOperationDefaultsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNexusEndpointOperationDefaults", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateNexusEndpointOperationDefaultsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNexusEndpointOperationDefaults indicates an expected call of UpdateNexusEndpointOperationDefaults.
+func (mr *MockAdminServiceServerMockRecorder) UpdateNexusEndpointOperationDefaults(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNexusEndpointOperationDefaults", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateNexusEndpointOperationDefaults), arg0, arg1)
+}
+
+// UpdateNexusEndpointOutboundAuth mocks base method.
+func (m *MockAdminServiceServer) UpdateNexusEndpointOutboundAuth(arg0 context.Context, arg1 *adminservice.UpdateNexusEndpointOutboundAuthRequest) (*adminservice.UpdateNexusEndpointOutboundAuthResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNexusEndpointOutboundAuth", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateNexusEndpointOutboundAuthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNexusEndpointOutboundAuth indicates an expected call of UpdateNexusEndpointOutboundAuth.
+func (mr *MockAdminServiceServerMockRecorder) UpdateNexusEndpointOutboundAuth(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNexusEndpointOutboundAuth", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateNexusEndpointOutboundAuth), arg0, arg1)
+}
+
+// UpdateSchedulePolicies mocks base method.
+func (m *MockAdminServiceServer) UpdateSchedulePolicies(arg0 context.Context, arg1 *adminservice.UpdateSchedulePoliciesRequest) (*adminservice.UpdateSchedulePoliciesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSchedulePolicies", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateSchedulePoliciesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSchedulePolicies indicates an expected call of UpdateSchedulePolicies.
+func (mr *MockAdminServiceServerMockRecorder) UpdateSchedulePolicies(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSchedulePolicies", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateSchedulePolicies), arg0, arg1)
+}
+
 // mustEmbedUnimplementedAdminServiceServer mocks base method.
 func (m *MockAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {
 	m.ctrl.T.Helper()
@@ -250,3 +250,77 @@ func (this *AddSearchAttributesResponse) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type NamespaceSnapshotHeader to the protobuf v3 wire format
+func (val *NamespaceSnapshotHeader) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type NamespaceSnapshotHeader from the protobuf v3 wire format
+func (val *NamespaceSnapshotHeader) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *NamespaceSnapshotHeader) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two NamespaceSnapshotHeader values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *NamespaceSnapshotHeader) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *NamespaceSnapshotHeader
+	switch t := that.(type) {
+	case *NamespaceSnapshotHeader:
+		that1 = t
+	case NamespaceSnapshotHeader:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type WorkflowExecutionSnapshot to the protobuf v3 wire format
+func (val *WorkflowExecutionSnapshot) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type WorkflowExecutionSnapshot from the protobuf v3 wire format
+func (val *WorkflowExecutionSnapshot) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *WorkflowExecutionSnapshot) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two WorkflowExecutionSnapshot values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *WorkflowExecutionSnapshot) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *WorkflowExecutionSnapshot
+	switch t := that.(type) {
+	case *WorkflowExecutionSnapshot:
+		that1 = t
+	case WorkflowExecutionSnapshot:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
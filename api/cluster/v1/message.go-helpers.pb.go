@@ -176,3 +176,77 @@ func (this *ClusterMember) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type ShardOwnership to the protobuf v3 wire format
+func (val *ShardOwnership) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ShardOwnership from the protobuf v3 wire format
+func (val *ShardOwnership) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ShardOwnership) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ShardOwnership values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ShardOwnership) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ShardOwnership
+	switch t := that.(type) {
+	case *ShardOwnership:
+		that1 = t
+	case ShardOwnership:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ShardOwnershipChange to the protobuf v3 wire format
+func (val *ShardOwnershipChange) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ShardOwnershipChange from the protobuf v3 wire format
+func (val *ShardOwnershipChange) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ShardOwnershipChange) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ShardOwnershipChange values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ShardOwnershipChange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ShardOwnershipChange
+	switch t := that.(type) {
+	case *ShardOwnershipChange:
+		that1 = t
+	case ShardOwnershipChange:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
@@ -68,3 +68,22 @@ func HealthStateFromString(s string) (HealthState, error) {
 	}
 	return HealthState(0), fmt.Errorf("%s is not a valid HealthState", s)
 }
+
+var (
+	ShardOwnershipChangeType_shorthandValue = map[string]int32{
+		"Unspecified": 0,
+		"Acquired":    1,
+		"Released":    2,
+	}
+)
+
+// ShardOwnershipChangeTypeFromString parses a ShardOwnershipChangeType value from  either the protojson
+// canonical SCREAMING_CASE enum or the traditional temporal PascalCase enum to ShardOwnershipChangeType
+func ShardOwnershipChangeTypeFromString(s string) (ShardOwnershipChangeType, error) {
+	if v, ok := ShardOwnershipChangeType_value[s]; ok {
+		return ShardOwnershipChangeType(v), nil
+	} else if v, ok := ShardOwnershipChangeType_shorthandValue[s]; ok {
+		return ShardOwnershipChangeType(v), nil
+	}
+	return ShardOwnershipChangeType(0), fmt.Errorf("%s is not a valid ShardOwnershipChangeType", s)
+}
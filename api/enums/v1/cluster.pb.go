@@ -172,6 +172,67 @@ func (HealthState) EnumDescriptor() ([]byte, []int) {
 	return file_temporal_server_api_enums_v1_cluster_proto_rawDescGZIP(), []int{1}
 }
 
+type ShardOwnershipChangeType int32
+
+const (
+	SHARD_OWNERSHIP_CHANGE_TYPE_UNSPECIFIED ShardOwnershipChangeType = 0
+	// The host started owning the shard.
+	SHARD_OWNERSHIP_CHANGE_TYPE_ACQUIRED ShardOwnershipChangeType = 1
+	// The host stopped owning the shard.
+	SHARD_OWNERSHIP_CHANGE_TYPE_RELEASED ShardOwnershipChangeType = 2
+)
+
+// Enum value maps for ShardOwnershipChangeType.
+var (
+	ShardOwnershipChangeType_name = map[int32]string{
+		0: "SHARD_OWNERSHIP_CHANGE_TYPE_UNSPECIFIED",
+		1: "SHARD_OWNERSHIP_CHANGE_TYPE_ACQUIRED",
+		2: "SHARD_OWNERSHIP_CHANGE_TYPE_RELEASED",
+	}
+	ShardOwnershipChangeType_value = map[string]int32{
+		"SHARD_OWNERSHIP_CHANGE_TYPE_UNSPECIFIED": 0,
+		"SHARD_OWNERSHIP_CHANGE_TYPE_ACQUIRED":    1,
+		"SHARD_OWNERSHIP_CHANGE_TYPE_RELEASED":    2,
+	}
+)
+
+func (x ShardOwnershipChangeType) Enum() *ShardOwnershipChangeType {
+	p := new(ShardOwnershipChangeType)
+	*p = x
+	return p
+}
+
+func (x ShardOwnershipChangeType) String() string {
+	switch x {
+	case SHARD_OWNERSHIP_CHANGE_TYPE_UNSPECIFIED:
+		return "Unspecified"
+	case SHARD_OWNERSHIP_CHANGE_TYPE_ACQUIRED:
+		return "Acquired"
+	case SHARD_OWNERSHIP_CHANGE_TYPE_RELEASED:
+		return "Released"
+	default:
+		return strconv.Itoa(int(x))
+	}
+
+}
+
+func (ShardOwnershipChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_temporal_server_api_enums_v1_cluster_proto_enumTypes[2].Descriptor()
+}
+
+func (ShardOwnershipChangeType) Type() protoreflect.EnumType {
+	return &file_temporal_server_api_enums_v1_cluster_proto_enumTypes[2]
+}
+
+func (x ShardOwnershipChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShardOwnershipChangeType.Descriptor instead.
+func (ShardOwnershipChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_temporal_server_api_enums_v1_cluster_proto_rawDescGZIP(), []int{2}
+}
+
 var File_temporal_server_api_enums_v1_cluster_proto protoreflect.FileDescriptor
 
 var file_temporal_server_api_enums_v1_cluster_proto_rawDesc = []byte{
@@ -197,11 +258,21 @@ var file_temporal_server_api_enums_v1_cluster_proto_rawDesc = []byte{
 	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x48, 0x45, 0x41, 0x4c, 0x54,
 	0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x4e, 0x47, 0x10,
 	0x01, 0x12, 0x1c, 0x0a, 0x18, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x42,
-	0x2a, 0x5a, 0x28, 0x67, 0x6f, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x69,
-	0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e, 0x75,
-	0x6d, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x2a,
+	0x9b, 0x01, 0x0a, 0x18, 0x53, 0x68, 0x61, 0x72, 0x64, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68,
+	0x69, 0x70, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x27,
+	0x53, 0x48, 0x41, 0x52, 0x44, 0x5f, 0x4f, 0x57, 0x4e, 0x45, 0x52, 0x53, 0x48, 0x49, 0x50, 0x5f,
+	0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x28, 0x0a, 0x24, 0x53, 0x48, 0x41,
+	0x52, 0x44, 0x5f, 0x4f, 0x57, 0x4e, 0x45, 0x52, 0x53, 0x48, 0x49, 0x50, 0x5f, 0x43, 0x48, 0x41,
+	0x4e, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x43, 0x51, 0x55, 0x49, 0x52, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x28, 0x0a, 0x24, 0x53, 0x48, 0x41, 0x52, 0x44, 0x5f, 0x4f, 0x57, 0x4e,
+	0x45, 0x52, 0x53, 0x48, 0x49, 0x50, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x44, 0x10, 0x02, 0x42, 0x2a, 0x5a,
+	0x28, 0x67, 0x6f, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x69, 0x6f, 0x2f,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73,
+	0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -216,10 +287,11 @@ func file_temporal_server_api_enums_v1_cluster_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_enums_v1_cluster_proto_rawDescData
 }
 
-var file_temporal_server_api_enums_v1_cluster_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_temporal_server_api_enums_v1_cluster_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
 var file_temporal_server_api_enums_v1_cluster_proto_goTypes = []interface{}{
-	(ClusterMemberRole)(0), // 0: temporal.server.api.enums.v1.ClusterMemberRole
-	(HealthState)(0),       // 1: temporal.server.api.enums.v1.HealthState
+	(ClusterMemberRole)(0),        // 0: temporal.server.api.enums.v1.ClusterMemberRole
+	(HealthState)(0),              // 1: temporal.server.api.enums.v1.HealthState
+	(ShardOwnershipChangeType)(0), // 2: temporal.server.api.enums.v1.ShardOwnershipChangeType
 }
 var file_temporal_server_api_enums_v1_cluster_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -239,7 +311,7 @@ func file_temporal_server_api_enums_v1_cluster_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_temporal_server_api_enums_v1_cluster_proto_rawDesc,
-			NumEnums:      2,
+			NumEnums:      3,
 			NumMessages:   0,
 			NumExtensions: 0,
 			NumServices:   0,
@@ -52,3 +52,23 @@ func NexusOperationStateFromString(s string) (NexusOperationState, error) {
 	}
 	return NexusOperationState(0), fmt.Errorf("%s is not a valid NexusOperationState", s)
 }
+
+var (
+	CircuitBreakerState_shorthandValue = map[string]int32{
+		"Unspecified": 0,
+		"Closed":      1,
+		"HalfOpen":    2,
+		"Open":        3,
+	}
+)
+
+// CircuitBreakerStateFromString parses a CircuitBreakerState value from  either the protojson
+// canonical SCREAMING_CASE enum or the traditional temporal PascalCase enum to CircuitBreakerState
+func CircuitBreakerStateFromString(s string) (CircuitBreakerState, error) {
+	if v, ok := CircuitBreakerState_value[s]; ok {
+		return CircuitBreakerState(v), nil
+	} else if v, ok := CircuitBreakerState_shorthandValue[s]; ok {
+		return CircuitBreakerState(v), nil
+	}
+	return CircuitBreakerState(0), fmt.Errorf("%s is not a valid CircuitBreakerState", s)
+}
@@ -139,6 +139,74 @@ func (NexusOperationState) EnumDescriptor() ([]byte, []int) {
 	return file_temporal_server_api_enums_v1_nexus_proto_rawDescGZIP(), []int{0}
 }
 
+// State of a circuit breaker protecting an outbound destination.
+type CircuitBreakerState int32
+
+const (
+	CIRCUIT_BREAKER_STATE_UNSPECIFIED CircuitBreakerState = 0
+	// Requests are allowed through.
+	CIRCUIT_BREAKER_STATE_CLOSED CircuitBreakerState = 1
+	// A limited number of requests are allowed through to probe whether the destination recovered.
+	CIRCUIT_BREAKER_STATE_HALF_OPEN CircuitBreakerState = 2
+	// Requests are rejected until the circuit breaker timeout expires.
+	CIRCUIT_BREAKER_STATE_OPEN CircuitBreakerState = 3
+)
+
+// Enum value maps for CircuitBreakerState.
+var (
+	CircuitBreakerState_name = map[int32]string{
+		0: "CIRCUIT_BREAKER_STATE_UNSPECIFIED",
+		1: "CIRCUIT_BREAKER_STATE_CLOSED",
+		2: "CIRCUIT_BREAKER_STATE_HALF_OPEN",
+		3: "CIRCUIT_BREAKER_STATE_OPEN",
+	}
+	CircuitBreakerState_value = map[string]int32{
+		"CIRCUIT_BREAKER_STATE_UNSPECIFIED": 0,
+		"CIRCUIT_BREAKER_STATE_CLOSED":      1,
+		"CIRCUIT_BREAKER_STATE_HALF_OPEN":   2,
+		"CIRCUIT_BREAKER_STATE_OPEN":        3,
+	}
+)
+
+func (x CircuitBreakerState) Enum() *CircuitBreakerState {
+	p := new(CircuitBreakerState)
+	*p = x
+	return p
+}
+
+func (x CircuitBreakerState) String() string {
+	switch x {
+	case CIRCUIT_BREAKER_STATE_UNSPECIFIED:
+		return "Unspecified"
+	case CIRCUIT_BREAKER_STATE_CLOSED:
+		return "Closed"
+	case CIRCUIT_BREAKER_STATE_HALF_OPEN:
+		return "HalfOpen"
+	case CIRCUIT_BREAKER_STATE_OPEN:
+		return "Open"
+	default:
+		return strconv.Itoa(int(x))
+	}
+
+}
+
+func (CircuitBreakerState) Descriptor() protoreflect.EnumDescriptor {
+	return file_temporal_server_api_enums_v1_nexus_proto_enumTypes[1].Descriptor()
+}
+
+func (CircuitBreakerState) Type() protoreflect.EnumType {
+	return &file_temporal_server_api_enums_v1_nexus_proto_enumTypes[1]
+}
+
+func (x CircuitBreakerState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CircuitBreakerState.Descriptor instead.
+func (CircuitBreakerState) EnumDescriptor() ([]byte, []int) {
+	return file_temporal_server_api_enums_v1_nexus_proto_rawDescGZIP(), []int{1}
+}
+
 var File_temporal_server_api_enums_v1_nexus_proto protoreflect.FileDescriptor
 
 var file_temporal_server_api_enums_v1_nexus_proto_rawDesc = []byte{
@@ -166,10 +234,20 @@ var file_temporal_server_api_enums_v1_nexus_proto_rawDesc = []byte{
 	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10,
 	0x06, 0x12, 0x23, 0x0a, 0x1f, 0x4e, 0x45, 0x58, 0x55, 0x53, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41,
 	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x44,
-	0x5f, 0x4f, 0x55, 0x54, 0x10, 0x07, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x6f, 0x2e, 0x74, 0x65, 0x6d,
-	0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x69, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6e, 0x75,
-	0x6d, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x5f, 0x4f, 0x55, 0x54, 0x10, 0x07, 0x2a, 0xa3, 0x01, 0x0a, 0x13, 0x43, 0x69, 0x72, 0x63, 0x75,
+	0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x25,
+	0x0a, 0x21, 0x43, 0x49, 0x52, 0x43, 0x55, 0x49, 0x54, 0x5f, 0x42, 0x52, 0x45, 0x41, 0x4b, 0x45,
+	0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x43, 0x49, 0x52, 0x43, 0x55, 0x49, 0x54,
+	0x5f, 0x42, 0x52, 0x45, 0x41, 0x4b, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43,
+	0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x43, 0x49, 0x52, 0x43, 0x55,
+	0x49, 0x54, 0x5f, 0x42, 0x52, 0x45, 0x41, 0x4b, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x48, 0x41, 0x4c, 0x46, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a,
+	0x43, 0x49, 0x52, 0x43, 0x55, 0x49, 0x54, 0x5f, 0x42, 0x52, 0x45, 0x41, 0x4b, 0x45, 0x52, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x03, 0x42, 0x2a, 0x5a, 0x28,
+	0x67, 0x6f, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x69, 0x6f, 0x2f, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2f,
+	0x76, 0x31, 0x3b, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -184,9 +262,10 @@ func file_temporal_server_api_enums_v1_nexus_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_enums_v1_nexus_proto_rawDescData
 }
 
-var file_temporal_server_api_enums_v1_nexus_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_temporal_server_api_enums_v1_nexus_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
 var file_temporal_server_api_enums_v1_nexus_proto_goTypes = []interface{}{
 	(NexusOperationState)(0), // 0: temporal.server.api.enums.v1.NexusOperationState
+	(CircuitBreakerState)(0), // 1: temporal.server.api.enums.v1.CircuitBreakerState
 }
 var file_temporal_server_api_enums_v1_nexus_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -206,7 +285,7 @@ func file_temporal_server_api_enums_v1_nexus_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_temporal_server_api_enums_v1_nexus_proto_rawDesc,
-			NumEnums:      1,
+			NumEnums:      2,
 			NumMessages:   0,
 			NumExtensions: 0,
 			NumServices:   0,
@@ -44,6 +44,8 @@ var (
 		"BackfillHistoryTask":           10,
 		"VerifyVersionedTransitionTask": 11,
 		"SyncVersionedTransitionTask":   12,
+		"NexusEndpoint":                 13,
+		"WriteIntent":                   14,
 	}
 )
 
@@ -0,0 +1,192 @@
+// The MIT License
+//
+// Copyright (c) 2021 Temporal Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// plugins:
+// 	protoc-gen-go
+// 	protoc
+// source: temporal/server/api/enums/v1/schedule.proto
+
+package enums
+
+import (
+	reflect "reflect"
+	"strconv"
+	sync "sync"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// How calendar specs treat local times affected by daylight saving transitions, i.e. times which don't exist
+// (skipped when clocks move forward) or exist twice (repeated when clocks move back).
+type ScheduleDaylightSavingPolicy int32
+
+const (
+	// Same as RUN_AT_BOTH, the behavior before the policy was introduced.
+	SCHEDULE_DAYLIGHT_SAVING_POLICY_UNSPECIFIED ScheduleDaylightSavingPolicy = 0
+	// Don't run at times which don't exist or exist twice.
+	SCHEDULE_DAYLIGHT_SAVING_POLICY_SKIP ScheduleDaylightSavingPolicy = 1
+	// Run once: times which exist twice run at their first occurrence, times which don't exist run at the end of the
+	// transition.
+	SCHEDULE_DAYLIGHT_SAVING_POLICY_RUN_ONCE ScheduleDaylightSavingPolicy = 2
+	// Times which exist twice run at both occurrences, times which don't exist are skipped.
+	SCHEDULE_DAYLIGHT_SAVING_POLICY_RUN_AT_BOTH ScheduleDaylightSavingPolicy = 3
+)
+
+// Enum value maps for ScheduleDaylightSavingPolicy.
+var (
+	ScheduleDaylightSavingPolicy_name = map[int32]string{
+		0: "SCHEDULE_DAYLIGHT_SAVING_POLICY_UNSPECIFIED",
+		1: "SCHEDULE_DAYLIGHT_SAVING_POLICY_SKIP",
+		2: "SCHEDULE_DAYLIGHT_SAVING_POLICY_RUN_ONCE",
+		3: "SCHEDULE_DAYLIGHT_SAVING_POLICY_RUN_AT_BOTH",
+	}
+	ScheduleDaylightSavingPolicy_value = map[string]int32{
+		"SCHEDULE_DAYLIGHT_SAVING_POLICY_UNSPECIFIED": 0,
+		"SCHEDULE_DAYLIGHT_SAVING_POLICY_SKIP":        1,
+		"SCHEDULE_DAYLIGHT_SAVING_POLICY_RUN_ONCE":    2,
+		"SCHEDULE_DAYLIGHT_SAVING_POLICY_RUN_AT_BOTH": 3,
+	}
+)
+
+func (x ScheduleDaylightSavingPolicy) Enum() *ScheduleDaylightSavingPolicy {
+	p := new(ScheduleDaylightSavingPolicy)
+	*p = x
+	return p
+}
+
+func (x ScheduleDaylightSavingPolicy) String() string {
+	switch x {
+	case SCHEDULE_DAYLIGHT_SAVING_POLICY_UNSPECIFIED:
+		return "Unspecified"
+	case SCHEDULE_DAYLIGHT_SAVING_POLICY_SKIP:
+		return "Skip"
+	case SCHEDULE_DAYLIGHT_SAVING_POLICY_RUN_ONCE:
+		return "RunOnce"
+	case SCHEDULE_DAYLIGHT_SAVING_POLICY_RUN_AT_BOTH:
+		return "RunAtBoth"
+	default:
+		return strconv.Itoa(int(x))
+	}
+
+}
+
+func (ScheduleDaylightSavingPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_temporal_server_api_enums_v1_schedule_proto_enumTypes[0].Descriptor()
+}
+
+func (ScheduleDaylightSavingPolicy) Type() protoreflect.EnumType {
+	return &file_temporal_server_api_enums_v1_schedule_proto_enumTypes[0]
+}
+
+func (x ScheduleDaylightSavingPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScheduleDaylightSavingPolicy.Descriptor instead.
+func (ScheduleDaylightSavingPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_temporal_server_api_enums_v1_schedule_proto_rawDescGZIP(), []int{0}
+}
+
+var File_temporal_server_api_enums_v1_schedule_proto protoreflect.FileDescriptor
+
+var file_temporal_server_api_enums_v1_schedule_proto_rawDesc = []byte{
+	0x0a, 0x2b, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1c, 0x74,
+	0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2a, 0xd8, 0x01, 0x0a, 0x1c,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x61, 0x79, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x53, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2f, 0x0a, 0x2b,
+	0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x44, 0x41, 0x59, 0x4c, 0x49, 0x47, 0x48,
+	0x54, 0x5f, 0x53, 0x41, 0x56, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x28, 0x0a,
+	0x24, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x44, 0x41, 0x59, 0x4c, 0x49, 0x47,
+	0x48, 0x54, 0x5f, 0x53, 0x41, 0x56, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59,
+	0x5f, 0x53, 0x4b, 0x49, 0x50, 0x10, 0x01, 0x12, 0x2c, 0x0a, 0x28, 0x53, 0x43, 0x48, 0x45, 0x44,
+	0x55, 0x4c, 0x45, 0x5f, 0x44, 0x41, 0x59, 0x4c, 0x49, 0x47, 0x48, 0x54, 0x5f, 0x53, 0x41, 0x56,
+	0x49, 0x4e, 0x47, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x4f,
+	0x4e, 0x43, 0x45, 0x10, 0x02, 0x12, 0x2f, 0x0a, 0x2b, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c,
+	0x45, 0x5f, 0x44, 0x41, 0x59, 0x4c, 0x49, 0x47, 0x48, 0x54, 0x5f, 0x53, 0x41, 0x56, 0x49, 0x4e,
+	0x47, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x41, 0x54, 0x5f,
+	0x42, 0x4f, 0x54, 0x48, 0x10, 0x03, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x6f, 0x2e, 0x74, 0x65, 0x6d,
+	0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x69, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6e, 0x75,
+	0x6d, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_temporal_server_api_enums_v1_schedule_proto_rawDescOnce sync.Once
+	file_temporal_server_api_enums_v1_schedule_proto_rawDescData = file_temporal_server_api_enums_v1_schedule_proto_rawDesc
+)
+
+func file_temporal_server_api_enums_v1_schedule_proto_rawDescGZIP() []byte {
+	file_temporal_server_api_enums_v1_schedule_proto_rawDescOnce.Do(func() {
+		file_temporal_server_api_enums_v1_schedule_proto_rawDescData = protoimpl.X.CompressGZIP(file_temporal_server_api_enums_v1_schedule_proto_rawDescData)
+	})
+	return file_temporal_server_api_enums_v1_schedule_proto_rawDescData
+}
+
+var file_temporal_server_api_enums_v1_schedule_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_temporal_server_api_enums_v1_schedule_proto_goTypes = []interface{}{
+	(ScheduleDaylightSavingPolicy)(0), // 0: temporal.server.api.enums.v1.ScheduleDaylightSavingPolicy
+}
+var file_temporal_server_api_enums_v1_schedule_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_temporal_server_api_enums_v1_schedule_proto_init() }
+func file_temporal_server_api_enums_v1_schedule_proto_init() {
+	if File_temporal_server_api_enums_v1_schedule_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_temporal_server_api_enums_v1_schedule_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_temporal_server_api_enums_v1_schedule_proto_goTypes,
+		DependencyIndexes: file_temporal_server_api_enums_v1_schedule_proto_depIdxs,
+		EnumInfos:         file_temporal_server_api_enums_v1_schedule_proto_enumTypes,
+	}.Build()
+	File_temporal_server_api_enums_v1_schedule_proto = out.File
+	file_temporal_server_api_enums_v1_schedule_proto_rawDesc = nil
+	file_temporal_server_api_enums_v1_schedule_proto_goTypes = nil
+	file_temporal_server_api_enums_v1_schedule_proto_depIdxs = nil
+}
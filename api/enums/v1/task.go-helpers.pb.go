@@ -79,6 +79,8 @@ var (
 		"WorkflowExecutionTimeout":           29,
 		"ReplicationSyncHsm":                 30,
 		"ReplicationSyncVersionedTransition": 31,
+		"WorkflowLifecycleEvent":             32,
+		"ReplicationWriteIntent":             33,
 	}
 )
 
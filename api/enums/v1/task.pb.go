@@ -143,6 +143,10 @@ const (
 	TASK_TYPE_WORKFLOW_EXECUTION_TIMEOUT            TaskType = 29
 	TASK_TYPE_REPLICATION_SYNC_HSM                  TaskType = 30
 	TASK_TYPE_REPLICATION_SYNC_VERSIONED_TRANSITION TaskType = 31
+	// Publishes a workflow lifecycle event to the lifecycle event sinks of the namespace.
+	TASK_TYPE_WORKFLOW_LIFECYCLE_EVENT TaskType = 32
+	// Carries a signal or an update accepted by a standby cluster to the cluster which is active for the namespace.
+	TASK_TYPE_REPLICATION_WRITE_INTENT TaskType = 33
 )
 
 // Enum value maps for TaskType.
@@ -177,6 +181,8 @@ var (
 		29: "TASK_TYPE_WORKFLOW_EXECUTION_TIMEOUT",
 		30: "TASK_TYPE_REPLICATION_SYNC_HSM",
 		31: "TASK_TYPE_REPLICATION_SYNC_VERSIONED_TRANSITION",
+		32: "TASK_TYPE_WORKFLOW_LIFECYCLE_EVENT",
+		33: "TASK_TYPE_REPLICATION_WRITE_INTENT",
 	}
 	TaskType_value = map[string]int32{
 		"TASK_TYPE_UNSPECIFIED":                           0,
@@ -208,6 +214,8 @@ var (
 		"TASK_TYPE_WORKFLOW_EXECUTION_TIMEOUT":            29,
 		"TASK_TYPE_REPLICATION_SYNC_HSM":                  30,
 		"TASK_TYPE_REPLICATION_SYNC_VERSIONED_TRANSITION": 31,
+		"TASK_TYPE_WORKFLOW_LIFECYCLE_EVENT":              32,
+		"TASK_TYPE_REPLICATION_WRITE_INTENT":              33,
 	}
 )
 
@@ -287,6 +295,10 @@ func (x TaskType) String() string {
 		// Deprecated: Use TaskPriority.Descriptor instead.
 	case TASK_TYPE_REPLICATION_SYNC_VERSIONED_TRANSITION:
 		return "ReplicationSyncVersionedTransition"
+	case TASK_TYPE_WORKFLOW_LIFECYCLE_EVENT:
+		return "WorkflowLifecycleEvent"
+	case TASK_TYPE_REPLICATION_WRITE_INTENT:
+		return "ReplicationWriteIntent"
 	default:
 		return strconv.Itoa(int(x))
 	}
@@ -380,7 +392,7 @@ var file_temporal_server_api_enums_v1_task_proto_rawDesc = []byte{
 	0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43,
 	0x45, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x54,
 	0x41, 0x53, 0x4b, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x44, 0x42, 0x5f, 0x42, 0x41,
-	0x43, 0x4b, 0x4c, 0x4f, 0x47, 0x10, 0x02, 0x2a, 0x87, 0x09, 0x0a, 0x08, 0x54, 0x61, 0x73, 0x6b,
+	0x43, 0x4b, 0x4c, 0x4f, 0x47, 0x10, 0x02, 0x2a, 0xd7, 0x09, 0x0a, 0x08, 0x54, 0x61, 0x73, 0x6b,
 	0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50,
 	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
 	0x21, 0x0a, 0x1d, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x50,
@@ -451,7 +463,12 @@ var file_temporal_server_api_enums_v1_task_proto_rawDesc = []byte{
 	0x53, 0x59, 0x4e, 0x43, 0x5f, 0x48, 0x53, 0x4d, 0x10, 0x1e, 0x12, 0x33, 0x0a, 0x2f, 0x54, 0x41,
 	0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54,
 	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e,
-	0x45, 0x44, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x1f, 0x22,
+	0x45, 0x44, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x1f, 0x12,
+	0x26, 0x0a, 0x22, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x57, 0x4f, 0x52,
+	0x4b, 0x46, 0x4c, 0x4f, 0x57, 0x5f, 0x4c, 0x49, 0x46, 0x45, 0x43, 0x59, 0x43, 0x4c, 0x45, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x20, 0x12, 0x26, 0x0a, 0x22, 0x54, 0x41, 0x53, 0x4b, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x10, 0x21, 0x22,
 	0x04, 0x08, 0x09, 0x10, 0x09, 0x22, 0x04, 0x08, 0x0b, 0x10, 0x0b, 0x22, 0x04, 0x08, 0x17, 0x10,
 	0x17, 0x2a, 0x5c, 0x0a, 0x0c, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
 	0x79, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49,
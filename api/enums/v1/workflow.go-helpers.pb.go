@@ -90,3 +90,43 @@ func PausedWorkflowEntityTypeFromString(s string) (PausedWorkflowEntityType, err
 	}
 	return PausedWorkflowEntityType(0), fmt.Errorf("%s is not a valid PausedWorkflowEntityType", s)
 }
+
+var (
+	QueryRejectCondition_shorthandValue = map[string]int32{
+		"Unspecified":         0,
+		"WorkflowTaskFailing": 1,
+		"WorkflowTaskBackoff": 2,
+		"ActivityPaused":      3,
+	}
+)
+
+// QueryRejectConditionFromString parses a QueryRejectCondition value from  either the protojson
+// canonical SCREAMING_CASE enum or the traditional temporal PascalCase enum to QueryRejectCondition
+func QueryRejectConditionFromString(s string) (QueryRejectCondition, error) {
+	if v, ok := QueryRejectCondition_value[s]; ok {
+		return QueryRejectCondition(v), nil
+	} else if v, ok := QueryRejectCondition_shorthandValue[s]; ok {
+		return QueryRejectCondition(v), nil
+	}
+	return QueryRejectCondition(0), fmt.Errorf("%s is not a valid QueryRejectCondition", s)
+}
+
+var (
+	WorkflowLifecycleEventType_shorthandValue = map[string]int32{
+		"Unspecified": 0,
+		"Started":     1,
+		"Closed":      2,
+		"Reset":       3,
+	}
+)
+
+// WorkflowLifecycleEventTypeFromString parses a WorkflowLifecycleEventType value from  either the protojson
+// canonical SCREAMING_CASE enum or the traditional temporal PascalCase enum to WorkflowLifecycleEventType
+func WorkflowLifecycleEventTypeFromString(s string) (WorkflowLifecycleEventType, error) {
+	if v, ok := WorkflowLifecycleEventType_value[s]; ok {
+		return WorkflowLifecycleEventType(v), nil
+	} else if v, ok := WorkflowLifecycleEventType_shorthandValue[s]; ok {
+		return WorkflowLifecycleEventType(v), nil
+	}
+	return WorkflowLifecycleEventType(0), fmt.Errorf("%s is not a valid WorkflowLifecycleEventType", s)
+}
@@ -246,6 +246,145 @@ func (PausedWorkflowEntityType) EnumDescriptor() ([]byte, []int) {
 	return file_temporal_server_api_enums_v1_workflow_proto_rawDescGZIP(), []int{2}
 }
 
+// QueryRejectCondition is an additional condition, on top of temporal.api.enums.v1.QueryRejectCondition, under which a
+// query of a running workflow is rejected instead of being dispatched to a worker.
+type QueryRejectCondition int32
+
+const (
+	QUERY_REJECT_CONDITION_UNSPECIFIED QueryRejectCondition = 0
+	// Reject if the current workflow task failed at least once, as the query would likely fail or be answered from a
+	// stale state.
+	QUERY_REJECT_CONDITION_WORKFLOW_TASK_FAILING QueryRejectCondition = 1
+	// Reject if the workflow waits for its first workflow task backoff (cron, retry or start delay) to elapse, as the
+	// query would block until then.
+	QUERY_REJECT_CONDITION_WORKFLOW_TASK_BACKOFF QueryRejectCondition = 2
+	// Reject if any pending activity of the workflow is paused.
+	QUERY_REJECT_CONDITION_ACTIVITY_PAUSED QueryRejectCondition = 3
+)
+
+// Enum value maps for QueryRejectCondition.
+var (
+	QueryRejectCondition_name = map[int32]string{
+		0: "QUERY_REJECT_CONDITION_UNSPECIFIED",
+		1: "QUERY_REJECT_CONDITION_WORKFLOW_TASK_FAILING",
+		2: "QUERY_REJECT_CONDITION_WORKFLOW_TASK_BACKOFF",
+		3: "QUERY_REJECT_CONDITION_ACTIVITY_PAUSED",
+	}
+	QueryRejectCondition_value = map[string]int32{
+		"QUERY_REJECT_CONDITION_UNSPECIFIED":           0,
+		"QUERY_REJECT_CONDITION_WORKFLOW_TASK_FAILING": 1,
+		"QUERY_REJECT_CONDITION_WORKFLOW_TASK_BACKOFF": 2,
+		"QUERY_REJECT_CONDITION_ACTIVITY_PAUSED":       3,
+	}
+)
+
+func (x QueryRejectCondition) Enum() *QueryRejectCondition {
+	p := new(QueryRejectCondition)
+	*p = x
+	return p
+}
+
+func (x QueryRejectCondition) String() string {
+	switch x {
+	case QUERY_REJECT_CONDITION_UNSPECIFIED:
+		return "Unspecified"
+	case QUERY_REJECT_CONDITION_WORKFLOW_TASK_FAILING:
+		return "WorkflowTaskFailing"
+	case QUERY_REJECT_CONDITION_WORKFLOW_TASK_BACKOFF:
+		return "WorkflowTaskBackoff"
+	case QUERY_REJECT_CONDITION_ACTIVITY_PAUSED:
+		return "ActivityPaused"
+	default:
+		return strconv.Itoa(int(x))
+	}
+
+}
+
+func (QueryRejectCondition) Descriptor() protoreflect.EnumDescriptor {
+	return file_temporal_server_api_enums_v1_workflow_proto_enumTypes[3].Descriptor()
+}
+
+func (QueryRejectCondition) Type() protoreflect.EnumType {
+	return &file_temporal_server_api_enums_v1_workflow_proto_enumTypes[3]
+}
+
+func (x QueryRejectCondition) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QueryRejectCondition.Descriptor instead.
+func (QueryRejectCondition) EnumDescriptor() ([]byte, []int) {
+	return file_temporal_server_api_enums_v1_workflow_proto_rawDescGZIP(), []int{3}
+}
+
+// WorkflowLifecycleEventType is the kind of a workflow lifecycle event published to external sinks.
+type WorkflowLifecycleEventType int32
+
+const (
+	WORKFLOW_LIFECYCLE_EVENT_TYPE_UNSPECIFIED WorkflowLifecycleEventType = 0
+	// The run started.
+	WORKFLOW_LIFECYCLE_EVENT_TYPE_STARTED WorkflowLifecycleEventType = 1
+	// The run closed, including by termination and continue-as-new.
+	WORKFLOW_LIFECYCLE_EVENT_TYPE_CLOSED WorkflowLifecycleEventType = 2
+	// The run was created by resetting another run of the workflow.
+	WORKFLOW_LIFECYCLE_EVENT_TYPE_RESET WorkflowLifecycleEventType = 3
+)
+
+// Enum value maps for WorkflowLifecycleEventType.
+var (
+	WorkflowLifecycleEventType_name = map[int32]string{
+		0: "WORKFLOW_LIFECYCLE_EVENT_TYPE_UNSPECIFIED",
+		1: "WORKFLOW_LIFECYCLE_EVENT_TYPE_STARTED",
+		2: "WORKFLOW_LIFECYCLE_EVENT_TYPE_CLOSED",
+		3: "WORKFLOW_LIFECYCLE_EVENT_TYPE_RESET",
+	}
+	WorkflowLifecycleEventType_value = map[string]int32{
+		"WORKFLOW_LIFECYCLE_EVENT_TYPE_UNSPECIFIED": 0,
+		"WORKFLOW_LIFECYCLE_EVENT_TYPE_STARTED":     1,
+		"WORKFLOW_LIFECYCLE_EVENT_TYPE_CLOSED":      2,
+		"WORKFLOW_LIFECYCLE_EVENT_TYPE_RESET":       3,
+	}
+)
+
+func (x WorkflowLifecycleEventType) Enum() *WorkflowLifecycleEventType {
+	p := new(WorkflowLifecycleEventType)
+	*p = x
+	return p
+}
+
+func (x WorkflowLifecycleEventType) String() string {
+	switch x {
+	case WORKFLOW_LIFECYCLE_EVENT_TYPE_UNSPECIFIED:
+		return "Unspecified"
+	case WORKFLOW_LIFECYCLE_EVENT_TYPE_STARTED:
+		return "Started"
+	case WORKFLOW_LIFECYCLE_EVENT_TYPE_CLOSED:
+		return "Closed"
+	case WORKFLOW_LIFECYCLE_EVENT_TYPE_RESET:
+		return "Reset"
+	default:
+		return strconv.Itoa(int(x))
+	}
+
+}
+
+func (WorkflowLifecycleEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_temporal_server_api_enums_v1_workflow_proto_enumTypes[4].Descriptor()
+}
+
+func (WorkflowLifecycleEventType) Type() protoreflect.EnumType {
+	return &file_temporal_server_api_enums_v1_workflow_proto_enumTypes[4]
+}
+
+func (x WorkflowLifecycleEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkflowLifecycleEventType.Descriptor instead.
+func (WorkflowLifecycleEventType) EnumDescriptor() ([]byte, []int) {
+	return file_temporal_server_api_enums_v1_workflow_proto_rawDescGZIP(), []int{4}
+}
+
 var File_temporal_server_api_enums_v1_workflow_proto protoreflect.FileDescriptor
 
 var file_temporal_server_api_enums_v1_workflow_proto_rawDesc = []byte{
@@ -292,10 +431,36 @@ var file_temporal_server_api_enums_v1_workflow_proto_rawDesc = []byte{
 	0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x10, 0x01, 0x12,
 	0x28, 0x0a, 0x24, 0x50, 0x41, 0x55, 0x53, 0x45, 0x44, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x46, 0x4c,
 	0x4f, 0x57, 0x5f, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x57,
-	0x4f, 0x52, 0x4b, 0x46, 0x4c, 0x4f, 0x57, 0x10, 0x02, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x6f, 0x2e,
-	0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x69, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2f, 0x76, 0x31, 0x3b,
-	0x65, 0x6e, 0x75, 0x6d, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x4f, 0x52, 0x4b, 0x46, 0x4c, 0x4f, 0x57, 0x10, 0x02, 0x2a, 0xce, 0x01, 0x0a, 0x14, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x22, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x52, 0x45, 0x4a, 0x45,
+	0x43, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x30, 0x0a, 0x2c, 0x51, 0x55,
+	0x45, 0x52, 0x59, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x44, 0x49,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x46, 0x4c, 0x4f, 0x57, 0x5f, 0x54, 0x41,
+	0x53, 0x4b, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x30, 0x0a, 0x2c,
+	0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x43, 0x4f, 0x4e,
+	0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x46, 0x4c, 0x4f, 0x57, 0x5f,
+	0x54, 0x41, 0x53, 0x4b, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x4f, 0x46, 0x46, 0x10, 0x02, 0x12, 0x2a,
+	0x0a, 0x26, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x43,
+	0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54,
+	0x59, 0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x44, 0x10, 0x03, 0x2a, 0xc9, 0x01, 0x0a, 0x1a, 0x57,
+	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2d, 0x0a, 0x29, 0x57, 0x4f, 0x52,
+	0x4b, 0x46, 0x4c, 0x4f, 0x57, 0x5f, 0x4c, 0x49, 0x46, 0x45, 0x43, 0x59, 0x43, 0x4c, 0x45, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x29, 0x0a, 0x25, 0x57, 0x4f, 0x52, 0x4b,
+	0x46, 0x4c, 0x4f, 0x57, 0x5f, 0x4c, 0x49, 0x46, 0x45, 0x43, 0x59, 0x43, 0x4c, 0x45, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x28, 0x0a, 0x24, 0x57, 0x4f, 0x52, 0x4b, 0x46, 0x4c, 0x4f, 0x57, 0x5f,
+	0x4c, 0x49, 0x46, 0x45, 0x43, 0x59, 0x43, 0x4c, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x02, 0x12, 0x27, 0x0a,
+	0x23, 0x57, 0x4f, 0x52, 0x4b, 0x46, 0x4c, 0x4f, 0x57, 0x5f, 0x4c, 0x49, 0x46, 0x45, 0x43, 0x59,
+	0x43, 0x4c, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52,
+	0x45, 0x53, 0x45, 0x54, 0x10, 0x03, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x6f, 0x2e, 0x74, 0x65, 0x6d,
+	0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x69, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6e, 0x75,
+	0x6d, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -310,11 +475,13 @@ func file_temporal_server_api_enums_v1_workflow_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_enums_v1_workflow_proto_rawDescData
 }
 
-var file_temporal_server_api_enums_v1_workflow_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_temporal_server_api_enums_v1_workflow_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
 var file_temporal_server_api_enums_v1_workflow_proto_goTypes = []interface{}{
-	(WorkflowExecutionState)(0),   // 0: temporal.server.api.enums.v1.WorkflowExecutionState
-	(WorkflowBackoffType)(0),      // 1: temporal.server.api.enums.v1.WorkflowBackoffType
-	(PausedWorkflowEntityType)(0), // 2: temporal.server.api.enums.v1.PausedWorkflowEntityType
+	(WorkflowExecutionState)(0),     // 0: temporal.server.api.enums.v1.WorkflowExecutionState
+	(WorkflowBackoffType)(0),        // 1: temporal.server.api.enums.v1.WorkflowBackoffType
+	(PausedWorkflowEntityType)(0),   // 2: temporal.server.api.enums.v1.PausedWorkflowEntityType
+	(QueryRejectCondition)(0),       // 3: temporal.server.api.enums.v1.QueryRejectCondition
+	(WorkflowLifecycleEventType)(0), // 4: temporal.server.api.enums.v1.WorkflowLifecycleEventType
 }
 var file_temporal_server_api_enums_v1_workflow_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -334,7 +501,7 @@ func file_temporal_server_api_enums_v1_workflow_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_temporal_server_api_enums_v1_workflow_proto_rawDesc,
-			NumEnums:      3,
+			NumEnums:      5,
 			NumMessages:   0,
 			NumExtensions: 0,
 			NumServices:   0,
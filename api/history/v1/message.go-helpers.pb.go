@@ -250,3 +250,151 @@ func (this *TaskRange) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type ResourceUsage to the protobuf v3 wire format
+func (val *ResourceUsage) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ResourceUsage from the protobuf v3 wire format
+func (val *ResourceUsage) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ResourceUsage) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ResourceUsage values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ResourceUsage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ResourceUsage
+	switch t := that.(type) {
+	case *ResourceUsage:
+		that1 = t
+	case ResourceUsage:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type HotShard to the protobuf v3 wire format
+func (val *HotShard) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type HotShard from the protobuf v3 wire format
+func (val *HotShard) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *HotShard) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two HotShard values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *HotShard) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *HotShard
+	switch t := that.(type) {
+	case *HotShard:
+		that1 = t
+	case HotShard:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type HotWorkflow to the protobuf v3 wire format
+func (val *HotWorkflow) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type HotWorkflow from the protobuf v3 wire format
+func (val *HotWorkflow) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *HotWorkflow) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two HotWorkflow values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *HotWorkflow) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *HotWorkflow
+	switch t := that.(type) {
+	case *HotWorkflow:
+		that1 = t
+	case HotWorkflow:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DebugTraceSpan to the protobuf v3 wire format
+func (val *DebugTraceSpan) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DebugTraceSpan from the protobuf v3 wire format
+func (val *DebugTraceSpan) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DebugTraceSpan) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DebugTraceSpan values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DebugTraceSpan) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DebugTraceSpan
+	switch t := that.(type) {
+	case *DebugTraceSpan:
+		that1 = t
+	case DebugTraceSpan:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
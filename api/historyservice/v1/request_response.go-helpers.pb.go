@@ -1250,6 +1250,80 @@ func (this *SignalWorkflowExecutionResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type MultiSignalWorkflowExecutionRequest to the protobuf v3 wire format
+func (val *MultiSignalWorkflowExecutionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type MultiSignalWorkflowExecutionRequest from the protobuf v3 wire format
+func (val *MultiSignalWorkflowExecutionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *MultiSignalWorkflowExecutionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two MultiSignalWorkflowExecutionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *MultiSignalWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *MultiSignalWorkflowExecutionRequest
+	switch t := that.(type) {
+	case *MultiSignalWorkflowExecutionRequest:
+		that1 = t
+	case MultiSignalWorkflowExecutionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type MultiSignalWorkflowExecutionResponse to the protobuf v3 wire format
+func (val *MultiSignalWorkflowExecutionResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type MultiSignalWorkflowExecutionResponse from the protobuf v3 wire format
+func (val *MultiSignalWorkflowExecutionResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *MultiSignalWorkflowExecutionResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two MultiSignalWorkflowExecutionResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *MultiSignalWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *MultiSignalWorkflowExecutionResponse
+	switch t := that.(type) {
+	case *MultiSignalWorkflowExecutionResponse:
+		that1 = t
+	case MultiSignalWorkflowExecutionResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type SignalWithStartWorkflowExecutionRequest to the protobuf v3 wire format
 func (val *SignalWithStartWorkflowExecutionRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -5504,3 +5578,77 @@ func (this *UpdateWorkflowExecutionOptionsResponse) Equal(that interface{}) bool
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type ListNexusEndpointHealthRequest to the protobuf v3 wire format
+func (val *ListNexusEndpointHealthRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListNexusEndpointHealthRequest from the protobuf v3 wire format
+func (val *ListNexusEndpointHealthRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListNexusEndpointHealthRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListNexusEndpointHealthRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListNexusEndpointHealthRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListNexusEndpointHealthRequest
+	switch t := that.(type) {
+	case *ListNexusEndpointHealthRequest:
+		that1 = t
+	case ListNexusEndpointHealthRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListNexusEndpointHealthResponse to the protobuf v3 wire format
+func (val *ListNexusEndpointHealthResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListNexusEndpointHealthResponse from the protobuf v3 wire format
+func (val *ListNexusEndpointHealthResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListNexusEndpointHealthResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListNexusEndpointHealthResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListNexusEndpointHealthResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListNexusEndpointHealthResponse
+	switch t := that.(type) {
+	case *ListNexusEndpointHealthResponse:
+		that1 = t
+	case ListNexusEndpointHealthResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
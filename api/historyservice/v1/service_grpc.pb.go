@@ -57,6 +57,7 @@ const (
 	HistoryService_RespondActivityTaskCanceled_FullMethodName            = "/temporal.server.api.historyservice.v1.HistoryService/RespondActivityTaskCanceled"
 	HistoryService_IsActivityTaskValid_FullMethodName                    = "/temporal.server.api.historyservice.v1.HistoryService/IsActivityTaskValid"
 	HistoryService_SignalWorkflowExecution_FullMethodName                = "/temporal.server.api.historyservice.v1.HistoryService/SignalWorkflowExecution"
+	HistoryService_MultiSignalWorkflowExecution_FullMethodName           = "/temporal.server.api.historyservice.v1.HistoryService/MultiSignalWorkflowExecution"
 	HistoryService_SignalWithStartWorkflowExecution_FullMethodName       = "/temporal.server.api.historyservice.v1.HistoryService/SignalWithStartWorkflowExecution"
 	HistoryService_ExecuteMultiOperation_FullMethodName                  = "/temporal.server.api.historyservice.v1.HistoryService/ExecuteMultiOperation"
 	HistoryService_RemoveSignalMutableState_FullMethodName               = "/temporal.server.api.historyservice.v1.HistoryService/RemoveSignalMutableState"
@@ -113,6 +114,7 @@ const (
 	HistoryService_PauseActivity_FullMethodName                          = "/temporal.server.api.historyservice.v1.HistoryService/PauseActivity"
 	HistoryService_UnpauseActivity_FullMethodName                        = "/temporal.server.api.historyservice.v1.HistoryService/UnpauseActivity"
 	HistoryService_ResetActivity_FullMethodName                          = "/temporal.server.api.historyservice.v1.HistoryService/ResetActivity"
+	HistoryService_ListNexusEndpointHealth_FullMethodName                = "/temporal.server.api.historyservice.v1.HistoryService/ListNexusEndpointHealth"
 )
 
 // HistoryServiceClient is the client API for HistoryService service.
@@ -186,6 +188,9 @@ type HistoryServiceClient interface {
 	// SignalWorkflowExecution is used to send a signal event to running workflow execution.  This results in
 	// WorkflowExecutionSignaled event recorded in the history and a workflow task being created for the execution.
 	SignalWorkflowExecution(ctx context.Context, in *SignalWorkflowExecutionRequest, opts ...grpc.CallOption) (*SignalWorkflowExecutionResponse, error)
+	// MultiSignalWorkflowExecution records a list of signals to a running workflow execution in a single history
+	// transaction, so that they are delivered together in the same workflow task.
+	MultiSignalWorkflowExecution(ctx context.Context, in *MultiSignalWorkflowExecutionRequest, opts ...grpc.CallOption) (*MultiSignalWorkflowExecutionResponse, error)
 	// (-- api-linter: core::0136::prepositions=disabled
 	//
 	//	aip.dev/not-precedent: "With" is needed here. --)
@@ -383,6 +388,9 @@ type HistoryServiceClient interface {
 	// (-- api-linter: core::0134::method-signature=disabled
 	// (-- api-linter: core::0134::response-message-name=disabled
 	ResetActivity(ctx context.Context, in *ResetActivityRequest, opts ...grpc.CallOption) (*ResetActivityResponse, error)
+	// ListNexusEndpointHealth returns the recent outcomes of requests to Nexus endpoints and the state of the
+	// endpoints' circuit breakers on a single history host.
+	ListNexusEndpointHealth(ctx context.Context, in *ListNexusEndpointHealthRequest, opts ...grpc.CallOption) (*ListNexusEndpointHealthResponse, error)
 }
 
 type historyServiceClient struct {
@@ -528,6 +536,15 @@ func (c *historyServiceClient) SignalWorkflowExecution(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *historyServiceClient) MultiSignalWorkflowExecution(ctx context.Context, in *MultiSignalWorkflowExecutionRequest, opts ...grpc.CallOption) (*MultiSignalWorkflowExecutionResponse, error) {
+	out := new(MultiSignalWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, HistoryService_MultiSignalWorkflowExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *historyServiceClient) SignalWithStartWorkflowExecution(ctx context.Context, in *SignalWithStartWorkflowExecutionRequest, opts ...grpc.CallOption) (*SignalWithStartWorkflowExecutionResponse, error) {
 	out := new(SignalWithStartWorkflowExecutionResponse)
 	err := c.cc.Invoke(ctx, HistoryService_SignalWithStartWorkflowExecution_FullMethodName, in, out, opts...)
@@ -1054,6 +1071,15 @@ func (c *historyServiceClient) ResetActivity(ctx context.Context, in *ResetActiv
 	return out, nil
 }
 
+func (c *historyServiceClient) ListNexusEndpointHealth(ctx context.Context, in *ListNexusEndpointHealthRequest, opts ...grpc.CallOption) (*ListNexusEndpointHealthResponse, error) {
+	out := new(ListNexusEndpointHealthResponse)
+	err := c.cc.Invoke(ctx, HistoryService_ListNexusEndpointHealth_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HistoryServiceServer is the server API for HistoryService service.
 // All implementations must embed UnimplementedHistoryServiceServer
 // for forward compatibility
@@ -1125,6 +1151,9 @@ type HistoryServiceServer interface {
 	// SignalWorkflowExecution is used to send a signal event to running workflow execution.  This results in
 	// WorkflowExecutionSignaled event recorded in the history and a workflow task being created for the execution.
 	SignalWorkflowExecution(context.Context, *SignalWorkflowExecutionRequest) (*SignalWorkflowExecutionResponse, error)
+	// MultiSignalWorkflowExecution records a list of signals to a running workflow execution in a single history
+	// transaction, so that they are delivered together in the same workflow task.
+	MultiSignalWorkflowExecution(context.Context, *MultiSignalWorkflowExecutionRequest) (*MultiSignalWorkflowExecutionResponse, error)
 	// (-- api-linter: core::0136::prepositions=disabled
 	//
 	//	aip.dev/not-precedent: "With" is needed here. --)
@@ -1322,6 +1351,9 @@ type HistoryServiceServer interface {
 	// (-- api-linter: core::0134::method-signature=disabled
 	// (-- api-linter: core::0134::response-message-name=disabled
 	ResetActivity(context.Context, *ResetActivityRequest) (*ResetActivityResponse, error)
+	// ListNexusEndpointHealth returns the recent outcomes of requests to Nexus endpoints and the state of the
+	// endpoints' circuit breakers on a single history host.
+	ListNexusEndpointHealth(context.Context, *ListNexusEndpointHealthRequest) (*ListNexusEndpointHealthResponse, error)
 	mustEmbedUnimplementedHistoryServiceServer()
 }
 
@@ -1374,6 +1406,9 @@ func (UnimplementedHistoryServiceServer) IsActivityTaskValid(context.Context, *I
 func (UnimplementedHistoryServiceServer) SignalWorkflowExecution(context.Context, *SignalWorkflowExecutionRequest) (*SignalWorkflowExecutionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SignalWorkflowExecution not implemented")
 }
+func (UnimplementedHistoryServiceServer) MultiSignalWorkflowExecution(context.Context, *MultiSignalWorkflowExecutionRequest) (*MultiSignalWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiSignalWorkflowExecution not implemented")
+}
 func (UnimplementedHistoryServiceServer) SignalWithStartWorkflowExecution(context.Context, *SignalWithStartWorkflowExecutionRequest) (*SignalWithStartWorkflowExecutionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SignalWithStartWorkflowExecution not implemented")
 }
@@ -1542,6 +1577,9 @@ func (UnimplementedHistoryServiceServer) UnpauseActivity(context.Context, *Unpau
 func (UnimplementedHistoryServiceServer) ResetActivity(context.Context, *ResetActivityRequest) (*ResetActivityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetActivity not implemented")
 }
+func (UnimplementedHistoryServiceServer) ListNexusEndpointHealth(context.Context, *ListNexusEndpointHealthRequest) (*ListNexusEndpointHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNexusEndpointHealth not implemented")
+}
 func (UnimplementedHistoryServiceServer) mustEmbedUnimplementedHistoryServiceServer() {}
 
 // UnsafeHistoryServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1825,6 +1863,24 @@ func _HistoryService_SignalWorkflowExecution_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_MultiSignalWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiSignalWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).MultiSignalWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_MultiSignalWorkflowExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).MultiSignalWorkflowExecution(ctx, req.(*MultiSignalWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HistoryService_SignalWithStartWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SignalWithStartWorkflowExecutionRequest)
 	if err := dec(in); err != nil {
@@ -2841,6 +2897,24 @@ func _HistoryService_ResetActivity_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_ListNexusEndpointHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNexusEndpointHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).ListNexusEndpointHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_ListNexusEndpointHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).ListNexusEndpointHealth(ctx, req.(*ListNexusEndpointHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // HistoryService_ServiceDesc is the grpc.ServiceDesc for HistoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2908,6 +2982,10 @@ var HistoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SignalWorkflowExecution",
 			Handler:    _HistoryService_SignalWorkflowExecution_Handler,
 		},
+		{
+			MethodName: "MultiSignalWorkflowExecution",
+			Handler:    _HistoryService_MultiSignalWorkflowExecution_Handler,
+		},
 		{
 			MethodName: "SignalWithStartWorkflowExecution",
 			Handler:    _HistoryService_SignalWithStartWorkflowExecution_Handler,
@@ -3128,6 +3206,10 @@ var HistoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetActivity",
 			Handler:    _HistoryService_ResetActivity_Handler,
 		},
+		{
+			MethodName: "ListNexusEndpointHealth",
+			Handler:    _HistoryService_ListNexusEndpointHealth_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -626,6 +626,26 @@ func (mr *MockHistoryServiceClientMockRecorder) IsWorkflowTaskValid(ctx, in any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowTaskValid", reflect.TypeOf((*MockHistoryServiceClient)(nil).IsWorkflowTaskValid), varargs...)
 }
 
+// ListNexusEndpointHealth mocks base method.
+func (m *MockHistoryServiceClient) ListNexusEndpointHealth(ctx context.Context, in *historyservice.ListNexusEndpointHealthRequest, opts ...grpc.CallOption) (*historyservice.ListNexusEndpointHealthResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNexusEndpointHealth", varargs...)
+	ret0, _ := ret[0].(*historyservice.ListNexusEndpointHealthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNexusEndpointHealth indicates an expected call of ListNexusEndpointHealth.
+func (mr *MockHistoryServiceClientMockRecorder) ListNexusEndpointHealth(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNexusEndpointHealth", reflect.TypeOf((*MockHistoryServiceClient)(nil).ListNexusEndpointHealth), varargs...)
+}
+
 // ListQueues mocks base method.
 func (m *MockHistoryServiceClient) ListQueues(ctx context.Context, in *historyservice.ListQueuesRequest, opts ...grpc.CallOption) (*historyservice.ListQueuesResponse, error) {
 	m.ctrl.T.Helper()
@@ -686,6 +706,26 @@ func (mr *MockHistoryServiceClientMockRecorder) MergeDLQMessages(ctx, in any, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockHistoryServiceClient)(nil).MergeDLQMessages), varargs...)
 }
 
+// MultiSignalWorkflowExecution mocks base method.
+func (m *MockHistoryServiceClient) MultiSignalWorkflowExecution(ctx context.Context, in *historyservice.MultiSignalWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.MultiSignalWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MultiSignalWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*historyservice.MultiSignalWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MultiSignalWorkflowExecution indicates an expected call of MultiSignalWorkflowExecution.
+func (mr *MockHistoryServiceClientMockRecorder) MultiSignalWorkflowExecution(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MultiSignalWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).MultiSignalWorkflowExecution), varargs...)
+}
+
 // PauseActivity mocks base method.
 func (m *MockHistoryServiceClient) PauseActivity(ctx context.Context, in *historyservice.PauseActivityRequest, opts ...grpc.CallOption) (*historyservice.PauseActivityResponse, error) {
 	m.ctrl.T.Helper()
@@ -2066,6 +2106,21 @@ func (mr *MockHistoryServiceServerMockRecorder) IsWorkflowTaskValid(arg0, arg1 a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowTaskValid", reflect.TypeOf((*MockHistoryServiceServer)(nil).IsWorkflowTaskValid), arg0, arg1)
 }
 
+// ListNexusEndpointHealth mocks base method.
+func (m *MockHistoryServiceServer) ListNexusEndpointHealth(arg0 context.Context, arg1 *historyservice.ListNexusEndpointHealthRequest) (*historyservice.ListNexusEndpointHealthResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNexusEndpointHealth", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.ListNexusEndpointHealthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNexusEndpointHealth indicates an expected call of ListNexusEndpointHealth.
+func (mr *MockHistoryServiceServerMockRecorder) ListNexusEndpointHealth(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNexusEndpointHealth", reflect.TypeOf((*MockHistoryServiceServer)(nil).ListNexusEndpointHealth), arg0, arg1)
+}
+
 // ListQueues mocks base method.
 func (m *MockHistoryServiceServer) ListQueues(arg0 context.Context, arg1 *historyservice.ListQueuesRequest) (*historyservice.ListQueuesResponse, error) {
 	m.ctrl.T.Helper()
@@ -2111,6 +2166,21 @@ func (mr *MockHistoryServiceServerMockRecorder) MergeDLQMessages(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockHistoryServiceServer)(nil).MergeDLQMessages), arg0, arg1)
 }
 
+// MultiSignalWorkflowExecution mocks base method.
+func (m *MockHistoryServiceServer) MultiSignalWorkflowExecution(arg0 context.Context, arg1 *historyservice.MultiSignalWorkflowExecutionRequest) (*historyservice.MultiSignalWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MultiSignalWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.MultiSignalWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MultiSignalWorkflowExecution indicates an expected call of MultiSignalWorkflowExecution.
+func (mr *MockHistoryServiceServerMockRecorder) MultiSignalWorkflowExecution(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MultiSignalWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).MultiSignalWorkflowExecution), arg0, arg1)
+}
+
 // PauseActivity mocks base method.
 func (m *MockHistoryServiceServer) PauseActivity(arg0 context.Context, arg1 *historyservice.PauseActivityRequest) (*historyservice.PauseActivityResponse, error) {
 	m.ctrl.T.Helper()
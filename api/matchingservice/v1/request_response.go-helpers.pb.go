@@ -547,6 +547,80 @@ func (this *CancelOutstandingPollResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type StreamPollTaskQueueRequest to the protobuf v3 wire format
+func (val *StreamPollTaskQueueRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StreamPollTaskQueueRequest from the protobuf v3 wire format
+func (val *StreamPollTaskQueueRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StreamPollTaskQueueRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StreamPollTaskQueueRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StreamPollTaskQueueRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StreamPollTaskQueueRequest
+	switch t := that.(type) {
+	case *StreamPollTaskQueueRequest:
+		that1 = t
+	case StreamPollTaskQueueRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StreamPollTaskQueueResponse to the protobuf v3 wire format
+func (val *StreamPollTaskQueueResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StreamPollTaskQueueResponse from the protobuf v3 wire format
+func (val *StreamPollTaskQueueResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StreamPollTaskQueueResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StreamPollTaskQueueResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StreamPollTaskQueueResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StreamPollTaskQueueResponse
+	switch t := that.(type) {
+	case *StreamPollTaskQueueResponse:
+		that1 = t
+	case StreamPollTaskQueueResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type DescribeTaskQueueRequest to the protobuf v3 wire format
 func (val *DescribeTaskQueueRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -2323,6 +2397,80 @@ func (this *DeleteNexusEndpointResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type ApplyNexusEndpointReplicationEventRequest to the protobuf v3 wire format
+func (val *ApplyNexusEndpointReplicationEventRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ApplyNexusEndpointReplicationEventRequest from the protobuf v3 wire format
+func (val *ApplyNexusEndpointReplicationEventRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ApplyNexusEndpointReplicationEventRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ApplyNexusEndpointReplicationEventRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ApplyNexusEndpointReplicationEventRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ApplyNexusEndpointReplicationEventRequest
+	switch t := that.(type) {
+	case *ApplyNexusEndpointReplicationEventRequest:
+		that1 = t
+	case ApplyNexusEndpointReplicationEventRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ApplyNexusEndpointReplicationEventResponse to the protobuf v3 wire format
+func (val *ApplyNexusEndpointReplicationEventResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ApplyNexusEndpointReplicationEventResponse from the protobuf v3 wire format
+func (val *ApplyNexusEndpointReplicationEventResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ApplyNexusEndpointReplicationEventResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ApplyNexusEndpointReplicationEventResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ApplyNexusEndpointReplicationEventResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ApplyNexusEndpointReplicationEventResponse
+	switch t := that.(type) {
+	case *ApplyNexusEndpointReplicationEventResponse:
+		that1 = t
+	case ApplyNexusEndpointReplicationEventResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type ListNexusEndpointsRequest to the protobuf v3 wire format
 func (val *ListNexusEndpointsRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
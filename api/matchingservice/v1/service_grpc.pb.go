@@ -53,6 +53,7 @@ const (
 	MatchingService_RespondNexusTaskCompleted_FullMethodName              = "/temporal.server.api.matchingservice.v1.MatchingService/RespondNexusTaskCompleted"
 	MatchingService_RespondNexusTaskFailed_FullMethodName                 = "/temporal.server.api.matchingservice.v1.MatchingService/RespondNexusTaskFailed"
 	MatchingService_CancelOutstandingPoll_FullMethodName                  = "/temporal.server.api.matchingservice.v1.MatchingService/CancelOutstandingPoll"
+	MatchingService_StreamPollTaskQueue_FullMethodName                    = "/temporal.server.api.matchingservice.v1.MatchingService/StreamPollTaskQueue"
 	MatchingService_DescribeTaskQueue_FullMethodName                      = "/temporal.server.api.matchingservice.v1.MatchingService/DescribeTaskQueue"
 	MatchingService_DescribeTaskQueuePartition_FullMethodName             = "/temporal.server.api.matchingservice.v1.MatchingService/DescribeTaskQueuePartition"
 	MatchingService_ListTaskQueuePartitions_FullMethodName                = "/temporal.server.api.matchingservice.v1.MatchingService/ListTaskQueuePartitions"
@@ -73,6 +74,7 @@ const (
 	MatchingService_CreateNexusEndpoint_FullMethodName                    = "/temporal.server.api.matchingservice.v1.MatchingService/CreateNexusEndpoint"
 	MatchingService_UpdateNexusEndpoint_FullMethodName                    = "/temporal.server.api.matchingservice.v1.MatchingService/UpdateNexusEndpoint"
 	MatchingService_DeleteNexusEndpoint_FullMethodName                    = "/temporal.server.api.matchingservice.v1.MatchingService/DeleteNexusEndpoint"
+	MatchingService_ApplyNexusEndpointReplicationEvent_FullMethodName     = "/temporal.server.api.matchingservice.v1.MatchingService/ApplyNexusEndpointReplicationEvent"
 	MatchingService_ListNexusEndpoints_FullMethodName                     = "/temporal.server.api.matchingservice.v1.MatchingService/ListNexusEndpoints"
 )
 
@@ -112,6 +114,12 @@ type MatchingServiceClient interface {
 	// api call to matching it passes in a pollerId and then calls this API when it detects client connection is closed
 	// to unblock long polls for this poller and prevent tasks being sent to these zombie pollers.
 	CancelOutstandingPoll(ctx context.Context, in *CancelOutstandingPollRequest, opts ...grpc.CallOption) (*CancelOutstandingPollResponse, error)
+	// StreamPollTaskQueue multiplexes many workflow and activity task polls of a task queue partition over a single
+	// stream. It is used by frontend to forward the polls of its clients without holding an rpc per poll.
+	// (-- api-linter: core::0134::method-signature=disabled
+	//
+	//	aip.dev/not-precedent: StreamPollTaskQueue RPC doesn't follow Google API format. --)
+	StreamPollTaskQueue(ctx context.Context, opts ...grpc.CallOption) (MatchingService_StreamPollTaskQueueClient, error)
 	// DescribeTaskQueue returns information about the target task queue, right now this API returns the
 	// pollers which polled this task queue in last few minutes.
 	DescribeTaskQueue(ctx context.Context, in *DescribeTaskQueueRequest, opts ...grpc.CallOption) (*DescribeTaskQueueResponse, error)
@@ -211,6 +219,9 @@ type MatchingServiceClient interface {
 	UpdateNexusEndpoint(ctx context.Context, in *UpdateNexusEndpointRequest, opts ...grpc.CallOption) (*UpdateNexusEndpointResponse, error)
 	// Delete a service by its name.
 	DeleteNexusEndpoint(ctx context.Context, in *DeleteNexusEndpointRequest, opts ...grpc.CallOption) (*DeleteNexusEndpointResponse, error)
+	// Apply a Nexus endpoint change replicated from a remote cluster. The change is ignored if the local endpoint
+	// has a more recent clock.
+	ApplyNexusEndpointReplicationEvent(ctx context.Context, in *ApplyNexusEndpointReplicationEventRequest, opts ...grpc.CallOption) (*ApplyNexusEndpointReplicationEventResponse, error)
 	// List all registered services.
 	ListNexusEndpoints(ctx context.Context, in *ListNexusEndpointsRequest, opts ...grpc.CallOption) (*ListNexusEndpointsResponse, error)
 }
@@ -322,6 +333,37 @@ func (c *matchingServiceClient) CancelOutstandingPoll(ctx context.Context, in *C
 	return out, nil
 }
 
+func (c *matchingServiceClient) StreamPollTaskQueue(ctx context.Context, opts ...grpc.CallOption) (MatchingService_StreamPollTaskQueueClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MatchingService_ServiceDesc.Streams[0], MatchingService_StreamPollTaskQueue_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &matchingServiceStreamPollTaskQueueClient{stream}
+	return x, nil
+}
+
+type MatchingService_StreamPollTaskQueueClient interface {
+	Send(*StreamPollTaskQueueRequest) error
+	Recv() (*StreamPollTaskQueueResponse, error)
+	grpc.ClientStream
+}
+
+type matchingServiceStreamPollTaskQueueClient struct {
+	grpc.ClientStream
+}
+
+func (x *matchingServiceStreamPollTaskQueueClient) Send(m *StreamPollTaskQueueRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *matchingServiceStreamPollTaskQueueClient) Recv() (*StreamPollTaskQueueResponse, error) {
+	m := new(StreamPollTaskQueueResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *matchingServiceClient) DescribeTaskQueue(ctx context.Context, in *DescribeTaskQueueRequest, opts ...grpc.CallOption) (*DescribeTaskQueueResponse, error) {
 	out := new(DescribeTaskQueueResponse)
 	err := c.cc.Invoke(ctx, MatchingService_DescribeTaskQueue_FullMethodName, in, out, opts...)
@@ -502,6 +544,15 @@ func (c *matchingServiceClient) DeleteNexusEndpoint(ctx context.Context, in *Del
 	return out, nil
 }
 
+func (c *matchingServiceClient) ApplyNexusEndpointReplicationEvent(ctx context.Context, in *ApplyNexusEndpointReplicationEventRequest, opts ...grpc.CallOption) (*ApplyNexusEndpointReplicationEventResponse, error) {
+	out := new(ApplyNexusEndpointReplicationEventResponse)
+	err := c.cc.Invoke(ctx, MatchingService_ApplyNexusEndpointReplicationEvent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *matchingServiceClient) ListNexusEndpoints(ctx context.Context, in *ListNexusEndpointsRequest, opts ...grpc.CallOption) (*ListNexusEndpointsResponse, error) {
 	out := new(ListNexusEndpointsResponse)
 	err := c.cc.Invoke(ctx, MatchingService_ListNexusEndpoints_FullMethodName, in, out, opts...)
@@ -547,6 +598,12 @@ type MatchingServiceServer interface {
 	// api call to matching it passes in a pollerId and then calls this API when it detects client connection is closed
 	// to unblock long polls for this poller and prevent tasks being sent to these zombie pollers.
 	CancelOutstandingPoll(context.Context, *CancelOutstandingPollRequest) (*CancelOutstandingPollResponse, error)
+	// StreamPollTaskQueue multiplexes many workflow and activity task polls of a task queue partition over a single
+	// stream. It is used by frontend to forward the polls of its clients without holding an rpc per poll.
+	// (-- api-linter: core::0134::method-signature=disabled
+	//
+	//	aip.dev/not-precedent: StreamPollTaskQueue RPC doesn't follow Google API format. --)
+	StreamPollTaskQueue(MatchingService_StreamPollTaskQueueServer) error
 	// DescribeTaskQueue returns information about the target task queue, right now this API returns the
 	// pollers which polled this task queue in last few minutes.
 	DescribeTaskQueue(context.Context, *DescribeTaskQueueRequest) (*DescribeTaskQueueResponse, error)
@@ -646,6 +703,9 @@ type MatchingServiceServer interface {
 	UpdateNexusEndpoint(context.Context, *UpdateNexusEndpointRequest) (*UpdateNexusEndpointResponse, error)
 	// Delete a service by its name.
 	DeleteNexusEndpoint(context.Context, *DeleteNexusEndpointRequest) (*DeleteNexusEndpointResponse, error)
+	// Apply a Nexus endpoint change replicated from a remote cluster. The change is ignored if the local endpoint
+	// has a more recent clock.
+	ApplyNexusEndpointReplicationEvent(context.Context, *ApplyNexusEndpointReplicationEventRequest) (*ApplyNexusEndpointReplicationEventResponse, error)
 	// List all registered services.
 	ListNexusEndpoints(context.Context, *ListNexusEndpointsRequest) (*ListNexusEndpointsResponse, error)
 	mustEmbedUnimplementedMatchingServiceServer()
@@ -688,6 +748,9 @@ func (UnimplementedMatchingServiceServer) RespondNexusTaskFailed(context.Context
 func (UnimplementedMatchingServiceServer) CancelOutstandingPoll(context.Context, *CancelOutstandingPollRequest) (*CancelOutstandingPollResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelOutstandingPoll not implemented")
 }
+func (UnimplementedMatchingServiceServer) StreamPollTaskQueue(MatchingService_StreamPollTaskQueueServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPollTaskQueue not implemented")
+}
 func (UnimplementedMatchingServiceServer) DescribeTaskQueue(context.Context, *DescribeTaskQueueRequest) (*DescribeTaskQueueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeTaskQueue not implemented")
 }
@@ -748,6 +811,9 @@ func (UnimplementedMatchingServiceServer) UpdateNexusEndpoint(context.Context, *
 func (UnimplementedMatchingServiceServer) DeleteNexusEndpoint(context.Context, *DeleteNexusEndpointRequest) (*DeleteNexusEndpointResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteNexusEndpoint not implemented")
 }
+func (UnimplementedMatchingServiceServer) ApplyNexusEndpointReplicationEvent(context.Context, *ApplyNexusEndpointReplicationEventRequest) (*ApplyNexusEndpointReplicationEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyNexusEndpointReplicationEvent not implemented")
+}
 func (UnimplementedMatchingServiceServer) ListNexusEndpoints(context.Context, *ListNexusEndpointsRequest) (*ListNexusEndpointsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListNexusEndpoints not implemented")
 }
@@ -962,6 +1028,32 @@ func _MatchingService_CancelOutstandingPoll_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MatchingService_StreamPollTaskQueue_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MatchingServiceServer).StreamPollTaskQueue(&matchingServiceStreamPollTaskQueueServer{stream})
+}
+
+type MatchingService_StreamPollTaskQueueServer interface {
+	Send(*StreamPollTaskQueueResponse) error
+	Recv() (*StreamPollTaskQueueRequest, error)
+	grpc.ServerStream
+}
+
+type matchingServiceStreamPollTaskQueueServer struct {
+	grpc.ServerStream
+}
+
+func (x *matchingServiceStreamPollTaskQueueServer) Send(m *StreamPollTaskQueueResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *matchingServiceStreamPollTaskQueueServer) Recv() (*StreamPollTaskQueueRequest, error) {
+	m := new(StreamPollTaskQueueRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _MatchingService_DescribeTaskQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DescribeTaskQueueRequest)
 	if err := dec(in); err != nil {
@@ -1322,6 +1414,24 @@ func _MatchingService_DeleteNexusEndpoint_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MatchingService_ApplyNexusEndpointReplicationEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyNexusEndpointReplicationEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).ApplyNexusEndpointReplicationEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_ApplyNexusEndpointReplicationEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).ApplyNexusEndpointReplicationEvent(ctx, req.(*ApplyNexusEndpointReplicationEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MatchingService_ListNexusEndpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListNexusEndpointsRequest)
 	if err := dec(in); err != nil {
@@ -1471,11 +1581,22 @@ var MatchingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteNexusEndpoint",
 			Handler:    _MatchingService_DeleteNexusEndpoint_Handler,
 		},
+		{
+			MethodName: "ApplyNexusEndpointReplicationEvent",
+			Handler:    _MatchingService_ApplyNexusEndpointReplicationEvent_Handler,
+		},
 		{
 			MethodName: "ListNexusEndpoints",
 			Handler:    _MatchingService_ListNexusEndpoints_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamPollTaskQueue",
+			Handler:       _MatchingService_StreamPollTaskQueue_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "temporal/server/api/matchingservice/v1/service.proto",
 }
@@ -40,6 +40,7 @@ import (
 	matchingservice "go.temporal.io/server/api/matchingservice/v1"
 	gomock "go.uber.org/mock/gomock"
 	grpc "google.golang.org/grpc"
+	metadata "google.golang.org/grpc/metadata"
 )
 
 // MockMatchingServiceClient is a mock of MatchingServiceClient interface.
@@ -105,6 +106,26 @@ func (mr *MockMatchingServiceClientMockRecorder) AddWorkflowTask(ctx, in any, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowTask", reflect.TypeOf((*MockMatchingServiceClient)(nil).AddWorkflowTask), varargs...)
 }
 
+// ApplyNexusEndpointReplicationEvent mocks base method.
+func (m *MockMatchingServiceClient) ApplyNexusEndpointReplicationEvent(ctx context.Context, in *matchingservice.ApplyNexusEndpointReplicationEventRequest, opts ...grpc.CallOption) (*matchingservice.ApplyNexusEndpointReplicationEventResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ApplyNexusEndpointReplicationEvent", varargs...)
+	ret0, _ := ret[0].(*matchingservice.ApplyNexusEndpointReplicationEventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyNexusEndpointReplicationEvent indicates an expected call of ApplyNexusEndpointReplicationEvent.
+func (mr *MockMatchingServiceClientMockRecorder) ApplyNexusEndpointReplicationEvent(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyNexusEndpointReplicationEvent", reflect.TypeOf((*MockMatchingServiceClient)(nil).ApplyNexusEndpointReplicationEvent), varargs...)
+}
+
 // ApplyTaskQueueUserDataReplicationEvent mocks base method.
 func (m *MockMatchingServiceClient) ApplyTaskQueueUserDataReplicationEvent(ctx context.Context, in *matchingservice.ApplyTaskQueueUserDataReplicationEventRequest, opts ...grpc.CallOption) (*matchingservice.ApplyTaskQueueUserDataReplicationEventResponse, error) {
 	m.ctrl.T.Helper()
@@ -605,6 +626,26 @@ func (mr *MockMatchingServiceClientMockRecorder) RespondQueryTaskCompleted(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondQueryTaskCompleted", reflect.TypeOf((*MockMatchingServiceClient)(nil).RespondQueryTaskCompleted), varargs...)
 }
 
+// StreamPollTaskQueue mocks base method.
+func (m *MockMatchingServiceClient) StreamPollTaskQueue(ctx context.Context, opts ...grpc.CallOption) (matchingservice.MatchingService_StreamPollTaskQueueClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamPollTaskQueue", varargs...)
+	ret0, _ := ret[0].(matchingservice.MatchingService_StreamPollTaskQueueClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamPollTaskQueue indicates an expected call of StreamPollTaskQueue.
+func (mr *MockMatchingServiceClientMockRecorder) StreamPollTaskQueue(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamPollTaskQueue", reflect.TypeOf((*MockMatchingServiceClient)(nil).StreamPollTaskQueue), varargs...)
+}
+
 // SyncDeploymentUserData mocks base method.
 func (m *MockMatchingServiceClient) SyncDeploymentUserData(ctx context.Context, in *matchingservice.SyncDeploymentUserDataRequest, opts ...grpc.CallOption) (*matchingservice.SyncDeploymentUserDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -705,6 +746,143 @@ func (mr *MockMatchingServiceClientMockRecorder) UpdateWorkerVersioningRules(ctx
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkerVersioningRules", reflect.TypeOf((*MockMatchingServiceClient)(nil).UpdateWorkerVersioningRules), varargs...)
 }
 
+// MockMatchingService_StreamPollTaskQueueClient is a mock of MatchingService_StreamPollTaskQueueClient interface.
+type MockMatchingService_StreamPollTaskQueueClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockMatchingService_StreamPollTaskQueueClientMockRecorder
+}
+
+// MockMatchingService_StreamPollTaskQueueClientMockRecorder is the mock recorder for MockMatchingService_StreamPollTaskQueueClient.
+type MockMatchingService_StreamPollTaskQueueClientMockRecorder struct {
+	mock *MockMatchingService_StreamPollTaskQueueClient
+}
+
+// NewMockMatchingService_StreamPollTaskQueueClient creates a new mock instance.
+func NewMockMatchingService_StreamPollTaskQueueClient(ctrl *gomock.Controller) *MockMatchingService_StreamPollTaskQueueClient {
+	mock := &MockMatchingService_StreamPollTaskQueueClient{ctrl: ctrl}
+	mock.recorder = &MockMatchingService_StreamPollTaskQueueClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMatchingService_StreamPollTaskQueueClient) EXPECT() *MockMatchingService_StreamPollTaskQueueClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockMatchingService_StreamPollTaskQueueClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockMatchingService_StreamPollTaskQueueClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockMatchingService_StreamPollTaskQueueClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockMatchingService_StreamPollTaskQueueClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockMatchingService_StreamPollTaskQueueClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockMatchingService_StreamPollTaskQueueClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockMatchingService_StreamPollTaskQueueClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockMatchingService_StreamPollTaskQueueClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockMatchingService_StreamPollTaskQueueClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockMatchingService_StreamPollTaskQueueClient) Recv() (*matchingservice.StreamPollTaskQueueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*matchingservice.StreamPollTaskQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockMatchingService_StreamPollTaskQueueClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockMatchingService_StreamPollTaskQueueClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockMatchingService_StreamPollTaskQueueClient) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockMatchingService_StreamPollTaskQueueClientMockRecorder) RecvMsg(m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockMatchingService_StreamPollTaskQueueClient)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockMatchingService_StreamPollTaskQueueClient) Send(arg0 *matchingservice.StreamPollTaskQueueRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockMatchingService_StreamPollTaskQueueClientMockRecorder) Send(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockMatchingService_StreamPollTaskQueueClient)(nil).Send), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockMatchingService_StreamPollTaskQueueClient) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockMatchingService_StreamPollTaskQueueClientMockRecorder) SendMsg(m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockMatchingService_StreamPollTaskQueueClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockMatchingService_StreamPollTaskQueueClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockMatchingService_StreamPollTaskQueueClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockMatchingService_StreamPollTaskQueueClient)(nil).Trailer))
+}
+
 // MockMatchingServiceServer is a mock of MatchingServiceServer interface.
 type MockMatchingServiceServer struct {
 	ctrl     *gomock.Controller
@@ -758,6 +936,21 @@ func (mr *MockMatchingServiceServerMockRecorder) AddWorkflowTask(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowTask", reflect.TypeOf((*MockMatchingServiceServer)(nil).AddWorkflowTask), arg0, arg1)
 }
 
+// ApplyNexusEndpointReplicationEvent mocks base method.
+func (m *MockMatchingServiceServer) ApplyNexusEndpointReplicationEvent(arg0 context.Context, arg1 *matchingservice.ApplyNexusEndpointReplicationEventRequest) (*matchingservice.ApplyNexusEndpointReplicationEventResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyNexusEndpointReplicationEvent", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.ApplyNexusEndpointReplicationEventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyNexusEndpointReplicationEvent indicates an expected call of ApplyNexusEndpointReplicationEvent.
+func (mr *MockMatchingServiceServerMockRecorder) ApplyNexusEndpointReplicationEvent(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyNexusEndpointReplicationEvent", reflect.TypeOf((*MockMatchingServiceServer)(nil).ApplyNexusEndpointReplicationEvent), arg0, arg1)
+}
+
 // ApplyTaskQueueUserDataReplicationEvent mocks base method.
 func (m *MockMatchingServiceServer) ApplyTaskQueueUserDataReplicationEvent(arg0 context.Context, arg1 *matchingservice.ApplyTaskQueueUserDataReplicationEventRequest) (*matchingservice.ApplyTaskQueueUserDataReplicationEventResponse, error) {
 	m.ctrl.T.Helper()
@@ -1133,6 +1326,20 @@ func (mr *MockMatchingServiceServerMockRecorder) RespondQueryTaskCompleted(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondQueryTaskCompleted", reflect.TypeOf((*MockMatchingServiceServer)(nil).RespondQueryTaskCompleted), arg0, arg1)
 }
 
+// StreamPollTaskQueue mocks base method.
+func (m *MockMatchingServiceServer) StreamPollTaskQueue(arg0 matchingservice.MatchingService_StreamPollTaskQueueServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamPollTaskQueue", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamPollTaskQueue indicates an expected call of StreamPollTaskQueue.
+func (mr *MockMatchingServiceServerMockRecorder) StreamPollTaskQueue(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamPollTaskQueue", reflect.TypeOf((*MockMatchingServiceServer)(nil).StreamPollTaskQueue), arg0)
+}
+
 // SyncDeploymentUserData mocks base method.
 func (m *MockMatchingServiceServer) SyncDeploymentUserData(arg0 context.Context, arg1 *matchingservice.SyncDeploymentUserDataRequest) (*matchingservice.SyncDeploymentUserDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -1254,3 +1461,137 @@ func (mr *MockUnsafeMatchingServiceServerMockRecorder) mustEmbedUnimplementedMat
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedMatchingServiceServer", reflect.TypeOf((*MockUnsafeMatchingServiceServer)(nil).mustEmbedUnimplementedMatchingServiceServer))
 }
+
+// MockMatchingService_StreamPollTaskQueueServer is a mock of MatchingService_StreamPollTaskQueueServer interface.
+type MockMatchingService_StreamPollTaskQueueServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockMatchingService_StreamPollTaskQueueServerMockRecorder
+}
+
+// MockMatchingService_StreamPollTaskQueueServerMockRecorder is the mock recorder for MockMatchingService_StreamPollTaskQueueServer.
+type MockMatchingService_StreamPollTaskQueueServerMockRecorder struct {
+	mock *MockMatchingService_StreamPollTaskQueueServer
+}
+
+// NewMockMatchingService_StreamPollTaskQueueServer creates a new mock instance.
+func NewMockMatchingService_StreamPollTaskQueueServer(ctrl *gomock.Controller) *MockMatchingService_StreamPollTaskQueueServer {
+	mock := &MockMatchingService_StreamPollTaskQueueServer{ctrl: ctrl}
+	mock.recorder = &MockMatchingService_StreamPollTaskQueueServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMatchingService_StreamPollTaskQueueServer) EXPECT() *MockMatchingService_StreamPollTaskQueueServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockMatchingService_StreamPollTaskQueueServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockMatchingService_StreamPollTaskQueueServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockMatchingService_StreamPollTaskQueueServer)(nil).Context))
+}
+
+// Recv mocks base method.
+func (m *MockMatchingService_StreamPollTaskQueueServer) Recv() (*matchingservice.StreamPollTaskQueueRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*matchingservice.StreamPollTaskQueueRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockMatchingService_StreamPollTaskQueueServerMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockMatchingService_StreamPollTaskQueueServer)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockMatchingService_StreamPollTaskQueueServer) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockMatchingService_StreamPollTaskQueueServerMockRecorder) RecvMsg(m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockMatchingService_StreamPollTaskQueueServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockMatchingService_StreamPollTaskQueueServer) Send(arg0 *matchingservice.StreamPollTaskQueueResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockMatchingService_StreamPollTaskQueueServerMockRecorder) Send(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockMatchingService_StreamPollTaskQueueServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockMatchingService_StreamPollTaskQueueServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockMatchingService_StreamPollTaskQueueServerMockRecorder) SendHeader(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockMatchingService_StreamPollTaskQueueServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockMatchingService_StreamPollTaskQueueServer) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockMatchingService_StreamPollTaskQueueServerMockRecorder) SendMsg(m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockMatchingService_StreamPollTaskQueueServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockMatchingService_StreamPollTaskQueueServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockMatchingService_StreamPollTaskQueueServerMockRecorder) SetHeader(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockMatchingService_StreamPollTaskQueueServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockMatchingService_StreamPollTaskQueueServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockMatchingService_StreamPollTaskQueueServerMockRecorder) SetTrailer(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockMatchingService_StreamPollTaskQueueServer)(nil).SetTrailer), arg0)
+}
@@ -103,6 +103,43 @@ func (this *WorkflowExecutionInfo) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type WorkflowHandlers to the protobuf v3 wire format
+func (val *WorkflowHandlers) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type WorkflowHandlers from the protobuf v3 wire format
+func (val *WorkflowHandlers) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *WorkflowHandlers) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two WorkflowHandlers values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *WorkflowHandlers) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *WorkflowHandlers
+	switch t := that.(type) {
+	case *WorkflowHandlers:
+		that1 = t
+	case WorkflowHandlers:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type ExecutionStats to the protobuf v3 wire format
 func (val *ExecutionStats) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -399,6 +436,43 @@ func (this *OutboundTaskInfo) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type WorkflowLifecycleEventTaskInfo to the protobuf v3 wire format
+func (val *WorkflowLifecycleEventTaskInfo) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type WorkflowLifecycleEventTaskInfo from the protobuf v3 wire format
+func (val *WorkflowLifecycleEventTaskInfo) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *WorkflowLifecycleEventTaskInfo) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two WorkflowLifecycleEventTaskInfo values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *WorkflowLifecycleEventTaskInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *WorkflowLifecycleEventTaskInfo
+	switch t := that.(type) {
+	case *WorkflowLifecycleEventTaskInfo:
+		that1 = t
+	case WorkflowLifecycleEventTaskInfo:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type NexusInvocationTaskInfo to the protobuf v3 wire format
 func (val *NexusInvocationTaskInfo) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
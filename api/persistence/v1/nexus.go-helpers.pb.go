@@ -66,6 +66,80 @@ func (this *NexusEndpointSpec) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type NexusOperationDefaults to the protobuf v3 wire format
+func (val *NexusOperationDefaults) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type NexusOperationDefaults from the protobuf v3 wire format
+func (val *NexusOperationDefaults) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *NexusOperationDefaults) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two NexusOperationDefaults values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *NexusOperationDefaults) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *NexusOperationDefaults
+	switch t := that.(type) {
+	case *NexusOperationDefaults:
+		that1 = t
+	case NexusOperationDefaults:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type NexusOutboundAuth to the protobuf v3 wire format
+func (val *NexusOutboundAuth) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type NexusOutboundAuth from the protobuf v3 wire format
+func (val *NexusOutboundAuth) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *NexusOutboundAuth) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two NexusOutboundAuth values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *NexusOutboundAuth) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *NexusOutboundAuth
+	switch t := that.(type) {
+	case *NexusOutboundAuth:
+		that1 = t
+	case NexusOutboundAuth:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type NexusEndpointTarget to the protobuf v3 wire format
 func (val *NexusEndpointTarget) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -176,3 +176,40 @@ func (this *UpdateInfo) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type PreviousRunUpdateOutcome to the protobuf v3 wire format
+func (val *PreviousRunUpdateOutcome) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PreviousRunUpdateOutcome from the protobuf v3 wire format
+func (val *PreviousRunUpdateOutcome) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PreviousRunUpdateOutcome) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PreviousRunUpdateOutcome values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PreviousRunUpdateOutcome) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PreviousRunUpdateOutcome
+	switch t := that.(type) {
+	case *PreviousRunUpdateOutcome:
+		that1 = t
+	case PreviousRunUpdateOutcome:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
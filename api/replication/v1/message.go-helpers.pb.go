@@ -547,6 +547,80 @@ func (this *TaskQueueUserDataAttributes) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type NexusEndpointAttributes to the protobuf v3 wire format
+func (val *NexusEndpointAttributes) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type NexusEndpointAttributes from the protobuf v3 wire format
+func (val *NexusEndpointAttributes) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *NexusEndpointAttributes) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two NexusEndpointAttributes values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *NexusEndpointAttributes) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *NexusEndpointAttributes
+	switch t := that.(type) {
+	case *NexusEndpointAttributes:
+		that1 = t
+	case NexusEndpointAttributes:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type WriteIntentAttributes to the protobuf v3 wire format
+func (val *WriteIntentAttributes) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type WriteIntentAttributes from the protobuf v3 wire format
+func (val *WriteIntentAttributes) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *WriteIntentAttributes) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two WriteIntentAttributes values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *WriteIntentAttributes) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *WriteIntentAttributes
+	switch t := that.(type) {
+	case *WriteIntentAttributes:
+		that1 = t
+	case WriteIntentAttributes:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type SyncHSMAttributes to the protobuf v3 wire format
 func (val *SyncHSMAttributes) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -103,6 +103,154 @@ func (this *InternalState) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type BackfillProgress to the protobuf v3 wire format
+func (val *BackfillProgress) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type BackfillProgress from the protobuf v3 wire format
+func (val *BackfillProgress) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *BackfillProgress) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two BackfillProgress values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *BackfillProgress) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *BackfillProgress
+	switch t := that.(type) {
+	case *BackfillProgress:
+		that1 = t
+	case BackfillProgress:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ScheduleBackfills to the protobuf v3 wire format
+func (val *ScheduleBackfills) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ScheduleBackfills from the protobuf v3 wire format
+func (val *ScheduleBackfills) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ScheduleBackfills) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ScheduleBackfills values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ScheduleBackfills) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ScheduleBackfills
+	switch t := that.(type) {
+	case *ScheduleBackfills:
+		that1 = t
+	case ScheduleBackfills:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type CancelBackfillRequest to the protobuf v3 wire format
+func (val *CancelBackfillRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CancelBackfillRequest from the protobuf v3 wire format
+func (val *CancelBackfillRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CancelBackfillRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CancelBackfillRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CancelBackfillRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CancelBackfillRequest
+	switch t := that.(type) {
+	case *CancelBackfillRequest:
+		that1 = t
+	case CancelBackfillRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ServerSchedulePolicies to the protobuf v3 wire format
+func (val *ServerSchedulePolicies) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ServerSchedulePolicies from the protobuf v3 wire format
+func (val *ServerSchedulePolicies) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ServerSchedulePolicies) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ServerSchedulePolicies values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ServerSchedulePolicies) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ServerSchedulePolicies
+	switch t := that.(type) {
+	case *ServerSchedulePolicies:
+		that1 = t
+	case ServerSchedulePolicies:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type StartScheduleArgs to the protobuf v3 wire format
 func (val *StartScheduleArgs) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -472,3 +620,40 @@ func (this *NextTimeCache) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type NotifyAutoPauseRequest to the protobuf v3 wire format
+func (val *NotifyAutoPauseRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type NotifyAutoPauseRequest from the protobuf v3 wire format
+func (val *NotifyAutoPauseRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *NotifyAutoPauseRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two NotifyAutoPauseRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *NotifyAutoPauseRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *NotifyAutoPauseRequest
+	switch t := that.(type) {
+	case *NotifyAutoPauseRequest:
+		that1 = t
+	case NotifyAutoPauseRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
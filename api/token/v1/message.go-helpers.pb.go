@@ -103,6 +103,43 @@ func (this *RawHistoryContinuation) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type ExportWorkflowExecutionsContinuation to the protobuf v3 wire format
+func (val *ExportWorkflowExecutionsContinuation) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ExportWorkflowExecutionsContinuation from the protobuf v3 wire format
+func (val *ExportWorkflowExecutionsContinuation) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ExportWorkflowExecutionsContinuation) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ExportWorkflowExecutionsContinuation values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ExportWorkflowExecutionsContinuation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ExportWorkflowExecutionsContinuation
+	switch t := that.(type) {
+	case *ExportWorkflowExecutionsContinuation:
+		that1 = t
+	case ExportWorkflowExecutionsContinuation:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type Task to the protobuf v3 wire format
 func (val *Task) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -73,6 +73,26 @@ func (c *clientImpl) CancelDLQJob(
 	return c.client.CancelDLQJob(ctx, request, opts...)
 }
 
+func (c *clientImpl) CancelScheduleBackfill(
+	ctx context.Context,
+	request *adminservice.CancelScheduleBackfillRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CancelScheduleBackfillResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.CancelScheduleBackfill(ctx, request, opts...)
+}
+
+func (c *clientImpl) CaptureProfile(
+	ctx context.Context,
+	request *adminservice.CaptureProfileRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CaptureProfileResponse, error) {
+	ctx, cancel := c.createContextWithLargeTimeout(ctx)
+	defer cancel()
+	return c.client.CaptureProfile(ctx, request, opts...)
+}
+
 func (c *clientImpl) CloseShard(
 	ctx context.Context,
 	request *adminservice.CloseShardRequest,
@@ -103,6 +123,16 @@ func (c *clientImpl) DeleteWorkflowExecution(
 	return c.client.DeleteWorkflowExecution(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeArchivalStatus(
+	ctx context.Context,
+	request *adminservice.DescribeArchivalStatusRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeArchivalStatusResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeArchivalStatus(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeCluster(
 	ctx context.Context,
 	request *adminservice.DescribeClusterRequest,
@@ -143,6 +173,26 @@ func (c *clientImpl) DescribeMutableState(
 	return c.client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeReplicationLag(
+	ctx context.Context,
+	request *adminservice.DescribeReplicationLagRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeReplicationLagResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeReplicationLag(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeShardOwnership(
+	ctx context.Context,
+	request *adminservice.DescribeShardOwnershipRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeShardOwnershipResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeShardOwnership(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.DescribeTaskQueuePartitionRequest,
@@ -153,6 +203,26 @@ func (c *clientImpl) DescribeTaskQueuePartition(
 	return c.client.DescribeTaskQueuePartition(ctx, request, opts...)
 }
 
+func (c *clientImpl) ExportNamespaceConfig(
+	ctx context.Context,
+	request *adminservice.ExportNamespaceConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ExportNamespaceConfigResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ExportNamespaceConfig(ctx, request, opts...)
+}
+
+func (c *clientImpl) ExportWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.ExportWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ExportWorkflowExecutionsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ExportWorkflowExecutions(ctx, request, opts...)
+}
+
 func (c *clientImpl) ForceUnloadTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.ForceUnloadTaskQueuePartitionRequest,
@@ -203,6 +273,26 @@ func (c *clientImpl) GetDLQTasks(
 	return c.client.GetDLQTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetDebugTrace(
+	ctx context.Context,
+	request *adminservice.GetDebugTraceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetDebugTraceResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetDebugTrace(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetDynamicConfigResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetDynamicConfig(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetNamespace(
 	ctx context.Context,
 	request *adminservice.GetNamespaceRequest,
@@ -283,6 +373,16 @@ func (c *clientImpl) GetWorkflowExecutionRawHistoryV2(
 	return c.client.GetWorkflowExecutionRawHistoryV2(ctx, request, opts...)
 }
 
+func (c *clientImpl) ImportNamespaceConfig(
+	ctx context.Context,
+	request *adminservice.ImportNamespaceConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ImportNamespaceConfigResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ImportNamespaceConfig(ctx, request, opts...)
+}
+
 func (c *clientImpl) ImportWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.ImportWorkflowExecutionRequest,
@@ -323,6 +423,36 @@ func (c *clientImpl) ListHistoryTasks(
 	return c.client.ListHistoryTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListHotspots(
+	ctx context.Context,
+	request *adminservice.ListHotspotsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListHotspotsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListHotspots(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListNexusEndpointHealth(
+	ctx context.Context,
+	request *adminservice.ListNexusEndpointHealthRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListNexusEndpointHealthResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListNexusEndpointHealth(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListPendingNexusOperations(
+	ctx context.Context,
+	request *adminservice.ListPendingNexusOperationsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListPendingNexusOperationsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListPendingNexusOperations(ctx, request, opts...)
+}
+
 func (c *clientImpl) ListQueues(
 	ctx context.Context,
 	request *adminservice.ListQueuesRequest,
@@ -353,6 +483,36 @@ func (c *clientImpl) MergeDLQTasks(
 	return c.client.MergeDLQTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) MoveShards(
+	ctx context.Context,
+	request *adminservice.MoveShardsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.MoveShardsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.MoveShards(ctx, request, opts...)
+}
+
+func (c *clientImpl) MultiSignalWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.MultiSignalWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.MultiSignalWorkflowExecutionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.MultiSignalWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) PauseActivityType(
+	ctx context.Context,
+	request *adminservice.PauseActivityTypeRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PauseActivityTypeResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.PauseActivityType(ctx, request, opts...)
+}
+
 func (c *clientImpl) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -373,6 +533,16 @@ func (c *clientImpl) PurgeDLQTasks(
 	return c.client.PurgeDLQTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) ReArchiveWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.ReArchiveWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ReArchiveWorkflowExecutionsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ReArchiveWorkflowExecutions(ctx, request, opts...)
+}
+
 func (c *clientImpl) ReapplyEvents(
 	ctx context.Context,
 	request *adminservice.ReapplyEventsRequest,
@@ -433,6 +603,16 @@ func (c *clientImpl) RemoveTask(
 	return c.client.RemoveTask(ctx, request, opts...)
 }
 
+func (c *clientImpl) ReplicateNexusEndpoints(
+	ctx context.Context,
+	request *adminservice.ReplicateNexusEndpointsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ReplicateNexusEndpointsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ReplicateNexusEndpoints(ctx, request, opts...)
+}
+
 func (c *clientImpl) ResendReplicationTasks(
 	ctx context.Context,
 	request *adminservice.ResendReplicationTasksRequest,
@@ -443,6 +623,56 @@ func (c *clientImpl) ResendReplicationTasks(
 	return c.client.ResendReplicationTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) SetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetDynamicConfigResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.SetDynamicConfig(ctx, request, opts...)
+}
+
+func (c *clientImpl) SignalWorkflowExecutionsByQuery(
+	ctx context.Context,
+	request *adminservice.SignalWorkflowExecutionsByQueryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SignalWorkflowExecutionsByQueryResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.SignalWorkflowExecutionsByQuery(ctx, request, opts...)
+}
+
+func (c *clientImpl) StartRebuildMutableStateBatchOperation(
+	ctx context.Context,
+	request *adminservice.StartRebuildMutableStateBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartRebuildMutableStateBatchOperationResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.StartRebuildMutableStateBatchOperation(ctx, request, opts...)
+}
+
+func (c *clientImpl) StartRefreshWorkflowTasksBatchOperation(
+	ctx context.Context,
+	request *adminservice.StartRefreshWorkflowTasksBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartRefreshWorkflowTasksBatchOperationResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.StartRefreshWorkflowTasksBatchOperation(ctx, request, opts...)
+}
+
+func (c *clientImpl) StartSignalWithStartBatchOperation(
+	ctx context.Context,
+	request *adminservice.StartSignalWithStartBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartSignalWithStartBatchOperationResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.StartSignalWithStartBatchOperation(ctx, request, opts...)
+}
+
 func (c *clientImpl) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
@@ -452,3 +682,53 @@ func (c *clientImpl) SyncWorkflowState(
 	defer cancel()
 	return c.client.SyncWorkflowState(ctx, request, opts...)
 }
+
+func (c *clientImpl) UnpauseActivityType(
+	ctx context.Context,
+	request *adminservice.UnpauseActivityTypeRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UnpauseActivityTypeResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UnpauseActivityType(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateClusterMetadata(
+	ctx context.Context,
+	request *adminservice.UpdateClusterMetadataRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateClusterMetadataResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateClusterMetadata(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateNexusEndpointOperationDefaults(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointOperationDefaultsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateNexusEndpointOperationDefaultsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateNexusEndpointOperationDefaults(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateNexusEndpointOutboundAuth(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointOutboundAuthRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateNexusEndpointOutboundAuthResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateNexusEndpointOutboundAuth(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateSchedulePolicies(
+	ctx context.Context,
+	request *adminservice.UpdateSchedulePoliciesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateSchedulePoliciesResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateSchedulePolicies(ctx, request, opts...)
+}
@@ -89,6 +89,34 @@ func (c *metricClient) CancelDLQJob(
 	return c.client.CancelDLQJob(ctx, request, opts...)
 }
 
+func (c *metricClient) CancelScheduleBackfill(
+	ctx context.Context,
+	request *adminservice.CancelScheduleBackfillRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.CancelScheduleBackfillResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientCancelScheduleBackfill")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.CancelScheduleBackfill(ctx, request, opts...)
+}
+
+func (c *metricClient) CaptureProfile(
+	ctx context.Context,
+	request *adminservice.CaptureProfileRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.CaptureProfileResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientCaptureProfile")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.CaptureProfile(ctx, request, opts...)
+}
+
 func (c *metricClient) CloseShard(
 	ctx context.Context,
 	request *adminservice.CloseShardRequest,
@@ -131,6 +159,20 @@ func (c *metricClient) DeleteWorkflowExecution(
 	return c.client.DeleteWorkflowExecution(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeArchivalStatus(
+	ctx context.Context,
+	request *adminservice.DescribeArchivalStatusRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeArchivalStatusResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeArchivalStatus")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeArchivalStatus(ctx, request, opts...)
+}
+
 func (c *metricClient) DescribeCluster(
 	ctx context.Context,
 	request *adminservice.DescribeClusterRequest,
@@ -187,6 +229,34 @@ func (c *metricClient) DescribeMutableState(
 	return c.client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeReplicationLag(
+	ctx context.Context,
+	request *adminservice.DescribeReplicationLagRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeReplicationLagResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeReplicationLag")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeReplicationLag(ctx, request, opts...)
+}
+
+func (c *metricClient) DescribeShardOwnership(
+	ctx context.Context,
+	request *adminservice.DescribeShardOwnershipRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeShardOwnershipResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeShardOwnership")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeShardOwnership(ctx, request, opts...)
+}
+
 func (c *metricClient) DescribeTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.DescribeTaskQueuePartitionRequest,
@@ -201,6 +271,34 @@ func (c *metricClient) DescribeTaskQueuePartition(
 	return c.client.DescribeTaskQueuePartition(ctx, request, opts...)
 }
 
+func (c *metricClient) ExportNamespaceConfig(
+	ctx context.Context,
+	request *adminservice.ExportNamespaceConfigRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ExportNamespaceConfigResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientExportNamespaceConfig")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ExportNamespaceConfig(ctx, request, opts...)
+}
+
+func (c *metricClient) ExportWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.ExportWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ExportWorkflowExecutionsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientExportWorkflowExecutions")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ExportWorkflowExecutions(ctx, request, opts...)
+}
+
 func (c *metricClient) ForceUnloadTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.ForceUnloadTaskQueuePartitionRequest,
@@ -271,6 +369,34 @@ func (c *metricClient) GetDLQTasks(
 	return c.client.GetDLQTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) GetDebugTrace(
+	ctx context.Context,
+	request *adminservice.GetDebugTraceRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.GetDebugTraceResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientGetDebugTrace")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetDebugTrace(ctx, request, opts...)
+}
+
+func (c *metricClient) GetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.GetDynamicConfigResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientGetDynamicConfig")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetDynamicConfig(ctx, request, opts...)
+}
+
 func (c *metricClient) GetNamespace(
 	ctx context.Context,
 	request *adminservice.GetNamespaceRequest,
@@ -383,6 +509,20 @@ func (c *metricClient) GetWorkflowExecutionRawHistoryV2(
 	return c.client.GetWorkflowExecutionRawHistoryV2(ctx, request, opts...)
 }
 
+func (c *metricClient) ImportNamespaceConfig(
+	ctx context.Context,
+	request *adminservice.ImportNamespaceConfigRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ImportNamespaceConfigResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientImportNamespaceConfig")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ImportNamespaceConfig(ctx, request, opts...)
+}
+
 func (c *metricClient) ImportWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.ImportWorkflowExecutionRequest,
@@ -439,6 +579,48 @@ func (c *metricClient) ListHistoryTasks(
 	return c.client.ListHistoryTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) ListHotspots(
+	ctx context.Context,
+	request *adminservice.ListHotspotsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListHotspotsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientListHotspots")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListHotspots(ctx, request, opts...)
+}
+
+func (c *metricClient) ListNexusEndpointHealth(
+	ctx context.Context,
+	request *adminservice.ListNexusEndpointHealthRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListNexusEndpointHealthResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientListNexusEndpointHealth")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListNexusEndpointHealth(ctx, request, opts...)
+}
+
+func (c *metricClient) ListPendingNexusOperations(
+	ctx context.Context,
+	request *adminservice.ListPendingNexusOperationsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListPendingNexusOperationsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientListPendingNexusOperations")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListPendingNexusOperations(ctx, request, opts...)
+}
+
 func (c *metricClient) ListQueues(
 	ctx context.Context,
 	request *adminservice.ListQueuesRequest,
@@ -481,6 +663,48 @@ func (c *metricClient) MergeDLQTasks(
 	return c.client.MergeDLQTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) MoveShards(
+	ctx context.Context,
+	request *adminservice.MoveShardsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.MoveShardsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientMoveShards")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.MoveShards(ctx, request, opts...)
+}
+
+func (c *metricClient) MultiSignalWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.MultiSignalWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.MultiSignalWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientMultiSignalWorkflowExecution")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.MultiSignalWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *metricClient) PauseActivityType(
+	ctx context.Context,
+	request *adminservice.PauseActivityTypeRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.PauseActivityTypeResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientPauseActivityType")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.PauseActivityType(ctx, request, opts...)
+}
+
 func (c *metricClient) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -509,6 +733,20 @@ func (c *metricClient) PurgeDLQTasks(
 	return c.client.PurgeDLQTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) ReArchiveWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.ReArchiveWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ReArchiveWorkflowExecutionsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientReArchiveWorkflowExecutions")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ReArchiveWorkflowExecutions(ctx, request, opts...)
+}
+
 func (c *metricClient) ReapplyEvents(
 	ctx context.Context,
 	request *adminservice.ReapplyEventsRequest,
@@ -593,6 +831,20 @@ func (c *metricClient) RemoveTask(
 	return c.client.RemoveTask(ctx, request, opts...)
 }
 
+func (c *metricClient) ReplicateNexusEndpoints(
+	ctx context.Context,
+	request *adminservice.ReplicateNexusEndpointsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ReplicateNexusEndpointsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientReplicateNexusEndpoints")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ReplicateNexusEndpoints(ctx, request, opts...)
+}
+
 func (c *metricClient) ResendReplicationTasks(
 	ctx context.Context,
 	request *adminservice.ResendReplicationTasksRequest,
@@ -607,6 +859,76 @@ func (c *metricClient) ResendReplicationTasks(
 	return c.client.ResendReplicationTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) SetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.SetDynamicConfigResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientSetDynamicConfig")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.SetDynamicConfig(ctx, request, opts...)
+}
+
+func (c *metricClient) SignalWorkflowExecutionsByQuery(
+	ctx context.Context,
+	request *adminservice.SignalWorkflowExecutionsByQueryRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.SignalWorkflowExecutionsByQueryResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientSignalWorkflowExecutionsByQuery")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.SignalWorkflowExecutionsByQuery(ctx, request, opts...)
+}
+
+func (c *metricClient) StartRebuildMutableStateBatchOperation(
+	ctx context.Context,
+	request *adminservice.StartRebuildMutableStateBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.StartRebuildMutableStateBatchOperationResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientStartRebuildMutableStateBatchOperation")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.StartRebuildMutableStateBatchOperation(ctx, request, opts...)
+}
+
+func (c *metricClient) StartRefreshWorkflowTasksBatchOperation(
+	ctx context.Context,
+	request *adminservice.StartRefreshWorkflowTasksBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.StartRefreshWorkflowTasksBatchOperationResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientStartRefreshWorkflowTasksBatchOperation")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.StartRefreshWorkflowTasksBatchOperation(ctx, request, opts...)
+}
+
+func (c *metricClient) StartSignalWithStartBatchOperation(
+	ctx context.Context,
+	request *adminservice.StartSignalWithStartBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.StartSignalWithStartBatchOperationResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientStartSignalWithStartBatchOperation")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.StartSignalWithStartBatchOperation(ctx, request, opts...)
+}
+
 func (c *metricClient) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
@@ -620,3 +942,73 @@ func (c *metricClient) SyncWorkflowState(
 
 	return c.client.SyncWorkflowState(ctx, request, opts...)
 }
+
+func (c *metricClient) UnpauseActivityType(
+	ctx context.Context,
+	request *adminservice.UnpauseActivityTypeRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UnpauseActivityTypeResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientUnpauseActivityType")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UnpauseActivityType(ctx, request, opts...)
+}
+
+func (c *metricClient) UpdateClusterMetadata(
+	ctx context.Context,
+	request *adminservice.UpdateClusterMetadataRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UpdateClusterMetadataResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientUpdateClusterMetadata")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateClusterMetadata(ctx, request, opts...)
+}
+
+func (c *metricClient) UpdateNexusEndpointOperationDefaults(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointOperationDefaultsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UpdateNexusEndpointOperationDefaultsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientUpdateNexusEndpointOperationDefaults")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateNexusEndpointOperationDefaults(ctx, request, opts...)
+}
+
+func (c *metricClient) UpdateNexusEndpointOutboundAuth(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointOutboundAuthRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UpdateNexusEndpointOutboundAuthResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientUpdateNexusEndpointOutboundAuth")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateNexusEndpointOutboundAuth(ctx, request, opts...)
+}
+
+func (c *metricClient) UpdateSchedulePolicies(
+	ctx context.Context,
+	request *adminservice.UpdateSchedulePoliciesRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UpdateSchedulePoliciesResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientUpdateSchedulePolicies")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateSchedulePolicies(ctx, request, opts...)
+}
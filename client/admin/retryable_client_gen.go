@@ -95,6 +95,36 @@ func (c *retryableClient) CancelDLQJob(
 	return resp, err
 }
 
+func (c *retryableClient) CancelScheduleBackfill(
+	ctx context.Context,
+	request *adminservice.CancelScheduleBackfillRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CancelScheduleBackfillResponse, error) {
+	var resp *adminservice.CancelScheduleBackfillResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.CancelScheduleBackfill(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) CaptureProfile(
+	ctx context.Context,
+	request *adminservice.CaptureProfileRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CaptureProfileResponse, error) {
+	var resp *adminservice.CaptureProfileResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.CaptureProfile(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) CloseShard(
 	ctx context.Context,
 	request *adminservice.CloseShardRequest,
@@ -140,6 +170,21 @@ func (c *retryableClient) DeleteWorkflowExecution(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeArchivalStatus(
+	ctx context.Context,
+	request *adminservice.DescribeArchivalStatusRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeArchivalStatusResponse, error) {
+	var resp *adminservice.DescribeArchivalStatusResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeArchivalStatus(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeCluster(
 	ctx context.Context,
 	request *adminservice.DescribeClusterRequest,
@@ -200,6 +245,36 @@ func (c *retryableClient) DescribeMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeReplicationLag(
+	ctx context.Context,
+	request *adminservice.DescribeReplicationLagRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeReplicationLagResponse, error) {
+	var resp *adminservice.DescribeReplicationLagResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeReplicationLag(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeShardOwnership(
+	ctx context.Context,
+	request *adminservice.DescribeShardOwnershipRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeShardOwnershipResponse, error) {
+	var resp *adminservice.DescribeShardOwnershipResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeShardOwnership(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.DescribeTaskQueuePartitionRequest,
@@ -215,6 +290,36 @@ func (c *retryableClient) DescribeTaskQueuePartition(
 	return resp, err
 }
 
+func (c *retryableClient) ExportNamespaceConfig(
+	ctx context.Context,
+	request *adminservice.ExportNamespaceConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ExportNamespaceConfigResponse, error) {
+	var resp *adminservice.ExportNamespaceConfigResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ExportNamespaceConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ExportWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.ExportWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ExportWorkflowExecutionsResponse, error) {
+	var resp *adminservice.ExportWorkflowExecutionsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ExportWorkflowExecutions(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ForceUnloadTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.ForceUnloadTaskQueuePartitionRequest,
@@ -290,6 +395,36 @@ func (c *retryableClient) GetDLQTasks(
 	return resp, err
 }
 
+func (c *retryableClient) GetDebugTrace(
+	ctx context.Context,
+	request *adminservice.GetDebugTraceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetDebugTraceResponse, error) {
+	var resp *adminservice.GetDebugTraceResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetDebugTrace(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetDynamicConfigResponse, error) {
+	var resp *adminservice.GetDynamicConfigResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetDynamicConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetNamespace(
 	ctx context.Context,
 	request *adminservice.GetNamespaceRequest,
@@ -410,6 +545,21 @@ func (c *retryableClient) GetWorkflowExecutionRawHistoryV2(
 	return resp, err
 }
 
+func (c *retryableClient) ImportNamespaceConfig(
+	ctx context.Context,
+	request *adminservice.ImportNamespaceConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ImportNamespaceConfigResponse, error) {
+	var resp *adminservice.ImportNamespaceConfigResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ImportNamespaceConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ImportWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.ImportWorkflowExecutionRequest,
@@ -470,6 +620,51 @@ func (c *retryableClient) ListHistoryTasks(
 	return resp, err
 }
 
+func (c *retryableClient) ListHotspots(
+	ctx context.Context,
+	request *adminservice.ListHotspotsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListHotspotsResponse, error) {
+	var resp *adminservice.ListHotspotsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListHotspots(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListNexusEndpointHealth(
+	ctx context.Context,
+	request *adminservice.ListNexusEndpointHealthRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListNexusEndpointHealthResponse, error) {
+	var resp *adminservice.ListNexusEndpointHealthResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListNexusEndpointHealth(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListPendingNexusOperations(
+	ctx context.Context,
+	request *adminservice.ListPendingNexusOperationsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListPendingNexusOperationsResponse, error) {
+	var resp *adminservice.ListPendingNexusOperationsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListPendingNexusOperations(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ListQueues(
 	ctx context.Context,
 	request *adminservice.ListQueuesRequest,
@@ -515,6 +710,51 @@ func (c *retryableClient) MergeDLQTasks(
 	return resp, err
 }
 
+func (c *retryableClient) MoveShards(
+	ctx context.Context,
+	request *adminservice.MoveShardsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.MoveShardsResponse, error) {
+	var resp *adminservice.MoveShardsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.MoveShards(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) MultiSignalWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.MultiSignalWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.MultiSignalWorkflowExecutionResponse, error) {
+	var resp *adminservice.MultiSignalWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.MultiSignalWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) PauseActivityType(
+	ctx context.Context,
+	request *adminservice.PauseActivityTypeRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PauseActivityTypeResponse, error) {
+	var resp *adminservice.PauseActivityTypeResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.PauseActivityType(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -545,6 +785,21 @@ func (c *retryableClient) PurgeDLQTasks(
 	return resp, err
 }
 
+func (c *retryableClient) ReArchiveWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.ReArchiveWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ReArchiveWorkflowExecutionsResponse, error) {
+	var resp *adminservice.ReArchiveWorkflowExecutionsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ReArchiveWorkflowExecutions(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ReapplyEvents(
 	ctx context.Context,
 	request *adminservice.ReapplyEventsRequest,
@@ -635,6 +890,21 @@ func (c *retryableClient) RemoveTask(
 	return resp, err
 }
 
+func (c *retryableClient) ReplicateNexusEndpoints(
+	ctx context.Context,
+	request *adminservice.ReplicateNexusEndpointsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ReplicateNexusEndpointsResponse, error) {
+	var resp *adminservice.ReplicateNexusEndpointsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ReplicateNexusEndpoints(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ResendReplicationTasks(
 	ctx context.Context,
 	request *adminservice.ResendReplicationTasksRequest,
@@ -650,6 +920,81 @@ func (c *retryableClient) ResendReplicationTasks(
 	return resp, err
 }
 
+func (c *retryableClient) SetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetDynamicConfigResponse, error) {
+	var resp *adminservice.SetDynamicConfigResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.SetDynamicConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) SignalWorkflowExecutionsByQuery(
+	ctx context.Context,
+	request *adminservice.SignalWorkflowExecutionsByQueryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SignalWorkflowExecutionsByQueryResponse, error) {
+	var resp *adminservice.SignalWorkflowExecutionsByQueryResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.SignalWorkflowExecutionsByQuery(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) StartRebuildMutableStateBatchOperation(
+	ctx context.Context,
+	request *adminservice.StartRebuildMutableStateBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartRebuildMutableStateBatchOperationResponse, error) {
+	var resp *adminservice.StartRebuildMutableStateBatchOperationResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.StartRebuildMutableStateBatchOperation(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) StartRefreshWorkflowTasksBatchOperation(
+	ctx context.Context,
+	request *adminservice.StartRefreshWorkflowTasksBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartRefreshWorkflowTasksBatchOperationResponse, error) {
+	var resp *adminservice.StartRefreshWorkflowTasksBatchOperationResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.StartRefreshWorkflowTasksBatchOperation(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) StartSignalWithStartBatchOperation(
+	ctx context.Context,
+	request *adminservice.StartSignalWithStartBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartSignalWithStartBatchOperationResponse, error) {
+	var resp *adminservice.StartSignalWithStartBatchOperationResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.StartSignalWithStartBatchOperation(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
@@ -664,3 +1009,78 @@ func (c *retryableClient) SyncWorkflowState(
 	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) UnpauseActivityType(
+	ctx context.Context,
+	request *adminservice.UnpauseActivityTypeRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UnpauseActivityTypeResponse, error) {
+	var resp *adminservice.UnpauseActivityTypeResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UnpauseActivityType(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateClusterMetadata(
+	ctx context.Context,
+	request *adminservice.UpdateClusterMetadataRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateClusterMetadataResponse, error) {
+	var resp *adminservice.UpdateClusterMetadataResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateClusterMetadata(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateNexusEndpointOperationDefaults(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointOperationDefaultsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateNexusEndpointOperationDefaultsResponse, error) {
+	var resp *adminservice.UpdateNexusEndpointOperationDefaultsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateNexusEndpointOperationDefaults(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateNexusEndpointOutboundAuth(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointOutboundAuthRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateNexusEndpointOutboundAuthResponse, error) {
+	var resp *adminservice.UpdateNexusEndpointOutboundAuthResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateNexusEndpointOutboundAuth(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateSchedulePolicies(
+	ctx context.Context,
+	request *adminservice.UpdateSchedulePoliciesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateSchedulePoliciesResponse, error) {
+	var resp *adminservice.UpdateSchedulePoliciesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateSchedulePolicies(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
@@ -126,7 +126,7 @@ func (cf *rpcClientFactory) NewHistoryClientWithTimeout(timeout time.Duration) (
 
 	client := history.NewClient(
 		cf.dynConfig,
-		resolver,
+		membership.NewShardOwnershipOverrideResolver(resolver, cf.dynConfig),
 		cf.logger,
 		cf.numberOfHistoryShards,
 		cf.rpcFactory,
@@ -160,6 +160,7 @@ func (cf *rpcClientFactory) NewMatchingClientWithTimeout(
 		cf.metricsHandler,
 		cf.logger,
 		matching.NewLoadBalancer(namespaceIDToName, cf.dynConfig),
+		dynamicconfig.MatchingMultiplexPolls.Get(cf.dynConfig),
 	)
 
 	if cf.metricsHandler != nil {
@@ -100,6 +100,10 @@ func (c *clientImpl) DeepHealthCheck(ctx context.Context, request *historyservic
 	return c.connections.getOrCreateClientConn(rpcAddress(request.GetHostAddress())).historyClient.DeepHealthCheck(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListNexusEndpointHealth(ctx context.Context, request *historyservice.ListNexusEndpointHealthRequest, opts ...grpc.CallOption) (*historyservice.ListNexusEndpointHealthResponse, error) {
+	return c.connections.getOrCreateClientConn(rpcAddress(request.GetHostAddress())).historyClient.ListNexusEndpointHealth(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeHistoryHost(
 	ctx context.Context,
 	request *historyservice.DescribeHistoryHostRequest,
@@ -578,6 +578,26 @@ func (c *clientImpl) MergeDLQMessages(
 	return response, nil
 }
 
+func (c *clientImpl) MultiSignalWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.MultiSignalWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.MultiSignalWorkflowExecutionResponse, error) {
+	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetWorkflowExecution().GetWorkflowId())
+	var response *historyservice.MultiSignalWorkflowExecutionResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.MultiSignalWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) PauseActivity(
 	ctx context.Context,
 	request *historyservice.PauseActivityRequest,
@@ -425,6 +425,20 @@ func (c *metricClient) IsWorkflowTaskValid(
 	return c.client.IsWorkflowTaskValid(ctx, request, opts...)
 }
 
+func (c *metricClient) ListNexusEndpointHealth(
+	ctx context.Context,
+	request *historyservice.ListNexusEndpointHealthRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.ListNexusEndpointHealthResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientListNexusEndpointHealth")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListNexusEndpointHealth(ctx, request, opts...)
+}
+
 func (c *metricClient) ListQueues(
 	ctx context.Context,
 	request *historyservice.ListQueuesRequest,
@@ -467,6 +481,20 @@ func (c *metricClient) MergeDLQMessages(
 	return c.client.MergeDLQMessages(ctx, request, opts...)
 }
 
+func (c *metricClient) MultiSignalWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.MultiSignalWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.MultiSignalWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientMultiSignalWorkflowExecution")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.MultiSignalWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *metricClient) PauseActivity(
 	ctx context.Context,
 	request *historyservice.PauseActivityRequest,
@@ -455,6 +455,21 @@ func (c *retryableClient) IsWorkflowTaskValid(
 	return resp, err
 }
 
+func (c *retryableClient) ListNexusEndpointHealth(
+	ctx context.Context,
+	request *historyservice.ListNexusEndpointHealthRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ListNexusEndpointHealthResponse, error) {
+	var resp *historyservice.ListNexusEndpointHealthResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListNexusEndpointHealth(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ListQueues(
 	ctx context.Context,
 	request *historyservice.ListQueuesRequest,
@@ -500,6 +515,21 @@ func (c *retryableClient) MergeDLQMessages(
 	return resp, err
 }
 
+func (c *retryableClient) MultiSignalWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.MultiSignalWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.MultiSignalWorkflowExecutionResponse, error) {
+	var resp *historyservice.MultiSignalWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.MultiSignalWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) PauseActivity(
 	ctx context.Context,
 	request *historyservice.PauseActivityRequest,
@@ -32,10 +32,12 @@ import (
 	"time"
 
 	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/debug"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
@@ -59,6 +61,8 @@ type clientImpl struct {
 	metricsHandler  metrics.Handler
 	logger          log.Logger
 	loadBalancer    LoadBalancer
+	multiplexPolls  dynamicconfig.BoolPropertyFn
+	pollStreams     *pollStreams
 }
 
 // NewClient creates a new history service gRPC client
@@ -69,6 +73,7 @@ func NewClient(
 	metricsHandler metrics.Handler,
 	logger log.Logger,
 	lb LoadBalancer,
+	multiplexPolls dynamicconfig.BoolPropertyFn,
 ) matchingservice.MatchingServiceClient {
 	return &clientImpl{
 		timeout:         timeout,
@@ -77,6 +82,8 @@ func NewClient(
 		metricsHandler:  metricsHandler,
 		logger:          logger,
 		loadBalancer:    lb,
+		multiplexPolls:  multiplexPolls,
+		pollStreams:     newPollStreams(),
 	}
 }
 
@@ -118,7 +125,7 @@ func (c *clientImpl) PollActivityTaskQueue(
 	ctx context.Context,
 	request *matchingservice.PollActivityTaskQueueRequest,
 	opts ...grpc.CallOption) (*matchingservice.PollActivityTaskQueueResponse, error) {
-	client, release, err := c.pickClientForRead(
+	client, partition, release, err := c.pickClientForRead(
 		request.GetPollRequest().GetTaskQueue(),
 		request.GetNamespaceId(),
 		enumspb.TASK_QUEUE_TYPE_ACTIVITY,
@@ -131,6 +138,12 @@ func (c *clientImpl) PollActivityTaskQueue(
 	}
 	ctx, cancel := c.createLongPollContext(ctx)
 	defer cancel()
+	if c.shouldMultiplexPoll(partition) {
+		resp, err := c.pollStreams.poll(ctx, partition.Key(), client, &matchingservice.StreamPollTaskQueueRequest{
+			Request: &matchingservice.StreamPollTaskQueueRequest_PollActivityTaskQueue{PollActivityTaskQueue: request},
+		})
+		return resp.GetPollActivityTaskQueue(), err
+	}
 	return client.PollActivityTaskQueue(ctx, request, opts...)
 }
 
@@ -138,7 +151,7 @@ func (c *clientImpl) PollWorkflowTaskQueue(
 	ctx context.Context,
 	request *matchingservice.PollWorkflowTaskQueueRequest,
 	opts ...grpc.CallOption) (*matchingservice.PollWorkflowTaskQueueResponse, error) {
-	client, release, err := c.pickClientForRead(
+	client, partition, release, err := c.pickClientForRead(
 		request.GetPollRequest().GetTaskQueue(),
 		request.GetNamespaceId(),
 		enumspb.TASK_QUEUE_TYPE_WORKFLOW,
@@ -151,6 +164,12 @@ func (c *clientImpl) PollWorkflowTaskQueue(
 	}
 	ctx, cancel := c.createLongPollContext(ctx)
 	defer cancel()
+	if c.shouldMultiplexPoll(partition) {
+		resp, err := c.pollStreams.poll(ctx, partition.Key(), client, &matchingservice.StreamPollTaskQueueRequest{
+			Request: &matchingservice.StreamPollTaskQueueRequest_PollWorkflowTaskQueue{PollWorkflowTaskQueue: request},
+		})
+		return resp.GetPollWorkflowTaskQueue(), err
+	}
 	return client.PollWorkflowTaskQueue(ctx, request, opts...)
 }
 
@@ -164,6 +183,22 @@ func (c *clientImpl) QueryWorkflow(ctx context.Context, request *matchingservice
 	return client.QueryWorkflow(ctx, request, opts...)
 }
 
+// StreamPollTaskQueue is not exposed to callers of the client: polls are multiplexed onto the stream of their
+// partition by PollWorkflowTaskQueue and PollActivityTaskQueue.
+func (c *clientImpl) StreamPollTaskQueue(
+	_ context.Context,
+	_ ...grpc.CallOption,
+) (matchingservice.MatchingService_StreamPollTaskQueueClient, error) {
+	return nil, serviceerror.NewUnimplemented("StreamPollTaskQueue is used internally by the matching client")
+}
+
+// shouldMultiplexPoll returns true if a poll to the given partition should be sent over the partition's poll
+// stream. Sticky partitions are polled by a single worker each, so they don't benefit from sharing a stream.
+func (c *clientImpl) shouldMultiplexPoll(partition tqid.Partition) bool {
+	_, ok := partition.(*tqid.NormalPartition)
+	return ok && c.multiplexPolls()
+}
+
 // processInputPartition returns a partition in certain cases that load balancer involvement is not necessary,
 // otherwise, returns a task queue to pass down to the load balancer.
 func (c *clientImpl) processInputPartition(proto *taskqueuepb.TaskQueue, nsid string, taskType enumspb.TaskQueueType, forwardedFrom string) (tqid.Partition, *tqid.TaskQueue) {
@@ -197,7 +232,7 @@ func (c *clientImpl) pickClientForWrite(proto *taskqueuepb.TaskQueue, nsid strin
 	return c.getClientForTaskQueuePartition(p)
 }
 
-func (c *clientImpl) pickClientForRead(proto *taskqueuepb.TaskQueue, nsid string, taskType enumspb.TaskQueueType, forwardedFrom string) (client matchingservice.MatchingServiceClient, release func(), err error) {
+func (c *clientImpl) pickClientForRead(proto *taskqueuepb.TaskQueue, nsid string, taskType enumspb.TaskQueueType, forwardedFrom string) (client matchingservice.MatchingServiceClient, partition tqid.Partition, release func(), err error) {
 	p, tq := c.processInputPartition(proto, nsid, taskType, forwardedFrom)
 	if tq != nil {
 		token := c.loadBalancer.PickReadPartition(tq)
@@ -207,7 +242,7 @@ func (c *clientImpl) pickClientForRead(proto *taskqueuepb.TaskQueue, nsid string
 
 	proto.Name = p.RpcName()
 	client, err = c.getClientForTaskQueuePartition(p)
-	return client, release, err
+	return client, p, release, err
 }
 
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
@@ -168,6 +168,19 @@ func (c *metricClient) QueryWorkflow(
 	return c.client.QueryWorkflow(ctx, request, opts...)
 }
 
+func (c *metricClient) StreamPollTaskQueue(
+	ctx context.Context,
+	opts ...grpc.CallOption,
+) (_ matchingservice.MatchingService_StreamPollTaskQueueClient, retError error) {
+
+	scope, stopwatch := c.startMetricsRecording(ctx, metrics.MatchingClientStreamPollTaskQueueScope)
+	defer func() {
+		c.finishMetricsRecording(scope, stopwatch, retError)
+	}()
+
+	return c.client.StreamPollTaskQueue(ctx, opts...)
+}
+
 func (c *metricClient) emitForwardedSourceStats(
 	metricsHandler metrics.Handler,
 	forwardedFrom string,
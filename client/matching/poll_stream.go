@@ -0,0 +1,235 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/matchingservice/v1"
+	"go.temporal.io/server/common/headers"
+	serviceerrors "go.temporal.io/server/common/serviceerror"
+	"go.temporal.io/server/common/tqid"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+type (
+	// pollStreams multiplexes long polls over one StreamPollTaskQueue stream per task queue partition, so that
+	// the calls and goroutines held for pollers don't grow with the number of pollers waiting on a partition.
+	// A stream is opened by the first poll to its partition and closed once no poll is outstanding on it.
+	pollStreams struct {
+		lock    sync.Mutex
+		streams map[tqid.PartitionKey]*pollStream
+	}
+
+	pollStream struct {
+		key    tqid.PartitionKey
+		client matchingservice.MatchingServiceClient
+		stream matchingservice.MatchingService_StreamPollTaskQueueClient
+		cancel context.CancelFunc
+
+		sendLock sync.Mutex
+
+		// Fields below are guarded by pollStreams.lock.
+		lastPollID int64
+		pending    map[int64]chan *matchingservice.StreamPollTaskQueueResponse
+		closed     bool
+		err        error
+	}
+)
+
+func newPollStreams() *pollStreams {
+	return &pollStreams{
+		streams: make(map[tqid.PartitionKey]*pollStream),
+	}
+}
+
+// poll sends the request over the stream of the given partition and waits for its response or for ctx to be done.
+// The poll ID, timeout and headers of the request are set from ctx.
+func (s *pollStreams) poll(
+	ctx context.Context,
+	key tqid.PartitionKey,
+	client matchingservice.MatchingServiceClient,
+	request *matchingservice.StreamPollTaskQueueRequest,
+) (*matchingservice.StreamPollTaskQueueResponse, error) {
+	ps, pollID, respCh, err := s.acquire(key, client)
+	if err != nil {
+		return nil, err
+	}
+	defer s.release(ps, pollID)
+
+	request.PollId = pollID
+	if deadline, ok := ctx.Deadline(); ok {
+		request.Timeout = durationpb.New(time.Until(deadline))
+	}
+	request.Headers = headers.GetPropagated(ctx)
+	// io.EOF means the stream is broken, the actual error is delivered by the receiving goroutine.
+	if err := ps.send(request); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	select {
+	case resp, ok := <-respCh:
+		if !ok {
+			return nil, ps.err
+		}
+		if resp.GetError() != nil {
+			return nil, serviceerrors.FromStatus(status.FromProto(resp.GetError()))
+		}
+		return resp, nil
+	case <-ctx.Done():
+		_ = ps.send(&matchingservice.StreamPollTaskQueueRequest{
+			PollId:  pollID,
+			Request: &matchingservice.StreamPollTaskQueueRequest_Cancel{Cancel: true},
+		})
+		return nil, ctx.Err()
+	}
+}
+
+// acquire registers a new poll on the stream of the given partition, opening the stream if there is none yet or if
+// the partition moved to another matching host since the stream was opened.
+func (s *pollStreams) acquire(
+	key tqid.PartitionKey,
+	client matchingservice.MatchingServiceClient,
+) (*pollStream, int64, chan *matchingservice.StreamPollTaskQueueResponse, error) {
+	s.lock.Lock()
+	ps, ok := s.streams[key]
+	if !ok || ps.client != client {
+		// Don't hold the lock while the stream is being opened.
+		s.lock.Unlock()
+		newPS, err := s.open(key, client)
+		if err != nil {
+			return nil, 0, nil, err
+		}
+		s.lock.Lock()
+		if ps, ok = s.streams[key]; !ok || ps.client != client {
+			ps = newPS
+			s.streams[key] = ps
+			go s.receive(ps)
+		} else {
+			newPS.cancel()
+		}
+	}
+	defer s.lock.Unlock()
+
+	ps.lastPollID++
+	respCh := make(chan *matchingservice.StreamPollTaskQueueResponse, 1)
+	ps.pending[ps.lastPollID] = respCh
+	return ps, ps.lastPollID, respCh, nil
+}
+
+// release unregisters a poll from its stream and closes the stream if it was the last outstanding poll.
+func (s *pollStreams) release(ps *pollStream, pollID int64) {
+	s.lock.Lock()
+	delete(ps.pending, pollID)
+	closeStream := len(ps.pending) == 0 && !ps.closed
+	if closeStream {
+		ps.closed = true
+		s.removeLocked(ps)
+	}
+	s.lock.Unlock()
+
+	if closeStream {
+		ps.cancel()
+	}
+}
+
+func (s *pollStreams) open(
+	key tqid.PartitionKey,
+	client matchingservice.MatchingServiceClient,
+) (*pollStream, error) {
+	// The stream outlives the polls that use it, so it is not bound to the context of any of them.
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := client.StreamPollTaskQueue(ctx)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return &pollStream{
+		key:     key,
+		client:  client,
+		stream:  stream,
+		cancel:  cancel,
+		pending: make(map[int64]chan *matchingservice.StreamPollTaskQueueResponse),
+	}, nil
+}
+
+// receive dispatches the responses of a stream to its polls until the stream fails or is closed.
+func (s *pollStreams) receive(ps *pollStream) {
+	for {
+		resp, err := ps.stream.Recv()
+		if err != nil {
+			s.fail(ps, err)
+			return
+		}
+		s.lock.Lock()
+		respCh, ok := ps.pending[resp.GetPollId()]
+		s.lock.Unlock()
+		// Responses of polls which were released already are dropped.
+		if ok {
+			respCh <- resp
+		}
+	}
+}
+
+// fail closes the stream and fails all of its outstanding polls with err.
+func (s *pollStreams) fail(ps *pollStream, err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if ps.closed {
+		return
+	}
+	ps.closed = true
+	s.removeLocked(ps)
+	ps.cancel()
+
+	if errors.Is(err, io.EOF) {
+		ps.err = serviceerror.NewUnavailable("matching poll stream was closed by the server")
+	} else {
+		ps.err = serviceerrors.FromStatus(serviceerror.ToStatus(err))
+	}
+	for pollID, respCh := range ps.pending {
+		close(respCh)
+		delete(ps.pending, pollID)
+	}
+}
+
+func (s *pollStreams) removeLocked(ps *pollStream) {
+	if s.streams[ps.key] == ps {
+		delete(s.streams, ps.key)
+	}
+}
+
+func (ps *pollStream) send(request *matchingservice.StreamPollTaskQueueRequest) error {
+	ps.sendLock.Lock()
+	defer ps.sendLock.Unlock()
+	return ps.stream.Send(request)
+}
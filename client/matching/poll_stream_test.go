@@ -0,0 +1,219 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/matchingservice/v1"
+	"go.temporal.io/server/common/tqid"
+	"google.golang.org/grpc"
+)
+
+type (
+	fakePollStreamClient struct {
+		matchingservice.MatchingServiceClient
+		streams chan *fakePollStream
+	}
+
+	fakePollStream struct {
+		matchingservice.MatchingService_StreamPollTaskQueueClient
+		ctx       context.Context
+		requests  chan *matchingservice.StreamPollTaskQueueRequest
+		responses chan *matchingservice.StreamPollTaskQueueResponse
+		errors    chan error
+	}
+)
+
+func newFakePollStreamClient() *fakePollStreamClient {
+	return &fakePollStreamClient{streams: make(chan *fakePollStream, 10)}
+}
+
+func (c *fakePollStreamClient) StreamPollTaskQueue(
+	ctx context.Context,
+	_ ...grpc.CallOption,
+) (matchingservice.MatchingService_StreamPollTaskQueueClient, error) {
+	stream := &fakePollStream{
+		ctx:       ctx,
+		requests:  make(chan *matchingservice.StreamPollTaskQueueRequest, 10),
+		responses: make(chan *matchingservice.StreamPollTaskQueueResponse, 10),
+		errors:    make(chan error, 1),
+	}
+	c.streams <- stream
+	return stream, nil
+}
+
+func (s *fakePollStream) Send(request *matchingservice.StreamPollTaskQueueRequest) error {
+	select {
+	case s.requests <- request:
+		return nil
+	case <-s.ctx.Done():
+		return io.EOF
+	}
+}
+
+func (s *fakePollStream) Recv() (*matchingservice.StreamPollTaskQueueResponse, error) {
+	select {
+	case resp := <-s.responses:
+		return resp, nil
+	case err := <-s.errors:
+		return nil, err
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	}
+}
+
+func testPartitionKey(t *testing.T) tqid.PartitionKey {
+	f, err := tqid.NewTaskQueueFamily("fake-namespace-id", "fake-taskqueue")
+	require.NoError(t, err)
+	return f.TaskQueue(enumspb.TASK_QUEUE_TYPE_ACTIVITY).RootPartition().Key()
+}
+
+func testPollRequest(pollerID string) *matchingservice.StreamPollTaskQueueRequest {
+	return &matchingservice.StreamPollTaskQueueRequest{
+		Request: &matchingservice.StreamPollTaskQueueRequest_PollActivityTaskQueue{
+			PollActivityTaskQueue: &matchingservice.PollActivityTaskQueueRequest{PollerId: pollerID},
+		},
+	}
+}
+
+func TestPollStreams_MultiplexesPollsOfPartition(t *testing.T) {
+	streams := newPollStreams()
+	client := newFakePollStreamClient()
+	key := testPartitionKey(t)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	type result struct {
+		resp *matchingservice.StreamPollTaskQueueResponse
+		err  error
+	}
+	results := make(map[string]chan result)
+	for _, pollerID := range []string{"poller-1", "poller-2"} {
+		resultCh := make(chan result, 1)
+		results[pollerID] = resultCh
+		go func() {
+			resp, err := streams.poll(ctx, key, client, testPollRequest(pollerID))
+			resultCh <- result{resp: resp, err: err}
+		}()
+	}
+
+	stream := <-client.streams
+	pollIDs := make(map[string]int64)
+	for range results {
+		request := <-stream.requests
+		require.NotNil(t, request.GetTimeout())
+		pollIDs[request.GetPollActivityTaskQueue().GetPollerId()] = request.GetPollId()
+	}
+	require.NotEqual(t, pollIDs["poller-1"], pollIDs["poller-2"])
+	require.Empty(t, client.streams, "polls of the same partition must share a stream")
+
+	// Respond out of order, responses are routed by poll ID.
+	stream.responses <- &matchingservice.StreamPollTaskQueueResponse{
+		PollId: pollIDs["poller-2"],
+		Response: &matchingservice.StreamPollTaskQueueResponse_PollActivityTaskQueue{
+			PollActivityTaskQueue: &matchingservice.PollActivityTaskQueueResponse{ActivityId: "activity-2"},
+		},
+	}
+	r := <-results["poller-2"]
+	require.NoError(t, r.err)
+	require.Equal(t, "activity-2", r.resp.GetPollActivityTaskQueue().GetActivityId())
+	require.NoError(t, stream.ctx.Err(), "stream must stay open while a poll is outstanding")
+
+	stream.responses <- &matchingservice.StreamPollTaskQueueResponse{
+		PollId:   pollIDs["poller-1"],
+		Response: &matchingservice.StreamPollTaskQueueResponse_Error{Error: serviceerror.ToStatus(serviceerror.NewResourceExhausted(0, "busy")).Proto()},
+	}
+	r = <-results["poller-1"]
+	var resourceExhausted *serviceerror.ResourceExhausted
+	require.ErrorAs(t, r.err, &resourceExhausted)
+
+	require.ErrorIs(t, stream.ctx.Err(), context.Canceled, "stream must be closed after the last poll")
+	streams.lock.Lock()
+	require.Empty(t, streams.streams)
+	streams.lock.Unlock()
+}
+
+func TestPollStreams_CancelPoll(t *testing.T) {
+	streams := newPollStreams()
+	client := newFakePollStreamClient()
+	key := testPartitionKey(t)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := streams.poll(ctx, key, client, testPollRequest("poller"))
+		errCh <- err
+	}()
+
+	stream := <-client.streams
+	request := <-stream.requests
+	cancel()
+	require.ErrorIs(t, <-errCh, context.Canceled)
+
+	cancelRequest := <-stream.requests
+	require.Equal(t, request.GetPollId(), cancelRequest.GetPollId())
+	require.True(t, cancelRequest.GetCancel())
+}
+
+func TestPollStreams_StreamFailureFailsPolls(t *testing.T) {
+	streams := newPollStreams()
+	client := newFakePollStreamClient()
+	key := testPartitionKey(t)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := streams.poll(ctx, key, client, testPollRequest("poller"))
+		errCh <- err
+	}()
+
+	stream := <-client.streams
+	<-stream.requests
+	stream.errors <- serviceerror.ToStatus(serviceerror.NewUnavailable("host went away")).Err()
+	var unavailable *serviceerror.Unavailable
+	require.ErrorAs(t, <-errCh, &unavailable)
+
+	// The next poll opens a new stream.
+	go func() {
+		_, err := streams.poll(ctx, key, client, testPollRequest("poller"))
+		errCh <- err
+	}()
+	newStream := <-client.streams
+	require.NotSame(t, stream, newStream)
+	request := <-newStream.requests
+	newStream.responses <- &matchingservice.StreamPollTaskQueueResponse{
+		PollId:   request.GetPollId(),
+		Response: &matchingservice.StreamPollTaskQueueResponse_PollActivityTaskQueue{},
+	}
+	require.NoError(t, <-errCh)
+}
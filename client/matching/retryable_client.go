@@ -25,8 +25,11 @@
 package matching
 
 import (
+	"context"
+
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/common/backoff"
+	"google.golang.org/grpc"
 )
 
 var _ matchingservice.MatchingServiceClient = (*retryableClient)(nil)
@@ -45,3 +48,17 @@ func NewRetryableClient(client matchingservice.MatchingServiceClient, policy bac
 		isRetryable: isRetryable,
 	}
 }
+
+func (c *retryableClient) StreamPollTaskQueue(
+	ctx context.Context,
+	opts ...grpc.CallOption,
+) (matchingservice.MatchingService_StreamPollTaskQueueClient, error) {
+	var resp matchingservice.MatchingService_StreamPollTaskQueueClient
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.StreamPollTaskQueue(ctx, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
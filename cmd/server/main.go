@@ -185,18 +185,24 @@ func buildCLI() *cli.App {
 				)
 
 				var dynamicConfigClient dynamicconfig.Client
-				if cfg.DynamicConfigClient != nil {
+				switch {
+				case cfg.DynamicConfigClient != nil:
 					dynamicConfigClient, err = dynamicconfig.NewFileBasedClient(cfg.DynamicConfigClient, logger, temporal.InterruptCh())
-					if err != nil {
-						return cli.Exit(fmt.Sprintf("Unable to create dynamic config client. Error: %v", err), 1)
-					}
-				} else {
+				case cfg.DynamicConfigEtcd != nil:
+					dynamicConfigClient, err = dynamicconfig.NewEtcdClient(cfg.DynamicConfigEtcd, logger, temporal.InterruptCh())
+				case cfg.DynamicConfigConsul != nil:
+					dynamicConfigClient, err = dynamicconfig.NewConsulClient(cfg.DynamicConfigConsul, logger, temporal.InterruptCh())
+				default:
 					dynamicConfigClient = dynamicconfig.NewNoopClient()
 					logger.Info("Dynamic config client is not configured. Using noop client.")
 				}
+				if err != nil {
+					return cli.Exit(fmt.Sprintf("Unable to create dynamic config client. Error: %v", err), 1)
+				}
 
 				authorizer, err := authorization.GetAuthorizerFromConfig(
 					&cfg.Global.Authorization,
+					dynamicconfig.NewCollection(dynamicConfigClient, logger),
 				)
 				if err != nil {
 					return cli.Exit(fmt.Sprintf("Unable to instantiate authorizer. Error: %v", err), 1)
@@ -46,7 +46,10 @@ type (
 		Name   string
 		GoArgs string
 		Expr   string
-		Index  int
+		// RolloutSubject is the expression that percentage rollouts are hashed by. Rollouts
+		// aren't allowed for precedences without one.
+		RolloutSubject string
+		Index          int
 	}
 )
 
@@ -89,14 +92,16 @@ var (
 			Expr:   "[]Constraints{{}}",
 		},
 		{
-			Name:   "Namespace",
-			GoArgs: "namespace string",
-			Expr:   "[]Constraints{{Namespace: namespace}, {}}",
+			Name:           "Namespace",
+			GoArgs:         "namespace string",
+			Expr:           "[]Constraints{{Namespace: namespace}, {}}",
+			RolloutSubject: "namespace",
 		},
 		{
-			Name:   "NamespaceID",
-			GoArgs: "namespaceID string",
-			Expr:   "[]Constraints{{NamespaceID: namespaceID}, {}}",
+			Name:           "NamespaceID",
+			GoArgs:         "namespaceID string",
+			Expr:           "[]Constraints{{NamespaceID: namespaceID}, {}}",
+			RolloutSubject: "namespaceID",
 		},
 		{
 			Name:   "TaskQueue",
@@ -111,6 +116,7 @@ var (
 			{Namespace: namespace},
 			{},
 		}`,
+			RolloutSubject: "namespace",
 		},
 		{
 			Name:   "ShardID",
@@ -131,6 +137,15 @@ var (
 			{Namespace: namespace},
 			{},
 		}`,
+			RolloutSubject: "namespace",
+		},
+		{
+			Name:   "Workflow",
+			GoArgs: "namespace string, workflowID string",
+			// Same constraints as namespace precedence, but rollouts apply to a percentage of
+			// workflows instead of namespaces.
+			Expr:           "[]Constraints{{Namespace: namespace}, {}}",
+			RolloutSubject: "workflowID",
 		},
 	}
 )
@@ -208,6 +223,16 @@ func (s {{.P.Name}}TypedSetting[T]) WithDefault(v T) {{.P.Name}}TypedSetting[T]
 	return newS
 }
 
+// WithValidator returns the setting with an additional check on converted values. Values that
+// fail it are rejected when dynamic config is loaded and ignored in favor of the default when
+// read. It must only be called from static initializers, on the value returned by a constructor.
+func (s {{.P.Name}}TypedSetting[T]) WithValidator(validate func(T) error) {{.P.Name}}TypedSetting[T] {
+	newS := s
+	newS.convert = convertAndValidate(s.convert, validate)
+	reregister(newS)
+	return newS
+}
+
 {{if eq .P.Name "Global" -}}
 type TypedPropertyFn[T any] func({{.P.GoArgs}}) T
 {{- else -}}
@@ -220,7 +245,7 @@ func (s {{.P.Name}}TypedSetting[T]) Get(c *Collection) TypedPropertyFn[T] {
 func (s {{.P.Name}}TypedSetting[T]) Get(c *Collection) TypedPropertyFnWith{{.P.Name}}Filter[T] {
 {{- end}}
 	return func({{.P.GoArgs}}) T {
-		prec := {{.P.Expr}}
+		prec := {{if .P.RolloutSubject}}withRolloutSubject({{.P.Expr}}, {{.P.RolloutSubject}}){{else}}{{.P.Expr}}{{end}}
 		return matchAndConvert(
 			c,
 			s.key,
@@ -245,7 +270,7 @@ func (s {{.P.Name}}TypedSetting[T]) Subscribe(c *Collection) TypedSubscribable[T
 func (s {{.P.Name}}TypedSetting[T]) Subscribe(c *Collection) TypedSubscribableWith{{.P.Name}}Filter[T] {
 	return func({{.P.GoArgs}}, callback func(T)) (T, func()) {
 {{- end}}
-		prec := {{.P.Expr}}
+		prec := {{if .P.RolloutSubject}}withRolloutSubject({{.P.Expr}}, {{.P.RolloutSubject}}){{else}}{{.P.Expr}}{{end}}
 		return subscribe(c, s.key, s.def, s.cdef, s.convert, prec, callback)
 	}
 }
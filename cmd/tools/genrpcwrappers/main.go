@@ -97,6 +97,7 @@ var (
 		"client.matching.ListNexusEndpoints":             true,
 	}
 	largeTimeoutContext = map[string]bool{
+		"client.admin.CaptureProfile":         true,
 		"client.admin.GetReplicationMessages": true,
 	}
 	ignoreMethod = map[string]bool{
@@ -108,6 +109,10 @@ var (
 		"client.history.StreamWorkflowReplicationMessages":          true,
 		"metricsClient.history.StreamWorkflowReplicationMessages":   true,
 		"retryableClient.history.StreamWorkflowReplicationMessages": true,
+		// polls are multiplexed onto this stream by the poll methods of the client.
+		"client.matching.StreamPollTaskQueue":          true,
+		"metricsClient.matching.StreamPollTaskQueue":   true,
+		"retryableClient.matching.StreamPollTaskQueue": true,
 
 		// these need to pick a partition. too complicated.
 		"client.matching.AddActivityTask":       true,
@@ -363,7 +368,8 @@ func makeGetMatchingClient(reqType reflect.Type) string {
 	case "CreateNexusEndpointRequest",
 		"UpdateNexusEndpointRequest",
 		"ListNexusEndpointsRequest",
-		"DeleteNexusEndpointRequest":
+		"DeleteNexusEndpointRequest",
+		"ApplyNexusEndpointReplicationEventRequest":
 		// Always route these requests to the same matching node for all namespaces.
 		tq = fieldWithPath{path: `"not-applicable"`}
 		tqt = fieldWithPath{path: "enumspb.TASK_QUEUE_TYPE_UNSPECIFIED"}
@@ -0,0 +1,77 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package audit
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// EventType identifies the kind of security relevant action an Event records.
+	EventType string
+
+	// Event is a single audit record. It is serialized as JSON by all sinks.
+	Event struct {
+		Time      time.Time         `json:"time"`
+		Type      EventType         `json:"type"`
+		API       string            `json:"api"`
+		Namespace string            `json:"namespace,omitempty"`
+		Subject   string            `json:"subject,omitempty"`
+		Outcome   Outcome           `json:"outcome"`
+		Reason    string            `json:"reason,omitempty"`
+		Details   map[string]string `json:"details,omitempty"`
+	}
+
+	// Outcome is the result of the audited action.
+	Outcome string
+
+	// Logger records audit events. Implementations must not block the caller.
+	Logger interface {
+		Emit(ctx context.Context, event *Event)
+	}
+
+	noopLogger struct{}
+)
+
+const (
+	// EventTypeAuthorizationDenied is recorded when the authorizer rejects a request.
+	EventTypeAuthorizationDenied EventType = "authorization_denied"
+	// EventTypeNamespaceChange is recorded for calls that create, update or remove a namespace.
+	EventTypeNamespaceChange EventType = "namespace_change"
+	// EventTypeAdminOperation is recorded for destructive admin and operator calls.
+	EventTypeAdminOperation EventType = "admin_operation"
+)
+
+const (
+	OutcomeSuccess Outcome = "success"
+	OutcomeFailure Outcome = "failure"
+	OutcomeDenied  Outcome = "denied"
+)
+
+// NewNoopLogger returns a Logger that discards all events.
+func NewNoopLogger() Logger {
+	return noopLogger{}
+}
+
+func (noopLogger) Emit(context.Context, *Event) {}
@@ -0,0 +1,238 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package audit
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+const (
+	defaultBufferSize       = 10000
+	defaultBatchSize        = 100
+	defaultFlushInterval    = time.Second
+	defaultMaxRetryInterval = 30 * time.Second
+	defaultRetryExpiration  = 5 * time.Minute
+
+	// drainTimeout bounds the time spent delivering buffered events on shutdown.
+	drainTimeout = 10 * time.Second
+)
+
+type (
+	// Exporter is a Logger that buffers events in memory and delivers them in batches to
+	// every configured sink. Delivery to each sink is retried with backoff; events are
+	// dropped when the buffer is full or a batch can't be delivered before the retry
+	// expiration.
+	Exporter struct {
+		status         int32
+		sinks          []Sink
+		events         chan *Event
+		batchSize      int
+		flushInterval  time.Duration
+		retryPolicy    backoff.RetryPolicy
+		logger         log.Logger
+		metricsHandler metrics.Handler
+
+		shutdownCtx    context.Context
+		shutdownCancel context.CancelFunc
+		shutdownWG     sync.WaitGroup
+	}
+)
+
+var _ Logger = (*Exporter)(nil)
+
+// NewExporterFromConfig creates an Exporter for the sinks in cfg. It returns a no-op
+// Logger when no sinks are configured.
+func NewExporterFromConfig(
+	cfg *config.Audit,
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+) (Logger, error) {
+	if len(cfg.Sinks) == 0 {
+		return NewNoopLogger(), nil
+	}
+	sinks := make([]Sink, 0, len(cfg.Sinks))
+	for _, sinkCfg := range cfg.Sinks {
+		sink, err := NewSink(sinkCfg)
+		if err != nil {
+			for _, s := range sinks {
+				_ = s.Close()
+			}
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+	return NewExporter(cfg, sinks, logger, metricsHandler), nil
+}
+
+// NewExporter creates an Exporter delivering to sinks. Buffering and retry settings are
+// taken from cfg; its Sinks field is ignored.
+func NewExporter(
+	cfg *config.Audit,
+	sinks []Sink,
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+) *Exporter {
+	bufferSize := cfg.BufferSize
+	if bufferSize <= 0 {
+		bufferSize = defaultBufferSize
+	}
+	batchSize := cfg.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+	flushInterval := cfg.FlushInterval
+	if flushInterval <= 0 {
+		flushInterval = defaultFlushInterval
+	}
+	maxRetryInterval := cfg.MaxRetryInterval
+	if maxRetryInterval <= 0 {
+		maxRetryInterval = defaultMaxRetryInterval
+	}
+	retryExpiration := cfg.RetryExpiration
+	if retryExpiration <= 0 {
+		retryExpiration = defaultRetryExpiration
+	}
+
+	shutdownCtx, shutdownCancel := context.WithCancel(context.Background())
+	return &Exporter{
+		status:        common.DaemonStatusInitialized,
+		sinks:         sinks,
+		events:        make(chan *Event, bufferSize),
+		batchSize:     batchSize,
+		flushInterval: flushInterval,
+		retryPolicy: backoff.NewExponentialRetryPolicy(100 * time.Millisecond).
+			WithMaximumInterval(maxRetryInterval).
+			WithExpirationInterval(retryExpiration),
+		logger:         logger,
+		metricsHandler: metricsHandler.WithTags(metrics.OperationTag(metrics.AuditScope)),
+		shutdownCtx:    shutdownCtx,
+		shutdownCancel: shutdownCancel,
+	}
+}
+
+// Start starts delivering events in the background.
+func (e *Exporter) Start() {
+	if !atomic.CompareAndSwapInt32(&e.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	e.shutdownWG.Add(1)
+	go e.run()
+}
+
+// Stop stops the exporter after attempting to deliver buffered events, and closes all sinks.
+func (e *Exporter) Stop() {
+	if !atomic.CompareAndSwapInt32(&e.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	e.shutdownCancel()
+	e.shutdownWG.Wait()
+	for _, sink := range e.sinks {
+		if err := sink.Close(); err != nil {
+			e.logger.Warn("Failed to close audit sink", tag.Value(sink.Name()), tag.Error(err))
+		}
+	}
+}
+
+// Emit buffers event for delivery. It never blocks; the event is dropped if the buffer is full.
+func (e *Exporter) Emit(_ context.Context, event *Event) {
+	if event.Time.IsZero() {
+		event.Time = time.Now().UTC()
+	}
+	select {
+	case e.events <- event:
+	default:
+		metrics.AuditEventsDropped.With(e.metricsHandler).Record(1)
+	}
+}
+
+func (e *Exporter) run() {
+	defer e.shutdownWG.Done()
+
+	ticker := time.NewTicker(e.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*Event, 0, e.batchSize)
+	flush := func(ctx context.Context) {
+		if len(batch) > 0 {
+			e.export(ctx, batch)
+			batch = make([]*Event, 0, e.batchSize)
+		}
+	}
+
+	for {
+		select {
+		case event := <-e.events:
+			batch = append(batch, event)
+			if len(batch) >= e.batchSize {
+				flush(e.shutdownCtx)
+			}
+		case <-ticker.C:
+			flush(e.shutdownCtx)
+		case <-e.shutdownCtx.Done():
+			ctx, cancel := context.WithTimeout(context.Background(), drainTimeout)
+			defer cancel()
+			for {
+				select {
+				case event := <-e.events:
+					batch = append(batch, event)
+					if len(batch) >= e.batchSize {
+						flush(ctx)
+					}
+				default:
+					flush(ctx)
+					return
+				}
+			}
+		}
+	}
+}
+
+func (e *Exporter) export(ctx context.Context, batch []*Event) {
+	for _, sink := range e.sinks {
+		op := func(ctx context.Context) error {
+			return sink.Export(ctx, batch)
+		}
+		err := backoff.ThrottleRetryContext(ctx, op, e.retryPolicy, nil)
+		if err == nil {
+			metrics.AuditEventsExported.With(e.metricsHandler).Record(int64(len(batch)))
+			continue
+		}
+		metrics.AuditExportFailures.With(e.metricsHandler).Record(1)
+		metrics.AuditEventsDropped.With(e.metricsHandler).Record(int64(len(batch)))
+		e.logger.Error("Failed to export audit events",
+			tag.Value(sink.Name()),
+			tag.Counter(len(batch)),
+			tag.Error(err),
+		)
+	}
+}
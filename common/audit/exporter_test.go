@@ -0,0 +1,122 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+)
+
+type testSink struct {
+	sync.Mutex
+	failures int
+	events   []*Event
+	closed   bool
+}
+
+func (s *testSink) Name() string {
+	return "test"
+}
+
+func (s *testSink) Export(_ context.Context, events []*Event) error {
+	s.Lock()
+	defer s.Unlock()
+	if s.failures > 0 {
+		s.failures--
+		return errors.New("unavailable")
+	}
+	s.events = append(s.events, events...)
+	return nil
+}
+
+func (s *testSink) Close() error {
+	s.Lock()
+	defer s.Unlock()
+	s.closed = true
+	return nil
+}
+
+func (s *testSink) exported() int {
+	s.Lock()
+	defer s.Unlock()
+	return len(s.events)
+}
+
+func TestExporter_RetriesAndFlushesOnStop(t *testing.T) {
+	sink := &testSink{failures: 2}
+	exporter := NewExporter(
+		&config.Audit{BatchSize: 2, FlushInterval: time.Hour},
+		[]Sink{sink},
+		log.NewNoopLogger(),
+		metrics.NoopMetricsHandler,
+	)
+	exporter.Start()
+
+	exporter.Emit(context.Background(), &Event{Type: EventTypeNamespaceChange, API: "a"})
+	exporter.Emit(context.Background(), &Event{Type: EventTypeNamespaceChange, API: "b"})
+	require.Eventually(t, func() bool { return sink.exported() == 2 }, 5*time.Second, 10*time.Millisecond)
+
+	exporter.Emit(context.Background(), &Event{Type: EventTypeAdminOperation, API: "c"})
+	exporter.Stop()
+	require.Equal(t, 3, sink.exported())
+	require.True(t, sink.closed)
+	require.False(t, sink.events[0].Time.IsZero())
+}
+
+func TestExporter_DropsWhenBufferFull(t *testing.T) {
+	sink := &testSink{}
+	exporter := NewExporter(
+		&config.Audit{BufferSize: 1},
+		[]Sink{sink},
+		log.NewNoopLogger(),
+		metrics.NoopMetricsHandler,
+	)
+
+	exporter.Emit(context.Background(), &Event{API: "a"})
+	exporter.Emit(context.Background(), &Event{API: "b"})
+	exporter.Start()
+	exporter.Stop()
+	require.Len(t, sink.events, 1)
+	require.Equal(t, "a", sink.events[0].API)
+}
+
+func TestNewExporterFromConfig(t *testing.T) {
+	logger, err := NewExporterFromConfig(&config.Audit{}, log.NewNoopLogger(), metrics.NoopMetricsHandler)
+	require.NoError(t, err)
+	require.Equal(t, NewNoopLogger(), logger)
+
+	_, err = NewExporterFromConfig(
+		&config.Audit{Sinks: []config.AuditSink{{Type: "syslog"}}},
+		log.NewNoopLogger(),
+		metrics.NoopMetricsHandler,
+	)
+	require.Error(t, err)
+}
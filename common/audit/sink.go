@@ -0,0 +1,155 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/eventsink"
+)
+
+const (
+	SinkTypeFile    = "file"
+	SinkTypeWebhook = "webhook"
+	SinkTypeKafka   = "kafka"
+)
+
+type (
+	// Sink delivers batches of audit events to an external system. Export is retried by the
+	// Exporter on error, so a sink must tolerate receiving the same batch more than once.
+	Sink interface {
+		Name() string
+		Export(ctx context.Context, events []*Event) error
+		Close() error
+	}
+
+	fileSink struct {
+		path string
+
+		sync.Mutex
+		file *os.File
+	}
+
+	httpSink struct {
+		*eventsink.HTTPClient
+		encode func(events []*Event) ([]byte, error)
+	}
+)
+
+// NewSink creates a sink from its config.
+func NewSink(cfg config.AuditSink) (Sink, error) {
+	switch strings.ToLower(cfg.Type) {
+	case SinkTypeFile:
+		if cfg.Path == "" {
+			return nil, fmt.Errorf("audit sink %q requires a path", cfg.Type)
+		}
+		return NewFileSink(cfg.Path)
+	case SinkTypeWebhook:
+		if cfg.URL == "" {
+			return nil, fmt.Errorf("audit sink %q requires a url", cfg.Type)
+		}
+		return NewWebhookSink(cfg.URL, cfg.Headers, cfg.Timeout), nil
+	case SinkTypeKafka:
+		if cfg.URL == "" || cfg.Topic == "" {
+			return nil, fmt.Errorf("audit sink %q requires a url and a topic", cfg.Type)
+		}
+		return NewKafkaSink(cfg.URL, cfg.Topic, cfg.Headers, cfg.Timeout), nil
+	default:
+		return nil, fmt.Errorf("unknown audit sink type: %q", cfg.Type)
+	}
+}
+
+// NewFileSink returns a sink that appends events to the file at path, one JSON object per line.
+func NewFileSink(path string) (Sink, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open audit file: %w", err)
+	}
+	return &fileSink{path: path, file: file}, nil
+}
+
+func (s *fileSink) Name() string {
+	return SinkTypeFile + ":" + s.path
+}
+
+func (s *fileSink) Export(_ context.Context, events []*Event) error {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, event := range events {
+		if err := encoder.Encode(event); err != nil {
+			return err
+		}
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	_, err := s.file.Write(buf.Bytes())
+	return err
+}
+
+func (s *fileSink) Close() error {
+	s.Lock()
+	defer s.Unlock()
+	return s.file.Close()
+}
+
+// NewWebhookSink returns a sink that POSTs every batch to url as a JSON array.
+func NewWebhookSink(url string, headers map[string]string, timeout time.Duration) Sink {
+	return &httpSink{
+		HTTPClient: eventsink.NewHTTPClient(SinkTypeWebhook, url, "application/json", headers, nil, timeout),
+		encode: func(events []*Event) ([]byte, error) {
+			return json.Marshal(events)
+		},
+	}
+}
+
+// NewKafkaSink returns a sink that produces every event as a record to topic through the
+// Kafka REST proxy listening at url.
+func NewKafkaSink(url string, topic string, headers map[string]string, timeout time.Duration) Sink {
+	return &httpSink{
+		HTTPClient: eventsink.NewHTTPClient(SinkTypeKafka, eventsink.KafkaTopicURL(url, topic), eventsink.KafkaContentType, headers, nil, timeout),
+		encode: func(events []*Event) ([]byte, error) {
+			records := eventsink.KafkaRecords[*Event]{Records: make([]eventsink.KafkaRecord[*Event], len(events))}
+			for i, event := range events {
+				records.Records[i].Value = event
+			}
+			return json.Marshal(records)
+		},
+	}
+}
+
+func (s *httpSink) Export(ctx context.Context, events []*Event) error {
+	body, err := s.encode(events)
+	if err != nil {
+		return err
+	}
+	return s.Post(ctx, body)
+}
@@ -0,0 +1,100 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/eventsink"
+)
+
+var testEvents = []*Event{
+	{Type: EventTypeAuthorizationDenied, API: "/api/A", Subject: "alice", Outcome: OutcomeDenied},
+	{Type: EventTypeNamespaceChange, API: "/api/B", Namespace: "ns", Outcome: OutcomeSuccess},
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	sink, err := NewSink(config.AuditSink{Type: SinkTypeFile, Path: path})
+	require.NoError(t, err)
+	require.NoError(t, sink.Export(context.Background(), testEvents))
+	require.NoError(t, sink.Close())
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer func() { _ = file.Close() }()
+	var lines []*Event
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var event Event
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+		lines = append(lines, &event)
+	}
+	require.Equal(t, testEvents, lines)
+}
+
+func TestWebhookSink(t *testing.T) {
+	var received []*Event
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.Equal(t, "secret", r.Header.Get("X-Token"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+	}))
+	defer server.Close()
+
+	sink, err := NewSink(config.AuditSink{Type: SinkTypeWebhook, URL: server.URL, Headers: map[string]string{"X-Token": "secret"}})
+	require.NoError(t, err)
+	require.NoError(t, sink.Export(context.Background(), testEvents))
+	require.Equal(t, testEvents, received)
+}
+
+func TestKafkaSink(t *testing.T) {
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/topics/audit", r.URL.Path)
+		require.Equal(t, eventsink.KafkaContentType, r.Header.Get("Content-Type"))
+		var err error
+		body, err = io.ReadAll(r.Body)
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	sink, err := NewSink(config.AuditSink{Type: SinkTypeKafka, URL: server.URL + "/", Topic: "audit"})
+	require.NoError(t, err)
+	require.NoError(t, sink.Export(context.Background(), testEvents))
+
+	var records eventsink.KafkaRecords[*Event]
+	require.NoError(t, json.Unmarshal(body, &records))
+	require.Len(t, records.Records, 2)
+	require.Equal(t, testEvents[1], records.Records[1].Value)
+}
@@ -32,6 +32,7 @@ import (
 	"strings"
 
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
 )
 
 const (
@@ -82,13 +83,29 @@ type hasNamespace interface {
 	GetNamespace() string
 }
 
-func GetAuthorizerFromConfig(config *config.Authorization) (Authorizer, error) {
+// GetAuthorizerFromConfig creates the authorizer selected by the config. dc is used by the "policy" authorizer when
+// its config sets neither a policy nor a policy file.
+func GetAuthorizerFromConfig(config *config.Authorization, dc *dynamicconfig.Collection) (Authorizer, error) {
 
 	switch strings.ToLower(config.Authorizer) {
 	case "":
 		return NewNoopAuthorizer(), nil
 	case "default":
 		return NewDefaultAuthorizer(), nil
+	case "policy":
+		switch {
+		case config.Policy != "":
+			return NewStaticPolicyAuthorizer(config.Policy)
+		case config.PolicyFile != "":
+			return NewPolicyAuthorizer(config.PolicyFile, config.PolicyRefreshInterval)
+		case dc == nil:
+			return nil, errPolicyNotSet
+		default:
+			return NewDynamicConfigPolicyAuthorizer(
+				dynamicconfig.FrontendAuthorizationPolicy.Get(dc),
+				config.PolicyRefreshInterval,
+			)
+		}
 	}
 	return nil, fmt.Errorf("unknown authorizer: %s", config.Authorizer)
 }
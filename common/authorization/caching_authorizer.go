@@ -0,0 +1,161 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/namespace"
+)
+
+const (
+	defaultDecisionCacheSize = 10000
+)
+
+type (
+	// CachingAuthorizer caches the decisions of another Authorizer by caller claims, namespace and API.
+	CachingAuthorizer struct {
+		authorizer Authorizer
+		registry   namespace.Registry
+		decisions  cache.Cache
+
+		sync.Mutex
+		// generations are incremented by Invalidate to discard the decisions cached for a namespace.
+		generations map[string]int64
+	}
+
+	decisionCacheKey struct {
+		claimsHash [sha256.Size]byte
+		namespace  string
+		apiName    string
+	}
+
+	cachedDecision struct {
+		result              Result
+		generation          int64
+		notificationVersion int64
+	}
+)
+
+var _ Authorizer = (*CachingAuthorizer)(nil)
+
+// NewCachingAuthorizer returns an Authorizer that caches the decisions of authorizer for ttl. Cached decisions
+// ignore the request content, so this must only wrap authorizers that decide based on claims, namespace and API
+// alone. Decisions of a namespace are discarded whenever its config changes; errors are never cached.
+func NewCachingAuthorizer(
+	authorizer Authorizer,
+	registry namespace.Registry,
+	ttl time.Duration,
+	maxSize int,
+	timeSource clock.TimeSource,
+) *CachingAuthorizer {
+	if maxSize <= 0 {
+		maxSize = defaultDecisionCacheSize
+	}
+	a := &CachingAuthorizer{
+		authorizer:  authorizer,
+		registry:    registry,
+		decisions:   cache.New(maxSize, &cache.Options{TTL: ttl, TimeSource: timeSource}),
+		generations: make(map[string]int64),
+	}
+	registry.RegisterStateChangeCallback(a, func(ns *namespace.Namespace, _ bool) {
+		a.Invalidate(ns.Name().String())
+	})
+	return a
+}
+
+func (a *CachingAuthorizer) Authorize(ctx context.Context, claims *Claims, target *CallTarget) (Result, error) {
+	key, ok := newDecisionCacheKey(claims, target)
+	if !ok {
+		return a.authorizer.Authorize(ctx, claims, target)
+	}
+	generation := a.generation(target.Namespace)
+	notificationVersion := a.notificationVersion(target.Namespace)
+	if decision, ok := a.decisions.Get(key).(*cachedDecision); ok &&
+		decision.generation == generation &&
+		decision.notificationVersion == notificationVersion {
+		return decision.result, nil
+	}
+
+	result, err := a.authorizer.Authorize(ctx, claims, target)
+	if err != nil {
+		return result, err
+	}
+	a.decisions.Put(key, &cachedDecision{
+		result:              result,
+		generation:          generation,
+		notificationVersion: notificationVersion,
+	})
+	return result, nil
+}
+
+// Invalidate discards all cached decisions for the namespace.
+func (a *CachingAuthorizer) Invalidate(namespaceName string) {
+	a.Lock()
+	defer a.Unlock()
+	a.generations[namespaceName]++
+}
+
+func (a *CachingAuthorizer) generation(namespaceName string) int64 {
+	a.Lock()
+	defer a.Unlock()
+	return a.generations[namespaceName]
+}
+
+// notificationVersion returns the version of the namespace config, which changes on every namespace update.
+func (a *CachingAuthorizer) notificationVersion(namespaceName string) int64 {
+	if namespaceName == "" {
+		return 0
+	}
+	ns, err := a.registry.GetNamespaceWithOptions(namespace.Name(namespaceName), namespace.GetNamespaceOptions{DisableReadthrough: true})
+	if err != nil {
+		return -1
+	}
+	return ns.NotificationVersion()
+}
+
+func newDecisionCacheKey(claims *Claims, target *CallTarget) (decisionCacheKey, bool) {
+	key := decisionCacheKey{
+		namespace: target.Namespace,
+		apiName:   target.APIName,
+	}
+	// Nexus endpoint targets are not cached since the endpoint isn't part of the key.
+	if target.NexusEndpointName != "" {
+		return key, false
+	}
+	if claims != nil {
+		// Encoding is deterministic: struct fields are ordered and map keys are sorted.
+		data, err := json.Marshal(claims)
+		if err != nil {
+			return key, false
+		}
+		key.claimsHash = sha256.Sum256(data)
+	}
+	return key, true
+}
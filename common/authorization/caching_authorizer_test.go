@@ -0,0 +1,142 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.uber.org/mock/gomock"
+)
+
+const testCacheNamespace = "test-namespace"
+
+func newTestCacheNamespace(notificationVersion int64) *namespace.Namespace {
+	return namespace.FromPersistentState(&persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info:   &persistencespb.NamespaceInfo{Id: "test-namespace-id", Name: testCacheNamespace},
+			Config: &persistencespb.NamespaceConfig{},
+		},
+		NotificationVersion: notificationVersion,
+	})
+}
+
+type cachingAuthorizerTest struct {
+	authorizer *MockAuthorizer
+	registry   *namespace.MockRegistry
+	timeSource *clock.EventTimeSource
+	callback   namespace.StateChangeCallbackFn
+	caching    *CachingAuthorizer
+	currentNS  *namespace.Namespace
+}
+
+func newCachingAuthorizerTest(t *testing.T) *cachingAuthorizerTest {
+	ctrl := gomock.NewController(t)
+	test := &cachingAuthorizerTest{
+		authorizer: NewMockAuthorizer(ctrl),
+		registry:   namespace.NewMockRegistry(ctrl),
+		timeSource: clock.NewEventTimeSource().Update(time.Now()),
+		currentNS:  newTestCacheNamespace(1),
+	}
+	test.registry.EXPECT().RegisterStateChangeCallback(gomock.Any(), gomock.Any()).Do(
+		func(_ any, cb namespace.StateChangeCallbackFn) {
+			test.callback = cb
+		})
+	test.registry.EXPECT().GetNamespaceWithOptions(namespace.Name(testCacheNamespace), gomock.Any()).DoAndReturn(
+		func(namespace.Name, namespace.GetNamespaceOptions) (*namespace.Namespace, error) {
+			return test.currentNS, nil
+		}).AnyTimes()
+	test.caching = NewCachingAuthorizer(test.authorizer, test.registry, time.Minute, 0, test.timeSource)
+	return test
+}
+
+func TestCachingAuthorizer_CachesDecisions(t *testing.T) {
+	test := newCachingAuthorizerTest(t)
+	claims := &Claims{Subject: "user", Namespaces: map[string]Role{testCacheNamespace: RoleReader}}
+	target := &CallTarget{APIName: "/temporal.api.workflowservice.v1.WorkflowService/DescribeNamespace", Namespace: testCacheNamespace}
+	otherClaims := &Claims{Subject: "user", Namespaces: map[string]Role{testCacheNamespace: RoleWriter}}
+
+	test.authorizer.EXPECT().Authorize(gomock.Any(), claims, target).Return(Result{Decision: DecisionAllow}, nil).Times(2)
+	test.authorizer.EXPECT().Authorize(gomock.Any(), otherClaims, target).Return(Result{Decision: DecisionDeny, Reason: "no"}, nil).Times(1)
+
+	for i := 0; i < 3; i++ {
+		result, err := test.caching.Authorize(context.Background(), claims, target)
+		require.NoError(t, err)
+		require.Equal(t, DecisionAllow, result.Decision)
+
+		result, err = test.caching.Authorize(context.Background(), otherClaims, target)
+		require.NoError(t, err)
+		require.Equal(t, Result{Decision: DecisionDeny, Reason: "no"}, result)
+	}
+
+	// Expired decisions are not used.
+	test.timeSource.Advance(2 * time.Minute)
+	_, err := test.caching.Authorize(context.Background(), claims, target)
+	require.NoError(t, err)
+}
+
+func TestCachingAuthorizer_Invalidation(t *testing.T) {
+	test := newCachingAuthorizerTest(t)
+	target := &CallTarget{APIName: "/temporal.api.workflowservice.v1.WorkflowService/DescribeNamespace", Namespace: testCacheNamespace}
+
+	test.authorizer.EXPECT().Authorize(gomock.Any(), nil, target).Return(Result{Decision: DecisionAllow}, nil).Times(3)
+
+	authorize := func() {
+		_, err := test.caching.Authorize(context.Background(), nil, target)
+		require.NoError(t, err)
+	}
+	authorize()
+	authorize()
+
+	// Namespace config change.
+	test.currentNS = newTestCacheNamespace(2)
+	authorize()
+	authorize()
+
+	// Namespace state change notification.
+	test.callback(test.currentNS, false)
+	authorize()
+	authorize()
+}
+
+func TestCachingAuthorizer_ErrorsNotCached(t *testing.T) {
+	test := newCachingAuthorizerTest(t)
+	target := &CallTarget{APIName: "/temporal.api.workflowservice.v1.WorkflowService/DescribeNamespace", Namespace: testCacheNamespace}
+
+	gomock.InOrder(
+		test.authorizer.EXPECT().Authorize(gomock.Any(), nil, target).Return(Result{}, context.DeadlineExceeded),
+		test.authorizer.EXPECT().Authorize(gomock.Any(), nil, target).Return(Result{Decision: DecisionAllow}, nil),
+	)
+
+	_, err := test.caching.Authorize(context.Background(), nil, target)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	result, err := test.caching.Authorize(context.Background(), nil, target)
+	require.NoError(t, err)
+	require.Equal(t, DecisionAllow, result.Decision)
+}
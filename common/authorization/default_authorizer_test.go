@@ -192,7 +192,7 @@ func (s *defaultAuthorizerSuite) TestGetAuthorizerFromConfigUnknown() {
 func (s *defaultAuthorizerSuite) testGetAuthorizerFromConfig(name string, valid bool, authorizerType reflect.Type) {
 
 	cfg := config.Authorization{Authorizer: name}
-	auth, err := GetAuthorizerFromConfig(&cfg)
+	auth, err := GetAuthorizerFromConfig(&cfg, nil)
 	if valid {
 		s.NoError(err)
 		s.NotNil(auth)
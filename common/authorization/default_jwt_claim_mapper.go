@@ -40,6 +40,7 @@ const (
 	defaultPermissionsClaimName = "permissions"
 	authorizationBearer         = "bearer"
 	headerSubject               = "sub"
+	headerIssuer                = "iss"
 	permissionScopeSystem       = primitives.SystemLocalNamespace
 	permissionRead              = "read"
 	permissionWrite             = "write"
@@ -147,6 +148,9 @@ func parseJWTWithAudience(tokenString string, keyProvider TokenKeyProvider, audi
 				return nil, fmt.Errorf("malformed token - no \"kid\" header")
 			}
 			alg := token.Header["alg"].(string)
+			if issuer, trusted := trustedIssuer(keyProvider, token); trusted {
+				return keyProvider.(issuerTokenKeyProvider).issuerKey(issuer, alg, kid)
+			}
 			switch token.Method.(type) {
 			case *jwt.SigningMethodHMAC:
 				return keyProvider.HmacKey(alg, kid)
@@ -176,9 +180,45 @@ func parseJWTWithAudience(tokenString string, keyProvider TokenKeyProvider, audi
 	if strings.TrimSpace(audience) != "" && !claims.VerifyAudience(audience, true) {
 		return nil, serviceerror.NewPermissionDenied("audience mismatch", "")
 	}
+	if issuer, trusted := trustedIssuer(keyProvider, token); trusted {
+		if audiences, _ := keyProvider.(issuerTokenKeyProvider).issuerAudiences(issuer); !verifyAnyAudience(claims, audiences) {
+			return nil, serviceerror.NewPermissionDenied("audience mismatch", "")
+		}
+	}
 	return claims, nil
 }
 
+// trustedIssuer returns the issuer of token if keyProvider is configured with it. Tokens of other
+// issuers are validated with the keys that aren't bound to an issuer.
+func trustedIssuer(keyProvider TokenKeyProvider, token *jwt.Token) (string, bool) {
+	provider, ok := keyProvider.(issuerTokenKeyProvider)
+	if !ok || !provider.hasIssuers() {
+		return "", false
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return "", false
+	}
+	issuer, ok := claims[headerIssuer].(string)
+	if !ok {
+		return "", false
+	}
+	_, trusted := provider.issuerAudiences(issuer)
+	return issuer, trusted
+}
+
+func verifyAnyAudience(claims jwt.MapClaims, audiences []string) bool {
+	if len(audiences) == 0 {
+		return true
+	}
+	for _, audience := range audiences {
+		if claims.VerifyAudience(audience, true) {
+			return true
+		}
+	}
+	return false
+}
+
 func permissionToRole(permission string) Role {
 	switch strings.ToLower(permission) {
 	case permissionRead:
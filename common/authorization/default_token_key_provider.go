@@ -35,6 +35,8 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v4"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -42,28 +44,69 @@ import (
 	"gopkg.in/go-jose/go-jose.v2"
 )
 
-// Default token key provider
-type defaultTokenKeyProvider struct {
-	config   config.JWTKeyProvider
-	rsaKeys  map[string]*rsa.PublicKey
-	ecKeys   map[string]*ecdsa.PublicKey
-	keysLock sync.RWMutex
-	ticker   *time.Ticker
-	logger   log.Logger
-	stop     chan bool
-}
+const (
+	// legacyIssuer groups keys from JWTKeyProvider.KeySourceURIs, which aren't bound to an issuer
+	legacyIssuer = ""
+
+	// minOnDemandRefreshInterval limits how often a token signed with an unknown key triggers a refresh
+	minOnDemandRefreshInterval  = 30 * time.Second
+	refreshRetryInitialInterval = time.Second
+)
+
+type (
+	// Default token key provider
+	defaultTokenKeyProvider struct {
+		config      config.JWTKeyProvider
+		sources     map[string][]string // issuer -> key source URIs
+		audiences   map[string][]string // issuer -> accepted audiences
+		keys        map[string]map[string]tokenKey
+		keysLock    sync.RWMutex
+		refreshLock sync.Mutex
+		lastRefresh time.Time
+		timeSource  clock.TimeSource
+		timer       *time.Timer
+		logger      log.Logger
+		stop        chan bool
+	}
+
+	tokenKey struct {
+		key      interface{} // *rsa.PublicKey or *ecdsa.PublicKey
+		lastSeen time.Time
+	}
+
+	// issuerTokenKeyProvider is implemented by token key providers that scope keys to trusted issuers.
+	issuerTokenKeyProvider interface {
+		hasIssuers() bool
+		issuerAudiences(issuer string) (audiences []string, trusted bool)
+		issuerKey(issuer string, alg string, kid string) (interface{}, error)
+	}
+)
 
 var _ TokenKeyProvider = (*defaultTokenKeyProvider)(nil)
+var _ issuerTokenKeyProvider = (*defaultTokenKeyProvider)(nil)
 
 func NewDefaultTokenKeyProvider(cfg *config.Authorization, logger log.Logger) *defaultTokenKeyProvider {
-	provider := defaultTokenKeyProvider{config: cfg.JWTKeyProvider, logger: logger}
+	provider := defaultTokenKeyProvider{
+		config:     cfg.JWTKeyProvider,
+		timeSource: clock.NewRealTimeSource(),
+		logger:     logger,
+	}
 	provider.initialize()
 	return &provider
 }
 
 func (a *defaultTokenKeyProvider) initialize() {
-	a.rsaKeys = make(map[string]*rsa.PublicKey)
-	a.ecKeys = make(map[string]*ecdsa.PublicKey)
+	a.keys = make(map[string]map[string]tokenKey)
+	a.sources = map[string][]string{legacyIssuer: a.config.KeySourceURIs}
+	a.audiences = make(map[string][]string)
+	for _, issuer := range a.config.Issuers {
+		if issuer.Issuer == "" {
+			a.logger.Warn("ignoring JWT issuer with empty name")
+			continue
+		}
+		a.sources[issuer.Issuer] = append(a.sources[issuer.Issuer], issuer.KeySourceURIs...)
+		a.audiences[issuer.Issuer] = append(a.audiences[issuer.Issuer], issuer.Audiences...)
+	}
 	if a.config.HasSourceURIsConfigured() {
 		err := a.updateKeys()
 		if err != nil {
@@ -72,13 +115,16 @@ func (a *defaultTokenKeyProvider) initialize() {
 	}
 	if a.config.RefreshInterval > 0 {
 		a.stop = make(chan bool)
-		a.ticker = time.NewTicker(a.config.RefreshInterval)
+		a.timer = time.NewTimer(a.config.RefreshInterval)
 		go a.timerCallback()
 	}
 }
 
 func (a *defaultTokenKeyProvider) Close() {
-	a.ticker.Stop()
+	if a.timer == nil {
+		return
+	}
+	a.timer.Stop()
 	a.stop <- true
 	close(a.stop)
 }
@@ -88,13 +134,12 @@ func (a *defaultTokenKeyProvider) RsaKey(alg string, kid string) (*rsa.PublicKey
 		return nil, fmt.Errorf("unexpected signing algorithm: %s", alg)
 	}
 
-	a.keysLock.RLock()
-	key, found := a.rsaKeys[kid]
-	a.keysLock.RUnlock()
-	if !found {
+	key, found := a.lookupKey(legacyIssuer, kid)
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !found || !ok {
 		return nil, fmt.Errorf("RSA key not found for key ID: %s", kid)
 	}
-	return key, nil
+	return rsaKey, nil
 }
 
 func (a *defaultTokenKeyProvider) EcdsaKey(alg string, kid string) (*ecdsa.PublicKey, error) {
@@ -102,64 +147,167 @@ func (a *defaultTokenKeyProvider) EcdsaKey(alg string, kid string) (*ecdsa.Publi
 		return nil, fmt.Errorf("unexpected signing algorithm: %s", alg)
 	}
 
-	a.keysLock.RLock()
-	key, found := a.ecKeys[kid]
-	a.keysLock.RUnlock()
-	if !found {
+	key, found := a.lookupKey(legacyIssuer, kid)
+	ecKey, ok := key.(*ecdsa.PublicKey)
+	if !found || !ok {
 		return nil, fmt.Errorf("ECDSA key not found for key ID: %s", kid)
 	}
-	return key, nil
+	return ecKey, nil
 }
 
 func (a *defaultTokenKeyProvider) SupportedMethods() []string {
 	return []string{jwt.SigningMethodRS256.Name, jwt.SigningMethodES256.Name}
 }
 
+func (a *defaultTokenKeyProvider) HmacKey(alg string, kid string) ([]byte, error) {
+	return nil, fmt.Errorf("unsupported key type HMAC for: %s", alg)
+}
+
+func (a *defaultTokenKeyProvider) hasIssuers() bool {
+	return len(a.audiences) > 0
+}
+
+func (a *defaultTokenKeyProvider) issuerAudiences(issuer string) ([]string, bool) {
+	audiences, trusted := a.audiences[issuer]
+	return audiences, trusted
+}
+
+func (a *defaultTokenKeyProvider) issuerKey(issuer string, alg string, kid string) (interface{}, error) {
+	key, found := a.lookupKey(issuer, kid)
+	if !found {
+		return nil, fmt.Errorf("key not found for issuer %q and key ID: %s", issuer, kid)
+	}
+	switch {
+	case strings.EqualFold(alg, jwt.SigningMethodRS256.Name):
+		if rsaKey, ok := key.(*rsa.PublicKey); ok {
+			return rsaKey, nil
+		}
+	case strings.EqualFold(alg, jwt.SigningMethodES256.Name):
+		if ecKey, ok := key.(*ecdsa.PublicKey); ok {
+			return ecKey, nil
+		}
+	}
+	return nil, fmt.Errorf("unexpected signing algorithm %s for key ID: %s", alg, kid)
+}
+
+// lookupKey returns the key with the given ID published by issuer. A key that isn't known yet
+// triggers a refresh, rate limited by minOnDemandRefreshInterval, to pick up newly rolled keys
+// before the next scheduled refresh.
+func (a *defaultTokenKeyProvider) lookupKey(issuer string, kid string) (interface{}, bool) {
+	a.keysLock.RLock()
+	key, found := a.keys[issuer][kid]
+	a.keysLock.RUnlock()
+	if found {
+		return key.key, true
+	}
+	if !hasURIs(a.sources[issuer]) || !a.refreshOnDemand() {
+		return nil, false
+	}
+
+	a.keysLock.RLock()
+	key, found = a.keys[issuer][kid]
+	a.keysLock.RUnlock()
+	return key.key, found
+}
+
+func (a *defaultTokenKeyProvider) refreshOnDemand() bool {
+	a.refreshLock.Lock()
+	defer a.refreshLock.Unlock()
+	if a.timeSource.Now().Sub(a.lastRefresh) < minOnDemandRefreshInterval {
+		return false
+	}
+	if err := a.updateKeysLocked(); err != nil {
+		a.logger.Error("error while refreshing token keys for unknown key ID: ", tag.Error(err))
+	}
+	return true
+}
+
 func (a *defaultTokenKeyProvider) timerCallback() {
+	// failed refreshes are retried with backoff instead of waiting for the next refresh interval
+	retrier := backoff.NewRetrier(
+		backoff.NewExponentialRetryPolicy(refreshRetryInitialInterval).
+			WithMaximumInterval(a.config.RefreshInterval).
+			WithExpirationInterval(backoff.NoInterval),
+		a.timeSource,
+	)
 	for {
 		select {
 		case <-a.stop:
 			return
-		case <-a.ticker.C:
+		case <-a.timer.C:
 		}
+		next := a.config.RefreshInterval
 		if a.config.HasSourceURIsConfigured() {
 			err := a.updateKeys()
 			if err != nil {
 				a.logger.Error("error while refreshing token keys: ", tag.Error(err))
+				next = retrier.NextBackOff(err)
+			} else {
+				retrier.Reset()
 			}
 		}
+		a.timer.Reset(next)
 	}
 }
 
 func (a *defaultTokenKeyProvider) updateKeys() error {
+	a.refreshLock.Lock()
+	defer a.refreshLock.Unlock()
+	return a.updateKeysLocked()
+}
+
+// updateKeysLocked fetches the keys of every issuer. Keys of an issuer whose sources can't be
+// fetched are kept as they are; keys that disappeared from a source are kept for the rollover
+// grace period.
+func (a *defaultTokenKeyProvider) updateKeysLocked() error {
 	if !a.config.HasSourceURIsConfigured() {
 		return fmt.Errorf("no URIs configured for retrieving token keys")
 	}
 
-	rsaKeys := make(map[string]*rsa.PublicKey)
-	ecKeys := make(map[string]*ecdsa.PublicKey)
+	now := a.timeSource.Now()
+	a.lastRefresh = now
 
-	for _, uri := range a.config.KeySourceURIs {
-		if strings.TrimSpace(uri) == "" {
-			continue
+	a.keysLock.RLock()
+	current := a.keys
+	a.keysLock.RUnlock()
+
+	var errs error
+	keys := make(map[string]map[string]tokenKey, len(a.sources))
+	for issuer, uris := range a.sources {
+		issuerKeys := make(map[string]tokenKey)
+		var err error
+		for _, uri := range uris {
+			if strings.TrimSpace(uri) == "" {
+				continue
+			}
+			if err = a.updateKeysFromURI(uri, now, issuerKeys); err != nil {
+				break
+			}
 		}
-		err := a.updateKeysFromURI(uri, rsaKeys, ecKeys)
 		if err != nil {
-			return err
+			errs = multierr.Append(errs, fmt.Errorf("issuer %q: %w", issuer, err))
+			keys[issuer] = current[issuer]
+			continue
+		}
+		for kid, key := range current[issuer] {
+			if _, ok := issuerKeys[kid]; !ok && now.Sub(key.lastSeen) < a.config.KeyRolloverGracePeriod {
+				issuerKeys[kid] = key
+			}
 		}
+		keys[issuer] = issuerKeys
 	}
+
 	// swap old keys with the new ones
 	a.keysLock.Lock()
-	a.rsaKeys = rsaKeys
-	a.ecKeys = ecKeys
+	a.keys = keys
 	a.keysLock.Unlock()
-	return nil
+	return errs
 }
 
 func (a *defaultTokenKeyProvider) updateKeysFromURI(
 	uri string,
-	rsaKeys map[string]*rsa.PublicKey,
-	ecKeys map[string]*ecdsa.PublicKey,
+	now time.Time,
+	keys map[string]tokenKey,
 ) (err error) {
 
 	resp, err := http.Get(uri)
@@ -169,6 +317,9 @@ func (a *defaultTokenKeyProvider) updateKeysFromURI(
 	defer func() {
 		err = multierr.Combine(err, resp.Body.Close())
 	}()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d from %s", resp.StatusCode, uri)
+	}
 
 	jwks := jose.JSONWebKeySet{}
 	err = json.NewDecoder(resp.Body).Decode(&jwks)
@@ -178,10 +329,8 @@ func (a *defaultTokenKeyProvider) updateKeysFromURI(
 
 	for _, k := range jwks.Keys {
 		switch k.Key.(type) {
-		case *rsa.PublicKey:
-			rsaKeys[k.KeyID] = k.Key.(*rsa.PublicKey)
-		case *ecdsa.PublicKey:
-			ecKeys[k.KeyID] = k.Key.(*ecdsa.PublicKey)
+		case *rsa.PublicKey, *ecdsa.PublicKey:
+			keys[k.KeyID] = tokenKey{key: k.Key, lastSeen: now}
 		default:
 			a.logger.Warn(fmt.Sprintf("unexpected type of JWKS public key %s", k.Algorithm))
 		}
@@ -189,6 +338,11 @@ func (a *defaultTokenKeyProvider) updateKeysFromURI(
 	return nil
 }
 
-func (a *defaultTokenKeyProvider) HmacKey(alg string, kid string) ([]byte, error) {
-	return nil, fmt.Errorf("unsupported key type HMAC for: %s", alg)
+func hasURIs(uris []string) bool {
+	for _, uri := range uris {
+		if strings.TrimSpace(uri) != "" {
+			return true
+		}
+	}
+	return false
 }
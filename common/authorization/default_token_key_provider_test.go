@@ -0,0 +1,168 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"gopkg.in/go-jose/go-jose.v2"
+)
+
+type jwksServer struct {
+	*httptest.Server
+
+	sync.Mutex
+	keys   []jose.JSONWebKey
+	status int
+}
+
+func newJWKSServer(t *testing.T, keys ...jose.JSONWebKey) *jwksServer {
+	s := &jwksServer{keys: keys, status: http.StatusOK}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.Lock()
+		defer s.Unlock()
+		if s.status != http.StatusOK {
+			w.WriteHeader(s.status)
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: s.keys}))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *jwksServer) set(status int, keys ...jose.JSONWebKey) {
+	s.Lock()
+	defer s.Unlock()
+	s.status = status
+	s.keys = keys
+}
+
+func signTestToken(t *testing.T, method jwt.SigningMethod, key interface{}, kid string, issuer string, audience string) string {
+	token := jwt.NewWithClaims(method, jwt.MapClaims{
+		"sub": testSubject,
+		"iss": issuer,
+		"aud": audience,
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return "Bearer " + signed
+}
+
+func newTestTokenKeyProvider(cfg config.JWTKeyProvider, timeSource clock.TimeSource) *defaultTokenKeyProvider {
+	provider := &defaultTokenKeyProvider{config: cfg, timeSource: timeSource, logger: log.NewNoopLogger()}
+	provider.initialize()
+	return provider
+}
+
+func TestDefaultTokenKeyProvider_MultipleIssuers(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	serverA := newJWKSServer(t, jose.JSONWebKey{Key: &rsaKey.PublicKey, KeyID: "a1"})
+	serverB := newJWKSServer(t, jose.JSONWebKey{Key: &ecKey.PublicKey, KeyID: "b1"})
+
+	provider := newTestTokenKeyProvider(config.JWTKeyProvider{
+		Issuers: []config.JWTIssuer{
+			{Issuer: "issuer-a", KeySourceURIs: []string{serverA.URL}, Audiences: []string{"temporal"}},
+			{Issuer: "issuer-b", KeySourceURIs: []string{serverB.URL}},
+		},
+	}, clock.NewRealTimeSource())
+	claimMapper := NewDefaultJWTClaimMapper(provider, &config.Authorization{}, log.NewNoopLogger())
+
+	testCases := []struct {
+		name    string
+		token   string
+		success bool
+	}{
+		{"issuer a", signTestToken(t, jwt.SigningMethodRS256, rsaKey, "a1", "issuer-a", "temporal"), true},
+		{"issuer a wrong audience", signTestToken(t, jwt.SigningMethodRS256, rsaKey, "a1", "issuer-a", "other"), false},
+		{"issuer b", signTestToken(t, jwt.SigningMethodES256, ecKey, "b1", "issuer-b", "any"), true},
+		{"issuer a with key of issuer b", signTestToken(t, jwt.SigningMethodES256, ecKey, "b1", "issuer-a", "temporal"), false},
+		{"untrusted issuer", signTestToken(t, jwt.SigningMethodRS256, rsaKey, "a1", "issuer-c", "temporal"), false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			claims, err := claimMapper.GetClaims(&AuthInfo{AuthToken: tc.token})
+			if tc.success {
+				require.NoError(t, err)
+				require.Equal(t, testSubject, claims.Subject)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestDefaultTokenKeyProvider_KeyRollover(t *testing.T) {
+	oldKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	newKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	server := newJWKSServer(t, jose.JSONWebKey{Key: &oldKey.PublicKey, KeyID: "old"})
+
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	provider := newTestTokenKeyProvider(config.JWTKeyProvider{
+		KeySourceURIs:          []string{server.URL},
+		KeyRolloverGracePeriod: time.Hour,
+	}, timeSource)
+
+	// a failed refresh keeps the current keys
+	server.set(http.StatusInternalServerError)
+	require.Error(t, provider.updateKeys())
+	_, err = provider.RsaKey("RS256", "old")
+	require.NoError(t, err)
+
+	// a key that isn't known yet triggers a refresh, and the removed key is kept for the grace period
+	server.set(http.StatusOK, jose.JSONWebKey{Key: &newKey.PublicKey, KeyID: "new"})
+	timeSource.Advance(minOnDemandRefreshInterval)
+	key, err := provider.RsaKey("RS256", "new")
+	require.NoError(t, err)
+	require.Equal(t, &newKey.PublicKey, key)
+	_, err = provider.RsaKey("RS256", "old")
+	require.NoError(t, err)
+
+	timeSource.Advance(time.Hour)
+	require.NoError(t, provider.updateKeys())
+	_, err = provider.RsaKey("RS256", "old")
+	require.Error(t, err)
+	_, err = provider.RsaKey("RS256", "new")
+	require.NoError(t, err)
+}
@@ -32,6 +32,7 @@ import (
 	"time"
 
 	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/audit"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -108,6 +109,7 @@ type Interceptor struct {
 	audienceGetter      JWTAudienceMapper
 	authHeaderName      string
 	authExtraHeaderName string
+	auditLogger         audit.Logger
 }
 
 // NewInterceptor creates an authorization interceptor.
@@ -120,7 +122,11 @@ func NewInterceptor(
 	audienceGetter JWTAudienceMapper,
 	authHeaderName string,
 	authExtraHeaderName string,
+	auditLogger audit.Logger,
 ) *Interceptor {
+	if auditLogger == nil {
+		auditLogger = audit.NewNoopLogger()
+	}
 	return &Interceptor{
 		claimMapper:         claimMapper,
 		authorizer:          authorizer,
@@ -130,6 +136,7 @@ func NewInterceptor(
 		authHeaderName:      cmp.Or(authHeaderName, defaultAuthHeaderName),
 		authExtraHeaderName: cmp.Or(authExtraHeaderName, defaultAuthExtraHeaderName),
 		audienceGetter:      audienceGetter,
+		auditLogger:         auditLogger,
 	}
 }
 
@@ -232,7 +239,7 @@ func (a *Interceptor) EnhanceContext(ctx context.Context, authInfo *AuthInfo, cl
 }
 
 // Authorize uses the policy's authorizer to authorize a request based on provided claims and call target.
-// Logs and emits metrics when unauthorized.
+// Logs, emits metrics and records an audit event when unauthorized.
 func (a *Interceptor) Authorize(ctx context.Context, claims *Claims, ct *CallTarget) error {
 	if a.authorizer == nil {
 		return nil
@@ -246,10 +253,12 @@ func (a *Interceptor) Authorize(ctx context.Context, claims *Claims, ct *CallTar
 	if err != nil {
 		metrics.ServiceErrAuthorizeFailedCounter.With(mh).Record(1)
 		a.logger.Error("Authorization error", tag.Error(err))
+		a.emitDenied(ctx, claims, ct, err.Error())
 		return errUnauthorized // return a generic error to the caller without disclosing details
 	}
 	if result.Decision != DecisionAllow {
 		metrics.ServiceErrUnauthorizedCounter.With(mh).Record(1)
+		a.emitDenied(ctx, claims, ct, result.Reason)
 		// if a reason is included in the result, include it in the error message
 		if result.Reason != "" {
 			return serviceerror.NewPermissionDenied(RequestUnauthorized, result.Reason)
@@ -259,6 +268,20 @@ func (a *Interceptor) Authorize(ctx context.Context, claims *Claims, ct *CallTar
 	return nil
 }
 
+func (a *Interceptor) emitDenied(ctx context.Context, claims *Claims, ct *CallTarget, reason string) {
+	event := &audit.Event{
+		Type:      audit.EventTypeAuthorizationDenied,
+		API:       ct.APIName,
+		Namespace: ct.Namespace,
+		Outcome:   audit.OutcomeDenied,
+		Reason:    reason,
+	}
+	if claims != nil {
+		event.Subject = claims.Subject
+	}
+	a.auditLogger.Emit(ctx, event)
+}
+
 // getMetricsHandler returns a metrics handler with a namespace tag
 func (a *Interceptor) getMetricsHandler(nsName string) metrics.Handler {
 	nsTag := metrics.NamespaceUnknownTag()
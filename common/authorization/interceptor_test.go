@@ -32,6 +32,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/common/audit"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
@@ -97,6 +98,7 @@ func (s *authorizerInterceptorSuite) SetupTest() {
 		nil,
 		"",
 		"",
+		nil,
 	)
 	s.handler = func(ctx context.Context, req interface{}) (interface{}, error) { return true, nil }
 }
@@ -133,6 +135,36 @@ func (s *authorizerInterceptorSuite) TestIsUnauthorized() {
 	s.Error(err)
 }
 
+func (s *authorizerInterceptorSuite) TestIsUnauthorized_AuditEvent() {
+	auditLogger := &recordingAuditLogger{}
+	interceptor := NewInterceptor(
+		s.mockClaimMapper,
+		s.mockAuthorizer,
+		s.mockMetricsHandler,
+		log.NewNoopLogger(),
+		mockNamespaceChecker(testNamespace),
+		nil,
+		"",
+		"",
+		auditLogger,
+	)
+	claims := &Claims{Subject: "alice"}
+	s.mockAuthorizer.EXPECT().Authorize(ctx, claims, describeNamespaceTarget).
+		Return(Result{Decision: DecisionDeny, Reason: "no role"}, nil)
+	s.mockMetricsHandler.EXPECT().Counter(metrics.ServiceErrUnauthorizedCounter.Name()).Return(metrics.NoopCounterMetricFunc)
+
+	err := interceptor.Authorize(ctx, claims, describeNamespaceTarget)
+	s.Error(err)
+	s.Equal([]*audit.Event{{
+		Type:      audit.EventTypeAuthorizationDenied,
+		API:       describeNamespaceTarget.APIName,
+		Namespace: testNamespace,
+		Subject:   "alice",
+		Outcome:   audit.OutcomeDenied,
+		Reason:    "no role",
+	}}, auditLogger.events)
+}
+
 func (s *authorizerInterceptorSuite) TestIsUnknown() {
 	request := &workflowservice.DescribeNamespaceRequest{Namespace: "unknown-namespace"}
 	target := &CallTarget{Namespace: "unknown-namespace", Request: request, APIName: "/temporal.api.workflowservice.v1.WorkflowService/DescribeNamespace"}
@@ -179,6 +211,7 @@ func (s *authorizerInterceptorSuite) TestNoopClaimMapperWithoutTLS() {
 		nil,
 		"",
 		"",
+		nil,
 	)
 	_, err := interceptor.Intercept(ctx, describeNamespaceRequest, describeNamespaceInfo, s.handler)
 	s.NoError(err)
@@ -194,6 +227,7 @@ func (s *authorizerInterceptorSuite) TestAlternateHeaders() {
 		nil,
 		"custom-header",
 		"custom-extra-header",
+		nil,
 	)
 
 	cases := []struct {
@@ -242,3 +276,11 @@ func (n mockNamespaceChecker) Exists(name namespace.Name) error {
 	}
 	return errors.New("doesn't exist")
 }
+
+type recordingAuditLogger struct {
+	events []*audit.Event
+}
+
+func (l *recordingAuditLogger) Emit(_ context.Context, event *audit.Event) {
+	l.events = append(l.events, event)
+}
@@ -0,0 +1,326 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"go.temporal.io/server/common/api"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/util"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	PolicyEffectAllow = "allow"
+	PolicyEffectDeny  = "deny"
+
+	defaultDynamicConfigPolicyRefreshInterval = 10 * time.Second
+)
+
+type (
+	// Policy is a list of declarative authorization rules evaluated by the policy authorizer. Policies are written in
+	// YAML or JSON, e.g.
+	//
+	//	rules:
+	//	  - effect: allow
+	//	    apiGroups: ["WorkflowService"]
+	//	    namespaces: ["payments-*"]
+	//	    roles: ["writer"]
+	//	  - effect: deny
+	//	    apis: ["Terminate*", "Reset*"]
+	//	    namespaces: ["payments-prod"]
+	Policy struct {
+		Rules []PolicyRule `yaml:"rules"`
+	}
+
+	// PolicyRule allows or denies the API calls matching all of its non empty fields. Patterns may contain wildcards
+	// (*) matching any substring.
+	PolicyRule struct {
+		// Effect is either "allow" or "deny".
+		Effect string `yaml:"effect"`
+		// APIGroups are patterns of the services of the APIs, e.g. "WorkflowService" or "OperatorService".
+		APIGroups []string `yaml:"apiGroups"`
+		// APIs are patterns of the method names of the APIs, e.g. "StartWorkflowExecution".
+		APIs []string `yaml:"apis"`
+		// Access lists the access levels of the APIs: "readonly", "write" or "admin".
+		Access []string `yaml:"access"`
+		// Namespaces are patterns of the target namespaces. APIs not targeting a namespace only match "*".
+		Namespaces []string `yaml:"namespaces"`
+		// Subjects are patterns of the caller subjects.
+		Subjects []string `yaml:"subjects"`
+		// Roles lists the roles the caller must have at least one of, at the system level or in the target
+		// namespace: "worker", "reader", "writer" or "admin".
+		Roles []string `yaml:"roles"`
+	}
+
+	policyAuthorizer struct {
+		// source describes where the policy is loaded from in errors.
+		source          string
+		load            func() ([]byte, error)
+		refreshInterval time.Duration
+
+		sync.Mutex
+		rules       []policyRule
+		policy      []byte
+		nextRefresh time.Time
+	}
+
+	policyRule struct {
+		allow      bool
+		apiGroups  *regexp.Regexp
+		apis       *regexp.Regexp
+		access     map[api.Access]struct{}
+		namespaces *regexp.Regexp
+		subjects   *regexp.Regexp
+		roles      Role
+	}
+)
+
+var _ Authorizer = (*policyAuthorizer)(nil)
+
+var (
+	errPolicyFileNotSet = errors.New("policyFile must be set for the policy authorizer")
+	errPolicyNotSet     = errors.New("policy, policyFile or dynamic config must be set for the policy authorizer")
+)
+
+// NewPolicyAuthorizer creates an authorizer evaluating the policy stored in a file. The file is reloaded when it
+// changes, checking at most once per refreshInterval. Zero refreshInterval disables reloading.
+func NewPolicyAuthorizer(path string, refreshInterval time.Duration) (Authorizer, error) {
+	if path == "" {
+		return nil, errPolicyFileNotSet
+	}
+	return newPolicyAuthorizer(
+		"policy file "+path,
+		func() ([]byte, error) { return os.ReadFile(path) },
+		refreshInterval,
+	)
+}
+
+// NewStaticPolicyAuthorizer creates an authorizer evaluating a policy which never changes.
+func NewStaticPolicyAuthorizer(policy string) (Authorizer, error) {
+	return newPolicyAuthorizer(
+		"policy",
+		func() ([]byte, error) { return []byte(policy), nil },
+		0,
+	)
+}
+
+// NewDynamicConfigPolicyAuthorizer creates an authorizer evaluating the policy stored in dynamic config. The policy
+// is reloaded when it changes, checking at most once per refreshInterval. Zero refreshInterval defaults to 10s.
+func NewDynamicConfigPolicyAuthorizer(policy dynamicconfig.StringPropertyFn, refreshInterval time.Duration) (Authorizer, error) {
+	if refreshInterval == 0 {
+		refreshInterval = defaultDynamicConfigPolicyRefreshInterval
+	}
+	return newPolicyAuthorizer(
+		"dynamic config "+dynamicconfig.FrontendAuthorizationPolicy.Key().String(),
+		func() ([]byte, error) { return []byte(policy()), nil },
+		refreshInterval,
+	)
+}
+
+func newPolicyAuthorizer(source string, load func() ([]byte, error), refreshInterval time.Duration) (Authorizer, error) {
+	a := &policyAuthorizer{
+		source:          source,
+		load:            load,
+		refreshInterval: refreshInterval,
+	}
+	if err := a.reload(time.Now()); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Authorize evaluates the rules of the policy: the call is denied if any deny rule matches it, allowed if any allow
+// rule matches it and denied otherwise. Health check APIs are always allowed.
+func (a *policyAuthorizer) Authorize(_ context.Context, claims *Claims, target *CallTarget) (Result, error) {
+	if IsHealthCheckAPI(target.APIName) {
+		return resultAllow, nil
+	}
+
+	allowed := false
+	for _, rule := range a.getRules() {
+		if !rule.matches(claims, target) {
+			continue
+		}
+		if !rule.allow {
+			return Result{Decision: DecisionDeny, Reason: "denied by policy"}, nil
+		}
+		allowed = true
+	}
+	if allowed {
+		return resultAllow, nil
+	}
+	return resultDeny, nil
+}
+
+func (a *policyAuthorizer) getRules() []policyRule {
+	a.Lock()
+	defer a.Unlock()
+
+	now := time.Now()
+	if a.refreshInterval > 0 && !now.Before(a.nextRefresh) {
+		// A policy which fails to load doesn't replace the current one, it is checked again at the next refresh.
+		_ = a.reload(now)
+	}
+	return a.rules
+}
+
+func (a *policyAuthorizer) reload(now time.Time) error {
+	a.nextRefresh = now.Add(a.refreshInterval)
+	content, err := a.load()
+	if err != nil {
+		return err
+	}
+	if a.rules != nil && bytes.Equal(content, a.policy) {
+		return nil
+	}
+	rules, err := parsePolicy(content)
+	if err != nil {
+		return fmt.Errorf("invalid %s: %w", a.source, err)
+	}
+	a.rules = rules
+	a.policy = content
+	return nil
+}
+
+// parsePolicy parses and validates a YAML or JSON policy. Unknown fields are rejected, so that a misspelled field
+// doesn't silently widen a rule. An empty policy denies all calls.
+func parsePolicy(content []byte) ([]policyRule, error) {
+	var policy Policy
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&policy); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	rules := make([]policyRule, 0, len(policy.Rules))
+	for i, r := range policy.Rules {
+		rule, err := compilePolicyRule(r)
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: %w", i, err)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+func compilePolicyRule(r PolicyRule) (policyRule, error) {
+	var rule policyRule
+	var err error
+	switch strings.ToLower(r.Effect) {
+	case PolicyEffectAllow:
+		rule.allow = true
+	case PolicyEffectDeny:
+	default:
+		return rule, fmt.Errorf("unknown effect: %q", r.Effect)
+	}
+	if rule.apiGroups, err = compilePolicyPatterns(r.APIGroups); err != nil {
+		return rule, err
+	}
+	if rule.apis, err = compilePolicyPatterns(r.APIs); err != nil {
+		return rule, err
+	}
+	if rule.namespaces, err = compilePolicyPatterns(r.Namespaces); err != nil {
+		return rule, err
+	}
+	if rule.subjects, err = compilePolicyPatterns(r.Subjects); err != nil {
+		return rule, err
+	}
+	for _, access := range r.Access {
+		if rule.access == nil {
+			rule.access = make(map[api.Access]struct{})
+		}
+		switch strings.ToLower(access) {
+		case "readonly":
+			rule.access[api.AccessReadOnly] = struct{}{}
+		case "write":
+			rule.access[api.AccessWrite] = struct{}{}
+		case "admin":
+			rule.access[api.AccessAdmin] = struct{}{}
+		default:
+			return rule, fmt.Errorf("unknown access: %q", access)
+		}
+	}
+	for _, role := range r.Roles {
+		switch strings.ToLower(role) {
+		case "worker":
+			rule.roles |= RoleWorker
+		case "reader":
+			rule.roles |= RoleReader
+		case "writer":
+			rule.roles |= RoleWriter
+		case "admin":
+			rule.roles |= RoleAdmin
+		default:
+			return rule, fmt.Errorf("unknown role: %q", role)
+		}
+	}
+	return rule, nil
+}
+
+func compilePolicyPatterns(patterns []string) (*regexp.Regexp, error) {
+	if len(patterns) == 0 {
+		return nil, nil
+	}
+	return util.WildCardStringsToRegexp(patterns)
+}
+
+func (r *policyRule) matches(claims *Claims, target *CallTarget) bool {
+	if r.apiGroups != nil && !r.apiGroups.MatchString(policyAPIGroup(target.APIName)) {
+		return false
+	}
+	if r.apis != nil && !r.apis.MatchString(api.MethodName(target.APIName)) {
+		return false
+	}
+	if r.access != nil {
+		if _, ok := r.access[api.GetMethodMetadata(target.APIName).Access]; !ok {
+			return false
+		}
+	}
+	if r.namespaces != nil && !r.namespaces.MatchString(target.Namespace) {
+		return false
+	}
+	if r.subjects != nil && (claims == nil || !r.subjects.MatchString(claims.Subject)) {
+		return false
+	}
+	if r.roles != RoleUndefined && (claims == nil || (claims.System|claims.Namespaces[target.Namespace])&r.roles == 0) {
+		return false
+	}
+	return true
+}
+
+// policyAPIGroup returns the short name of the service of an API, e.g. "WorkflowService" for
+// "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution".
+func policyAPIGroup(fullApiName string) string {
+	service := strings.Trim(api.ServiceName(fullApiName), "/")
+	return service[strings.LastIndex(service, ".")+1:]
+}
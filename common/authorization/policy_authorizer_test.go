@@ -0,0 +1,183 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+)
+
+const testPolicy = `
+rules:
+  - effect: allow
+    apiGroups: ["WorkflowService"]
+    namespaces: ["payments-*"]
+    roles: ["writer"]
+  - effect: allow
+    access: ["readonly"]
+    subjects: ["auditor@*"]
+  - effect: deny
+    apis: ["Terminate*"]
+    namespaces: ["payments-prod"]
+`
+
+func writePolicyFile(t *testing.T, path string, content string) {
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+}
+
+func TestPolicyAuthorizer(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.yaml")
+	writePolicyFile(t, path, testPolicy)
+	authorizer, err := GetAuthorizerFromConfig(&config.Authorization{Authorizer: "policy", PolicyFile: path}, nil)
+	require.NoError(t, err)
+
+	writer := &Claims{Subject: "writer", Namespaces: map[string]Role{"payments-prod": RoleWriter}}
+	auditor := &Claims{Subject: "auditor@example.com"}
+	startWorkflow := &CallTarget{
+		APIName:   "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution",
+		Namespace: "payments-prod",
+	}
+	terminateWorkflow := &CallTarget{
+		APIName:   "/temporal.api.workflowservice.v1.WorkflowService/TerminateWorkflowExecution",
+		Namespace: "payments-prod",
+	}
+	describeWorkflow := &CallTarget{
+		APIName:   "/temporal.api.workflowservice.v1.WorkflowService/DescribeWorkflowExecution",
+		Namespace: "orders",
+	}
+	addSearchAttributes := &CallTarget{
+		APIName: "/temporal.api.operatorservice.v1.OperatorService/AddSearchAttributes",
+	}
+
+	for _, tc := range []struct {
+		name     string
+		claims   *Claims
+		target   *CallTarget
+		decision Decision
+	}{
+		{"writer starts workflow", writer, startWorkflow, DecisionAllow},
+		{"deny rule wins", writer, terminateWorkflow, DecisionDeny},
+		{"writer outside namespace pattern", writer, describeWorkflow, DecisionDeny},
+		{"no matching rule", writer, addSearchAttributes, DecisionDeny},
+		{"auditor reads", auditor, describeWorkflow, DecisionAllow},
+		{"auditor writes", auditor, startWorkflow, DecisionDeny},
+		{"no claims", nil, startWorkflow, DecisionDeny},
+		{"health check", nil, &CallTarget{APIName: "/temporal.api.workflowservice.v1.WorkflowService/GetSystemInfo"}, DecisionAllow},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := authorizer.Authorize(context.Background(), tc.claims, tc.target)
+			require.NoError(t, err)
+			require.Equal(t, tc.decision, result.Decision)
+		})
+	}
+}
+
+func TestPolicyAuthorizer_Reload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.json")
+	writePolicyFile(t, path, `{"rules": []}`)
+	authorizer, err := NewPolicyAuthorizer(path, time.Nanosecond)
+	require.NoError(t, err)
+
+	target := &CallTarget{APIName: "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution"}
+	result, err := authorizer.Authorize(context.Background(), nil, target)
+	require.NoError(t, err)
+	require.Equal(t, DecisionDeny, result.Decision)
+
+	writePolicyFile(t, path, `{"rules": [{"effect": "allow"}]}`)
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(time.Minute)))
+	result, err = authorizer.Authorize(context.Background(), nil, target)
+	require.NoError(t, err)
+	require.Equal(t, DecisionAllow, result.Decision)
+
+	// an invalid policy doesn't replace the current one
+	writePolicyFile(t, path, `{"rules": [{"effect": "maybe"}]}`)
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(2*time.Minute)))
+	result, err = authorizer.Authorize(context.Background(), nil, target)
+	require.NoError(t, err)
+	require.Equal(t, DecisionAllow, result.Decision)
+}
+
+func TestPolicyAuthorizer_Sources(t *testing.T) {
+	target := &CallTarget{APIName: "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution"}
+
+	authorizer, err := GetAuthorizerFromConfig(&config.Authorization{Authorizer: "policy", Policy: testPolicy}, nil)
+	require.NoError(t, err)
+	result, err := authorizer.Authorize(context.Background(), &Claims{Subject: "auditor@example.com"}, &CallTarget{
+		APIName: "/temporal.api.workflowservice.v1.WorkflowService/DescribeWorkflowExecution",
+	})
+	require.NoError(t, err)
+	require.Equal(t, DecisionAllow, result.Decision)
+
+	dc := dynamicconfig.NewCollection(dynamicconfig.StaticClient{
+		dynamicconfig.FrontendAuthorizationPolicy.Key(): `{"rules": [{"effect": "allow"}]}`,
+	}, log.NewNoopLogger())
+	authorizer, err = GetAuthorizerFromConfig(&config.Authorization{Authorizer: "policy"}, dc)
+	require.NoError(t, err)
+	result, err = authorizer.Authorize(context.Background(), nil, target)
+	require.NoError(t, err)
+	require.Equal(t, DecisionAllow, result.Decision)
+
+	_, err = GetAuthorizerFromConfig(&config.Authorization{Authorizer: "policy"}, nil)
+	require.ErrorIs(t, err, errPolicyNotSet)
+}
+
+func TestPolicyAuthorizer_DynamicConfigReload(t *testing.T) {
+	policy := ""
+	authorizer, err := NewDynamicConfigPolicyAuthorizer(func() string { return policy }, time.Nanosecond)
+	require.NoError(t, err)
+
+	// an empty policy denies all calls
+	target := &CallTarget{APIName: "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution"}
+	result, err := authorizer.Authorize(context.Background(), nil, target)
+	require.NoError(t, err)
+	require.Equal(t, DecisionDeny, result.Decision)
+
+	policy = "rules:\n  - effect: allow\n"
+	result, err = authorizer.Authorize(context.Background(), nil, target)
+	require.NoError(t, err)
+	require.Equal(t, DecisionAllow, result.Decision)
+}
+
+func TestPolicyAuthorizer_InvalidPolicy(t *testing.T) {
+	_, err := NewPolicyAuthorizer("", 0)
+	require.ErrorIs(t, err, errPolicyFileNotSet)
+
+	for _, policy := range []string{
+		`{"rules": [{"effect": "maybe"}]}`,
+		`{"rules": [{"effect": "allow", "roles": ["owner"]}]}`,
+		`{"rules": [{"effect": "allow", "access": ["delete"]}]}`,
+		`{"rules": [{"effect": "deny", "namespace": ["payments-prod"]}]}`,
+		`{"rule": [{"effect": "allow"}]}`,
+	} {
+		_, err := parsePolicy([]byte(policy))
+		require.Error(t, err)
+	}
+}
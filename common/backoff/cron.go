@@ -52,16 +52,45 @@ func ValidateSchedule(cronSchedule string) error {
 	return nil
 }
 
+// CronOverlapPolicy controls when the next run of a cron workflow starts if the previous run closed after one or more
+// of its scheduled times had passed.
+type CronOverlapPolicy string
+
+const (
+	// CronOverlapPolicySkip skips the missed scheduled times: the next run starts at the first scheduled time after
+	// the previous run closed.
+	CronOverlapPolicySkip CronOverlapPolicy = "skip"
+	// CronOverlapPolicyBufferOne starts a single run immediately for all the missed scheduled times, then resumes the
+	// schedule.
+	CronOverlapPolicyBufferOne CronOverlapPolicy = "bufferOne"
+	// CronOverlapPolicyAllowAll starts one run immediately for every missed scheduled time, in order, until the
+	// workflow catches up with the schedule.
+	CronOverlapPolicyAllowAll CronOverlapPolicy = "allowAll"
+)
+
 // GetBackoffForNextSchedule calculates the backoff time for the next run given
 // a cronSchedule, current scheduled time, and now.
 func GetBackoffForNextSchedule(cronSchedule string, scheduledTime time.Time, now time.Time) time.Duration {
+	_, backoffInterval := GetNextSchedule(cronSchedule, scheduledTime, now, CronOverlapPolicySkip)
+	return backoffInterval
+}
+
+// GetNextSchedule calculates the scheduled time and the backoff time of the next run given a cronSchedule, current
+// scheduled time, now and the policy applied to the scheduled times missed before now. The backoff time is 0 if the
+// next run makes up for a missed scheduled time. NoBackoff is returned if there is no next run.
+func GetNextSchedule(
+	cronSchedule string,
+	scheduledTime time.Time,
+	now time.Time,
+	overlapPolicy CronOverlapPolicy,
+) (time.Time, time.Duration) {
 	if len(cronSchedule) == 0 {
-		return NoBackoff
+		return time.Time{}, NoBackoff
 	}
 
 	schedule, err := cron.ParseStandard(cronSchedule)
 	if err != nil {
-		return NoBackoff
+		return time.Time{}, NoBackoff
 	}
 
 	scheduledUTCTime := scheduledTime.UTC()
@@ -72,6 +101,19 @@ func GetBackoffForNextSchedule(cronSchedule string, scheduledTime time.Time, now
 		nextScheduleTime = scheduledUTCTime
 	} else {
 		nextScheduleTime = schedule.Next(scheduledUTCTime)
+		if !nextScheduleTime.IsZero() && nextScheduleTime.Before(nowUTC) {
+			switch overlapPolicy {
+			case CronOverlapPolicyAllowAll:
+				// Make up for the first missed scheduled time.
+				return nextScheduleTime, 0
+			case CronOverlapPolicyBufferOne:
+				// Make up for the last missed scheduled time.
+				for next := schedule.Next(nextScheduleTime); !next.IsZero() && next.Before(nowUTC); next = schedule.Next(next) {
+					nextScheduleTime = next
+				}
+				return nextScheduleTime, 0
+			}
+		}
 		// Calculate the next schedule start time which is nearest to now (right after now).
 		for !nextScheduleTime.IsZero() && nextScheduleTime.Before(nowUTC) {
 			nextScheduleTime = schedule.Next(nextScheduleTime)
@@ -79,12 +121,12 @@ func GetBackoffForNextSchedule(cronSchedule string, scheduledTime time.Time, now
 	}
 	if nextScheduleTime.IsZero() {
 		// no time can be found to satisfy the schedule
-		return NoBackoff
+		return time.Time{}, NoBackoff
 	}
 
 	backoffInterval := nextScheduleTime.Sub(nowUTC)
 	roundedInterval := time.Second * time.Duration(convert.Int64Ceil(backoffInterval.Seconds()))
-	return roundedInterval
+	return nextScheduleTime, roundedInterval
 }
 
 // GetBackoffForNextScheduleNonNegative calculates the backoff time and ensures a non-negative duration.
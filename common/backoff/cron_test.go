@@ -86,3 +86,29 @@ func TestCron(t *testing.T) {
 		})
 	}
 }
+
+func TestGetNextScheduleOverlapPolicy(t *testing.T) {
+	scheduledTime := time.Date(2018, 12, 17, 8, 0, 0, 0, time.UTC)
+	// The run scheduled at 08:00 closed at 10:30, missing 09:00 and 10:00.
+	now := time.Date(2018, 12, 17, 10, 30, 0, 0, time.UTC)
+
+	next, backoff := GetNextSchedule("0 * * * *", scheduledTime, now, CronOverlapPolicySkip)
+	assert.Equal(t, time.Date(2018, 12, 17, 11, 0, 0, 0, time.UTC), next)
+	assert.Equal(t, 30*time.Minute, backoff)
+
+	next, backoff = GetNextSchedule("0 * * * *", scheduledTime, now, CronOverlapPolicyBufferOne)
+	assert.Equal(t, time.Date(2018, 12, 17, 10, 0, 0, 0, time.UTC), next)
+	assert.Equal(t, time.Duration(0), backoff)
+
+	next, backoff = GetNextSchedule("0 * * * *", scheduledTime, now, CronOverlapPolicyAllowAll)
+	assert.Equal(t, time.Date(2018, 12, 17, 9, 0, 0, 0, time.UTC), next)
+	assert.Equal(t, time.Duration(0), backoff)
+
+	// Nothing was missed.
+	now = time.Date(2018, 12, 17, 8, 30, 0, 0, time.UTC)
+	for _, policy := range []CronOverlapPolicy{CronOverlapPolicySkip, CronOverlapPolicyBufferOne, CronOverlapPolicyAllowAll} {
+		next, backoff = GetNextSchedule("0 * * * *", scheduledTime, now, policy)
+		assert.Equal(t, time.Date(2018, 12, 17, 9, 0, 0, 0, time.UTC), next)
+		assert.Equal(t, 30*time.Minute, backoff)
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"time"
 
 	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/metrics"
 )
 
 // A Cache is a generalized interface to a cache.  See cache.LRU for a specific
@@ -73,6 +74,11 @@ type Options struct {
 	OnPut func(val any)
 
 	OnEvict func(val any)
+
+	// Group optionally returns the group of a key as a metrics tag, e.g. the namespace of a workflow. If set, the usage
+	// of each group is reported, and entries of the groups using more than their fair share of the cache are evicted
+	// before the least recently used entries of the other groups.
+	Group func(key any) metrics.Tag
 }
 
 // SimpleOptions provides options that can be used to configure SimpleCache.
@@ -63,6 +63,8 @@ type (
 		pin            bool
 		timeSource     clock.TimeSource
 		metricsHandler metrics.Handler
+		group          func(key any) metrics.Tag
+		groupSizes     map[string]int
 	}
 
 	iteratorImpl struct {
@@ -77,6 +79,7 @@ type (
 		value      interface{}
 		refCount   int
 		size       int
+		group      metrics.Tag
 	}
 )
 
@@ -181,6 +184,8 @@ func NewWithMetrics(maxSize int, opts *Options, handler metrics.Handler) Cache {
 		onEvict:        opts.OnEvict,
 		timeSource:     timeSource,
 		metricsHandler: handler,
+		group:          opts.Group,
+		groupSizes:     make(map[string]int),
 	}
 }
 
@@ -277,6 +282,7 @@ func (c *lru) Release(key interface{}) {
 	// Entry size might have changed. Recalculate size and evict entries if necessary.
 	newEntrySize := getSize(entry.value)
 	c.currSize = c.calculateNewCacheSize(newEntrySize, entry.Size())
+	c.updateGroupSize(entry, newEntrySize-entry.Size())
 	entry.size = newEntrySize
 	if c.currSize > c.maxSize {
 		c.tryEvictUntilCacheSizeUnderLimit()
@@ -331,6 +337,7 @@ func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool)
 					}
 				}
 				existingEntry.value = value
+				c.updateGroupSize(existingEntry, newEntrySize-existingEntry.Size())
 				existingEntry.size = newEntrySize
 				c.currSize = newCacheSize
 				metrics.CacheUsage.With(c.metricsHandler).Record(float64(c.currSize))
@@ -363,6 +370,10 @@ func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool)
 		value: value,
 		size:  newEntrySize,
 	}
+	if c.group != nil {
+		entry.group = c.group(key)
+	}
+	c.updateGroupSize(entry, newEntrySize)
 	c.updateEntryTTL(entry)
 	c.updateEntryRefCount(entry)
 	element := c.byAccess.PushFront(entry)
@@ -384,6 +395,7 @@ func (c *lru) calculateNewCacheSize(newEntrySize int, existingEntrySize int) int
 func (c *lru) deleteInternal(element *list.Element) {
 	entry := c.byAccess.Remove(element).(*entryImpl)
 	c.currSize -= entry.Size()
+	c.updateGroupSize(entry, -entry.Size())
 	metrics.CacheUsage.With(c.metricsHandler).Record(float64(c.currSize))
 	metrics.CacheEntryAgeOnEviction.With(c.metricsHandler).Record(c.timeSource.Now().UTC().Sub(entry.createTime))
 	delete(c.byKey, entry.key)
@@ -407,6 +419,21 @@ func (c *lru) tryEvictUntilEnoughSpaceWithSkipEntry(newEntrySize int, existingEn
 		existingEntrySize = existingEntry.Size()
 	}
 
+	if len(c.groupSizes) > 1 {
+		// evict from the groups using more than their fair share first, so that a single busy group can't push
+		// the entries of all others out of the cache
+		fairShare := c.maxSize / len(c.groupSizes)
+		for c.calculateNewCacheSize(newEntrySize, existingEntrySize) > c.maxSize && element != nil {
+			entry := element.Value.(*entryImpl)
+			if (existingEntry != nil && entry.key == existingEntry.key) || c.groupSizes[entry.group.Value()] <= fairShare {
+				element = element.Prev()
+				continue
+			}
+			element = c.tryEvictAndGetPreviousElement(entry, element)
+		}
+		element = c.byAccess.Back()
+	}
+
 	for c.calculateNewCacheSize(newEntrySize, existingEntrySize) > c.maxSize && element != nil {
 		entry := element.Value.(*entryImpl)
 		if existingEntry != nil && entry.key == existingEntry.key {
@@ -429,6 +456,22 @@ func (c *lru) tryEvictAndGetPreviousElement(entry *entryImpl, element *list.Elem
 	return element.Prev()
 }
 
+// updateGroupSize adds delta to the size of the group of entry and reports its usage.
+func (c *lru) updateGroupSize(entry *entryImpl, delta int) {
+	if entry.group == nil {
+		return
+	}
+	group := entry.group.Value()
+	size := c.groupSizes[group] + delta
+	if size <= 0 {
+		size = 0
+		delete(c.groupSizes, group)
+	} else {
+		c.groupSizes[group] = size
+	}
+	metrics.CacheGroupUsage.With(c.metricsHandler).Record(float64(size), entry.group)
+}
+
 func (c *lru) isEntryExpired(entry *entryImpl, currentTime time.Time) bool {
 	return entry.refCount == 0 && !entry.createTime.IsZero() && currentTime.After(entry.createTime.Add(c.ttl))
 }
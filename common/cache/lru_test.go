@@ -759,3 +759,47 @@ func TestCache_InvokeLifecycleCallbacks(t *testing.T) {
 	assert.Nil(t, cache.Get("key"))
 	require.Equal(t, 2, onEvict, "expected OnEvict callback to be invoked")
 }
+
+func TestCache_GroupFairEviction(t *testing.T) {
+	t.Parallel()
+	metricsHandler := metricstest.NewCaptureHandler()
+	capture := metricsHandler.StartCapture()
+
+	cache := NewWithMetrics(10,
+		&Options{
+			Group: func(key any) metrics.Tag {
+				return metrics.NamespaceTag(key.(string)[:1])
+			},
+		},
+		metricsHandler,
+	)
+
+	cache.Put("a1", &testEntryWithCacheSize{2})
+	cache.Put("b1", &testEntryWithCacheSize{2})
+	cache.Put("b2", &testEntryWithCacheSize{2})
+	cache.Put("b3", &testEntryWithCacheSize{2})
+	cache.Put("b4", &testEntryWithCacheSize{2})
+	assert.Equal(t, 10, cache.Size())
+
+	// a1 is the least recently used entry, but group b uses more than its fair share of the cache
+	cache.Put("a2", &testEntryWithCacheSize{2})
+	assert.Equal(t, 10, cache.Size())
+	assert.NotNil(t, cache.Get("a1"))
+	assert.Nil(t, cache.Get("b1"))
+	assert.NotNil(t, cache.Get("b2"))
+
+	snapshot := capture.Snapshot()
+	usage := snapshot[metrics.CacheGroupUsage.Name()]
+	last := usage[len(usage)-1]
+	assert.Equal(t, float64(4), last.Value)
+	assert.Equal(t, "a", last.Tags[metrics.NamespaceTag("").Key()])
+
+	// now group a is over its fair share, so its least recently used entry goes first
+	cache.Delete("b2")
+	cache.Put("a3", &testEntryWithCacheSize{2})
+	cache.Put("a4", &testEntryWithCacheSize{2})
+	assert.Equal(t, 10, cache.Size())
+	assert.Nil(t, cache.Get("a2"))
+	assert.NotNil(t, cache.Get("b3"))
+	assert.NotNil(t, cache.Get("a1"))
+}
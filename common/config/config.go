@@ -65,6 +65,10 @@ type (
 		// DynamicConfigClient is the config for setting up the file based dynamic config client
 		// Filepath should be relative to the root directory
 		DynamicConfigClient *dynamicconfig.FileBasedClientConfig `yaml:"dynamicConfigClient"`
+		// DynamicConfigEtcd is the config for reading dynamic config from etcd instead of a file
+		DynamicConfigEtcd *dynamicconfig.EtcdClientConfig `yaml:"dynamicConfigEtcd"`
+		// DynamicConfigConsul is the config for reading dynamic config from Consul instead of a file
+		DynamicConfigConsul *dynamicconfig.ConsulClientConfig `yaml:"dynamicConfigConsul"`
 		// NamespaceDefaults is the default config for every namespace
 		NamespaceDefaults NamespaceDefaults `yaml:"namespaceDefaults"`
 		// ExporterConfig allows the specification of process-wide OTEL exporters
@@ -107,6 +111,10 @@ type (
 		// forwarded from HTTP to gRPC. Any value with a trailing * will match the prefix before
 		// the asterisk (eg. `x-internal-*`)
 		HTTPAdditionalForwardedHeaders []string `yaml:"httpAdditionalForwardedHeaders"`
+		// DrainPort is the port of the HTTP endpoint used to drain the host before it's
+		// restarted, see the drain package. If unset/0, the endpoint is disabled. This setting
+		// only applies to the history and matching services.
+		DrainPort int `yaml:"drainPort"`
 	}
 
 	// Global contains config items that apply process-wide to all services
@@ -121,6 +129,10 @@ type (
 		Metrics *metrics.Config `yaml:"metrics"`
 		// Settings for authentication and authorization
 		Authorization Authorization `yaml:"authorization"`
+		// Audit configures export of security relevant events to external sinks
+		Audit Audit `yaml:"audit"`
+		// WorkflowLifecycleEvents configures the sinks workflow lifecycle events can be published to
+		WorkflowLifecycleEvents WorkflowLifecycleEvents `yaml:"workflowLifecycleEvents"`
 	}
 
 	// RootTLS contains all TLS settings for the Temporal server
@@ -154,6 +166,21 @@ type (
 		// specific hostname. Host names are case insensitive. Optional. If not present,
 		// uses configuration supplied by Server field.
 		PerHostOverrides map[string]ServerTLS `yaml:"hostOverrides"`
+
+		// NamespaceClientCAs contains per-namespace Certificate Authorities trusted for client
+		// authentication. Only honored for the frontend when RequireClientAuth is enabled. When
+		// set, a client certificate must chain to the CAs of the namespace a request targets, or
+		// to the Server client CAs for namespaces without an entry and for cluster level calls.
+		NamespaceClientCAs map[string]NamespaceClientCAs `yaml:"namespaceClientCAs"`
+	}
+
+	// NamespaceClientCAs contains the client Certificate Authorities trusted for a single namespace
+	NamespaceClientCAs struct {
+		// A list of paths to files containing PEM-encoded CA certificates.
+		// Cannot specify both ClientCAFiles and ClientCAData
+		ClientCAFiles []string `yaml:"clientCaFiles"`
+		// Base64 equivalent of ClientCAFiles
+		ClientCAData []string `yaml:"clientCaData"`
 	}
 
 	// ServerTLS contains items to load server TLS configuration
@@ -562,14 +589,82 @@ type (
 		// Signing key provider for validating JWT tokens
 		JWTKeyProvider       JWTKeyProvider `yaml:"jwtKeyProvider"`
 		PermissionsClaimName string         `yaml:"permissionsClaimName"`
-		// Empty string for noopAuthorizer or "default" for defaultAuthorizer
+		// Empty string for noopAuthorizer, "default" for defaultAuthorizer or "policy" for policyAuthorizer
 		Authorizer string `yaml:"authorizer"`
+		// YAML or JSON policy evaluated by policyAuthorizer
+		Policy string `yaml:"policy"`
+		// Path of the YAML or JSON policy file evaluated by policyAuthorizer. Without Policy and PolicyFile,
+		// policyAuthorizer evaluates the policy stored in dynamic config.
+		PolicyFile string `yaml:"policyFile"`
+		// Interval at which policyAuthorizer checks its policy file or dynamic config for changes. Zero disables
+		// reloading the policy file and defaults to 10s for dynamic config.
+		PolicyRefreshInterval time.Duration `yaml:"policyRefreshInterval"`
 		// Empty string for noopClaimMapper or "default" for defaultJWTClaimMapper
 		ClaimMapper string `yaml:"claimMapper"`
 		// Name of main auth header to pass to ClaimMapper (as `AuthToken`). Defaults to `authorization`.
 		AuthHeaderName string `yaml:"authHeaderName"`
 		// Name of extra auth header to pass to ClaimMapper (as `ExtraData`). Defaults to `authorization-extras`.
 		AuthExtraHeaderName string `yaml:"authExtraHeaderName"`
+		// Time authorizer decisions are cached for, keyed by claims, namespace and API. Zero disables caching.
+		// Only enable it if the authorizer doesn't look at the request content.
+		DecisionCacheTTL time.Duration `yaml:"decisionCacheTTL"`
+		// Maximum number of cached authorizer decisions. Defaults to 10000.
+		DecisionCacheSize int `yaml:"decisionCacheSize"`
+	}
+
+	// Audit contains the config for exporting audit events
+	Audit struct {
+		// Sinks receive every audit event. Audit export is disabled when empty.
+		Sinks []AuditSink `yaml:"sinks"`
+		// BufferSize is the number of events buffered in memory before new events are dropped. Defaults to 10000.
+		BufferSize int `yaml:"bufferSize"`
+		// BatchSize is the maximum number of events delivered to a sink at once. Defaults to 100.
+		BatchSize int `yaml:"batchSize"`
+		// FlushInterval is the maximum time an event is buffered before delivery. Defaults to 1s.
+		FlushInterval time.Duration `yaml:"flushInterval"`
+		// MaxRetryInterval bounds the backoff between delivery retries. Defaults to 30s.
+		MaxRetryInterval time.Duration `yaml:"maxRetryInterval"`
+		// RetryExpiration is the time after which a batch that can't be delivered is dropped. Defaults to 5m.
+		RetryExpiration time.Duration `yaml:"retryExpiration"`
+	}
+
+	// AuditSink contains the config of a single audit event sink
+	AuditSink struct {
+		// Type is one of "file", "webhook" or "kafka"
+		Type string `yaml:"type"`
+		// Path of the file events are appended to as JSON lines (file)
+		Path string `yaml:"path"`
+		// URL events are posted to (webhook), or base URL of the Kafka REST proxy (kafka)
+		URL string `yaml:"url"`
+		// Topic events are produced to (kafka)
+		Topic string `yaml:"topic"`
+		// Headers added to every request (webhook and kafka)
+		Headers map[string]string `yaml:"headers"`
+		// Timeout of a single delivery request. Defaults to 10s.
+		Timeout time.Duration `yaml:"timeout"`
+	}
+
+	// WorkflowLifecycleEvents contains the config for publishing workflow lifecycle events
+	WorkflowLifecycleEvents struct {
+		// Sinks by name. A namespace publishes its events to the sinks listed by name in the
+		// history.workflowLifecycleEventSinks dynamic config.
+		Sinks map[string]WorkflowLifecycleEventSink `yaml:"sinks"`
+	}
+
+	// WorkflowLifecycleEventSink contains the config of a single workflow lifecycle event sink
+	WorkflowLifecycleEventSink struct {
+		// Type is one of "webhook", "kafka" or "pubsub"
+		Type string `yaml:"type"`
+		// URL events are posted to (webhook), base URL of the Kafka REST proxy (kafka), or endpoint of the
+		// Pub/Sub API (pubsub). Pub/Sub defaults to https://pubsub.googleapis.com with Google default
+		// credentials; requests to any other endpoint, e.g. the emulator, are sent without credentials.
+		URL string `yaml:"url"`
+		// Topic events are produced to (kafka), or full topic name "projects/<project>/topics/<topic>" (pubsub)
+		Topic string `yaml:"topic"`
+		// Headers added to every request
+		Headers map[string]string `yaml:"headers"`
+		// Timeout of a single delivery request. Defaults to 10s.
+		Timeout time.Duration `yaml:"timeout"`
 	}
 
 	// @@@SNIPSTART temporal-common-service-config-jwtkeyprovider
@@ -577,8 +672,24 @@ type (
 	JWTKeyProvider struct {
 		KeySourceURIs   []string      `yaml:"keySourceURIs"`
 		RefreshInterval time.Duration `yaml:"refreshInterval"`
+		// Issuers are trusted token issuers with their own key sources. A token whose "iss" claim
+		// matches one of them is only validated with that issuer's keys.
+		Issuers []JWTIssuer `yaml:"issuers"`
+		// KeyRolloverGracePeriod keeps accepting keys for this long after they were removed from
+		// their key source, so that tokens signed just before a rollover remain valid.
+		KeyRolloverGracePeriod time.Duration `yaml:"keyRolloverGracePeriod"`
 	}
 	// @@@SNIPEND
+
+	// JWTIssuer contains the config of a trusted JWT issuer
+	JWTIssuer struct {
+		// Issuer is matched against the "iss" claim of tokens
+		Issuer string `yaml:"issuer"`
+		// KeySourceURIs are the JWKS endpoints publishing the issuer's signing keys
+		KeySourceURIs []string `yaml:"keySourceURIs"`
+		// Audiences, when set, requires the "aud" claim of tokens to contain one of them
+		Audiences []string `yaml:"audiences"`
+	}
 )
 
 const (
@@ -613,6 +724,16 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("when using internal-frontend, publicClient must be empty")
 	}
 
+	dynamicConfigSources := 0
+	for _, configured := range []bool{c.DynamicConfigClient != nil, c.DynamicConfigEtcd != nil, c.DynamicConfigConsul != nil} {
+		if configured {
+			dynamicConfigSources++
+		}
+	}
+	if dynamicConfigSources > 1 {
+		return fmt.Errorf("only one of dynamicConfigClient, dynamicConfigEtcd and dynamicConfigConsul can be configured")
+	}
+
 	switch c.PublicClient.ForceTLSConfig {
 	case ForceTLSConfigAuto, ForceTLSConfigInternode, ForceTLSConfigFrontend:
 	default:
@@ -642,10 +763,19 @@ func (r *GroupTLS) IsClientEnabled() bool {
 }
 
 func (p *JWTKeyProvider) HasSourceURIsConfigured() bool {
-	if len(p.KeySourceURIs) == 0 {
-		return false
+	if hasNonEmptyURI(p.KeySourceURIs) {
+		return true
 	}
-	for _, uri := range p.KeySourceURIs {
+	for _, issuer := range p.Issuers {
+		if hasNonEmptyURI(issuer.KeySourceURIs) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasNonEmptyURI(uris []string) bool {
+	for _, uri := range uris {
 		if strings.TrimSpace(uri) != "" {
 			return true
 		}
@@ -0,0 +1,104 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package debugtrace keeps the spans of requests sent with the debug trace header so they can be retrieved through
+// the GetDebugTrace admin API.
+package debugtrace
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/headers"
+	"google.golang.org/grpc/metadata"
+)
+
+type (
+	// Store keeps the spans recorded on a host for a limited time, keyed by trace ID.
+	Store struct {
+		traces cache.Cache
+	}
+
+	trace struct {
+		sync.Mutex
+		spans []*historyspb.DebugTraceSpan
+	}
+)
+
+// maxSpansPerTrace bounds the memory used by a single trace, e.g. when a client reuses a trace ID for many requests.
+const maxSpansPerTrace = 1000
+
+// NewStore creates a Store keeping up to maxTraces traces for the given retention.
+func NewStore(retention time.Duration, maxTraces int) *Store {
+	return &Store{
+		traces: cache.New(maxTraces, &cache.Options{TTL: retention}),
+	}
+}
+
+// Record adds a span to its trace. Spans beyond maxSpansPerTrace are dropped.
+func (s *Store) Record(span *historyspb.DebugTraceSpan) {
+	t, ok := s.traces.Get(span.GetTraceId()).(*trace)
+	if !ok {
+		existing, err := s.traces.PutIfNotExist(span.GetTraceId(), &trace{})
+		if err != nil {
+			return
+		}
+		t = existing.(*trace)
+	}
+	t.Lock()
+	defer t.Unlock()
+	if len(t.spans) < maxSpansPerTrace {
+		t.spans = append(t.spans, span)
+	}
+}
+
+// Get returns the spans recorded for a trace, or nil if the trace is unknown or has expired.
+func (s *Store) Get(traceID string) []*historyspb.DebugTraceSpan {
+	t, ok := s.traces.Get(traceID).(*trace)
+	if !ok {
+		return nil
+	}
+	t.Lock()
+	defer t.Unlock()
+	return slices.Clone(t.spans)
+}
+
+// TraceID returns the debug trace ID the incoming request was sent with, or an empty string if it isn't traced.
+func TraceID(ctx context.Context) string {
+	return headers.GetValues(ctx, headers.DebugTraceHeaderName)[0]
+}
+
+// WithoutTraceID returns a context whose incoming metadata no longer carries the debug trace header, so the request
+// is neither traced locally nor by the services it calls.
+func WithoutTraceID(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get(headers.DebugTraceHeaderName)) == 0 {
+		return ctx
+	}
+	md = md.Copy()
+	md.Delete(headers.DebugTraceHeaderName)
+	return metadata.NewIncomingContext(ctx, md)
+}
@@ -0,0 +1,99 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package debugtrace
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/common/headers"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestStore_RecordAndGet(t *testing.T) {
+	store := NewStore(time.Minute, 10)
+	require.Empty(t, store.Get("trace"))
+
+	store.Record(&historyspb.DebugTraceSpan{TraceId: "trace", Service: "frontend", Method: "SignalWorkflowExecution"})
+	store.Record(&historyspb.DebugTraceSpan{TraceId: "trace", Service: "history", Method: "SignalWorkflowExecution", Error: "workflow not found"})
+	store.Record(&historyspb.DebugTraceSpan{TraceId: "other-trace", Service: "frontend"})
+
+	spans := store.Get("trace")
+	require.Len(t, spans, 2)
+	require.Equal(t, "frontend", spans[0].GetService())
+	require.Empty(t, spans[0].GetError())
+	require.Equal(t, "history", spans[1].GetService())
+	require.Equal(t, "workflow not found", spans[1].GetError())
+	require.Len(t, store.Get("other-trace"), 1)
+
+	// the returned spans are a copy of the stored ones
+	spans[0] = nil
+	require.NotNil(t, store.Get("trace")[0])
+}
+
+func TestStore_Limits(t *testing.T) {
+	store := NewStore(time.Minute, 1)
+	for i := 0; i < maxSpansPerTrace+1; i++ {
+		store.Record(&historyspb.DebugTraceSpan{TraceId: "trace", Method: fmt.Sprintf("method-%d", i)})
+	}
+	spans := store.Get("trace")
+	require.Len(t, spans, maxSpansPerTrace)
+	require.Equal(t, fmt.Sprintf("method-%d", maxSpansPerTrace-1), spans[maxSpansPerTrace-1].GetMethod())
+
+	// the least recently used trace is evicted
+	store.Record(&historyspb.DebugTraceSpan{TraceId: "other-trace"})
+	require.Empty(t, store.Get("trace"))
+	require.Len(t, store.Get("other-trace"), 1)
+}
+
+func TestStore_Retention(t *testing.T) {
+	store := NewStore(10*time.Millisecond, 10)
+	store.Record(&historyspb.DebugTraceSpan{TraceId: "trace"})
+	require.Len(t, store.Get("trace"), 1)
+
+	require.Eventually(t, func() bool {
+		return len(store.Get("trace")) == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestTraceID(t *testing.T) {
+	require.Empty(t, TraceID(context.Background()))
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		headers.DebugTraceHeaderName, "trace",
+		headers.ClientNameHeaderName, "client",
+	))
+	require.Equal(t, "trace", TraceID(ctx))
+
+	ctx = WithoutTraceID(ctx)
+	require.Empty(t, TraceID(ctx))
+	// the other headers are kept
+	require.Equal(t, "client", headers.GetValues(ctx, headers.ClientNameHeaderName)[0])
+
+	ctx = context.Background()
+	require.Equal(t, ctx, WithoutTraceID(ctx))
+}
@@ -107,14 +107,27 @@ type (
 	// ConstrainedValue with only Namespace set, or with no fields set. (Or return one of
 	// each.) If you return a ConstrainedValue with Namespace and ShardID set, for example,
 	// that value will never be used, even if the Namespace matches.
+	//
+	// RolloutPercentage is the exception: it isn't part of the lookup. A value with
+	// RolloutPercentage set matches like the same value without it, but only for the given
+	// percentage of lookups, chosen by a stable hash of the namespace (or workflow ID, for
+	// workflow precedence). Lookups that fall outside the rollout continue to the next
+	// matching value. Since the hash doesn't depend on the key or service, a namespace that is
+	// in a 10% rollout is in it for every key and on every host, and stays in it when the
+	// percentage is raised. Rollouts can't be used with settings that have no namespace or
+	// workflow ID to hash.
 	Constraints struct {
-		Namespace     string
-		NamespaceID   string
-		TaskQueueName string
-		TaskQueueType enumspb.TaskQueueType
-		ShardID       int32
-		TaskType      enumsspb.TaskType
-		Destination   string
+		Namespace         string
+		NamespaceID       string
+		TaskQueueName     string
+		TaskQueueType     enumspb.TaskQueueType
+		ShardID           int32
+		TaskType          enumsspb.TaskType
+		Destination       string
+		RolloutPercentage int32
+
+		// rolloutSubject is set on the constraints of a lookup to what rollouts are hashed by.
+		rolloutSubject string
 	}
 )
 
@@ -25,6 +25,7 @@
 package dynamicconfig
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
@@ -35,6 +36,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/dgryski/go-farm"
 	"github.com/mitchellh/mapstructure"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/log"
@@ -131,11 +133,22 @@ func (c *Collection) Start() {
 	c.callbackPool = goro.NewAdaptivePool(clock.NewRealTimeSource(), s.MinWorkers, s.MaxWorkers, s.TargetDelay, s.ShrinkFactor)
 	if notifyingClient, ok := c.client.(NotifyingClient); ok {
 		c.cancelClientSubscription = notifyingClient.Subscribe(c.keysChanged)
-	} else {
+	}
+	if !notifiesAllChanges(c.client) {
 		c.poller.Go(c.pollForChanges)
 	}
 }
 
+// notifiesAllChanges returns true if the client notifies of all changes to its values, so it
+// doesn't need to be polled.
+func notifiesAllChanges(client Client) bool {
+	if overrideClient, ok := client.(*RuntimeOverrideClient); ok {
+		return overrideClient.notifiesAllChanges()
+	}
+	_, ok := client.(NotifyingClient)
+	return ok
+}
+
 func (c *Collection) Stop() {
 	c.poller.Cancel()
 	c.poller.Wait()
@@ -231,12 +244,12 @@ func findMatch[T any](cvs []ConstrainedValue, defaultCVs []TypedConstrainedValue
 	}
 	for _, m := range precedence {
 		for _, cv := range cvs {
-			if m == cv.Constraints {
+			if cv.Constraints.matches(m) {
 				return cv.Value, nil
 			}
 		}
 		for _, cv := range defaultCVs {
-			if m == cv.Constraints {
+			if cv.Constraints.matches(m) {
 				return cv.Value, nil
 			}
 		}
@@ -245,6 +258,30 @@ func findMatch[T any](cvs []ConstrainedValue, defaultCVs []TypedConstrainedValue
 	return nil, errNoMatchingConstraint
 }
 
+// matches returns true if a value with constraints c applies to a lookup with constraints m.
+func (c Constraints) matches(m Constraints) bool {
+	percentage, subject := c.RolloutPercentage, m.rolloutSubject
+	c.RolloutPercentage, m.rolloutSubject = 0, ""
+	if c != m {
+		return false
+	}
+	return percentage == 0 || (subject != "" && rolloutBucket(subject) < percentage)
+}
+
+// rolloutBucket returns the bucket in [0, 100) that subject falls into for percentage
+// rollouts: a value with RolloutPercentage p applies to subject if its bucket is less than p.
+func rolloutBucket(subject string) int32 {
+	return int32(farm.Fingerprint32([]byte(subject)) % 100)
+}
+
+// withRolloutSubject sets the subject that rollouts are hashed by on every lookup constraint.
+func withRolloutSubject(precedence []Constraints, subject string) []Constraints {
+	for i := range precedence {
+		precedence[i].rolloutSubject = subject
+	}
+	return precedence
+}
+
 // matchAndConvert can't be a method of Collection because methods can't be generic, but we can
 // take a *Collection as an argument.
 func matchAndConvert[T any](
@@ -451,6 +488,42 @@ func convertMap(val any) (map[string]any, error) {
 	return nil, errors.New("value type is not map")
 }
 
+func convertAndValidate[T any](convert func(any) (T, error), validate func(T) error) func(any) (T, error) {
+	return func(val any) (T, error) {
+		typedVal, err := convert(val)
+		if err != nil {
+			return typedVal, err
+		}
+		if err := validate(typedVal); err != nil {
+			var zero T
+			return zero, err
+		}
+		return typedVal, nil
+	}
+}
+
+// ValidateRange returns a validator, for use with WithValidator, that only accepts values
+// between min and max inclusive.
+func ValidateRange[T cmp.Ordered](min, max T) func(T) error {
+	return func(v T) error {
+		if v < min || v > max {
+			return fmt.Errorf("value %v is out of range [%v, %v]", v, min, max)
+		}
+		return nil
+	}
+}
+
+// ValidateMin returns a validator, for use with WithValidator, that only accepts values of at
+// least min.
+func ValidateMin[T cmp.Ordered](min T) func(T) error {
+	return func(v T) error {
+		if v < min {
+			return fmt.Errorf("value %v is less than the minimum %v", v, min)
+		}
+		return nil
+	}
+}
+
 // ConvertStructure can be used as a conversion function for New*TypedSettingWithConverter.
 // The value from dynamic config will be converted to T, on top of the given default.
 //
@@ -25,6 +25,7 @@
 package dynamicconfig_test
 
 import (
+	"fmt"
 	"maps"
 	"sync"
 	"testing"
@@ -59,6 +60,8 @@ const (
 	testGetBoolPropertyFilteredByTaskQueueInfoKey     = "testGetBoolPropertyFilteredByTaskQueueInfoKey"
 	testGetStringPropertyFilteredByNamespaceIDKey     = "testGetStringPropertyFilteredByNamespaceIDKey"
 	testGetIntPropertyFilteredByDestinationKey        = "testGetIntPropertyFilteredByDestinationKey"
+	testGetBoolPropertyRolloutByNamespaceKey          = "testGetBoolPropertyRolloutByNamespaceKey"
+	testGetBoolPropertyRolloutByWorkflowKey           = "testGetBoolPropertyRolloutByWorkflowKey"
 )
 
 // Note: fileBasedClientSuite also heavily tests Collection, since some tests are easier with data
@@ -490,6 +493,54 @@ func (s *collectionSuite) TestGetIntPropertyFilteredByDestination() {
 	s.Equal(10, value("testAnotherNamespace", "testAnotherDestination"))
 }
 
+func (s *collectionSuite) TestGetBoolPropertyRolloutByNamespace() {
+	setting := dynamicconfig.NewNamespaceBoolSetting(testGetBoolPropertyRolloutByNamespaceKey, true, "")
+	value := setting.Get(s.cln)
+	rollout := func(percentage int32) {
+		s.client.Set(testGetBoolPropertyRolloutByNamespaceKey, []dynamicconfig.ConstrainedValue{
+			{Constraints: dynamicconfig.Constraints{RolloutPercentage: percentage}, Value: true},
+			// namespaces outside of the rollout get the next matching value, not the default
+			{Constraints: dynamicconfig.Constraints{}, Value: false},
+		})
+	}
+
+	rollout(25)
+	var enabled []string
+	for i := 0; i < 1000; i++ {
+		namespace := fmt.Sprintf("testNamespace%d", i)
+		if value(namespace) {
+			enabled = append(enabled, namespace)
+		}
+	}
+	s.InDelta(250, len(enabled), 50)
+
+	// raising the percentage keeps namespaces that are already in the rollout
+	rollout(50)
+	for _, namespace := range enabled {
+		s.True(value(namespace))
+	}
+	rollout(100)
+	s.True(value("testNamespace"))
+}
+
+func (s *collectionSuite) TestGetBoolPropertyRolloutByWorkflow() {
+	setting := dynamicconfig.NewWorkflowBoolSetting(testGetBoolPropertyRolloutByWorkflowKey, false, "")
+	value := setting.Get(s.cln)
+	s.client.Set(testGetBoolPropertyRolloutByWorkflowKey, []dynamicconfig.ConstrainedValue{
+		{Constraints: dynamicconfig.Constraints{Namespace: "testNamespace", RolloutPercentage: 10}, Value: true},
+	})
+
+	enabled := 0
+	for i := 0; i < 1000; i++ {
+		workflowID := fmt.Sprintf("testWorkflowID%d", i)
+		s.False(value("testAnotherNamespace", workflowID))
+		if value("testNamespace", workflowID) {
+			enabled++
+		}
+	}
+	s.InDelta(100, enabled, 40)
+}
+
 type (
 	subscriptionSuite struct {
 		suite.Suite
@@ -61,6 +61,38 @@ var (
 		true,
 		`AdminEnableListHistoryTasks is the key for enabling listing history tasks`,
 	)
+	AdminDescribeArchivalStatusMaxTasksPerShard = NewGlobalIntSetting(
+		"admin.describeArchivalStatusMaxTasksPerShard",
+		10000,
+		`AdminDescribeArchivalStatusMaxTasksPerShard is the maximum number of archival tasks DescribeArchivalStatus scans per history shard`,
+	)
+	AdminSignalByQueryMaxExecutions = NewNamespaceIntSetting(
+		"admin.signalByQueryMaxExecutions",
+		100,
+		`AdminSignalByQueryMaxExecutions is the maximum number of executions SignalWorkflowExecutionsByQuery signals in one call`,
+	)
+	AdminDescribeReplicationLagMaxTasksPerShard = NewGlobalIntSetting(
+		"admin.describeReplicationLagMaxTasksPerShard",
+		10000,
+		`AdminDescribeReplicationLagMaxTasksPerShard is the maximum number of replication tasks DescribeReplicationLag scans per history shard
+when counting the pending replication tasks of a namespace`,
+	)
+	AdminCaptureProfileMaxDuration = NewGlobalDurationSetting(
+		"admin.captureProfileMaxDuration",
+		time.Minute,
+		`AdminCaptureProfileMaxDuration is the longest cpu profile CaptureProfile samples`,
+	)
+	AdminCaptureProfileMaxHosts = NewGlobalIntSetting(
+		"admin.captureProfileMaxHosts",
+		10,
+		`AdminCaptureProfileMaxHosts is the maximum number of hosts CaptureProfile profiles in one call`,
+	)
+	AdminCaptureProfileStoreURI = NewGlobalStringSetting(
+		"admin.captureProfileStoreURI",
+		"",
+		`AdminCaptureProfileStoreURI is the location CaptureProfile writes profiles to when requested, e.g.
+file:///var/lib/temporal/profiles. Only the file scheme is supported.`,
+	)
 	AdminMatchingNamespaceToPartitionDispatchRate = NewNamespaceFloatSetting(
 		"admin.matchingNamespaceToPartitionDispatchRate",
 		10000,
@@ -162,6 +194,13 @@ for signal / start / signal with start API if namespace is not active`,
 		false,
 		`DisallowQuery is the key to disallow query for a namespace`,
 	)
+	RedactPayloads = NewNamespaceBoolSetting(
+		"system.redactPayloads",
+		true,
+		`RedactPayloads removes payload data (workflow and activity inputs, heartbeat details, memo values, ...) from
+server logs and DLQ message dumps of a namespace. Set it to false for a namespace to opt out. Log entries that are
+not tagged with a namespace name are always redacted.`,
+	)
 	EnableCrossNamespaceCommands = NewGlobalBoolSetting(
 		"system.enableCrossNamespaceCommands",
 		true,
@@ -211,6 +250,26 @@ config as the other services.`,
 		false,
 		`EnableEagerWorkflowStart toggles "eager workflow start" - returning the first workflow task inline in the
 response to a StartWorkflowExecution request and skipping the trip through matching.`,
+	)
+	StuckWorkflowTaskFailureThreshold = NewNamespaceIntSetting(
+		"system.stuckWorkflowTaskFailureThreshold",
+		0,
+		`StuckWorkflowTaskFailureThreshold is the number of consecutive workflow task failures with the same cause after
+which an execution is considered stuck. Stuck executions are tagged with the TemporalReportedProblems search attribute
+and reported by the executions scavenger. 0 disables the detection.`,
+	)
+	StuckWorkflowTaskMinDuration = NewNamespaceDurationSetting(
+		"system.stuckWorkflowTaskMinDuration",
+		10*time.Minute,
+		`StuckWorkflowTaskMinDuration is how long workflow tasks of an execution must have been failing with the same cause
+before it is considered stuck, see StuckWorkflowTaskFailureThreshold`,
+	)
+	EnablePendingNexusOperationsSearchAttribute = NewNamespaceBoolSetting(
+		"system.enablePendingNexusOperationsSearchAttribute",
+		false,
+		`EnablePendingNexusOperationsSearchAttribute toggles indexing the pending Nexus operations of workflows into the
+TemporalPendingNexusOperations search attribute, which backs the ListPendingNexusOperations admin API. Requires a
+visibility schema that includes the search attribute.`,
 	)
 	NamespaceCacheRefreshInterval = NewGlobalDurationSetting(
 		"system.namespaceCacheRefreshInterval",
@@ -237,6 +296,27 @@ response to a StartWorkflowExecution request and skipping the trip through match
 		5000,
 		`PersistenceHealthSignalBufferSize is the maximum number of persistence signals to buffer in memory per signal key`,
 	)
+	PersistenceSQLMaxConns = NewGlobalIntSetting(
+		"system.persistenceSQLMaxConns",
+		0,
+		`PersistenceSQLMaxConns overrides the maxConns of the SQL data store config at runtime, if positive`,
+	)
+	PersistenceSQLMaxIdleConns = NewGlobalIntSetting(
+		"system.persistenceSQLMaxIdleConns",
+		0,
+		`PersistenceSQLMaxIdleConns overrides the maxIdleConns of the SQL data store config at runtime, if positive`,
+	)
+	PersistenceSQLMaxConnLifetime = NewGlobalDurationSetting(
+		"system.persistenceSQLMaxConnLifetime",
+		0,
+		`PersistenceSQLMaxConnLifetime overrides the maxConnLifetime of the SQL data store config at runtime, if positive`,
+	)
+	PersistencePoolMetricsInterval = NewGlobalDurationSetting(
+		"system.persistencePoolMetricsInterval",
+		10*time.Second,
+		`PersistencePoolMetricsInterval is how often the connection pool metrics of the SQL data store are emitted and the
+pool size overrides are applied`,
+	)
 	ShardRPSWarnLimit = NewGlobalIntSetting(
 		"system.shardRPSWarnLimit",
 		50,
@@ -253,7 +333,7 @@ these warning are not emitted if the value is set to 0 or less`,
 		0.2,
 		`OperatorRPSRatio is the percentage of the rate limit provided to priority rate limiters that should be used for
 operator API calls (highest priority). Should be >0.0 and <= 1.0 (defaults to 20% if not specified)`,
-	)
+	).WithValidator(ValidateRange(0.0, 1.0))
 	PersistenceQPSBurstRatio = NewGlobalFloatSetting(
 		"system.persistenceQPSBurstRatio",
 		1.0,
@@ -294,17 +374,17 @@ operator API calls (highest priority). Should be >0.0 and <= 1.0 (defaults to 20
 		"system.validateUTF8.sample.rpcRequest",
 		0.0,
 		`Sample rate of utf-8 string validation for rpc requests`,
-	)
+	).WithValidator(ValidateRange(0.0, 1.0))
 	ValidateUTF8SampleRPCResponse = NewGlobalFloatSetting(
 		"system.validateUTF8.sample.rpcResponse",
 		0.0,
 		`Sample rate of utf-8 string validation for rpc responses`,
-	)
+	).WithValidator(ValidateRange(0.0, 1.0))
 	ValidateUTF8SamplePersistence = NewGlobalFloatSetting(
 		"system.validateUTF8.sample.persistence",
 		0.0,
 		`Sample rate of utf-8 string validation for persistence [de]serialization`,
-	)
+	).WithValidator(ValidateRange(0.0, 1.0))
 	ValidateUTF8FailRPCRequest = NewGlobalBoolSetting(
 		"system.validateUTF8.fail.rpcRequest",
 		false,
@@ -333,6 +413,17 @@ operator API calls (highest priority). Should be >0.0 and <= 1.0 (defaults to 20
 		512*1024,
 		`BlobSizeLimitWarn is the per event blob size limit for warning`,
 	)
+	NexusPayloadSizeLimitError = NewNamespaceIntSetting(
+		"limit.nexusPayloadSize.error",
+		2*1024*1024,
+		`NexusPayloadSizeLimitError is the size limit of Nexus operation input and result payloads. Nexus requests
+carrying larger payloads are rejected and oversized results are not recorded in history.`,
+	)
+	NexusPayloadSizeLimitWarn = NewNamespaceIntSetting(
+		"limit.nexusPayloadSize.warn",
+		512*1024,
+		`NexusPayloadSizeLimitWarn is the size of Nexus operation input and result payloads above which a warning is logged`,
+	)
 	MemoSizeLimitError = NewNamespaceIntSetting(
 		"limit.memoSize.error",
 		2*1024*1024,
@@ -343,6 +434,21 @@ operator API calls (highest priority). Should be >0.0 and <= 1.0 (defaults to 20
 		2*1024,
 		`MemoSizeLimitWarn is the per event memo size limit for warning`,
 	)
+	HistoryPayloadCompressionThreshold = NewNamespaceIntSetting(
+		"limit.historyPayloadCompressionThreshold",
+		0,
+		`HistoryPayloadCompressionThreshold is the size above which signal and update inputs are compressed before they are
+written to history. They are decompressed whenever history events are deserialized, so readers of history, e.g. workflow
+tasks, resets, archival, replication and tdbg, see the original payloads. Raw history is not sent to clients (see
+SendRawWorkflowHistory) when compression is enabled, as clients don't decompress it. 0 disables compression.`,
+	)
+	DurableQueryResultsSizeLimit = NewNamespaceIntSetting(
+		"limit.durableQueryResultsSize",
+		256*1024,
+		`DurableQueryResultsSizeLimit is the total size limit of the final query results a workflow can report when it
+closes. Results which don't fit are dropped and queries of the closed workflow for them are sent to a worker. 0 disables
+storing final query results.`,
+	)
 	NumPendingChildExecutionsLimitError = NewNamespaceIntSetting(
 		"limit.numPendingChildExecutions.error",
 		2000,
@@ -354,6 +460,14 @@ StartChildWorkflowExecution commands will fail.`,
 		2000,
 		`NumPendingActivitiesLimitError is the maximum number of pending activities a workflow can have before
 ScheduleActivityTask will fail.`,
+	)
+	WorkflowTypeNumPendingActivitiesLimit = NewNamespaceTypedSetting(
+		"limit.workflowTypeNumPendingActivities",
+		(map[string]int)(nil),
+		`WorkflowTypeNumPendingActivitiesLimit maps workflow types to the maximum number of activities their executions
+can have pending at the same time, replacing limit.numPendingActivities.error for them, e.g. {"FanOutWorkflow": 100}.
+Workflow tasks scheduling activities above the limit fail with the PendingActivitiesLimitExceeded cause and are
+retried.`,
 	)
 	NumPendingSignalsLimitError = NewNamespaceIntSetting(
 		"limit.numPendingSignals.error",
@@ -582,6 +696,13 @@ is currently processing a task.
 		[]string(nil),
 		`HTTP API Requests with a "Host" header matching the allowed hosts will be processed, otherwise rejected.
 Wildcards (*) are expanded to allow any substring. By default any Host header is allowed.`,
+	)
+	FrontendAuthorizationPolicy = NewGlobalStringSetting(
+		"frontend.authorizationPolicy",
+		"",
+		`FrontendAuthorizationPolicy is the YAML or JSON policy evaluated by the "policy" authorizer when neither a
+policy nor a policy file is set in its static config. Changes take effect without a restart, and a policy which fails
+to load doesn't replace the current one.`,
 	)
 	FrontendPersistenceMaxQPS = NewGlobalIntSetting(
 		"frontend.persistenceMaxQPS",
@@ -615,6 +736,13 @@ See DynamicRateLimitingParams comments for more details.`,
 		1000,
 		`FrontendVisibilityMaxPageSize is default max size for ListWorkflowExecutions in one page`,
 	)
+	FrontendExportWorkflowExecutionsMaxPageSize = NewNamespaceIntSetting(
+		"frontend.exportWorkflowExecutionsMaxPageSize",
+		10,
+		`FrontendExportWorkflowExecutionsMaxPageSize is the max number of executions returned in one page of the
+ExportWorkflowExecutions admin API. Each execution comes with its mutable state and full history, so pages are kept
+small.`,
+	)
 	FrontendHistoryMaxPageSize = NewNamespaceIntSetting(
 		"frontend.historyMaxPageSize",
 		primitives.GetHistoryMaxPageSize,
@@ -642,6 +770,13 @@ This config is EXPERIMENTAL and may be changed or removed in a later release.`,
 		2400,
 		`FrontendMaxNamespaceRPSPerInstance is workflow namespace rate limit per second`,
 	)
+	FrontendNamespaceAllowedCallerCIDRs = NewNamespaceTypedSetting(
+		"frontend.namespaceAllowedCallerCIDRs",
+		[]string(nil),
+		`FrontendNamespaceAllowedCallerCIDRs is the list of IP addresses and CIDR ranges allowed to call the frontend for
+a namespace. Requests from other addresses are rejected with PermissionDenied. An empty list allows all callers. The
+namespace data key "temporal.allowedCallerCIDRs" takes precedence over this setting when present.`,
+	)
 	FrontendMaxNamespaceBurstRatioPerInstance = NewNamespaceFloatSetting(
 		"frontend.namespaceBurstRatio",
 		2,
@@ -696,6 +831,29 @@ namespace replication inducing APIs (e.g. RegisterNamespace, UpdateNamespace, Up
 as a ratio of namespace ReplicationInducingAPIs RPS. The RPS used here will be the effective RPS from global and
 per-instance limits. This config is EXPERIMENTAL and may be changed or removed in a later release. The value must
 be 1 or higher.`,
+	)
+	FrontendNamespaceAPIClassRPSPerInstance = NewNamespaceTypedSetting(
+		"frontend.namespaceRPS.apiClass",
+		map[string]int(nil),
+		`FrontendNamespaceAPIClassRPSPerInstance is a per host/per namespace RPS limit for each API class. Keys are
+API classes ("read", "write", "poll" or "visibility") and values are RPS limits. A class without a positive
+limit is only subject to the other namespace limits. This config is EXPERIMENTAL and may be changed or removed
+in a later release.`,
+	)
+	FrontendNamespaceIdentityRPSPerInstance = NewNamespaceIntSetting(
+		"frontend.namespaceRPS.identity",
+		0,
+		`FrontendNamespaceIdentityRPSPerInstance is a per host/per namespace RPS limit for each caller identity (the
+identity field of the request). When an identity exceeds its limit, requests are shed in reverse order of
+"frontend.apiClassPriority". Zero disables the limit. This config is EXPERIMENTAL and may be changed or removed
+in a later release.`,
+	)
+	FrontendAPIClassPriority = NewGlobalTypedSetting(
+		"frontend.apiClassPriority",
+		[]string{"poll", "write", "read", "visibility"},
+		`FrontendAPIClassPriority orders API classes from highest to lowest priority for the per identity limit.
+Classes later in the list are shed first, so with the default order worker polls are shed last. Classes
+missing from the list have the lowest priority.`,
 	)
 	FrontendGlobalNamespaceRPS = NewNamespaceIntSetting(
 		"frontend.globalNamespaceRPS",
@@ -764,6 +922,29 @@ This config is EXPERIMENTAL and may be changed or removed in a later release.`,
 		"frontend.historyHostErrorPercentage",
 		0.5,
 		`HistoryHostErrorPercentage is the percentage of hosts that are unhealthy`,
+	).WithValidator(ValidateRange(0.0, 1.0))
+	MatchingHostErrorPercentage = NewGlobalFloatSetting(
+		"frontend.matchingHostErrorPercentage",
+		0.5,
+		`MatchingHostErrorPercentage is the percentage of matching hosts that may be unreachable before the dependency
+health check reports matching as not serving`,
+	).WithValidator(ValidateRange(0.0, 1.0))
+	DependencyHealthCheckTimeout = NewGlobalDurationSetting(
+		"frontend.dependencyHealthCheckTimeout",
+		5*time.Second,
+		`DependencyHealthCheckTimeout is the timeout of each dependency probe of the dependency health check`,
+	)
+	DependencyHealthCheckCacheTTL = NewGlobalDurationSetting(
+		"frontend.dependencyHealthCheckCacheTTL",
+		10*time.Second,
+		`DependencyHealthCheckCacheTTL is how long the result of the dependency health check is reused, so that load
+balancer and readiness probes don't put load on persistence`,
+	)
+	DependencyHealthRingStabilizationPeriod = NewGlobalDurationSetting(
+		"frontend.dependencyHealthRingStabilizationPeriod",
+		0,
+		`DependencyHealthRingStabilizationPeriod is how long a membership ring must remain unchanged to be reported as
+serving by the dependency health check. Zero only requires the ring to have members.`,
 	)
 	SendRawWorkflowHistory = NewNamespaceBoolSetting(
 		"frontend.sendRawWorkflowHistory",
@@ -800,6 +981,22 @@ This config is EXPERIMENTAL and may be changed or removed in a later release.`,
 		true,
 		`EnableTokenNamespaceEnforcement enables enforcement that namespace in completion token matches namespace of the request`,
 	)
+	EnableFineGrainedHandover = NewNamespaceBoolSetting(
+		"system.enableFineGrainedHandover",
+		false,
+		`EnableFineGrainedHandover stops frontend from rejecting all requests of a namespace in handover state.
+Instead, a history shard keeps accepting writes while remote clusters catch up with its replication tasks written
+before the handover, except writes to workflows which are not yet replicated, which shrinks the window of
+unavailability during a graceful failover. Accepted writes move the handover point of the shard forward. Once the
+tasks written before the handover are acked, the handover point is fixed and the shard rejects all writes until
+the failover completes.`,
+	)
+	HandoverMaxReplicationLag = NewNamespaceDurationSetting(
+		"frontend.handoverMaxReplicationLag",
+		0,
+		`HandoverMaxReplicationLag rejects moving a namespace into handover state while any history shard is behind on
+replication to a remote cluster of the namespace by more than this duration. Zero disables the check.`,
+	)
 	DisableListVisibilityByFilter = NewNamespaceBoolSetting(
 		"frontend.disableListVisibilityByFilter",
 		false,
@@ -940,6 +1137,25 @@ Wildcards (*) are expanded to allow any substring. By default blacklist is empty
 		1000,
 		`FrontendMaxExecutionCountBatchOperationPerNamespace is the max execution count batch operation supports per namespace`,
 	)
+	FrontendDestructiveOperationApprovalEnabled = NewGlobalBoolSetting(
+		"frontend.destructiveOperationApproval.enabled",
+		false,
+		`FrontendDestructiveOperationApprovalEnabled requires destructive operator APIs (DeleteNamespace, large batch
+terminate and DLQ purge) to be confirmed by a second caller identity before they execute. Callers are identified by
+the subject of their authorization claims, so this should only be enabled together with a claim mapper.`,
+	)
+	FrontendDestructiveOperationApprovalTTL = NewGlobalDurationSetting(
+		"frontend.destructiveOperationApproval.ttl",
+		15*time.Minute,
+		`FrontendDestructiveOperationApprovalTTL is how long a pending approval waits for confirmation by a second
+identity before it expires and the operation has to be requested again.`,
+	)
+	FrontendBatchTerminateApprovalThreshold = NewNamespaceIntSetting(
+		"frontend.destructiveOperationApproval.batchTerminateThreshold",
+		1000,
+		`FrontendBatchTerminateApprovalThreshold is the number of target executions at or above which a batch terminate
+operation requires a second approval when FrontendDestructiveOperationApprovalEnabled is set.`,
+	)
 	FrontendEnableBatcher = NewNamespaceBoolSetting(
 		"frontend.enableBatcher",
 		true,
@@ -1075,6 +1291,12 @@ See DynamicRateLimitingParams comments for more details.`,
 		200*time.Millisecond,
 		`MatchingSyncMatchWaitDuration is to wait time for sync match`,
 	)
+	MatchingMultiplexPolls = NewGlobalBoolSetting(
+		"matching.multiplexPolls",
+		false,
+		`MatchingMultiplexPolls is whether the matching client multiplexes long polls to normal task queue partitions
+over one stream per partition instead of holding a unary call per poll`,
+	)
 	MatchingHistoryMaxPageSize = NewNamespaceIntSetting(
 		"matching.historyMaxPageSize",
 		primitives.GetHistoryMaxPageSize,
@@ -1233,6 +1455,20 @@ duration since last poll exceeds this threshold.`,
 		10*time.Second,
 		`Time to wait between calls to check that the in-memory view of Nexus endpoints matches the persisted state.`,
 	)
+	MatchingEnableNexusEndpointReplication = NewGlobalBoolSetting(
+		"matching.enableNexusEndpointReplication",
+		false,
+		`MatchingEnableNexusEndpointReplication publishes Nexus endpoint changes to the namespace replication queue so
+that connected clusters have the same endpoints after failover. Conflicting changes made in different clusters are
+resolved by last writer wins.`,
+	)
+	MatchingNexusEndpointTombstoneTTL = NewGlobalDurationSetting(
+		"matching.nexusEndpointTombstoneTTL",
+		7*24*time.Hour,
+		`MatchingNexusEndpointTombstoneTTL is how long deleted Nexus endpoints are kept as tombstones in the endpoints
+table, so that older changes replicated from remote clusters don't recreate them. It must be greater than the longest
+expected replication delay between clusters.`,
+	)
 	MatchingMembershipUnloadDelay = NewGlobalDurationSetting(
 		"matching.membershipUnloadDelay",
 		500*time.Millisecond,
@@ -1342,6 +1578,13 @@ See DynamicRateLimitingParams comments for more details.`,
 		`HistoryCacheSizeBasedLimit if true, size of the history cache will be limited by HistoryCacheMaxSizeBytes
 and HistoryCacheHostLevelMaxSizeBytes. Otherwise, entry count in the history cache will be limited by
 HistoryCacheMaxSize and HistoryCacheHostLevelMaxSize.`,
+	)
+	HistoryCacheNamespaceFairEviction = NewGlobalBoolSetting(
+		"history.cacheNamespaceFairEviction",
+		false,
+		`HistoryCacheNamespaceFairEviction if true, the host level history cache reports its usage per namespace and
+evicts the workflows of namespaces using more than their fair share of the cache before the least recently used
+workflows of other namespaces. Takes effect on restart.`,
 	)
 	HistoryCacheInitialSize = NewGlobalIntSetting(
 		"history.cacheInitialSize",
@@ -1385,6 +1628,13 @@ will wait on workflow lock acquisition. Requires service restart to take effect.
 		256000*4*1024,
 		`HistoryCacheHostLevelMaxSizeBytes is the maximum size of the host level history cache. This is only used if
 HistoryCacheSizeBasedLimit is set to true.`,
+	)
+	HistoryReadMaxPageBytes = NewGlobalIntSetting(
+		"history.readMaxPageBytes",
+		8*1024*1024,
+		`HistoryReadMaxPageBytes is the approximate maximum size of history events buffered at a time when the history
+service reads a history branch, e.g. for GetWorkflowExecutionHistory or to rebuild or reset a workflow. Fewer batches
+are read per page when the batches read so far are large.`,
 	)
 	EnableWorkflowExecutionTimeoutTimer = NewGlobalBoolSetting(
 		"history.enableWorkflowExecutionTimeoutTimer",
@@ -1405,6 +1655,13 @@ This feature is still under development and should NOT be enabled.`,
 		0*time.Second,
 		`HistoryStartupMembershipJoinDelay is the duration a history instance waits
 before joining membership after starting.`,
+	)
+	HistoryLazyPayloadDecoding = NewGlobalBoolSetting(
+		"history.lazyPayloadDecoding",
+		false,
+		`HistoryLazyPayloadDecoding makes history keep the workflow input of start requests and the result of activity
+completions received from frontend encoded, instead of decoding them and encoding them again to persist them in the
+history of the workflow. It saves CPU and allocations for large payloads.`,
 	)
 	HistoryShutdownDrainDuration = NewGlobalDurationSetting(
 		"history.shutdownDrainDuration",
@@ -1446,6 +1703,19 @@ before joining membership after starting.`,
 		10,
 		`AcquireShardConcurrency is number of goroutines that can be used to acquire shards in the shard controller.`,
 	)
+	AcquireShardPrioritizeOldestTasks = NewGlobalBoolSetting(
+		"history.acquireShardPrioritizeOldestTasks",
+		true,
+		`AcquireShardPrioritizeOldestTasks makes the shard controller read the shard info of owned shards which are not
+loaded yet, and acquire the shards with the oldest pending timer tasks first.`,
+	)
+	HistoryShardOwnershipOverrides = NewGlobalTypedSetting(
+		"history.shardOwnershipOverrides",
+		[]ShardOwnershipOverride(nil),
+		`HistoryShardOwnershipOverrides pins history shards to specific history hosts instead of the ones chosen by the
+membership ring, e.g. [{shardId: 12, address: "10.0.0.5:7234"}]. An override is ignored while its host isn't an
+available member of the ring. It's usually set through the MoveShards admin API.`,
+	)
 	ShardLingerOwnershipCheckQPS = NewGlobalIntSetting(
 		"history.shardLingerOwnershipCheckQPS",
 		4,
@@ -1459,6 +1729,36 @@ checks while a shard is lingering.`,
 will temporarily delay closing shards after a membership update, awaiting a
 shard ownership lost error from persistence. If set to zero, shards will not delay closing.
 Do NOT use non-zero value with persistence layers that are missing AssertShardOwnership support.`,
+	)
+	ShardOwnershipChangeLogSize = NewGlobalIntSetting(
+		"history.shardOwnershipChangeLogSize",
+		100,
+		`ShardOwnershipChangeLogSize is the number of recent shard acquisitions and releases a history host keeps for
+the DescribeShardOwnership admin API`,
+	)
+	HotspotDetectionWindow = NewGlobalDurationSetting(
+		"history.hotspotDetectionWindow",
+		time.Minute,
+		`HotspotDetectionWindow is the interval over which a history host measures the lock wait time, task count and
+history bytes written of its shards and workflows to detect hotspots`,
+	)
+	HotspotMaxTrackedWorkflows = NewGlobalIntSetting(
+		"history.hotspotMaxTrackedWorkflows",
+		1000,
+		`HotspotMaxTrackedWorkflows is the maximum number of workflows per shard whose resource usage is tracked within a
+hotspot detection window. Usage of workflows beyond the limit only counts towards the shard.`,
+	)
+	HotspotScoreFactor = NewGlobalFloatSetting(
+		"history.hotspotScoreFactor",
+		3,
+		`HotspotScoreFactor is how many times its even share of the host resources a shard or workflow has to use within
+a hotspot detection window to be reported as hot`,
+	)
+	HotWorkflowMinScore = NewGlobalFloatSetting(
+		"history.hotWorkflowMinScore",
+		0.1,
+		`HotWorkflowMinScore is the minimum share of the host resources a workflow has to use within a hotspot detection
+window to be reported as hot`,
 	)
 	ShardFinalizerTimeout = NewGlobalDurationSetting(
 		"history.shardFinalizerTimeout",
@@ -1909,6 +2209,12 @@ data (eg: search attributes) will be lost after workflow is closed.`,
 		0,
 		`VisibilityProcessorRelocateAttributesMinBlobSize is the minimum size in bytes of memo or search
 attributes.`,
+	)
+	VisibilityProcessorCoalesceUpserts = NewNamespaceBoolSetting(
+		"history.visibilityProcessorCoalesceUpserts",
+		false,
+		`VisibilityProcessorCoalesceUpserts skips visibility upsert tasks of an execution whose changes were already
+written by a later processed upsert task, since upserts always write the latest state of the execution.`,
 	)
 	VisibilityQueueMaxReaderCount = NewGlobalIntSetting(
 		"history.visibilityQueueMaxReaderCount",
@@ -1986,16 +2292,96 @@ archivalQueueProcessor`,
 		`ArchivalQueueMaxReaderCount is the max number of readers in one multi-cursor archival queue`,
 	)
 
+	WorkflowLifecycleEventSinks = NewNamespaceTypedSetting(
+		"history.workflowLifecycleEventSinks",
+		[]string(nil),
+		`WorkflowLifecycleEventSinks is the list of sinks, by name as configured in the static config under
+global.workflowLifecycleEvents.sinks, that the lifecycle events (started, closed and reset) of the namespace's
+workflows are published to. Publishing is disabled for the namespace when empty.`,
+	)
+	WorkflowLifecycleEventTaskBatchSize = NewGlobalIntSetting(
+		"history.workflowLifecycleEventTaskBatchSize",
+		100,
+		`WorkflowLifecycleEventTaskBatchSize is batch size for workflowLifecycleEventQueueProcessor`,
+	)
+	WorkflowLifecycleEventProcessorMaxPollRPS = NewGlobalIntSetting(
+		"history.workflowLifecycleEventProcessorMaxPollRPS",
+		20,
+		`WorkflowLifecycleEventProcessorMaxPollRPS is max poll rate per second for workflowLifecycleEventQueueProcessor`,
+	)
+	WorkflowLifecycleEventProcessorMaxPollHostRPS = NewGlobalIntSetting(
+		"history.workflowLifecycleEventProcessorMaxPollHostRPS",
+		0,
+		`WorkflowLifecycleEventProcessorMaxPollHostRPS is max poll rate per second for all
+workflowLifecycleEventQueueProcessor on a host`,
+	)
+	WorkflowLifecycleEventProcessorSchedulerWorkerCount = NewGlobalIntSetting(
+		"history.workflowLifecycleEventProcessorSchedulerWorkerCount",
+		128,
+		`WorkflowLifecycleEventProcessorSchedulerWorkerCount is the number of workers in the host level task scheduler
+for workflowLifecycleEventQueueProcessor`,
+	)
+	WorkflowLifecycleEventProcessorMaxPollInterval = NewGlobalDurationSetting(
+		"history.workflowLifecycleEventProcessorMaxPollInterval",
+		time.Minute,
+		`WorkflowLifecycleEventProcessorMaxPollInterval max poll interval for workflowLifecycleEventQueueProcessor`,
+	)
+	WorkflowLifecycleEventProcessorMaxPollIntervalJitterCoefficient = NewGlobalFloatSetting(
+		"history.workflowLifecycleEventProcessorMaxPollIntervalJitterCoefficient",
+		0.15,
+		`WorkflowLifecycleEventProcessorMaxPollIntervalJitterCoefficient is the max poll interval jitter coefficient`,
+	)
+	WorkflowLifecycleEventProcessorUpdateAckInterval = NewGlobalDurationSetting(
+		"history.workflowLifecycleEventProcessorUpdateAckInterval",
+		30*time.Second,
+		`WorkflowLifecycleEventProcessorUpdateAckInterval is update interval for workflowLifecycleEventQueueProcessor`,
+	)
+	WorkflowLifecycleEventProcessorUpdateAckIntervalJitterCoefficient = NewGlobalFloatSetting(
+		"history.workflowLifecycleEventProcessorUpdateAckIntervalJitterCoefficient",
+		0.15,
+		`WorkflowLifecycleEventProcessorUpdateAckIntervalJitterCoefficient is the update interval jitter coefficient`,
+	)
+	WorkflowLifecycleEventProcessorPollBackoffInterval = NewGlobalDurationSetting(
+		"history.workflowLifecycleEventProcessorPollBackoffInterval",
+		5*time.Second,
+		`WorkflowLifecycleEventProcessorPollBackoffInterval is the poll backoff interval if task redispatcher's size
+exceeds limit for workflowLifecycleEventQueueProcessor`,
+	)
+	WorkflowLifecycleEventQueueMaxReaderCount = NewGlobalIntSetting(
+		"history.workflowLifecycleEventQueueMaxReaderCount",
+		2,
+		`WorkflowLifecycleEventQueueMaxReaderCount is the max number of readers in one multi-cursor workflow lifecycle
+event queue`,
+	)
+
 	WorkflowExecutionMaxInFlightUpdates = NewNamespaceIntSetting(
 		"history.maxInFlightUpdates",
 		10,
 		`WorkflowExecutionMaxInFlightUpdates is the max number of updates that can be in-flight (admitted but not yet completed) for any given workflow execution.`,
 	)
+	WorkflowExecutionMaxBufferedUpdates = NewNamespaceIntSetting(
+		"history.maxBufferedUpdates",
+		10,
+		`WorkflowExecutionMaxBufferedUpdates is the max number of updates that can be admitted but not yet sent to a worker for any given workflow execution.`,
+	)
 	WorkflowExecutionMaxTotalUpdates = NewNamespaceIntSetting(
 		"history.maxTotalUpdates",
 		2000,
 		`WorkflowExecutionMaxTotalUpdates is the max number of updates that any given workflow execution can receive.`,
 	)
+	WorkflowExecutionMaxCarriedOverUpdateOutcomes = NewNamespaceIntSetting(
+		"history.maxCarriedOverUpdateOutcomes",
+		100,
+		`WorkflowExecutionMaxCarriedOverUpdateOutcomes is the max number of outcomes of the most recently completed updates
+carried over to the new run on continue-as-new, so that retrying an update by ID after continue-as-new returns its
+outcome. 0 disables carrying over update outcomes.`,
+	)
+	WorkflowExecutionCarriedOverUpdateOutcomesSizeLimit = NewNamespaceIntSetting(
+		"history.carriedOverUpdateOutcomesSizeLimit",
+		512*1024,
+		`WorkflowExecutionCarriedOverUpdateOutcomesSizeLimit is the max total size in bytes of the update outcomes carried
+over to the new run on continue-as-new. The oldest outcomes are dropped first.`,
+	)
 
 	ReplicatorTaskBatchSize = NewGlobalIntSetting(
 		"history.replicatorTaskBatchSize",
@@ -2033,6 +2419,25 @@ state. The total size is determined by the sum of the size, in bytes, of each Hi
 		10000,
 		`MaximumSignalsPerExecution is max number of signals supported by single execution`,
 	)
+	SignalDedupKeyTTL = NewNamespaceDurationSetting(
+		"history.signalDedupKeyTTL",
+		time.Hour,
+		`SignalDedupKeyTTL is how long the dedup key of an accepted signal is remembered by the execution. Signals with a
+remembered key are dropped as duplicates. 0 disables signal deduplication by key.`,
+	)
+	SignalDedupKeysLimit = NewNamespaceIntSetting(
+		"history.signalDedupKeysLimit",
+		1000,
+		`SignalDedupKeysLimit is the max number of signal dedup keys remembered by a single execution. The keys closest
+to expiring are evicted first.`,
+	)
+	RejectUnknownWorkflowHandlers = NewNamespaceBoolSetting(
+		"history.rejectUnknownWorkflowHandlers",
+		false,
+		`RejectUnknownWorkflowHandlers rejects signals and updates for which the worker reported no handler in the
+workflow metadata of its last completed workflow task. Workflows whose worker never reported the metadata are not
+affected.`,
+	)
 	ShardUpdateMinInterval = NewGlobalDurationSetting(
 		"history.shardUpdateMinInterval",
 		5*time.Minute,
@@ -2071,12 +2476,76 @@ When the this config is zero or lower we will only update shard info at most onc
 		retrypolicy.DefaultDefaultRetrySettings,
 		`DefaultActivityRetryPolicy represents the out-of-box retry policy for activities where
 the user has not specified an explicit RetryPolicy`,
+	)
+	ActivityRetryPolicyCaps = NewNamespaceTypedSetting(
+		"history.activityRetryPolicyCaps",
+		retrypolicy.Caps{},
+		`ActivityRetryPolicyCaps are upper bounds applied to the retry policies of activities when they are scheduled,
+after the defaults are filled in, e.g. {"MaximumInterval": "10m", "MaximumAttempts": 50}. Zero fields are not applied.`,
+	)
+	ActivityTypeRetryPolicyCaps = NewNamespaceTypedSetting(
+		"history.activityTypeRetryPolicyCaps",
+		(map[string]retrypolicy.Caps)(nil),
+		`ActivityTypeRetryPolicyCaps maps activity types to the caps applied to their retry policies, replacing
+history.activityRetryPolicyCaps for them, e.g. {"ChargeCard": {"MaximumAttempts": 3}}.`,
+	)
+	PausedActivityTypeRecheckInterval = NewNamespaceDurationSetting(
+		"history.pausedActivityTypeRecheckInterval",
+		time.Minute,
+		`PausedActivityTypeRecheckInterval is how long the dispatch of an activity whose type is paused in its namespace
+is postponed before the pause is checked again, i.e. how long after the activity type is unpaused activities are
+dispatched at most.`,
+	)
+	ActivityHeartbeatPersistenceInterval = NewNamespaceDurationSetting(
+		"history.activityHeartbeatPersistenceInterval",
+		0,
+		`ActivityHeartbeatPersistenceInterval is the minimum interval between two activity heartbeats persisted to mutable
+state. Heartbeats received sooner after the last persisted one are acknowledged without being persisted, so their
+details are dropped. The interval is capped at half of the heartbeat timeout of the activity. Zero persists every
+heartbeat.`,
+	)
+	LocalActivityMarkerDurationThreshold = NewNamespaceDurationSetting(
+		"history.localActivityMarkerDurationThreshold",
+		0,
+		`LocalActivityMarkerDurationThreshold is the duration above which the markers recorded for local activities are
+annotated with the type, duration and failure message of the local activity in their header. Only markers recorded by
+the Go SDK are recognized. Zero disables the annotation.`,
 	)
 	DefaultWorkflowRetryPolicy = NewNamespaceTypedSetting(
 		"history.defaultWorkflowRetryPolicy",
 		retrypolicy.DefaultDefaultRetrySettings,
 		`DefaultWorkflowRetryPolicy represents the out-of-box retry policy for unset fields
 where the user has set an explicit RetryPolicy, but not specified all the fields`,
+	)
+	WorkflowExecutionMaxLifetime = NewNamespaceDurationSetting(
+		"history.workflowExecutionMaxLifetime",
+		0,
+		`WorkflowExecutionMaxLifetime is the max wall-clock time a workflow execution chain can live, counted from the
+start of its first run and surviving continue-as-new, retries and cron runs. The execution times out once it expires,
+like with a workflow execution timeout, which it caps. Applies to executions started after it is set. 0 means no
+limit.`,
+	)
+	UserTimerCoalescingWindow = NewNamespaceDurationSetting(
+		"history.userTimerCoalescingWindow",
+		0,
+		`UserTimerCoalescingWindow is the window within which user timers of the same workflow execution are fired
+together by a single timer task, and thus into a single workflow task. Timers may fire up to this window late.
+0 disables coalescing.`,
+	)
+	MaxWorkflowExecutionChainLength = NewNamespaceIntSetting(
+		"history.maxWorkflowExecutionChainLength",
+		0,
+		`MaxWorkflowExecutionChainLength is the max number of runs in a cron, retry or continue-as-new chain. The last run
+allowed is reported in the TemporalReportedProblems search attribute, its cron schedule is not honored anymore and it
+is terminated if it continues as new. Retries are counted but not refused. 0 means no limit.`,
+	)
+	CronOverlapPolicy = NewNamespaceStringSetting(
+		"history.cronOverlapPolicy",
+		"skip",
+		`CronOverlapPolicy controls when the next run of a cron workflow starts if the previous run closed after one or
+more of its scheduled times had passed. "skip" skips the missed times and waits for the next scheduled time,
+"bufferOne" starts a single run immediately for all the missed times, and "allowAll" starts one run immediately for
+every missed time, in order, until the workflow catches up with the schedule.`,
 	)
 	AllowResetWithPendingChildren = NewNamespaceBoolSetting(
 		"history.allowResetWithPendingChildren",
@@ -2104,6 +2573,12 @@ the number of children greater than or equal to this threshold`,
 		10,
 		`NumParentClosePolicySystemWorkflows is key for number of parentClosePolicy system workflows running in total`,
 	)
+	ParentClosePolicyBatchSize = NewGlobalIntSetting(
+		"history.parentClosePolicyBatchSize",
+		100,
+		`ParentClosePolicyBatchSize is the max number of children sent to a parentClosePolicy system workflow in one
+request. Children are grouped by the history shard they belong to before being split into requests of this size.`,
+	)
 	HistoryThrottledLogRPS = NewGlobalIntSetting(
 		"history.throttledLogRPS",
 		4,
@@ -2149,6 +2624,18 @@ the number of children greater than or equal to this threshold`,
 		1,
 		`MaxBufferedQueryCount indicates max buffer query count`,
 	)
+	QueryStrongConsistencyTimeout = NewNamespaceDurationSetting(
+		"history.queryStrongConsistencyTimeout",
+		0,
+		`QueryStrongConsistencyTimeout is how long history waits for the answer to a query which has to be dispatched on a
+workflow task to be consistent with the events that came before it. 0 means the query waits until the caller's deadline.`,
+	)
+	QueryEventualConsistencyTimeout = NewNamespaceDurationSetting(
+		"history.queryEventualConsistencyTimeout",
+		0,
+		`QueryEventualConsistencyTimeout is how long history waits for the answer to a query dispatched directly to a worker
+through matching. 0 means the query waits until the caller's deadline.`,
+	)
 	MutableStateChecksumGenProbability = NewNamespaceIntSetting(
 		"history.mutableStateChecksumGenProbability",
 		0,
@@ -2240,6 +2727,12 @@ the number of children greater than or equal to this threshold`,
 		30,
 		`ReplicationTaskProcessorShardQPS is the qps of task processing rate limiter on shard level`,
 	)
+	ReplicationTaskProcessorApplyParallelism = NewShardIDIntSetting(
+		"history.ReplicationTaskProcessorApplyParallelism",
+		1,
+		`ReplicationTaskProcessorApplyParallelism is the number of workflow executions whose replication tasks are
+applied in parallel by the replication task processor of a shard. Tasks of the same workflow are always applied in order.`,
+	)
 	ReplicationEnableDLQMetrics = NewGlobalBoolSetting(
 		"history.ReplicationEnableDLQMetrics",
 		true,
@@ -2256,6 +2749,35 @@ should be enabled for non continuedAsNew workflow UpdateWithNew case.`,
 		false,
 		`ReplicationMultipleBatches is the flag to enable replication of multiple history event batches`,
 	)
+	WorkflowTypeRetention = NewNamespaceTypedSetting(
+		"history.workflowTypeRetention",
+		(map[string]time.Duration)(nil),
+		`WorkflowTypeRetention maps workflow types to the retention period of their closed executions, overriding the
+retention of the namespace, e.g. {"PaymentWorkflow": "2160h", "HeartbeatWorkflow": "24h"}. The override is applied
+when the retention timer is created at close, so changing it doesn't affect already closed executions.`,
+	)
+	ReplicationExcludedWorkflowTypes = NewNamespaceTypedSetting(
+		"history.replicationExcludedWorkflowTypes",
+		([]string)(nil),
+		`ReplicationExcludedWorkflowTypes is a list of workflow type patterns of a global namespace for which no
+replication tasks are generated. Wildcards (*) are expanded to allow any substring. The filter is evaluated when an
+execution starts, and executions which are not replicated are lost on the standby clusters in case of failover.`,
+	)
+	WriteIntentWorkflowTypes = NewNamespaceTypedSetting(
+		"history.writeIntentWorkflowTypes",
+		([]string)(nil),
+		`WriteIntentWorkflowTypes is a list of workflow type patterns of a global namespace for which standby clusters
+accept signals and updates instead of rejecting them as not active. Wildcards (*) are expanded to allow any substring.
+Accepted writes are recorded as intents on the history replication stream of the execution's shard and applied by the
+active cluster, deduplicated by request ID. Updates wait for the outcome to be replicated back, up to the long poll
+expiration interval. Intents which arrive after the execution completes on the active cluster are dropped.`,
+	)
+	ReplicationExcludedSearchAttribute = NewNamespaceStringSetting(
+		"history.replicationExcludedSearchAttribute",
+		"",
+		`ReplicationExcludedSearchAttribute is the name of a search attribute which, when set on an execution of a global
+namespace at start time, excludes the execution from replication. See ReplicationExcludedWorkflowTypes for caveats.`,
+	)
 	HistoryTaskDLQEnabled = NewGlobalBoolSetting(
 		"history.TaskDLQEnabled",
 		true,
@@ -2336,6 +2858,23 @@ that task will be sent to DLQ.`,
 		100,
 		`Maximum number of low priority replication tasks that can be sent per second per shard`,
 	)
+	ReplicationStreamSendBatchMaxCount = NewGlobalIntSetting(
+		"history.ReplicationStreamSendBatchMaxCount",
+		1,
+		`Maximum number of replication tasks the stream sender puts in a single message. Pending tasks are sent as
+soon as there are no more tasks to read, so batches only fill up when the sender is catching up on a backlog`,
+	)
+	ReplicationStreamSendBatchMaxBytes = NewGlobalIntSetting(
+		"history.ReplicationStreamSendBatchMaxBytes",
+		2*1024*1024,
+		`Maximum size in bytes of the replication tasks the stream sender puts in a single message`,
+	)
+	ReplicationStreamCompressor = NewGlobalStringSetting(
+		"frontend.replicationStreamCompressor",
+		"",
+		`Name of the gRPC compressor (e.g. "gzip") used for replication stream messages sent to remote clusters.
+Only used if the remote cluster advertises support for it, empty disables compression`,
+	)
 	ReplicationReceiverMaxOutstandingTaskCount = NewGlobalIntSetting(
 		"history.ReplicationReceiverMaxOutstandingTaskCount",
 		500,
@@ -2375,7 +2914,7 @@ that task will be sent to DLQ.`,
 		"history.healthPersistenceErrorRatio",
 		0.90,
 		"History service health check on persistence error ratio",
-	)
+	).WithValidator(ValidateRange(0.0, 1.0))
 
 	// keys for worker
 
@@ -2492,6 +3031,60 @@ Should be at least WorkerESProcessorFlushInterval+<time to process request>.`,
 		true,
 		`ExecutionScannerHistoryEventIdValidator is the flag to enable history event id validator`,
 	)
+	ExecutionScannerOrphanedChildValidator = NewGlobalBoolSetting(
+		"worker.executionEnableOrphanedChildValidator",
+		false,
+		`ExecutionScannerOrphanedChildValidator is the flag to enable the validator which reports running child executions
+whose running parent no longer tracks them, and running parent executions tracking child executions which no longer
+exist. Children of a parent which no longer exists are not reported, as they may have been abandoned by it.`,
+	)
+	ExecutionScannerRepairOrphanedChildren = NewGlobalBoolSetting(
+		"worker.executionRepairOrphanedChildren",
+		false,
+		`ExecutionScannerRepairOrphanedChildren is the flag to repair the executions reported by the orphaned child
+validator: orphaned children are terminated and stale child info is cleared by recording the child as terminated in the
+parent.`,
+	)
+	ExecutionScannerStuckWorkflowValidator = NewGlobalBoolSetting(
+		"worker.executionEnableStuckWorkflowValidator",
+		false,
+		`ExecutionScannerStuckWorkflowValidator is the flag to enable the validator which reports running executions whose
+workflow tasks keep failing with the same cause, see StuckWorkflowTaskFailureThreshold`,
+	)
+	ExecutionScannerStuckWorkflowAutoReset = NewNamespaceBoolSetting(
+		"worker.executionStuckWorkflowAutoReset",
+		false,
+		`ExecutionScannerStuckWorkflowAutoReset is the namespace policy to reset the executions reported by the stuck
+workflow validator to their last completed workflow task, up to ExecutionScannerStuckWorkflowMaxResets times. Executions
+which never completed a workflow task are only reported.`,
+	)
+	ExecutionScannerStuckWorkflowMaxResets = NewNamespaceIntSetting(
+		"worker.executionStuckWorkflowMaxResets",
+		1,
+		`ExecutionScannerStuckWorkflowMaxResets is the number of times an execution is reset by
+ExecutionScannerStuckWorkflowAutoReset without completing a workflow task in between. Executions which are still stuck
+after that are only reported. Zero disables auto-reset.`,
+	)
+	ExecutionScannerZombieValidator = NewGlobalBoolSetting(
+		"worker.executionEnableZombieExecutionValidator",
+		false,
+		`ExecutionScannerZombieValidator is the flag to enable the validator which reports executions in an
+inconsistent terminal state: closed executions whose visibility record is still open, and running executions without
+a pending workflow task whose run or execution timeout has expired`,
+	)
+	ExecutionScannerRepairZombies = NewNamespaceBoolSetting(
+		"worker.executionRepairZombieExecutions",
+		false,
+		`ExecutionScannerRepairZombies is the namespace policy to repair the executions reported by the zombie
+execution validator: the close tasks of closed executions are regenerated and expired running executions are
+terminated. When disabled the executions are only reported.`,
+	)
+	ExecutionScannerZombieGracePeriod = NewGlobalDurationSetting(
+		"worker.executionZombieExecutionGracePeriod",
+		time.Hour,
+		`ExecutionScannerZombieGracePeriod is how long after its close time or timeout an execution must still be
+inconsistent before it is reported by the zombie execution validator`,
+	)
 	TaskQueueScannerEnabled = NewGlobalBoolSetting(
 		"worker.taskQueueScannerEnabled",
 		true,
@@ -2522,6 +3115,20 @@ Should be at least WorkerESProcessorFlushInterval+<time to process request>.`,
 		true,
 		`HistoryScannerVerifyRetention indicates the history scanner verify data retention.
 If the service configures with archival feature enabled, update worker.historyScannerVerifyRetention to be double of the data retention.`,
+	)
+	HistoryScannerDryRun = NewGlobalBoolSetting(
+		"worker.historyScannerDryRun",
+		false,
+		`HistoryScannerDryRun indicates the history scanner only reports garbage history branches instead of deleting them.
+Garbage branches are branches of executions which no longer exist, and branches not referenced by the version histories
+of their execution, such as the ones left over by failed resets.`,
+	)
+	HistoryScannerDeleteUnreferenced = NewGlobalBoolSetting(
+		"worker.historyScannerDeleteUnreferenced",
+		false,
+		`HistoryScannerDeleteUnreferenced indicates the history scanner deletes branches of existing executions which
+are not referenced by their version histories, such as the ones left over by failed resets. When disabled, such branches
+are only reported.`,
 	)
 	EnableBatcherNamespace = NewNamespaceBoolSetting(
 		"worker.enableNamespaceBatcher",
@@ -2538,6 +3145,25 @@ If the service configures with archival feature enabled, update worker.historySc
 		5,
 		`BatcherConcurrency controls the concurrency of one batch operation`,
 	)
+	BatcherAdaptiveRateLimit = NewNamespaceBoolSetting(
+		"worker.batcherAdaptiveRateLimit",
+		false,
+		`BatcherAdaptiveRateLimit enables adjusting the rps of batch operations based on the health of the requests they send.
+The rate is reduced when requests are throttled (resource exhausted or unavailable) or slow, and grows back towards the
+configured rps (worker.batcherRPS or the rps of the batch operation) while they are healthy. It never exceeds the configured rps.`,
+	)
+	BatcherAdaptiveRateLimitTargetLatency = NewNamespaceDurationSetting(
+		"worker.batcherAdaptiveRateLimitTargetLatency",
+		time.Second,
+		`BatcherAdaptiveRateLimitTargetLatency is the average request latency above which the adaptive batcher rate limiter
+backs off, see worker.batcherAdaptiveRateLimit`,
+	)
+	WorkerReArchivalRPS = NewNamespaceIntSetting(
+		"worker.reArchivalRPS",
+		50,
+		`WorkerReArchivalRPS is the maximum rate at which the re-archival workflow enqueues archival tasks for a namespace.
+Zero means no limit.`,
+	)
 	WorkerParentCloseMaxConcurrentActivityExecutionSize = NewGlobalIntSetting(
 		"worker.ParentCloseMaxConcurrentActivityExecutionSize",
 		1000,
@@ -2620,6 +3246,31 @@ WorkerActivitiesPerSecond, MaxConcurrentActivityTaskPollers.
 		false,
 		`When set to true, logs all RPC/request errors for the namespace, not just unexpected ones.`,
 	)
+	SlowRequestLogThresholds = NewGlobalTypedSetting(
+		"system.slowRequestLogThresholds",
+		(map[string]time.Duration)(nil),
+		`SlowRequestLogThresholds maps API names, as reported in the operation metric tag, to the latency above which a
+request is logged as slow along with its namespace, workflow, shard and the time it spent waiting on the workflow lock,
+persistence and matching, e.g. {"StartWorkflowExecution": "1s", "*": "5s"}. The "*" key applies to all other APIs and
+a zero threshold disables the log for an API, which is useful to exclude long polls.`,
+	)
+	EnableDebugTrace = NewGlobalBoolSetting(
+		"system.enableDebugTrace",
+		true,
+		`EnableDebugTrace allows callers with the system admin role to record a debug trace of a request by setting the
+debug-trace-id header. Traced requests are logged at info level by every service they reach.`,
+	)
+	DebugTraceRetention = NewGlobalDurationSetting(
+		"system.debugTraceRetention",
+		10*time.Minute,
+		`DebugTraceRetention is how long a host keeps the spans of a debug trace for the GetDebugTrace admin API.
+Changes take effect on restart.`,
+	)
+	DebugTraceMaxTraces = NewGlobalIntSetting(
+		"system.debugTraceMaxTraces",
+		1000,
+		`DebugTraceMaxTraces is the maximum number of debug traces a host keeps. Changes take effect on restart.`,
+	)
 
 	ActivityAPIsEnabled = NewNamespaceBoolSetting(
 		"frontend.activityAPIsEnabled",
@@ -0,0 +1,163 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.temporal.io/server/common/auth"
+	"go.temporal.io/server/common/log"
+)
+
+const (
+	defaultConsulWaitTime = time.Minute * 5
+	consulIndexHeader     = "X-Consul-Index"
+	consulTokenHeader     = "X-Consul-Token"
+)
+
+type (
+	// ConsulClientConfig is the config for the Consul based dynamic config client. The whole
+	// dynamic config document, in the format of the dynamic config file, is stored as the
+	// value of a single Consul KV key, and updates are picked up with blocking queries.
+	ConsulClientConfig struct {
+		Address    string   `yaml:"address"`
+		Key        string   `yaml:"key"`
+		Datacenter string   `yaml:"datacenter"`
+		Token      string   `yaml:"token"`
+		TLS        auth.TLS `yaml:"tls"`
+		// WaitTime is how long a single blocking query waits for a change. Consul caps it at 10 minutes.
+		WaitTime time.Duration `yaml:"waitTime"`
+	}
+
+	consulWatcher struct {
+		config     *ConsulClientConfig
+		httpClient *http.Client
+		url        string
+	}
+
+	consulKeyValue struct {
+		Value       []byte `json:"Value"`
+		ModifyIndex int64  `json:"ModifyIndex"`
+	}
+)
+
+var _ DocumentWatcher = (*consulWatcher)(nil)
+
+// NewConsulClient creates a Consul based dynamic config client.
+func NewConsulClient(config *ConsulClientConfig, logger log.Logger, doneCh <-chan interface{}) (*watchBasedClient, error) {
+	watcher, err := newConsulWatcher(config)
+	if err != nil {
+		return nil, err
+	}
+	return NewWatchBasedClient(watcher, logger, doneCh)
+}
+
+func newConsulWatcher(config *ConsulClientConfig) (*consulWatcher, error) {
+	if config == nil {
+		return nil, errors.New("configuration for consul dynamic config client is nil")
+	}
+	if config.Address == "" {
+		return nil, errors.New("consul dynamic config client: address is required")
+	}
+	if config.Key == "" {
+		return nil, errors.New("consul dynamic config client: key is required")
+	}
+	httpClient, err := newKVHTTPClient(&config.TLS)
+	if err != nil {
+		return nil, fmt.Errorf("consul dynamic config client: %w", err)
+	}
+	return &consulWatcher{
+		config:     config,
+		httpClient: httpClient,
+		url:        kvEndpointURL(config.Address, config.TLS.Enabled) + "/v1/kv/" + strings.TrimPrefix(config.Key, "/"),
+	}, nil
+}
+
+func (w *consulWatcher) Get(ctx context.Context) ([]byte, int64, error) {
+	return w.query(ctx, url.Values{})
+}
+
+func (w *consulWatcher) Watch(ctx context.Context, revision int64) ([]byte, int64, error) {
+	waitTime := w.config.WaitTime
+	if waitTime <= 0 {
+		waitTime = defaultConsulWaitTime
+	}
+	params := url.Values{}
+	params.Set("index", strconv.FormatInt(revision, 10))
+	params.Set("wait", fmt.Sprintf("%ds", int64(waitTime/time.Second)))
+
+	// If the index goes backwards, e.g. after a snapshot restore, the document is reloaded
+	// because the revision differs, and the next query waits from the new index.
+	return w.query(ctx, params)
+}
+
+func (w *consulWatcher) query(ctx context.Context, params url.Values) ([]byte, int64, error) {
+	if w.config.Datacenter != "" {
+		params.Set("dc", w.config.Datacenter)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, w.url+"?"+params.Encode(), nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	if w.config.Token != "" {
+		req.Header.Set(consulTokenHeader, w.config.Token)
+	}
+
+	resp, err := w.httpClient.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	index, err := strconv.ParseInt(resp.Header.Get(consulIndexHeader), 10, 64)
+	if err != nil && resp.StatusCode != http.StatusNotFound {
+		return nil, 0, fmt.Errorf("consul returned invalid %s header: %w", consulIndexHeader, err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, index, nil
+	default:
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, 0, fmt.Errorf("consul returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var kvs []consulKeyValue
+	if err := json.NewDecoder(resp.Body).Decode(&kvs); err != nil {
+		return nil, 0, err
+	}
+	if len(kvs) == 0 {
+		return nil, index, nil
+	}
+	return kvs[0].Value, index, nil
+}
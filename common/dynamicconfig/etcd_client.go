@@ -0,0 +1,340 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go.temporal.io/server/common/auth"
+	"go.temporal.io/server/common/log"
+)
+
+const (
+	defaultEtcdWatchTimeout = time.Minute * 5
+)
+
+type (
+	// EtcdClientConfig is the config for the etcd based dynamic config client. The whole
+	// dynamic config document, in the format of the dynamic config file, is stored as the
+	// value of a single etcd key, and updates are picked up by watching that key.
+	// The client talks to the etcd v3 JSON gateway, which etcd serves on its client port.
+	EtcdClientConfig struct {
+		Endpoints []string `yaml:"endpoints"`
+		Key       string   `yaml:"key"`
+		Username  string   `yaml:"username"`
+		Password  string   `yaml:"password"`
+		TLS       auth.TLS `yaml:"tls"`
+		// WatchTimeout is how long a single watch request is kept open before it's re-established.
+		WatchTimeout time.Duration `yaml:"watchTimeout"`
+	}
+
+	etcdWatcher struct {
+		config     *EtcdClientConfig
+		httpClient *http.Client
+		endpoints  []string
+		key        string
+
+		lock     sync.Mutex
+		endpoint int
+		token    string
+	}
+
+	etcdHeader struct {
+		Revision int64 `json:"revision,string"`
+	}
+
+	etcdKeyValue struct {
+		Value       []byte `json:"value"`
+		ModRevision int64  `json:"mod_revision,string"`
+	}
+
+	etcdRangeResponse struct {
+		Header etcdHeader     `json:"header"`
+		Kvs    []etcdKeyValue `json:"kvs"`
+	}
+
+	etcdWatchResponse struct {
+		Result *struct {
+			Header          etcdHeader `json:"header"`
+			Canceled        bool       `json:"canceled"`
+			CancelReason    string     `json:"cancel_reason"`
+			CompactRevision int64      `json:"compact_revision,string"`
+			Events          []struct {
+				Type string       `json:"type"`
+				Kv   etcdKeyValue `json:"kv"`
+			} `json:"events"`
+		} `json:"result"`
+		Error *struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+
+	etcdStatusError struct {
+		statusCode int
+		body       string
+	}
+)
+
+var _ DocumentWatcher = (*etcdWatcher)(nil)
+
+// NewEtcdClient creates an etcd based dynamic config client.
+func NewEtcdClient(config *EtcdClientConfig, logger log.Logger, doneCh <-chan interface{}) (*watchBasedClient, error) {
+	watcher, err := newEtcdWatcher(config)
+	if err != nil {
+		return nil, err
+	}
+	return NewWatchBasedClient(watcher, logger, doneCh)
+}
+
+func newEtcdWatcher(config *EtcdClientConfig) (*etcdWatcher, error) {
+	if config == nil {
+		return nil, errors.New("configuration for etcd dynamic config client is nil")
+	}
+	if len(config.Endpoints) == 0 {
+		return nil, errors.New("etcd dynamic config client: no endpoints configured")
+	}
+	if config.Key == "" {
+		return nil, errors.New("etcd dynamic config client: key is required")
+	}
+	httpClient, err := newKVHTTPClient(&config.TLS)
+	if err != nil {
+		return nil, fmt.Errorf("etcd dynamic config client: %w", err)
+	}
+	endpoints := make([]string, len(config.Endpoints))
+	for i, endpoint := range config.Endpoints {
+		endpoints[i] = kvEndpointURL(endpoint, config.TLS.Enabled)
+	}
+	return &etcdWatcher{
+		config:     config,
+		httpClient: httpClient,
+		endpoints:  endpoints,
+		key:        config.Key,
+	}, nil
+}
+
+func (w *etcdWatcher) Get(ctx context.Context) ([]byte, int64, error) {
+	var resp etcdRangeResponse
+	err := w.call(ctx, "/v3/kv/range", map[string]any{"key": []byte(w.key)}, func(body io.Reader) error {
+		return json.NewDecoder(body).Decode(&resp)
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, resp.Header.Revision, nil
+	}
+	return resp.Kvs[0].Value, resp.Header.Revision, nil
+}
+
+func (w *etcdWatcher) Watch(ctx context.Context, revision int64) ([]byte, int64, error) {
+	watchTimeout := w.config.WatchTimeout
+	if watchTimeout <= 0 {
+		watchTimeout = defaultEtcdWatchTimeout
+	}
+	watchCtx, cancel := context.WithTimeout(ctx, watchTimeout)
+	defer cancel()
+
+	var (
+		contents    []byte
+		newRevision int64
+		compacted   bool
+	)
+	request := map[string]any{
+		"create_request": map[string]any{
+			"key":            []byte(w.key),
+			"start_revision": revision + 1,
+		},
+	}
+	err := w.call(watchCtx, "/v3/watch", request, func(body io.Reader) error {
+		decoder := json.NewDecoder(body)
+		for {
+			var resp etcdWatchResponse
+			if err := decoder.Decode(&resp); err != nil {
+				return err
+			}
+			if resp.Error != nil {
+				return fmt.Errorf("etcd watch failed: %s", resp.Error.Message)
+			}
+			if resp.Result == nil {
+				continue
+			}
+			if resp.Result.CompactRevision > 0 {
+				compacted = true
+				return nil
+			}
+			if resp.Result.Canceled {
+				return fmt.Errorf("etcd watch canceled: %s", resp.Result.CancelReason)
+			}
+			if len(resp.Result.Events) == 0 {
+				continue
+			}
+			event := resp.Result.Events[len(resp.Result.Events)-1]
+			if event.Type != "DELETE" {
+				contents = event.Kv.Value
+			}
+			newRevision = event.Kv.ModRevision
+			return nil
+		}
+	})
+	switch {
+	case compacted:
+		// The revision we watched from is gone, start over from the current value.
+		return w.Get(ctx)
+	case err != nil && ctx.Err() == nil && watchCtx.Err() != nil:
+		// Nothing changed before the watch timed out.
+		return nil, revision, nil
+	case err != nil:
+		return nil, 0, err
+	}
+	return contents, newRevision, nil
+}
+
+// call sends a request to the etcd JSON gateway, moving on to the next endpoint if one fails
+// and authenticating first if a username is configured.
+func (w *etcdWatcher) call(ctx context.Context, path string, request any, handle func(io.Reader) error) error {
+	var lastErr error
+	for attempt := 0; attempt < len(w.endpoints); attempt++ {
+		w.lock.Lock()
+		endpoint := w.endpoints[w.endpoint]
+		w.lock.Unlock()
+
+		err := w.callEndpoint(ctx, endpoint, path, request, handle)
+		var statusErr *etcdStatusError
+		if errors.As(err, &statusErr) && statusErr.statusCode == http.StatusUnauthorized && w.config.Username != "" {
+			// The token expired, get a new one and retry once.
+			w.setToken("")
+			err = w.callEndpoint(ctx, endpoint, path, request, handle)
+		}
+		if err == nil || ctx.Err() != nil || errors.As(err, &statusErr) {
+			return err
+		}
+
+		lastErr = err
+		w.lock.Lock()
+		w.endpoint = (w.endpoint + 1) % len(w.endpoints)
+		w.lock.Unlock()
+	}
+	return lastErr
+}
+
+func (w *etcdWatcher) callEndpoint(ctx context.Context, endpoint string, path string, request any, handle func(io.Reader) error) error {
+	token, err := w.getToken(ctx, endpoint)
+	if err != nil {
+		return err
+	}
+	return w.post(ctx, endpoint+path, token, request, handle)
+}
+
+func (w *etcdWatcher) getToken(ctx context.Context, endpoint string) (string, error) {
+	if w.config.Username == "" {
+		return "", nil
+	}
+	w.lock.Lock()
+	token := w.token
+	w.lock.Unlock()
+	if token != "" {
+		return token, nil
+	}
+
+	var resp struct {
+		Token string `json:"token"`
+	}
+	request := map[string]string{"name": w.config.Username, "password": w.config.Password}
+	err := w.post(ctx, endpoint+"/v3/auth/authenticate", "", request, func(body io.Reader) error {
+		return json.NewDecoder(body).Decode(&resp)
+	})
+	if err != nil {
+		return "", fmt.Errorf("etcd authentication failed: %w", err)
+	}
+	w.setToken(resp.Token)
+	return resp.Token, nil
+}
+
+func (w *etcdWatcher) setToken(token string) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.token = token
+}
+
+func (w *etcdWatcher) post(ctx context.Context, url string, token string, request any, handle func(io.Reader) error) error {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", token)
+	}
+
+	resp, err := w.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return &etcdStatusError{statusCode: resp.StatusCode, body: strings.TrimSpace(string(respBody))}
+	}
+	return handle(resp.Body)
+}
+
+func (e *etcdStatusError) Error() string {
+	return fmt.Sprintf("etcd returned status %d: %s", e.statusCode, e.body)
+}
+
+func newKVHTTPClient(tlsConfig *auth.TLS) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if tlsConfig.Enabled {
+		config, err := auth.NewTLSConfig(tlsConfig)
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig = config
+	}
+	return &http.Client{Transport: transport}, nil
+}
+
+func kvEndpointURL(endpoint string, tlsEnabled bool) string {
+	endpoint = strings.TrimSuffix(endpoint, "/")
+	if strings.Contains(endpoint, "://") {
+		return endpoint
+	}
+	if tlsEnabled {
+		return "https://" + endpoint
+	}
+	return "http://" + endpoint
+}
@@ -91,6 +91,11 @@ type (
 		Warnings []error
 		Errors   []error
 	}
+
+	yamlConstrainedValue struct {
+		Constraints map[string]any
+		Value       any
+	}
 )
 
 func ValidateFile(contents []byte) *LoadResult {
@@ -203,7 +208,7 @@ func (fc *fileBasedClient) Update() error {
 
 	prev := fc.values.Swap(newValues)
 	oldValues, _ := prev.(configValueMap)
-	changedMap := fc.diffAndLog(oldValues, newValues)
+	changedMap := diffAndLog(fc.logger, oldValues, newValues)
 	fc.logger.Info("Updated dynamic config")
 
 	if len(changedMap) == 0 {
@@ -224,49 +229,77 @@ func (fc *fileBasedClient) Update() error {
 func loadFile(contents []byte) (configValueMap, *LoadResult) {
 	lr := &LoadResult{}
 
-	var yamlValues map[string][]struct {
-		Constraints map[string]any
-		Value       any
-	}
+	var yamlValues map[string][]yamlConstrainedValue
 	if err := yaml.Unmarshal(contents, &yamlValues); err != nil {
 		return nil, lr.errorf("decode error: %w", err)
 	}
 
 	newValues := make(configValueMap, len(yamlValues))
 	for key, yamlCV := range yamlValues {
-		precedence := PrecedenceUnknown
 		setting := queryRegistry(Key(key))
 		if setting == nil {
 			lr.warnf("unregistered key %q", key)
-		} else {
-			precedence = setting.Precedence()
 		}
+		newValues[strings.ToLower(key)] = loadConstrainedValues(key, setting, yamlCV, lr)
+	}
 
-		cvs := make([]ConstrainedValue, len(yamlCV))
-		for i, cv := range yamlCV {
-			// yaml will unmarshal map into map[interface{}]interface{} instead of map[string]interface{}
-			// manually convert key type to string for all values here
-			val, err := convertKeyTypeToString(cv.Value)
-			if err != nil {
-				lr.error(err)
-				continue
-			}
+	return newValues, lr
+}
 
-			// try validating if known setting
-			if setting != nil {
-				if valErr := setting.Validate(val); valErr != nil {
-					// TODO: raise this to error level
-					lr.warnf("validation failed: key %q value %v: %w", key, cv.Value, valErr)
-				}
+// ParseConstrainedValues parses the values of a single key, given as a YAML list in the format of the
+// dynamic config file, e.g.
+//
+//   - constraints: {namespace: "my-namespace"}
+//     value: 10
+//   - value: 5
+func ParseConstrainedValues(key Key, contents []byte) ([]ConstrainedValue, *LoadResult) {
+	lr := &LoadResult{}
+
+	var yamlCV []yamlConstrainedValue
+	if err := yaml.Unmarshal(contents, &yamlCV); err != nil {
+		return nil, lr.errorf("decode error: %w", err)
+	}
+
+	setting := queryRegistry(key)
+	if setting == nil {
+		lr.warnf("unregistered key %q", key)
+	}
+	return loadConstrainedValues(key.String(), setting, yamlCV, lr), lr
+}
+
+func loadConstrainedValues(key string, setting GenericSetting, yamlCV []yamlConstrainedValue, lr *LoadResult) []ConstrainedValue {
+	precedence := PrecedenceUnknown
+	if setting != nil {
+		precedence = setting.Precedence()
+	}
+
+	cvs := make([]ConstrainedValue, len(yamlCV))
+	for i, cv := range yamlCV {
+		// yaml will unmarshal map into map[interface{}]interface{} instead of map[string]interface{}
+		// manually convert key type to string for all values here
+		val, err := convertKeyTypeToString(cv.Value)
+		if err != nil {
+			lr.error(err)
+			continue
+		}
+
+		// validate against the type and validator of the registered setting, if known
+		if setting != nil {
+			if valErr := setting.Validate(val); valErr != nil {
+				lr.errorf("validation failed: key %q value %v: %w", key, cv.Value, valErr)
 			}
+		}
 
-			cvs[i].Value = val
-			cvs[i].Constraints = convertYamlConstraints(key, cv.Constraints, precedence, lr)
+		cvs[i].Value = val
+		cvs[i].Constraints = convertYamlConstraints(key, cv.Constraints, precedence, lr)
+		for _, prev := range cvs[:i] {
+			if prev.Constraints == cvs[i].Constraints {
+				lr.errorf("duplicate constraints for dynamic config key %q: %v", key, cv.Constraints)
+				break
+			}
 		}
-		newValues[strings.ToLower(key)] = cvs
 	}
-
-	return newValues, lr
+	return cvs
 }
 
 func (fc *fileBasedClient) validateStaticConfig(config *FileBasedClientConfig) error {
@@ -282,7 +315,7 @@ func (fc *fileBasedClient) validateStaticConfig(config *FileBasedClientConfig) e
 	return nil
 }
 
-func (fc *fileBasedClient) diffAndLog(old configValueMap, new configValueMap) map[Key][]ConstrainedValue {
+func diffAndLog(logger log.Logger, old configValueMap, new configValueMap) map[Key][]ConstrainedValue {
 	changedMap := make(map[Key][]ConstrainedValue)
 
 	for key, newValues := range new {
@@ -290,12 +323,12 @@ func (fc *fileBasedClient) diffAndLog(old configValueMap, new configValueMap) ma
 		if !ok {
 			for _, newValue := range newValues {
 				// new key added
-				fc.diffAndLogValue(key, nil, &newValue)
+				diffAndLogValue(logger, key, nil, &newValue)
 			}
 			changedMap[Key(key)] = newValues
 		} else {
 			// compare existing keys
-			changed := fc.diffAndLogConstraints(key, oldValues, newValues)
+			changed := diffAndLogConstraints(logger, key, oldValues, newValues)
 			if changed {
 				changedMap[Key(key)] = newValues
 			}
@@ -306,7 +339,7 @@ func (fc *fileBasedClient) diffAndLog(old configValueMap, new configValueMap) ma
 	for key, oldValues := range old {
 		if _, ok := new[key]; !ok {
 			for _, oldValue := range oldValues {
-				fc.diffAndLogValue(key, &oldValue, nil)
+				diffAndLogValue(logger, key, &oldValue, nil)
 			}
 			changedMap[Key(key)] = nil
 		}
@@ -315,7 +348,7 @@ func (fc *fileBasedClient) diffAndLog(old configValueMap, new configValueMap) ma
 	return changedMap
 }
 
-func (fc *fileBasedClient) diffAndLogConstraints(key string, oldValues []ConstrainedValue, newValues []ConstrainedValue) bool {
+func diffAndLogConstraints(logger log.Logger, key string, oldValues []ConstrainedValue, newValues []ConstrainedValue) bool {
 	changed := false
 	for _, oldValue := range oldValues {
 		matchFound := false
@@ -323,13 +356,13 @@ func (fc *fileBasedClient) diffAndLogConstraints(key string, oldValues []Constra
 			if oldValue.Constraints == newValue.Constraints {
 				matchFound = true
 				if !reflect.DeepEqual(oldValue.Value, newValue.Value) {
-					fc.diffAndLogValue(key, &oldValue, &newValue)
+					diffAndLogValue(logger, key, &oldValue, &newValue)
 					changed = true
 				}
 			}
 		}
 		if !matchFound {
-			fc.diffAndLogValue(key, &oldValue, nil)
+			diffAndLogValue(logger, key, &oldValue, nil)
 			changed = true
 		}
 	}
@@ -342,26 +375,26 @@ func (fc *fileBasedClient) diffAndLogConstraints(key string, oldValues []Constra
 			}
 		}
 		if !matchFound {
-			fc.diffAndLogValue(key, nil, &newValue)
+			diffAndLogValue(logger, key, nil, &newValue)
 			changed = true
 		}
 	}
 	return changed
 }
 
-func (fc *fileBasedClient) diffAndLogValue(key string, oldValue *ConstrainedValue, newValue *ConstrainedValue) {
+func diffAndLogValue(logger log.Logger, key string, oldValue *ConstrainedValue, newValue *ConstrainedValue) {
 	logLine := &strings.Builder{}
 	logLine.Grow(128)
 	logLine.WriteString("dynamic config changed for the key: ")
 	logLine.WriteString(key)
 	logLine.WriteString(" oldValue: ")
-	fc.appendConstrainedValue(logLine, oldValue)
+	appendConstrainedValue(logLine, oldValue)
 	logLine.WriteString(" newValue: ")
-	fc.appendConstrainedValue(logLine, newValue)
-	fc.logger.Info(logLine.String())
+	appendConstrainedValue(logLine, newValue)
+	logger.Info(logLine.String())
 }
 
-func (fc *fileBasedClient) appendConstrainedValue(logLine *strings.Builder, value *ConstrainedValue) {
+func appendConstrainedValue(logLine *strings.Builder, value *ConstrainedValue) {
 	if value == nil {
 		logLine.WriteString("nil")
 	} else {
@@ -387,6 +420,9 @@ func (fc *fileBasedClient) appendConstrainedValue(logLine *strings.Builder, valu
 		if value.Constraints.Destination != "" {
 			logLine.WriteString(fmt.Sprintf("{Destination:%s}", value.Constraints.Destination))
 		}
+		if value.Constraints.RolloutPercentage != 0 {
+			logLine.WriteString(fmt.Sprintf("{RolloutPercentage:%d}", value.Constraints.RolloutPercentage))
+		}
 		logLine.WriteString(fmt.Sprint("} value: ", value.Value, " }"))
 	}
 }
@@ -439,21 +475,21 @@ func convertYamlConstraints(key string, m map[string]any, precedence Precedence,
 			if v, ok := v.(string); ok {
 				cs.Namespace = v
 			} else {
-				lr.errorf("namespace constraint must be string")
+				lr.errorf("key %q: namespace constraint must be string", key)
 			}
-			validConstraint = precedence == PrecedenceNamespace || precedence == PrecedenceTaskQueue || precedence == PrecedenceDestination
+			validConstraint = precedence == PrecedenceNamespace || precedence == PrecedenceTaskQueue || precedence == PrecedenceDestination || precedence == PrecedenceWorkflow
 		case "namespaceid":
 			if v, ok := v.(string); ok {
 				cs.NamespaceID = v
 			} else {
-				lr.errorf("namespaceID constraint must be string")
+				lr.errorf("key %q: namespaceID constraint must be string", key)
 			}
 			validConstraint = precedence == PrecedenceNamespaceID
 		case "taskqueuename":
 			if v, ok := v.(string); ok {
 				cs.TaskQueueName = v
 			} else {
-				lr.errorf("taskQueueName constraint must be string")
+				lr.errorf("key %q: taskQueueName constraint must be string", key)
 			}
 			validConstraint = precedence == PrecedenceTaskQueue
 		case "tasktype":
@@ -461,19 +497,19 @@ func convertYamlConstraints(key string, m map[string]any, precedence Precedence,
 			case string:
 				i, err := enumspb.TaskQueueTypeFromString(v)
 				if err != nil {
-					lr.errorf("invalid value for taskType: %w", err)
+					lr.errorf("key %q: invalid value for taskType: %w", key, err)
 				} else if i <= enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
-					lr.errorf("taskType constraint must be Workflow/Activity")
+					lr.errorf("key %q: taskType constraint must be Workflow/Activity", key)
 				}
 				cs.TaskQueueType = i
 			case int:
 				if v > int(enumspb.TASK_QUEUE_TYPE_UNSPECIFIED) {
 					cs.TaskQueueType = enumspb.TaskQueueType(v)
 				} else {
-					lr.errorf("taskType constraint must be Workflow/Activity")
+					lr.errorf("key %q: taskType constraint must be Workflow/Activity", key)
 				}
 			default:
-				lr.errorf("taskType constraint must be Workflow/Activity")
+				lr.errorf("key %q: taskType constraint must be Workflow/Activity", key)
 			}
 			validConstraint = precedence == PrecedenceTaskQueue
 		case "historytasktype":
@@ -481,40 +517,46 @@ func convertYamlConstraints(key string, m map[string]any, precedence Precedence,
 			case string:
 				tt, err := enumsspb.TaskTypeFromString(v)
 				if err != nil {
-					lr.errorf("invalid value for historytasktype constraint: %w", err)
+					lr.errorf("key %q: invalid value for historytasktype constraint: %w", key, err)
 				} else if tt <= enumsspb.TASK_TYPE_UNSPECIFIED {
-					lr.errorf("historytasktype %s constraint is not supported", v)
+					lr.errorf("key %q: historytasktype %s constraint is not supported", key, v)
 				}
 				cs.TaskType = tt
 			case int:
 				cs.TaskType = enumsspb.TaskType(v)
 			default:
-				lr.errorf("historytasktype %T constraint is not supported", v)
+				lr.errorf("key %q: historytasktype %T constraint is not supported", key, v)
 			}
 			validConstraint = precedence == PrecedenceTaskType
 		case "shardid":
 			if v, ok := v.(int); ok {
 				cs.ShardID = int32(v)
 			} else {
-				lr.errorf("shardID constraint must be integer")
+				lr.errorf("key %q: shardID constraint must be integer", key)
 			}
 			validConstraint = precedence == PrecedenceShardID
 		case "destination":
 			if v, ok := v.(string); ok {
 				cs.Destination = v
 			} else {
-				lr.errorf("destination constraint must be string")
+				lr.errorf("key %q: destination constraint must be string", key)
 			}
 			validConstraint = precedence == PrecedenceDestination
+		case "rolloutpercentage":
+			if v, ok := v.(int); ok && v > 0 && v <= 100 {
+				cs.RolloutPercentage = int32(v)
+			} else {
+				lr.errorf("key %q: rolloutPercentage constraint must be integer between 1 and 100", key)
+			}
+			validConstraint = precedence != PrecedenceGlobal && precedence != PrecedenceShardID && precedence != PrecedenceTaskType
 		default:
-			lr.errorf("unknown constraint type %q", k)
+			lr.errorf("key %q: unknown constraint type %q", key, k)
 		}
 
 		// don't log error for PrecedenceUnknown, we would already have logged for an
 		// unregistered key above
-		// TODO: raise this to error level
 		if !validConstraint && precedence != PrecedenceUnknown {
-			lr.warnf("constraint %q isn't valid for dynamic config key %q", k, key)
+			lr.errorf("constraint %q isn't valid for dynamic config key %q", k, key)
 		}
 	}
 	return cs
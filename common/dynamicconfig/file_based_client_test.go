@@ -25,6 +25,7 @@
 package dynamicconfig_test
 
 import (
+	"errors"
 	"strings"
 	"testing"
 	"time"
@@ -58,6 +59,9 @@ func (s *fileBasedClientSuite) SetupSuite() {
 	var err error
 	s.doneCh = make(chan interface{})
 	logger := log.NewNoopLogger()
+	// The test config file contains keys of all types, which must not be validated against
+	// settings registered elsewhere.
+	dynamicconfig.ResetRegistryForTest()
 	s.client, err = dynamicconfig.NewFileBasedClient(&dynamicconfig.FileBasedClientConfig{
 		Filepath:     "config/testConfig.yaml",
 		PollInterval: time.Second * 5,
@@ -578,19 +582,78 @@ testGetFloat64PropertyKey:
 	s.ErrorContains(lr.Warnings[0], `unregistered key "testGetFloat64PropertyKey"`)
 }
 
-func (s *fileBasedClientSuite) TestWarnValidationInt() {
+func (s *fileBasedClientSuite) TestErrorValidationInt() {
 	dynamicconfig.NewGlobalIntSetting(testGetIntPropertyKey, 0, "")
 
 	lr := dynamicconfig.ValidateFile([]byte(`
 testGetIntPropertyKey:
 - value: not a number
 `))
-	s.Empty(lr.Errors)
-	s.Equal(1, len(lr.Warnings))
-	s.ErrorContains(lr.Warnings[0], `validation failed: key "testGetIntPropertyKey" value not a number: value type is not int`)
+	s.Empty(lr.Warnings)
+	s.Equal(1, len(lr.Errors))
+	s.ErrorContains(lr.Errors[0], `validation failed: key "testGetIntPropertyKey" value not a number: value type is not int`)
 }
 
-func (s *fileBasedClientSuite) TestWarnConstraint() {
+func (s *fileBasedClientSuite) TestErrorValidationRange() {
+	dynamicconfig.NewGlobalFloatSetting(testGetFloat64PropertyKey, 0, "").WithValidator(dynamicconfig.ValidateRange(0.0, 1.0))
+
+	lr := dynamicconfig.ValidateFile([]byte(`
+testGetFloat64PropertyKey:
+- value: 0.5
+- value: 1.5
+  constraints:
+    namespace: samples-namespace
+`))
+	s.Empty(lr.Warnings)
+	s.Equal(2, len(lr.Errors))
+	s.ErrorContains(lr.Errors[0], `validation failed: key "testGetFloat64PropertyKey" value 1.5: value 1.5 is out of range [0, 1]`)
+	s.ErrorContains(lr.Errors[1], `constraint "namespace" isn't valid for dynamic config key "testGetFloat64PropertyKey"`)
+}
+
+func (s *fileBasedClientSuite) TestErrorDuplicateConstraints() {
+	dynamicconfig.NewNamespaceIntSetting(testGetIntPropertyKey, 0, "")
+
+	lr := dynamicconfig.ValidateFile([]byte(`
+testGetIntPropertyKey:
+- value: 1
+  constraints:
+    namespace: samples-namespace
+- value: 2
+  constraints:
+    namespace: samples-namespace
+`))
+	s.Equal(1, len(lr.Errors))
+	s.ErrorContains(lr.Errors[0], `duplicate constraints for dynamic config key "testGetIntPropertyKey"`)
+}
+
+func (s *fileBasedClientSuite) TestRolloutPercentageConstraint() {
+	dynamicconfig.NewNamespaceIntSetting(testGetIntPropertyKey, 0, "")
+	dynamicconfig.NewGlobalIntSetting(testGetFloat64PropertyKey, 0, "")
+
+	lr := dynamicconfig.ValidateFile([]byte(`
+testGetIntPropertyKey:
+- value: 1
+  constraints:
+    namespace: samples-namespace
+    rolloutPercentage: 10
+- value: 2
+  constraints:
+    namespace: samples-namespace
+- value: 3
+  constraints:
+    rolloutPercentage: 101
+testGetFloat64PropertyKey:
+- value: 4
+  constraints:
+    rolloutPercentage: 50
+`))
+	s.Equal(2, len(lr.Errors))
+	err := errors.Join(lr.Errors...)
+	s.ErrorContains(err, `key "testGetIntPropertyKey": rolloutPercentage constraint must be integer between 1 and 100`)
+	s.ErrorContains(err, `constraint "rolloutPercentage" isn't valid for dynamic config key "testGetFloat64PropertyKey"`)
+}
+
+func (s *fileBasedClientSuite) TestErrorConstraint() {
 	dynamicconfig.NewGlobalIntSetting(testGetIntPropertyKey, 0, "")
 
 	lr := dynamicconfig.ValidateFile([]byte(`
@@ -599,12 +662,12 @@ testGetIntPropertyKey:
   constraints:
     namespace: samples-namespace
 `))
-	s.Empty(lr.Errors)
-	s.Equal(1, len(lr.Warnings))
-	s.ErrorContains(lr.Warnings[0], `constraint "namespace" isn't valid for dynamic config key "testGetIntPropertyKey"`)
+	s.Empty(lr.Warnings)
+	s.Equal(1, len(lr.Errors))
+	s.ErrorContains(lr.Errors[0], `constraint "namespace" isn't valid for dynamic config key "testGetIntPropertyKey"`)
 }
 
-func (s *fileBasedClientSuite) TestWarnMultiple() {
+func (s *fileBasedClientSuite) TestErrorsAndWarningsMultiple() {
 	dynamicconfig.NewGlobalIntSetting(testGetIntPropertyKey, 0, "")
 
 	lr := dynamicconfig.ValidateFile([]byte(`
@@ -615,8 +678,8 @@ testGetIntPropertyKey:
   constraints:
     namespace: samples-namespace
 `))
-	s.Empty(lr.Errors)
-	s.Equal(3, len(lr.Warnings))
+	s.Equal(2, len(lr.Errors))
+	s.Equal(1, len(lr.Warnings))
 }
 
 func (s *fileBasedClientSuite) TestErrorYamlDecode() {
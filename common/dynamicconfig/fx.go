@@ -31,7 +31,8 @@ import (
 )
 
 var Module = fx.Options(
-	fx.Provide(func(client Client, logger log.Logger, lc fx.Lifecycle) *Collection {
+	fx.Provide(NewRuntimeOverrideClient),
+	fx.Provide(func(client *RuntimeOverrideClient, logger log.Logger, lc fx.Lifecycle) *Collection {
 		col := NewCollection(client, logger)
 		lc.Append(fx.StartStopHook(col.Start, col.Stop))
 		return col
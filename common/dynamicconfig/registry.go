@@ -55,6 +55,18 @@ func register(s GenericSetting) {
 	globalRegistry.settings[keyStr] = s
 }
 
+// reregister replaces a registered setting with a modified copy of it.
+func reregister(s GenericSetting) {
+	if globalRegistry.queried.Load() {
+		panic("dynamicconfig settings must only be modified from static initializers")
+	}
+	keyStr := strings.ToLower(s.Key().String())
+	if globalRegistry.settings[keyStr] == nil {
+		panic(fmt.Sprintf("dynamic config key %q must be registered before it's modified", keyStr))
+	}
+	globalRegistry.settings[keyStr] = s
+}
+
 func queryRegistry(k Key) GenericSetting {
 	if !globalRegistry.queried.Load() {
 		globalRegistry.queried.Store(true)
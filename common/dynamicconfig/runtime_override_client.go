@@ -0,0 +1,190 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+
+	expmaps "golang.org/x/exp/maps"
+)
+
+type (
+	// RuntimeOverrideClient layers values set at runtime through the admin API over the values of
+	// another Client. For a key with runtime overrides, values of the underlying client are only
+	// used for constraints that aren't overridden.
+	RuntimeOverrideClient struct {
+		base Client
+
+		lock      sync.RWMutex
+		overrides map[Key][]ConstrainedValue // keys are lower case
+
+		notifyLock        sync.Mutex // serializes calls to subscribers
+		subscriptionLock  sync.Mutex
+		subscriptionIdx   int
+		subscriptions     map[int]ClientUpdateFunc
+		cancelBaseUpdates func()
+	}
+)
+
+var _ NotifyingClient = (*RuntimeOverrideClient)(nil)
+
+// NewRuntimeOverrideClient creates a RuntimeOverrideClient on top of base without any overrides.
+func NewRuntimeOverrideClient(base Client) *RuntimeOverrideClient {
+	return &RuntimeOverrideClient{
+		base:          base,
+		overrides:     make(map[Key][]ConstrainedValue),
+		subscriptions: make(map[int]ClientUpdateFunc),
+	}
+}
+
+func (c *RuntimeOverrideClient) GetValue(key Key) []ConstrainedValue {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.getValueLocked(key)
+}
+
+func (c *RuntimeOverrideClient) getValueLocked(key Key) []ConstrainedValue {
+	return c.mergeLocked(key, c.base.GetValue(key))
+}
+
+func (c *RuntimeOverrideClient) mergeLocked(key Key, baseValues []ConstrainedValue) []ConstrainedValue {
+	overrides, ok := c.overrides[Key(strings.ToLower(key.String()))]
+	if !ok {
+		return baseValues
+	}
+	values := make([]ConstrainedValue, 0, len(overrides)+len(baseValues))
+	values = append(values, overrides...)
+	for _, baseValue := range baseValues {
+		if !hasConstraints(overrides, baseValue.Constraints) {
+			values = append(values, baseValue)
+		}
+	}
+	return values
+}
+
+// SetOverrides replaces all runtime overrides and notifies subscribers of the keys whose
+// overrides changed.
+func (c *RuntimeOverrideClient) SetOverrides(overrides map[Key][]ConstrainedValue) {
+	newOverrides := make(map[Key][]ConstrainedValue, len(overrides))
+	for key, values := range overrides {
+		newOverrides[Key(strings.ToLower(key.String()))] = values
+	}
+
+	c.lock.Lock()
+	changed := make(map[Key][]ConstrainedValue)
+	oldOverrides := c.overrides
+	c.overrides = newOverrides
+	for key, values := range newOverrides {
+		if !reflect.DeepEqual(oldOverrides[key], values) {
+			key = canonicalKey(key)
+			changed[key] = c.getValueLocked(key)
+		}
+	}
+	for key := range oldOverrides {
+		if _, ok := newOverrides[key]; !ok {
+			key = canonicalKey(key)
+			changed[key] = c.getValueLocked(key)
+		}
+	}
+	c.lock.Unlock()
+
+	if len(changed) > 0 {
+		c.notify(changed)
+	}
+}
+
+func (c *RuntimeOverrideClient) Subscribe(f ClientUpdateFunc) (cancel func()) {
+	c.subscriptionLock.Lock()
+	defer c.subscriptionLock.Unlock()
+
+	if c.cancelBaseUpdates == nil {
+		if notifyingClient, ok := c.base.(NotifyingClient); ok {
+			c.cancelBaseUpdates = notifyingClient.Subscribe(c.baseUpdated)
+		}
+	}
+
+	c.subscriptionIdx++
+	id := c.subscriptionIdx
+	c.subscriptions[id] = f
+
+	return func() {
+		c.subscriptionLock.Lock()
+		defer c.subscriptionLock.Unlock()
+		delete(c.subscriptions, id)
+		if len(c.subscriptions) == 0 && c.cancelBaseUpdates != nil {
+			c.cancelBaseUpdates()
+			c.cancelBaseUpdates = nil
+		}
+	}
+}
+
+// notifiesAllChanges returns true if subscribers are notified of changes to the values of
+// the underlying client too. Otherwise the underlying client needs to be polled for them.
+func (c *RuntimeOverrideClient) notifiesAllChanges() bool {
+	_, ok := c.base.(NotifyingClient)
+	return ok
+}
+
+// baseUpdated must not call back into the underlying client, which may hold its own lock
+// while notifying.
+func (c *RuntimeOverrideClient) baseUpdated(changed map[Key][]ConstrainedValue) {
+	c.lock.RLock()
+	merged := make(map[Key][]ConstrainedValue, len(changed))
+	for key, baseValues := range changed {
+		merged[key] = c.mergeLocked(key, baseValues)
+	}
+	c.lock.RUnlock()
+	c.notify(merged)
+}
+
+func (c *RuntimeOverrideClient) notify(changed map[Key][]ConstrainedValue) {
+	c.notifyLock.Lock()
+	defer c.notifyLock.Unlock()
+
+	c.subscriptionLock.Lock()
+	subscriptions := expmaps.Values(c.subscriptions)
+	c.subscriptionLock.Unlock()
+
+	for _, update := range subscriptions {
+		update(changed)
+	}
+}
+
+// canonicalKey returns the key as registered, so that clients with case sensitive keys find it.
+func canonicalKey(key Key) Key {
+	if setting := queryRegistry(key); setting != nil {
+		return setting.Key()
+	}
+	return key
+}
+
+func hasConstraints(values []ConstrainedValue, constraints Constraints) bool {
+	for _, value := range values {
+		if value.Constraints == constraints {
+			return true
+		}
+	}
+	return false
+}
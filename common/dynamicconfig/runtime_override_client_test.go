@@ -0,0 +1,97 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.temporal.io/server/common/dynamicconfig"
+)
+
+func TestRuntimeOverrideClient(t *testing.T) {
+	base := dynamicconfig.NewMemoryClient()
+	c := dynamicconfig.NewRuntimeOverrideClient(base)
+	k := dynamicconfig.Key("key")
+	nsConstraints := dynamicconfig.Constraints{Namespace: "ns"}
+
+	var changes []map[dynamicconfig.Key][]dynamicconfig.ConstrainedValue
+	cancel := c.Subscribe(func(changed map[dynamicconfig.Key][]dynamicconfig.ConstrainedValue) {
+		changes = append(changes, changed)
+	})
+	defer cancel()
+
+	base.OverrideValue(k, []dynamicconfig.ConstrainedValue{
+		{Value: 1},
+		{Constraints: nsConstraints, Value: 2},
+	})
+	assert.Equal(t, []dynamicconfig.ConstrainedValue{{Value: 1}, {Constraints: nsConstraints, Value: 2}}, c.GetValue(k))
+	assert.Len(t, changes, 1)
+
+	// Overrides replace base values with the same constraints only.
+	c.SetOverrides(map[dynamicconfig.Key][]dynamicconfig.ConstrainedValue{
+		"KEY": {{Constraints: nsConstraints, Value: 3}},
+	})
+	expected := []dynamicconfig.ConstrainedValue{{Constraints: nsConstraints, Value: 3}, {Value: 1}}
+	assert.Equal(t, expected, c.GetValue(k))
+	assert.Len(t, changes, 2)
+	assert.Equal(t, expected, changes[1][k])
+
+	// Unchanged overrides don't notify.
+	c.SetOverrides(map[dynamicconfig.Key][]dynamicconfig.ConstrainedValue{
+		k: {{Constraints: nsConstraints, Value: 3}},
+	})
+	assert.Len(t, changes, 2)
+
+	c.SetOverrides(nil)
+	expected = []dynamicconfig.ConstrainedValue{{Value: 1}, {Constraints: nsConstraints, Value: 2}}
+	assert.Equal(t, expected, c.GetValue(k))
+	assert.Len(t, changes, 3)
+	assert.Equal(t, expected, changes[2][k])
+}
+
+func TestParseConstrainedValues(t *testing.T) {
+	dynamicconfig.ResetRegistryForTest()
+	defer dynamicconfig.ResetRegistryForTest()
+	setting := dynamicconfig.NewGlobalIntSetting("testParseConstrainedValues", 0, "")
+
+	cvs, lr := dynamicconfig.ParseConstrainedValues(setting.Key(), []byte(`
+- value: 10
+`))
+	assert.Empty(t, lr.Errors)
+	assert.Empty(t, lr.Warnings)
+	assert.Equal(t, []dynamicconfig.ConstrainedValue{{Value: 10}}, cvs)
+
+	_, lr = dynamicconfig.ParseConstrainedValues(setting.Key(), []byte(`
+- value: ten
+`))
+	assert.NotEmpty(t, lr.Errors)
+
+	_, lr = dynamicconfig.ParseConstrainedValues("unknown.key", []byte(`
+- value: 10
+`))
+	assert.NotEmpty(t, lr.Warnings)
+
+	_, lr = dynamicconfig.ParseConstrainedValues(setting.Key(), []byte(`value: 10`))
+	assert.NotEmpty(t, lr.Errors)
+}
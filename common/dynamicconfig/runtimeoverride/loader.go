@@ -0,0 +1,113 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package runtimeoverride
+
+import (
+	"context"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/util"
+	"go.temporal.io/server/internal/goro"
+)
+
+type (
+	// Loader applies the overrides stored in the system namespace to the dynamic config of the
+	// host. It reads them from the namespace registry, so changes are picked up within two
+	// namespace cache refresh intervals.
+	Loader struct {
+		registry        namespace.Registry
+		client          *dynamicconfig.RuntimeOverrideClient
+		refreshInterval dynamicconfig.DurationPropertyFn
+		logger          log.Logger
+
+		lastEncoded string // only accessed by refresh, which is never called concurrently
+		refresher   goro.Group
+	}
+)
+
+func NewLoader(
+	registry namespace.Registry,
+	client *dynamicconfig.RuntimeOverrideClient,
+	dc *dynamicconfig.Collection,
+	logger log.Logger,
+) *Loader {
+	return &Loader{
+		registry:        registry,
+		client:          client,
+		refreshInterval: dynamicconfig.NamespaceCacheRefreshInterval.Get(dc),
+		logger:          logger,
+	}
+}
+
+func (l *Loader) Start() {
+	l.refresh()
+	l.refresher.Go(l.refreshLoop)
+}
+
+func (l *Loader) Stop() {
+	l.refresher.Cancel()
+	l.refresher.Wait()
+}
+
+func (l *Loader) refreshLoop(ctx context.Context) error {
+	for ctx.Err() == nil {
+		util.InterruptibleSleep(ctx, l.refreshInterval())
+		l.refresh()
+	}
+	return ctx.Err()
+}
+
+func (l *Loader) refresh() {
+	ns, err := l.registry.GetNamespace(primitives.SystemLocalNamespace)
+	if err != nil {
+		l.logger.Warn("Unable to read dynamic config runtime overrides.", tag.Error(err))
+		return
+	}
+	encoded := ns.GetCustomData(dataKey)
+	if encoded == l.lastEncoded {
+		return
+	}
+	overrides, err := decode(encoded)
+	if err != nil {
+		l.logger.Error("Unable to decode dynamic config runtime overrides.", tag.Error(err))
+		return
+	}
+
+	values := make(map[dynamicconfig.Key][]dynamicconfig.ConstrainedValue, len(overrides))
+	for key, override := range overrides {
+		cvs, lr := dynamicconfig.ParseConstrainedValues(key, []byte(override.Values))
+		if len(lr.Errors) > 0 {
+			l.logger.Error("Ignoring invalid dynamic config runtime override.",
+				tag.Key(key.String()), tag.Error(lr.Errors[0]))
+			continue
+		}
+		values[key] = cvs
+	}
+	l.client.SetOverrides(values)
+	l.lastEncoded = encoded
+	l.logger.Info("Updated dynamic config runtime overrides.", tag.Counter(len(values)))
+}
@@ -0,0 +1,109 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package runtimeoverride
+
+import (
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// NamespaceValues returns the entries of the given override values that are constrained to the
+// namespace, in the same YAML format, or an empty string if there are none.
+func NamespaceValues(values string, namespaceName string) (string, error) {
+	entries, err := decodeEntries(values)
+	if err != nil {
+		return "", err
+	}
+	var matching []map[string]any
+	for _, entry := range entries {
+		if entryNamespace(entry) == namespaceName {
+			matching = append(matching, entry)
+		}
+	}
+	return encodeEntries(matching)
+}
+
+// ReplaceNamespaceValues returns the given override values with the entries constrained to the
+// namespace replaced by namespaceValues. The namespace constraint of each entry in namespaceValues
+// is set to the namespace, so values exported for one namespace can be applied to another.
+func ReplaceNamespaceValues(values string, namespaceName string, namespaceValues string) (string, error) {
+	entries, err := decodeEntries(values)
+	if err != nil {
+		return "", err
+	}
+	replacements, err := decodeEntries(namespaceValues)
+	if err != nil {
+		return "", err
+	}
+
+	result := make([]map[string]any, 0, len(entries)+len(replacements))
+	for _, entry := range entries {
+		if entryNamespace(entry) != namespaceName {
+			result = append(result, entry)
+		}
+	}
+	for _, entry := range replacements {
+		constraints, _ := entry["constraints"].(map[string]any)
+		newConstraints := make(map[string]any, len(constraints)+1)
+		for k, v := range constraints {
+			if !strings.EqualFold(k, "namespace") {
+				newConstraints[k] = v
+			}
+		}
+		newConstraints["namespace"] = namespaceName
+		entry["constraints"] = newConstraints
+		result = append(result, entry)
+	}
+	return encodeEntries(result)
+}
+
+func decodeEntries(values string) ([]map[string]any, error) {
+	var entries []map[string]any
+	if err := yaml.Unmarshal([]byte(values), &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func encodeEntries(entries []map[string]any) (string, error) {
+	if len(entries) == 0 {
+		return "", nil
+	}
+	encoded, err := yaml.Marshal(entries)
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
+
+func entryNamespace(entry map[string]any) string {
+	constraints, _ := entry["constraints"].(map[string]any)
+	for k, v := range constraints {
+		if strings.EqualFold(k, "namespace") {
+			namespaceName, _ := v.(string)
+			return namespaceName
+		}
+	}
+	return ""
+}
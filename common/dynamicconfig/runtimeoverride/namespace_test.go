@@ -0,0 +1,59 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package runtimeoverride
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNamespaceValues(t *testing.T) {
+	values := `
+- value: 1
+- constraints: {namespace: ns-a}
+  value: 2
+- constraints: {namespace: ns-b, taskQueueName: tq}
+  value: 3
+`
+	nsValues, err := NamespaceValues(values, "ns-b")
+	require.NoError(t, err)
+	require.Equal(t, "- constraints:\n    namespace: ns-b\n    taskQueueName: tq\n  value: 3\n", nsValues)
+
+	nsValues, err = NamespaceValues(values, "ns-c")
+	require.NoError(t, err)
+	require.Empty(t, nsValues)
+
+	replaced, err := ReplaceNamespaceValues(values, "ns-a", nsValues)
+	require.NoError(t, err)
+	require.Equal(t, "- value: 1\n- constraints:\n    namespace: ns-b\n    taskQueueName: tq\n  value: 3\n", replaced)
+
+	replaced, err = ReplaceNamespaceValues(values, "ns-c", "- constraints: {namespace: ns-a}\n  value: 4\n")
+	require.NoError(t, err)
+	nsValues, err = NamespaceValues(replaced, "ns-c")
+	require.NoError(t, err)
+	require.Equal(t, "- constraints:\n    namespace: ns-c\n  value: 4\n", nsValues)
+
+	_, err = NamespaceValues("not: [a list", "ns-a")
+	require.Error(t, err)
+}
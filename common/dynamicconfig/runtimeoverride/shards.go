@@ -0,0 +1,65 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package runtimeoverride
+
+import (
+	"fmt"
+	"slices"
+
+	"go.temporal.io/server/common/dynamicconfig"
+)
+
+// ShardOwnershipOverrides decodes the override values of dynamicconfig.HistoryShardOwnershipOverrides.
+func ShardOwnershipOverrides(values string) ([]dynamicconfig.ShardOwnershipOverride, error) {
+	if values == "" {
+		return nil, nil
+	}
+	key := dynamicconfig.HistoryShardOwnershipOverrides.Key()
+	cvs, lr := dynamicconfig.ParseConstrainedValues(key, []byte(values))
+	if len(lr.Errors) > 0 {
+		return nil, lr.Errors[0]
+	}
+	for _, cv := range cvs {
+		if cv.Constraints == (dynamicconfig.Constraints{}) {
+			return dynamicconfig.ConvertStructure([]dynamicconfig.ShardOwnershipOverride(nil))(cv.Value)
+		}
+	}
+	return nil, fmt.Errorf("no unconstrained value for %v", key)
+}
+
+// EncodeShardOwnershipOverrides encodes the values of dynamicconfig.HistoryShardOwnershipOverrides,
+// sorted by shard ID. It returns an empty string, which removes the override, if there are none.
+func EncodeShardOwnershipOverrides(overrides []dynamicconfig.ShardOwnershipOverride) (string, error) {
+	if len(overrides) == 0 {
+		return "", nil
+	}
+	overrides = slices.Clone(overrides)
+	slices.SortFunc(overrides, func(a, b dynamicconfig.ShardOwnershipOverride) int {
+		return int(a.ShardID - b.ShardID)
+	})
+	value := make([]map[string]any, len(overrides))
+	for i, override := range overrides {
+		value[i] = map[string]any{"shardId": override.ShardID, "address": override.Address}
+	}
+	return encodeEntries([]map[string]any{{"value": value}})
+}
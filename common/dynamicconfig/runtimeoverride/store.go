@@ -0,0 +1,120 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package runtimeoverride persists dynamic config values set at runtime through the admin API and
+// applies them to the dynamic config of every service.
+package runtimeoverride
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/persistence"
+)
+
+// dataKey is the key of the overrides in the Data map of the system namespace. Storing them in the
+// system namespace makes the namespace registry of each host pick them up.
+const dataKey = "temporal.dynamicconfig"
+
+type (
+	// Override is the runtime override of a dynamic config key.
+	Override struct {
+		// Values in the YAML format of the dynamic config file, see dynamicconfig.ParseConstrainedValues.
+		Values     string    `json:"values"`
+		UpdatedBy  string    `json:"updatedBy,omitempty"`
+		UpdateTime time.Time `json:"updateTime"`
+	}
+
+	// Store reads and writes runtime overrides.
+	Store struct {
+		data       *persistence.SystemNamespaceData
+		timeSource clock.TimeSource
+	}
+)
+
+func NewStore(
+	metadataMgr persistence.MetadataManager,
+	timeSource clock.TimeSource,
+) *Store {
+	return &Store{
+		data:       persistence.NewSystemNamespaceData(metadataMgr, dataKey),
+		timeSource: timeSource,
+	}
+}
+
+// Get returns the current overrides by key.
+func (s *Store) Get(ctx context.Context) (map[dynamicconfig.Key]*Override, error) {
+	encoded, err := s.data.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return decode(encoded)
+}
+
+// Set replaces the override of a key with the given values, or removes it if values is empty. It
+// returns the previous override, if any. Values must have been validated by the caller. Keys are
+// case insensitive and stored in lower case.
+func (s *Store) Set(
+	ctx context.Context,
+	key dynamicconfig.Key,
+	values string,
+	updatedBy string,
+) (*Override, error) {
+	key = dynamicconfig.Key(strings.ToLower(key.String()))
+	var previous *Override
+	if err := s.data.Update(ctx, func(current string) (string, error) {
+		overrides, err := decode(current)
+		if err != nil {
+			return "", err
+		}
+		previous = overrides[key]
+		if values == "" {
+			delete(overrides, key)
+		} else {
+			overrides[key] = &Override{
+				Values:     values,
+				UpdatedBy:  updatedBy,
+				UpdateTime: s.timeSource.Now().UTC(),
+			}
+		}
+		encoded, err := json.Marshal(overrides)
+		return string(encoded), err
+	}); err != nil {
+		return nil, err
+	}
+	return previous, nil
+}
+
+func decode(encoded string) (map[dynamicconfig.Key]*Override, error) {
+	overrides := make(map[dynamicconfig.Key]*Override)
+	if encoded == "" {
+		return overrides, nil
+	}
+	if err := json.Unmarshal([]byte(encoded), &overrides); err != nil {
+		return nil, err
+	}
+	return overrides, nil
+}
@@ -49,6 +49,8 @@ const PrecedenceTaskType Precedence = 6
 
 const PrecedenceDestination Precedence = 7
 
+const PrecedenceWorkflow Precedence = 8
+
 type GlobalBoolSetting = GlobalTypedSetting[bool]
 
 func NewGlobalBoolSetting(key Key, def bool, description string) GlobalBoolSetting {
@@ -161,6 +163,22 @@ func GetBoolPropertyFnFilteredByDestination(value bool) BoolPropertyFnWithDestin
 	return GetTypedPropertyFnFilteredByDestination(value)
 }
 
+type WorkflowBoolSetting = WorkflowTypedSetting[bool]
+
+func NewWorkflowBoolSetting(key Key, def bool, description string) WorkflowBoolSetting {
+	return NewWorkflowTypedSettingWithConverter[bool](key, convertBool, def, description)
+}
+
+func NewWorkflowBoolSettingWithConstrainedDefault(key Key, cdef []TypedConstrainedValue[bool], description string) WorkflowBoolSetting {
+	return NewWorkflowTypedSettingWithConstrainedDefault[bool](key, convertBool, cdef, description)
+}
+
+type BoolPropertyFnWithWorkflowFilter = TypedPropertyFnWithWorkflowFilter[bool]
+
+func GetBoolPropertyFnFilteredByWorkflow(value bool) BoolPropertyFnWithWorkflowFilter {
+	return GetTypedPropertyFnFilteredByWorkflow(value)
+}
+
 type GlobalIntSetting = GlobalTypedSetting[int]
 
 func NewGlobalIntSetting(key Key, def int, description string) GlobalIntSetting {
@@ -273,6 +291,22 @@ func GetIntPropertyFnFilteredByDestination(value int) IntPropertyFnWithDestinati
 	return GetTypedPropertyFnFilteredByDestination(value)
 }
 
+type WorkflowIntSetting = WorkflowTypedSetting[int]
+
+func NewWorkflowIntSetting(key Key, def int, description string) WorkflowIntSetting {
+	return NewWorkflowTypedSettingWithConverter[int](key, convertInt, def, description)
+}
+
+func NewWorkflowIntSettingWithConstrainedDefault(key Key, cdef []TypedConstrainedValue[int], description string) WorkflowIntSetting {
+	return NewWorkflowTypedSettingWithConstrainedDefault[int](key, convertInt, cdef, description)
+}
+
+type IntPropertyFnWithWorkflowFilter = TypedPropertyFnWithWorkflowFilter[int]
+
+func GetIntPropertyFnFilteredByWorkflow(value int) IntPropertyFnWithWorkflowFilter {
+	return GetTypedPropertyFnFilteredByWorkflow(value)
+}
+
 type GlobalFloatSetting = GlobalTypedSetting[float64]
 
 func NewGlobalFloatSetting(key Key, def float64, description string) GlobalFloatSetting {
@@ -385,6 +419,22 @@ func GetFloatPropertyFnFilteredByDestination(value float64) FloatPropertyFnWithD
 	return GetTypedPropertyFnFilteredByDestination(value)
 }
 
+type WorkflowFloatSetting = WorkflowTypedSetting[float64]
+
+func NewWorkflowFloatSetting(key Key, def float64, description string) WorkflowFloatSetting {
+	return NewWorkflowTypedSettingWithConverter[float64](key, convertFloat, def, description)
+}
+
+func NewWorkflowFloatSettingWithConstrainedDefault(key Key, cdef []TypedConstrainedValue[float64], description string) WorkflowFloatSetting {
+	return NewWorkflowTypedSettingWithConstrainedDefault[float64](key, convertFloat, cdef, description)
+}
+
+type FloatPropertyFnWithWorkflowFilter = TypedPropertyFnWithWorkflowFilter[float64]
+
+func GetFloatPropertyFnFilteredByWorkflow(value float64) FloatPropertyFnWithWorkflowFilter {
+	return GetTypedPropertyFnFilteredByWorkflow(value)
+}
+
 type GlobalStringSetting = GlobalTypedSetting[string]
 
 func NewGlobalStringSetting(key Key, def string, description string) GlobalStringSetting {
@@ -497,6 +547,22 @@ func GetStringPropertyFnFilteredByDestination(value string) StringPropertyFnWith
 	return GetTypedPropertyFnFilteredByDestination(value)
 }
 
+type WorkflowStringSetting = WorkflowTypedSetting[string]
+
+func NewWorkflowStringSetting(key Key, def string, description string) WorkflowStringSetting {
+	return NewWorkflowTypedSettingWithConverter[string](key, convertString, def, description)
+}
+
+func NewWorkflowStringSettingWithConstrainedDefault(key Key, cdef []TypedConstrainedValue[string], description string) WorkflowStringSetting {
+	return NewWorkflowTypedSettingWithConstrainedDefault[string](key, convertString, cdef, description)
+}
+
+type StringPropertyFnWithWorkflowFilter = TypedPropertyFnWithWorkflowFilter[string]
+
+func GetStringPropertyFnFilteredByWorkflow(value string) StringPropertyFnWithWorkflowFilter {
+	return GetTypedPropertyFnFilteredByWorkflow(value)
+}
+
 type GlobalDurationSetting = GlobalTypedSetting[time.Duration]
 
 func NewGlobalDurationSetting(key Key, def time.Duration, description string) GlobalDurationSetting {
@@ -609,6 +675,22 @@ func GetDurationPropertyFnFilteredByDestination(value time.Duration) DurationPro
 	return GetTypedPropertyFnFilteredByDestination(value)
 }
 
+type WorkflowDurationSetting = WorkflowTypedSetting[time.Duration]
+
+func NewWorkflowDurationSetting(key Key, def time.Duration, description string) WorkflowDurationSetting {
+	return NewWorkflowTypedSettingWithConverter[time.Duration](key, convertDuration, def, description)
+}
+
+func NewWorkflowDurationSettingWithConstrainedDefault(key Key, cdef []TypedConstrainedValue[time.Duration], description string) WorkflowDurationSetting {
+	return NewWorkflowTypedSettingWithConstrainedDefault[time.Duration](key, convertDuration, cdef, description)
+}
+
+type DurationPropertyFnWithWorkflowFilter = TypedPropertyFnWithWorkflowFilter[time.Duration]
+
+func GetDurationPropertyFnFilteredByWorkflow(value time.Duration) DurationPropertyFnWithWorkflowFilter {
+	return GetTypedPropertyFnFilteredByWorkflow(value)
+}
+
 type GlobalMapSetting = GlobalTypedSetting[map[string]any]
 
 func NewGlobalMapSetting(key Key, def map[string]any, description string) GlobalMapSetting {
@@ -721,6 +803,22 @@ func GetMapPropertyFnFilteredByDestination(value map[string]any) MapPropertyFnWi
 	return GetTypedPropertyFnFilteredByDestination(value)
 }
 
+type WorkflowMapSetting = WorkflowTypedSetting[map[string]any]
+
+func NewWorkflowMapSetting(key Key, def map[string]any, description string) WorkflowMapSetting {
+	return NewWorkflowTypedSettingWithConverter[map[string]any](key, convertMap, def, description)
+}
+
+func NewWorkflowMapSettingWithConstrainedDefault(key Key, cdef []TypedConstrainedValue[map[string]any], description string) WorkflowMapSetting {
+	return NewWorkflowTypedSettingWithConstrainedDefault[map[string]any](key, convertMap, cdef, description)
+}
+
+type MapPropertyFnWithWorkflowFilter = TypedPropertyFnWithWorkflowFilter[map[string]any]
+
+func GetMapPropertyFnFilteredByWorkflow(value map[string]any) MapPropertyFnWithWorkflowFilter {
+	return GetTypedPropertyFnFilteredByWorkflow(value)
+}
+
 type GlobalTypedSetting[T any] setting[T, func()]
 
 // NewGlobalTypedSetting creates a setting that uses mapstructure to handle complex structured
@@ -774,6 +872,16 @@ func (s GlobalTypedSetting[T]) WithDefault(v T) GlobalTypedSetting[T] {
 	return newS
 }
 
+// WithValidator returns the setting with an additional check on converted values. Values that
+// fail it are rejected when dynamic config is loaded and ignored in favor of the default when
+// read. It must only be called from static initializers, on the value returned by a constructor.
+func (s GlobalTypedSetting[T]) WithValidator(validate func(T) error) GlobalTypedSetting[T] {
+	newS := s
+	newS.convert = convertAndValidate(s.convert, validate)
+	reregister(newS)
+	return newS
+}
+
 type TypedPropertyFn[T any] func() T
 
 func (s GlobalTypedSetting[T]) Get(c *Collection) TypedPropertyFn[T] {
@@ -868,11 +976,21 @@ func (s NamespaceTypedSetting[T]) WithDefault(v T) NamespaceTypedSetting[T] {
 	return newS
 }
 
+// WithValidator returns the setting with an additional check on converted values. Values that
+// fail it are rejected when dynamic config is loaded and ignored in favor of the default when
+// read. It must only be called from static initializers, on the value returned by a constructor.
+func (s NamespaceTypedSetting[T]) WithValidator(validate func(T) error) NamespaceTypedSetting[T] {
+	newS := s
+	newS.convert = convertAndValidate(s.convert, validate)
+	reregister(newS)
+	return newS
+}
+
 type TypedPropertyFnWithNamespaceFilter[T any] func(namespace string) T
 
 func (s NamespaceTypedSetting[T]) Get(c *Collection) TypedPropertyFnWithNamespaceFilter[T] {
 	return func(namespace string) T {
-		prec := []Constraints{{Namespace: namespace}, {}}
+		prec := withRolloutSubject([]Constraints{{Namespace: namespace}, {}}, namespace)
 		return matchAndConvert(
 			c,
 			s.key,
@@ -888,7 +1006,7 @@ type TypedSubscribableWithNamespaceFilter[T any] func(namespace string, callback
 
 func (s NamespaceTypedSetting[T]) Subscribe(c *Collection) TypedSubscribableWithNamespaceFilter[T] {
 	return func(namespace string, callback func(T)) (T, func()) {
-		prec := []Constraints{{Namespace: namespace}, {}}
+		prec := withRolloutSubject([]Constraints{{Namespace: namespace}, {}}, namespace)
 		return subscribe(c, s.key, s.def, s.cdef, s.convert, prec, callback)
 	}
 }
@@ -962,11 +1080,21 @@ func (s NamespaceIDTypedSetting[T]) WithDefault(v T) NamespaceIDTypedSetting[T]
 	return newS
 }
 
+// WithValidator returns the setting with an additional check on converted values. Values that
+// fail it are rejected when dynamic config is loaded and ignored in favor of the default when
+// read. It must only be called from static initializers, on the value returned by a constructor.
+func (s NamespaceIDTypedSetting[T]) WithValidator(validate func(T) error) NamespaceIDTypedSetting[T] {
+	newS := s
+	newS.convert = convertAndValidate(s.convert, validate)
+	reregister(newS)
+	return newS
+}
+
 type TypedPropertyFnWithNamespaceIDFilter[T any] func(namespaceID string) T
 
 func (s NamespaceIDTypedSetting[T]) Get(c *Collection) TypedPropertyFnWithNamespaceIDFilter[T] {
 	return func(namespaceID string) T {
-		prec := []Constraints{{NamespaceID: namespaceID}, {}}
+		prec := withRolloutSubject([]Constraints{{NamespaceID: namespaceID}, {}}, namespaceID)
 		return matchAndConvert(
 			c,
 			s.key,
@@ -982,7 +1110,7 @@ type TypedSubscribableWithNamespaceIDFilter[T any] func(namespaceID string, call
 
 func (s NamespaceIDTypedSetting[T]) Subscribe(c *Collection) TypedSubscribableWithNamespaceIDFilter[T] {
 	return func(namespaceID string, callback func(T)) (T, func()) {
-		prec := []Constraints{{NamespaceID: namespaceID}, {}}
+		prec := withRolloutSubject([]Constraints{{NamespaceID: namespaceID}, {}}, namespaceID)
 		return subscribe(c, s.key, s.def, s.cdef, s.convert, prec, callback)
 	}
 }
@@ -1056,17 +1184,27 @@ func (s TaskQueueTypedSetting[T]) WithDefault(v T) TaskQueueTypedSetting[T] {
 	return newS
 }
 
+// WithValidator returns the setting with an additional check on converted values. Values that
+// fail it are rejected when dynamic config is loaded and ignored in favor of the default when
+// read. It must only be called from static initializers, on the value returned by a constructor.
+func (s TaskQueueTypedSetting[T]) WithValidator(validate func(T) error) TaskQueueTypedSetting[T] {
+	newS := s
+	newS.convert = convertAndValidate(s.convert, validate)
+	reregister(newS)
+	return newS
+}
+
 type TypedPropertyFnWithTaskQueueFilter[T any] func(namespace string, taskQueue string, taskQueueType enumspb.TaskQueueType) T
 
 func (s TaskQueueTypedSetting[T]) Get(c *Collection) TypedPropertyFnWithTaskQueueFilter[T] {
 	return func(namespace string, taskQueue string, taskQueueType enumspb.TaskQueueType) T {
-		prec := []Constraints{
+		prec := withRolloutSubject([]Constraints{
 			{Namespace: namespace, TaskQueueName: taskQueue, TaskQueueType: taskQueueType},
 			{Namespace: namespace, TaskQueueName: taskQueue},
 			{TaskQueueName: taskQueue},
 			{Namespace: namespace},
 			{},
-		}
+		}, namespace)
 		return matchAndConvert(
 			c,
 			s.key,
@@ -1082,13 +1220,13 @@ type TypedSubscribableWithTaskQueueFilter[T any] func(namespace string, taskQueu
 
 func (s TaskQueueTypedSetting[T]) Subscribe(c *Collection) TypedSubscribableWithTaskQueueFilter[T] {
 	return func(namespace string, taskQueue string, taskQueueType enumspb.TaskQueueType, callback func(T)) (T, func()) {
-		prec := []Constraints{
+		prec := withRolloutSubject([]Constraints{
 			{Namespace: namespace, TaskQueueName: taskQueue, TaskQueueType: taskQueueType},
 			{Namespace: namespace, TaskQueueName: taskQueue},
 			{TaskQueueName: taskQueue},
 			{Namespace: namespace},
 			{},
-		}
+		}, namespace)
 		return subscribe(c, s.key, s.def, s.cdef, s.convert, prec, callback)
 	}
 }
@@ -1162,6 +1300,16 @@ func (s ShardIDTypedSetting[T]) WithDefault(v T) ShardIDTypedSetting[T] {
 	return newS
 }
 
+// WithValidator returns the setting with an additional check on converted values. Values that
+// fail it are rejected when dynamic config is loaded and ignored in favor of the default when
+// read. It must only be called from static initializers, on the value returned by a constructor.
+func (s ShardIDTypedSetting[T]) WithValidator(validate func(T) error) ShardIDTypedSetting[T] {
+	newS := s
+	newS.convert = convertAndValidate(s.convert, validate)
+	reregister(newS)
+	return newS
+}
+
 type TypedPropertyFnWithShardIDFilter[T any] func(shardID int32) T
 
 func (s ShardIDTypedSetting[T]) Get(c *Collection) TypedPropertyFnWithShardIDFilter[T] {
@@ -1256,6 +1404,16 @@ func (s TaskTypeTypedSetting[T]) WithDefault(v T) TaskTypeTypedSetting[T] {
 	return newS
 }
 
+// WithValidator returns the setting with an additional check on converted values. Values that
+// fail it are rejected when dynamic config is loaded and ignored in favor of the default when
+// read. It must only be called from static initializers, on the value returned by a constructor.
+func (s TaskTypeTypedSetting[T]) WithValidator(validate func(T) error) TaskTypeTypedSetting[T] {
+	newS := s
+	newS.convert = convertAndValidate(s.convert, validate)
+	reregister(newS)
+	return newS
+}
+
 type TypedPropertyFnWithTaskTypeFilter[T any] func(taskType enumsspb.TaskType) T
 
 func (s TaskTypeTypedSetting[T]) Get(c *Collection) TypedPropertyFnWithTaskTypeFilter[T] {
@@ -1350,16 +1508,26 @@ func (s DestinationTypedSetting[T]) WithDefault(v T) DestinationTypedSetting[T]
 	return newS
 }
 
+// WithValidator returns the setting with an additional check on converted values. Values that
+// fail it are rejected when dynamic config is loaded and ignored in favor of the default when
+// read. It must only be called from static initializers, on the value returned by a constructor.
+func (s DestinationTypedSetting[T]) WithValidator(validate func(T) error) DestinationTypedSetting[T] {
+	newS := s
+	newS.convert = convertAndValidate(s.convert, validate)
+	reregister(newS)
+	return newS
+}
+
 type TypedPropertyFnWithDestinationFilter[T any] func(namespace string, destination string) T
 
 func (s DestinationTypedSetting[T]) Get(c *Collection) TypedPropertyFnWithDestinationFilter[T] {
 	return func(namespace string, destination string) T {
-		prec := []Constraints{
+		prec := withRolloutSubject([]Constraints{
 			{Namespace: namespace, Destination: destination},
 			{Destination: destination},
 			{Namespace: namespace},
 			{},
-		}
+		}, namespace)
 		return matchAndConvert(
 			c,
 			s.key,
@@ -1375,12 +1543,12 @@ type TypedSubscribableWithDestinationFilter[T any] func(namespace string, destin
 
 func (s DestinationTypedSetting[T]) Subscribe(c *Collection) TypedSubscribableWithDestinationFilter[T] {
 	return func(namespace string, destination string, callback func(T)) (T, func()) {
-		prec := []Constraints{
+		prec := withRolloutSubject([]Constraints{
 			{Namespace: namespace, Destination: destination},
 			{Destination: destination},
 			{Namespace: namespace},
 			{},
-		}
+		}, namespace)
 		return subscribe(c, s.key, s.def, s.cdef, s.convert, prec, callback)
 	}
 }
@@ -1400,3 +1568,107 @@ func GetTypedPropertyFnFilteredByDestination[T any](value T) TypedPropertyFnWith
 		return value
 	}
 }
+
+type WorkflowTypedSetting[T any] setting[T, func(namespace string, workflowID string)]
+
+// NewWorkflowTypedSetting creates a setting that uses mapstructure to handle complex structured
+// values. The value from dynamic config will be copied over a shallow copy of 'def', which means
+// 'def' must not contain any non-nil slices, maps, or pointers.
+func NewWorkflowTypedSetting[T any](key Key, def T, description string) WorkflowTypedSetting[T] {
+	s := WorkflowTypedSetting[T]{
+		key:         key,
+		def:         def,
+		convert:     ConvertStructure[T](def),
+		description: description,
+	}
+	register(s)
+	return s
+}
+
+// NewWorkflowTypedSettingWithConverter creates a setting with a custom converter function.
+func NewWorkflowTypedSettingWithConverter[T any](key Key, convert func(any) (T, error), def T, description string) WorkflowTypedSetting[T] {
+	s := WorkflowTypedSetting[T]{
+		key:         key,
+		def:         def,
+		convert:     convert,
+		description: description,
+	}
+	register(s)
+	return s
+}
+
+// NewWorkflowTypedSettingWithConstrainedDefault creates a setting with a compound default value.
+func NewWorkflowTypedSettingWithConstrainedDefault[T any](key Key, convert func(any) (T, error), cdef []TypedConstrainedValue[T], description string) WorkflowTypedSetting[T] {
+	s := WorkflowTypedSetting[T]{
+		key:         key,
+		cdef:        &cdef,
+		convert:     convert,
+		description: description,
+	}
+	register(s)
+	return s
+}
+
+func (s WorkflowTypedSetting[T]) Key() Key               { return s.key }
+func (s WorkflowTypedSetting[T]) Precedence() Precedence { return PrecedenceWorkflow }
+func (s WorkflowTypedSetting[T]) Validate(v any) error {
+	_, err := s.convert(v)
+	return err
+}
+
+func (s WorkflowTypedSetting[T]) WithDefault(v T) WorkflowTypedSetting[T] {
+	newS := s
+	newS.def = v
+	return newS
+}
+
+// WithValidator returns the setting with an additional check on converted values. Values that
+// fail it are rejected when dynamic config is loaded and ignored in favor of the default when
+// read. It must only be called from static initializers, on the value returned by a constructor.
+func (s WorkflowTypedSetting[T]) WithValidator(validate func(T) error) WorkflowTypedSetting[T] {
+	newS := s
+	newS.convert = convertAndValidate(s.convert, validate)
+	reregister(newS)
+	return newS
+}
+
+type TypedPropertyFnWithWorkflowFilter[T any] func(namespace string, workflowID string) T
+
+func (s WorkflowTypedSetting[T]) Get(c *Collection) TypedPropertyFnWithWorkflowFilter[T] {
+	return func(namespace string, workflowID string) T {
+		prec := withRolloutSubject([]Constraints{{Namespace: namespace}, {}}, workflowID)
+		return matchAndConvert(
+			c,
+			s.key,
+			s.def,
+			s.cdef,
+			s.convert,
+			prec,
+		)
+	}
+}
+
+type TypedSubscribableWithWorkflowFilter[T any] func(namespace string, workflowID string, callback func(T)) (v T, cancel func())
+
+func (s WorkflowTypedSetting[T]) Subscribe(c *Collection) TypedSubscribableWithWorkflowFilter[T] {
+	return func(namespace string, workflowID string, callback func(T)) (T, func()) {
+		prec := withRolloutSubject([]Constraints{{Namespace: namespace}, {}}, workflowID)
+		return subscribe(c, s.key, s.def, s.cdef, s.convert, prec, callback)
+	}
+}
+
+func (s WorkflowTypedSetting[T]) dispatchUpdate(c *Collection, sub any, cvs []ConstrainedValue) {
+	dispatchUpdate(
+		c,
+		s.key,
+		s.convert,
+		sub.(*subscription[T]),
+		cvs,
+	)
+}
+
+func GetTypedPropertyFnFilteredByWorkflow[T any](value T) TypedPropertyFnWithWorkflowFilter[T] {
+	return func(namespace string, workflowID string) T {
+		return value
+	}
+}
@@ -114,6 +114,13 @@ var DefaultDynamicRateLimitingParams = DynamicRateLimitingParams{
 	RateMultiMax:         1.0,
 }
 
+// ShardOwnershipOverride pins a history shard to the history host with the given address, see
+// HistoryShardOwnershipOverrides.
+type ShardOwnershipOverride struct {
+	ShardID int32
+	Address string
+}
+
 type CircuitBreakerSettings struct {
 	// MaxRequests: Maximum number of requests allowed to pass through when
 	// it is in half-open state (default 1).
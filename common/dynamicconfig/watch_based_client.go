@@ -0,0 +1,174 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	expmaps "golang.org/x/exp/maps"
+)
+
+var _ Client = (*watchBasedClient)(nil)
+var _ NotifyingClient = (*watchBasedClient)(nil)
+
+const (
+	watchRetryInterval = time.Second * 5
+)
+
+type (
+	// DocumentWatcher reads a dynamic config document, in the format of the dynamic config
+	// file, from a key-value store that supports watching for changes.
+	DocumentWatcher interface {
+		// Get returns the current contents of the document, or nil if it doesn't exist, and the
+		// revision of the store it was read at.
+		Get(ctx context.Context) (contents []byte, revision int64, err error)
+		// Watch blocks until the document changes after the given revision, or the store gives
+		// up waiting, and returns the contents and revision at that point.
+		Watch(ctx context.Context, revision int64) (contents []byte, newRevision int64, err error)
+	}
+
+	watchBasedClient struct {
+		values   atomic.Value // configValueMap
+		logger   log.Logger
+		watcher  DocumentWatcher
+		revision int64
+
+		subscriptionLock sync.Mutex
+		subscriptionIdx  int
+		subscriptions    map[int]ClientUpdateFunc
+	}
+)
+
+// NewWatchBasedClient creates a client that reads the dynamic config document from the given
+// watcher and notifies subscribers as soon as the watcher reports a change, instead of polling.
+// The initial read must succeed. Later failures are logged and the previous values are kept.
+func NewWatchBasedClient(watcher DocumentWatcher, logger log.Logger, doneCh <-chan interface{}) (*watchBasedClient, error) {
+	client := &watchBasedClient{
+		logger:        logger,
+		watcher:       watcher,
+		subscriptions: make(map[int]ClientUpdateFunc),
+	}
+
+	contents, revision, err := watcher.Get(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("unable to read dynamic config: %w", err)
+	}
+	if err := client.update(contents, revision); err != nil {
+		return nil, fmt.Errorf("unable to read dynamic config: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-doneCh
+		cancel()
+	}()
+	go client.watchLoop(ctx)
+
+	return client, nil
+}
+
+func (wc *watchBasedClient) GetValue(key Key) []ConstrainedValue {
+	values := wc.values.Load().(configValueMap)
+	return values[strings.ToLower(key.String())]
+}
+
+func (wc *watchBasedClient) Subscribe(f ClientUpdateFunc) (cancel func()) {
+	wc.subscriptionLock.Lock()
+	defer wc.subscriptionLock.Unlock()
+
+	wc.subscriptionIdx++
+	id := wc.subscriptionIdx
+	wc.subscriptions[id] = f
+
+	return func() {
+		wc.subscriptionLock.Lock()
+		defer wc.subscriptionLock.Unlock()
+		delete(wc.subscriptions, id)
+	}
+}
+
+func (wc *watchBasedClient) watchLoop(ctx context.Context) {
+	for ctx.Err() == nil {
+		contents, revision, err := wc.watcher.Watch(ctx, wc.revision)
+		if err == nil {
+			err = wc.update(contents, revision)
+		}
+		if err == nil || errors.Is(err, context.Canceled) {
+			continue
+		}
+
+		wc.logger.Error("Unable to update dynamic config.", tag.Error(err))
+		select {
+		case <-time.After(watchRetryInterval):
+		case <-ctx.Done():
+		}
+	}
+}
+
+func (wc *watchBasedClient) update(contents []byte, revision int64) error {
+	if revision == wc.revision && wc.values.Load() != nil {
+		return nil
+	}
+
+	newValues, lr := loadFile(contents)
+	for _, e := range lr.Errors {
+		wc.logger.Warn("dynamic config error", tag.Error(e))
+	}
+	for _, w := range lr.Warnings {
+		wc.logger.Warn("dynamic config warning", tag.Error(w))
+	}
+	if len(lr.Errors) > 0 {
+		// Skip this revision, otherwise the watch would return it again right away.
+		wc.revision = revision
+		return fmt.Errorf("loading dynamic config failed: %d errors, %d warnings",
+			len(lr.Errors), len(lr.Warnings))
+	}
+	wc.revision = revision
+
+	prev := wc.values.Swap(newValues)
+	oldValues, _ := prev.(configValueMap)
+	changedMap := diffAndLog(wc.logger, oldValues, newValues)
+	wc.logger.Info("Updated dynamic config", tag.NewInt64("revision", revision))
+
+	if len(changedMap) == 0 {
+		return nil
+	}
+
+	wc.subscriptionLock.Lock()
+	subscriptions := expmaps.Values(wc.subscriptions)
+	wc.subscriptionLock.Unlock()
+
+	for _, update := range subscriptions {
+		update(changedMap)
+	}
+
+	return nil
+}
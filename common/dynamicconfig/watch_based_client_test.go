@@ -0,0 +1,172 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+)
+
+type watchedClient interface {
+	dynamicconfig.Client
+	dynamicconfig.NotifyingClient
+}
+
+type fakeKVStore struct {
+	lock     sync.Mutex
+	changed  chan struct{}
+	contents []byte
+	revision int64
+}
+
+func newFakeKVStore(contents string) *fakeKVStore {
+	return &fakeKVStore{changed: make(chan struct{}), contents: []byte(contents), revision: 1}
+}
+
+func (s *fakeKVStore) get() ([]byte, int64, <-chan struct{}) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.contents, s.revision, s.changed
+}
+
+func (s *fakeKVStore) put(contents string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.contents = []byte(contents)
+	s.revision++
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+func newConsulServer(store *fakeKVStore) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contents, revision, changed := store.get()
+		if index, err := strconv.ParseInt(r.URL.Query().Get("index"), 10, 64); err == nil && index >= revision {
+			select {
+			case <-changed:
+			case <-r.Context().Done():
+				return
+			}
+			contents, revision, _ = store.get()
+		}
+		w.Header().Set("X-Consul-Index", strconv.FormatInt(revision, 10))
+		_ = json.NewEncoder(w).Encode([]map[string]any{{"Value": contents, "ModifyIndex": revision}})
+	}))
+}
+
+func newEtcdServer(store *fakeKVStore) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v3/kv/range", func(w http.ResponseWriter, r *http.Request) {
+		contents, revision, _ := store.get()
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"header": map[string]string{"revision": strconv.FormatInt(revision, 10)},
+			"kvs":    []map[string]any{{"value": contents, "mod_revision": strconv.FormatInt(revision, 10)}},
+		})
+	})
+	mux.HandleFunc("/v3/watch", func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			CreateRequest struct {
+				StartRevision int64 `json:"start_revision"`
+			} `json:"create_request"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		_ = json.NewEncoder(w).Encode(map[string]any{"result": map[string]any{"created": true}})
+		w.(http.Flusher).Flush()
+
+		contents, revision, changed := store.get()
+		for revision < req.CreateRequest.StartRevision {
+			select {
+			case <-changed:
+			case <-r.Context().Done():
+				return
+			}
+			contents, revision, changed = store.get()
+		}
+		rev := strconv.FormatInt(revision, 10)
+		_ = json.NewEncoder(w).Encode(map[string]any{"result": map[string]any{
+			"header": map[string]string{"revision": rev},
+			"events": []map[string]any{{"kv": map[string]any{"value": contents, "mod_revision": rev}}},
+		}})
+		w.(http.Flusher).Flush()
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestWatchBasedClients(t *testing.T) {
+	for name, newClient := range map[string]func(*fakeKVStore, <-chan interface{}) (watchedClient, func(), error){
+		"consul": func(store *fakeKVStore, doneCh <-chan interface{}) (watchedClient, func(), error) {
+			server := newConsulServer(store)
+			client, err := dynamicconfig.NewConsulClient(&dynamicconfig.ConsulClientConfig{
+				Address: server.URL,
+				Key:     "temporal/dynamicconfig",
+			}, log.NewNoopLogger(), doneCh)
+			return client, server.Close, err
+		},
+		"etcd": func(store *fakeKVStore, doneCh <-chan interface{}) (watchedClient, func(), error) {
+			server := newEtcdServer(store)
+			client, err := dynamicconfig.NewEtcdClient(&dynamicconfig.EtcdClientConfig{
+				Endpoints: []string{server.URL},
+				Key:       "temporal/dynamicconfig",
+			}, log.NewNoopLogger(), doneCh)
+			return client, server.Close, err
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			store := newFakeKVStore("testKey:\n- value: 1\n")
+			doneCh := make(chan interface{})
+			client, closeServer, err := newClient(store, doneCh)
+			require.NoError(t, err)
+			defer closeServer()
+			defer close(doneCh)
+
+			require.Equal(t, []dynamicconfig.ConstrainedValue{{Value: 1}}, client.GetValue("testkey"))
+
+			changes := make(chan map[dynamicconfig.Key][]dynamicconfig.ConstrainedValue, 1)
+			cancel := client.Subscribe(func(changed map[dynamicconfig.Key][]dynamicconfig.ConstrainedValue) {
+				changes <- changed
+			})
+			defer cancel()
+
+			store.put("testKey:\n- value: 2\notherKey:\n- value: true\n")
+			select {
+			case changed := <-changes:
+				require.Equal(t, map[dynamicconfig.Key][]dynamicconfig.ConstrainedValue{
+					"testkey":  {{Value: 2}},
+					"otherkey": {{Value: true}},
+				}, changed)
+			case <-time.After(10 * time.Second):
+				require.Fail(t, "no update received")
+			}
+			require.Equal(t, []dynamicconfig.ConstrainedValue{{Value: 2}}, client.GetValue("testKey"))
+		})
+	}
+}
@@ -0,0 +1,133 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package eventsink delivers JSON encoded events to external systems over HTTP, e.g. to webhooks and to Kafka through
+// the Kafka REST proxy. It is shared by the sinks of audit events and of workflow lifecycle events.
+package eventsink
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// KafkaContentType is the content type of records produced through the Kafka REST proxy.
+	KafkaContentType = "application/vnd.kafka.json.v2+json"
+
+	defaultTimeout = 10 * time.Second
+)
+
+type (
+	// HTTPClient posts encoded events to an HTTP endpoint.
+	HTTPClient struct {
+		name        string
+		url         string
+		contentType string
+		headers     map[string]string
+		client      *http.Client
+	}
+
+	// KafkaRecord is a record produced through the Kafka REST proxy. Records without a key are spread over the
+	// partitions of the topic.
+	KafkaRecord[T any] struct {
+		Key   string `json:"key,omitempty"`
+		Value T      `json:"value"`
+	}
+
+	// KafkaRecords is the body of a request producing records through the Kafka REST proxy.
+	KafkaRecords[T any] struct {
+		Records []KafkaRecord[T] `json:"records"`
+	}
+)
+
+// NewHTTPClient returns a client posting to url with the given content type and headers. The name of the client is
+// made of the type of the sink and the url. client may be nil to use an HTTP client with the given timeout.
+func NewHTTPClient(
+	sinkType string,
+	url string,
+	contentType string,
+	headers map[string]string,
+	client *http.Client,
+	timeout time.Duration,
+) *HTTPClient {
+	if client == nil {
+		client = &http.Client{}
+	}
+	client.Timeout = timeoutOrDefault(timeout)
+	return &HTTPClient{
+		name:        sinkType + ":" + url,
+		url:         url,
+		contentType: contentType,
+		headers:     headers,
+		client:      client,
+	}
+}
+
+// KafkaTopicURL returns the url of a topic of the Kafka REST proxy listening at url.
+func KafkaTopicURL(url string, topic string) string {
+	return strings.TrimSuffix(url, "/") + "/topics/" + topic
+}
+
+// timeoutOrDefault returns the timeout, or the default timeout if it isn't positive.
+func timeoutOrDefault(timeout time.Duration) time.Duration {
+	if timeout <= 0 {
+		return defaultTimeout
+	}
+	return timeout
+}
+
+func (c *HTTPClient) Name() string {
+	return c.name
+}
+
+// Post sends the body to the endpoint. Responses with a non 2xx status are returned as errors.
+func (c *HTTPClient) Post(ctx context.Context, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", c.contentType)
+	for name, value := range c.headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("sink %s responded with status %d", c.name, resp.StatusCode)
+	}
+	return nil
+}
+
+func (c *HTTPClient) Close() error {
+	c.client.CloseIdleConnections()
+	return nil
+}
@@ -0,0 +1,74 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package eventsink
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPClient_Post(t *testing.T) {
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/topics/events", r.URL.Path)
+		require.Equal(t, KafkaContentType, r.Header.Get("Content-Type"))
+		require.Equal(t, "secret", r.Header.Get("X-Token"))
+		var err error
+		body, err = io.ReadAll(r.Body)
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	url := KafkaTopicURL(server.URL+"/", "events")
+	client := NewHTTPClient("kafka", url, KafkaContentType, map[string]string{"X-Token": "secret"}, nil, 0)
+	require.Equal(t, "kafka:"+url, client.Name())
+	require.NoError(t, client.Post(context.Background(), []byte(`{"records":[]}`)))
+	require.Equal(t, `{"records":[]}`, string(body))
+	require.NoError(t, client.Close())
+}
+
+func TestHTTPClient_ErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient("webhook", server.URL, "application/json", nil, nil, 0)
+	require.ErrorContains(t, client.Post(context.Background(), []byte("{}")), "status 503")
+}
+
+func TestHTTPClient_Timeout(t *testing.T) {
+	require.Equal(t, defaultTimeout, NewHTTPClient("webhook", "http://localhost", "application/json", nil, nil, 0).client.Timeout)
+
+	// the timeout applies to the given client as well
+	client := &http.Client{}
+	NewHTTPClient("pubsub", "http://localhost", "application/json", nil, client, time.Second)
+	require.Equal(t, time.Second, client.Timeout)
+}
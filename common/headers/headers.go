@@ -42,6 +42,15 @@ const (
 	CallerNameHeaderName = "caller-name"
 	CallerTypeHeaderName = "caller-type"
 	CallOriginHeaderName = "call-initiation"
+
+	// QueryRejectConditionsHeaderName is set by callers of QueryWorkflow to reject the query under additional
+	// conditions, see temporal.server.api.enums.v1.QueryRejectCondition for the accepted values.
+	QueryRejectConditionsHeaderName  = "query-reject-conditions"
+	QueryRejectConditionsHeaderDelim = ","
+
+	// DebugTraceHeaderName is set by callers to record a debug trace of the request under the header value as trace
+	// ID. It is only honored for callers with the system admin role.
+	DebugTraceHeaderName = "debug-trace-id"
 )
 
 var (
@@ -54,6 +63,7 @@ var (
 		CallerNameHeaderName,
 		CallerTypeHeaderName,
 		CallOriginHeaderName,
+		DebugTraceHeaderName,
 	}
 )
 
@@ -92,6 +102,28 @@ func Propagate(ctx context.Context) context.Context {
 	return ctx
 }
 
+// GetPropagated returns the headers which Propagate would copy to an outgoing context, for requests that
+// carry them in the request message rather than in gRPC metadata. Headers already set on the outgoing
+// context take precedence over the incoming ones.
+func GetPropagated(ctx context.Context) map[string]string {
+	var result map[string]string
+	mdOutgoing, _ := metadata.FromOutgoingContext(ctx)
+	for _, headerName := range propagateHeaders {
+		value := mdOutgoing.Get(headerName)
+		if len(value) == 0 {
+			value = metadata.ValueFromIncomingContext(ctx, headerName)
+		}
+		if len(value) == 0 {
+			continue
+		}
+		if result == nil {
+			result = make(map[string]string, len(propagateHeaders))
+		}
+		result[headerName] = value[0]
+	}
+	return result
+}
+
 // HeaderGetter is an interface for getting a single header value from a case insensitive key.
 type HeaderGetter interface {
 	Get(string) string
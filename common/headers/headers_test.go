@@ -151,3 +151,25 @@ func (s *HeadersSuite) TestPropagate_EmptyIncomingContext() {
 	s.Equal("<21.04.16", md.Get(SupportedServerVersionsHeaderName)[0])
 	s.Equal("28.08.14", md.Get(ClientNameHeaderName)[0])
 }
+
+func (s *HeadersSuite) TestGetPropagated() {
+	ctx := context.Background()
+	s.Nil(GetPropagated(ctx))
+
+	ctx = metadata.NewIncomingContext(ctx, metadata.New(map[string]string{
+		ClientVersionHeaderName:     "07.08.78",   // Must be ignored
+		SupportedFeaturesHeaderName: "my-feature", // Passed through
+		"some-other-header":         "value",      // Not propagated
+	}))
+
+	ctx = metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{
+		ClientVersionHeaderName: "22.08.78",
+		ClientNameHeaderName:    "28.08.14",
+	}))
+
+	s.Equal(map[string]string{
+		ClientVersionHeaderName:     "22.08.78",
+		ClientNameHeaderName:        "28.08.14",
+		SupportedFeaturesHeaderName: "my-feature",
+	}, GetPropagated(ctx))
+}
@@ -0,0 +1,156 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package log
+
+import (
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/payloads"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	extraSkipForRedactLogger = 1
+
+	namespaceTagKey = "wf-namespace"
+)
+
+type redactLogger struct {
+	logger   Logger
+	redactFn func(namespace string) bool
+	// namespace is the namespace name tag value inherited from With.
+	namespace string
+}
+
+var _ Logger = (*redactLogger)(nil)
+
+// NewRedactLogger returns a logger that removes payloads (inputs, heartbeat details, memo values, ...) from
+// proto message tags before passing them to logger. redactFn is called with the value of the namespace tag,
+// or with an empty string if there is none, and allows namespaces to opt out of redaction.
+func NewRedactLogger(logger Logger, redactFn func(namespace string) bool) *redactLogger {
+	if sl, ok := logger.(SkipLogger); ok {
+		logger = sl.Skip(extraSkipForRedactLogger)
+	}
+	return &redactLogger{
+		logger:   logger,
+		redactFn: redactFn,
+	}
+}
+
+func (l *redactLogger) Debug(msg string, tags ...tag.Tag) {
+	l.logger.Debug(msg, l.redact(tags)...)
+}
+
+func (l *redactLogger) Info(msg string, tags ...tag.Tag) {
+	l.logger.Info(msg, l.redact(tags)...)
+}
+
+func (l *redactLogger) Warn(msg string, tags ...tag.Tag) {
+	l.logger.Warn(msg, l.redact(tags)...)
+}
+
+func (l *redactLogger) Error(msg string, tags ...tag.Tag) {
+	l.logger.Error(msg, l.redact(tags)...)
+}
+
+func (l *redactLogger) DPanic(msg string, tags ...tag.Tag) {
+	l.logger.DPanic(msg, l.redact(tags)...)
+}
+
+func (l *redactLogger) Panic(msg string, tags ...tag.Tag) {
+	l.logger.Panic(msg, l.redact(tags)...)
+}
+
+func (l *redactLogger) Fatal(msg string, tags ...tag.Tag) {
+	l.logger.Fatal(msg, l.redact(tags)...)
+}
+
+func (l *redactLogger) With(tags ...tag.Tag) Logger {
+	namespace := l.namespace
+	if ns, ok := namespaceFromTags(tags); ok {
+		namespace = ns
+	}
+	return &redactLogger{
+		logger:    With(l.logger, l.redactWithNamespace(namespace, tags)...),
+		redactFn:  l.redactFn,
+		namespace: namespace,
+	}
+}
+
+func (l *redactLogger) Skip(extraSkip int) Logger {
+	logger := l.logger
+	if sl, ok := logger.(SkipLogger); ok {
+		logger = sl.Skip(extraSkip)
+	}
+	return &redactLogger{
+		logger:    logger,
+		redactFn:  l.redactFn,
+		namespace: l.namespace,
+	}
+}
+
+func (l *redactLogger) redact(tags []tag.Tag) []tag.Tag {
+	namespace := l.namespace
+	if ns, ok := namespaceFromTags(tags); ok {
+		namespace = ns
+	}
+	return l.redactWithNamespace(namespace, tags)
+}
+
+func (l *redactLogger) redactWithNamespace(namespace string, tags []tag.Tag) []tag.Tag {
+	var redacted []tag.Tag
+	for i, t := range tags {
+		zt, ok := t.(tag.ZapTag)
+		if !ok {
+			continue
+		}
+		msg, ok := zt.Field().Interface.(proto.Message)
+		if !ok {
+			continue
+		}
+		redactedMsg := payloads.Redact(msg)
+		if redactedMsg == msg {
+			continue
+		}
+		if !l.redactFn(namespace) {
+			return tags
+		}
+		if redacted == nil {
+			redacted = make([]tag.Tag, len(tags))
+			copy(redacted, tags)
+		}
+		redacted[i] = tag.NewAnyTag(zt.Key(), redactedMsg)
+	}
+	if redacted == nil {
+		return tags
+	}
+	return redacted
+}
+
+func namespaceFromTags(tags []tag.Tag) (string, bool) {
+	for _, t := range tags {
+		if zt, ok := t.(tag.ZapTag); ok && zt.Key() == namespaceTagKey {
+			return zt.Field().String, true
+		}
+	}
+	return "", false
+}
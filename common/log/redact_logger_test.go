@@ -0,0 +1,85 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package log
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/payloads"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func newObservedRedactLogger(redactFn func(string) bool) (Logger, *observer.ObservedLogs) {
+	core, logs := observer.New(zapcore.DebugLevel)
+	return NewRedactLogger(NewZapLogger(zap.New(core)), redactFn), logs
+}
+
+func loggedPayloads(t *testing.T, entry observer.LoggedEntry, key string) *commonpb.Payloads {
+	for _, field := range entry.Context {
+		if field.Key == key {
+			p, ok := field.Interface.(*commonpb.Payloads)
+			require.True(t, ok)
+			return p
+		}
+	}
+	require.Failf(t, "field not found", "key: %s", key)
+	return nil
+}
+
+func TestRedactLogger_RedactsPayloads(t *testing.T) {
+	logger, logs := newObservedRedactLogger(func(string) bool { return true })
+	input := payloads.EncodeString("secret")
+
+	logger.Info("message", tag.NewAnyTag("input", input), tag.WorkflowNamespace("ns"))
+
+	entries := logs.AllUntimed()
+	require.Len(t, entries, 1)
+	logged := loggedPayloads(t, entries[0], "input")
+	require.Nil(t, logged.Payloads[0].Data)
+	require.Equal(t, payloads.RedactedEncoding, string(logged.Payloads[0].Metadata["encoding"]))
+	require.Equal(t, `["secret"]`, payloads.ToString(input))
+	require.Contains(t, entries[0].ContextMap()[tag.LoggingCallAtKey], "redact_logger_test.go")
+}
+
+func TestRedactLogger_NamespaceOptOut(t *testing.T) {
+	var namespaces []string
+	logger, logs := newObservedRedactLogger(func(namespace string) bool {
+		namespaces = append(namespaces, namespace)
+		return namespace != "opted-out"
+	})
+	input := payloads.EncodeString("secret")
+
+	With(logger, tag.WorkflowNamespace("opted-out")).Info("message", tag.NewAnyTag("input", input))
+	logger.Info("message", tag.NewAnyTag("input", input))
+
+	entries := logs.AllUntimed()
+	require.Len(t, entries, 2)
+	require.Equal(t, `["secret"]`, payloads.ToString(loggedPayloads(t, entries[0], "input")))
+	require.Nil(t, loggedPayloads(t, entries[1], "input").Payloads[0].Data)
+	require.Equal(t, []string{"opted-out", ""}, namespaces)
+}
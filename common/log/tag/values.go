@@ -117,6 +117,7 @@ var (
 	ComponentOutboundQueue             = component("outbound-queue-processor")
 	ComponentVisibilityQueue           = component("visibility-queue-processor")
 	ComponentArchivalQueue             = component("archival-queue-processor")
+	ComponentLifecycleEventQueue       = component("workflow-lifecycle-event-queue-processor")
 	ComponentTimerQueue                = component("timer-queue-processor")
 	ComponentMemoryScheduledQueue      = component("memory-scheduled-queue-processor")
 	ComponentTimerBuilder              = component("timer-builder")
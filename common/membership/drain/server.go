@@ -0,0 +1,221 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package drain contains the HTTP endpoint used to drain a host ahead of a restart, e.g. from a
+// Kubernetes preStop hook. Draining removes the host from the membership ring, so that other hosts
+// take over its shards or task queue partitions, and waits until it doesn't own any anymore.
+package drain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/membership"
+)
+
+const (
+	// Path starts draining the host and waits until it's done. The optional "wait" query
+	// parameter limits how long to wait, e.g. "wait=30s". The response is the Progress, with
+	// status 200 if draining is done and 503 otherwise.
+	Path = "/drain"
+	// ProgressPath returns the Progress without starting to drain.
+	ProgressPath = "/drain/progress"
+
+	pollInterval = time.Second
+)
+
+var errDrainGRPCListenerNotTCP = errors.New("must use TCP for gRPC listener to support draining")
+
+type (
+	// Progress describes how far draining the host got.
+	Progress struct {
+		Draining bool      `json:"draining"`
+		Done     bool      `json:"done"`
+		Started  time.Time `json:"started,omitempty"`
+		// Remaining is the number of units, e.g. shards, the host still owns.
+		Remaining int    `json:"remaining"`
+		Unit      string `json:"unit"`
+	}
+
+	// Server serves the drain endpoints of a host.
+	Server struct {
+		monitor   membership.Monitor
+		unit      string
+		remaining func() int
+		logger    log.Logger
+		listener  net.Listener
+		server    *http.Server
+
+		lock    sync.Mutex
+		started time.Time
+	}
+)
+
+// NewServer creates a drain server listening on the drain port of rpcConfig, on the same address
+// as the gRPC listener. It returns nil if the drain port isn't set. remaining returns the number of
+// units the host still owns, the host is drained once it returns 0.
+func NewServer(
+	rpcConfig config.RPC,
+	grpcListener net.Listener,
+	monitor membership.Monitor,
+	unit string,
+	remaining func() int,
+	logger log.Logger,
+) (*Server, error) {
+	if rpcConfig.DrainPort == 0 {
+		return nil, nil
+	}
+	tcpAddrRef, ok := grpcListener.Addr().(*net.TCPAddr)
+	if !ok {
+		return nil, errDrainGRPCListenerNotTCP
+	}
+	tcpAddr := *tcpAddrRef
+	tcpAddr.Port = rpcConfig.DrainPort
+	listener, err := net.ListenTCP("tcp", &tcpAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		monitor:   monitor,
+		unit:      unit,
+		remaining: remaining,
+		logger:    logger,
+		listener:  listener,
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(Path, s.serveDrain)
+	mux.HandleFunc(ProgressPath, s.serveProgress)
+	s.server = &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	return s, nil
+}
+
+// Start serves the drain endpoints in the background.
+func (s *Server) Start() {
+	go func() {
+		s.logger.Info("Starting drain server", tag.Address(s.listener.Addr().String()))
+		if err := s.server.Serve(s.listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.logger.Error("Drain server failed", tag.Error(err))
+		}
+	}()
+}
+
+// Stop stops the server. Requests waiting for draining to finish are cancelled.
+func (s *Server) Stop() {
+	_ = s.server.Close()
+}
+
+// Drain evicts the host from the membership ring. Calling it again has no effect.
+func (s *Server) Drain() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if !s.started.IsZero() {
+		return nil
+	}
+	s.logger.Info("Draining host, evicting self from membership ring")
+	if err := s.monitor.EvictSelf(); err != nil {
+		return err
+	}
+	s.started = time.Now().UTC()
+	return nil
+}
+
+// Progress returns how far draining the host got.
+func (s *Server) Progress() Progress {
+	s.lock.Lock()
+	started := s.started
+	s.lock.Unlock()
+
+	remaining := s.remaining()
+	return Progress{
+		Draining:  !started.IsZero(),
+		Done:      !started.IsZero() && remaining == 0,
+		Started:   started,
+		Remaining: remaining,
+		Unit:      s.unit,
+	}
+}
+
+// Wait blocks until draining is done or ctx is done, and returns the last progress.
+func (s *Server) Wait(ctx context.Context) Progress {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		progress := s.Progress()
+		if progress.Done {
+			return progress
+		}
+		select {
+		case <-ctx.Done():
+			return progress
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Server) serveDrain(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if wait := r.URL.Query().Get("wait"); wait != "" {
+		timeout, err := time.ParseDuration(wait)
+		if err != nil {
+			http.Error(w, "invalid wait duration: "+strconv.Quote(wait), http.StatusBadRequest)
+			return
+		}
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	if err := s.Drain(); err != nil {
+		s.logger.Error("Failed to evict self from membership ring", tag.Error(err))
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	progress := s.Wait(ctx)
+	if progress.Done {
+		s.logger.Info("Host drained", tag.NewDurationTag("duration", time.Since(progress.Started)))
+	}
+	s.writeProgress(w, progress)
+}
+
+func (s *Server) serveProgress(w http.ResponseWriter, _ *http.Request) {
+	s.writeProgress(w, s.Progress())
+}
+
+func (s *Server) writeProgress(w http.ResponseWriter, progress Progress) {
+	w.Header().Set("Content-Type", "application/json")
+	if progress.Draining && !progress.Done {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	if err := json.NewEncoder(w).Encode(progress); err != nil {
+		s.logger.Warn("Failed to write drain progress", tag.Error(err))
+	}
+}
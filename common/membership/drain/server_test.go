@@ -0,0 +1,116 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package drain
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/membership"
+	"go.uber.org/mock/gomock"
+)
+
+func TestServer_Disabled(t *testing.T) {
+	s, err := NewServer(config.RPC{}, nil, nil, "shards", nil, log.NewNoopLogger())
+	require.NoError(t, err)
+	require.Nil(t, s)
+}
+
+func TestServer_Drain(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	monitor := membership.NewMockMonitor(ctrl)
+	monitor.EXPECT().EvictSelf().Return(nil).Times(1)
+
+	var remaining atomic.Int32
+	remaining.Store(2)
+	s, addr := newTestServer(t, monitor, func() int {
+		// each check releases one shard
+		return int(max(remaining.Add(-1), 0))
+	})
+
+	progress := getProgress(t, "http://"+addr+ProgressPath, http.StatusOK)
+	require.False(t, progress.Draining)
+	require.Equal(t, "shards", progress.Unit)
+
+	progress = getProgress(t, "http://"+addr+Path, http.StatusOK)
+	require.True(t, progress.Draining)
+	require.True(t, progress.Done)
+	require.Zero(t, progress.Remaining)
+
+	// draining again doesn't evict again
+	progress = getProgress(t, "http://"+addr+Path, http.StatusOK)
+	require.True(t, progress.Done)
+	require.Equal(t, progress, s.Progress())
+}
+
+func TestServer_DrainTimeout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	monitor := membership.NewMockMonitor(ctrl)
+	monitor.EXPECT().EvictSelf().Return(nil).Times(1)
+
+	_, addr := newTestServer(t, monitor, func() int { return 3 })
+
+	progress := getProgress(t, "http://"+addr+Path+"?wait=10ms", http.StatusServiceUnavailable)
+	require.True(t, progress.Draining)
+	require.False(t, progress.Done)
+	require.Equal(t, 3, progress.Remaining)
+
+	resp, err := http.Get("http://" + addr + Path + "?wait=soon")
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func newTestServer(t *testing.T, monitor membership.Monitor, remaining func() int) (*Server, string) {
+	grpcListener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = grpcListener.Close() })
+
+	// find a free port for the drain server
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := l.Addr().(*net.TCPAddr).Port
+	require.NoError(t, l.Close())
+
+	s, err := NewServer(config.RPC{DrainPort: port}, grpcListener, monitor, "shards", remaining, log.NewNoopLogger())
+	require.NoError(t, err)
+	s.Start()
+	t.Cleanup(s.Stop)
+	return s, s.listener.Addr().String()
+}
+
+func getProgress(t *testing.T, url string, expectedStatus int) Progress {
+	resp, err := http.Get(url)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	require.Equal(t, expectedStatus, resp.StatusCode)
+	var progress Progress
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&progress))
+	return progress
+}
@@ -0,0 +1,117 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/dynamicconfig"
+)
+
+type (
+	// shardOwnershipOverrideResolver applies the history.shardOwnershipOverrides dynamic config
+	// on top of the membership ring of the history service.
+	shardOwnershipOverrideResolver struct {
+		ServiceResolver
+
+		overrides atomic.Pointer[map[string]string] // shard ID to host address
+
+		listenersLock sync.Mutex
+		listeners     map[string]chan<- *ChangedEvent
+	}
+)
+
+// NewShardOwnershipOverrideResolver wraps the resolver of the history service, so that lookups of
+// shard IDs return the host pinned by the HistoryShardOwnershipOverrides dynamic config. Listeners
+// are notified with an empty event when the overrides change.
+func NewShardOwnershipOverrideResolver(resolver ServiceResolver, dc *dynamicconfig.Collection) ServiceResolver {
+	r := &shardOwnershipOverrideResolver{
+		ServiceResolver: resolver,
+		listeners:       make(map[string]chan<- *ChangedEvent),
+	}
+	// The subscription lives as long as the collection, like the resolver.
+	overrides, _ := dynamicconfig.HistoryShardOwnershipOverrides.Subscribe(dc)(r.overridesChanged)
+	r.setOverrides(overrides)
+	return r
+}
+
+// LookupWithOverride returns the host with the given address if it's an available member of the
+// ring, and the owner chosen by the ring otherwise.
+func LookupWithOverride(resolver ServiceResolver, key string, address string) (HostInfo, error) {
+	if address != "" {
+		for _, host := range resolver.AvailableMembers() {
+			if host.GetAddress() == address {
+				return host, nil
+			}
+		}
+	}
+	return resolver.Lookup(key)
+}
+
+func (r *shardOwnershipOverrideResolver) Lookup(key string) (HostInfo, error) {
+	if address, ok := (*r.overrides.Load())[key]; ok {
+		return LookupWithOverride(r.ServiceResolver, key, address)
+	}
+	return r.ServiceResolver.Lookup(key)
+}
+
+func (r *shardOwnershipOverrideResolver) AddListener(name string, notifyChannel chan<- *ChangedEvent) error {
+	if err := r.ServiceResolver.AddListener(name, notifyChannel); err != nil {
+		return err
+	}
+	r.listenersLock.Lock()
+	defer r.listenersLock.Unlock()
+	r.listeners[name] = notifyChannel
+	return nil
+}
+
+func (r *shardOwnershipOverrideResolver) RemoveListener(name string) error {
+	r.listenersLock.Lock()
+	delete(r.listeners, name)
+	r.listenersLock.Unlock()
+	return r.ServiceResolver.RemoveListener(name)
+}
+
+func (r *shardOwnershipOverrideResolver) setOverrides(overrides []dynamicconfig.ShardOwnershipOverride) {
+	byShard := make(map[string]string, len(overrides))
+	for _, override := range overrides {
+		byShard[convert.Int32ToString(override.ShardID)] = override.Address
+	}
+	r.overrides.Store(&byShard)
+}
+
+func (r *shardOwnershipOverrideResolver) overridesChanged(overrides []dynamicconfig.ShardOwnershipOverride) {
+	r.setOverrides(overrides)
+
+	r.listenersLock.Lock()
+	defer r.listenersLock.Unlock()
+	for _, ch := range r.listeners {
+		select {
+		case ch <- &ChangedEvent{}:
+		default:
+			// the listener has an event pending already
+		}
+	}
+}
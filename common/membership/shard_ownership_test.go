@@ -0,0 +1,78 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.uber.org/mock/gomock"
+)
+
+func TestShardOwnershipOverrideResolver(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	base := NewMockServiceResolver(ctrl)
+	hostA := NewHostInfoFromAddress("a:7234")
+	hostB := NewHostInfoFromAddress("b:7234")
+	base.EXPECT().Lookup(gomock.Any()).Return(hostA, nil).AnyTimes()
+	base.EXPECT().AvailableMembers().Return([]HostInfo{hostA, hostB}).AnyTimes()
+	base.EXPECT().AddListener("test", gomock.Any()).Return(nil)
+	base.EXPECT().RemoveListener("test").Return(nil)
+
+	client := dynamicconfig.NewMemoryClient()
+	client.OverrideSetting(dynamicconfig.HistoryShardOwnershipOverrides, []any{
+		map[string]any{"shardId": 2, "address": "b:7234"},
+		map[string]any{"shardId": 3, "address": "gone:7234"},
+	})
+	dc := dynamicconfig.NewCollection(client, log.NewNoopLogger())
+	dc.Start()
+	defer dc.Stop()
+
+	r := NewShardOwnershipOverrideResolver(base, dc)
+	lookup := func(key string) string {
+		host, err := r.Lookup(key)
+		require.NoError(t, err)
+		return host.GetAddress()
+	}
+	require.Equal(t, "a:7234", lookup("1"))
+	require.Equal(t, "b:7234", lookup("2"))
+	// overrides to hosts that aren't available are ignored
+	require.Equal(t, "a:7234", lookup("3"))
+
+	ch := make(chan *ChangedEvent, 1)
+	require.NoError(t, r.AddListener("test", ch))
+	client.OverrideSetting(dynamicconfig.HistoryShardOwnershipOverrides, []any{
+		map[string]any{"shardId": 1, "address": "b:7234"},
+	})
+	select {
+	case <-ch:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "listener wasn't notified of changed overrides")
+	}
+	require.Equal(t, "b:7234", lookup("1"))
+	require.Equal(t, "a:7234", lookup("2"))
+	require.NoError(t, r.RemoveListener("test"))
+}
@@ -60,6 +60,10 @@ type (
 		// Each value present in keys will have relevant tag value replaced with "_tag_excluded_"
 		// Each value in values list will white-list tag values to be reported as usual.
 		ExcludeTags map[string][]string `yaml:"excludeTags"`
+		// PerNamespaceTagCardinalityLimits is a map from tag name string to the maximum number of distinct values
+		// reported for that tag by each namespace, e.g. {"taskqueue": 100, "workflowType": 100}.
+		// Values seen after a namespace reached the limit are replaced with "_other_".
+		PerNamespaceTagCardinalityLimits map[string]int `yaml:"perNamespaceTagCardinalityLimits"`
 		// Prefix sets the prefix to all outgoing metrics
 		Prefix string `yaml:"prefix"`
 
@@ -52,6 +52,10 @@ var (
 	metricsCtxKey     = metricsContextKey{}
 )
 
+// MatchingCallLatencyCounterName is the metrics context counter accumulating the nanoseconds a request spent in calls
+// to the matching service.
+const MatchingCallLatencyCounterName = "matching_call_latency"
+
 // NewServerMetricsContextInjectorInterceptor returns grpc server interceptor that adds metrics context to golang
 // context.
 func NewServerMetricsContextInjectorInterceptor() grpc.UnaryServerInterceptor {
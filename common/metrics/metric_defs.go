@@ -39,10 +39,14 @@ const (
 	QueueTypeTagName            = "queue_type"
 	visibilityPluginNameTagName = "visibility_plugin_name"
 	visibilityIndexNameTagName  = "visibility_index_name"
+	persistenceStoreTagName     = "persistence_store"
 	ErrorTypeTagName            = "error_type"
 	httpStatusTagName           = "http_status"
 	nexusMethodTagName          = "method"
 	nexusEndpointTagName        = "nexus_endpoint"
+	nexusPayloadTypeTagName     = "payload_type"
+	nexusServiceTagName         = "nexus_service"
+	nexusOperationTagName       = "nexus_operation"
 	outcomeTagName              = "outcome"
 	versionedTagName            = "versioned"
 	resourceExhaustedTag        = "resource_exhausted_cause"
@@ -92,6 +96,8 @@ const (
 	MatchingClientAddWorkflowTaskScope = "MatchingClientAddWorkflowTask"
 	// MatchingClientQueryWorkflowScope tracks RPC calls to matching service
 	MatchingClientQueryWorkflowScope = "MatchingClientQueryWorkflow"
+	// MatchingClientStreamPollTaskQueueScope tracks RPC calls to matching service
+	MatchingClientStreamPollTaskQueueScope = "MatchingClientStreamPollTaskQueue"
 )
 
 // Worker
@@ -207,6 +213,12 @@ const (
 	PersistenceCompleteOutboundTasksScope = "CompleteOutboundTasks"
 	// PersistenceRangeCompleteOutboundTasksScope tracks RangeCompleteOutboundTasks calls made by service to persistence layer
 	PersistenceRangeCompleteOutboundTasksScope = "RangeCompleteOutboundTasks"
+	// PersistenceGetWorkflowLifecycleEventTasksScope tracks GetWorkflowLifecycleEventTasks calls made by service to persistence layer
+	PersistenceGetWorkflowLifecycleEventTasksScope = "GetWorkflowLifecycleEventTasks"
+	// PersistenceCompleteWorkflowLifecycleEventTaskScope tracks CompleteWorkflowLifecycleEventTask calls made by service to persistence layer
+	PersistenceCompleteWorkflowLifecycleEventTaskScope = "CompleteWorkflowLifecycleEventTask"
+	// PersistenceRangeCompleteWorkflowLifecycleEventTasksScope tracks RangeCompleteWorkflowLifecycleEventTasks calls made by service to persistence layer
+	PersistenceRangeCompleteWorkflowLifecycleEventTasksScope = "RangeCompleteWorkflowLifecycleEventTasks"
 	// PersistenceCompleteArchivalTaskScope tracks CompleteArchivalTasks calls made by service to persistence layer
 	PersistenceCompleteArchivalTaskScope = "CompleteArchivalTask"
 	// PersistenceRangeCompleteArchivalTasksScope tracks CompleteArchivalTasks calls made by service to persistence layer
@@ -309,6 +321,8 @@ const (
 	ServerTlsScope = "ServerTls"
 	// AuthorizationScope is the scope used by all metric emitted by authorization code
 	AuthorizationScope = "Authorization"
+	// AuditScope is the scope used by all metric emitted by audit event export
+	AuditScope = "Audit"
 	// NamespaceCacheScope tracks namespace cache callbacks
 	NamespaceCacheScope = "NamespaceCache"
 )
@@ -453,6 +467,8 @@ const (
 	OperationMemoryScheduledQueueProcessorScope = "MemoryScheduledQueueProcessor"
 	// OperationOutboundQueueProcessorScope is a scope for the outbound queue processor.
 	OperationOutboundQueueProcessorScope = "OutboundQueueProcessor"
+	// OperationWorkflowLifecycleEventQueueProcessorScope is a scope for the workflow lifecycle event queue processor.
+	OperationWorkflowLifecycleEventQueueProcessorScope = "WorkflowLifecycleEventQueueProcessor"
 )
 
 // Matching Scope
@@ -535,6 +551,8 @@ const (
 	VerifyVersionedTransitionTaskScope = "VerifyVersionedTransitionTask"
 	// SyncVersionedTransitionTaskScope is the scope used by sync versioned transition task processing
 	SyncVersionedTransitionTaskScope = "SyncVersionedTransitionTask"
+	// WriteIntentTaskScope is the scope used by write intent replication task processing
+	WriteIntentTaskScope = "WriteIntentTask"
 	// SyncWatermarkScope is the scope used by closed workflow task replication processing
 	SyncWatermarkScope = "SyncWatermark"
 	// NoopTaskScope is the scope used by noop task
@@ -568,6 +586,7 @@ const (
 	TaskTypeVisibilityTaskCloseExecution                  = "VisibilityTaskCloseExecution"
 	TaskTypeVisibilityTaskDeleteExecution                 = "VisibilityTaskDeleteExecution"
 	TaskTypeArchivalTaskArchiveExecution                  = "ArchivalTaskArchiveExecution"
+	TaskTypeWorkflowLifecycleEventTask                    = "WorkflowLifecycleEventTask"
 	TaskTypeTimerActiveTaskActivityTimeout                = "TimerActiveTaskActivityTimeout"
 	TaskTypeTimerActiveTaskWorkflowTaskTimeout            = "TimerActiveTaskWorkflowTaskTimeout"
 	TaskTypeTimerActiveTaskUserTimer                      = "TimerActiveTaskUserTimer"
@@ -620,6 +639,9 @@ var (
 	TlsCertsExpired                          = NewGaugeDef("certificates_expired")
 	TlsCertsExpiring                         = NewGaugeDef("certificates_expiring")
 	ServiceAuthorizationLatency              = NewTimerDef("service_authorization_latency")
+	AuditEventsExported                      = NewCounterDef("audit_events_exported")
+	AuditEventsDropped                       = NewCounterDef("audit_events_dropped")
+	AuditExportFailures                      = NewCounterDef("audit_export_failures")
 	EventBlobSize                            = NewBytesHistogramDef("event_blob_size")
 	LockRequests                             = NewCounterDef("lock_requests")
 	LockLatency                              = NewTimerDef("lock_latency")
@@ -694,6 +716,14 @@ var (
 		"nexus_completion_latency",
 		WithDescription("Latency histogram of Nexus completion (callback) requests."),
 	)
+	NexusPayloadSize = NewBytesHistogramDef(
+		"nexus_payload_size",
+		WithDescription("Size of Nexus operation input and result payloads."),
+	)
+	NexusPayloadSizeLimitExceeded = NewCounterDef(
+		"nexus_payload_size_limit_exceeded",
+		WithDescription("The number of Nexus operation payloads rejected for exceeding the size limit."),
+	)
 	NexusCompletionRequestPreProcessErrors = NewCounterDef(
 		"nexus_completion_request_preprocess_errors",
 		WithDescription("The number of Nexus completion requests for which pre-processing failed."),
@@ -709,6 +739,7 @@ var (
 	CacheSize                                    = NewGaugeDef("cache_size")
 	CacheUsage                                   = NewGaugeDef("cache_usage")
 	CachePinnedUsage                             = NewGaugeDef("cache_pinned_usage")
+	CacheGroupUsage                              = NewGaugeDef("cache_group_usage")
 	CacheTtl                                     = NewTimerDef("cache_ttl")
 	CacheEntryAgeOnGet                           = NewTimerDef("cache_entry_age_on_get")
 	CacheEntryAgeOnEviction                      = NewTimerDef("cache_entry_age_on_eviction")
@@ -719,7 +750,14 @@ var (
 	// ArchivalTaskInvalidURI is emitted by the archival queue task executor when the history or visibility URI for an
 	// archival task is not a valid URI.
 	// We may emit this metric several times for a single task if the task is retried.
-	ArchivalTaskInvalidURI              = NewCounterDef("archival_task_invalid_uri")
+	ArchivalTaskInvalidURI = NewCounterDef("archival_task_invalid_uri")
+	// ArchivalTaskLag is emitted by the archival queue task executor when an archival task completes successfully. It
+	// measures the time between the task becoming ready and it being processed, i.e. how far archival is behind.
+	ArchivalTaskLag = NewTimerDef("archival_task_lag")
+	// ArchivalBacklogPendingTasks and ArchivalBacklogOldestPendingAge are emitted per namespace by the
+	// DescribeArchivalStatus admin API from the archival queue backlog it scanned.
+	ArchivalBacklogPendingTasks         = NewGaugeDef("archival_backlog_pending_tasks")
+	ArchivalBacklogOldestPendingAge     = NewTimerDef("archival_backlog_oldest_pending_age")
 	ArchiverArchiveLatency              = NewTimerDef("archiver_archive_latency")
 	ArchiverArchiveTargetLatency        = NewTimerDef("archiver_archive_target_latency")
 	ShardContextClosedCounter           = NewCounterDef("shard_closed_count")
@@ -763,6 +801,14 @@ var (
 		"task_latency_queue",
 		WithDescription("End-to-end latency for processing and completing a history task, from task generation to completion."),
 	)
+	WorkflowLifecycleEventPublished = NewCounterDef(
+		"workflow_lifecycle_event_published",
+		WithDescription("The number of workflow lifecycle events delivered to a sink."),
+	)
+	WorkflowLifecycleEventPublishFailed = NewCounterDef(
+		"workflow_lifecycle_event_publish_failed",
+		WithDescription("The number of failed attempts to deliver a workflow lifecycle event to a sink. Failed deliveries are retried."),
+	)
 	TaskAttempt = NewDimensionlessHistogramDef(
 		"task_attempt",
 		WithDescription("The number of attempts took to complete a history task."),
@@ -786,6 +832,7 @@ var (
 	)
 	TaskDiscarded                   = NewCounterDef("task_errors_discarded")
 	TaskSkipped                     = NewCounterDef("task_skipped")
+	VisibilityUpsertCoalesced       = NewCounterDef("visibility_upsert_coalesced")
 	TaskVersionMisMatch             = NewCounterDef("task_errors_version_mismatch")
 	TasksDependencyTaskNotCompleted = NewCounterDef("task_dependency_task_not_completed")
 	TaskStandbyRetryCounter         = NewCounterDef("task_errors_standby_retry_counter")
@@ -814,6 +861,7 @@ var (
 	QueueSliceCountHistogram                             = NewDimensionlessHistogramDef("queue_slice_count")
 	QueueActionCounter                                   = NewCounterDef("queue_actions")
 	ActivityE2ELatency                                   = NewTimerDef("activity_end_to_end_latency")
+	LocalActivityMarkerDuration                          = NewTimerDef("local_activity_marker_duration")
 	AckLevelUpdateCounter                                = NewCounterDef("ack_level_update")
 	AckLevelUpdateFailedCounter                          = NewCounterDef("ack_level_update_failed")
 	CommandCounter                                       = NewCounterDef("command")
@@ -825,6 +873,9 @@ var (
 	WorkflowExecutionUpdateRegistrySize                  = NewBytesHistogramDef("workflow_update_registry_size")
 	WorkflowExecutionUpdateRequestRateLimited            = NewCounterDef("workflow_update_request_rate_limited")
 	WorkflowExecutionUpdateTooMany                       = NewCounterDef("workflow_update_request_too_many")
+	WorkflowExecutionUpdateBufferFull                    = NewCounterDef("workflow_update_request_buffer_full")
+	WorkflowExecutionUpdateInFlightCount                 = NewDimensionlessHistogramDef("workflow_update_in_flight_count")
+	WorkflowExecutionUpdateBufferedCount                 = NewDimensionlessHistogramDef("workflow_update_buffered_count")
 	WorkflowExecutionUpdateAborted                       = NewCounterDef("workflow_update_aborted")
 	WorkflowExecutionUpdateSentToWorker                  = NewCounterDef("workflow_update_sent_to_worker")
 	WorkflowExecutionUpdateSentToWorkerAgain             = NewCounterDef("workflow_update_sent_to_worker_again")
@@ -847,6 +898,7 @@ var (
 	MultipleCompletionCommandsCounter             = NewCounterDef("multiple_completion_commands")
 	FailedWorkflowTasksCounter                    = NewCounterDef("failed_workflow_tasks")
 	WorkflowTaskAttempt                           = NewDimensionlessHistogramDef("workflow_task_attempt")
+	StuckWorkflowTaskReported                     = NewCounterDef("stuck_workflow_task_reported")
 	StaleMutableStateCounter                      = NewCounterDef("stale_mutable_state")
 	AutoResetPointsLimitExceededCounter           = NewCounterDef("auto_reset_points_exceed_limit")
 	AutoResetPointCorruptionCounter               = NewCounterDef("auto_reset_point_corruption")
@@ -854,6 +906,7 @@ var (
 	ConcurrencyUpdateFailureCounter               = NewCounterDef("concurrency_update_failure")
 	ServiceErrShardOwnershipLostCounter           = NewCounterDef("service_errors_shard_ownership_lost")
 	HeartbeatTimeoutCounter                       = NewCounterDef("heartbeat_timeout")
+	ActivityHeartbeatWriteSuppressedCounter       = NewCounterDef("activity_heartbeat_write_suppressed")
 	ScheduleToStartTimeoutCounter                 = NewCounterDef("schedule_to_start_timeout")
 	StartToCloseTimeoutCounter                    = NewCounterDef("start_to_close_timeout")
 	ScheduleToCloseTimeoutCounter                 = NewCounterDef("schedule_to_close_timeout")
@@ -921,6 +974,7 @@ var (
 	ReplicationServiceError               = NewCounterDef("replication_service_error")
 	ReplicationStreamStuck                = NewCounterDef("replication_stream_stuck")
 	ReplicationTasksSend                  = NewCounterDef("replication_tasks_send")
+	ReplicationTasksSendBatchSize         = NewDimensionlessHistogramDef("replication_tasks_send_batch_size")
 	ReplicationTasksRecv                  = NewCounterDef("replication_tasks_recv")
 	ReplicationTasksRecvBacklog           = NewDimensionlessHistogramDef("replication_tasks_recv_backlog")
 	ReplicationTasksSkipped               = NewCounterDef("replication_tasks_skipped")
@@ -929,6 +983,15 @@ var (
 	// ReplicationTasksLag is a heuristic for how far behind the remote DC is for a given cluster. It measures the
 	// difference between task IDs so its unit should be "tasks".
 	ReplicationTasksLag = NewDimensionlessHistogramDef("replication_tasks_lag")
+	// ReplicationStreamTaskLag and ReplicationStreamTimeLag measure how far behind the remote cluster is on the
+	// replication tasks of a shard, as reported by the remote cluster through the replication stream. The time lag is
+	// the age of the oldest task not acknowledged yet, ReplicationStreamNamespaceTimeLag is the same for the tasks of
+	// a namespace.
+	ReplicationStreamTaskLag          = NewDimensionlessHistogramDef("replication_stream_task_lag")
+	ReplicationStreamTimeLag          = NewTimerDef("replication_stream_time_lag")
+	ReplicationStreamNamespaceTimeLag = NewTimerDef("replication_stream_namespace_time_lag")
+	// WriteIntentBuffered counts signals and updates accepted by a standby cluster and buffered for the active cluster.
+	WriteIntentBuffered = NewCounterDef("write_intent_buffered")
 	// ReplicationTasksFetched records the number of tasks fetched by the poller.
 	ReplicationTasksFetched                        = NewDimensionlessHistogramDef("replication_tasks_fetched")
 	ReplicationLatency                             = NewTimerDef("replication_latency")
@@ -939,6 +1002,7 @@ var (
 	ReplicationNonEmptyDLQCount                    = NewCounterDef("replication_dlq_non_empty")
 	ReplicationOutlierNamespace                    = NewCounterDef("replication_outlier_namespace")
 	EventReapplySkippedCount                       = NewCounterDef("event_reapply_skipped_count")
+	ReplicationConflictResolvedCount               = NewCounterDef("replication_conflict_resolved")
 	DirectQueryDispatchLatency                     = NewTimerDef("direct_query_dispatch_latency")
 	DirectQueryDispatchStickyLatency               = NewTimerDef("direct_query_dispatch_sticky_latency")
 	DirectQueryDispatchNonStickyLatency            = NewTimerDef("direct_query_dispatch_non_sticky_latency")
@@ -951,6 +1015,7 @@ var (
 	ConsistentQueryTimeoutCount                    = NewCounterDef("consistent_query_timeout")
 	QueryBufferExceededCount                       = NewCounterDef("query_buffer_exceeded")
 	QueryRegistryInvalidStateCount                 = NewCounterDef("query_registry_invalid_state")
+	DurableQueryResultServedCount                  = NewCounterDef("durable_query_result_served")
 	WorkflowTaskTimeoutOverrideCount               = NewCounterDef("workflow_task_timeout_overrides")
 	WorkflowRunTimeoutOverrideCount                = NewCounterDef("workflow_run_timeout_overrides")
 	ReplicationTaskCleanupCount                    = NewCounterDef("replication_task_cleanup_count")
@@ -971,6 +1036,14 @@ var (
 		"dlq_message_count",
 		WithDescription("The number of messages currently in DLQ."),
 	)
+	HotShardsDetected = NewCounterDef(
+		"hot_shards_detected",
+		WithDescription("The number of shards using a disproportionate share of their history host resources within a hotspot detection window."),
+	)
+	HotWorkflowsDetected = NewCounterDef(
+		"hot_workflows_detected",
+		WithDescription("The number of workflows using a disproportionate share of their history host resources within a hotspot detection window, keyed by namespace."),
+	)
 	ReadNamespaceErrors                     = NewCounterDef("read_namespace_errors")
 	RateLimitedTaskRunnableWaitTime         = NewTimerDef("rate_limited_task_runnable_wait_time")
 	CircuitBreakerExecutableBlocked         = NewCounterDef("circuit_breaker_executable_blocked")
@@ -1065,10 +1138,14 @@ var (
 	HistoryScavengerSuccessCount                    = NewCounterDef("scavenger_success")
 	HistoryScavengerErrorCount                      = NewCounterDef("scavenger_errors")
 	HistoryScavengerSkipCount                       = NewCounterDef("scavenger_skips")
+	HistoryScavengerGarbageCount                    = NewCounterDef("scavenger_garbage_branches")
 	ExecutionsOutstandingCount                      = NewGaugeDef("executions_outstanding")
 	ScavengerValidationRequestsCount                = NewCounterDef("scavenger_validation_requests")
 	ScavengerValidationFailuresCount                = NewCounterDef("scavenger_validation_failures")
 	ScavengerValidationSkipsCount                   = NewCounterDef("scavenger_validation_skips")
+	ScavengerStuckWorkflowResetCount                = NewCounterDef("scavenger_stuck_workflow_resets")
+	ScavengerStuckWorkflowResetLimitReachedCount    = NewCounterDef("scavenger_stuck_workflow_reset_limit_reached")
+	ScavengerZombieExecutionRepairCount             = NewCounterDef("scavenger_zombie_execution_repairs")
 	AddSearchAttributesFailuresCount                = NewCounterDef("add_search_attributes_failures")
 
 	// Delete Namespace metrics.
@@ -1109,6 +1186,7 @@ var (
 	)
 	BatcherProcessorFailures                          = NewCounterDef("batcher_processor_errors")
 	BatcherOperationFailures                          = NewCounterDef("batcher_operation_errors")
+	BatcherOperationRPS                               = NewGaugeDef("batcher_operation_rps")
 	ElasticsearchBulkProcessorRequests                = NewCounterDef("elasticsearch_bulk_processor_requests")
 	ElasticsearchBulkProcessorQueuedRequests          = NewDimensionlessHistogramDef("elasticsearch_bulk_processor_queued_requests")
 	ElasticsearchBulkProcessorFailures                = NewCounterDef("elasticsearch_bulk_processor_errors")
@@ -1132,6 +1210,7 @@ var (
 	NamespaceReplicationEnqueueDLQCount               = NewCounterDef("namespace_replication_dlq_enqueue_requests")
 	ParentClosePolicyProcessorSuccess                 = NewCounterDef("parent_close_policy_processor_requests")
 	ParentClosePolicyProcessorFailures                = NewCounterDef("parent_close_policy_processor_errors")
+	ParentClosePolicyProcessorBatches                 = NewDimensionlessHistogramDef("parent_close_policy_processor_batches")
 	ScheduleMissedCatchupWindow                       = NewCounterDef(
 		"schedule_missed_catchup_window",
 		WithDescription("The number of times a schedule missed an action due to the configured catchup window"),
@@ -1164,6 +1243,10 @@ var (
 		"schedule_terminate_workflow_errors",
 		WithDescription("The number of times a schedule got an error trying to terminate a previous run"),
 	)
+	ScheduleAutoPaused = NewCounterDef(
+		"schedule_auto_paused",
+		WithDescription("The number of times a schedule was paused after consecutive action failures"),
+	)
 	ScheduleActionDelay = NewTimerDef(
 		"schedule_action_delay",
 		WithDescription("Delay between when scheduled actions should/actually happen"),
@@ -1210,6 +1293,12 @@ var (
 	CassandraSessionRefreshFailures        = NewCounterDef("cassandra_session_refresh_failures")
 	PersistenceSessionRefreshFailures      = NewCounterDef("persistence_session_refresh_failures")
 	PersistenceSessionRefreshAttempts      = NewCounterDef("persistence_session_refresh_attempts")
+	PersistencePoolMaxConnections          = NewGaugeDef("persistence_pool_max_connections")
+	PersistencePoolOpenConnections         = NewGaugeDef("persistence_pool_open_connections")
+	PersistencePoolInUseConnections        = NewGaugeDef("persistence_pool_in_use_connections")
+	PersistencePoolIdleConnections         = NewGaugeDef("persistence_pool_idle_connections")
+	PersistencePoolWaits                   = NewCounterDef("persistence_pool_waits")
+	PersistencePoolWaitLatency             = NewTimerDef("persistence_pool_wait_latency")
 
 	// Common service base metrics
 	RestartCount         = NewCounterDef("restarts")
@@ -39,12 +39,13 @@ import (
 // otelMetricsHandler is an adapter around an OpenTelemetry [metric.Meter] that implements the [Handler] interface.
 type (
 	otelMetricsHandler struct {
-		l           log.Logger
-		set         attribute.Set
-		provider    OpenTelemetryProvider
-		excludeTags map[string]map[string]struct{}
-		catalog     catalog
-		gauges      *sync.Map // string -> *gaugeAdapter. note: shared between multiple otelMetricsHandlers
+		l                  log.Logger
+		set                attribute.Set
+		provider           OpenTelemetryProvider
+		excludeTags        map[string]map[string]struct{}
+		cardinalityLimiter *tagCardinalityLimiter // note: shared between multiple otelMetricsHandlers
+		catalog            catalog
+		gauges             *sync.Map // string -> *gaugeAdapter. note: shared between multiple otelMetricsHandlers
 	}
 
 	// This is to work around the lack of synchronous gauge:
@@ -85,12 +86,13 @@ func NewOtelMetricsHandler(
 		return nil, fmt.Errorf("failed to build metrics catalog: %w", err)
 	}
 	return &otelMetricsHandler{
-		l:           l,
-		set:         makeInitialSet(cfg.Tags),
-		provider:    o,
-		excludeTags: configExcludeTags(cfg),
-		catalog:     c,
-		gauges:      new(sync.Map),
+		l:                  l,
+		set:                makeInitialSet(cfg.Tags),
+		provider:           o,
+		excludeTags:        configExcludeTags(cfg),
+		cardinalityLimiter: newTagCardinalityLimiter(cfg.PerNamespaceTagCardinalityLimits),
+		catalog:            c,
+		gauges:             new(sync.Map),
 	}, nil
 }
 
@@ -222,19 +224,21 @@ func (omp *otelMetricsHandler) makeSet(tags []Tag) attribute.Set {
 	for i := omp.set.Iter(); i.Next(); {
 		attrs = append(attrs, i.Attribute())
 	}
+	defaultNamespace, _ := omp.set.Value(namespace)
+	ns := namespaceOf(tags, defaultNamespace.AsString())
 	for _, t := range tags {
-		attrs = append(attrs, omp.convertTag(t))
+		attrs = append(attrs, omp.convertTag(ns, t))
 	}
 	return attribute.NewSet(attrs...)
 }
 
-func (omp *otelMetricsHandler) convertTag(tag Tag) attribute.KeyValue {
+func (omp *otelMetricsHandler) convertTag(ns string, tag Tag) attribute.KeyValue {
 	if vals, ok := omp.excludeTags[tag.Key()]; ok {
 		if _, ok := vals[tag.Value()]; !ok {
 			return attribute.String(tag.Key(), tagExcludedValue)
 		}
 	}
-	return attribute.String(tag.Key(), tag.Value())
+	return attribute.String(tag.Key(), omp.cardinalityLimiter.limit(ns, tag.Key(), tag.Value()))
 }
 
 func makeInitialSet(tags map[string]string) attribute.Set {
@@ -0,0 +1,103 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metrics
+
+import (
+	"sync"
+)
+
+const tagOverflowValue = "_other_"
+
+type (
+	// tagCardinalityLimiter bounds, per namespace, the number of distinct values reported for a tag. Values first
+	// seen after the budget of a namespace is used up are rolled up into tagOverflowValue, so that a single namespace
+	// with e.g. thousands of workflow types cannot blow up the number of series.
+	//
+	// A limiter is shared by all handlers derived from the same root handler.
+	tagCardinalityLimiter struct {
+		limits map[string]int
+
+		sync.RWMutex
+		admitted map[tagCardinalityKey]map[string]struct{}
+	}
+
+	tagCardinalityKey struct {
+		namespace string
+		tag       string
+	}
+)
+
+func newTagCardinalityLimiter(limits map[string]int) *tagCardinalityLimiter {
+	if len(limits) == 0 {
+		return nil
+	}
+	return &tagCardinalityLimiter{
+		limits:   limits,
+		admitted: make(map[tagCardinalityKey]map[string]struct{}),
+	}
+}
+
+// limit returns the value to report for the given tag of a metric emitted for the given namespace.
+func (l *tagCardinalityLimiter) limit(namespace string, tag string, value string) string {
+	if l == nil {
+		return value
+	}
+	limit, ok := l.limits[tag]
+	if !ok {
+		return value
+	}
+	key := tagCardinalityKey{namespace: namespace, tag: tag}
+
+	l.RLock()
+	_, admitted := l.admitted[key][value]
+	l.RUnlock()
+	if admitted {
+		return value
+	}
+
+	l.Lock()
+	defer l.Unlock()
+	values, ok := l.admitted[key]
+	if !ok {
+		values = make(map[string]struct{})
+		l.admitted[key] = values
+	}
+	if _, ok := values[value]; ok {
+		return value
+	}
+	if len(values) >= limit {
+		return tagOverflowValue
+	}
+	values[value] = struct{}{}
+	return value
+}
+
+// namespaceOf returns the value of the namespace tag in tags, or defaultNamespace if there is none.
+func namespaceOf(tags []Tag, defaultNamespace string) string {
+	for _, tag := range tags {
+		if tag.Key() == namespace {
+			return tag.Value()
+		}
+	}
+	return defaultNamespace
+}
@@ -0,0 +1,98 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metrics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally/v4"
+	sdkmetrics "go.opentelemetry.io/otel/sdk/metric"
+	"go.temporal.io/server/common/log"
+)
+
+var cardinalityLimitedConfig = ClientConfig{
+	PerNamespaceTagCardinalityLimits: map[string]int{
+		"workflowType": 2,
+	},
+}
+
+func TestTagCardinalityLimiter(t *testing.T) {
+	l := newTagCardinalityLimiter(map[string]int{"workflowType": 2})
+
+	assert.Equal(t, "a", l.limit("ns1", "workflowType", "a"))
+	assert.Equal(t, "b", l.limit("ns1", "workflowType", "b"))
+	assert.Equal(t, tagOverflowValue, l.limit("ns1", "workflowType", "c"))
+	// values admitted before the budget was used up are still reported
+	assert.Equal(t, "a", l.limit("ns1", "workflowType", "a"))
+	// budgets are per namespace
+	assert.Equal(t, "c", l.limit("ns2", "workflowType", "c"))
+	// tags without a limit are not affected
+	assert.Equal(t, "c", l.limit("ns1", "taskqueue", "c"))
+
+	noLimits := newTagCardinalityLimiter(nil)
+	assert.Nil(t, noLimits)
+	assert.Equal(t, "c", noLimits.limit("ns1", "workflowType", "c"))
+}
+
+func TestTallyScope_TagCardinalityLimits(t *testing.T) {
+	scope := tally.NewTestScope("test", map[string]string{})
+	mp := NewTallyMetricsHandler(cardinalityLimitedConfig, scope)
+
+	ns1 := mp.WithTags(NamespaceTag("ns1"))
+	for _, wt := range []string{"a", "b", "c", "d"} {
+		ns1.Counter("hits").Record(1, WorkflowTypeTag(wt))
+	}
+	mp.Counter("hits").Record(1, NamespaceTag("ns2"), WorkflowTypeTag("c"))
+
+	counters := scope.Snapshot().Counters()
+	assert.EqualValues(t, 1, counters["test.hits+namespace=ns1,workflowType=a"].Value())
+	assert.EqualValues(t, 1, counters["test.hits+namespace=ns1,workflowType=b"].Value())
+	assert.EqualValues(t, 2, counters["test.hits+namespace=ns1,workflowType=_other_"].Value())
+	assert.EqualValues(t, 1, counters["test.hits+namespace=ns2,workflowType=c"].Value())
+}
+
+func TestOtelMetricsHandler_TagCardinalityLimits(t *testing.T) {
+	provider := sdkmetrics.NewMeterProvider()
+	p, err := NewOtelMetricsHandler(
+		log.NewTestLogger(),
+		&testProvider{meter: provider.Meter("test")},
+		cardinalityLimitedConfig,
+	)
+	require.NoError(t, err)
+
+	ns1 := p.WithTags(NamespaceTag("ns1")).(*otelMetricsHandler)
+	var reported []string
+	for _, wt := range []string{"a", "b", "c"} {
+		set := ns1.makeSet([]Tag{WorkflowTypeTag(wt)})
+		v, ok := set.Value(workflowType)
+		require.True(t, ok)
+		reported = append(reported, v.AsString())
+	}
+	assert.Equal(t, []string{"a", "b", tagOverflowValue}, reported)
+
+	set := p.makeSet([]Tag{NamespaceTag("ns2"), WorkflowTypeTag("c")})
+	v, _ := set.Value(workflowType)
+	assert.Equal(t, "c", v.AsString())
+}
@@ -63,6 +63,8 @@ const (
 	reason = "reason"
 	// See server.api.enums.v1.ReplicationTaskType
 	replicationTaskType = "replicationTaskType"
+	// See ndc.ConflictResolutionPolicy
+	conflictResolutionPolicy = "conflict_resolution_policy"
 
 	namespaceAllValue = "all"
 	unknownValue      = "_unknown_"
@@ -303,6 +305,14 @@ func VisibilityIndexNameTag(value string) Tag {
 	return &tagImpl{key: visibilityIndexNameTagName, value: value}
 }
 
+// PersistenceStoreTag identifies a data store, e.g. the visibility store of a mysql8 database.
+func PersistenceStoreTag(value string) Tag {
+	if value == "" {
+		value = unknownValue
+	}
+	return &tagImpl{key: persistenceStoreTagName, value: value}
+}
+
 // VersionedTag represents whether a loaded task queue manager represents a specific version set or build ID or not.
 func VersionedTag(versioned string) Tag {
 	return &tagImpl{key: versionedTagName, value: versioned}
@@ -327,6 +337,27 @@ func NexusEndpointTag(value string) Tag {
 	return &tagImpl{key: nexusEndpointTagName, value: value}
 }
 
+// NexusPayloadTypeTag returns a new tag identifying the kind of Nexus operation payload, e.g. "input" or "result".
+func NexusPayloadTypeTag(value string) Tag {
+	return &tagImpl{key: nexusPayloadTypeTagName, value: value}
+}
+
+// NexusServiceTag returns a new tag identifying a Nexus service by name.
+func NexusServiceTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return &tagImpl{key: nexusServiceTagName, value: value}
+}
+
+// NexusOperationTag returns a new tag identifying a Nexus operation by name.
+func NexusOperationTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return &tagImpl{key: nexusOperationTagName, value: value}
+}
+
 // HttpStatusTag returns a new httpStatusTag.
 func HttpStatusTag(value int) Tag {
 	return &tagImpl{key: httpStatusTagName, value: strconv.Itoa(value)}
@@ -379,6 +410,11 @@ func ReplicationTaskTypeTag(value enumsspb.ReplicationTaskType) Tag {
 	return &tagImpl{key: replicationTaskType, value: value.String()}
 }
 
+// ConflictResolutionPolicyTag returns a new replication conflict resolution policy tag.
+func ConflictResolutionPolicyTag(value string) Tag {
+	return &tagImpl{key: conflictResolutionPolicy, value: value}
+}
+
 // DestinationTag is a tag for metrics emitted by outbound task executors for the task's destination.
 func DestinationTag(value string) Tag {
 	return &tagImpl{
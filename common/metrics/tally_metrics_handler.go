@@ -42,9 +42,12 @@ type (
 	excludeTags map[string]map[string]struct{}
 
 	tallyMetricsHandler struct {
-		scope          tally.Scope
-		perUnitBuckets map[MetricUnit]tally.Buckets
-		excludeTags    excludeTags
+		scope              tally.Scope
+		perUnitBuckets     map[MetricUnit]tally.Buckets
+		excludeTags        excludeTags
+		cardinalityLimiter *tagCardinalityLimiter
+		// namespace is the value of the namespace tag of scope, used for tag cardinality limits.
+		namespace string
 	}
 )
 
@@ -58,9 +61,10 @@ func NewTallyMetricsHandler(cfg ClientConfig, scope tally.Scope) *tallyMetricsHa
 	}
 
 	return &tallyMetricsHandler{
-		scope:          scope,
-		perUnitBuckets: perUnitBuckets,
-		excludeTags:    configExcludeTags(cfg),
+		scope:              scope,
+		perUnitBuckets:     perUnitBuckets,
+		excludeTags:        configExcludeTags(cfg),
+		cardinalityLimiter: newTagCardinalityLimiter(cfg.PerNamespaceTagCardinalityLimits),
 	}
 }
 
@@ -68,9 +72,11 @@ func NewTallyMetricsHandler(cfg ClientConfig, scope tally.Scope) *tallyMetricsHa
 // Tags are merged with registered Tags from the source MetricsHandler
 func (tmh *tallyMetricsHandler) WithTags(tags ...Tag) Handler {
 	return &tallyMetricsHandler{
-		scope:          tmh.scope.Tagged(tagsToMap(tags, tmh.excludeTags)),
-		perUnitBuckets: tmh.perUnitBuckets,
-		excludeTags:    tmh.excludeTags,
+		scope:              tmh.scope.Tagged(tmh.tagsToMap(tags)),
+		perUnitBuckets:     tmh.perUnitBuckets,
+		excludeTags:        tmh.excludeTags,
+		cardinalityLimiter: tmh.cardinalityLimiter,
+		namespace:          namespaceOf(tags, tmh.namespace),
 	}
 }
 
@@ -79,7 +85,7 @@ func (tmh *tallyMetricsHandler) Counter(counter string) CounterIface {
 	return CounterFunc(func(i int64, t ...Tag) {
 		scope := tmh.scope
 		if len(t) > 0 {
-			scope = tmh.scope.Tagged(tagsToMap(t, tmh.excludeTags))
+			scope = tmh.scope.Tagged(tmh.tagsToMap(t))
 		}
 		scope.Counter(counter).Inc(i)
 	})
@@ -90,7 +96,7 @@ func (tmh *tallyMetricsHandler) Gauge(gauge string) GaugeIface {
 	return GaugeFunc(func(f float64, t ...Tag) {
 		scope := tmh.scope
 		if len(t) > 0 {
-			scope = tmh.scope.Tagged(tagsToMap(t, tmh.excludeTags))
+			scope = tmh.scope.Tagged(tmh.tagsToMap(t))
 		}
 		scope.Gauge(gauge).Update(f)
 	})
@@ -101,7 +107,7 @@ func (tmh *tallyMetricsHandler) Timer(timer string) TimerIface {
 	return TimerFunc(func(d time.Duration, t ...Tag) {
 		scope := tmh.scope
 		if len(t) > 0 {
-			scope = tmh.scope.Tagged(tagsToMap(t, tmh.excludeTags))
+			scope = tmh.scope.Tagged(tmh.tagsToMap(t))
 		}
 		scope.Timer(timer).Record(d)
 	})
@@ -112,7 +118,7 @@ func (tmh *tallyMetricsHandler) Histogram(histogram string, unit MetricUnit) His
 	return HistogramFunc(func(i int64, t ...Tag) {
 		scope := tmh.scope
 		if len(t) > 0 {
-			scope = tmh.scope.Tagged(tagsToMap(t, tmh.excludeTags))
+			scope = tmh.scope.Tagged(tmh.tagsToMap(t))
 		}
 		scope.Histogram(histogram, tmh.perUnitBuckets[unit]).RecordValue(float64(i))
 	})
@@ -128,22 +134,23 @@ func (tmh *tallyMetricsHandler) StartBatch(_ string) BatchHandler {
 	return tmh
 }
 
-func tagsToMap(t1 []Tag, e excludeTags) map[string]string {
+func (tmh *tallyMetricsHandler) tagsToMap(t1 []Tag) map[string]string {
 	if len(t1) == 0 {
 		return nil
 	}
 
 	m := make(map[string]string, len(t1))
+	ns := namespaceOf(t1, tmh.namespace)
 
 	convert := func(tag Tag) {
-		if vals, ok := e[tag.Key()]; ok {
+		if vals, ok := tmh.excludeTags[tag.Key()]; ok {
 			if _, ok := vals[tag.Value()]; !ok {
 				m[tag.Key()] = tagExcludedValue
 				return
 			}
 		}
 
-		m[tag.Key()] = tag.Value()
+		m[tag.Key()] = tmh.cardinalityLimiter.limit(ns, tag.Key(), tag.Value())
 	}
 
 	for i := range t1 {
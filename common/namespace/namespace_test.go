@@ -140,3 +140,16 @@ func TestNamespace_GetCustomData(t *testing.T) {
 	data2 := ns.GetCustomData("fake")
 	assert.Equal(t, "", data2)
 }
+
+func TestNamespace_PausedActivityTypes(t *testing.T) {
+	ns := base(t)
+	assert.Empty(t, ns.PausedActivityTypes())
+	assert.False(t, ns.IsActivityTypePaused("a"))
+
+	value := namespace.FormatPausedActivityTypes([]string{"b", "a", "b"})
+	assert.Equal(t, "a,b", value)
+	ns = ns.Clone(namespace.WithData(namespace.PausedActivityTypesDataKey, value))
+	assert.Equal(t, []string{"a", "b"}, ns.PausedActivityTypes())
+	assert.True(t, ns.IsActivityTypePaused("a"))
+	assert.False(t, ns.IsActivityTypePaused("c"))
+}
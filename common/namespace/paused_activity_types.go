@@ -0,0 +1,60 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespace
+
+import (
+	"slices"
+	"strings"
+)
+
+// PausedActivityTypesDataKey is the namespace data key listing, comma separated, the activity types whose dispatch
+// is paused in the namespace. Namespace data is replicated along with the namespace, so the pause applies to all
+// clusters.
+const PausedActivityTypesDataKey = "temporal.pausedActivityTypes"
+
+// PausedActivityTypes returns the activity types whose dispatch is paused in the namespace.
+func (ns *Namespace) PausedActivityTypes() []string {
+	return ParsePausedActivityTypes(ns.GetCustomData(PausedActivityTypesDataKey))
+}
+
+// IsActivityTypePaused returns true if the dispatch of activities of the given type is paused in the namespace.
+func (ns *Namespace) IsActivityTypePaused(activityType string) bool {
+	return slices.Contains(ns.PausedActivityTypes(), activityType)
+}
+
+// ParsePausedActivityTypes parses the value of the PausedActivityTypesDataKey namespace data.
+func ParsePausedActivityTypes(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
+
+// FormatPausedActivityTypes formats activity types into the value of the PausedActivityTypesDataKey namespace data.
+func FormatPausedActivityTypes(activityTypes []string) string {
+	activityTypes = slices.Clone(activityTypes)
+	slices.Sort(activityTypes)
+	return strings.Join(slices.Compact(activityTypes), ",")
+}
@@ -0,0 +1,70 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nexus
+
+import (
+	"errors"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+const (
+	// PayloadTypeInput identifies the input payload of a Nexus operation.
+	PayloadTypeInput = "input"
+	// PayloadTypeResult identifies the result payload of a successful Nexus operation.
+	PayloadTypeResult = "result"
+)
+
+// ErrPayloadSizeExceedsLimit is returned when a Nexus operation payload exceeds the configured error limit.
+var ErrPayloadSizeExceedsLimit = errors.New("payload size exceeds limit")
+
+// CheckPayloadSizeLimit records the size of a Nexus operation payload and checks it against the configured warn and
+// error limits, similar to common.CheckEventBlobSizeLimit. The metrics handler is expected to be tagged with the
+// namespace and endpoint.
+func CheckPayloadSizeLimit(
+	actualSize int,
+	warnLimit int,
+	errorLimit int,
+	payloadType string,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) error {
+	payloadTypeTag := metrics.NexusPayloadTypeTag(payloadType)
+	metrics.NexusPayloadSize.With(metricsHandler).Record(int64(actualSize), payloadTypeTag)
+	if actualSize > errorLimit {
+		metrics.NexusPayloadSizeLimitExceeded.With(metricsHandler).Record(1, payloadTypeTag)
+		logger.Error("Nexus payload size exceeds the error limit.",
+			tag.BlobSize(int64(actualSize)),
+			tag.NewStringTag("payload-type", payloadType))
+		return ErrPayloadSizeExceedsLimit
+	}
+	if actualSize <= warnLimit {
+		return nil
+	}
+	logger.Warn("Nexus payload size exceeds the warning limit.",
+		tag.BlobSize(int64(actualSize)),
+		tag.NewStringTag("payload-type", payloadType))
+	return nil
+}
@@ -0,0 +1,56 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nexus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
+)
+
+func TestCheckPayloadSizeLimit(t *testing.T) {
+	t.Parallel()
+	metricsHandler := metricstest.NewCaptureHandler()
+	logger := log.NewNoopLogger()
+
+	capture := metricsHandler.StartCapture()
+	require.NoError(t, CheckPayloadSizeLimit(10, 20, 30, PayloadTypeInput, metricsHandler, logger))
+	require.NoError(t, CheckPayloadSizeLimit(25, 20, 30, PayloadTypeInput, metricsHandler, logger))
+	snapshot := capture.Snapshot()
+	require.Len(t, snapshot[metrics.NexusPayloadSize.Name()], 2)
+	require.Equal(t, int64(25), snapshot[metrics.NexusPayloadSize.Name()][1].Value)
+	require.Equal(t, PayloadTypeInput, snapshot[metrics.NexusPayloadSize.Name()][1].Tags["payload_type"])
+	require.Empty(t, snapshot[metrics.NexusPayloadSizeLimitExceeded.Name()])
+	metricsHandler.StopCapture(capture)
+
+	capture = metricsHandler.StartCapture()
+	err := CheckPayloadSizeLimit(31, 20, 30, PayloadTypeResult, metricsHandler, logger)
+	require.ErrorIs(t, err, ErrPayloadSizeExceedsLimit)
+	snapshot = capture.Snapshot()
+	require.Len(t, snapshot[metrics.NexusPayloadSizeLimitExceeded.Name()], 1)
+	require.Equal(t, PayloadTypeResult, snapshot[metrics.NexusPayloadSizeLimitExceeded.Name()][0].Tags["payload_type"])
+	metricsHandler.StopCapture(capture)
+}
@@ -0,0 +1,126 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payload
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"maps"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+)
+
+const (
+	// CompressionMetadataKey is the metadata key the server sets on payloads it compressed before writing them to
+	// history. Such payloads are decompressed before history is returned to callers.
+	CompressionMetadataKey = "temporal-server-compression"
+	compressionGzip        = "gzip"
+)
+
+// Compress returns a copy of payloads with the data of every payload gzip compressed. Payloads which don't get smaller
+// or are compressed already are kept as is. The original payloads are not modified.
+func Compress(payloads *commonpb.Payloads) (*commonpb.Payloads, error) {
+	if payloads == nil {
+		return nil, nil
+	}
+	result := &commonpb.Payloads{Payloads: make([]*commonpb.Payload, len(payloads.GetPayloads()))}
+	for i, p := range payloads.GetPayloads() {
+		result.Payloads[i] = p
+		if IsCompressed(p) {
+			continue
+		}
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write(p.GetData()); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		if buf.Len() >= len(p.GetData()) {
+			continue
+		}
+		metadata := maps.Clone(p.GetMetadata())
+		if metadata == nil {
+			metadata = make(map[string][]byte, 1)
+		}
+		metadata[CompressionMetadataKey] = []byte(compressionGzip)
+		result.Payloads[i] = &commonpb.Payload{Metadata: metadata, Data: buf.Bytes()}
+	}
+	return result, nil
+}
+
+// Decompress restores, in place, the payloads compressed by Compress.
+func Decompress(payloads *commonpb.Payloads) error {
+	for _, p := range payloads.GetPayloads() {
+		if !IsCompressed(p) {
+			continue
+		}
+		if codec := string(p.Metadata[CompressionMetadataKey]); codec != compressionGzip {
+			return fmt.Errorf("unknown payload compression codec: %q", codec)
+		}
+		r, err := gzip.NewReader(bytes.NewReader(p.Data))
+		if err != nil {
+			return err
+		}
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		delete(p.Metadata, CompressionMetadataKey)
+		p.Data = data
+	}
+	return nil
+}
+
+// DecompressEvents restores, in place, the signal and Update inputs of events which were compressed when they were
+// written to history.
+func DecompressEvents(events ...*historypb.HistoryEvent) error {
+	for _, event := range events {
+		var payloads *commonpb.Payloads
+		switch event.GetEventType() {
+		case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED:
+			payloads = event.GetWorkflowExecutionSignaledEventAttributes().GetInput()
+		case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_ADMITTED:
+			payloads = event.GetWorkflowExecutionUpdateAdmittedEventAttributes().GetRequest().GetInput().GetArgs()
+		case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_ACCEPTED:
+			payloads = event.GetWorkflowExecutionUpdateAcceptedEventAttributes().GetAcceptedRequest().GetInput().GetArgs()
+		}
+		if err := Decompress(payloads); err != nil {
+			return fmt.Errorf("unable to decompress payloads of event %d: %w", event.GetEventId(), err)
+		}
+	}
+	return nil
+}
+
+// IsCompressed returns true if the payload was compressed by Compress.
+func IsCompressed(p *commonpb.Payload) bool {
+	_, ok := p.GetMetadata()[CompressionMetadataKey]
+	return ok
+}
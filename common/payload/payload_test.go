@@ -25,10 +25,14 @@
 package payload
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"google.golang.org/protobuf/proto"
 )
 
 type testStruct struct {
@@ -178,3 +182,46 @@ func TestIsEqual(t *testing.T) {
 	b, _ = Encode("foo")
 	s.False(isEqual(a, b))
 }
+
+func TestCompress(t *testing.T) {
+	s := assert.New(t)
+
+	large := EncodeString(strings.Repeat("a", 1024))
+	small := EncodeString("a")
+	payloads := &commonpb.Payloads{Payloads: []*commonpb.Payload{large, small}}
+
+	compressed, err := Compress(payloads)
+	s.NoError(err)
+	s.True(IsCompressed(compressed.Payloads[0]))
+	s.Less(len(compressed.Payloads[0].Data), len(large.Data))
+	s.False(IsCompressed(compressed.Payloads[1]))
+	s.False(IsCompressed(large), "original payload must not be modified")
+
+	again, err := Compress(compressed)
+	s.NoError(err)
+	s.Equal(compressed.Payloads[0].Data, again.Payloads[0].Data)
+
+	s.NoError(Decompress(compressed))
+	s.True(proto.Equal(payloads, compressed))
+}
+
+func TestDecompressEvents(t *testing.T) {
+	s := assert.New(t)
+
+	input := &commonpb.Payloads{Payloads: []*commonpb.Payload{EncodeString(strings.Repeat("a", 1024))}}
+	compressed, err := Compress(input)
+	s.NoError(err)
+	event := &historypb.HistoryEvent{
+		EventId:   5,
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+		Attributes: &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{
+			WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{Input: compressed},
+		},
+	}
+
+	s.NoError(DecompressEvents(event))
+	s.True(proto.Equal(input, event.GetWorkflowExecutionSignaledEventAttributes().GetInput()))
+
+	compressed.Payloads[0].Metadata[CompressionMetadataKey] = []byte("unknown")
+	s.ErrorContains(DecompressEvents(event), "event 5")
+}
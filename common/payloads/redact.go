@@ -0,0 +1,103 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payloads
+
+import (
+	commonpb "go.temporal.io/api/common/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// RedactedEncoding is the encoding of payloads whose data was removed by Redact.
+	RedactedEncoding = "binary/redacted"
+)
+
+var (
+	payloadFullName          = (&commonpb.Payload{}).ProtoReflect().Descriptor().FullName()
+	searchAttributesFullName = (&commonpb.SearchAttributes{}).ProtoReflect().Descriptor().FullName()
+)
+
+// Redact returns a copy of msg in which the data and metadata of every payload (inputs, results, heartbeat details,
+// memo values, ...) are removed. Search attributes are left untouched since they are already indexed in visibility.
+// msg itself is returned if it doesn't contain any payload.
+func Redact(msg proto.Message) proto.Message {
+	if msg == nil {
+		return msg
+	}
+	found := false
+	visitPayloads(msg.ProtoReflect(), func(*commonpb.Payload) bool {
+		found = true
+		return false
+	})
+	if !found {
+		return msg
+	}
+
+	redacted := proto.Clone(msg)
+	visitPayloads(redacted.ProtoReflect(), func(p *commonpb.Payload) bool {
+		p.Metadata = map[string][]byte{"encoding": []byte(RedactedEncoding)}
+		p.Data = nil
+		return true
+	})
+	return redacted
+}
+
+// visitPayloads calls fn for every payload within m until fn returns false. It returns false if it was stopped.
+func visitPayloads(m protoreflect.Message, fn func(*commonpb.Payload) bool) bool {
+	switch m.Descriptor().FullName() {
+	case payloadFullName:
+		if p, ok := m.Interface().(*commonpb.Payload); ok {
+			return fn(p)
+		}
+		return true
+	case searchAttributesFullName:
+		return true
+	}
+
+	ok := true
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Message() == nil {
+				return true
+			}
+			v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+				ok = visitPayloads(mv.Message(), fn)
+				return ok
+			})
+		case fd.IsList():
+			if fd.Message() == nil {
+				return true
+			}
+			list := v.List()
+			for i := 0; i < list.Len() && ok; i++ {
+				ok = visitPayloads(list.Get(i).Message(), fn)
+			}
+		case fd.Message() != nil:
+			ok = visitPayloads(v.Message(), fn)
+		}
+		return ok
+	})
+	return ok
+}
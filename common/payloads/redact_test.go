@@ -0,0 +1,69 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payloads
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
+)
+
+func TestRedact(t *testing.T) {
+	assert := assert.New(t)
+
+	memo, err := Encode("memo")
+	assert.NoError(err)
+	searchAttribute, err := Encode("keyword")
+	assert.NoError(err)
+	attributes := &historypb.WorkflowExecutionStartedEventAttributes{
+		Input: EncodeString("input"),
+		Memo: &commonpb.Memo{
+			Fields: map[string]*commonpb.Payload{"key": memo.Payloads[0]},
+		},
+		SearchAttributes: &commonpb.SearchAttributes{
+			IndexedFields: map[string]*commonpb.Payload{"key": searchAttribute.Payloads[0]},
+		},
+	}
+
+	redacted, ok := Redact(attributes).(*historypb.WorkflowExecutionStartedEventAttributes)
+	assert.True(ok)
+	for _, p := range []*commonpb.Payload{redacted.Input.Payloads[0], redacted.Memo.Fields["key"]} {
+		assert.Nil(p.Data)
+		assert.Equal(map[string][]byte{"encoding": []byte(RedactedEncoding)}, p.Metadata)
+	}
+	assert.Equal(`["keyword"]`, ToString(&commonpb.Payloads{Payloads: []*commonpb.Payload{redacted.SearchAttributes.IndexedFields["key"]}}))
+
+	// The original message is left untouched.
+	assert.Equal(`["input"]`, ToString(attributes.Input))
+	assert.Equal(`["memo"]`, ToString(&commonpb.Payloads{Payloads: []*commonpb.Payload{attributes.Memo.Fields["key"]}}))
+}
+
+func TestRedact_NoPayloads(t *testing.T) {
+	attributes := &historypb.WorkflowExecutionStartedEventAttributes{
+		Identity: "identity",
+	}
+	assert.Same(t, attributes, Redact(attributes))
+	assert.Nil(t, Redact(nil))
+}
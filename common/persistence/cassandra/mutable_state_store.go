@@ -27,6 +27,7 @@ package cassandra
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	commonpb "go.temporal.io/api/common/v1"
@@ -79,8 +80,8 @@ const (
 		`visibility_ts, task_id, checksum, checksum_encoding) ` +
 		`VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) IF NOT EXISTS `
 
-	templateGetWorkflowExecutionQuery = `SELECT execution, execution_encoding, execution_state, execution_state_encoding, next_event_id, activity_map, activity_map_encoding, timer_map, timer_map_encoding, ` +
-		`child_executions_map, child_executions_map_encoding, request_cancel_map, request_cancel_map_encoding, signal_map, signal_map_encoding, signal_requested, buffered_events_list, ` +
+	// templateGetWorkflowExecutionQuery is formatted with the columns of the selected collections, see getWorkflowExecutionQuery
+	templateGetWorkflowExecutionQuery = `SELECT execution, execution_encoding, execution_state, execution_state_encoding, next_event_id, %s` +
 		`checksum, checksum_encoding, db_record_version ` +
 		`FROM executions ` +
 		`WHERE shard_id = ? ` +
@@ -361,6 +362,20 @@ type (
 	}
 )
 
+// workflowExecutionCollectionColumns are the columns of the executions table holding each mutable state collection
+var workflowExecutionCollectionColumns = []struct {
+	collection p.MutableStateCollections
+	columns    string
+}{
+	{p.MutableStateActivityInfos, "activity_map, activity_map_encoding, "},
+	{p.MutableStateTimerInfos, "timer_map, timer_map_encoding, "},
+	{p.MutableStateChildExecutionInfos, "child_executions_map, child_executions_map_encoding, "},
+	{p.MutableStateRequestCancelInfos, "request_cancel_map, request_cancel_map_encoding, "},
+	{p.MutableStateSignalInfos, "signal_map, signal_map_encoding, "},
+	{p.MutableStateSignalRequestedIDs, "signal_requested, "},
+	{p.MutableStateBufferedEvents, "buffered_events_list, "},
+}
+
 func NewMutableStateStore(session gocql.Session) *MutableStateStore {
 	return &MutableStateStore{
 		Session: session,
@@ -481,7 +496,7 @@ func (d *MutableStateStore) GetWorkflowExecution(
 	ctx context.Context,
 	request *p.GetWorkflowExecutionRequest,
 ) (*p.InternalGetWorkflowExecutionResponse, error) {
-	query := d.Session.Query(templateGetWorkflowExecutionQuery,
+	query := d.Session.Query(getWorkflowExecutionQuery(request.OmitCollections),
 		request.ShardID,
 		rowTypeExecution,
 		request.NamespaceID,
@@ -501,54 +516,69 @@ func (d *MutableStateStore) GetWorkflowExecution(
 		return nil, serviceerror.NewUnavailable(fmt.Sprintf("GetWorkflowExecution operation failed. Error: %v", err))
 	}
 
-	activityInfos := make(map[int64]*commonpb.DataBlob)
-	aMap := result["activity_map"].(map[int64][]byte)
-	aMapEncoding := result["activity_map_encoding"].(string)
-	for key, value := range aMap {
-		activityInfos[key] = p.NewDataBlob(value, aMapEncoding)
+	if !request.OmitCollections.Contains(p.MutableStateActivityInfos) {
+		activityInfos := make(map[int64]*commonpb.DataBlob)
+		aMap := result["activity_map"].(map[int64][]byte)
+		aMapEncoding := result["activity_map_encoding"].(string)
+		for key, value := range aMap {
+			activityInfos[key] = p.NewDataBlob(value, aMapEncoding)
+		}
+		state.ActivityInfos = activityInfos
 	}
-	state.ActivityInfos = activityInfos
 
-	timerInfos := make(map[string]*commonpb.DataBlob)
-	tMapEncoding := result["timer_map_encoding"].(string)
-	tMap := result["timer_map"].(map[string][]byte)
-	for key, value := range tMap {
-		timerInfos[key] = p.NewDataBlob(value, tMapEncoding)
+	if !request.OmitCollections.Contains(p.MutableStateTimerInfos) {
+		timerInfos := make(map[string]*commonpb.DataBlob)
+		tMapEncoding := result["timer_map_encoding"].(string)
+		tMap := result["timer_map"].(map[string][]byte)
+		for key, value := range tMap {
+			timerInfos[key] = p.NewDataBlob(value, tMapEncoding)
+		}
+		state.TimerInfos = timerInfos
 	}
-	state.TimerInfos = timerInfos
 
-	childExecutionInfos := make(map[int64]*commonpb.DataBlob)
-	cMap := result["child_executions_map"].(map[int64][]byte)
-	cMapEncoding := result["child_executions_map_encoding"].(string)
-	for key, value := range cMap {
-		childExecutionInfos[key] = p.NewDataBlob(value, cMapEncoding)
+	if !request.OmitCollections.Contains(p.MutableStateChildExecutionInfos) {
+		childExecutionInfos := make(map[int64]*commonpb.DataBlob)
+		cMap := result["child_executions_map"].(map[int64][]byte)
+		cMapEncoding := result["child_executions_map_encoding"].(string)
+		for key, value := range cMap {
+			childExecutionInfos[key] = p.NewDataBlob(value, cMapEncoding)
+		}
+		state.ChildExecutionInfos = childExecutionInfos
 	}
-	state.ChildExecutionInfos = childExecutionInfos
 
-	requestCancelInfos := make(map[int64]*commonpb.DataBlob)
-	rMapEncoding := result["request_cancel_map_encoding"].(string)
-	rMap := result["request_cancel_map"].(map[int64][]byte)
-	for key, value := range rMap {
-		requestCancelInfos[key] = p.NewDataBlob(value, rMapEncoding)
+	if !request.OmitCollections.Contains(p.MutableStateRequestCancelInfos) {
+		requestCancelInfos := make(map[int64]*commonpb.DataBlob)
+		rMapEncoding := result["request_cancel_map_encoding"].(string)
+		rMap := result["request_cancel_map"].(map[int64][]byte)
+		for key, value := range rMap {
+			requestCancelInfos[key] = p.NewDataBlob(value, rMapEncoding)
+		}
+		state.RequestCancelInfos = requestCancelInfos
 	}
-	state.RequestCancelInfos = requestCancelInfos
 
-	signalInfos := make(map[int64]*commonpb.DataBlob)
-	sMapEncoding := result["signal_map_encoding"].(string)
-	sMap := result["signal_map"].(map[int64][]byte)
-	for key, value := range sMap {
-		signalInfos[key] = p.NewDataBlob(value, sMapEncoding)
+	if !request.OmitCollections.Contains(p.MutableStateSignalInfos) {
+		signalInfos := make(map[int64]*commonpb.DataBlob)
+		sMapEncoding := result["signal_map_encoding"].(string)
+		sMap := result["signal_map"].(map[int64][]byte)
+		for key, value := range sMap {
+			signalInfos[key] = p.NewDataBlob(value, sMapEncoding)
+		}
+		state.SignalInfos = signalInfos
 	}
-	state.SignalInfos = signalInfos
-	state.SignalRequestedIDs = gocql.UUIDsToStringSlice(result["signal_requested"])
-
-	eList := result["buffered_events_list"].([]map[string]interface{})
-	bufferedEventsBlobs := make([]*commonpb.DataBlob, 0, len(eList))
-	for _, v := range eList {
-		blob := createHistoryEventBatchBlob(v)
-		bufferedEventsBlobs = append(bufferedEventsBlobs, blob)
+
+	if !request.OmitCollections.Contains(p.MutableStateSignalRequestedIDs) {
+		state.SignalRequestedIDs = gocql.UUIDsToStringSlice(result["signal_requested"])
+	}
+
+	if !request.OmitCollections.Contains(p.MutableStateBufferedEvents) {
+		eList := result["buffered_events_list"].([]map[string]interface{})
+		bufferedEventsBlobs := make([]*commonpb.DataBlob, 0, len(eList))
+		for _, v := range eList {
+			blob := createHistoryEventBatchBlob(v)
+			bufferedEventsBlobs = append(bufferedEventsBlobs, blob)
+		}
+		state.BufferedEvents = bufferedEventsBlobs
 	}
-	state.BufferedEvents = bufferedEventsBlobs
 
 	state.Checksum = p.NewDataBlob(result["checksum"].([]byte), result["checksum_encoding"].(string))
 
@@ -565,6 +595,17 @@ func (d *MutableStateStore) GetWorkflowExecution(
 	}, nil
 }
 
+// getWorkflowExecutionQuery returns the query selecting the execution row with all but the omitted collections.
+func getWorkflowExecutionQuery(omit p.MutableStateCollections) string {
+	var columns strings.Builder
+	for _, c := range workflowExecutionCollectionColumns {
+		if !omit.Contains(c.collection) {
+			columns.WriteString(c.columns)
+		}
+	}
+	return fmt.Sprintf(templateGetWorkflowExecutionQuery, columns.String())
+}
+
 func (d *MutableStateStore) UpdateWorkflowExecution(
 	ctx context.Context,
 	request *p.InternalUpdateWorkflowExecutionRequest,
@@ -0,0 +1,50 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cassandra
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	p "go.temporal.io/server/common/persistence"
+)
+
+func TestGetWorkflowExecutionQuery(t *testing.T) {
+	query := getWorkflowExecutionQuery(0)
+	for _, c := range workflowExecutionCollectionColumns {
+		require.Contains(t, query, c.columns)
+	}
+	require.Contains(t, query, "buffered_events_list, checksum, checksum_encoding, db_record_version FROM executions")
+
+	query = getWorkflowExecutionQuery(p.MutableStateActivityInfos | p.MutableStateSignalRequestedIDs)
+	require.NotContains(t, query, "activity_map")
+	require.NotContains(t, query, "signal_requested")
+	require.Contains(t, query, "signal_map, signal_map_encoding, ")
+
+	query = getWorkflowExecutionQuery(p.MutableStateAllCollections)
+	require.True(t, strings.HasPrefix(query,
+		"SELECT execution, execution_encoding, execution_state, execution_state_encoding, next_event_id, checksum, "))
+}
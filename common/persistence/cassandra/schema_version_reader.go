@@ -28,10 +28,12 @@ import (
 	"fmt"
 
 	"go.temporal.io/server/common/persistence/nosql/nosqlplugin/cassandra/gocql"
+	"go.temporal.io/server/common/persistence/schema"
 )
 
 const (
 	readSchemaVersionCQL = `SELECT curr_version from schema_version where keyspace_name=?`
+	readTableColumnsCQL  = `SELECT table_name, column_name from system_schema.columns where keyspace_name=?`
 )
 
 type (
@@ -63,3 +65,17 @@ func (svr *SchemaVersionReader) ReadSchemaVersion(keyspace string) (string, erro
 
 	return version, nil
 }
+
+// ReadTableShape returns the columns of each table in the Keyspace
+func (svr *SchemaVersionReader) ReadTableShape(keyspace string) (schema.TableShape, error) {
+	iter := svr.session.Query(readTableColumnsCQL, keyspace).Iter()
+	shape := make(schema.TableShape)
+	var table, column string
+	for iter.Scan(&table, &column) {
+		shape.Add(table, column)
+	}
+	if err := iter.Close(); err != nil {
+		return nil, fmt.Errorf("unable to get table columns from Cassandra: %w", err)
+	}
+	return shape, nil
+}
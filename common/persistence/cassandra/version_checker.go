@@ -35,6 +35,11 @@ import (
 	cassandraschema "go.temporal.io/server/schema/cassandra"
 )
 
+const (
+	// expectedSchemaFile is the embedded schema file of the expected keyspace version
+	expectedSchemaFile = "cassandra/temporal/schema.cql"
+)
+
 // VerifyCompatibleVersion ensures that the installed version of temporal and visibility keyspaces
 // is greater than or equal to the expected version.
 // In most cases, the versions should match. However if after a schema upgrade there is a code
@@ -83,5 +88,5 @@ func CheckCompatibleVersion(
 
 	schemaVersionReader := NewSchemaVersionReader(session)
 
-	return schema.VerifyCompatibleVersion(schemaVersionReader, cfg.Keyspace, expectedVersion)
+	return schema.VerifyCompatibleSchema(schemaVersionReader, cfg.Keyspace, expectedVersion, expectedSchemaFile)
 }
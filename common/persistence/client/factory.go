@@ -34,6 +34,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/payloadencryption"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/quotas"
 )
@@ -80,6 +81,7 @@ type (
 		namespaceRateLimiter quotas.RequestRateLimiter
 		shardRateLimiter     quotas.RequestRateLimiter
 		healthSignals        persistence.HealthSignalAggregator
+		payloadKeyProvider   payloadencryption.KeyProvider
 	}
 )
 
@@ -102,6 +104,7 @@ func NewFactory(
 	metricsHandler metrics.Handler,
 	logger log.Logger,
 	healthSignals persistence.HealthSignalAggregator,
+	payloadKeyProvider payloadencryption.KeyProvider,
 ) Factory {
 	factory := &factoryImpl{
 		dataStoreFactory:     dataStoreFactory,
@@ -115,6 +118,7 @@ func NewFactory(
 		namespaceRateLimiter: namespaceRateLimiter,
 		shardRateLimiter:     shardRateLimiter,
 		healthSignals:        healthSignals,
+		payloadKeyProvider:   payloadKeyProvider,
 	}
 	factory.initDependencies()
 	return factory
@@ -200,6 +204,9 @@ func (f *factoryImpl) NewExecutionManager() (persistence.ExecutionManager, error
 	}
 
 	result := persistence.NewExecutionManager(store, f.serializer, f.eventBlobCache, f.logger, f.config.TransactionSizeLimit)
+	if f.payloadKeyProvider != nil {
+		result = payloadencryption.NewExecutionManager(result, f.payloadKeyProvider)
+	}
 	if f.systemRateLimiter != nil && f.namespaceRateLimiter != nil {
 		result = persistence.NewExecutionPersistenceRateLimitedClient(result, f.systemRateLimiter, f.namespaceRateLimiter, f.shardRateLimiter, f.logger)
 	}
@@ -35,6 +35,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/cassandra"
 	"go.temporal.io/server/common/persistence/faultinjection"
+	"go.temporal.io/server/common/persistence/payloadencryption"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/sql"
 	"go.temporal.io/server/common/primitives"
@@ -71,6 +72,7 @@ type (
 		Logger                             log.Logger
 		HealthSignals                      persistence.HealthSignalAggregator
 		DynamicRateLimitingParams          DynamicRateLimitingParams
+		PayloadKeyProvider                 payloadencryption.KeyProvider `optional:"true"`
 	}
 
 	FactoryProviderFn func(NewFactoryParams) Factory
@@ -152,6 +154,7 @@ func FactoryProvider(
 		params.MetricsHandler,
 		params.Logger,
 		params.HealthSignals,
+		params.PayloadKeyProvider,
 	)
 }
 
@@ -182,6 +185,7 @@ func DataStoreFactoryProvider(
 	abstractDataStoreFactory AbstractDataStoreFactory,
 	logger log.Logger,
 	metricsHandler metrics.Handler,
+	dc *dynamicconfig.Collection,
 ) persistence.DataStoreFactory {
 
 	var dataStoreFactory persistence.DataStoreFactory
@@ -190,7 +194,11 @@ func DataStoreFactoryProvider(
 	case defaultStoreCfg.Cassandra != nil:
 		dataStoreFactory = cassandra.NewFactory(*defaultStoreCfg.Cassandra, r, string(clusterName), logger, metricsHandler)
 	case defaultStoreCfg.SQL != nil:
-		dataStoreFactory = sql.NewFactory(*defaultStoreCfg.SQL, r, string(clusterName), logger, metricsHandler)
+		sqlFactory := sql.NewFactory(*defaultStoreCfg.SQL, r, string(clusterName), logger, metricsHandler)
+		if dc != nil {
+			sqlFactory = sqlFactory.WithPoolConfig(sql.NewPoolConfig(dc))
+		}
+		dataStoreFactory = sqlFactory
 	case defaultStoreCfg.CustomDataStoreConfig != nil:
 		dataStoreFactory = abstractDataStoreFactory.NewFactory(*defaultStoreCfg.CustomDataStoreConfig, r, string(clusterName), logger, metricsHandler)
 	default:
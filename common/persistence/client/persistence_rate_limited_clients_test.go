@@ -189,6 +189,7 @@ func TestRateLimitedPersistenceClients(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 			)
 			shardManager, _ := factory.NewShardManager()
 			executionManager, _ := factory.NewExecutionManager()
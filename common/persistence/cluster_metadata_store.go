@@ -193,7 +193,7 @@ func (m *clusterMetadataManagerImpl) SaveClusterMetadata(
 	if err != nil {
 		return false, err
 	}
-	if immutableFieldsChanged(oldClusterMetadata.ClusterMetadata, request.ClusterMetadata) {
+	if immutableFieldsChanged(oldClusterMetadata.ClusterMetadata, request) {
 		return false, nil
 	}
 
@@ -230,15 +230,18 @@ func (m *clusterMetadataManagerImpl) convertInternalGetClusterMetadataResponse(
 }
 
 // immutableFieldsChanged returns true if any of immutable fields changed.
-func immutableFieldsChanged(old *persistencespb.ClusterMetadata, cur *persistencespb.ClusterMetadata) bool {
+func immutableFieldsChanged(old *persistencespb.ClusterMetadata, request *SaveClusterMetadataRequest) bool {
+	cur := request.ClusterMetadata
+	shardCountChanged := old.HistoryShardCount != cur.HistoryShardCount &&
+		!(request.AllowHistoryShardCountDoubling && cur.HistoryShardCount == 2*old.HistoryShardCount)
 	if (old.ClusterName != "" && old.ClusterName != cur.ClusterName) ||
 		(old.ClusterId != "" && old.ClusterId != cur.ClusterId) ||
-		(old.HistoryShardCount != 0 && old.HistoryShardCount != cur.HistoryShardCount) ||
+		(old.HistoryShardCount != 0 && shardCountChanged) ||
 		(old.IsGlobalNamespaceEnabled && !cur.IsGlobalNamespaceEnabled) {
 		return true
 	}
 	if old.IsGlobalNamespaceEnabled {
-		if (old.FailoverVersionIncrement != 0 && old.FailoverVersionIncrement != cur.FailoverVersionIncrement) ||
+		if (old.FailoverVersionIncrement != 0 && old.FailoverVersionIncrement != cur.FailoverVersionIncrement && !request.AllowFailoverVersionIncrementChange) ||
 			(old.InitialFailoverVersion != 0 && old.InitialFailoverVersion != cur.InitialFailoverVersion) {
 			return true
 		}
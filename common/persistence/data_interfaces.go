@@ -97,6 +97,30 @@ const (
 	ConflictResolveWorkflowModeBypassCurrent
 )
 
+// MutableStateCollections is a set of the collections of a mutable state which are stored apart from its
+// execution info, so that they can be left out when reading executions which don't need them
+type MutableStateCollections int
+
+// Mutable State Collections
+const (
+	MutableStateActivityInfos MutableStateCollections = 1 << iota
+	MutableStateTimerInfos
+	MutableStateChildExecutionInfos
+	MutableStateRequestCancelInfos
+	MutableStateSignalInfos
+	MutableStateSignalRequestedIDs
+	MutableStateBufferedEvents
+
+	// MutableStateAllCollections is every collection, i.e. everything but the execution info and state
+	MutableStateAllCollections = MutableStateActivityInfos | MutableStateTimerInfos | MutableStateChildExecutionInfos |
+		MutableStateRequestCancelInfos | MutableStateSignalInfos | MutableStateSignalRequestedIDs | MutableStateBufferedEvents
+)
+
+// Contains reports whether all collections of other are in c
+func (c MutableStateCollections) Contains(other MutableStateCollections) bool {
+	return c&other == other
+}
+
 // UnknownNumRowsAffected is returned when the number of rows that an API affected cannot be determined
 const UnknownNumRowsAffected = -1
 
@@ -292,6 +316,9 @@ type (
 		NamespaceID string
 		WorkflowID  string
 		RunID       string
+		// OmitCollections are left empty in the returned state and not read from the database.
+		// A mutable state built from a partial state must not update nor persist the omitted collections.
+		OmitCollections MutableStateCollections
 	}
 
 	// GetWorkflowExecutionResponse is the response to GetWorkflowExecutionRequest
@@ -299,6 +326,8 @@ type (
 		State             *persistencespb.WorkflowMutableState
 		DBRecordVersion   int64
 		MutableStateStats MutableStateStatistics
+		// OmittedCollections are the collections which were not loaded, see GetWorkflowExecutionRequest
+		OmittedCollections MutableStateCollections
 	}
 
 	// SetWorkflowExecutionRequest is used to overwrite the info of a workflow execution
@@ -970,6 +999,12 @@ type (
 	SaveClusterMetadataRequest struct {
 		*persistencespb.ClusterMetadata
 		Version int64
+		// AllowFailoverVersionIncrementChange allows changing the failover version increment of
+		// a cluster with global namespaces enabled, which is otherwise immutable.
+		AllowFailoverVersionIncrementChange bool
+		// AllowHistoryShardCountDoubling allows doubling the history shard count, which is
+		// otherwise immutable, after the executions were moved to their new shards.
+		AllowHistoryShardCountDoubling bool
 	}
 
 	// DeleteClusterMetadataRequest is the request to DeleteClusterMetadata
@@ -1022,12 +1057,16 @@ type (
 
 	GetNexusEndpointRequest struct {
 		ID string
+		// IncludeTombstones returns the entries of deleted endpoints, which have no spec, instead of NotFound.
+		IncludeTombstones bool
 	}
 
 	ListNexusEndpointsRequest struct {
 		LastKnownTableVersion int64
 		NextPageToken         []byte
 		PageSize              int
+		// IncludeTombstones returns the entries of deleted endpoints, which have no spec, instead of skipping them.
+		IncludeTombstones bool
 	}
 
 	ListNexusEndpointsResponse struct {
@@ -418,9 +418,10 @@ func (m *executionManagerImpl) GetWorkflowExecution(
 	}
 
 	newResponse := &GetWorkflowExecutionResponse{
-		State:             state,
-		DBRecordVersion:   response.DBRecordVersion,
-		MutableStateStats: *statusOfInternalWorkflow(response.State, state, nil),
+		State:              state,
+		DBRecordVersion:    response.DBRecordVersion,
+		MutableStateStats:  *statusOfInternalWorkflow(response.State, state, nil),
+		OmittedCollections: request.OmitCollections,
 	}
 	return newResponse, respErr
 }
@@ -32,13 +32,48 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 )
 
+// HistoryPageSizer bounds the memory used to read a history branch page by page. It adjusts the number of batches
+// read per page to the average size of the batches read so far, so that a page holds about maxPageBytes of history.
+type HistoryPageSizer struct {
+	maxPageSize  int
+	maxPageBytes int
+	batchesRead  int
+	bytesRead    int
+}
+
+// NewHistoryPageSizer creates a HistoryPageSizer reading at most maxPageSize batches per page. A maxPageBytes <= 0
+// means pages are not bounded by size.
+func NewHistoryPageSizer(maxPageSize int, maxPageBytes int) *HistoryPageSizer {
+	return &HistoryPageSizer{
+		maxPageSize:  maxPageSize,
+		maxPageBytes: maxPageBytes,
+	}
+}
+
+// PageSize returns the number of batches to read for the next page.
+func (s *HistoryPageSizer) PageSize() int {
+	if s.maxPageBytes <= 0 || s.bytesRead == 0 {
+		return s.maxPageSize
+	}
+	pageSize := int(int64(s.maxPageBytes) * int64(s.batchesRead) / int64(s.bytesRead))
+	return max(1, min(pageSize, s.maxPageSize))
+}
+
+// Record records a page of the given number of batches and bytes.
+func (s *HistoryPageSizer) Record(batches int, bytes int) {
+	s.batchesRead += batches
+	s.bytesRead += bytes
+}
+
 // ReadFullPageEvents reads a full page of history events from ExecutionManager. Due to storage format of V2 History
-// it is not guaranteed that pageSize amount of data is returned. Function returns the list of history events, the size
-// of data read, the next page token, and an error if present.
+// it is not guaranteed that pageSize amount of data is returned. Reading stops early once maxPageBytes of history
+// were read, a maxPageBytes <= 0 means no limit. Function returns the list of history events, the size of data read,
+// the next page token, and an error if present.
 func ReadFullPageEvents(
 	ctx context.Context,
 	executionMgr ExecutionManager,
 	req *ReadHistoryBranchRequest,
+	maxPageBytes int,
 ) ([]*historypb.HistoryEvent, int, []byte, error) {
 	var historyEvents []*historypb.HistoryEvent
 	size := 0
@@ -49,7 +84,7 @@ func ReadFullPageEvents(
 		}
 		historyEvents = append(historyEvents, response.HistoryEvents...)
 		size += response.Size
-		if len(historyEvents) >= req.PageSize || len(response.NextPageToken) == 0 {
+		if len(historyEvents) >= req.PageSize || len(response.NextPageToken) == 0 || (maxPageBytes > 0 && size >= maxPageBytes) {
 			return historyEvents, size, response.NextPageToken, nil
 		}
 		req.NextPageToken = response.NextPageToken
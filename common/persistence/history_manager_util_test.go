@@ -0,0 +1,52 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistoryPageSizer(t *testing.T) {
+	sizer := NewHistoryPageSizer(100, 1000)
+	require.Equal(t, 100, sizer.PageSize())
+
+	// small batches don't shrink pages
+	sizer.Record(100, 500)
+	require.Equal(t, 100, sizer.PageSize())
+
+	// batches of 100 bytes on average so far
+	sizer.Record(10, 10500)
+	require.Equal(t, 10, sizer.PageSize())
+
+	// at least one batch is read even if it is larger than the limit
+	sizer.Record(1, 1000000)
+	require.Equal(t, 1, sizer.PageSize())
+
+	unbounded := NewHistoryPageSizer(100, 0)
+	unbounded.Record(1, 1000000)
+	require.Equal(t, 100, unbounded.PageSize())
+}
@@ -84,6 +84,9 @@ func (m *nexusEndpointManagerImpl) GetNexusEndpoint(
 		m.logger.Error(fmt.Sprintf("error deserializing nexus endpoint with ID:%v", internalEndpoint.ID), tag.Error(err))
 		return nil, err
 	}
+	if endpoint.GetSpec() == nil && !request.IncludeTombstones {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("Nexus endpoint with ID `%v` not found", request.ID))
+	}
 
 	return &persistencespb.NexusEndpointEntry{
 		Id:       internalEndpoint.ID,
@@ -110,18 +113,21 @@ func (m *nexusEndpointManagerImpl) ListNexusEndpoints(
 		return result, err
 	}
 
-	entries := make([]*persistencespb.NexusEndpointEntry, len(resp.Endpoints))
-	for i, entry := range resp.Endpoints {
+	entries := make([]*persistencespb.NexusEndpointEntry, 0, len(resp.Endpoints))
+	for _, entry := range resp.Endpoints {
 		endpoint, err := m.serializer.NexusEndpointFromBlob(entry.Data)
 		if err != nil {
 			m.logger.Error(fmt.Sprintf("error deserializing nexus endpoint with ID: %v", entry.ID), tag.Error(err))
 			return nil, err
 		}
-		entries[i] = &persistencespb.NexusEndpointEntry{
+		if endpoint.GetSpec() == nil && !request.IncludeTombstones {
+			continue
+		}
+		entries = append(entries, &persistencespb.NexusEndpointEntry{
 			Id:       entry.ID,
 			Version:  entry.Version,
 			Endpoint: endpoint,
-		}
+		})
 	}
 
 	result.NextPageToken = resp.NextPageToken
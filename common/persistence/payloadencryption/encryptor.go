@@ -0,0 +1,321 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payloadencryption
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/proxy"
+	"go.temporal.io/server/common/payload"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// MetadataEncoding is the encoding of the payloads encrypted by the server.
+	MetadataEncoding = "binary/server-encrypted"
+	// MetadataKeyID is the metadata of encrypted payloads holding the ID of the key they are encrypted with.
+	MetadataKeyID = "temporal-server-encryption-key-id"
+	// MetadataNamespaceID is the metadata of encrypted payloads holding the ID of the namespace they belong to. It is
+	// authenticated as the additional data of the encryption, so that payloads can't be moved to another namespace.
+	MetadataNamespaceID = "temporal-server-encryption-namespace-id"
+
+	metadataEncodingKey = "encoding"
+)
+
+type (
+	// KeyProvider provides the keys encrypting the payloads of namespaces, e.g. from a KMS. Implementations are expected
+	// to cache keys, since they are requested for every history read and write.
+	KeyProvider interface {
+		// GetEncryptionKey returns the ID and the value of the AES key (16, 24 or 32 bytes) encrypting the payloads of
+		// a namespace. An empty key ID leaves the payloads of the namespace unencrypted.
+		GetEncryptionKey(ctx context.Context, namespaceID string) (keyID string, key []byte, err error)
+		// GetDecryptionKey returns the value of a key previously returned by GetEncryptionKey for the namespace. It must
+		// return an error if the key doesn't belong to the namespace. Keys must remain available after rotation as
+		// long as payloads encrypted with them are retained.
+		GetDecryptionKey(ctx context.Context, namespaceID string, keyID string) ([]byte, error)
+	}
+
+	// Encryptor encrypts and decrypts the payloads of history events and memos with the keys of their namespace.
+	// Payloads stored elsewhere in mutable state, e.g. activity heartbeat details, are not encrypted.
+	Encryptor struct {
+		keyProvider KeyProvider
+	}
+
+	payloadEncryptor struct {
+		aead        cipher.AEAD
+		keyID       string
+		namespaceID string
+	}
+
+	// payloadDecryptor caches the keys of the payloads it decrypts, as payloads read together usually share a key.
+	payloadDecryptor struct {
+		keyProvider KeyProvider
+		// namespaceID is the namespace the payloads are expected to belong to, empty if unknown
+		namespaceID string
+		aeads       map[decryptionKey]cipher.AEAD
+	}
+
+	decryptionKey struct {
+		namespaceID string
+		keyID       string
+	}
+)
+
+var (
+	errInvalidEncryptedPayload = errors.New("invalid encrypted payload")
+	errNamespaceMismatch       = errors.New("encrypted payload belongs to another namespace")
+)
+
+func NewEncryptor(keyProvider KeyProvider) *Encryptor {
+	return &Encryptor{keyProvider: keyProvider}
+}
+
+// EncryptEvents returns copies of events whose payloads, except search attributes, are encrypted with the key of the
+// namespace. Events are returned as is if the namespace has no key.
+func (e *Encryptor) EncryptEvents(
+	ctx context.Context,
+	namespaceID string,
+	events []*historypb.HistoryEvent,
+) ([]*historypb.HistoryEvent, error) {
+	if len(events) == 0 {
+		return events, nil
+	}
+	encryptor, err := e.newPayloadEncryptor(ctx, namespaceID)
+	if err != nil || encryptor == nil {
+		return events, err
+	}
+
+	options := proxy.VisitPayloadsOptions{
+		SkipSearchAttributes: true,
+		Visitor: func(_ *proxy.VisitPayloadsContext, payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+			result := make([]*commonpb.Payload, len(payloads))
+			for i, p := range payloads {
+				encrypted, err := encryptor.encrypt(p)
+				if err != nil {
+					return nil, err
+				}
+				result[i] = encrypted
+			}
+			return result, nil
+		},
+	}
+	encryptedEvents := make([]*historypb.HistoryEvent, len(events))
+	for i, event := range events {
+		// Events are copied by encoding and decoding them rather than cloning them, so that payloads kept encoded by
+		// rpc.LazyPayloadsCodec are decoded and encrypted as well.
+		encoded, err := proto.Marshal(event)
+		if err != nil {
+			return nil, err
+		}
+		encryptedEvents[i] = &historypb.HistoryEvent{}
+		if err := proto.Unmarshal(encoded, encryptedEvents[i]); err != nil {
+			return nil, err
+		}
+		if err := proxy.VisitPayloads(ctx, encryptedEvents[i], options); err != nil {
+			return nil, err
+		}
+	}
+	return encryptedEvents, nil
+}
+
+// EncryptPayloads returns a copy of payloads, e.g. the fields of a memo, encrypted with the key of the namespace. It
+// returns nil if there are no payloads or the namespace has no key.
+func (e *Encryptor) EncryptPayloads(
+	ctx context.Context,
+	namespaceID string,
+	payloads map[string]*commonpb.Payload,
+) (map[string]*commonpb.Payload, error) {
+	if len(payloads) == 0 {
+		return nil, nil
+	}
+	encryptor, err := e.newPayloadEncryptor(ctx, namespaceID)
+	if err != nil || encryptor == nil {
+		return nil, err
+	}
+
+	result := make(map[string]*commonpb.Payload, len(payloads))
+	for k, p := range payloads {
+		if result[k], err = encryptor.encrypt(p); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// DecryptEvents decrypts in place the encrypted payloads of events of the namespace. The namespace ID may be empty
+// when it is unknown, e.g. when reading a history branch, the payloads are then decrypted with the keys of the
+// namespace they are bound to by their encryption.
+// Payloads compressed before they were encrypted are decompressed as well, as the serializer can't see their
+// compression through the encryption.
+func (e *Encryptor) DecryptEvents(ctx context.Context, namespaceID string, events ...*historypb.HistoryEvent) error {
+	decryptor := e.newPayloadDecryptor(namespaceID)
+	options := proxy.VisitPayloadsOptions{
+		SkipSearchAttributes: true,
+		Visitor: func(visitCtx *proxy.VisitPayloadsContext, payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+			result := make([]*commonpb.Payload, len(payloads))
+			for i, p := range payloads {
+				decrypted, err := decryptor.decrypt(visitCtx, p)
+				if err != nil {
+					return nil, err
+				}
+				result[i] = decrypted
+			}
+			return result, nil
+		},
+	}
+	for _, event := range events {
+		if err := proxy.VisitPayloads(ctx, event, options); err != nil {
+			return err
+		}
+	}
+	return payload.DecompressEvents(events...)
+}
+
+// DecryptPayloads decrypts in place the encrypted payloads of the namespace, e.g. the fields of a memo.
+func (e *Encryptor) DecryptPayloads(
+	ctx context.Context,
+	namespaceID string,
+	payloads map[string]*commonpb.Payload,
+) error {
+	decryptor := e.newPayloadDecryptor(namespaceID)
+	for k, p := range payloads {
+		decrypted, err := decryptor.decrypt(ctx, p)
+		if err != nil {
+			return err
+		}
+		payloads[k] = decrypted
+	}
+	return nil
+}
+
+// newPayloadEncryptor returns nil if the namespace has no key.
+func (e *Encryptor) newPayloadEncryptor(ctx context.Context, namespaceID string) (*payloadEncryptor, error) {
+	keyID, key, err := e.keyProvider.GetEncryptionKey(ctx, namespaceID)
+	if err != nil || keyID == "" {
+		return nil, err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	return &payloadEncryptor{aead: aead, keyID: keyID, namespaceID: namespaceID}, nil
+}
+
+func (e *Encryptor) newPayloadDecryptor(namespaceID string) *payloadDecryptor {
+	return &payloadDecryptor{
+		keyProvider: e.keyProvider,
+		namespaceID: namespaceID,
+		aeads:       make(map[decryptionKey]cipher.AEAD),
+	}
+}
+
+// encrypt encrypts every payload, including payloads which already carry the metadata of encrypted payloads: as
+// payloads written through the encryptor are always decrypted when read, such payloads don't come from the server and
+// can't be trusted. They are returned as written once decrypted.
+func (e *payloadEncryptor) encrypt(p *commonpb.Payload) (*commonpb.Payload, error) {
+	return encryptPayload(e.aead, e.keyID, e.namespaceID, p)
+}
+
+func (d *payloadDecryptor) decrypt(ctx context.Context, p *commonpb.Payload) (*commonpb.Payload, error) {
+	if !isEncrypted(p) {
+		return p, nil
+	}
+	key := decryptionKey{
+		namespaceID: string(p.GetMetadata()[MetadataNamespaceID]),
+		keyID:       string(p.GetMetadata()[MetadataKeyID]),
+	}
+	if key.namespaceID == "" {
+		return nil, errInvalidEncryptedPayload
+	}
+	if d.namespaceID != "" && key.namespaceID != d.namespaceID {
+		return nil, errNamespaceMismatch
+	}
+	aead, ok := d.aeads[key]
+	if !ok {
+		value, err := d.keyProvider.GetDecryptionKey(ctx, key.namespaceID, key.keyID)
+		if err != nil {
+			return nil, err
+		}
+		if aead, err = newAEAD(value); err != nil {
+			return nil, err
+		}
+		d.aeads[key] = aead
+	}
+	return decryptPayload(aead, key.namespaceID, p)
+}
+
+func isEncrypted(p *commonpb.Payload) bool {
+	return len(p.GetMetadata()[MetadataKeyID]) > 0
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid encryption key: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// encryptPayload authenticates the namespace ID as the additional data of the encryption.
+func encryptPayload(aead cipher.AEAD, keyID string, namespaceID string, p *commonpb.Payload) (*commonpb.Payload, error) {
+	plaintext, err := proto.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return &commonpb.Payload{
+		Metadata: map[string][]byte{
+			metadataEncodingKey: []byte(MetadataEncoding),
+			MetadataKeyID:       []byte(keyID),
+			MetadataNamespaceID: []byte(namespaceID),
+		},
+		Data: aead.Seal(nonce, nonce, plaintext, []byte(namespaceID)),
+	}, nil
+}
+
+func decryptPayload(aead cipher.AEAD, namespaceID string, p *commonpb.Payload) (*commonpb.Payload, error) {
+	data := p.GetData()
+	if len(data) < aead.NonceSize() {
+		return nil, errInvalidEncryptedPayload
+	}
+	plaintext, err := aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], []byte(namespaceID))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidEncryptedPayload, err)
+	}
+	decrypted := &commonpb.Payload{}
+	if err := proto.Unmarshal(plaintext, decrypted); err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidEncryptedPayload, err)
+	}
+	return decrypted, nil
+}
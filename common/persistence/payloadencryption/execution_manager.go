@@ -0,0 +1,331 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payloadencryption
+
+import (
+	"context"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+type (
+	// executionManager encrypts the payloads of the history events, including buffered events, and the memo of the
+	// executions written through it, and decrypts them when they are read through it. Raw history is decoded to be
+	// decrypted or encrypted, so readers of raw history, e.g. replication and clients receiving raw history, get the
+	// payloads in plain text. Other payloads stored in mutable state, e.g. heartbeat details, are not encrypted.
+	executionManager struct {
+		persistence.ExecutionManager
+		encryptor  *Encryptor
+		serializer serialization.Serializer
+	}
+)
+
+var _ persistence.ExecutionManager = (*executionManager)(nil)
+
+// NewExecutionManager wraps an execution manager to encrypt the payloads of history events with the keys of their
+// namespace.
+func NewExecutionManager(
+	manager persistence.ExecutionManager,
+	keyProvider KeyProvider,
+) persistence.ExecutionManager {
+	return &executionManager{
+		ExecutionManager: manager,
+		encryptor:        NewEncryptor(keyProvider),
+		serializer:       serialization.NewSerializer(),
+	}
+}
+
+func (m *executionManager) CreateWorkflowExecution(
+	ctx context.Context,
+	request *persistence.CreateWorkflowExecutionRequest,
+) (*persistence.CreateWorkflowExecutionResponse, error) {
+	newRequest := *request
+	var err error
+	if err = m.encryptSnapshot(ctx, &newRequest.NewWorkflowSnapshot); err != nil {
+		return nil, err
+	}
+	if newRequest.NewWorkflowEvents, err = m.encryptWorkflowEvents(ctx, request.NewWorkflowEvents); err != nil {
+		return nil, err
+	}
+	return m.ExecutionManager.CreateWorkflowExecution(ctx, &newRequest)
+}
+
+func (m *executionManager) GetWorkflowExecution(
+	ctx context.Context,
+	request *persistence.GetWorkflowExecutionRequest,
+) (*persistence.GetWorkflowExecutionResponse, error) {
+	resp, err := m.ExecutionManager.GetWorkflowExecution(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	namespaceID := resp.State.GetExecutionInfo().GetNamespaceId()
+	if err := m.encryptor.DecryptPayloads(ctx, namespaceID, resp.State.GetExecutionInfo().GetMemo()); err != nil {
+		return nil, err
+	}
+	if err := m.encryptor.DecryptEvents(ctx, namespaceID, resp.State.GetBufferedEvents()...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (m *executionManager) SetWorkflowExecution(
+	ctx context.Context,
+	request *persistence.SetWorkflowExecutionRequest,
+) (*persistence.SetWorkflowExecutionResponse, error) {
+	newRequest := *request
+	if err := m.encryptSnapshot(ctx, &newRequest.SetWorkflowSnapshot); err != nil {
+		return nil, err
+	}
+	return m.ExecutionManager.SetWorkflowExecution(ctx, &newRequest)
+}
+
+func (m *executionManager) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *persistence.UpdateWorkflowExecutionRequest,
+) (*persistence.UpdateWorkflowExecutionResponse, error) {
+	newRequest := *request
+	var err error
+	if err = m.encryptMutation(ctx, &newRequest.UpdateWorkflowMutation); err != nil {
+		return nil, err
+	}
+	if newRequest.NewWorkflowSnapshot, err = m.encryptSnapshotCopy(ctx, request.NewWorkflowSnapshot); err != nil {
+		return nil, err
+	}
+	if newRequest.UpdateWorkflowEvents, err = m.encryptWorkflowEvents(ctx, request.UpdateWorkflowEvents); err != nil {
+		return nil, err
+	}
+	if newRequest.NewWorkflowEvents, err = m.encryptWorkflowEvents(ctx, request.NewWorkflowEvents); err != nil {
+		return nil, err
+	}
+	return m.ExecutionManager.UpdateWorkflowExecution(ctx, &newRequest)
+}
+
+func (m *executionManager) ConflictResolveWorkflowExecution(
+	ctx context.Context,
+	request *persistence.ConflictResolveWorkflowExecutionRequest,
+) (*persistence.ConflictResolveWorkflowExecutionResponse, error) {
+	newRequest := *request
+	var err error
+	if err = m.encryptSnapshot(ctx, &newRequest.ResetWorkflowSnapshot); err != nil {
+		return nil, err
+	}
+	if newRequest.NewWorkflowSnapshot, err = m.encryptSnapshotCopy(ctx, request.NewWorkflowSnapshot); err != nil {
+		return nil, err
+	}
+	if request.CurrentWorkflowMutation != nil {
+		mutation := *request.CurrentWorkflowMutation
+		if err = m.encryptMutation(ctx, &mutation); err != nil {
+			return nil, err
+		}
+		newRequest.CurrentWorkflowMutation = &mutation
+	}
+	if newRequest.ResetWorkflowEvents, err = m.encryptWorkflowEvents(ctx, request.ResetWorkflowEvents); err != nil {
+		return nil, err
+	}
+	if newRequest.NewWorkflowEvents, err = m.encryptWorkflowEvents(ctx, request.NewWorkflowEvents); err != nil {
+		return nil, err
+	}
+	if newRequest.CurrentWorkflowEvents, err = m.encryptWorkflowEvents(ctx, request.CurrentWorkflowEvents); err != nil {
+		return nil, err
+	}
+	return m.ExecutionManager.ConflictResolveWorkflowExecution(ctx, &newRequest)
+}
+
+func (m *executionManager) AppendHistoryNodes(
+	ctx context.Context,
+	request *persistence.AppendHistoryNodesRequest,
+) (*persistence.AppendHistoryNodesResponse, error) {
+	namespaceID, _, _, err := persistence.SplitHistoryGarbageCleanupInfo(request.Info)
+	if err != nil {
+		// the namespace of the events is unknown, they are appended unencrypted
+		return m.ExecutionManager.AppendHistoryNodes(ctx, request)
+	}
+	newRequest := *request
+	if newRequest.Events, err = m.encryptor.EncryptEvents(ctx, namespaceID, request.Events); err != nil {
+		return nil, err
+	}
+	return m.ExecutionManager.AppendHistoryNodes(ctx, &newRequest)
+}
+
+func (m *executionManager) AppendRawHistoryNodes(
+	ctx context.Context,
+	request *persistence.AppendRawHistoryNodesRequest,
+) (*persistence.AppendHistoryNodesResponse, error) {
+	namespaceID, _, _, err := persistence.SplitHistoryGarbageCleanupInfo(request.Info)
+	if err != nil {
+		// the namespace of the events is unknown, they are appended unencrypted
+		return m.ExecutionManager.AppendRawHistoryNodes(ctx, request)
+	}
+	events, err := m.serializer.DeserializeEvents(request.History)
+	if err != nil {
+		return nil, err
+	}
+	encryptedEvents, err := m.encryptor.EncryptEvents(ctx, namespaceID, events)
+	if err != nil {
+		return nil, err
+	}
+	newRequest := *request
+	if newRequest.History, err = m.serializer.SerializeEvents(encryptedEvents, request.History.GetEncodingType()); err != nil {
+		return nil, err
+	}
+	return m.ExecutionManager.AppendRawHistoryNodes(ctx, &newRequest)
+}
+
+func (m *executionManager) ReadHistoryBranch(
+	ctx context.Context,
+	request *persistence.ReadHistoryBranchRequest,
+) (*persistence.ReadHistoryBranchResponse, error) {
+	resp, err := m.ExecutionManager.ReadHistoryBranch(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.encryptor.DecryptEvents(ctx, "", resp.HistoryEvents...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (m *executionManager) ReadHistoryBranchByBatch(
+	ctx context.Context,
+	request *persistence.ReadHistoryBranchRequest,
+) (*persistence.ReadHistoryBranchByBatchResponse, error) {
+	resp, err := m.ExecutionManager.ReadHistoryBranchByBatch(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	for _, batch := range resp.History {
+		if err := m.encryptor.DecryptEvents(ctx, "", batch.GetEvents()...); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func (m *executionManager) ReadHistoryBranchReverse(
+	ctx context.Context,
+	request *persistence.ReadHistoryBranchReverseRequest,
+) (*persistence.ReadHistoryBranchReverseResponse, error) {
+	resp, err := m.ExecutionManager.ReadHistoryBranchReverse(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.encryptor.DecryptEvents(ctx, "", resp.HistoryEvents...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (m *executionManager) ReadRawHistoryBranch(
+	ctx context.Context,
+	request *persistence.ReadHistoryBranchRequest,
+) (*persistence.ReadRawHistoryBranchResponse, error) {
+	resp, err := m.ExecutionManager.ReadRawHistoryBranch(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	for i, blob := range resp.HistoryEventBlobs {
+		events, err := m.serializer.DeserializeEvents(blob)
+		if err != nil {
+			return nil, err
+		}
+		if err := m.encryptor.DecryptEvents(ctx, "", events...); err != nil {
+			return nil, err
+		}
+		if resp.HistoryEventBlobs[i], err = m.serializer.SerializeEvents(events, blob.GetEncodingType()); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func (m *executionManager) encryptMutation(
+	ctx context.Context,
+	mutation *persistence.WorkflowMutation,
+) error {
+	var err error
+	if mutation.ExecutionInfo, err = m.encryptExecutionInfo(ctx, mutation.ExecutionInfo); err != nil {
+		return err
+	}
+	mutation.NewBufferedEvents, err = m.encryptor.EncryptEvents(ctx, mutation.ExecutionInfo.GetNamespaceId(), mutation.NewBufferedEvents)
+	return err
+}
+
+func (m *executionManager) encryptSnapshot(
+	ctx context.Context,
+	snapshot *persistence.WorkflowSnapshot,
+) error {
+	var err error
+	snapshot.ExecutionInfo, err = m.encryptExecutionInfo(ctx, snapshot.ExecutionInfo)
+	return err
+}
+
+func (m *executionManager) encryptSnapshotCopy(
+	ctx context.Context,
+	snapshot *persistence.WorkflowSnapshot,
+) (*persistence.WorkflowSnapshot, error) {
+	if snapshot == nil {
+		return nil, nil
+	}
+	encrypted := *snapshot
+	if err := m.encryptSnapshot(ctx, &encrypted); err != nil {
+		return nil, err
+	}
+	return &encrypted, nil
+}
+
+// encryptExecutionInfo returns a copy of the execution info with its memo encrypted, or the execution info as is if
+// it has no memo to encrypt, as it is shared with the cached mutable state.
+func (m *executionManager) encryptExecutionInfo(
+	ctx context.Context,
+	executionInfo *persistencespb.WorkflowExecutionInfo,
+) (*persistencespb.WorkflowExecutionInfo, error) {
+	memo, err := m.encryptor.EncryptPayloads(ctx, executionInfo.GetNamespaceId(), executionInfo.GetMemo())
+	if err != nil || memo == nil {
+		return executionInfo, err
+	}
+	encrypted := common.CloneProto(executionInfo)
+	encrypted.Memo = memo
+	return encrypted, nil
+}
+
+func (m *executionManager) encryptWorkflowEvents(
+	ctx context.Context,
+	workflowEvents []*persistence.WorkflowEvents,
+) ([]*persistence.WorkflowEvents, error) {
+	if len(workflowEvents) == 0 {
+		return workflowEvents, nil
+	}
+	result := make([]*persistence.WorkflowEvents, len(workflowEvents))
+	for i, events := range workflowEvents {
+		encrypted := *events
+		var err error
+		if encrypted.Events, err = m.encryptor.EncryptEvents(ctx, events.NamespaceID, events.Events); err != nil {
+			return nil, err
+		}
+		result[i] = &encrypted
+	}
+	return result, nil
+}
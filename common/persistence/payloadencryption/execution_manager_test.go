@@ -0,0 +1,303 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payloadencryption
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/testing/protorequire"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
+)
+
+// testKeyProvider holds the keys of namespaces by namespace ID, the ID of a key is the ID of its namespace.
+type testKeyProvider map[string][]byte
+
+func (p testKeyProvider) GetEncryptionKey(_ context.Context, namespaceID string) (string, []byte, error) {
+	if key, ok := p[namespaceID]; ok {
+		return namespaceID, key, nil
+	}
+	return "", nil, nil
+}
+
+func (p testKeyProvider) GetDecryptionKey(_ context.Context, namespaceID string, keyID string) ([]byte, error) {
+	if keyID != namespaceID {
+		return nil, errors.New("key doesn't belong to the namespace")
+	}
+	if key, ok := p[keyID]; ok {
+		return key, nil
+	}
+	return nil, errors.New("unknown key")
+}
+
+func startedEvent(input string) *historypb.HistoryEvent {
+	return &historypb.HistoryEvent{
+		EventId:   1,
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+		Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+			WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+				Input: payloads.EncodeString(input),
+				Memo: &commonpb.Memo{Fields: map[string]*commonpb.Payload{
+					"memo": payloads.EncodeString("memo").GetPayloads()[0],
+				}},
+			},
+		},
+	}
+}
+
+func TestEncryptor(t *testing.T) {
+	encryptor := NewEncryptor(testKeyProvider{"encrypted": make([]byte, 32)})
+	event := startedEvent("input")
+
+	events, err := encryptor.EncryptEvents(context.Background(), "plain", []*historypb.HistoryEvent{event})
+	require.NoError(t, err)
+	require.Same(t, event, events[0])
+
+	events, err = encryptor.EncryptEvents(context.Background(), "encrypted", []*historypb.HistoryEvent{event})
+	require.NoError(t, err)
+	protorequire.ProtoEqual(t, startedEvent("input"), event)
+	attr := events[0].GetWorkflowExecutionStartedEventAttributes()
+	input := attr.GetInput().GetPayloads()[0]
+	require.Equal(t, MetadataEncoding, string(input.GetMetadata()["encoding"]))
+	require.Equal(t, "encrypted", string(input.GetMetadata()[MetadataKeyID]))
+	require.Equal(t, "encrypted", string(input.GetMetadata()[MetadataNamespaceID]))
+	require.Equal(t, "encrypted", string(attr.GetMemo().GetFields()["memo"].GetMetadata()[MetadataKeyID]))
+
+	require.NoError(t, encryptor.DecryptEvents(context.Background(), "", events...))
+	protorequire.ProtoEqual(t, event, events[0])
+}
+
+func TestEncryptor_CompressedPayloads(t *testing.T) {
+	encryptor := NewEncryptor(testKeyProvider{"encrypted": make([]byte, 32)})
+	input := payloads.EncodeString(strings.Repeat("input", 100))
+	compressed, err := payload.Compress(input)
+	require.NoError(t, err)
+	event := &historypb.HistoryEvent{
+		EventId:   1,
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+		Attributes: &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{
+			WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{Input: compressed},
+		},
+	}
+
+	// the compression of encrypted payloads is only visible once they are decrypted
+	events, err := encryptor.EncryptEvents(context.Background(), "encrypted", []*historypb.HistoryEvent{event})
+	require.NoError(t, err)
+	require.False(t, payload.IsCompressed(events[0].GetWorkflowExecutionSignaledEventAttributes().GetInput().GetPayloads()[0]))
+
+	require.NoError(t, encryptor.DecryptEvents(context.Background(), "encrypted", events...))
+	protorequire.ProtoEqual(t, input, events[0].GetWorkflowExecutionSignaledEventAttributes().GetInput())
+}
+
+func TestEncryptor_ForgedMetadata(t *testing.T) {
+	encryptor := NewEncryptor(testKeyProvider{"encrypted": make([]byte, 32)})
+	// a client payload carrying the metadata of encrypted payloads is encrypted as any other payload
+	event := startedEvent("input")
+	input := event.GetWorkflowExecutionStartedEventAttributes().GetInput().GetPayloads()[0]
+	input.Metadata[MetadataKeyID] = []byte("encrypted")
+	input.Metadata[MetadataNamespaceID] = []byte("encrypted")
+
+	events, err := encryptor.EncryptEvents(context.Background(), "encrypted", []*historypb.HistoryEvent{event})
+	require.NoError(t, err)
+	require.NotEqual(t, input.GetData(), events[0].GetWorkflowExecutionStartedEventAttributes().GetInput().GetPayloads()[0].GetData())
+
+	require.NoError(t, encryptor.DecryptEvents(context.Background(), "encrypted", events...))
+	protorequire.ProtoEqual(t, event, events[0])
+}
+
+func TestEncryptor_LazyPayloads(t *testing.T) {
+	encryptor := NewEncryptor(testKeyProvider{"encrypted": make([]byte, 32)})
+	// input kept encoded by rpc.LazyPayloadsCodec
+	event := startedEvent("input")
+	attr := event.GetWorkflowExecutionStartedEventAttributes()
+	encodedInput, err := proto.Marshal(attr.GetInput())
+	require.NoError(t, err)
+	attr.Input = &commonpb.Payloads{}
+	attr.Input.ProtoReflect().SetUnknown(encodedInput)
+
+	events, err := encryptor.EncryptEvents(context.Background(), "encrypted", []*historypb.HistoryEvent{event})
+	require.NoError(t, err)
+	input := events[0].GetWorkflowExecutionStartedEventAttributes().GetInput()
+	require.Empty(t, input.ProtoReflect().GetUnknown())
+	require.Equal(t, "encrypted", string(input.GetPayloads()[0].GetMetadata()[MetadataKeyID]))
+
+	require.NoError(t, encryptor.DecryptEvents(context.Background(), "encrypted", events...))
+	protorequire.ProtoEqual(t, startedEvent("input"), events[0])
+}
+
+func TestEncryptor_Payloads(t *testing.T) {
+	encryptor := NewEncryptor(testKeyProvider{"encrypted": make([]byte, 32)})
+	memo := map[string]*commonpb.Payload{"memo": payloads.EncodeString("memo").GetPayloads()[0]}
+
+	encrypted, err := encryptor.EncryptPayloads(context.Background(), "plain", memo)
+	require.NoError(t, err)
+	require.Nil(t, encrypted)
+
+	encrypted, err = encryptor.EncryptPayloads(context.Background(), "encrypted", memo)
+	require.NoError(t, err)
+	require.Equal(t, "encrypted", string(encrypted["memo"].GetMetadata()[MetadataKeyID]))
+	require.Empty(t, memo["memo"].GetMetadata()[MetadataKeyID])
+
+	require.NoError(t, encryptor.DecryptPayloads(context.Background(), "encrypted", encrypted))
+	protorequire.ProtoEqual(t, memo["memo"], encrypted["memo"])
+}
+
+func TestEncryptor_InvalidPayload(t *testing.T) {
+	encryptor := NewEncryptor(testKeyProvider{"encrypted": make([]byte, 32), "other": make([]byte, 16)})
+	events, err := encryptor.EncryptEvents(context.Background(), "encrypted", []*historypb.HistoryEvent{startedEvent("input")})
+	require.NoError(t, err)
+
+	input := events[0].GetWorkflowExecutionStartedEventAttributes().GetInput().GetPayloads()[0]
+	require.ErrorIs(t, encryptor.DecryptEvents(context.Background(), "other", events...), errNamespaceMismatch)
+
+	// the namespace is authenticated by the encryption
+	input.Metadata[MetadataKeyID] = []byte("other")
+	input.Metadata[MetadataNamespaceID] = []byte("other")
+	require.ErrorIs(t, encryptor.DecryptEvents(context.Background(), "", events...), errInvalidEncryptedPayload)
+
+	// keys are only used for the payloads of their namespace
+	input.Metadata[MetadataNamespaceID] = []byte("encrypted")
+	require.Error(t, encryptor.DecryptEvents(context.Background(), "", events...))
+
+	delete(input.Metadata, MetadataNamespaceID)
+	require.ErrorIs(t, encryptor.DecryptEvents(context.Background(), "", events...), errInvalidEncryptedPayload)
+}
+
+func TestExecutionManager(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockManager := persistence.NewMockExecutionManager(controller)
+	manager := NewExecutionManager(mockManager, testKeyProvider{"encrypted": make([]byte, 32)})
+
+	var stored []*historypb.HistoryEvent
+	mockManager.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error) {
+			stored = request.UpdateWorkflowEvents[0].Events
+			return &persistence.UpdateWorkflowExecutionResponse{}, nil
+		},
+	)
+	request := &persistence.UpdateWorkflowExecutionRequest{
+		UpdateWorkflowEvents: []*persistence.WorkflowEvents{{
+			NamespaceID: "encrypted",
+			Events:      []*historypb.HistoryEvent{startedEvent("input")},
+		}},
+	}
+	_, err := manager.UpdateWorkflowExecution(context.Background(), request)
+	require.NoError(t, err)
+	protorequire.ProtoEqual(t, startedEvent("input"), request.UpdateWorkflowEvents[0].Events[0])
+	require.NotNil(t, stored[0].GetWorkflowExecutionStartedEventAttributes().GetInput().GetPayloads()[0].GetMetadata()[MetadataKeyID])
+
+	mockManager.EXPECT().ReadHistoryBranch(gomock.Any(), gomock.Any()).Return(
+		&persistence.ReadHistoryBranchResponse{HistoryEvents: stored},
+		nil,
+	)
+	resp, err := manager.ReadHistoryBranch(context.Background(), &persistence.ReadHistoryBranchRequest{})
+	require.NoError(t, err)
+	protorequire.ProtoEqual(t, startedEvent("input"), resp.HistoryEvents[0])
+}
+
+func TestExecutionManager_MutableState(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockManager := persistence.NewMockExecutionManager(controller)
+	manager := NewExecutionManager(mockManager, testKeyProvider{"encrypted": make([]byte, 32)})
+
+	executionInfo := &persistencespb.WorkflowExecutionInfo{
+		NamespaceId: "encrypted",
+		Memo:        map[string]*commonpb.Payload{"memo": payloads.EncodeString("memo").GetPayloads()[0]},
+	}
+	var stored persistence.WorkflowMutation
+	mockManager.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error) {
+			stored = request.UpdateWorkflowMutation
+			return &persistence.UpdateWorkflowExecutionResponse{}, nil
+		},
+	)
+	_, err := manager.UpdateWorkflowExecution(context.Background(), &persistence.UpdateWorkflowExecutionRequest{
+		UpdateWorkflowMutation: persistence.WorkflowMutation{
+			ExecutionInfo:     executionInfo,
+			NewBufferedEvents: []*historypb.HistoryEvent{startedEvent("input")},
+		},
+	})
+	require.NoError(t, err)
+	require.Empty(t, executionInfo.Memo["memo"].GetMetadata()[MetadataKeyID])
+	require.NotEmpty(t, stored.ExecutionInfo.Memo["memo"].GetMetadata()[MetadataKeyID])
+	require.NotEmpty(t, stored.NewBufferedEvents[0].GetWorkflowExecutionStartedEventAttributes().GetInput().GetPayloads()[0].GetMetadata()[MetadataKeyID])
+
+	mockManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(
+		&persistence.GetWorkflowExecutionResponse{State: &persistencespb.WorkflowMutableState{
+			ExecutionInfo:  stored.ExecutionInfo,
+			BufferedEvents: stored.NewBufferedEvents,
+		}},
+		nil,
+	)
+	resp, err := manager.GetWorkflowExecution(context.Background(), &persistence.GetWorkflowExecutionRequest{})
+	require.NoError(t, err)
+	protorequire.ProtoEqual(t, executionInfo, resp.State.ExecutionInfo)
+	protorequire.ProtoEqual(t, startedEvent("input"), resp.State.BufferedEvents[0])
+}
+
+func TestExecutionManager_RawHistory(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockManager := persistence.NewMockExecutionManager(controller)
+	manager := NewExecutionManager(mockManager, testKeyProvider{"encrypted": make([]byte, 32)})
+	serializer := serialization.NewSerializer()
+	blob, err := serializer.SerializeEvents([]*historypb.HistoryEvent{startedEvent("input")}, enumspb.ENCODING_TYPE_PROTO3)
+	require.NoError(t, err)
+
+	var stored *commonpb.DataBlob
+	mockManager.EXPECT().AppendRawHistoryNodes(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.AppendRawHistoryNodesRequest) (*persistence.AppendHistoryNodesResponse, error) {
+			stored = request.History
+			return &persistence.AppendHistoryNodesResponse{}, nil
+		},
+	)
+	_, err = manager.AppendRawHistoryNodes(context.Background(), &persistence.AppendRawHistoryNodesRequest{
+		Info:    persistence.BuildHistoryGarbageCleanupInfo("encrypted", "workflow-id", "run-id"),
+		History: blob,
+	})
+	require.NoError(t, err)
+	storedEvents, err := serializer.DeserializeEvents(stored)
+	require.NoError(t, err)
+	require.NotEmpty(t, storedEvents[0].GetWorkflowExecutionStartedEventAttributes().GetInput().GetPayloads()[0].GetMetadata()[MetadataKeyID])
+
+	mockManager.EXPECT().ReadRawHistoryBranch(gomock.Any(), gomock.Any()).Return(
+		&persistence.ReadRawHistoryBranchResponse{HistoryEventBlobs: []*commonpb.DataBlob{stored}},
+		nil,
+	)
+	resp, err := manager.ReadRawHistoryBranch(context.Background(), &persistence.ReadHistoryBranchRequest{})
+	require.NoError(t, err)
+	events, err := serializer.DeserializeEvents(resp.HistoryEventBlobs[0])
+	require.NoError(t, err)
+	protorequire.ProtoEqual(t, startedEvent("input"), events[0])
+}
@@ -0,0 +1,152 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payloadencryption
+
+import (
+	"context"
+
+	commonpb "go.temporal.io/api/common/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+)
+
+type (
+	// visibilityManager encrypts the memo of the executions recorded through it and decrypts the memo of the
+	// executions read through it.
+	visibilityManager struct {
+		manager.VisibilityManager
+		encryptor *Encryptor
+	}
+)
+
+var _ manager.VisibilityManager = (*visibilityManager)(nil)
+
+// NewVisibilityManager wraps a visibility manager to encrypt the memo of executions with the keys of their namespace.
+func NewVisibilityManager(
+	manager manager.VisibilityManager,
+	keyProvider KeyProvider,
+) manager.VisibilityManager {
+	return &visibilityManager{
+		VisibilityManager: manager,
+		encryptor:         NewEncryptor(keyProvider),
+	}
+}
+
+func (m *visibilityManager) RecordWorkflowExecutionStarted(
+	ctx context.Context,
+	request *manager.RecordWorkflowExecutionStartedRequest,
+) error {
+	base, err := m.encryptRequestBase(ctx, request.VisibilityRequestBase)
+	if err != nil {
+		return err
+	}
+	newRequest := *request
+	newRequest.VisibilityRequestBase = base
+	return m.VisibilityManager.RecordWorkflowExecutionStarted(ctx, &newRequest)
+}
+
+func (m *visibilityManager) RecordWorkflowExecutionClosed(
+	ctx context.Context,
+	request *manager.RecordWorkflowExecutionClosedRequest,
+) error {
+	base, err := m.encryptRequestBase(ctx, request.VisibilityRequestBase)
+	if err != nil {
+		return err
+	}
+	newRequest := *request
+	newRequest.VisibilityRequestBase = base
+	return m.VisibilityManager.RecordWorkflowExecutionClosed(ctx, &newRequest)
+}
+
+func (m *visibilityManager) UpsertWorkflowExecution(
+	ctx context.Context,
+	request *manager.UpsertWorkflowExecutionRequest,
+) error {
+	base, err := m.encryptRequestBase(ctx, request.VisibilityRequestBase)
+	if err != nil {
+		return err
+	}
+	newRequest := *request
+	newRequest.VisibilityRequestBase = base
+	return m.VisibilityManager.UpsertWorkflowExecution(ctx, &newRequest)
+}
+
+func (m *visibilityManager) ListWorkflowExecutions(
+	ctx context.Context,
+	request *manager.ListWorkflowExecutionsRequestV2,
+) (*manager.ListWorkflowExecutionsResponse, error) {
+	resp, err := m.VisibilityManager.ListWorkflowExecutions(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return resp, m.decryptExecutions(ctx, request.NamespaceID, resp.Executions...)
+}
+
+func (m *visibilityManager) ScanWorkflowExecutions(
+	ctx context.Context,
+	request *manager.ListWorkflowExecutionsRequestV2,
+) (*manager.ListWorkflowExecutionsResponse, error) {
+	resp, err := m.VisibilityManager.ScanWorkflowExecutions(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return resp, m.decryptExecutions(ctx, request.NamespaceID, resp.Executions...)
+}
+
+func (m *visibilityManager) GetWorkflowExecution(
+	ctx context.Context,
+	request *manager.GetWorkflowExecutionRequest,
+) (*manager.GetWorkflowExecutionResponse, error) {
+	resp, err := m.VisibilityManager.GetWorkflowExecution(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return resp, m.decryptExecutions(ctx, request.NamespaceID, resp.Execution)
+}
+
+func (m *visibilityManager) encryptRequestBase(
+	ctx context.Context,
+	base *manager.VisibilityRequestBase,
+) (*manager.VisibilityRequestBase, error) {
+	fields, err := m.encryptor.EncryptPayloads(ctx, base.NamespaceID.String(), base.Memo.GetFields())
+	if err != nil || fields == nil {
+		return base, err
+	}
+	encrypted := *base
+	encrypted.Memo = &commonpb.Memo{Fields: fields}
+	return &encrypted, nil
+}
+
+func (m *visibilityManager) decryptExecutions(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	executions ...*workflowpb.WorkflowExecutionInfo,
+) error {
+	for _, execution := range executions {
+		if err := m.encryptor.DecryptPayloads(ctx, namespaceID.String(), execution.GetMemo().GetFields()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payloadencryption
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/testing/protorequire"
+	"go.uber.org/mock/gomock"
+)
+
+func TestVisibilityManager(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockManager := manager.NewMockVisibilityManager(controller)
+	visibilityManager := NewVisibilityManager(mockManager, testKeyProvider{"encrypted": make([]byte, 32)})
+	memo := &commonpb.Memo{Fields: map[string]*commonpb.Payload{
+		"memo": payloads.EncodeString("memo").GetPayloads()[0],
+	}}
+
+	var stored *commonpb.Memo
+	mockManager.EXPECT().UpsertWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *manager.UpsertWorkflowExecutionRequest) error {
+			stored = request.Memo
+			return nil
+		},
+	)
+	request := &manager.UpsertWorkflowExecutionRequest{
+		VisibilityRequestBase: &manager.VisibilityRequestBase{NamespaceID: "encrypted", Memo: memo},
+	}
+	require.NoError(t, visibilityManager.UpsertWorkflowExecution(context.Background(), request))
+	require.Same(t, memo, request.Memo)
+	require.Equal(t, "encrypted", string(stored.GetFields()["memo"].GetMetadata()[MetadataKeyID]))
+
+	mockManager.EXPECT().ListWorkflowExecutions(gomock.Any(), gomock.Any()).Return(
+		&manager.ListWorkflowExecutionsResponse{
+			Executions: []*workflowpb.WorkflowExecutionInfo{{Memo: stored}},
+		},
+		nil,
+	)
+	resp, err := visibilityManager.ListWorkflowExecutions(context.Background(), &manager.ListWorkflowExecutionsRequestV2{})
+	require.NoError(t, err)
+	protorequire.ProtoEqual(t, memo, resp.Executions[0].GetMemo())
+}
@@ -217,6 +217,7 @@ func (s *TestBase) Setup(clusterMetadataConfig *cluster.Config) {
 		s.AbstractDataStoreFactory,
 		s.Logger,
 		metrics.NoopMetricsHandler,
+		nil,
 	)
 	factory := client.NewFactory(
 		dataStoreFactory,
@@ -230,6 +231,7 @@ func (s *TestBase) Setup(clusterMetadataConfig *cluster.Config) {
 		metrics.NoopMetricsHandler,
 		s.Logger,
 		s.PersistenceHealthSignals,
+		nil,
 	)
 
 	s.TaskMgr, err = factory.NewTaskManager()
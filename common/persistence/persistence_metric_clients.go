@@ -29,6 +29,9 @@ import (
 	"fmt"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/serviceerror"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
@@ -40,6 +43,8 @@ import (
 	"go.temporal.io/server/service/history/tasks"
 )
 
+const tracerName = "go.temporal.io/server/common/persistence"
+
 type (
 	metricEmitter struct {
 		metricsHandler metrics.Handler
@@ -194,7 +199,7 @@ func (p *shardPersistenceClient) GetOrCreateShard(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, caller, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetOrCreateShardScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetOrCreateShardScope, caller, startTime, retErr)
 	}()
 	return p.persistence.GetOrCreateShard(ctx, request)
 }
@@ -207,7 +212,7 @@ func (p *shardPersistenceClient) UpdateShard(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardInfo.GetShardId(), caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceUpdateShardScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceUpdateShardScope, caller, startTime, retErr)
 	}()
 	return p.persistence.UpdateShard(ctx, request)
 }
@@ -220,7 +225,7 @@ func (p *shardPersistenceClient) AssertShardOwnership(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceAssertShardOwnershipScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceAssertShardOwnershipScope, caller, startTime, retErr)
 	}()
 	return p.persistence.AssertShardOwnership(ctx, request)
 }
@@ -245,7 +250,7 @@ func (p *executionPersistenceClient) CreateWorkflowExecution(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceCreateWorkflowExecutionScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceCreateWorkflowExecutionScope, caller, startTime, retErr)
 	}()
 	return p.persistence.CreateWorkflowExecution(ctx, request)
 }
@@ -258,7 +263,7 @@ func (p *executionPersistenceClient) GetWorkflowExecution(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetWorkflowExecutionScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetWorkflowExecutionScope, caller, startTime, retErr)
 	}()
 	return p.persistence.GetWorkflowExecution(ctx, request)
 }
@@ -271,7 +276,7 @@ func (p *executionPersistenceClient) SetWorkflowExecution(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceSetWorkflowExecutionScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceSetWorkflowExecutionScope, caller, startTime, retErr)
 	}()
 	return p.persistence.SetWorkflowExecution(ctx, request)
 }
@@ -284,7 +289,7 @@ func (p *executionPersistenceClient) UpdateWorkflowExecution(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceUpdateWorkflowExecutionScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceUpdateWorkflowExecutionScope, caller, startTime, retErr)
 	}()
 	return p.persistence.UpdateWorkflowExecution(ctx, request)
 }
@@ -297,7 +302,7 @@ func (p *executionPersistenceClient) ConflictResolveWorkflowExecution(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceConflictResolveWorkflowExecutionScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceConflictResolveWorkflowExecutionScope, caller, startTime, retErr)
 	}()
 	return p.persistence.ConflictResolveWorkflowExecution(ctx, request)
 }
@@ -310,7 +315,7 @@ func (p *executionPersistenceClient) DeleteWorkflowExecution(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceDeleteWorkflowExecutionScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceDeleteWorkflowExecutionScope, caller, startTime, retErr)
 	}()
 	return p.persistence.DeleteWorkflowExecution(ctx, request)
 }
@@ -323,7 +328,7 @@ func (p *executionPersistenceClient) DeleteCurrentWorkflowExecution(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceDeleteCurrentWorkflowExecutionScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceDeleteCurrentWorkflowExecutionScope, caller, startTime, retErr)
 	}()
 	return p.persistence.DeleteCurrentWorkflowExecution(ctx, request)
 }
@@ -336,7 +341,7 @@ func (p *executionPersistenceClient) GetCurrentExecution(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetCurrentExecutionScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetCurrentExecutionScope, caller, startTime, retErr)
 	}()
 	return p.persistence.GetCurrentExecution(ctx, request)
 }
@@ -349,7 +354,7 @@ func (p *executionPersistenceClient) ListConcreteExecutions(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceListConcreteExecutionsScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceListConcreteExecutionsScope, caller, startTime, retErr)
 	}()
 	return p.persistence.ListConcreteExecutions(ctx, request)
 }
@@ -362,7 +367,7 @@ func (p *executionPersistenceClient) AddHistoryTasks(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceAddTasksScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceAddTasksScope, caller, startTime, retErr)
 	}()
 	return p.persistence.AddHistoryTasks(ctx, request)
 }
@@ -385,6 +390,8 @@ func (p *executionPersistenceClient) GetHistoryTasks(
 		operation = metrics.PersistenceGetArchivalTasksScope
 	case tasks.CategoryIDOutbound:
 		operation = metrics.PersistenceGetOutboundTasksScope
+	case tasks.CategoryIDWorkflowLifecycleEvent:
+		operation = metrics.PersistenceGetWorkflowLifecycleEventTasksScope
 	default:
 		return nil, serviceerror.NewInternal(fmt.Sprintf("unknown task category type: %v", request.TaskCategory))
 	}
@@ -393,7 +400,7 @@ func (p *executionPersistenceClient) GetHistoryTasks(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(operation, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, operation, caller, startTime, retErr)
 	}()
 	return p.persistence.GetHistoryTasks(ctx, request)
 }
@@ -416,6 +423,8 @@ func (p *executionPersistenceClient) CompleteHistoryTask(
 		operation = metrics.PersistenceCompleteArchivalTaskScope
 	case tasks.CategoryIDOutbound:
 		operation = metrics.PersistenceCompleteOutboundTasksScope
+	case tasks.CategoryIDWorkflowLifecycleEvent:
+		operation = metrics.PersistenceCompleteWorkflowLifecycleEventTaskScope
 	default:
 		return serviceerror.NewInternal(fmt.Sprintf("unknown task category type: %v", request.TaskCategory))
 	}
@@ -424,7 +433,7 @@ func (p *executionPersistenceClient) CompleteHistoryTask(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(operation, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, operation, caller, startTime, retErr)
 	}()
 	return p.persistence.CompleteHistoryTask(ctx, request)
 }
@@ -447,6 +456,8 @@ func (p *executionPersistenceClient) RangeCompleteHistoryTasks(
 		operation = metrics.PersistenceRangeCompleteArchivalTasksScope
 	case tasks.CategoryIDOutbound:
 		operation = metrics.PersistenceRangeCompleteOutboundTasksScope
+	case tasks.CategoryIDWorkflowLifecycleEvent:
+		operation = metrics.PersistenceRangeCompleteWorkflowLifecycleEventTasksScope
 	default:
 		return serviceerror.NewInternal(fmt.Sprintf("unknown task category type: %v", request.TaskCategory))
 	}
@@ -455,7 +466,7 @@ func (p *executionPersistenceClient) RangeCompleteHistoryTasks(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(operation, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, operation, caller, startTime, retErr)
 	}()
 	return p.persistence.RangeCompleteHistoryTasks(ctx, request)
 }
@@ -468,7 +479,7 @@ func (p *executionPersistenceClient) PutReplicationTaskToDLQ(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistencePutReplicationTaskToDLQScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistencePutReplicationTaskToDLQScope, caller, startTime, retErr)
 	}()
 	return p.persistence.PutReplicationTaskToDLQ(ctx, request)
 }
@@ -481,7 +492,7 @@ func (p *executionPersistenceClient) GetReplicationTasksFromDLQ(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetReplicationTasksFromDLQScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetReplicationTasksFromDLQScope, caller, startTime, retErr)
 	}()
 	return p.persistence.GetReplicationTasksFromDLQ(ctx, request)
 }
@@ -494,7 +505,7 @@ func (p *executionPersistenceClient) DeleteReplicationTaskFromDLQ(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceDeleteReplicationTaskFromDLQScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceDeleteReplicationTaskFromDLQScope, caller, startTime, retErr)
 	}()
 	return p.persistence.DeleteReplicationTaskFromDLQ(ctx, request)
 }
@@ -507,7 +518,7 @@ func (p *executionPersistenceClient) RangeDeleteReplicationTaskFromDLQ(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceRangeDeleteReplicationTaskFromDLQScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceRangeDeleteReplicationTaskFromDLQScope, caller, startTime, retErr)
 	}()
 	return p.persistence.RangeDeleteReplicationTaskFromDLQ(ctx, request)
 }
@@ -520,7 +531,7 @@ func (p *executionPersistenceClient) IsReplicationDLQEmpty(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetReplicationTasksFromDLQScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetReplicationTasksFromDLQScope, caller, startTime, retErr)
 	}()
 	return p.persistence.IsReplicationDLQEmpty(ctx, request)
 }
@@ -541,7 +552,7 @@ func (p *taskPersistenceClient) CreateTasks(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceCreateTasksScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceCreateTasksScope, caller, startTime, retErr)
 	}()
 	return p.persistence.CreateTasks(ctx, request)
 }
@@ -554,7 +565,7 @@ func (p *taskPersistenceClient) GetTasks(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetTasksScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetTasksScope, caller, startTime, retErr)
 	}()
 	return p.persistence.GetTasks(ctx, request)
 }
@@ -567,7 +578,7 @@ func (p *taskPersistenceClient) CompleteTasksLessThan(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceCompleteTasksLessThanScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceCompleteTasksLessThanScope, caller, startTime, retErr)
 	}()
 	return p.persistence.CompleteTasksLessThan(ctx, request)
 }
@@ -580,7 +591,7 @@ func (p *taskPersistenceClient) CreateTaskQueue(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceCreateTaskQueueScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceCreateTaskQueueScope, caller, startTime, retErr)
 	}()
 	return p.persistence.CreateTaskQueue(ctx, request)
 }
@@ -593,7 +604,7 @@ func (p *taskPersistenceClient) UpdateTaskQueue(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceUpdateTaskQueueScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceUpdateTaskQueueScope, caller, startTime, retErr)
 	}()
 	return p.persistence.UpdateTaskQueue(ctx, request)
 }
@@ -606,7 +617,7 @@ func (p *taskPersistenceClient) GetTaskQueue(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetTaskQueueScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetTaskQueueScope, caller, startTime, retErr)
 	}()
 	return p.persistence.GetTaskQueue(ctx, request)
 }
@@ -619,7 +630,7 @@ func (p *taskPersistenceClient) ListTaskQueue(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceListTaskQueueScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceListTaskQueueScope, caller, startTime, retErr)
 	}()
 	return p.persistence.ListTaskQueue(ctx, request)
 }
@@ -632,7 +643,7 @@ func (p *taskPersistenceClient) DeleteTaskQueue(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceDeleteTaskQueueScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceDeleteTaskQueueScope, caller, startTime, retErr)
 	}()
 	return p.persistence.DeleteTaskQueue(ctx, request)
 }
@@ -645,7 +656,7 @@ func (p *taskPersistenceClient) GetTaskQueueUserData(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetTaskQueueUserDataScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetTaskQueueUserDataScope, caller, startTime, retErr)
 	}()
 	return p.persistence.GetTaskQueueUserData(ctx, request)
 }
@@ -658,7 +669,7 @@ func (p *taskPersistenceClient) UpdateTaskQueueUserData(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceUpdateTaskQueueUserDataScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceUpdateTaskQueueUserDataScope, caller, startTime, retErr)
 	}()
 	return p.persistence.UpdateTaskQueueUserData(ctx, request)
 }
@@ -671,7 +682,7 @@ func (p *taskPersistenceClient) ListTaskQueueUserDataEntries(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceListTaskQueueUserDataEntriesScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceListTaskQueueUserDataEntriesScope, caller, startTime, retErr)
 	}()
 	return p.persistence.ListTaskQueueUserDataEntries(ctx, request)
 }
@@ -681,7 +692,7 @@ func (p *taskPersistenceClient) GetTaskQueuesByBuildId(ctx context.Context, requ
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetTaskQueuesByBuildIdScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetTaskQueuesByBuildIdScope, caller, startTime, retErr)
 	}()
 	return p.persistence.GetTaskQueuesByBuildId(ctx, request)
 }
@@ -691,7 +702,7 @@ func (p *taskPersistenceClient) CountTaskQueuesByBuildId(ctx context.Context, re
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceCountTaskQueuesByBuildIdScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceCountTaskQueuesByBuildIdScope, caller, startTime, retErr)
 	}()
 	return p.persistence.CountTaskQueuesByBuildId(ctx, request)
 }
@@ -712,7 +723,7 @@ func (p *metadataPersistenceClient) CreateNamespace(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceCreateNamespaceScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceCreateNamespaceScope, caller, startTime, retErr)
 	}()
 	return p.persistence.CreateNamespace(ctx, request)
 }
@@ -725,7 +736,7 @@ func (p *metadataPersistenceClient) GetNamespace(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetNamespaceScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetNamespaceScope, caller, startTime, retErr)
 	}()
 	return p.persistence.GetNamespace(ctx, request)
 }
@@ -738,7 +749,7 @@ func (p *metadataPersistenceClient) UpdateNamespace(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceUpdateNamespaceScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceUpdateNamespaceScope, caller, startTime, retErr)
 	}()
 	return p.persistence.UpdateNamespace(ctx, request)
 }
@@ -751,7 +762,7 @@ func (p *metadataPersistenceClient) RenameNamespace(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceRenameNamespaceScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceRenameNamespaceScope, caller, startTime, retErr)
 	}()
 	return p.persistence.RenameNamespace(ctx, request)
 }
@@ -764,7 +775,7 @@ func (p *metadataPersistenceClient) DeleteNamespace(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceDeleteNamespaceScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceDeleteNamespaceScope, caller, startTime, retErr)
 	}()
 	return p.persistence.DeleteNamespace(ctx, request)
 }
@@ -777,7 +788,7 @@ func (p *metadataPersistenceClient) DeleteNamespaceByName(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceDeleteNamespaceByNameScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceDeleteNamespaceByNameScope, caller, startTime, retErr)
 	}()
 	return p.persistence.DeleteNamespaceByName(ctx, request)
 }
@@ -790,7 +801,7 @@ func (p *metadataPersistenceClient) ListNamespaces(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceListNamespacesScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceListNamespacesScope, caller, startTime, retErr)
 	}()
 	return p.persistence.ListNamespaces(ctx, request)
 }
@@ -802,7 +813,7 @@ func (p *metadataPersistenceClient) GetMetadata(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetMetadataScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetMetadataScope, caller, startTime, retErr)
 	}()
 	return p.persistence.GetMetadata(ctx)
 }
@@ -820,7 +831,7 @@ func (p *executionPersistenceClient) AppendHistoryNodes(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceAppendHistoryNodesScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceAppendHistoryNodesScope, caller, startTime, retErr)
 	}()
 	return p.persistence.AppendHistoryNodes(ctx, request)
 }
@@ -834,7 +845,7 @@ func (p *executionPersistenceClient) AppendRawHistoryNodes(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceAppendRawHistoryNodesScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceAppendRawHistoryNodesScope, caller, startTime, retErr)
 	}()
 	return p.persistence.AppendRawHistoryNodes(ctx, request)
 }
@@ -847,7 +858,7 @@ func (p *executionPersistenceClient) ReadHistoryBranch(
 	caller := headers.GetCallerInfo(ctx).CallerName
 	startTime := time.Now().UTC()
 	defer func() {
-		p.recordRequestMetrics(metrics.PersistenceReadHistoryBranchScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceReadHistoryBranchScope, caller, startTime, retErr)
 	}()
 	return p.persistence.ReadHistoryBranch(ctx, request)
 }
@@ -859,7 +870,7 @@ func (p *executionPersistenceClient) ReadHistoryBranchReverse(
 	caller := headers.GetCallerInfo(ctx).CallerName
 	startTime := time.Now().UTC()
 	defer func() {
-		p.recordRequestMetrics(metrics.PersistenceReadHistoryBranchReverseScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceReadHistoryBranchReverseScope, caller, startTime, retErr)
 	}()
 	return p.persistence.ReadHistoryBranchReverse(ctx, request)
 }
@@ -872,7 +883,7 @@ func (p *executionPersistenceClient) ReadHistoryBranchByBatch(
 	caller := headers.GetCallerInfo(ctx).CallerName
 	startTime := time.Now().UTC()
 	defer func() {
-		p.recordRequestMetrics(metrics.PersistenceReadHistoryBranchScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceReadHistoryBranchScope, caller, startTime, retErr)
 	}()
 	return p.persistence.ReadHistoryBranchByBatch(ctx, request)
 }
@@ -885,7 +896,7 @@ func (p *executionPersistenceClient) ReadRawHistoryBranch(
 	caller := headers.GetCallerInfo(ctx).CallerName
 	startTime := time.Now().UTC()
 	defer func() {
-		p.recordRequestMetrics(metrics.PersistenceReadRawHistoryBranchScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceReadRawHistoryBranchScope, caller, startTime, retErr)
 	}()
 	return p.persistence.ReadRawHistoryBranch(ctx, request)
 }
@@ -898,7 +909,7 @@ func (p *executionPersistenceClient) ForkHistoryBranch(
 	caller := headers.GetCallerInfo(ctx).CallerName
 	startTime := time.Now().UTC()
 	defer func() {
-		p.recordRequestMetrics(metrics.PersistenceForkHistoryBranchScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceForkHistoryBranchScope, caller, startTime, retErr)
 	}()
 	return p.persistence.ForkHistoryBranch(ctx, request)
 }
@@ -911,7 +922,7 @@ func (p *executionPersistenceClient) DeleteHistoryBranch(
 	caller := headers.GetCallerInfo(ctx).CallerName
 	startTime := time.Now().UTC()
 	defer func() {
-		p.recordRequestMetrics(metrics.PersistenceDeleteHistoryBranchScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceDeleteHistoryBranchScope, caller, startTime, retErr)
 	}()
 	return p.persistence.DeleteHistoryBranch(ctx, request)
 }
@@ -925,7 +936,7 @@ func (p *executionPersistenceClient) TrimHistoryBranch(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceTrimHistoryBranchScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceTrimHistoryBranchScope, caller, startTime, retErr)
 	}()
 	return p.persistence.TrimHistoryBranch(ctx, request)
 }
@@ -938,7 +949,7 @@ func (p *executionPersistenceClient) GetAllHistoryTreeBranches(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetAllHistoryTreeBranchesScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetAllHistoryTreeBranchesScope, caller, startTime, retErr)
 	}()
 	return p.persistence.GetAllHistoryTreeBranches(ctx, request)
 }
@@ -958,7 +969,7 @@ func (p *queuePersistenceClient) EnqueueMessage(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceEnqueueMessageScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceEnqueueMessageScope, caller, startTime, retErr)
 	}()
 	return p.persistence.EnqueueMessage(ctx, blob)
 }
@@ -972,7 +983,7 @@ func (p *queuePersistenceClient) ReadMessages(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceReadQueueMessagesScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceReadQueueMessagesScope, caller, startTime, retErr)
 	}()
 	return p.persistence.ReadMessages(ctx, lastMessageID, maxCount)
 }
@@ -985,7 +996,7 @@ func (p *queuePersistenceClient) UpdateAckLevel(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceUpdateAckLevelScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceUpdateAckLevelScope, caller, startTime, retErr)
 	}()
 	return p.persistence.UpdateAckLevel(ctx, metadata)
 }
@@ -997,7 +1008,7 @@ func (p *queuePersistenceClient) GetAckLevels(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetAckLevelScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetAckLevelScope, caller, startTime, retErr)
 	}()
 	return p.persistence.GetAckLevels(ctx)
 }
@@ -1010,7 +1021,7 @@ func (p *queuePersistenceClient) DeleteMessagesBefore(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceDeleteMessagesBeforeScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceDeleteMessagesBeforeScope, caller, startTime, retErr)
 	}()
 	return p.persistence.DeleteMessagesBefore(ctx, messageID)
 }
@@ -1023,7 +1034,7 @@ func (p *queuePersistenceClient) EnqueueMessageToDLQ(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceEnqueueMessageToDLQScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceEnqueueMessageToDLQScope, caller, startTime, retErr)
 	}()
 	return p.persistence.EnqueueMessageToDLQ(ctx, blob)
 }
@@ -1039,7 +1050,7 @@ func (p *queuePersistenceClient) ReadMessagesFromDLQ(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceReadMessagesFromDLQScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceReadMessagesFromDLQScope, caller, startTime, retErr)
 	}()
 	return p.persistence.ReadMessagesFromDLQ(ctx, firstMessageID, lastMessageID, pageSize, pageToken)
 }
@@ -1052,7 +1063,7 @@ func (p *queuePersistenceClient) DeleteMessageFromDLQ(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceDeleteMessageFromDLQScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceDeleteMessageFromDLQScope, caller, startTime, retErr)
 	}()
 	return p.persistence.DeleteMessageFromDLQ(ctx, messageID)
 }
@@ -1066,7 +1077,7 @@ func (p *queuePersistenceClient) RangeDeleteMessagesFromDLQ(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceRangeDeleteMessagesFromDLQScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceRangeDeleteMessagesFromDLQScope, caller, startTime, retErr)
 	}()
 	return p.persistence.RangeDeleteMessagesFromDLQ(ctx, firstMessageID, lastMessageID)
 }
@@ -1079,7 +1090,7 @@ func (p *queuePersistenceClient) UpdateDLQAckLevel(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceUpdateDLQAckLevelScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceUpdateDLQAckLevelScope, caller, startTime, retErr)
 	}()
 	return p.persistence.UpdateDLQAckLevel(ctx, metadata)
 }
@@ -1091,7 +1102,7 @@ func (p *queuePersistenceClient) GetDLQAckLevels(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetDLQAckLevelScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetDLQAckLevelScope, caller, startTime, retErr)
 	}()
 	return p.persistence.GetDLQAckLevels(ctx)
 }
@@ -1112,7 +1123,7 @@ func (p *clusterMetadataPersistenceClient) ListClusterMetadata(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceListClusterMetadataScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceListClusterMetadataScope, caller, startTime, retErr)
 	}()
 	return p.persistence.ListClusterMetadata(ctx, request)
 }
@@ -1124,7 +1135,7 @@ func (p *clusterMetadataPersistenceClient) GetCurrentClusterMetadata(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetCurrentClusterMetadataScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetCurrentClusterMetadataScope, caller, startTime, retErr)
 	}()
 	return p.persistence.GetCurrentClusterMetadata(ctx)
 }
@@ -1137,7 +1148,7 @@ func (p *clusterMetadataPersistenceClient) GetClusterMetadata(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetClusterMetadataScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetClusterMetadataScope, caller, startTime, retErr)
 	}()
 	return p.persistence.GetClusterMetadata(ctx, request)
 }
@@ -1150,7 +1161,7 @@ func (p *clusterMetadataPersistenceClient) SaveClusterMetadata(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceSaveClusterMetadataScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceSaveClusterMetadataScope, caller, startTime, retErr)
 	}()
 	return p.persistence.SaveClusterMetadata(ctx, request)
 }
@@ -1163,7 +1174,7 @@ func (p *clusterMetadataPersistenceClient) DeleteClusterMetadata(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceDeleteClusterMetadataScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceDeleteClusterMetadataScope, caller, startTime, retErr)
 	}()
 	return p.persistence.DeleteClusterMetadata(ctx, request)
 }
@@ -1180,7 +1191,7 @@ func (p *clusterMetadataPersistenceClient) GetClusterMembers(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetClusterMembersScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetClusterMembersScope, caller, startTime, retErr)
 	}()
 	return p.persistence.GetClusterMembers(ctx, request)
 }
@@ -1193,7 +1204,7 @@ func (p *clusterMetadataPersistenceClient) UpsertClusterMembership(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceUpsertClusterMembershipScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceUpsertClusterMembershipScope, caller, startTime, retErr)
 	}()
 	return p.persistence.UpsertClusterMembership(ctx, request)
 }
@@ -1206,7 +1217,7 @@ func (p *clusterMetadataPersistenceClient) PruneClusterMembership(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistencePruneClusterMembershipScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistencePruneClusterMembershipScope, caller, startTime, retErr)
 	}()
 	return p.persistence.PruneClusterMembership(ctx, request)
 }
@@ -1219,7 +1230,7 @@ func (p *metadataPersistenceClient) InitializeSystemNamespaces(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceInitializeSystemNamespaceScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceInitializeSystemNamespaceScope, caller, startTime, retErr)
 	}()
 	return p.persistence.InitializeSystemNamespaces(ctx, currentClusterName)
 }
@@ -1240,7 +1251,7 @@ func (p *nexusEndpointPersistenceClient) GetNexusEndpoint(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetNexusEndpointScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetNexusEndpointScope, caller, startTime, retErr)
 	}()
 	return p.persistence.GetNexusEndpoint(ctx, request)
 }
@@ -1253,7 +1264,7 @@ func (p *nexusEndpointPersistenceClient) ListNexusEndpoints(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceListNexusEndpointsScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceListNexusEndpointsScope, caller, startTime, retErr)
 	}()
 	return p.persistence.ListNexusEndpoints(ctx, request)
 }
@@ -1266,7 +1277,7 @@ func (p *nexusEndpointPersistenceClient) CreateOrUpdateNexusEndpoint(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceCreateOrUpdateNexusEndpointScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceCreateOrUpdateNexusEndpointScope, caller, startTime, retErr)
 	}()
 	return p.persistence.CreateOrUpdateNexusEndpoint(ctx, request)
 }
@@ -1279,16 +1290,42 @@ func (p *nexusEndpointPersistenceClient) DeleteNexusEndpoint(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceDeleteNexusEndpointScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceDeleteNexusEndpointScope, caller, startTime, retErr)
 	}()
 	return p.persistence.DeleteNexusEndpoint(ctx, request)
 }
 
-func (p *metricEmitter) recordRequestMetrics(operation string, caller string, latency time.Duration, err error) {
+func (p *metricEmitter) recordRequestMetrics(ctx context.Context, operation string, caller string, startTime time.Time, err error) {
 	handler := p.metricsHandler.WithTags(metrics.OperationTag(operation), metrics.NamespaceTag(caller))
 	metrics.PersistenceRequests.With(handler).Record(1)
+	latency := time.Since(startTime)
 	metrics.PersistenceLatency.With(handler).Record(latency)
+	metrics.ContextCounterAdd(ctx, metrics.PersistenceLatency.Name(), latency.Nanoseconds())
 	updateErrorMetric(handler, p.logger, operation, err)
+	recordRequestSpan(ctx, operation, caller, startTime, err)
+}
+
+// recordRequestSpan emits a client span covering a persistence request if the request is part of a trace.
+func recordRequestSpan(ctx context.Context, operation string, caller string, startTime time.Time, err error) {
+	parent := trace.SpanFromContext(ctx)
+	if !parent.SpanContext().IsValid() {
+		return
+	}
+	_, span := parent.TracerProvider().Tracer(tracerName).Start(
+		ctx,
+		"persistence/"+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithTimestamp(startTime),
+		trace.WithAttributes(
+			attribute.String("db.operation", operation),
+			attribute.String("temporal.persistence.caller", caller),
+		),
+	)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
 }
 
 func updateErrorMetric(handler metrics.Handler, logger log.Logger, operation string, err error) {
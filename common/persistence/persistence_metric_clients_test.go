@@ -0,0 +1,73 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPersistenceMetricsClient_RequestSpan(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	exporter := tracetest.NewInMemoryExporter()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)).Tracer("test")
+
+	mockMgr := persistence.NewMockShardManager(ctrl)
+	client := persistence.NewShardPersistenceMetricsClient(
+		mockMgr,
+		metrics.NoopMetricsHandler,
+		persistence.NoopHealthSignalAggregator,
+		log.NewNoopLogger(),
+	)
+	request := &persistence.UpdateShardRequest{ShardInfo: &persistencespb.ShardInfo{ShardId: 1}}
+
+	// No span is emitted for requests which are not part of a trace.
+	mockMgr.EXPECT().UpdateShard(gomock.Any(), request).Return(nil)
+	require.NoError(t, client.UpdateShard(context.Background(), request))
+	require.Empty(t, exporter.GetSpans())
+
+	ctx, parent := tracer.Start(context.Background(), "parent")
+	mockMgr.EXPECT().UpdateShard(gomock.Any(), request).Return(serviceerror.NewUnavailable("unavailable"))
+	require.Error(t, client.UpdateShard(ctx, request))
+	parent.End()
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+	span := spans[0]
+	require.Equal(t, "persistence/UpdateShard", span.Name)
+	require.Equal(t, trace.SpanKindClient, span.SpanKind)
+	require.Equal(t, parent.SpanContext().SpanID(), span.Parent.SpanID())
+	require.Equal(t, codes.Error, span.Status.Code)
+}
@@ -0,0 +1,194 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strings"
+
+	embeddedschema "go.temporal.io/server/schema"
+)
+
+type (
+	// TableShape is the set of columns of each table of a keyspace/database, all names are lower case.
+	TableShape map[string]map[string]struct{}
+
+	// TableShapeDiff lists the tables and columns expected by the binary which are missing in the keyspace/database.
+	TableShapeDiff struct {
+		MissingTables  []string
+		MissingColumns map[string][]string
+	}
+)
+
+var (
+	createTableRegex  = regexp.MustCompile(`(?i)CREATE\s+TABLE\s+(?:IF\s+NOT\s+EXISTS\s+)?([\w."` + "`" + `]+)\s*\(`)
+	lineCommentRegex  = regexp.MustCompile(`--[^\n]*`)
+	blockCommentRegex = regexp.MustCompile(`(?s)/\*.*?\*/`)
+
+	// tableConstraintKeywords are the first words of the definitions in a CREATE TABLE statement which are not columns
+	tableConstraintKeywords = map[string]struct{}{
+		"primary":    {},
+		"key":        {},
+		"index":      {},
+		"unique":     {},
+		"constraint": {},
+		"foreign":    {},
+		"check":      {},
+		"exclude":    {},
+		"fulltext":   {},
+	}
+)
+
+// Add adds a column of a table to the shape.
+func (s TableShape) Add(table string, column string) {
+	table = strings.ToLower(table)
+	columns, ok := s[table]
+	if !ok {
+		columns = make(map[string]struct{})
+		s[table] = columns
+	}
+	columns[strings.ToLower(column)] = struct{}{}
+}
+
+// ReadExpectedTableShape returns the shape of the tables created by a schema file embedded in the binary, e.g.
+// mysql/v8/temporal/schema.sql.
+func ReadExpectedTableShape(schemaFile string) (TableShape, error) {
+	content, err := fs.ReadFile(embeddedschema.Assets(), schemaFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read embedded schema file %s: %w", schemaFile, err)
+	}
+	return ParseTableShape(string(content)), nil
+}
+
+// ParseTableShape returns the shape of the tables created by the CREATE TABLE statements of a cql or sql schema.
+func ParseTableShape(statements string) TableShape {
+	statements = blockCommentRegex.ReplaceAllString(statements, "")
+	statements = lineCommentRegex.ReplaceAllString(statements, "")
+
+	shape := make(TableShape)
+	for _, match := range createTableRegex.FindAllStringSubmatchIndex(statements, -1) {
+		table := unquoteIdentifier(statements[match[2]:match[3]])
+		if i := strings.LastIndex(table, "."); i >= 0 {
+			table = table[i+1:]
+		}
+		shape[strings.ToLower(table)] = make(map[string]struct{})
+		for _, definition := range splitTableDefinitions(statements[match[1]:]) {
+			fields := strings.Fields(definition)
+			if len(fields) == 0 {
+				continue
+			}
+			if _, ok := tableConstraintKeywords[strings.ToLower(fields[0])]; ok {
+				continue
+			}
+			shape.Add(table, unquoteIdentifier(fields[0]))
+		}
+	}
+	return shape
+}
+
+// splitTableDefinitions splits the body of a CREATE TABLE statement, starting right after its opening parenthesis, into
+// its column and constraint definitions.
+func splitTableDefinitions(body string) []string {
+	var definitions []string
+	parenDepth, angleDepth := 0, 0
+	start := 0
+	for i, c := range body {
+		switch c {
+		case '(':
+			parenDepth++
+		case ')':
+			if parenDepth == 0 {
+				return append(definitions, body[start:i])
+			}
+			parenDepth--
+		case '<':
+			// cql collection types, e.g. map<int, blob>
+			if parenDepth == 0 {
+				angleDepth++
+			}
+		case '>':
+			if parenDepth == 0 && angleDepth > 0 {
+				angleDepth--
+			}
+		case ',':
+			if parenDepth == 0 && angleDepth == 0 {
+				definitions = append(definitions, body[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(definitions, body[start:])
+}
+
+func unquoteIdentifier(identifier string) string {
+	return strings.NewReplacer("`", "", `"`, "").Replace(identifier)
+}
+
+// DiffTableShape returns the tables and columns of the expected shape which are missing in the actual one. Extra
+// tables and columns are fine: they may have been added by a newer, backward compatible, schema version.
+func DiffTableShape(expected TableShape, actual TableShape) *TableShapeDiff {
+	diff := &TableShapeDiff{MissingColumns: make(map[string][]string)}
+	for table, expectedColumns := range expected {
+		actualColumns, ok := actual[table]
+		if !ok {
+			diff.MissingTables = append(diff.MissingTables, table)
+			continue
+		}
+		for column := range expectedColumns {
+			if _, ok := actualColumns[column]; !ok {
+				diff.MissingColumns[table] = append(diff.MissingColumns[table], column)
+			}
+		}
+		sort.Strings(diff.MissingColumns[table])
+	}
+	sort.Strings(diff.MissingTables)
+	return diff
+}
+
+// Empty returns true if no table or column is missing.
+func (d *TableShapeDiff) Empty() bool {
+	return len(d.MissingTables) == 0 && len(d.MissingColumns) == 0
+}
+
+func (d *TableShapeDiff) String() string {
+	var lines []string
+	for _, table := range d.MissingTables {
+		lines = append(lines, fmt.Sprintf("  missing table %s", table))
+	}
+	tables := make([]string, 0, len(d.MissingColumns))
+	for table := range d.MissingColumns {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	for _, table := range tables {
+		for _, column := range d.MissingColumns[table] {
+			lines = append(lines, fmt.Sprintf("  missing column %s.%s", table, column))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
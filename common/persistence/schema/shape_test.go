@@ -0,0 +1,150 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testSchemaReader struct {
+	version    string
+	shape      TableShape
+	versionErr error
+	shapeErr   error
+}
+
+func (r *testSchemaReader) ReadSchemaVersion(string) (string, error) {
+	return r.version, r.versionErr
+}
+
+func (r *testSchemaReader) ReadTableShape(string) (TableShape, error) {
+	return r.shape, r.shapeErr
+}
+
+func TestParseTableShape(t *testing.T) {
+	shape := ParseTableShape(`
+/* block comment, CREATE TABLE ignored (a INT) */
+CREATE TABLE executions(
+  shard_id INT NOT NULL,
+  -- comment, with a comma
+  ` + "`data`" + ` MEDIUMBLOB,
+  TemporalChangeVersion JSON GENERATED ALWAYS AS (search_attributes->"$.TemporalChangeVersion"),
+  PRIMARY KEY (shard_id)
+);
+
+CREATE INDEX by_shard ON executions (shard_id);
+
+CREATE TABLE IF NOT EXISTS tasks (
+  task_queue_name text,
+  activity_map map<bigint, blob>,
+  INDEX by_name (task_queue_name),
+  PRIMARY KEY ((task_queue_name), activity_map)
+) WITH COMPACTION = {
+    'class': 'org.apache.cassandra.db.compaction.LeveledCompactionStrategy'
+  };
+`)
+	require.Equal(t, TableShape{
+		"executions": {"shard_id": {}, "data": {}, "temporalchangeversion": {}},
+		"tasks":      {"task_queue_name": {}, "activity_map": {}},
+	}, shape)
+}
+
+func TestDiffTableShape(t *testing.T) {
+	expected := TableShape{
+		"executions": {"shard_id": {}, "data": {}, "start_time": {}},
+		"tasks":      {"task_id": {}},
+	}
+	actual := TableShape{
+		"executions":     {"shard_id": {}, "data": {}, "extra": {}},
+		"schema_version": {"curr_version": {}},
+	}
+	diff := DiffTableShape(expected, actual)
+	require.False(t, diff.Empty())
+	require.Equal(t, []string{"tasks"}, diff.MissingTables)
+	require.Equal(t, map[string][]string{"executions": {"start_time"}}, diff.MissingColumns)
+	require.Equal(t, "  missing table tasks\n  missing column executions.start_time", diff.String())
+
+	require.True(t, DiffTableShape(expected, expected).Empty())
+}
+
+func TestVerifyCompatibleSchema(t *testing.T) {
+	const schemaFile = "mysql/v8/visibility/schema.sql"
+	expected, err := ReadExpectedTableShape(schemaFile)
+	require.NoError(t, err)
+	require.Contains(t, expected, "executions_visibility")
+
+	partial := make(TableShape)
+	for table, columns := range expected {
+		for column := range columns {
+			if column != "root_run_id" {
+				partial.Add(table, column)
+			}
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		reader        *testSchemaReader
+		expectedError string
+	}{
+		{
+			name:   "compatible",
+			reader: &testSchemaReader{version: "1.9", shape: expected},
+		},
+		{
+			name:          "partially upgraded",
+			reader:        &testSchemaReader{version: "1.9", shape: partial},
+			expectedError: "missing column executions_visibility.root_run_id",
+		},
+		{
+			name:          "old version",
+			reader:        &testSchemaReader{version: "1.8", shape: partial},
+			expectedError: "Expected version: 1.9 cannot be greater than Actual version: 1.8. Schema differences",
+		},
+		{
+			name:          "old version without shape",
+			reader:        &testSchemaReader{version: "1.8", shapeErr: errors.New("access denied")},
+			expectedError: "Expected version: 1.9 cannot be greater than Actual version: 1.8",
+		},
+		{
+			name:   "shape not readable",
+			reader: &testSchemaReader{version: "1.9", shapeErr: errors.New("access denied")},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := VerifyCompatibleSchema(tc.reader, "temporal_visibility", "1.9", schemaFile)
+			if tc.expectedError == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.expectedError)
+		})
+	}
+}
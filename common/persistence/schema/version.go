@@ -25,6 +25,7 @@
 package schema
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/blang/semver/v4"
@@ -52,3 +53,36 @@ func VerifyCompatibleVersion(
 	}
 	return nil
 }
+
+// VerifyCompatibleSchema ensures that the installed version is greater than or equal to the expected version and that
+// the keyspace/database has all the tables and columns of the expected schema file, so that a partially applied schema
+// upgrade is reported at startup instead of failing queries later on.
+func VerifyCompatibleSchema(
+	schemaReader SchemaReader,
+	dbName string,
+	expectedVersion string,
+	expectedSchemaFile string,
+) error {
+
+	versionErr := VerifyCompatibleVersion(schemaReader, dbName, expectedVersion)
+
+	expectedShape, err := ReadExpectedTableShape(expectedSchemaFile)
+	if err != nil {
+		return errors.Join(versionErr, err)
+	}
+	actualShape, err := schemaReader.ReadTableShape(dbName)
+	if err != nil {
+		// the tables are only checked to explain incompatibilities, the version is authoritative
+		return versionErr
+	}
+	diff := DiffTableShape(expectedShape, actualShape)
+	if diff.Empty() {
+		return versionErr
+	}
+	if versionErr != nil {
+		return fmt.Errorf("%w. Schema differences with %s:\n%v", versionErr, expectedSchemaFile, diff)
+	}
+	return fmt.Errorf("keyspace/database %q is missing tables or columns of version %s, the last schema upgrade was likely "+
+		"only partially applied. Re-run the schema update to version %s. Schema differences with %s:\n%v",
+		dbName, expectedVersion, expectedVersion, expectedSchemaFile, diff)
+}
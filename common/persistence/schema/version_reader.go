@@ -29,4 +29,14 @@ type (
 		// ReadSchemaVersion returns the current schema version for the keyspace
 		ReadSchemaVersion(dbName string) (string, error)
 	}
+
+	TableShapeReader interface {
+		// ReadTableShape returns the columns of each table in the keyspace
+		ReadTableShape(dbName string) (TableShape, error)
+	}
+
+	SchemaReader interface {
+		VersionReader
+		TableShapeReader
+	}
 )
@@ -106,6 +106,15 @@ func OutboundTaskInfoFromBlob(blob []byte, encoding string) (*persistencespb.Out
 	return result, proto3Decode(blob, encoding, result)
 }
 
+func WorkflowLifecycleEventTaskInfoToBlob(info *persistencespb.WorkflowLifecycleEventTaskInfo) (*commonpb.DataBlob, error) {
+	return proto3Encode(info)
+}
+
+func WorkflowLifecycleEventTaskInfoFromBlob(blob []byte, encoding string) (*persistencespb.WorkflowLifecycleEventTaskInfo, error) {
+	result := &persistencespb.WorkflowLifecycleEventTaskInfo{}
+	return result, proto3Decode(blob, encoding, result)
+}
+
 func QueueMetadataToBlob(metadata *persistencespb.QueueMetadata) (*commonpb.DataBlob, error) {
 	// TODO change ENCODING_TYPE_JSON to ENCODING_TYPE_PROTO3
 	return encode(metadata, enumspb.ENCODING_TYPE_JSON)
@@ -37,6 +37,7 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/utf8validator"
 	"go.temporal.io/server/service/history/tasks"
 	"google.golang.org/protobuf/proto"
@@ -175,6 +176,9 @@ func (t *serializerImpl) DeserializeEvents(data *commonpb.DataBlob) ([]*historyp
 	if err == nil {
 		err = utf8validator.Validate(events, utf8validator.SourcePersistence)
 	}
+	if err == nil {
+		err = payload.DecompressEvents(events.Events...)
+	}
 	if err != nil {
 		return nil, NewDeserializationError(enumspb.ENCODING_TYPE_PROTO3, err)
 	}
@@ -208,6 +212,9 @@ func (t *serializerImpl) DeserializeEvent(data *commonpb.DataBlob) (*historypb.H
 	if err == nil {
 		err = utf8validator.Validate(event, utf8validator.SourcePersistence)
 	}
+	if err == nil {
+		err = payload.DecompressEvents(event)
+	}
 	if err != nil {
 		return nil, NewDeserializationError(enumspb.ENCODING_TYPE_PROTO3, err)
 	}
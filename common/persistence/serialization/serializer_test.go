@@ -26,17 +26,20 @@ package serialization
 
 import (
 	"math/rand"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/testing/fakedata"
 	"go.temporal.io/server/common/testing/protorequire"
@@ -155,6 +158,37 @@ func (s *temporalSerializerSuite) TestSerializer() {
 	s.True(succ, "test timed out")
 }
 
+func (s *temporalSerializerSuite) TestDeserializeEvents_DecompressesPayloads() {
+	input := payloads.EncodeString(strings.Repeat("input", 100))
+	compressed, err := payload.Compress(input)
+	s.NoError(err)
+	event := &historypb.HistoryEvent{
+		EventId:   1,
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+		Attributes: &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{
+			WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{Input: compressed},
+		},
+	}
+	expected := common.CloneProto(event)
+	expected.GetWorkflowExecutionSignaledEventAttributes().Input = input
+
+	// readers of raw history, e.g. replication and tdbg, get the original payloads back when deserializing events
+	blob, err := s.serializer.SerializeEvents([]*historypb.HistoryEvent{event}, enumspb.ENCODING_TYPE_PROTO3)
+	s.NoError(err)
+	events, err := s.serializer.DeserializeEvents(blob)
+	s.NoError(err)
+	s.ProtoEqual(expected, events[0])
+
+	blob, err = s.serializer.SerializeEvent(event, enumspb.ENCODING_TYPE_PROTO3)
+	s.NoError(err)
+	deserialized, err := s.serializer.DeserializeEvent(blob)
+	s.NoError(err)
+	s.ProtoEqual(expected, deserialized)
+
+	_, err = s.serializer.DeserializeEvents(&commonpb.DataBlob{})
+	s.NoError(err)
+}
+
 func (s *temporalSerializerSuite) TestSerializeShardInfo_EmptyMapSlice() {
 	var shardInfo persistencespb.ShardInfo
 
@@ -26,6 +26,7 @@ package serialization
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
 	commonpb "go.temporal.io/api/common/v1"
@@ -36,6 +37,7 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/service/history/tasks"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -44,6 +46,21 @@ type (
 	}
 )
 
+var (
+	// The task infos of the transfer, timer and visibility categories are only read while being converted to tasks,
+	// which copy what they need out of them, so they are reused across deserializations. Those categories are read
+	// for every state transition.
+	transferTaskInfoPool = sync.Pool{
+		New: func() any { return &persistencespb.TransferTaskInfo{} },
+	}
+	timerTaskInfoPool = sync.Pool{
+		New: func() any { return &persistencespb.TimerTaskInfo{} },
+	}
+	visibilityTaskInfoPool = sync.Pool{
+		New: func() any { return &persistencespb.VisibilityTaskInfo{} },
+	}
+)
+
 func NewTaskSerializer() *TaskSerializer {
 	return &TaskSerializer{}
 }
@@ -65,6 +82,8 @@ func (s *TaskSerializer) SerializeTask(
 		return s.serializeArchivalTask(task)
 	case tasks.CategoryIDOutbound:
 		return s.serializeOutboundTask(task)
+	case tasks.CategoryIDWorkflowLifecycleEvent:
+		return s.serializeWorkflowLifecycleEventTask(task)
 	default:
 		return nil, serviceerror.NewInternal(fmt.Sprintf("Unknown task category: %v", category))
 	}
@@ -87,6 +106,8 @@ func (s *TaskSerializer) DeserializeTask(
 		return s.deserializeArchivalTasks(blob)
 	case tasks.CategoryIDOutbound:
 		return s.deserializeOutboundTask(blob)
+	case tasks.CategoryIDWorkflowLifecycleEvent:
+		return s.deserializeWorkflowLifecycleEventTask(blob)
 	default:
 		return nil, serviceerror.NewInternal(fmt.Sprintf("Unknown task category: %v", category))
 	}
@@ -123,8 +144,9 @@ func (s *TaskSerializer) serializeTransferTask(
 func (s *TaskSerializer) deserializeTransferTasks(
 	blob *commonpb.DataBlob,
 ) (tasks.Task, error) {
-	transferTask, err := TransferTaskInfoFromBlob(blob.Data, blob.EncodingType.String())
-	if err != nil {
+	transferTask := transferTaskInfoPool.Get().(*persistencespb.TransferTaskInfo)
+	defer releaseTaskInfo(&transferTaskInfoPool, transferTask)
+	if err := proto3Decode(blob.Data, blob.EncodingType.String(), transferTask); err != nil {
 		return nil, err
 	}
 
@@ -184,8 +206,9 @@ func (s *TaskSerializer) serializeTimerTask(
 func (s *TaskSerializer) deserializeTimerTasks(
 	blob *commonpb.DataBlob,
 ) (tasks.Task, error) {
-	timerTask, err := TimerTaskInfoFromBlob(blob.Data, blob.EncodingType.String())
-	if err != nil {
+	timerTask := timerTaskInfoPool.Get().(*persistencespb.TimerTaskInfo)
+	defer releaseTaskInfo(&timerTaskInfoPool, timerTask)
+	if err := proto3Decode(blob.Data, blob.EncodingType.String(), timerTask); err != nil {
 		return nil, err
 	}
 
@@ -238,8 +261,9 @@ func (s *TaskSerializer) serializeVisibilityTask(
 func (s *TaskSerializer) deserializeVisibilityTasks(
 	blob *commonpb.DataBlob,
 ) (tasks.Task, error) {
-	visibilityTask, err := VisibilityTaskInfoFromBlob(blob.Data, blob.EncodingType.String())
-	if err != nil {
+	visibilityTask := visibilityTaskInfoPool.Get().(*persistencespb.VisibilityTaskInfo)
+	defer releaseTaskInfo(&visibilityTaskInfoPool, visibilityTask)
+	if err := proto3Decode(blob.Data, blob.EncodingType.String(), visibilityTask); err != nil {
 		return nil, err
 	}
 
@@ -292,6 +316,8 @@ func (s *TaskSerializer) ParseReplicationTask(replicationTask *persistencespb.Re
 		return s.replicationSyncHSMTaskFromProto(replicationTask), nil
 	case enumsspb.TASK_TYPE_REPLICATION_SYNC_VERSIONED_TRANSITION:
 		return s.replicationSyncVersionedTransitionTaskFromProto(replicationTask)
+	case enumsspb.TASK_TYPE_REPLICATION_WRITE_INTENT:
+		return s.replicationWriteIntentTaskFromProto(replicationTask), nil
 	default:
 		return nil, serviceerror.NewInternal(fmt.Sprintf("Unknown replication task type: %v", replicationTask.TaskType))
 	}
@@ -309,6 +335,8 @@ func (s *TaskSerializer) ParseReplicationTaskInfo(task tasks.Task) (*persistence
 		return s.replicationSyncHSMTaskToProto(task), nil
 	case *tasks.SyncVersionedTransitionTask:
 		return s.replicationSyncVersionedTransitionTaskToProto(task)
+	case *tasks.WriteIntentTask:
+		return s.replicationWriteIntentTaskToProto(task), nil
 	default:
 		return nil, serviceerror.NewInternal(fmt.Sprintf("Unknown repication task type: %v", task))
 	}
@@ -1252,6 +1280,41 @@ func (s *TaskSerializer) replicationSyncHSMTaskFromProto(
 	}
 }
 
+func (s *TaskSerializer) replicationWriteIntentTaskToProto(
+	writeIntentTask *tasks.WriteIntentTask,
+) *persistencespb.ReplicationTaskInfo {
+	return &persistencespb.ReplicationTaskInfo{
+		NamespaceId:    writeIntentTask.WorkflowKey.NamespaceID,
+		WorkflowId:     writeIntentTask.WorkflowKey.WorkflowID,
+		RunId:          writeIntentTask.WorkflowKey.RunID,
+		TaskType:       enumsspb.TASK_TYPE_REPLICATION_WRITE_INTENT,
+		TaskId:         writeIntentTask.TaskID,
+		VisibilityTime: timestamppb.New(writeIntentTask.VisibilityTimestamp),
+		SignalRequest:  writeIntentTask.SignalRequest,
+		UpdateRequest:  writeIntentTask.UpdateRequest,
+	}
+}
+
+func (s *TaskSerializer) replicationWriteIntentTaskFromProto(
+	writeIntentTask *persistencespb.ReplicationTaskInfo,
+) *tasks.WriteIntentTask {
+	visibilityTimestamp := time.Unix(0, 0)
+	if writeIntentTask.VisibilityTime != nil {
+		visibilityTimestamp = writeIntentTask.VisibilityTime.AsTime()
+	}
+	return &tasks.WriteIntentTask{
+		WorkflowKey: definition.NewWorkflowKey(
+			writeIntentTask.NamespaceId,
+			writeIntentTask.WorkflowId,
+			writeIntentTask.RunId,
+		),
+		VisibilityTimestamp: visibilityTimestamp,
+		TaskID:              writeIntentTask.TaskId,
+		SignalRequest:       writeIntentTask.SignalRequest,
+		UpdateRequest:       writeIntentTask.UpdateRequest,
+	}
+}
+
 func (s *TaskSerializer) replicationSyncVersionedTransitionTaskToProto(
 	syncVersionedTransitionTask *tasks.SyncVersionedTransitionTask,
 ) (*persistencespb.ReplicationTaskInfo, error) {
@@ -1355,3 +1418,52 @@ func (s *TaskSerializer) deserializeOutboundTask(blob *commonpb.DataBlob) (tasks
 		Destination: info.Destination,
 	}, nil
 }
+
+func (s *TaskSerializer) serializeWorkflowLifecycleEventTask(task tasks.Task) (*commonpb.DataBlob, error) {
+	switch task := task.(type) {
+	case *tasks.WorkflowLifecycleEventTask:
+		return WorkflowLifecycleEventTaskInfoToBlob(&persistencespb.WorkflowLifecycleEventTaskInfo{
+			NamespaceId:    task.NamespaceID,
+			WorkflowId:     task.WorkflowID,
+			RunId:          task.RunID,
+			TaskType:       task.GetType(),
+			TaskId:         task.TaskID,
+			Version:        task.Version,
+			VisibilityTime: timestamppb.New(task.VisibilityTimestamp),
+			EventType:      task.EventType,
+			BaseRunId:      task.BaseRunID,
+			Reason:         task.Reason,
+		})
+	default:
+		return nil, serviceerror.NewInternal(fmt.Sprintf("unknown workflow lifecycle event task type while serializing: %v", task))
+	}
+}
+
+func (s *TaskSerializer) deserializeWorkflowLifecycleEventTask(blob *commonpb.DataBlob) (tasks.Task, error) {
+	info, err := WorkflowLifecycleEventTaskInfoFromBlob(blob.Data, blob.EncodingType.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if info.TaskType != enumsspb.TASK_TYPE_WORKFLOW_LIFECYCLE_EVENT {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("unknown workflow lifecycle event task type while deserializing: %v", info))
+	}
+	return &tasks.WorkflowLifecycleEventTask{
+		WorkflowKey: definition.NewWorkflowKey(
+			info.NamespaceId,
+			info.WorkflowId,
+			info.RunId,
+		),
+		VisibilityTimestamp: info.VisibilityTime.AsTime(),
+		TaskID:              info.TaskId,
+		Version:             info.Version,
+		EventType:           info.EventType,
+		BaseRunID:           info.BaseRunId,
+		Reason:              info.Reason,
+	}, nil
+}
+
+func releaseTaskInfo(pool *sync.Pool, info proto.Message) {
+	proto.Reset(info)
+	pool.Put(info)
+}
@@ -0,0 +1,83 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package serialization
+
+import (
+	"testing"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+func BenchmarkTaskSerializer_DeserializeTransferTask(b *testing.B) {
+	benchmarkDeserializeTask(b, &tasks.ActivityTask{
+		WorkflowKey:         definition.NewWorkflowKey("namespace-id", "workflow-id", "run-id"),
+		VisibilityTimestamp: time.Now().UTC(),
+		TaskID:              1234,
+		TaskQueue:           "task-queue",
+		ScheduledEventID:    5,
+		Version:             1,
+	})
+}
+
+func BenchmarkTaskSerializer_DeserializeTimerTask(b *testing.B) {
+	benchmarkDeserializeTask(b, &tasks.UserTimerTask{
+		WorkflowKey:         definition.NewWorkflowKey("namespace-id", "workflow-id", "run-id"),
+		VisibilityTimestamp: time.Now().UTC(),
+		TaskID:              1234,
+		EventID:             5,
+	})
+}
+
+func BenchmarkTaskSerializer_DeserializeVisibilityTask(b *testing.B) {
+	benchmarkDeserializeTask(b, &tasks.UpsertExecutionVisibilityTask{
+		WorkflowKey:         definition.NewWorkflowKey("namespace-id", "workflow-id", "run-id"),
+		VisibilityTimestamp: time.Now().UTC(),
+		TaskID:              1234,
+	})
+}
+
+func benchmarkDeserializeTask(b *testing.B, task tasks.Task) {
+	serializer := NewTaskSerializer()
+	blob, err := serializer.SerializeTask(task)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if blob.EncodingType != enumspb.ENCODING_TYPE_PROTO3 {
+		b.Fatalf("unexpected encoding type: %v", blob.EncodingType)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := serializer.DeserializeTask(task.GetCategory(), blob); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -34,7 +34,9 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/workflowservice/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/definition"
@@ -351,6 +353,30 @@ func (s *taskSerializerSuite) TestSyncHSMTask() {
 	s.assertEqualTasks(syncHSMTask)
 }
 
+func (s *taskSerializerSuite) TestWriteIntentTask() {
+	writeIntentTask := &tasks.WriteIntentTask{
+		WorkflowKey:         s.workflowKey,
+		VisibilityTimestamp: time.Unix(0, 0).UTC(), // go == compare for location as well which is striped during marshaling/unmarshaling
+		TaskID:              rand.Int63(),
+		SignalRequest: &workflowservice.SignalWorkflowExecutionRequest{
+			Namespace: "namespace",
+			WorkflowExecution: &commonpb.WorkflowExecution{
+				WorkflowId: s.workflowKey.WorkflowID,
+			},
+			SignalName: "signal",
+			RequestId:  uuid.New().String(),
+		},
+	}
+
+	s.assertEqualTasksWithOpts(writeIntentTask,
+		func(task, deserializedTask tasks.Task) {
+			s.True(proto.Equal(task.(*tasks.WriteIntentTask).SignalRequest, deserializedTask.(*tasks.WriteIntentTask).SignalRequest))
+			s.Nil(deserializedTask.(*tasks.WriteIntentTask).UpdateRequest)
+		},
+		cmpopts.IgnoreFields(tasks.WriteIntentTask{}, "SignalRequest"),
+	)
+}
+
 func (s *taskSerializerSuite) TestSyncVersionedTransitionTask() {
 	syncVersionedTransitionTask := &tasks.SyncVersionedTransitionTask{
 		WorkflowKey:         s.workflowKey,
@@ -430,6 +456,22 @@ func (s *taskSerializerSuite) TestArchiveExecutionTask() {
 	s.assertEqualTasks(task)
 }
 
+func (s *taskSerializerSuite) TestWorkflowLifecycleEventTask() {
+	task := &tasks.WorkflowLifecycleEventTask{
+		WorkflowKey:         s.workflowKey,
+		VisibilityTimestamp: time.Unix(0, 0).UTC(), // go == compare for location as well which is striped during marshaling/unmarshaling
+		TaskID:              rand.Int63(),
+		Version:             rand.Int63(),
+		EventType:           enumsspb.WORKFLOW_LIFECYCLE_EVENT_TYPE_RESET,
+		BaseRunID:           uuid.New().String(),
+		Reason:              "some reason",
+	}
+	s.Assert().Equal(tasks.CategoryWorkflowLifecycleEvent, task.GetCategory())
+	s.Assert().Equal(enumsspb.TASK_TYPE_WORKFLOW_LIFECYCLE_EVENT, task.GetType())
+
+	s.assertEqualTasks(task)
+}
+
 func (s *taskSerializerSuite) TestStateMachineOutboundTask() {
 	task := &tasks.StateMachineOutboundTask{
 		StateMachineTask: tasks.StateMachineTask{
@@ -0,0 +1,157 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"database/sql"
+	"time"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+)
+
+type (
+	// PoolConfig holds the dynamic overrides of the connection pool settings of a SQL data store.
+	// Non-positive overrides keep the settings of config.SQL.
+	PoolConfig struct {
+		MaxConns        dynamicconfig.IntPropertyFn
+		MaxIdleConns    dynamicconfig.IntPropertyFn
+		MaxConnLifetime dynamicconfig.DurationPropertyFn
+		MetricsInterval dynamicconfig.DurationPropertyFn
+	}
+
+	// poolManager periodically applies the PoolConfig to a connection pool and emits its metrics
+	poolManager struct {
+		pool           sqlplugin.ConnPool
+		cfg            *config.SQL
+		poolConfig     *PoolConfig
+		metricsHandler metrics.Handler
+
+		limits    sqlplugin.PoolLimits
+		lastStats sql.DBStats
+		stopC     chan struct{}
+	}
+)
+
+// NewPoolConfig returns the PoolConfig of the dynamic config collection
+func NewPoolConfig(dc *dynamicconfig.Collection) *PoolConfig {
+	return &PoolConfig{
+		MaxConns:        dynamicconfig.PersistenceSQLMaxConns.Get(dc),
+		MaxIdleConns:    dynamicconfig.PersistenceSQLMaxIdleConns.Get(dc),
+		MaxConnLifetime: dynamicconfig.PersistenceSQLMaxConnLifetime.Get(dc),
+		MetricsInterval: dynamicconfig.PersistencePoolMetricsInterval.Get(dc),
+	}
+}
+
+// startPoolManager starts managing the connection pool of db, it returns nil if db has no adjustable pool
+func startPoolManager(
+	db sqlplugin.DB,
+	dbKind sqlplugin.DbKind,
+	cfg *config.SQL,
+	poolConfig *PoolConfig,
+	metricsHandler metrics.Handler,
+) *poolManager {
+	pool, ok := db.(sqlplugin.ConnPool)
+	if !ok {
+		return nil
+	}
+	m := &poolManager{
+		pool:           pool,
+		cfg:            cfg,
+		poolConfig:     poolConfig,
+		metricsHandler: metricsHandler.WithTags(metrics.PersistenceStoreTag(cfg.PluginName + "_" + dbKind.String())),
+		limits: sqlplugin.PoolLimits{
+			MaxConns:        cfg.MaxConns,
+			MaxIdleConns:    cfg.MaxIdleConns,
+			MaxConnLifetime: cfg.MaxConnLifetime,
+		},
+		stopC: make(chan struct{}),
+	}
+	m.refresh()
+	go m.run()
+	return m
+}
+
+func (m *poolManager) run() {
+	timer := time.NewTimer(m.poolConfig.MetricsInterval())
+	defer timer.Stop()
+	for {
+		select {
+		case <-m.stopC:
+			return
+		case <-timer.C:
+			m.refresh()
+			timer.Reset(m.poolConfig.MetricsInterval())
+		}
+	}
+}
+
+func (m *poolManager) stop() {
+	close(m.stopC)
+}
+
+func (m *poolManager) refresh() {
+	limits := sqlplugin.PoolLimits{
+		MaxConns:        m.cfg.MaxConns,
+		MaxIdleConns:    m.cfg.MaxIdleConns,
+		MaxConnLifetime: m.cfg.MaxConnLifetime,
+	}
+	if maxConns := m.poolConfig.MaxConns(); maxConns > 0 {
+		limits.MaxConns = maxConns
+	}
+	if maxIdleConns := m.poolConfig.MaxIdleConns(); maxIdleConns > 0 {
+		limits.MaxIdleConns = maxIdleConns
+	}
+	if maxConnLifetime := m.poolConfig.MaxConnLifetime(); maxConnLifetime > 0 {
+		limits.MaxConnLifetime = maxConnLifetime
+	}
+	if limits != m.limits {
+		m.pool.SetPoolLimits(limits)
+		m.limits = limits
+	}
+
+	stats, ok := m.pool.PoolStats()
+	if !ok {
+		return
+	}
+	metrics.PersistencePoolMaxConnections.With(m.metricsHandler).Record(float64(stats.MaxOpenConnections))
+	metrics.PersistencePoolOpenConnections.With(m.metricsHandler).Record(float64(stats.OpenConnections))
+	metrics.PersistencePoolInUseConnections.With(m.metricsHandler).Record(float64(stats.InUse))
+	metrics.PersistencePoolIdleConnections.With(m.metricsHandler).Record(float64(stats.Idle))
+
+	waits, waitDuration := stats.WaitCount, stats.WaitDuration
+	if waits >= m.lastStats.WaitCount {
+		// the counters are cumulative unless the pool was replaced by a reconnect
+		waits -= m.lastStats.WaitCount
+		waitDuration -= m.lastStats.WaitDuration
+	}
+	if waits > 0 {
+		metrics.PersistencePoolWaits.With(m.metricsHandler).Record(waits)
+		metrics.PersistencePoolWaitLatency.With(m.metricsHandler).Record(waitDuration / time.Duration(waits))
+	}
+	m.lastStats = stats
+}
@@ -254,81 +254,95 @@ func (m *sqlExecutionStore) GetWorkflowExecution(
 		DBRecordVersion: executionsRow.DBRecordVersion,
 	}
 
-	state.ActivityInfos, err = getActivityInfoMap(ctx,
-		m.Db,
-		request.ShardID,
-		namespaceID,
-		workflowID,
-		runID,
-	)
-	if err != nil {
-		return nil, serviceerror.NewUnavailable(fmt.Sprintf("GetWorkflowExecution: failed to get activity info. Error: %v", err))
+	if !request.OmitCollections.Contains(p.MutableStateActivityInfos) {
+		state.ActivityInfos, err = getActivityInfoMap(ctx,
+			m.Db,
+			request.ShardID,
+			namespaceID,
+			workflowID,
+			runID,
+		)
+		if err != nil {
+			return nil, serviceerror.NewUnavailable(fmt.Sprintf("GetWorkflowExecution: failed to get activity info. Error: %v", err))
+		}
 	}
 
-	state.TimerInfos, err = getTimerInfoMap(ctx,
-		m.Db,
-		request.ShardID,
-		namespaceID,
-		workflowID,
-		runID,
-	)
-	if err != nil {
-		return nil, serviceerror.NewUnavailable(fmt.Sprintf("GetWorkflowExecution: failed to get timer info. Error: %v", err))
+	if !request.OmitCollections.Contains(p.MutableStateTimerInfos) {
+		state.TimerInfos, err = getTimerInfoMap(ctx,
+			m.Db,
+			request.ShardID,
+			namespaceID,
+			workflowID,
+			runID,
+		)
+		if err != nil {
+			return nil, serviceerror.NewUnavailable(fmt.Sprintf("GetWorkflowExecution: failed to get timer info. Error: %v", err))
+		}
 	}
 
-	state.ChildExecutionInfos, err = getChildExecutionInfoMap(ctx,
-		m.Db,
-		request.ShardID,
-		namespaceID,
-		workflowID,
-		runID,
-	)
-	if err != nil {
-		return nil, serviceerror.NewUnavailable(fmt.Sprintf("GetWorkflowExecution: failed to get child executionsRow info. Error: %v", err))
+	if !request.OmitCollections.Contains(p.MutableStateChildExecutionInfos) {
+		state.ChildExecutionInfos, err = getChildExecutionInfoMap(ctx,
+			m.Db,
+			request.ShardID,
+			namespaceID,
+			workflowID,
+			runID,
+		)
+		if err != nil {
+			return nil, serviceerror.NewUnavailable(fmt.Sprintf("GetWorkflowExecution: failed to get child executionsRow info. Error: %v", err))
+		}
 	}
 
-	state.RequestCancelInfos, err = getRequestCancelInfoMap(ctx,
-		m.Db,
-		request.ShardID,
-		namespaceID,
-		workflowID,
-		runID,
-	)
-	if err != nil {
-		return nil, serviceerror.NewUnavailable(fmt.Sprintf("GetWorkflowExecution: failed to get request cancel info. Error: %v", err))
+	if !request.OmitCollections.Contains(p.MutableStateRequestCancelInfos) {
+		state.RequestCancelInfos, err = getRequestCancelInfoMap(ctx,
+			m.Db,
+			request.ShardID,
+			namespaceID,
+			workflowID,
+			runID,
+		)
+		if err != nil {
+			return nil, serviceerror.NewUnavailable(fmt.Sprintf("GetWorkflowExecution: failed to get request cancel info. Error: %v", err))
+		}
 	}
 
-	state.SignalInfos, err = getSignalInfoMap(ctx,
-		m.Db,
-		request.ShardID,
-		namespaceID,
-		workflowID,
-		runID,
-	)
-	if err != nil {
-		return nil, serviceerror.NewUnavailable(fmt.Sprintf("GetWorkflowExecution: failed to get signal info. Error: %v", err))
+	if !request.OmitCollections.Contains(p.MutableStateSignalInfos) {
+		state.SignalInfos, err = getSignalInfoMap(ctx,
+			m.Db,
+			request.ShardID,
+			namespaceID,
+			workflowID,
+			runID,
+		)
+		if err != nil {
+			return nil, serviceerror.NewUnavailable(fmt.Sprintf("GetWorkflowExecution: failed to get signal info. Error: %v", err))
+		}
 	}
 
-	state.BufferedEvents, err = getBufferedEvents(ctx,
-		m.Db,
-		request.ShardID,
-		namespaceID,
-		workflowID,
-		runID,
-	)
-	if err != nil {
-		return nil, serviceerror.NewUnavailable(fmt.Sprintf("GetWorkflowExecution: failed to get buffered events. Error: %v", err))
+	if !request.OmitCollections.Contains(p.MutableStateBufferedEvents) {
+		state.BufferedEvents, err = getBufferedEvents(ctx,
+			m.Db,
+			request.ShardID,
+			namespaceID,
+			workflowID,
+			runID,
+		)
+		if err != nil {
+			return nil, serviceerror.NewUnavailable(fmt.Sprintf("GetWorkflowExecution: failed to get buffered events. Error: %v", err))
+		}
 	}
 
-	state.SignalRequestedIDs, err = getSignalsRequested(ctx,
-		m.Db,
-		request.ShardID,
-		namespaceID,
-		workflowID,
-		runID,
-	)
-	if err != nil {
-		return nil, serviceerror.NewUnavailable(fmt.Sprintf("GetWorkflowExecution: failed to get signals requested. Error: %v", err))
+	if !request.OmitCollections.Contains(p.MutableStateSignalRequestedIDs) {
+		state.SignalRequestedIDs, err = getSignalsRequested(ctx,
+			m.Db,
+			request.ShardID,
+			namespaceID,
+			workflowID,
+			runID,
+		)
+		if err != nil {
+			return nil, serviceerror.NewUnavailable(fmt.Sprintf("GetWorkflowExecution: failed to get signals requested. Error: %v", err))
+		}
 	}
 
 	return &p.InternalGetWorkflowExecutionResponse{
@@ -57,6 +57,9 @@ type (
 
 		sqlplugin.DB
 
+		poolConfig  *PoolConfig
+		poolManager *poolManager
+
 		sync.Mutex
 		refCnt int
 	}
@@ -79,6 +82,13 @@ func NewFactory(
 	}
 }
 
+// WithPoolConfig makes the factory adjust its connection pool to poolConfig at runtime and emit pool metrics.
+// It must be called before any store is created.
+func (f *Factory) WithPoolConfig(poolConfig *PoolConfig) *Factory {
+	f.mainDBConn.poolConfig = poolConfig
+	return f
+}
+
 // GetDB return a new SQL DB connection
 func (f *Factory) GetDB() (sqlplugin.DB, error) {
 	conn, err := f.mainDBConn.Get()
@@ -198,6 +208,9 @@ func (c *DbConn) Get() (sqlplugin.DB, error) {
 			return nil, err
 		}
 		c.DB = conn
+		if c.poolConfig != nil {
+			c.poolManager = startPoolManager(conn, c.dbKind, c.cfg, c.poolConfig, c.metrics)
+		}
 	}
 	c.refCnt++
 	return c, nil
@@ -207,6 +220,7 @@ func (c *DbConn) Get() (sqlplugin.DB, error) {
 func (c *DbConn) ForceClose() {
 	c.Lock()
 	defer c.Unlock()
+	c.stopPoolManager()
 	if c.DB != nil {
 		err := c.DB.Close()
 		if err != nil {
@@ -222,7 +236,15 @@ func (c *DbConn) Close() error {
 	defer c.Unlock()
 	c.refCnt--
 	if c.refCnt == 0 {
+		c.stopPoolManager()
 		return c.DB.Close()
 	}
 	return nil
 }
+
+func (c *DbConn) stopPoolManager() {
+	if c.poolManager != nil {
+		c.poolManager.stop()
+		c.poolManager = nil
+	}
+}
@@ -58,6 +58,7 @@ type DatabaseHandle struct {
 	needsRefresh func(error) bool
 
 	lastRefresh time.Time
+	poolLimits  atomic.Pointer[PoolLimits]
 	metrics     metrics.Handler
 	logger      log.Logger
 	timeSource  clock.TimeSource
@@ -131,10 +132,41 @@ func (h *DatabaseHandle) reconnect(force bool) *sqlx.DB {
 		return nil
 	}
 
+	if limits := h.poolLimits.Load(); limits != nil {
+		applyPoolLimits(newConn, *limits)
+	}
 	h.db.Store(newConn)
 	return newConn
 }
 
+// SetPoolLimits applies limits to the connection pool, and to the pools of later reconnects.
+func (h *DatabaseHandle) SetPoolLimits(limits PoolLimits) {
+	h.poolLimits.Store(&limits)
+	if db := h.db.Load(); db != nil {
+		applyPoolLimits(db, limits)
+	}
+}
+
+// PoolStats returns the statistics of the current connection pool.
+func (h *DatabaseHandle) PoolStats() (sql.DBStats, bool) {
+	if db := h.db.Load(); db != nil {
+		return db.Stats(), true
+	}
+	return sql.DBStats{}, false
+}
+
+func applyPoolLimits(db *sqlx.DB, limits PoolLimits) {
+	if limits.MaxConns > 0 {
+		db.SetMaxOpenConns(limits.MaxConns)
+	}
+	if limits.MaxIdleConns > 0 {
+		db.SetMaxIdleConns(limits.MaxIdleConns)
+	}
+	if limits.MaxConnLifetime > 0 {
+		db.SetConnMaxLifetime(limits.MaxConnLifetime)
+	}
+}
+
 func (h *DatabaseHandle) Close() {
 	h.Lock()
 	defer h.Unlock()
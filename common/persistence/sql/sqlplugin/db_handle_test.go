@@ -23,6 +23,9 @@
 package sqlplugin
 
 import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
 	"errors"
 	"testing"
 	"time"
@@ -74,4 +77,37 @@ func TestDatabaseHandleReconnect(t *testing.T) {
 	}
 }
 
+// TestDatabaseHandlePoolLimits tests that pool limits apply to the current connection pool and to those of reconnects.
+func TestDatabaseHandlePoolLimits(t *testing.T) {
+	connectFunc := func() (*sqlx.DB, error) {
+		return sqlx.NewDb(sql.OpenDB(testConnector{}), "test"), nil
+	}
+	fakeTimeSource := clock.NewEventTimeSource().Update(time.Now())
+	dbHandle := NewDatabaseHandle(connectFunc, func(error) bool { return false }, log.NewNoopLogger(), metrics.NoopMetricsHandler, fakeTimeSource)
+	defer dbHandle.Close()
+
+	stats, ok := dbHandle.PoolStats()
+	assert.True(t, ok)
+	assert.Equal(t, 0, stats.MaxOpenConnections)
+
+	dbHandle.SetPoolLimits(PoolLimits{MaxConns: 10})
+	stats, _ = dbHandle.PoolStats()
+	assert.Equal(t, 10, stats.MaxOpenConnections)
+
+	fakeTimeSource.Advance(sessionRefreshMinInternal + time.Millisecond)
+	assert.NotNil(t, dbHandle.reconnect(true))
+	stats, _ = dbHandle.PoolStats()
+	assert.Equal(t, 10, stats.MaxOpenConnections)
+}
+
 var errTest = errors.New("test")
+
+type testConnector struct{}
+
+func (testConnector) Connect(context.Context) (driver.Conn, error) {
+	return nil, errTest
+}
+
+func (testConnector) Driver() driver.Driver {
+	return nil
+}
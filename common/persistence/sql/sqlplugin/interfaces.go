@@ -27,6 +27,7 @@ package sqlplugin
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	"go.temporal.io/server/common/config"
@@ -137,6 +138,20 @@ type (
 		Close() error
 	}
 
+	// PoolLimits are the limits of a connection pool, zero values leave the respective limit unchanged
+	PoolLimits struct {
+		MaxConns        int
+		MaxIdleConns    int
+		MaxConnLifetime time.Duration
+	}
+
+	// ConnPool is implemented by DBs backed by a pool of connections which can be adjusted at runtime
+	ConnPool interface {
+		SetPoolLimits(limits PoolLimits)
+		// PoolStats returns the statistics of the pool, false if there is no usable connection pool
+		PoolStats() (sql.DBStats, bool)
+	}
+
 	// Conn defines the API for a single database connection
 	Conn interface {
 		Rebind(query string) string
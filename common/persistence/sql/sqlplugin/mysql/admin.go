@@ -27,6 +27,15 @@ package mysql
 import (
 	"fmt"
 	"time"
+
+	"go.temporal.io/server/common/persistence/schema"
+)
+
+type (
+	tableColumn struct {
+		TableName  string `db:"table_name"`
+		ColumnName string `db:"column_name"`
+	}
 )
 
 const (
@@ -64,6 +73,8 @@ const (
 
 	listTablesQuery = "SHOW TABLES FROM %v"
 
+	listColumnsQuery = `SELECT table_name AS table_name, column_name AS column_name FROM information_schema.columns WHERE table_schema=?`
+
 	dropTableQuery = "DROP TABLE %v"
 )
 
@@ -118,6 +129,23 @@ func (mdb *db) ListTables(database string) ([]string, error) {
 	return tables, mdb.handle.ConvertError(err)
 }
 
+// ReadTableShape returns the columns of each table in this database
+func (mdb *db) ReadTableShape(database string) (schema.TableShape, error) {
+	var columns []tableColumn
+	db, err := mdb.handle.DB()
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Select(&columns, listColumnsQuery, database); err != nil {
+		return nil, mdb.handle.ConvertError(err)
+	}
+	shape := make(schema.TableShape)
+	for _, column := range columns {
+		shape.Add(column.TableName, column.ColumnName)
+	}
+	return shape, nil
+}
+
 // DropTable drops a given table from the database
 func (mdb *db) DropTable(name string) error {
 	return mdb.Exec(fmt.Sprintf(dropTableQuery, name))
@@ -62,6 +62,7 @@ type db struct {
 
 var _ sqlplugin.AdminDB = (*db)(nil)
 var _ sqlplugin.DB = (*db)(nil)
+var _ sqlplugin.ConnPool = (*db)(nil)
 var _ sqlplugin.Tx = (*db)(nil)
 
 func isConnNeedsRefreshError(err error) bool {
@@ -131,6 +132,16 @@ func (mdb *db) Close() error {
 	return nil
 }
 
+// SetPoolLimits adjusts the limits of the connection pool
+func (mdb *db) SetPoolLimits(limits sqlplugin.PoolLimits) {
+	mdb.handle.SetPoolLimits(limits)
+}
+
+// PoolStats returns the statistics of the connection pool
+func (mdb *db) PoolStats() (sql.DBStats, bool) {
+	return mdb.handle.PoolStats()
+}
+
 // PluginName returns the name of the mysql plugin
 func (mdb *db) PluginName() string {
 	return PluginName
@@ -153,10 +164,22 @@ func (mdb *db) ExpectedVersion() string {
 	}
 }
 
+// expectedSchemaFile returns the embedded schema file of the expected version.
+func (mdb *db) expectedSchemaFile() string {
+	switch mdb.dbKind {
+	case sqlplugin.DbKindMain:
+		return "mysql/v8/temporal/schema.sql"
+	case sqlplugin.DbKindVisibility:
+		return "mysql/v8/visibility/schema.sql"
+	default:
+		panic(fmt.Sprintf("unknown db kind %v", mdb.dbKind))
+	}
+}
+
 // VerifyVersion verify schema version is up to date
 func (mdb *db) VerifyVersion() error {
 	expectedVersion := mdb.ExpectedVersion()
-	return schema.VerifyCompatibleVersion(mdb, mdb.dbName, expectedVersion)
+	return schema.VerifyCompatibleSchema(mdb, mdb.dbName, expectedVersion, mdb.expectedSchemaFile())
 }
 
 // Helper methods to hide common error handling
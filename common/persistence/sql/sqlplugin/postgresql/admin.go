@@ -27,6 +27,15 @@ package postgresql
 import (
 	"fmt"
 	"time"
+
+	"go.temporal.io/server/common/persistence/schema"
+)
+
+type (
+	tableColumn struct {
+		TableName  string `db:"table_name"`
+		ColumnName string `db:"column_name"`
+	}
 )
 
 const (
@@ -68,6 +77,8 @@ const (
 
 	listTablesQuery = "select table_name from information_schema.tables where table_schema='public'"
 
+	listColumnsQuery = "select table_name, column_name from information_schema.columns where table_schema='public'"
+
 	dropTableQuery = "DROP TABLE %v"
 )
 
@@ -119,6 +130,19 @@ func (pdb *db) ListTables(database string) ([]string, error) {
 	return tables, pdb.handle.ConvertError(err)
 }
 
+// ReadTableShape returns the columns of each table in this database
+func (pdb *db) ReadTableShape(database string) (schema.TableShape, error) {
+	var columns []tableColumn
+	if err := pdb.Select(&columns, listColumnsQuery); err != nil {
+		return nil, pdb.handle.ConvertError(err)
+	}
+	shape := make(schema.TableShape)
+	for _, column := range columns {
+		shape.Add(column.TableName, column.ColumnName)
+	}
+	return shape, nil
+}
+
 // DropTable drops a given table from the database
 func (pdb *db) DropTable(name string) error {
 	return pdb.Exec(fmt.Sprintf(dropTableQuery, name))
@@ -62,6 +62,7 @@ type db struct {
 }
 
 var _ sqlplugin.DB = (*db)(nil)
+var _ sqlplugin.ConnPool = (*db)(nil)
 
 // newDB returns an instance of DB, which is a logical
 // connection to the underlying postgresql database
@@ -110,6 +111,16 @@ func (pdb *db) Close() error {
 	return nil
 }
 
+// SetPoolLimits adjusts the limits of the connection pool
+func (pdb *db) SetPoolLimits(limits sqlplugin.PoolLimits) {
+	pdb.handle.SetPoolLimits(limits)
+}
+
+// PoolStats returns the statistics of the connection pool
+func (pdb *db) PoolStats() (sql.DBStats, bool) {
+	return pdb.handle.PoolStats()
+}
+
 // PluginName returns the name of the mysql plugin
 func (pdb *db) PluginName() string {
 	return PluginName
@@ -132,10 +143,22 @@ func (pdb *db) ExpectedVersion() string {
 	}
 }
 
+// expectedSchemaFile returns the embedded schema file of the expected version.
+func (pdb *db) expectedSchemaFile() string {
+	switch pdb.dbKind {
+	case sqlplugin.DbKindMain:
+		return "postgresql/v12/temporal/schema.sql"
+	case sqlplugin.DbKindVisibility:
+		return "postgresql/v12/visibility/schema.sql"
+	default:
+		panic(fmt.Sprintf("unknown db kind %v", pdb.dbKind))
+	}
+}
+
 // VerifyVersion verify schema version is up to date
 func (pdb *db) VerifyVersion() error {
 	expectedVersion := pdb.ExpectedVersion()
-	return schema.VerifyCompatibleVersion(pdb, pdb.dbName, expectedVersion)
+	return schema.VerifyCompatibleSchema(pdb, pdb.dbName, expectedVersion, pdb.expectedSchemaFile())
 }
 
 // Commit commits a previously started transaction
@@ -0,0 +1,107 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"maps"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/primitives"
+)
+
+type (
+	// SystemNamespaceData stores the document of a server component, e.g. the runtime dynamic config overrides, under
+	// a single key of the Data of the system namespace, which makes the namespace registry of every host pick it up.
+	// Every write bumps the notification version of the namespace table and makes every host reload the namespaces, so
+	// it is only meant for small and rarely updated documents.
+	SystemNamespaceData struct {
+		metadataMgr MetadataManager
+		key         string
+	}
+)
+
+func NewSystemNamespaceData(
+	metadataMgr MetadataManager,
+	key string,
+) *SystemNamespaceData {
+	return &SystemNamespaceData{
+		metadataMgr: metadataMgr,
+		key:         key,
+	}
+}
+
+// Get returns the current document, or an empty string if there is none.
+func (d *SystemNamespaceData) Get(ctx context.Context) (string, error) {
+	resp, err := d.metadataMgr.GetNamespace(ctx, &GetNamespaceRequest{Name: primitives.SystemLocalNamespace})
+	if err != nil {
+		return "", err
+	}
+	return resp.Namespace.GetInfo().GetData()[d.key], nil
+}
+
+// Update replaces the document with the one returned by update, which is given the current document. An empty
+// document removes the key. Nothing is written if the document is unchanged. Concurrent updates of the namespace table
+// fail with a ConditionFailedError, as its notification version acts as its lock, see namespaceHandler.UpdateNamespace.
+func (d *SystemNamespaceData) Update(
+	ctx context.Context,
+	update func(current string) (string, error),
+) error {
+	metadata, err := d.metadataMgr.GetMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	resp, err := d.metadataMgr.GetNamespace(ctx, &GetNamespaceRequest{Name: primitives.SystemLocalNamespace})
+	if err != nil {
+		return err
+	}
+	info := resp.Namespace.Info
+	current := info.GetData()[d.key]
+	updated, err := update(current)
+	if err != nil || updated == current {
+		return err
+	}
+
+	data := maps.Clone(info.Data)
+	if data == nil {
+		data = make(map[string]string, 1)
+	}
+	if updated == "" {
+		delete(data, d.key)
+	} else {
+		data[d.key] = updated
+	}
+	info.Data = data
+	return d.metadataMgr.UpdateNamespace(ctx, &UpdateNamespaceRequest{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info:                        info,
+			Config:                      resp.Namespace.Config,
+			ReplicationConfig:           resp.Namespace.ReplicationConfig,
+			ConfigVersion:               resp.Namespace.ConfigVersion,
+			FailoverVersion:             resp.Namespace.FailoverVersion,
+			FailoverNotificationVersion: resp.Namespace.FailoverNotificationVersion,
+		},
+		IsGlobalNamespace:   resp.IsGlobalNamespace,
+		NotificationVersion: metadata.NotificationVersion,
+	})
+}
@@ -0,0 +1,83 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/primitives"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSystemNamespaceData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	metadataMgr := NewMockMetadataManager(ctrl)
+	data := NewSystemNamespaceData(metadataMgr, "component")
+	ctx := context.Background()
+
+	namespaceData := map[string]string{"owner": "ops", "component": "v1"}
+	metadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&GetMetadataResponse{NotificationVersion: 7}, nil).AnyTimes()
+	metadataMgr.EXPECT().GetNamespace(gomock.Any(), &GetNamespaceRequest{Name: primitives.SystemLocalNamespace}).DoAndReturn(
+		func(context.Context, *GetNamespaceRequest) (*GetNamespaceResponse, error) {
+			return &GetNamespaceResponse{
+				Namespace: &persistencespb.NamespaceDetail{
+					Info:          &persistencespb.NamespaceInfo{Name: primitives.SystemLocalNamespace, Data: namespaceData},
+					ConfigVersion: 3,
+				},
+			}, nil
+		},
+	).AnyTimes()
+
+	document, err := data.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "v1", document)
+
+	// unchanged documents are not written, as every write makes every host reload the namespaces
+	require.NoError(t, data.Update(ctx, func(current string) (string, error) {
+		require.Equal(t, "v1", current)
+		return current, nil
+	}))
+
+	metadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *UpdateNamespaceRequest) error {
+			require.Equal(t, int64(7), request.NotificationVersion)
+			require.Equal(t, int64(3), request.Namespace.ConfigVersion)
+			require.Equal(t, map[string]string{"owner": "ops", "component": "v2"}, request.Namespace.Info.Data)
+			return nil
+		},
+	)
+	require.NoError(t, data.Update(ctx, func(string) (string, error) { return "v2", nil }))
+	require.Equal(t, "v1", namespaceData["component"], "the namespace data read must not be modified")
+
+	metadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *UpdateNamespaceRequest) error {
+			require.Equal(t, map[string]string{"owner": "ops"}, request.Namespace.Info.Data)
+			return &ConditionFailedError{Msg: "notification version mismatch"}
+		},
+	)
+	var conditionFailed *ConditionFailedError
+	require.ErrorAs(t, data.Update(ctx, func(string) (string, error) { return "", nil }), &conditionFailed)
+}
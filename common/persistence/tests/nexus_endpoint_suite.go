@@ -34,12 +34,20 @@ import (
 	"github.com/stretchr/testify/require"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
+	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/sql"
 )
 
 func RunNexusEndpointTestSuite(t *testing.T, store persistence.NexusEndpointStore, tableVersion *atomic.Int64) {
 	// NB: These tests cannot be run in parallel because of concurrent updates to the table version by different tests
+	// NB: The tombstone test decodes every listed entry, so it runs first, before the other tests leave raw test data.
+	t.Run("TestNexusEndpointTombstones", func(t *testing.T) {
+		testNexusEndpointTombstones(t, store, tableVersion)
+	})
 	t.Run("TestNexusEndpointsSteadyState", func(t *testing.T) {
 		testNexusEndpointsStoreSteadyState(t, store, tableVersion)
 	})
@@ -341,3 +349,54 @@ func testDeleteNexusEndpointExpectedErrors(t *testing.T, store persistence.Nexus
 		tableVersion.Add(1)
 	})
 }
+
+func testNexusEndpointTombstones(t *testing.T, store persistence.NexusEndpointStore, tableVersion *atomic.Int64) {
+	t.Run("Tombstones", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		manager := persistence.NewNexusEndpointManager(store, serialization.NewSerializer(), log.NewNoopLogger())
+
+		// Create a tombstone, an entry with the deletion clock and without a spec
+		id := uuid.NewString()
+		_, err := manager.CreateOrUpdateNexusEndpoint(ctx, &persistence.CreateOrUpdateNexusEndpointRequest{
+			LastKnownTableVersion: tableVersion.Load(),
+			Entry: &persistencespb.NexusEndpointEntry{
+				Id:       id,
+				Endpoint: &persistencespb.NexusEndpoint{Clock: hlc.Zero(1)},
+			},
+		})
+		require.NoError(t, err)
+		tableVersion.Add(1)
+
+		// Tombstones are hidden by default
+		_, err = manager.GetNexusEndpoint(ctx, &persistence.GetNexusEndpointRequest{ID: id})
+		require.ErrorContains(t, err, "not found")
+		resp, err := manager.ListNexusEndpoints(ctx, &persistence.ListNexusEndpointsRequest{PageSize: 100})
+		require.NoError(t, err)
+		for _, entry := range resp.Entries {
+			require.NotEqual(t, id, entry.Id)
+		}
+
+		// and returned on request
+		entry, err := manager.GetNexusEndpoint(ctx, &persistence.GetNexusEndpointRequest{ID: id, IncludeTombstones: true})
+		require.NoError(t, err)
+		require.Equal(t, id, entry.Id)
+		resp, err = manager.ListNexusEndpoints(ctx, &persistence.ListNexusEndpointsRequest{PageSize: 100, IncludeTombstones: true})
+		require.NoError(t, err)
+		require.Contains(t, func() []string {
+			var ids []string
+			for _, entry := range resp.Entries {
+				ids = append(ids, entry.Id)
+			}
+			return ids
+		}(), id)
+
+		err = manager.DeleteNexusEndpoint(ctx, &persistence.DeleteNexusEndpointRequest{
+			ID:                    id,
+			LastKnownTableVersion: tableVersion.Load(),
+		})
+		require.NoError(t, err)
+		tableVersion.Add(1)
+	})
+}
@@ -31,6 +31,7 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/payloadencryption"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
@@ -55,6 +56,7 @@ func NewManager(
 	persistenceCfg config.Persistence,
 	persistenceResolver resolver.ServiceResolver,
 	customVisibilityStoreFactory VisibilityStoreFactory,
+	payloadKeyProvider payloadencryption.KeyProvider,
 
 	esProcessorConfig *elasticsearch.ProcessorConfig,
 	searchAttributesProvider searchattribute.Provider,
@@ -127,14 +129,17 @@ func NewManager(
 			enableReadFromSecondaryVisibility,
 			secondaryVisibilityWritingMode,
 		)
-		return NewVisibilityManagerDual(
+		visibilityManager = NewVisibilityManagerDual(
 			visibilityManager,
 			secondaryVisibilityManager,
 			managerSelector,
 			visibilityEnableShadowReadMode,
-		), nil
+		)
 	}
 
+	if payloadKeyProvider != nil {
+		visibilityManager = payloadencryption.NewVisibilityManager(visibilityManager, payloadKeyProvider)
+	}
 	return visibilityManager, nil
 }
 
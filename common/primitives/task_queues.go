@@ -33,4 +33,6 @@ const (
 	AddSearchAttributesActivityTQ = "temporal-sys-add-search-attributes-activity-tq"
 	DeleteNamespaceActivityTQ     = "temporal-sys-delete-namespace-activity-tq"
 	DLQActivityTQ                 = "temporal-sys-dlq-activity-tq"
+	ReArchivalActivityTQ          = "temporal-sys-rearchival-activity-tq"
+	ReshardActivityTQ             = "temporal-sys-reshard-activity-tq"
 )
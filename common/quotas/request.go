@@ -32,6 +32,8 @@ type (
 		CallerType    string
 		CallerSegment int32
 		Initiation    string
+		// Identity is the caller identity reported in the request, if any.
+		Identity string
 	}
 )
 
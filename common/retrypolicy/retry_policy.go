@@ -61,6 +61,13 @@ var DefaultDefaultRetrySettings = DefaultRetrySettings{
 	MaximumAttempts:            0,
 }
 
+// Caps are upper bounds the server applies to retry policies regardless of what the user specified. Zero values are
+// not applied.
+type Caps struct {
+	MaximumInterval time.Duration
+	MaximumAttempts int32
+}
+
 // EnsureDefaults ensures the policy subfields, if not explicitly set, are set to the specified defaults
 func EnsureDefaults(originalPolicy *commonpb.RetryPolicy, defaultSettings DefaultRetrySettings) {
 	if originalPolicy.GetMaximumAttempts() == 0 {
@@ -80,6 +87,21 @@ func EnsureDefaults(originalPolicy *commonpb.RetryPolicy, defaultSettings Defaul
 	}
 }
 
+// ApplyCaps lowers the maximum interval and the maximum attempts of the policy to the caps. The initial interval is
+// lowered as well if it exceeds the capped maximum interval. It must be called after EnsureDefaults.
+func ApplyCaps(policy *commonpb.RetryPolicy, caps Caps) {
+	if caps.MaximumInterval > 0 && timestamp.DurationValue(policy.GetMaximumInterval()) > caps.MaximumInterval {
+		policy.MaximumInterval = durationpb.New(caps.MaximumInterval)
+		if timestamp.DurationValue(policy.GetInitialInterval()) > caps.MaximumInterval {
+			policy.InitialInterval = durationpb.New(caps.MaximumInterval)
+		}
+	}
+
+	if caps.MaximumAttempts > 0 && (policy.GetMaximumAttempts() == 0 || policy.GetMaximumAttempts() > caps.MaximumAttempts) {
+		policy.MaximumAttempts = caps.MaximumAttempts
+	}
+}
+
 // Validate validates a retry policy
 func Validate(policy *commonpb.RetryPolicy) error {
 	if policy == nil {
@@ -44,6 +44,7 @@ import (
 
 var _ CertProvider = (*localStoreCertProvider)(nil)
 var _ CertExpirationChecker = (*localStoreCertProvider)(nil)
+var _ NamespaceClientCertVerifier = (*localStoreCertProvider)(nil)
 
 type certCache struct {
 	serverCert          *tls.Certificate
@@ -54,6 +55,12 @@ type certCache struct {
 	clientCACerts       []*x509.Certificate // copies of certs in the clientCAPool CertPool for expiration checks
 	serverCACerts       []*x509.Certificate // copies of certs in the serverCAPool CertPool for expiration checks
 	serverCACertsWorker []*x509.Certificate // copies of certs in the serverCAsWorkerPool CertPool for expiration checks
+
+	// When per-namespace client CAs are configured, clientCAPool accepts certs issued by any of them
+	// during the handshake, and requests are checked against the namespace specific pool afterwards.
+	defaultClientCAPool    *x509.CertPool
+	namespaceClientCAPools map[string]*x509.CertPool
+	namespaceClientCACerts map[string][]*x509.Certificate // copies of certs in namespaceClientCAPools for expiration checks
 }
 
 type localStoreCertProvider struct {
@@ -192,6 +199,9 @@ func (s *localStoreCertProvider) GetExpiringCerts(timeWindow time.Duration,
 	checkCertsForExpiration(certs.clientCACerts, when, expiring, expired)
 	checkCertsForExpiration(certs.serverCACerts, when, expiring, expired)
 	checkCertsForExpiration(certs.serverCACertsWorker, when, expiring, expired)
+	for _, namespaceCerts := range certs.namespaceClientCACerts {
+		checkCertsForExpiration(namespaceCerts, when, expiring, expired)
+	}
 
 	return expiring, expired, err
 }
@@ -247,6 +257,10 @@ func (s *localStoreCertProvider) loadCerts() (*certCache, error) {
 		}
 		newCerts.clientCAPool = certPool
 		newCerts.clientCACerts = certs
+
+		if err := s.loadNamespaceClientCAs(&newCerts); err != nil {
+			return nil, err
+		}
 	}
 
 	if s.isLegacyWorkerConfig {
@@ -278,6 +292,68 @@ func (s *localStoreCertProvider) loadCerts() (*certCache, error) {
 	return &newCerts, nil
 }
 
+// VerifyNamespaceClientCertificate checks that chain, as presented by a client, was issued by a CA
+// trusted for namespace. It is a no-op unless per-namespace client CAs are configured.
+func (s *localStoreCertProvider) VerifyNamespaceClientCertificate(namespace string, chain []*x509.Certificate) error {
+	if s.tlsSettings == nil || len(s.tlsSettings.NamespaceClientCAs) == 0 || len(chain) == 0 {
+		return nil
+	}
+	certs, err := s.getCerts()
+	if err != nil {
+		return err
+	}
+
+	roots, ok := certs.namespaceClientCAPools[namespace]
+	if !ok {
+		roots = certs.defaultClientCAPool
+	}
+	if roots == nil {
+		return fmt.Errorf("no client CAs trusted for namespace %q", namespace)
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range chain[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err = chain[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	return err
+}
+
+func (s *localStoreCertProvider) loadNamespaceClientCAs(newCerts *certCache) error {
+	if len(s.tlsSettings.NamespaceClientCAs) == 0 {
+		return nil
+	}
+
+	handshakePool := x509.NewCertPool()
+	if newCerts.clientCAPool != nil {
+		handshakePool = newCerts.clientCAPool.Clone()
+	}
+	newCerts.defaultClientCAPool = newCerts.clientCAPool
+	newCerts.namespaceClientCAPools = make(map[string]*x509.CertPool, len(s.tlsSettings.NamespaceClientCAs))
+	newCerts.namespaceClientCACerts = make(map[string][]*x509.Certificate, len(s.tlsSettings.NamespaceClientCAs))
+
+	for namespace, cas := range s.tlsSettings.NamespaceClientCAs {
+		certPool, certs, err := s.fetchCAs(cas.ClientCAFiles, cas.ClientCAData,
+			"cannot specify both clientCAFiles and clientCAData properties")
+		if err != nil {
+			return fmt.Errorf("failed to load client CAs for namespace %q: %w", namespace, err)
+		}
+		if certPool == nil {
+			continue
+		}
+		newCerts.namespaceClientCAPools[namespace] = certPool
+		newCerts.namespaceClientCACerts[namespace] = certs
+		if err := appendCAsToPool(handshakePool, cas.ClientCAFiles, cas.ClientCAData); err != nil {
+			return fmt.Errorf("failed to load client CAs for namespace %q: %w", namespace, err)
+		}
+	}
+	newCerts.clientCAPool = handshakePool
+	return nil
+}
+
 func (s *localStoreCertProvider) fetchCertificate(
 	certFile string, certData string,
 	keyFile string, keyData string) (*tls.Certificate, error) {
@@ -458,6 +534,28 @@ func (s *localStoreCertProvider) buildCAPoolFromFiles(caFiles []string) (*x509.C
 	return buildCAPool(caFiles, os.ReadFile)
 }
 
+func appendCAsToPool(pool *x509.CertPool, files []string, data []string) error {
+	for _, file := range files {
+		caBytes, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		if !pool.AppendCertsFromPEM(caBytes) {
+			return errors.New("unknown failure constructing cert pool for ca")
+		}
+	}
+	for _, ca := range data {
+		caBytes, err := base64.StdEncoding.DecodeString(ca)
+		if err != nil {
+			return err
+		}
+		if !pool.AppendCertsFromPEM(caBytes) {
+			return errors.New("unknown failure constructing cert pool for ca")
+		}
+	}
+	return nil
+}
+
 func buildCAPool(cas []string, getBytes loadOrDecodeDataFunc) (*x509.CertPool, []*x509.Certificate, error) {
 
 	var caPool *x509.CertPool
@@ -569,6 +667,14 @@ func (c *certCache) isEqual(other *certCache) bool {
 		!equalX509(c.serverCACertsWorker, other.serverCACertsWorker) {
 		return false
 	}
+	if len(c.namespaceClientCACerts) != len(other.namespaceClientCACerts) {
+		return false
+	}
+	for namespace, certs := range c.namespaceClientCACerts {
+		if !equalX509(certs, other.namespaceClientCACerts[namespace]) {
+			return false
+		}
+	}
 	return true
 }
 
@@ -25,10 +25,22 @@
 package encryption
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
 	"errors"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
 )
 
 func TestAppendError(t *testing.T) {
@@ -47,3 +59,70 @@ func TestAppendError(t *testing.T) {
 	assert.Equal(err2, errors.Unwrap(err))
 	assert.Equal("error1, error2", err.Error())
 }
+
+func TestVerifyNamespaceClientCertificate(t *testing.T) {
+	defaultCA, defaultKey := generateTestCert(t, "default-ca", nil, nil)
+	tenantCA, tenantKey := generateTestCert(t, "tenant-ca", nil, nil)
+	defaultClient, _ := generateTestCert(t, "default-client", defaultCA, defaultKey)
+	tenantClient, _ := generateTestCert(t, "tenant-client", tenantCA, tenantKey)
+
+	provider := NewLocalStoreCertProvider(&config.GroupTLS{
+		Server: config.ServerTLS{
+			ClientCAData:      []string{encodeTestCert(defaultCA)},
+			RequireClientAuth: true,
+		},
+		NamespaceClientCAs: map[string]config.NamespaceClientCAs{
+			"tenant": {ClientCAData: []string{encodeTestCert(tenantCA)}},
+		},
+	}, nil, nil, 0, log.NewNoopLogger())
+	verifier := provider.(NamespaceClientCertVerifier)
+
+	// the handshake accepts certs issued by any configured CA
+	clientCAs, err := provider.FetchClientCAs()
+	require.NoError(t, err)
+	for _, cert := range []*x509.Certificate{defaultClient, tenantClient} {
+		_, err = cert.Verify(x509.VerifyOptions{Roots: clientCAs, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}})
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, verifier.VerifyNamespaceClientCertificate("tenant", []*x509.Certificate{tenantClient}))
+	require.Error(t, verifier.VerifyNamespaceClientCertificate("tenant", []*x509.Certificate{defaultClient}))
+	require.NoError(t, verifier.VerifyNamespaceClientCertificate("other", []*x509.Certificate{defaultClient}))
+	require.Error(t, verifier.VerifyNamespaceClientCertificate("other", []*x509.Certificate{tenantClient}))
+	require.Error(t, verifier.VerifyNamespaceClientCertificate("", []*x509.Certificate{tenantClient}))
+}
+
+func TestVerifyNamespaceClientCertificate_NotConfigured(t *testing.T) {
+	ca, key := generateTestCert(t, "ca", nil, nil)
+	client, _ := generateTestCert(t, "client", ca, key)
+
+	provider := NewLocalStoreCertProvider(&config.GroupTLS{}, nil, nil, 0, log.NewNoopLogger())
+	require.NoError(t, provider.(NamespaceClientCertVerifier).VerifyNamespaceClientCertificate("tenant", []*x509.Certificate{client}))
+}
+
+func generateTestCert(t *testing.T, commonName string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage = x509.KeyUsageCertSign
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert, key
+}
+
+func encodeTestCert(cert *x509.Certificate) string {
+	return base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}))
+}
@@ -27,6 +27,7 @@ package encryption
 import (
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -71,6 +72,7 @@ type localStoreTlsProvider struct {
 
 var _ TLSConfigProvider = (*localStoreTlsProvider)(nil)
 var _ CertExpirationChecker = (*localStoreTlsProvider)(nil)
+var _ NamespaceClientCertVerifier = (*localStoreTlsProvider)(nil)
 
 func NewLocalStoreTlsProvider(tlsConfig *config.RootTLS, metricsHandler metrics.Handler, logger log.Logger, certProviderFactory CertProviderFactory,
 ) (TLSConfigProvider, error) {
@@ -200,6 +202,16 @@ func (s *localStoreTlsProvider) GetInternodeServerConfig() (*tls.Config, error)
 		s.settings.Internode.IsServerEnabled())
 }
 
+// VerifyNamespaceClientCertificate checks a frontend client certificate chain against the client CAs
+// configured for namespace. Per-host overrides don't support per-namespace client CAs.
+func (s *localStoreTlsProvider) VerifyNamespaceClientCertificate(namespace string, chain []*x509.Certificate) error {
+	verifier, ok := s.frontendCertProvider.(NamespaceClientCertVerifier)
+	if !ok {
+		return nil
+	}
+	return verifier.VerifyNamespaceClientCertificate(namespace, chain)
+}
+
 func (s *localStoreTlsProvider) GetExpiringCerts(timeWindow time.Duration,
 ) (expiring CertExpirationMap, expired CertExpirationMap, err error) {
 
@@ -413,12 +425,41 @@ func newClientTLSConfig(
 		}
 	}
 
-	return auth.NewDynamicTLSClientConfig(
+	tlsConfig := auth.NewDynamicTLSClientConfig(
 		getCert,
 		serverCa,
 		serverName,
 		enableHostVerification,
-	), nil
+	)
+	if serverCa != nil && enableHostVerification {
+		// Verify the server against the current root CAs on every handshake, so that rotated
+		// CAs are picked up by new connections without rebuilding the config.
+		tlsConfig.InsecureSkipVerify = true
+		tlsConfig.VerifyConnection = func(state tls.ConnectionState) error {
+			roots, err := clientProvider.FetchServerRootCAsForClient(isWorker)
+			if err != nil {
+				return fmt.Errorf("failed to load client ca: %v", err)
+			}
+			return verifyServerCertificate(state, roots)
+		}
+	}
+	return tlsConfig, nil
+}
+
+func verifyServerCertificate(state tls.ConnectionState, roots *x509.CertPool) error {
+	if len(state.PeerCertificates) == 0 {
+		return errors.New("server did not present a certificate")
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range state.PeerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err := state.PeerCertificates[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		DNSName:       state.ServerName,
+	})
+	return err
 }
 
 func (s *localStoreTlsProvider) timerCallback() {
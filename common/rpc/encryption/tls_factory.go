@@ -78,6 +78,12 @@ type (
 		GetExpiringCerts(timeWindow time.Duration) (expiring CertExpirationMap, expired CertExpirationMap, err error)
 	}
 
+	// NamespaceClientCertVerifier verifies that a frontend client certificate chain was issued
+	// by a CA trusted for the namespace a request targets.
+	NamespaceClientCertVerifier interface {
+		VerifyNamespaceClientCertificate(namespace string, chain []*x509.Certificate) error
+	}
+
 	tlsConfigConstructor func() (*tls.Config, error)
 )
 
@@ -125,6 +131,20 @@ func validateGroupTLS(cfg *config.GroupTLS) error {
 			return err
 		}
 	}
+	for namespace, cas := range cfg.NamespaceClientCAs {
+		if strings.TrimSpace(namespace) == "" {
+			return fmt.Errorf("namespace name cannot be empty string")
+		}
+		if err := validateCAs(cas.ClientCAData); err != nil {
+			return fmt.Errorf("invalid NamespaceClientCAs.ClientCAData for namespace %q: %w", namespace, err)
+		}
+		if err := validateCAs(cas.ClientCAFiles); err != nil {
+			return fmt.Errorf("invalid NamespaceClientCAs.ClientCAFiles for namespace %q: %w", namespace, err)
+		}
+		if len(cas.ClientCAFiles) > 0 && len(cas.ClientCAData) > 0 {
+			return fmt.Errorf("cannot specify ClientCAFiles and ClientCAData at the same time for namespace %q", namespace)
+		}
+	}
 	return nil
 }
 
@@ -28,9 +28,11 @@ import (
 	"context"
 	"crypto/tls"
 	"errors"
+	"strings"
 	"time"
 
 	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/api"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -42,6 +44,7 @@ import (
 	"google.golang.org/grpc/backoff"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	_ "google.golang.org/grpc/encoding/gzip" // register gzip compressor, used by the replication stream
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
@@ -107,6 +110,7 @@ func Dial(hostName string, tlsConfig *tls.Config, logger log.Logger, opts ...grp
 		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxInternodeRecvPayloadSize)),
 		grpc.WithChainUnaryInterceptor(
 			headersInterceptor,
+			matchingLatencyInterceptor,
 			metrics.NewClientMetricsTrailerPropagatorInterceptor(logger),
 			errorInterceptor,
 		),
@@ -147,6 +151,25 @@ func headersInterceptor(
 	return invoker(ctx, method, req, reply, cc, opts...)
 }
 
+// matchingLatencyInterceptor accumulates the time spent in calls to the matching service into the metrics context of
+// the request making them.
+func matchingLatencyInterceptor(
+	ctx context.Context,
+	method string,
+	req, reply interface{},
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	if !strings.HasPrefix(method, api.MatchingServicePrefix) {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	startTime := time.Now()
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	metrics.ContextCounterAdd(ctx, metrics.MatchingCallLatencyCounterName, time.Since(startTime).Nanoseconds())
+	return err
+}
+
 func ServiceErrorInterceptor(
 	ctx context.Context,
 	req interface{},
@@ -0,0 +1,114 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+
+	"go.temporal.io/server/common/api"
+	"go.temporal.io/server/common/audit"
+	"go.temporal.io/server/common/authorization"
+	"google.golang.org/grpc"
+)
+
+type (
+	// AuditInterceptor records an audit event for every call to an API that changes a
+	// namespace or performs a destructive admin operation. It must run after the
+	// authorization interceptor so the caller's claims are available.
+	AuditInterceptor struct {
+		auditLogger audit.Logger
+	}
+)
+
+var (
+	_ grpc.UnaryServerInterceptor = (*AuditInterceptor)(nil).Intercept
+
+	auditedAPIs = map[string]audit.EventType{
+		api.WorkflowServicePrefix + "RegisterNamespace":  audit.EventTypeNamespaceChange,
+		api.WorkflowServicePrefix + "UpdateNamespace":    audit.EventTypeNamespaceChange,
+		api.WorkflowServicePrefix + "DeprecateNamespace": audit.EventTypeNamespaceChange,
+		api.OperatorServicePrefix + "DeleteNamespace":    audit.EventTypeNamespaceChange,
+
+		api.OperatorServicePrefix + "RemoveSearchAttributes": audit.EventTypeAdminOperation,
+		api.OperatorServicePrefix + "RemoveRemoteCluster":    audit.EventTypeAdminOperation,
+		api.OperatorServicePrefix + "DeleteNexusEndpoint":    audit.EventTypeAdminOperation,
+		api.AdminServicePrefix + "RemoveSearchAttributes":    audit.EventTypeAdminOperation,
+		api.AdminServicePrefix + "RemoveRemoteCluster":       audit.EventTypeAdminOperation,
+		api.AdminServicePrefix + "DeleteWorkflowExecution":   audit.EventTypeAdminOperation,
+		api.AdminServicePrefix + "RebuildMutableState":       audit.EventTypeAdminOperation,
+		api.AdminServicePrefix + "RemoveTask":                audit.EventTypeAdminOperation,
+		api.AdminServicePrefix + "CloseShard":                audit.EventTypeAdminOperation,
+		api.AdminServicePrefix + "PurgeDLQMessages":          audit.EventTypeAdminOperation,
+		api.AdminServicePrefix + "PurgeDLQTasks":             audit.EventTypeAdminOperation,
+		api.AdminServicePrefix + "PauseActivityType":         audit.EventTypeAdminOperation,
+		api.AdminServicePrefix + "UnpauseActivityType":       audit.EventTypeAdminOperation,
+		api.AdminServicePrefix + "CaptureProfile":            audit.EventTypeAdminOperation,
+		api.AdminServicePrefix + "SetDynamicConfig":          audit.EventTypeAdminOperation,
+		api.AdminServicePrefix + "ImportNamespaceConfig":     audit.EventTypeAdminOperation,
+		api.AdminServicePrefix + "UpdateClusterMetadata":     audit.EventTypeAdminOperation,
+		api.AdminServicePrefix + "MoveShards":                audit.EventTypeAdminOperation,
+		api.AdminServicePrefix + "UpdateSchedulePolicies":    audit.EventTypeAdminOperation,
+
+		// batch operations which modify the state of many executions at once
+		api.AdminServicePrefix + "StartRebuildMutableStateBatchOperation":  audit.EventTypeAdminOperation,
+		api.AdminServicePrefix + "StartRefreshWorkflowTasksBatchOperation": audit.EventTypeAdminOperation,
+	}
+)
+
+func NewAuditInterceptor(auditLogger audit.Logger) *AuditInterceptor {
+	return &AuditInterceptor{
+		auditLogger: auditLogger,
+	}
+}
+
+func (i *AuditInterceptor) Intercept(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	eventType, ok := auditedAPIs[info.FullMethod]
+	if !ok {
+		return handler(ctx, req)
+	}
+
+	resp, err := handler(ctx, req)
+
+	event := &audit.Event{
+		Type:    eventType,
+		API:     info.FullMethod,
+		Outcome: audit.OutcomeSuccess,
+	}
+	if request, ok := req.(NamespaceNameGetter); ok {
+		event.Namespace = request.GetNamespace()
+	}
+	if claims, ok := ctx.Value(authorization.MappedClaims).(*authorization.Claims); ok && claims != nil {
+		event.Subject = claims.Subject
+	}
+	if err != nil {
+		event.Outcome = audit.OutcomeFailure
+		event.Reason = err.Error()
+	}
+	i.auditLogger.Emit(ctx, event)
+	return resp, err
+}
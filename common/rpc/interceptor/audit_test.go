@@ -0,0 +1,76 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/common/api"
+	"go.temporal.io/server/common/audit"
+	"go.temporal.io/server/common/authorization"
+	"google.golang.org/grpc"
+)
+
+type recordingAuditLogger struct {
+	events []*audit.Event
+}
+
+func (l *recordingAuditLogger) Emit(_ context.Context, event *audit.Event) {
+	l.events = append(l.events, event)
+}
+
+func TestAuditInterceptor(t *testing.T) {
+	auditLogger := &recordingAuditLogger{}
+	interceptor := NewAuditInterceptor(auditLogger)
+	ctx := context.WithValue(context.Background(), authorization.MappedClaims, &authorization.Claims{Subject: "alice"})
+
+	_, err := interceptor.Intercept(
+		ctx,
+		&workflowservice.DescribeNamespaceRequest{Namespace: "ns"},
+		&grpc.UnaryServerInfo{FullMethod: api.WorkflowServicePrefix + "DescribeNamespace"},
+		func(context.Context, interface{}) (interface{}, error) { return nil, nil },
+	)
+	require.NoError(t, err)
+	require.Empty(t, auditLogger.events)
+
+	updateErr := errors.New("update failed")
+	_, err = interceptor.Intercept(
+		ctx,
+		&workflowservice.UpdateNamespaceRequest{Namespace: "ns"},
+		&grpc.UnaryServerInfo{FullMethod: api.WorkflowServicePrefix + "UpdateNamespace"},
+		func(context.Context, interface{}) (interface{}, error) { return nil, updateErr },
+	)
+	require.ErrorIs(t, err, updateErr)
+	require.Equal(t, []*audit.Event{{
+		Type:      audit.EventTypeNamespaceChange,
+		API:       api.WorkflowServicePrefix + "UpdateNamespace",
+		Namespace: "ns",
+		Subject:   "alice",
+		Outcome:   audit.OutcomeFailure,
+		Reason:    "update failed",
+	}}, auditLogger.events)
+}
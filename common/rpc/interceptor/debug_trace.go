@@ -0,0 +1,127 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+	"time"
+
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/debugtrace"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/rpc/interceptor/logtags"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	// DebugTraceInterceptor logs requests sent with the debug trace header at info level and records a span for
+	// each of them in the debug trace store. When requireSystemAdmin is set, the header is dropped for callers
+	// without the system admin role, which keeps it from reaching other services as well. It must then run after
+	// the authorization interceptor so the caller's claims are available.
+	DebugTraceInterceptor struct {
+		store              *debugtrace.Store
+		serviceName        primitives.ServiceName
+		hostInfoProvider   membership.HostInfoProvider
+		enabled            dynamicconfig.BoolPropertyFn
+		requireSystemAdmin bool
+		workflowTags       *logtags.WorkflowTags
+		logger             log.Logger
+	}
+)
+
+var _ grpc.UnaryServerInterceptor = (*DebugTraceInterceptor)(nil).Intercept
+
+func NewDebugTraceInterceptor(
+	store *debugtrace.Store,
+	serviceName primitives.ServiceName,
+	hostInfoProvider membership.HostInfoProvider,
+	enabled dynamicconfig.BoolPropertyFn,
+	requireSystemAdmin bool,
+	logger log.Logger,
+) *DebugTraceInterceptor {
+	return &DebugTraceInterceptor{
+		store:              store,
+		serviceName:        serviceName,
+		hostInfoProvider:   hostInfoProvider,
+		enabled:            enabled,
+		requireSystemAdmin: requireSystemAdmin,
+		workflowTags:       logtags.NewWorkflowTags(common.NewProtoTaskTokenSerializer(), logger),
+		logger:             logger,
+	}
+}
+
+func (i *DebugTraceInterceptor) Intercept(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	traceID := debugtrace.TraceID(ctx)
+	if traceID == "" {
+		return handler(ctx, req)
+	}
+	if !i.enabled() || (i.requireSystemAdmin && !isSystemAdmin(ctx)) {
+		return handler(debugtrace.WithoutTraceID(ctx), req)
+	}
+
+	logTags := append([]tag.Tag{
+		tag.NewStringTag("debug-trace-id", traceID),
+		tag.Service(i.serviceName),
+		tag.NewStringTag("grpc-method", info.FullMethod),
+	}, i.workflowTags.Extract(req, info.FullMethod)...)
+	i.logger.Info("Debug trace request started.", append(logTags, tag.NewAnyTag("request", req))...)
+
+	startTime := time.Now().UTC()
+	resp, err := handler(ctx, req)
+	latency := time.Since(startTime)
+
+	span := &historyspb.DebugTraceSpan{
+		TraceId:     traceID,
+		Service:     string(i.serviceName),
+		HostAddress: i.hostInfoProvider.HostInfo().GetAddress(),
+		Method:      info.FullMethod,
+		StartTime:   timestamppb.New(startTime),
+		Duration:    durationpb.New(latency),
+	}
+	logTags = append(logTags, tag.NewDurationTag("latency", latency))
+	if err != nil {
+		span.Error = err.Error()
+		logTags = append(logTags, tag.Error(err))
+	}
+	i.store.Record(span)
+	i.logger.Info("Debug trace request completed.", logTags...)
+	return resp, err
+}
+
+func isSystemAdmin(ctx context.Context) bool {
+	claims, ok := ctx.Value(authorization.MappedClaims).(*authorization.Claims)
+	return ok && claims != nil && claims.System&authorization.RoleAdmin != 0
+}
@@ -0,0 +1,155 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/common/api"
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/debugtrace"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/primitives"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestDebugTraceInterceptor(t *testing.T) {
+	store := debugtrace.NewStore(time.Minute, 10)
+	interceptor := NewDebugTraceInterceptor(
+		store,
+		primitives.FrontendService,
+		membership.NewHostInfoProvider(membership.NewHostInfoFromAddress("10.0.0.1:7233")),
+		dynamicconfig.GetBoolPropertyFn(true),
+		true,
+		log.NewNoopLogger(),
+	)
+	info := &grpc.UnaryServerInfo{FullMethod: api.WorkflowServicePrefix + "SignalWorkflowExecution"}
+	req := &workflowservice.SignalWorkflowExecutionRequest{Namespace: "ns"}
+	signalErr := errors.New("signal failed")
+
+	call := func(ctx context.Context) string {
+		var handlerTraceID string
+		_, err := interceptor.Intercept(ctx, req, info, func(ctx context.Context, _ interface{}) (interface{}, error) {
+			handlerTraceID = debugtrace.TraceID(ctx)
+			return nil, signalErr
+		})
+		require.ErrorIs(t, err, signalErr)
+		return handlerTraceID
+	}
+	withClaims := func(traceID string, role authorization.Role) context.Context {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(headers.DebugTraceHeaderName, traceID))
+		return context.WithValue(ctx, authorization.MappedClaims, &authorization.Claims{System: role})
+	}
+
+	require.Empty(t, call(context.Background()))
+
+	// The header is dropped for callers that are not system admins.
+	require.Empty(t, call(withClaims("reader-trace", authorization.RoleReader)))
+	require.Empty(t, store.Get("reader-trace"))
+
+	require.Equal(t, "admin-trace", call(withClaims("admin-trace", authorization.RoleAdmin)))
+	spans := store.Get("admin-trace")
+	require.Len(t, spans, 1)
+	require.Equal(t, "admin-trace", spans[0].GetTraceId())
+	require.Equal(t, string(primitives.FrontendService), spans[0].GetService())
+	require.Equal(t, "10.0.0.1:7233", spans[0].GetHostAddress())
+	require.Equal(t, info.FullMethod, spans[0].GetMethod())
+	require.Equal(t, "signal failed", spans[0].GetError())
+}
+
+func TestDebugTraceInterceptor_Disabled(t *testing.T) {
+	store := debugtrace.NewStore(time.Minute, 10)
+	interceptor := NewDebugTraceInterceptor(
+		store,
+		primitives.HistoryService,
+		membership.NewHostInfoProvider(membership.NewHostInfoFromAddress("10.0.0.1:7234")),
+		dynamicconfig.GetBoolPropertyFn(false),
+		false,
+		log.NewNoopLogger(),
+	)
+	info := &grpc.UnaryServerInfo{FullMethod: api.HistoryServicePrefix + "SignalWorkflowExecution"}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(headers.DebugTraceHeaderName, "trace"))
+	var handlerTraceID string
+	_, err := interceptor.Intercept(ctx, nil, info, func(ctx context.Context, _ interface{}) (interface{}, error) {
+		handlerTraceID = debugtrace.TraceID(ctx)
+		return nil, nil
+	})
+	require.NoError(t, err)
+	require.Empty(t, handlerTraceID)
+	require.Empty(t, store.Get("trace"))
+}
+
+func TestDebugTraceInterceptor_Logs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := log.NewMockLogger(ctrl)
+	interceptor := NewDebugTraceInterceptor(
+		debugtrace.NewStore(time.Minute, 10),
+		primitives.HistoryService,
+		membership.NewHostInfoProvider(membership.NewHostInfoFromAddress("10.0.0.1:7234")),
+		dynamicconfig.GetBoolPropertyFn(true),
+		false,
+		logger,
+	)
+	info := &grpc.UnaryServerInfo{FullMethod: api.HistoryServicePrefix + "SignalWorkflowExecution"}
+	signalErr := errors.New("signal failed")
+
+	tagValues := func(tags []tag.Tag) map[string]interface{} {
+		values := make(map[string]interface{}, len(tags))
+		for _, tg := range tags {
+			values[tg.Key()] = tg.Value()
+		}
+		return values
+	}
+	gomock.InOrder(
+		logger.EXPECT().Info("Debug trace request started.", gomock.Any()).Do(func(_ string, tags ...tag.Tag) {
+			values := tagValues(tags)
+			require.Equal(t, "trace", values["debug-trace-id"])
+			require.Equal(t, info.FullMethod, values["grpc-method"])
+			require.Contains(t, values, "request")
+		}),
+		logger.EXPECT().Info("Debug trace request completed.", gomock.Any()).Do(func(_ string, tags ...tag.Tag) {
+			values := tagValues(tags)
+			require.Equal(t, "trace", values["debug-trace-id"])
+			require.Contains(t, values, "latency")
+			require.Equal(t, signalErr.Error(), values["error"])
+		}),
+	)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(headers.DebugTraceHeaderName, "trace"))
+	_, err := interceptor.Intercept(ctx, nil, info, func(context.Context, interface{}) (interface{}, error) {
+		return nil, signalErr
+	})
+	require.ErrorIs(t, err, signalErr)
+}
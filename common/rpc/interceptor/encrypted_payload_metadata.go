@@ -0,0 +1,90 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/proxy"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/persistence/payloadencryption"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+type (
+	// EncryptedPayloadMetadataInterceptor rejects requests whose payloads carry the metadata reserved for the payloads
+	// encrypted by the server, so that clients can't pass payloads off as encrypted by the server.
+	EncryptedPayloadMetadataInterceptor struct {
+		enabled bool
+	}
+)
+
+var (
+	_ grpc.UnaryServerInterceptor = (*EncryptedPayloadMetadataInterceptor)(nil).Intercept
+
+	errReservedPayloadMetadata = serviceerror.NewInvalidArgument("Payload metadata is reserved for payloads encrypted by the server.")
+)
+
+func NewEncryptedPayloadMetadataInterceptor(enabled bool) *EncryptedPayloadMetadataInterceptor {
+	return &EncryptedPayloadMetadataInterceptor{enabled: enabled}
+}
+
+func (i *EncryptedPayloadMetadataInterceptor) Intercept(
+	ctx context.Context,
+	req interface{},
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if !i.enabled {
+		return handler(ctx, req)
+	}
+	request, ok := req.(proto.Message)
+	if !ok {
+		return handler(ctx, req)
+	}
+	err := proxy.VisitPayloads(ctx, request, proxy.VisitPayloadsOptions{
+		// search attributes are not encrypted
+		SkipSearchAttributes: true,
+		Visitor: func(_ *proxy.VisitPayloadsContext, payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+			for _, p := range payloads {
+				if hasReservedMetadata(p) {
+					return nil, errReservedPayloadMetadata
+				}
+			}
+			return payloads, nil
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func hasReservedMetadata(p *commonpb.Payload) bool {
+	metadata := p.GetMetadata()
+	_, hasKeyID := metadata[payloadencryption.MetadataKeyID]
+	_, hasNamespaceID := metadata[payloadencryption.MetadataNamespaceID]
+	return hasKeyID || hasNamespaceID
+}
@@ -0,0 +1,68 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/persistence/payloadencryption"
+)
+
+func TestEncryptedPayloadMetadataInterceptor(t *testing.T) {
+	handler := func(context.Context, interface{}) (interface{}, error) { return true, nil }
+	forged := payloads.EncodeString("input")
+	forged.Payloads[0].Metadata[payloadencryption.MetadataKeyID] = []byte("key")
+
+	testCases := []struct {
+		name    string
+		enabled bool
+		request *workflowservice.SignalWorkflowExecutionRequest
+		allowed bool
+	}{
+		{"plain", true, &workflowservice.SignalWorkflowExecutionRequest{Input: payloads.EncodeString("input")}, true},
+		{"forged", true, &workflowservice.SignalWorkflowExecutionRequest{Input: forged}, false},
+		{"forged header", true, &workflowservice.SignalWorkflowExecutionRequest{Header: &commonpb.Header{
+			Fields: map[string]*commonpb.Payload{"header": forged.Payloads[0]},
+		}}, false},
+		{"disabled", false, &workflowservice.SignalWorkflowExecutionRequest{Input: forged}, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			interceptor := NewEncryptedPayloadMetadataInterceptor(tc.enabled)
+			resp, err := interceptor.Intercept(context.Background(), tc.request, nil, handler)
+			if tc.allowed {
+				require.NoError(t, err)
+				require.Equal(t, true, resp)
+			} else {
+				var invalidArgument *serviceerror.InvalidArgument
+				require.ErrorAs(t, err, &invalidArgument)
+			}
+		})
+	}
+}
@@ -41,6 +41,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerRequest(req any) []tag.Tag
 		return nil
 	case *adminservice.CancelDLQJobRequest:
 		return nil
+	case *adminservice.CancelScheduleBackfillRequest:
+		return nil
+	case *adminservice.CaptureProfileRequest:
+		return nil
 	case *adminservice.CloseShardRequest:
 		return nil
 	case *adminservice.DeepHealthCheckRequest:
@@ -50,6 +54,8 @@ func (wt *WorkflowTags) extractFromAdminServiceServerRequest(req any) []tag.Tag
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
 			tag.WorkflowRunID(r.GetExecution().GetRunId()),
 		}
+	case *adminservice.DescribeArchivalStatusRequest:
+		return nil
 	case *adminservice.DescribeClusterRequest:
 		return nil
 	case *adminservice.DescribeDLQJobRequest:
@@ -64,8 +70,16 @@ func (wt *WorkflowTags) extractFromAdminServiceServerRequest(req any) []tag.Tag
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
 			tag.WorkflowRunID(r.GetExecution().GetRunId()),
 		}
+	case *adminservice.DescribeReplicationLagRequest:
+		return nil
+	case *adminservice.DescribeShardOwnershipRequest:
+		return nil
 	case *adminservice.DescribeTaskQueuePartitionRequest:
 		return nil
+	case *adminservice.ExportNamespaceConfigRequest:
+		return nil
+	case *adminservice.ExportWorkflowExecutionsRequest:
+		return nil
 	case *adminservice.ForceUnloadTaskQueuePartitionRequest:
 		return nil
 	case *adminservice.GenerateLastHistoryReplicationTasksRequest:
@@ -79,6 +93,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerRequest(req any) []tag.Tag
 		return nil
 	case *adminservice.GetDLQTasksRequest:
 		return nil
+	case *adminservice.GetDebugTraceRequest:
+		return nil
+	case *adminservice.GetDynamicConfigRequest:
+		return nil
 	case *adminservice.GetNamespaceRequest:
 		return nil
 	case *adminservice.GetNamespaceReplicationMessagesRequest:
@@ -101,6 +119,8 @@ func (wt *WorkflowTags) extractFromAdminServiceServerRequest(req any) []tag.Tag
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
 			tag.WorkflowRunID(r.GetExecution().GetRunId()),
 		}
+	case *adminservice.ImportNamespaceConfigRequest:
+		return nil
 	case *adminservice.ImportWorkflowExecutionRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
@@ -112,16 +132,35 @@ func (wt *WorkflowTags) extractFromAdminServiceServerRequest(req any) []tag.Tag
 		return nil
 	case *adminservice.ListHistoryTasksRequest:
 		return nil
+	case *adminservice.ListHotspotsRequest:
+		return nil
+	case *adminservice.ListNexusEndpointHealthRequest:
+		return nil
+	case *adminservice.ListPendingNexusOperationsRequest:
+		return nil
 	case *adminservice.ListQueuesRequest:
 		return nil
 	case *adminservice.MergeDLQMessagesRequest:
-		return nil
+		return []tag.Tag{
+			tag.WorkflowID(r.GetWorkflowId()),
+		}
 	case *adminservice.MergeDLQTasksRequest:
 		return nil
+	case *adminservice.MoveShardsRequest:
+		return nil
+	case *adminservice.MultiSignalWorkflowExecutionRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetWorkflowExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetWorkflowExecution().GetRunId()),
+		}
+	case *adminservice.PauseActivityTypeRequest:
+		return nil
 	case *adminservice.PurgeDLQMessagesRequest:
 		return nil
 	case *adminservice.PurgeDLQTasksRequest:
 		return nil
+	case *adminservice.ReArchiveWorkflowExecutionsRequest:
+		return nil
 	case *adminservice.ReapplyEventsRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetWorkflowExecution().GetWorkflowId()),
@@ -143,16 +182,38 @@ func (wt *WorkflowTags) extractFromAdminServiceServerRequest(req any) []tag.Tag
 		return nil
 	case *adminservice.RemoveTaskRequest:
 		return nil
+	case *adminservice.ReplicateNexusEndpointsRequest:
+		return nil
 	case *adminservice.ResendReplicationTasksRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetWorkflowId()),
 			tag.WorkflowRunID(r.GetRunId()),
 		}
+	case *adminservice.SetDynamicConfigRequest:
+		return nil
+	case *adminservice.SignalWorkflowExecutionsByQueryRequest:
+		return nil
+	case *adminservice.StartRebuildMutableStateBatchOperationRequest:
+		return nil
+	case *adminservice.StartRefreshWorkflowTasksBatchOperationRequest:
+		return nil
+	case *adminservice.StartSignalWithStartBatchOperationRequest:
+		return nil
 	case *adminservice.SyncWorkflowStateRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
 			tag.WorkflowRunID(r.GetExecution().GetRunId()),
 		}
+	case *adminservice.UnpauseActivityTypeRequest:
+		return nil
+	case *adminservice.UpdateClusterMetadataRequest:
+		return nil
+	case *adminservice.UpdateNexusEndpointOperationDefaultsRequest:
+		return nil
+	case *adminservice.UpdateNexusEndpointOutboundAuthRequest:
+		return nil
+	case *adminservice.UpdateSchedulePoliciesRequest:
+		return nil
 	default:
 		return nil
 	}
@@ -142,12 +142,21 @@ func (wt *WorkflowTags) extractFromHistoryServiceServerRequest(req any) []tag.Ta
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
 			tag.WorkflowRunID(r.GetExecution().GetRunId()),
 		}
+	case *historyservice.ListNexusEndpointHealthRequest:
+		return nil
 	case *historyservice.ListQueuesRequest:
 		return nil
 	case *historyservice.ListTasksRequest:
 		return nil
 	case *historyservice.MergeDLQMessagesRequest:
-		return nil
+		return []tag.Tag{
+			tag.WorkflowID(r.GetWorkflowId()),
+		}
+	case *historyservice.MultiSignalWorkflowExecutionRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetWorkflowExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetWorkflowExecution().GetRunId()),
+		}
 	case *historyservice.PauseActivityRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetFrontendRequest().GetWorkflowId()),
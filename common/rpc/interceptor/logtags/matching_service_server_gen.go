@@ -43,6 +43,8 @@ func (wt *WorkflowTags) extractFromMatchingServiceServerRequest(req any) []tag.T
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
 			tag.WorkflowRunID(r.GetExecution().GetRunId()),
 		}
+	case *matchingservice.ApplyNexusEndpointReplicationEventRequest:
+		return nil
 	case *matchingservice.ApplyTaskQueueUserDataReplicationEventRequest:
 		return nil
 	case *matchingservice.CancelOutstandingPollRequest:
@@ -0,0 +1,87 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/rpc/encryption"
+	"google.golang.org/grpc"
+)
+
+type (
+	// NamespaceClientCertInterceptor rejects requests whose mTLS client certificate wasn't issued
+	// by a CA trusted for the target namespace.
+	NamespaceClientCertInterceptor struct {
+		verifier encryption.NamespaceClientCertVerifier
+		logger   log.Logger
+	}
+)
+
+var (
+	_ grpc.UnaryServerInterceptor = (*NamespaceClientCertInterceptor)(nil).Intercept
+
+	errClientCertNotTrustedForNamespace = serviceerror.NewPermissionDenied("Client certificate is not trusted for this namespace.", "")
+)
+
+func NewNamespaceClientCertInterceptor(
+	verifier encryption.NamespaceClientCertVerifier,
+	logger log.Logger,
+) *NamespaceClientCertInterceptor {
+	return &NamespaceClientCertInterceptor{
+		verifier: verifier,
+		logger:   logger,
+	}
+}
+
+func (i *NamespaceClientCertInterceptor) Intercept(
+	ctx context.Context,
+	req interface{},
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if i.verifier == nil {
+		return handler(ctx, req)
+	}
+	tlsInfo := authorization.TLSInfoFromContext(ctx)
+	if tlsInfo == nil || len(tlsInfo.State.PeerCertificates) == 0 {
+		return handler(ctx, req)
+	}
+
+	var namespaceName string
+	if request, ok := req.(NamespaceNameGetter); ok {
+		namespaceName = request.GetNamespace()
+	}
+	if err := i.verifier.VerifyNamespaceClientCertificate(namespaceName, tlsInfo.State.PeerCertificates); err != nil {
+		i.logger.Warn("Client certificate is not trusted for namespace",
+			tag.WorkflowNamespace(namespaceName),
+			tag.Error(err),
+		)
+		return nil, errClientCertNotTrustedForNamespace
+	}
+	return handler(ctx, req)
+}
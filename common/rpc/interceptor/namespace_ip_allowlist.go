@@ -0,0 +1,142 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+	"net"
+	"net/netip"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+)
+
+type (
+	// NamespaceIPAllowlistInterceptor rejects requests for a namespace from caller addresses outside of the
+	// namespace's allowlist. The allowlist is read from the namespace data, falling back to dynamic config.
+	NamespaceIPAllowlistInterceptor struct {
+		enabled           bool
+		namespaceRegistry namespace.Registry
+		allowedCIDRs      dynamicconfig.TypedPropertyFnWithNamespaceFilter[[]string]
+		logger            log.Logger
+	}
+)
+
+var (
+	_ grpc.UnaryServerInterceptor = (*NamespaceIPAllowlistInterceptor)(nil).Intercept
+
+	errCallerAddressNotAllowed = serviceerror.NewPermissionDenied("Caller address is not allowed for this namespace.", "")
+)
+
+func NewNamespaceIPAllowlistInterceptor(
+	enabled bool,
+	namespaceRegistry namespace.Registry,
+	allowedCIDRs dynamicconfig.TypedPropertyFnWithNamespaceFilter[[]string],
+	logger log.Logger,
+) *NamespaceIPAllowlistInterceptor {
+	return &NamespaceIPAllowlistInterceptor{
+		enabled:           enabled,
+		namespaceRegistry: namespaceRegistry,
+		allowedCIDRs:      allowedCIDRs,
+		logger:            logger,
+	}
+}
+
+func (i *NamespaceIPAllowlistInterceptor) Intercept(
+	ctx context.Context,
+	req interface{},
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if !i.enabled {
+		return handler(ctx, req)
+	}
+	request, ok := req.(NamespaceNameGetter)
+	if !ok || request.GetNamespace() == "" {
+		return handler(ctx, req)
+	}
+	namespaceName := namespace.Name(request.GetNamespace())
+
+	var cidrs []string
+	ns, err := i.namespaceRegistry.GetNamespace(namespaceName)
+	if err == nil {
+		cidrs, ok = ns.AllowedCallerCIDRs()
+	}
+	if err != nil || !ok {
+		// unknown namespaces are rejected by the namespace validator
+		cidrs = i.allowedCIDRs(namespaceName.String())
+	}
+	if len(cidrs) == 0 {
+		return handler(ctx, req)
+	}
+
+	addr, ok := callerAddr(ctx)
+	if !ok || !isAddrAllowed(addr, cidrs) {
+		i.logger.Warn("Rejected request from caller address not allowed for namespace",
+			tag.WorkflowNamespace(namespaceName.String()),
+			tag.Address(addr.String()),
+		)
+		return nil, errCallerAddressNotAllowed
+	}
+	return handler(ctx, req)
+}
+
+func callerAddr(ctx context.Context) (netip.Addr, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return netip.Addr{}, false
+	}
+	host := p.Addr.String()
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return addr.Unmap(), true
+}
+
+// isAddrAllowed returns true if addr matches one of cidrs. Entries may be single addresses; invalid entries never
+// match.
+func isAddrAllowed(addr netip.Addr, cidrs []string) bool {
+	for _, cidr := range cidrs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			allowed, err := netip.ParseAddr(cidr)
+			if err != nil {
+				continue
+			}
+			prefix = netip.PrefixFrom(allowed, allowed.BitLen())
+		}
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
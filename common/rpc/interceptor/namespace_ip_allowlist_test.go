@@ -0,0 +1,103 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/workflowservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+)
+
+func TestNamespaceIPAllowlistInterceptor(t *testing.T) {
+	controller := gomock.NewController(t)
+	registry := namespace.NewMockRegistry(controller)
+	registry.EXPECT().GetNamespace(namespace.Name("from-data")).Return(namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{
+			Name: "from-data",
+			Data: map[string]string{namespace.AllowedCallerCIDRsDataKey: "10.0.0.0/8, 192.168.1.1"},
+		}, nil, ""), nil).AnyTimes()
+	registry.EXPECT().GetNamespace(namespace.Name("from-config")).Return(namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Name: "from-config"}, nil, ""), nil).AnyTimes()
+	registry.EXPECT().GetNamespace(namespace.Name("open")).Return(namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Name: "open"}, nil, ""), nil).AnyTimes()
+
+	allowedCIDRs := func(namespaceName string) []string {
+		if namespaceName == "from-config" {
+			return []string{"2001:db8::/32"}
+		}
+		return nil
+	}
+	interceptor := NewNamespaceIPAllowlistInterceptor(true, registry, allowedCIDRs, log.NewNoopLogger())
+	handler := func(context.Context, interface{}) (interface{}, error) { return true, nil }
+
+	testCases := []struct {
+		namespace string
+		caller    string
+		allowed   bool
+	}{
+		{"from-data", "10.1.2.3", true},
+		{"from-data", "192.168.1.1", true},
+		{"from-data", "192.168.1.2", false},
+		{"from-data", "::ffff:10.1.2.3", true},
+		{"from-config", "2001:db8::1", true},
+		{"from-config", "10.1.2.3", false},
+		{"open", "172.16.0.1", true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.namespace+"/"+tc.caller, func(t *testing.T) {
+			ctx := peer.NewContext(context.Background(), &peer.Peer{
+				Addr: &net.TCPAddr{IP: net.ParseIP(tc.caller), Port: 1234},
+			})
+			_, err := interceptor.Intercept(
+				ctx,
+				&workflowservice.StartWorkflowExecutionRequest{Namespace: tc.namespace},
+				&grpc.UnaryServerInfo{},
+				handler,
+			)
+			if tc.allowed {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, errCallerAddressNotAllowed)
+			}
+		})
+	}
+
+	disabled := NewNamespaceIPAllowlistInterceptor(false, registry, dynamicconfig.GetTypedPropertyFnFilteredByNamespace([]string{"10.0.0.0/8"}), log.NewNoopLogger())
+	_, err := disabled.Intercept(
+		context.Background(),
+		&workflowservice.StartWorkflowExecutionRequest{Namespace: "from-data"},
+		&grpc.UnaryServerInfo{},
+		handler,
+	)
+	require.NoError(t, err)
+}
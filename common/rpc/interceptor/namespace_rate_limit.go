@@ -49,6 +49,10 @@ var (
 )
 
 type (
+	identityGetter interface {
+		GetIdentity() string
+	}
+
 	NamespaceRateLimitInterceptor struct {
 		namespaceRegistry namespace.Registry
 		rateLimiter       quotas.RequestRateLimiter
@@ -77,7 +81,11 @@ func (ni *NamespaceRateLimitInterceptor) Intercept(
 	handler grpc.UnaryHandler,
 ) (interface{}, error) {
 	if ns := MustGetNamespaceName(ni.namespaceRegistry, req); ns != namespace.EmptyName {
-		if err := ni.Allow(ns, info.FullMethod, headers.NewGRPCHeaderGetter(ctx)); err != nil {
+		var identity string
+		if getter, ok := req.(identityGetter); ok {
+			identity = getter.GetIdentity()
+		}
+		if err := ni.allow(ns, info.FullMethod, identity, headers.NewGRPCHeaderGetter(ctx)); err != nil {
 			return nil, err
 		}
 	}
@@ -86,19 +94,25 @@ func (ni *NamespaceRateLimitInterceptor) Intercept(
 }
 
 func (ni *NamespaceRateLimitInterceptor) Allow(namespaceName namespace.Name, methodName string, headerGetter headers.HeaderGetter) error {
+	return ni.allow(namespaceName, methodName, "", headerGetter)
+}
+
+func (ni *NamespaceRateLimitInterceptor) allow(namespaceName namespace.Name, methodName string, identity string, headerGetter headers.HeaderGetter) error {
 	token, ok := ni.tokens[methodName]
 	if !ok {
 		token = NamespaceRateLimitDefaultToken
 	}
 
-	if !ni.rateLimiter.Allow(time.Now().UTC(), quotas.NewRequest(
+	request := quotas.NewRequest(
 		methodName,
 		token,
 		namespaceName.String(),
 		headerGetter.Get(headers.CallerTypeHeaderName),
 		0,  // this interceptor layer does not throttle based on caller segment
 		"", // this interceptor layer does not throttle based on call initiation
-	)) {
+	)
+	request.Identity = identity
+	if !ni.rateLimiter.Allow(time.Now().UTC(), request) {
 		return ErrNamespaceRateLimitServerBusy
 	}
 	return nil
@@ -57,10 +57,11 @@ var (
 	matchingAPIExcluded = map[string]struct{}{
 		"ListTaskQueuePartitions": {},
 		// Nexus endpoint APIs operate on a cluster scope, not a namespace scope.
-		"CreateNexusEndpoint": {},
-		"UpdateNexusEndpoint": {},
-		"ListNexusEndpoints":  {},
-		"DeleteNexusEndpoint": {},
+		"CreateNexusEndpoint":                {},
+		"UpdateNexusEndpoint":                {},
+		"ListNexusEndpoints":                 {},
+		"DeleteNexusEndpoint":                {},
+		"ApplyNexusEndpointReplicationEvent": {},
 	}
 
 	historyAPIExcluded = map[string]struct{}{
@@ -81,6 +82,7 @@ var (
 		"ListTasks":                 {},
 		"CompleteNexusOperation":    {}, // NamespaceId is in the completion token for this request.
 		"DeepHealthCheck":           {},
+		"ListNexusEndpointHealth":   {},
 	}
 )
 
@@ -50,6 +50,9 @@ type (
 		tokenSerializer                 common.TaskTokenSerializer
 		enableTokenNamespaceEnforcement dynamicconfig.BoolPropertyFn
 		maxNamespaceLength              dynamicconfig.IntPropertyFn
+		// enableFineGrainedHandover delegates handover blocking to history shards, which only reject writes while
+		// the shard's replication tasks are not yet drained to the remote cluster.
+		enableFineGrainedHandover dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	}
 )
 
@@ -112,12 +115,14 @@ func NewNamespaceValidatorInterceptor(
 	namespaceRegistry namespace.Registry,
 	enableTokenNamespaceEnforcement dynamicconfig.BoolPropertyFn,
 	maxNamespaceLength dynamicconfig.IntPropertyFn,
+	enableFineGrainedHandover dynamicconfig.BoolPropertyFnWithNamespaceFilter,
 ) *NamespaceValidatorInterceptor {
 	return &NamespaceValidatorInterceptor{
 		namespaceRegistry:               namespaceRegistry,
 		tokenSerializer:                 common.NewProtoTaskTokenSerializer(),
 		enableTokenNamespaceEnforcement: enableTokenNamespaceEnforcement,
 		maxNamespaceLength:              maxNamespaceLength,
+		enableFineGrainedHandover:       enableFineGrainedHandover,
 	}
 }
 
@@ -406,6 +411,12 @@ func (ni *NamespaceValidatorInterceptor) checkReplicationState(namespaceEntry *n
 		return nil
 	}
 
+	if ni.enableFineGrainedHandover(namespaceEntry.Name().String()) {
+		// Reads and polls keep being served. Writes are rejected by the history shards
+		// which haven't drained their replication tasks yet.
+		return nil
+	}
+
 	methodName := api.MethodName(fullMethod)
 
 	if _, ok := allowedMethodsDuringHandover[methodName]; ok {
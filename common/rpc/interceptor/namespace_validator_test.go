@@ -88,7 +88,8 @@ func (s *namespaceValidatorSuite) Test_StateValidationIntercept_NamespaceNotSet(
 	nvi := NewNamespaceValidatorInterceptor(
 		s.mockRegistry,
 		dynamicconfig.GetBoolPropertyFn(false),
-		dynamicconfig.GetIntPropertyFn(100))
+		dynamicconfig.GetIntPropertyFn(100),
+		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false))
 	serverInfo := &grpc.UnaryServerInfo{
 		FullMethod: "/temporal/random",
 	}
@@ -139,7 +140,8 @@ func (s *namespaceValidatorSuite) Test_StateValidationIntercept_NamespaceNotFoun
 	nvi := NewNamespaceValidatorInterceptor(
 		s.mockRegistry,
 		dynamicconfig.GetBoolPropertyFn(false),
-		dynamicconfig.GetIntPropertyFn(100))
+		dynamicconfig.GetIntPropertyFn(100),
+		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false))
 	serverInfo := &grpc.UnaryServerInfo{
 		FullMethod: "/temporal/random",
 	}
@@ -413,7 +415,8 @@ func (s *namespaceValidatorSuite) Test_StateValidationIntercept_StatusFromNamesp
 			nvi := NewNamespaceValidatorInterceptor(
 				s.mockRegistry,
 				dynamicconfig.GetBoolPropertyFn(false),
-				dynamicconfig.GetIntPropertyFn(100))
+				dynamicconfig.GetIntPropertyFn(100),
+				dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false))
 			serverInfo := &grpc.UnaryServerInfo{
 				FullMethod: testCase.method,
 			}
@@ -435,6 +438,49 @@ func (s *namespaceValidatorSuite) Test_StateValidationIntercept_StatusFromNamesp
 	}
 }
 
+func (s *namespaceValidatorSuite) Test_StateValidationIntercept_FineGrainedHandover() {
+	s.mockRegistry.EXPECT().GetNamespace(namespace.Name("test-namespace")).Return(namespace.FromPersistentState(
+		&persistence.GetNamespaceResponse{
+			Namespace: &persistencespb.NamespaceDetail{
+				Config: &persistencespb.NamespaceConfig{},
+				ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
+					State: enumspb.REPLICATION_STATE_HANDOVER,
+				},
+				Info: &persistencespb.NamespaceInfo{
+					Name:  "test-namespace",
+					State: enumspb.NAMESPACE_STATE_REGISTERED,
+				},
+			},
+		}), nil).Times(2)
+
+	req := &workflowservice.StartWorkflowExecutionRequest{Namespace: "test-namespace"}
+	serverInfo := &grpc.UnaryServerInfo{
+		FullMethod: api.WorkflowServicePrefix + "StartWorkflowExecution",
+	}
+	for _, enabled := range []bool{false, true} {
+		nvi := NewNamespaceValidatorInterceptor(
+			s.mockRegistry,
+			dynamicconfig.GetBoolPropertyFn(false),
+			dynamicconfig.GetIntPropertyFn(100),
+			dynamicconfig.GetBoolPropertyFnFilteredByNamespace(enabled))
+
+		handlerCalled := false
+		_, err := nvi.StateValidationIntercept(context.Background(), req, serverInfo, func(ctx context.Context, req interface{}) (interface{}, error) {
+			handlerCalled = true
+			return &workflowservice.StartWorkflowExecutionResponse{}, nil
+		})
+
+		if enabled {
+			// Writes are rejected by history shards instead.
+			s.NoError(err)
+			s.True(handlerCalled)
+		} else {
+			s.Equal(common.ErrNamespaceHandover, err)
+			s.False(handlerCalled)
+		}
+	}
+}
+
 func (s *namespaceValidatorSuite) Test_StateValidationIntercept_StatusFromToken() {
 	taskToken, _ := common.NewProtoTaskTokenSerializer().Serialize(&tokenspb.Task{
 		NamespaceId: "test-namespace-id",
@@ -488,7 +534,8 @@ func (s *namespaceValidatorSuite) Test_StateValidationIntercept_StatusFromToken(
 		nvi := NewNamespaceValidatorInterceptor(
 			s.mockRegistry,
 			dynamicconfig.GetBoolPropertyFn(false),
-			dynamicconfig.GetIntPropertyFn(100))
+			dynamicconfig.GetIntPropertyFn(100),
+			dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false))
 		serverInfo := &grpc.UnaryServerInfo{
 			FullMethod: testCase.method,
 		}
@@ -513,7 +560,8 @@ func (s *namespaceValidatorSuite) Test_StateValidationIntercept_DescribeNamespac
 	nvi := NewNamespaceValidatorInterceptor(
 		s.mockRegistry,
 		dynamicconfig.GetBoolPropertyFn(false),
-		dynamicconfig.GetIntPropertyFn(100))
+		dynamicconfig.GetIntPropertyFn(100),
+		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false))
 	serverInfo := &grpc.UnaryServerInfo{
 		FullMethod: "/temporal/random",
 	}
@@ -543,7 +591,8 @@ func (s *namespaceValidatorSuite) Test_StateValidationIntercept_GetClusterInfo()
 	nvi := NewNamespaceValidatorInterceptor(
 		s.mockRegistry,
 		dynamicconfig.GetBoolPropertyFn(false),
-		dynamicconfig.GetIntPropertyFn(100))
+		dynamicconfig.GetIntPropertyFn(100),
+		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false))
 	serverInfo := &grpc.UnaryServerInfo{
 		FullMethod: "/temporal/random",
 	}
@@ -564,7 +613,8 @@ func (s *namespaceValidatorSuite) Test_Intercept_RegisterNamespace() {
 	nvi := NewNamespaceValidatorInterceptor(
 		s.mockRegistry,
 		dynamicconfig.GetBoolPropertyFn(false),
-		dynamicconfig.GetIntPropertyFn(100))
+		dynamicconfig.GetIntPropertyFn(100),
+		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false))
 	serverInfo := &grpc.UnaryServerInfo{
 		FullMethod: "/temporal/random",
 	}
@@ -678,7 +728,8 @@ func (s *namespaceValidatorSuite) Test_StateValidationIntercept_TokenNamespaceEn
 		nvi := NewNamespaceValidatorInterceptor(
 			s.mockRegistry,
 			dynamicconfig.GetBoolPropertyFn(testCase.enableTokenNamespaceEnforcement),
-			dynamicconfig.GetIntPropertyFn(100))
+			dynamicconfig.GetIntPropertyFn(100),
+			dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false))
 		serverInfo := &grpc.UnaryServerInfo{
 			FullMethod: api.WorkflowServicePrefix + "RandomMethod",
 		}
@@ -723,6 +774,7 @@ func (s *namespaceValidatorSuite) Test_Intercept_DescribeHistoryHostRequests() {
 			s.mockRegistry,
 			dynamicconfig.GetBoolPropertyFn(false),
 			dynamicconfig.GetIntPropertyFn(100),
+			dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
 		)
 		serverInfo := &grpc.UnaryServerInfo{
 			FullMethod: api.WorkflowServicePrefix + "random",
@@ -808,6 +860,7 @@ func (s *namespaceValidatorSuite) Test_Intercept_SearchAttributeRequests() {
 			s.mockRegistry,
 			dynamicconfig.GetBoolPropertyFn(false),
 			dynamicconfig.GetIntPropertyFn(100),
+			dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
 		)
 		serverInfo := &grpc.UnaryServerInfo{
 			FullMethod: api.WorkflowServicePrefix + "random",
@@ -832,7 +885,8 @@ func (s *namespaceValidatorSuite) Test_NamespaceValidateIntercept() {
 	nvi := NewNamespaceValidatorInterceptor(
 		s.mockRegistry,
 		dynamicconfig.GetBoolPropertyFn(false),
-		dynamicconfig.GetIntPropertyFn(10))
+		dynamicconfig.GetIntPropertyFn(10),
+		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false))
 	serverInfo := &grpc.UnaryServerInfo{
 		FullMethod: api.WorkflowServicePrefix + "random",
 	}
@@ -902,6 +956,7 @@ func (s *namespaceValidatorSuite) TestSetNamespace() {
 		s.mockRegistry,
 		dynamicconfig.GetBoolPropertyFn(false),
 		dynamicconfig.GetIntPropertyFn(10),
+		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
 	)
 
 	queryReq := &workflowservice.RespondQueryTaskCompletedRequest{}
@@ -57,6 +57,9 @@ type (
 		logger            log.Logger
 		workflowTags      *logtags.WorkflowTags
 		logAllReqErrors   dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		// slowRequestLogThresholds maps operation names to the latency above which requests are logged as slow.
+		slowRequestLogThresholds dynamicconfig.TypedPropertyFn[map[string]time.Duration]
+		numHistoryShards         int32
 	}
 )
 
@@ -76,6 +79,11 @@ var (
 	_ grpc.StreamServerInterceptor = (*TelemetryInterceptor)(nil).StreamIntercept
 )
 
+const (
+	// slowRequestLogDefaultKey is the slow request log threshold key applying to operations without their own.
+	slowRequestLogDefaultKey = "*"
+)
+
 var (
 	respondWorkflowTaskCompleted = "RespondWorkflowTaskCompleted"
 	pollActivityTaskQueue        = "PollActivityTaskQueue"
@@ -120,13 +128,17 @@ func NewTelemetryInterceptor(
 	metricsHandler metrics.Handler,
 	logger log.Logger,
 	logAllReqErrors dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+	slowRequestLogThresholds dynamicconfig.TypedPropertyFn[map[string]time.Duration],
+	numHistoryShards int32,
 ) *TelemetryInterceptor {
 	return &TelemetryInterceptor{
-		namespaceRegistry: namespaceRegistry,
-		metricsHandler:    metricsHandler,
-		logger:            logger,
-		workflowTags:      logtags.NewWorkflowTags(common.NewProtoTaskTokenSerializer(), logger),
-		logAllReqErrors:   logAllReqErrors,
+		namespaceRegistry:        namespaceRegistry,
+		metricsHandler:           metricsHandler,
+		logger:                   logger,
+		workflowTags:             logtags.NewWorkflowTags(common.NewProtoTaskTokenSerializer(), logger),
+		logAllReqErrors:          logAllReqErrors,
+		slowRequestLogThresholds: slowRequestLogThresholds,
+		numHistoryShards:         numHistoryShards,
 	}
 }
 
@@ -191,6 +203,7 @@ func (ti *TelemetryInterceptor) UnaryIntercept(
 	startTime := time.Now().UTC()
 	defer func() {
 		ti.RecordLatencyMetrics(ctx, startTime, metricsHandler)
+		ti.logSlowRequest(ctx, req, info.FullMethod, methodName, nsName, logTags, time.Since(startTime))
 	}()
 
 	resp, err := handler(ctx, req)
@@ -222,6 +235,70 @@ func (ti *TelemetryInterceptor) RecordLatencyMetrics(ctx context.Context, startT
 	metrics.ServiceLatencyNoUserLatency.With(metricsHandler).Record(noUserLatency)
 }
 
+// logSlowRequest logs requests whose latency exceeds the threshold configured for their operation, along with the
+// time the request spent waiting on the workflow lock, persistence and matching.
+func (ti *TelemetryInterceptor) logSlowRequest(
+	ctx context.Context,
+	req any,
+	fullMethod string,
+	methodName string,
+	nsName namespace.Name,
+	logTags []tag.Tag,
+	latency time.Duration,
+) {
+	thresholds := ti.slowRequestLogThresholds()
+	if len(thresholds) == 0 {
+		return
+	}
+	threshold, ok := thresholds[ti.unaryOverrideOperationTag(fullMethod, methodName, req)]
+	if !ok {
+		threshold = thresholds[slowRequestLogDefaultKey]
+	}
+	if threshold <= 0 || latency < threshold {
+		return
+	}
+
+	workflowTags := ti.workflowTags.Extract(req, fullMethod)
+	logTags = append(logTags, workflowTags...)
+	if shardID, ok := ti.historyShardID(nsName, workflowTags); ok {
+		logTags = append(logTags, tag.ShardID(shardID))
+	}
+	logTags = append(logTags,
+		tag.NewDurationTag("latency", latency),
+		tag.NewDurationTag("threshold", threshold),
+		tag.NewDurationTag("lock-wait-latency", contextLatency(ctx, metrics.HistoryWorkflowExecutionCacheLatency.Name())),
+		tag.NewDurationTag("persistence-latency", contextLatency(ctx, metrics.PersistenceLatency.Name())),
+		tag.NewDurationTag("matching-latency", contextLatency(ctx, metrics.MatchingCallLatencyCounterName)),
+	)
+	ti.logger.Warn("slow request", logTags...)
+}
+
+// historyShardID returns the history shard owning the workflow the request targets, if known.
+func (ti *TelemetryInterceptor) historyShardID(nsName namespace.Name, workflowTags []tag.Tag) (int32, bool) {
+	if ti.numHistoryShards <= 0 || nsName == "" {
+		return 0, false
+	}
+	var workflowID string
+	for _, workflowTag := range workflowTags {
+		if workflowTag.Key() == tag.WorkflowIDKey {
+			workflowID, _ = workflowTag.Value().(string)
+		}
+	}
+	if workflowID == "" {
+		return 0, false
+	}
+	nsID, err := ti.namespaceRegistry.GetNamespaceID(nsName)
+	if err != nil {
+		return 0, false
+	}
+	return common.WorkflowIDToHistoryShard(nsID.String(), workflowID, ti.numHistoryShards), true
+}
+
+func contextLatency(ctx context.Context, counterName string) time.Duration {
+	val, _ := metrics.ContextCounterGet(ctx, counterName)
+	return time.Duration(val)
+}
+
 func (ti *TelemetryInterceptor) StreamIntercept(
 	service any,
 	serverStream grpc.ServerStream,
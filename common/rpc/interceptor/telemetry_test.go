@@ -25,7 +25,9 @@
 package interceptor
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	commandpb "go.temporal.io/api/command/v1"
@@ -36,6 +38,7 @@ import (
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/api"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
@@ -59,7 +62,9 @@ func TestEmitActionMetric(t *testing.T) {
 	telemetry := NewTelemetryInterceptor(register,
 		metricsHandler,
 		log.NewNoopLogger(),
-		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false))
+		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
+		dynamicconfig.GetTypedPropertyFn(map[string]time.Duration(nil)),
+		0)
 
 	testCases := []struct {
 		methodName        string
@@ -353,7 +358,9 @@ func TestHandleError(t *testing.T) {
 			telemetry := NewTelemetryInterceptor(registry,
 				metricsHandler,
 				mockLogger,
-				tt.logAllErrors)
+				tt.logAllErrors,
+				dynamicconfig.GetTypedPropertyFn(map[string]time.Duration(nil)),
+				0)
 
 			if tt.expectLogging {
 				mockLogger.EXPECT().Error(gomock.Eq("service failures"), gomock.Any()).Times(1)
@@ -378,7 +385,9 @@ func TestOperationOverwrite(t *testing.T) {
 	telemetry := NewTelemetryInterceptor(register,
 		metricsHandler,
 		log.NewNoopLogger(),
-		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false))
+		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
+		dynamicconfig.GetTypedPropertyFn(map[string]time.Duration(nil)),
+		0)
 
 	testCases := []struct {
 		methodName        string
@@ -416,7 +425,9 @@ func TestOperationOverride(t *testing.T) {
 	metricsHandler := metrics.NewMockHandler(controller)
 	telemetry := NewTelemetryInterceptor(register, metricsHandler,
 		log.NewNoopLogger(),
-		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false))
+		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
+		dynamicconfig.GetTypedPropertyFn(map[string]time.Duration(nil)),
+		0)
 
 	wid := "test_workflow_id"
 	rid := "test_run_id"
@@ -488,3 +499,67 @@ func TestOperationOverride(t *testing.T) {
 		})
 	}
 }
+
+func TestLogSlowRequest(t *testing.T) {
+	controller := gomock.NewController(t)
+	registry := namespace.NewMockRegistry(controller)
+	registry.EXPECT().GetNamespaceID(namespace.Name("test-namespace")).Return(namespace.ID("test-namespace-id"), nil).AnyTimes()
+	thresholds := map[string]time.Duration{
+		startWorkflow:            time.Second,
+		"PollWorkflowTaskQueue":  0,
+		slowRequestLogDefaultKey: 5 * time.Second,
+	}
+	req := &workflowservice.StartWorkflowExecutionRequest{Namespace: "test-namespace", WorkflowId: "test-workflow-id"}
+
+	testCases := []struct {
+		name          string
+		methodName    string
+		latency       time.Duration
+		expectLogging bool
+	}{
+		{name: "below-threshold", methodName: startWorkflow, latency: 500 * time.Millisecond},
+		{name: "above-threshold", methodName: startWorkflow, latency: 2 * time.Second, expectLogging: true},
+		{name: "below-default-threshold", methodName: queryWorkflow, latency: 2 * time.Second},
+		{name: "above-default-threshold", methodName: queryWorkflow, latency: 6 * time.Second, expectLogging: true},
+		{name: "disabled", methodName: "PollWorkflowTaskQueue", latency: time.Minute},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockLogger := log.NewMockLogger(controller)
+			telemetry := NewTelemetryInterceptor(registry,
+				metrics.NoopMetricsHandler,
+				mockLogger,
+				dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
+				dynamicconfig.GetTypedPropertyFn(thresholds),
+				4)
+
+			ctx := metrics.AddMetricsContext(context.Background())
+			metrics.ContextCounterAdd(ctx, metrics.HistoryWorkflowExecutionCacheLatency.Name(), int64(100*time.Millisecond))
+			metrics.ContextCounterAdd(ctx, metrics.PersistenceLatency.Name(), int64(200*time.Millisecond))
+
+			if tt.expectLogging {
+				mockLogger.EXPECT().Warn("slow request", gomock.Any()).DoAndReturn(func(_ string, tags ...tag.Tag) {
+					logged := make(map[string]any, len(tags))
+					for _, logTag := range tags {
+						logged[logTag.Key()] = logTag.Value()
+					}
+					assert.Equal(t, "test-workflow-id", logged[tag.WorkflowIDKey])
+					assert.Equal(t, common.WorkflowIDToHistoryShard("test-namespace-id", "test-workflow-id", 4), logged["shard-id"])
+					assert.Equal(t, tt.latency, logged["latency"])
+					assert.Equal(t, 100*time.Millisecond, logged["lock-wait-latency"])
+					assert.Equal(t, 200*time.Millisecond, logged["persistence-latency"])
+					assert.Equal(t, time.Duration(0), logged["matching-latency"])
+				})
+			}
+
+			telemetry.logSlowRequest(ctx,
+				req,
+				api.WorkflowServicePrefix+startWorkflow,
+				tt.methodName,
+				"test-namespace",
+				nil,
+				tt.latency)
+		})
+	}
+}
@@ -0,0 +1,175 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpc
+
+import (
+	"fmt"
+	"slices"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"google.golang.org/grpc/encoding"
+	protoencoding "google.golang.org/grpc/encoding/proto"
+	"google.golang.org/grpc/mem"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+type (
+	// LazyPayloadsCodec is the proto codec of gRPC, except that it decodes some user payloads lazily. When enabled, the
+	// payloads at the given paths of the messages it decodes are kept encoded, as the unknown fields of their Payloads
+	// message. Unknown fields are encoded back as is, so such payloads pass through the service, e.g. to persistence,
+	// without being decoded and encoded again, which is most of the cost of handling large payloads.
+	//
+	// Lazily decoded payloads are not validated and their content can't be read: code which needs to read them, e.g.
+	// to encrypt them, has to decode them first, e.g. by encoding and decoding the message holding them again. It is
+	// meant for requests from other services, which already validated the payloads.
+	LazyPayloadsCodec struct {
+		encoding.CodecV2
+		enabled func() bool
+		paths   map[protoreflect.FullName][]LazyPayloadsPath
+	}
+
+	// LazyPayloadsPath is the path, from a message decoded by LazyPayloadsCodec, to a Payloads field to decode lazily.
+	LazyPayloadsPath struct {
+		message protoreflect.FullName
+		fields  []protowire.Number
+		// descriptors of the fields, to set the lazily decoded payloads in the decoded message
+		descriptors []protoreflect.FieldDescriptor
+	}
+)
+
+var _ encoding.CodecV2 = (*LazyPayloadsCodec)(nil)
+
+// NewLazyPayloadsCodec returns a codec decoding the payloads at the given paths lazily while enabled returns true.
+// It is meant to be set on gRPC servers with grpc.ForceServerCodecV2.
+func NewLazyPayloadsCodec(enabled func() bool, paths ...LazyPayloadsPath) *LazyPayloadsCodec {
+	c := &LazyPayloadsCodec{
+		CodecV2: encoding.GetCodecV2(protoencoding.Name),
+		enabled: enabled,
+		paths:   make(map[protoreflect.FullName][]LazyPayloadsPath, len(paths)),
+	}
+	for _, path := range paths {
+		c.paths[path.message] = append(c.paths[path.message], path)
+	}
+	return c
+}
+
+// NewLazyPayloadsPath returns the path of the Payloads field reached from message by following the fields with the
+// given names, e.g. "start_request", "input". It panics if the fields don't lead to a non-repeated Payloads field.
+func NewLazyPayloadsPath(message proto.Message, fieldNames ...protoreflect.Name) LazyPayloadsPath {
+	desc := message.ProtoReflect().Descriptor()
+	path := LazyPayloadsPath{message: desc.FullName()}
+	for _, name := range fieldNames {
+		fd := desc.Fields().ByName(name)
+		if fd == nil || fd.Message() == nil || fd.IsList() || fd.IsMap() {
+			panic(fmt.Sprintf("%v has no non-repeated message field %v", desc.FullName(), name))
+		}
+		path.fields = append(path.fields, fd.Number())
+		path.descriptors = append(path.descriptors, fd)
+		desc = fd.Message()
+	}
+	if len(fieldNames) == 0 || desc.FullName() != (&commonpb.Payloads{}).ProtoReflect().Descriptor().FullName() {
+		panic(fmt.Sprintf("%v%v is not a Payloads field", path.message, fieldNames))
+	}
+	return path
+}
+
+func (c *LazyPayloadsCodec) Unmarshal(data mem.BufferSlice, v any) error {
+	m, ok := v.(proto.Message)
+	if !ok {
+		return c.CodecV2.Unmarshal(data, v)
+	}
+	paths := c.paths[m.ProtoReflect().Descriptor().FullName()]
+	if len(paths) == 0 || !c.enabled() {
+		return c.CodecV2.Unmarshal(data, v)
+	}
+
+	// Lazily decoded payloads reference the buffer, so unlike the proto codec, it is not taken from the buffer pool.
+	b := data.Materialize()
+	payloads := make([][]byte, len(paths))
+	found := make([]bool, len(paths))
+	for i, path := range paths {
+		var err error
+		if b, payloads[i], found[i], err = extractField(b, path.fields); err != nil {
+			return fmt.Errorf("failed to unmarshal %T: %w", v, err)
+		}
+	}
+	if err := proto.Unmarshal(b, m); err != nil {
+		return err
+	}
+	for i, path := range paths {
+		if !found[i] {
+			continue
+		}
+		msg := m.ProtoReflect()
+		for _, fd := range path.descriptors {
+			msg = msg.Mutable(fd).Message()
+		}
+		msg.SetUnknown(payloads[i])
+	}
+	return nil
+}
+
+// extractField splits the encoded message b into the encoded content of the message field at the given path, and the
+// rest of the message. Occurrences of the field are concatenated, which merges them as decoding would. The content
+// references b, unless the field occurs more than once.
+func extractField(b []byte, path []protowire.Number) (rest []byte, field []byte, found bool, err error) {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return nil, nil, false, protowire.ParseError(n)
+		}
+		m := protowire.ConsumeFieldValue(num, typ, b[n:])
+		if m < 0 {
+			return nil, nil, false, protowire.ParseError(m)
+		}
+		encodedField := b[:n+m]
+		b = b[n+m:]
+		if num != path[0] || typ != protowire.BytesType {
+			rest = append(rest, encodedField...)
+			continue
+		}
+
+		value, _ := protowire.ConsumeBytes(encodedField[n:])
+		if len(path) > 1 {
+			var valueField []byte
+			var valueFound bool
+			if value, valueField, valueFound, err = extractField(value, path[1:]); err != nil {
+				return nil, nil, false, err
+			}
+			rest = protowire.AppendTag(rest, num, protowire.BytesType)
+			rest = protowire.AppendBytes(rest, value)
+			if !valueFound {
+				continue
+			}
+			value = valueField
+		}
+		if found {
+			field = append(slices.Clip(field), value...)
+		} else {
+			field, found = value, true
+		}
+	}
+	return rest, field, found, nil
+}
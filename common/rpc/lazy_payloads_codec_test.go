@@ -0,0 +1,216 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpc_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/rpc"
+	"go.temporal.io/server/common/testing/protorequire"
+	"google.golang.org/grpc/mem"
+	"google.golang.org/protobuf/proto"
+)
+
+func newTestLazyPayloadsCodec(enabled bool) *rpc.LazyPayloadsCodec {
+	return rpc.NewLazyPayloadsCodec(
+		func() bool { return enabled },
+		rpc.NewLazyPayloadsPath(&historyservice.RespondActivityTaskCompletedRequest{}, "complete_request", "result"),
+		rpc.NewLazyPayloadsPath(&historyservice.StartWorkflowExecutionRequest{}, "start_request", "input"),
+		rpc.NewLazyPayloadsPath(&historyservice.StartWorkflowExecutionRequest{}, "start_request", "last_completion_result"),
+	)
+}
+
+func encode(t testing.TB, m proto.Message) mem.BufferSlice {
+	b, err := proto.Marshal(m)
+	require.NoError(t, err)
+	return mem.BufferSlice{mem.SliceBuffer(b)}
+}
+
+func TestLazyPayloadsCodec(t *testing.T) {
+	t.Parallel()
+	codec := newTestLazyPayloadsCodec(true)
+	request := &historyservice.RespondActivityTaskCompletedRequest{
+		NamespaceId: "namespace-id",
+		CompleteRequest: &workflowservice.RespondActivityTaskCompletedRequest{
+			TaskToken: []byte("task-token"),
+			Result:    payloads.EncodeString("result"),
+			Identity:  "identity",
+		},
+	}
+
+	var decoded historyservice.RespondActivityTaskCompletedRequest
+	require.NoError(t, codec.Unmarshal(encode(t, request), &decoded))
+	require.Equal(t, "namespace-id", decoded.NamespaceId)
+	require.Equal(t, []byte("task-token"), decoded.CompleteRequest.TaskToken)
+	require.Equal(t, "identity", decoded.CompleteRequest.Identity)
+	// the result is not decoded, but is encoded back as is
+	require.Empty(t, decoded.CompleteRequest.Result.Payloads)
+	require.Equal(t, proto.Size(request.CompleteRequest.Result), decoded.CompleteRequest.Result.Size())
+	event := &historypb.ActivityTaskCompletedEventAttributes{Result: decoded.CompleteRequest.Result}
+	var decodedEvent historypb.ActivityTaskCompletedEventAttributes
+	require.NoError(t, proto.Unmarshal(encode(t, event).Materialize(), &decodedEvent))
+	protorequire.ProtoEqual(t, request.CompleteRequest.Result, decodedEvent.Result)
+
+	// messages without lazily decoded payloads are decoded as usual
+	failedRequest := &historyservice.RespondActivityTaskFailedRequest{NamespaceId: "namespace-id"}
+	var decodedFailedRequest historyservice.RespondActivityTaskFailedRequest
+	require.NoError(t, codec.Unmarshal(encode(t, failedRequest), &decodedFailedRequest))
+	protorequire.ProtoEqual(t, failedRequest, &decodedFailedRequest)
+}
+
+func TestLazyPayloadsCodec_MultiplePaths(t *testing.T) {
+	t.Parallel()
+	codec := newTestLazyPayloadsCodec(true)
+	request := &historyservice.StartWorkflowExecutionRequest{
+		NamespaceId: "namespace-id",
+		StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+			WorkflowId: "workflow-id",
+			Input:      payloads.EncodeString("input"),
+			// present but empty payloads remain present
+			LastCompletionResult: &commonpb.Payloads{},
+			Memo:                 &commonpb.Memo{Fields: map[string]*commonpb.Payload{"key": payloads.EncodeString("memo").Payloads[0]}},
+		},
+	}
+
+	var decoded historyservice.StartWorkflowExecutionRequest
+	require.NoError(t, codec.Unmarshal(encode(t, request), &decoded))
+	require.Equal(t, "workflow-id", decoded.StartRequest.WorkflowId)
+	require.Empty(t, decoded.StartRequest.Input.Payloads)
+	require.NotNil(t, decoded.StartRequest.LastCompletionResult)
+	protorequire.ProtoEqual(t, request.StartRequest.Memo, decoded.StartRequest.Memo)
+
+	var reDecoded historyservice.StartWorkflowExecutionRequest
+	require.NoError(t, proto.Unmarshal(encode(t, &decoded).Materialize(), &reDecoded))
+	protorequire.ProtoEqual(t, request, &reDecoded)
+
+	// absent payloads remain absent
+	request.StartRequest.Input = nil
+	request.StartRequest.LastCompletionResult = nil
+	decoded = historyservice.StartWorkflowExecutionRequest{}
+	require.NoError(t, codec.Unmarshal(encode(t, request), &decoded))
+	require.Nil(t, decoded.StartRequest.Input)
+	require.Nil(t, decoded.StartRequest.LastCompletionResult)
+}
+
+func TestLazyPayloadsCodec_RepeatedField(t *testing.T) {
+	t.Parallel()
+	codec := newTestLazyPayloadsCodec(true)
+	first := &historyservice.RespondActivityTaskCompletedRequest{
+		CompleteRequest: &workflowservice.RespondActivityTaskCompletedRequest{Result: payloads.EncodeString("first")},
+	}
+	second := &historyservice.RespondActivityTaskCompletedRequest{
+		CompleteRequest: &workflowservice.RespondActivityTaskCompletedRequest{Result: payloads.EncodeString("second")},
+	}
+	// occurrences of a message field are merged
+	encoded := append(encode(t, first).Materialize(), encode(t, second).Materialize()...)
+	var expected historyservice.RespondActivityTaskCompletedRequest
+	require.NoError(t, proto.Unmarshal(encoded, &expected))
+	require.Len(t, expected.CompleteRequest.Result.Payloads, 2)
+
+	var decoded historyservice.RespondActivityTaskCompletedRequest
+	require.NoError(t, codec.Unmarshal(mem.BufferSlice{mem.SliceBuffer(encoded)}, &decoded))
+	var reDecoded historyservice.RespondActivityTaskCompletedRequest
+	require.NoError(t, proto.Unmarshal(encode(t, &decoded).Materialize(), &reDecoded))
+	protorequire.ProtoEqual(t, &expected, &reDecoded)
+}
+
+func TestLazyPayloadsCodec_Disabled(t *testing.T) {
+	t.Parallel()
+	codec := newTestLazyPayloadsCodec(false)
+	request := &historyservice.RespondActivityTaskCompletedRequest{
+		CompleteRequest: &workflowservice.RespondActivityTaskCompletedRequest{Result: payloads.EncodeString("result")},
+	}
+
+	var decoded historyservice.RespondActivityTaskCompletedRequest
+	require.NoError(t, codec.Unmarshal(encode(t, request), &decoded))
+	protorequire.ProtoEqual(t, request, &decoded)
+	require.Empty(t, decoded.CompleteRequest.Result.ProtoReflect().GetUnknown())
+}
+
+func TestLazyPayloadsCodec_InvalidMessage(t *testing.T) {
+	t.Parallel()
+	codec := newTestLazyPayloadsCodec(true)
+	var decoded historyservice.RespondActivityTaskCompletedRequest
+	require.Error(t, codec.Unmarshal(mem.BufferSlice{mem.SliceBuffer([]byte{0x12, 0x05, 0x12})}, &decoded))
+}
+
+func TestNewLazyPayloadsPath_Invalid(t *testing.T) {
+	t.Parallel()
+	require.Panics(t, func() {
+		rpc.NewLazyPayloadsPath(&historyservice.RespondActivityTaskCompletedRequest{}, "complete_request")
+	})
+	require.Panics(t, func() {
+		rpc.NewLazyPayloadsPath(&historyservice.RespondActivityTaskCompletedRequest{}, "complete_request", "identity")
+	})
+	require.Panics(t, func() {
+		rpc.NewLazyPayloadsPath(&historyservice.RespondActivityTaskCompletedRequest{}, "unknown_field")
+	})
+}
+
+// BenchmarkLazyPayloadsCodec measures decoding an activity completion from the frontend and encoding its result into
+// a history event, as history does before persisting it.
+func BenchmarkLazyPayloadsCodec(b *testing.B) {
+	request := &historyservice.RespondActivityTaskCompletedRequest{
+		NamespaceId: "namespace-id",
+		CompleteRequest: &workflowservice.RespondActivityTaskCompletedRequest{
+			TaskToken: []byte("task-token"),
+			Result:    payloads.EncodeBytes(bytes.Repeat([]byte("result"), 256*1024)),
+			Identity:  "identity",
+		},
+	}
+	for _, enabled := range []bool{false, true} {
+		name := "Decoded"
+		if enabled {
+			name = "Lazy"
+		}
+		b.Run(name, func(b *testing.B) {
+			codec := newTestLazyPayloadsCodec(enabled)
+			encoded := encode(b, request)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				var decoded historyservice.RespondActivityTaskCompletedRequest
+				if err := codec.Unmarshal(encoded, &decoded); err != nil {
+					b.Fatal(err)
+				}
+				event := &historypb.HistoryEvent{
+					Attributes: &historypb.HistoryEvent_ActivityTaskCompletedEventAttributes{
+						ActivityTaskCompletedEventAttributes: &historypb.ActivityTaskCompletedEventAttributes{
+							Result: decoded.CompleteRequest.Result,
+						},
+					},
+				}
+				if _, err := proto.Marshal(event); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -96,6 +96,26 @@ const (
 	//     * "Reason:ManualWorkflowPause"
 	TemporalPauseInfo = "TemporalPauseInfo"
 
+	// TemporalReportedProblems is a search attribute that stores the problems the server detected with the workflow.
+	// Format of a single entry: "<key>=<value>", for example a workflow whose workflow tasks keep failing has:
+	//   - "category=WorkflowTaskFailed"
+	//   - "cause=WorkflowTaskFailedCauseNonDeterministicError"
+	TemporalReportedProblems = "TemporalReportedProblems"
+
+	// TemporalPendingNexusOperations is a search attribute that stores the Nexus operations the workflow is waiting on.
+	// Format of a single entry: "<key>=<value>", for example a workflow with a started operation has:
+	//   - "endpoint=my-endpoint"
+	//   - "service=my-service"
+	//   - "operation=my-operation"
+	//   - "state=Started"
+	TemporalPendingNexusOperations = "TemporalPendingNexusOperations"
+
+	// Prefixes of the TemporalPendingNexusOperations entries.
+	PendingNexusOperationEndpointPrefix  = "endpoint="
+	PendingNexusOperationServicePrefix   = "service="
+	PendingNexusOperationOperationPrefix = "operation="
+	PendingNexusOperationStatePrefix     = "state="
+
 	// Used for Worker Versioning
 	BuildIds = "BuildIds"
 )
@@ -123,16 +143,18 @@ var (
 
 	// predefined are internal search attributes which are passed and stored in SearchAttributes object together with custom search attributes.
 	predefined = map[string]enumspb.IndexedValueType{
-		TemporalChangeVersion:      enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST,
-		BinaryChecksums:            enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST,
-		BuildIds:                   enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST,
-		BatcherNamespace:           enumspb.INDEXED_VALUE_TYPE_KEYWORD,
-		BatcherUser:                enumspb.INDEXED_VALUE_TYPE_KEYWORD,
-		TemporalScheduledStartTime: enumspb.INDEXED_VALUE_TYPE_DATETIME,
-		TemporalScheduledById:      enumspb.INDEXED_VALUE_TYPE_KEYWORD,
-		TemporalSchedulePaused:     enumspb.INDEXED_VALUE_TYPE_BOOL,
-		TemporalNamespaceDivision:  enumspb.INDEXED_VALUE_TYPE_KEYWORD,
-		TemporalPauseInfo:          enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST,
+		TemporalChangeVersion:          enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST,
+		BinaryChecksums:                enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST,
+		BuildIds:                       enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST,
+		BatcherNamespace:               enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		BatcherUser:                    enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		TemporalScheduledStartTime:     enumspb.INDEXED_VALUE_TYPE_DATETIME,
+		TemporalScheduledById:          enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		TemporalSchedulePaused:         enumspb.INDEXED_VALUE_TYPE_BOOL,
+		TemporalNamespaceDivision:      enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		TemporalPauseInfo:              enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST,
+		TemporalReportedProblems:       enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST,
+		TemporalPendingNexusOperations: enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST,
 	}
 
 	// reserved are internal field names that can't be used as search attribute names.
@@ -25,11 +25,21 @@
 package tasktoken
 
 import (
+	"sync"
+
 	clockspb "go.temporal.io/server/api/clock/v1"
 	tokenspb "go.temporal.io/server/api/token/v1"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// taskPool holds the task tokens given back with Release. Task tokens are created for every workflow and activity
+// task handed to a poller, and most are dropped as soon as they are serialized.
+var taskPool = sync.Pool{
+	New: func() any {
+		return &tokenspb.Task{}
+	},
+}
+
 func NewWorkflowTaskToken(
 	namespaceID string,
 	workflowID string,
@@ -41,17 +51,17 @@ func NewWorkflowTaskToken(
 	clock *clockspb.VectorClock,
 	version int64,
 ) *tokenspb.Task {
-	return &tokenspb.Task{
-		NamespaceId:      namespaceID,
-		WorkflowId:       workflowID,
-		RunId:            runID,
-		ScheduledEventId: scheduledEventID,
-		StartedEventId:   startedEventId,
-		StartedTime:      startedTime,
-		Attempt:          attempt,
-		Clock:            clock,
-		Version:          version,
-	}
+	token := taskPool.Get().(*tokenspb.Task)
+	token.NamespaceId = namespaceID
+	token.WorkflowId = workflowID
+	token.RunId = runID
+	token.ScheduledEventId = scheduledEventID
+	token.StartedEventId = startedEventId
+	token.StartedTime = startedTime
+	token.Attempt = attempt
+	token.Clock = clock
+	token.Version = version
+	return token
 }
 
 func NewActivityTaskToken(
@@ -65,15 +75,22 @@ func NewActivityTaskToken(
 	clock *clockspb.VectorClock,
 	version int64,
 ) *tokenspb.Task {
-	return &tokenspb.Task{
-		NamespaceId:      namespaceID,
-		WorkflowId:       workflowID,
-		RunId:            runID,
-		ScheduledEventId: scheduledEventID,
-		ActivityType:     activityType,
-		Attempt:          attempt,
-		ActivityId:       activityId,
-		Clock:            clock,
-		Version:          version,
-	}
+	token := taskPool.Get().(*tokenspb.Task)
+	token.NamespaceId = namespaceID
+	token.WorkflowId = workflowID
+	token.RunId = runID
+	token.ScheduledEventId = scheduledEventID
+	token.ActivityType = activityType
+	token.Attempt = attempt
+	token.ActivityId = activityId
+	token.Clock = clock
+	token.Version = version
+	return token
+}
+
+// Release gives a token created by NewWorkflowTaskToken or NewActivityTaskToken back for reuse. The token must not
+// be used after it is released.
+func Release(token *tokenspb.Task) {
+	token.Reset()
+	taskPool.Put(token)
 }
@@ -0,0 +1,63 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tasktoken
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	clockspb "go.temporal.io/server/api/clock/v1"
+	"go.temporal.io/server/common"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestRelease(t *testing.T) {
+	token := NewActivityTaskToken("namespace-id", "workflow-id", "run-id", 5, "activity-id", "activity-type", 2, &clockspb.VectorClock{}, 1)
+	Release(token)
+	require.Empty(t, token.GetActivityId())
+	require.Nil(t, token.GetClock(), "released token must not hold on to the messages it referenced")
+
+	token = NewWorkflowTaskToken("namespace-id", "workflow-id", "run-id", 5, 6, timestamppb.Now(), 1, nil, 1)
+	require.Equal(t, "workflow-id", token.GetWorkflowId())
+	require.Empty(t, token.GetActivityId())
+	require.Empty(t, token.GetActivityType())
+}
+
+func BenchmarkSerializeActivityTaskToken(b *testing.B) {
+	serializer := common.NewProtoTaskTokenSerializer()
+	clock := &clockspb.VectorClock{ShardId: 1, Clock: 2, ClusterId: 3}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			token := NewActivityTaskToken("namespace-id", "workflow-id", "run-id", 5, "activity-id", "activity-type", 2, clock, 1)
+			if _, err := serializer.Serialize(token); err != nil {
+				b.Fatal(err)
+			}
+			Release(token)
+		}
+	})
+}
@@ -0,0 +1,73 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package telemetry
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.temporal.io/server/common/cache"
+)
+
+// SpanLinks remembers the span context of the request that produced a unit of asynchronous work (e.g. a
+// persisted history task) so that the span which later executes that work can be linked back to it.
+//
+// Span contexts are held in a bounded in-memory cache. Work that is executed by a different host, after a restart
+// or after the entry was evicted is simply not linked.
+type SpanLinks[K comparable] struct {
+	spanContexts cache.Cache
+}
+
+// NewSpanLinks creates a SpanLinks holding at most maxSize span contexts for up to ttl each.
+func NewSpanLinks[K comparable](maxSize int, ttl time.Duration) *SpanLinks[K] {
+	return &SpanLinks[K]{
+		spanContexts: cache.New(maxSize, &cache.Options{TTL: ttl}),
+	}
+}
+
+// Record associates the span context of ctx, if any, with the given keys.
+func (l *SpanLinks[K]) Record(ctx context.Context, keys ...K) {
+	if l == nil {
+		return
+	}
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.IsValid() {
+		return
+	}
+	for _, key := range keys {
+		l.spanContexts.Put(key, spanContext)
+	}
+}
+
+// Links returns the span links recorded for key.
+func (l *SpanLinks[K]) Links(key K) []trace.Link {
+	if l == nil {
+		return nil
+	}
+	spanContext, ok := l.spanContexts.Get(key).(trace.SpanContext)
+	if !ok {
+		return nil
+	}
+	return []trace.Link{{SpanContext: spanContext}}
+}
@@ -25,6 +25,7 @@
 package common
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -42,6 +43,7 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/log"
@@ -148,6 +150,11 @@ var (
 	ErrNamespaceHandover = serviceerror.NewUnavailable(fmt.Sprintf("Namespace replication in %s state.", enumspb.REPLICATION_STATE_HANDOVER.String()))
 )
 
+var (
+	payloadsFullName = (&commonpb.Payloads{}).ProtoReflect().Descriptor().FullName()
+	payloadFullName  = (&commonpb.Payload{}).ProtoReflect().Descriptor().FullName()
+)
+
 // AwaitWaitGroup calls Wait on the given wait
 // Returns true if the Wait() call succeeded before the timeout
 // Returns false if the Wait() did not return before the timeout
@@ -393,6 +400,21 @@ func ErrorHash(err error) string {
 	return "00000000"
 }
 
+// IsWorkflowTaskStuck returns true if the workflow tasks of an execution failed with the same cause at least
+// failureThreshold times in a row, the first of them at least minDuration ago. A non-positive threshold disables it.
+func IsWorkflowTaskStuck(
+	executionInfo *persistencespb.WorkflowExecutionInfo,
+	now time.Time,
+	failureThreshold int,
+	minDuration time.Duration,
+) bool {
+	if failureThreshold <= 0 || executionInfo.GetWorkflowTaskLastFailureCause() == "" {
+		return false
+	}
+	return int(executionInfo.GetWorkflowTaskSameFailureCount()) >= failureThreshold &&
+		now.Sub(executionInfo.GetWorkflowTaskFirstSameFailureTime().AsTime()) >= minDuration
+}
+
 // WorkflowIDToHistoryShard is used to map namespaceID-workflowID pair to a shardID.
 func WorkflowIDToHistoryShard(
 	namespaceID string,
@@ -707,6 +729,58 @@ func CloneProto[T proto.Message](v T) T {
 	return proto.Clone(v).(T)
 }
 
+// CloneProtoSharingPayloads is like CloneProto, except that user payloads are shared by v and the returned copy
+// instead of being copied. Payloads can be large and are never modified in place once received, so copying a request
+// to change some of its fields does not need to copy them as well.
+func CloneProtoSharingPayloads[T proto.Message](v T) T {
+	return cloneMessageSharingPayloads(v.ProtoReflect()).Interface().(T)
+}
+
+func cloneMessageSharingPayloads(src protoreflect.Message) protoreflect.Message {
+	if !src.IsValid() {
+		return src
+	}
+	switch src.Descriptor().FullName() {
+	case payloadsFullName, payloadFullName:
+		return src
+	}
+
+	dst := src.New()
+	src.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsList():
+			srcList, dstList := v.List(), dst.Mutable(fd).List()
+			for i := 0; i < srcList.Len(); i++ {
+				dstList.Append(cloneValueSharingPayloads(fd, srcList.Get(i)))
+			}
+		case fd.IsMap():
+			dstMap := dst.Mutable(fd).Map()
+			v.Map().Range(func(k protoreflect.MapKey, mv protoreflect.Value) bool {
+				dstMap.Set(k, cloneValueSharingPayloads(fd.MapValue(), mv))
+				return true
+			})
+		default:
+			dst.Set(fd, cloneValueSharingPayloads(fd, v))
+		}
+		return true
+	})
+	if unknown := src.GetUnknown(); len(unknown) > 0 {
+		dst.SetUnknown(bytes.Clone(unknown))
+	}
+	return dst
+}
+
+func cloneValueSharingPayloads(fd protoreflect.FieldDescriptor, v protoreflect.Value) protoreflect.Value {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return protoreflect.ValueOfMessage(cloneMessageSharingPayloads(v.Message()))
+	case protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes(bytes.Clone(v.Bytes()))
+	default:
+		return v
+	}
+}
+
 func ValidateUTF8String(fieldName string, strValue string) error {
 	if !utf8.ValidString(strValue) {
 		return serviceerror.NewInvalidArgument(fmt.Sprintf("%s %v is not a valid UTF-8 string", fieldName, strValue))
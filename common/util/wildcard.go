@@ -25,7 +25,23 @@ package util
 import (
 	"errors"
 	"regexp"
+	"slices"
 	"strings"
+	"sync"
+)
+
+type (
+	// WildCardRegexpCache caches the regular expressions converted from wildcard patterns by key, e.g. by namespace,
+	// and only converts the patterns of a key again when they change. The zero value is ready to use.
+	WildCardRegexpCache struct {
+		entries sync.Map // string -> *wildCardRegexpCacheEntry
+	}
+
+	wildCardRegexpCacheEntry struct {
+		patterns []string
+		regexp   *regexp.Regexp
+		err      error
+	}
 )
 
 // WildCardStringToRegexp converts a given string pattern to a regular expression matching wildcards (*) with any
@@ -59,3 +75,19 @@ func WildCardStringsToRegexp(patterns []string) (*regexp.Regexp, error) {
 	result.WriteRune('$')
 	return regexp.Compile(result.String())
 }
+
+// Get returns the regular expression matching the given patterns of key, see WildCardStringsToRegexp.
+func (c *WildCardRegexpCache) Get(key string, patterns []string) (*regexp.Regexp, error) {
+	if value, ok := c.entries.Load(key); ok {
+		if entry := value.(*wildCardRegexpCacheEntry); slices.Equal(entry.patterns, patterns) {
+			return entry.regexp, entry.err
+		}
+	}
+	re, err := WildCardStringsToRegexp(patterns)
+	c.entries.Store(key, &wildCardRegexpCacheEntry{
+		patterns: slices.Clone(patterns),
+		regexp:   re,
+		err:      err,
+	})
+	return re, err
+}
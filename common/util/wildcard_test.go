@@ -54,3 +54,31 @@ func TestWildCardStringsToRegexp(t *testing.T) {
 	require.NoError(t, err)
 	require.NotRegexp(t, re, "a")
 }
+
+func TestWildCardRegexpCache(t *testing.T) {
+	var cache util.WildCardRegexpCache
+
+	re, err := cache.Get("ns", []string{"payments-*"})
+	require.NoError(t, err)
+	require.True(t, re.MatchString("payments-charge"))
+
+	// the regexp is reused while the patterns don't change
+	cached, err := cache.Get("ns", []string{"payments-*"})
+	require.NoError(t, err)
+	require.Same(t, re, cached)
+
+	// and compiled again when they do
+	changed, err := cache.Get("ns", []string{"orders-*"})
+	require.NoError(t, err)
+	require.NotSame(t, re, changed)
+	require.False(t, changed.MatchString("payments-charge"))
+	require.True(t, changed.MatchString("orders-ship"))
+
+	// keys are cached separately
+	other, err := cache.Get("other-ns", []string{"payments-*"})
+	require.NoError(t, err)
+	require.True(t, other.MatchString("payments-charge"))
+	cached, err = cache.Get("ns", []string{"orders-*"})
+	require.NoError(t, err)
+	require.Same(t, changed, cached)
+}
@@ -32,10 +32,12 @@ import (
 
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	failurepb "go.temporal.io/api/failure/v1"
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
@@ -43,6 +45,7 @@ import (
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/testing/protorequire"
 	"google.golang.org/protobuf/testing/protopack"
 )
 
@@ -615,3 +618,55 @@ func TestCreateHistoryStartWorkflowRequestPayloads(t *testing.T) {
 	// ensure the original request object is unmodified
 	require.Equal(t, startRequestClone, startRequest)
 }
+
+func TestCloneProtoSharingPayloads(t *testing.T) {
+	input := payloads.EncodeString("input")
+	memo := payloads.EncodeString("memo").Payloads[0]
+	request := &workflowservice.StartWorkflowExecutionRequest{
+		Namespace:  uuid.New(),
+		WorkflowId: uuid.New(),
+		TaskQueue:  &taskqueuepb.TaskQueue{Name: "task-queue"},
+		Input:      input,
+		Memo:       &commonpb.Memo{Fields: map[string]*commonpb.Payload{"key": memo}},
+		Links:      []*commonpb.Link{{Variant: &commonpb.Link_WorkflowEvent_{WorkflowEvent: &commonpb.Link_WorkflowEvent{Namespace: "ns"}}}},
+	}
+
+	clone := CloneProtoSharingPayloads(request)
+	protorequire.ProtoEqual(t, request, clone)
+
+	// payloads are shared
+	require.Same(t, input, clone.Input)
+	require.Same(t, memo, clone.Memo.Fields["key"])
+
+	// everything else is copied
+	require.NotSame(t, request.TaskQueue, clone.TaskQueue)
+	require.NotSame(t, request.Memo, clone.Memo)
+	require.NotSame(t, request.Links[0], clone.Links[0])
+	clone.TaskQueue.Name = "other-task-queue"
+	clone.Memo.Fields["other-key"] = memo
+	require.Equal(t, "task-queue", request.TaskQueue.Name)
+	require.Len(t, request.Memo.Fields, 1)
+
+	require.Nil(t, CloneProtoSharingPayloads((*workflowservice.StartWorkflowExecutionRequest)(nil)))
+}
+
+func BenchmarkCloneProtoSharingPayloads(b *testing.B) {
+	request := &workflowservice.StartWorkflowExecutionRequest{
+		Namespace:  uuid.New(),
+		WorkflowId: uuid.New(),
+		TaskQueue:  &taskqueuepb.TaskQueue{Name: "task-queue"},
+		Input:      payloads.EncodeBytes(make([]byte, 1024*1024)),
+	}
+	b.Run("CloneProto", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = CloneProto(request)
+		}
+	})
+	b.Run("CloneProtoSharingPayloads", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = CloneProtoSharingPayloads(request)
+		}
+	})
+}
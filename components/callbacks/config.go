@@ -49,14 +49,46 @@ var RetryPolicyMaximumInterval = dynamicconfig.NewGlobalDurationSetting(
 	`The maximum backoff interval between every callback request attempt for a given callback.`,
 )
 
+var RetryPolicyRules = dynamicconfig.NewNamespaceTypedSettingWithConverter(
+	"component.callbacks.retryPolicy.rules",
+	retryPolicyRulesConverter,
+	[]RetryPolicyRule(nil),
+	`The per-namespace list of callback retry policies keyed by callback address.
+The host:port of a callback URL is checked against each entry in order and the first match determines the retry policy.
+Callbacks that don't match any entry use the server defaults configured via component.callbacks.retryPolicy.*.
+Once a matching policy is exhausted the callback transitions to the terminal failed state. Any invalid entries are ignored.
+Each entry is a map with possible values:
+	 - "Pattern":string (required) the host:port pattern to which this config applies.
+		Wildcards, '*', are supported and can match any number of characters (e.g. '*' matches everything, 'prefix.*.domain' matches 'prefix.a.domain' as well as 'prefix.a.b.domain').
+	 - "InitialInterval":duration (optional, default=1s) the initial backoff interval.
+	 - "BackoffCoefficient":float (optional, default=2) the multiplier applied to the interval after every attempt.
+	 - "MaximumInterval":duration (optional, default=1h) the maximum backoff interval.
+	 - "MaximumAttempts":int (optional, default=0) the maximum number of attempts, 0 means unlimited.
+	 - "MaximumDuration":duration (optional, default=0) the maximum time since callback registration to keep retrying, 0 means unlimited.`)
+
 type Config struct {
-	RequestTimeout dynamicconfig.DurationPropertyFnWithDestinationFilter
-	RetryPolicy    func() backoff.RetryPolicy
+	RequestTimeout   dynamicconfig.DurationPropertyFnWithDestinationFilter
+	RetryPolicy      func() backoff.RetryPolicy
+	RetryPolicyRules dynamicconfig.TypedPropertyFnWithNamespaceFilter[[]RetryPolicyRule]
+}
+
+// RetryPolicyForAddress returns the retry policy of the first rule matching the given host:port in the namespace,
+// falling back to the server default retry policy.
+func (c *Config) RetryPolicyForAddress(ns string, address string) backoff.RetryPolicy {
+	if c.RetryPolicyRules != nil && address != "" {
+		for _, rule := range c.RetryPolicyRules(ns) {
+			if rule.Regexp.MatchString(address) {
+				return rule.RetryPolicy
+			}
+		}
+	}
+	return c.RetryPolicy()
 }
 
 func ConfigProvider(dc *dynamicconfig.Collection) *Config {
 	return &Config{
-		RequestTimeout: RequestTimeout.Get(dc),
+		RequestTimeout:   RequestTimeout.Get(dc),
+		RetryPolicyRules: RetryPolicyRules.Get(dc),
 		RetryPolicy: func() backoff.RetryPolicy {
 			return backoff.NewExponentialRetryPolicy(
 				RetryPolicyInitialInterval.Get(dc)(),
@@ -114,6 +146,62 @@ func allowedAddressConverter(val any) ([]AddressMatchRule, error) {
 	return configs, nil
 }
 
+type RetryPolicyRule struct {
+	Regexp      *regexp.Regexp
+	RetryPolicy backoff.RetryPolicy
+}
+
+func retryPolicyRulesConverter(val any) ([]RetryPolicyRule, error) {
+	type entry struct {
+		Pattern            string
+		InitialInterval    time.Duration
+		BackoffCoefficient float64
+		MaximumInterval    time.Duration
+		MaximumAttempts    int
+		MaximumDuration    time.Duration
+	}
+	intermediate, err := dynamicconfig.ConvertStructure([]entry{})(val)
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []RetryPolicyRule
+	for _, e := range intermediate {
+		if e.Pattern == "" {
+			// Skip configs with missing / unparsable Pattern
+			continue
+		}
+		re, err := regexp.Compile(addressPatternToRegexp(e.Pattern))
+		if err != nil {
+			// Skip configs with malformed Pattern
+			continue
+		}
+		if e.InitialInterval == 0 {
+			e.InitialInterval = time.Second
+		}
+		if e.BackoffCoefficient == 0 {
+			e.BackoffCoefficient = 2
+		}
+		if e.MaximumInterval == 0 {
+			e.MaximumInterval = time.Hour
+		}
+		if e.InitialInterval < 0 || e.BackoffCoefficient < 1 || e.MaximumInterval < e.InitialInterval ||
+			e.MaximumAttempts < 0 || e.MaximumDuration < 0 {
+			// Skip configs with invalid retry parameters
+			continue
+		}
+		rules = append(rules, RetryPolicyRule{
+			Regexp: re,
+			RetryPolicy: backoff.NewExponentialRetryPolicy(e.InitialInterval).
+				WithBackoffCoefficient(e.BackoffCoefficient).
+				WithMaximumInterval(e.MaximumInterval).
+				WithMaximumAttempts(e.MaximumAttempts).
+				WithExpirationInterval(e.MaximumDuration),
+		})
+	}
+	return rules, nil
+}
+
 func addressPatternToRegexp(pattern string) string {
 	var result strings.Builder
 	result.WriteString("^")
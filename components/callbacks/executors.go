@@ -26,6 +26,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/log"
@@ -143,7 +144,7 @@ func (e taskExecutor) executeInvocationTask(
 	defer cancel()
 
 	result := invokable.Invoke(callCtx, ns, e, task)
-	saveErr := e.saveResult(callCtx, env, ref, result)
+	saveErr := e.saveResult(callCtx, env, ref, ns, result)
 	return invokable.WrapError(result, saveErr)
 }
 
@@ -199,6 +200,7 @@ func (e taskExecutor) saveResult(
 	ctx context.Context,
 	env hsm.Environment,
 	ref hsm.Ref,
+	ns *namespace.Namespace,
 	result invocationResult,
 ) error {
 	return env.Access(ctx, ref, hsm.AccessWrite, func(node *hsm.Node) error {
@@ -209,10 +211,18 @@ func (e taskExecutor) saveResult(
 					Time: env.Now(),
 				})
 			case invocationResultRetry:
+				retryPolicy := e.Config.RetryPolicyForAddress(ns.Name().String(), callbackAddress(callback))
+				// Attempt is incremented when the attempt is recorded, account for the current attempt here.
+				if retryPolicy.ComputeNextDelay(callback.elapsedSinceRegistration(env.Now()), int(callback.GetAttempt())+1, result.error()) < 0 {
+					return TransitionFailed.Apply(callback, EventFailed{
+						Time: env.Now(),
+						Err:  fmt.Errorf("callback delivery failed, retry policy exhausted: %w", result.error()),
+					})
+				}
 				return TransitionAttemptFailed.Apply(callback, EventAttemptFailed{
 					Time:        env.Now(),
 					Err:         result.error(),
-					RetryPolicy: e.Config.RetryPolicy(),
+					RetryPolicy: retryPolicy,
 				})
 			case invocationResultFail:
 				return TransitionFailed.Apply(callback, EventFailed{
@@ -226,6 +236,20 @@ func (e taskExecutor) saveResult(
 	})
 }
 
+// callbackAddress returns the host:port of a Nexus callback URL, or an empty string for callbacks that aren't
+// delivered over HTTP.
+func callbackAddress(callback Callback) string {
+	rawURL := callback.GetCallback().GetNexus().GetUrl()
+	if rawURL == "" {
+		return ""
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return u.Host
+}
+
 func (e taskExecutor) executeBackoffTask(
 	env hsm.Environment,
 	node *hsm.Node,
@@ -56,6 +56,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type fakeEnv struct {
@@ -238,6 +239,137 @@ func TestProcessInvocationTaskNexus_Outcomes(t *testing.T) {
 	}
 }
 
+func TestProcessInvocationTaskNexus_RetryPolicyRules(t *testing.T) {
+	cases := []struct {
+		name             string
+		rules            []any
+		registrationTime time.Time
+		expectedState    enumsspb.CallbackState
+	}{
+		{
+			name:          "no rules",
+			expectedState: enumsspb.CALLBACK_STATE_BACKING_OFF,
+		},
+		{
+			name:          "non matching rule",
+			rules:         []any{map[string]any{"Pattern": "other.host", "MaximumAttempts": 1}},
+			expectedState: enumsspb.CALLBACK_STATE_BACKING_OFF,
+		},
+		{
+			name:          "maximum attempts exhausted",
+			rules:         []any{map[string]any{"Pattern": "local*", "MaximumAttempts": 1}},
+			expectedState: enumsspb.CALLBACK_STATE_FAILED,
+		},
+		{
+			name:             "maximum duration exhausted",
+			rules:            []any{map[string]any{"Pattern": "localhost", "MaximumDuration": "1m"}},
+			registrationTime: time.Now().Add(-time.Hour),
+			expectedState:    enumsspb.CALLBACK_STATE_FAILED,
+		},
+		{
+			name:             "within maximum duration",
+			rules:            []any{map[string]any{"Pattern": "localhost", "MaximumDuration": "1h"}},
+			registrationTime: time.Now(),
+			expectedState:    enumsspb.CALLBACK_STATE_BACKING_OFF,
+		},
+		{
+			name: "first match wins",
+			rules: []any{
+				map[string]any{"Pattern": "localhost", "MaximumAttempts": 5},
+				map[string]any{"Pattern": "*", "MaximumAttempts": 1},
+			},
+			expectedState: enumsspb.CALLBACK_STATE_BACKING_OFF,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			namespaceRegistryMock := namespace.NewMockRegistry(ctrl)
+			namespaceRegistryMock.EXPECT().GetNamespaceByID(namespace.ID("namespace-id")).Return(
+				namespace.FromPersistentState(&persistence.GetNamespaceResponse{
+					Namespace: &persistencespb.NamespaceDetail{
+						Info: &persistencespb.NamespaceInfo{
+							Id:   "namespace-id",
+							Name: "namespace-name",
+						},
+						Config: &persistencespb.NamespaceConfig{},
+					},
+				}),
+				nil,
+			)
+
+			root := newRoot(t)
+			cb := callbacks.Callback{
+				CallbackInfo: &persistencespb.CallbackInfo{
+					Callback: &persistencespb.Callback{
+						Variant: &persistencespb.Callback_Nexus_{
+							Nexus: &persistencespb.Callback_Nexus{
+								Url: "http://localhost",
+							},
+						},
+					},
+					State: enumsspb.CALLBACK_STATE_SCHEDULED,
+				},
+			}
+			if !tc.registrationTime.IsZero() {
+				cb.RegistrationTime = timestamppb.New(tc.registrationTime)
+			}
+			coll := callbacks.MachineCollection(root)
+			node, err := coll.Add("ID", cb)
+			require.NoError(t, err)
+			env := fakeEnv{node}
+
+			dc := dynamicconfig.NewCollection(dynamicconfig.StaticClient{
+				callbacks.RetryPolicyRules.Key(): tc.rules,
+			}, log.NewNoopLogger())
+
+			reg := hsm.NewRegistry()
+			require.NoError(t, callbacks.RegisterExecutor(
+				reg,
+				callbacks.TaskExecutorOptions{
+					NamespaceRegistry: namespaceRegistryMock,
+					MetricsHandler:    metrics.NoopMetricsHandler,
+					HTTPCallerProvider: func(nid queues.NamespaceIDAndDestination) callbacks.HTTPCaller {
+						return func(r *http.Request) (*http.Response, error) {
+							return &http.Response{StatusCode: 500, Body: http.NoBody}, nil
+						}
+					},
+					Logger: log.NewNoopLogger(),
+					Config: callbacks.ConfigProvider(dc),
+				},
+			))
+
+			err = reg.ExecuteImmediateTask(
+				context.Background(),
+				env,
+				hsm.Ref{
+					WorkflowKey: definition.NewWorkflowKey("namespace-id", "", ""),
+					StateMachineRef: &persistencespb.StateMachineRef{
+						Path: []*persistencespb.StateMachineKey{
+							{
+								Type: callbacks.StateMachineType,
+								Id:   "ID",
+							},
+						},
+					},
+				},
+				callbacks.NewInvocationTask("http://localhost"),
+			)
+			var destinationDownErr *queues.DestinationDownError
+			require.ErrorAs(t, err, &destinationDownErr)
+
+			cb, err = coll.Data("ID")
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedState, cb.State())
+			if tc.expectedState == enumsspb.CALLBACK_STATE_FAILED {
+				require.True(t, cb.LastAttemptFailure.GetApplicationFailureInfo().GetNonRetryable())
+				require.Contains(t, cb.LastAttemptFailure.GetMessage(), "retry policy exhausted")
+			}
+		})
+	}
+}
+
 func TestProcessInvocationTaskHsm_Outcomes(t *testing.T) {
 	cases := []struct {
 		name                  string
@@ -90,6 +90,15 @@ func (c Callback) recordAttempt(ts time.Time) {
 	c.CallbackInfo.LastAttemptCompleteTime = timestamppb.New(ts)
 }
 
+// elapsedSinceRegistration returns the time elapsed since the callback was registered, or 0 if the registration time
+// is unknown.
+func (c Callback) elapsedSinceRegistration(now time.Time) time.Duration {
+	if c.CallbackInfo.GetRegistrationTime() == nil {
+		return 0
+	}
+	return now.Sub(c.CallbackInfo.GetRegistrationTime().AsTime())
+}
+
 func (c Callback) RegenerateTasks(*hsm.Node) ([]hsm.Task, error) {
 	switch c.CallbackInfo.State {
 	case enumsspb.CALLBACK_STATE_BACKING_OFF:
@@ -232,8 +241,7 @@ var TransitionAttemptFailed = hsm.NewTransition(
 	enumsspb.CALLBACK_STATE_BACKING_OFF,
 	func(cb Callback, event EventAttemptFailed) (hsm.TransitionOutput, error) {
 		cb.recordAttempt(event.Time)
-		// Use 0 for elapsed time as we don't limit the retry by time (for now).
-		nextDelay := event.RetryPolicy.ComputeNextDelay(0, int(cb.Attempt), event.Err)
+		nextDelay := event.RetryPolicy.ComputeNextDelay(cb.elapsedSinceRegistration(event.Time), int(cb.Attempt), event.Err)
 		nextAttemptScheduleTime := event.Time.Add(nextDelay)
 		cb.CallbackInfo.NextAttemptScheduleTime = timestamppb.New(nextAttemptScheduleTime)
 		cb.CallbackInfo.LastAttemptFailure = &failurepb.Failure{
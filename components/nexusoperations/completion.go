@@ -32,6 +32,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/metrics"
 	commonnexus "go.temporal.io/server/common/nexus"
 	"go.temporal.io/server/service/history/hsm"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -183,26 +184,43 @@ func CompletionHandler(
 	links []*commonpb.Link,
 	result *commonpb.Payload,
 	opFailedError *nexus.UnsuccessfulOperationError,
+	checkResultSize func(endpointName string, size int) error,
+	metricsHandler metrics.Handler,
 ) error {
 	// The initial version of the completion token did not include a request ID.
 	// Only retry Access without a run ID if the request ID is not empty.
 	isRetryableNotFoundErr := requestID != ""
+	var opMetrics operationMetrics
+	var fabricatedStart bool
 	err := env.Access(ctx, ref, hsm.AccessWrite, func(node *hsm.Node) error {
 		if err := node.CheckRunning(); err != nil {
 			return serviceerror.NewNotFound("operation not found")
 		}
+		operation, err := hsm.MachineData[Operation](node)
+		if err != nil {
+			return err
+		}
+		fabricatedStart = TransitionStarted.Possible(operation)
 		if err := fabricateStartedEventIfMissing(node, requestID, operationID, startTime, links); err != nil {
 			return err
 		}
-		err := hsm.MachineTransition(node, func(operation Operation) (hsm.TransitionOutput, error) {
+		err = hsm.MachineTransition(node, func(operation Operation) (hsm.TransitionOutput, error) {
 			if requestID != "" && operation.RequestId != requestID {
 				isRetryableNotFoundErr = false
 				return hsm.TransitionOutput{}, serviceerror.NewNotFound("operation not found")
 			}
+			var output hsm.TransitionOutput
+			var err error
 			if opFailedError != nil {
-				return handleUnsuccessfulOperationError(node, operation, opFailedError, CompletionSourceCallback)
+				output, err = handleUnsuccessfulOperationError(node, operation, opFailedError, CompletionSourceCallback)
+			} else {
+				if err := checkResultSize(operation.Endpoint, result.Size()); err != nil {
+					return hsm.TransitionOutput{}, serviceerror.NewInvalidArgument("result exceeds size limit")
+				}
+				output, err = handleSuccessfulOperationResult(node, operation, result, CompletionSourceCallback)
 			}
-			return handleSuccessfulOperationResult(node, operation, result, CompletionSourceCallback)
+			opMetrics = newOperationMetrics(operation)
+			return output, err
 		})
 		// TODO(bergundy): Remove this once the operation auto-deletes itself from the tree on completion.
 		if errors.Is(err, hsm.ErrInvalidTransition) {
@@ -214,7 +232,25 @@ func CompletionHandler(
 	if errors.As(err, new(*serviceerror.NotFound)) && isRetryableNotFoundErr && ref.WorkflowKey.RunID != "" {
 		// Try again without a run ID in case the original run was reset.
 		ref.WorkflowKey.RunID = ""
-		return CompletionHandler(ctx, env, ref, requestID, operationID, startTime, links, result, opFailedError)
+		return CompletionHandler(ctx, env, ref, requestID, operationID, startTime, links, result, opFailedError, checkResultSize, metricsHandler)
+	}
+	if err != nil {
+		return err
 	}
-	return err
+
+	now := env.Now()
+	if fabricatedStart {
+		// The completion was received before the start response.
+		startedTime := now
+		if startTime != nil {
+			startedTime = startTime.AsTime()
+		}
+		opMetrics.recordStarted(metricsHandler, startedTime)
+	}
+	failureType := failureTypeNone
+	if opFailedError != nil {
+		failureType = unsuccessfulOperationFailureType(opFailedError)
+	}
+	opMetrics.recordClosed(metricsHandler, now, failureType)
+	return nil
 }
@@ -106,6 +106,7 @@ var DisallowedOperationHeaders = dynamicconfig.NewNamespaceTypedSettingWithConve
 		headers.CallerNameHeaderName,
 		headers.CallerTypeHeaderName,
 		headers.CallOriginHeaderName,
+		headers.DebugTraceHeaderName,
 	},
 	`Case insensitive list of disallowed header keys for Nexus Operations.
 ScheduleNexusOperation commands with a "nexus_header" field that contains any of these disallowed keys will be
@@ -120,6 +121,37 @@ commands that specify no schedule-to-close timeout or a longer timeout than perm
 schedule-to-close timeout capped to this value. 0 implies no limit.`,
 )
 
+var EndpointMaxRPS = dynamicconfig.NewDestinationFloatSetting(
+	"component.nexusoperations.limit.endpoint.rps",
+	0,
+	`EndpointMaxRPS limits the rate of outgoing start and cancel requests per Nexus endpoint on a single history host.
+The limit is shared by all namespaces calling the endpoint, only the destination (endpoint name) constraint is applied.
+Tasks over the limit are rescheduled by the outbound queue with backoff. 0 implies no limit.`,
+)
+
+var EndpointMaxConcurrentRequests = dynamicconfig.NewDestinationIntSetting(
+	"component.nexusoperations.limit.endpoint.concurrency",
+	0,
+	`EndpointMaxConcurrentRequests limits the number of in-flight start and cancel requests per Nexus endpoint on a single
+history host. The limit is shared by all namespaces calling the endpoint, only the destination (endpoint name)
+constraint is applied. Tasks over the limit are rescheduled by the outbound queue with backoff. 0 implies no limit.`,
+)
+
+var EndpointHealthWindow = dynamicconfig.NewGlobalDurationSetting(
+	"component.nexusoperations.endpointHealth.window",
+	5*time.Minute,
+	`EndpointHealthWindow is the window over which the success and failure counts of outgoing requests are reported
+per Nexus endpoint by the ListNexusEndpointHealth admin API.`,
+)
+
+var OutboundAuthSecretsDirectory = dynamicconfig.NewGlobalStringSetting(
+	"component.nexusoperations.outboundAuth.secretsDirectory",
+	"",
+	`OutboundAuthSecretsDirectory is the directory the default Nexus secret store reads the secrets referenced by the
+outbound auth configuration of endpoints from, one file per secret named after the secret. Files are read when a
+client for the endpoint is created, and on every request or TLS handshake for bearer tokens and client certificates.`,
+)
+
 var CallbackURLTemplate = dynamicconfig.NewGlobalStringSetting(
 	"component.nexusoperations.callback.endpoint.template",
 	"unset",
@@ -151,8 +183,13 @@ type Config struct {
 	DisallowedOperationHeaders         dynamicconfig.TypedPropertyFnWithNamespaceFilter[[]string]
 	MaxOperationScheduleToCloseTimeout dynamicconfig.DurationPropertyFnWithNamespaceFilter
 	PayloadSizeLimit                   dynamicconfig.IntPropertyFnWithNamespaceFilter
+	PayloadSizeWarnLimit               dynamicconfig.IntPropertyFnWithNamespaceFilter
 	CallbackURLTemplate                dynamicconfig.StringPropertyFn
 	EndpointNotFoundAlwaysNonRetryable dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	EndpointMaxRPS                     dynamicconfig.FloatPropertyFnWithDestinationFilter
+	EndpointMaxConcurrentRequests      dynamicconfig.IntPropertyFnWithDestinationFilter
+	EndpointHealthWindow               dynamicconfig.DurationPropertyFn
+	OutboundAuthSecretsDirectory       dynamicconfig.StringPropertyFn
 	RetryPolicy                        func() backoff.RetryPolicy
 }
 
@@ -167,9 +204,14 @@ func ConfigProvider(dc *dynamicconfig.Collection) *Config {
 		MaxOperationHeaderSize:             MaxOperationHeaderSize.Get(dc),
 		DisallowedOperationHeaders:         DisallowedOperationHeaders.Get(dc),
 		MaxOperationScheduleToCloseTimeout: MaxOperationScheduleToCloseTimeout.Get(dc),
-		PayloadSizeLimit:                   dynamicconfig.BlobSizeLimitError.Get(dc),
+		PayloadSizeLimit:                   dynamicconfig.NexusPayloadSizeLimitError.Get(dc),
+		PayloadSizeWarnLimit:               dynamicconfig.NexusPayloadSizeLimitWarn.Get(dc),
 		CallbackURLTemplate:                CallbackURLTemplate.Get(dc),
 		EndpointNotFoundAlwaysNonRetryable: EndpointNotFoundAlwaysNonRetryable.Get(dc),
+		EndpointMaxRPS:                     EndpointMaxRPS.Get(dc),
+		EndpointMaxConcurrentRequests:      EndpointMaxConcurrentRequests.Get(dc),
+		EndpointHealthWindow:               EndpointHealthWindow.Get(dc),
+		OutboundAuthSecretsDirectory:       OutboundAuthSecretsDirectory.Get(dc),
 		RetryPolicy: func() backoff.RetryPolicy {
 			return backoff.NewExponentialRetryPolicy(
 				RetryPolicyInitialInterval.Get(dc)(),
@@ -0,0 +1,169 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nexusoperations
+
+import (
+	"errors"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	nexusspb "go.temporal.io/server/api/nexus/v1"
+	"go.temporal.io/server/common/clock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// endpointHealthBucketSize is the granularity at which request outcomes are aggregated.
+const endpointHealthBucketSize = 10 * time.Second
+
+// EndpointHealthTracker records the outcomes of outgoing requests per Nexus endpoint on this host so operators can
+// find failing integrations via the ListNexusEndpointHealth admin API.
+type EndpointHealthTracker struct {
+	config     *Config
+	timeSource clock.TimeSource
+
+	mu        sync.Mutex
+	endpoints map[string]*endpointHealth
+}
+
+type endpointHealth struct {
+	name               string
+	namespaceIDs       map[string]struct{}
+	buckets            map[time.Time]*endpointHealthBucket
+	lastSuccessTime    time.Time
+	lastFailureTime    time.Time
+	lastFailureMessage string
+}
+
+type endpointHealthBucket struct {
+	successCount int64
+	failureCount int64
+}
+
+func EndpointHealthTrackerProvider(config *Config, timeSource clock.TimeSource) *EndpointHealthTracker {
+	return &EndpointHealthTracker{
+		config:     config,
+		timeSource: timeSource,
+		endpoints:  make(map[string]*endpointHealth),
+	}
+}
+
+// Record records the outcome of a request made on behalf of the given namespace. Only errors that indicate the
+// endpoint is down, i.e. the ones tripping the outbound queue circuit breaker, are counted as failures.
+func (t *EndpointHealthTracker) Record(namespaceID, endpointID, endpointName string, callErr error) {
+	if errors.Is(callErr, ErrOperationTimeoutBelowMin) {
+		// The request was never sent.
+		return
+	}
+	now := t.timeSource.Now()
+	failed := callErr != nil && isDestinationDown(callErr)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	health, ok := t.endpoints[endpointID]
+	if !ok {
+		health = &endpointHealth{
+			namespaceIDs: make(map[string]struct{}),
+			buckets:      make(map[time.Time]*endpointHealthBucket),
+		}
+		t.endpoints[endpointID] = health
+	}
+	health.name = endpointName
+	health.namespaceIDs[namespaceID] = struct{}{}
+	health.pruneBuckets(now.Add(-t.config.EndpointHealthWindow()))
+
+	bucketStart := now.Truncate(endpointHealthBucketSize)
+	bucket, ok := health.buckets[bucketStart]
+	if !ok {
+		bucket = &endpointHealthBucket{}
+		health.buckets[bucketStart] = bucket
+	}
+	if failed {
+		bucket.failureCount++
+		health.lastFailureTime = now
+		health.lastFailureMessage = callErr.Error()
+	} else {
+		bucket.successCount++
+		health.lastSuccessTime = now
+	}
+}
+
+// Snapshot returns the health of the endpoints this host made requests to, sorted by endpoint name. Results are
+// limited to the given endpoint names if any are provided. Circuit breaker states are resolved per calling namespace
+// with the given function.
+func (t *EndpointHealthTracker) Snapshot(
+	endpointNames []string,
+	circuitBreaker func(namespaceID, endpointName string) *nexusspb.EndpointCircuitBreaker,
+) []*nexusspb.EndpointHealth {
+	windowStart := t.timeSource.Now().Add(-t.config.EndpointHealthWindow())
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	result := make([]*nexusspb.EndpointHealth, 0, len(t.endpoints))
+	for endpointID, health := range t.endpoints {
+		if len(endpointNames) > 0 && !slices.Contains(endpointNames, health.name) {
+			continue
+		}
+		health.pruneBuckets(windowStart)
+
+		h := &nexusspb.EndpointHealth{
+			EndpointId:         endpointID,
+			EndpointName:       health.name,
+			LastFailureMessage: health.lastFailureMessage,
+		}
+		for _, bucket := range health.buckets {
+			h.SuccessCount += bucket.successCount
+			h.FailureCount += bucket.failureCount
+		}
+		if !health.lastSuccessTime.IsZero() {
+			h.LastSuccessTime = timestamppb.New(health.lastSuccessTime)
+		}
+		if !health.lastFailureTime.IsZero() {
+			h.LastFailureTime = timestamppb.New(health.lastFailureTime)
+		}
+		for namespaceID := range health.namespaceIDs {
+			h.CircuitBreakers = append(h.CircuitBreakers, circuitBreaker(namespaceID, health.name))
+		}
+		sort.Slice(h.CircuitBreakers, func(i, j int) bool {
+			return h.CircuitBreakers[i].GetNamespaceId() < h.CircuitBreakers[j].GetNamespaceId()
+		})
+		result = append(result, h)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].GetEndpointName() < result[j].GetEndpointName()
+	})
+	return result
+}
+
+func (h *endpointHealth) pruneBuckets(windowStart time.Time) {
+	for start := range h.buckets {
+		if start.Add(endpointHealthBucketSize).Before(windowStart) {
+			delete(h.buckets, start)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nexusoperations_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nexus-rpc/sdk-go/nexus"
+	"github.com/stretchr/testify/require"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	nexusspb "go.temporal.io/server/api/nexus/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/components/nexusoperations"
+)
+
+func TestEndpointHealthTracker(t *testing.T) {
+	timeSource := clock.NewEventTimeSource().Update(time.Now().UTC())
+	tracker := nexusoperations.EndpointHealthTrackerProvider(&nexusoperations.Config{
+		EndpointHealthWindow: dynamicconfig.GetDurationPropertyFn(time.Minute),
+	}, timeSource)
+	circuitBreaker := func(namespaceID, endpointName string) *nexusspb.EndpointCircuitBreaker {
+		return &nexusspb.EndpointCircuitBreaker{NamespaceId: namespaceID, State: enumsspb.CIRCUIT_BREAKER_STATE_CLOSED}
+	}
+
+	tracker.Record("ns-1", "id-a", "endpoint-a", nil)
+	tracker.Record("ns-1", "id-a", "endpoint-a", errors.New("connection refused"))
+	// Operation failures are reported by a healthy handler and don't count as failures.
+	tracker.Record("ns-2", "id-a", "endpoint-a", &nexus.UnsuccessfulOperationError{State: nexus.OperationStateFailed})
+	// Requests that were never sent are ignored.
+	tracker.Record("ns-3", "id-a", "endpoint-a", nexusoperations.ErrOperationTimeoutBelowMin)
+	tracker.Record("ns-1", "id-b", "endpoint-b", nil)
+
+	snapshot := tracker.Snapshot(nil, circuitBreaker)
+	require.Len(t, snapshot, 2)
+	endpointA := snapshot[0]
+	require.Equal(t, "id-a", endpointA.GetEndpointId())
+	require.Equal(t, "endpoint-a", endpointA.GetEndpointName())
+	require.Equal(t, int64(2), endpointA.GetSuccessCount())
+	require.Equal(t, int64(1), endpointA.GetFailureCount())
+	require.Equal(t, "connection refused", endpointA.GetLastFailureMessage())
+	require.Equal(t, timeSource.Now(), endpointA.GetLastFailureTime().AsTime())
+	require.Equal(t, timeSource.Now(), endpointA.GetLastSuccessTime().AsTime())
+	require.Len(t, endpointA.GetCircuitBreakers(), 2)
+	require.Equal(t, "ns-1", endpointA.GetCircuitBreakers()[0].GetNamespaceId())
+	require.Equal(t, "ns-2", endpointA.GetCircuitBreakers()[1].GetNamespaceId())
+
+	snapshot = tracker.Snapshot([]string{"endpoint-b"}, circuitBreaker)
+	require.Len(t, snapshot, 1)
+	require.Equal(t, "endpoint-b", snapshot[0].GetEndpointName())
+
+	// Counts outside of the window are dropped, the last outcomes are retained.
+	timeSource.Advance(2 * time.Minute)
+	tracker.Record("ns-1", "id-a", "endpoint-a", nil)
+	snapshot = tracker.Snapshot([]string{"endpoint-a"}, circuitBreaker)
+	require.Len(t, snapshot, 1)
+	require.Equal(t, int64(1), snapshot[0].GetSuccessCount())
+	require.Equal(t, int64(0), snapshot[0].GetFailureCount())
+	require.Equal(t, "connection refused", snapshot[0].GetLastFailureMessage())
+}
@@ -0,0 +1,89 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nexusoperations
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/collection"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/quotas"
+)
+
+// EndpointLimiter enforces the per endpoint rate and concurrency limits for outgoing Nexus requests. Limits are shared
+// by all namespaces on the host so a single namespace cannot overwhelm a handler that serves multiple callers.
+type EndpointLimiter struct {
+	config         *Config
+	metricsHandler metrics.Handler
+	rateLimiters   *collection.OnceMap[string, quotas.RateLimiter]
+	inflight       *collection.OnceMap[string, *atomic.Int64]
+}
+
+func EndpointLimiterProvider(config *Config, metricsHandler metrics.Handler) *EndpointLimiter {
+	return &EndpointLimiter{
+		config:         config,
+		metricsHandler: metricsHandler,
+		rateLimiters: collection.NewOnceMap(func(endpointName string) quotas.RateLimiter {
+			return quotas.NewDefaultOutgoingRateLimiter(func() float64 {
+				return config.EndpointMaxRPS("", endpointName)
+			})
+		}),
+		inflight: collection.NewOnceMap(func(string) *atomic.Int64 {
+			return &atomic.Int64{}
+		}),
+	}
+}
+
+// Acquire reserves a request slot for the given endpoint. The returned function must be called to release the slot
+// once the request completes.
+// A ResourceExhausted error is returned when the endpoint is saturated, the outbound queue reschedules the task with
+// backoff in that case.
+func (l *EndpointLimiter) Acquire(endpointName string) (func(), error) {
+	inflight := l.inflight.Get(endpointName)
+	release := func() { inflight.Add(-1) }
+
+	count := inflight.Add(1)
+	if maxConcurrency := l.config.EndpointMaxConcurrentRequests("", endpointName); maxConcurrency > 0 && count > int64(maxConcurrency) {
+		release()
+		return nil, l.saturated(endpointName, enumspb.RESOURCE_EXHAUSTED_CAUSE_CONCURRENT_LIMIT)
+	}
+	if l.config.EndpointMaxRPS("", endpointName) > 0 && !l.rateLimiters.Get(endpointName).Allow() {
+		release()
+		return nil, l.saturated(endpointName, enumspb.RESOURCE_EXHAUSTED_CAUSE_RPS_LIMIT)
+	}
+	return release, nil
+}
+
+func (l *EndpointLimiter) saturated(endpointName string, cause enumspb.ResourceExhaustedCause) error {
+	EndpointSaturatedCounter.With(l.metricsHandler).Record(
+		1,
+		metrics.DestinationTag(endpointName),
+		metrics.ResourceExhaustedCauseTag(cause),
+	)
+	return serviceerror.NewResourceExhausted(cause, fmt.Sprintf("request limit reached for Nexus endpoint %q", endpointName))
+}
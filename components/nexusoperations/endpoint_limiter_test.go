@@ -0,0 +1,91 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nexusoperations_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/metrics/metricstest"
+	"go.temporal.io/server/components/nexusoperations"
+)
+
+func TestEndpointLimiter_Concurrency(t *testing.T) {
+	metricsHandler := metricstest.NewCaptureHandler()
+	capture := metricsHandler.StartCapture()
+	limiter := nexusoperations.EndpointLimiterProvider(&nexusoperations.Config{
+		EndpointMaxRPS: dynamicconfig.GetFloatPropertyFnFilteredByDestination(0),
+		EndpointMaxConcurrentRequests: func(_ string, endpointName string) int {
+			if endpointName == "limited" {
+				return 1
+			}
+			return 0
+		},
+	}, metricsHandler)
+
+	release, err := limiter.Acquire("limited")
+	require.NoError(t, err)
+	_, err = limiter.Acquire("limited")
+	var resourceExhaustedErr *serviceerror.ResourceExhausted
+	require.ErrorAs(t, err, &resourceExhaustedErr)
+	require.Equal(t, enumspb.RESOURCE_EXHAUSTED_CAUSE_CONCURRENT_LIMIT, resourceExhaustedErr.Cause)
+
+	// Other endpoints are not affected.
+	for i := 0; i < 10; i++ {
+		_, err = limiter.Acquire("unlimited")
+		require.NoError(t, err)
+	}
+
+	release()
+	release, err = limiter.Acquire("limited")
+	require.NoError(t, err)
+	release()
+
+	recordings := capture.Snapshot()[nexusoperations.EndpointSaturatedCounter.Name()]
+	require.Len(t, recordings, 1)
+	require.Equal(t, "limited", recordings[0].Tags["destination"])
+}
+
+func TestEndpointLimiter_RPS(t *testing.T) {
+	limiter := nexusoperations.EndpointLimiterProvider(&nexusoperations.Config{
+		EndpointMaxRPS:                dynamicconfig.GetFloatPropertyFnFilteredByDestination(1),
+		EndpointMaxConcurrentRequests: dynamicconfig.GetIntPropertyFnFilteredByDestination(0),
+	}, metricstest.NewCaptureHandler())
+
+	var resourceExhaustedErr *serviceerror.ResourceExhausted
+	for i := 0; i < 10 && resourceExhaustedErr == nil; i++ {
+		release, err := limiter.Acquire("endpoint")
+		if err != nil {
+			require.ErrorAs(t, err, &resourceExhaustedErr)
+			break
+		}
+		release()
+	}
+	require.NotNil(t, resourceExhaustedErr)
+	require.Equal(t, enumspb.RESOURCE_EXHAUSTED_CAUSE_RPS_LIMIT, resourceExhaustedErr.Cause)
+}
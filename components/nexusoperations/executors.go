@@ -40,6 +40,7 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	tokenspb "go.temporal.io/server/api/token/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
@@ -66,6 +67,9 @@ type TaskExecutorOptions struct {
 	CallbackTokenGenerator *commonnexus.CallbackTokenGenerator
 	ClientProvider         ClientProvider
 	EndpointRegistry       commonnexus.EndpointRegistry
+	EndpointLimiter        *EndpointLimiter
+	EndpointHealthTracker  *EndpointHealthTracker
+	PayloadSizeChecker     *PayloadSizeChecker
 }
 
 func RegisterExecutor(
@@ -122,7 +126,7 @@ func (e taskExecutor) executeInvocationTask(ctx context.Context, env hsm.Environ
 	// indicated by the EndpointNotFoundAlwaysNonRetryable dynamic config.
 	if args.endpointID == "" {
 		handlerError := nexus.HandlerErrorf(nexus.HandlerErrorTypeNotFound, "endpoint not registered")
-		return e.saveResult(ctx, env, ref, nil, handlerError)
+		return e.saveResult(ctx, env, ref, nil, handlerError, e.Config.RetryPolicy())
 	}
 
 	endpoint, err := e.lookupEndpoint(ctx, namespace.ID(ref.WorkflowKey.NamespaceID), args.endpointID, args.endpointName)
@@ -130,7 +134,7 @@ func (e taskExecutor) executeInvocationTask(ctx context.Context, env hsm.Environ
 		if errors.As(err, new(*serviceerror.NotFound)) {
 			// The endpoint is not registered, immediately fail the invocation.
 			handlerError := nexus.HandlerErrorf(nexus.HandlerErrorTypeNotFound, "endpoint not registered")
-			return e.saveResult(ctx, env, ref, nil, handlerError)
+			return e.saveResult(ctx, env, ref, nil, handlerError, e.Config.RetryPolicy())
 		}
 		return err
 	}
@@ -194,6 +198,12 @@ func (e taskExecutor) executeInvocationTask(ctx context.Context, env hsm.Environ
 		}
 	}
 
+	release, err := e.EndpointLimiter.Acquire(endpoint.Endpoint.Spec.GetName())
+	if err != nil {
+		return err
+	}
+	defer release()
+
 	callCtx, cancel := context.WithTimeout(ctx, callTimeout)
 	defer cancel()
 
@@ -217,9 +227,12 @@ func (e taskExecutor) executeInvocationTask(ctx context.Context, env hsm.Environ
 	methodTag := metrics.NexusMethodTag("StartOperation")
 	namespaceTag := metrics.NamespaceTag(ns.Name().String())
 	destTag := metrics.DestinationTag(endpoint.Endpoint.Spec.GetName())
+	serviceTag := metrics.NexusServiceTag(args.service)
+	operationTag := metrics.NexusOperationTag(args.operation)
 	outcomeTag := metrics.OutcomeTag(startCallOutcomeTag(callCtx, rawResult, callErr))
-	OutboundRequestCounter.With(e.MetricsHandler).Record(1, namespaceTag, destTag, methodTag, outcomeTag)
-	OutboundRequestLatency.With(e.MetricsHandler).Record(time.Since(startTime), namespaceTag, destTag, methodTag, outcomeTag)
+	OutboundRequestCounter.With(e.MetricsHandler).Record(1, namespaceTag, destTag, serviceTag, operationTag, methodTag, outcomeTag)
+	OutboundRequestLatency.With(e.MetricsHandler).Record(time.Since(startTime), namespaceTag, destTag, serviceTag, operationTag, methodTag, outcomeTag)
+	e.EndpointHealthTracker.Record(ref.WorkflowKey.NamespaceID, endpoint.Id, endpoint.Endpoint.Spec.GetName(), callErr)
 
 	var result *nexus.ClientStartOperationResult[*commonpb.Payload]
 	if callErr == nil {
@@ -236,7 +249,7 @@ func (e taskExecutor) executeInvocationTask(ctx context.Context, env hsm.Environ
 			err := rawResult.Successful.Consume(&payload)
 			if err != nil {
 				callErr = err
-			} else if payload.Size() > e.Config.PayloadSizeLimit(ns.Name().String()) {
+			} else if e.PayloadSizeChecker.Check(ns.Name().String(), endpoint.Endpoint.Spec.GetName(), commonnexus.PayloadTypeResult, payload.Size()) != nil {
 				callErr = ErrResponseBodyTooLarge
 			} else {
 				result = &nexus.ClientStartOperationResult[*commonpb.Payload]{
@@ -250,7 +263,7 @@ func (e taskExecutor) executeInvocationTask(ctx context.Context, env hsm.Environ
 		e.Logger.Error("Nexus StartOperation request failed", tag.Error(callErr))
 	}
 
-	err = e.saveResult(ctx, env, ref, result, callErr)
+	err = e.saveResult(ctx, env, ref, result, callErr, e.endpointRetryPolicy(endpoint))
 
 	if callErr != nil && isDestinationDown(callErr) {
 		err = queues.NewDestinationDownError(callErr.Error(), err)
@@ -317,68 +330,96 @@ func (e taskExecutor) loadOperationArgs(
 	return
 }
 
-func (e taskExecutor) saveResult(ctx context.Context, env hsm.Environment, ref hsm.Ref, result *nexus.ClientStartOperationResult[*commonpb.Payload], callErr error) error {
-	return env.Access(ctx, ref, hsm.AccessWrite, func(node *hsm.Node) error {
+func (e taskExecutor) saveResult(ctx context.Context, env hsm.Environment, ref hsm.Ref, result *nexus.ClientStartOperationResult[*commonpb.Payload], callErr error, retryPolicy backoff.RetryPolicy) error {
+	var opMetrics operationMetrics
+	err := env.Access(ctx, ref, hsm.AccessWrite, func(node *hsm.Node) error {
 		return hsm.MachineTransition(node, func(operation Operation) (hsm.TransitionOutput, error) {
-			if callErr != nil {
-				return e.handleStartOperationError(env, node, operation, callErr)
-			}
-			eventID, err := hsm.EventIDFromToken(operation.ScheduledEventToken)
-			if err != nil {
-				return hsm.TransitionOutput{}, err
-			}
-			if result.Pending != nil {
-				var links []*commonpb.Link
-				for _, nexusLink := range result.Links {
-					switch nexusLink.Type {
-					case string((&commonpb.Link_WorkflowEvent{}).ProtoReflect().Descriptor().FullName()):
-						link, err := temporalnexus.ConvertNexusLinkToLinkWorkflowEvent(nexusLink)
-						if err != nil {
-							// TODO(rodrigozhou): links are non-essential for the execution of the workflow,
-							// so ignoring the error for now; we will revisit how to handle these errors later.
-							e.Logger.Error(
-								fmt.Sprintf("failed to parse link to %q: %s", nexusLink.Type, nexusLink.URL),
-								tag.Error(err),
-							)
-							continue
-						}
-						links = append(links, &commonpb.Link{
-							Variant: &commonpb.Link_WorkflowEvent_{
-								WorkflowEvent: link,
-							},
-						})
-					default:
-						// If the link data type is unsupported, just ignore it for now.
-						e.Logger.Error(fmt.Sprintf("invalid link data type: %q", nexusLink.Type))
-					}
+			output, err := e.applyStartResult(env, node, operation, result, callErr, retryPolicy)
+			opMetrics = newOperationMetrics(operation)
+			return output, err
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	now := env.Now()
+	var opFailedErr *nexus.UnsuccessfulOperationError
+	if result != nil || errors.As(callErr, &opFailedErr) {
+		// The handler accepted the operation.
+		opMetrics.recordStarted(e.MetricsHandler, now)
+	}
+	if opMetrics.closed() {
+		opMetrics.recordClosed(e.MetricsHandler, now, startFailureType(callErr))
+	}
+	return nil
+}
+
+func (e taskExecutor) applyStartResult(
+	env hsm.Environment,
+	node *hsm.Node,
+	operation Operation,
+	result *nexus.ClientStartOperationResult[*commonpb.Payload],
+	callErr error,
+	retryPolicy backoff.RetryPolicy,
+) (hsm.TransitionOutput, error) {
+	if callErr != nil {
+		return handleStartOperationError(env, node, operation, callErr, retryPolicy)
+	}
+	eventID, err := hsm.EventIDFromToken(operation.ScheduledEventToken)
+	if err != nil {
+		return hsm.TransitionOutput{}, err
+	}
+	if result.Pending != nil {
+		var links []*commonpb.Link
+		for _, nexusLink := range result.Links {
+			switch nexusLink.Type {
+			case string((&commonpb.Link_WorkflowEvent{}).ProtoReflect().Descriptor().FullName()):
+				link, err := temporalnexus.ConvertNexusLinkToLinkWorkflowEvent(nexusLink)
+				if err != nil {
+					// TODO(rodrigozhou): links are non-essential for the execution of the workflow,
+					// so ignoring the error for now; we will revisit how to handle these errors later.
+					e.Logger.Error(
+						fmt.Sprintf("failed to parse link to %q: %s", nexusLink.Type, nexusLink.URL),
+						tag.Error(err),
+					)
+					continue
 				}
-				// Handler has indicated that the operation will complete asynchronously. Mark the operation as started
-				// to allow it to complete via callback.
-				event := node.AddHistoryEvent(enumspb.EVENT_TYPE_NEXUS_OPERATION_STARTED, func(e *historypb.HistoryEvent) {
-					// nolint:revive // We must mutate here even if the linter doesn't like it.
-					e.Attributes = &historypb.HistoryEvent_NexusOperationStartedEventAttributes{
-						NexusOperationStartedEventAttributes: &historypb.NexusOperationStartedEventAttributes{
-							ScheduledEventId: eventID,
-							OperationId:      result.Pending.ID,
-							RequestId:        operation.RequestId,
-						},
-					}
-					// nolint:revive // We must mutate here even if the linter doesn't like it.
-					e.Links = links
-				})
-				return TransitionStarted.Apply(operation, EventStarted{
-					Time:       env.Now(),
-					Node:       node,
-					Attributes: event.GetNexusOperationStartedEventAttributes(),
+				links = append(links, &commonpb.Link{
+					Variant: &commonpb.Link_WorkflowEvent_{
+						WorkflowEvent: link,
+					},
 				})
+			default:
+				// If the link data type is unsupported, just ignore it for now.
+				e.Logger.Error(fmt.Sprintf("invalid link data type: %q", nexusLink.Type))
+			}
+		}
+		// Handler has indicated that the operation will complete asynchronously. Mark the operation as started
+		// to allow it to complete via callback.
+		event := node.AddHistoryEvent(enumspb.EVENT_TYPE_NEXUS_OPERATION_STARTED, func(e *historypb.HistoryEvent) {
+			// nolint:revive // We must mutate here even if the linter doesn't like it.
+			e.Attributes = &historypb.HistoryEvent_NexusOperationStartedEventAttributes{
+				NexusOperationStartedEventAttributes: &historypb.NexusOperationStartedEventAttributes{
+					ScheduledEventId: eventID,
+					OperationId:      result.Pending.ID,
+					RequestId:        operation.RequestId,
+				},
 			}
-			// Operation completed synchronously. Store the result and update the state machine.
-			return handleSuccessfulOperationResult(node, operation, result.Successful, CompletionSourceResponse)
+			// nolint:revive // We must mutate here even if the linter doesn't like it.
+			e.Links = links
 		})
-	})
+		return TransitionStarted.Apply(operation, EventStarted{
+			Time:       env.Now(),
+			Node:       node,
+			Attributes: event.GetNexusOperationStartedEventAttributes(),
+		})
+	}
+	// Operation completed synchronously. Store the result and update the state machine.
+	return handleSuccessfulOperationResult(node, operation, result.Successful, CompletionSourceResponse)
 }
 
-func (e taskExecutor) handleStartOperationError(env hsm.Environment, node *hsm.Node, operation Operation, callErr error) (hsm.TransitionOutput, error) {
+func handleStartOperationError(env hsm.Environment, node *hsm.Node, operation Operation, callErr error, retryPolicy backoff.RetryPolicy) (hsm.TransitionOutput, error) {
 	var handlerErr *nexus.HandlerError
 	var opFailedErr *nexus.UnsuccessfulOperationError
 
@@ -399,6 +440,10 @@ func (e taskExecutor) handleStartOperationError(env hsm.Environment, node *hsm.N
 		// Operation timeout is not retryable
 		return handleNonRetryableStartOperationError(env, node, operation, callErr)
 	}
+	if retryPolicy.ComputeNextDelay(0, int(operation.Attempt)+1, nil) < 0 {
+		// The endpoint's retry policy ran out of attempts.
+		return handleNonRetryableStartOperationError(env, node, operation, callErr)
+	}
 	failure, err := callErrToFailure(callErr, true)
 	if err != nil {
 		return hsm.TransitionOutput{}, err
@@ -407,7 +452,7 @@ func (e taskExecutor) handleStartOperationError(env hsm.Environment, node *hsm.N
 		Time:        env.Now(),
 		Failure:     failure,
 		Node:        node,
-		RetryPolicy: e.Config.RetryPolicy(),
+		RetryPolicy: retryPolicy,
 	})
 }
 
@@ -453,7 +498,8 @@ func (e taskExecutor) executeBackoffTask(env hsm.Environment, node *hsm.Node, ta
 }
 
 func (e taskExecutor) executeTimeoutTask(env hsm.Environment, node *hsm.Node, task TimeoutTask) error {
-	return hsm.MachineTransition(node, func(op Operation) (hsm.TransitionOutput, error) {
+	var opMetrics operationMetrics
+	err := hsm.MachineTransition(node, func(op Operation) (hsm.TransitionOutput, error) {
 		eventID, err := hsm.EventIDFromToken(op.ScheduledEventToken)
 		if err != nil {
 			return hsm.TransitionOutput{}, err
@@ -480,10 +526,17 @@ func (e taskExecutor) executeTimeoutTask(env hsm.Environment, node *hsm.Node, ta
 			}
 		})
 
-		return TransitionTimedOut.Apply(op, EventTimedOut{
+		output, err := TransitionTimedOut.Apply(op, EventTimedOut{
 			Node: node,
 		})
+		opMetrics = newOperationMetrics(op)
+		return output, err
 	})
+	if err != nil {
+		return err
+	}
+	opMetrics.recordClosed(e.MetricsHandler, env.Now(), failureTypeTimeout)
+	return nil
 }
 
 func (e taskExecutor) executeCancelationTask(ctx context.Context, env hsm.Environment, ref hsm.Ref, task CancelationTask) error {
@@ -527,6 +580,12 @@ func (e taskExecutor) executeCancelationTask(ctx context.Context, env hsm.Enviro
 		opTimeout := args.scheduleToCloseTimeout - time.Since(args.scheduledTime)
 		callTimeout = min(callTimeout, opTimeout)
 	}
+	release, err := e.EndpointLimiter.Acquire(endpoint.Endpoint.Spec.GetName())
+	if err != nil {
+		return err
+	}
+	defer release()
+
 	callCtx, cancel := context.WithTimeout(ctx, callTimeout)
 	defer cancel()
 
@@ -541,9 +600,12 @@ func (e taskExecutor) executeCancelationTask(ctx context.Context, env hsm.Enviro
 	methodTag := metrics.NexusMethodTag("CancelOperation")
 	namespaceTag := metrics.NamespaceTag(ns.Name().String())
 	destTag := metrics.DestinationTag(endpoint.Endpoint.Spec.GetName())
+	serviceTag := metrics.NexusServiceTag(args.service)
+	operationTag := metrics.NexusOperationTag(args.operation)
 	statusCodeTag := metrics.OutcomeTag(cancelCallOutcomeTag(callCtx, callErr))
-	OutboundRequestCounter.With(e.MetricsHandler).Record(1, namespaceTag, destTag, methodTag, statusCodeTag)
-	OutboundRequestLatency.With(e.MetricsHandler).Record(time.Since(startTime), namespaceTag, destTag, methodTag, statusCodeTag)
+	OutboundRequestCounter.With(e.MetricsHandler).Record(1, namespaceTag, destTag, serviceTag, operationTag, methodTag, statusCodeTag)
+	OutboundRequestLatency.With(e.MetricsHandler).Record(time.Since(startTime), namespaceTag, destTag, serviceTag, operationTag, methodTag, statusCodeTag)
+	e.EndpointHealthTracker.Record(ref.WorkflowKey.NamespaceID, endpoint.Id, endpoint.Endpoint.Spec.GetName(), callErr)
 
 	if callErr != nil {
 		e.Logger.Error("Nexus CancelOperation request failed", tag.Error(callErr))
@@ -576,6 +638,10 @@ func (e taskExecutor) loadArgsForCancelation(ctx context.Context, env hsm.Enviro
 			// Operation is already in a terminal state.
 			return fmt.Errorf("%w: operation already in terminal state", consts.ErrStaleReference)
 		}
+		if op.OperationId == "" {
+			// Cancelation is only scheduled once the operation ID is known, this is not expected to happen.
+			return fmt.Errorf("%w: operation ID is not known yet", consts.ErrStaleReference)
+		}
 
 		args.service = op.Service
 		args.operation = op.Operation
@@ -632,6 +698,26 @@ func (e taskExecutor) executeCancelationBackoffTask(env hsm.Environment, node *h
 	})
 }
 
+// endpointRetryPolicy returns the retry policy for StartOperation requests to the given endpoint, preferring the
+// endpoint's default retry policy over the dynamic config one.
+func (e taskExecutor) endpointRetryPolicy(endpoint *persistencespb.NexusEndpointEntry) backoff.RetryPolicy {
+	policy := endpoint.GetEndpoint().GetSpec().GetOperationDefaults().GetRetryPolicy()
+	if policy == nil {
+		return e.Config.RetryPolicy()
+	}
+	return backoff.NewExponentialRetryPolicy(
+		policy.GetInitialInterval().AsDuration(),
+	).WithBackoffCoefficient(
+		policy.GetBackoffCoefficient(),
+	).WithMaximumInterval(
+		policy.GetMaximumInterval().AsDuration(),
+	).WithMaximumAttempts(
+		int(policy.GetMaximumAttempts()),
+	).WithExpirationInterval(
+		backoff.NoInterval,
+	)
+}
+
 // lookupEndpint gets an endpoint from the registry, preferring to look up by ID and falling back to name lookup.
 // The fallback is a temporary workaround for not implementing endpoint replication, and endpoint ID being a UUID set by
 // the system. We try to get the endpoint by name to support cases where an operator manually created an endpoint with
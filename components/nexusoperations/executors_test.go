@@ -39,11 +39,14 @@ import (
 	"go.temporal.io/sdk/temporalnexus"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
 	"go.temporal.io/server/common/namespace"
 	commonnexus "go.temporal.io/server/common/nexus"
 	"go.temporal.io/server/common/nexus/nexustest"
@@ -108,13 +111,18 @@ func TestProcessInvocationTask(t *testing.T) {
 		checkStartOperationOptions func(t *testing.T, options nexus.StartOperationOptions)
 		onStartOperation           func(ctx context.Context, service, operation string, input *nexus.LazyValue, options nexus.StartOperationOptions) (nexus.HandlerStartOperationResult[any], error)
 		expectedMetricOutcome      string
+		expectedStarted            bool
+		expectedCloseOutcome       string
+		expectedFailureType        string
 		checkOutcome               func(t *testing.T, op nexusoperations.Operation, events []*historypb.HistoryEvent)
 		requestTimeout             time.Duration
 		schedToCloseTimeout        time.Duration
 		destinationDown            bool
+		endpointRetryPolicy        *commonpb.RetryPolicy
 	}{
 		{
 			name:            "async start",
+			expectedStarted: true,
 			requestTimeout:  time.Hour,
 			destinationDown: false,
 			checkStartOperationOptions: func(t *testing.T, options nexus.StartOperationOptions) {
@@ -168,11 +176,14 @@ func TestProcessInvocationTask(t *testing.T) {
 			},
 		},
 		{
-			name:                "sync start",
-			requestTimeout:      time.Hour,
-			schedToCloseTimeout: time.Hour,
-			header:              nexus.Header{nexus.HeaderOperationTimeout: time.Microsecond.String()}, // to test this value is ignored when ScheduleToCloseTimeout is set
-			destinationDown:     false,
+			name:                 "sync start",
+			expectedStarted:      true,
+			expectedCloseOutcome: "succeeded",
+			expectedFailureType:  "none",
+			requestTimeout:       time.Hour,
+			schedToCloseTimeout:  time.Hour,
+			header:               nexus.Header{nexus.HeaderOperationTimeout: time.Microsecond.String()}, // to test this value is ignored when ScheduleToCloseTimeout is set
+			destinationDown:      false,
 			onStartOperation: func(ctx context.Context, service, operation string, input *nexus.LazyValue, options nexus.StartOperationOptions) (nexus.HandlerStartOperationResult[any], error) {
 				// Also use this test case to check the input and options provided.
 				if service != "service" {
@@ -207,9 +218,12 @@ func TestProcessInvocationTask(t *testing.T) {
 			},
 		},
 		{
-			name:            "sync failed",
-			requestTimeout:  time.Hour,
-			destinationDown: false,
+			name:                 "sync failed",
+			expectedStarted:      true,
+			expectedCloseOutcome: "failed",
+			expectedFailureType:  "operation-failed",
+			requestTimeout:       time.Hour,
+			destinationDown:      false,
 			onStartOperation: func(ctx context.Context, service, operation string, input *nexus.LazyValue, options nexus.StartOperationOptions) (nexus.HandlerStartOperationResult[any], error) {
 				return nil, nexus.NewFailedOperationError(&nexus.FailureError{
 					Failure: nexus.Failure{Message: "operation failed from handler", Metadata: map[string]string{"encoding": "json/plain"}, Details: json.RawMessage("\"details\"")},
@@ -253,9 +267,12 @@ func TestProcessInvocationTask(t *testing.T) {
 			},
 		},
 		{
-			name:            "sync canceled",
-			requestTimeout:  time.Hour,
-			destinationDown: false,
+			name:                 "sync canceled",
+			expectedStarted:      true,
+			expectedCloseOutcome: "canceled",
+			expectedFailureType:  "operation-canceled",
+			requestTimeout:       time.Hour,
+			destinationDown:      false,
 			onStartOperation: func(ctx context.Context, service, operation string, input *nexus.LazyValue, options nexus.StartOperationOptions) (nexus.HandlerStartOperationResult[any], error) {
 				return nil, nexus.NewCanceledOperationError(
 					&nexus.FailureError{
@@ -313,6 +330,24 @@ func TestProcessInvocationTask(t *testing.T) {
 				require.Equal(t, 0, len(events))
 			},
 		},
+		{
+			name:                 "transient error - endpoint retry policy exhausted",
+			expectedCloseOutcome: "failed",
+			expectedFailureType:  "handler-error:INTERNAL",
+			requestTimeout:       time.Hour,
+			destinationDown:      true,
+			endpointRetryPolicy:  &commonpb.RetryPolicy{MaximumAttempts: 1},
+			onStartOperation: func(ctx context.Context, service, operation string, input *nexus.LazyValue, options nexus.StartOperationOptions) (nexus.HandlerStartOperationResult[any], error) {
+				return nil, nexus.HandlerErrorf(nexus.HandlerErrorTypeInternal, "internal server error")
+			},
+			expectedMetricOutcome: "handler-error:INTERNAL",
+			checkOutcome: func(t *testing.T, op nexusoperations.Operation, events []*historypb.HistoryEvent) {
+				require.Equal(t, enumsspb.NEXUS_OPERATION_STATE_FAILED, op.State())
+				require.Equal(t, 1, len(events))
+				require.Equal(t, enumspb.EVENT_TYPE_NEXUS_OPERATION_FAILED, events[0].EventType)
+				require.Equal(t, "handler error (INTERNAL): internal server error", events[0].GetNexusOperationFailedEventAttributes().GetFailure().GetCause().GetMessage())
+			},
+		},
 		{
 			name:                  "invocation timeout by request timeout",
 			requestTimeout:        2 * time.Millisecond,
@@ -349,6 +384,8 @@ func TestProcessInvocationTask(t *testing.T) {
 		},
 		{
 			name:                  "ScheduleToCloseTimeout less than MinOperationTimeout",
+			expectedCloseOutcome:  "failed",
+			expectedFailureType:   "operation-timeout",
 			requestTimeout:        time.Hour,
 			schedToCloseTimeout:   time.Microsecond,
 			destinationDown:       false,
@@ -362,11 +399,13 @@ func TestProcessInvocationTask(t *testing.T) {
 			},
 		},
 		{
-			name:             "endpoint not found",
-			endpointNotFound: true,
-			requestTimeout:   time.Hour,
-			destinationDown:  false,
-			onStartOperation: nil, // This should not be called if the endpoint is not found.
+			name:                 "endpoint not found",
+			expectedCloseOutcome: "failed",
+			expectedFailureType:  "handler-error:NOT_FOUND",
+			endpointNotFound:     true,
+			requestTimeout:       time.Hour,
+			destinationDown:      false,
+			onStartOperation:     nil, // This should not be called if the endpoint is not found.
 			checkOutcome: func(t *testing.T, op nexusoperations.Operation, events []*historypb.HistoryEvent) {
 				require.Equal(t, enumsspb.NEXUS_OPERATION_STATE_FAILED, op.State())
 				require.NotNil(t, op.LastAttemptFailure.GetApplicationFailureInfo())
@@ -376,6 +415,8 @@ func TestProcessInvocationTask(t *testing.T) {
 		},
 		{
 			name:                 "endpoint not found on command processing",
+			expectedCloseOutcome: "failed",
+			expectedFailureType:  "handler-error:NOT_FOUND",
 			eventHasNoEndpointID: true,
 			requestTimeout:       time.Hour,
 			destinationDown:      false,
@@ -389,6 +430,7 @@ func TestProcessInvocationTask(t *testing.T) {
 		},
 		{
 			name:              "cancel before start",
+			expectedStarted:   true,
 			cancelBeforeStart: true,
 			requestTimeout:    time.Hour,
 			destinationDown:   false,
@@ -464,23 +506,56 @@ func TestProcessInvocationTask(t *testing.T) {
 				counter.EXPECT().Record(int64(1),
 					metrics.NamespaceTag("ns-name"),
 					metrics.DestinationTag("endpoint"),
+					metrics.NexusServiceTag("service"),
+					metrics.NexusOperationTag("operation"),
 					metrics.NexusMethodTag("StartOperation"),
 					metrics.OutcomeTag(tc.expectedMetricOutcome))
 				metricsHandler.EXPECT().Timer(nexusoperations.OutboundRequestLatency.Name()).Return(timer)
 				timer.EXPECT().Record(gomock.Any(),
 					metrics.NamespaceTag("ns-name"),
 					metrics.DestinationTag("endpoint"),
+					metrics.NexusServiceTag("service"),
+					metrics.NexusOperationTag("operation"),
 					metrics.NexusMethodTag("StartOperation"),
 					metrics.OutcomeTag(tc.expectedMetricOutcome))
 			}
+			operationTags := []any{
+				metrics.DestinationTag("endpoint"),
+				metrics.NexusServiceTag("service"),
+				metrics.NexusOperationTag("operation"),
+			}
+			if tc.expectedStarted {
+				timer := metrics.NewMockTimerIface(ctrl)
+				metricsHandler.EXPECT().Timer(nexusoperations.OperationScheduleToStartLatency.Name()).Return(timer)
+				timer.EXPECT().Record(gomock.Any(), operationTags...)
+			}
+			if tc.expectedCloseOutcome != "" {
+				closeTags := append([]any{
+					metrics.OutcomeTag(tc.expectedCloseOutcome),
+					metrics.FailureTag(tc.expectedFailureType),
+				}, operationTags...)
+				timer := metrics.NewMockTimerIface(ctrl)
+				metricsHandler.EXPECT().Timer(nexusoperations.OperationScheduleToCloseLatency.Name()).Return(timer)
+				timer.EXPECT().Record(gomock.Any(), closeTags...)
+				counter := metrics.NewMockCounterIface(ctrl)
+				metricsHandler.EXPECT().Counter(nexusoperations.OperationClosedCounter.Name()).Return(counter)
+				counter.EXPECT().Record(int64(1), closeTags...)
+			}
 
+			entry := endpointEntry
+			if tc.endpointRetryPolicy != nil {
+				entry = common.CloneProto(endpointEntry)
+				entry.Endpoint.Spec.OperationDefaults = &persistencespb.NexusOperationDefaults{
+					RetryPolicy: tc.endpointRetryPolicy,
+				}
+			}
 			endpointReg := nexustest.FakeEndpointRegistry{
 				OnGetByID: func(ctx context.Context, endpointID string) (*persistencespb.NexusEndpointEntry, error) {
 					require.Equal(t, "endpoint-id", endpointID)
 					if tc.endpointNotFound {
 						return nil, serviceerror.NewNotFound("endpoint not found")
 					}
-					return endpointEntry, nil
+					return entry, nil
 				},
 				OnGetByName: func(ctx context.Context, namespaceID namespace.ID, endpointName string) (*persistencespb.NexusEndpointEntry, error) {
 					require.Equal(t, "endpoint", endpointName)
@@ -488,25 +563,33 @@ func TestProcessInvocationTask(t *testing.T) {
 					if tc.endpointNotFound {
 						return nil, serviceerror.NewNotFound("endpoint not found")
 					}
-					return endpointEntry, nil
+					return entry, nil
 				},
 			}
-			require.NoError(t, nexusoperations.RegisterExecutor(reg, nexusoperations.TaskExecutorOptions{
-				Config: &nexusoperations.Config{
-					Enabled:             dynamicconfig.GetBoolPropertyFn(true),
-					RequestTimeout:      dynamicconfig.GetDurationPropertyFnFilteredByDestination(tc.requestTimeout),
-					MinOperationTimeout: dynamicconfig.GetDurationPropertyFnFilteredByNamespace(time.Millisecond),
-					PayloadSizeLimit:    dynamicconfig.GetIntPropertyFnFilteredByNamespace(2 * 1024 * 1024),
-					CallbackURLTemplate: dynamicconfig.GetStringPropertyFn("http://localhost/callback"),
-					RetryPolicy: func() backoff.RetryPolicy {
-						return backoff.NewExponentialRetryPolicy(time.Second)
-					},
+			config := &nexusoperations.Config{
+				Enabled:                       dynamicconfig.GetBoolPropertyFn(true),
+				RequestTimeout:                dynamicconfig.GetDurationPropertyFnFilteredByDestination(tc.requestTimeout),
+				MinOperationTimeout:           dynamicconfig.GetDurationPropertyFnFilteredByNamespace(time.Millisecond),
+				PayloadSizeLimit:              dynamicconfig.GetIntPropertyFnFilteredByNamespace(2 * 1024 * 1024),
+				PayloadSizeWarnLimit:          dynamicconfig.GetIntPropertyFnFilteredByNamespace(512 * 1024),
+				CallbackURLTemplate:           dynamicconfig.GetStringPropertyFn("http://localhost/callback"),
+				EndpointMaxRPS:                dynamicconfig.GetFloatPropertyFnFilteredByDestination(0),
+				EndpointMaxConcurrentRequests: dynamicconfig.GetIntPropertyFnFilteredByDestination(0),
+				EndpointHealthWindow:          dynamicconfig.GetDurationPropertyFn(time.Minute),
+				RetryPolicy: func() backoff.RetryPolicy {
+					return backoff.NewExponentialRetryPolicy(time.Second)
 				},
+			}
+			require.NoError(t, nexusoperations.RegisterExecutor(reg, nexusoperations.TaskExecutorOptions{
+				Config:                 config,
 				CallbackTokenGenerator: commonnexus.NewCallbackTokenGenerator(),
 				NamespaceRegistry:      namespaceRegistry,
 				MetricsHandler:         metricsHandler,
 				Logger:                 log.NewNoopLogger(),
 				EndpointRegistry:       endpointReg,
+				EndpointLimiter:        nexusoperations.EndpointLimiterProvider(config, metrics.NoopMetricsHandler),
+				EndpointHealthTracker:  nexusoperations.EndpointHealthTrackerProvider(config, clock.NewRealTimeSource()),
+				PayloadSizeChecker:     nexusoperations.PayloadSizeCheckerProvider(config, metrics.NoopMetricsHandler, log.NewNoopLogger()),
 				ClientProvider: func(ctx context.Context, namespaceID string, entry *persistencespb.NexusEndpointEntry, service string) (*nexus.HTTPClient, error) {
 					return nexus.NewHTTPClient(nexus.HTTPClientOptions{
 						BaseURL:    "http://" + listenAddr,
@@ -550,7 +633,9 @@ func TestProcessBackoffTask(t *testing.T) {
 	node := newOperationNode(t, backend, mustNewScheduledEvent(time.Now(), time.Hour))
 	env := fakeEnv{node}
 
-	require.NoError(t, nexusoperations.RegisterExecutor(reg, nexusoperations.TaskExecutorOptions{}))
+	require.NoError(t, nexusoperations.RegisterExecutor(reg, nexusoperations.TaskExecutorOptions{
+		MetricsHandler: metrics.NoopMetricsHandler,
+	}))
 	err := hsm.MachineTransition(node, func(op nexusoperations.Operation) (hsm.TransitionOutput, error) {
 		return nexusoperations.TransitionAttemptFailed.Apply(op, nexusoperations.EventAttemptFailed{
 			Node:        node,
@@ -578,8 +663,13 @@ func TestProcessTimeoutTask(t *testing.T) {
 	backend := &hsmtest.NodeBackend{}
 	node := newOperationNode(t, backend, mustNewScheduledEvent(time.Now(), time.Hour))
 	env := fakeEnv{node}
+	metricsHandler := metricstest.NewCaptureHandler()
+	capture := metricsHandler.StartCapture()
+	defer metricsHandler.StopCapture(capture)
 
-	require.NoError(t, nexusoperations.RegisterExecutor(reg, nexusoperations.TaskExecutorOptions{}))
+	require.NoError(t, nexusoperations.RegisterExecutor(reg, nexusoperations.TaskExecutorOptions{
+		MetricsHandler: metricsHandler,
+	}))
 
 	err := reg.ExecuteTimerTask(
 		env,
@@ -615,6 +705,18 @@ func TestProcessTimeoutTask(t *testing.T) {
 			},
 		},
 	}, backend.Events[0].GetNexusOperationTimedOutEventAttributes())
+
+	snapshot := capture.Snapshot()
+	require.Len(t, snapshot[nexusoperations.OperationClosedCounter.Name()], 1)
+	closed := snapshot[nexusoperations.OperationClosedCounter.Name()][0]
+	require.Equal(t, map[string]string{
+		"destination":     "endpoint",
+		"nexus_service":   "service",
+		"nexus_operation": "operation",
+		"outcome":         "timed-out",
+		"failure":         "schedule-to-close-timeout",
+	}, closed.Tags)
+	require.Len(t, snapshot[nexusoperations.OperationScheduleToCloseLatency.Name()], 1)
 }
 
 func TestProcessCancelationTask(t *testing.T) {
@@ -751,12 +853,16 @@ func TestProcessCancelationTask(t *testing.T) {
 				counter.EXPECT().Record(int64(1),
 					metrics.NamespaceTag("ns-name"),
 					metrics.DestinationTag("endpoint"),
+					metrics.NexusServiceTag("service"),
+					metrics.NexusOperationTag("operation"),
 					metrics.NexusMethodTag("CancelOperation"),
 					metrics.OutcomeTag(tc.expectedMetricOutcome))
 				metricsHandler.EXPECT().Timer(nexusoperations.OutboundRequestLatency.Name()).Return(timer)
 				timer.EXPECT().Record(gomock.Any(),
 					metrics.NamespaceTag("ns-name"),
 					metrics.DestinationTag("endpoint"),
+					metrics.NexusServiceTag("service"),
+					metrics.NexusOperationTag("operation"),
 					metrics.NexusMethodTag("CancelOperation"),
 					metrics.OutcomeTag(tc.expectedMetricOutcome))
 			}
@@ -778,19 +884,25 @@ func TestProcessCancelationTask(t *testing.T) {
 				},
 			}
 
-			require.NoError(t, nexusoperations.RegisterExecutor(reg, nexusoperations.TaskExecutorOptions{
-				Config: &nexusoperations.Config{
-					Enabled:             dynamicconfig.GetBoolPropertyFn(true),
-					RequestTimeout:      dynamicconfig.GetDurationPropertyFnFilteredByDestination(tc.requestTimeout),
-					MinOperationTimeout: dynamicconfig.GetDurationPropertyFnFilteredByNamespace(time.Millisecond),
-					RetryPolicy: func() backoff.RetryPolicy {
-						return backoff.NewExponentialRetryPolicy(time.Second)
-					},
+			config := &nexusoperations.Config{
+				Enabled:                       dynamicconfig.GetBoolPropertyFn(true),
+				RequestTimeout:                dynamicconfig.GetDurationPropertyFnFilteredByDestination(tc.requestTimeout),
+				MinOperationTimeout:           dynamicconfig.GetDurationPropertyFnFilteredByNamespace(time.Millisecond),
+				EndpointMaxRPS:                dynamicconfig.GetFloatPropertyFnFilteredByDestination(0),
+				EndpointMaxConcurrentRequests: dynamicconfig.GetIntPropertyFnFilteredByDestination(0),
+				EndpointHealthWindow:          dynamicconfig.GetDurationPropertyFn(time.Minute),
+				RetryPolicy: func() backoff.RetryPolicy {
+					return backoff.NewExponentialRetryPolicy(time.Second)
 				},
-				NamespaceRegistry: namespaceRegistry,
-				MetricsHandler:    metricsHandler,
-				Logger:            log.NewNoopLogger(),
-				EndpointRegistry:  endpointReg,
+			}
+			require.NoError(t, nexusoperations.RegisterExecutor(reg, nexusoperations.TaskExecutorOptions{
+				Config:                config,
+				NamespaceRegistry:     namespaceRegistry,
+				MetricsHandler:        metricsHandler,
+				Logger:                log.NewNoopLogger(),
+				EndpointRegistry:      endpointReg,
+				EndpointLimiter:       nexusoperations.EndpointLimiterProvider(config, metrics.NoopMetricsHandler),
+				EndpointHealthTracker: nexusoperations.EndpointHealthTrackerProvider(config, clock.NewRealTimeSource()),
 				ClientProvider: func(ctx context.Context, namespaceID string, entry *persistencespb.NexusEndpointEntry, service string) (*nexus.HTTPClient, error) {
 					return nexus.NewHTTPClient(nexus.HTTPClientOptions{
 						BaseURL:    "http://" + listenAddr,
@@ -915,7 +1027,9 @@ func TestProcessCancelationBackoffTask(t *testing.T) {
 
 	env := fakeEnv{node}
 
-	require.NoError(t, nexusoperations.RegisterExecutor(reg, nexusoperations.TaskExecutorOptions{}))
+	require.NoError(t, nexusoperations.RegisterExecutor(reg, nexusoperations.TaskExecutorOptions{
+		MetricsHandler: metrics.NoopMetricsHandler,
+	}))
 
 	err = reg.ExecuteTimerTask(
 		env,
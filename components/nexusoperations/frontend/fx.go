@@ -46,7 +46,8 @@ var Module = fx.Module(
 func ConfigProvider(coll *dynamicconfig.Collection) *Config {
 	return &Config{
 		Enabled:                       dynamicconfig.EnableNexus.Get(coll),
-		PayloadSizeLimit:              dynamicconfig.BlobSizeLimitError.Get(coll),
+		PayloadSizeLimit:              dynamicconfig.NexusPayloadSizeLimitError.Get(coll),
+		PayloadSizeWarnLimit:          dynamicconfig.NexusPayloadSizeLimitWarn.Get(coll),
 		ForwardingEnabledForNamespace: dynamicconfig.EnableNamespaceNotActiveAutoForwarding.Get(coll),
 	}
 }
@@ -75,6 +75,7 @@ const (
 type Config struct {
 	Enabled                       dynamicconfig.BoolPropertyFn
 	PayloadSizeLimit              dynamicconfig.IntPropertyFnWithNamespaceFilter
+	PayloadSizeWarnLimit          dynamicconfig.IntPropertyFnWithNamespaceFilter
 	ForwardingEnabledForNamespace dynamicconfig.BoolPropertyFnWithNamespaceFilter
 }
 
@@ -227,8 +228,15 @@ func (h *completionHandler) CompleteOperation(ctx context.Context, r *nexus.Comp
 			logger.Error("cannot deserialize payload from completion result", tag.Error(err))
 			return nexus.HandlerErrorf(nexus.HandlerErrorTypeBadRequest, "invalid result content")
 		}
-		if result.Size() > h.Config.PayloadSizeLimit(ns.Name().String()) {
-			logger.Error("payload size exceeds error limit for Nexus CompleteOperation request", tag.WorkflowNamespace(ns.Name().String()))
+		// The endpoint isn't known when receiving completions, results are tagged with the endpoint in history.
+		if err := commonnexus.CheckPayloadSizeLimit(
+			result.Size(),
+			h.Config.PayloadSizeWarnLimit(ns.Name().String()),
+			h.Config.PayloadSizeLimit(ns.Name().String()),
+			commonnexus.PayloadTypeResult,
+			h.MetricsHandler.WithTags(metrics.NamespaceTag(ns.Name().String()), metrics.NexusEndpointTag("")),
+			logger,
+		); err != nil {
 			return nexus.HandlerErrorf(nexus.HandlerErrorTypeBadRequest, "result exceeds size limit")
 		}
 		hr.Outcome = &historyservice.CompleteNexusOperationRequest_Success{
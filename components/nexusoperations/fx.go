@@ -41,6 +41,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/resource"
 	"go.uber.org/fx"
+	"google.golang.org/protobuf/proto"
 )
 
 var Module = fx.Module(
@@ -50,6 +51,10 @@ var Module = fx.Module(
 	fx.Provide(DefaultNexusTransportProvider),
 	fx.Provide(CallbackTokenGeneratorProvider),
 	fx.Provide(EndpointRegistryProvider),
+	fx.Provide(EndpointLimiterProvider),
+	fx.Provide(EndpointHealthTrackerProvider),
+	fx.Provide(PayloadSizeCheckerProvider),
+	fx.Provide(SecretStoreProvider),
 	fx.Invoke(EndpointRegistryLifetimeHooks),
 	fx.Invoke(RegisterStateMachines),
 	fx.Invoke(RegisterTaskSerializers),
@@ -85,8 +90,8 @@ type NexusTransportProvider func(namespaceID, serviceName string) http.RoundTrip
 
 func DefaultNexusTransportProvider() NexusTransportProvider {
 	return func(namespaceID, serviceName string) http.RoundTripper {
-		// In the future, we'll want to inject headers and certs here.
-		// For now this is must be done externally via a custom transport provider.
+		// Credentials configured on the endpoint are applied on top of this transport. Mutual TLS requires the
+		// transport to be an *http.Transport.
 		return http.DefaultTransport
 	}
 }
@@ -96,12 +101,15 @@ type clientProviderCacheKey struct {
 	// URL is part of the cache key in case the service configuration is modified to use a new URL after caching the
 	// client for the service.
 	url string
+	// Serialized outbound auth config of the endpoint, part of the cache key for the same reason as the URL.
+	outboundAuth string
 }
 
 func ClientProviderFactory(
 	namespaceRegistry namespace.Registry,
 	endpointRegistry commonnexus.EndpointRegistry,
 	httpTransportProvider NexusTransportProvider,
+	secretStore SecretStore,
 	clusterMetadata cluster.Metadata,
 	rpcFactory common.RPCFactory,
 ) (ClientProvider, error) {
@@ -113,6 +121,16 @@ func ClientProviderFactory(
 	// TODO(bergundy): This should use an LRU or other form of cache that supports eviction.
 	m := collection.NewFallibleOnceMap(func(key clientProviderCacheKey) (*http.Client, error) {
 		transport := httpTransportProvider(key.namespaceID, key.endpointID)
+		if key.outboundAuth != "" {
+			auth := &persistencespb.NexusOutboundAuth{}
+			if err := proto.Unmarshal([]byte(key.outboundAuth), auth); err != nil {
+				return nil, err
+			}
+			var err error
+			if transport, err = newOutboundAuthTransport(transport, auth, secretStore); err != nil {
+				return nil, fmt.Errorf("cannot configure outbound auth for endpoint %q: %w", key.endpointID, err)
+			}
+		}
 		return &http.Client{
 			Transport: ResponseSizeLimiter{transport},
 		}, nil
@@ -123,8 +141,11 @@ func ClientProviderFactory(
 		switch variant := entry.Endpoint.Spec.Target.Variant.(type) {
 		case *persistencespb.NexusEndpointTarget_External_:
 			url = variant.External.GetUrl()
-			var err error
-			httpClient, err = m.Get(clientProviderCacheKey{namespaceID, entry.Id, url})
+			outboundAuth, err := proto.MarshalOptions{Deterministic: true}.Marshal(entry.Endpoint.Spec.GetOutboundAuth())
+			if err != nil {
+				return nil, err
+			}
+			httpClient, err = m.Get(clientProviderCacheKey{namespaceID, entry.Id, url, string(outboundAuth)})
 			if err != nil {
 				return nil, err
 			}
@@ -22,7 +22,14 @@
 
 package nexusoperations
 
-import "go.temporal.io/server/common/metrics"
+import (
+	"errors"
+	"time"
+
+	"github.com/nexus-rpc/sdk-go/nexus"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/metrics"
+)
 
 var OutboundRequestCounter = metrics.NewCounterDef(
 	"nexus_outbound_requests",
@@ -32,3 +39,117 @@ var OutboundRequestLatency = metrics.NewTimerDef(
 	"nexus_outbound_latency",
 	metrics.WithDescription("Latency of outbound Nexus requests made by the history service."),
 )
+var EndpointSaturatedCounter = metrics.NewCounterDef(
+	"nexus_endpoint_saturated",
+	metrics.WithDescription("The number of Nexus outbound requests delayed because the endpoint rate or concurrency limit was reached."),
+)
+var OperationScheduleToStartLatency = metrics.NewTimerDef(
+	"nexus_operation_schedule_to_start_latency",
+	metrics.WithDescription("Time from scheduling a Nexus operation until its handler accepted it, either by starting it asynchronously or by completing it synchronously."),
+)
+var OperationScheduleToCloseLatency = metrics.NewTimerDef(
+	"nexus_operation_schedule_to_close_latency",
+	metrics.WithDescription("Time from scheduling a Nexus operation until it reached a terminal state, tagged by outcome and failure type."),
+)
+var OperationClosedCounter = metrics.NewCounterDef(
+	"nexus_operation_closed",
+	metrics.WithDescription("The number of Nexus operations that reached a terminal state, tagged by outcome and failure type."),
+)
+
+// operationMetrics is a snapshot of the operation fields used by per-operation metrics. It is taken in a state machine
+// transition and recorded once the transition is applied, when the operation may no longer be accessed.
+//
+// Per-operation metrics are tagged by endpoint, service and operation name but not by namespace, which is not known
+// when an operation times out. Endpoints are cluster scoped so this is enough to track SLOs per operation.
+type operationMetrics struct {
+	tags          []metrics.Tag
+	scheduledTime time.Time
+	state         enumsspb.NexusOperationState
+}
+
+func newOperationMetrics(op Operation) operationMetrics {
+	return operationMetrics{
+		tags: []metrics.Tag{
+			metrics.DestinationTag(op.Endpoint),
+			metrics.NexusServiceTag(op.Service),
+			metrics.NexusOperationTag(op.Operation),
+		},
+		scheduledTime: op.ScheduledTime.AsTime(),
+		state:         op.State(),
+	}
+}
+
+// closed returns whether the operation was in a terminal state when the snapshot was taken.
+func (m operationMetrics) closed() bool {
+	switch m.state { // nolint:exhaustive
+	case enumsspb.NEXUS_OPERATION_STATE_SUCCEEDED,
+		enumsspb.NEXUS_OPERATION_STATE_FAILED,
+		enumsspb.NEXUS_OPERATION_STATE_CANCELED,
+		enumsspb.NEXUS_OPERATION_STATE_TIMED_OUT:
+		return true
+	default:
+		return false
+	}
+}
+
+func (m operationMetrics) recordStarted(handler metrics.Handler, startTime time.Time) {
+	OperationScheduleToStartLatency.With(handler).Record(startTime.Sub(m.scheduledTime), m.tags...)
+}
+
+func (m operationMetrics) recordClosed(handler metrics.Handler, closeTime time.Time, failureType string) {
+	var outcome string
+	switch m.state { // nolint:exhaustive
+	case enumsspb.NEXUS_OPERATION_STATE_SUCCEEDED:
+		outcome = "succeeded"
+	case enumsspb.NEXUS_OPERATION_STATE_FAILED:
+		outcome = "failed"
+	case enumsspb.NEXUS_OPERATION_STATE_CANCELED:
+		outcome = "canceled"
+	case enumsspb.NEXUS_OPERATION_STATE_TIMED_OUT:
+		outcome = "timed-out"
+	default:
+		return
+	}
+	tags := append([]metrics.Tag{metrics.OutcomeTag(outcome), metrics.FailureTag(failureType)}, m.tags...)
+	OperationScheduleToCloseLatency.With(handler).Record(closeTime.Sub(m.scheduledTime), tags...)
+	OperationClosedCounter.With(handler).Record(1, tags...)
+}
+
+// Failure types of closed operations. Handler errors are tagged with "handler-error:<type>".
+const (
+	failureTypeNone              = "none"
+	failureTypeOperationFailed   = "operation-failed"
+	failureTypeOperationCanceled = "operation-canceled"
+	failureTypeTimeout           = "schedule-to-close-timeout"
+	failureTypeOperationTimeout  = "operation-timeout"
+	failureTypeResponseTooLarge  = "response-too-large"
+	failureTypeRequestFailed     = "request-failed"
+)
+
+func unsuccessfulOperationFailureType(opFailedErr *nexus.UnsuccessfulOperationError) string {
+	if opFailedErr.State == nexus.OperationStateCanceled {
+		return failureTypeOperationCanceled
+	}
+	return failureTypeOperationFailed
+}
+
+// startFailureType returns the failure type of an operation that was closed in response to a StartOperation call.
+func startFailureType(callErr error) string {
+	var handlerErr *nexus.HandlerError
+	var opFailedErr *nexus.UnsuccessfulOperationError
+	switch {
+	case callErr == nil:
+		return failureTypeNone
+	case errors.As(callErr, &opFailedErr):
+		return unsuccessfulOperationFailureType(opFailedErr)
+	case errors.As(callErr, &handlerErr):
+		return "handler-error:" + string(handlerErr.Type)
+	case errors.Is(callErr, ErrResponseBodyTooLarge):
+		return failureTypeResponseTooLarge
+	case errors.Is(callErr, ErrOperationTimeoutBelowMin):
+		return failureTypeOperationTimeout
+	default:
+		// Retries exhausted.
+		return failureTypeRequestFailed
+	}
+}
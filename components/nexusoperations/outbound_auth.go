@@ -0,0 +1,198 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nexusoperations
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// oauth2TokenRequestTimeout bounds requests to OAuth2 authorization servers, which are made outside of the context of
+// the Nexus request that triggered them.
+const oauth2TokenRequestTimeout = 10 * time.Second
+
+// SecretStore resolves the secrets referenced by the outbound auth configuration of Nexus endpoints.
+// The default implementation reads secrets from files, a custom implementation can be provided by decorating the
+// component's fx module.
+type SecretStore interface {
+	GetSecret(ctx context.Context, name string) ([]byte, error)
+}
+
+type fileSecretStore struct {
+	directory dynamicconfig.StringPropertyFn
+}
+
+func SecretStoreProvider(config *Config) SecretStore {
+	return fileSecretStore{directory: config.OutboundAuthSecretsDirectory}
+}
+
+// GetSecret reads the file named after the secret from the configured directory.
+func (s fileSecretStore) GetSecret(_ context.Context, name string) ([]byte, error) {
+	directory := s.directory()
+	if directory == "" {
+		return nil, errors.New("nexus secrets directory is not configured")
+	}
+	if name == "" || name == "." || name == ".." || name != filepath.Base(name) {
+		return nil, fmt.Errorf("invalid secret name: %q", name)
+	}
+	return os.ReadFile(filepath.Join(directory, name))
+}
+
+// newOutboundAuthTransport wraps the given transport to attach the credentials described by auth to outgoing requests.
+func newOutboundAuthTransport(transport http.RoundTripper, auth *persistencespb.NexusOutboundAuth, store SecretStore) (http.RoundTripper, error) {
+	// Transports are cached and outlive the request that created them.
+	ctx := context.Background()
+	base := transport
+	if mtls := auth.GetMutualTls(); mtls != nil {
+		var err error
+		if transport, err = withClientCertificate(ctx, transport, mtls, store); err != nil {
+			return nil, err
+		}
+	}
+
+	switch variant := auth.GetToken().(type) {
+	case nil:
+	case *persistencespb.NexusOutboundAuth_BearerToken_:
+		transport = bearerTokenRoundTripper{
+			base:       transport,
+			store:      store,
+			secretName: variant.BearerToken.GetTokenSecretName(),
+		}
+	case *persistencespb.NexusOutboundAuth_Oauth2ClientCredentials:
+		creds := variant.Oauth2ClientCredentials
+		secret, err := store.GetSecret(ctx, creds.GetClientSecretName())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get OAuth2 client secret: %w", err)
+		}
+		cfg := clientcredentials.Config{
+			ClientID:     creds.GetClientId(),
+			ClientSecret: strings.TrimSpace(string(secret)),
+			TokenURL:     creds.GetTokenUrl(),
+			Scopes:       creds.GetScopes(),
+		}
+		// The authorization server is not the endpoint's target, don't present the target's client certificate.
+		tokenCtx := context.WithValue(ctx, oauth2.HTTPClient, &http.Client{
+			Transport: base,
+			Timeout:   oauth2TokenRequestTimeout,
+		})
+		transport = &oauth2.Transport{
+			Source: cfg.TokenSource(tokenCtx),
+			Base:   transport,
+		}
+	default:
+		return nil, fmt.Errorf("unexpected outbound auth token type: %T", variant)
+	}
+	return transport, nil
+}
+
+// withClientCertificate returns a copy of the given transport that presents a client certificate loaded from the
+// secret store. The certificate is reloaded on every handshake to pick up rotated secrets.
+func withClientCertificate(
+	ctx context.Context,
+	transport http.RoundTripper,
+	mtls *persistencespb.NexusOutboundAuth_MutualTLS,
+	store SecretStore,
+) (http.RoundTripper, error) {
+	httpTransport, ok := transport.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("mutual TLS requires an *http.Transport, got: %T", transport)
+	}
+	loadCertificate := func(ctx context.Context) (*tls.Certificate, error) {
+		certPEM, err := store.GetSecret(ctx, mtls.GetCertSecretName())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get client certificate: %w", err)
+		}
+		keyPEM, err := store.GetSecret(ctx, mtls.GetKeySecretName())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get client key: %w", err)
+		}
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate: %w", err)
+		}
+		return &cert, nil
+	}
+	// Fail early on misconfiguration instead of on the first handshake.
+	if _, err := loadCertificate(ctx); err != nil {
+		return nil, err
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if httpTransport.TLSClientConfig != nil {
+		tlsConfig = httpTransport.TLSClientConfig.Clone()
+	}
+	if mtls.GetCaSecretName() != "" {
+		caPEM, err := store.GetSecret(ctx, mtls.GetCaSecretName())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get CA certificates: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, errors.New("no valid CA certificates found")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	tlsConfig.GetClientCertificate = func(info *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+		return loadCertificate(info.Context())
+	}
+
+	httpTransport = httpTransport.Clone()
+	httpTransport.TLSClientConfig = tlsConfig
+	return httpTransport, nil
+}
+
+// bearerTokenRoundTripper sets a token from the secret store in the Authorization header of every request. The token
+// is read per request to pick up rotated secrets.
+type bearerTokenRoundTripper struct {
+	base       http.RoundTripper
+	store      SecretStore
+	secretName string
+}
+
+func (t bearerTokenRoundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	token, err := t.store.GetSecret(r.Context(), t.secretName)
+	if err != nil {
+		if r.Body != nil {
+			_ = r.Body.Close()
+		}
+		return nil, fmt.Errorf("failed to get bearer token: %w", err)
+	}
+	r = r.Clone(r.Context())
+	r.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+	return t.base.RoundTrip(r)
+}
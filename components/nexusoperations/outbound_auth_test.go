@@ -0,0 +1,228 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nexusoperations
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+)
+
+type mapSecretStore map[string][]byte
+
+func (s mapSecretStore) GetSecret(_ context.Context, name string) ([]byte, error) {
+	secret, ok := s[name]
+	if !ok {
+		return nil, fmt.Errorf("secret %q not found", name)
+	}
+	return secret, nil
+}
+
+func newTestTransport() http.RoundTripper {
+	return http.DefaultTransport.(*http.Transport).Clone()
+}
+
+func TestOutboundAuth_BearerToken(t *testing.T) {
+	var authorization string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+	}))
+	defer srv.Close()
+
+	store := mapSecretStore{"token": []byte("secret-token\n")}
+	transport, err := newOutboundAuthTransport(newTestTransport(), &persistencespb.NexusOutboundAuth{
+		Token: &persistencespb.NexusOutboundAuth_BearerToken_{
+			BearerToken: &persistencespb.NexusOutboundAuth_BearerToken{TokenSecretName: "token"},
+		},
+	}, store)
+	require.NoError(t, err)
+	client := &http.Client{Transport: transport}
+
+	resp, err := client.Get(srv.URL)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, "Bearer secret-token", authorization)
+
+	// tokens are read per request
+	store["token"] = []byte("rotated-token")
+	resp, err = client.Get(srv.URL)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, "Bearer rotated-token", authorization)
+
+	delete(store, "token")
+	_, err = client.Get(srv.URL)
+	require.ErrorContains(t, err, "failed to get bearer token")
+}
+
+func TestOutboundAuth_OAuth2ClientCredentials(t *testing.T) {
+	tokenRequests := 0
+	tokenSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokenRequests++
+		id, secret, ok := r.BasicAuth()
+		if !ok || id != "client" || secret != "client-secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+		require.Equal(t, "nexus", r.PostForm.Get("scope"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"access-token","token_type":"bearer","expires_in":3600}`))
+	}))
+	defer tokenSrv.Close()
+
+	var authorization string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+	}))
+	defer srv.Close()
+
+	transport, err := newOutboundAuthTransport(newTestTransport(), &persistencespb.NexusOutboundAuth{
+		Token: &persistencespb.NexusOutboundAuth_Oauth2ClientCredentials{
+			Oauth2ClientCredentials: &persistencespb.NexusOutboundAuth_OAuth2ClientCredentials{
+				TokenUrl:         tokenSrv.URL,
+				ClientId:         "client",
+				ClientSecretName: "client-secret",
+				Scopes:           []string{"nexus"},
+			},
+		},
+	}, mapSecretStore{"client-secret": []byte("client-secret")})
+	require.NoError(t, err)
+	client := &http.Client{Transport: transport}
+
+	for i := 0; i < 2; i++ {
+		resp, err := client.Get(srv.URL)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, "Bearer access-token", authorization)
+	}
+	// the token is reused until it expires
+	require.Equal(t, 1, tokenRequests)
+
+	_, err = newOutboundAuthTransport(newTestTransport(), &persistencespb.NexusOutboundAuth{
+		Token: &persistencespb.NexusOutboundAuth_Oauth2ClientCredentials{
+			Oauth2ClientCredentials: &persistencespb.NexusOutboundAuth_OAuth2ClientCredentials{
+				TokenUrl:         tokenSrv.URL,
+				ClientId:         "client",
+				ClientSecretName: "missing",
+			},
+		},
+	}, mapSecretStore{})
+	require.ErrorContains(t, err, "failed to get OAuth2 client secret")
+}
+
+func TestOutboundAuth_MutualTLS(t *testing.T) {
+	var clientCommonName string
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientCommonName = r.TLS.PeerCertificates[0].Subject.CommonName
+	}))
+	srv.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	srv.StartTLS()
+	defer srv.Close()
+
+	certPEM, keyPEM := generateClientCertificate(t, "nexus-caller")
+	store := mapSecretStore{
+		"cert": certPEM,
+		"key":  keyPEM,
+		"ca":   pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}),
+	}
+	auth := &persistencespb.NexusOutboundAuth{
+		MutualTls: &persistencespb.NexusOutboundAuth_MutualTLS{
+			CertSecretName: "cert",
+			KeySecretName:  "key",
+			CaSecretName:   "ca",
+		},
+	}
+	transport, err := newOutboundAuthTransport(newTestTransport(), auth, store)
+	require.NoError(t, err)
+
+	resp, err := (&http.Client{Transport: transport}).Get(srv.URL)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, "nexus-caller", clientCommonName)
+
+	delete(store, "key")
+	_, err = newOutboundAuthTransport(newTestTransport(), auth, store)
+	require.ErrorContains(t, err, "failed to get client key")
+
+	_, err = newOutboundAuthTransport(http.NewFileTransport(http.Dir(t.TempDir())), auth, store)
+	require.ErrorContains(t, err, "mutual TLS requires an *http.Transport")
+}
+
+func TestFileSecretStore(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "token"), []byte("secret"), 0o600))
+
+	store := fileSecretStore{directory: dynamicconfig.GetStringPropertyFn("")}
+	_, err := store.GetSecret(context.Background(), "token")
+	require.ErrorContains(t, err, "not configured")
+
+	store = fileSecretStore{directory: dynamicconfig.GetStringPropertyFn(dir)}
+	secret, err := store.GetSecret(context.Background(), "token")
+	require.NoError(t, err)
+	require.Equal(t, []byte("secret"), secret)
+
+	for _, name := range []string{"", "..", "../token", "nested/token"} {
+		_, err = store.GetSecret(context.Background(), name)
+		require.ErrorContains(t, err, "invalid secret name")
+	}
+}
+
+func generateClientCertificate(t *testing.T, commonName string) (certPEM, keyPEM []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
@@ -0,0 +1,60 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nexusoperations
+
+import (
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	commonnexus "go.temporal.io/server/common/nexus"
+)
+
+// PayloadSizeChecker checks Nexus operation payloads against the configured size limits before they are recorded in
+// history and records their size per namespace and endpoint.
+type PayloadSizeChecker struct {
+	config         *Config
+	metricsHandler metrics.Handler
+	logger         log.Logger
+}
+
+func PayloadSizeCheckerProvider(config *Config, metricsHandler metrics.Handler, logger log.Logger) *PayloadSizeChecker {
+	return &PayloadSizeChecker{
+		config:         config,
+		metricsHandler: metricsHandler,
+		logger:         logger,
+	}
+}
+
+// Check returns [commonnexus.ErrPayloadSizeExceedsLimit] if size exceeds the namespace's error limit.
+func (c *PayloadSizeChecker) Check(namespaceName, endpointName, payloadType string, size int) error {
+	return commonnexus.CheckPayloadSizeLimit(
+		size,
+		c.config.PayloadSizeWarnLimit(namespaceName),
+		c.config.PayloadSizeLimit(namespaceName),
+		payloadType,
+		c.metricsHandler.WithTags(metrics.NamespaceTag(namespaceName), metrics.NexusEndpointTag(endpointName)),
+		log.With(c.logger, tag.WorkflowNamespace(namespaceName), tag.Endpoint(endpointName)),
+	)
+}
@@ -414,12 +414,13 @@ var TransitionStarted = hsm.NewTransition(
 		op.recordAttempt(event.Time)
 		op.OperationId = event.Attributes.OperationId
 
-		// If cancelation is requested already, schedule sending the cancelation request.
+		// If cancelation is requested already, schedule sending the buffered cancelation request now that the
+		// operation ID is known.
 		child, err := op.CancelationNode(event.Node)
 		if err != nil {
 			return hsm.TransitionOutput{}, err
 		}
-		if child != nil {
+		if child != nil && op.OperationId != "" {
 			return hsm.TransitionOutput{}, hsm.MachineTransition(child, func(c Cancelation) (hsm.TransitionOutput, error) {
 				return TransitionCancelationScheduled.Apply(c, EventCancelationScheduled{
 					Time: event.Time,
@@ -453,8 +454,9 @@ var TransitionTimedOut = hsm.NewTransition(
 // Cancel marks the Operation machine as canceled by spawning a child Cancelation machine. If the
 // Operation already completed, then the Operation cannot be canceled anymore, and the Cancelation
 // machine will stay in UNSPECIFIED state. If the Operation is in STARTED state, then transition the
-// Cancelation machine to the SCHEDULED state. Otherwise, the Cancelation machine will wait the
-// Operation machine transition to the STARTED state.
+// Cancelation machine to the SCHEDULED state. Otherwise, the cancelation is buffered: the Cancelation
+// machine waits for the Operation machine to transition to the STARTED state, at which point the
+// operation ID is known and the cancelation request can be delivered.
 func (o Operation) Cancel(node *hsm.Node, t time.Time) (hsm.TransitionOutput, error) {
 	child, err := node.AddChild(CancelationMachineKey, Cancelation{
 		NexusOperationCancellationInfo: &persistencespb.NexusOperationCancellationInfo{},
@@ -464,7 +466,7 @@ func (o Operation) Cancel(node *hsm.Node, t time.Time) (hsm.TransitionOutput, er
 		// more than once.
 		return hsm.TransitionOutput{}, err
 	}
-	if o.State() != enumsspb.NEXUS_OPERATION_STATE_STARTED {
+	if o.State() != enumsspb.NEXUS_OPERATION_STATE_STARTED || o.OperationId == "" {
 		// Operation hasn't started yet or has already completed. Either way, cannot schedule
 		// cancelation.
 		return hsm.TransitionOutput{}, nil
@@ -637,6 +637,39 @@ func TestCancelationBeforeStarted(t *testing.T) {
 	require.Equal(t, enumspb.NEXUS_OPERATION_CANCELLATION_STATE_SCHEDULED, cancelation.State())
 }
 
+func TestCancelationBufferedUntilOperationIDKnown(t *testing.T) {
+	backend := &hsmtest.NodeBackend{}
+	root := newOperationNode(t, backend, mustNewScheduledEvent(time.Now(), 0))
+	require.NoError(t, hsm.MachineTransition(root, func(op nexusoperations.Operation) (hsm.TransitionOutput, error) {
+		return op.Cancel(root, time.Now())
+	}))
+	root.ClearTransactionState()
+
+	// A started operation without an ID cannot be canceled, keep the cancelation buffered.
+	require.NoError(t, hsm.MachineTransition(root, func(op nexusoperations.Operation) (hsm.TransitionOutput, error) {
+		return nexusoperations.TransitionStarted.Apply(op, nexusoperations.EventStarted{
+			Time:       time.Now(),
+			Node:       root,
+			Attributes: &historypb.NexusOperationStartedEventAttributes{},
+		})
+	}))
+	opLog, err := root.Parent.Outputs()
+	require.NoError(t, err)
+	for _, output := range opLog {
+		transitionOp, ok := output.(hsm.TransitionOperation)
+		require.True(t, ok)
+		for _, task := range transitionOp.Output.Tasks {
+			require.NotEqual(t, nexusoperations.TaskTypeCancelation, task.Type())
+		}
+	}
+
+	node, err := root.Child([]hsm.Key{nexusoperations.CancelationMachineKey})
+	require.NoError(t, err)
+	cancelation, err := hsm.MachineData[nexusoperations.Cancelation](node)
+	require.NoError(t, err)
+	require.Equal(t, enumspb.NEXUS_OPERATION_CANCELLATION_STATE_UNSPECIFIED, cancelation.State())
+}
+
 func TestOperationCompareState(t *testing.T) {
 	reg := hsm.NewRegistry()
 	require.NoError(t, nexusoperations.RegisterStateMachines(reg))
@@ -44,8 +44,9 @@ import (
 )
 
 type commandHandler struct {
-	config           *nexusoperations.Config
-	endpointRegistry commonnexus.EndpointRegistry
+	config             *nexusoperations.Config
+	endpointRegistry   commonnexus.EndpointRegistry
+	payloadSizeChecker *nexusoperations.PayloadSizeChecker
 }
 
 func (ch *commandHandler) HandleScheduleCommand(
@@ -119,7 +120,8 @@ func (ch *commandHandler) HandleScheduleCommand(
 		}
 	}
 
-	if !validator.IsValidPayloadSize(attrs.Input.Size()) {
+	inputSizeErr := ch.payloadSizeChecker.Check(nsName, attrs.Endpoint, commonnexus.PayloadTypeInput, attrs.Input.Size())
+	if inputSizeErr != nil || !validator.IsValidPayloadSize(attrs.Input.Size()) {
 		return workflow.FailWorkflowTaskError{
 			Cause:             enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_SCHEDULE_NEXUS_OPERATION_ATTRIBUTES,
 			Message:           "ScheduleNexusOperationCommandAttributes.Input exceeds size limit",
@@ -155,6 +157,13 @@ func (ch *commandHandler) HandleScheduleCommand(
 		}
 	}
 
+	// Apply the endpoint's default timeout if the caller didn't set one.
+	if attrs.ScheduleToCloseTimeout.AsDuration() == 0 {
+		if defaultTimeout := endpoint.GetEndpoint().GetSpec().GetOperationDefaults().GetScheduleToCloseTimeout(); defaultTimeout.AsDuration() > 0 {
+			attrs.ScheduleToCloseTimeout = defaultTimeout
+		}
+	}
+
 	// Trim timeout to workflow run timeout.
 	runTimeout := ms.GetExecutionInfo().WorkflowRunTimeout.AsDuration()
 	opTimeout := attrs.ScheduleToCloseTimeout.AsDuration()
@@ -263,8 +272,13 @@ func (ch *commandHandler) HandleCancelCommand(
 	return err
 }
 
-func RegisterCommandHandlers(reg *workflow.CommandHandlerRegistry, endpointRegistry commonnexus.EndpointRegistry, config *nexusoperations.Config) error {
-	h := commandHandler{config: config, endpointRegistry: endpointRegistry}
+func RegisterCommandHandlers(
+	reg *workflow.CommandHandlerRegistry,
+	endpointRegistry commonnexus.EndpointRegistry,
+	config *nexusoperations.Config,
+	payloadSizeChecker *nexusoperations.PayloadSizeChecker,
+) error {
+	h := commandHandler{config: config, endpointRegistry: endpointRegistry, payloadSizeChecker: payloadSizeChecker}
 	if err := reg.Register(enumspb.COMMAND_TYPE_SCHEDULE_NEXUS_OPERATION, h.HandleScheduleCommand); err != nil {
 		return err
 	}
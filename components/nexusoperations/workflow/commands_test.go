@@ -37,6 +37,8 @@ import (
 	"go.temporal.io/api/serviceerror"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/nexus/nexustest"
 	"go.temporal.io/server/components/nexusoperations"
@@ -74,20 +76,37 @@ var defaultConfig = &nexusoperations.Config{
 	DisallowedOperationHeaders:         dynamicconfig.GetTypedPropertyFnFilteredByNamespace([]string{"request-timeout"}),
 	MaxOperationScheduleToCloseTimeout: dynamicconfig.GetDurationPropertyFnFilteredByNamespace(time.Hour * 24),
 	EndpointNotFoundAlwaysNonRetryable: dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
+	PayloadSizeLimit:                   dynamicconfig.GetIntPropertyFnFilteredByNamespace(2 * 1024 * 1024),
+	PayloadSizeWarnLimit:               dynamicconfig.GetIntPropertyFnFilteredByNamespace(512 * 1024),
 }
 
 func newTestContext(t *testing.T, cfg *nexusoperations.Config) testContext {
 	endpointReg := nexustest.FakeEndpointRegistry{
 		OnGetByName: func(ctx context.Context, namespaceID namespace.ID, endpointName string) (*persistencespb.NexusEndpointEntry, error) {
-			if endpointName != "endpoint" {
+			switch endpointName {
+			case "endpoint":
+				// Only the ID is taken here.
+				return &persistencespb.NexusEndpointEntry{Id: "endpoint-id"}, nil
+			case "endpoint-with-defaults":
+				return &persistencespb.NexusEndpointEntry{
+					Id: "endpoint-with-defaults-id",
+					Endpoint: &persistencespb.NexusEndpoint{
+						Spec: &persistencespb.NexusEndpointSpec{
+							Name: endpointName,
+							OperationDefaults: &persistencespb.NexusOperationDefaults{
+								ScheduleToCloseTimeout: durationpb.New(10 * time.Minute),
+							},
+						},
+					},
+				}, nil
+			default:
 				return nil, serviceerror.NewNotFound("endpoint not found")
 			}
-			// Only the ID is taken here.
-			return &persistencespb.NexusEndpointEntry{Id: "endpoint-id"}, nil
 		},
 	}
 	chReg := workflow.NewCommandHandlerRegistry()
-	require.NoError(t, opsworkflow.RegisterCommandHandlers(chReg, endpointReg, cfg))
+	payloadSizeChecker := nexusoperations.PayloadSizeCheckerProvider(cfg, metrics.NoopMetricsHandler, log.NewNoopLogger())
+	require.NoError(t, opsworkflow.RegisterCommandHandlers(chReg, endpointReg, cfg, payloadSizeChecker))
 	smReg := hsm.NewRegistry()
 	require.NoError(t, workflow.RegisterStateMachine(smReg))
 	require.NoError(t, nexusoperations.RegisterStateMachines(smReg))
@@ -286,6 +305,30 @@ func TestHandleScheduleCommand(t *testing.T) {
 		require.Equal(t, 0, len(tcx.history.Events))
 	})
 
+	t.Run("exceeds nexus payload size limit", func(t *testing.T) {
+		cfg := *defaultConfig
+		cfg.PayloadSizeLimit = dynamicconfig.GetIntPropertyFnFilteredByNamespace(1)
+		cfg.PayloadSizeWarnLimit = dynamicconfig.GetIntPropertyFnFilteredByNamespace(1)
+		tcx := newTestContext(t, &cfg)
+		err := tcx.scheduleHandler(context.Background(), tcx.ms, commandValidator{maxPayloadSize: 100}, 1, &commandpb.Command{
+			Attributes: &commandpb.Command_ScheduleNexusOperationCommandAttributes{
+				ScheduleNexusOperationCommandAttributes: &commandpb.ScheduleNexusOperationCommandAttributes{
+					Endpoint:  "endpoint",
+					Service:   "service",
+					Operation: "op",
+					Input: &commonpb.Payload{
+						Data: []byte("ab"),
+					},
+				},
+			},
+		})
+		var failWFTErr workflow.FailWorkflowTaskError
+		require.ErrorAs(t, err, &failWFTErr)
+		require.True(t, failWFTErr.TerminateWorkflow)
+		require.Equal(t, enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_SCHEDULE_NEXUS_OPERATION_ATTRIBUTES, failWFTErr.Cause)
+		require.Equal(t, 0, len(tcx.history.Events))
+	})
+
 	t.Run("exceeds max concurrent operations", func(t *testing.T) {
 		tcx := newTestContext(t, defaultConfig)
 		for i := 0; i < 2; i++ {
@@ -384,6 +427,48 @@ func TestHandleScheduleCommand(t *testing.T) {
 			expectedTimeout:    durationpb.New(time.Second),
 		},
 	}
+	endpointDefaultTimeoutCases := []struct {
+		name               string
+		workflowRunTimeout *durationpb.Duration
+		commandTimeout     *durationpb.Duration
+		expectedTimeout    *durationpb.Duration
+	}{
+		{
+			name:            "operation timeout defaults to endpoint default timeout",
+			expectedTimeout: durationpb.New(10 * time.Minute),
+		},
+		{
+			name:            "operation timeout overrides endpoint default timeout",
+			commandTimeout:  durationpb.New(time.Hour),
+			expectedTimeout: durationpb.New(time.Hour),
+		},
+		{
+			name:               "endpoint default timeout trimmed to workflow run timeout",
+			workflowRunTimeout: durationpb.New(time.Minute),
+			expectedTimeout:    durationpb.New(time.Minute),
+		},
+	}
+	for _, tc := range endpointDefaultTimeoutCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tcx := newTestContext(t, defaultConfig)
+
+			tcx.ms.GetExecutionInfo().WorkflowRunTimeout = tc.workflowRunTimeout
+			err := tcx.scheduleHandler(context.Background(), tcx.ms, commandValidator{maxPayloadSize: 1}, 1, &commandpb.Command{
+				Attributes: &commandpb.Command_ScheduleNexusOperationCommandAttributes{
+					ScheduleNexusOperationCommandAttributes: &commandpb.ScheduleNexusOperationCommandAttributes{
+						Endpoint:               "endpoint-with-defaults",
+						Service:                "service",
+						Operation:              "op",
+						ScheduleToCloseTimeout: tc.commandTimeout,
+					},
+				},
+			})
+			require.NoError(t, err)
+			require.Equal(t, 1, len(tcx.history.Events))
+			require.Equal(t, tc.expectedTimeout.AsDuration(), tcx.history.Events[0].GetNexusOperationScheduledEventAttributes().ScheduleToCloseTimeout.AsDuration())
+		})
+	}
+
 	for _, tc := range timeoutCases {
 		t.Run(tc.name, func(t *testing.T) {
 			tcx := newTestContext(t, defaultConfig)
@@ -44,6 +44,8 @@ CREATE TABLE executions_visibility (
   TemporalNamespaceDivision     VARCHAR(255)  GENERATED ALWAYS AS (search_attributes->>'TemporalNamespaceDivision')               STORED,
   BuildIds                      JSONB         GENERATED ALWAYS AS (search_attributes->'BuildIds')                                 STORED,
   TemporalPauseInfo            JSONB         GENERATED ALWAYS AS (search_attributes->'TemporalPauseInfo')                       STORED,
+  TemporalReportedProblems     JSONB         GENERATED ALWAYS AS (search_attributes->'TemporalReportedProblems')                STORED,
+  TemporalPendingNexusOperations JSONB       GENERATED ALWAYS AS (search_attributes->'TemporalPendingNexusOperations')          STORED,
 
   -- Pre-allocated custom search attributes
   Bool01          BOOLEAN         GENERATED ALWAYS AS ((search_attributes->'Bool01')::boolean)        STORED,
@@ -98,6 +100,8 @@ CREATE INDEX by_temporal_change_version       ON executions_visibility USING GIN
 CREATE INDEX by_binary_checksums              ON executions_visibility USING GIN (namespace_id, BinaryChecksums jsonb_path_ops);
 CREATE INDEX by_build_ids                     ON executions_visibility USING GIN (namespace_id, BuildIds jsonb_path_ops);
 CREATE INDEX by_temporal_pause_info           ON executions_visibility USING GIN (namespace_id, TemporalPauseInfo jsonb_path_ops);
+CREATE INDEX by_temporal_reported_problems    ON executions_visibility USING GIN (namespace_id, TemporalReportedProblems jsonb_path_ops);
+CREATE INDEX by_temporal_pending_nexus_operations ON executions_visibility USING GIN (namespace_id, TemporalPendingNexusOperations jsonb_path_ops);
 CREATE INDEX by_batcher_user                  ON executions_visibility (namespace_id, BatcherUser,                (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
 CREATE INDEX by_temporal_scheduled_start_time ON executions_visibility (namespace_id, TemporalScheduledStartTime, (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
 CREATE INDEX by_temporal_scheduled_by_id      ON executions_visibility (namespace_id, TemporalScheduledById,      (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
@@ -0,0 +1,2 @@
+ALTER TABLE executions_visibility ADD COLUMN TemporalPendingNexusOperations JSONB GENERATED ALWAYS AS (search_attributes->'TemporalPendingNexusOperations') STORED;
+CREATE INDEX by_temporal_pending_nexus_operations ON executions_visibility USING GIN (namespace_id, TemporalPendingNexusOperations jsonb_path_ops);
@@ -30,6 +30,8 @@ CREATE TABLE executions_visibility (
   TemporalNamespaceDivision     VARCHAR(255)  GENERATED ALWAYS AS (JSON_EXTRACT(search_attributes, "$.TemporalNamespaceDivision")),
   BuildIds                      TEXT          GENERATED ALWAYS AS (JSON_EXTRACT(search_attributes, "$.BuildIds"))              STORED,
   TemporalPauseInfo            TEXT          GENERATED ALWAYS AS (JSON_EXTRACT(search_attributes, "$.TemporalPauseInfo"))    STORED,
+  TemporalReportedProblems     TEXT          GENERATED ALWAYS AS (JSON_EXTRACT(search_attributes, "$.TemporalReportedProblems")) STORED,
+  TemporalPendingNexusOperations TEXT        GENERATED ALWAYS AS (JSON_EXTRACT(search_attributes, "$.TemporalPendingNexusOperations")) STORED,
 
   -- Pre-allocated custom search attributes
   Bool01          BOOLEAN         GENERATED ALWAYS AS (JSON_EXTRACT(search_attributes, "$.Bool01")),
@@ -86,6 +88,8 @@ CREATE INDEX by_temporal_scheduled_by_id      ON executions_visibility (namespac
 CREATE INDEX by_temporal_schedule_paused      ON executions_visibility (namespace_id, TemporalSchedulePaused,     (COALESCE(close_time, '9999-12-31 23:59:59+00:00')) DESC, start_time DESC, run_id);
 CREATE INDEX by_temporal_namespace_division   ON executions_visibility (namespace_id, TemporalNamespaceDivision,  (COALESCE(close_time, '9999-12-31 23:59:59+00:00')) DESC, start_time DESC, run_id);
 CREATE INDEX by_temporal_pause_info           ON executions_visibility (namespace_id, TemporalPauseInfo,          (COALESCE(close_time, '9999-12-31 23:59:59+00:00')) DESC, start_time DESC, run_id);
+CREATE INDEX by_temporal_reported_problems    ON executions_visibility (namespace_id, TemporalReportedProblems,   (COALESCE(close_time, '9999-12-31 23:59:59+00:00')) DESC, start_time DESC, run_id);
+CREATE INDEX by_temporal_pending_nexus_operations ON executions_visibility (namespace_id, TemporalPendingNexusOperations, (COALESCE(close_time, '9999-12-31 23:59:59+00:00')) DESC, start_time DESC, run_id);
 
 
 -- Indexes for the pre-allocated custom search attributes
@@ -131,6 +135,8 @@ CREATE VIRTUAL TABLE executions_visibility_fts_keyword_list USING fts5 (
   BinaryChecksums,
   BuildIds,
   TemporalPauseInfo,
+  TemporalReportedProblems,
+  TemporalPendingNexusOperations,
   KeywordList01,
   KeywordList02,
   KeywordList03,
@@ -159,6 +165,8 @@ BEGIN
     BinaryChecksums,
     BuildIds,
     TemporalPauseInfo,
+    TemporalReportedProblems,
+    TemporalPendingNexusOperations,
     KeywordList01,
     KeywordList02,
     KeywordList03
@@ -168,6 +176,8 @@ BEGIN
     NEW.BinaryChecksums,
     NEW.BuildIds,
     NEW.TemporalPauseInfo,
+    NEW.TemporalReportedProblems,
+    NEW.TemporalPendingNexusOperations,
     NEW.KeywordList01,
     NEW.KeywordList02,
     NEW.KeywordList03
@@ -198,6 +208,8 @@ BEGIN
     BinaryChecksums,
     BuildIds,
     TemporalPauseInfo,
+    TemporalReportedProblems,
+    TemporalPendingNexusOperations,
     KeywordList01,
     KeywordList02,
     KeywordList03
@@ -208,6 +220,8 @@ BEGIN
     OLD.BinaryChecksums,
     OLD.BuildIds,
     OLD.TemporalPauseInfo,
+    OLD.TemporalReportedProblems,
+    OLD.TemporalPendingNexusOperations,
     OLD.KeywordList01,
     OLD.KeywordList02,
     OLD.KeywordList03
@@ -249,6 +263,8 @@ BEGIN
     BinaryChecksums,
     BuildIds,
     TemporalPauseInfo,
+    TemporalReportedProblems,
+    TemporalPendingNexusOperations,
     KeywordList01,
     KeywordList02,
     KeywordList03
@@ -259,6 +275,8 @@ BEGIN
     OLD.BinaryChecksums,
     OLD.BuildIds,
     OLD.TemporalPauseInfo,
+    OLD.TemporalReportedProblems,
+    OLD.TemporalPendingNexusOperations,
     OLD.KeywordList01,
     OLD.KeywordList02,
     OLD.KeywordList03
@@ -269,6 +287,8 @@ BEGIN
     BinaryChecksums,
     BuildIds,
     TemporalPauseInfo,
+    TemporalReportedProblems,
+    TemporalPendingNexusOperations,
     KeywordList01,
     KeywordList02,
     KeywordList03
@@ -278,6 +298,8 @@ BEGIN
     NEW.BinaryChecksums,
     NEW.BuildIds,
     NEW.TemporalPauseInfo,
+    NEW.TemporalReportedProblems,
+    NEW.TemporalPendingNexusOperations,
     NEW.KeywordList01,
     NEW.KeywordList02,
     NEW.KeywordList03
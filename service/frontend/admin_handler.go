@@ -25,23 +25,30 @@
 package frontend
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
 	"io"
 	"maps"
+	"math"
 	"net"
+	"slices"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/pborman/uuid"
+	"github.com/temporalio/sqlparser"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 	namespacepb "go.temporal.io/api/namespace/v1"
+	"go.temporal.io/api/operatorservice/v1"
 	replicationpb "go.temporal.io/api/replication/v1"
 	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	sdkclient "go.temporal.io/sdk/client"
@@ -52,26 +59,36 @@ import (
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
+	nexusspb "go.temporal.io/server/api/nexus/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
+	tokenspb "go.temporal.io/server/api/token/v1"
 	serverClient "go.temporal.io/server/client"
 	"go.temporal.io/server/client/admin"
 	"go.temporal.io/server/client/frontend"
 	"go.temporal.io/server/client/history"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/channel"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/debugtrace"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/dynamicconfig/runtimeoverride"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
@@ -85,9 +102,16 @@ import (
 	"go.temporal.io/server/common/xdc"
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/worker/addsearchattributes"
+	"go.temporal.io/server/service/worker/batcher"
 	"go.temporal.io/server/service/worker/dlq"
+	"go.temporal.io/server/service/worker/rearchival"
+	"go.temporal.io/server/service/worker/scheduler"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -95,6 +119,22 @@ const (
 	getNamespaceReplicationMessageBatchSize = 100
 	defaultLastMessageID                    = -1
 	listClustersPageSize                    = 100
+
+	describeArchivalStatusConcurrency = 16
+	describeArchivalStatusPageSize    = 1000
+
+	exportNamespaceConfigPageSize = 100
+
+	exportWorkflowExecutionsListPageSize    = 100
+	exportWorkflowExecutionsHistoryPageSize = 100
+
+	updateClusterMetadataPageSize              = 1000
+	updateClusterMetadataMaxReportedNamespaces = 10
+
+	describeReplicationLagConcurrency = 16
+	describeReplicationLagPageSize    = 1000
+
+	defaultHotspotLimit = 10
 )
 
 type (
@@ -104,33 +144,42 @@ type (
 
 		status int32
 
-		logger                     log.Logger
-		numberOfHistoryShards      int32
-		ESClient                   esclient.Client
-		config                     *Config
-		namespaceDLQHandler        namespace.DLQMessageHandler
-		eventSerializer            serialization.Serializer
-		visibilityMgr              manager.VisibilityManager
-		persistenceExecutionName   string
-		namespaceReplicationQueue  persistence.NamespaceReplicationQueue
-		taskManager                persistence.TaskManager
-		clusterMetadataManager     persistence.ClusterMetadataManager
-		persistenceMetadataManager persistence.MetadataManager
-		clientFactory              serverClient.Factory
-		clientBean                 serverClient.Bean
-		historyClient              historyservice.HistoryServiceClient
-		sdkClientFactory           sdk.ClientFactory
-		membershipMonitor          membership.Monitor
-		hostInfoProvider           membership.HostInfoProvider
-		metricsHandler             metrics.Handler
-		namespaceRegistry          namespace.Registry
-		saProvider                 searchattribute.Provider
-		saManager                  searchattribute.Manager
-		saMapperProvider           searchattribute.MapperProvider
-		saValidator                *searchattribute.Validator
-		clusterMetadata            cluster.Metadata
-		healthServer               *health.Server
-		historyHealthChecker       HealthChecker
+		logger                    log.Logger
+		numberOfHistoryShards     int32
+		ESClient                  esclient.Client
+		config                    *Config
+		namespaceDLQHandler       namespace.DLQMessageHandler
+		eventSerializer           serialization.Serializer
+		visibilityMgr             manager.VisibilityManager
+		persistenceExecutionName  string
+		namespaceReplicationQueue persistence.NamespaceReplicationQueue
+		// replicatorNamespaceReplicationQueue is nil if global namespaces are disabled.
+		replicatorNamespaceReplicationQueue persistence.NamespaceReplicationQueue
+		taskManager                         persistence.TaskManager
+		clusterMetadataManager              persistence.ClusterMetadataManager
+		persistenceMetadataManager          persistence.MetadataManager
+		clientFactory                       serverClient.Factory
+		clientBean                          serverClient.Bean
+		historyClient                       historyservice.HistoryServiceClient
+		sdkClientFactory                    sdk.ClientFactory
+		membershipMonitor                   membership.Monitor
+		hostInfoProvider                    membership.HostInfoProvider
+		metricsHandler                      metrics.Handler
+		namespaceRegistry                   namespace.Registry
+		saProvider                          searchattribute.Provider
+		saManager                           searchattribute.Manager
+		saMapperProvider                    searchattribute.MapperProvider
+		saValidator                         *searchattribute.Validator
+		clusterMetadata                     cluster.Metadata
+		healthServer                        *health.Server
+		historyHealthChecker                HealthChecker
+		archivalMetadata                    archiver.ArchivalMetadata
+		nexusEndpointClient                 *NexusEndpointClient
+		approvalGate                        *ApprovalGate
+		profileCapturer                     *ProfileCapturer
+		dependencyHealth                    *DependencyHealthChecker
+		debugTraceStore                     *debugtrace.Store
+		dynamicConfigOverrides              *runtimeoverride.Store
 
 		// DEPRECATED: only history service on server side is supposed to
 		// use the following components.
@@ -165,6 +214,13 @@ type (
 		HealthServer                        *health.Server
 		EventSerializer                     serialization.Serializer
 		TimeSource                          clock.TimeSource
+		ArchivalMetadata                    archiver.ArchivalMetadata
+		NexusEndpointClient                 *NexusEndpointClient
+		ApprovalGate                        *ApprovalGate
+		ProfileCapturer                     *ProfileCapturer
+		DependencyHealthChecker             *DependencyHealthChecker
+		DebugTraceStore                     *debugtrace.Store
+		DynamicConfigOverrides              *runtimeoverride.Store
 
 		// DEPRECATED: only history service on server side is supposed to
 		// use the following components.
@@ -189,17 +245,10 @@ func NewAdminHandler(
 		args.Logger,
 	)
 
-	historyHealthChecker := NewHealthChecker(
-		primitives.HistoryService,
+	historyHealthChecker := newHistoryHealthChecker(
+		args.HistoryClient,
 		args.MembershipMonitor,
 		args.Config.HistoryHostErrorPercentage,
-		func(ctx context.Context, hostAddress string) (enumsspb.HealthState, error) {
-			resp, err := args.HistoryClient.DeepHealthCheck(ctx, &historyservice.DeepHealthCheckRequest{HostAddress: hostAddress})
-			if err != nil {
-				return enumsspb.HEALTH_STATE_NOT_SERVING, err
-			}
-			return resp.GetState(), nil
-		},
 		args.Logger,
 	)
 
@@ -213,25 +262,26 @@ func NewAdminHandler(
 			args.NamespaceReplicationQueue,
 			args.Logger,
 		),
-		eventSerializer:            args.EventSerializer,
-		visibilityMgr:              args.visibilityMgr,
-		ESClient:                   args.EsClient,
-		persistenceExecutionName:   args.PersistenceExecutionManager.GetName(),
-		namespaceReplicationQueue:  args.NamespaceReplicationQueue,
-		taskManager:                args.TaskManager,
-		clusterMetadataManager:     args.ClusterMetadataManager,
-		persistenceMetadataManager: args.PersistenceMetadataManager,
-		clientFactory:              args.ClientFactory,
-		clientBean:                 args.ClientBean,
-		historyClient:              args.HistoryClient,
-		sdkClientFactory:           args.sdkClientFactory,
-		membershipMonitor:          args.MembershipMonitor,
-		hostInfoProvider:           args.HostInfoProvider,
-		metricsHandler:             args.MetricsHandler,
-		namespaceRegistry:          args.NamespaceRegistry,
-		saProvider:                 args.SaProvider,
-		saManager:                  args.SaManager,
-		saMapperProvider:           args.SaMapperProvider,
+		eventSerializer:                     args.EventSerializer,
+		visibilityMgr:                       args.visibilityMgr,
+		ESClient:                            args.EsClient,
+		persistenceExecutionName:            args.PersistenceExecutionManager.GetName(),
+		namespaceReplicationQueue:           args.NamespaceReplicationQueue,
+		replicatorNamespaceReplicationQueue: args.ReplicatorNamespaceReplicationQueue,
+		taskManager:                         args.TaskManager,
+		clusterMetadataManager:              args.ClusterMetadataManager,
+		persistenceMetadataManager:          args.PersistenceMetadataManager,
+		clientFactory:                       args.ClientFactory,
+		clientBean:                          args.ClientBean,
+		historyClient:                       args.HistoryClient,
+		sdkClientFactory:                    args.sdkClientFactory,
+		membershipMonitor:                   args.MembershipMonitor,
+		hostInfoProvider:                    args.HostInfoProvider,
+		metricsHandler:                      args.MetricsHandler,
+		namespaceRegistry:                   args.NamespaceRegistry,
+		saProvider:                          args.SaProvider,
+		saManager:                           args.SaManager,
+		saMapperProvider:                    args.SaMapperProvider,
 		saValidator: searchattribute.NewValidator(
 			args.SaProvider,
 			args.SaMapperProvider,
@@ -245,11 +295,18 @@ func NewAdminHandler(
 			),
 			args.Config.SuppressErrorSetSystemSearchAttribute,
 		),
-		clusterMetadata:      args.ClusterMetadata,
-		healthServer:         args.HealthServer,
-		historyHealthChecker: historyHealthChecker,
-		taskCategoryRegistry: args.CategoryRegistry,
-		matchingClient:       args.matchingClient,
+		clusterMetadata:        args.ClusterMetadata,
+		healthServer:           args.HealthServer,
+		historyHealthChecker:   historyHealthChecker,
+		archivalMetadata:       args.ArchivalMetadata,
+		nexusEndpointClient:    args.NexusEndpointClient,
+		approvalGate:           args.ApprovalGate,
+		profileCapturer:        args.ProfileCapturer,
+		dependencyHealth:       args.DependencyHealthChecker,
+		debugTraceStore:        args.DebugTraceStore,
+		dynamicConfigOverrides: args.DynamicConfigOverrides,
+		taskCategoryRegistry:   args.CategoryRegistry,
+		matchingClient:         args.matchingClient,
 	}
 }
 
@@ -277,10 +334,13 @@ func (adh *AdminHandler) Stop() {
 
 func (adh *AdminHandler) DeepHealthCheck(
 	ctx context.Context,
-	_ *adminservice.DeepHealthCheckRequest,
+	request *adminservice.DeepHealthCheckRequest,
 ) (_ *adminservice.DeepHealthCheckResponse, retError error) {
 	defer log.CapturePanic(adh.logger, &retError)
 
+	if request.GetIncludeDependencies() {
+		return adh.dependencyHealth.Check(ctx), nil
+	}
 	healthStatus, err := adh.historyHealthChecker.Check(ctx)
 	if err != nil {
 		return nil, err
@@ -743,6 +803,7 @@ func (adh *AdminHandler) ImportWorkflowExecution(
 		HistoryBatches: unaliasedBatches,
 		VersionHistory: request.VersionHistory,
 		Token:          request.Token,
+		MutableState:   request.MutableState,
 	})
 	if err != nil {
 		return nil, err
@@ -1374,7 +1435,7 @@ func (adh *AdminHandler) GetDLQReplicationMessages(ctx context.Context, request
 	if err != nil {
 		return nil, err
 	}
-	return &adminservice.GetDLQReplicationMessagesResponse{ReplicationTasks: resp.GetReplicationTasks()}, nil
+	return &adminservice.GetDLQReplicationMessagesResponse{ReplicationTasks: adh.redactReplicationTasks(resp.GetReplicationTasks())}, nil
 }
 
 // ReapplyEvents applies stale events to the current workflow and the current run
@@ -1442,7 +1503,7 @@ func (adh *AdminHandler) GetDLQMessages(
 
 		return &adminservice.GetDLQMessagesResponse{
 			Type:                 resp.GetType(),
-			ReplicationTasks:     resp.GetReplicationTasks(),
+			ReplicationTasks:     adh.redactReplicationTasks(resp.GetReplicationTasks()),
 			ReplicationTasksInfo: resp.GetReplicationTasksInfo(),
 			NextPageToken:        resp.GetNextPageToken(),
 		}, err
@@ -1465,6 +1526,40 @@ func (adh *AdminHandler) GetDLQMessages(
 	}
 }
 
+// redactReplicationTasks removes payloads from the DLQ replication tasks of namespaces that didn't opt out of
+// payload redaction.
+func (adh *AdminHandler) redactReplicationTasks(tasks []*replicationspb.ReplicationTask) []*replicationspb.ReplicationTask {
+	if len(tasks) == 0 {
+		return tasks
+	}
+	redacted := make([]*replicationspb.ReplicationTask, len(tasks))
+	for i, task := range tasks {
+		nsName, err := adh.namespaceRegistry.GetNamespaceName(namespace.ID(replicationTaskNamespaceID(task)))
+		if err == nil && !adh.config.AdminRedactDLQPayloads(nsName.String()) {
+			redacted[i] = task
+			continue
+		}
+		redacted[i] = payloads.Redact(task).(*replicationspb.ReplicationTask)
+	}
+	return redacted
+}
+
+// replicationTaskNamespaceID returns the namespace ID of the task attributes, or an empty string if the attributes
+// don't have one.
+func replicationTaskNamespaceID(task *replicationspb.ReplicationTask) string {
+	var namespaceID string
+	task.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.ContainingOneof() == nil || fd.Message() == nil {
+			return true
+		}
+		if field := fd.Message().Fields().ByName("namespace_id"); field != nil && field.Kind() == protoreflect.StringKind {
+			namespaceID = v.Message().Get(field).String()
+		}
+		return false
+	})
+	return namespaceID
+}
+
 // PurgeDLQMessages purge messages from DLQ
 func (adh *AdminHandler) PurgeDLQMessages(
 	ctx context.Context,
@@ -1479,6 +1574,16 @@ func (adh *AdminHandler) PurgeDLQMessages(
 		request.InclusiveEndMessageId = common.EndMessageID
 	}
 
+	if err := adh.approvalGate.Check(ctx, "PurgeDLQMessages", fmt.Sprintf(
+		"%s/%d/%s/%d",
+		request.GetType(),
+		request.GetShardId(),
+		request.GetSourceCluster(),
+		request.GetInclusiveEndMessageId(),
+	)); err != nil {
+		return nil, err
+	}
+
 	switch request.GetType() {
 	case enumsspb.DEAD_LETTER_QUEUE_TYPE_REPLICATION:
 		resp, err := adh.historyClient.PurgeDLQMessages(ctx, &historyservice.PurgeDLQMessagesRequest{
@@ -1521,6 +1626,16 @@ func (adh *AdminHandler) MergeDLQMessages(
 
 	switch request.GetType() {
 	case enumsspb.DEAD_LETTER_QUEUE_TYPE_REPLICATION:
+		if len(request.GetWorkflowId()) != 0 && len(request.GetNamespace()) == 0 {
+			return nil, errNamespaceNotSet
+		}
+		var namespaceID namespace.ID
+		if len(request.GetNamespace()) != 0 {
+			namespaceID, err = adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+			if err != nil {
+				return nil, err
+			}
+		}
 		resp, err := adh.historyClient.MergeDLQMessages(ctx, &historyservice.MergeDLQMessagesRequest{
 			Type:                  request.GetType(),
 			ShardId:               request.GetShardId(),
@@ -1528,15 +1643,22 @@ func (adh *AdminHandler) MergeDLQMessages(
 			InclusiveEndMessageId: request.GetInclusiveEndMessageId(),
 			MaximumPageSize:       request.GetMaximumPageSize(),
 			NextPageToken:         request.GetNextPageToken(),
+			NamespaceId:           namespaceID.String(),
+			WorkflowId:            request.GetWorkflowId(),
+			DryRun:                request.GetDryRun(),
 		})
 		if resp == nil {
 			return nil, err
 		}
 
 		return &adminservice.MergeDLQMessagesResponse{
-			NextPageToken: request.GetNextPageToken(),
+			NextPageToken: resp.GetNextPageToken(),
+			TaskPreviews:  resp.GetTaskPreviews(),
 		}, nil
 	case enumsspb.DEAD_LETTER_QUEUE_TYPE_NAMESPACE:
+		if request.GetDryRun() || len(request.GetNamespace()) != 0 || len(request.GetWorkflowId()) != 0 {
+			return nil, errDLQMergeFilterNotSupported
+		}
 		token, err := adh.namespaceDLQHandler.Merge(
 			ctx,
 			request.GetInclusiveEndMessageId(),
@@ -1676,9 +1798,27 @@ func (adh *AdminHandler) GetTaskQueueTasks(
 		return nil, err
 	}
 
+	var taskQueueInfo *persistencespb.TaskQueueInfo
+	if len(request.NextPageToken) == 0 {
+		// Like the tasks, the metadata is read from persistence rather than from matching, so that it can be
+		// inspected when matching is unhealthy.
+		taskQueue, err := adh.taskManager.GetTaskQueue(ctx, &persistence.GetTaskQueueRequest{
+			NamespaceID: namespaceID.String(),
+			TaskQueue:   request.GetTaskQueue(),
+			TaskType:    request.GetTaskQueueType(),
+		})
+		var notFound *serviceerror.NotFound
+		if err == nil {
+			taskQueueInfo = taskQueue.TaskQueueInfo
+		} else if !errors.As(err, &notFound) {
+			return nil, err
+		}
+	}
+
 	return &adminservice.GetTaskQueueTasksResponse{
 		Tasks:         resp.Tasks,
 		NextPageToken: resp.NextPageToken,
+		TaskQueueInfo: taskQueueInfo,
 	}, nil
 }
 
@@ -1835,6 +1975,12 @@ func (adh *AdminHandler) StreamWorkflowReplicationMessages(
 	logger.Info("AdminStreamReplicationMessages started.")
 	defer logger.Info("AdminStreamReplicationMessages stopped.")
 
+	if compressor := adh.config.ReplicationStreamCompressor(); compressor != "" {
+		if err := setStreamSendCompressor(clientCluster.Context(), compressor); err != nil {
+			logger.Warn("AdminStreamReplicationMessages unable to compress messages", tag.Error(err))
+		}
+	}
+
 	historyStreamCtx, cancel := context.WithCancel(clientCluster.Context())
 	defer cancel()
 
@@ -1923,6 +2069,19 @@ func (adh *AdminHandler) StreamWorkflowReplicationMessages(
 	return nil
 }
 
+// setStreamSendCompressor compresses messages sent on the stream with the given compressor,
+// if the client advertised support for it.
+func setStreamSendCompressor(ctx context.Context, compressor string) error {
+	supported, err := grpc.ClientSupportedCompressors(ctx)
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(supported, compressor) {
+		return fmt.Errorf("compressor %q is not supported by client, supported compressors: %v", compressor, supported)
+	}
+	return grpc.SetSendCompressor(ctx, compressor)
+}
+
 func (adh *AdminHandler) GetNamespace(ctx context.Context, request *adminservice.GetNamespaceRequest) (_ *adminservice.GetNamespaceResponse, err error) {
 	defer log.CapturePanic(adh.logger, &err)
 	if request == nil || (len(request.GetId()) == 0 && len(request.GetNamespace()) == 0) {
@@ -1968,6 +2127,18 @@ func (adh *AdminHandler) GetNamespace(ctx context.Context, request *adminservice
 		IsGlobalNamespace: resp.IsGlobalNamespace,
 		FailoverHistory:   convertFailoverHistoryToReplicationProto(resp.Namespace.GetReplicationConfig().GetFailoverHistory()),
 	}
+	if replicationConfig.GetState() == enumspb.REPLICATION_STATE_HANDOVER {
+		nsResponse.HandoverProgress, err = getNamespaceHandoverProgress(
+			ctx,
+			adh.historyClient,
+			info.Name,
+			replicationConfig.GetClusters(),
+			replicationConfig.GetActiveClusterName(),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return nsResponse, nil
 }
 
@@ -1998,6 +2169,13 @@ func (adh *AdminHandler) PurgeDLQTasks(
 	}
 
 	workflowID := adh.getDLQWorkflowID(request.DlqKey)
+	if err := adh.approvalGate.Check(ctx, "PurgeDLQTasks", fmt.Sprintf(
+		"%s/%d",
+		workflowID,
+		request.GetInclusiveMaxTaskMetadata().GetMessageId(),
+	)); err != nil {
+		return nil, err
+	}
 	client := adh.sdkClientFactory.GetSystemClient()
 	run, err := client.ExecuteWorkflow(ctx, sdkclient.StartWorkflowOptions{
 		ID:        workflowID,
@@ -2259,6 +2437,2458 @@ func (adh *AdminHandler) GenerateLastHistoryReplicationTasks(
 	}, nil
 }
 
+// ReArchiveWorkflowExecutions starts the re-archival system workflow for the closed executions matching the query.
+func (adh *AdminHandler) ReArchiveWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.ReArchiveWorkflowExecutionsRequest,
+) (_ *adminservice.ReArchiveWorkflowExecutionsResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetRps() < 0 {
+		return nil, errInvalidRPS
+	}
+	if request.GetRps() > 0 && float64(request.GetRps()) < rearchival.MinRPS {
+		return nil, errReArchivalRPSTooLow
+	}
+	nsEntry, err := adh.namespaceRegistry.GetNamespace(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+	historyArchivalEnabled := adh.archivalMetadata.GetHistoryConfig().ClusterConfiguredForArchival() &&
+		nsEntry.HistoryArchivalState().State == enumspb.ARCHIVAL_STATE_ENABLED
+	visibilityArchivalEnabled := adh.archivalMetadata.GetVisibilityConfig().ClusterConfiguredForArchival() &&
+		nsEntry.VisibilityArchivalState().State == enumspb.ARCHIVAL_STATE_ENABLED
+	if !historyArchivalEnabled && !visibilityArchivalEnabled {
+		return nil, errArchivalNotEnabled
+	}
+
+	workflowID := fmt.Sprintf("%s-%s-%s", rearchival.WorkflowName, nsEntry.Name(), uuid.New())
+	run, err := adh.sdkClientFactory.GetSystemClient().ExecuteWorkflow(ctx, sdkclient.StartWorkflowOptions{
+		ID:        workflowID,
+		TaskQueue: primitives.DefaultWorkerTaskQueue,
+		Memo: map[string]interface{}{
+			"Reason": request.GetReason(),
+		},
+	}, rearchival.WorkflowName, rearchival.WorkflowParams{
+		Namespace:   nsEntry.Name().String(),
+		NamespaceID: nsEntry.ID().String(),
+		Query:       rearchival.BuildQuery(request.GetQuery()),
+		RPS:         float64(request.GetRps()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.ReArchiveWorkflowExecutionsResponse{
+		JobId: workflowID,
+		RunId: run.GetRunID(),
+	}, nil
+}
+
+// StartSignalWithStartBatchOperation starts a batch operation which signal-with-starts the given workflow IDs, or the
+// IDs of the workflows matching a visibility query on the source namespace.
+func (adh *AdminHandler) StartSignalWithStartBatchOperation(
+	ctx context.Context,
+	request *adminservice.StartSignalWithStartBatchOperationRequest,
+) (_ *adminservice.StartSignalWithStartBatchOperationResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if len(request.GetJobId()) == 0 {
+		return nil, errBatchJobIDNotSet
+	}
+	if len(request.GetReason()) == 0 {
+		return nil, errReasonNotSet
+	}
+	if len(request.GetVisibilityQuery()) == 0 && len(request.GetWorkflowIds()) == 0 {
+		return nil, errBatchOpsWorkflowFilterNotSet
+	}
+	if len(request.GetVisibilityQuery()) != 0 && len(request.GetWorkflowIds()) != 0 {
+		return nil, errBatchOpsWorkflowFiltersNotAllowed
+	}
+	if len(request.GetWorkflowIds()) > adh.config.MaxExecutionCountBatchOperation(request.GetNamespace()) {
+		return nil, errBatchOpsMaxWorkflowExecutionCount
+	}
+	if len(request.GetSignalName()) == 0 {
+		return nil, errSignalNameNotSet
+	}
+	if len(request.GetWorkflowType()) == 0 {
+		return nil, errWorkflowTypeNotSet
+	}
+	if len(request.GetTaskQueue()) == 0 {
+		return nil, errTaskQueueNotSet
+	}
+	if request.GetMaxOperationsPerSecond() < 0 {
+		return nil, errInvalidRPS
+	}
+	if !adh.config.EnableBatcher(request.GetNamespace()) {
+		return nil, errBatchAPINotAllowed
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+	sourceNamespace := request.GetSourceNamespace()
+	if sourceNamespace != "" {
+		// fail early instead of in the batch activity if the source namespace doesn't exist
+		if _, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(sourceNamespace)); err != nil {
+			return nil, err
+		}
+	}
+
+	executions := make([]*commonpb.WorkflowExecution, 0, len(request.GetWorkflowIds()))
+	for _, workflowID := range request.GetWorkflowIds() {
+		executions = append(executions, &commonpb.WorkflowExecution{WorkflowId: workflowID})
+	}
+	input := &batcher.BatchParams{
+		Namespace:  request.GetNamespace(),
+		Query:      request.GetVisibilityQuery(),
+		Executions: executions,
+		Reason:     request.GetReason(),
+		BatchType:  batcher.BatchTypeSignalWithStart,
+		RPS:        float64(request.GetMaxOperationsPerSecond()),
+		SignalWithStartParams: batcher.SignalWithStartParams{
+			SignalName:               request.GetSignalName(),
+			SignalInput:              request.GetSignalInput(),
+			WorkflowType:             request.GetWorkflowType(),
+			TaskQueue:                request.GetTaskQueue(),
+			Input:                    request.GetInput(),
+			WorkflowExecutionTimeout: request.GetWorkflowExecutionTimeout().AsDuration(),
+			WorkflowRunTimeout:       request.GetWorkflowRunTimeout().AsDuration(),
+			SourceNamespace:          sourceNamespace,
+		},
+	}
+	if err := adh.startBatchOperation(ctx, namespaceID, request.GetJobId(), request.GetIdentity(), input); err != nil {
+		return nil, err
+	}
+	return &adminservice.StartSignalWithStartBatchOperationResponse{}, nil
+}
+
+// StartRebuildMutableStateBatchOperation starts a batch operation which rebuilds the mutable state of the executions
+// matching a visibility query from their history.
+func (adh *AdminHandler) StartRebuildMutableStateBatchOperation(
+	ctx context.Context,
+	request *adminservice.StartRebuildMutableStateBatchOperationRequest,
+) (_ *adminservice.StartRebuildMutableStateBatchOperationResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if len(request.GetJobId()) == 0 {
+		return nil, errBatchJobIDNotSet
+	}
+	if len(request.GetReason()) == 0 {
+		return nil, errReasonNotSet
+	}
+	if len(request.GetVisibilityQuery()) == 0 {
+		return nil, errVisibilityQueryNotSet
+	}
+	if request.GetMaxOperationsPerSecond() < 0 {
+		return nil, errInvalidRPS
+	}
+	if !adh.config.EnableBatcher(request.GetNamespace()) {
+		return nil, errBatchAPINotAllowed
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	input := &batcher.BatchParams{
+		Namespace: request.GetNamespace(),
+		Query:     request.GetVisibilityQuery(),
+		Reason:    request.GetReason(),
+		BatchType: batcher.BatchTypeRebuildMutableState,
+		RPS:       float64(request.GetMaxOperationsPerSecond()),
+	}
+	if err := adh.startBatchOperation(ctx, namespaceID, request.GetJobId(), request.GetIdentity(), input); err != nil {
+		return nil, err
+	}
+	return &adminservice.StartRebuildMutableStateBatchOperationResponse{}, nil
+}
+
+// StartRefreshWorkflowTasksBatchOperation starts a batch operation which regenerates the tasks of the given executions,
+// or of the executions matching a visibility query.
+func (adh *AdminHandler) StartRefreshWorkflowTasksBatchOperation(
+	ctx context.Context,
+	request *adminservice.StartRefreshWorkflowTasksBatchOperationRequest,
+) (_ *adminservice.StartRefreshWorkflowTasksBatchOperationResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if len(request.GetJobId()) == 0 {
+		return nil, errBatchJobIDNotSet
+	}
+	if len(request.GetReason()) == 0 {
+		return nil, errReasonNotSet
+	}
+	if len(request.GetVisibilityQuery()) == 0 && len(request.GetExecutions()) == 0 {
+		return nil, errBatchOpsWorkflowFilterNotSet
+	}
+	if len(request.GetVisibilityQuery()) != 0 && len(request.GetExecutions()) != 0 {
+		return nil, errBatchOpsWorkflowFiltersNotAllowed
+	}
+	if len(request.GetExecutions()) > adh.config.MaxExecutionCountBatchOperation(request.GetNamespace()) {
+		return nil, errBatchOpsMaxWorkflowExecutionCount
+	}
+	for _, execution := range request.GetExecutions() {
+		if err := validateExecution(execution); err != nil {
+			return nil, err
+		}
+	}
+	if request.GetMaxOperationsPerSecond() < 0 {
+		return nil, errInvalidRPS
+	}
+	if !adh.config.EnableBatcher(request.GetNamespace()) {
+		return nil, errBatchAPINotAllowed
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	input := &batcher.BatchParams{
+		Namespace:  request.GetNamespace(),
+		Query:      request.GetVisibilityQuery(),
+		Executions: request.GetExecutions(),
+		Reason:     request.GetReason(),
+		BatchType:  batcher.BatchTypeRefreshWorkflowTasks,
+		RPS:        float64(request.GetMaxOperationsPerSecond()),
+	}
+	if err := adh.startBatchOperation(ctx, namespaceID, request.GetJobId(), request.GetIdentity(), input); err != nil {
+		return nil, err
+	}
+	return &adminservice.StartRefreshWorkflowTasksBatchOperationResponse{}, nil
+}
+
+// startBatchOperation starts a batch workflow for a batch operation started through the admin API, unless the
+// namespace already has the maximum number of batch operations running.
+func (adh *AdminHandler) startBatchOperation(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	jobID string,
+	identity string,
+	input *batcher.BatchParams,
+) error {
+	countResp, err := adh.visibilityMgr.CountWorkflowExecutions(ctx, &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: namespaceID,
+		Namespace:   namespace.Name(input.Namespace),
+		Query:       batcher.OpenBatchOperationQuery,
+	})
+	if err != nil {
+		return err
+	}
+	if int(countResp.Count) >= adh.config.MaxConcurrentBatchOperation(input.Namespace) {
+		return &serviceerror.ResourceExhausted{
+			Cause:   enumspb.RESOURCE_EXHAUSTED_CAUSE_CONCURRENT_LIMIT,
+			Scope:   enumspb.RESOURCE_EXHAUSTED_SCOPE_NAMESPACE,
+			Message: "Max concurrent batch operations is reached",
+		}
+	}
+
+	inputPayload, err := sdk.PreferProtoDataConverter.ToPayloads(input)
+	if err != nil {
+		return err
+	}
+
+	memo := &commonpb.Memo{
+		Fields: map[string]*commonpb.Payload{
+			batcher.BatchOperationTypeMemo: payload.EncodeString(input.BatchType),
+			batcher.BatchReasonMemo:        payload.EncodeString(input.Reason),
+		},
+	}
+	var searchAttributes *commonpb.SearchAttributes
+	searchattribute.AddSearchAttribute(&searchAttributes, searchattribute.BatcherUser, payload.EncodeString(identity))
+	searchattribute.AddSearchAttribute(&searchAttributes, searchattribute.TemporalNamespaceDivision, payload.EncodeString(batcher.NamespaceDivision))
+
+	startReq := &workflowservice.StartWorkflowExecutionRequest{
+		Namespace:                input.Namespace,
+		WorkflowId:               jobID,
+		WorkflowType:             &commonpb.WorkflowType{Name: batcher.BatchWFTypeName},
+		TaskQueue:                &taskqueuepb.TaskQueue{Name: primitives.PerNSWorkerTaskQueue},
+		Input:                    inputPayload,
+		Identity:                 identity,
+		RequestId:                uuid.New(),
+		WorkflowIdConflictPolicy: enumspb.WORKFLOW_ID_CONFLICT_POLICY_FAIL,
+		WorkflowIdReusePolicy:    enumspb.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
+		Memo:                     memo,
+		SearchAttributes:         searchAttributes,
+	}
+	_, err = adh.historyClient.StartWorkflowExecution(
+		ctx,
+		common.CreateHistoryStartWorkflowRequest(
+			namespaceID.String(),
+			startReq,
+			nil,
+			nil,
+			time.Now().UTC(),
+		),
+	)
+	return err
+}
+
+// CancelScheduleBackfill cancels an ongoing backfill of a schedule, or all of them if no backfill ID is given. Actions
+// the backfill already started are not affected.
+func (adh *AdminHandler) CancelScheduleBackfill(
+	ctx context.Context,
+	request *adminservice.CancelScheduleBackfillRequest,
+) (_ *adminservice.CancelScheduleBackfillResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if len(request.GetScheduleId()) == 0 {
+		return nil, errScheduleIDNotSet
+	}
+	if !adh.config.EnableSchedules(request.GetNamespace()) {
+		return nil, errSchedulesNotAllowed
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	input, err := sdk.PreferProtoDataConverter.ToPayloads(&schedulespb.CancelBackfillRequest{
+		BackfillId: request.GetBackfillId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	_, err = adh.historyClient.SignalWorkflowExecution(ctx, &historyservice.SignalWorkflowExecutionRequest{
+		NamespaceId: namespaceID.String(),
+		SignalRequest: &workflowservice.SignalWorkflowExecutionRequest{
+			Namespace:         request.GetNamespace(),
+			WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: scheduler.WorkflowIDPrefix + request.GetScheduleId()},
+			SignalName:        scheduler.SignalNameCancelBackfill,
+			Input:             input,
+			Identity:          request.GetIdentity(),
+			RequestId:         uuid.New(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.CancelScheduleBackfillResponse{}, nil
+}
+
+// UpdateSchedulePolicies signals the scheduler workflow of a schedule to replace its server side policies.
+func (adh *AdminHandler) UpdateSchedulePolicies(
+	ctx context.Context,
+	request *adminservice.UpdateSchedulePoliciesRequest,
+) (_ *adminservice.UpdateSchedulePoliciesResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if len(request.GetScheduleId()) == 0 {
+		return nil, errScheduleIDNotSet
+	}
+	if request.GetPolicies().GetPauseAfterConsecutiveFailures() < 0 {
+		return nil, errInvalidPauseAfterConsecutiveFailures
+	}
+	if _, ok := enumsspb.ScheduleDaylightSavingPolicy_name[int32(request.GetPolicies().GetDaylightSavingPolicy())]; !ok {
+		return nil, errInvalidScheduleDaylightSavingPolicy
+	}
+	if !adh.config.EnableSchedules(request.GetNamespace()) {
+		return nil, errSchedulesNotAllowed
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	policies := request.GetPolicies()
+	if policies == nil {
+		policies = &schedulespb.ServerSchedulePolicies{}
+	}
+	input, err := sdk.PreferProtoDataConverter.ToPayloads(policies)
+	if err != nil {
+		return nil, err
+	}
+	_, err = adh.historyClient.SignalWorkflowExecution(ctx, &historyservice.SignalWorkflowExecutionRequest{
+		NamespaceId: namespaceID.String(),
+		SignalRequest: &workflowservice.SignalWorkflowExecutionRequest{
+			Namespace:         request.GetNamespace(),
+			WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: scheduler.WorkflowIDPrefix + request.GetScheduleId()},
+			SignalName:        scheduler.SignalNameUpdatePolicies,
+			Input:             input,
+			Identity:          request.GetIdentity(),
+			RequestId:         uuid.New(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.UpdateSchedulePoliciesResponse{}, nil
+}
+
+// UpdateNexusEndpointOperationDefaults sets the defaults applied to Nexus operations targeting an endpoint.
+func (adh *AdminHandler) UpdateNexusEndpointOperationDefaults(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointOperationDefaultsRequest,
+) (_ *adminservice.UpdateNexusEndpointOperationDefaultsResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	return adh.nexusEndpointClient.UpdateOperationDefaults(ctx, request)
+}
+
+// UpdateNexusEndpointOutboundAuth sets the credentials attached to requests sent to an external Nexus endpoint.
+func (adh *AdminHandler) UpdateNexusEndpointOutboundAuth(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointOutboundAuthRequest,
+) (_ *adminservice.UpdateNexusEndpointOutboundAuthResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	return adh.nexusEndpointClient.UpdateOutboundAuth(ctx, request)
+}
+
+// ReplicateNexusEndpoints publishes the current state of Nexus endpoints to remote clusters.
+func (adh *AdminHandler) ReplicateNexusEndpoints(
+	ctx context.Context,
+	request *adminservice.ReplicateNexusEndpointsRequest,
+) (_ *adminservice.ReplicateNexusEndpointsResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if adh.replicatorNamespaceReplicationQueue == nil {
+		return nil, errNexusEndpointReplicationNotSupported
+	}
+	return adh.nexusEndpointClient.Replicate(ctx, request, adh.replicatorNamespaceReplicationQueue)
+}
+
+// ListPendingNexusOperations finds the running executions with pending Nexus operations matching the request filters
+// through the TemporalPendingNexusOperations search attribute, then describes each of them to return the details of the
+// matching operations. Since visibility is eventually consistent, executions whose operations completed in the meantime
+// are skipped, so pages may contain fewer executions than requested.
+func (adh *AdminHandler) ListPendingNexusOperations(
+	ctx context.Context,
+	request *adminservice.ListPendingNexusOperationsRequest,
+) (_ *adminservice.ListPendingNexusOperationsResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	namespaceName := namespace.Name(request.GetNamespace())
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	maxPageSize := int32(adh.config.VisibilityMaxPageSize(request.GetNamespace()))
+	if request.GetPageSize() <= 0 || request.GetPageSize() > maxPageSize {
+		request.PageSize = maxPageSize
+	}
+	listResp, err := adh.visibilityMgr.ListWorkflowExecutions(ctx, &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID:   namespaceID,
+		Namespace:     namespaceName,
+		PageSize:      int(request.GetPageSize()),
+		NextPageToken: request.GetNextPageToken(),
+		Query:         pendingNexusOperationsQuery(request),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &adminservice.ListPendingNexusOperationsResponse{
+		NextPageToken: listResp.NextPageToken,
+	}
+	for _, execution := range listResp.Executions {
+		describeResp, err := adh.historyClient.DescribeWorkflowExecution(ctx, &historyservice.DescribeWorkflowExecutionRequest{
+			NamespaceId: namespaceID.String(),
+			Request: &workflowservice.DescribeWorkflowExecutionRequest{
+				Namespace: request.GetNamespace(),
+				Execution: execution.GetExecution(),
+			},
+		})
+		if err != nil {
+			var notFound *serviceerror.NotFound
+			if errors.As(err, &notFound) {
+				continue
+			}
+			return nil, err
+		}
+		var operations []*workflowpb.PendingNexusOperationInfo
+		for _, op := range describeResp.GetPendingNexusOperations() {
+			if pendingNexusOperationMatches(request, op) {
+				operations = append(operations, op)
+			}
+		}
+		if len(operations) == 0 {
+			continue
+		}
+		resp.Executions = append(resp.Executions, &adminservice.ListPendingNexusOperationsResponse_Execution{
+			Execution:    execution.GetExecution(),
+			WorkflowType: execution.GetType().GetName(),
+			Operations:   operations,
+		})
+	}
+	return resp, nil
+}
+
+func pendingNexusOperationsQuery(request *adminservice.ListPendingNexusOperationsRequest) string {
+	filters := []string{fmt.Sprintf(`%s = "Running"`, searchattribute.ExecutionStatus)}
+	for _, entry := range []struct{ prefix, value string }{
+		{searchattribute.PendingNexusOperationEndpointPrefix, request.GetEndpoint()},
+		{searchattribute.PendingNexusOperationServicePrefix, request.GetService()},
+		{searchattribute.PendingNexusOperationOperationPrefix, request.GetOperation()},
+	} {
+		if entry.value == "" {
+			continue
+		}
+		escaped := sqlparser.String(sqlparser.NewStrVal([]byte(entry.prefix + entry.value)))
+		filters = append(filters, fmt.Sprintf("%s = %s", searchattribute.TemporalPendingNexusOperations, escaped))
+	}
+	if len(filters) == 1 {
+		filters = append(filters, fmt.Sprintf("%s IS NOT NULL", searchattribute.TemporalPendingNexusOperations))
+	}
+	return strings.Join(filters, " AND ")
+}
+
+func pendingNexusOperationMatches(
+	request *adminservice.ListPendingNexusOperationsRequest,
+	op *workflowpb.PendingNexusOperationInfo,
+) bool {
+	return (request.GetEndpoint() == "" || request.GetEndpoint() == op.GetEndpoint()) &&
+		(request.GetService() == "" || request.GetService() == op.GetService()) &&
+		(request.GetOperation() == "" || request.GetOperation() == op.GetOperation()) &&
+		(request.GetState() == enumspb.PENDING_NEXUS_OPERATION_STATE_UNSPECIFIED || request.GetState() == op.GetState())
+}
+
+// SignalWorkflowExecutionsByQuery signals the executions matching a visibility query one by one and reports the
+// outcome per execution. Failing to signal an execution doesn't fail the request.
+func (adh *AdminHandler) SignalWorkflowExecutionsByQuery(
+	ctx context.Context,
+	request *adminservice.SignalWorkflowExecutionsByQueryRequest,
+) (_ *adminservice.SignalWorkflowExecutionsByQueryResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	if request.GetQuery() == "" {
+		return nil, errVisibilityQueryNotSet
+	}
+	if request.GetSignalName() == "" {
+		return nil, errSignalNameNotSet
+	}
+	if len(request.GetSignalName()) > adh.config.MaxIDLengthLimit() {
+		return nil, errSignalNameTooLong
+	}
+	if len(request.GetRequestId()) > adh.config.MaxIDLengthLimit() {
+		return nil, errRequestIDTooLong
+	}
+	if request.GetInput().Size() > adh.config.BlobSizeLimitError(request.GetNamespace()) {
+		return nil, common.ErrBlobSizeExceedsLimit
+	}
+	namespaceName := namespace.Name(request.GetNamespace())
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	maxExecutions := adh.config.AdminSignalByQueryMaxExecutions(request.GetNamespace())
+	if request.GetMaxExecutions() > 0 && int(request.GetMaxExecutions()) < maxExecutions {
+		maxExecutions = int(request.GetMaxExecutions())
+	}
+	requestID := request.GetRequestId()
+	if requestID == "" {
+		requestID = uuid.New()
+	}
+
+	// Fetch one execution more than the cap to find out whether the result is truncated.
+	var executions []*commonpb.WorkflowExecution
+	var nextPageToken []byte
+	for {
+		listResp, err := adh.visibilityMgr.ListWorkflowExecutions(ctx, &manager.ListWorkflowExecutionsRequestV2{
+			NamespaceID:   namespaceID,
+			Namespace:     namespaceName,
+			PageSize:      min(maxExecutions+1-len(executions), adh.config.VisibilityMaxPageSize(request.GetNamespace())),
+			NextPageToken: nextPageToken,
+			Query:         request.GetQuery(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, execution := range listResp.Executions {
+			executions = append(executions, execution.GetExecution())
+		}
+		nextPageToken = listResp.NextPageToken
+		if len(nextPageToken) == 0 || len(executions) > maxExecutions {
+			break
+		}
+	}
+
+	resp := &adminservice.SignalWorkflowExecutionsByQueryResponse{}
+	if len(executions) > maxExecutions {
+		executions = executions[:maxExecutions]
+		resp.Truncated = true
+	}
+	for _, execution := range executions {
+		result := &adminservice.SignalWorkflowExecutionsByQueryResponse_Result{Execution: execution}
+		_, err := adh.historyClient.SignalWorkflowExecution(ctx, &historyservice.SignalWorkflowExecutionRequest{
+			NamespaceId: namespaceID.String(),
+			SignalRequest: &workflowservice.SignalWorkflowExecutionRequest{
+				Namespace:         request.GetNamespace(),
+				WorkflowExecution: execution,
+				SignalName:        request.GetSignalName(),
+				Input:             request.GetInput(),
+				Identity:          request.GetIdentity(),
+				RequestId:         fmt.Sprintf("%s-%s-%s", requestID, execution.GetWorkflowId(), execution.GetRunId()),
+				Header:            request.GetHeader(),
+			},
+		})
+		if err != nil {
+			if common.IsContextDeadlineExceededErr(err) || common.IsContextCanceledErr(err) {
+				return nil, err
+			}
+			result.Error = err.Error()
+		}
+		resp.Results = append(resp.Results, result)
+	}
+	return resp, nil
+}
+
+// MultiSignalWorkflowExecution delivers a list of signals to a running execution in a single history transaction.
+func (adh *AdminHandler) MultiSignalWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.MultiSignalWorkflowExecutionRequest,
+) (_ *adminservice.MultiSignalWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	if err := validateExecution(request.GetWorkflowExecution()); err != nil {
+		return nil, err
+	}
+	if len(request.GetSignals()) == 0 {
+		return nil, errSignalsNotSet
+	}
+	if len(request.GetIdentity()) > adh.config.MaxIDLengthLimit() {
+		return nil, errIdentityTooLong
+	}
+	if len(request.GetRequestId()) > adh.config.MaxIDLengthLimit() {
+		return nil, errRequestIDTooLong
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+	requestID := request.GetRequestId()
+	if requestID == "" {
+		requestID = uuid.New()
+	}
+
+	signalRequests := make([]*workflowservice.SignalWorkflowExecutionRequest, 0, len(request.GetSignals()))
+	for _, signal := range request.GetSignals() {
+		if signal.GetSignalName() == "" {
+			return nil, errSignalNameNotSet
+		}
+		if len(signal.GetSignalName()) > adh.config.MaxIDLengthLimit() {
+			return nil, errSignalNameTooLong
+		}
+		if signal.GetInput().Size() > adh.config.BlobSizeLimitError(request.GetNamespace()) {
+			return nil, common.ErrBlobSizeExceedsLimit
+		}
+		signalRequests = append(signalRequests, &workflowservice.SignalWorkflowExecutionRequest{
+			Namespace:         request.GetNamespace(),
+			WorkflowExecution: request.GetWorkflowExecution(),
+			SignalName:        signal.GetSignalName(),
+			Input:             signal.GetInput(),
+			Identity:          request.GetIdentity(),
+			RequestId:         requestID,
+			Header:            signal.GetHeader(),
+		})
+	}
+
+	if _, err := adh.historyClient.MultiSignalWorkflowExecution(ctx, &historyservice.MultiSignalWorkflowExecutionRequest{
+		NamespaceId:       namespaceID.String(),
+		WorkflowExecution: request.GetWorkflowExecution(),
+		SignalRequests:    signalRequests,
+		RequestId:         requestID,
+	}); err != nil {
+		return nil, err
+	}
+	return &adminservice.MultiSignalWorkflowExecutionResponse{}, nil
+}
+
+// PauseActivityType pauses the dispatch of the activities of a type across a namespace by adding the type to the
+// paused activity types recorded in the namespace data.
+func (adh *AdminHandler) PauseActivityType(
+	ctx context.Context,
+	request *adminservice.PauseActivityTypeRequest,
+) (_ *adminservice.PauseActivityTypeResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	pausedActivityTypes, err := adh.updatePausedActivityTypes(
+		ctx,
+		request.GetNamespace(),
+		request.GetActivityType(),
+		func(activityTypes []string) []string {
+			return append(activityTypes, request.GetActivityType())
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.PauseActivityTypeResponse{PausedActivityTypes: pausedActivityTypes}, nil
+}
+
+// UnpauseActivityType resumes the dispatch of the activities of a type paused by PauseActivityType.
+func (adh *AdminHandler) UnpauseActivityType(
+	ctx context.Context,
+	request *adminservice.UnpauseActivityTypeRequest,
+) (_ *adminservice.UnpauseActivityTypeResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	pausedActivityTypes, err := adh.updatePausedActivityTypes(
+		ctx,
+		request.GetNamespace(),
+		request.GetActivityType(),
+		func(activityTypes []string) []string {
+			return slices.DeleteFunc(activityTypes, func(activityType string) bool {
+				return activityType == request.GetActivityType()
+			})
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.UnpauseActivityTypeResponse{PausedActivityTypes: pausedActivityTypes}, nil
+}
+
+// updatePausedActivityTypes applies update to the activity types paused in the namespace and stores the result in
+// the namespace data through the frontend, so that the change is replicated along with the namespace.
+func (adh *AdminHandler) updatePausedActivityTypes(
+	ctx context.Context,
+	nsName string,
+	activityType string,
+	update func([]string) []string,
+) ([]string, error) {
+	if nsName == "" {
+		return nil, errNamespaceNotSet
+	}
+	if activityType == "" {
+		return nil, errActivityTypeNotSet
+	}
+	if strings.Contains(activityType, ",") {
+		return nil, errInvalidActivityType
+	}
+	if len(activityType) > adh.config.MaxIDLengthLimit() {
+		return nil, errActivityTypeTooLong
+	}
+
+	_, client, err := adh.clientFactory.NewLocalFrontendClientWithTimeout(
+		frontend.DefaultTimeout,
+		frontend.DefaultLongPollTimeout,
+	)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf(errUnableToCreateFrontendClientMessage, err))
+	}
+	resp, err := client.DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{Namespace: nsName})
+	if err != nil {
+		return nil, err
+	}
+
+	current := namespace.ParsePausedActivityTypes(resp.GetNamespaceInfo().GetData()[namespace.PausedActivityTypesDataKey])
+	value := namespace.FormatPausedActivityTypes(update(slices.Clone(current)))
+	if value == namespace.FormatPausedActivityTypes(current) {
+		return namespace.ParsePausedActivityTypes(value), nil
+	}
+	if _, err := client.UpdateNamespace(ctx, &workflowservice.UpdateNamespaceRequest{
+		Namespace: nsName,
+		UpdateInfo: &namespacepb.UpdateNamespaceInfo{
+			Data: map[string]string{namespace.PausedActivityTypesDataKey: value},
+		},
+	}); err != nil {
+		return nil, err
+	}
+	return namespace.ParsePausedActivityTypes(value), nil
+}
+
+// ListNexusEndpointHealth collects the health of Nexus endpoints from every history host and aggregates it per
+// endpoint. Hosts that fail to respond are reported in the response instead of failing the request.
+func (adh *AdminHandler) ListNexusEndpointHealth(
+	ctx context.Context,
+	request *adminservice.ListNexusEndpointHealthRequest,
+) (_ *adminservice.ListNexusEndpointHealthResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	resolver, err := adh.membershipMonitor.GetResolver(primitives.HistoryService)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		endpoints = make(map[string]*nexusspb.EndpointHealth)
+		response  = &adminservice.ListNexusEndpointHealthResponse{}
+	)
+	for _, host := range resolver.AvailableMembers() {
+		wg.Add(1)
+		go func(hostAddress string) {
+			defer wg.Done()
+			resp, err := adh.historyClient.ListNexusEndpointHealth(ctx, &historyservice.ListNexusEndpointHealthRequest{
+				HostAddress:   hostAddress,
+				EndpointNames: request.GetEndpointNames(),
+			})
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				adh.logger.Warn("failed to get Nexus endpoint health from history host", tag.Address(hostAddress), tag.Error(err))
+				response.UnreachableHosts = append(response.UnreachableHosts, hostAddress)
+				return
+			}
+			for _, health := range resp.GetEndpoints() {
+				mergeNexusEndpointHealth(endpoints, health)
+			}
+		}(host.GetAddress())
+	}
+	wg.Wait()
+
+	for _, health := range endpoints {
+		slices.SortFunc(health.CircuitBreakers, func(a, b *nexusspb.EndpointCircuitBreaker) int {
+			if c := strings.Compare(a.GetNamespaceId(), b.GetNamespaceId()); c != 0 {
+				return c
+			}
+			return strings.Compare(a.GetHostAddress(), b.GetHostAddress())
+		})
+		response.Endpoints = append(response.Endpoints, health)
+	}
+	slices.SortFunc(response.Endpoints, func(a, b *nexusspb.EndpointHealth) int {
+		return strings.Compare(a.GetEndpointName(), b.GetEndpointName())
+	})
+	slices.Sort(response.UnreachableHosts)
+	return response, nil
+}
+
+// mergeNexusEndpointHealth folds the health reported by a single history host into the aggregate for the endpoint.
+func mergeNexusEndpointHealth(endpoints map[string]*nexusspb.EndpointHealth, health *nexusspb.EndpointHealth) {
+	merged, ok := endpoints[health.GetEndpointId()]
+	if !ok {
+		endpoints[health.GetEndpointId()] = health
+		return
+	}
+	merged.SuccessCount += health.GetSuccessCount()
+	merged.FailureCount += health.GetFailureCount()
+	if health.GetLastSuccessTime().AsTime().After(merged.GetLastSuccessTime().AsTime()) {
+		merged.LastSuccessTime = health.GetLastSuccessTime()
+	}
+	if health.GetLastFailureTime().AsTime().After(merged.GetLastFailureTime().AsTime()) {
+		merged.LastFailureTime = health.GetLastFailureTime()
+		merged.LastFailureMessage = health.GetLastFailureMessage()
+	}
+	merged.CircuitBreakers = append(merged.CircuitBreakers, health.GetCircuitBreakers()...)
+}
+
+// DescribeArchivalStatus returns the archival configuration and backlog of a namespace. The backlog is computed by
+// scanning the archival queue of every history shard, so this API is relatively expensive.
+func (adh *AdminHandler) DescribeArchivalStatus(
+	ctx context.Context,
+	request *adminservice.DescribeArchivalStatusRequest,
+) (_ *adminservice.DescribeArchivalStatusResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if !adh.config.AdminEnableListHistoryTasks() {
+		return nil, errListHistoryTasksNotAllowed
+	}
+	nsEntry, err := adh.namespaceRegistry.GetNamespace(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	response := &adminservice.DescribeArchivalStatusResponse{
+		HistoryArchivalState:    nsEntry.HistoryArchivalState().State,
+		HistoryArchivalUri:      nsEntry.HistoryArchivalState().URI,
+		VisibilityArchivalState: nsEntry.VisibilityArchivalState().State,
+		VisibilityArchivalUri:   nsEntry.VisibilityArchivalState().URI,
+		Retention:               durationpb.New(nsEntry.Retention()),
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+		oldest   time.Time
+	)
+	shardIDs := make(chan int32, adh.numberOfHistoryShards)
+	for shardID := int32(1); shardID <= adh.numberOfHistoryShards; shardID++ {
+		shardIDs <- shardID
+	}
+	close(shardIDs)
+	for i := 0; i < describeArchivalStatusConcurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for shardID := range shardIDs {
+				backlog, err := adh.getShardArchivalBacklog(ctx, shardID, nsEntry.ID())
+				mu.Lock()
+				if err != nil {
+					if firstErr == nil {
+						firstErr = err
+					}
+				} else {
+					response.PendingArchivalTasks += backlog.pendingTasks
+					response.Truncated = response.Truncated || backlog.truncated
+					if !backlog.oldestTaskTime.IsZero() && (oldest.IsZero() || backlog.oldestTaskTime.Before(oldest)) {
+						oldest = backlog.oldestTaskTime
+					}
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	var oldestAge time.Duration
+	if !oldest.IsZero() {
+		response.OldestPendingTaskTime = timestamppb.New(oldest)
+		oldestAge = max(time.Since(oldest), 0)
+	}
+	nsTag := metrics.NamespaceTag(nsEntry.Name().String())
+	metrics.ArchivalBacklogPendingTasks.With(adh.metricsHandler).Record(float64(response.PendingArchivalTasks), nsTag)
+	metrics.ArchivalBacklogOldestPendingAge.With(adh.metricsHandler).Record(oldestAge, nsTag)
+	return response, nil
+}
+
+type shardArchivalBacklog struct {
+	pendingTasks   int64
+	oldestTaskTime time.Time
+	truncated      bool
+}
+
+func (adh *AdminHandler) getShardArchivalBacklog(
+	ctx context.Context,
+	shardID int32,
+	namespaceID namespace.ID,
+) (shardArchivalBacklog, error) {
+	var backlog shardArchivalBacklog
+	shardResp, err := adh.historyClient.GetShard(ctx, &historyservice.GetShardRequest{ShardId: shardID})
+	if err != nil {
+		return backlog, err
+	}
+	// Tasks below the ack level of the archival queue are completed and only wait for range deletion, so they are not
+	// part of the backlog.
+	ackLevel := archivalQueueAckLevel(shardResp.GetShardInfo().GetQueueStates()[int32(tasks.CategoryIDArchival)])
+	maxTasks := adh.config.AdminDescribeArchivalStatusMaxTasksPerShard()
+	var pageToken []byte
+	for scanned := 0; ; {
+		resp, err := adh.historyClient.ListTasks(ctx, &historyservice.ListTasksRequest{
+			Request: &adminservice.ListHistoryTasksRequest{
+				ShardId:  shardID,
+				Category: tasks.CategoryIDArchival,
+				// Archival is a scheduled category, so persistence requires a max fire time. Archival tasks may be
+				// scheduled in the future, so scan up to the maximum key rather than now.
+				TaskRange: &historyspb.TaskRange{
+					InclusiveMinTaskKey: &historyspb.TaskKey{FireTime: timestamppb.New(ackLevel)},
+					ExclusiveMaxTaskKey: &historyspb.TaskKey{FireTime: timestamppb.New(tasks.MaximumKey.FireTime)},
+				},
+				BatchSize:     describeArchivalStatusPageSize,
+				NextPageToken: pageToken,
+			},
+		})
+		if err != nil {
+			return backlog, err
+		}
+		for _, task := range resp.GetResponse().GetTasks() {
+			if task.GetNamespaceId() != namespaceID.String() {
+				continue
+			}
+			backlog.pendingTasks++
+			fireTime := task.GetFireTime().AsTime()
+			if backlog.oldestTaskTime.IsZero() || fireTime.Before(backlog.oldestTaskTime) {
+				backlog.oldestTaskTime = fireTime
+			}
+		}
+		scanned += len(resp.GetResponse().GetTasks())
+		pageToken = resp.GetResponse().GetNextPageToken()
+		if len(pageToken) == 0 {
+			return backlog, nil
+		}
+		if scanned >= maxTasks {
+			backlog.truncated = true
+			return backlog, nil
+		}
+	}
+}
+
+// archivalQueueAckLevel returns the fire time below which all tasks of the archival queue were processed, which is the
+// minimum of the ranges still pending in the queue readers and the reader high watermark. Scheduled queues read tasks
+// by fire time only, so the task ID of the keys is ignored.
+func archivalQueueAckLevel(state *persistencespb.QueueState) time.Time {
+	if state == nil {
+		return tasks.MinimumKey.FireTime
+	}
+	ackLevel := state.GetExclusiveReaderHighWatermark().GetFireTime().AsTime()
+	for _, readerState := range state.GetReaderStates() {
+		for _, scope := range readerState.GetScopes() {
+			if minFireTime := scope.GetRange().GetInclusiveMin().GetFireTime().AsTime(); minFireTime.Before(ackLevel) {
+				ackLevel = minFireTime
+			}
+		}
+	}
+	return ackLevel
+}
+
+func (adh *AdminHandler) DescribeReplicationLag(
+	ctx context.Context,
+	request *adminservice.DescribeReplicationLagRequest,
+) (_ *adminservice.DescribeReplicationLagResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	var nsEntry *namespace.Namespace
+	var remoteClusters []string
+	if len(request.GetNamespace()) != 0 {
+		// counting pending tasks of a namespace scans the replication queue of every shard
+		if !adh.config.AdminEnableListHistoryTasks() {
+			return nil, errListHistoryTasksNotAllowed
+		}
+		var err error
+		nsEntry, err = adh.namespaceRegistry.GetNamespace(namespace.Name(request.GetNamespace()))
+		if err != nil {
+			return nil, err
+		}
+		remoteClusters = remoteClusterNames(nsEntry.ClusterNames(), nsEntry.ActiveClusterName())
+	}
+	if len(request.GetCluster()) != 0 {
+		remoteClusters = []string{request.GetCluster()}
+	}
+
+	// empty remoteClusters returns the status of all remote clusters
+	statusResp, err := adh.historyClient.GetReplicationStatus(ctx, &historyservice.GetReplicationStatusRequest{
+		RemoteClusters: remoteClusters,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &adminservice.DescribeReplicationLagResponse{}
+	var maxTimeLag time.Duration
+	shardMinAckedTaskIDs := make(map[int32]int64, len(statusResp.GetShards()))
+	for _, status := range statusResp.GetShards() {
+		clusterNames := slices.Sorted(maps.Keys(status.GetRemoteClusters()))
+		for _, clusterName := range clusterNames {
+			taskLag, timeLag, _ := shardReplicationLag(status, clusterName)
+			response.Shards = append(response.Shards, &adminservice.ShardReplicationLag{
+				ShardId: status.GetShardId(),
+				Cluster: clusterName,
+				TaskLag: taskLag,
+				TimeLag: durationpb.New(timeLag),
+			})
+			response.MaxTaskLag = max(response.MaxTaskLag, taskLag)
+			maxTimeLag = max(maxTimeLag, timeLag)
+
+			ackedTaskID := status.GetRemoteClusters()[clusterName].GetAckedTaskId()
+			if minAcked, ok := shardMinAckedTaskIDs[status.GetShardId()]; !ok || ackedTaskID < minAcked {
+				shardMinAckedTaskIDs[status.GetShardId()] = ackedTaskID
+			}
+		}
+	}
+	response.MaxTimeLag = durationpb.New(maxTimeLag)
+
+	if nsEntry == nil {
+		return response, nil
+	}
+
+	// the time lag of the namespace is measured on its own pending tasks
+	nsStatusResp, err := adh.historyClient.GetReplicationStatus(ctx, &historyservice.GetReplicationStatusRequest{
+		RemoteClusters: remoteClusters,
+		NamespaceId:    nsEntry.ID().String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var nsTime time.Duration
+	for _, status := range nsStatusResp.GetShards() {
+		for clusterName := range status.GetRemoteClusters() {
+			_, timeLag, _ := shardReplicationLag(status, clusterName)
+			nsTime = max(nsTime, timeLag)
+		}
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+		nsLag    = &adminservice.NamespaceReplicationLag{}
+	)
+	shards := make(chan *historyservice.ShardReplicationStatus, len(statusResp.GetShards()))
+	for _, status := range statusResp.GetShards() {
+		if _, ok := shardMinAckedTaskIDs[status.GetShardId()]; ok {
+			shards <- status
+		}
+	}
+	close(shards)
+	for i := 0; i < describeReplicationLagConcurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for status := range shards {
+				pendingTasks, truncated, err := adh.countShardPendingReplicationTasks(
+					ctx,
+					status.GetShardId(),
+					nsEntry.ID(),
+					shardMinAckedTaskIDs[status.GetShardId()]+1,
+					status.GetMaxReplicationTaskId()+1,
+				)
+				mu.Lock()
+				if err != nil {
+					if firstErr == nil {
+						firstErr = err
+					}
+				} else {
+					nsLag.PendingTasks += pendingTasks
+					nsLag.Truncated = nsLag.Truncated || truncated
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	nsLag.TimeLag = durationpb.New(nsTime)
+	response.NamespaceLag = nsLag
+	return response, nil
+}
+
+// countShardPendingReplicationTasks counts the replication tasks of a namespace within [minTaskID, maxTaskID) of a shard.
+func (adh *AdminHandler) countShardPendingReplicationTasks(
+	ctx context.Context,
+	shardID int32,
+	namespaceID namespace.ID,
+	minTaskID int64,
+	maxTaskID int64,
+) (pendingTasks int64, truncated bool, _ error) {
+	if minTaskID >= maxTaskID {
+		return 0, false, nil
+	}
+	maxTasks := adh.config.AdminDescribeReplicationLagMaxTasksPerShard()
+	var pageToken []byte
+	for scanned := 0; ; {
+		resp, err := adh.historyClient.ListTasks(ctx, &historyservice.ListTasksRequest{
+			Request: &adminservice.ListHistoryTasksRequest{
+				ShardId:  shardID,
+				Category: tasks.CategoryIDReplication,
+				TaskRange: &historyspb.TaskRange{
+					InclusiveMinTaskKey: &historyspb.TaskKey{TaskId: minTaskID},
+					ExclusiveMaxTaskKey: &historyspb.TaskKey{TaskId: maxTaskID},
+				},
+				BatchSize:     describeReplicationLagPageSize,
+				NextPageToken: pageToken,
+			},
+		})
+		if err != nil {
+			return 0, false, err
+		}
+		for _, task := range resp.GetResponse().GetTasks() {
+			if task.GetNamespaceId() == namespaceID.String() {
+				pendingTasks++
+			}
+		}
+		scanned += len(resp.GetResponse().GetTasks())
+		pageToken = resp.GetResponse().GetNextPageToken()
+		if len(pageToken) == 0 {
+			return pendingTasks, false, nil
+		}
+		if scanned >= maxTasks {
+			return pendingTasks, true, nil
+		}
+	}
+}
+
+// CaptureProfile captures runtime profiles of a host, or of every host of a role, and returns them or writes them to
+// the profile store.
+func (adh *AdminHandler) CaptureProfile(
+	ctx context.Context,
+	request *adminservice.CaptureProfileRequest,
+) (_ *adminservice.CaptureProfileResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	profileTypes := request.GetProfileTypes()
+	if len(profileTypes) == 0 {
+		profileTypes = defaultProfileTypes
+	}
+	for _, profileType := range profileTypes {
+		if !isValidProfileType(profileType) {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Unknown profile type %q.", profileType))
+		}
+	}
+	duration := request.GetDuration().AsDuration()
+	if duration <= 0 {
+		duration = defaultCPUProfileDuration
+	}
+	if maxDuration := adh.config.AdminCaptureProfileMaxDuration(); duration > maxDuration {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Duration exceeds the limit of %v.", maxDuration))
+	}
+	if request.GetStore() && adh.config.AdminCaptureProfileStoreURI() == "" {
+		return nil, errProfileStoreNotConfigured
+	}
+
+	targets, err := adh.captureProfileTargets(request)
+	if err != nil {
+		return nil, err
+	}
+	hosts := make([]string, len(targets))
+	for i, target := range targets {
+		hosts[i] = target.address
+	}
+	adh.logger.Info("Capturing profiles",
+		tag.NewStringsTag("profile-types", profileTypes),
+		tag.NewStringsTag("hosts", hosts),
+		tag.NewDurationTag("duration", duration),
+	)
+
+	// Hosts are profiled concurrently, the profiles of a host one after the other so that they don't skew each other.
+	profiles := make([]*adminservice.CaptureProfileResponse_Profile, len(targets)*len(profileTypes))
+	var wg sync.WaitGroup
+	for i, target := range targets {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j, profileType := range profileTypes {
+				profile := &adminservice.CaptureProfileResponse_Profile{
+					HostAddress: target.address,
+					ProfileType: profileType,
+				}
+				profiles[i*len(profileTypes)+j] = profile
+
+				data, err := adh.profileCapturer.Capture(ctx, target.address, profileType, duration)
+				if err == nil && request.GetStore() {
+					profile.Uri, err = adh.profileCapturer.Store(string(target.role), target.address, profileType, data)
+					data = nil
+				}
+				if err != nil {
+					profile.Error = err.Error()
+					continue
+				}
+				profile.Data = data
+			}
+		}()
+	}
+	wg.Wait()
+
+	return &adminservice.CaptureProfileResponse{Profiles: profiles}, nil
+}
+
+type profileTarget struct {
+	role    primitives.ServiceName
+	address string
+}
+
+var profileRoles = map[enumsspb.ClusterMemberRole]primitives.ServiceName{
+	enumsspb.CLUSTER_MEMBER_ROLE_FRONTEND: primitives.FrontendService,
+	enumsspb.CLUSTER_MEMBER_ROLE_HISTORY:  primitives.HistoryService,
+	enumsspb.CLUSTER_MEMBER_ROLE_MATCHING: primitives.MatchingService,
+	enumsspb.CLUSTER_MEMBER_ROLE_WORKER:   primitives.WorkerService,
+}
+
+// captureProfileTargets resolves the hosts a CaptureProfile request profiles through membership.
+func (adh *AdminHandler) captureProfileTargets(
+	request *adminservice.CaptureProfileRequest,
+) ([]profileTarget, error) {
+	var roles []primitives.ServiceName
+	if request.GetRole() != enumsspb.CLUSTER_MEMBER_ROLE_UNSPECIFIED {
+		role, ok := profileRoles[request.GetRole()]
+		if !ok {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Unknown role %v.", request.GetRole()))
+		}
+		roles = []primitives.ServiceName{role}
+	} else if request.GetHostAddress() != "" {
+		roles = []primitives.ServiceName{
+			primitives.FrontendService,
+			primitives.InternalFrontendService,
+			primitives.HistoryService,
+			primitives.MatchingService,
+			primitives.WorkerService,
+		}
+	} else {
+		return nil, errRoleOrHostAddressNotSet
+	}
+
+	var targets []profileTarget
+	for _, role := range roles {
+		resolver, err := adh.membershipMonitor.GetResolver(role)
+		if err != nil {
+			if role == primitives.InternalFrontendService {
+				continue // this one is optional
+			}
+			return nil, err
+		}
+		for _, member := range resolver.Members() {
+			if request.GetHostAddress() == "" || member.GetAddress() == request.GetHostAddress() {
+				targets = append(targets, profileTarget{role: role, address: member.GetAddress()})
+			}
+		}
+	}
+
+	if request.GetHostAddress() != "" {
+		if len(targets) == 0 {
+			return nil, serviceerror.NewNotFound(fmt.Sprintf("Host %v is not a member of the cluster.", request.GetHostAddress()))
+		}
+		return targets[:1], nil
+	}
+	if maxHosts := adh.config.AdminCaptureProfileMaxHosts(); len(targets) > maxHosts {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(
+			"Role %v has %d hosts, more than the limit of %d. Set HostAddress to profile a single host.",
+			roles[0], len(targets), maxHosts,
+		))
+	}
+	return targets, nil
+}
+
+// DescribeShardOwnership describes the shards owned by each history host of the ring, as reported by the hosts.
+func (adh *AdminHandler) DescribeShardOwnership(
+	ctx context.Context,
+	_ *adminservice.DescribeShardOwnershipRequest,
+) (_ *adminservice.DescribeShardOwnershipResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	resolver, err := adh.membershipMonitor.GetResolver(primitives.HistoryService)
+	if err != nil {
+		return nil, err
+	}
+	// Draining hosts are described too, as they own shards until they hand them over.
+	members := resolver.Members()
+	hostResponses := make([]*historyservice.DescribeHistoryHostResponse, len(members))
+	hostErrors := make([]error, len(members))
+	var wg sync.WaitGroup
+	for i, member := range members {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			hostResponses[i], hostErrors[i] = adh.historyClient.DescribeHistoryHost(ctx, &historyservice.DescribeHistoryHostRequest{
+				HostAddress: member.GetAddress(),
+			})
+		}()
+	}
+	wg.Wait()
+
+	resp := &adminservice.DescribeShardOwnershipResponse{
+		Shards: make([]*adminservice.DescribeShardOwnershipResponse_Shard, adh.numberOfHistoryShards),
+	}
+	for i := range resp.Shards {
+		shardID := int32(i + 1)
+		resp.Shards[i] = &adminservice.DescribeShardOwnershipResponse_Shard{ShardId: shardID}
+		if owner, err := resolver.Lookup(convert.Int32ToString(shardID)); err == nil {
+			resp.Shards[i].RingOwner = owner.GetAddress()
+		}
+	}
+	for i, member := range members {
+		host := &adminservice.DescribeShardOwnershipResponse_Host{Address: member.GetAddress()}
+		resp.Hosts = append(resp.Hosts, host)
+		if hostErrors[i] != nil {
+			host.Error = hostErrors[i].Error()
+			continue
+		}
+		host.ShardCount = int32(len(hostResponses[i].GetShards()))
+		for _, shard := range hostResponses[i].GetShards() {
+			if shardID := shard.GetShardId(); shardID >= 1 && shardID <= adh.numberOfHistoryShards {
+				resp.Shards[shardID-1].Owners = append(resp.Shards[shardID-1].Owners, shard)
+			}
+		}
+		resp.RecentChanges = append(resp.RecentChanges, hostResponses[i].GetShardOwnershipChanges()...)
+	}
+	slices.SortStableFunc(resp.RecentChanges, func(a, b *clusterspb.ShardOwnershipChange) int {
+		return a.GetTime().AsTime().Compare(b.GetTime().AsTime())
+	})
+	return resp, nil
+}
+
+// ListHotspots returns the shards and workflows using the largest share of the resources of their history hosts
+func (adh *AdminHandler) ListHotspots(
+	ctx context.Context,
+	request *adminservice.ListHotspotsRequest,
+) (_ *adminservice.ListHotspotsResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	limit := request.GetLimit()
+	if limit < 0 {
+		return nil, serviceerror.NewInvalidArgument("limit must not be negative")
+	}
+	if limit == 0 {
+		limit = defaultHotspotLimit
+	}
+
+	resolver, err := adh.membershipMonitor.GetResolver(primitives.HistoryService)
+	if err != nil {
+		return nil, err
+	}
+	members := resolver.Members()
+	hostResponses := make([]*historyservice.DescribeHistoryHostResponse, len(members))
+	hostErrors := make([]error, len(members))
+	var wg sync.WaitGroup
+	for i, member := range members {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			hostResponses[i], hostErrors[i] = adh.historyClient.DescribeHistoryHost(ctx, &historyservice.DescribeHistoryHostRequest{
+				HostAddress:  member.GetAddress(),
+				HotspotLimit: limit,
+			})
+		}()
+	}
+	wg.Wait()
+
+	resp := &adminservice.ListHotspotsResponse{}
+	for i, member := range members {
+		if hostErrors[i] != nil {
+			adh.logger.Warn("Failed to describe history host.", tag.Address(member.GetAddress()), tag.Error(hostErrors[i]))
+			resp.FailedHosts = append(resp.FailedHosts, member.GetAddress())
+			continue
+		}
+		for _, shard := range hostResponses[i].GetHotShards() {
+			shard.HostAddress = member.GetAddress()
+			resp.Shards = append(resp.Shards, shard)
+		}
+		for _, workflow := range hostResponses[i].GetHotWorkflows() {
+			workflow.HostAddress = member.GetAddress()
+			resp.Workflows = append(resp.Workflows, workflow)
+		}
+	}
+	// Scores are shares of each host's own usage, so hosts with little traffic can rank high.
+	slices.SortStableFunc(resp.Shards, func(a, b *historyspb.HotShard) int {
+		return cmp.Compare(b.GetUsage().GetScore(), a.GetUsage().GetScore())
+	})
+	slices.SortStableFunc(resp.Workflows, func(a, b *historyspb.HotWorkflow) int {
+		return cmp.Compare(b.GetUsage().GetScore(), a.GetUsage().GetScore())
+	})
+	resp.Shards = resp.Shards[:min(int(limit), len(resp.Shards))]
+	resp.Workflows = resp.Workflows[:min(int(limit), len(resp.Workflows))]
+	return resp, nil
+}
+
+// GetDebugTrace returns the spans recorded for a debug trace by this frontend host and by all history hosts
+func (adh *AdminHandler) GetDebugTrace(
+	ctx context.Context,
+	request *adminservice.GetDebugTraceRequest,
+) (_ *adminservice.GetDebugTraceResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	traceID := request.GetTraceId()
+	if traceID == "" {
+		return nil, serviceerror.NewInvalidArgument("trace ID is not set on request")
+	}
+	// Looking up the trace must not add to it.
+	ctx = debugtrace.WithoutTraceID(ctx)
+
+	resolver, err := adh.membershipMonitor.GetResolver(primitives.HistoryService)
+	if err != nil {
+		return nil, err
+	}
+	members := resolver.Members()
+	hostResponses := make([]*historyservice.DescribeHistoryHostResponse, len(members))
+	hostErrors := make([]error, len(members))
+	var wg sync.WaitGroup
+	for i, member := range members {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			hostResponses[i], hostErrors[i] = adh.historyClient.DescribeHistoryHost(ctx, &historyservice.DescribeHistoryHostRequest{
+				HostAddress:  member.GetAddress(),
+				DebugTraceId: traceID,
+			})
+		}()
+	}
+	wg.Wait()
+
+	resp := &adminservice.GetDebugTraceResponse{
+		Spans: adh.debugTraceStore.Get(traceID),
+	}
+	for i, member := range members {
+		if hostErrors[i] != nil {
+			adh.logger.Warn("Failed to describe history host.", tag.Address(member.GetAddress()), tag.Error(hostErrors[i]))
+			resp.FailedHosts = append(resp.FailedHosts, member.GetAddress())
+			continue
+		}
+		resp.Spans = append(resp.Spans, hostResponses[i].GetDebugTraceSpans()...)
+	}
+	slices.SortStableFunc(resp.Spans, func(a, b *historyspb.DebugTraceSpan) int {
+		return a.GetStartTime().AsTime().Compare(b.GetStartTime().AsTime())
+	})
+	return resp, nil
+}
+
+// GetDynamicConfig returns the dynamic config values set at runtime
+func (adh *AdminHandler) GetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigRequest,
+) (_ *adminservice.GetDynamicConfigResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	overrides, err := adh.dynamicConfigOverrides.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &adminservice.GetDynamicConfigResponse{}
+	for key, override := range overrides {
+		if request.GetKey() != "" && !strings.EqualFold(request.GetKey(), key.String()) {
+			continue
+		}
+		resp.Overrides = append(resp.Overrides, &adminservice.DynamicConfigOverride{
+			Key:        key.String(),
+			Values:     override.Values,
+			UpdatedBy:  override.UpdatedBy,
+			UpdateTime: timestamppb.New(override.UpdateTime),
+		})
+	}
+	slices.SortFunc(resp.Overrides, func(a, b *adminservice.DynamicConfigOverride) int {
+		return strings.Compare(a.GetKey(), b.GetKey())
+	})
+	return resp, nil
+}
+
+// SetDynamicConfig sets or removes the runtime override of a dynamic config key
+func (adh *AdminHandler) SetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigRequest,
+) (_ *adminservice.SetDynamicConfigResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	key := dynamicconfig.Key(request.GetKey())
+	if key == "" {
+		return nil, serviceerror.NewInvalidArgument("key is not set on request")
+	}
+	if request.GetValues() != "" {
+		// Unlike for the dynamic config file, warnings such as unregistered keys or values of the wrong type are
+		// rejected since the caller can fix them right away.
+		_, lr := dynamicconfig.ParseConstrainedValues(key, []byte(request.GetValues()))
+		if problems := append(lr.Errors, lr.Warnings...); len(problems) > 0 {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("invalid dynamic config values: %v", errors.Join(problems...)))
+		}
+	}
+
+	updatedBy := approvalCallerIdentity(ctx)
+	previous, err := adh.dynamicConfigOverrides.Set(ctx, key, request.GetValues(), updatedBy)
+	if err != nil {
+		return nil, err
+	}
+	var previousValues string
+	if previous != nil {
+		previousValues = previous.Values
+	}
+	adh.logger.Info("Dynamic config runtime override changed.",
+		tag.Key(key.String()),
+		tag.NewStringTag("previous-values", previousValues),
+		tag.NewStringTag("values", request.GetValues()),
+		tag.NewStringTag("updated-by", updatedBy),
+	)
+	return &adminservice.SetDynamicConfigResponse{}, nil
+}
+
+// ExportNamespaceConfig returns the configuration of a namespace, its custom search attributes, the dynamic config
+// overrides constrained to it, its schedules and the Nexus endpoints targeting it.
+func (adh *AdminHandler) ExportNamespaceConfig(
+	ctx context.Context,
+	request *adminservice.ExportNamespaceConfigRequest,
+) (_ *adminservice.ExportNamespaceConfigResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	nsName := request.GetNamespace()
+	if nsName == "" {
+		return nil, errNamespaceNotSet
+	}
+	_, client, err := adh.clientFactory.NewLocalFrontendClientWithTimeout(
+		frontend.DefaultTimeout,
+		frontend.DefaultLongPollTimeout,
+	)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf(errUnableToCreateFrontendClientMessage, err))
+	}
+
+	nsResp, err := client.DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{Namespace: nsName})
+	if err != nil {
+		return nil, err
+	}
+	nsConfig := common.CloneProto(nsResp.GetConfig())
+	if nsConfig != nil {
+		// Aliases map to cluster specific field names, search attributes are exported by name instead.
+		nsConfig.CustomSearchAttributeAliases = nil
+	}
+	saResp, err := adh.GetSearchAttributes(ctx, &adminservice.GetSearchAttributesRequest{Namespace: nsName})
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := &adminservice.NamespaceConfigBundle{
+		Info:              nsResp.GetNamespaceInfo(),
+		Config:            nsConfig,
+		ReplicationConfig: nsResp.GetReplicationConfig(),
+		IsGlobalNamespace: nsResp.GetIsGlobalNamespace(),
+		SearchAttributes:  saResp.GetCustomAttributes(),
+		SourceCluster:     adh.clusterMetadata.GetCurrentClusterName(),
+		ExportTime:        timestamppb.New(time.Now().UTC()),
+	}
+
+	overrides, err := adh.dynamicConfigOverrides.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range slices.Sorted(maps.Keys(overrides)) {
+		override := overrides[key]
+		values, err := runtimeoverride.NamespaceValues(override.Values, nsName)
+		if err != nil {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("unable to parse dynamic config override of %q: %v", key, err))
+		}
+		if values == "" {
+			continue
+		}
+		bundle.DynamicConfig = append(bundle.DynamicConfig, &adminservice.DynamicConfigOverride{
+			Key:        key.String(),
+			Values:     values,
+			UpdatedBy:  override.UpdatedBy,
+			UpdateTime: timestamppb.New(override.UpdateTime),
+		})
+	}
+
+	var nextPageToken []byte
+	for {
+		listResp, err := client.ListSchedules(ctx, &workflowservice.ListSchedulesRequest{
+			Namespace:       nsName,
+			MaximumPageSize: exportNamespaceConfigPageSize,
+			NextPageToken:   nextPageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range listResp.GetSchedules() {
+			descResp, err := client.DescribeSchedule(ctx, &workflowservice.DescribeScheduleRequest{
+				Namespace:  nsName,
+				ScheduleId: entry.GetScheduleId(),
+			})
+			if common.IsNotFoundError(err) {
+				// deleted since it was listed
+				continue
+			} else if err != nil {
+				return nil, err
+			}
+			bundle.Schedules = append(bundle.Schedules, &adminservice.NamespaceConfigSchedule{
+				ScheduleId:       entry.GetScheduleId(),
+				Schedule:         descResp.GetSchedule(),
+				Memo:             descResp.GetMemo(),
+				SearchAttributes: descResp.GetSearchAttributes(),
+			})
+		}
+		nextPageToken = listResp.GetNextPageToken()
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+
+	nextPageToken = nil
+	for {
+		listResp, err := adh.nexusEndpointClient.List(ctx, &operatorservice.ListNexusEndpointsRequest{
+			PageSize:      exportNamespaceConfigPageSize,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, endpoint := range listResp.GetEndpoints() {
+			if endpoint.GetSpec().GetTarget().GetWorker().GetNamespace() == nsName {
+				bundle.NexusEndpoints = append(bundle.NexusEndpoints, endpoint.GetSpec())
+			}
+		}
+		nextPageToken = listResp.GetNextPageToken()
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+
+	return &adminservice.ExportNamespaceConfigResponse{Bundle: bundle}, nil
+}
+
+// ImportNamespaceConfig applies a bundle returned by ExportNamespaceConfig. Everything that can be validated up front
+// is validated before anything is changed. Schedules and Nexus endpoints that already exist are left alone and
+// reported as skipped.
+func (adh *AdminHandler) ImportNamespaceConfig(
+	ctx context.Context,
+	request *adminservice.ImportNamespaceConfigRequest,
+) (_ *adminservice.ImportNamespaceConfigResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	bundle := request.GetBundle()
+	if bundle.GetInfo() == nil {
+		return nil, serviceerror.NewInvalidArgument("bundle is not set on request")
+	}
+	nsName := request.GetNamespace()
+	if nsName == "" {
+		nsName = bundle.GetInfo().GetName()
+	}
+	if nsName == "" {
+		return nil, errNamespaceNotSet
+	}
+	_, client, err := adh.clientFactory.NewLocalFrontendClientWithTimeout(
+		frontend.DefaultTimeout,
+		frontend.DefaultLongPollTimeout,
+	)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf(errUnableToCreateFrontendClientMessage, err))
+	}
+
+	// The dynamic config overrides are merged with the current ones and validated before anything is changed.
+	dynamicConfig := make(map[dynamicconfig.Key]string, len(bundle.GetDynamicConfig()))
+	if len(bundle.GetDynamicConfig()) > 0 {
+		overrides, err := adh.dynamicConfigOverrides.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, override := range bundle.GetDynamicConfig() {
+			key := dynamicconfig.Key(strings.ToLower(override.GetKey()))
+			var current string
+			if o := overrides[key]; o != nil {
+				current = o.Values
+			}
+			merged, err := runtimeoverride.ReplaceNamespaceValues(current, nsName, override.GetValues())
+			if err != nil {
+				return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("invalid dynamic config values for %q: %v", override.GetKey(), err))
+			}
+			if _, lr := dynamicconfig.ParseConstrainedValues(key, []byte(merged)); len(lr.Errors) > 0 {
+				return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("invalid dynamic config values for %q: %v", override.GetKey(), errors.Join(lr.Errors...)))
+			}
+			dynamicConfig[key] = merged
+		}
+	}
+
+	resp := &adminservice.ImportNamespaceConfigResponse{}
+	created, skipped, err := adh.importNamespace(ctx, client, nsName, bundle)
+	if err != nil {
+		return nil, err
+	}
+	resp.NamespaceCreated = created
+	resp.Skipped = append(resp.Skipped, skipped...)
+
+	saResp, err := adh.GetSearchAttributes(ctx, &adminservice.GetSearchAttributesRequest{Namespace: nsName})
+	if err != nil {
+		return nil, err
+	}
+	missingSearchAttributes := make(map[string]enumspb.IndexedValueType)
+	for name, saType := range bundle.GetSearchAttributes() {
+		if currentType, ok := saResp.GetCustomAttributes()[name]; ok {
+			if currentType != saType {
+				resp.Skipped = append(resp.Skipped, fmt.Sprintf("search attribute %s: exists with type %v instead of %v", name, currentType, saType))
+			}
+			continue
+		}
+		missingSearchAttributes[name] = saType
+	}
+	if len(missingSearchAttributes) > 0 {
+		if _, err := adh.AddSearchAttributes(ctx, &adminservice.AddSearchAttributesRequest{
+			SearchAttributes: missingSearchAttributes,
+			Namespace:        nsName,
+		}); err != nil {
+			return nil, err
+		}
+		resp.AddedSearchAttributes = slices.Sorted(maps.Keys(missingSearchAttributes))
+	}
+
+	updatedBy := approvalCallerIdentity(ctx)
+	for _, key := range slices.Sorted(maps.Keys(dynamicConfig)) {
+		if _, err := adh.dynamicConfigOverrides.Set(ctx, key, dynamicConfig[key], updatedBy); err != nil {
+			return nil, err
+		}
+		resp.UpdatedDynamicConfigKeys = append(resp.UpdatedDynamicConfigKeys, key.String())
+	}
+
+	for _, schedule := range bundle.GetSchedules() {
+		_, err := client.CreateSchedule(ctx, &workflowservice.CreateScheduleRequest{
+			Namespace:        nsName,
+			ScheduleId:       schedule.GetScheduleId(),
+			Schedule:         schedule.GetSchedule(),
+			Memo:             schedule.GetMemo(),
+			SearchAttributes: schedule.GetSearchAttributes(),
+			Identity:         updatedBy,
+			RequestId:        uuid.New(),
+		})
+		var alreadyExists *serviceerror.WorkflowExecutionAlreadyStarted
+		if errors.As(err, &alreadyExists) {
+			resp.Skipped = append(resp.Skipped, fmt.Sprintf("schedule %s: already exists", schedule.GetScheduleId()))
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		resp.CreatedSchedules = append(resp.CreatedSchedules, schedule.GetScheduleId())
+	}
+
+	for _, spec := range bundle.GetNexusEndpoints() {
+		spec = common.CloneProto(spec)
+		if worker := spec.GetTarget().GetWorker(); worker != nil && worker.GetNamespace() == bundle.GetInfo().GetName() {
+			worker.Namespace = nsName
+		}
+		_, err := adh.nexusEndpointClient.Create(ctx, &operatorservice.CreateNexusEndpointRequest{Spec: spec})
+		var alreadyExists *serviceerror.AlreadyExists
+		if errors.As(err, &alreadyExists) {
+			resp.Skipped = append(resp.Skipped, fmt.Sprintf("nexus endpoint %s: already exists", spec.GetName()))
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		resp.CreatedNexusEndpoints = append(resp.CreatedNexusEndpoints, spec.GetName())
+	}
+
+	adh.logger.Info("Imported namespace config.",
+		tag.WorkflowNamespace(nsName),
+		tag.NewStringTag("source-cluster", bundle.GetSourceCluster()),
+		tag.NewStringTag("updated-by", updatedBy),
+	)
+	return resp, nil
+}
+
+// importNamespace registers the namespace of the bundle, or updates it if it exists. Replication config is cluster
+// specific and not imported. Archival URIs can't be changed once set, so differing ones are skipped.
+func (adh *AdminHandler) importNamespace(
+	ctx context.Context,
+	client workflowservice.WorkflowServiceClient,
+	nsName string,
+	bundle *adminservice.NamespaceConfigBundle,
+) (created bool, skipped []string, err error) {
+	info := bundle.GetInfo()
+	nsConfig := bundle.GetConfig()
+
+	descResp, err := client.DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{Namespace: nsName})
+	var notFound *serviceerror.NamespaceNotFound
+	if errors.As(err, &notFound) {
+		_, err = client.RegisterNamespace(ctx, &workflowservice.RegisterNamespaceRequest{
+			Namespace:                        nsName,
+			Description:                      info.GetDescription(),
+			OwnerEmail:                       info.GetOwnerEmail(),
+			WorkflowExecutionRetentionPeriod: nsConfig.GetWorkflowExecutionRetentionTtl(),
+			Data:                             info.GetData(),
+			IsGlobalNamespace:                bundle.GetIsGlobalNamespace() && adh.clusterMetadata.IsGlobalNamespaceEnabled(),
+			HistoryArchivalState:             nsConfig.GetHistoryArchivalState(),
+			HistoryArchivalUri:               nsConfig.GetHistoryArchivalUri(),
+			VisibilityArchivalState:          nsConfig.GetVisibilityArchivalState(),
+			VisibilityArchivalUri:            nsConfig.GetVisibilityArchivalUri(),
+		})
+		return err == nil, nil, err
+	} else if err != nil {
+		return false, nil, err
+	}
+
+	updateConfig := &namespacepb.NamespaceConfig{
+		WorkflowExecutionRetentionTtl: nsConfig.GetWorkflowExecutionRetentionTtl(),
+		BadBinaries:                   nsConfig.GetBadBinaries(),
+	}
+	currentConfig := descResp.GetConfig()
+	if uri := currentConfig.GetHistoryArchivalUri(); uri == "" || uri == nsConfig.GetHistoryArchivalUri() {
+		updateConfig.HistoryArchivalState = nsConfig.GetHistoryArchivalState()
+		updateConfig.HistoryArchivalUri = nsConfig.GetHistoryArchivalUri()
+	} else {
+		skipped = append(skipped, fmt.Sprintf("history archival: URI is already set to %s", uri))
+	}
+	if uri := currentConfig.GetVisibilityArchivalUri(); uri == "" || uri == nsConfig.GetVisibilityArchivalUri() {
+		updateConfig.VisibilityArchivalState = nsConfig.GetVisibilityArchivalState()
+		updateConfig.VisibilityArchivalUri = nsConfig.GetVisibilityArchivalUri()
+	} else {
+		skipped = append(skipped, fmt.Sprintf("visibility archival: URI is already set to %s", uri))
+	}
+	_, err = client.UpdateNamespace(ctx, &workflowservice.UpdateNamespaceRequest{
+		Namespace: nsName,
+		UpdateInfo: &namespacepb.UpdateNamespaceInfo{
+			Description: info.GetDescription(),
+			OwnerEmail:  info.GetOwnerEmail(),
+			Data:        info.GetData(),
+		},
+		Config: updateConfig,
+	})
+	return false, skipped, err
+}
+
+// ExportWorkflowExecutions returns a page of the executions started before the snapshot time, each with its mutable
+// state and the history up to the last event of that mutable state, so every execution is consistent on its own and no
+// older than the snapshot time. The snapshot time is chosen on the first page and carried in the page token together
+// with the position in the visibility listing, so later pages, including retried ones, list the same executions.
+// Executions deleted after they were listed are skipped.
+func (adh *AdminHandler) ExportWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.ExportWorkflowExecutionsRequest,
+) (_ *adminservice.ExportWorkflowExecutionsResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	namespaceName := namespace.Name(request.GetNamespace())
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	continuation := &tokenspb.ExportWorkflowExecutionsContinuation{
+		SnapshotTime: request.GetSnapshotTime(),
+	}
+	if len(request.GetNextPageToken()) > 0 {
+		continuation = &tokenspb.ExportWorkflowExecutionsContinuation{}
+		if err := continuation.Unmarshal(request.GetNextPageToken()); err != nil {
+			return nil, errInvalidNextPageToken
+		}
+	}
+	if continuation.SnapshotTime == nil {
+		continuation.SnapshotTime = timestamppb.New(time.Now().UTC())
+	}
+	pageSize := int(request.GetMaximumPageSize())
+	maxPageSize := adh.config.ExportWorkflowExecutionsMaxPageSize(request.GetNamespace())
+	if pageSize <= 0 || pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	// The visibility page size has to stay the same across requests for the offset in the token to be valid.
+	listPageSize := min(exportWorkflowExecutionsListPageSize, adh.config.VisibilityMaxPageSize(request.GetNamespace()))
+	query := fmt.Sprintf("%s < %q", searchattribute.StartTime, continuation.SnapshotTime.AsTime().Format(time.RFC3339Nano))
+
+	resp := &adminservice.ExportWorkflowExecutionsResponse{
+		SnapshotTime: continuation.SnapshotTime,
+	}
+	withNextPageToken := func() (*adminservice.ExportWorkflowExecutionsResponse, error) {
+		token, err := continuation.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		resp.NextPageToken = token
+		return resp, nil
+	}
+	for {
+		listResp, err := adh.visibilityMgr.ListWorkflowExecutions(ctx, &manager.ListWorkflowExecutionsRequestV2{
+			NamespaceID:   namespaceID,
+			Namespace:     namespaceName,
+			PageSize:      listPageSize,
+			NextPageToken: continuation.GetVisibilityPageToken(),
+			Query:         query,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for offset := int(continuation.GetOffset()); offset < len(listResp.Executions); offset++ {
+			if len(resp.Executions) >= pageSize {
+				continuation.Offset = int32(offset)
+				return withNextPageToken()
+			}
+			exported, err := adh.exportWorkflowExecution(ctx, namespaceID, listResp.Executions[offset].GetExecution())
+			if err != nil {
+				return nil, err
+			}
+			if exported != nil {
+				resp.Executions = append(resp.Executions, exported)
+			}
+		}
+		if len(listResp.NextPageToken) == 0 {
+			return resp, nil
+		}
+		continuation.VisibilityPageToken = listResp.NextPageToken
+		continuation.Offset = 0
+		if len(resp.Executions) >= pageSize {
+			return withNextPageToken()
+		}
+	}
+}
+
+// exportWorkflowExecution returns nil if the execution was deleted.
+func (adh *AdminHandler) exportWorkflowExecution(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	execution *commonpb.WorkflowExecution,
+) (*adminservice.ExportedWorkflowExecution, error) {
+	var notFound *serviceerror.NotFound
+	describeResp, err := adh.historyClient.DescribeMutableState(ctx, &historyservice.DescribeMutableStateRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   execution,
+	})
+	if errors.As(err, &notFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	mutableState := describeResp.GetDatabaseMutableState()
+	versionHistory, err := versionhistory.GetCurrentVersionHistory(mutableState.GetExecutionInfo().GetVersionHistories())
+	if err != nil {
+		return nil, err
+	}
+	lastItem, err := versionhistory.GetLastVersionHistoryItem(versionHistory)
+	if err != nil {
+		return nil, err
+	}
+
+	exported := &adminservice.ExportedWorkflowExecution{
+		Execution:      execution,
+		VersionHistory: versionhistory.CopyVersionHistory(versionHistory),
+		MutableState:   mutableState,
+	}
+	var pageToken []byte
+	for {
+		historyResp, err := adh.historyClient.GetWorkflowExecutionRawHistory(ctx, &historyservice.GetWorkflowExecutionRawHistoryRequest{
+			NamespaceId: namespaceID.String(),
+			Request: &adminservice.GetWorkflowExecutionRawHistoryRequest{
+				NamespaceId:     namespaceID.String(),
+				Execution:       execution,
+				EndEventId:      lastItem.GetEventId(),
+				EndEventVersion: lastItem.GetVersion(),
+				MaximumPageSize: exportWorkflowExecutionsHistoryPageSize,
+				NextPageToken:   pageToken,
+			},
+		})
+		if errors.As(err, &notFound) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		exported.HistoryBatches = append(exported.HistoryBatches, historyResp.GetResponse().GetHistoryBatches()...)
+
+		pageToken = historyResp.GetResponse().GetNextPageToken()
+		if len(pageToken) == 0 {
+			return exported, nil
+		}
+	}
+}
+
+// UpdateClusterMetadata changes the failover version increment or the addresses of a cluster
+// metadata record, after checking the change against the connected clusters and the namespaces.
+func (adh *AdminHandler) UpdateClusterMetadata(
+	ctx context.Context,
+	request *adminservice.UpdateClusterMetadataRequest,
+) (_ *adminservice.UpdateClusterMetadataResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	clusterName := request.GetClusterName()
+	if clusterName == "" {
+		clusterName = adh.clusterMetadata.GetCurrentClusterName()
+	}
+
+	record, err := adh.clusterMetadataManager.GetClusterMetadata(
+		ctx,
+		&persistence.GetClusterMetadataRequest{ClusterName: clusterName},
+	)
+	if err != nil {
+		return nil, err
+	}
+	updated := common.CloneProto(record.ClusterMetadata)
+	if increment := request.GetFailoverVersionIncrement(); increment != 0 {
+		updated.FailoverVersionIncrement = increment
+	}
+	if address := request.GetClusterAddress(); address != "" {
+		updated.ClusterAddress = address
+	}
+	if address := request.GetHttpAddress(); address != "" {
+		updated.HttpAddress = address
+	}
+	if proto.Equal(updated, record.ClusterMetadata) {
+		return nil, serviceerror.NewInvalidArgument("The request doesn't change the cluster metadata.")
+	}
+
+	incompatibilities, err := adh.checkClusterMetadataUpdate(ctx, record.ClusterMetadata, updated)
+	if err != nil {
+		return nil, err
+	}
+	if request.GetDryRun() {
+		return &adminservice.UpdateClusterMetadataResponse{Incompatibilities: incompatibilities}, nil
+	}
+	if len(incompatibilities) > 0 {
+		return nil, serviceerror.NewFailedPrecondition(
+			fmt.Sprintf("Cannot update cluster metadata: %s.", strings.Join(incompatibilities, "; ")))
+	}
+
+	applied, err := adh.clusterMetadataManager.SaveClusterMetadata(ctx, &persistence.SaveClusterMetadataRequest{
+		ClusterMetadata:                     updated,
+		Version:                             record.Version,
+		AllowFailoverVersionIncrementChange: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !applied {
+		return nil, serviceerror.NewInvalidArgument("Cannot update cluster metadata due to update immutable fields")
+	}
+	adh.logger.Info("Updated cluster metadata.",
+		tag.ClusterName(clusterName),
+		tag.NewInt64("failover-version-increment", updated.FailoverVersionIncrement),
+		tag.Address(updated.ClusterAddress),
+	)
+	return &adminservice.UpdateClusterMetadataResponse{}, nil
+}
+
+// checkClusterMetadataUpdate returns the reasons why changing the cluster metadata record from
+// old to updated would break the cluster or its replication.
+func (adh *AdminHandler) checkClusterMetadataUpdate(
+	ctx context.Context,
+	old *persistencespb.ClusterMetadata,
+	updated *persistencespb.ClusterMetadata,
+) ([]string, error) {
+	var incompatibilities []string
+	isCurrentCluster := updated.ClusterName == adh.clusterMetadata.GetCurrentClusterName()
+
+	addressChanged := updated.ClusterAddress != old.ClusterAddress || updated.HttpAddress != old.HttpAddress
+	if addressChanged && isCurrentCluster {
+		// the current cluster record is overwritten with the static config on startup
+		incompatibilities = append(incompatibilities,
+			"the addresses of the current cluster are taken from the static config, change them there")
+	} else if updated.ClusterAddress != old.ClusterAddress {
+		// the new address must reach the same cluster
+		resp, err := adh.clientFactory.NewRemoteAdminClientWithTimeout(
+			updated.ClusterAddress,
+			admin.DefaultTimeout,
+			admin.DefaultLargeTimeout,
+		).DescribeCluster(ctx, &adminservice.DescribeClusterRequest{})
+		if err != nil {
+			incompatibilities = append(incompatibilities,
+				fmt.Sprintf("cluster address %s can't be reached: %v", updated.ClusterAddress, err))
+		} else if resp.GetClusterId() != updated.ClusterId {
+			incompatibilities = append(incompatibilities,
+				fmt.Sprintf("cluster address %s belongs to cluster %q", updated.ClusterAddress, resp.GetClusterName()))
+		}
+	}
+
+	if updated.FailoverVersionIncrement != old.FailoverVersionIncrement {
+		fviIncompatibilities, err := adh.checkFailoverVersionIncrement(ctx, updated)
+		if err != nil {
+			return nil, err
+		}
+		incompatibilities = append(incompatibilities, fviIncompatibilities...)
+	}
+	return incompatibilities, nil
+}
+
+// checkFailoverVersionIncrement checks that the failover version increment of the updated record
+// is valid for all clusters, is used by all connected clusters, and maps the failover version of
+// every global namespace to the same active cluster as before.
+func (adh *AdminHandler) checkFailoverVersionIncrement(
+	ctx context.Context,
+	updated *persistencespb.ClusterMetadata,
+) ([]string, error) {
+	var incompatibilities []string
+	increment := updated.FailoverVersionIncrement
+	if increment <= 0 || increment > math.MaxInt32 {
+		return []string{fmt.Sprintf("failover version increment %d must be between 1 and %d", increment, math.MaxInt32)}, nil
+	}
+
+	versionToClusterName := make(map[int64]string)
+	for clusterName, info := range adh.clusterMetadata.GetAllClusterInfo() {
+		versionToClusterName[info.InitialFailoverVersion] = clusterName
+		if info.InitialFailoverVersion >= increment {
+			incompatibilities = append(incompatibilities, fmt.Sprintf(
+				"initial failover version %d of cluster %q must be smaller than the failover version increment",
+				info.InitialFailoverVersion, clusterName))
+		}
+		if clusterName == updated.ClusterName || !info.Enabled {
+			continue
+		}
+
+		// the failover version increment must be the same on all connected clusters
+		var clusterIncrement int64
+		if clusterName == adh.clusterMetadata.GetCurrentClusterName() {
+			clusterIncrement = adh.clusterMetadata.GetFailoverVersionIncrement()
+		} else {
+			resp, err := adh.clientFactory.NewRemoteAdminClientWithTimeout(
+				info.RPCAddress,
+				admin.DefaultTimeout,
+				admin.DefaultLargeTimeout,
+			).DescribeCluster(ctx, &adminservice.DescribeClusterRequest{})
+			if err != nil {
+				incompatibilities = append(incompatibilities,
+					fmt.Sprintf("connected cluster %q can't be reached: %v", clusterName, err))
+				continue
+			}
+			clusterIncrement = resp.GetFailoverVersionIncrement()
+		}
+		if clusterIncrement != increment {
+			incompatibilities = append(incompatibilities, fmt.Sprintf(
+				"connected cluster %q uses failover version increment %d, disconnect it first",
+				clusterName, clusterIncrement))
+		}
+	}
+
+	var mismatched int
+	var pageToken []byte
+	for {
+		resp, err := adh.persistenceMetadataManager.ListNamespaces(ctx, &persistence.ListNamespacesRequest{
+			PageSize:      updateClusterMetadataPageSize,
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, ns := range resp.Namespaces {
+			failoverVersion := ns.Namespace.GetFailoverVersion()
+			if !ns.IsGlobalNamespace || failoverVersion == common.EmptyVersion {
+				continue
+			}
+			activeClusterName := ns.Namespace.GetReplicationConfig().GetActiveClusterName()
+			if clusterName := versionToClusterName[failoverVersion%increment]; clusterName != activeClusterName {
+				mismatched++
+				if mismatched <= updateClusterMetadataMaxReportedNamespaces {
+					incompatibilities = append(incompatibilities, fmt.Sprintf(
+						"failover version %d of namespace %q would no longer belong to its active cluster %q",
+						failoverVersion, ns.Namespace.GetInfo().GetName(), activeClusterName))
+				}
+			}
+		}
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			break
+		}
+	}
+	if mismatched > updateClusterMetadataMaxReportedNamespaces {
+		incompatibilities = append(incompatibilities, fmt.Sprintf(
+			"failover versions of %d more namespaces would no longer belong to their active cluster",
+			mismatched-updateClusterMetadataMaxReportedNamespaces))
+	}
+	return incompatibilities, nil
+}
+
+// MoveShards pins history shards to other hosts, or removes the pins, by updating the
+// HistoryShardOwnershipOverrides dynamic config through the runtime overrides.
+func (adh *AdminHandler) MoveShards(
+	ctx context.Context,
+	request *adminservice.MoveShardsRequest,
+) (_ *adminservice.MoveShardsResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	modes := 0
+	for _, set := range []bool{request.GetTargetAddress() != "", request.GetSourceAddress() != "", request.GetUnpin()} {
+		if set {
+			modes++
+		}
+	}
+	if modes != 1 {
+		return nil, serviceerror.NewInvalidArgument("Exactly one of target address, source address and unpin must be set.")
+	}
+	if request.GetTargetAddress() != "" && len(request.GetShardIds()) == 0 {
+		return nil, serviceerror.NewInvalidArgument("Shard IDs must be set to move shards to a target host.")
+	}
+	shardIDs := make(map[int32]struct{}, len(request.GetShardIds()))
+	for _, shardID := range request.GetShardIds() {
+		if shardID < 1 || shardID > adh.numberOfHistoryShards {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(
+				"Shard ID %d is out of range, the cluster has %d shards.", shardID, adh.numberOfHistoryShards))
+		}
+		shardIDs[shardID] = struct{}{}
+	}
+
+	resolver, err := adh.membershipMonitor.GetResolver(primitives.HistoryService)
+	if err != nil {
+		return nil, err
+	}
+	key := dynamicconfig.HistoryShardOwnershipOverrides.Key()
+	current, err := adh.dynamicConfigOverrides.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var currentValues string
+	if override := current[dynamicconfig.Key(strings.ToLower(key.String()))]; override != nil {
+		currentValues = override.Values
+	}
+	overrideList, err := runtimeoverride.ShardOwnershipOverrides(currentValues)
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("Unable to decode shard ownership overrides: %v", err))
+	}
+	// pins to hosts that left the ring are dropped, their shards are owned by the ring's choice already
+	members := make(map[string]struct{})
+	for _, host := range resolver.Members() {
+		members[host.GetAddress()] = struct{}{}
+	}
+	overrides := make(map[int32]string, len(overrideList))
+	for _, override := range overrideList {
+		if _, ok := members[override.Address]; ok {
+			overrides[override.ShardID] = override.Address
+		}
+	}
+	owner := func(shardID int32) (string, error) {
+		host, err := membership.LookupWithOverride(resolver, convert.Int32ToString(shardID), overrides[shardID])
+		if err != nil {
+			return "", err
+		}
+		return host.GetAddress(), nil
+	}
+	available := make(map[string]struct{})
+	for _, host := range resolver.AvailableMembers() {
+		available[host.GetAddress()] = struct{}{}
+	}
+
+	var moves []*adminservice.ShardMove
+	move := func(shardID int32, to string, pin bool) error {
+		from, err := owner(shardID)
+		if err != nil {
+			return err
+		}
+		if pin {
+			overrides[shardID] = to
+		} else {
+			delete(overrides, shardID)
+		}
+		if from != to {
+			moves = append(moves, &adminservice.ShardMove{ShardId: shardID, FromAddress: from, ToAddress: to})
+		}
+		return nil
+	}
+
+	switch {
+	case request.GetTargetAddress() != "":
+		target := request.GetTargetAddress()
+		if _, ok := available[target]; !ok {
+			return nil, serviceerror.NewFailedPrecondition(fmt.Sprintf(
+				"%s is not an available history host, it must be a member of the ring and not draining.", target))
+		}
+		for _, shardID := range slices.Sorted(maps.Keys(shardIDs)) {
+			if err := move(shardID, target, true); err != nil {
+				return nil, err
+			}
+		}
+
+	case request.GetSourceAddress() != "":
+		source := request.GetSourceAddress()
+		if _, ok := members[source]; !ok {
+			return nil, serviceerror.NewFailedPrecondition(fmt.Sprintf("%s is not a history host.", source))
+		}
+		delete(available, source)
+		if len(available) == 0 {
+			return nil, serviceerror.NewFailedPrecondition("There is no other available history host to move the shards to.")
+		}
+		for shardID := int32(1); shardID <= adh.numberOfHistoryShards; shardID++ {
+			if _, ok := shardIDs[shardID]; len(shardIDs) > 0 && !ok {
+				continue
+			}
+			from, err := owner(shardID)
+			if err != nil {
+				return nil, err
+			}
+			if from != source {
+				continue
+			}
+			// prefer the hosts the ring would pick if the source left, to spread the shards like a restart would
+			var to string
+			for _, host := range resolver.LookupN(convert.Int32ToString(shardID), len(members)) {
+				if _, ok := available[host.GetAddress()]; ok {
+					to = host.GetAddress()
+					break
+				}
+			}
+			if to == "" {
+				return nil, serviceerror.NewUnavailable(fmt.Sprintf("No available history host found for shard %d.", shardID))
+			}
+			if err := move(shardID, to, true); err != nil {
+				return nil, err
+			}
+		}
+
+	default:
+		for _, shardID := range slices.Sorted(maps.Keys(overrides)) {
+			if _, ok := shardIDs[shardID]; len(shardIDs) > 0 && !ok {
+				continue
+			}
+			host, err := resolver.Lookup(convert.Int32ToString(shardID))
+			if err != nil {
+				return nil, err
+			}
+			if err := move(shardID, host.GetAddress(), false); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if request.GetDryRun() {
+		return &adminservice.MoveShardsResponse{Moves: moves}, nil
+	}
+	newList := make([]dynamicconfig.ShardOwnershipOverride, 0, len(overrides))
+	for shardID, address := range overrides {
+		newList = append(newList, dynamicconfig.ShardOwnershipOverride{ShardID: shardID, Address: address})
+	}
+	values, err := runtimeoverride.EncodeShardOwnershipOverrides(newList)
+	if err != nil {
+		return nil, err
+	}
+	if values != currentValues {
+		if _, err := adh.dynamicConfigOverrides.Set(ctx, key, values, approvalCallerIdentity(ctx)); err != nil {
+			return nil, err
+		}
+	}
+	for _, m := range moves {
+		adh.logger.Info("Moved history shard.",
+			tag.ShardID(m.ShardId),
+			tag.NewStringTag("from", m.FromAddress),
+			tag.NewStringTag("to", m.ToAddress),
+		)
+	}
+	return &adminservice.MoveShardsResponse{Moves: moves}, nil
+}
+
 func (adh *AdminHandler) getDLQWorkflowID(
 	key *commonspb.HistoryDLQKey,
 ) string {
@@ -26,12 +26,14 @@ package frontend
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/rand"
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/assert"
@@ -45,45 +47,69 @@ import (
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	sdkclient "go.temporal.io/sdk/client"
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/adminservicemock/v1"
+	clusterspb "go.temporal.io/server/api/cluster/v1"
 	commonspb "go.temporal.io/server/api/common/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/api/matchingservicemock/v1"
+	nexusspb "go.temporal.io/server/api/nexus/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	clientmocks "go.temporal.io/server/client"
 	historyclient "go.temporal.io/server/client/history"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/debugtrace"
+	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/dynamicconfig/runtimeoverride"
+	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/resourcetest"
+	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/common/searchattribute"
 	serviceerror2 "go.temporal.io/server/common/serviceerror"
 	test "go.temporal.io/server/common/testing"
 	"go.temporal.io/server/common/testing/historyrequire"
 	"go.temporal.io/server/common/testing/mocksdk"
+	"go.temporal.io/server/common/testing/protomock"
 	"go.temporal.io/server/common/testing/protorequire"
 	"go.temporal.io/server/common/testing/testvars"
+	"go.temporal.io/server/service/history/api/listtasks"
 	"go.temporal.io/server/service/history/tasks"
+	"go.temporal.io/server/service/worker/batcher"
 	"go.temporal.io/server/service/worker/dlq"
+	"go.temporal.io/server/service/worker/rearchival"
+	"go.temporal.io/server/service/worker/scheduler"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type (
@@ -106,6 +132,7 @@ type (
 		mockMetadata               *cluster.MockMetadata
 		mockProducer               *persistence.MockNamespaceReplicationQueue
 		mockMatchingClient         *matchingservicemock.MockMatchingServiceClient
+		mockNexusEndpointMgr       *persistence.MockNexusEndpointManager
 		mockSaMapper               *searchattribute.MockMapper
 
 		namespace      namespace.Name
@@ -151,6 +178,7 @@ func (s *adminHandlerSuite) SetupTest() {
 	s.mockVisibilityMgr = s.mockResource.VisibilityManager
 	s.mockProducer = persistence.NewMockNamespaceReplicationQueue(s.controller)
 	s.mockMatchingClient = s.mockResource.MatchingClient
+	s.mockNexusEndpointMgr = persistence.NewMockNexusEndpointManager(s.controller)
 
 	mockSaMapperProvider := searchattribute.NewMockMapperProvider(s.controller)
 	s.mockSaMapper = searchattribute.NewMockMapper(s.controller)
@@ -167,7 +195,11 @@ func (s *adminHandlerSuite) SetupTest() {
 		SearchAttributesSizeOfValueLimit:      dynamicconfig.GetIntPropertyFnFilteredByNamespace(10),
 		SearchAttributesTotalSizeLimit:        dynamicconfig.GetIntPropertyFnFilteredByNamespace(10),
 		VisibilityAllowList:                   dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
+		ReplicationStreamCompressor:           dynamicconfig.GetStringPropertyFn(""),
 		SuppressErrorSetSystemSearchAttribute: dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
+		AdminRedactDLQPayloads: func(namespace string) bool {
+			return namespace != "opted-out-namespace"
+		},
 	}
 	args := NewAdminHandlerArgs{
 		persistenceConfig,
@@ -196,6 +228,26 @@ func (s *adminHandlerSuite) SetupTest() {
 		health.NewServer(),
 		serialization.NewSerializer(),
 		clock.NewRealTimeSource(),
+		s.mockResource.GetArchivalMetadata(),
+		newNexusEndpointClient(
+			&nexusEndpointClientConfig{
+				listDefaultPageSize: dynamicconfig.GetIntPropertyFn(100),
+			},
+			s.mockNamespaceCache,
+			s.mockMatchingClient,
+			s.mockNexusEndpointMgr,
+			s.mockResource.GetLogger(),
+		),
+		nil,
+		NewProfileCapturer(
+			s.mockResource.GetHostInfoProvider(),
+			0,
+			dynamicconfig.GetStringPropertyFn(""),
+			clock.NewRealTimeSource(),
+		),
+		nil,
+		debugtrace.NewStore(time.Minute, 10),
+		runtimeoverride.NewStore(s.mockResource.GetMetadataManager(), clock.NewRealTimeSource()),
 		tasks.NewDefaultTaskCategoryRegistry(),
 		s.mockResource.GetMatchingClient(),
 	}
@@ -1189,12 +1241,104 @@ func (s *adminHandlerSuite) TestGetNamespace_WithNameSuccess() {
 	s.Equal(cluster.TestAlternativeClusterName, resp.GetReplicationConfig().GetActiveClusterName())
 }
 
+func (s *adminHandlerSuite) TestGetNamespace_HandoverProgress() {
+	namespaceName := "some name"
+	nsResponse := &persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{
+				Id:   "some id",
+				Name: namespaceName,
+			},
+			Config: &persistencespb.NamespaceConfig{},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []string{
+					cluster.TestCurrentClusterName,
+					cluster.TestAlternativeClusterName,
+				},
+				State: enumspb.REPLICATION_STATE_HANDOVER,
+			},
+		},
+		IsGlobalNamespace: true,
+	}
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{
+		Name: namespaceName,
+	}).Return(nsResponse, nil)
+	shardStatus := func(shardID int32, ackedTaskID int64, handover bool) *historyservice.ShardReplicationStatus {
+		status := &historyservice.ShardReplicationStatus{
+			ShardId: shardID,
+			RemoteClusters: map[string]*historyservice.ShardReplicationStatusPerCluster{
+				cluster.TestAlternativeClusterName: {AckedTaskId: ackedTaskID},
+			},
+		}
+		if handover {
+			status.HandoverNamespaces = map[string]*historyservice.HandoverNamespaceInfo{
+				namespaceName: {HandoverReplicationTaskId: 10},
+			}
+		}
+		return status
+	}
+	s.mockHistoryClient.EXPECT().GetReplicationStatus(gomock.Any(), &historyservice.GetReplicationStatusRequest{
+		RemoteClusters: []string{cluster.TestAlternativeClusterName},
+	}).Return(&historyservice.GetReplicationStatusResponse{
+		Shards: []*historyservice.ShardReplicationStatus{
+			shardStatus(1, 10, true),
+			shardStatus(2, 9, true),
+			shardStatus(3, 10, false),
+		},
+	}, nil)
+
+	resp, err := s.handler.GetNamespace(context.Background(), &adminservice.GetNamespaceRequest{
+		Attributes: &adminservice.GetNamespaceRequest_Namespace{
+			Namespace: namespaceName,
+		},
+	})
+	s.NoError(err)
+	s.Equal(int32(3), resp.GetHandoverProgress().GetTotalShards())
+	s.Equal(int32(1), resp.GetHandoverProgress().GetDrainedShards())
+}
+
 func (s *adminHandlerSuite) TestGetNamespace_EmptyRequest() {
 	v := &adminservice.GetNamespaceRequest{}
 	_, err := s.handler.GetNamespace(context.Background(), v)
 	s.Equal(errRequestNotSet, err)
 }
 
+func (s *adminHandlerSuite) TestGetDLQReplicationMessages_RedactsPayloads() {
+	newTask := func(namespaceID string) *replicationspb.ReplicationTask {
+		return &replicationspb.ReplicationTask{
+			TaskType: enumsspb.REPLICATION_TASK_TYPE_SYNC_ACTIVITY_TASK,
+			Attributes: &replicationspb.ReplicationTask_SyncActivityTaskAttributes{
+				SyncActivityTaskAttributes: &replicationspb.SyncActivityTaskAttributes{
+					NamespaceId: namespaceID,
+					Details:     payloads.EncodeString("secret"),
+				},
+			},
+		}
+	}
+	redactedTask := newTask("redacted-namespace-id")
+	optedOutTask := newTask("opted-out-namespace-id")
+	s.mockNamespaceCache.EXPECT().GetNamespaceName(namespace.ID("redacted-namespace-id")).Return(namespace.Name("redacted-namespace"), nil)
+	s.mockNamespaceCache.EXPECT().GetNamespaceName(namespace.ID("opted-out-namespace-id")).Return(namespace.Name("opted-out-namespace"), nil)
+	s.mockHistoryClient.EXPECT().GetDLQReplicationMessages(gomock.Any(), gomock.Any()).Return(&historyservice.GetDLQReplicationMessagesResponse{
+		ReplicationTasks: []*replicationspb.ReplicationTask{redactedTask, optedOutTask},
+	}, nil)
+
+	resp, err := s.handler.GetDLQReplicationMessages(context.Background(), &adminservice.GetDLQReplicationMessagesRequest{
+		TaskInfos: []*replicationspb.ReplicationTaskInfo{{}},
+	})
+	s.NoError(err)
+	s.Len(resp.GetReplicationTasks(), 2)
+	redactedDetails := resp.GetReplicationTasks()[0].GetSyncActivityTaskAttributes().GetDetails().GetPayloads()[0]
+	s.Nil(redactedDetails.GetData())
+	s.Equal(payloads.RedactedEncoding, string(redactedDetails.GetMetadata()["encoding"]))
+	s.Equal(optedOutTask, resp.GetReplicationTasks()[1])
+	// The task returned by history must not be modified.
+	var details string
+	s.NoError(payloads.Decode(redactedTask.GetSyncActivityTaskAttributes().GetDetails(), &details))
+	s.Equal("secret", details)
+}
+
 func (s *adminHandlerSuite) TestGetDLQTasks() {
 	for _, tc := range []struct {
 		name string
@@ -2107,6 +2251,1117 @@ func (s *adminHandlerSuite) TestImportWorkflowExecution_WithNonAliasedSearchAttr
 	}
 }
 
+func (s *adminHandlerSuite) TestReArchiveWorkflowExecutions() {
+	ctx := context.Background()
+	request := &adminservice.ReArchiveWorkflowExecutionsRequest{
+		Namespace: s.namespace.String(),
+		Query:     "WorkflowType = 'foo'",
+		Reason:    "provider migration",
+	}
+
+	// RPS too low to finish a single execution within the activity timeout.
+	_, err := s.handler.ReArchiveWorkflowExecutions(ctx, &adminservice.ReArchiveWorkflowExecutionsRequest{
+		Namespace: s.namespace.String(),
+		Rps:       float32(rearchival.MinRPS / 2),
+	})
+	s.ErrorIs(err, errReArchivalRPSTooLow)
+
+	// Archival is not enabled for the namespace.
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(s.namespaceEntry, nil)
+	_, err = s.handler.ReArchiveWorkflowExecutions(ctx, request)
+	s.ErrorIs(err, errArchivalNotEnabled)
+
+	// Success case.
+	archivalEnabledEntry := namespace.NewNamespaceForTest(
+		&persistencespb.NamespaceInfo{
+			Name: s.namespace.String(),
+			Id:   s.namespaceID.String(),
+		},
+		&persistencespb.NamespaceConfig{
+			HistoryArchivalState: enumspb.ARCHIVAL_STATE_ENABLED,
+			HistoryArchivalUri:   "file:///tmp/history",
+		},
+		false,
+		nil,
+		int64(100),
+	)
+	s.mockResource.ArchivalMetadata.SetHistoryEnabledByDefault()
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(archivalEnabledEntry, nil)
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient)
+	mockRun := mocksdk.NewMockWorkflowRun(s.controller)
+	mockRun.EXPECT().GetRunID().Return("run-id")
+	mockSdkClient.EXPECT().ExecuteWorkflow(gomock.Any(), gomock.Any(), rearchival.WorkflowName, gomock.Any()).DoAndReturn(
+		func(_ context.Context, options sdkclient.StartWorkflowOptions, _ string, args ...interface{}) (sdkclient.WorkflowRun, error) {
+			s.Equal(primitives.DefaultWorkerTaskQueue, options.TaskQueue)
+			s.Equal(rearchival.WorkflowParams{
+				Namespace:   s.namespace.String(),
+				NamespaceID: s.namespaceID.String(),
+				Query:       rearchival.BuildQuery(request.Query),
+			}, args[0])
+			return mockRun, nil
+		},
+	)
+	resp, err := s.handler.ReArchiveWorkflowExecutions(ctx, request)
+	s.NoError(err)
+	s.Equal("run-id", resp.GetRunId())
+	s.True(strings.HasPrefix(resp.GetJobId(), rearchival.WorkflowName))
+}
+
+func (s *adminHandlerSuite) TestStartSignalWithStartBatchOperation() {
+	ctx := context.Background()
+	request := &adminservice.StartSignalWithStartBatchOperationRequest{
+		Namespace:    s.namespace.String(),
+		JobId:        "job-id",
+		Reason:       "reactivate entities",
+		Identity:     "operator",
+		WorkflowIds:  []string{"entity-1", "entity-2"},
+		SignalName:   "wake-up",
+		WorkflowType: "entity-workflow",
+		TaskQueue:    "entity-task-queue",
+	}
+	s.handler.config.EnableBatcher = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true)
+	s.handler.config.MaxExecutionCountBatchOperation = dynamicconfig.GetIntPropertyFnFilteredByNamespace(10)
+	s.handler.config.MaxConcurrentBatchOperation = dynamicconfig.GetIntPropertyFnFilteredByNamespace(1)
+
+	// Validation errors.
+	_, err := s.handler.StartSignalWithStartBatchOperation(ctx, &adminservice.StartSignalWithStartBatchOperationRequest{
+		Namespace:       s.namespace.String(),
+		JobId:           "job-id",
+		Reason:          "reactivate entities",
+		WorkflowIds:     []string{"entity-1"},
+		VisibilityQuery: "WorkflowType = 'entity-workflow'",
+	})
+	s.ErrorIs(err, errBatchOpsWorkflowFiltersNotAllowed)
+	invalidRequest := common.CloneProto(request)
+	invalidRequest.TaskQueue = ""
+	_, err = s.handler.StartSignalWithStartBatchOperation(ctx, invalidRequest)
+	s.ErrorIs(err, errTaskQueueNotSet)
+
+	// Too many concurrent batch operations.
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).Times(2)
+	s.mockVisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(
+		&manager.CountWorkflowExecutionsResponse{Count: 1}, nil)
+	_, err = s.handler.StartSignalWithStartBatchOperation(ctx, request)
+	var resourceExhausted *serviceerror.ResourceExhausted
+	s.ErrorAs(err, &resourceExhausted)
+
+	// Success case.
+	s.mockVisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(
+		&manager.CountWorkflowExecutionsResponse{Count: 0}, nil)
+	s.mockHistoryClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *historyservice.StartWorkflowExecutionRequest, _ ...grpc.CallOption) (*historyservice.StartWorkflowExecutionResponse, error) {
+			startReq := req.GetStartRequest()
+			s.Equal(s.namespaceID.String(), req.GetNamespaceId())
+			s.Equal("job-id", startReq.GetWorkflowId())
+			s.Equal(batcher.BatchWFTypeName, startReq.GetWorkflowType().GetName())
+			s.Equal(primitives.PerNSWorkerTaskQueue, startReq.GetTaskQueue().GetName())
+
+			var params batcher.BatchParams
+			s.NoError(sdk.PreferProtoDataConverter.FromPayloads(startReq.GetInput(), &params))
+			s.Equal(batcher.BatchTypeSignalWithStart, params.BatchType)
+			s.Len(params.Executions, 2)
+			s.Equal("entity-2", params.Executions[1].GetWorkflowId())
+			s.Equal("wake-up", params.SignalWithStartParams.SignalName)
+			s.Equal("entity-workflow", params.SignalWithStartParams.WorkflowType)
+			s.Equal("entity-task-queue", params.SignalWithStartParams.TaskQueue)
+			return &historyservice.StartWorkflowExecutionResponse{}, nil
+		},
+	)
+	_, err = s.handler.StartSignalWithStartBatchOperation(ctx, request)
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestStartRebuildMutableStateBatchOperation() {
+	ctx := context.Background()
+	request := &adminservice.StartRebuildMutableStateBatchOperationRequest{
+		Namespace:       s.namespace.String(),
+		JobId:           "job-id",
+		Reason:          "fix corrupted mutable state",
+		Identity:        "operator",
+		VisibilityQuery: "WorkflowType = 'corrupted-workflow'",
+	}
+	s.handler.config.EnableBatcher = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true)
+	s.handler.config.MaxConcurrentBatchOperation = dynamicconfig.GetIntPropertyFnFilteredByNamespace(1)
+
+	// Validation errors.
+	invalidRequest := common.CloneProto(request)
+	invalidRequest.VisibilityQuery = ""
+	_, err := s.handler.StartRebuildMutableStateBatchOperation(ctx, invalidRequest)
+	s.ErrorIs(err, errVisibilityQueryNotSet)
+	invalidRequest = common.CloneProto(request)
+	invalidRequest.Reason = ""
+	_, err = s.handler.StartRebuildMutableStateBatchOperation(ctx, invalidRequest)
+	s.ErrorIs(err, errReasonNotSet)
+
+	// Too many concurrent batch operations.
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).Times(2)
+	s.mockVisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(
+		&manager.CountWorkflowExecutionsResponse{Count: 1}, nil)
+	_, err = s.handler.StartRebuildMutableStateBatchOperation(ctx, request)
+	var resourceExhausted *serviceerror.ResourceExhausted
+	s.ErrorAs(err, &resourceExhausted)
+
+	// Success case.
+	s.mockVisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(
+		&manager.CountWorkflowExecutionsResponse{Count: 0}, nil)
+	s.mockHistoryClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *historyservice.StartWorkflowExecutionRequest, _ ...grpc.CallOption) (*historyservice.StartWorkflowExecutionResponse, error) {
+			startReq := req.GetStartRequest()
+			s.Equal(s.namespaceID.String(), req.GetNamespaceId())
+			s.Equal("job-id", startReq.GetWorkflowId())
+			s.Equal(batcher.BatchWFTypeName, startReq.GetWorkflowType().GetName())
+			s.Equal("operator", startReq.GetIdentity())
+
+			var params batcher.BatchParams
+			s.NoError(sdk.PreferProtoDataConverter.FromPayloads(startReq.GetInput(), &params))
+			s.Equal(batcher.BatchTypeRebuildMutableState, params.BatchType)
+			s.Equal("WorkflowType = 'corrupted-workflow'", params.Query)
+			s.Empty(params.Executions)
+			return &historyservice.StartWorkflowExecutionResponse{}, nil
+		},
+	)
+	_, err = s.handler.StartRebuildMutableStateBatchOperation(ctx, request)
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestStartRefreshWorkflowTasksBatchOperation() {
+	ctx := context.Background()
+	request := &adminservice.StartRefreshWorkflowTasksBatchOperationRequest{
+		Namespace: s.namespace.String(),
+		JobId:     "job-id",
+		Reason:    "recover lost tasks",
+		Identity:  "operator",
+		Executions: []*commonpb.WorkflowExecution{
+			{WorkflowId: "workflow-1"},
+			{WorkflowId: "workflow-2", RunId: uuid.New()},
+		},
+	}
+	s.handler.config.EnableBatcher = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true)
+	s.handler.config.MaxExecutionCountBatchOperation = dynamicconfig.GetIntPropertyFnFilteredByNamespace(10)
+	s.handler.config.MaxConcurrentBatchOperation = dynamicconfig.GetIntPropertyFnFilteredByNamespace(1)
+
+	// Validation errors.
+	invalidRequest := common.CloneProto(request)
+	invalidRequest.VisibilityQuery = "WorkflowType = 'lost-tasks-workflow'"
+	_, err := s.handler.StartRefreshWorkflowTasksBatchOperation(ctx, invalidRequest)
+	s.ErrorIs(err, errBatchOpsWorkflowFiltersNotAllowed)
+	invalidRequest = common.CloneProto(request)
+	invalidRequest.Executions = nil
+	_, err = s.handler.StartRefreshWorkflowTasksBatchOperation(ctx, invalidRequest)
+	s.ErrorIs(err, errBatchOpsWorkflowFilterNotSet)
+	invalidRequest = common.CloneProto(request)
+	invalidRequest.Executions[1].RunId = "invalid"
+	_, err = s.handler.StartRefreshWorkflowTasksBatchOperation(ctx, invalidRequest)
+	s.ErrorIs(err, errInvalidRunID)
+
+	// Success case.
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockVisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(
+		&manager.CountWorkflowExecutionsResponse{Count: 0}, nil)
+	s.mockHistoryClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *historyservice.StartWorkflowExecutionRequest, _ ...grpc.CallOption) (*historyservice.StartWorkflowExecutionResponse, error) {
+			startReq := req.GetStartRequest()
+			s.Equal(s.namespaceID.String(), req.GetNamespaceId())
+			s.Equal("job-id", startReq.GetWorkflowId())
+			s.Equal(batcher.BatchWFTypeName, startReq.GetWorkflowType().GetName())
+
+			var params batcher.BatchParams
+			s.NoError(sdk.PreferProtoDataConverter.FromPayloads(startReq.GetInput(), &params))
+			s.Equal(batcher.BatchTypeRefreshWorkflowTasks, params.BatchType)
+			s.Empty(params.Query)
+			s.Len(params.Executions, 2)
+			s.Equal(request.Executions[1].GetRunId(), params.Executions[1].GetRunId())
+			return &historyservice.StartWorkflowExecutionResponse{}, nil
+		},
+	)
+	_, err = s.handler.StartRefreshWorkflowTasksBatchOperation(ctx, request)
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestCancelScheduleBackfill() {
+	ctx := context.Background()
+	request := &adminservice.CancelScheduleBackfillRequest{
+		Namespace:  s.namespace.String(),
+		ScheduleId: "schedule-id",
+		BackfillId: "3",
+		Identity:   "operator",
+	}
+
+	_, err := s.handler.CancelScheduleBackfill(ctx, &adminservice.CancelScheduleBackfillRequest{Namespace: s.namespace.String()})
+	s.ErrorIs(err, errScheduleIDNotSet)
+
+	s.handler.config.EnableSchedules = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false)
+	_, err = s.handler.CancelScheduleBackfill(ctx, request)
+	s.ErrorIs(err, errSchedulesNotAllowed)
+
+	s.handler.config.EnableSchedules = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true)
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockHistoryClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *historyservice.SignalWorkflowExecutionRequest, _ ...grpc.CallOption) (*historyservice.SignalWorkflowExecutionResponse, error) {
+			signalReq := req.GetSignalRequest()
+			s.Equal(s.namespaceID.String(), req.GetNamespaceId())
+			s.Equal(scheduler.WorkflowIDPrefix+"schedule-id", signalReq.GetWorkflowExecution().GetWorkflowId())
+			s.Equal(scheduler.SignalNameCancelBackfill, signalReq.GetSignalName())
+
+			var cancelReq schedulespb.CancelBackfillRequest
+			s.NoError(sdk.PreferProtoDataConverter.FromPayloads(signalReq.GetInput(), &cancelReq))
+			s.Equal("3", cancelReq.GetBackfillId())
+			return &historyservice.SignalWorkflowExecutionResponse{}, nil
+		},
+	)
+	_, err = s.handler.CancelScheduleBackfill(ctx, request)
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestUpdateSchedulePolicies() {
+	ctx := context.Background()
+	request := &adminservice.UpdateSchedulePoliciesRequest{
+		Namespace:  s.namespace.String(),
+		ScheduleId: "schedule-id",
+		Policies: &schedulespb.ServerSchedulePolicies{
+			PauseAfterConsecutiveFailures: 3,
+			DaylightSavingPolicy:          enumsspb.SCHEDULE_DAYLIGHT_SAVING_POLICY_SKIP,
+		},
+		Identity: "operator",
+	}
+
+	_, err := s.handler.UpdateSchedulePolicies(ctx, &adminservice.UpdateSchedulePoliciesRequest{Namespace: s.namespace.String()})
+	s.ErrorIs(err, errScheduleIDNotSet)
+
+	_, err = s.handler.UpdateSchedulePolicies(ctx, &adminservice.UpdateSchedulePoliciesRequest{
+		Namespace:  s.namespace.String(),
+		ScheduleId: "schedule-id",
+		Policies:   &schedulespb.ServerSchedulePolicies{PauseAfterConsecutiveFailures: -1},
+	})
+	s.ErrorIs(err, errInvalidPauseAfterConsecutiveFailures)
+
+	_, err = s.handler.UpdateSchedulePolicies(ctx, &adminservice.UpdateSchedulePoliciesRequest{
+		Namespace:  s.namespace.String(),
+		ScheduleId: "schedule-id",
+		Policies:   &schedulespb.ServerSchedulePolicies{DaylightSavingPolicy: 99},
+	})
+	s.ErrorIs(err, errInvalidScheduleDaylightSavingPolicy)
+
+	s.handler.config.EnableSchedules = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false)
+	_, err = s.handler.UpdateSchedulePolicies(ctx, request)
+	s.ErrorIs(err, errSchedulesNotAllowed)
+
+	s.handler.config.EnableSchedules = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true)
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockHistoryClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *historyservice.SignalWorkflowExecutionRequest, _ ...grpc.CallOption) (*historyservice.SignalWorkflowExecutionResponse, error) {
+			signalReq := req.GetSignalRequest()
+			s.Equal(s.namespaceID.String(), req.GetNamespaceId())
+			s.Equal(scheduler.WorkflowIDPrefix+"schedule-id", signalReq.GetWorkflowExecution().GetWorkflowId())
+			s.Equal(scheduler.SignalNameUpdatePolicies, signalReq.GetSignalName())
+
+			var policies schedulespb.ServerSchedulePolicies
+			s.NoError(sdk.PreferProtoDataConverter.FromPayloads(signalReq.GetInput(), &policies))
+			s.Equal(int32(3), policies.GetPauseAfterConsecutiveFailures())
+			s.Equal(enumsspb.SCHEDULE_DAYLIGHT_SAVING_POLICY_SKIP, policies.GetDaylightSavingPolicy())
+			return &historyservice.SignalWorkflowExecutionResponse{}, nil
+		},
+	)
+	_, err = s.handler.UpdateSchedulePolicies(ctx, request)
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestUpdateNexusEndpointOperationDefaults() {
+	ctx := context.Background()
+	endpointID := uuid.New()
+	entry := &persistencespb.NexusEndpointEntry{
+		Version: 3,
+		Id:      endpointID,
+		Endpoint: &persistencespb.NexusEndpoint{
+			Spec: &persistencespb.NexusEndpointSpec{Name: "endpoint"},
+		},
+	}
+
+	_, err := s.handler.UpdateNexusEndpointOperationDefaults(ctx, &adminservice.UpdateNexusEndpointOperationDefaultsRequest{Id: "malformed", Version: 3})
+	s.ErrorContains(err, "malformed endpoint ID")
+
+	_, err = s.handler.UpdateNexusEndpointOperationDefaults(ctx, &adminservice.UpdateNexusEndpointOperationDefaultsRequest{
+		Id:      endpointID,
+		Version: 3,
+		OperationDefaults: &persistencespb.NexusOperationDefaults{
+			RetryPolicy: &commonpb.RetryPolicy{BackoffCoefficient: 0.5},
+		},
+	})
+	var invalidArgErr *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgErr)
+
+	s.mockNexusEndpointMgr.EXPECT().GetNexusEndpoint(gomock.Any(), &persistence.GetNexusEndpointRequest{ID: endpointID}).Return(entry, nil)
+	s.mockMatchingClient.EXPECT().UpdateNexusEndpoint(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *matchingservice.UpdateNexusEndpointRequest, _ ...grpc.CallOption) (*matchingservice.UpdateNexusEndpointResponse, error) {
+			s.Equal(endpointID, req.GetId())
+			s.Equal(int64(3), req.GetVersion())
+			s.Equal("endpoint", req.GetSpec().GetName())
+			defaults := req.GetSpec().GetOperationDefaults()
+			s.Equal(time.Hour, defaults.GetScheduleToCloseTimeout().AsDuration())
+			// unset retry policy fields are filled with the defaults
+			s.Equal(int32(5), defaults.GetRetryPolicy().GetMaximumAttempts())
+			s.Equal(time.Second, defaults.GetRetryPolicy().GetInitialInterval().AsDuration())
+			s.Equal(2.0, defaults.GetRetryPolicy().GetBackoffCoefficient())
+			return &matchingservice.UpdateNexusEndpointResponse{Entry: &persistencespb.NexusEndpointEntry{Id: endpointID, Version: 4}}, nil
+		},
+	)
+	resp, err := s.handler.UpdateNexusEndpointOperationDefaults(ctx, &adminservice.UpdateNexusEndpointOperationDefaultsRequest{
+		Id:      endpointID,
+		Version: 3,
+		OperationDefaults: &persistencespb.NexusOperationDefaults{
+			ScheduleToCloseTimeout: durationpb.New(time.Hour),
+			RetryPolicy:            &commonpb.RetryPolicy{MaximumAttempts: 5},
+		},
+	})
+	s.NoError(err)
+	s.Equal(int64(4), resp.GetEntry().GetVersion())
+}
+
+func (s *adminHandlerSuite) TestListNexusEndpointHealth() {
+	ctx := context.Background()
+	now := time.Now().UTC()
+	s.mockResource.HistoryServiceResolver.EXPECT().AvailableMembers().Return([]membership.HostInfo{
+		membership.NewHostInfoFromAddress("host-1"),
+		membership.NewHostInfoFromAddress("host-2"),
+		membership.NewHostInfoFromAddress("host-3"),
+	})
+	s.mockHistoryClient.EXPECT().ListNexusEndpointHealth(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.ListNexusEndpointHealthRequest, _ ...grpc.CallOption) (*historyservice.ListNexusEndpointHealthResponse, error) {
+			s.Equal([]string{"endpoint-a", "endpoint-b"}, request.GetEndpointNames())
+			switch request.GetHostAddress() {
+			case "host-1":
+				return &historyservice.ListNexusEndpointHealthResponse{Endpoints: []*nexusspb.EndpointHealth{
+					{
+						EndpointId:         "id-b",
+						EndpointName:       "endpoint-b",
+						SuccessCount:       1,
+						FailureCount:       2,
+						LastFailureTime:    timestamppb.New(now.Add(-time.Minute)),
+						LastFailureMessage: "old failure",
+						CircuitBreakers: []*nexusspb.EndpointCircuitBreaker{
+							{NamespaceId: "ns", HostAddress: "host-1", State: enumsspb.CIRCUIT_BREAKER_STATE_OPEN},
+						},
+					},
+				}}, nil
+			case "host-2":
+				return &historyservice.ListNexusEndpointHealthResponse{Endpoints: []*nexusspb.EndpointHealth{
+					{
+						EndpointId:   "id-a",
+						EndpointName: "endpoint-a",
+						SuccessCount: 5,
+					},
+					{
+						EndpointId:         "id-b",
+						EndpointName:       "endpoint-b",
+						SuccessCount:       3,
+						FailureCount:       1,
+						LastSuccessTime:    timestamppb.New(now),
+						LastFailureTime:    timestamppb.New(now),
+						LastFailureMessage: "new failure",
+						CircuitBreakers: []*nexusspb.EndpointCircuitBreaker{
+							{NamespaceId: "ns", HostAddress: "host-2", State: enumsspb.CIRCUIT_BREAKER_STATE_CLOSED},
+						},
+					},
+				}}, nil
+			default:
+				return nil, serviceerror.NewUnavailable("host down")
+			}
+		},
+	).Times(3)
+
+	resp, err := s.handler.ListNexusEndpointHealth(ctx, &adminservice.ListNexusEndpointHealthRequest{
+		EndpointNames: []string{"endpoint-a", "endpoint-b"},
+	})
+	s.NoError(err)
+	s.Equal([]string{"host-3"}, resp.GetUnreachableHosts())
+	s.Len(resp.GetEndpoints(), 2)
+	s.Equal("endpoint-a", resp.GetEndpoints()[0].GetEndpointName())
+	s.Equal(int64(5), resp.GetEndpoints()[0].GetSuccessCount())
+	endpointB := resp.GetEndpoints()[1]
+	s.Equal("endpoint-b", endpointB.GetEndpointName())
+	s.Equal(int64(4), endpointB.GetSuccessCount())
+	s.Equal(int64(3), endpointB.GetFailureCount())
+	s.Equal(now, endpointB.GetLastSuccessTime().AsTime())
+	s.Equal(now, endpointB.GetLastFailureTime().AsTime())
+	s.Equal("new failure", endpointB.GetLastFailureMessage())
+	s.Len(endpointB.GetCircuitBreakers(), 2)
+	s.Equal("host-1", endpointB.GetCircuitBreakers()[0].GetHostAddress())
+	s.Equal(enumsspb.CIRCUIT_BREAKER_STATE_OPEN, endpointB.GetCircuitBreakers()[0].GetState())
+	s.Equal("host-2", endpointB.GetCircuitBreakers()[1].GetHostAddress())
+}
+
+func (s *adminHandlerSuite) TestUpdateNexusEndpointOutboundAuth() {
+	ctx := context.Background()
+	endpointID := uuid.New()
+	newEntry := func(target *persistencespb.NexusEndpointTarget) *persistencespb.NexusEndpointEntry {
+		return &persistencespb.NexusEndpointEntry{
+			Version: 3,
+			Id:      endpointID,
+			Endpoint: &persistencespb.NexusEndpoint{
+				Spec: &persistencespb.NexusEndpointSpec{Name: "endpoint", Target: target},
+			},
+		}
+	}
+	auth := &persistencespb.NexusOutboundAuth{
+		Token: &persistencespb.NexusOutboundAuth_Oauth2ClientCredentials{
+			Oauth2ClientCredentials: &persistencespb.NexusOutboundAuth_OAuth2ClientCredentials{
+				TokenUrl:         "https://auth.example.com/token",
+				ClientId:         "client",
+				ClientSecretName: "client-secret",
+			},
+		},
+		MutualTls: &persistencespb.NexusOutboundAuth_MutualTLS{
+			CertSecretName: "cert",
+			KeySecretName:  "key",
+		},
+	}
+
+	_, err := s.handler.UpdateNexusEndpointOutboundAuth(ctx, &adminservice.UpdateNexusEndpointOutboundAuthRequest{
+		Id:      endpointID,
+		Version: 3,
+		OutboundAuth: &persistencespb.NexusOutboundAuth{
+			Token: &persistencespb.NexusOutboundAuth_Oauth2ClientCredentials{
+				Oauth2ClientCredentials: &persistencespb.NexusOutboundAuth_OAuth2ClientCredentials{TokenUrl: "/token"},
+			},
+		},
+	})
+	s.ErrorContains(err, "OAuth2 token URL must be an absolute http or https URL")
+	s.ErrorContains(err, "OAuth2 client ID not set")
+
+	// only external targets accept credentials
+	s.mockNexusEndpointMgr.EXPECT().GetNexusEndpoint(gomock.Any(), &persistence.GetNexusEndpointRequest{ID: endpointID}).Return(newEntry(
+		&persistencespb.NexusEndpointTarget{
+			Variant: &persistencespb.NexusEndpointTarget_Worker_{Worker: &persistencespb.NexusEndpointTarget_Worker{TaskQueue: "tq"}},
+		},
+	), nil)
+	_, err = s.handler.UpdateNexusEndpointOutboundAuth(ctx, &adminservice.UpdateNexusEndpointOutboundAuthRequest{
+		Id:           endpointID,
+		Version:      3,
+		OutboundAuth: auth,
+	})
+	var invalidArgErr *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgErr)
+
+	s.mockNexusEndpointMgr.EXPECT().GetNexusEndpoint(gomock.Any(), &persistence.GetNexusEndpointRequest{ID: endpointID}).Return(newEntry(
+		&persistencespb.NexusEndpointTarget{
+			Variant: &persistencespb.NexusEndpointTarget_External_{External: &persistencespb.NexusEndpointTarget_External{Url: "https://example.com"}},
+		},
+	), nil)
+	s.mockMatchingClient.EXPECT().UpdateNexusEndpoint(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *matchingservice.UpdateNexusEndpointRequest, _ ...grpc.CallOption) (*matchingservice.UpdateNexusEndpointResponse, error) {
+			s.Equal(endpointID, req.GetId())
+			s.Equal(int64(3), req.GetVersion())
+			s.Equal("https://example.com", req.GetSpec().GetTarget().GetExternal().GetUrl())
+			s.ProtoEqual(auth, req.GetSpec().GetOutboundAuth())
+			return &matchingservice.UpdateNexusEndpointResponse{Entry: &persistencespb.NexusEndpointEntry{Id: endpointID, Version: 4}}, nil
+		},
+	)
+	resp, err := s.handler.UpdateNexusEndpointOutboundAuth(ctx, &adminservice.UpdateNexusEndpointOutboundAuthRequest{
+		Id:           endpointID,
+		Version:      3,
+		OutboundAuth: auth,
+	})
+	s.NoError(err)
+	s.Equal(int64(4), resp.GetEntry().GetVersion())
+}
+
+func (s *adminHandlerSuite) TestReplicateNexusEndpoints() {
+	ctx := context.Background()
+	entries := []*persistencespb.NexusEndpointEntry{
+		{Id: "id-1", Version: 1, Endpoint: &persistencespb.NexusEndpoint{Spec: &persistencespb.NexusEndpointSpec{Name: "endpoint-1"}}},
+		{Id: "id-2", Version: 2, Endpoint: &persistencespb.NexusEndpoint{Spec: &persistencespb.NexusEndpointSpec{Name: "endpoint-2"}}},
+	}
+	var published []string
+	s.mockProducer.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, task *replicationspb.ReplicationTask) error {
+			s.Equal(enumsspb.REPLICATION_TASK_TYPE_NEXUS_ENDPOINT, task.GetTaskType())
+			published = append(published, task.GetNexusEndpointAttributes().GetId())
+			return nil
+		},
+	).AnyTimes()
+
+	// all endpoints
+	s.mockNexusEndpointMgr.EXPECT().ListNexusEndpoints(gomock.Any(), gomock.Any()).Return(&persistence.ListNexusEndpointsResponse{
+		Entries:       entries[:1],
+		NextPageToken: []byte("token"),
+	}, nil)
+	s.mockNexusEndpointMgr.EXPECT().ListNexusEndpoints(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *persistence.ListNexusEndpointsRequest) (*persistence.ListNexusEndpointsResponse, error) {
+			s.Equal([]byte("token"), req.NextPageToken)
+			s.True(req.IncludeTombstones)
+			return &persistence.ListNexusEndpointsResponse{Entries: entries[1:]}, nil
+		},
+	)
+	resp, err := s.handler.ReplicateNexusEndpoints(ctx, &adminservice.ReplicateNexusEndpointsRequest{})
+	s.NoError(err)
+	s.Equal([]string{"id-1", "id-2"}, resp.GetReplicatedIds())
+	s.Equal([]string{"id-1", "id-2"}, published)
+
+	// selected endpoints
+	published = nil
+	s.mockNexusEndpointMgr.EXPECT().GetNexusEndpoint(gomock.Any(), &persistence.GetNexusEndpointRequest{ID: "id-2", IncludeTombstones: true}).Return(entries[1], nil)
+	resp, err = s.handler.ReplicateNexusEndpoints(ctx, &adminservice.ReplicateNexusEndpointsRequest{Ids: []string{"id-2"}})
+	s.NoError(err)
+	s.Equal([]string{"id-2"}, resp.GetReplicatedIds())
+	s.Equal([]string{"id-2"}, published)
+
+	// global namespaces disabled
+	s.handler.replicatorNamespaceReplicationQueue = nil
+	_, err = s.handler.ReplicateNexusEndpoints(ctx, &adminservice.ReplicateNexusEndpointsRequest{})
+	s.ErrorIs(err, errNexusEndpointReplicationNotSupported)
+}
+
+func (s *adminHandlerSuite) TestListPendingNexusOperations() {
+	ctx := context.Background()
+	s.handler.config.VisibilityMaxPageSize = dynamicconfig.GetIntPropertyFnFilteredByNamespace(100)
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+
+	completed := &commonpb.WorkflowExecution{WorkflowId: "completed", RunId: uuid.New()}
+	blocked := &commonpb.WorkflowExecution{WorkflowId: "blocked", RunId: uuid.New()}
+	s.mockVisibilityMgr.EXPECT().ListWorkflowExecutions(gomock.Any(), &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID:   s.namespaceID,
+		Namespace:     s.namespace,
+		PageSize:      100,
+		NextPageToken: []byte("token"),
+		Query:         `ExecutionStatus = "Running" AND TemporalPendingNexusOperations = 'endpoint=endpoint' AND TemporalPendingNexusOperations = 'operation=it\'s'`,
+	}).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			{Execution: completed, Type: &commonpb.WorkflowType{Name: "wf"}},
+			{Execution: blocked, Type: &commonpb.WorkflowType{Name: "wf"}},
+		},
+		NextPageToken: []byte("next"),
+	}, nil)
+	s.mockHistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *historyservice.DescribeWorkflowExecutionRequest, _ ...grpc.CallOption) (*historyservice.DescribeWorkflowExecutionResponse, error) {
+			if req.GetRequest().GetExecution().GetWorkflowId() == completed.WorkflowId {
+				return &historyservice.DescribeWorkflowExecutionResponse{}, nil
+			}
+			return &historyservice.DescribeWorkflowExecutionResponse{
+				PendingNexusOperations: []*workflowpb.PendingNexusOperationInfo{
+					{Endpoint: "endpoint", Operation: "it's", State: enumspb.PENDING_NEXUS_OPERATION_STATE_BLOCKED},
+					{Endpoint: "other", Operation: "it's", State: enumspb.PENDING_NEXUS_OPERATION_STATE_SCHEDULED},
+				},
+			}, nil
+		},
+	).Times(2)
+
+	resp, err := s.handler.ListPendingNexusOperations(ctx, &adminservice.ListPendingNexusOperationsRequest{
+		Namespace:     s.namespace.String(),
+		Endpoint:      "endpoint",
+		Operation:     "it's",
+		NextPageToken: []byte("token"),
+	})
+	s.NoError(err)
+	s.Equal([]byte("next"), resp.GetNextPageToken())
+	s.Len(resp.GetExecutions(), 1)
+	s.Equal(blocked.WorkflowId, resp.GetExecutions()[0].GetExecution().GetWorkflowId())
+	s.Equal("wf", resp.GetExecutions()[0].GetWorkflowType())
+	s.Len(resp.GetExecutions()[0].GetOperations(), 1)
+	s.Equal(enumspb.PENDING_NEXUS_OPERATION_STATE_BLOCKED, resp.GetExecutions()[0].GetOperations()[0].GetState())
+}
+
+func (s *adminHandlerSuite) TestExportWorkflowExecutions() {
+	ctx := context.Background()
+	s.handler.config.VisibilityMaxPageSize = dynamicconfig.GetIntPropertyFnFilteredByNamespace(3)
+	s.handler.config.ExportWorkflowExecutionsMaxPageSize = dynamicconfig.GetIntPropertyFnFilteredByNamespace(10)
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+
+	snapshotTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	query := `StartTime < "2024-01-02T03:04:05Z"`
+	first := &commonpb.WorkflowExecution{WorkflowId: "first", RunId: uuid.New()}
+	deleted := &commonpb.WorkflowExecution{WorkflowId: "deleted", RunId: uuid.New()}
+	second := &commonpb.WorkflowExecution{WorkflowId: "second", RunId: uuid.New()}
+	third := &commonpb.WorkflowExecution{WorkflowId: "third", RunId: uuid.New()}
+	// The first visibility page is listed again for the second page, which resumes within it.
+	s.mockVisibilityMgr.EXPECT().ListWorkflowExecutions(gomock.Any(), &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID: s.namespaceID,
+		Namespace:   s.namespace,
+		PageSize:    3,
+		Query:       query,
+	}).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions:    []*workflowpb.WorkflowExecutionInfo{{Execution: first}, {Execution: deleted}, {Execution: second}},
+		NextPageToken: []byte("visibility-token"),
+	}, nil).Times(2)
+	s.mockVisibilityMgr.EXPECT().ListWorkflowExecutions(gomock.Any(), &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID:   s.namespaceID,
+		Namespace:     s.namespace,
+		PageSize:      3,
+		NextPageToken: []byte("visibility-token"),
+		Query:         query,
+	}).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{{Execution: third}},
+	}, nil)
+
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *historyservice.DescribeMutableStateRequest, _ ...grpc.CallOption) (*historyservice.DescribeMutableStateResponse, error) {
+			if req.GetExecution().GetWorkflowId() == deleted.WorkflowId {
+				return nil, serviceerror.NewNotFound("deleted")
+			}
+			return &historyservice.DescribeMutableStateResponse{
+				DatabaseMutableState: &persistencespb.WorkflowMutableState{
+					ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+						WorkflowId: req.GetExecution().GetWorkflowId(),
+						VersionHistories: versionhistory.NewVersionHistories(versionhistory.NewVersionHistory(
+							nil,
+							[]*historyspb.VersionHistoryItem{versionhistory.NewVersionHistoryItem(10, 2)},
+						)),
+					},
+				},
+			}, nil
+		},
+	).Times(4)
+	s.mockHistoryClient.EXPECT().GetWorkflowExecutionRawHistory(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *historyservice.GetWorkflowExecutionRawHistoryRequest, _ ...grpc.CallOption) (*historyservice.GetWorkflowExecutionRawHistoryResponse, error) {
+			s.Equal(int64(10), req.GetRequest().GetEndEventId())
+			s.Equal(int64(2), req.GetRequest().GetEndEventVersion())
+			if len(req.GetRequest().GetNextPageToken()) == 0 {
+				return &historyservice.GetWorkflowExecutionRawHistoryResponse{
+					Response: &adminservice.GetWorkflowExecutionRawHistoryResponse{
+						HistoryBatches: []*commonpb.DataBlob{{Data: []byte("batch-1")}},
+						NextPageToken:  []byte("history-token"),
+					},
+				}, nil
+			}
+			return &historyservice.GetWorkflowExecutionRawHistoryResponse{
+				Response: &adminservice.GetWorkflowExecutionRawHistoryResponse{
+					HistoryBatches: []*commonpb.DataBlob{{Data: []byte("batch-2")}},
+				},
+			}, nil
+		},
+	).Times(6)
+
+	var exported []string
+	request := &adminservice.ExportWorkflowExecutionsRequest{
+		Namespace:       s.namespace.String(),
+		SnapshotTime:    timestamppb.New(snapshotTime),
+		MaximumPageSize: 1,
+	}
+	for pages := 1; ; pages++ {
+		resp, err := s.handler.ExportWorkflowExecutions(ctx, request)
+		s.NoError(err)
+		s.Equal(snapshotTime, resp.GetSnapshotTime().AsTime())
+		s.Len(resp.GetExecutions(), 1)
+		execution := resp.GetExecutions()[0]
+		s.Equal(execution.GetExecution().GetWorkflowId(), execution.GetMutableState().GetExecutionInfo().GetWorkflowId())
+		s.Equal(int64(10), execution.GetVersionHistory().GetItems()[0].GetEventId())
+		s.Len(execution.GetHistoryBatches(), 2)
+		exported = append(exported, execution.GetExecution().GetWorkflowId())
+		if len(resp.GetNextPageToken()) == 0 {
+			s.Equal(3, pages)
+			break
+		}
+		// The snapshot time is taken from the token.
+		request = &adminservice.ExportWorkflowExecutionsRequest{
+			Namespace:       s.namespace.String(),
+			SnapshotTime:    timestamppb.Now(),
+			MaximumPageSize: 1,
+			NextPageToken:   resp.GetNextPageToken(),
+		}
+	}
+	s.Equal([]string{first.WorkflowId, second.WorkflowId, third.WorkflowId}, exported)
+
+	_, err := s.handler.ExportWorkflowExecutions(ctx, &adminservice.ExportWorkflowExecutionsRequest{
+		Namespace:     s.namespace.String(),
+		NextPageToken: []byte("invalid"),
+	})
+	s.ErrorIs(err, errInvalidNextPageToken)
+}
+
+func (s *adminHandlerSuite) TestSignalWorkflowExecutionsByQuery() {
+	ctx := context.Background()
+	s.handler.config.VisibilityMaxPageSize = dynamicconfig.GetIntPropertyFnFilteredByNamespace(2)
+	s.handler.config.AdminSignalByQueryMaxExecutions = dynamicconfig.GetIntPropertyFnFilteredByNamespace(100)
+	s.handler.config.MaxIDLengthLimit = dynamicconfig.GetIntPropertyFn(1000)
+	s.handler.config.BlobSizeLimitError = dynamicconfig.GetIntPropertyFnFilteredByNamespace(1024)
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+
+	completed := &commonpb.WorkflowExecution{WorkflowId: "completed", RunId: uuid.New()}
+	running := &commonpb.WorkflowExecution{WorkflowId: "running", RunId: uuid.New()}
+	other := &commonpb.WorkflowExecution{WorkflowId: "other", RunId: uuid.New()}
+	query := `WorkflowType = "wf"`
+	s.mockVisibilityMgr.EXPECT().ListWorkflowExecutions(gomock.Any(), &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID: s.namespaceID,
+		Namespace:   s.namespace,
+		PageSize:    2,
+		Query:       query,
+	}).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions:    []*workflowpb.WorkflowExecutionInfo{{Execution: completed}, {Execution: running}},
+		NextPageToken: []byte("next"),
+	}, nil)
+	s.mockVisibilityMgr.EXPECT().ListWorkflowExecutions(gomock.Any(), &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID:   s.namespaceID,
+		Namespace:     s.namespace,
+		PageSize:      1,
+		NextPageToken: []byte("next"),
+		Query:         query,
+	}).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions:    []*workflowpb.WorkflowExecutionInfo{{Execution: other}},
+		NextPageToken: []byte("more"),
+	}, nil)
+	s.mockHistoryClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *historyservice.SignalWorkflowExecutionRequest, _ ...grpc.CallOption) (*historyservice.SignalWorkflowExecutionResponse, error) {
+			s.Equal("signal", req.GetSignalRequest().GetSignalName())
+			s.Equal(fmt.Sprintf("request-%s-%s", req.GetSignalRequest().GetWorkflowExecution().GetWorkflowId(), req.GetSignalRequest().GetWorkflowExecution().GetRunId()), req.GetSignalRequest().GetRequestId())
+			if req.GetSignalRequest().GetWorkflowExecution().GetWorkflowId() == completed.WorkflowId {
+				return nil, serviceerror.NewNotFound("workflow execution already completed")
+			}
+			return &historyservice.SignalWorkflowExecutionResponse{}, nil
+		},
+	).Times(2)
+
+	resp, err := s.handler.SignalWorkflowExecutionsByQuery(ctx, &adminservice.SignalWorkflowExecutionsByQueryRequest{
+		Namespace:     s.namespace.String(),
+		Query:         query,
+		SignalName:    "signal",
+		RequestId:     "request",
+		MaxExecutions: 2,
+	})
+	s.NoError(err)
+	s.True(resp.GetTruncated())
+	s.Len(resp.GetResults(), 2)
+	s.Equal(completed.WorkflowId, resp.GetResults()[0].GetExecution().GetWorkflowId())
+	s.Equal("workflow execution already completed", resp.GetResults()[0].GetError())
+	s.Equal(running.WorkflowId, resp.GetResults()[1].GetExecution().GetWorkflowId())
+	s.Empty(resp.GetResults()[1].GetError())
+
+	_, err = s.handler.SignalWorkflowExecutionsByQuery(ctx, &adminservice.SignalWorkflowExecutionsByQueryRequest{
+		Namespace:  s.namespace.String(),
+		SignalName: "signal",
+	})
+	s.ErrorIs(err, errVisibilityQueryNotSet)
+}
+
+func (s *adminHandlerSuite) TestMultiSignalWorkflowExecution() {
+	ctx := context.Background()
+	s.handler.config.MaxIDLengthLimit = dynamicconfig.GetIntPropertyFn(1000)
+	s.handler.config.BlobSizeLimitError = dynamicconfig.GetIntPropertyFnFilteredByNamespace(1024)
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+
+	execution := &commonpb.WorkflowExecution{WorkflowId: "workflow", RunId: uuid.New()}
+	s.mockHistoryClient.EXPECT().MultiSignalWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *historyservice.MultiSignalWorkflowExecutionRequest, _ ...grpc.CallOption) (*historyservice.MultiSignalWorkflowExecutionResponse, error) {
+			s.Equal(s.namespaceID.String(), req.GetNamespaceId())
+			s.Equal("request", req.GetRequestId())
+			s.Len(req.GetSignalRequests(), 2)
+			for i, name := range []string{"first", "second"} {
+				s.Equal(name, req.GetSignalRequests()[i].GetSignalName())
+				s.Equal(execution.GetWorkflowId(), req.GetSignalRequests()[i].GetWorkflowExecution().GetWorkflowId())
+				s.Equal("identity", req.GetSignalRequests()[i].GetIdentity())
+			}
+			return &historyservice.MultiSignalWorkflowExecutionResponse{}, nil
+		},
+	)
+
+	_, err := s.handler.MultiSignalWorkflowExecution(ctx, &adminservice.MultiSignalWorkflowExecutionRequest{
+		Namespace:         s.namespace.String(),
+		WorkflowExecution: execution,
+		Signals: []*adminservice.MultiSignalWorkflowExecutionRequest_Signal{
+			{SignalName: "first"},
+			{SignalName: "second"},
+		},
+		Identity:  "identity",
+		RequestId: "request",
+	})
+	s.NoError(err)
+
+	_, err = s.handler.MultiSignalWorkflowExecution(ctx, &adminservice.MultiSignalWorkflowExecutionRequest{
+		Namespace:         s.namespace.String(),
+		WorkflowExecution: execution,
+	})
+	s.ErrorIs(err, errSignalsNotSet)
+
+	_, err = s.handler.MultiSignalWorkflowExecution(ctx, &adminservice.MultiSignalWorkflowExecutionRequest{
+		Namespace:         s.namespace.String(),
+		WorkflowExecution: execution,
+		Signals:           []*adminservice.MultiSignalWorkflowExecutionRequest_Signal{{SignalName: "first"}, {}},
+	})
+	s.ErrorIs(err, errSignalNameNotSet)
+}
+
+func TestPendingNexusOperationsQuery(t *testing.T) {
+	require.Equal(t,
+		`ExecutionStatus = "Running" AND TemporalPendingNexusOperations IS NOT NULL`,
+		pendingNexusOperationsQuery(&adminservice.ListPendingNexusOperationsRequest{State: enumspb.PENDING_NEXUS_OPERATION_STATE_STARTED}),
+	)
+}
+
+func (s *adminHandlerSuite) TestDescribeArchivalStatus() {
+	ctx := context.Background()
+	request := &adminservice.DescribeArchivalStatusRequest{Namespace: s.namespace.String()}
+
+	s.handler.config.AdminEnableListHistoryTasks = dynamicconfig.GetBoolPropertyFn(false)
+	_, err := s.handler.DescribeArchivalStatus(ctx, request)
+	s.ErrorIs(err, errListHistoryTasksNotAllowed)
+
+	s.handler.config.AdminEnableListHistoryTasks = dynamicconfig.GetBoolPropertyFn(true)
+	s.handler.config.AdminDescribeArchivalStatusMaxTasksPerShard = dynamicconfig.GetIntPropertyFn(2)
+	archivalEnabledEntry := namespace.NewNamespaceForTest(
+		&persistencespb.NamespaceInfo{
+			Name: s.namespace.String(),
+			Id:   s.namespaceID.String(),
+		},
+		&persistencespb.NamespaceConfig{
+			Retention:               durationpb.New(24 * time.Hour),
+			HistoryArchivalState:    enumspb.ARCHIVAL_STATE_ENABLED,
+			HistoryArchivalUri:      "file:///tmp/history",
+			VisibilityArchivalState: enumspb.ARCHIVAL_STATE_DISABLED,
+		},
+		false,
+		nil,
+		int64(100),
+	)
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(archivalEnabledEntry, nil)
+	oldest := time.Now().Add(-time.Hour).UTC()
+	ackLevel := oldest.Add(-time.Hour)
+	s.mockHistoryClient.EXPECT().GetShard(gomock.Any(), &historyservice.GetShardRequest{ShardId: 1}).Return(&historyservice.GetShardResponse{
+		ShardInfo: &persistencespb.ShardInfo{
+			QueueStates: map[int32]*persistencespb.QueueState{
+				int32(tasks.CategoryIDArchival): {
+					ReaderStates: map[int64]*persistencespb.QueueReaderState{
+						0: {Scopes: []*persistencespb.QueueSliceScope{{
+							Range: &persistencespb.QueueSliceRange{
+								InclusiveMin: &persistencespb.TaskKey{FireTime: timestamppb.New(ackLevel)},
+								ExclusiveMax: &persistencespb.TaskKey{FireTime: timestamppb.New(oldest)},
+							},
+						}}},
+					},
+					ExclusiveReaderHighWatermark: &persistencespb.TaskKey{FireTime: timestamppb.New(oldest)},
+				},
+			},
+		},
+	}, nil)
+	s.mockHistoryClient.EXPECT().ListTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.ListTasksRequest, _ ...grpc.CallOption) (*historyservice.ListTasksResponse, error) {
+			s.Equal(int32(1), request.Request.ShardId)
+			s.Equal(int32(tasks.CategoryIDArchival), request.Request.Category)
+			s.Equal(ackLevel, request.Request.TaskRange.InclusiveMinTaskKey.FireTime.AsTime())
+			return &historyservice.ListTasksResponse{
+				Response: &adminservice.ListHistoryTasksResponse{
+					Tasks: []*adminservice.Task{
+						{NamespaceId: s.namespaceID.String(), FireTime: timestamppb.New(oldest.Add(time.Minute))},
+						{NamespaceId: s.namespaceID.String(), FireTime: timestamppb.New(oldest)},
+						{NamespaceId: "another-namespace-id", FireTime: timestamppb.New(oldest.Add(-time.Minute))},
+					},
+					NextPageToken: []byte("next"),
+				},
+			}, nil
+		},
+	)
+	metricsHandler := metricstest.NewCaptureHandler()
+	capture := metricsHandler.StartCapture()
+	defer metricsHandler.StopCapture(capture)
+	s.handler.metricsHandler = metricsHandler
+	resp, err := s.handler.DescribeArchivalStatus(ctx, request)
+	s.NoError(err)
+	s.Equal(enumspb.ARCHIVAL_STATE_ENABLED, resp.GetHistoryArchivalState())
+	s.Equal("file:///tmp/history", resp.GetHistoryArchivalUri())
+	s.Equal(enumspb.ARCHIVAL_STATE_DISABLED, resp.GetVisibilityArchivalState())
+	s.Equal(24*time.Hour, resp.GetRetention().AsDuration())
+	s.Equal(int64(2), resp.GetPendingArchivalTasks())
+	s.Equal(oldest, resp.GetOldestPendingTaskTime().AsTime())
+	s.True(resp.GetTruncated())
+
+	snapshot := capture.Snapshot()
+	s.Len(snapshot[metrics.ArchivalBacklogPendingTasks.Name()], 1)
+	s.Equal(float64(2), snapshot[metrics.ArchivalBacklogPendingTasks.Name()][0].Value)
+	s.Equal(s.namespace.String(), snapshot[metrics.ArchivalBacklogPendingTasks.Name()][0].Tags["namespace"])
+	s.Len(snapshot[metrics.ArchivalBacklogOldestPendingAge.Name()], 1)
+	s.GreaterOrEqual(snapshot[metrics.ArchivalBacklogOldestPendingAge.Name()][0].Value.(time.Duration), time.Hour)
+}
+
+func TestArchivalQueueAckLevel(t *testing.T) {
+	now := time.Now().UTC()
+	require.Equal(t, tasks.MinimumKey.FireTime, archivalQueueAckLevel(nil))
+
+	// no pending ranges, everything below the high watermark was processed
+	require.Equal(t, now, archivalQueueAckLevel(&persistencespb.QueueState{
+		ExclusiveReaderHighWatermark: &persistencespb.TaskKey{FireTime: timestamppb.New(now)},
+	}))
+
+	// the lowest pending range of any reader holds the ack level back
+	require.Equal(t, now.Add(-2*time.Minute), archivalQueueAckLevel(&persistencespb.QueueState{
+		ReaderStates: map[int64]*persistencespb.QueueReaderState{
+			0: {Scopes: []*persistencespb.QueueSliceScope{{
+				Range: &persistencespb.QueueSliceRange{InclusiveMin: &persistencespb.TaskKey{FireTime: timestamppb.New(now.Add(-time.Minute))}},
+			}}},
+			1: {Scopes: []*persistencespb.QueueSliceScope{{
+				Range: &persistencespb.QueueSliceRange{InclusiveMin: &persistencespb.TaskKey{FireTime: timestamppb.New(now.Add(-2 * time.Minute))}},
+			}}},
+		},
+		ExclusiveReaderHighWatermark: &persistencespb.TaskKey{FireTime: timestamppb.New(now)},
+	}))
+}
+
+// archivalTaskStore serves archival tasks to the execution manager, so that requests go through its task range
+// validation.
+type archivalTaskStore struct {
+	persistence.ExecutionStore
+	tasks []persistence.InternalHistoryTask
+}
+
+func (s *archivalTaskStore) GetHistoryTasks(
+	_ context.Context,
+	_ *persistence.GetHistoryTasksRequest,
+) (*persistence.InternalGetHistoryTasksResponse, error) {
+	return &persistence.InternalGetHistoryTasksResponse{Tasks: s.tasks}, nil
+}
+
+func (s *adminHandlerSuite) TestDescribeArchivalStatus_ExecutionManagerTaskRange() {
+	ctx := context.Background()
+	s.handler.config.AdminEnableListHistoryTasks = dynamicconfig.GetBoolPropertyFn(true)
+	s.handler.config.AdminDescribeArchivalStatusMaxTasksPerShard = dynamicconfig.GetIntPropertyFn(100)
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(namespace.NewNamespaceForTest(
+		&persistencespb.NamespaceInfo{Name: s.namespace.String(), Id: s.namespaceID.String()},
+		&persistencespb.NamespaceConfig{
+			Retention:            durationpb.New(24 * time.Hour),
+			HistoryArchivalState: enumspb.ARCHIVAL_STATE_ENABLED,
+		},
+		false,
+		nil,
+		int64(100),
+	), nil)
+
+	serializer := serialization.NewSerializer()
+	fireTime := time.Now().Add(time.Hour).UTC()
+	blob, err := serializer.SerializeTask(&tasks.ArchiveExecutionTask{
+		WorkflowKey:         definition.NewWorkflowKey(s.namespaceID.String(), "workflow-id", "run-id"),
+		VisibilityTimestamp: fireTime,
+		TaskID:              1,
+	})
+	s.NoError(err)
+	executionManager := persistence.NewExecutionManager(
+		&archivalTaskStore{tasks: []persistence.InternalHistoryTask{{Key: tasks.NewKey(fireTime, 1), Blob: blob}}},
+		serializer,
+		nil,
+		log.NewNoopLogger(),
+		dynamicconfig.GetIntPropertyFn(1024*1024),
+	)
+	taskCategoryRegistry := tasks.NewDefaultTaskCategoryRegistry()
+	taskCategoryRegistry.AddCategory(tasks.CategoryArchival)
+	s.mockHistoryClient.EXPECT().GetShard(gomock.Any(), gomock.Any()).Return(&historyservice.GetShardResponse{
+		ShardInfo: &persistencespb.ShardInfo{},
+	}, nil)
+	s.mockHistoryClient.EXPECT().ListTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *historyservice.ListTasksRequest, _ ...grpc.CallOption) (*historyservice.ListTasksResponse, error) {
+			return listtasks.Invoke(ctx, taskCategoryRegistry, executionManager, request)
+		},
+	)
+
+	resp, err := s.handler.DescribeArchivalStatus(ctx, &adminservice.DescribeArchivalStatusRequest{Namespace: s.namespace.String()})
+	s.NoError(err)
+	s.Equal(int64(1), resp.GetPendingArchivalTasks())
+	s.Equal(fireTime, resp.GetOldestPendingTaskTime().AsTime())
+	s.False(resp.GetTruncated())
+}
+
+func (s *adminHandlerSuite) TestDescribeReplicationLag() {
+	ctx := context.Background()
+	request := &adminservice.DescribeReplicationLagRequest{Namespace: s.namespace.String()}
+
+	s.handler.config.AdminEnableListHistoryTasks = dynamicconfig.GetBoolPropertyFn(false)
+	_, err := s.handler.DescribeReplicationLag(ctx, request)
+	s.ErrorIs(err, errListHistoryTasksNotAllowed)
+
+	s.handler.config.AdminEnableListHistoryTasks = dynamicconfig.GetBoolPropertyFn(true)
+	s.handler.config.AdminDescribeReplicationLagMaxTasksPerShard = dynamicconfig.GetIntPropertyFn(10)
+	nsEntry := namespace.NewGlobalNamespaceForTest(
+		&persistencespb.NamespaceInfo{
+			Name: s.namespace.String(),
+			Id:   s.namespaceID.String(),
+		},
+		&persistencespb.NamespaceConfig{},
+		&persistencespb.NamespaceReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters: []string{
+				cluster.TestCurrentClusterName,
+				cluster.TestAlternativeClusterName,
+			},
+		},
+		int64(100),
+	)
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(nsEntry, nil)
+	now := time.Now().UTC()
+	s.mockHistoryClient.EXPECT().GetReplicationStatus(gomock.Any(), &historyservice.GetReplicationStatusRequest{
+		RemoteClusters: []string{cluster.TestAlternativeClusterName},
+	}).Return(&historyservice.GetReplicationStatusResponse{
+		Shards: []*historyservice.ShardReplicationStatus{
+			{
+				ShardId:              1,
+				MaxReplicationTaskId: 20,
+				ShardLocalTime:       timestamppb.New(now),
+				RemoteClusters: map[string]*historyservice.ShardReplicationStatusPerCluster{
+					cluster.TestAlternativeClusterName: {
+						AckedTaskId:                     15,
+						AckedTaskVisibilityTime:         timestamppb.New(now.Add(-time.Hour)),
+						OldestPendingTaskVisibilityTime: timestamppb.New(now.Add(-time.Minute)),
+					},
+				},
+			},
+			{
+				ShardId:              2,
+				MaxReplicationTaskId: 30,
+				ShardLocalTime:       timestamppb.New(now),
+				RemoteClusters: map[string]*historyservice.ShardReplicationStatusPerCluster{
+					cluster.TestAlternativeClusterName: {
+						AckedTaskId:             30,
+						AckedTaskVisibilityTime: timestamppb.New(now.Add(-time.Hour)),
+					},
+				},
+			},
+		},
+	}, nil)
+	// the oldest pending task of the namespace is younger than the oldest pending task of the shard
+	s.mockHistoryClient.EXPECT().GetReplicationStatus(gomock.Any(), &historyservice.GetReplicationStatusRequest{
+		RemoteClusters: []string{cluster.TestAlternativeClusterName},
+		NamespaceId:    s.namespaceID.String(),
+	}).Return(&historyservice.GetReplicationStatusResponse{
+		Shards: []*historyservice.ShardReplicationStatus{
+			{
+				ShardId:              1,
+				MaxReplicationTaskId: 20,
+				ShardLocalTime:       timestamppb.New(now),
+				RemoteClusters: map[string]*historyservice.ShardReplicationStatusPerCluster{
+					cluster.TestAlternativeClusterName: {
+						AckedTaskId:                     15,
+						AckedTaskVisibilityTime:         timestamppb.New(now.Add(-time.Hour)),
+						OldestPendingTaskVisibilityTime: timestamppb.New(now.Add(-time.Second)),
+					},
+				},
+			},
+		},
+	}, nil)
+	s.mockHistoryClient.EXPECT().ListTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.ListTasksRequest, _ ...grpc.CallOption) (*historyservice.ListTasksResponse, error) {
+			s.Equal(int32(1), request.Request.ShardId)
+			s.Equal(int32(tasks.CategoryIDReplication), request.Request.Category)
+			s.Equal(int64(16), request.Request.TaskRange.InclusiveMinTaskKey.TaskId)
+			s.Equal(int64(21), request.Request.TaskRange.ExclusiveMaxTaskKey.TaskId)
+			return &historyservice.ListTasksResponse{
+				Response: &adminservice.ListHistoryTasksResponse{
+					Tasks: []*adminservice.Task{
+						{NamespaceId: s.namespaceID.String(), TaskId: 16},
+						{NamespaceId: "another-namespace-id", TaskId: 17},
+						{NamespaceId: s.namespaceID.String(), TaskId: 18},
+					},
+				},
+			}, nil
+		},
+	)
+	resp, err := s.handler.DescribeReplicationLag(ctx, request)
+	s.NoError(err)
+	s.Len(resp.GetShards(), 2)
+	s.Equal(int64(5), resp.GetShards()[0].GetTaskLag())
+	s.Equal(time.Minute, resp.GetShards()[0].GetTimeLag().AsDuration())
+	s.Equal(int64(0), resp.GetShards()[1].GetTaskLag())
+	s.Equal(time.Duration(0), resp.GetShards()[1].GetTimeLag().AsDuration())
+	s.Equal(int64(5), resp.GetMaxTaskLag())
+	s.Equal(time.Minute, resp.GetMaxTimeLag().AsDuration())
+	s.Equal(int64(2), resp.GetNamespaceLag().GetPendingTasks())
+	s.Equal(time.Second, resp.GetNamespaceLag().GetTimeLag().AsDuration())
+	s.False(resp.GetNamespaceLag().GetTruncated())
+}
+
 func (s *adminHandlerSuite) validatePhysicalTaskQueueInfo(expectedPhysicalTaskQueueInfo *taskqueuespb.PhysicalTaskQueueInfo,
 	responsePhysicalTaskQueueInfo *taskqueuespb.PhysicalTaskQueueInfo) {
 
@@ -2114,3 +3369,650 @@ func (s *adminHandlerSuite) validatePhysicalTaskQueueInfo(expectedPhysicalTaskQu
 	s.Equal(expectedPhysicalTaskQueueInfo.GetTaskQueueStats(), responsePhysicalTaskQueueInfo.GetTaskQueueStats())
 	s.Equal(expectedPhysicalTaskQueueInfo.GetInternalTaskQueueStatus(), responsePhysicalTaskQueueInfo.GetInternalTaskQueueStatus())
 }
+
+func (s *adminHandlerSuite) TestPauseActivityType_InvalidRequest() {
+	ctx := context.Background()
+	s.handler.config.MaxIDLengthLimit = dynamicconfig.GetIntPropertyFn(10)
+
+	_, err := s.handler.PauseActivityType(ctx, &adminservice.PauseActivityTypeRequest{ActivityType: "activity"})
+	s.ErrorIs(err, errNamespaceNotSet)
+
+	_, err = s.handler.PauseActivityType(ctx, &adminservice.PauseActivityTypeRequest{Namespace: s.namespace.String()})
+	s.ErrorIs(err, errActivityTypeNotSet)
+
+	_, err = s.handler.UnpauseActivityType(ctx, &adminservice.UnpauseActivityTypeRequest{
+		Namespace:    s.namespace.String(),
+		ActivityType: "a,b",
+	})
+	s.ErrorIs(err, errInvalidActivityType)
+
+	_, err = s.handler.UnpauseActivityType(ctx, &adminservice.UnpauseActivityTypeRequest{
+		Namespace:    s.namespace.String(),
+		ActivityType: "activity-type-too-long",
+	})
+	s.ErrorIs(err, errActivityTypeTooLong)
+}
+
+func (s *adminHandlerSuite) TestCaptureProfile_InvalidRequest() {
+	ctx := context.Background()
+	s.handler.config.AdminCaptureProfileMaxDuration = dynamicconfig.GetDurationPropertyFn(time.Minute)
+	s.handler.config.AdminCaptureProfileMaxHosts = dynamicconfig.GetIntPropertyFn(1)
+	s.handler.config.AdminCaptureProfileStoreURI = dynamicconfig.GetStringPropertyFn("")
+
+	_, err := s.handler.CaptureProfile(ctx, &adminservice.CaptureProfileRequest{})
+	s.ErrorIs(err, errRoleOrHostAddressNotSet)
+
+	_, err = s.handler.CaptureProfile(ctx, &adminservice.CaptureProfileRequest{
+		Role:         enumsspb.CLUSTER_MEMBER_ROLE_HISTORY,
+		ProfileTypes: []string{"unknown"},
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+
+	_, err = s.handler.CaptureProfile(ctx, &adminservice.CaptureProfileRequest{
+		Role:     enumsspb.CLUSTER_MEMBER_ROLE_HISTORY,
+		Duration: durationpb.New(time.Hour),
+	})
+	s.ErrorAs(err, &invalidArgument)
+
+	_, err = s.handler.CaptureProfile(ctx, &adminservice.CaptureProfileRequest{
+		Role:  enumsspb.CLUSTER_MEMBER_ROLE_HISTORY,
+		Store: true,
+	})
+	s.ErrorIs(err, errProfileStoreNotConfigured)
+
+	s.mockResource.HistoryServiceResolver.EXPECT().Members().Return([]membership.HostInfo{
+		membership.NewHostInfoFromAddress("10.0.0.1:7234"),
+		membership.NewHostInfoFromAddress("10.0.0.2:7234"),
+	})
+	_, err = s.handler.CaptureProfile(ctx, &adminservice.CaptureProfileRequest{
+		Role: enumsspb.CLUSTER_MEMBER_ROLE_HISTORY,
+	})
+	s.ErrorAs(err, &invalidArgument)
+}
+
+func (s *adminHandlerSuite) TestCaptureProfile() {
+	ctx := context.Background()
+	s.handler.config.AdminCaptureProfileMaxDuration = dynamicconfig.GetDurationPropertyFn(time.Minute)
+	s.handler.config.AdminCaptureProfileMaxHosts = dynamicconfig.GetIntPropertyFn(10)
+	s.handler.config.AdminCaptureProfileStoreURI = dynamicconfig.GetStringPropertyFn("")
+
+	self := membership.NewHostInfoFromAddress("127.0.0.1:7233")
+	remote := membership.NewHostInfoFromAddress("10.0.0.1:7233")
+	s.mockResource.HostInfoProvider.EXPECT().HostInfo().Return(self).AnyTimes()
+	s.mockResource.FrontendServiceResolver.EXPECT().Members().Return([]membership.HostInfo{self, remote})
+
+	resp, err := s.handler.CaptureProfile(ctx, &adminservice.CaptureProfileRequest{
+		Role:         enumsspb.CLUSTER_MEMBER_ROLE_FRONTEND,
+		ProfileTypes: []string{"heap", "goroutine"},
+	})
+	s.NoError(err)
+	s.Len(resp.GetProfiles(), 4)
+	for _, profile := range resp.GetProfiles()[:2] {
+		s.Equal(self.GetAddress(), profile.GetHostAddress())
+		s.NotEmpty(profile.GetData())
+		s.Empty(profile.GetError())
+	}
+	// pprof is not enabled in the test, so the other frontend can't be profiled.
+	for _, profile := range resp.GetProfiles()[2:] {
+		s.Equal(remote.GetAddress(), profile.GetHostAddress())
+		s.Empty(profile.GetData())
+		s.Equal(errPProfNotEnabled.Error(), profile.GetError())
+	}
+	s.Equal("heap", resp.GetProfiles()[0].GetProfileType())
+	s.Equal("goroutine", resp.GetProfiles()[1].GetProfileType())
+
+	s.mockResource.FrontendServiceResolver.EXPECT().Members().Return([]membership.HostInfo{self, remote})
+	s.mockResource.HistoryServiceResolver.EXPECT().Members().Return(nil)
+	s.mockResource.MatchingServiceResolver.EXPECT().Members().Return(nil)
+	s.mockResource.WorkerServiceResolver.EXPECT().Members().Return(nil)
+	_, err = s.handler.CaptureProfile(ctx, &adminservice.CaptureProfileRequest{
+		HostAddress:  "10.0.0.9:7233",
+		ProfileTypes: []string{"heap"},
+	})
+	var notFound *serviceerror.NotFound
+	s.ErrorAs(err, &notFound)
+}
+
+func (s *adminHandlerSuite) TestDescribeShardOwnership() {
+	ctx := context.Background()
+	s.handler.numberOfHistoryShards = 2
+
+	host1 := membership.NewHostInfoFromAddress("10.0.0.1:7234")
+	host2 := membership.NewHostInfoFromAddress("10.0.0.2:7234")
+	now := time.Now().UTC()
+	s.mockResource.HistoryServiceResolver.EXPECT().Members().Return([]membership.HostInfo{host1, host2})
+	s.mockResource.HistoryServiceResolver.EXPECT().Lookup("1").Return(host1, nil)
+	s.mockResource.HistoryServiceResolver.EXPECT().Lookup("2").Return(host1, nil)
+
+	shard1 := &clusterspb.ShardOwnership{
+		ShardId:     1,
+		HostAddress: host1.GetAddress(),
+		AcquireTime: timestamppb.New(now.Add(-time.Hour)),
+		RangeId:     7,
+	}
+	shard2 := &clusterspb.ShardOwnership{
+		ShardId:     2,
+		HostAddress: host1.GetAddress(),
+		AcquireTime: timestamppb.New(now.Add(-time.Minute)),
+	}
+	acquired := &clusterspb.ShardOwnershipChange{
+		ShardId:     2,
+		HostAddress: host1.GetAddress(),
+		Type:        enumsspb.SHARD_OWNERSHIP_CHANGE_TYPE_ACQUIRED,
+		Time:        shard2.GetAcquireTime(),
+	}
+	released := &clusterspb.ShardOwnershipChange{
+		ShardId:     2,
+		HostAddress: host2.GetAddress(),
+		Type:        enumsspb.SHARD_OWNERSHIP_CHANGE_TYPE_RELEASED,
+		Time:        timestamppb.New(now.Add(-2 * time.Minute)),
+	}
+	s.mockHistoryClient.EXPECT().DescribeHistoryHost(gomock.Any(), protomock.Eq(&historyservice.DescribeHistoryHostRequest{
+		HostAddress: host1.GetAddress(),
+	})).Return(&historyservice.DescribeHistoryHostResponse{
+		Shards:                []*clusterspb.ShardOwnership{shard1, shard2},
+		ShardOwnershipChanges: []*clusterspb.ShardOwnershipChange{acquired},
+	}, nil)
+	s.mockHistoryClient.EXPECT().DescribeHistoryHost(gomock.Any(), protomock.Eq(&historyservice.DescribeHistoryHostRequest{
+		HostAddress: host2.GetAddress(),
+	})).Return(&historyservice.DescribeHistoryHostResponse{
+		ShardOwnershipChanges: []*clusterspb.ShardOwnershipChange{released},
+	}, nil)
+
+	resp, err := s.handler.DescribeShardOwnership(ctx, &adminservice.DescribeShardOwnershipRequest{})
+	s.NoError(err)
+	s.ProtoEqual(&adminservice.DescribeShardOwnershipResponse{
+		Shards: []*adminservice.DescribeShardOwnershipResponse_Shard{
+			{ShardId: 1, RingOwner: host1.GetAddress(), Owners: []*clusterspb.ShardOwnership{shard1}},
+			{ShardId: 2, RingOwner: host1.GetAddress(), Owners: []*clusterspb.ShardOwnership{shard2}},
+		},
+		Hosts: []*adminservice.DescribeShardOwnershipResponse_Host{
+			{Address: host1.GetAddress(), ShardCount: 2},
+			{Address: host2.GetAddress()},
+		},
+		RecentChanges: []*clusterspb.ShardOwnershipChange{released, acquired},
+	}, resp)
+}
+
+func (s *adminHandlerSuite) TestListHotspots() {
+	ctx := context.Background()
+
+	host1 := membership.NewHostInfoFromAddress("10.0.0.1:7234")
+	host2 := membership.NewHostInfoFromAddress("10.0.0.2:7234")
+	host3 := membership.NewHostInfoFromAddress("10.0.0.3:7234")
+	s.mockResource.HistoryServiceResolver.EXPECT().Members().Return([]membership.HostInfo{host1, host2, host3})
+
+	s.mockHistoryClient.EXPECT().DescribeHistoryHost(gomock.Any(), protomock.Eq(&historyservice.DescribeHistoryHostRequest{
+		HostAddress:  host1.GetAddress(),
+		HotspotLimit: 2,
+	})).Return(&historyservice.DescribeHistoryHostResponse{
+		HotShards: []*historyspb.HotShard{
+			{ShardId: 1, Usage: &historyspb.ResourceUsage{TaskCount: 8, Score: 0.8}},
+			{ShardId: 2, Usage: &historyspb.ResourceUsage{TaskCount: 2, Score: 0.2}},
+		},
+		HotWorkflows: []*historyspb.HotWorkflow{
+			{ShardId: 1, NamespaceId: "ns-id", WorkflowId: "wf-1", Usage: &historyspb.ResourceUsage{TaskCount: 8, Score: 0.8}},
+		},
+	}, nil)
+	s.mockHistoryClient.EXPECT().DescribeHistoryHost(gomock.Any(), protomock.Eq(&historyservice.DescribeHistoryHostRequest{
+		HostAddress:  host2.GetAddress(),
+		HotspotLimit: 2,
+	})).Return(&historyservice.DescribeHistoryHostResponse{
+		HotShards: []*historyspb.HotShard{
+			{ShardId: 3, Usage: &historyspb.ResourceUsage{HistoryBytes: 100, Score: 1}},
+		},
+		HotWorkflows: []*historyspb.HotWorkflow{
+			{ShardId: 3, NamespaceId: "ns-id", WorkflowId: "wf-2", Usage: &historyspb.ResourceUsage{HistoryBytes: 60, Score: 0.6}},
+			{ShardId: 3, NamespaceId: "ns-id", WorkflowId: "wf-3", Usage: &historyspb.ResourceUsage{HistoryBytes: 40, Score: 0.4}},
+		},
+	}, nil)
+	s.mockHistoryClient.EXPECT().DescribeHistoryHost(gomock.Any(), protomock.Eq(&historyservice.DescribeHistoryHostRequest{
+		HostAddress:  host3.GetAddress(),
+		HotspotLimit: 2,
+	})).Return(nil, serviceerror.NewUnavailable("host unavailable"))
+
+	resp, err := s.handler.ListHotspots(ctx, &adminservice.ListHotspotsRequest{Limit: 2})
+	s.NoError(err)
+	s.ProtoEqual(&adminservice.ListHotspotsResponse{
+		Shards: []*historyspb.HotShard{
+			{ShardId: 3, HostAddress: host2.GetAddress(), Usage: &historyspb.ResourceUsage{HistoryBytes: 100, Score: 1}},
+			{ShardId: 1, HostAddress: host1.GetAddress(), Usage: &historyspb.ResourceUsage{TaskCount: 8, Score: 0.8}},
+		},
+		Workflows: []*historyspb.HotWorkflow{
+			{ShardId: 1, HostAddress: host1.GetAddress(), NamespaceId: "ns-id", WorkflowId: "wf-1", Usage: &historyspb.ResourceUsage{TaskCount: 8, Score: 0.8}},
+			{ShardId: 3, HostAddress: host2.GetAddress(), NamespaceId: "ns-id", WorkflowId: "wf-2", Usage: &historyspb.ResourceUsage{HistoryBytes: 60, Score: 0.6}},
+		},
+		FailedHosts: []string{host3.GetAddress()},
+	}, resp)
+
+	_, err = s.handler.ListHotspots(ctx, &adminservice.ListHotspotsRequest{Limit: -1})
+	s.Error(err)
+}
+
+func (s *adminHandlerSuite) TestGetDebugTrace() {
+	ctx := context.Background()
+	startTime := time.Now().UTC()
+
+	host1 := membership.NewHostInfoFromAddress("10.0.0.1:7234")
+	host2 := membership.NewHostInfoFromAddress("10.0.0.2:7234")
+	s.mockResource.HistoryServiceResolver.EXPECT().Members().Return([]membership.HostInfo{host1, host2})
+
+	frontendSpan := &historyspb.DebugTraceSpan{
+		TraceId:   "trace-id",
+		Service:   "frontend",
+		Method:    "/temporal.api.workflowservice.v1.WorkflowService/SignalWorkflowExecution",
+		StartTime: timestamppb.New(startTime),
+		Duration:  durationpb.New(time.Second),
+	}
+	historySpan := &historyspb.DebugTraceSpan{
+		TraceId:     "trace-id",
+		Service:     "history",
+		HostAddress: host1.GetAddress(),
+		Method:      "/temporal.server.api.historyservice.v1.HistoryService/SignalWorkflowExecution",
+		StartTime:   timestamppb.New(startTime.Add(time.Millisecond)),
+		Duration:    durationpb.New(time.Millisecond),
+		Error:       "workflow not found",
+	}
+	s.handler.debugTraceStore.Record(frontendSpan)
+	s.handler.debugTraceStore.Record(&historyspb.DebugTraceSpan{TraceId: "other-trace-id"})
+
+	s.mockHistoryClient.EXPECT().DescribeHistoryHost(gomock.Any(), protomock.Eq(&historyservice.DescribeHistoryHostRequest{
+		HostAddress:  host1.GetAddress(),
+		DebugTraceId: "trace-id",
+	})).Return(&historyservice.DescribeHistoryHostResponse{
+		DebugTraceSpans: []*historyspb.DebugTraceSpan{historySpan},
+	}, nil)
+	s.mockHistoryClient.EXPECT().DescribeHistoryHost(gomock.Any(), protomock.Eq(&historyservice.DescribeHistoryHostRequest{
+		HostAddress:  host2.GetAddress(),
+		DebugTraceId: "trace-id",
+	})).Return(nil, serviceerror.NewUnavailable("host unavailable"))
+
+	resp, err := s.handler.GetDebugTrace(ctx, &adminservice.GetDebugTraceRequest{TraceId: "trace-id"})
+	s.NoError(err)
+	s.ProtoEqual(&adminservice.GetDebugTraceResponse{
+		Spans:       []*historyspb.DebugTraceSpan{frontendSpan, historySpan},
+		FailedHosts: []string{host2.GetAddress()},
+	}, resp)
+
+	_, err = s.handler.GetDebugTrace(ctx, &adminservice.GetDebugTraceRequest{})
+	s.Error(err)
+}
+
+func (s *adminHandlerSuite) TestSetDynamicConfig() {
+	ctx := context.WithValue(context.Background(), authorization.MappedClaims, &authorization.Claims{Subject: "alice"})
+	key := dynamicconfig.HistoryRPS.Key().String()
+
+	_, err := s.handler.SetDynamicConfig(ctx, &adminservice.SetDynamicConfigRequest{Values: "- value: 10"})
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+	_, err = s.handler.SetDynamicConfig(ctx, &adminservice.SetDynamicConfigRequest{Key: "unknown.key", Values: "- value: 10"})
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+	_, err = s.handler.SetDynamicConfig(ctx, &adminservice.SetDynamicConfigRequest{Key: key, Values: "- value: not-a-number"})
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+	_, err = s.handler.SetDynamicConfig(ctx, &adminservice.SetDynamicConfigRequest{Key: key, Values: "- constraints: {namespace: ns}\n  value: 10"})
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+
+	systemNamespace := &persistencespb.NamespaceDetail{
+		Info: &persistencespb.NamespaceInfo{
+			Id:   primitives.SystemNamespaceID,
+			Name: primitives.SystemLocalNamespace,
+			Data: map[string]string{"other": "data"},
+		},
+		Config:            &persistencespb.NamespaceConfig{},
+		ReplicationConfig: &persistencespb.NamespaceReplicationConfig{},
+	}
+	s.mockResource.MetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 7}, nil)
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{
+		Name: primitives.SystemLocalNamespace,
+	}).Return(&persistence.GetNamespaceResponse{Namespace: systemNamespace}, nil)
+	var updatedData map[string]string
+	s.mockResource.MetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateNamespaceRequest) error {
+			s.Equal(int64(7), request.NotificationVersion)
+			updatedData = request.Namespace.Info.Data
+			return nil
+		},
+	)
+	_, err = s.handler.SetDynamicConfig(ctx, &adminservice.SetDynamicConfigRequest{Key: key, Values: "- value: 10"})
+	s.NoError(err)
+	s.Equal("data", updatedData["other"])
+
+	systemNamespace.Info.Data = updatedData
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{
+		Name: primitives.SystemLocalNamespace,
+	}).Return(&persistence.GetNamespaceResponse{Namespace: systemNamespace}, nil).Times(2)
+	resp, err := s.handler.GetDynamicConfig(ctx, &adminservice.GetDynamicConfigRequest{})
+	s.NoError(err)
+	s.Len(resp.Overrides, 1)
+	s.Equal(strings.ToLower(key), resp.Overrides[0].GetKey())
+	s.Equal("- value: 10", resp.Overrides[0].GetValues())
+	s.Equal("alice", resp.Overrides[0].GetUpdatedBy())
+
+	resp, err = s.handler.GetDynamicConfig(ctx, &adminservice.GetDynamicConfigRequest{Key: "other.key"})
+	s.NoError(err)
+	s.Empty(resp.Overrides)
+}
+
+func (s *adminHandlerSuite) TestImportNamespaceConfig() {
+	ctx := context.WithValue(context.Background(), authorization.MappedClaims, &authorization.Claims{Subject: "alice"})
+	key := dynamicconfig.FrontendMaxNamespaceRPSPerInstance.Key().String()
+
+	_, err := s.handler.ImportNamespaceConfig(ctx, &adminservice.ImportNamespaceConfigRequest{})
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+
+	systemNamespace := &persistencespb.NamespaceDetail{
+		Info: &persistencespb.NamespaceInfo{
+			Id:   primitives.SystemNamespaceID,
+			Name: primitives.SystemLocalNamespace,
+		},
+		Config:            &persistencespb.NamespaceConfig{},
+		ReplicationConfig: &persistencespb.NamespaceReplicationConfig{},
+	}
+	s.mockResource.ClientFactory.EXPECT().NewLocalFrontendClientWithTimeout(gomock.Any(), gomock.Any()).
+		Return(nil, s.mockResource.GetFrontendClient(), nil).AnyTimes()
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{
+		Name: primitives.SystemLocalNamespace,
+	}).Return(&persistence.GetNamespaceResponse{Namespace: systemNamespace}, nil).AnyTimes()
+
+	// invalid dynamic config is rejected before anything is changed
+	bundle := &adminservice.NamespaceConfigBundle{
+		Info: &namespacepb.NamespaceInfo{Name: "staging-ns", Description: "imported"},
+		Config: &namespacepb.NamespaceConfig{
+			WorkflowExecutionRetentionTtl: durationpb.New(48 * time.Hour),
+		},
+		SearchAttributes: map[string]enumspb.IndexedValueType{
+			"MyAlias": enumspb.INDEXED_VALUE_TYPE_INT,
+		},
+		DynamicConfig: []*adminservice.DynamicConfigOverride{
+			{Key: key, Values: "- constraints: {namespace: staging-ns}\n  value: not-a-number\n"},
+		},
+		Schedules: []*adminservice.NamespaceConfigSchedule{{ScheduleId: "nightly"}},
+	}
+	_, err = s.handler.ImportNamespaceConfig(ctx, &adminservice.ImportNamespaceConfigRequest{Bundle: bundle, Namespace: "prod-ns"})
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+
+	bundle.DynamicConfig[0].Values = "- constraints: {namespace: staging-ns}\n  value: 10\n"
+	s.mockResource.FrontendClient.EXPECT().DescribeNamespace(gomock.Any(), &workflowservice.DescribeNamespaceRequest{Namespace: "prod-ns"}).
+		Return(&workflowservice.DescribeNamespaceResponse{
+			Config: &namespacepb.NamespaceConfig{
+				CustomSearchAttributeAliases: map[string]string{"CustomKeywordField": "MyAlias"},
+			},
+		}, nil).AnyTimes()
+	s.mockResource.FrontendClient.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *workflowservice.UpdateNamespaceRequest, _ ...grpc.CallOption) (*workflowservice.UpdateNamespaceResponse, error) {
+			s.Equal("prod-ns", request.Namespace)
+			s.Equal("imported", request.UpdateInfo.Description)
+			s.Equal(48*time.Hour, request.Config.WorkflowExecutionRetentionTtl.AsDuration())
+			return &workflowservice.UpdateNamespaceResponse{}, nil
+		},
+	)
+	s.mockVisibilityMgr.EXPECT().HasStoreName(elasticsearch.PersistenceName).Return(false).AnyTimes()
+	s.mockVisibilityMgr.EXPECT().GetIndexName().Return("visibility").AnyTimes()
+	s.mockResource.SearchAttributesProvider.EXPECT().GetSearchAttributes("visibility", true).Return(searchattribute.TestNameTypeMap, nil).AnyTimes()
+	s.mockResource.MetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 7}, nil)
+	var updatedData map[string]string
+	s.mockResource.MetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateNamespaceRequest) error {
+			updatedData = request.Namespace.Info.Data
+			return nil
+		},
+	)
+	s.mockResource.FrontendClient.EXPECT().CreateSchedule(gomock.Any(), gomock.Any()).
+		Return(nil, serviceerror.NewWorkflowExecutionAlreadyStarted("already started", "", ""))
+
+	resp, err := s.handler.ImportNamespaceConfig(ctx, &adminservice.ImportNamespaceConfigRequest{Bundle: bundle, Namespace: "prod-ns"})
+	s.NoError(err)
+	s.False(resp.NamespaceCreated)
+	s.Empty(resp.AddedSearchAttributes)
+	s.Equal([]string{strings.ToLower(key)}, resp.UpdatedDynamicConfigKeys)
+	s.Empty(resp.CreatedSchedules)
+	s.Len(resp.Skipped, 2)
+	s.Contains(updatedData["temporal.dynamicconfig"], "namespace: prod-ns")
+	s.NotContains(updatedData["temporal.dynamicconfig"], "staging-ns")
+}
+
+func (s *adminHandlerSuite) TestUpdateClusterMetadata() {
+	ctx := context.Background()
+	active := s.mockMetadata.GetCurrentClusterName()
+	s.mockMetadata.EXPECT().GetAllClusterInfo().Return(map[string]cluster.ClusterInformation{
+		active:    {Enabled: true, InitialFailoverVersion: 1, RPCAddress: "active:7233"},
+		"standby": {Enabled: true, InitialFailoverVersion: 2, RPCAddress: "standby:7233"},
+	}).AnyTimes()
+	s.mockClusterMetadataManager.EXPECT().GetClusterMetadata(gomock.Any(), &persistence.GetClusterMetadataRequest{ClusterName: active}).Return(
+		&persistence.GetClusterMetadataResponse{
+			ClusterMetadata: &persistencespb.ClusterMetadata{
+				ClusterName:              active,
+				ClusterAddress:           "active:7233",
+				FailoverVersionIncrement: 10,
+				InitialFailoverVersion:   1,
+				IsGlobalNamespaceEnabled: true,
+			},
+			Version: 3,
+		}, nil).AnyTimes()
+
+	// the increment must match the connected clusters and keep namespaces on their active cluster
+	s.mockClientFactory.EXPECT().NewRemoteAdminClientWithTimeout("standby:7233", gomock.Any(), gomock.Any()).Return(s.mockAdminClient)
+	s.mockAdminClient.EXPECT().DescribeCluster(gomock.Any(), &adminservice.DescribeClusterRequest{}).Return(
+		&adminservice.DescribeClusterResponse{ClusterName: "standby", FailoverVersionIncrement: 10}, nil)
+	s.mockResource.MetadataMgr.EXPECT().ListNamespaces(gomock.Any(), gomock.Any()).Return(&persistence.ListNamespacesResponse{
+		Namespaces: []*persistence.GetNamespaceResponse{
+			{
+				Namespace: &persistencespb.NamespaceDetail{
+					Info:              &persistencespb.NamespaceInfo{Name: "failed-over"},
+					ReplicationConfig: &persistencespb.NamespaceReplicationConfig{ActiveClusterName: "standby"},
+					FailoverVersion:   12,
+				},
+				IsGlobalNamespace: true,
+			},
+			{
+				Namespace: &persistencespb.NamespaceDetail{
+					Info:              &persistencespb.NamespaceInfo{Name: "never-failed-over"},
+					ReplicationConfig: &persistencespb.NamespaceReplicationConfig{ActiveClusterName: active},
+					FailoverVersion:   1,
+				},
+				IsGlobalNamespace: true,
+			},
+		},
+	}, nil)
+	resp, err := s.handler.UpdateClusterMetadata(ctx, &adminservice.UpdateClusterMetadataRequest{
+		FailoverVersionIncrement: 100,
+		DryRun:                   true,
+	})
+	s.NoError(err)
+	s.Len(resp.Incompatibilities, 2)
+	s.Contains(resp.Incompatibilities[0], `connected cluster "standby" uses failover version increment 10`)
+	s.Contains(resp.Incompatibilities[1], `namespace "failed-over"`)
+
+	_, err = s.handler.UpdateClusterMetadata(ctx, &adminservice.UpdateClusterMetadataRequest{
+		ClusterAddress: "active-new:7233",
+	})
+	s.ErrorAs(err, new(*serviceerror.FailedPrecondition))
+
+	_, err = s.handler.UpdateClusterMetadata(ctx, &adminservice.UpdateClusterMetadataRequest{
+		FailoverVersionIncrement: 10,
+	})
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+
+	// a remote cluster's address can be changed if it reaches the same cluster
+	s.mockClusterMetadataManager.EXPECT().GetClusterMetadata(gomock.Any(), &persistence.GetClusterMetadataRequest{ClusterName: "standby"}).Return(
+		&persistence.GetClusterMetadataResponse{
+			ClusterMetadata: &persistencespb.ClusterMetadata{
+				ClusterName:    "standby",
+				ClusterId:      "standby-id",
+				ClusterAddress: "standby:7233",
+			},
+			Version: 5,
+		}, nil)
+	s.mockClientFactory.EXPECT().NewRemoteAdminClientWithTimeout("standby-new:7233", gomock.Any(), gomock.Any()).Return(s.mockAdminClient)
+	s.mockAdminClient.EXPECT().DescribeCluster(gomock.Any(), &adminservice.DescribeClusterRequest{}).Return(
+		&adminservice.DescribeClusterResponse{ClusterName: "standby", ClusterId: "standby-id"}, nil)
+	s.mockClusterMetadataManager.EXPECT().SaveClusterMetadata(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.SaveClusterMetadataRequest) (bool, error) {
+			s.ProtoEqual(&persistencespb.ClusterMetadata{
+				ClusterName:    "standby",
+				ClusterId:      "standby-id",
+				ClusterAddress: "standby-new:7233",
+			}, request.ClusterMetadata)
+			s.Equal(int64(5), request.Version)
+			return true, nil
+		},
+	)
+	_, err = s.handler.UpdateClusterMetadata(ctx, &adminservice.UpdateClusterMetadataRequest{
+		ClusterName:    "standby",
+		ClusterAddress: "standby-new:7233",
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestMoveShards() {
+	ctx := context.Background()
+	s.handler.numberOfHistoryShards = 4
+	key := strings.ToLower(dynamicconfig.HistoryShardOwnershipOverrides.Key().String())
+
+	hostA := membership.NewHostInfoFromAddress("a:7234")
+	hostB := membership.NewHostInfoFromAddress("b:7234")
+	hostC := membership.NewHostInfoFromAddress("c:7234")
+	ring := map[string][]membership.HostInfo{
+		"1": {hostA, hostB, hostC},
+		"2": {hostB, hostC, hostA},
+		"3": {hostA, hostC, hostB},
+		"4": {hostC, hostA, hostB},
+	}
+	resolver := s.mockResource.HistoryServiceResolver
+	resolver.EXPECT().Members().Return([]membership.HostInfo{hostA, hostB, hostC}).AnyTimes()
+	// c is draining
+	resolver.EXPECT().AvailableMembers().Return([]membership.HostInfo{hostA, hostB}).AnyTimes()
+	resolver.EXPECT().Lookup(gomock.Any()).DoAndReturn(func(key string) (membership.HostInfo, error) {
+		return ring[key][0], nil
+	}).AnyTimes()
+	resolver.EXPECT().LookupN(gomock.Any(), 3).DoAndReturn(func(key string, _ int) []membership.HostInfo {
+		return ring[key]
+	}).AnyTimes()
+
+	// shard 2 is pinned to a host that left the ring
+	initialValues, err := runtimeoverride.EncodeShardOwnershipOverrides([]dynamicconfig.ShardOwnershipOverride{
+		{ShardID: 2, Address: "gone:7234"},
+	})
+	s.NoError(err)
+	initialData, err := json.Marshal(map[string]*runtimeoverride.Override{key: {Values: initialValues}})
+	s.NoError(err)
+	systemNamespace := &persistencespb.NamespaceDetail{
+		Info: &persistencespb.NamespaceInfo{
+			Id:   primitives.SystemNamespaceID,
+			Name: primitives.SystemLocalNamespace,
+			Data: map[string]string{"temporal.dynamicconfig": string(initialData)},
+		},
+		Config:            &persistencespb.NamespaceConfig{},
+		ReplicationConfig: &persistencespb.NamespaceReplicationConfig{},
+	}
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{
+		Name: primitives.SystemLocalNamespace,
+	}).DoAndReturn(func(context.Context, *persistence.GetNamespaceRequest) (*persistence.GetNamespaceResponse, error) {
+		return &persistence.GetNamespaceResponse{Namespace: common.CloneProto(systemNamespace)}, nil
+	}).AnyTimes()
+	s.mockResource.MetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{}, nil).AnyTimes()
+	s.mockResource.MetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateNamespaceRequest) error {
+			systemNamespace.Info.Data = request.Namespace.Info.Data
+			return nil
+		},
+	).Times(2)
+	storedOverrides := func() []dynamicconfig.ShardOwnershipOverride {
+		var overrides map[string]*runtimeoverride.Override
+		s.NoError(json.Unmarshal([]byte(systemNamespace.Info.Data["temporal.dynamicconfig"]), &overrides))
+		if overrides[key] == nil {
+			return nil
+		}
+		decoded, err := runtimeoverride.ShardOwnershipOverrides(overrides[key].Values)
+		s.NoError(err)
+		return decoded
+	}
+
+	for _, request := range []*adminservice.MoveShardsRequest{
+		{},
+		{TargetAddress: "b:7234", Unpin: true},
+		{TargetAddress: "b:7234"},
+		{TargetAddress: "b:7234", ShardIds: []int32{5}},
+	} {
+		_, err := s.handler.MoveShards(ctx, request)
+		s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+	}
+	_, err = s.handler.MoveShards(ctx, &adminservice.MoveShardsRequest{TargetAddress: "c:7234", ShardIds: []int32{1}})
+	s.ErrorAs(err, new(*serviceerror.FailedPrecondition))
+
+	resp, err := s.handler.MoveShards(ctx, &adminservice.MoveShardsRequest{SourceAddress: "a:7234", DryRun: true})
+	s.NoError(err)
+	s.ProtoEqual(&adminservice.MoveShardsResponse{Moves: []*adminservice.ShardMove{
+		{ShardId: 1, FromAddress: "a:7234", ToAddress: "b:7234"},
+		{ShardId: 3, FromAddress: "a:7234", ToAddress: "b:7234"},
+	}}, resp)
+
+	resp, err = s.handler.MoveShards(ctx, &adminservice.MoveShardsRequest{TargetAddress: "b:7234", ShardIds: []int32{4, 1}})
+	s.NoError(err)
+	s.ProtoEqual(&adminservice.MoveShardsResponse{Moves: []*adminservice.ShardMove{
+		{ShardId: 1, FromAddress: "a:7234", ToAddress: "b:7234"},
+		{ShardId: 4, FromAddress: "c:7234", ToAddress: "b:7234"},
+	}}, resp)
+	s.Equal([]dynamicconfig.ShardOwnershipOverride{
+		{ShardID: 1, Address: "b:7234"},
+		{ShardID: 4, Address: "b:7234"},
+	}, storedOverrides())
+
+	resp, err = s.handler.MoveShards(ctx, &adminservice.MoveShardsRequest{Unpin: true})
+	s.NoError(err)
+	s.ProtoEqual(&adminservice.MoveShardsResponse{Moves: []*adminservice.ShardMove{
+		{ShardId: 1, FromAddress: "b:7234", ToAddress: "a:7234"},
+		{ShardId: 4, FromAddress: "b:7234", ToAddress: "c:7234"},
+	}}, resp)
+	s.Empty(storedOverrides())
+}
+
+func (s *adminHandlerSuite) TestGetTaskQueueTasks() {
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+	request := &adminservice.GetTaskQueueTasksRequest{
+		Namespace:     s.namespace.String(),
+		TaskQueue:     "test-tq",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		MinTaskId:     0,
+		MaxTaskId:     100,
+		BatchSize:     1,
+	}
+	tasks := []*persistencespb.AllocatedTaskInfo{{TaskId: 5}}
+	taskQueueInfo := &persistencespb.TaskQueueInfo{Name: "test-tq", AckLevel: 4}
+
+	// The task queue metadata is only returned with the first page.
+	s.mockResource.TaskMgr.EXPECT().GetTasks(gomock.Any(), &persistence.GetTasksRequest{
+		NamespaceID:        s.namespaceID.String(),
+		TaskQueue:          "test-tq",
+		TaskType:           enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		InclusiveMinTaskID: 0,
+		ExclusiveMaxTaskID: 100,
+		PageSize:           1,
+	}).Return(&persistence.GetTasksResponse{Tasks: tasks, NextPageToken: []byte("token")}, nil)
+	s.mockResource.TaskMgr.EXPECT().GetTaskQueue(gomock.Any(), &persistence.GetTaskQueueRequest{
+		NamespaceID: s.namespaceID.String(),
+		TaskQueue:   "test-tq",
+		TaskType:    enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+	}).Return(&persistence.GetTaskQueueResponse{RangeID: 3, TaskQueueInfo: taskQueueInfo}, nil)
+	resp, err := s.handler.GetTaskQueueTasks(context.Background(), request)
+	s.NoError(err)
+	s.ProtoEqual(&adminservice.GetTaskQueueTasksResponse{
+		Tasks:         tasks,
+		NextPageToken: []byte("token"),
+		TaskQueueInfo: taskQueueInfo,
+	}, resp)
+
+	request.NextPageToken = []byte("token")
+	s.mockResource.TaskMgr.EXPECT().GetTasks(gomock.Any(), gomock.Any()).Return(&persistence.GetTasksResponse{}, nil)
+	resp, err = s.handler.GetTaskQueueTasks(context.Background(), request)
+	s.NoError(err)
+	s.Nil(resp.TaskQueueInfo)
+
+	// A task queue without metadata still returns its tasks.
+	request.NextPageToken = nil
+	s.mockResource.TaskMgr.EXPECT().GetTasks(gomock.Any(), gomock.Any()).Return(&persistence.GetTasksResponse{Tasks: tasks}, nil)
+	s.mockResource.TaskMgr.EXPECT().GetTaskQueue(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("not found"))
+	resp, err = s.handler.GetTaskQueueTasks(context.Background(), request)
+	s.NoError(err)
+	s.ProtoEqual(&adminservice.GetTaskQueueTasksResponse{Tasks: tasks}, resp)
+}
@@ -0,0 +1,179 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+)
+
+const (
+	// approvalsDataKey is the key of the pending approvals in the Data of the system namespace.
+	approvalsDataKey = "temporal.approvals"
+)
+
+type (
+	// ApprovalGate enforces two-person approval for destructive operator APIs. The first call for a given
+	// operation records a pending approval and is rejected. The operation executes only when it is requested
+	// again, within the configured TTL, by a different caller identity.
+	//
+	// Pending approvals are stored in the system namespace so that both calls may be served by different
+	// frontend hosts.
+	ApprovalGate struct {
+		approvals  *persistence.SystemNamespaceData
+		enabled    func() bool
+		ttl        func() time.Duration
+		timeSource clock.TimeSource
+		logger     log.Logger
+	}
+
+	approvalRecord struct {
+		Requester string    `json:"requester"`
+		API       string    `json:"api"`
+		Target    string    `json:"target"`
+		ExpiresAt time.Time `json:"expiresAt"`
+	}
+)
+
+// NewApprovalGate creates an ApprovalGate.
+func NewApprovalGate(
+	metadataMgr persistence.MetadataManager,
+	enabled func() bool,
+	ttl func() time.Duration,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+) *ApprovalGate {
+	return &ApprovalGate{
+		approvals:  persistence.NewSystemNamespaceData(metadataMgr, approvalsDataKey),
+		enabled:    enabled,
+		ttl:        ttl,
+		timeSource: timeSource,
+		logger:     logger,
+	}
+}
+
+// Enabled reports whether destructive operations currently require approval.
+func (g *ApprovalGate) Enabled() bool {
+	return g != nil && g.enabled()
+}
+
+// Check returns nil if the operation identified by api and target may proceed. Otherwise it returns a
+// FailedPrecondition error describing the pending approval, or PermissionDenied if the caller has no identity.
+func (g *ApprovalGate) Check(ctx context.Context, api string, target string) error {
+	if !g.Enabled() {
+		return nil
+	}
+
+	caller := approvalCallerIdentity(ctx)
+	if caller == "" {
+		return serviceerror.NewPermissionDenied(
+			fmt.Sprintf("%s requires approval by a second identity, but the caller is not authenticated", api),
+			"",
+		)
+	}
+
+	var result error
+	if err := g.approvals.Update(ctx, func(current string) (string, error) {
+		now := g.timeSource.Now()
+		records := decodeApprovalRecords(current)
+		maps.DeleteFunc(records, func(_ string, record approvalRecord) bool {
+			return !now.Before(record.ExpiresAt)
+		})
+
+		key := approvalRecordKey(api, target)
+		record, pending := records[key]
+		switch {
+		case pending && record.Requester == caller:
+			result = serviceerror.NewFailedPrecondition(fmt.Sprintf(
+				"%s on %q is pending approval by another identity until %s",
+				api, target, record.ExpiresAt.UTC().Format(time.RFC3339),
+			))
+		case pending:
+			delete(records, key)
+			g.logger.Info("Destructive operation approved",
+				tag.NewStringTag("api", api),
+				tag.NewStringTag("target", target),
+				tag.NewStringTag("requester", record.Requester),
+				tag.NewStringTag("approver", caller),
+			)
+		default:
+			record = approvalRecord{
+				Requester: caller,
+				API:       api,
+				Target:    target,
+				ExpiresAt: now.Add(g.ttl()),
+			}
+			records[key] = record
+			result = serviceerror.NewFailedPrecondition(fmt.Sprintf(
+				"%s on %q requires approval: it must be requested again by a second identity before %s",
+				api, target, record.ExpiresAt.UTC().Format(time.RFC3339),
+			))
+		}
+		return encodeApprovalRecords(records)
+	}); err != nil {
+		return err
+	}
+	return result
+}
+
+func approvalCallerIdentity(ctx context.Context) string {
+	if claims, ok := ctx.Value(authorization.MappedClaims).(*authorization.Claims); ok && claims != nil {
+		return claims.Subject
+	}
+	return ""
+}
+
+// approvalRecordKey returns the key of the pending approval of an operation in the approvals document.
+func approvalRecordKey(api string, target string) string {
+	sum := sha256.Sum256([]byte(api + "\x00" + target))
+	return hex.EncodeToString(sum[:])
+}
+
+// decodeApprovalRecords returns the pending approvals by key. An unreadable document holds no pending approvals.
+func decodeApprovalRecords(encoded string) map[string]approvalRecord {
+	records := make(map[string]approvalRecord)
+	if encoded != "" && json.Unmarshal([]byte(encoded), &records) != nil {
+		return make(map[string]approvalRecord)
+	}
+	return records
+}
+
+func encodeApprovalRecords(records map[string]approvalRecord) (string, error) {
+	if len(records) == 0 {
+		return "", nil
+	}
+	encoded, err := json.Marshal(records)
+	return string(encoded), err
+}
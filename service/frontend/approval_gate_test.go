@@ -0,0 +1,188 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	batchpb "go.temporal.io/api/batch/v1"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives"
+	"go.uber.org/mock/gomock"
+)
+
+// newApprovalGateForTest returns a gate backed by an in-memory system namespace.
+func newApprovalGateForTest(
+	t *testing.T,
+	timeSource clock.TimeSource,
+) (*ApprovalGate, *persistencespb.NamespaceDetail) {
+	ctrl := gomock.NewController(t)
+	metadataMgr := persistence.NewMockMetadataManager(ctrl)
+	detail := &persistencespb.NamespaceDetail{
+		Info: &persistencespb.NamespaceInfo{Name: primitives.SystemLocalNamespace, Data: map[string]string{"owner": "ops"}},
+	}
+	var notificationVersion int64
+
+	metadataMgr.EXPECT().GetMetadata(gomock.Any()).DoAndReturn(
+		func(context.Context) (*persistence.GetMetadataResponse, error) {
+			return &persistence.GetMetadataResponse{NotificationVersion: notificationVersion}, nil
+		},
+	).AnyTimes()
+	metadataMgr.EXPECT().GetNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.GetNamespaceRequest) (*persistence.GetNamespaceResponse, error) {
+			require.Equal(t, primitives.SystemLocalNamespace, request.Name)
+			data := make(map[string]string, len(detail.Info.Data))
+			for k, v := range detail.Info.Data {
+				data[k] = v
+			}
+			return &persistence.GetNamespaceResponse{
+				Namespace: &persistencespb.NamespaceDetail{
+					Info: &persistencespb.NamespaceInfo{Name: detail.Info.Name, Data: data},
+				},
+			}, nil
+		},
+	).AnyTimes()
+	metadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateNamespaceRequest) error {
+			if request.NotificationVersion != notificationVersion {
+				return &persistence.ConditionFailedError{Msg: "notification version mismatch"}
+			}
+			notificationVersion++
+			detail.Info.Data = request.Namespace.Info.Data
+			return nil
+		},
+	).AnyTimes()
+
+	gate := NewApprovalGate(
+		metadataMgr,
+		func() bool { return true },
+		func() time.Duration { return 10 * time.Minute },
+		timeSource,
+		log.NewNoopLogger(),
+	)
+	return gate, detail
+}
+
+func approvalContext(subject string) context.Context {
+	return context.WithValue(context.Background(), authorization.MappedClaims, &authorization.Claims{Subject: subject})
+}
+
+func TestApprovalGate_Disabled(t *testing.T) {
+	var nilGate *ApprovalGate
+	require.False(t, nilGate.Enabled())
+	require.NoError(t, nilGate.Check(context.Background(), "DeleteNamespace", "ns"))
+
+	gate := NewApprovalGate(nil, func() bool { return false }, nil, nil, log.NewNoopLogger())
+	require.NoError(t, gate.Check(context.Background(), "DeleteNamespace", "ns"))
+}
+
+func TestApprovalGate_RequiresAuthenticatedCaller(t *testing.T) {
+	gate, _ := newApprovalGateForTest(t, clock.NewEventTimeSource())
+
+	var permissionDenied *serviceerror.PermissionDenied
+	require.ErrorAs(t, gate.Check(context.Background(), "DeleteNamespace", "ns"), &permissionDenied)
+}
+
+func TestApprovalGate_SecondIdentityApproves(t *testing.T) {
+	gate, detail := newApprovalGateForTest(t, clock.NewEventTimeSource())
+
+	var failedPrecondition *serviceerror.FailedPrecondition
+	require.ErrorAs(t, gate.Check(approvalContext("alice"), "DeleteNamespace", "ns"), &failedPrecondition)
+	require.Contains(t, decodeApprovalRecords(detail.Info.Data[approvalsDataKey]), approvalRecordKey("DeleteNamespace", "ns"))
+	require.Equal(t, "ops", detail.Info.Data["owner"])
+
+	// The requester cannot approve their own request.
+	require.ErrorAs(t, gate.Check(approvalContext("alice"), "DeleteNamespace", "ns"), &failedPrecondition)
+
+	// A pending approval covers only the exact operation and target.
+	require.ErrorAs(t, gate.Check(approvalContext("bob"), "DeleteNamespace", "other-ns"), &failedPrecondition)
+
+	require.NoError(t, gate.Check(approvalContext("bob"), "DeleteNamespace", "ns"))
+	require.NotContains(t, decodeApprovalRecords(detail.Info.Data[approvalsDataKey]), approvalRecordKey("DeleteNamespace", "ns"))
+	require.Equal(t, "ops", detail.Info.Data["owner"])
+
+	// The approval is consumed by the approved operation.
+	require.ErrorAs(t, gate.Check(approvalContext("bob"), "DeleteNamespace", "ns"), &failedPrecondition)
+}
+
+func TestApprovalGate_ExpiredApproval(t *testing.T) {
+	timeSource := clock.NewEventTimeSource()
+	timeSource.Update(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	gate, detail := newApprovalGateForTest(t, timeSource)
+
+	var failedPrecondition *serviceerror.FailedPrecondition
+	require.ErrorAs(t, gate.Check(approvalContext("alice"), "PurgeDLQTasks", "dlq"), &failedPrecondition)
+
+	timeSource.Advance(11 * time.Minute)
+
+	// The expired approval is pruned and bob's call starts a new approval instead of executing.
+	require.ErrorAs(t, gate.Check(approvalContext("bob"), "PurgeDLQTasks", "dlq"), &failedPrecondition)
+	require.Len(t, decodeApprovalRecords(detail.Info.Data[approvalsDataKey]), 1)
+	require.NoError(t, gate.Check(approvalContext("alice"), "PurgeDLQTasks", "dlq"))
+}
+
+func TestBatchTerminateApprovalTarget(t *testing.T) {
+	newRequest := func() *workflowservice.StartBatchOperationRequest {
+		return &workflowservice.StartBatchOperationRequest{
+			Namespace:       "ns",
+			JobId:           "job",
+			VisibilityQuery: "WorkflowType = 'order'",
+			Reason:          "cleanup",
+			Operation: &workflowservice.StartBatchOperationRequest_TerminationOperation{
+				TerminationOperation: &batchpb.BatchOperationTermination{Identity: "alice"},
+			},
+		}
+	}
+	target := batchTerminateApprovalTarget(newRequest())
+
+	// The approver's request only differs in its identity.
+	approval := newRequest()
+	approval.GetTerminationOperation().Identity = "bob"
+	require.Equal(t, target, batchTerminateApprovalTarget(approval))
+
+	query := newRequest()
+	query.VisibilityQuery = "WorkflowType = 'payment'"
+	require.NotEqual(t, target, batchTerminateApprovalTarget(query))
+
+	reason := newRequest()
+	reason.Reason = "other"
+	require.NotEqual(t, target, batchTerminateApprovalTarget(reason))
+
+	executions := newRequest()
+	executions.VisibilityQuery = ""
+	executions.Executions = []*commonpb.WorkflowExecution{{WorkflowId: "wf", RunId: "run"}}
+	otherExecutions := newRequest()
+	otherExecutions.VisibilityQuery = ""
+	otherExecutions.Executions = []*commonpb.WorkflowExecution{{WorkflowId: "wfr", RunId: "un"}}
+	require.NotEqual(t, batchTerminateApprovalTarget(executions), batchTerminateApprovalTarget(otherExecutions))
+}
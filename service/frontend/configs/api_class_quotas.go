@@ -0,0 +1,229 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package configs
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/quotas"
+)
+
+const (
+	APIClassRead       = "read"
+	APIClassWrite      = "write"
+	APIClassPoll       = "poll"
+	APIClassVisibility = "visibility"
+
+	identityRateLimiterCacheSize = 10000
+	identityRateLimiterCacheTTL  = 10 * time.Minute
+)
+
+var (
+	APIClasses = []string{APIClassRead, APIClassWrite, APIClassPoll, APIClassVisibility}
+
+	readAPIPrefixes = []string{"Get", "Describe", "List", "Query", "Count", "Fetch"}
+)
+
+type (
+	// optionalRequestRateLimiter bypasses the wrapped rate limiter while its rate is not positive.
+	optionalRequestRateLimiter struct {
+		rateFn      quotas.RateFn
+		rateLimiter quotas.RequestRateLimiter
+	}
+
+	apiClassRateLimiterKey struct {
+		namespace string
+		class     string
+	}
+
+	// identityRateLimiter keeps a rate limiter per namespace and identity. Rate limiters of idle identities are
+	// evicted, since identities are usually tied to worker processes and churn with them.
+	identityRateLimiter struct {
+		rateLimiterGenFn quotas.RequestRateLimiterFn
+		rateLimiters     cache.Cache
+	}
+
+	identityRateLimiterKey struct {
+		namespace string
+		identity  string
+	}
+)
+
+var _ quotas.RequestRateLimiter = (*optionalRequestRateLimiter)(nil)
+var _ quotas.RequestRateLimiter = (*identityRateLimiter)(nil)
+
+// APIToClass returns the API class (read, write, poll or visibility) of the given full method name.
+func APIToClass(api string) string {
+	if _, ok := VisibilityAPIToPriority[api]; ok {
+		return APIClassVisibility
+	}
+	method := api[strings.LastIndex(api, "/")+1:]
+	if strings.HasPrefix(method, "Poll") {
+		return APIClassPoll
+	}
+	for _, prefix := range readAPIPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return APIClassRead
+		}
+	}
+	return APIClassWrite
+}
+
+// NewAPIClassRateLimiter returns a rate limiter that applies a separate per namespace limit to each API class.
+// Classes without a positive limit are not throttled by this rate limiter.
+func NewAPIClassRateLimiter(
+	rpsFn dynamicconfig.TypedPropertyFnWithNamespaceFilter[map[string]int],
+	burstRatioFn dynamicconfig.FloatPropertyFnWithNamespaceFilter,
+) quotas.RequestRateLimiter {
+	return quotas.NewMapRequestRateLimiter[apiClassRateLimiterKey](
+		func(req quotas.Request) quotas.RequestRateLimiter {
+			class := APIToClass(req.API)
+			rateFn := func(namespace string) float64 {
+				return float64(rpsFn(namespace)[class])
+			}
+			rateBurst := NewNamespaceRateBurst(req.Caller, rateFn, burstRatioFn)
+			return newOptionalRequestRateLimiter(
+				rateBurst.Rate,
+				quotas.NewRequestRateLimiterAdapter(quotas.NewDynamicRateLimiter(rateBurst, time.Minute)),
+			)
+		},
+		func(req quotas.Request) apiClassRateLimiterKey {
+			return apiClassRateLimiterKey{namespace: req.Caller, class: APIToClass(req.API)}
+		},
+	)
+}
+
+// NewIdentityRateLimiter returns a rate limiter that applies a per namespace limit to each caller identity.
+// Within the limit of an identity, API classes are prioritized according to classPriorityFn so that lower priority
+// classes are shed first. Requests without an identity are not throttled by this rate limiter.
+func NewIdentityRateLimiter(
+	rpsFn dynamicconfig.IntPropertyFnWithNamespaceFilter,
+	burstRatioFn dynamicconfig.FloatPropertyFnWithNamespaceFilter,
+	classPriorityFn dynamicconfig.TypedPropertyFn[[]string],
+	operatorRPSRatio dynamicconfig.FloatPropertyFn,
+) quotas.RequestRateLimiter {
+	rateFn := func(namespace string) float64 {
+		return float64(rpsFn(namespace))
+	}
+	return &identityRateLimiter{
+		rateLimiterGenFn: func(req quotas.Request) quotas.RequestRateLimiter {
+			rateBurst := NewNamespaceRateBurst(req.Caller, rateFn, burstRatioFn)
+			return newOptionalRequestRateLimiter(
+				rateBurst.Rate,
+				NewAPIClassPriorityRateLimiter(rateBurst, classPriorityFn, operatorRPSRatio),
+			)
+		},
+		rateLimiters: cache.New(identityRateLimiterCacheSize, &cache.Options{TTL: identityRateLimiterCacheTTL}),
+	}
+}
+
+// NewAPIClassPriorityRateLimiter returns a priority rate limiter where the priority of a request is the position of
+// its API class in classPriorityFn. Operator requests always have the highest priority.
+func NewAPIClassPriorityRateLimiter(
+	rateBurstFn quotas.RateBurst,
+	classPriorityFn dynamicconfig.TypedPropertyFn[[]string],
+	operatorRPSRatio dynamicconfig.FloatPropertyFn,
+) quotas.RequestRateLimiter {
+	lowestPriority := len(APIClasses)
+	rateLimiters := make(map[int]quotas.RequestRateLimiter)
+	for priority := 0; priority <= lowestPriority; priority++ {
+		if priority == OperatorPriority {
+			rateLimiters[priority] = quotas.NewRequestRateLimiterAdapter(quotas.NewDynamicRateLimiter(newOperatorRateBurst(rateBurstFn, operatorRPSRatio), time.Minute))
+		} else {
+			rateLimiters[priority] = quotas.NewRequestRateLimiterAdapter(quotas.NewDynamicRateLimiter(rateBurstFn, time.Minute))
+		}
+	}
+	return quotas.NewPriorityRateLimiter(func(req quotas.Request) int {
+		if req.CallerType == headers.CallerTypeOperator {
+			return OperatorPriority
+		}
+		if index := slices.Index(classPriorityFn(), APIToClass(req.API)); index >= 0 {
+			return min(index+1, lowestPriority)
+		}
+		return lowestPriority
+	}, rateLimiters)
+}
+
+func newOptionalRequestRateLimiter(
+	rateFn quotas.RateFn,
+	rateLimiter quotas.RequestRateLimiter,
+) *optionalRequestRateLimiter {
+	return &optionalRequestRateLimiter{
+		rateFn:      rateFn,
+		rateLimiter: rateLimiter,
+	}
+}
+
+func (l *optionalRequestRateLimiter) Allow(now time.Time, request quotas.Request) bool {
+	if l.rateFn() <= 0 {
+		return true
+	}
+	return l.rateLimiter.Allow(now, request)
+}
+
+func (l *optionalRequestRateLimiter) Reserve(now time.Time, request quotas.Request) quotas.Reservation {
+	if l.rateFn() <= 0 {
+		return quotas.NoopReservation
+	}
+	return l.rateLimiter.Reserve(now, request)
+}
+
+func (l *optionalRequestRateLimiter) Wait(ctx context.Context, request quotas.Request) error {
+	if l.rateFn() <= 0 {
+		return nil
+	}
+	return l.rateLimiter.Wait(ctx, request)
+}
+
+func (r *identityRateLimiter) Allow(now time.Time, request quotas.Request) bool {
+	return r.getOrInitRateLimiter(request).Allow(now, request)
+}
+
+func (r *identityRateLimiter) Reserve(now time.Time, request quotas.Request) quotas.Reservation {
+	return r.getOrInitRateLimiter(request).Reserve(now, request)
+}
+
+func (r *identityRateLimiter) Wait(ctx context.Context, request quotas.Request) error {
+	return r.getOrInitRateLimiter(request).Wait(ctx, request)
+}
+
+func (r *identityRateLimiter) getOrInitRateLimiter(req quotas.Request) quotas.RequestRateLimiter {
+	if req.Identity == "" {
+		return quotas.NoopRequestRateLimiter
+	}
+	key := identityRateLimiterKey{namespace: req.Caller, identity: req.Identity}
+	if rateLimiter, ok := r.rateLimiters.Get(key).(quotas.RequestRateLimiter); ok {
+		return rateLimiter
+	}
+	rateLimiter, err := r.rateLimiters.PutIfNotExist(key, r.rateLimiterGenFn(req))
+	if err != nil {
+		return quotas.NoopRequestRateLimiter
+	}
+	return rateLimiter.(quotas.RequestRateLimiter)
+}
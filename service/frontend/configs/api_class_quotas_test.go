@@ -0,0 +1,138 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package configs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/quotas"
+)
+
+const (
+	testPollAPI       = "/temporal.api.workflowservice.v1.WorkflowService/PollWorkflowTaskQueue"
+	testReadAPI       = "/temporal.api.workflowservice.v1.WorkflowService/DescribeWorkflowExecution"
+	testWriteAPI      = "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution"
+	testVisibilityAPI = "/temporal.api.workflowservice.v1.WorkflowService/ListWorkflowExecutions"
+)
+
+func newTestClassRequest(api string, namespace string, identity string) quotas.Request {
+	request := quotas.NewRequest(api, 1, namespace, headers.CallerTypeAPI, -1, "")
+	request.Identity = identity
+	return request
+}
+
+func TestAPIToClass(t *testing.T) {
+	require.Equal(t, APIClassPoll, APIToClass(testPollAPI))
+	require.Equal(t, APIClassRead, APIToClass(testReadAPI))
+	require.Equal(t, APIClassWrite, APIToClass(testWriteAPI))
+	require.Equal(t, APIClassVisibility, APIToClass(testVisibilityAPI))
+	require.Equal(t, APIClassRead, APIToClass("/temporal.api.workflowservice.v1.WorkflowService/QueryWorkflow"))
+	require.Equal(t, APIClassWrite, APIToClass("/temporal.api.workflowservice.v1.WorkflowService/RespondActivityTaskCompleted"))
+}
+
+func TestAPIClassRateLimiter(t *testing.T) {
+	limiter := NewAPIClassRateLimiter(
+		func(namespace string) map[string]int {
+			if namespace == "limited" {
+				return map[string]int{APIClassRead: 2}
+			}
+			return nil
+		},
+		func(string) float64 { return 1 },
+	)
+
+	now := time.Now()
+	require.True(t, limiter.Allow(now, newTestClassRequest(testReadAPI, "limited", "")))
+	require.True(t, limiter.Allow(now, newTestClassRequest(testReadAPI, "limited", "")))
+	require.False(t, limiter.Allow(now, newTestClassRequest(testReadAPI, "limited", "")))
+
+	// Other classes and namespaces have no limit configured.
+	for i := 0; i < 10; i++ {
+		require.True(t, limiter.Allow(now, newTestClassRequest(testWriteAPI, "limited", "")))
+		require.True(t, limiter.Allow(now, newTestClassRequest(testReadAPI, "other", "")))
+	}
+}
+
+func TestIdentityRateLimiter_ShedsLowerPriorityClassesFirst(t *testing.T) {
+	limiter := NewIdentityRateLimiter(
+		func(string) int { return 5 },
+		func(string) float64 { return 1 },
+		func() []string { return []string{APIClassPoll, APIClassWrite, APIClassRead, APIClassVisibility} },
+		testOperatorRPSRatioFn,
+	)
+
+	now := time.Now()
+	for i := 0; i < 5; i++ {
+		require.True(t, limiter.Allow(now, newTestClassRequest(testVisibilityAPI, "ns", "worker")))
+	}
+	require.False(t, limiter.Allow(now, newTestClassRequest(testVisibilityAPI, "ns", "worker")))
+
+	// Polls are not affected by exhausted visibility quota, but they consume it.
+	for i := 0; i < 5; i++ {
+		require.True(t, limiter.Allow(now, newTestClassRequest(testPollAPI, "ns", "worker")))
+	}
+	require.False(t, limiter.Allow(now, newTestClassRequest(testPollAPI, "ns", "worker")))
+
+	// Other identities and requests without identity have their own quota.
+	require.True(t, limiter.Allow(now, newTestClassRequest(testVisibilityAPI, "ns", "other-worker")))
+	for i := 0; i < 10; i++ {
+		require.True(t, limiter.Allow(now, newTestClassRequest(testVisibilityAPI, "ns", "")))
+	}
+}
+
+func TestIdentityRateLimiter_DynamicPriority(t *testing.T) {
+	priority := []string{APIClassPoll, APIClassVisibility}
+	limiter := NewIdentityRateLimiter(
+		func(string) int { return 2 },
+		func(string) float64 { return 1 },
+		func() []string { return priority },
+		testOperatorRPSRatioFn,
+	)
+
+	now := time.Now()
+	require.True(t, limiter.Allow(now, newTestClassRequest(testWriteAPI, "ns", "worker")))
+	require.True(t, limiter.Allow(now, newTestClassRequest(testWriteAPI, "ns", "worker")))
+	require.False(t, limiter.Allow(now, newTestClassRequest(testWriteAPI, "ns", "worker")))
+	require.True(t, limiter.Allow(now, newTestClassRequest(testVisibilityAPI, "ns", "worker")))
+
+	priority = []string{APIClassWrite}
+	require.True(t, limiter.Allow(now, newTestClassRequest(testWriteAPI, "ns", "worker")))
+	require.False(t, limiter.Allow(now, newTestClassRequest(testVisibilityAPI, "ns", "worker")))
+}
+
+func TestIdentityRateLimiter_Disabled(t *testing.T) {
+	limiter := NewIdentityRateLimiter(
+		func(string) int { return 0 },
+		func(string) float64 { return 1 },
+		func() []string { return nil },
+		testOperatorRPSRatioFn,
+	)
+
+	now := time.Now()
+	for i := 0; i < 10; i++ {
+		require.True(t, limiter.Allow(now, newTestClassRequest(testPollAPI, "ns", "worker")))
+	}
+}
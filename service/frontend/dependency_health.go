@@ -0,0 +1,345 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/primitives"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	dependencyPersistenceRead  = "persistence-read"
+	dependencyPersistenceWrite = "persistence-write"
+	dependencyVisibility       = "visibility"
+	dependencyMembership       = "membership/"
+	dependencyService          = "service/"
+
+	// matchingServiceName is the name matching hosts register on their gRPC health server.
+	matchingServiceName = "temporal.api.workflowservice.v1.MatchingService"
+)
+
+var (
+	// ringServices are the services whose membership ring the frontend needs to route requests.
+	ringServices = []primitives.ServiceName{
+		primitives.FrontendService,
+		primitives.HistoryService,
+		primitives.MatchingService,
+	}
+
+	errNoRingMembers        = errors.New("no available member in the ring")
+	errTooManyUnhealthyHost = errors.New("too many hosts are not serving")
+)
+
+type (
+	// DependencyHealthChecker probes the dependencies the frontend needs to serve requests: persistence, visibility,
+	// the membership rings and the history and matching services.
+	DependencyHealthChecker struct {
+		clusterMetadataManager  persistence.ClusterMetadataManager
+		visibilityManager       manager.VisibilityManager
+		namespaceRegistry       namespace.Registry
+		membershipMonitor       membership.Monitor
+		historyHealthChecker    HealthChecker
+		matchingHealthChecker   HealthChecker
+		probeTimeout            dynamicconfig.DurationPropertyFn
+		cacheTTL                dynamicconfig.DurationPropertyFn
+		ringStabilizationPeriod dynamicconfig.DurationPropertyFn
+		timeSource              clock.TimeSource
+
+		// Held while probing so that concurrent checks share the same probes.
+		lock     sync.Mutex
+		cached   *adminservice.DeepHealthCheckResponse
+		cachedAt time.Time
+
+		ringsLock sync.Mutex
+		rings     map[primitives.ServiceName]ringState
+	}
+
+	dependencyProbe struct {
+		name  string
+		probe func(ctx context.Context) error
+	}
+
+	ringState struct {
+		members   string
+		changedAt time.Time
+	}
+)
+
+func NewDependencyHealthChecker(
+	clusterMetadataManager persistence.ClusterMetadataManager,
+	visibilityManager manager.VisibilityManager,
+	namespaceRegistry namespace.Registry,
+	membershipMonitor membership.Monitor,
+	historyHealthChecker HealthChecker,
+	matchingHealthChecker HealthChecker,
+	probeTimeout dynamicconfig.DurationPropertyFn,
+	cacheTTL dynamicconfig.DurationPropertyFn,
+	ringStabilizationPeriod dynamicconfig.DurationPropertyFn,
+	timeSource clock.TimeSource,
+) *DependencyHealthChecker {
+	return &DependencyHealthChecker{
+		clusterMetadataManager:  clusterMetadataManager,
+		visibilityManager:       visibilityManager,
+		namespaceRegistry:       namespaceRegistry,
+		membershipMonitor:       membershipMonitor,
+		historyHealthChecker:    historyHealthChecker,
+		matchingHealthChecker:   matchingHealthChecker,
+		probeTimeout:            probeTimeout,
+		cacheTTL:                cacheTTL,
+		ringStabilizationPeriod: ringStabilizationPeriod,
+		timeSource:              timeSource,
+		rings:                   make(map[primitives.ServiceName]ringState),
+	}
+}
+
+// newHistoryHealthChecker checks the history hosts of the ring with their deep health check.
+func newHistoryHealthChecker(
+	historyClient historyservice.HistoryServiceClient,
+	membershipMonitor membership.Monitor,
+	hostFailurePercentage dynamicconfig.FloatPropertyFn,
+	logger log.Logger,
+) HealthChecker {
+	return NewHealthChecker(
+		primitives.HistoryService,
+		membershipMonitor,
+		hostFailurePercentage,
+		func(ctx context.Context, hostAddress string) (enumsspb.HealthState, error) {
+			resp, err := historyClient.DeepHealthCheck(ctx, &historyservice.DeepHealthCheckRequest{HostAddress: hostAddress})
+			if err != nil {
+				return enumsspb.HEALTH_STATE_NOT_SERVING, err
+			}
+			return resp.GetState(), nil
+		},
+		logger,
+	)
+}
+
+// newMatchingHealthChecker checks the matching hosts of the ring with the gRPC health protocol.
+func newMatchingHealthChecker(
+	rpcFactory common.RPCFactory,
+	membershipMonitor membership.Monitor,
+	hostFailurePercentage dynamicconfig.FloatPropertyFn,
+	logger log.Logger,
+) HealthChecker {
+	return NewHealthChecker(
+		primitives.MatchingService,
+		membershipMonitor,
+		hostFailurePercentage,
+		func(ctx context.Context, hostAddress string) (enumsspb.HealthState, error) {
+			client := healthpb.NewHealthClient(rpcFactory.CreateInternodeGRPCConnection(hostAddress))
+			resp, err := client.Check(ctx, &healthpb.HealthCheckRequest{Service: matchingServiceName})
+			if err != nil {
+				return enumsspb.HEALTH_STATE_NOT_SERVING, err
+			}
+			if resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+				return enumsspb.HEALTH_STATE_NOT_SERVING, nil
+			}
+			return enumsspb.HEALTH_STATE_SERVING, nil
+		},
+		logger,
+	)
+}
+
+// Check probes every dependency concurrently and reports the health of each of them. The state of the response is
+// serving only if every dependency is serving. The result is reused for frontend.dependencyHealthCheckCacheTTL.
+func (c *DependencyHealthChecker) Check(ctx context.Context) *adminservice.DeepHealthCheckResponse {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	now := c.timeSource.Now()
+	if c.cached != nil && now.Sub(c.cachedAt) < c.cacheTTL() {
+		return c.cached
+	}
+
+	// A cancelled caller must not leave a failed result in the cache.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), c.probeTimeout())
+	defer cancel()
+
+	probes := c.probes()
+	dependencies := make([]*adminservice.DeepHealthCheckResponse_Dependency, len(probes))
+	var wg sync.WaitGroup
+	for i, p := range probes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			dependencies[i] = c.runProbe(ctx, p)
+		}()
+	}
+	wg.Wait()
+
+	state := enumsspb.HEALTH_STATE_SERVING
+	for _, dependency := range dependencies {
+		if dependency.GetState() != enumsspb.HEALTH_STATE_SERVING {
+			state = enumsspb.HEALTH_STATE_NOT_SERVING
+		}
+	}
+	c.cached = &adminservice.DeepHealthCheckResponse{
+		State:        state,
+		Dependencies: dependencies,
+	}
+	c.cachedAt = now
+	return c.cached
+}
+
+func (c *DependencyHealthChecker) probes() []dependencyProbe {
+	probes := []dependencyProbe{
+		{name: dependencyPersistenceRead, probe: c.probePersistenceRead},
+		{name: dependencyPersistenceWrite, probe: c.probePersistenceWrite},
+		{name: dependencyVisibility, probe: c.probeVisibility},
+	}
+	for _, service := range ringServices {
+		probes = append(probes, dependencyProbe{
+			name: dependencyMembership + string(service),
+			probe: func(context.Context) error {
+				return c.probeRing(service)
+			},
+		})
+	}
+	return append(probes,
+		dependencyProbe{
+			name:  dependencyService + string(primitives.HistoryService),
+			probe: healthCheckerProbe(c.historyHealthChecker),
+		},
+		dependencyProbe{
+			name:  dependencyService + string(primitives.MatchingService),
+			probe: healthCheckerProbe(c.matchingHealthChecker),
+		},
+	)
+}
+
+func (c *DependencyHealthChecker) runProbe(
+	ctx context.Context,
+	p dependencyProbe,
+) *adminservice.DeepHealthCheckResponse_Dependency {
+	startTime := c.timeSource.Now()
+	err := p.probe(ctx)
+	dependency := &adminservice.DeepHealthCheckResponse_Dependency{
+		Name:    p.name,
+		State:   enumsspb.HEALTH_STATE_SERVING,
+		Latency: durationpb.New(c.timeSource.Now().Sub(startTime)),
+	}
+	if err != nil {
+		dependency.State = enumsspb.HEALTH_STATE_NOT_SERVING
+		dependency.Message = err.Error()
+	}
+	return dependency
+}
+
+func (c *DependencyHealthChecker) probePersistenceRead(ctx context.Context) error {
+	_, err := c.clusterMetadataManager.GetCurrentClusterMetadata(ctx)
+	return err
+}
+
+func (c *DependencyHealthChecker) probePersistenceWrite(ctx context.Context) error {
+	resp, err := c.clusterMetadataManager.GetCurrentClusterMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	// Rewrite the record as it was read. The write is conditional on the version read, so a concurrent update of the
+	// cluster metadata makes it a no-op rather than a failure.
+	_, err = c.clusterMetadataManager.SaveClusterMetadata(ctx, &persistence.SaveClusterMetadataRequest{
+		ClusterMetadata: resp.ClusterMetadata,
+		Version:         resp.Version,
+	})
+	return err
+}
+
+func (c *DependencyHealthChecker) probeVisibility(ctx context.Context) error {
+	ns, err := c.namespaceRegistry.GetNamespace(primitives.SystemLocalNamespace)
+	if err != nil {
+		return err
+	}
+	_, err = c.visibilityManager.ListWorkflowExecutions(ctx, &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID: ns.ID(),
+		Namespace:   ns.Name(),
+		PageSize:    1,
+	})
+	return err
+}
+
+// probeRing fails when the ring of the service has no available member or, with
+// frontend.dependencyHealthRingStabilizationPeriod set, when its members changed within that period. Changes are
+// only observed when the ring is probed.
+func (c *DependencyHealthChecker) probeRing(service primitives.ServiceName) error {
+	resolver, err := c.membershipMonitor.GetResolver(service)
+	if err != nil {
+		return err
+	}
+	hosts := resolver.AvailableMembers()
+	if len(hosts) == 0 {
+		return errNoRingMembers
+	}
+	addresses := make([]string, 0, len(hosts))
+	for _, host := range hosts {
+		addresses = append(addresses, host.GetAddress())
+	}
+	slices.Sort(addresses)
+	members := strings.Join(addresses, ",")
+
+	c.ringsLock.Lock()
+	defer c.ringsLock.Unlock()
+
+	now := c.timeSource.Now()
+	ring, ok := c.rings[service]
+	if !ok || ring.members != members {
+		ring = ringState{members: members, changedAt: now}
+		c.rings[service] = ring
+	}
+	if period := c.ringStabilizationPeriod(); period > 0 && now.Sub(ring.changedAt) < period {
+		return fmt.Errorf("ring of %d members changed %v ago", len(hosts), now.Sub(ring.changedAt))
+	}
+	return nil
+}
+
+func healthCheckerProbe(checker HealthChecker) func(context.Context) error {
+	return func(ctx context.Context) error {
+		state, err := checker.Check(ctx)
+		if err != nil {
+			return err
+		}
+		if state != enumsspb.HEALTH_STATE_SERVING {
+			return errTooManyUnhealthyHost
+		}
+		return nil
+	}
+}
@@ -0,0 +1,212 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/primitives"
+	"go.uber.org/mock/gomock"
+)
+
+type (
+	dependencyHealthCheckerSuite struct {
+		suite.Suite
+		controller *gomock.Controller
+
+		clusterMetadataManager *persistence.MockClusterMetadataManager
+		visibilityManager      *manager.MockVisibilityManager
+		namespaceRegistry      *namespace.MockRegistry
+		membershipMonitor      *membership.MockMonitor
+		resolver               *membership.MockServiceResolver
+		historyHealthChecker   *staticHealthChecker
+		timeSource             *clock.EventTimeSource
+
+		cacheTTL                time.Duration
+		ringStabilizationPeriod time.Duration
+		checker                 *DependencyHealthChecker
+	}
+
+	staticHealthChecker struct {
+		state enumsspb.HealthState
+		err   error
+	}
+)
+
+func (c *staticHealthChecker) Check(context.Context) (enumsspb.HealthState, error) {
+	return c.state, c.err
+}
+
+func TestDependencyHealthCheckerSuite(t *testing.T) {
+	s := new(dependencyHealthCheckerSuite)
+	suite.Run(t, s)
+}
+
+func (s *dependencyHealthCheckerSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.clusterMetadataManager = persistence.NewMockClusterMetadataManager(s.controller)
+	s.visibilityManager = manager.NewMockVisibilityManager(s.controller)
+	s.namespaceRegistry = namespace.NewMockRegistry(s.controller)
+	s.membershipMonitor = membership.NewMockMonitor(s.controller)
+	s.resolver = membership.NewMockServiceResolver(s.controller)
+	s.historyHealthChecker = &staticHealthChecker{state: enumsspb.HEALTH_STATE_SERVING}
+	s.timeSource = clock.NewEventTimeSource().Update(time.Now())
+	s.cacheTTL = 0
+	s.ringStabilizationPeriod = 0
+
+	systemNamespace := namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: "system-namespace-id", Name: primitives.SystemLocalNamespace},
+		nil,
+		"active",
+	)
+	s.namespaceRegistry.EXPECT().GetNamespace(namespace.Name(primitives.SystemLocalNamespace)).Return(systemNamespace, nil).AnyTimes()
+	s.membershipMonitor.EXPECT().GetResolver(gomock.Any()).Return(s.resolver, nil).AnyTimes()
+
+	s.checker = NewDependencyHealthChecker(
+		s.clusterMetadataManager,
+		s.visibilityManager,
+		s.namespaceRegistry,
+		s.membershipMonitor,
+		s.historyHealthChecker,
+		&staticHealthChecker{state: enumsspb.HEALTH_STATE_SERVING},
+		dynamicconfig.GetDurationPropertyFn(time.Second),
+		func() time.Duration { return s.cacheTTL },
+		func() time.Duration { return s.ringStabilizationPeriod },
+		s.timeSource,
+	)
+}
+
+func (s *dependencyHealthCheckerSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *dependencyHealthCheckerSuite) expectProbes(times int, saveErr error, members ...string) {
+	metadata := &persistence.GetClusterMetadataResponse{
+		ClusterMetadata: &persistencespb.ClusterMetadata{ClusterName: "active"},
+		Version:         3,
+	}
+	s.clusterMetadataManager.EXPECT().GetCurrentClusterMetadata(gomock.Any()).Return(metadata, nil).Times(2 * times)
+	s.clusterMetadataManager.EXPECT().SaveClusterMetadata(gomock.Any(), &persistence.SaveClusterMetadataRequest{
+		ClusterMetadata: metadata.ClusterMetadata,
+		Version:         3,
+	}).Return(true, saveErr).Times(times)
+	s.visibilityManager.EXPECT().ListWorkflowExecutions(gomock.Any(), &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID: "system-namespace-id",
+		Namespace:   primitives.SystemLocalNamespace,
+		PageSize:    1,
+	}).Return(&manager.ListWorkflowExecutionsResponse{}, nil).Times(times)
+	hosts := make([]membership.HostInfo, 0, len(members))
+	for _, member := range members {
+		hosts = append(hosts, membership.NewHostInfoFromAddress(member))
+	}
+	s.resolver.EXPECT().AvailableMembers().Return(hosts).Times(len(ringServices) * times)
+}
+
+func (s *dependencyHealthCheckerSuite) dependencyStates(resp *adminservice.DeepHealthCheckResponse) map[string]enumsspb.HealthState {
+	states := make(map[string]enumsspb.HealthState)
+	for _, dependency := range resp.GetDependencies() {
+		states[dependency.GetName()] = dependency.GetState()
+	}
+	return states
+}
+
+func (s *dependencyHealthCheckerSuite) Test_Check_Serving() {
+	s.expectProbes(1, nil, "1", "2")
+
+	resp := s.checker.Check(context.Background())
+	s.Equal(enumsspb.HEALTH_STATE_SERVING, resp.GetState())
+	s.Equal(map[string]enumsspb.HealthState{
+		"persistence-read":    enumsspb.HEALTH_STATE_SERVING,
+		"persistence-write":   enumsspb.HEALTH_STATE_SERVING,
+		"visibility":          enumsspb.HEALTH_STATE_SERVING,
+		"membership/frontend": enumsspb.HEALTH_STATE_SERVING,
+		"membership/history":  enumsspb.HEALTH_STATE_SERVING,
+		"membership/matching": enumsspb.HEALTH_STATE_SERVING,
+		"service/history":     enumsspb.HEALTH_STATE_SERVING,
+		"service/matching":    enumsspb.HEALTH_STATE_SERVING,
+	}, s.dependencyStates(resp))
+}
+
+func (s *dependencyHealthCheckerSuite) Test_Check_DependencyNotServing() {
+	s.historyHealthChecker.state = enumsspb.HEALTH_STATE_NOT_SERVING
+	s.expectProbes(1, errors.New("write timed out"))
+
+	resp := s.checker.Check(context.Background())
+	s.Equal(enumsspb.HEALTH_STATE_NOT_SERVING, resp.GetState())
+	states := s.dependencyStates(resp)
+	s.Equal(enumsspb.HEALTH_STATE_SERVING, states["persistence-read"])
+	s.Equal(enumsspb.HEALTH_STATE_NOT_SERVING, states["persistence-write"])
+	s.Equal(enumsspb.HEALTH_STATE_NOT_SERVING, states["membership/history"])
+	s.Equal(enumsspb.HEALTH_STATE_NOT_SERVING, states["service/history"])
+	s.Equal(enumsspb.HEALTH_STATE_SERVING, states["service/matching"])
+	for _, dependency := range resp.GetDependencies() {
+		switch dependency.GetName() {
+		case "persistence-write":
+			s.Equal("write timed out", dependency.GetMessage())
+		case "membership/history":
+			s.Equal(errNoRingMembers.Error(), dependency.GetMessage())
+		case "service/history":
+			s.Equal(errTooManyUnhealthyHost.Error(), dependency.GetMessage())
+		}
+	}
+}
+
+func (s *dependencyHealthCheckerSuite) Test_Check_Cached() {
+	s.cacheTTL = 10 * time.Second
+	s.expectProbes(2, nil, "1")
+
+	resp := s.checker.Check(context.Background())
+	s.timeSource.Advance(5 * time.Second)
+	s.Same(resp, s.checker.Check(context.Background()))
+	s.timeSource.Advance(5 * time.Second)
+	s.NotSame(resp, s.checker.Check(context.Background()))
+}
+
+func (s *dependencyHealthCheckerSuite) Test_Check_RingStabilization() {
+	s.ringStabilizationPeriod = time.Minute
+	s.expectProbes(2, nil, "1")
+	s.expectProbes(1, nil, "1", "2")
+
+	resp := s.checker.Check(context.Background())
+	s.Equal(enumsspb.HEALTH_STATE_NOT_SERVING, s.dependencyStates(resp)["membership/history"])
+
+	s.timeSource.Advance(time.Minute)
+	resp = s.checker.Check(context.Background())
+	s.Equal(enumsspb.HEALTH_STATE_SERVING, resp.GetState())
+
+	resp = s.checker.Check(context.Background())
+	s.Equal(enumsspb.HEALTH_STATE_NOT_SERVING, s.dependencyStates(resp)["membership/history"])
+}
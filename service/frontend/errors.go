@@ -36,6 +36,7 @@ var (
 	errWorkflowIDNotSet                                   = serviceerror.NewInvalidArgument("WorkflowId is not set on request.")
 	errActivityIDNotSet                                   = serviceerror.NewInvalidArgument("ActivityId is not set on request.")
 	errSignalNameNotSet                                   = serviceerror.NewInvalidArgument("SignalName is not set on request.")
+	errScheduleIDNotSet                                   = serviceerror.NewInvalidArgument("ScheduleId is not set on request.")
 	errInvalidRunID                                       = serviceerror.NewInvalidArgument("Invalid RunId.")
 	errInvalidNextPageToken                               = serviceerror.NewInvalidArgument("Invalid NextPageToken.")                                 // DEPRECATED
 	errNextPageTokenRunIDMismatch                         = serviceerror.NewInvalidArgument("RunId in the request does not match the NextPageToken.") // DEPRECATED
@@ -71,6 +72,7 @@ var (
 	errInvalidVersionHistories                            = serviceerror.NewInvalidArgument("Invalid version histories.")                        // DEPRECATED
 	errInvalidEventQueryRange                             = serviceerror.NewInvalidArgument("Invalid event query range.")                        // DEPRECATED
 	errDLQTypeIsNotSupported                              = serviceerror.NewInvalidArgument("The DLQ type is not supported.")
+	errDLQMergeFilterNotSupported                         = serviceerror.NewInvalidArgument("Dry run and filters are only supported when merging the replication DLQ.")
 	errFailureMustHaveApplicationFailureInfo              = serviceerror.NewInvalidArgument("Failure must have ApplicationFailureInfo.")
 	errStatusFilterMustBeNotRunning                       = serviceerror.NewInvalidArgument("StatusFilter must be specified and must be not Running.")
 	errCronNotAllowed                                     = serviceerror.NewInvalidArgument("Scheduled workflow must not contain CronSchedule")
@@ -81,6 +83,8 @@ var (
 	errBatchOperationNotSet                               = serviceerror.NewInvalidArgument("Batch operation is not set on request.")
 	errCronAndStartDelaySet                               = serviceerror.NewInvalidArgument("CronSchedule and WorkflowStartDelay may not be used together.")
 	errInvalidWorkflowStartDelaySeconds                   = serviceerror.NewInvalidArgument("An invalid WorkflowStartDelaySeconds is set on request.")
+	errInvalidPauseAfterConsecutiveFailures               = serviceerror.NewInvalidArgument("PauseAfterConsecutiveFailures must not be negative.")
+	errInvalidScheduleDaylightSavingPolicy                = serviceerror.NewInvalidArgument("DaylightSavingPolicy is not a valid daylight saving policy.")
 	errRaceConditionAddingSearchAttributes                = serviceerror.NewUnavailable("Generated search attributes mapping unavailable.")
 	errUseVersioningWithoutBuildId                        = serviceerror.NewInvalidArgument("WorkerVersionStamp must be present if UseVersioning is true.")
 	errUseVersioningWithoutNormalName                     = serviceerror.NewInvalidArgument("NormalName must be set on sticky queue if UseVersioning is true.")
@@ -107,9 +111,23 @@ var (
 	errSourceClusterNotSet    = serviceerror.NewInvalidArgument("SourceCluster is not set on request.")
 	errTargetClusterNotSet    = serviceerror.NewInvalidArgument("TargetCluster is not set on request.")
 	errInvalidDLQJobToken     = serviceerror.NewInvalidArgument("Invalid DLQ job token.")
+	errInvalidRPS             = serviceerror.NewInvalidArgument("RPS must not be negative.")
+	errReArchivalRPSTooLow    = serviceerror.NewInvalidArgument("RPS is too low to re-archive an execution within the activity timeout.")
+	errArchivalNotEnabled     = serviceerror.NewFailedPrecondition("Archival is not enabled for the namespace.")
+
+	errVisibilityQueryNotSet                = serviceerror.NewInvalidArgument("Query is not set on request.")
+	errSignalsNotSet                        = serviceerror.NewInvalidArgument("Signals are not set on request.")
+	errActivityTypeNotSet                   = serviceerror.NewInvalidArgument("ActivityType is not set on request.")
+	errInvalidActivityType                  = serviceerror.NewInvalidArgument("ActivityType must not contain commas.")
+	errActivityTypeTooLong                  = serviceerror.NewInvalidArgument("ActivityType length exceeds limit.")
+	errRoleOrHostAddressNotSet              = serviceerror.NewInvalidArgument("Role or HostAddress must be set on request.")
+	errProfileStoreNotConfigured            = serviceerror.NewFailedPrecondition("admin.captureProfileStoreURI is not configured.")
+	errNexusEndpointReplicationNotSupported = serviceerror.NewFailedPrecondition("Nexus endpoints can only be replicated when global namespaces are enabled.")
 
 	errPageSizeTooBigMessage = "PageSize is larger than allowed %d."
 
+	errInvalidQueryRejectConditionMessage = "Invalid query reject condition header: %v."
+
 	errSearchAttributeIsReservedMessage               = "Search attribute %s is reserved by system."
 	errSearchAttributeAlreadyExistsMessage            = "Search attribute %s already exists."
 	errSearchAttributeDoesntExistMessage              = "Search attribute %s doesn't exist."
@@ -127,6 +145,7 @@ var (
 	errUnableToCreateFrontendClientMessage            = "Unable to create frontend client with error: %v."
 	errTooManySearchAttributesMessage                 = "Unable to create search attributes: cannot have more than %d search attribute of type %s."
 	errUnsupportedIDConflictPolicy                    = "Invalid WorkflowIDConflictPolicy: %v is not supported for this operation."
+	errHandoverReplicationLagTooHighMessage           = "Unable to handover namespace: shard %d is behind on replication to cluster %s by %v, more than the allowed %v."
 
 	errListNotAllowed      = serviceerror.NewPermissionDenied("List is disabled on this namespace.", "")
 	errSchedulesNotAllowed = serviceerror.NewPermissionDenied("Schedules are disabled on this namespace.", "")
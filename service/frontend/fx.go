@@ -34,11 +34,14 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/audit"
 	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/debugtrace"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/dynamicconfig/runtimeoverride"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/membership"
@@ -46,6 +49,7 @@ import (
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/nexus"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/payloadencryption"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/persistence/visibility/manager"
@@ -116,7 +120,18 @@ var Module = fx.Options(
 	service.PersistenceLazyLoadedServiceResolverModule,
 	fx.Provide(FEReplicatorNamespaceReplicationQueueProvider),
 	fx.Provide(AuthorizationInterceptorProvider),
+	fx.Provide(AuditLoggerProvider),
+	fx.Provide(NamespaceClientCertInterceptorProvider),
+	fx.Provide(NamespaceIPAllowlistInterceptorProvider),
+	fx.Provide(EncryptedPayloadMetadataInterceptorProvider),
+	fx.Provide(interceptor.NewAuditInterceptor),
 	fx.Provide(NamespaceCheckerProvider),
+	fx.Provide(ApprovalGateProvider),
+	fx.Provide(ProfileCapturerProvider),
+	fx.Provide(DependencyHealthCheckerProvider),
+	fx.Provide(DebugTraceStoreProvider),
+	fx.Provide(runtimeoverride.NewStore),
+	fx.Provide(DebugTraceInterceptorProvider),
 	fx.Provide(func(so GrpcServerOptions) *grpc.Server { return grpc.NewServer(so.Options...) }),
 	fx.Provide(HandlerProvider),
 	fx.Provide(AdminHandlerProvider),
@@ -125,6 +140,7 @@ var Module = fx.Options(
 	fx.Provide(ServiceResolverProvider),
 	fx.Invoke(RegisterNexusHTTPHandler),
 	fx.Invoke(RegisterOpenAPIHTTPHandler),
+	fx.Invoke(RegisterHealthHTTPHandler),
 	fx.Provide(HTTPAPIServerProvider),
 	fx.Provide(NewServiceProvider),
 	fx.Provide(NexusEndpointClientProvider),
@@ -181,7 +197,20 @@ func AuthorizationInterceptorProvider(
 	authorizer authorization.Authorizer,
 	claimMapper authorization.ClaimMapper,
 	audienceGetter authorization.JWTAudienceMapper,
+	auditLogger audit.Logger,
+	namespaceRegistry namespace.Registry,
+	timeSource clock.TimeSource,
 ) *authorization.Interceptor {
+	authCfg := &cfg.Global.Authorization
+	if authorizer != nil && !authorization.IsNoopAuthorizer(authorizer) && authCfg.DecisionCacheTTL > 0 {
+		authorizer = authorization.NewCachingAuthorizer(
+			authorizer,
+			namespaceRegistry,
+			authCfg.DecisionCacheTTL,
+			authCfg.DecisionCacheSize,
+			timeSource,
+		)
+	}
 	return authorization.NewInterceptor(
 		claimMapper,
 		authorizer,
@@ -189,11 +218,63 @@ func AuthorizationInterceptorProvider(
 		logger,
 		namespaceChecker,
 		audienceGetter,
-		cfg.Global.Authorization.AuthHeaderName,
-		cfg.Global.Authorization.AuthExtraHeaderName,
+		authCfg.AuthHeaderName,
+		authCfg.AuthExtraHeaderName,
+		auditLogger,
+	)
+}
+
+func AuditLoggerProvider(
+	lc fx.Lifecycle,
+	cfg *config.Config,
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+) (audit.Logger, error) {
+	auditLogger, err := audit.NewExporterFromConfig(&cfg.Global.Audit, logger, metricsHandler)
+	if err != nil {
+		return nil, err
+	}
+	if exporter, ok := auditLogger.(*audit.Exporter); ok {
+		lc.Append(fx.StartStopHook(exporter.Start, exporter.Stop))
+	}
+	return auditLogger, nil
+}
+
+func NamespaceClientCertInterceptorProvider(
+	cfg *config.Config,
+	serviceName primitives.ServiceName,
+	tlsConfigProvider encryption.TLSConfigProvider,
+	logger log.Logger,
+) *interceptor.NamespaceClientCertInterceptor {
+	var verifier encryption.NamespaceClientCertVerifier
+	// internal-frontend is authenticated with internode TLS, which has no per-namespace CAs
+	if serviceName == primitives.FrontendService && len(cfg.Global.TLS.Frontend.NamespaceClientCAs) > 0 {
+		verifier, _ = tlsConfigProvider.(encryption.NamespaceClientCertVerifier)
+	}
+	return interceptor.NewNamespaceClientCertInterceptor(verifier, logger)
+}
+
+func NamespaceIPAllowlistInterceptorProvider(
+	serviceConfig *Config,
+	serviceName primitives.ServiceName,
+	namespaceRegistry namespace.Registry,
+	logger log.Logger,
+) *interceptor.NamespaceIPAllowlistInterceptor {
+	return interceptor.NewNamespaceIPAllowlistInterceptor(
+		// internal-frontend is only called by system workers from within the cluster
+		serviceName == primitives.FrontendService,
+		namespaceRegistry,
+		serviceConfig.NamespaceAllowedCallerCIDRs,
+		logger,
 	)
 }
 
+func EncryptedPayloadMetadataInterceptorProvider(
+	payloadKeyProvider payloadencryption.KeyProvider,
+) *interceptor.EncryptedPayloadMetadataInterceptor {
+	return interceptor.NewEncryptedPayloadMetadataInterceptor(payloadKeyProvider != nil)
+}
+
 func NamespaceCheckerProvider(registry namespace.Registry) authorization.NamespaceChecker {
 	return &namespaceChecker{r: registry}
 }
@@ -226,6 +307,11 @@ func GrpcServerOptionsProvider(
 	sdkVersionInterceptor *interceptor.SDKVersionInterceptor,
 	callerInfoInterceptor *interceptor.CallerInfoInterceptor,
 	authInterceptor *authorization.Interceptor,
+	auditInterceptor *interceptor.AuditInterceptor,
+	debugTraceInterceptor *interceptor.DebugTraceInterceptor,
+	namespaceClientCertInterceptor *interceptor.NamespaceClientCertInterceptor,
+	namespaceIPAllowlistInterceptor *interceptor.NamespaceIPAllowlistInterceptor,
+	encryptedPayloadMetadataInterceptor *interceptor.EncryptedPayloadMetadataInterceptor,
 	maskInternalErrorDetailsInterceptor *interceptor.MaskInternalErrorDetailsInterceptor,
 	utf8Validator *utf8validator.Validator,
 	customInterceptors []grpc.UnaryServerInterceptor,
@@ -263,10 +349,15 @@ func GrpcServerOptionsProvider(
 		rpc.ServiceErrorInterceptor,
 		rpc.NewFrontendServiceErrorInterceptor(logger),
 		utf8Validator.Intercept,
+		encryptedPayloadMetadataInterceptor.Intercept,
 		namespaceValidatorInterceptor.NamespaceValidateIntercept,
 		namespaceLogInterceptor.Intercept, // TODO: Deprecate this with a outer custom interceptor
 		metrics.NewServerMetricsContextInjectorInterceptor(),
+		namespaceClientCertInterceptor.Intercept,
+		namespaceIPAllowlistInterceptor.Intercept,
 		authInterceptor.Intercept,
+		auditInterceptor.Intercept,
+		debugTraceInterceptor.Intercept,
 		redirectionInterceptor.Intercept,
 		telemetryInterceptor.UnaryIntercept,
 		healthInterceptor.Intercept,
@@ -364,6 +455,29 @@ func TelemetryInterceptorProvider(
 		metricsHandler,
 		logger,
 		serviceConfig.LogAllReqErrors,
+		serviceConfig.SlowRequestLogThresholds,
+		serviceConfig.NumHistoryShards,
+	)
+}
+
+func DebugTraceStoreProvider(serviceConfig *Config) *debugtrace.Store {
+	return debugtrace.NewStore(serviceConfig.DebugTraceRetention(), serviceConfig.DebugTraceMaxTraces())
+}
+
+func DebugTraceInterceptorProvider(
+	store *debugtrace.Store,
+	serviceName primitives.ServiceName,
+	hostInfoProvider membership.HostInfoProvider,
+	serviceConfig *Config,
+	logger log.Logger,
+) *interceptor.DebugTraceInterceptor {
+	return interceptor.NewDebugTraceInterceptor(
+		store,
+		serviceName,
+		hostInfoProvider,
+		serviceConfig.EnableDebugTrace,
+		true,
+		logger,
 	)
 }
 
@@ -475,7 +589,19 @@ func NamespaceRateLimitInterceptorProvider(
 			)
 		},
 	)
-	return interceptor.NewNamespaceRateLimitInterceptor(namespaceRegistry, namespaceRateLimiter, map[string]int{})
+	// Per API class and per identity limits are enforced on top of the namespace limits. Each of them only
+	// throttles once configured for the namespace.
+	rateLimiter := quotas.NewMultiRequestRateLimiter(
+		namespaceRateLimiter,
+		configs.NewAPIClassRateLimiter(serviceConfig.NamespaceAPIClassRPSPerInstance, serviceConfig.MaxNamespaceBurstRatioPerInstance),
+		configs.NewIdentityRateLimiter(
+			serviceConfig.NamespaceIdentityRPSPerInstance,
+			serviceConfig.MaxNamespaceBurstRatioPerInstance,
+			serviceConfig.APIClassPriority,
+			serviceConfig.OperatorRPSRatio,
+		),
+	)
+	return interceptor.NewNamespaceRateLimitInterceptor(namespaceRegistry, rateLimiter, map[string]int{})
 }
 
 func NamespaceCountLimitInterceptorProvider(
@@ -502,6 +628,7 @@ func NamespaceValidatorInterceptorProvider(
 		namespaceRegistry,
 		serviceConfig.EnableTokenNamespaceEnforcement,
 		serviceConfig.MaxIDLengthLimit,
+		serviceConfig.EnableFineGrainedHandover,
 	)
 }
 
@@ -538,6 +665,7 @@ func VisibilityManagerProvider(
 	logger log.Logger,
 	persistenceConfig *config.Persistence,
 	customVisibilityStoreFactory visibility.VisibilityStoreFactory,
+	payloadKeyProvider payloadencryption.KeyProvider,
 	metricsHandler metrics.Handler,
 	serviceConfig *Config,
 	persistenceServiceResolver resolver.ServiceResolver,
@@ -549,6 +677,7 @@ func VisibilityManagerProvider(
 		*persistenceConfig,
 		persistenceServiceResolver,
 		customVisibilityStoreFactory,
+		payloadKeyProvider,
 		nil, // frontend visibility never write
 		saProvider,
 		searchAttributesMapperProvider,
@@ -612,6 +741,13 @@ func AdminHandlerProvider(
 	healthServer *health.Server,
 	eventSerializer serialization.Serializer,
 	timeSource clock.TimeSource,
+	archivalMetadata archiver.ArchivalMetadata,
+	nexusEndpointClient *NexusEndpointClient,
+	approvalGate *ApprovalGate,
+	profileCapturer *ProfileCapturer,
+	dependencyHealthChecker *DependencyHealthChecker,
+	debugTraceStore *debugtrace.Store,
+	dynamicConfigOverrides *runtimeoverride.Store,
 	taskCategoryRegistry tasks.TaskCategoryRegistry,
 	matchingClient resource.MatchingClient,
 ) *AdminHandler {
@@ -642,12 +778,34 @@ func AdminHandlerProvider(
 		healthServer,
 		eventSerializer,
 		timeSource,
+		archivalMetadata,
+		nexusEndpointClient,
+		approvalGate,
+		profileCapturer,
+		dependencyHealthChecker,
+		debugTraceStore,
+		dynamicConfigOverrides,
 		taskCategoryRegistry,
 		matchingClient,
 	}
 	return NewAdminHandler(args)
 }
 
+func ApprovalGateProvider(
+	serviceConfig *Config,
+	persistenceMetadataManager persistence.MetadataManager,
+	timeSource clock.TimeSource,
+	logger log.SnTaggedLogger,
+) *ApprovalGate {
+	return NewApprovalGate(
+		persistenceMetadataManager,
+		serviceConfig.DestructiveOperationApprovalEnabled,
+		serviceConfig.DestructiveOperationApprovalTTL,
+		timeSource,
+		logger,
+	)
+}
+
 func OperatorHandlerProvider(
 	configuration *Config,
 	esClient esclient.Client,
@@ -663,6 +821,7 @@ func OperatorHandlerProvider(
 	clientFactory client.Factory,
 	namespaceRegistry namespace.Registry,
 	nexusEndpointClient *NexusEndpointClient,
+	approvalGate *ApprovalGate,
 ) *OperatorHandlerImpl {
 	args := NewOperatorHandlerImplArgs{
 		configuration,
@@ -679,6 +838,7 @@ func OperatorHandlerProvider(
 		clientFactory,
 		namespaceRegistry,
 		nexusEndpointClient,
+		approvalGate,
 	}
 	return NewOperatorHandlerImpl(args)
 }
@@ -713,6 +873,7 @@ func HandlerProvider(
 	membershipMonitor membership.Monitor,
 	healthInterceptor *interceptor.HealthInterceptor,
 	scheduleSpecBuilder *scheduler.SpecBuilder,
+	approvalGate *ApprovalGate,
 ) Handler {
 	wfHandler := NewWorkflowHandler(
 		serviceConfig,
@@ -738,6 +899,7 @@ func HandlerProvider(
 		membershipMonitor,
 		healthInterceptor,
 		scheduleSpecBuilder,
+		approvalGate,
 		httpEnabled(cfg, serviceName),
 	)
 	return wfHandler
@@ -795,6 +957,18 @@ func RegisterOpenAPIHTTPHandler(
 	return h
 }
 
+func RegisterHealthHTTPHandler(
+	dependencyHealthChecker *DependencyHealthChecker,
+	logger log.Logger,
+	router *mux.Router,
+) {
+	h := NewHealthHTTPHandler(
+		dependencyHealthChecker,
+		logger,
+	)
+	h.RegisterRoutes(router)
+}
+
 func MuxRouterProvider() *mux.Router {
 	// Instantiate a router to support additional route prefixes.
 	return mux.NewRouter().UseEncodedPath()
@@ -885,3 +1059,42 @@ func EndpointRegistryLifetimeHooks(lc fx.Lifecycle, registry nexus.EndpointRegis
 func ServiceLifetimeHooks(lc fx.Lifecycle, svc *Service) {
 	lc.Append(fx.StartStopHook(svc.Start, svc.Stop))
 }
+
+func ProfileCapturerProvider(
+	cfg *config.Config,
+	serviceConfig *Config,
+	hostInfoProvider membership.HostInfoProvider,
+	timeSource clock.TimeSource,
+) *ProfileCapturer {
+	return NewProfileCapturer(
+		hostInfoProvider,
+		cfg.Global.PProf.Port,
+		serviceConfig.AdminCaptureProfileStoreURI,
+		timeSource,
+	)
+}
+
+func DependencyHealthCheckerProvider(
+	serviceConfig *Config,
+	clusterMetadataManager persistence.ClusterMetadataManager,
+	visibilityMgr manager.VisibilityManager,
+	namespaceRegistry namespace.Registry,
+	membershipMonitor membership.Monitor,
+	historyClient resource.HistoryClient,
+	rpcFactory common.RPCFactory,
+	timeSource clock.TimeSource,
+	logger log.SnTaggedLogger,
+) *DependencyHealthChecker {
+	return NewDependencyHealthChecker(
+		clusterMetadataManager,
+		visibilityMgr,
+		namespaceRegistry,
+		membershipMonitor,
+		newHistoryHealthChecker(historyClient, membershipMonitor, serviceConfig.HistoryHostErrorPercentage, logger),
+		newMatchingHealthChecker(rpcFactory, membershipMonitor, serviceConfig.MatchingHostErrorPercentage, logger),
+		serviceConfig.DependencyHealthCheckTimeout,
+		serviceConfig.DependencyHealthCheckCacheTTL,
+		serviceConfig.DependencyHealthRingStabilizationPeriod,
+		timeSource,
+	)
+}
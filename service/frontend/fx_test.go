@@ -731,6 +731,15 @@ func getTestConfig(tc namespaceRateLimitInterceptorTestCase) Config {
 		MaxNamespaceNamespaceReplicationInducingAPIsBurstRatioPerInstance: func(namespace string) float64 {
 			return getOrDefaultLimit(tc.maxNamespaceNamespaceReplicationInducingAPIsBurstRatioPerInstance)
 		},
+		NamespaceAPIClassRPSPerInstance: func(namespace string) map[string]int {
+			return nil
+		},
+		NamespaceIdentityRPSPerInstance: func(namespace string) int {
+			return 0
+		},
+		APIClassPriority: func() []string {
+			return nil
+		},
 	}
 }
 
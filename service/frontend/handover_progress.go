@@ -0,0 +1,120 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"maps"
+	"strconv"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+)
+
+const (
+	// HandoverTotalShardsDataKey and HandoverDrainedShardsDataKey are added to the namespace data returned by
+	// DescribeNamespace while the namespace is in handover state. They are not persisted.
+	HandoverTotalShardsDataKey   = "temporal.handover.totalShards"
+	HandoverDrainedShardsDataKey = "temporal.handover.drainedShards"
+)
+
+// getNamespaceHandoverProgress returns how many history shards have drained their replication tasks of a namespace
+// in handover state to all of its remote clusters.
+func getNamespaceHandoverProgress(
+	ctx context.Context,
+	historyClient historyservice.HistoryServiceClient,
+	namespaceName string,
+	clusterNames []string,
+	activeClusterName string,
+) (*adminservice.NamespaceHandoverProgress, error) {
+	remoteClusters := remoteClusterNames(clusterNames, activeClusterName)
+	resp, err := historyClient.GetReplicationStatus(ctx, &historyservice.GetReplicationStatusRequest{
+		RemoteClusters: remoteClusters,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	progress := &adminservice.NamespaceHandoverProgress{
+		TotalShards: int32(len(resp.GetShards())),
+	}
+	for _, shard := range resp.GetShards() {
+		handoverInfo, ok := shard.GetHandoverNamespaces()[namespaceName]
+		if !ok {
+			// shard hasn't seen the namespace state change yet
+			continue
+		}
+		drained := true
+		for _, clusterName := range remoteClusters {
+			clusterInfo, ok := shard.GetRemoteClusters()[clusterName]
+			if !ok || clusterInfo.GetAckedTaskId() < handoverInfo.GetHandoverReplicationTaskId() {
+				drained = false
+				break
+			}
+		}
+		if drained {
+			progress.DrainedShards++
+		}
+	}
+	return progress, nil
+}
+
+// addNamespaceHandoverProgress adds the handover progress of a namespace in handover state to the namespace data
+// of a DescribeNamespace response.
+func addNamespaceHandoverProgress(
+	ctx context.Context,
+	historyClient historyservice.HistoryServiceClient,
+	response *workflowservice.DescribeNamespaceResponse,
+) error {
+	replicationConfig := response.GetReplicationConfig()
+	if replicationConfig.GetState() != enumspb.REPLICATION_STATE_HANDOVER {
+		return nil
+	}
+	clusterNames := make([]string, 0, len(replicationConfig.GetClusters()))
+	for _, clusterConfig := range replicationConfig.GetClusters() {
+		clusterNames = append(clusterNames, clusterConfig.GetClusterName())
+	}
+	progress, err := getNamespaceHandoverProgress(
+		ctx,
+		historyClient,
+		response.GetNamespaceInfo().GetName(),
+		clusterNames,
+		replicationConfig.GetActiveClusterName(),
+	)
+	if err != nil {
+		return err
+	}
+
+	data := maps.Clone(response.GetNamespaceInfo().GetData())
+	if data == nil {
+		data = make(map[string]string, 2)
+	}
+	data[HandoverTotalShardsDataKey] = strconv.Itoa(int(progress.GetTotalShards()))
+	data[HandoverDrainedShardsDataKey] = strconv.Itoa(int(progress.GetDrainedShards()))
+	response.NamespaceInfo.Data = data
+	return nil
+}
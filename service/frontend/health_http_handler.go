@@ -0,0 +1,75 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// HealthHTTPHandler serves the dependency health check on the HTTP API listener for load balancer and Kubernetes
+// readiness probes. It responds with 200 when every dependency is serving and 503 otherwise, and the health of each
+// dependency as JSON.
+type HealthHTTPHandler struct {
+	logger                  log.Logger
+	dependencyHealthChecker *DependencyHealthChecker
+}
+
+func NewHealthHTTPHandler(
+	dependencyHealthChecker *DependencyHealthChecker,
+	logger log.Logger,
+) *HealthHTTPHandler {
+	return &HealthHTTPHandler{
+		logger:                  logger,
+		dependencyHealthChecker: dependencyHealthChecker,
+	}
+}
+
+func (h *HealthHTTPHandler) RegisterRoutes(r *mux.Router) {
+	r.Path("/health").Methods("GET").HandlerFunc(h.serveHealth)
+}
+
+func (h *HealthHTTPHandler) serveHealth(w http.ResponseWriter, r *http.Request) {
+	resp := h.dependencyHealthChecker.Check(r.Context())
+	body, err := protojson.Marshal(resp)
+	if err != nil {
+		h.logger.Error("failed to marshal dependency health", tag.Error(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.GetState() == enumsspb.HEALTH_STATE_SERVING {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	if _, err := w.Write(body); err != nil {
+		h.logger.Warn("failed to send dependency health", tag.Error(err))
+	}
+}
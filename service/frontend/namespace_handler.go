@@ -723,6 +723,7 @@ func (d *namespaceHandler) createResponse(
 	replicationConfigResult := &replicationpb.NamespaceReplicationConfig{
 		ActiveClusterName: replicationConfig.ActiveClusterName,
 		Clusters:          clusters,
+		State:             replicationConfig.State,
 	}
 
 	var failoverHistory []*replicationpb.FailoverStatus
@@ -33,8 +33,12 @@ import (
 	nexuspb "go.temporal.io/api/nexus/v1"
 	"go.temporal.io/api/operatorservice/v1"
 	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
+	"go.temporal.io/server/common"
 	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
@@ -42,6 +46,8 @@ import (
 	"go.temporal.io/server/common/namespace"
 	cnexus "go.temporal.io/server/common/nexus"
 	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/retrypolicy"
 	"go.temporal.io/server/common/rpc"
 	"go.temporal.io/server/common/tqid"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -249,6 +255,157 @@ func (c *NexusEndpointClient) List(
 	}, nil
 }
 
+// UpdateOperationDefaults sets the defaults of Nexus operations targeting an endpoint. The defaults are not part of
+// the public endpoint spec, so the stored spec is read from persistence and written back through matching.
+func (c *NexusEndpointClient) UpdateOperationDefaults(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointOperationDefaultsRequest,
+) (*adminservice.UpdateNexusEndpointOperationDefaultsResponse, error) {
+	defaults, err := validateOperationDefaults(request)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := c.persistence.GetNexusEndpoint(ctx, &p.GetNexusEndpointRequest{
+		ID: request.Id,
+	})
+	if err != nil {
+		return nil, c.transformServiceError(err, fmt.Sprintf("error looking up Nexus endpoint with ID `%v`", request.Id))
+	}
+
+	spec := common.CloneProto(entry.GetEndpoint().GetSpec())
+	if spec == nil {
+		return nil, serviceerror.NewInternal("empty endpoint spec")
+	}
+	spec.OperationDefaults = defaults
+
+	resp, err := c.matchingClient.UpdateNexusEndpoint(ctx, &matchingservice.UpdateNexusEndpointRequest{
+		Id:      request.Id,
+		Version: request.Version,
+		Spec:    spec,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminservice.UpdateNexusEndpointOperationDefaultsResponse{
+		Entry: resp.Entry,
+	}, nil
+}
+
+// UpdateOutboundAuth sets the credentials attached to requests sent to an endpoint's external target. Like the
+// operation defaults, the credentials are not part of the public endpoint spec.
+func (c *NexusEndpointClient) UpdateOutboundAuth(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointOutboundAuthRequest,
+) (*adminservice.UpdateNexusEndpointOutboundAuthResponse, error) {
+	auth, err := validateOutboundAuth(request)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := c.persistence.GetNexusEndpoint(ctx, &p.GetNexusEndpointRequest{
+		ID: request.Id,
+	})
+	if err != nil {
+		return nil, c.transformServiceError(err, fmt.Sprintf("error looking up Nexus endpoint with ID `%v`", request.Id))
+	}
+
+	spec := common.CloneProto(entry.GetEndpoint().GetSpec())
+	if spec == nil {
+		return nil, serviceerror.NewInternal("empty endpoint spec")
+	}
+	if spec.GetTarget().GetExternal() == nil && (auth.GetToken() != nil || auth.GetMutualTls() != nil) {
+		return nil, serviceerror.NewInvalidArgument("outbound auth is only supported for endpoints with an external target")
+	}
+	spec.OutboundAuth = auth
+
+	resp, err := c.matchingClient.UpdateNexusEndpoint(ctx, &matchingservice.UpdateNexusEndpointRequest{
+		Id:      request.Id,
+		Version: request.Version,
+		Spec:    spec,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminservice.UpdateNexusEndpointOutboundAuthResponse{
+		Entry: resp.Entry,
+	}, nil
+}
+
+// Replicate publishes the persisted state of the requested endpoints, or all endpoints if none are requested, to the
+// given namespace replication queue. Remote clusters apply the tasks with the usual last-writer-wins conflict
+// resolution, so replicating an endpoint that is already up to date in a remote cluster is a no-op.
+func (c *NexusEndpointClient) Replicate(
+	ctx context.Context,
+	request *adminservice.ReplicateNexusEndpointsRequest,
+	queue p.NamespaceReplicationQueue,
+) (*adminservice.ReplicateNexusEndpointsResponse, error) {
+	var entries []*persistencespb.NexusEndpointEntry
+	if len(request.Ids) > 0 {
+		for _, id := range request.Ids {
+			// tombstones are replicated too, so that remote clusters delete the endpoint
+			entry, err := c.persistence.GetNexusEndpoint(ctx, &p.GetNexusEndpointRequest{ID: id, IncludeTombstones: true})
+			if err != nil {
+				return nil, c.transformServiceError(err, fmt.Sprintf("error looking up Nexus endpoint with ID `%v`", id))
+			}
+			entries = append(entries, entry)
+		}
+	} else {
+		var err error
+		if entries, err = c.listAllEntries(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	resp := &adminservice.ReplicateNexusEndpointsResponse{}
+	for _, entry := range entries {
+		if err := queue.Publish(ctx, &replicationspb.ReplicationTask{
+			TaskType: enumsspb.REPLICATION_TASK_TYPE_NEXUS_ENDPOINT,
+			Attributes: &replicationspb.ReplicationTask_NexusEndpointAttributes{
+				NexusEndpointAttributes: &replicationspb.NexusEndpointAttributes{
+					Id:       entry.Id,
+					Endpoint: entry.Endpoint,
+				},
+			},
+		}); err != nil {
+			c.logger.Error("error publishing Nexus endpoint replication task", tag.Endpoint(entry.Id), tag.Error(err))
+			return nil, serviceerror.NewUnavailable(fmt.Sprintf("error replicating Nexus endpoint `%v`, %d endpoints were replicated before the failure", entry.Id, len(resp.ReplicatedIds)))
+		}
+		resp.ReplicatedIds = append(resp.ReplicatedIds, entry.Id)
+	}
+	return resp, nil
+}
+
+// listAllEntries paginates over all endpoints returned by persistence layer, including tombstones.
+func (c *NexusEndpointClient) listAllEntries(ctx context.Context) ([]*persistencespb.NexusEndpointEntry, error) {
+	var entries []*persistencespb.NexusEndpointEntry
+	pageSize := c.config.listDefaultPageSize()
+	var currentPageToken []byte
+
+	for ctx.Err() == nil {
+		resp, err := c.persistence.ListNexusEndpoints(ctx, &p.ListNexusEndpointsRequest{
+			LastKnownTableVersion: 0,
+			NextPageToken:         currentPageToken,
+			PageSize:              pageSize,
+			IncludeTombstones:     true,
+		})
+		if err != nil {
+			c.logger.Error(fmt.Sprintf("error listing Nexus endpoints from persistence. CurrentPageToken: %v PageSize: %d", currentPageToken, pageSize), tag.Error(err))
+			return nil, serviceerror.NewInternal("error listing Nexus endpoints")
+		}
+		entries = append(entries, resp.Entries...)
+
+		if resp.NextPageToken == nil {
+			return entries, nil
+		}
+		currentPageToken = resp.NextPageToken
+	}
+
+	return nil, ctx.Err()
+}
+
 func (c *NexusEndpointClient) apiSpecToPersistenceSpec(source *nexuspb.EndpointSpec) (*persistencespb.NexusEndpointSpec, error) {
 	target, err := c.apiTargetToPersistenceTarget(source.GetTarget())
 	if err != nil {
@@ -415,6 +572,79 @@ func validateDeleteRequest(request *operatorservice.DeleteNexusEndpointRequest)
 	return issues.GetError()
 }
 
+// validateOperationDefaults validates the requested defaults and returns them with the unset retry policy fields
+// filled in. The returned defaults are never nil so matching doesn't carry over the previous defaults.
+func validateOperationDefaults(request *adminservice.UpdateNexusEndpointOperationDefaultsRequest) (*persistencespb.NexusOperationDefaults, error) {
+	issues := getEndpointIDIssues(request.GetId())
+	if request.GetVersion() <= 0 {
+		issues.Append("endpoint version is non-positive")
+	}
+	if err := issues.GetError(); err != nil {
+		return nil, err
+	}
+
+	defaults := common.CloneProto(request.GetOperationDefaults())
+	if defaults == nil {
+		return &persistencespb.NexusOperationDefaults{}, nil
+	}
+	if err := timestamp.ValidateProtoDuration(defaults.GetScheduleToCloseTimeout()); err != nil {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("invalid schedule-to-close timeout: %v", err))
+	}
+	if defaults.RetryPolicy != nil {
+		retrypolicy.EnsureDefaults(defaults.RetryPolicy, retrypolicy.DefaultDefaultRetrySettings)
+		if err := retrypolicy.Validate(defaults.RetryPolicy); err != nil {
+			return nil, err
+		}
+	}
+	return defaults, nil
+}
+
+// validateOutboundAuth validates the requested credentials. The returned config is never nil so matching doesn't carry
+// over the previous credentials.
+func validateOutboundAuth(request *adminservice.UpdateNexusEndpointOutboundAuthRequest) (*persistencespb.NexusOutboundAuth, error) {
+	issues := getEndpointIDIssues(request.GetId())
+	if request.GetVersion() <= 0 {
+		issues.Append("endpoint version is non-positive")
+	}
+
+	auth := request.GetOutboundAuth()
+	if auth == nil {
+		if err := issues.GetError(); err != nil {
+			return nil, err
+		}
+		return &persistencespb.NexusOutboundAuth{}, nil
+	}
+
+	if bearer := auth.GetBearerToken(); bearer != nil && bearer.GetTokenSecretName() == "" {
+		issues.Append("bearer token secret name not set")
+	}
+	if oauth2 := auth.GetOauth2ClientCredentials(); oauth2 != nil {
+		if u, err := url.Parse(oauth2.GetTokenUrl()); err != nil {
+			issues.Appendf("malformed OAuth2 token URL: %v", err)
+		} else if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			issues.Append("OAuth2 token URL must be an absolute http or https URL")
+		}
+		if oauth2.GetClientId() == "" {
+			issues.Append("OAuth2 client ID not set")
+		}
+		if oauth2.GetClientSecretName() == "" {
+			issues.Append("OAuth2 client secret name not set")
+		}
+	}
+	if mtls := auth.GetMutualTls(); mtls != nil {
+		if mtls.GetCertSecretName() == "" {
+			issues.Append("client certificate secret name not set")
+		}
+		if mtls.GetKeySecretName() == "" {
+			issues.Append("client key secret name not set")
+		}
+	}
+	if err := issues.GetError(); err != nil {
+		return nil, err
+	}
+	return auth, nil
+}
+
 func validateGetRequest(request *operatorservice.GetNexusEndpointRequest) error {
 	issues := getEndpointIDIssues(request.GetId())
 	return issues.GetError()
@@ -291,6 +291,7 @@ type nexusHandler struct {
 	forwardingEnabledForNamespace dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	forwardingClients             *cluster.FrontendHTTPClientCache
 	payloadSizeLimit              dynamicconfig.IntPropertyFnWithNamespaceFilter
+	payloadSizeWarnLimit          dynamicconfig.IntPropertyFnWithNamespaceFilter
 	headersBlacklist              *dynamicconfig.GlobalCachedTypedValue[*regexp.Regexp]
 }
 
@@ -394,8 +395,14 @@ func (h *nexusHandler) StartOperation(
 		oc.logger.Warn("invalid input", tag.Error(err))
 		return nil, nexus.HandlerErrorf(nexus.HandlerErrorTypeBadRequest, "invalid input")
 	}
-	if startOperationRequest.Payload.Size() > h.payloadSizeLimit(oc.namespaceName) {
-		oc.logger.Error("payload size exceeds error limit for Nexus StartOperation request", tag.Operation(operation), tag.WorkflowNamespace(oc.namespaceName))
+	if err := commonnexus.CheckPayloadSizeLimit(
+		startOperationRequest.Payload.Size(),
+		h.payloadSizeWarnLimit(oc.namespaceName),
+		h.payloadSizeLimit(oc.namespaceName),
+		commonnexus.PayloadTypeInput,
+		h.metricsHandler.WithTags(metrics.NamespaceTag(oc.namespaceName), metrics.NexusEndpointTag(oc.endpointName)),
+		log.With(oc.logger, tag.Operation(operation)),
+	); err != nil {
 		return nil, nexus.HandlerErrorf(nexus.HandlerErrorTypeBadRequest, "input exceeds size limit")
 	}
 
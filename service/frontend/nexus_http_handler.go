@@ -108,7 +108,8 @@ func NewNexusHTTPHandler(
 				redirectionInterceptor:        redirectionInterceptor,
 				forwardingEnabledForNamespace: serviceConfig.EnableNamespaceNotActiveAutoForwarding,
 				forwardingClients:             clientCache,
-				payloadSizeLimit:              serviceConfig.BlobSizeLimitError,
+				payloadSizeLimit:              serviceConfig.NexusPayloadSizeLimitError,
+				payloadSizeWarnLimit:          serviceConfig.NexusPayloadSizeLimitWarn,
 				headersBlacklist:              serviceConfig.NexusRequestHeadersBlacklist,
 			},
 			GetResultTimeout: serviceConfig.KeepAliveMaxConnectionIdle(),
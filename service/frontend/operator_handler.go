@@ -94,6 +94,7 @@ type (
 		clientFactory          svc.Factory
 		namespaceRegistry      namespace.Registry
 		nexusEndpointClient    *NexusEndpointClient
+		approvalGate           *ApprovalGate
 	}
 
 	NewOperatorHandlerImplArgs struct {
@@ -111,6 +112,7 @@ type (
 		clientFactory          svc.Factory
 		namespaceRegistry      namespace.Registry
 		nexusEndpointClient    *NexusEndpointClient
+		approvalGate           *ApprovalGate
 	}
 )
 
@@ -141,6 +143,7 @@ func NewOperatorHandlerImpl(
 		clientFactory:          args.clientFactory,
 		namespaceRegistry:      args.namespaceRegistry,
 		nexusEndpointClient:    args.nexusEndpointClient,
+		approvalGate:           args.approvalGate,
 	}
 
 	return handler
@@ -614,6 +617,10 @@ func (h *OperatorHandlerImpl) DeleteNamespace(
 		return nil, errRequestNotSet
 	}
 
+	if err := h.approvalGate.Check(ctx, "DeleteNamespace", deleteNamespaceApprovalTarget(request)); err != nil {
+		return nil, err
+	}
+
 	// If NamespaceDeleteDelay is not provided, the default delay configured in the cluster should be used.
 	if request.NamespaceDeleteDelay == nil {
 		request.NamespaceDeleteDelay = durationpb.New(h.config.DeleteNamespaceNamespaceDeleteDelay())
@@ -663,6 +670,14 @@ func (h *OperatorHandlerImpl) DeleteNamespace(
 	}, nil
 }
 
+// deleteNamespaceApprovalTarget identifies the namespace of a DeleteNamespace request for approval purposes.
+func deleteNamespaceApprovalTarget(request *operatorservice.DeleteNamespaceRequest) string {
+	if request.GetNamespace() != "" {
+		return request.GetNamespace()
+	}
+	return "id:" + request.GetNamespaceId()
+}
+
 // AddOrUpdateRemoteCluster adds or updates the connection config to a remote cluster.
 func (h *OperatorHandlerImpl) AddOrUpdateRemoteCluster(
 	ctx context.Context,
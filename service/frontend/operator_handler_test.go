@@ -116,6 +116,7 @@ func (s *operatorHandlerSuite) SetupTest() {
 		s.mockResource.GetClientFactory(),
 		s.mockResource.NamespaceCache,
 		endpointClient,
+		nil,
 	}
 	s.handler = NewOperatorHandlerImpl(args)
 	s.handler.Start()
@@ -0,0 +1,220 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/membership"
+)
+
+const (
+	cpuProfileType = "cpu"
+	// defaultCPUProfileDuration is used when a cpu profile is requested without a duration.
+	defaultCPUProfileDuration = 10 * time.Second
+	// maxProfileSize caps the size of a profile read from the pprof endpoint of a host.
+	maxProfileSize = 64 * 1024 * 1024
+)
+
+var (
+	defaultProfileTypes = []string{cpuProfileType, "heap", "goroutine"}
+
+	errPProfNotEnabled = errors.New("pprof is not enabled, profiles of other hosts are read from their pprof endpoint")
+)
+
+type (
+	// ProfileCapturer captures pprof profiles of the host it runs on and, through their pprof endpoints, of other
+	// hosts of the cluster.
+	ProfileCapturer struct {
+		hostInfoProvider membership.HostInfoProvider
+		pprofPort        int
+		storeURI         dynamicconfig.StringPropertyFn
+		timeSource       clock.TimeSource
+		httpClient       *http.Client
+
+		// The runtime samples one cpu profile at a time.
+		cpuProfileLock sync.Mutex
+	}
+)
+
+func NewProfileCapturer(
+	hostInfoProvider membership.HostInfoProvider,
+	pprofPort int,
+	storeURI dynamicconfig.StringPropertyFn,
+	timeSource clock.TimeSource,
+) *ProfileCapturer {
+	return &ProfileCapturer{
+		hostInfoProvider: hostInfoProvider,
+		pprofPort:        pprofPort,
+		storeURI:         storeURI,
+		timeSource:       timeSource,
+		httpClient:       &http.Client{},
+	}
+}
+
+func isValidProfileType(profileType string) bool {
+	return profileType == cpuProfileType || pprof.Lookup(profileType) != nil
+}
+
+// Capture captures a profile of the host with the given RPC address. The cpu profile is sampled for the given
+// duration, other profiles are snapshots.
+func (c *ProfileCapturer) Capture(
+	ctx context.Context,
+	hostAddress string,
+	profileType string,
+	duration time.Duration,
+) ([]byte, error) {
+	if hostAddress == c.hostInfoProvider.HostInfo().GetAddress() {
+		return c.captureLocal(ctx, profileType, duration)
+	}
+	return c.captureRemote(ctx, hostAddress, profileType, duration)
+}
+
+func (c *ProfileCapturer) captureLocal(
+	ctx context.Context,
+	profileType string,
+	duration time.Duration,
+) ([]byte, error) {
+	var buf bytes.Buffer
+	if profileType != cpuProfileType {
+		profile := pprof.Lookup(profileType)
+		if profile == nil {
+			return nil, fmt.Errorf("unknown profile type %q", profileType)
+		}
+		if err := profile.WriteTo(&buf, 0); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+
+	c.cpuProfileLock.Lock()
+	defer c.cpuProfileLock.Unlock()
+	if err := pprof.StartCPUProfile(&buf); err != nil {
+		return nil, err
+	}
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+	pprof.StopCPUProfile()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (c *ProfileCapturer) captureRemote(
+	ctx context.Context,
+	hostAddress string,
+	profileType string,
+	duration time.Duration,
+) ([]byte, error) {
+	if c.pprofPort == 0 {
+		return nil, errPProfNotEnabled
+	}
+	host, _, err := net.SplitHostPort(hostAddress)
+	if err != nil {
+		return nil, err
+	}
+	profileURL := &url.URL{
+		Scheme: "http",
+		Host:   net.JoinHostPort(host, strconv.Itoa(c.pprofPort)),
+		Path:   "/debug/pprof/" + profileType,
+	}
+	if profileType == cpuProfileType {
+		profileURL.Path = "/debug/pprof/profile"
+		profileURL.RawQuery = url.Values{"seconds": {strconv.Itoa(max(1, int(duration.Round(time.Second).Seconds())))}}.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, profileURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxProfileSize))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("pprof endpoint of %v returned %v: %s", host, resp.Status, strings.TrimSpace(string(body)))
+	}
+	return body, nil
+}
+
+// Store writes a profile to the profile store configured by admin.captureProfileStoreURI and returns its location.
+func (c *ProfileCapturer) Store(
+	role string,
+	hostAddress string,
+	profileType string,
+	data []byte,
+) (string, error) {
+	storeURI := c.storeURI()
+	if storeURI == "" {
+		return "", errProfileStoreNotConfigured
+	}
+	store, err := url.Parse(storeURI)
+	if err != nil {
+		return "", err
+	}
+	if store.Scheme != "file" {
+		return "", fmt.Errorf("unsupported profile store scheme %q", store.Scheme)
+	}
+
+	if err := os.MkdirAll(store.Path, 0o755); err != nil {
+		return "", err
+	}
+	name := fmt.Sprintf(
+		"%s-%s-%s-%s.pb.gz",
+		c.timeSource.Now().UTC().Format("20060102T150405.000"),
+		role,
+		strings.NewReplacer(":", "_", "[", "", "]", "").Replace(hostAddress),
+		profileType,
+	)
+	path := filepath.Join(store.Path, name)
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return "", err
+	}
+	return (&url.URL{Scheme: "file", Path: path}).String(), nil
+}
@@ -0,0 +1,123 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/membership"
+	"go.uber.org/mock/gomock"
+)
+
+const selfAddress = "127.0.0.1:7233"
+
+func newProfileCapturerForTest(t *testing.T, pprofPort int, storeURI string) *ProfileCapturer {
+	hostInfoProvider := membership.NewMockHostInfoProvider(gomock.NewController(t))
+	hostInfoProvider.EXPECT().HostInfo().Return(membership.NewHostInfoFromAddress(selfAddress)).AnyTimes()
+	return NewProfileCapturer(
+		hostInfoProvider,
+		pprofPort,
+		dynamicconfig.GetStringPropertyFn(storeURI),
+		clock.NewEventTimeSource().Update(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)),
+	)
+}
+
+func TestProfileCapturer_CaptureLocal(t *testing.T) {
+	capturer := newProfileCapturerForTest(t, 0, "")
+
+	for _, profileType := range []string{"heap", "goroutine", cpuProfileType} {
+		data, err := capturer.Capture(context.Background(), selfAddress, profileType, 50*time.Millisecond)
+		require.NoError(t, err, profileType)
+		require.NotEmpty(t, data, profileType)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := capturer.Capture(ctx, selfAddress, cpuProfileType, time.Minute)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestProfileCapturer_CaptureRemote(t *testing.T) {
+	var requested []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.URL.RequestURI())
+		if r.URL.Path == "/debug/pprof/mutex" {
+			http.Error(w, "mutex profiling is disabled", http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write([]byte("profile"))
+	}))
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	host, port, err := net.SplitHostPort(serverURL.Host)
+	require.NoError(t, err)
+	pprofPort, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	capturer := newProfileCapturerForTest(t, pprofPort, "")
+	remoteAddress := net.JoinHostPort(host, "7234")
+
+	data, err := capturer.Capture(context.Background(), remoteAddress, cpuProfileType, 3*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, "profile", string(data))
+	data, err = capturer.Capture(context.Background(), remoteAddress, "heap", 3*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, "profile", string(data))
+	_, err = capturer.Capture(context.Background(), remoteAddress, "mutex", 3*time.Second)
+	require.ErrorContains(t, err, "mutex profiling is disabled")
+	require.Equal(t, []string{"/debug/pprof/profile?seconds=3", "/debug/pprof/heap", "/debug/pprof/mutex"}, requested)
+
+	_, err = newProfileCapturerForTest(t, 0, "").Capture(context.Background(), remoteAddress, "heap", 0)
+	require.ErrorIs(t, err, errPProfNotEnabled)
+}
+
+func TestProfileCapturer_Store(t *testing.T) {
+	dir := t.TempDir()
+	capturer := newProfileCapturerForTest(t, 0, "file://"+dir)
+
+	uri, err := capturer.Store("history", "10.0.0.1:7234", "heap", []byte("profile"))
+	require.NoError(t, err)
+	path := filepath.Join(dir, "20240102T030405.000-history-10.0.0.1_7234-heap.pb.gz")
+	require.Equal(t, "file://"+path, uri)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "profile", string(data))
+
+	_, err = newProfileCapturerForTest(t, 0, "").Store("history", "10.0.0.1:7234", "heap", nil)
+	require.ErrorIs(t, err, errProfileStoreNotConfigured)
+	_, err = newProfileCapturerForTest(t, 0, "s3://bucket").Store("history", "10.0.0.1:7234", "heap", nil)
+	require.ErrorContains(t, err, "unsupported profile store scheme")
+}
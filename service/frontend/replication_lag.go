@@ -0,0 +1,107 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/namespace"
+)
+
+// shardReplicationLag returns how far behind the remote cluster is on the replication tasks of a shard, both in
+// number of task ids and in time since the oldest task not acknowledged yet was created. The time lag is only
+// measured on the tasks of the namespace the status was requested for, if any, and is zero if there is no such task.
+// ok is false if the shard has no replication status for the remote cluster.
+func shardReplicationLag(
+	status *historyservice.ShardReplicationStatus,
+	clusterName string,
+) (taskLag int64, timeLag time.Duration, ok bool) {
+	clusterStatus, ok := status.GetRemoteClusters()[clusterName]
+	if !ok {
+		return 0, 0, false
+	}
+	taskLag = status.GetMaxReplicationTaskId() - clusterStatus.GetAckedTaskId()
+	if taskLag <= 0 {
+		return 0, 0, true
+	}
+	oldestPendingTaskTime := clusterStatus.GetOldestPendingTaskVisibilityTime()
+	if oldestPendingTaskTime == nil {
+		return taskLag, 0, true
+	}
+	timeLag = max(status.GetShardLocalTime().AsTime().Sub(oldestPendingTaskTime.AsTime()), 0)
+	return taskLag, timeLag, true
+}
+
+// remoteClusterNames returns the clusters of a namespace other than its active cluster.
+func remoteClusterNames(clusterNames []string, activeClusterName string) []string {
+	var remoteClusters []string
+	for _, clusterName := range clusterNames {
+		if clusterName != activeClusterName {
+			remoteClusters = append(remoteClusters, clusterName)
+		}
+	}
+	return remoteClusters
+}
+
+// validateHandoverReplicationLag rejects moving a namespace into handover state while any shard has a replication
+// task of the namespace not acknowledged by one of its remote clusters for longer than the configured bound.
+func (wh *WorkflowHandler) validateHandoverReplicationLag(ctx context.Context, nsName namespace.Name) error {
+	maxLag := wh.config.HandoverMaxReplicationLag(nsName.String())
+	if maxLag <= 0 {
+		return nil
+	}
+	nsEntry, err := wh.namespaceRegistry.GetNamespace(nsName)
+	if err != nil {
+		return err
+	}
+	remoteClusters := remoteClusterNames(nsEntry.ClusterNames(), nsEntry.ActiveClusterName())
+	if len(remoteClusters) == 0 {
+		return nil
+	}
+	resp, err := wh.historyClient.GetReplicationStatus(ctx, &historyservice.GetReplicationStatusRequest{
+		RemoteClusters: remoteClusters,
+		NamespaceId:    nsEntry.ID().String(),
+	})
+	if err != nil {
+		return err
+	}
+	slices.Sort(remoteClusters)
+	for _, status := range resp.GetShards() {
+		for _, clusterName := range remoteClusters {
+			_, timeLag, ok := shardReplicationLag(status, clusterName)
+			if ok && timeLag > maxLag {
+				return serviceerror.NewFailedPrecondition(fmt.Sprintf(
+					errHandoverReplicationLagTooHighMessage, status.GetShardId(), clusterName, timeLag, maxLag,
+				))
+			}
+		}
+	}
+	return nil
+}
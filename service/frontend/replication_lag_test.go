@@ -0,0 +1,74 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/api/historyservice/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestShardReplicationLag(t *testing.T) {
+	now := time.Now().UTC()
+	newStatus := func(clusterStatus *historyservice.ShardReplicationStatusPerCluster) *historyservice.ShardReplicationStatus {
+		return &historyservice.ShardReplicationStatus{
+			MaxReplicationTaskId: 20,
+			ShardLocalTime:       timestamppb.New(now),
+			RemoteClusters:       map[string]*historyservice.ShardReplicationStatusPerCluster{"remote": clusterStatus},
+		}
+	}
+
+	_, _, ok := shardReplicationLag(newStatus(&historyservice.ShardReplicationStatusPerCluster{}), "unknown")
+	require.False(t, ok)
+
+	// the lag is measured from the oldest pending task, not from the last acknowledged one
+	taskLag, timeLag, ok := shardReplicationLag(newStatus(&historyservice.ShardReplicationStatusPerCluster{
+		AckedTaskId:                     15,
+		AckedTaskVisibilityTime:         timestamppb.New(now.Add(-time.Hour)),
+		OldestPendingTaskVisibilityTime: timestamppb.New(now.Add(-time.Second)),
+	}), "remote")
+	require.True(t, ok)
+	require.Equal(t, int64(5), taskLag)
+	require.Equal(t, time.Second, timeLag)
+
+	// no pending task of the requested namespace
+	taskLag, timeLag, ok = shardReplicationLag(newStatus(&historyservice.ShardReplicationStatusPerCluster{
+		AckedTaskId:             15,
+		AckedTaskVisibilityTime: timestamppb.New(now.Add(-time.Hour)),
+	}), "remote")
+	require.True(t, ok)
+	require.Equal(t, int64(5), taskLag)
+	require.Equal(t, time.Duration(0), timeLag)
+
+	// nothing pending
+	taskLag, timeLag, ok = shardReplicationLag(newStatus(&historyservice.ShardReplicationStatusPerCluster{
+		AckedTaskId:                     20,
+		OldestPendingTaskVisibilityTime: timestamppb.New(now.Add(-time.Hour)),
+	}), "remote")
+	require.True(t, ok)
+	require.Equal(t, int64(0), taskLag)
+	require.Equal(t, time.Duration(0), timeLag)
+}
@@ -70,6 +70,7 @@ type Config struct {
 	VisibilityPersistenceMaxWriteQPS        dynamicconfig.IntPropertyFn
 	VisibilityPersistenceSlowQueryThreshold dynamicconfig.DurationPropertyFn
 	VisibilityMaxPageSize                   dynamicconfig.IntPropertyFnWithNamespaceFilter
+	ExportWorkflowExecutionsMaxPageSize     dynamicconfig.IntPropertyFnWithNamespaceFilter
 	EnableReadFromSecondaryVisibility       dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	VisibilityEnableShadowReadMode          dynamicconfig.BoolPropertyFn
 	VisibilityDisableOrderByClause          dynamicconfig.BoolPropertyFnWithNamespaceFilter
@@ -85,12 +86,16 @@ type Config struct {
 	NamespaceReplicationInducingAPIsRPS                               dynamicconfig.IntPropertyFn
 	MaxNamespaceRPSPerInstance                                        dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MaxNamespaceBurstRatioPerInstance                                 dynamicconfig.FloatPropertyFnWithNamespaceFilter
+	NamespaceAllowedCallerCIDRs                                       dynamicconfig.TypedPropertyFnWithNamespaceFilter[[]string]
 	MaxConcurrentLongRunningRequestsPerInstance                       dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MaxGlobalConcurrentLongRunningRequests                            dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MaxNamespaceVisibilityRPSPerInstance                              dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MaxNamespaceVisibilityBurstRatioPerInstance                       dynamicconfig.FloatPropertyFnWithNamespaceFilter
 	MaxNamespaceNamespaceReplicationInducingAPIsRPSPerInstance        dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MaxNamespaceNamespaceReplicationInducingAPIsBurstRatioPerInstance dynamicconfig.FloatPropertyFnWithNamespaceFilter
+	NamespaceAPIClassRPSPerInstance                                   dynamicconfig.TypedPropertyFnWithNamespaceFilter[map[string]int]
+	NamespaceIdentityRPSPerInstance                                   dynamicconfig.IntPropertyFnWithNamespaceFilter
+	APIClassPriority                                                  dynamicconfig.TypedPropertyFn[[]string]
 	GlobalNamespaceRPS                                                dynamicconfig.IntPropertyFnWithNamespaceFilter
 	InternalFEGlobalNamespaceRPS                                      dynamicconfig.IntPropertyFnWithNamespaceFilter
 	GlobalNamespaceVisibilityRPS                                      dynamicconfig.IntPropertyFnWithNamespaceFilter
@@ -116,6 +121,9 @@ type Config struct {
 	BlobSizeLimitError dynamicconfig.IntPropertyFnWithNamespaceFilter
 	BlobSizeLimitWarn  dynamicconfig.IntPropertyFnWithNamespaceFilter
 
+	NexusPayloadSizeLimitError dynamicconfig.IntPropertyFnWithNamespaceFilter
+	NexusPayloadSizeLimitWarn  dynamicconfig.IntPropertyFnWithNamespaceFilter
+
 	ThrottledLogRPS dynamicconfig.IntPropertyFn
 
 	// Namespace specific config
@@ -144,6 +152,11 @@ type Config struct {
 	// EnableTokenNamespaceEnforcement enables enforcement that namespace in completion token matches namespace of the request
 	EnableTokenNamespaceEnforcement dynamicconfig.BoolPropertyFn
 
+	// EnableFineGrainedHandover delegates rejection of requests during namespace handover to history shards
+	EnableFineGrainedHandover dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	// HandoverMaxReplicationLag is the max replication lag allowed when a namespace is moved into handover state
+	HandoverMaxReplicationLag dynamicconfig.DurationPropertyFnWithNamespaceFilter
+
 	// gRPC keep alive options
 	// If a client pings too frequently, terminate the connection.
 	KeepAliveMinTime dynamicconfig.DurationPropertyFn
@@ -191,6 +204,11 @@ type Config struct {
 	MaxConcurrentBatchOperation     dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MaxExecutionCountBatchOperation dynamicconfig.IntPropertyFnWithNamespaceFilter
 
+	// Two-person approval for destructive operations
+	DestructiveOperationApprovalEnabled dynamicconfig.BoolPropertyFn
+	DestructiveOperationApprovalTTL     dynamicconfig.DurationPropertyFn
+	BatchTerminateApprovalThreshold     dynamicconfig.IntPropertyFnWithNamespaceFilter
+
 	EnableUpdateWorkflowExecution              dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	EnableUpdateWorkflowExecutionAsyncAccepted dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
@@ -213,14 +231,31 @@ type Config struct {
 	LinkMaxSize        dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MaxLinksPerRequest dynamicconfig.IntPropertyFnWithNamespaceFilter
 
-	AdminEnableListHistoryTasks dynamicconfig.BoolPropertyFn
+	AdminEnableListHistoryTasks                 dynamicconfig.BoolPropertyFn
+	AdminDescribeArchivalStatusMaxTasksPerShard dynamicconfig.IntPropertyFn
+	AdminSignalByQueryMaxExecutions             dynamicconfig.IntPropertyFnWithNamespaceFilter
+	ReplicationStreamCompressor                 dynamicconfig.StringPropertyFn
+	AdminDescribeReplicationLagMaxTasksPerShard dynamicconfig.IntPropertyFn
+	AdminRedactDLQPayloads                      dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	AdminCaptureProfileMaxDuration              dynamicconfig.DurationPropertyFn
+	AdminCaptureProfileMaxHosts                 dynamicconfig.IntPropertyFn
+	AdminCaptureProfileStoreURI                 dynamicconfig.StringPropertyFn
 
 	MaskInternalErrorDetails dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
 	// Health check
-	HistoryHostErrorPercentage dynamicconfig.FloatPropertyFn
+	HistoryHostErrorPercentage              dynamicconfig.FloatPropertyFn
+	MatchingHostErrorPercentage             dynamicconfig.FloatPropertyFn
+	DependencyHealthCheckTimeout            dynamicconfig.DurationPropertyFn
+	DependencyHealthCheckCacheTTL           dynamicconfig.DurationPropertyFn
+	DependencyHealthRingStabilizationPeriod dynamicconfig.DurationPropertyFn
 
-	LogAllReqErrors dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	LogAllReqErrors          dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	SlowRequestLogThresholds dynamicconfig.TypedPropertyFn[map[string]time.Duration]
+
+	EnableDebugTrace    dynamicconfig.BoolPropertyFn
+	DebugTraceRetention dynamicconfig.DurationPropertyFn
+	DebugTraceMaxTraces dynamicconfig.IntPropertyFn
 
 	EnableEagerWorkflowStart dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
@@ -248,6 +283,7 @@ func NewConfig(
 		VisibilityPersistenceMaxWriteQPS:        dynamicconfig.VisibilityPersistenceMaxWriteQPS.Get(dc),
 		VisibilityPersistenceSlowQueryThreshold: dynamicconfig.VisibilityPersistenceSlowQueryThreshold.Get(dc),
 		VisibilityMaxPageSize:                   dynamicconfig.FrontendVisibilityMaxPageSize.Get(dc),
+		ExportWorkflowExecutionsMaxPageSize:     dynamicconfig.FrontendExportWorkflowExecutionsMaxPageSize.Get(dc),
 		EnableReadFromSecondaryVisibility:       dynamicconfig.EnableReadFromSecondaryVisibility.Get(dc),
 		VisibilityEnableShadowReadMode:          dynamicconfig.VisibilityEnableShadowReadMode.Get(dc),
 		VisibilityDisableOrderByClause:          dynamicconfig.VisibilityDisableOrderByClause.Get(dc),
@@ -263,12 +299,16 @@ func NewConfig(
 
 		MaxNamespaceRPSPerInstance:                                        dynamicconfig.FrontendMaxNamespaceRPSPerInstance.Get(dc),
 		MaxNamespaceBurstRatioPerInstance:                                 dynamicconfig.FrontendMaxNamespaceBurstRatioPerInstance.Get(dc),
+		NamespaceAllowedCallerCIDRs:                                       dynamicconfig.FrontendNamespaceAllowedCallerCIDRs.Get(dc),
 		MaxConcurrentLongRunningRequestsPerInstance:                       dynamicconfig.FrontendMaxConcurrentLongRunningRequestsPerInstance.Get(dc),
 		MaxGlobalConcurrentLongRunningRequests:                            dynamicconfig.FrontendGlobalMaxConcurrentLongRunningRequests.Get(dc),
 		MaxNamespaceVisibilityRPSPerInstance:                              dynamicconfig.FrontendMaxNamespaceVisibilityRPSPerInstance.Get(dc),
 		MaxNamespaceVisibilityBurstRatioPerInstance:                       dynamicconfig.FrontendMaxNamespaceVisibilityBurstRatioPerInstance.Get(dc),
 		MaxNamespaceNamespaceReplicationInducingAPIsRPSPerInstance:        dynamicconfig.FrontendMaxNamespaceNamespaceReplicationInducingAPIsRPSPerInstance.Get(dc),
 		MaxNamespaceNamespaceReplicationInducingAPIsBurstRatioPerInstance: dynamicconfig.FrontendMaxNamespaceNamespaceReplicationInducingAPIsBurstRatioPerInstance.Get(dc),
+		NamespaceAPIClassRPSPerInstance:                                   dynamicconfig.FrontendNamespaceAPIClassRPSPerInstance.Get(dc),
+		NamespaceIdentityRPSPerInstance:                                   dynamicconfig.FrontendNamespaceIdentityRPSPerInstance.Get(dc),
+		APIClassPriority:                                                  dynamicconfig.FrontendAPIClassPriority.Get(dc),
 
 		GlobalNamespaceRPS:                     dynamicconfig.FrontendGlobalNamespaceRPS.Get(dc),
 		InternalFEGlobalNamespaceRPS:           dynamicconfig.InternalFrontendGlobalNamespaceRPS.Get(dc),
@@ -287,6 +327,8 @@ func NewConfig(
 		DisableListVisibilityByFilter:            dynamicconfig.DisableListVisibilityByFilter.Get(dc),
 		BlobSizeLimitError:                       dynamicconfig.BlobSizeLimitError.Get(dc),
 		BlobSizeLimitWarn:                        dynamicconfig.BlobSizeLimitWarn.Get(dc),
+		NexusPayloadSizeLimitError:               dynamicconfig.NexusPayloadSizeLimitError.Get(dc),
+		NexusPayloadSizeLimitWarn:                dynamicconfig.NexusPayloadSizeLimitWarn.Get(dc),
 		ThrottledLogRPS:                          dynamicconfig.FrontendThrottledLogRPS.Get(dc),
 		ShutdownDrainDuration:                    dynamicconfig.FrontendShutdownDrainDuration.Get(dc),
 		ShutdownFailHealthCheckDuration:          dynamicconfig.FrontendShutdownFailHealthCheckDuration.Get(dc),
@@ -301,6 +343,8 @@ func NewConfig(
 		DefaultWorkflowTaskTimeout:               dynamicconfig.DefaultWorkflowTaskTimeout.Get(dc),
 		EnableServerVersionCheck:                 dynamicconfig.EnableServerVersionCheck.Get(dc),
 		EnableTokenNamespaceEnforcement:          dynamicconfig.EnableTokenNamespaceEnforcement.Get(dc),
+		EnableFineGrainedHandover:                dynamicconfig.EnableFineGrainedHandover.Get(dc),
+		HandoverMaxReplicationLag:                dynamicconfig.HandoverMaxReplicationLag.Get(dc),
 		KeepAliveMinTime:                         dynamicconfig.KeepAliveMinTime.Get(dc),
 		KeepAlivePermitWithoutStream:             dynamicconfig.KeepAlivePermitWithoutStream.Get(dc),
 		KeepAliveMaxConnectionIdle:               dynamicconfig.KeepAliveMaxConnectionIdle.Get(dc),
@@ -323,6 +367,10 @@ func NewConfig(
 		MaxConcurrentBatchOperation:     dynamicconfig.FrontendMaxConcurrentBatchOperationPerNamespace.Get(dc),
 		MaxExecutionCountBatchOperation: dynamicconfig.FrontendMaxExecutionCountBatchOperationPerNamespace.Get(dc),
 
+		DestructiveOperationApprovalEnabled: dynamicconfig.FrontendDestructiveOperationApprovalEnabled.Get(dc),
+		DestructiveOperationApprovalTTL:     dynamicconfig.FrontendDestructiveOperationApprovalTTL.Get(dc),
+		BatchTerminateApprovalThreshold:     dynamicconfig.FrontendBatchTerminateApprovalThreshold.Get(dc),
+
 		EnableExecuteMultiOperation: dynamicconfig.FrontendEnableExecuteMultiOperation.Get(dc),
 
 		EnableUpdateWorkflowExecution:              dynamicconfig.FrontendEnableUpdateWorkflowExecution.Get(dc),
@@ -351,16 +399,33 @@ func NewConfig(
 		LinkMaxSize:        dynamicconfig.FrontendLinkMaxSize.Get(dc),
 		MaxLinksPerRequest: dynamicconfig.FrontendMaxLinksPerRequest.Get(dc),
 
-		CallbackEndpointConfigs:     callbacks.AllowedAddresses.Get(dc),
-		AdminEnableListHistoryTasks: dynamicconfig.AdminEnableListHistoryTasks.Get(dc),
+		CallbackEndpointConfigs:                     callbacks.AllowedAddresses.Get(dc),
+		AdminEnableListHistoryTasks:                 dynamicconfig.AdminEnableListHistoryTasks.Get(dc),
+		AdminDescribeArchivalStatusMaxTasksPerShard: dynamicconfig.AdminDescribeArchivalStatusMaxTasksPerShard.Get(dc),
+		AdminSignalByQueryMaxExecutions:             dynamicconfig.AdminSignalByQueryMaxExecutions.Get(dc),
+		ReplicationStreamCompressor:                 dynamicconfig.ReplicationStreamCompressor.Get(dc),
+		AdminDescribeReplicationLagMaxTasksPerShard: dynamicconfig.AdminDescribeReplicationLagMaxTasksPerShard.Get(dc),
+		AdminRedactDLQPayloads:                      dynamicconfig.RedactPayloads.Get(dc),
+		AdminCaptureProfileMaxDuration:              dynamicconfig.AdminCaptureProfileMaxDuration.Get(dc),
+		AdminCaptureProfileMaxHosts:                 dynamicconfig.AdminCaptureProfileMaxHosts.Get(dc),
+		AdminCaptureProfileStoreURI:                 dynamicconfig.AdminCaptureProfileStoreURI.Get(dc),
 
 		MaskInternalErrorDetails: dynamicconfig.FrontendMaskInternalErrorDetails.Get(dc),
 
 		HistoryHostErrorPercentage: dynamicconfig.HistoryHostErrorPercentage.Get(dc),
 		LogAllReqErrors:            dynamicconfig.LogAllReqErrors.Get(dc),
+		SlowRequestLogThresholds:   dynamicconfig.SlowRequestLogThresholds.Get(dc),
+		EnableDebugTrace:           dynamicconfig.EnableDebugTrace.Get(dc),
+		DebugTraceRetention:        dynamicconfig.DebugTraceRetention.Get(dc),
+		DebugTraceMaxTraces:        dynamicconfig.DebugTraceMaxTraces.Get(dc),
 		EnableEagerWorkflowStart:   dynamicconfig.EnableEagerWorkflowStart.Get(dc),
 		ActivityAPIsEnabled:        dynamicconfig.ActivityAPIsEnabled.Get(dc),
 
+		MatchingHostErrorPercentage:             dynamicconfig.MatchingHostErrorPercentage.Get(dc),
+		DependencyHealthCheckTimeout:            dynamicconfig.DependencyHealthCheckTimeout.Get(dc),
+		DependencyHealthCheckCacheTTL:           dynamicconfig.DependencyHealthCheckCacheTTL.Get(dc),
+		DependencyHealthRingStabilizationPeriod: dynamicconfig.DependencyHealthRingStabilizationPeriod.Get(dc),
+
 		HTTPAllowedHosts: dynamicconfig.NewGlobalCachedTypedValue(dc, dynamicconfig.FrontendHTTPAllowedHosts, func(patterns []string) (*regexp.Regexp, error) {
 			if len(patterns) == 0 {
 				return matchAny, nil
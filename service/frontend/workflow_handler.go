@@ -27,7 +27,9 @@ package frontend
 import (
 	"cmp"
 	"context"
+	"crypto/sha256"
 	"encoding/binary"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -49,6 +51,7 @@ import (
 	updatepb "go.temporal.io/api/update/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	schedulespb "go.temporal.io/server/api/schedule/v1"
@@ -146,6 +149,7 @@ type (
 		healthInterceptor               *interceptor.HealthInterceptor
 		scheduleSpecBuilder             *scheduler.SpecBuilder
 		outstandingPollers              collection.SyncMap[string, collection.SyncMap[string, context.CancelFunc]]
+		approvalGate                    *ApprovalGate
 		httpEnabled                     bool
 	}
 )
@@ -175,6 +179,7 @@ func NewWorkflowHandler(
 	membershipMonitor membership.Monitor,
 	healthInterceptor *interceptor.HealthInterceptor,
 	scheduleSpecBuilder *scheduler.SpecBuilder,
+	approvalGate *ApprovalGate,
 	httpEnabled bool,
 ) *WorkflowHandler {
 
@@ -228,6 +233,7 @@ func NewWorkflowHandler(
 		healthInterceptor:   healthInterceptor,
 		scheduleSpecBuilder: scheduleSpecBuilder,
 		outstandingPollers:  collection.NewSyncMap[string, collection.SyncMap[string, context.CancelFunc]](),
+		approvalGate:        approvalGate,
 		httpEnabled:         httpEnabled,
 	}
 
@@ -322,7 +328,10 @@ func (wh *WorkflowHandler) DescribeNamespace(ctx context.Context, request *workf
 	if err != nil {
 		return resp, err
 	}
-	return resp, err
+	if err := addNamespaceHandoverProgress(ctx, wh.historyClient, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
 }
 
 // ListNamespaces returns the information and configuration for all namespaces.
@@ -348,6 +357,12 @@ func (wh *WorkflowHandler) UpdateNamespace(ctx context.Context, request *workflo
 		return nil, errRequestNotSet
 	}
 
+	if request.GetReplicationConfig().GetState() == enumspb.REPLICATION_STATE_HANDOVER {
+		if err := wh.validateHandoverReplicationLag(ctx, namespace.Name(request.GetNamespace())); err != nil {
+			return nil, err
+		}
+	}
+
 	resp, err := wh.namespaceHandler.UpdateNamespace(ctx, request)
 	if err != nil {
 		return resp, err
@@ -481,7 +496,7 @@ func (wh *WorkflowHandler) prepareStartWorkflowRequest(
 	}
 	if sa != request.SearchAttributes {
 		// cloning here so in case of retry the field is set to the current search attributes
-		request = common.CloneProto(request)
+		request = common.CloneProtoSharingPayloads(request)
 		request.SearchAttributes = sa
 	}
 
@@ -2001,7 +2016,7 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 	}
 	if sa != request.GetSearchAttributes() {
 		// cloning here so in case of retry the field is set to the current search attributes
-		request = common.CloneProto(request)
+		request = common.CloneProtoSharingPayloads(request)
 		request.SearchAttributes = sa
 	}
 
@@ -2692,6 +2707,10 @@ func (wh *WorkflowHandler) QueryWorkflow(ctx context.Context, request *workflows
 	}
 
 	enums.SetDefaultQueryRejectCondition(&request.QueryRejectCondition)
+	extendedRejectConditions, err := getExtendedQueryRejectConditions(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	namespaceID, err := wh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
 	if err != nil {
@@ -2715,8 +2734,9 @@ func (wh *WorkflowHandler) QueryWorkflow(ctx context.Context, request *workflows
 	}
 
 	req := &historyservice.QueryWorkflowRequest{
-		NamespaceId: namespaceID.String(),
-		Request:     request,
+		NamespaceId:                   namespaceID.String(),
+		Request:                       request,
+		ExtendedQueryRejectConditions: extendedRejectConditions,
 	}
 	hResponse, err := wh.historyClient.QueryWorkflow(ctx, req)
 	if err != nil {
@@ -3432,6 +3452,7 @@ func (wh *WorkflowHandler) DescribeSchedule(ctx context.Context, request *workfl
 
 	memo := describeResponse.GetWorkflowExecutionInfo().GetMemo()
 	memo = wh.cleanScheduleMemo(memo)
+	memo = wh.addScheduleBackfillProgressMemo(memo, queryResponse.GetBackfills())
 
 	scheduler.CleanSpec(queryResponse.Schedule.Spec)
 
@@ -4180,6 +4201,53 @@ func (wh *WorkflowHandler) GetWorkerTaskReachability(ctx context.Context, reques
 	return response, nil
 }
 
+// checkBatchTerminateApproval requires a second approval for batch terminate operations that target at least
+// BatchTerminateApprovalThreshold executions.
+func (wh *WorkflowHandler) checkBatchTerminateApproval(
+	ctx context.Context,
+	request *workflowservice.StartBatchOperationRequest,
+) error {
+	if !wh.approvalGate.Enabled() {
+		return nil
+	}
+
+	count := int64(len(request.GetExecutions()))
+	if len(request.GetVisibilityQuery()) != 0 {
+		countResp, err := wh.CountWorkflowExecutions(ctx, &workflowservice.CountWorkflowExecutionsRequest{
+			Namespace: request.GetNamespace(),
+			Query:     request.GetVisibilityQuery(),
+		})
+		if err != nil {
+			return err
+		}
+		count = countResp.GetCount()
+	}
+	if count < int64(wh.config.BatchTerminateApprovalThreshold(request.GetNamespace())) {
+		return nil
+	}
+	return wh.approvalGate.Check(ctx, "StartBatchOperation", batchTerminateApprovalTarget(request))
+}
+
+// batchTerminateApprovalTarget identifies a batch terminate operation by its namespace, job ID and a digest of the
+// executions it targets and its reason, so that an approval only covers the exact same operation. The identity of
+// the caller is left out, as the approver's request is expected to differ in it.
+func batchTerminateApprovalTarget(request *workflowservice.StartBatchOperationRequest) string {
+	h := sha256.New()
+	writeField := func(field string) {
+		// fields are prefixed with their length so that they can't be shifted into one another
+		_ = binary.Write(h, binary.BigEndian, uint64(len(field)))
+		h.Write([]byte(field))
+	}
+	writeField(request.GetVisibilityQuery())
+	writeField(request.GetReason())
+	_ = binary.Write(h, binary.BigEndian, uint64(len(request.GetExecutions())))
+	for _, execution := range request.GetExecutions() {
+		writeField(execution.GetWorkflowId())
+		writeField(execution.GetRunId())
+	}
+	return fmt.Sprintf("%s/%s/%s", request.GetNamespace(), request.GetJobId(), hex.EncodeToString(h.Sum(nil)))
+}
+
 func (wh *WorkflowHandler) StartBatchOperation(
 	ctx context.Context,
 	request *workflowservice.StartBatchOperationRequest,
@@ -4243,6 +4311,11 @@ func (wh *WorkflowHandler) StartBatchOperation(
 	if err != nil {
 		return nil, err
 	}
+	if request.GetTerminationOperation() != nil {
+		if err := wh.checkBatchTerminateApproval(ctx, request); err != nil {
+			return nil, err
+		}
+	}
 	var identity string
 	var operationType string
 	var signalParams batcher.SignalParams
@@ -4471,6 +4544,9 @@ func (wh *WorkflowHandler) DescribeBatchOperation(
 		operationType = enumspb.BATCH_OPERATION_TYPE_RESET
 	case batcher.BatchTypeUpdateOptions:
 		operationType = enumspb.BATCH_OPERATION_TYPE_UPDATE_EXECUTION_OPTIONS
+	case batcher.BatchTypeSignalWithStart, batcher.BatchTypeRebuildMutableState, batcher.BatchTypeRefreshWorkflowTasks:
+		// started through the admin API, there is no public operation type for it
+		operationType = enumspb.BATCH_OPERATION_TYPE_UNSPECIFIED
 	default:
 		operationType = enumspb.BATCH_OPERATION_TYPE_UNSPECIFIED
 		wh.throttledLogger.Warn("Unknown batch operation type", tag.NewStringTag("batch-operation-type", operationTypeString))
@@ -5150,6 +5226,23 @@ func validateRequestId(requestID *string, lenLimit int) error {
 	return common.ValidateUTF8String("RequestId", *requestID)
 }
 
+// getExtendedQueryRejectConditions parses the query reject conditions the caller set in the request header, if any.
+func getExtendedQueryRejectConditions(ctx context.Context) ([]enumsspb.QueryRejectCondition, error) {
+	header := headers.GetValues(ctx, headers.QueryRejectConditionsHeaderName)[0]
+	if header == "" {
+		return nil, nil
+	}
+	var conditions []enumsspb.QueryRejectCondition
+	for _, value := range strings.Split(header, headers.QueryRejectConditionsHeaderDelim) {
+		condition, err := enumsspb.QueryRejectConditionFromString(strings.TrimSpace(value))
+		if err != nil || condition == enumsspb.QUERY_REJECT_CONDITION_UNSPECIFIED {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(errInvalidQueryRejectConditionMessage, value))
+		}
+		conditions = append(conditions, condition)
+	}
+	return conditions, nil
+}
+
 func (wh *WorkflowHandler) validateStartWorkflowTimeouts(
 	request *workflowservice.StartWorkflowExecutionRequest,
 ) error {
@@ -5300,6 +5393,27 @@ func (wh *WorkflowHandler) cleanScheduleMemo(memo *commonpb.Memo) *commonpb.Memo
 	return memo
 }
 
+// addScheduleBackfillProgressMemo exposes the progress of ongoing backfills in the memo of a DescribeSchedule
+// response, since the public response has no field for it.
+func (wh *WorkflowHandler) addScheduleBackfillProgressMemo(memo *commonpb.Memo, backfills *schedulespb.ScheduleBackfills) *commonpb.Memo {
+	if len(backfills.GetOngoing()) == 0 {
+		return memo
+	}
+	p, err := payload.Encode(backfills)
+	if err != nil {
+		wh.logger.Error("encoding schedule backfill progress failed", tag.Error(err))
+		return memo
+	}
+	if memo == nil {
+		memo = &commonpb.Memo{}
+	}
+	if memo.Fields == nil {
+		memo.Fields = make(map[string]*commonpb.Payload)
+	}
+	memo.Fields[scheduler.MemoFieldBackfillProgress] = p
+	return memo
+}
+
 // This mutates request (but idempotent so safe for retries)
 func (wh *WorkflowHandler) addInitialScheduleMemo(request *workflowservice.CreateScheduleRequest, args *schedulespb.StartScheduleArgs) {
 	info := scheduler.GetListInfoFromStartArgs(args, time.Now().UTC(), wh.scheduleSpecBuilder)
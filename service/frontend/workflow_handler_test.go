@@ -48,6 +48,7 @@ import (
 	updatepb "go.temporal.io/api/update/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
@@ -78,6 +79,7 @@ import (
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -197,6 +199,7 @@ func (s *WorkflowHandlerSuite) getWorkflowHandler(config *Config) *WorkflowHandl
 		s.mockResource.GetMembershipMonitor(),
 		healthInterceptor,
 		scheduler.NewSpecBuilder(),
+		nil,
 		true,
 	)
 }
@@ -1336,6 +1339,53 @@ func (s *WorkflowHandlerSuite) TestDescribeNamespace_Success_ArchivalEnabled() {
 	s.Equal(testVisibilityArchivalURI, result.Config.GetVisibilityArchivalUri())
 }
 
+func (s *WorkflowHandlerSuite) TestDescribeNamespace_HandoverProgress() {
+	getNamespaceResp := persistenceGetNamespaceResponse(
+		&namespace.ArchivalConfigState{State: enumspb.ARCHIVAL_STATE_DISABLED, URI: ""},
+		&namespace.ArchivalConfigState{State: enumspb.ARCHIVAL_STATE_DISABLED, URI: ""},
+	)
+	getNamespaceResp.IsGlobalNamespace = true
+	getNamespaceResp.Namespace.ReplicationConfig.Clusters = []string{
+		cluster.TestCurrentClusterName,
+		cluster.TestAlternativeClusterName,
+	}
+	getNamespaceResp.Namespace.ReplicationConfig.State = enumspb.REPLICATION_STATE_HANDOVER
+	s.mockMetadataMgr.EXPECT().GetNamespace(gomock.Any(), gomock.Any()).Return(getNamespaceResp, nil)
+	s.mockHistoryClient.EXPECT().GetReplicationStatus(gomock.Any(), &historyservice.GetReplicationStatusRequest{
+		RemoteClusters: []string{cluster.TestAlternativeClusterName},
+	}).Return(&historyservice.GetReplicationStatusResponse{
+		Shards: []*historyservice.ShardReplicationStatus{
+			{
+				ShardId: 1,
+				RemoteClusters: map[string]*historyservice.ShardReplicationStatusPerCluster{
+					cluster.TestAlternativeClusterName: {AckedTaskId: 10},
+				},
+				HandoverNamespaces: map[string]*historyservice.HandoverNamespaceInfo{
+					"test-name": {HandoverReplicationTaskId: 10},
+				},
+			},
+			{
+				ShardId: 2,
+				RemoteClusters: map[string]*historyservice.ShardReplicationStatusPerCluster{
+					cluster.TestAlternativeClusterName: {AckedTaskId: 9},
+				},
+				HandoverNamespaces: map[string]*historyservice.HandoverNamespaceInfo{
+					"test-name": {HandoverReplicationTaskId: 10},
+				},
+			},
+		},
+	}, nil)
+
+	wh := s.getWorkflowHandler(s.newConfig())
+
+	result, err := wh.DescribeNamespace(context.Background(), &workflowservice.DescribeNamespaceRequest{
+		Namespace: "test-name",
+	})
+	s.NoError(err)
+	s.Equal("2", result.GetNamespaceInfo().GetData()[HandoverTotalShardsDataKey])
+	s.Equal("1", result.GetNamespaceInfo().GetData()[HandoverDrainedShardsDataKey])
+}
+
 func (s *WorkflowHandlerSuite) TestUpdateNamespace_Failure_UpdateExistingArchivalURI() {
 	s.mockMetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{
 		NotificationVersion: int64(0),
@@ -1362,6 +1412,55 @@ func (s *WorkflowHandlerSuite) TestUpdateNamespace_Failure_UpdateExistingArchiva
 	s.Error(err)
 }
 
+func (s *WorkflowHandlerSuite) TestUpdateNamespace_Failure_HandoverReplicationLagTooHigh() {
+	config := s.newConfig()
+	config.HandoverMaxReplicationLag = dc.GetDurationPropertyFnFilteredByNamespace(time.Minute)
+	wh := s.getWorkflowHandler(config)
+
+	nsEntry := namespace.NewGlobalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: s.testNamespaceID.String(), Name: s.testNamespace.String()},
+		&persistencespb.NamespaceConfig{},
+		&persistencespb.NamespaceReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters: []string{
+				cluster.TestCurrentClusterName,
+				cluster.TestAlternativeClusterName,
+			},
+		},
+		int64(100),
+	)
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.testNamespace).Return(nsEntry, nil)
+	now := time.Now().UTC()
+	s.mockHistoryClient.EXPECT().GetReplicationStatus(gomock.Any(), &historyservice.GetReplicationStatusRequest{
+		RemoteClusters: []string{cluster.TestAlternativeClusterName},
+		NamespaceId:    s.testNamespaceID.String(),
+	}).Return(&historyservice.GetReplicationStatusResponse{
+		Shards: []*historyservice.ShardReplicationStatus{
+			{
+				ShardId:              1,
+				MaxReplicationTaskId: 20,
+				ShardLocalTime:       timestamppb.New(now),
+				RemoteClusters: map[string]*historyservice.ShardReplicationStatusPerCluster{
+					cluster.TestAlternativeClusterName: {
+						AckedTaskId:                     10,
+						AckedTaskVisibilityTime:         timestamppb.New(now.Add(-time.Second)),
+						OldestPendingTaskVisibilityTime: timestamppb.New(now.Add(-2 * time.Minute)),
+					},
+				},
+			},
+		},
+	}, nil)
+
+	_, err := wh.UpdateNamespace(context.Background(), &workflowservice.UpdateNamespaceRequest{
+		Namespace: s.testNamespace.String(),
+		ReplicationConfig: &replicationpb.NamespaceReplicationConfig{
+			State: enumspb.REPLICATION_STATE_HANDOVER,
+		},
+	})
+	var failedPrecondition *serviceerror.FailedPrecondition
+	s.ErrorAs(err, &failedPrecondition)
+}
+
 func (s *WorkflowHandlerSuite) TestUpdateNamespace_Failure_InvalidArchivalURI() {
 	s.mockMetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{
 		NotificationVersion: int64(0),
@@ -2891,6 +2990,29 @@ func TestValidateRequestId(t *testing.T) {
 	assert.Contains(t, err.Error(), "not a valid UTF-8 string")
 }
 
+func TestGetExtendedQueryRejectConditions(t *testing.T) {
+	conditions, err := getExtendedQueryRejectConditions(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, conditions)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		headers.QueryRejectConditionsHeaderName, "WorkflowTaskFailing, QUERY_REJECT_CONDITION_ACTIVITY_PAUSED",
+	))
+	conditions, err = getExtendedQueryRejectConditions(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []enumsspb.QueryRejectCondition{
+		enumsspb.QUERY_REJECT_CONDITION_WORKFLOW_TASK_FAILING,
+		enumsspb.QUERY_REJECT_CONDITION_ACTIVITY_PAUSED,
+	}, conditions)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		headers.QueryRejectConditionsHeaderName, "Unspecified",
+	))
+	_, err = getExtendedQueryRejectConditions(ctx)
+	var invalidArgument *serviceerror.InvalidArgument
+	assert.ErrorAs(t, err, &invalidArgument)
+}
+
 func (s *WorkflowHandlerSuite) Test_DeleteWorkflowExecution() {
 	config := s.newConfig()
 	wh := s.getWorkflowHandler(config)
@@ -66,6 +66,7 @@ type (
 		RpcFactory             common.RPCFactory
 		RetryableInterceptor   *interceptor.RetryableInterceptor
 		TelemetryInterceptor   *interceptor.TelemetryInterceptor
+		DebugTraceInterceptor  *interceptor.DebugTraceInterceptor `optional:"true"`
 		RateLimitInterceptor   *interceptor.RateLimitInterceptor
 		TracingStatsHandler    telemetry.ServerStatsHandler
 		AdditionalInterceptors []grpc.UnaryServerInterceptor `optional:"true"`
@@ -168,6 +169,9 @@ func getUnaryInterceptors(params GrpcServerOptionsParams) []grpc.UnaryServerInte
 		metrics.NewServerMetricsTrailerPropagatorInterceptor(params.Logger),
 		params.TelemetryInterceptor.UnaryIntercept,
 	}
+	if params.DebugTraceInterceptor != nil {
+		interceptors = append(interceptors, params.DebugTraceInterceptor.Intercept)
+	}
 
 	interceptors = append(interceptors, params.AdditionalInterceptors...)
 
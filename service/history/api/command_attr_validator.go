@@ -129,7 +129,7 @@ func (v *CommandAttrValidator) ValidateActivityScheduleAttributes(
 		attributes.RetryPolicy = &commonpb.RetryPolicy{}
 	}
 
-	if err := v.validateActivityRetryPolicy(namespaceID, attributes.RetryPolicy); err != nil {
+	if err := v.validateActivityRetryPolicy(namespaceID, activityType, attributes.RetryPolicy); err != nil {
 		return failedCause, fmt.Errorf("invalid ActivityRetryPolicy on SechduleActivityTaskCommand: %w. ActivityId=%s ActivityType=%s", err, activityID, activityType)
 	}
 	if len(activityID) > v.maxIDLengthLimit {
@@ -620,6 +620,7 @@ func (v *CommandAttrValidator) ValidateStartChildExecutionAttributes(
 
 func (v *CommandAttrValidator) validateActivityRetryPolicy(
 	namespaceID namespace.ID,
+	activityType string,
 	retryPolicy *commonpb.RetryPolicy,
 ) error {
 	if retryPolicy == nil {
@@ -628,6 +629,17 @@ func (v *CommandAttrValidator) validateActivityRetryPolicy(
 	// TODO: this is a namespace setting, not a namespace id setting
 	defaultActivityRetrySettings := v.getDefaultActivityRetrySettings(namespaceID.String())
 	retrypolicy.EnsureDefaults(retryPolicy, defaultActivityRetrySettings)
+
+	namespaceEntry, err := v.namespaceRegistry.GetNamespaceByID(namespaceID)
+	if err != nil {
+		return err
+	}
+	namespaceName := namespaceEntry.Name().String()
+	caps, ok := v.config.ActivityTypeRetryPolicyCaps(namespaceName)[activityType]
+	if !ok {
+		caps = v.config.ActivityRetryPolicyCaps(namespaceName)
+	}
+	retrypolicy.ApplyCaps(retryPolicy, caps)
 	return retrypolicy.Validate(retryPolicy)
 }
 
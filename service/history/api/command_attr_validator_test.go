@@ -127,6 +127,8 @@ func (s *commandAttrValidatorSuite) SetupTest() {
 		SearchAttributesTotalSizeLimit:    dynamicconfig.GetIntPropertyFnFilteredByNamespace(40 * 1024),
 		DefaultActivityRetryPolicy:        func(string) retrypolicy.DefaultRetrySettings { return retrypolicy.DefaultDefaultRetrySettings },
 		DefaultWorkflowRetryPolicy:        func(string) retrypolicy.DefaultRetrySettings { return retrypolicy.DefaultDefaultRetrySettings },
+		ActivityRetryPolicyCaps:           func(string) retrypolicy.Caps { return retrypolicy.Caps{} },
+		ActivityTypeRetryPolicyCaps:       func(string) map[string]retrypolicy.Caps { return nil },
 		EnableCrossNamespaceCommands:      dynamicconfig.GetBoolPropertyFn(true),
 		DefaultWorkflowTaskTimeout:        dynamicconfig.GetDurationPropertyFnFilteredByNamespace(primitives.DefaultWorkflowTaskTimeout),
 	}
@@ -685,13 +687,48 @@ func (s *commandAttrValidatorSuite) TestValidateActivityRetryPolicy() {
 				RetryPolicy: tt.input,
 			}
 
-			err := s.validator.validateActivityRetryPolicy(s.testNamespaceID, attr.GetRetryPolicy())
+			s.mockNamespaceCache.EXPECT().GetNamespaceByID(s.testNamespaceID).Return(tests.LocalNamespaceEntry, nil)
+			err := s.validator.validateActivityRetryPolicy(s.testNamespaceID, "test-activity-type", attr.GetRetryPolicy())
 			assert.Nil(s.T(), err, "expected no error")
 			assert.Equal(s.T(), tt.want, attr.RetryPolicy, "unexpected retry policy")
 		})
 	}
 }
 
+func (s *commandAttrValidatorSuite) TestValidateActivityRetryPolicy_Caps() {
+	s.validator.config.ActivityRetryPolicyCaps = func(string) retrypolicy.Caps {
+		return retrypolicy.Caps{MaximumInterval: 10 * time.Second, MaximumAttempts: 20}
+	}
+	s.validator.config.ActivityTypeRetryPolicyCaps = func(string) map[string]retrypolicy.Caps {
+		return map[string]retrypolicy.Caps{"capped-activity-type": {MaximumAttempts: 3}}
+	}
+	s.mockNamespaceCache.EXPECT().GetNamespaceByID(s.testNamespaceID).Return(tests.LocalNamespaceEntry, nil).Times(2)
+
+	retryPolicy := &commonpb.RetryPolicy{
+		InitialInterval: durationpb.New(30 * time.Second),
+	}
+	err := s.validator.validateActivityRetryPolicy(s.testNamespaceID, "test-activity-type", retryPolicy)
+	s.NoError(err)
+	s.Equal(&commonpb.RetryPolicy{
+		InitialInterval:    durationpb.New(10 * time.Second),
+		BackoffCoefficient: 2,
+		MaximumInterval:    durationpb.New(10 * time.Second),
+		MaximumAttempts:    20,
+	}, retryPolicy)
+
+	retryPolicy = &commonpb.RetryPolicy{
+		MaximumAttempts: 5,
+	}
+	err = s.validator.validateActivityRetryPolicy(s.testNamespaceID, "capped-activity-type", retryPolicy)
+	s.NoError(err)
+	s.Equal(&commonpb.RetryPolicy{
+		InitialInterval:    durationpb.New(1 * time.Second),
+		BackoffCoefficient: 2,
+		MaximumInterval:    durationpb.New(100 * time.Second),
+		MaximumAttempts:    3,
+	}, retryPolicy)
+}
+
 func (s *commandAttrValidatorSuite) TestValidateCommandSequence_NoTerminalCommand() {
 	err := s.validator.ValidateCommandSequence(nonTerminalCommands)
 	s.NoError(err)
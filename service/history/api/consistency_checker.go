@@ -60,6 +60,15 @@ type (
 			workflowKey definition.WorkflowKey,
 			lockPriority locks.Priority,
 		) (WorkflowLease, error)
+		// GetWorkflowLeaseWithoutCollections is GetWorkflowLease for callers which don't need the given collections
+		// of the mutable state, see workflow.Context.LoadMutableStateWithoutCollections.
+		GetWorkflowLeaseWithoutCollections(
+			ctx context.Context,
+			reqClock *clockspb.VectorClock,
+			workflowKey definition.WorkflowKey,
+			lockPriority locks.Priority,
+			omitCollections persistence.MutableStateCollections,
+		) (WorkflowLease, error)
 
 		GetWorkflowLeaseWithConsistencyCheck(
 			ctx context.Context,
@@ -112,7 +121,17 @@ func (c *WorkflowConsistencyCheckerImpl) GetWorkflowLease(
 	workflowKey definition.WorkflowKey,
 	lockPriority locks.Priority,
 ) (WorkflowLease, error) {
-	return c.getWorkflowLeaseImpl(ctx, reqClock, nil, workflowKey, lockPriority)
+	return c.getWorkflowLeaseImpl(ctx, reqClock, nil, workflowKey, lockPriority, 0)
+}
+
+func (c *WorkflowConsistencyCheckerImpl) GetWorkflowLeaseWithoutCollections(
+	ctx context.Context,
+	reqClock *clockspb.VectorClock,
+	workflowKey definition.WorkflowKey,
+	lockPriority locks.Priority,
+	omitCollections persistence.MutableStateCollections,
+) (WorkflowLease, error) {
+	return c.getWorkflowLeaseImpl(ctx, reqClock, nil, workflowKey, lockPriority, omitCollections)
 }
 
 // The code below should be used when custom workflow state validation is required.
@@ -126,7 +145,7 @@ func (c *WorkflowConsistencyCheckerImpl) GetWorkflowLeaseWithConsistencyCheck(
 	lockPriority locks.Priority,
 ) (WorkflowLease, error) {
 
-	return c.getWorkflowLeaseImpl(ctx, reqClock, consistencyPredicate, workflowKey, lockPriority)
+	return c.getWorkflowLeaseImpl(ctx, reqClock, consistencyPredicate, workflowKey, lockPriority, 0)
 }
 
 func (c *WorkflowConsistencyCheckerImpl) getWorkflowLeaseImpl(
@@ -135,13 +154,14 @@ func (c *WorkflowConsistencyCheckerImpl) getWorkflowLeaseImpl(
 	consistencyPredicate MutableStateConsistencyPredicate,
 	workflowKey definition.WorkflowKey,
 	lockPriority locks.Priority,
+	omitCollections persistence.MutableStateCollections,
 ) (WorkflowLease, error) {
 	if err := c.clockConsistencyCheck(reqClock); err != nil {
 		return nil, err
 	}
 
 	if len(workflowKey.RunID) != 0 {
-		return c.getWorkflowLease(ctx, consistencyPredicate, workflowKey, lockPriority)
+		return c.getWorkflowLease(ctx, consistencyPredicate, workflowKey, lockPriority, omitCollections)
 	}
 
 	return c.getCurrentWorkflowLease(
@@ -150,6 +170,7 @@ func (c *WorkflowConsistencyCheckerImpl) getWorkflowLeaseImpl(
 		workflowKey.NamespaceID,
 		workflowKey.WorkflowID,
 		lockPriority,
+		omitCollections,
 	)
 }
 
@@ -186,6 +207,7 @@ func (c *WorkflowConsistencyCheckerImpl) getCurrentWorkflowLease(
 	namespaceID string,
 	workflowID string,
 	lockPriority locks.Priority,
+	omitCollections persistence.MutableStateCollections,
 ) (WorkflowLease, error) {
 	runID, err := c.GetCurrentRunID(
 		ctx,
@@ -201,6 +223,7 @@ func (c *WorkflowConsistencyCheckerImpl) getCurrentWorkflowLease(
 		consistencyPredicate,
 		definition.NewWorkflowKey(namespaceID, workflowID, runID),
 		lockPriority,
+		omitCollections,
 	)
 
 	if err != nil {
@@ -229,6 +252,7 @@ func (c *WorkflowConsistencyCheckerImpl) getWorkflowLease(
 	consistencyPredicate MutableStateConsistencyPredicate,
 	workflowKey definition.WorkflowKey,
 	lockPriority locks.Priority,
+	omitCollections persistence.MutableStateCollections,
 ) (WorkflowLease, error) {
 
 	wfContext, release, err := c.workflowCache.GetOrCreateWorkflowExecution(
@@ -245,7 +269,7 @@ func (c *WorkflowConsistencyCheckerImpl) getWorkflowLease(
 		return nil, err
 	}
 
-	mutableState, err := wfContext.LoadMutableState(ctx, c.shardContext)
+	mutableState, err := loadMutableState(ctx, c.shardContext, wfContext, omitCollections)
 	if err != nil {
 		release(err)
 		return nil, err
@@ -257,10 +281,22 @@ func (c *WorkflowConsistencyCheckerImpl) getWorkflowLease(
 	}
 	wfContext.Clear()
 
-	mutableState, err = wfContext.LoadMutableState(ctx, c.shardContext)
+	mutableState, err = loadMutableState(ctx, c.shardContext, wfContext, omitCollections)
 	if err != nil {
 		release(err)
 		return nil, err
 	}
 	return NewWorkflowLease(wfContext, release, mutableState), nil
 }
+
+func loadMutableState(
+	ctx context.Context,
+	shardContext shard.Context,
+	wfContext workflow.Context,
+	omitCollections persistence.MutableStateCollections,
+) (workflow.MutableState, error) {
+	if omitCollections == 0 {
+		return wfContext.LoadMutableState(ctx, shardContext)
+	}
+	return wfContext.LoadMutableStateWithoutCollections(ctx, shardContext, omitCollections)
+}
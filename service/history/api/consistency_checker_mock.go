@@ -40,6 +40,7 @@ import (
 	clock "go.temporal.io/server/api/clock/v1"
 	definition "go.temporal.io/server/common/definition"
 	locks "go.temporal.io/server/common/locks"
+	persistence "go.temporal.io/server/common/persistence"
 	cache "go.temporal.io/server/service/history/workflow/cache"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -125,3 +126,18 @@ func (mr *MockWorkflowConsistencyCheckerMockRecorder) GetWorkflowLeaseWithConsis
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowLeaseWithConsistencyCheck", reflect.TypeOf((*MockWorkflowConsistencyChecker)(nil).GetWorkflowLeaseWithConsistencyCheck), ctx, reqClock, consistencyPredicate, workflowKey, lockPriority)
 }
+
+// GetWorkflowLeaseWithoutCollections mocks base method.
+func (m *MockWorkflowConsistencyChecker) GetWorkflowLeaseWithoutCollections(ctx context.Context, reqClock *clock.VectorClock, workflowKey definition.WorkflowKey, lockPriority locks.Priority, omitCollections persistence.MutableStateCollections) (WorkflowLease, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflowLeaseWithoutCollections", ctx, reqClock, workflowKey, lockPriority, omitCollections)
+	ret0, _ := ret[0].(WorkflowLease)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowLeaseWithoutCollections indicates an expected call of GetWorkflowLeaseWithoutCollections.
+func (mr *MockWorkflowConsistencyCheckerMockRecorder) GetWorkflowLeaseWithoutCollections(ctx, reqClock, workflowKey, lockPriority, omitCollections any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowLeaseWithoutCollections", reflect.TypeOf((*MockWorkflowConsistencyChecker)(nil).GetWorkflowLeaseWithoutCollections), ctx, reqClock, workflowKey, lockPriority, omitCollections)
+}
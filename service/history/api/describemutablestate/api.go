@@ -31,6 +31,7 @@ import (
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/locks"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/service/history/api"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/workflow"
@@ -48,7 +49,9 @@ func Invoke(
 		return nil, err
 	}
 
-	workflowLease, err := workflowConsistencyChecker.GetWorkflowLease(
+	// The mutable state is reloaded from persistence below, so a mutable state which is not cached yet is loaded
+	// without collections only to lock the workflow.
+	workflowLease, err := workflowConsistencyChecker.GetWorkflowLeaseWithoutCollections(
 		ctx,
 		nil,
 		definition.NewWorkflowKey(
@@ -57,6 +60,7 @@ func Invoke(
 			req.Execution.RunId,
 		),
 		locks.PriorityHigh,
+		persistence.MutableStateAllCollections,
 	)
 	if err != nil {
 		return nil, err
@@ -64,12 +68,13 @@ func Invoke(
 	defer func() { workflowLease.GetReleaseFn()(retError) }()
 
 	response := &historyservice.DescribeMutableStateResponse{}
-	if workflowLease.GetContext().(*workflow.ContextImpl).MutableState != nil {
-		msb := workflowLease.GetContext().(*workflow.ContextImpl).MutableState
+	// A partial mutable state is not reported, since clients such as tdbg take pending state from the cached one.
+	if msb := workflowLease.GetContext().(*workflow.ContextImpl).MutableState; msb != nil && msb.GetOmittedCollections() == 0 {
 		response.CacheMutableState = msb.CloneToProto()
 	}
 
 	// clear mutable state to force reload from persistence. This API returns both cached and persisted version.
+	// The persisted version is loaded with all collections, e.g. for tdbg to export it.
 	workflowLease.GetContext().Clear()
 	mutableState, err := workflowLease.GetContext().LoadMutableState(ctx, shardContext)
 	if err != nil {
@@ -44,6 +44,7 @@ import (
 	"go.temporal.io/server/common/locks"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/components/callbacks"
 	"go.temporal.io/server/components/nexusoperations"
@@ -91,7 +92,7 @@ func Invoke(
 		return nil, err
 	}
 
-	workflowLease, err := workflowConsistencyChecker.GetWorkflowLease(
+	workflowLease, err := workflowConsistencyChecker.GetWorkflowLeaseWithoutCollections(
 		ctx,
 		nil,
 		definition.NewWorkflowKey(
@@ -100,6 +101,9 @@ func Invoke(
 			req.Request.Execution.RunId,
 		),
 		locks.PriorityHigh,
+		// only pending activities and children are described
+		persistence.MutableStateTimerInfos|persistence.MutableStateRequestCancelInfos|
+			persistence.MutableStateSignalInfos|persistence.MutableStateSignalRequestedIDs,
 	)
 	if err != nil {
 		return nil, err
@@ -465,7 +469,16 @@ func buildNexusOperationCancellationInfo(
 
 	state := cancelation.State()
 	blockedReason := ""
-	if state == enumspb.NEXUS_OPERATION_CANCELLATION_STATE_SCHEDULED {
+	switch state {
+	case enumspb.NEXUS_OPERATION_CANCELLATION_STATE_UNSPECIFIED:
+		// The cancelation is buffered until the handler starts the operation and its ID is known. Operations which
+		// completed before they started never deliver the cancelation, which is reported as is.
+		switch op.State() {
+		case enumsspb.NEXUS_OPERATION_STATE_SCHEDULED, enumsspb.NEXUS_OPERATION_STATE_BACKING_OFF:
+			state = enumspb.NEXUS_OPERATION_CANCELLATION_STATE_BLOCKED
+			blockedReason = "Cancelation will be requested once the operation is started."
+		}
+	case enumspb.NEXUS_OPERATION_CANCELLATION_STATE_SCHEDULED:
 		cb := outboundQueueCBPool.Get(tasks.TaskGroupNamespaceIDAndDestination{
 			TaskGroup:   nexusoperations.TaskTypeCancelation,
 			NamespaceID: namespaceID.String(),
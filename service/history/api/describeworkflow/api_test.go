@@ -0,0 +1,119 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package describeworkflow
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/components/nexusoperations"
+	"go.temporal.io/server/service/history/hsm"
+	"go.temporal.io/server/service/history/hsm/hsmtest"
+	"go.temporal.io/server/service/history/tests"
+	"go.temporal.io/server/service/history/workflow"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type root struct{}
+
+func (root) IsWorkflowExecutionRunning() bool {
+	return true
+}
+
+func newOperationNode(t *testing.T) *hsm.Node {
+	reg := hsm.NewRegistry()
+	require.NoError(t, workflow.RegisterStateMachine(reg))
+	require.NoError(t, nexusoperations.RegisterStateMachines(reg))
+	rootNode, err := hsm.NewRoot(reg, workflow.StateMachineType, root{}, make(map[string]*persistencespb.StateMachineMap), &hsmtest.NodeBackend{})
+	require.NoError(t, err)
+
+	event := &historypb.HistoryEvent{
+		EventType: enumspb.EVENT_TYPE_NEXUS_OPERATION_SCHEDULED,
+		EventId:   1,
+		EventTime: timestamppb.Now(),
+		Attributes: &historypb.HistoryEvent_NexusOperationScheduledEventAttributes{
+			NexusOperationScheduledEventAttributes: &historypb.NexusOperationScheduledEventAttributes{
+				EndpointId: "endpoint-id",
+				Endpoint:   "endpoint",
+				Service:    "service",
+				Operation:  "operation",
+			},
+		},
+	}
+	token, err := hsm.GenerateEventLoadToken(event)
+	require.NoError(t, err)
+	node, err := nexusoperations.AddChild(rootNode, fmt.Sprintf("%d", event.EventId), event, token, false)
+	require.NoError(t, err)
+	return node
+}
+
+func TestBuildNexusOperationCancellationInfo_Buffered(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		state         enumsspb.NexusOperationState
+		expectedState enumspb.NexusOperationCancellationState
+		blocked       bool
+	}{
+		{"scheduled", enumsspb.NEXUS_OPERATION_STATE_SCHEDULED, enumspb.NEXUS_OPERATION_CANCELLATION_STATE_BLOCKED, true},
+		{"backing off", enumsspb.NEXUS_OPERATION_STATE_BACKING_OFF, enumspb.NEXUS_OPERATION_CANCELLATION_STATE_BLOCKED, true},
+		{"succeeded", enumsspb.NEXUS_OPERATION_STATE_SUCCEEDED, enumspb.NEXUS_OPERATION_CANCELLATION_STATE_UNSPECIFIED, false},
+		{"failed", enumsspb.NEXUS_OPERATION_STATE_FAILED, enumspb.NEXUS_OPERATION_CANCELLATION_STATE_UNSPECIFIED, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			node := newOperationNode(t)
+			op, err := hsm.MachineData[nexusoperations.Operation](node)
+			require.NoError(t, err)
+			op.NexusOperationInfo.State = tc.state
+			_, err = op.Cancel(node, time.Now())
+			require.NoError(t, err)
+
+			// The circuit breaker pool is only consulted for scheduled cancelations.
+			info, err := buildNexusOperationCancellationInfo(tests.NamespaceID, node, op, nil)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedState, info.GetState())
+			if tc.blocked {
+				require.NotEmpty(t, info.GetBlockedReason())
+			} else {
+				require.Empty(t, info.GetBlockedReason())
+			}
+		})
+	}
+}
+
+func TestBuildNexusOperationCancellationInfo_NotRequested(t *testing.T) {
+	node := newOperationNode(t)
+	op, err := hsm.MachineData[nexusoperations.Operation](node)
+	require.NoError(t, err)
+
+	info, err := buildNexusOperationCancellationInfo(tests.NamespaceID, node, op, nil)
+	require.NoError(t, err)
+	require.Nil(t, info)
+}
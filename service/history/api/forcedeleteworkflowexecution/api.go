@@ -76,6 +76,8 @@ func Invoke(
 		NamespaceID: request.NamespaceId,
 		WorkflowID:  execution.WorkflowId,
 		RunID:       execution.RunId,
+		// only the version histories of the execution info are needed
+		OmitCollections: persistence.MutableStateAllCollections,
 	})
 	if err != nil {
 		if common.IsContextCanceledErr(err) || common.IsContextDeadlineExceededErr(err) {
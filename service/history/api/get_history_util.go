@@ -127,7 +127,7 @@ func GetHistory(
 		PageSize:      int(pageSize),
 		NextPageToken: nextPageToken,
 		ShardID:       shardID,
-	})
+	}, shard.GetConfig().HistoryReadMaxPageBytes())
 	switch err.(type) {
 	case nil:
 		// noop
@@ -188,10 +188,14 @@ func Invoke(
 
 	history := &historypb.History{}
 	history.Events = []*historypb.HistoryEvent{}
+	// Raw history is never deserialized by the server, so it would reach clients with the payloads compressed when they
+	// were written to history.
+	sendRawHistory := shardContext.GetConfig().SendRawWorkflowHistory(request.Request.GetNamespace()) &&
+		shardContext.GetConfig().HistoryPayloadCompressionThreshold(request.Request.GetNamespace()) <= 0
 	var historyBlob []*commonpb.DataBlob
 	if isCloseEventOnly {
 		if !isWorkflowRunning {
-			if shardContext.GetConfig().SendRawWorkflowHistory(request.Request.GetNamespace()) {
+			if sendRawHistory {
 				historyBlob, _, err = api.GetRawHistory(
 					ctx,
 					shardContext,
@@ -246,7 +250,7 @@ func Invoke(
 				continuationToken = nil
 			}
 		} else {
-			if shardContext.GetConfig().SendRawWorkflowHistory(request.Request.GetNamespace()) {
+			if sendRawHistory {
 				historyBlob, continuationToken.PersistenceToken, err = api.GetRawHistory(
 					ctx,
 					shardContext,
@@ -0,0 +1,153 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package multisignalworkflow
+
+import (
+	"context"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/consts"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/workflow"
+)
+
+func Invoke(
+	ctx context.Context,
+	req *historyservice.MultiSignalWorkflowExecutionRequest,
+	shard shard.Context,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+) (resp *historyservice.MultiSignalWorkflowExecutionResponse, retError error) {
+	namespaceEntry, err := api.GetActiveNamespace(shard, namespace.ID(req.GetNamespaceId()))
+	if err != nil {
+		return nil, err
+	}
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+	namespaceName := namespaceEntry.Name().String()
+	maxAllowedSignals := shard.GetConfig().MaximumSignalsPerExecution(namespaceName)
+	rejectUnknownHandlers := shard.GetConfig().RejectUnknownWorkflowHandlers(namespaceName)
+
+	err = api.GetAndUpdateWorkflowWithNew(
+		ctx,
+		nil,
+		definition.NewWorkflowKey(
+			req.GetNamespaceId(),
+			req.GetWorkflowExecution().GetWorkflowId(),
+			req.GetWorkflowExecution().GetRunId(),
+		),
+		func(workflowLease api.WorkflowLease) (*api.UpdateWorkflowAction, error) {
+			mutableState := workflowLease.GetMutableState()
+			if req.GetRequestId() != "" && mutableState.IsSignalRequested(req.GetRequestId()) {
+				return &api.UpdateWorkflowAction{
+					Noop:               true,
+					CreateWorkflowTask: false,
+				}, nil
+			}
+
+			releaseFn := workflowLease.GetReleaseFn()
+			if !mutableState.IsWorkflowExecutionRunning() {
+				// in-memory mutable state is still clean, release the lock with nil error to prevent
+				// clearing and reloading mutable state
+				releaseFn(nil)
+				return nil, consts.ErrWorkflowCompleted
+			}
+
+			// Validate all signals before adding any event, so that either all or none of them are recorded.
+			executionInfo := mutableState.GetExecutionInfo()
+			if maxAllowedSignals > 0 && int(executionInfo.SignalCount)+len(req.GetSignalRequests()) > maxAllowedSignals {
+				releaseFn(nil)
+				return nil, consts.ErrSignalsLimitExceeded
+			}
+			for _, request := range req.GetSignalRequests() {
+				if rejectUnknownHandlers &&
+					!workflow.HasSignalHandler(executionInfo.GetWorkflowHandlers(), request.GetSignalName()) {
+					releaseFn(nil)
+					return nil, consts.ErrSignalHandlerNotFound
+				}
+				if err := api.ValidateSignal(
+					ctx,
+					shard,
+					mutableState,
+					request.GetInput().Size(),
+					"MultiSignalWorkflowExecution",
+				); err != nil {
+					releaseFn(nil)
+					return nil, err
+				}
+			}
+
+			if req.GetRequestId() != "" {
+				mutableState.AddSignalRequested(req.GetRequestId())
+			}
+			for _, request := range req.GetSignalRequests() {
+				if _, err := mutableState.AddWorkflowExecutionSignaledEvent(
+					request.GetSignalName(),
+					request.GetInput(),
+					request.GetIdentity(),
+					request.GetHeader(),
+					nil,
+					request.GetLinks(),
+				); err != nil {
+					return nil, err
+				}
+			}
+
+			return &api.UpdateWorkflowAction{
+				Noop: false,
+				// Do not create workflow task when the workflow has first workflow task backoff and execution is not started yet
+				CreateWorkflowTask: !mutableState.IsWorkflowPendingOnWorkflowTaskBackoff(),
+			}, nil
+		},
+		nil,
+		shard,
+		workflowConsistencyChecker,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &historyservice.MultiSignalWorkflowExecutionResponse{}, nil
+}
+
+func validateRequest(req *historyservice.MultiSignalWorkflowExecutionRequest) error {
+	if len(req.GetSignalRequests()) == 0 {
+		return serviceerror.NewInvalidArgument("No signals set on request.")
+	}
+	execution := req.GetWorkflowExecution()
+	for _, request := range req.GetSignalRequests() {
+		if request.GetWorkflowExecution().GetWorkflowId() != execution.GetWorkflowId() ||
+			request.GetWorkflowExecution().GetRunId() != execution.GetRunId() {
+			return serviceerror.NewInvalidArgument("All signals must target the workflow execution of the request.")
+		}
+		if request.GetSignalName() == "" {
+			return serviceerror.NewInvalidArgument("SignalName is not set on request.")
+		}
+	}
+	return nil
+}
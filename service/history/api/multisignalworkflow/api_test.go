@@ -0,0 +1,169 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package multisignalworkflow
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/cluster/clustertest"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/locks"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/configs"
+	"go.temporal.io/server/service/history/consts"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tests"
+	"go.temporal.io/server/service/history/workflow"
+	wcache "go.temporal.io/server/service/history/workflow/cache"
+	"go.uber.org/mock/gomock"
+)
+
+type (
+	multiSignalWorkflowSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller        *gomock.Controller
+		shardContext      *shard.MockContext
+		config            *configs.Config
+		namespaceRegistry *namespace.MockRegistry
+
+		workflowCache              *wcache.MockCache
+		workflowConsistencyChecker api.WorkflowConsistencyChecker
+
+		currentContext      *workflow.MockContext
+		currentMutableState *workflow.MockMutableState
+	}
+)
+
+func TestMultiSignalWorkflowSuite(t *testing.T) {
+	s := new(multiSignalWorkflowSuite)
+	suite.Run(t, s)
+}
+
+func (s *multiSignalWorkflowSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.namespaceRegistry = namespace.NewMockRegistry(s.controller)
+	s.namespaceRegistry.EXPECT().GetNamespaceByID(tests.GlobalNamespaceEntry.ID()).Return(tests.GlobalNamespaceEntry, nil).AnyTimes()
+
+	s.config = tests.NewDynamicConfig()
+	s.shardContext = shard.NewMockContext(s.controller)
+	s.shardContext.EXPECT().GetConfig().Return(s.config).AnyTimes()
+	s.shardContext.EXPECT().GetLogger().Return(log.NewTestLogger()).AnyTimes()
+	s.shardContext.EXPECT().GetThrottledLogger().Return(log.NewTestLogger()).AnyTimes()
+	s.shardContext.EXPECT().GetMetricsHandler().Return(metrics.NoopMetricsHandler).AnyTimes()
+	s.shardContext.EXPECT().GetTimeSource().Return(clock.NewRealTimeSource()).AnyTimes()
+	s.shardContext.EXPECT().GetNamespaceRegistry().Return(s.namespaceRegistry).AnyTimes()
+	s.shardContext.EXPECT().GetClusterMetadata().Return(clustertest.NewMetadataForTest(cluster.NewTestClusterMetadataConfig(true, true))).AnyTimes()
+
+	s.currentMutableState = workflow.NewMockMutableState(s.controller)
+	s.currentMutableState.EXPECT().GetNamespaceEntry().Return(tests.GlobalNamespaceEntry).AnyTimes()
+	s.currentMutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{
+		WorkflowId:  tests.WorkflowID,
+		SignalCount: 9,
+	}).AnyTimes()
+	s.currentMutableState.EXPECT().GetExecutionState().Return(&persistencespb.WorkflowExecutionState{
+		RunId: tests.RunID,
+	}).AnyTimes()
+
+	s.currentContext = workflow.NewMockContext(s.controller)
+	s.currentContext.EXPECT().LoadMutableState(gomock.Any(), s.shardContext).Return(s.currentMutableState, nil).AnyTimes()
+
+	s.workflowCache = wcache.NewMockCache(s.controller)
+	s.workflowCache.EXPECT().GetOrCreateWorkflowExecution(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), locks.PriorityHigh).
+		Return(s.currentContext, wcache.NoopReleaseFn, nil).AnyTimes()
+
+	s.workflowConsistencyChecker = api.NewWorkflowConsistencyChecker(
+		s.shardContext,
+		s.workflowCache,
+	)
+}
+
+func (s *multiSignalWorkflowSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *multiSignalWorkflowSuite) TestMultiSignalWorkflow_SignalsLimitExceeded() {
+	s.config.MaximumSignalsPerExecution = dynamicconfig.GetIntPropertyFnFilteredByNamespace(10)
+	s.currentMutableState.EXPECT().IsWorkflowExecutionRunning().Return(true)
+	s.currentMutableState.EXPECT().AddWorkflowExecutionSignaledEvent(
+		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+	).Times(0)
+
+	resp, err := Invoke(
+		context.Background(),
+		s.newRequest("first", "second"),
+		s.shardContext,
+		s.workflowConsistencyChecker,
+	)
+	s.Nil(resp)
+	s.ErrorIs(err, consts.ErrSignalsLimitExceeded)
+}
+
+func (s *multiSignalWorkflowSuite) TestValidateRequest() {
+	s.NoError(validateRequest(s.newRequest("first", "second")))
+
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(validateRequest(s.newRequest()), &invalidArgument)
+	s.ErrorAs(validateRequest(s.newRequest("first", "")), &invalidArgument)
+
+	req := s.newRequest("first", "second")
+	req.SignalRequests[1].WorkflowExecution = &commonpb.WorkflowExecution{WorkflowId: "other-workflow-id"}
+	s.ErrorAs(validateRequest(req), &invalidArgument)
+}
+
+func (s *multiSignalWorkflowSuite) newRequest(signalNames ...string) *historyservice.MultiSignalWorkflowExecutionRequest {
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: tests.WorkflowID,
+		RunId:      tests.RunID,
+	}
+	req := &historyservice.MultiSignalWorkflowExecutionRequest{
+		NamespaceId:       tests.NamespaceID.String(),
+		WorkflowExecution: execution,
+	}
+	for _, name := range signalNames {
+		req.SignalRequests = append(req.SignalRequests, &workflowservice.SignalWorkflowExecutionRequest{
+			Namespace:         tests.Namespace.String(),
+			WorkflowExecution: execution,
+			SignalName:        name,
+		})
+	}
+	return req
+}
@@ -32,6 +32,7 @@ import (
 	querypb "go.temporal.io/api/query/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/common"
@@ -120,6 +121,25 @@ func Invoke(
 	}
 
 	mutableState := workflowLease.GetMutableState()
+	if mutableStateStatus == enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+		for _, condition := range request.GetExtendedQueryRejectConditions() {
+			if shouldRejectQuery(mutableState, condition) {
+				return &historyservice.QueryWorkflowResponse{
+					Response: &workflowservice.QueryWorkflowResponse{
+						QueryRejected: &querypb.QueryRejected{
+							Status: mutableStateStatus,
+						},
+					},
+				}, nil
+			}
+		}
+	}
+
+	if resp, ok, err := queryDurableResult(mutableState, req.GetQuery()); ok {
+		metrics.DurableQueryResultServedCount.With(scope).Record(1)
+		return resp, err
+	}
+
 	if !mutableState.IsWorkflowExecutionRunning() && !mutableState.HasCompletedAnyWorkflowTask() {
 		// Workflow was closed before WorkflowTaskStarted event. In this case query will fail.
 		return nil, consts.ErrWorkflowClosedBeforeWorkflowTaskStarted
@@ -198,6 +218,8 @@ func Invoke(
 	queryID, completionCh := queryReg.BufferQuery(req.GetQuery())
 	defer queryReg.RemoveQuery(queryID)
 	workflowLease.GetReleaseFn()(nil)
+	ctx, cancel := withQueryTimeout(ctx, shardContext.GetConfig().QueryStrongConsistencyTimeout(nsEntry.Name().String()))
+	defer cancel()
 	select {
 	case <-completionCh:
 		completionState, err := queryReg.GetCompletionState(queryID)
@@ -250,6 +272,14 @@ func Invoke(
 	}
 }
 
+// withQueryTimeout shortens the deadline of ctx to the configured query timeout, if one is set.
+func withQueryTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
 func queryWillTimeoutsBeforeFirstWorkflowTaskStart(
 	ctx context.Context, mutableState workflow.MutableState,
 ) (bool, error) {
@@ -290,6 +320,8 @@ func queryDirectlyThroughMatching(
 	defer func() {
 		metrics.DirectQueryDispatchLatency.With(metricsHandler).Record(time.Since(startTime))
 	}()
+	ctx, cancel := withQueryTimeout(ctx, shard.GetConfig().QueryEventualConsistencyTimeout(queryRequest.GetNamespace()))
+	defer cancel()
 
 	directive := worker_versioning.MakeDirectiveForWorkflowTask(
 		msResp.GetInheritedBuildId(),
@@ -370,3 +402,49 @@ func queryDirectlyThroughMatching(
 			QueryRejected: matchingResp.GetQueryRejected(),
 		}}, err
 }
+
+func shouldRejectQuery(
+	mutableState workflow.MutableState,
+	condition enumsspb.QueryRejectCondition,
+) bool {
+	switch condition {
+	case enumsspb.QUERY_REJECT_CONDITION_WORKFLOW_TASK_FAILING:
+		return mutableState.GetExecutionInfo().WorkflowTaskAttempt > 1
+	case enumsspb.QUERY_REJECT_CONDITION_WORKFLOW_TASK_BACKOFF:
+		return mutableState.IsWorkflowPendingOnWorkflowTaskBackoff()
+	case enumsspb.QUERY_REJECT_CONDITION_ACTIVITY_PAUSED:
+		for _, ai := range mutableState.GetPendingActivityInfos() {
+			if ai.GetPaused() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// queryDurableResult answers a query of a closed workflow from the final query results stored when it closed. Since
+// only the final value is stored, queries with arguments are always sent to a worker.
+func queryDurableResult(
+	mutableState workflow.MutableState,
+	query *querypb.WorkflowQuery,
+) (*historyservice.QueryWorkflowResponse, bool, error) {
+	if mutableState.IsWorkflowExecutionRunning() || query.GetQueryArgs() != nil {
+		return nil, false, nil
+	}
+	result, ok := mutableState.GetExecutionInfo().GetDurableQueryResults()[query.GetQueryType()]
+	if !ok {
+		return nil, false, nil
+	}
+	switch result.GetResultType() {
+	case enumspb.QUERY_RESULT_TYPE_ANSWERED:
+		return &historyservice.QueryWorkflowResponse{
+			Response: &workflowservice.QueryWorkflowResponse{
+				QueryResult: result.GetAnswer(),
+			},
+		}, true, nil
+	case enumspb.QUERY_RESULT_TYPE_FAILED:
+		return nil, true, serviceerror.NewQueryFailedWithFailure(result.GetErrorMessage(), result.GetFailure())
+	default:
+		return nil, false, nil
+	}
+}
@@ -28,13 +28,16 @@ import (
 	"context"
 
 	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/service/history/api"
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/workflow"
 )
 
 func Invoke(
@@ -98,13 +101,26 @@ func Invoke(
 				return nil, consts.ErrActivityTaskNotFound
 			}
 
+			cancelRequested = ai.CancelRequested
+
+			if heartbeatWriteSuppressed(shard, mutableState, ai) {
+				// The heartbeat is kept in the cached mutable state, so the heartbeat timeout is still measured from it.
+				mutableState.UpdateActivityHeartbeatTimeInMemory(ai)
+				metrics.ActivityHeartbeatWriteSuppressedCounter.With(shard.GetMetricsHandler()).Record(
+					1,
+					metrics.OperationTag(metrics.HistoryRecordActivityTaskHeartbeatScope),
+					metrics.NamespaceTag(mutableState.GetNamespaceEntry().Name().String()))
+				return &api.UpdateWorkflowAction{
+					Noop:               true,
+					CreateWorkflowTask: false,
+				}, nil
+			}
+
 			// update worker identity if available
 			if req.HeartbeatRequest.Identity != "" {
 				ai.RetryLastWorkerIdentity = req.HeartbeatRequest.Identity
 			}
 
-			cancelRequested = ai.CancelRequested
-
 			// Save progress and last HB reported time.
 			mutableState.UpdateActivityProgress(ai, request)
 
@@ -125,3 +141,23 @@ func Invoke(
 		CancelRequested: cancelRequested,
 	}, nil
 }
+
+// heartbeatWriteSuppressed returns true if the heartbeat arrives within history.activityHeartbeatPersistenceInterval
+// of the last persisted one. Suppressed heartbeats are only kept in memory, so if the mutable state is reloaded the
+// heartbeat timeout is measured from the last persisted heartbeat again. The interval is capped at half of the heartbeat
+// timeout to bound how early the activity can then time out.
+func heartbeatWriteSuppressed(
+	shard shard.Context,
+	mutableState workflow.MutableState,
+	ai *persistencespb.ActivityInfo,
+) bool {
+	interval := shard.GetConfig().ActivityHeartbeatPersistenceInterval(mutableState.GetNamespaceEntry().Name().String())
+	if heartbeatTimeout := timestamp.DurationValue(ai.HeartbeatTimeout); heartbeatTimeout > 0 {
+		interval = min(interval, heartbeatTimeout/2)
+	}
+	lastHeartbeatTime := mutableState.GetActivityLastPersistedHeartbeatTime(ai)
+	if interval <= 0 || lastHeartbeatTime.IsZero() {
+		return false
+	}
+	return shard.GetTimeSource().Now().Before(lastHeartbeatTime.Add(interval))
+}
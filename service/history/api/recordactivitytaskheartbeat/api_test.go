@@ -0,0 +1,160 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package recordactivitytaskheartbeat
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/service/history/events"
+	"go.temporal.io/server/service/history/hsm"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tests"
+	"go.temporal.io/server/service/history/workflow"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestHeartbeatWriteSuppressed(t *testing.T) {
+	controller := gomock.NewController(t)
+	timeSource := clock.NewEventTimeSource()
+	now := time.Now()
+	timeSource.Update(now)
+	shardContext := shard.NewTestContextWithTimeSource(
+		controller,
+		&persistencespb.ShardInfo{ShardId: 1, RangeId: 1},
+		tests.NewDynamicConfig(),
+		timeSource,
+	)
+	defer shardContext.StopForTest()
+	mutableState := workflow.NewMockMutableState(controller)
+	mutableState.EXPECT().GetNamespaceEntry().Return(tests.LocalNamespaceEntry).AnyTimes()
+	mutableState.EXPECT().GetActivityLastPersistedHeartbeatTime(gomock.Any()).DoAndReturn(
+		func(ai *persistencespb.ActivityInfo) time.Time {
+			return timestamp.TimeValue(ai.LastHeartbeatUpdateTime)
+		},
+	).AnyTimes()
+
+	ai := &persistencespb.ActivityInfo{HeartbeatTimeout: durationpb.New(time.Minute)}
+	shardContext.GetConfig().ActivityHeartbeatPersistenceInterval = dynamicconfig.GetDurationPropertyFnFilteredByNamespace(0)
+	ai.LastHeartbeatUpdateTime = timestamppb.New(now.Add(-time.Second))
+	require.False(t, heartbeatWriteSuppressed(shardContext, mutableState, ai))
+
+	shardContext.GetConfig().ActivityHeartbeatPersistenceInterval = dynamicconfig.GetDurationPropertyFnFilteredByNamespace(time.Hour)
+	require.True(t, heartbeatWriteSuppressed(shardContext, mutableState, ai))
+
+	// the interval is capped at half of the heartbeat timeout
+	ai.LastHeartbeatUpdateTime = timestamppb.New(now.Add(-30 * time.Second))
+	require.False(t, heartbeatWriteSuppressed(shardContext, mutableState, ai))
+
+	// the first heartbeat is always persisted
+	ai.LastHeartbeatUpdateTime = nil
+	require.False(t, heartbeatWriteSuppressed(shardContext, mutableState, ai))
+}
+
+func TestHeartbeatWriteSuppressed_IrregularHeartbeats(t *testing.T) {
+	controller := gomock.NewController(t)
+	timeSource := clock.NewEventTimeSource()
+	start := time.Now().UTC()
+	timeSource.Update(start)
+	shardContext := shard.NewTestContextWithTimeSource(
+		controller,
+		&persistencespb.ShardInfo{ShardId: 1, RangeId: 1},
+		tests.NewDynamicConfig(),
+		timeSource,
+	)
+	defer shardContext.StopForTest()
+	shardContext.Resource.ClusterMetadata.EXPECT().GetClusterID().Return(int64(1)).AnyTimes()
+	reg := hsm.NewRegistry()
+	require.NoError(t, workflow.RegisterStateMachine(reg))
+	shardContext.SetStateMachineRegistry(reg)
+	shardContext.GetConfig().ActivityHeartbeatPersistenceInterval = dynamicconfig.GetDurationPropertyFnFilteredByNamespace(time.Hour)
+
+	const scheduledEventID = 5
+	mutableState, err := workflow.NewMutableStateFromDB(
+		shardContext,
+		events.NewMockCache(controller),
+		shardContext.GetLogger(),
+		tests.LocalNamespaceEntry,
+		&persistencespb.WorkflowMutableState{
+			ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+				NamespaceId: tests.NamespaceID.String(),
+				WorkflowId:  tests.WorkflowID,
+			},
+			ExecutionState: &persistencespb.WorkflowExecutionState{
+				RunId:  tests.RunID,
+				State:  enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+				Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+			},
+			ActivityInfos: map[int64]*persistencespb.ActivityInfo{
+				scheduledEventID: {
+					ScheduledEventId:        scheduledEventID,
+					StartedEventId:          scheduledEventID + 1,
+					StartedTime:             timestamppb.New(start),
+					ActivityId:              "activity-id",
+					HeartbeatTimeout:        durationpb.New(10 * time.Second),
+					LastHeartbeatUpdateTime: timestamppb.New(start),
+				},
+			},
+			NextEventId: scheduledEventID + 2,
+		},
+		1,
+	)
+	require.NoError(t, err)
+	ai, ok := mutableState.GetActivityInfo(scheduledEventID)
+	require.True(t, ok)
+	heartbeatTimeout := func() time.Time {
+		for _, timerSequenceID := range workflow.NewTimerSequence(mutableState).LoadAndSortActivityTimers() {
+			if timerSequenceID.TimerType == enumspb.TIMEOUT_TYPE_HEARTBEAT {
+				return timerSequenceID.Timestamp
+			}
+		}
+		require.FailNow(t, "no heartbeat timer")
+		return time.Time{}
+	}
+
+	// The heartbeat is suppressed, but the heartbeat timeout is measured from it.
+	timeSource.Update(start.Add(4900 * time.Millisecond))
+	require.True(t, heartbeatWriteSuppressed(shardContext, mutableState, ai))
+	mutableState.UpdateActivityHeartbeatTimeInMemory(ai)
+	require.Equal(t, start.Add(14900*time.Millisecond), heartbeatTimeout())
+
+	// The next heartbeat arrives after the heartbeat timeout of the persisted heartbeat, but within the heartbeat
+	// timeout of the suppressed one. The suppressed heartbeat does not extend the suppression interval, so it is
+	// persisted.
+	timeSource.Update(start.Add(12900 * time.Millisecond))
+	require.True(t, heartbeatTimeout().After(timeSource.Now()))
+	require.False(t, heartbeatWriteSuppressed(shardContext, mutableState, ai))
+	mutableState.UpdateActivityProgress(ai, &workflowservice.RecordActivityTaskHeartbeatRequest{})
+	require.Equal(t, start.Add(12900*time.Millisecond), mutableState.GetActivityLastPersistedHeartbeatTime(ai))
+	require.Equal(t, start.Add(22900*time.Millisecond), heartbeatTimeout())
+}
@@ -58,6 +58,7 @@ func Invoke(
 	var err error
 	response := &historyservice.RecordActivityTaskStartedResponse{}
 	var startedTransition bool
+	var postponed bool
 
 	err = api.GetAndUpdateWorkflowWithNew(
 		ctx,
@@ -73,6 +74,11 @@ func Invoke(
 				return nil, consts.ErrWorkflowCompleted
 			}
 
+			postponed, err = postponeIfActivityTypePaused(shardContext, mutableState, request)
+			if err != nil || postponed {
+				return &api.UpdateWorkflowAction{}, err
+			}
+
 			response, startedTransition, err = recordActivityTaskStarted(ctx, shardContext, mutableState, request, matchingClient)
 			if err != nil {
 				return nil, err
@@ -95,6 +101,11 @@ func Invoke(
 		return nil, err
 	}
 
+	if postponed {
+		// Matching can drop the task, the activity is dispatched again once its type is unpaused.
+		return nil, serviceerrors.NewObsoleteMatchingTask("activity type is paused")
+	}
+
 	if startedTransition {
 		// Rejecting the activity start because the workflow is now in transition. Matching can drop
 		// the task, new activity task will be scheduled after transition completion.
@@ -103,6 +114,24 @@ func Invoke(
 	return response, err
 }
 
+// postponeIfActivityTypePaused postpones the activity if its type is paused in the namespace. Matching may still
+// dispatch tasks it received before the activity type was paused.
+func postponeIfActivityTypePaused(
+	shardContext shard.Context,
+	mutableState workflow.MutableState,
+	request *historyservice.RecordActivityTaskStartedRequest,
+) (bool, error) {
+	ai, ok := mutableState.GetActivityInfo(request.GetScheduledEventId())
+	if !ok || ai.StartedEventId != common.EmptyEventID || ai.Stamp != request.Stamp {
+		// left to recordActivityTaskStarted
+		return false, nil
+	}
+	if !mutableState.GetNamespaceEntry().IsActivityTypePaused(ai.ActivityType.GetName()) {
+		return false, nil
+	}
+	return true, workflow.PostponePausedActivity(shardContext, mutableState, ai)
+}
+
 func recordActivityTaskStarted(
 	ctx context.Context,
 	shardContext shard.Context,
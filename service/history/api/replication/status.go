@@ -52,7 +52,24 @@ func GetStatus(
 	if err != nil {
 		return nil, err
 	}
+	setOldestPendingTasks(replicationAckMgr, remoteClusters, request.GetNamespaceId())
 	resp.RemoteClusters = remoteClusters
 	resp.HandoverNamespaces = handoverNamespaces
 	return resp, nil
 }
+
+// setOldestPendingTasks sets the visibility time of the oldest replication task, of the namespace if any, not acked
+// by each remote cluster yet, which is what the replication lag of the cluster is measured from. It is taken from the
+// pending tasks cached by the ack manager and left unset until those were read.
+func setOldestPendingTasks(
+	replicationAckMgr replication.AckManager,
+	remoteClusters map[string]*historyservice.ShardReplicationStatusPerCluster,
+	namespaceID string,
+) {
+	pendingTasks, truncated := replicationAckMgr.GetPendingTasks()
+	for _, status := range remoteClusters {
+		if task, ok := replication.OldestPendingTask(pendingTasks, truncated, status.GetAckedTaskId(), namespaceID); ok {
+			status.OldestPendingTaskVisibilityTime = timestamppb.New(task.GetVisibilityTime())
+		}
+	}
+}
@@ -44,12 +44,35 @@ func MergeDLQ(
 		return nil, consts.ErrUnknownCluster
 	}
 
+	filter := replication.DLQMessageFilter{
+		NamespaceID: request.GetNamespaceId(),
+		WorkflowID:  request.GetWorkflowId(),
+	}
+	if request.GetDryRun() {
+		previews, token, err := replicationDLQHandler.PreviewMergeMessages(
+			ctx,
+			request.GetSourceCluster(),
+			request.GetInclusiveEndMessageId(),
+			int(request.GetMaximumPageSize()),
+			request.GetNextPageToken(),
+			filter,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return &historyservice.MergeDLQMessagesResponse{
+			NextPageToken: token,
+			TaskPreviews:  previews,
+		}, nil
+	}
+
 	token, err := replicationDLQHandler.MergeMessages(
 		ctx,
 		request.GetSourceCluster(),
 		request.GetInclusiveEndMessageId(),
 		int(request.GetMaximumPageSize()),
 		request.GetNextPageToken(),
+		filter,
 	)
 	if err != nil {
 		return nil, err
@@ -27,12 +27,16 @@ package respondworkflowtaskcompleted
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
 
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 	protocolpb "go.temporal.io/api/protocol/v1"
 	querypb "go.temporal.io/api/query/v1"
+	sdkpb "go.temporal.io/api/sdk/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
@@ -49,6 +53,7 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/visibility/manager"
@@ -368,7 +373,7 @@ func (handler *WorkflowTaskCompletedHandler) Invoke(
 				memoSizeLimitWarn:              handler.config.MemoSizeLimitWarn(namespace.String()),
 				memoSizeLimitError:             handler.config.MemoSizeLimitError(namespace.String()),
 				numPendingChildExecutionsLimit: handler.config.NumPendingChildExecutionsLimit(namespace.String()),
-				numPendingActivitiesLimit:      handler.config.NumPendingActivitiesLimit(namespace.String()),
+				numPendingActivitiesLimit:      handler.numPendingActivitiesLimit(namespace, ms.GetExecutionInfo().GetWorkflowTypeName()),
 				numPendingSignalsLimit:         handler.config.NumPendingSignalsLimit(namespace.String()),
 				numPendingCancelsRequestLimit:  handler.config.NumPendingCancelsRequestLimit(namespace.String()),
 			},
@@ -571,6 +576,13 @@ func (handler *WorkflowTaskCompletedHandler) Invoke(
 		}
 	}
 
+	if wtFailedCause == nil && !ms.IsWorkflowExecutionRunning() {
+		handler.storeDurableQueryResults(ms, request.GetQueryResults(), namespaceEntry)
+	}
+	if wtFailedCause == nil {
+		handler.storeWorkflowHandlers(ms, request.GetQueryResults())
+	}
+
 	var updateErr error
 	if newMutableState != nil {
 		newWorkflowExecutionInfo := newMutableState.GetExecutionInfo()
@@ -920,6 +932,14 @@ func (handler *WorkflowTaskCompletedHandler) handleBufferedQueries(
 
 	// Complete or fail all queries we have results for
 	for id, result := range queryResults {
+		if strings.HasPrefix(id, workflow.DurableQueryResultIDPrefix) {
+			// not a buffered query, see storeDurableQueryResults
+			continue
+		}
+		if id == workflow.WorkflowMetadataQueryResultID {
+			// not a buffered query, see storeWorkflowHandlers
+			continue
+		}
 		if err := common.CheckEventBlobSizeLimit(
 			result.GetAnswer().Size(),
 			sizeLimitWarn,
@@ -991,6 +1011,79 @@ func (handler *WorkflowTaskCompletedHandler) handleBufferedQueries(
 	}
 }
 
+// storeDurableQueryResults stores the final query results reported by the workflow task which closed the workflow.
+// Results are stored in the order of their IDs until the size limit is reached, the remaining ones are dropped.
+func (handler *WorkflowTaskCompletedHandler) storeDurableQueryResults(
+	ms workflow.MutableState,
+	queryResults map[string]*querypb.WorkflowQueryResult,
+	namespaceEntry *namespace.Namespace,
+) {
+	sizeLimit := handler.config.DurableQueryResultsSizeLimit(namespaceEntry.Name().String())
+	var results map[string]*querypb.WorkflowQueryResult
+	totalSize := 0
+	for _, id := range slices.Sorted(maps.Keys(queryResults)) {
+		queryType, ok := strings.CutPrefix(id, workflow.DurableQueryResultIDPrefix)
+		if !ok || queryType == "" {
+			continue
+		}
+		result := queryResults[id]
+		if !isValidDurableQueryResult(result) {
+			continue
+		}
+		totalSize += len(queryType) + result.Size()
+		if totalSize > sizeLimit {
+			handler.logger.Warn("Dropping final query results exceeding size limit.",
+				tag.WorkflowNamespace(namespaceEntry.Name().String()),
+				tag.WorkflowID(ms.GetExecutionInfo().WorkflowId),
+				tag.WorkflowRunID(ms.GetExecutionState().GetRunId()),
+				tag.QueryID(id),
+				tag.WorkflowSize(int64(totalSize)),
+			)
+			break
+		}
+		if results == nil {
+			results = make(map[string]*querypb.WorkflowQueryResult)
+		}
+		results[queryType] = result
+	}
+	if len(results) > 0 {
+		ms.GetExecutionInfo().DurableQueryResults = results
+	}
+}
+
+// storeWorkflowHandlers records the signal and update handlers from the workflow metadata reported by the workflow
+// task, if any. Metadata which can't be decoded is ignored.
+func (handler *WorkflowTaskCompletedHandler) storeWorkflowHandlers(
+	ms workflow.MutableState,
+	queryResults map[string]*querypb.WorkflowQueryResult,
+) {
+	result, ok := queryResults[workflow.WorkflowMetadataQueryResultID]
+	if !ok || result.GetResultType() != enumspb.QUERY_RESULT_TYPE_ANSWERED || len(result.GetAnswer().GetPayloads()) == 0 {
+		return
+	}
+	metadata := &sdkpb.WorkflowMetadata{}
+	if err := payload.Decode(result.GetAnswer().GetPayloads()[0], metadata); err != nil {
+		handler.logger.Warn("Failed to decode workflow metadata reported by workflow task.",
+			tag.WorkflowID(ms.GetExecutionInfo().WorkflowId),
+			tag.WorkflowRunID(ms.GetExecutionState().GetRunId()),
+			tag.Error(err),
+		)
+		return
+	}
+	ms.GetExecutionInfo().WorkflowHandlers = workflow.NewWorkflowHandlers(metadata)
+}
+
+func isValidDurableQueryResult(result *querypb.WorkflowQueryResult) bool {
+	switch result.GetResultType() {
+	case enumspb.QUERY_RESULT_TYPE_ANSWERED:
+		return result.GetAnswer() != nil
+	case enumspb.QUERY_RESULT_TYPE_FAILED:
+		return result.GetErrorMessage() != ""
+	default:
+		return false
+	}
+}
+
 func failWorkflowTask(
 	ctx context.Context,
 	shardContext shard.Context,
@@ -1052,3 +1145,15 @@ func (handler *WorkflowTaskCompletedHandler) clearStickyTaskQueue(ctx context.Co
 	}
 	return nil
 }
+
+// numPendingActivitiesLimit returns the maximum number of pending activities of an execution, taking the limit of its
+// workflow type into account.
+func (handler *WorkflowTaskCompletedHandler) numPendingActivitiesLimit(
+	namespaceName namespace.Name,
+	workflowType string,
+) int {
+	if limit, ok := handler.config.WorkflowTypeNumPendingActivitiesLimit(namespaceName.String())[workflowType]; ok {
+		return limit
+	}
+	return handler.config.NumPendingActivitiesLimit(namespaceName.String())
+}
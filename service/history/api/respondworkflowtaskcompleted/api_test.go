@@ -35,10 +35,12 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	commandpb "go.temporal.io/api/command/v1"
+	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 	protocolpb "go.temporal.io/api/protocol/v1"
 	querypb "go.temporal.io/api/query/v1"
+	sdkpb "go.temporal.io/api/sdk/v1"
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	updatepb "go.temporal.io/api/update/v1"
@@ -50,10 +52,12 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/locks"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/testing/historyrequire"
@@ -536,6 +540,96 @@ func (s *WorkflowTaskCompletedHandlerSuite) TestHandleBufferedQueries() {
 	})
 }
 
+func (s *WorkflowTaskCompletedHandlerSuite) TestStoreDurableQueryResults() {
+	s.Run("Store Results", func() {
+		executionInfo := &persistencespb.WorkflowExecutionInfo{WorkflowId: tests.WorkflowID}
+		mockMutableState := workflow.NewMockMutableState(s.controller)
+		mockMutableState.EXPECT().GetExecutionInfo().Return(executionInfo).AnyTimes()
+		mockMutableState.EXPECT().GetExecutionState().Return(&persistencespb.WorkflowExecutionState{RunId: tests.RunID}).AnyTimes()
+
+		answered := &querypb.WorkflowQueryResult{
+			ResultType: enumspb.QUERY_RESULT_TYPE_ANSWERED,
+			Answer:     payloads.EncodeString("done"),
+		}
+		failed := &querypb.WorkflowQueryResult{
+			ResultType:   enumspb.QUERY_RESULT_TYPE_FAILED,
+			ErrorMessage: "unknown query",
+		}
+		s.workflowTaskCompletedHandler.storeDurableQueryResults(mockMutableState, map[string]*querypb.WorkflowQueryResult{
+			workflow.DurableQueryResultIDPrefix + "status":   answered,
+			workflow.DurableQueryResultIDPrefix + "progress": failed,
+			workflow.DurableQueryResultIDPrefix + "invalid":  {ResultType: enumspb.QUERY_RESULT_TYPE_ANSWERED},
+			workflow.DurableQueryResultIDPrefix:              answered,
+			"buffered-query-id":                              answered,
+			workflow.DurableQueryResultIDPrefix + "zz-large": {
+				ResultType: enumspb.QUERY_RESULT_TYPE_ANSWERED,
+				Answer:     payloads.EncodeBytes(make([]byte, 1024*1024)),
+			},
+		}, tests.GlobalNamespaceEntry)
+
+		s.Len(executionInfo.GetDurableQueryResults(), 2)
+		s.ProtoEqual(answered, executionInfo.GetDurableQueryResults()["status"])
+		s.ProtoEqual(failed, executionInfo.GetDurableQueryResults()["progress"])
+	})
+
+	s.Run("No Results", func() {
+		mockMutableState := workflow.NewMockMutableState(s.controller)
+		s.workflowTaskCompletedHandler.storeDurableQueryResults(mockMutableState, map[string]*querypb.WorkflowQueryResult{
+			"buffered-query-id": {ResultType: enumspb.QUERY_RESULT_TYPE_ANSWERED, Answer: payloads.EncodeString("done")},
+		}, tests.GlobalNamespaceEntry)
+	})
+}
+
+func (s *WorkflowTaskCompletedHandlerSuite) TestNumPendingActivitiesLimit() {
+	s.Run("Workflow Type Limit", func() {
+		config := s.workflowTaskCompletedHandler.config
+		config.NumPendingActivitiesLimit = dynamicconfig.GetIntPropertyFnFilteredByNamespace(10)
+		config.WorkflowTypeNumPendingActivitiesLimit = dynamicconfig.GetTypedPropertyFnFilteredByNamespace(map[string]int{"FanOutWorkflow": 2})
+
+		s.Equal(2, s.workflowTaskCompletedHandler.numPendingActivitiesLimit(tests.Namespace, "FanOutWorkflow"))
+		s.Equal(10, s.workflowTaskCompletedHandler.numPendingActivitiesLimit(tests.Namespace, "OtherWorkflow"))
+	})
+}
+
+func (s *WorkflowTaskCompletedHandlerSuite) TestStoreWorkflowHandlers() {
+	s.Run("Store Handlers", func() {
+		executionInfo := &persistencespb.WorkflowExecutionInfo{WorkflowId: tests.WorkflowID}
+		mockMutableState := workflow.NewMockMutableState(s.controller)
+		mockMutableState.EXPECT().GetExecutionInfo().Return(executionInfo).AnyTimes()
+
+		metadata, err := payload.Encode(&sdkpb.WorkflowMetadata{
+			Definition: &sdkpb.WorkflowDefinition{
+				SignalDefinitions: []*sdkpb.WorkflowInteractionDefinition{{Name: "cancel"}, {Name: ""}},
+				UpdateDefinitions: []*sdkpb.WorkflowInteractionDefinition{{Name: "setStatus"}},
+			},
+		})
+		s.NoError(err)
+		s.workflowTaskCompletedHandler.storeWorkflowHandlers(mockMutableState, map[string]*querypb.WorkflowQueryResult{
+			workflow.WorkflowMetadataQueryResultID: {
+				ResultType: enumspb.QUERY_RESULT_TYPE_ANSWERED,
+				Answer:     &commonpb.Payloads{Payloads: []*commonpb.Payload{metadata}},
+			},
+		})
+
+		s.ProtoEqual(&persistencespb.WorkflowHandlers{
+			SignalNames:          []string{"cancel"},
+			UpdateNames:          []string{"setStatus"},
+			DynamicSignalHandler: true,
+		}, executionInfo.GetWorkflowHandlers())
+	})
+
+	s.Run("No Metadata", func() {
+		mockMutableState := workflow.NewMockMutableState(s.controller)
+		s.workflowTaskCompletedHandler.storeWorkflowHandlers(mockMutableState, map[string]*querypb.WorkflowQueryResult{
+			"buffered-query-id": {ResultType: enumspb.QUERY_RESULT_TYPE_ANSWERED, Answer: payloads.EncodeString("done")},
+			workflow.WorkflowMetadataQueryResultID: {
+				ResultType:   enumspb.QUERY_RESULT_TYPE_FAILED,
+				ErrorMessage: "unknown query",
+			},
+		})
+	})
+}
+
 func (s *WorkflowTaskCompletedHandlerSuite) createStartedWorkflow(tv *testvars.TestVars) workflow.Context {
 	ms := workflow.TestLocalMutableState(s.workflowTaskCompletedHandler.shardContext, s.mockEventsCache, tv.Namespace(),
 		tv.WorkflowID(), tv.RunID(), log.NewTestLogger())
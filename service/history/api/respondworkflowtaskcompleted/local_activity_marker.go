@@ -0,0 +1,90 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package respondworkflowtaskcompleted
+
+import (
+	"time"
+
+	commandpb "go.temporal.io/api/command/v1"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/payloads"
+)
+
+const (
+	// localActivityMarkerName and localActivityMarkerDataKey follow the markers recorded by the Go SDK for local
+	// activities. Markers recorded by other SDKs aren't annotated.
+	localActivityMarkerName    = "LocalActivity"
+	localActivityMarkerDataKey = "data"
+
+	localActivityTypeHeaderKey     = "temporal-local-activity-type"
+	localActivityDurationHeaderKey = "temporal-local-activity-duration"
+	localActivityFailureHeaderKey  = "temporal-local-activity-failure"
+)
+
+type (
+	localActivityMarkerData struct {
+		ActivityID   string
+		ActivityType string
+		ReplayTime   time.Time
+		Attempt      int32
+	}
+)
+
+// annotateLocalActivityMarker adds the type, the approximate duration and the failure message of a local activity to
+// the header of its marker if the local activity ran for at least threshold, so that expensive local activities are
+// visible in history without decoding the marker details. The duration is measured from the workflow time at which
+// the local activity was scheduled, so it includes retry backoffs. The original attributes are left untouched since
+// they can be needed again in case of retry.
+func annotateLocalActivityMarker(
+	attr *commandpb.RecordMarkerCommandAttributes,
+	threshold time.Duration,
+	now time.Time,
+) (*commandpb.RecordMarkerCommandAttributes, time.Duration, bool) {
+	if threshold <= 0 || attr.GetMarkerName() != localActivityMarkerName {
+		return attr, 0, false
+	}
+	var data localActivityMarkerData
+	if err := payloads.Decode(attr.GetDetails()[localActivityMarkerDataKey], &data); err != nil || data.ReplayTime.IsZero() {
+		return attr, 0, false
+	}
+	duration := now.Sub(data.ReplayTime)
+	if duration < threshold {
+		return attr, 0, false
+	}
+
+	newAttr := common.CloneProto(attr)
+	if newAttr.Header == nil {
+		newAttr.Header = &commonpb.Header{}
+	}
+	if newAttr.Header.Fields == nil {
+		newAttr.Header.Fields = make(map[string]*commonpb.Payload)
+	}
+	newAttr.Header.Fields[localActivityTypeHeaderKey] = payload.EncodeString(data.ActivityType)
+	newAttr.Header.Fields[localActivityDurationHeaderKey] = payload.EncodeString(duration.String())
+	if failure := attr.GetFailure(); failure != nil {
+		newAttr.Header.Fields[localActivityFailureHeaderKey] = payload.EncodeString(failure.GetMessage())
+	}
+	return newAttr, duration, true
+}
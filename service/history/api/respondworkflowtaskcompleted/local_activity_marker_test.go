@@ -0,0 +1,79 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package respondworkflowtaskcompleted
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commandpb "go.temporal.io/api/command/v1"
+	commonpb "go.temporal.io/api/common/v1"
+	failurepb "go.temporal.io/api/failure/v1"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/payloads"
+)
+
+func TestAnnotateLocalActivityMarker(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	data, err := payloads.Encode(localActivityMarkerData{
+		ActivityID:   "1",
+		ActivityType: "Expensive",
+		ReplayTime:   now.Add(-time.Minute),
+		Attempt:      1,
+	})
+	require.NoError(t, err)
+	attr := &commandpb.RecordMarkerCommandAttributes{
+		MarkerName: localActivityMarkerName,
+		Details:    map[string]*commonpb.Payloads{localActivityMarkerDataKey: data},
+		Failure:    &failurepb.Failure{Message: "boom"},
+	}
+
+	newAttr, _, annotated := annotateLocalActivityMarker(attr, 0, now)
+	require.False(t, annotated)
+	require.Same(t, attr, newAttr)
+
+	newAttr, _, annotated = annotateLocalActivityMarker(attr, 2*time.Minute, now)
+	require.False(t, annotated)
+	require.Same(t, attr, newAttr)
+
+	newAttr, duration, annotated := annotateLocalActivityMarker(attr, time.Second, now)
+	require.True(t, annotated)
+	require.Equal(t, time.Minute, duration)
+	require.Nil(t, attr.GetHeader())
+	for key, expected := range map[string]string{
+		localActivityTypeHeaderKey:     "Expensive",
+		localActivityDurationHeaderKey: "1m0s",
+		localActivityFailureHeaderKey:  "boom",
+	} {
+		var value string
+		require.NoError(t, payload.Decode(newAttr.GetHeader().GetFields()[key], &value))
+		require.Equal(t, expected, value)
+	}
+
+	newAttr, _, annotated = annotateLocalActivityMarker(&commandpb.RecordMarkerCommandAttributes{MarkerName: "Version"}, time.Second, now)
+	require.False(t, annotated)
+	require.Nil(t, newAttr.GetHeader())
+}
@@ -57,6 +57,7 @@ import (
 	"go.temporal.io/server/internal/protocol"
 	"go.temporal.io/server/service/history/api"
 	"go.temporal.io/server/service/history/configs"
+	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/workflow"
 	"go.temporal.io/server/service/history/workflow/update"
@@ -568,6 +569,7 @@ func (handler *workflowTaskCompletedHandler) handlePostCommandEagerExecuteActivi
 		ai.Version,
 	)
 	serializedToken, err := handler.tokenSerializer.Serialize(taskToken)
+	tasktoken.Release(taskToken)
 	if err != nil {
 		return nil, err
 	}
@@ -906,6 +908,15 @@ func (handler *workflowTaskCompletedHandler) handleCommandRecordMarker(
 		return nil, handler.terminateWorkflow(enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_RECORD_MARKER_ATTRIBUTES, err)
 	}
 
+	attr, duration, annotated := annotateLocalActivityMarker(
+		attr,
+		handler.config.LocalActivityMarkerDurationThreshold(handler.mutableState.GetNamespaceEntry().Name().String()),
+		handler.shard.GetTimeSource().Now(),
+	)
+	if annotated {
+		metrics.LocalActivityMarkerDuration.With(handler.metricsHandler).Record(duration)
+	}
+
 	return handler.mutableState.AddRecordMarkerEvent(handler.workflowTaskCompletedID, attr)
 }
 
@@ -991,6 +1002,10 @@ func (handler *workflowTaskCompletedHandler) handleCommandContinueAsNewWorkflow(
 		return nil, nil
 	}
 
+	if handler.mutableState.IsChainLengthLimitReached() {
+		return nil, handler.terminateWorkflow(enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_CONTINUE_AS_NEW_ATTRIBUTES, consts.ErrChainLengthLimitExceeded)
+	}
+
 	// Extract parentNamespace, so it can be passed down to next run of workflow execution
 	var parentNamespace namespace.Name
 	if handler.mutableState.HasParentExecution() {
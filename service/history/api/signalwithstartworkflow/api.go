@@ -37,6 +37,7 @@ import (
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/service/history/api"
 	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/workflow"
 )
 
 func Invoke(
@@ -51,6 +52,10 @@ func Invoke(
 	}
 	namespaceID := namespaceEntry.ID()
 
+	if _, err := workflow.GetSignalDedupKey(signalWithStartRequest.SignalWithStartRequest.GetHeader()); err != nil {
+		return nil, err
+	}
+
 	var currentWorkflowLease api.WorkflowLease
 	currentWorkflowLease, err = workflowConsistencyChecker.GetWorkflowLease(
 		ctx,
@@ -314,6 +314,16 @@ func signalWorkflow(
 		workflowLease.GetReleaseFn()(nil)
 		return nil
 	}
+	// the dedup key header is validated by Invoke
+	dedupKey, _ := workflow.GetSignalDedupKey(request.GetHeader())
+	if shardContext.GetConfig().SignalDedupKeyTTL(request.GetNamespace()) > 0 &&
+		workflow.IsDuplicateSignal(mutableState.GetExecutionInfo(), dedupKey, shardContext.GetTimeSource().Now()) {
+		// duplicate signal
+		// in-memory mutable state is still clean, release the lock with nil error to prevent
+		// clearing and reloading mutable state
+		workflowLease.GetReleaseFn()(nil)
+		return nil
+	}
 	if request.GetRequestId() != "" {
 		mutableState.AddSignalRequested(request.GetRequestId())
 	}
@@ -29,10 +29,13 @@ import (
 
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/locks"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/service/history/api"
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/workflow"
 )
 
 func Invoke(
@@ -51,7 +54,15 @@ func Invoke(
 	externalWorkflowExecution := req.ExternalWorkflowExecution
 	childWorkflowOnly := req.GetChildWorkflowOnly()
 
-	err = api.GetAndUpdateWorkflowWithNew(
+	dedupKey, err := workflow.GetSignalDedupKey(request.GetHeader())
+	if err != nil {
+		return nil, err
+	}
+	dedupKeyTTL := shard.GetConfig().SignalDedupKeyTTL(namespaceEntry.Name().String())
+	rejectUnknownHandlers := shard.GetConfig().RejectUnknownWorkflowHandlers(namespaceEntry.Name().String())
+
+	// A signal only reads and updates the execution info, signal requested IDs and buffered events.
+	workflowLease, err := workflowConsistencyChecker.GetWorkflowLeaseWithoutCollections(
 		ctx,
 		nil,
 		definition.NewWorkflowKey(
@@ -59,6 +70,19 @@ func Invoke(
 			request.WorkflowExecution.WorkflowId,
 			request.WorkflowExecution.RunId,
 		),
+		locks.PriorityHigh,
+		persistence.MutableStateActivityInfos|persistence.MutableStateTimerInfos|persistence.MutableStateChildExecutionInfos|
+			persistence.MutableStateRequestCancelInfos|persistence.MutableStateSignalInfos,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { workflowLease.GetReleaseFn()(retError) }()
+
+	err = api.UpdateWorkflowWithNew(
+		shard,
+		ctx,
+		workflowLease,
 		func(workflowLease api.WorkflowLease) (*api.UpdateWorkflowAction, error) {
 			mutableState := workflowLease.GetMutableState()
 			if request.GetRequestId() != "" && mutableState.IsSignalRequested(request.GetRequestId()) {
@@ -76,6 +100,19 @@ func Invoke(
 				return nil, consts.ErrWorkflowCompleted
 			}
 
+			if dedupKeyTTL > 0 && workflow.IsDuplicateSignal(mutableState.GetExecutionInfo(), dedupKey, shard.GetTimeSource().Now()) {
+				return &api.UpdateWorkflowAction{
+					Noop:               true,
+					CreateWorkflowTask: false,
+				}, nil
+			}
+
+			if rejectUnknownHandlers &&
+				!workflow.HasSignalHandler(mutableState.GetExecutionInfo().GetWorkflowHandlers(), request.GetSignalName()) {
+				releaseFn(nil)
+				return nil, consts.ErrSignalHandlerNotFound
+			}
+
 			if err := api.ValidateSignal(
 				ctx,
 				shard,
@@ -123,8 +160,6 @@ func Invoke(
 			}, nil
 		},
 		nil,
-		shard,
-		workflowConsistencyChecker,
 	)
 	if err != nil {
 		return nil, err
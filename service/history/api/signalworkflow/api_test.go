@@ -27,27 +27,34 @@ package signalworkflow
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/cluster/clustertest"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/locks"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/tests"
 	"go.temporal.io/server/service/history/workflow"
 	wcache "go.temporal.io/server/service/history/workflow/cache"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type (
@@ -57,6 +64,7 @@ type (
 
 		controller        *gomock.Controller
 		shardContext      *shard.MockContext
+		config            *configs.Config
 		namespaceRegistry *namespace.MockRegistry
 
 		workflowCache              *wcache.MockCache
@@ -86,7 +94,8 @@ func (s *signalWorkflowSuite) SetupTest() {
 	s.namespaceRegistry.EXPECT().GetNamespaceByID(tests.GlobalNamespaceEntry.ID()).Return(tests.GlobalNamespaceEntry, nil).AnyTimes()
 
 	s.shardContext = shard.NewMockContext(s.controller)
-	s.shardContext.EXPECT().GetConfig().Return(tests.NewDynamicConfig()).AnyTimes()
+	s.config = tests.NewDynamicConfig()
+	s.shardContext.EXPECT().GetConfig().Return(s.config).AnyTimes()
 	s.shardContext.EXPECT().GetLogger().Return(log.NewTestLogger()).AnyTimes()
 	s.shardContext.EXPECT().GetThrottledLogger().Return(log.NewTestLogger()).AnyTimes()
 	s.shardContext.EXPECT().GetMetricsHandler().Return(metrics.NoopMetricsHandler).AnyTimes()
@@ -98,13 +107,24 @@ func (s *signalWorkflowSuite) SetupTest() {
 	s.currentMutableState.EXPECT().GetNamespaceEntry().Return(tests.GlobalNamespaceEntry).AnyTimes()
 	s.currentMutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{
 		WorkflowId: tests.WorkflowID,
+		SignalDedupKeys: map[string]*timestamppb.Timestamp{
+			"seen-key": timestamppb.New(time.Now().Add(time.Hour)),
+		},
+		WorkflowHandlers: &persistencespb.WorkflowHandlers{
+			SignalNames: []string{"signal-name"},
+		},
 	}).AnyTimes()
 	s.currentMutableState.EXPECT().GetExecutionState().Return(&persistencespb.WorkflowExecutionState{
 		RunId: tests.RunID,
 	}).AnyTimes()
 
 	s.currentContext = workflow.NewMockContext(s.controller)
-	s.currentContext.EXPECT().LoadMutableState(gomock.Any(), s.shardContext).Return(s.currentMutableState, nil).AnyTimes()
+	s.currentContext.EXPECT().LoadMutableStateWithoutCollections(
+		gomock.Any(),
+		s.shardContext,
+		persistence.MutableStateActivityInfos|persistence.MutableStateTimerInfos|persistence.MutableStateChildExecutionInfos|
+			persistence.MutableStateRequestCancelInfos|persistence.MutableStateSignalInfos,
+	).Return(s.currentMutableState, nil).AnyTimes()
 
 	s.workflowCache = wcache.NewMockCache(s.controller)
 	s.workflowCache.EXPECT().GetOrCreateWorkflowExecution(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), locks.PriorityHigh).
@@ -145,3 +165,81 @@ func (s *signalWorkflowSuite) TestSignalWorkflow_WorkflowCloseAttempted() {
 	s.Nil(resp)
 	s.Error(consts.ErrWorkflowClosing, err)
 }
+
+func (s *signalWorkflowSuite) TestSignalWorkflow_DuplicateDedupKey() {
+	s.currentMutableState.EXPECT().IsWorkflowExecutionRunning().Return(true)
+
+	resp, err := Invoke(
+		context.Background(),
+		&historyservice.SignalWorkflowExecutionRequest{
+			NamespaceId: tests.NamespaceID.String(),
+			SignalRequest: &workflowservice.SignalWorkflowExecutionRequest{
+				Namespace: tests.Namespace.String(),
+				WorkflowExecution: &commonpb.WorkflowExecution{
+					WorkflowId: tests.WorkflowID,
+					RunId:      tests.RunID,
+				},
+				SignalName: "signal-name",
+				Header: &commonpb.Header{
+					Fields: map[string]*commonpb.Payload{
+						workflow.SignalDedupKeyHeader: payload.EncodeString("seen-key"),
+					},
+				},
+			},
+		},
+		s.shardContext,
+		s.workflowConsistencyChecker,
+	)
+	s.NoError(err)
+	s.NotNil(resp)
+}
+
+func (s *signalWorkflowSuite) TestSignalWorkflow_UnknownHandler() {
+	s.config.RejectUnknownWorkflowHandlers = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true)
+	s.currentMutableState.EXPECT().IsWorkflowExecutionRunning().Return(true)
+
+	resp, err := Invoke(
+		context.Background(),
+		&historyservice.SignalWorkflowExecutionRequest{
+			NamespaceId: tests.NamespaceID.String(),
+			SignalRequest: &workflowservice.SignalWorkflowExecutionRequest{
+				Namespace: tests.Namespace.String(),
+				WorkflowExecution: &commonpb.WorkflowExecution{
+					WorkflowId: tests.WorkflowID,
+					RunId:      tests.RunID,
+				},
+				SignalName: "unknown-signal-name",
+			},
+		},
+		s.shardContext,
+		s.workflowConsistencyChecker,
+	)
+	s.Nil(resp)
+	s.ErrorIs(err, consts.ErrSignalHandlerNotFound)
+}
+
+func (s *signalWorkflowSuite) TestSignalWorkflow_InvalidDedupKey() {
+	_, err := Invoke(
+		context.Background(),
+		&historyservice.SignalWorkflowExecutionRequest{
+			NamespaceId: tests.NamespaceID.String(),
+			SignalRequest: &workflowservice.SignalWorkflowExecutionRequest{
+				Namespace: tests.Namespace.String(),
+				WorkflowExecution: &commonpb.WorkflowExecution{
+					WorkflowId: tests.WorkflowID,
+					RunId:      tests.RunID,
+				},
+				SignalName: "signal-name",
+				Header: &commonpb.Header{
+					Fields: map[string]*commonpb.Payload{
+						workflow.SignalDedupKeyHeader: payload.EncodeBytes([]byte("not-a-string")),
+					},
+				},
+			},
+		},
+		s.shardContext,
+		s.workflowConsistencyChecker,
+	)
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+}
@@ -666,6 +666,7 @@ func (s *Starter) generateResponse(
 		workflowTaskInfo.Version,
 	)
 	serializedToken, err := tokenSerializer.Serialize(taskToken)
+	tasktoken.Release(taskToken)
 	if err != nil {
 		return nil, err
 	}
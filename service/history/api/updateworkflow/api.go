@@ -170,6 +170,13 @@ func (u *Updater) ApplyRequest(
 		return nil, consts.ErrWorkflowClosing
 	}
 
+	// Fail fast if the worker reported that the workflow has no handler for a new update.
+	if u.shardCtx.GetConfig().RejectUnknownWorkflowHandlers(u.req.Request.Namespace) &&
+		updateReg.Find(ctx, updateID) == nil &&
+		!workflow.HasUpdateHandler(ms.GetExecutionInfo().GetWorkflowHandlers(), u.req.GetRequest().GetRequest().GetInput().GetName()) {
+		return nil, consts.ErrUpdateHandlerNotFound
+	}
+
 	var (
 		alreadyExisted bool
 		err            error
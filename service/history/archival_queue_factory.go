@@ -187,6 +187,7 @@ func (f *archivalQueueFactory) newScheduledQueue(shard shard.Context, executor q
 		f.Config.TaskDLQUnexpectedErrorAttempts,
 		f.Config.TaskDLQInternalErrors,
 		f.Config.TaskDLQErrorPattern,
+		f.executableTracingOption(shard.GetShardID()),
 	)
 	return queues.NewScheduledQueue(
 		shard,
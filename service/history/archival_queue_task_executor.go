@@ -101,6 +101,11 @@ func (e *archivalQueueTaskExecutor) Execute(
 		if err == ErrMutableStateIsNil || err == ErrWorkflowExecutionIsStillRunning {
 			// If either of these errors are returned, it means that we can just drop the task.
 			err = nil
+		} else if err == nil {
+			metrics.ArchivalTaskLag.With(e.metricsHandler).Record(
+				e.shardContext.GetTimeSource().Now().Sub(task.GetVisibilityTime()),
+				tags[0],
+			)
 		}
 	default:
 		err = fmt.Errorf("task with invalid type sent to archival queue: %+v", task)
@@ -356,6 +356,10 @@ func TestArchivalQueueTaskExecutor(t *testing.T) {
 			a := archival.NewMockArchiver(p.Controller)
 
 			shardContext.EXPECT().GetNamespaceRegistry().Return(namespaceRegistry).AnyTimes()
+			shardContext.EXPECT().GetTimeSource().Return(timeSource).AnyTimes()
+			mockLagTimer := metrics.NewMockTimerIface(p.Controller)
+			mockLagTimer.EXPECT().Record(gomock.Any(), metrics.NamespaceTag(tests.Namespace.String())).AnyTimes()
+			p.MetricsHandler.EXPECT().Timer("archival_task_lag").Return(mockLagTimer).AnyTimes()
 			cfg := tests.NewDynamicConfig()
 			cfg.RetentionTimerJitterDuration = func() time.Duration {
 				return 0
@@ -25,10 +25,14 @@
 package configs
 
 import (
+	"regexp"
+	"time"
+
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/retrypolicy"
+	"go.temporal.io/server/common/util"
 )
 
 // Config represents configuration for history service
@@ -65,6 +69,7 @@ type Config struct {
 	EnableStickyQuery          dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	ShutdownDrainDuration      dynamicconfig.DurationPropertyFn
 	StartupMembershipJoinDelay dynamicconfig.DurationPropertyFn
+	LazyPayloadDecoding        dynamicconfig.BoolPropertyFn
 
 	// Workflow reset related settings.
 	AllowResetWithPendingChildren dynamicconfig.BoolPropertyFnWithNamespaceFilter
@@ -73,6 +78,7 @@ type Config struct {
 	// HistoryCache settings
 	// Change of these configs require shard restart
 	HistoryCacheLimitSizeBased            bool
+	HistoryCacheFairEviction              bool
 	HistoryCacheInitialSize               dynamicconfig.IntPropertyFn
 	HistoryShardLevelCacheMaxSize         dynamicconfig.IntPropertyFn
 	HistoryShardLevelCacheMaxSizeBytes    dynamicconfig.IntPropertyFn
@@ -84,6 +90,7 @@ type Config struct {
 	EnableNexus                           dynamicconfig.BoolPropertyFn
 	EnableWorkflowExecutionTimeoutTimer   dynamicconfig.BoolPropertyFn
 	EnableTransitionHistory               dynamicconfig.BoolPropertyFn
+	HistoryReadMaxPageBytes               dynamicconfig.IntPropertyFn
 
 	// EventsCache settings
 	// Change of these configs require shard restart
@@ -94,14 +101,21 @@ type Config struct {
 	EventsHostLevelCacheMaxSizeBytes dynamicconfig.IntPropertyFn
 
 	// ShardController settings
-	RangeSizeBits                uint
-	AcquireShardInterval         dynamicconfig.DurationPropertyFn
-	AcquireShardConcurrency      dynamicconfig.IntPropertyFn
-	ShardIOConcurrency           dynamicconfig.IntPropertyFn
-	ShardIOTimeout               dynamicconfig.DurationPropertyFn
-	ShardLingerOwnershipCheckQPS dynamicconfig.IntPropertyFn
-	ShardLingerTimeLimit         dynamicconfig.DurationPropertyFn
-	ShardFinalizerTimeout        dynamicconfig.DurationPropertyFn
+	RangeSizeBits                     uint
+	AcquireShardInterval              dynamicconfig.DurationPropertyFn
+	AcquireShardConcurrency           dynamicconfig.IntPropertyFn
+	AcquireShardPrioritizeOldestTasks dynamicconfig.BoolPropertyFn
+	ShardIOConcurrency                dynamicconfig.IntPropertyFn
+	ShardIOTimeout                    dynamicconfig.DurationPropertyFn
+	ShardLingerOwnershipCheckQPS      dynamicconfig.IntPropertyFn
+	ShardLingerTimeLimit              dynamicconfig.DurationPropertyFn
+	ShardFinalizerTimeout             dynamicconfig.DurationPropertyFn
+	ShardOwnershipChangeLogSize       dynamicconfig.IntPropertyFn
+
+	HotspotDetectionWindow     dynamicconfig.DurationPropertyFn
+	HotspotMaxTrackedWorkflows dynamicconfig.IntPropertyFn
+	HotspotScoreFactor         dynamicconfig.FloatPropertyFn
+	HotWorkflowMinScore        dynamicconfig.FloatPropertyFn
 
 	HistoryClientOwnershipCachingEnabled dynamicconfig.BoolPropertyFn
 
@@ -193,6 +207,9 @@ type Config struct {
 	MaximumBufferedEventsBatch       dynamicconfig.IntPropertyFn
 	MaximumBufferedEventsSizeInBytes dynamicconfig.IntPropertyFn
 	MaximumSignalsPerExecution       dynamicconfig.IntPropertyFnWithNamespaceFilter
+	SignalDedupKeyTTL                dynamicconfig.DurationPropertyFnWithNamespaceFilter
+	SignalDedupKeysLimit             dynamicconfig.IntPropertyFnWithNamespaceFilter
+	RejectUnknownWorkflowHandlers    dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
 	// ShardUpdateMinInterval is the minimum time interval within which the shard info can be updated.
 	ShardUpdateMinInterval dynamicconfig.DurationPropertyFn
@@ -222,12 +239,23 @@ type Config struct {
 	ParentClosePolicyThreshold dynamicconfig.IntPropertyFnWithNamespaceFilter
 	// total number of parentClosePolicy system workflows
 	NumParentClosePolicySystemWorkflows dynamicconfig.IntPropertyFn
+	// max number of children in one request sent to parentClosePolicy system workflows
+	ParentClosePolicyBatchSize dynamicconfig.IntPropertyFn
+
+	// executions whose workflow tasks keep failing with the same cause are reported as stuck
+	StuckWorkflowTaskFailureThreshold dynamicconfig.IntPropertyFnWithNamespaceFilter
+	StuckWorkflowTaskMinDuration      dynamicconfig.DurationPropertyFnWithNamespaceFilter
+
+	// pending Nexus operations of workflows are indexed into visibility
+	EnablePendingNexusOperationsSearchAttribute dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
 	// Size limit related settings
 	BlobSizeLimitError                        dynamicconfig.IntPropertyFnWithNamespaceFilter
 	BlobSizeLimitWarn                         dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MemoSizeLimitError                        dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MemoSizeLimitWarn                         dynamicconfig.IntPropertyFnWithNamespaceFilter
+	DurableQueryResultsSizeLimit              dynamicconfig.IntPropertyFnWithNamespaceFilter
+	HistoryPayloadCompressionThreshold        dynamicconfig.IntPropertyFnWithNamespaceFilter
 	HistorySizeLimitError                     dynamicconfig.IntPropertyFnWithNamespaceFilter
 	HistorySizeLimitWarn                      dynamicconfig.IntPropertyFnWithNamespaceFilter
 	HistorySizeSuggestContinueAsNew           dynamicconfig.IntPropertyFnWithNamespaceFilter
@@ -242,17 +270,42 @@ type Config struct {
 	MutableStateTombstoneCountLimit           dynamicconfig.IntPropertyFn
 	NumPendingChildExecutionsLimit            dynamicconfig.IntPropertyFnWithNamespaceFilter
 	NumPendingActivitiesLimit                 dynamicconfig.IntPropertyFnWithNamespaceFilter
+	WorkflowTypeNumPendingActivitiesLimit     dynamicconfig.TypedPropertyFnWithNamespaceFilter[map[string]int]
 	NumPendingSignalsLimit                    dynamicconfig.IntPropertyFnWithNamespaceFilter
 	NumPendingCancelsRequestLimit             dynamicconfig.IntPropertyFnWithNamespaceFilter
 
 	// DefaultActivityRetryOptions specifies the out-of-box retry policy if
 	// none is configured on the Activity by the user.
 	DefaultActivityRetryPolicy dynamicconfig.TypedPropertyFnWithNamespaceFilter[retrypolicy.DefaultRetrySettings]
+	// ActivityRetryPolicyCaps are upper bounds applied to activity retry policies, ActivityTypeRetryPolicyCaps
+	// replace them for specific activity types
+	ActivityRetryPolicyCaps     dynamicconfig.TypedPropertyFnWithNamespaceFilter[retrypolicy.Caps]
+	ActivityTypeRetryPolicyCaps dynamicconfig.TypedPropertyFnWithNamespaceFilter[map[string]retrypolicy.Caps]
+	// PausedActivityTypeRecheckInterval is how long the dispatch of activities of paused types is postponed
+	PausedActivityTypeRecheckInterval dynamicconfig.DurationPropertyFnWithNamespaceFilter
+	// ActivityHeartbeatPersistenceInterval throttles how often activity heartbeats update mutable state
+	ActivityHeartbeatPersistenceInterval dynamicconfig.DurationPropertyFnWithNamespaceFilter
+	// LocalActivityMarkerDurationThreshold enables annotating the markers of long running local activities
+	LocalActivityMarkerDurationThreshold dynamicconfig.DurationPropertyFnWithNamespaceFilter
 
 	// DefaultWorkflowRetryPolicy specifies the out-of-box retry policy for
 	// any unset fields on a RetryPolicy configured on a Workflow
 	DefaultWorkflowRetryPolicy dynamicconfig.TypedPropertyFnWithNamespaceFilter[retrypolicy.DefaultRetrySettings]
 
+	// CronOverlapPolicy specifies when the next run of a cron workflow starts if
+	// the previous run missed one or more of its scheduled times
+	CronOverlapPolicy dynamicconfig.StringPropertyFnWithNamespaceFilter
+
+	// MaxWorkflowExecutionChainLength is the max number of runs in a cron, retry or continue-as-new chain
+	MaxWorkflowExecutionChainLength dynamicconfig.IntPropertyFnWithNamespaceFilter
+
+	// UserTimerCoalescingWindow is the window within which user timers are fired together
+	UserTimerCoalescingWindow dynamicconfig.DurationPropertyFnWithNamespaceFilter
+
+	// WorkflowExecutionMaxLifetime caps the execution timeout of workflows,
+	// counted from the start of the first run of the execution chain
+	WorkflowExecutionMaxLifetime dynamicconfig.DurationPropertyFnWithNamespaceFilter
+
 	// Workflow task settings
 	// DefaultWorkflowTaskTimeout the default workflow task timeout
 	DefaultWorkflowTaskTimeout dynamicconfig.DurationPropertyFnWithNamespaceFilter
@@ -279,9 +332,15 @@ type Config struct {
 	ReplicationTaskProcessorCleanupJitterCoefficient     dynamicconfig.FloatPropertyFnWithShardIDFilter
 	ReplicationTaskProcessorHostQPS                      dynamicconfig.FloatPropertyFn
 	ReplicationTaskProcessorShardQPS                     dynamicconfig.FloatPropertyFn
+	ReplicationTaskProcessorApplyParallelism             dynamicconfig.IntPropertyFnWithShardIDFilter
 	ReplicationEnableDLQMetrics                          dynamicconfig.BoolPropertyFn
 	ReplicationEnableUpdateWithNewTaskMerge              dynamicconfig.BoolPropertyFn
 	ReplicationMultipleBatches                           dynamicconfig.BoolPropertyFn
+	ReplicationExcludedWorkflowTypes                     dynamicconfig.TypedPropertyFnWithNamespaceFilter[[]string]
+	replicationExcludedWorkflowTypesRegexps              util.WildCardRegexpCache
+	WorkflowTypeRetention                                dynamicconfig.TypedPropertyFnWithNamespaceFilter[map[string]time.Duration]
+	WriteIntentWorkflowTypes                             dynamicconfig.TypedPropertyFnWithNamespaceFilter[[]string]
+	ReplicationExcludedSearchAttribute                   dynamicconfig.StringPropertyFnWithNamespaceFilter
 
 	ReplicationStreamSyncStatusDuration                 dynamicconfig.DurationPropertyFn
 	ReplicationProcessorSchedulerQueueSize              dynamicconfig.IntPropertyFn
@@ -289,18 +348,23 @@ type Config struct {
 	ReplicationLowPriorityProcessorSchedulerWorkerCount dynamicconfig.TypedSubscribable[int]
 	ReplicationLowPriorityTaskParallelism               dynamicconfig.IntPropertyFn
 	EnableReplicationEagerRefreshNamespace              dynamicconfig.BoolPropertyFn
+	EnableFineGrainedHandover                           dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	EnableReplicationTaskBatching                       dynamicconfig.BoolPropertyFn
 	EnableReplicateLocalGeneratedEvent                  dynamicconfig.BoolPropertyFn
 	EnableReplicationTaskTieredProcessing               dynamicconfig.BoolPropertyFn
 	ReplicationStreamSenderHighPriorityQPS              dynamicconfig.IntPropertyFn
 	ReplicationStreamSenderLowPriorityQPS               dynamicconfig.IntPropertyFn
+	ReplicationStreamSendBatchMaxCount                  dynamicconfig.IntPropertyFn
+	ReplicationStreamSendBatchMaxBytes                  dynamicconfig.IntPropertyFn
 	ReplicationReceiverMaxOutstandingTaskCount          dynamicconfig.IntPropertyFn
 	ReplicationResendMaxBatchCount                      dynamicconfig.IntPropertyFn
 	ReplicationProgressCacheMaxSize                     dynamicconfig.IntPropertyFn
 	ReplicationProgressCacheTTL                         dynamicconfig.DurationPropertyFn
 
 	// The following are used by consistent query
-	MaxBufferedQueryCount dynamicconfig.IntPropertyFn
+	MaxBufferedQueryCount           dynamicconfig.IntPropertyFn
+	QueryStrongConsistencyTimeout   dynamicconfig.DurationPropertyFnWithNamespaceFilter
+	QueryEventualConsistencyTimeout dynamicconfig.DurationPropertyFnWithNamespaceFilter
 
 	// Data integrity check related config knobs
 	MutableStateChecksumGenProbability    dynamicconfig.IntPropertyFnWithNamespaceFilter
@@ -328,6 +392,7 @@ type Config struct {
 	VisibilityProcessorEnsureCloseBeforeDelete            dynamicconfig.BoolPropertyFn
 	VisibilityProcessorEnableCloseWorkflowCleanup         dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	VisibilityProcessorRelocateAttributesMinBlobSize      dynamicconfig.IntPropertyFnWithNamespaceFilter
+	VisibilityProcessorCoalesceUpserts                    dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	VisibilityQueueMaxReaderCount                         dynamicconfig.IntPropertyFn
 
 	// Disable fetching memo and search attributes from visibility in the event that they were removed
@@ -363,9 +428,26 @@ type Config struct {
 	ArchivalBackendMaxRPS                               dynamicconfig.FloatPropertyFn
 	ArchivalQueueMaxReaderCount                         dynamicconfig.IntPropertyFn
 
+	// WorkflowLifecycleEventQueueProcessor settings
+	WorkflowLifecycleEventSinks                                       dynamicconfig.TypedPropertyFnWithNamespaceFilter[[]string]
+	WorkflowLifecycleEventProcessorSchedulerWorkerCount               dynamicconfig.TypedSubscribable[int]
+	WorkflowLifecycleEventProcessorMaxPollHostRPS                     dynamicconfig.IntPropertyFn
+	WorkflowLifecycleEventTaskBatchSize                               dynamicconfig.IntPropertyFn
+	WorkflowLifecycleEventProcessorPollBackoffInterval                dynamicconfig.DurationPropertyFn
+	WorkflowLifecycleEventProcessorMaxPollRPS                         dynamicconfig.IntPropertyFn
+	WorkflowLifecycleEventProcessorMaxPollInterval                    dynamicconfig.DurationPropertyFn
+	WorkflowLifecycleEventProcessorMaxPollIntervalJitterCoefficient   dynamicconfig.FloatPropertyFn
+	WorkflowLifecycleEventProcessorUpdateAckInterval                  dynamicconfig.DurationPropertyFn
+	WorkflowLifecycleEventProcessorUpdateAckIntervalJitterCoefficient dynamicconfig.FloatPropertyFn
+	WorkflowLifecycleEventQueueMaxReaderCount                         dynamicconfig.IntPropertyFn
+
 	WorkflowExecutionMaxInFlightUpdates dynamicconfig.IntPropertyFnWithNamespaceFilter
+	WorkflowExecutionMaxBufferedUpdates dynamicconfig.IntPropertyFnWithNamespaceFilter
 	WorkflowExecutionMaxTotalUpdates    dynamicconfig.IntPropertyFnWithNamespaceFilter
 
+	WorkflowExecutionMaxCarriedOverUpdateOutcomes       dynamicconfig.IntPropertyFnWithNamespaceFilter
+	WorkflowExecutionCarriedOverUpdateOutcomesSizeLimit dynamicconfig.IntPropertyFnWithNamespaceFilter
+
 	SendRawWorkflowHistory dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
 	WorkflowIdReuseMinimalInterval           dynamicconfig.DurationPropertyFnWithNamespaceFilter
@@ -376,7 +458,12 @@ type Config struct {
 
 	BreakdownMetricsByTaskQueue dynamicconfig.BoolPropertyFnWithTaskQueueFilter
 
-	LogAllReqErrors dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	LogAllReqErrors          dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	SlowRequestLogThresholds dynamicconfig.TypedPropertyFn[map[string]time.Duration]
+
+	EnableDebugTrace    dynamicconfig.BoolPropertyFn
+	DebugTraceRetention dynamicconfig.DurationPropertyFn
+	DebugTraceMaxTraces dynamicconfig.IntPropertyFn
 }
 
 // NewConfig returns new service config with default values
@@ -402,6 +489,7 @@ func NewConfig(
 		PersistenceQPSBurstRatio:             dynamicconfig.PersistenceQPSBurstRatio.Get(dc),
 		ShutdownDrainDuration:                dynamicconfig.HistoryShutdownDrainDuration.Get(dc),
 		StartupMembershipJoinDelay:           dynamicconfig.HistoryStartupMembershipJoinDelay.Get(dc),
+		LazyPayloadDecoding:                  dynamicconfig.HistoryLazyPayloadDecoding.Get(dc),
 		AllowResetWithPendingChildren:        dynamicconfig.AllowResetWithPendingChildren.Get(dc),
 		MaxAutoResetPoints:                   dynamicconfig.HistoryMaxAutoResetPoints.Get(dc),
 		DefaultWorkflowTaskTimeout:           dynamicconfig.DefaultWorkflowTaskTimeout.Get(dc),
@@ -418,8 +506,9 @@ func NewConfig(
 		SuppressErrorSetSystemSearchAttribute:   dynamicconfig.SuppressErrorSetSystemSearchAttribute.Get(dc),
 
 		EmitShardLagLog: dynamicconfig.EmitShardLagLog.Get(dc),
-		// HistoryCacheLimitSizeBased should not change during runtime.
+		// HistoryCacheLimitSizeBased and HistoryCacheFairEviction should not change during runtime.
 		HistoryCacheLimitSizeBased:            dynamicconfig.HistoryCacheSizeBasedLimit.Get(dc)(),
+		HistoryCacheFairEviction:              dynamicconfig.HistoryCacheNamespaceFairEviction.Get(dc)(),
 		HistoryCacheInitialSize:               dynamicconfig.HistoryCacheInitialSize.Get(dc),
 		HistoryShardLevelCacheMaxSize:         dynamicconfig.HistoryCacheMaxSize.Get(dc),
 		HistoryShardLevelCacheMaxSizeBytes:    dynamicconfig.HistoryCacheMaxSizeBytes.Get(dc),
@@ -431,6 +520,7 @@ func NewConfig(
 		EnableNexus:                           dynamicconfig.EnableNexus.Get(dc),
 		EnableWorkflowExecutionTimeoutTimer:   dynamicconfig.EnableWorkflowExecutionTimeoutTimer.Get(dc),
 		EnableTransitionHistory:               dynamicconfig.EnableTransitionHistory.Get(dc),
+		HistoryReadMaxPageBytes:               dynamicconfig.HistoryReadMaxPageBytes.Get(dc),
 
 		EventsShardLevelCacheMaxSizeBytes: dynamicconfig.EventsCacheMaxSizeBytes.Get(dc),          // 512KB
 		EventsHostLevelCacheMaxSizeBytes:  dynamicconfig.EventsHostLevelCacheMaxSizeBytes.Get(dc), // 256MB
@@ -439,13 +529,20 @@ func NewConfig(
 
 		RangeSizeBits: 20, // 20 bits for sequencer, 2^20 sequence number for any range
 
-		AcquireShardInterval:         dynamicconfig.AcquireShardInterval.Get(dc),
-		AcquireShardConcurrency:      dynamicconfig.AcquireShardConcurrency.Get(dc),
-		ShardIOConcurrency:           dynamicconfig.ShardIOConcurrency.Get(dc),
-		ShardIOTimeout:               dynamicconfig.ShardIOTimeout.Get(dc),
-		ShardLingerOwnershipCheckQPS: dynamicconfig.ShardLingerOwnershipCheckQPS.Get(dc),
-		ShardLingerTimeLimit:         dynamicconfig.ShardLingerTimeLimit.Get(dc),
-		ShardFinalizerTimeout:        dynamicconfig.ShardFinalizerTimeout.Get(dc),
+		AcquireShardInterval:              dynamicconfig.AcquireShardInterval.Get(dc),
+		AcquireShardConcurrency:           dynamicconfig.AcquireShardConcurrency.Get(dc),
+		AcquireShardPrioritizeOldestTasks: dynamicconfig.AcquireShardPrioritizeOldestTasks.Get(dc),
+		ShardIOConcurrency:                dynamicconfig.ShardIOConcurrency.Get(dc),
+		ShardIOTimeout:                    dynamicconfig.ShardIOTimeout.Get(dc),
+		ShardLingerOwnershipCheckQPS:      dynamicconfig.ShardLingerOwnershipCheckQPS.Get(dc),
+		ShardLingerTimeLimit:              dynamicconfig.ShardLingerTimeLimit.Get(dc),
+		ShardFinalizerTimeout:             dynamicconfig.ShardFinalizerTimeout.Get(dc),
+		ShardOwnershipChangeLogSize:       dynamicconfig.ShardOwnershipChangeLogSize.Get(dc),
+
+		HotspotDetectionWindow:     dynamicconfig.HotspotDetectionWindow.Get(dc),
+		HotspotMaxTrackedWorkflows: dynamicconfig.HotspotMaxTrackedWorkflows.Get(dc),
+		HotspotScoreFactor:         dynamicconfig.HotspotScoreFactor.Get(dc),
+		HotWorkflowMinScore:        dynamicconfig.HotWorkflowMinScore.Get(dc),
 
 		HistoryClientOwnershipCachingEnabled: dynamicconfig.HistoryClientOwnershipCachingEnabled.Get(dc),
 
@@ -529,6 +626,7 @@ func NewConfig(
 		ReplicatorProcessorMaxSkipTaskCount:                 dynamicconfig.ReplicatorMaxSkipTaskCount.Get(dc),
 		ReplicationTaskProcessorHostQPS:                     dynamicconfig.ReplicationTaskProcessorHostQPS.Get(dc),
 		ReplicationTaskProcessorShardQPS:                    dynamicconfig.ReplicationTaskProcessorShardQPS.Get(dc),
+		ReplicationTaskProcessorApplyParallelism:            dynamicconfig.ReplicationTaskProcessorApplyParallelism.Get(dc),
 		ReplicationEnableDLQMetrics:                         dynamicconfig.ReplicationEnableDLQMetrics.Get(dc),
 		ReplicationEnableUpdateWithNewTaskMerge:             dynamicconfig.ReplicationEnableUpdateWithNewTaskMerge.Get(dc),
 		ReplicationStreamSyncStatusDuration:                 dynamicconfig.ReplicationStreamSyncStatusDuration.Get(dc),
@@ -537,11 +635,14 @@ func NewConfig(
 		ReplicationLowPriorityProcessorSchedulerWorkerCount: dynamicconfig.ReplicationLowPriorityProcessorSchedulerWorkerCount.Subscribe(dc),
 		ReplicationLowPriorityTaskParallelism:               dynamicconfig.ReplicationLowPriorityTaskParallelism.Get(dc),
 		EnableReplicationEagerRefreshNamespace:              dynamicconfig.EnableEagerNamespaceRefresher.Get(dc),
+		EnableFineGrainedHandover:                           dynamicconfig.EnableFineGrainedHandover.Get(dc),
 		EnableReplicationTaskBatching:                       dynamicconfig.EnableReplicationTaskBatching.Get(dc),
 		EnableReplicateLocalGeneratedEvent:                  dynamicconfig.EnableReplicateLocalGeneratedEvents.Get(dc),
 		EnableReplicationTaskTieredProcessing:               dynamicconfig.EnableReplicationTaskTieredProcessing.Get(dc),
 		ReplicationStreamSenderHighPriorityQPS:              dynamicconfig.ReplicationStreamSenderHighPriorityQPS.Get(dc),
 		ReplicationStreamSenderLowPriorityQPS:               dynamicconfig.ReplicationStreamSenderLowPriorityQPS.Get(dc),
+		ReplicationStreamSendBatchMaxCount:                  dynamicconfig.ReplicationStreamSendBatchMaxCount.Get(dc),
+		ReplicationStreamSendBatchMaxBytes:                  dynamicconfig.ReplicationStreamSendBatchMaxBytes.Get(dc),
 		ReplicationReceiverMaxOutstandingTaskCount:          dynamicconfig.ReplicationReceiverMaxOutstandingTaskCount.Get(dc),
 		ReplicationResendMaxBatchCount:                      dynamicconfig.ReplicationResendMaxBatchCount.Get(dc),
 		ReplicationProgressCacheMaxSize:                     dynamicconfig.ReplicationProgressCacheMaxSize.Get(dc),
@@ -550,6 +651,9 @@ func NewConfig(
 		MaximumBufferedEventsBatch:       dynamicconfig.MaximumBufferedEventsBatch.Get(dc),
 		MaximumBufferedEventsSizeInBytes: dynamicconfig.MaximumBufferedEventsSizeInBytes.Get(dc),
 		MaximumSignalsPerExecution:       dynamicconfig.MaximumSignalsPerExecution.Get(dc),
+		SignalDedupKeyTTL:                dynamicconfig.SignalDedupKeyTTL.Get(dc),
+		SignalDedupKeysLimit:             dynamicconfig.SignalDedupKeysLimit.Get(dc),
+		RejectUnknownWorkflowHandlers:    dynamicconfig.RejectUnknownWorkflowHandlers.Get(dc),
 		ShardUpdateMinInterval:           dynamicconfig.ShardUpdateMinInterval.Get(dc),
 		ShardFirstUpdateInterval:         dynamicconfig.ShardFirstUpdateInterval.Get(dc),
 		ShardUpdateMinTasksCompleted:     dynamicconfig.ShardUpdateMinTasksCompleted.Get(dc),
@@ -562,15 +666,23 @@ func NewConfig(
 		EventEncodingType:                   dynamicconfig.DefaultEventEncoding.Get(dc),
 		EnableParentClosePolicy:             dynamicconfig.EnableParentClosePolicy.Get(dc),
 		NumParentClosePolicySystemWorkflows: dynamicconfig.NumParentClosePolicySystemWorkflows.Get(dc),
-		EnableParentClosePolicyWorker:       dynamicconfig.EnableParentClosePolicyWorker.Get(dc),
-		ParentClosePolicyThreshold:          dynamicconfig.ParentClosePolicyThreshold.Get(dc),
+		ParentClosePolicyBatchSize:          dynamicconfig.ParentClosePolicyBatchSize.Get(dc),
+		StuckWorkflowTaskFailureThreshold:   dynamicconfig.StuckWorkflowTaskFailureThreshold.Get(dc),
+		StuckWorkflowTaskMinDuration:        dynamicconfig.StuckWorkflowTaskMinDuration.Get(dc),
+
+		EnablePendingNexusOperationsSearchAttribute: dynamicconfig.EnablePendingNexusOperationsSearchAttribute.Get(dc),
+		EnableParentClosePolicyWorker:               dynamicconfig.EnableParentClosePolicyWorker.Get(dc),
+		ParentClosePolicyThreshold:                  dynamicconfig.ParentClosePolicyThreshold.Get(dc),
 
 		BlobSizeLimitError:                        dynamicconfig.BlobSizeLimitError.Get(dc),
 		BlobSizeLimitWarn:                         dynamicconfig.BlobSizeLimitWarn.Get(dc),
 		MemoSizeLimitError:                        dynamicconfig.MemoSizeLimitError.Get(dc),
 		MemoSizeLimitWarn:                         dynamicconfig.MemoSizeLimitWarn.Get(dc),
+		DurableQueryResultsSizeLimit:              dynamicconfig.DurableQueryResultsSizeLimit.Get(dc),
+		HistoryPayloadCompressionThreshold:        dynamicconfig.HistoryPayloadCompressionThreshold.Get(dc),
 		NumPendingChildExecutionsLimit:            dynamicconfig.NumPendingChildExecutionsLimitError.Get(dc),
 		NumPendingActivitiesLimit:                 dynamicconfig.NumPendingActivitiesLimitError.Get(dc),
+		WorkflowTypeNumPendingActivitiesLimit:     dynamicconfig.WorkflowTypeNumPendingActivitiesLimit.Get(dc),
 		NumPendingSignalsLimit:                    dynamicconfig.NumPendingSignalsLimitError.Get(dc),
 		NumPendingCancelsRequestLimit:             dynamicconfig.NumPendingCancelRequestsLimitError.Get(dc),
 		HistorySizeLimitError:                     dynamicconfig.HistorySizeLimitError.Get(dc),
@@ -590,7 +702,16 @@ func NewConfig(
 		EnableStickyQuery: dynamicconfig.EnableStickyQuery.Get(dc),
 
 		DefaultActivityRetryPolicy:                       dynamicconfig.DefaultActivityRetryPolicy.Get(dc),
+		ActivityRetryPolicyCaps:                          dynamicconfig.ActivityRetryPolicyCaps.Get(dc),
+		ActivityTypeRetryPolicyCaps:                      dynamicconfig.ActivityTypeRetryPolicyCaps.Get(dc),
+		PausedActivityTypeRecheckInterval:                dynamicconfig.PausedActivityTypeRecheckInterval.Get(dc),
+		ActivityHeartbeatPersistenceInterval:             dynamicconfig.ActivityHeartbeatPersistenceInterval.Get(dc),
+		LocalActivityMarkerDurationThreshold:             dynamicconfig.LocalActivityMarkerDurationThreshold.Get(dc),
 		DefaultWorkflowRetryPolicy:                       dynamicconfig.DefaultWorkflowRetryPolicy.Get(dc),
+		CronOverlapPolicy:                                dynamicconfig.CronOverlapPolicy.Get(dc),
+		WorkflowExecutionMaxLifetime:                     dynamicconfig.WorkflowExecutionMaxLifetime.Get(dc),
+		UserTimerCoalescingWindow:                        dynamicconfig.UserTimerCoalescingWindow.Get(dc),
+		MaxWorkflowExecutionChainLength:                  dynamicconfig.MaxWorkflowExecutionChainLength.Get(dc),
 		WorkflowTaskHeartbeatTimeout:                     dynamicconfig.WorkflowTaskHeartbeatTimeout.Get(dc),
 		WorkflowTaskCriticalAttempts:                     dynamicconfig.WorkflowTaskCriticalAttempts.Get(dc),
 		WorkflowTaskRetryMaxInterval:                     dynamicconfig.WorkflowTaskRetryMaxInterval.Get(dc),
@@ -611,8 +732,14 @@ func NewConfig(
 		ReplicationTaskProcessorCleanupInterval:              dynamicconfig.ReplicationTaskProcessorCleanupInterval.Get(dc),
 		ReplicationTaskProcessorCleanupJitterCoefficient:     dynamicconfig.ReplicationTaskProcessorCleanupJitterCoefficient.Get(dc),
 		ReplicationMultipleBatches:                           dynamicconfig.ReplicationMultipleBatches.Get(dc),
+		ReplicationExcludedWorkflowTypes:                     dynamicconfig.ReplicationExcludedWorkflowTypes.Get(dc),
+		WorkflowTypeRetention:                                dynamicconfig.WorkflowTypeRetention.Get(dc),
+		WriteIntentWorkflowTypes:                             dynamicconfig.WriteIntentWorkflowTypes.Get(dc),
+		ReplicationExcludedSearchAttribute:                   dynamicconfig.ReplicationExcludedSearchAttribute.Get(dc),
 
 		MaxBufferedQueryCount:                 dynamicconfig.MaxBufferedQueryCount.Get(dc),
+		QueryStrongConsistencyTimeout:         dynamicconfig.QueryStrongConsistencyTimeout.Get(dc),
+		QueryEventualConsistencyTimeout:       dynamicconfig.QueryEventualConsistencyTimeout.Get(dc),
 		MutableStateChecksumGenProbability:    dynamicconfig.MutableStateChecksumGenProbability.Get(dc),
 		MutableStateChecksumVerifyProbability: dynamicconfig.MutableStateChecksumVerifyProbability.Get(dc),
 		MutableStateChecksumInvalidateBefore:  dynamicconfig.MutableStateChecksumInvalidateBefore.Get(dc),
@@ -636,6 +763,7 @@ func NewConfig(
 		VisibilityProcessorEnsureCloseBeforeDelete:            dynamicconfig.VisibilityProcessorEnsureCloseBeforeDelete.Get(dc),
 		VisibilityProcessorEnableCloseWorkflowCleanup:         dynamicconfig.VisibilityProcessorEnableCloseWorkflowCleanup.Get(dc),
 		VisibilityProcessorRelocateAttributesMinBlobSize:      dynamicconfig.VisibilityProcessorRelocateAttributesMinBlobSize.Get(dc),
+		VisibilityProcessorCoalesceUpserts:                    dynamicconfig.VisibilityProcessorCoalesceUpserts.Get(dc),
 		VisibilityQueueMaxReaderCount:                         dynamicconfig.VisibilityQueueMaxReaderCount.Get(dc),
 
 		DisableFetchRelocatableAttributesFromVisibility: dynamicconfig.DisableFetchRelocatableAttributesFromVisibility.Get(dc),
@@ -673,10 +801,27 @@ func NewConfig(
 		ArchivalBackendMaxRPS:                               dynamicconfig.ArchivalBackendMaxRPS.Get(dc),
 		ArchivalQueueMaxReaderCount:                         dynamicconfig.ArchivalQueueMaxReaderCount.Get(dc),
 
+		// Workflow lifecycle event related
+		WorkflowLifecycleEventSinks:                                       dynamicconfig.WorkflowLifecycleEventSinks.Get(dc),
+		WorkflowLifecycleEventTaskBatchSize:                               dynamicconfig.WorkflowLifecycleEventTaskBatchSize.Get(dc),
+		WorkflowLifecycleEventProcessorMaxPollRPS:                         dynamicconfig.WorkflowLifecycleEventProcessorMaxPollRPS.Get(dc),
+		WorkflowLifecycleEventProcessorMaxPollHostRPS:                     dynamicconfig.WorkflowLifecycleEventProcessorMaxPollHostRPS.Get(dc),
+		WorkflowLifecycleEventProcessorSchedulerWorkerCount:               dynamicconfig.WorkflowLifecycleEventProcessorSchedulerWorkerCount.Subscribe(dc),
+		WorkflowLifecycleEventProcessorMaxPollInterval:                    dynamicconfig.WorkflowLifecycleEventProcessorMaxPollInterval.Get(dc),
+		WorkflowLifecycleEventProcessorMaxPollIntervalJitterCoefficient:   dynamicconfig.WorkflowLifecycleEventProcessorMaxPollIntervalJitterCoefficient.Get(dc),
+		WorkflowLifecycleEventProcessorUpdateAckInterval:                  dynamicconfig.WorkflowLifecycleEventProcessorUpdateAckInterval.Get(dc),
+		WorkflowLifecycleEventProcessorUpdateAckIntervalJitterCoefficient: dynamicconfig.WorkflowLifecycleEventProcessorUpdateAckIntervalJitterCoefficient.Get(dc),
+		WorkflowLifecycleEventProcessorPollBackoffInterval:                dynamicconfig.WorkflowLifecycleEventProcessorPollBackoffInterval.Get(dc),
+		WorkflowLifecycleEventQueueMaxReaderCount:                         dynamicconfig.WorkflowLifecycleEventQueueMaxReaderCount.Get(dc),
+
 		// workflow update related
 		WorkflowExecutionMaxInFlightUpdates: dynamicconfig.WorkflowExecutionMaxInFlightUpdates.Get(dc),
+		WorkflowExecutionMaxBufferedUpdates: dynamicconfig.WorkflowExecutionMaxBufferedUpdates.Get(dc),
 		WorkflowExecutionMaxTotalUpdates:    dynamicconfig.WorkflowExecutionMaxTotalUpdates.Get(dc),
 
+		WorkflowExecutionMaxCarriedOverUpdateOutcomes:       dynamicconfig.WorkflowExecutionMaxCarriedOverUpdateOutcomes.Get(dc),
+		WorkflowExecutionCarriedOverUpdateOutcomesSizeLimit: dynamicconfig.WorkflowExecutionCarriedOverUpdateOutcomesSizeLimit.Get(dc),
+
 		SendRawWorkflowHistory:                   dynamicconfig.SendRawWorkflowHistory.Get(dc),
 		WorkflowIdReuseMinimalInterval:           dynamicconfig.WorkflowIdReuseMinimalInterval.Get(dc),
 		EnableWorkflowIdReuseStartTimeValidation: dynamicconfig.EnableWorkflowIdReuseStartTimeValidation.Get(dc),
@@ -686,7 +831,12 @@ func NewConfig(
 
 		BreakdownMetricsByTaskQueue: dynamicconfig.MetricsBreakdownByTaskQueue.Get(dc),
 
-		LogAllReqErrors: dynamicconfig.LogAllReqErrors.Get(dc),
+		LogAllReqErrors:          dynamicconfig.LogAllReqErrors.Get(dc),
+		SlowRequestLogThresholds: dynamicconfig.SlowRequestLogThresholds.Get(dc),
+
+		EnableDebugTrace:    dynamicconfig.EnableDebugTrace.Get(dc),
+		DebugTraceRetention: dynamicconfig.DebugTraceRetention.Get(dc),
+		DebugTraceMaxTraces: dynamicconfig.DebugTraceMaxTraces.Get(dc),
 	}
 
 	return cfg
@@ -696,3 +846,13 @@ func NewConfig(
 func (config *Config) GetShardID(namespaceID namespace.ID, workflowID string) int32 {
 	return common.WorkflowIDToHistoryShard(namespaceID.String(), workflowID, config.NumberOfShards)
 }
+
+// ReplicationExcludedWorkflowTypesRegexp returns the regular expression matching the ReplicationExcludedWorkflowTypes
+// of a namespace, or nil if none are set. The patterns are only compiled again when they change.
+func (config *Config) ReplicationExcludedWorkflowTypesRegexp(namespace string) (*regexp.Regexp, error) {
+	patterns := config.ReplicationExcludedWorkflowTypes(namespace)
+	if len(patterns) == 0 {
+		return nil, nil
+	}
+	return config.replicationExcludedWorkflowTypesRegexps.Get(namespace, patterns)
+}
@@ -78,6 +78,7 @@ var (
 		"/temporal.server.api.historyservice.v1.HistoryService/VerifyFirstWorkflowTaskScheduled":       1,
 		"/temporal.server.api.historyservice.v1.HistoryService/SignalWithStartWorkflowExecution":       1,
 		"/temporal.server.api.historyservice.v1.HistoryService/SignalWorkflowExecution":                1,
+		"/temporal.server.api.historyservice.v1.HistoryService/MultiSignalWorkflowExecution":           1,
 		"/temporal.server.api.historyservice.v1.HistoryService/StartWorkflowExecution":                 1,
 		"/temporal.server.api.historyservice.v1.HistoryService/SyncActivity":                           1,
 		"/temporal.server.api.historyservice.v1.HistoryService/SyncShardStatus":                        1,
@@ -103,6 +104,7 @@ var (
 		"/temporal.server.api.historyservice.v1.HistoryService/CompleteNexusOperation":                 1,
 		"/temporal.server.api.historyservice.v1.HistoryService/InvokeStateMachineMethod":               1,
 		"/temporal.server.api.historyservice.v1.HistoryService/DeepHealthCheck":                        1,
+		"/temporal.server.api.historyservice.v1.HistoryService/ListNexusEndpointHealth":                1,
 		"/temporal.server.api.historyservice.v1.HistoryService/UpdateActivityOptions":                  1,
 		"/temporal.server.api.historyservice.v1.HistoryService/PauseActivity":                          1,
 		"/temporal.server.api.historyservice.v1.HistoryService/UnpauseActivity":                        1,
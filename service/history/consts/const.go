@@ -83,6 +83,12 @@ var (
 	ErrDeserializingToken = serviceerror.NewInvalidArgument("error deserializing task token")
 	// ErrSignalsLimitExceeded is the error indicating limit reached for maximum number of signal events
 	ErrSignalsLimitExceeded = serviceerror.NewInvalidArgument("exceeded workflow execution limit for signal events")
+	// ErrSignalHandlerNotFound is the error indicating the workflow has no handler for the signal
+	ErrSignalHandlerNotFound = serviceerror.NewInvalidArgument("workflow has no handler for the signal")
+	// ErrUpdateHandlerNotFound is the error indicating the workflow has no handler for the update
+	ErrUpdateHandlerNotFound = serviceerror.NewInvalidArgument("workflow has no handler for the update")
+	// ErrChainLengthLimitExceeded is the error indicating the run can not start a next run in its execution chain
+	ErrChainLengthLimitExceeded = serviceerror.NewFailedPrecondition("exceeded workflow execution limit for runs in an execution chain")
 	// ErrWorkflowClosing is the error indicating requests to workflow can not be applied as workflow is closing
 	ErrWorkflowClosing = &serviceerror.ResourceExhausted{
 		Cause:   enumspb.RESOURCE_EXHAUSTED_CAUSE_BUSY_WORKFLOW,
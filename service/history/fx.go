@@ -25,17 +25,23 @@
 package history
 
 import (
+	"context"
+	"net"
+
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/debugtrace"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/membership/drain"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
+	"go.temporal.io/server/common/persistence/payloadencryption"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
@@ -44,6 +50,7 @@ import (
 	"go.temporal.io/server/common/quotas/calculator"
 	"go.temporal.io/server/common/resolver"
 	"go.temporal.io/server/common/resource"
+	"go.temporal.io/server/common/rpc"
 	"go.temporal.io/server/common/rpc/interceptor"
 	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/components/callbacks"
@@ -55,9 +62,12 @@ import (
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/events"
+	"go.temporal.io/server/service/history/hotspot"
 	"go.temporal.io/server/service/history/hsm"
+	"go.temporal.io/server/service/history/lifecycleevent"
 	"go.temporal.io/server/service/history/replication"
 	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/history/workflow"
 	"go.temporal.io/server/service/history/workflow/cache"
 	"go.uber.org/fx"
@@ -68,6 +78,7 @@ import (
 var Module = fx.Options(
 	resource.Module,
 	fx.Provide(hsm.NewRegistry),
+	fx.Provide(tasks.NewSpanLinks),
 	workflow.Module,
 	shard.Module,
 	events.Module,
@@ -79,6 +90,8 @@ var Module = fx.Options(
 	fx.Provide(RetryableInterceptorProvider),
 	fx.Provide(TelemetryInterceptorProvider),
 	fx.Provide(RateLimitInterceptorProvider),
+	fx.Provide(DebugTraceStoreProvider),
+	fx.Provide(DebugTraceInterceptorProvider),
 	fx.Provide(service.GrpcServerOptionsProvider),
 	fx.Provide(ESProcessorConfigProvider),
 	fx.Provide(VisibilityManagerProvider),
@@ -90,8 +103,11 @@ var Module = fx.Options(
 	fx.Provide(HistoryEngineFactoryProvider),
 	fx.Provide(HandlerProvider),
 	fx.Provide(ServerProvider),
+	fx.Provide(DrainServerProvider),
 	fx.Provide(NewService),
 	fx.Provide(ReplicationProgressCacheProvider),
+	fx.Provide(WorkflowLifecycleEventPublisherProvider),
+	fx.Provide(HotspotTrackerProvider),
 	fx.Invoke(ServiceLifetimeHooks),
 
 	callbacks.Module,
@@ -99,14 +115,25 @@ var Module = fx.Options(
 	fx.Invoke(nexusworkflow.RegisterCommandHandlers),
 )
 
-func ServerProvider(grpcServerOptions []grpc.ServerOption) *grpc.Server {
-	return grpc.NewServer(grpcServerOptions...)
+func ServerProvider(grpcServerOptions []grpc.ServerOption, serviceConfig *configs.Config) *grpc.Server {
+	// Payloads of the requests received from frontend are persisted as they are, without decoding them.
+	codec := rpc.NewLazyPayloadsCodec(
+		serviceConfig.LazyPayloadDecoding,
+		rpc.NewLazyPayloadsPath(&historyservice.StartWorkflowExecutionRequest{}, "start_request", "input"),
+		rpc.NewLazyPayloadsPath(&historyservice.RespondActivityTaskCompletedRequest{}, "complete_request", "result"),
+	)
+	return grpc.NewServer(append(grpcServerOptions, grpc.ForceServerCodecV2(codec))...)
 }
 
 func ServiceResolverProvider(
 	membershipMonitor membership.Monitor,
+	dc *dynamicconfig.Collection,
 ) (membership.ServiceResolver, error) {
-	return membershipMonitor.GetResolver(primitives.HistoryService)
+	resolver, err := membershipMonitor.GetResolver(primitives.HistoryService)
+	if err != nil {
+		return nil, err
+	}
+	return membership.NewShardOwnershipOverrideResolver(resolver, dc), nil
 }
 
 func HandlerProvider(args NewHandlerArgs) *Handler {
@@ -135,6 +162,11 @@ func HandlerProvider(args NewHandlerArgs) *Handler {
 		taskQueueManager:             args.TaskQueueManager,
 		taskCategoryRegistry:         args.TaskCategoryRegistry,
 		dlqMetricsEmitter:            args.DLQMetricsEmitter,
+		nexusEndpointHealthTracker:   args.NexusEndpointHealthTracker,
+		nexusPayloadSizeChecker:      args.NexusPayloadSizeChecker,
+		outboundQueueCBPool:          args.OutboundQueueCBPool,
+		hotspotTracker:               args.HotspotTracker,
+		debugTraceStore:              args.DebugTraceStore,
 
 		replicationTaskFetcherFactory:    args.ReplicationTaskFetcherFactory,
 		replicationTaskConverterProvider: args.ReplicationTaskConverterFactory,
@@ -187,6 +219,8 @@ func TelemetryInterceptorProvider(
 		metricsHandler,
 		logger,
 		serviceConfig.LogAllReqErrors,
+		serviceConfig.SlowRequestLogThresholds,
+		serviceConfig.NumberOfShards,
 	)
 }
 
@@ -202,6 +236,27 @@ func RateLimitInterceptorProvider(
 	)
 }
 
+func DebugTraceStoreProvider(serviceConfig *configs.Config) *debugtrace.Store {
+	return debugtrace.NewStore(serviceConfig.DebugTraceRetention(), serviceConfig.DebugTraceMaxTraces())
+}
+
+func DebugTraceInterceptorProvider(
+	store *debugtrace.Store,
+	hostInfoProvider membership.HostInfoProvider,
+	serviceConfig *configs.Config,
+	logger log.Logger,
+) *interceptor.DebugTraceInterceptor {
+	// Callers were already authorized by the frontend, which drops the debug trace header otherwise.
+	return interceptor.NewDebugTraceInterceptor(
+		store,
+		primitives.HistoryService,
+		hostInfoProvider,
+		serviceConfig.EnableDebugTrace,
+		false,
+		logger,
+	)
+}
+
 func ESProcessorConfigProvider(
 	serviceConfig *configs.Config,
 ) *elasticsearch.ProcessorConfig {
@@ -258,6 +313,7 @@ func VisibilityManagerProvider(
 	metricsHandler metrics.Handler,
 	persistenceConfig *config.Persistence,
 	customVisibilityStoreFactory visibility.VisibilityStoreFactory,
+	payloadKeyProvider payloadencryption.KeyProvider,
 	esProcessorConfig *elasticsearch.ProcessorConfig,
 	serviceConfig *configs.Config,
 	persistenceServiceResolver resolver.ServiceResolver,
@@ -269,6 +325,7 @@ func VisibilityManagerProvider(
 		*persistenceConfig,
 		persistenceServiceResolver,
 		customVisibilityStoreFactory,
+		payloadKeyProvider,
 		esProcessorConfig,
 		saProvider,
 		searchAttributesMapperProvider,
@@ -299,6 +356,33 @@ func EventNotifierProvider(
 	)
 }
 
+type DrainServerParams struct {
+	fx.In
+
+	Config            *config.Config `optional:"true"`
+	ServiceName       primitives.ServiceName
+	GrpcListener      net.Listener
+	MembershipMonitor membership.Monitor
+	ShardController   shard.Controller
+	Logger            log.Logger
+}
+
+// DrainServerProvider provides the server of the drain endpoint if enabled or nil otherwise. The endpoint is disabled
+// without a static config, e.g. in tests.
+func DrainServerProvider(params DrainServerParams) (*drain.Server, error) {
+	if params.Config == nil {
+		return nil, nil
+	}
+	return drain.NewServer(
+		params.Config.Services[string(params.ServiceName)].RPC,
+		params.GrpcListener,
+		params.MembershipMonitor,
+		"shards",
+		func() int { return len(params.ShardController.ShardIDs()) },
+		params.Logger,
+	)
+}
+
 func ServiceLifetimeHooks(lc fx.Lifecycle, svc *Service) {
 	lc.Append(fx.StartStopHook(svc.Start, svc.Stop))
 }
@@ -310,3 +394,39 @@ func ReplicationProgressCacheProvider(
 ) replication.ProgressCache {
 	return replication.NewProgressCache(serviceConfig, logger, handler)
 }
+
+type WorkflowLifecycleEventPublisherParams struct {
+	fx.In
+
+	Lifecycle      fx.Lifecycle
+	Config         *config.Config `optional:"true"`
+	Logger         log.Logger
+	MetricsHandler metrics.Handler
+}
+
+func WorkflowLifecycleEventPublisherProvider(
+	params WorkflowLifecycleEventPublisherParams,
+) (*lifecycleevent.Publisher, error) {
+	var cfg config.WorkflowLifecycleEvents
+	if params.Config != nil {
+		cfg = params.Config.Global.WorkflowLifecycleEvents
+	}
+	publisher, err := lifecycleevent.NewPublisherFromConfig(context.Background(), &cfg, params.Logger, params.MetricsHandler)
+	if err != nil {
+		return nil, err
+	}
+	params.Lifecycle.Append(fx.StopHook(publisher.Close))
+	return publisher, nil
+}
+
+func HotspotTrackerProvider(
+	lc fx.Lifecycle,
+	serviceConfig *configs.Config,
+	namespaceRegistry namespace.Registry,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) *hotspot.Tracker {
+	tracker := hotspot.NewTracker(serviceConfig, namespaceRegistry, metricsHandler, logger)
+	lc.Append(fx.StartStopHook(tracker.Start, tracker.Stop))
+	return tracker
+}
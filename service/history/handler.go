@@ -33,6 +33,7 @@ import (
 
 	"github.com/nexus-rpc/sdk-go/nexus"
 	"github.com/pborman/uuid"
+	"github.com/sony/gobreaker"
 	"go.opentelemetry.io/otel/trace"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
@@ -40,6 +41,7 @@ import (
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	namespacespb "go.temporal.io/server/api/namespace/v1"
+	nexusspb "go.temporal.io/server/api/nexus/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	tokenspb "go.temporal.io/server/api/token/v1"
 	"go.temporal.io/server/client/history"
@@ -48,6 +50,7 @@ import (
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/debugtrace"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
@@ -68,9 +71,11 @@ import (
 	"go.temporal.io/server/service/history/api/forcedeleteworkflowexecution"
 	"go.temporal.io/server/service/history/api/getdlqtasks"
 	"go.temporal.io/server/service/history/api/listqueues"
+	"go.temporal.io/server/service/history/circuitbreakerpool"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/events"
+	"go.temporal.io/server/service/history/hotspot"
 	"go.temporal.io/server/service/history/hsm"
 	"go.temporal.io/server/service/history/replication"
 	"go.temporal.io/server/service/history/shard"
@@ -110,6 +115,11 @@ type (
 		taskQueueManager             persistence.HistoryTaskQueueManager
 		taskCategoryRegistry         tasks.TaskCategoryRegistry
 		dlqMetricsEmitter            *persistence.DLQMetricsEmitter
+		nexusEndpointHealthTracker   *nexusoperations.EndpointHealthTracker
+		nexusPayloadSizeChecker      *nexusoperations.PayloadSizeChecker
+		outboundQueueCBPool          *circuitbreakerpool.OutboundQueueCircuitBreakerPool
+		hotspotTracker               *hotspot.Tracker
+		debugTraceStore              *debugtrace.Store
 
 		replicationTaskFetcherFactory    replication.TaskFetcherFactory
 		replicationTaskConverterProvider replication.SourceTaskConverterProvider
@@ -141,6 +151,11 @@ type (
 		TaskQueueManager             persistence.HistoryTaskQueueManager
 		TaskCategoryRegistry         tasks.TaskCategoryRegistry
 		DLQMetricsEmitter            *persistence.DLQMetricsEmitter
+		NexusEndpointHealthTracker   *nexusoperations.EndpointHealthTracker
+		NexusPayloadSizeChecker      *nexusoperations.PayloadSizeChecker
+		OutboundQueueCBPool          *circuitbreakerpool.OutboundQueueCircuitBreakerPool
+		HotspotTracker               *hotspot.Tracker
+		DebugTraceStore              *debugtrace.Store
 
 		ReplicationTaskFetcherFactory   replication.TaskFetcherFactory
 		ReplicationTaskConverterFactory replication.SourceTaskConverterProvider
@@ -224,6 +239,46 @@ func (h *Handler) DeepHealthCheck(
 	return &historyservice.DeepHealthCheckResponse{State: enumsspb.HEALTH_STATE_SERVING}, nil
 }
 
+// ListNexusEndpointHealth returns the health of the Nexus endpoints this host made requests to.
+func (h *Handler) ListNexusEndpointHealth(
+	_ context.Context,
+	request *historyservice.ListNexusEndpointHealthRequest,
+) (_ *historyservice.ListNexusEndpointHealthResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	h.startWG.Wait()
+
+	hostAddress := h.hostInfoProvider.HostInfo().GetAddress()
+	endpoints := h.nexusEndpointHealthTracker.Snapshot(
+		request.GetEndpointNames(),
+		func(namespaceID, endpointName string) *nexusspb.EndpointCircuitBreaker {
+			cb := h.outboundQueueCBPool.Get(tasks.TaskGroupNamespaceIDAndDestination{
+				TaskGroup:   nexusoperations.TaskTypeInvocation,
+				NamespaceID: namespaceID,
+				Destination: endpointName,
+			})
+			return &nexusspb.EndpointCircuitBreaker{
+				NamespaceId: namespaceID,
+				HostAddress: hostAddress,
+				State:       circuitBreakerStateToProto(cb.State()),
+			}
+		},
+	)
+	return &historyservice.ListNexusEndpointHealthResponse{Endpoints: endpoints}, nil
+}
+
+func circuitBreakerStateToProto(state gobreaker.State) enumsspb.CircuitBreakerState {
+	switch state {
+	case gobreaker.StateClosed:
+		return enumsspb.CIRCUIT_BREAKER_STATE_CLOSED
+	case gobreaker.StateHalfOpen:
+		return enumsspb.CIRCUIT_BREAKER_STATE_HALF_OPEN
+	case gobreaker.StateOpen:
+		return enumsspb.CIRCUIT_BREAKER_STATE_OPEN
+	default:
+		return enumsspb.CIRCUIT_BREAKER_STATE_UNSPECIFIED
+	}
+}
+
 // IsWorkflowTaskValid - whether workflow task is still valid
 func (h *Handler) IsWorkflowTaskValid(ctx context.Context, request *historyservice.IsWorkflowTaskValidRequest) (_ *historyservice.IsWorkflowTaskValidResponse, retError error) {
 	defer log.CapturePanic(h.logger, &retError)
@@ -674,13 +729,14 @@ func (h *Handler) ExecuteMultiOperation(
 }
 
 // DescribeHistoryHost returns information about the internal states of a history host
-func (h *Handler) DescribeHistoryHost(_ context.Context, _ *historyservice.DescribeHistoryHostRequest) (_ *historyservice.DescribeHistoryHostResponse, retError error) {
+func (h *Handler) DescribeHistoryHost(_ context.Context, request *historyservice.DescribeHistoryHostRequest) (_ *historyservice.DescribeHistoryHostResponse, retError error) {
 	defer metrics.CapturePanic(h.logger, h.metricsHandler, &retError)
 	h.startWG.Wait()
 
 	itemsInCacheByIDCount, itemsInCacheByNameCount := h.namespaceRegistry.GetCacheSize()
 
 	ownedShardIDs := h.controller.ShardIDs()
+	shards, shardOwnershipChanges := h.controller.ShardOwnership()
 	resp := &historyservice.DescribeHistoryHostResponse{
 		ShardsNumber: int32(len(ownedShardIDs)),
 		ShardIds:     ownedShardIDs,
@@ -688,7 +744,13 @@ func (h *Handler) DescribeHistoryHost(_ context.Context, _ *historyservice.Descr
 			ItemsInCacheByIdCount:   itemsInCacheByIDCount,
 			ItemsInCacheByNameCount: itemsInCacheByNameCount,
 		},
-		Address: h.hostInfoProvider.HostInfo().GetAddress(),
+		Address:               h.hostInfoProvider.HostInfo().GetAddress(),
+		Shards:                shards,
+		ShardOwnershipChanges: shardOwnershipChanges,
+	}
+	resp.HotShards, resp.HotWorkflows = h.hotspotTracker.Report(int(request.GetHotspotLimit()))
+	if traceID := request.GetDebugTraceId(); traceID != "" {
+		resp.DebugTraceSpans = h.debugTraceStore.Get(traceID)
 	}
 	return resp, nil
 }
@@ -1007,6 +1069,15 @@ func (h *Handler) SignalWorkflowExecution(ctx context.Context, request *historys
 	}
 
 	resp, err2 := engine.SignalWorkflowExecution(ctx, request)
+	if _, ok := err2.(*serviceerror.NamespaceNotActive); ok {
+		buffered, err := h.bufferSignalIntent(ctx, shardContext, engine, request)
+		if err != nil {
+			return nil, h.convertError(err)
+		}
+		if buffered {
+			return &historyservice.SignalWorkflowExecutionResponse{}, nil
+		}
+	}
 	if err2 != nil {
 		return nil, h.convertError(err2)
 	}
@@ -1014,6 +1085,38 @@ func (h *Handler) SignalWorkflowExecution(ctx context.Context, request *historys
 	return resp, nil
 }
 
+// MultiSignalWorkflowExecution records a list of signals to a running workflow execution in a single history
+// transaction.
+func (h *Handler) MultiSignalWorkflowExecution(ctx context.Context, request *historyservice.MultiSignalWorkflowExecutionRequest) (_ *historyservice.MultiSignalWorkflowExecutionResponse, retError error) {
+	defer metrics.CapturePanic(h.logger, h.metricsHandler, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if namespaceID == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	workflowID := request.GetWorkflowExecution().GetWorkflowId()
+	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	resp, err := engine.MultiSignalWorkflowExecution(ctx, request)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	return resp, nil
+}
+
 // SignalWithStartWorkflowExecution is used to ensure sending a signal event to a workflow execution.
 // If workflow is running, this results in WorkflowExecutionSignaled event recorded in the history
 // and a workflow task being created for the execution.
@@ -2039,6 +2142,14 @@ func (h *Handler) UpdateWorkflowExecution(
 		return nil, h.convertError(err)
 	}
 
+	resp, buffered, err := h.bufferUpdateIntent(ctx, shardContext, engine, request)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	if buffered {
+		return resp, nil
+	}
+
 	return engine.UpdateWorkflowExecution(ctx, request)
 }
 
@@ -2348,6 +2459,11 @@ func (h *Handler) CompleteNexusOperation(ctx context.Context, request *historyse
 		return nil, errShuttingDown
 	}
 
+	nsName, err := h.namespaceRegistry.GetNamespaceName(namespace.ID(request.Completion.NamespaceId))
+	if err != nil {
+		return nil, err
+	}
+
 	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespace.ID(request.Completion.NamespaceId), request.Completion.WorkflowId)
 	if err != nil {
 		return nil, h.convertError(err)
@@ -2381,6 +2497,10 @@ func (h *Handler) CompleteNexusOperation(ctx context.Context, request *historyse
 		request.Links,
 		request.GetSuccess(),
 		opErr,
+		func(endpointName string, size int) error {
+			return h.nexusPayloadSizeChecker.Check(nsName.String(), endpointName, commonnexus.PayloadTypeResult, size)
+		},
+		h.metricsHandler,
 	)
 	if err != nil {
 		return nil, h.convertError(err)
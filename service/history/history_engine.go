@@ -68,6 +68,7 @@ import (
 	"go.temporal.io/server/service/history/api/isworkflowtaskvalid"
 	"go.temporal.io/server/service/history/api/listtasks"
 	"go.temporal.io/server/service/history/api/multioperation"
+	"go.temporal.io/server/service/history/api/multisignalworkflow"
 	"go.temporal.io/server/service/history/api/pauseactivity"
 	"go.temporal.io/server/service/history/api/pollupdate"
 	"go.temporal.io/server/service/history/api/queryworkflow"
@@ -634,6 +635,13 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 	return signalworkflow.Invoke(ctx, req, e.shardContext, e.workflowConsistencyChecker)
 }
 
+func (e *historyEngineImpl) MultiSignalWorkflowExecution(
+	ctx context.Context,
+	req *historyservice.MultiSignalWorkflowExecutionRequest,
+) (resp *historyservice.MultiSignalWorkflowExecutionResponse, retError error) {
+	return multisignalworkflow.Invoke(ctx, req, e.shardContext, e.workflowConsistencyChecker)
+}
+
 // SignalWithStartWorkflowExecution signals current workflow (if running) or creates & signals a new workflow
 // Consistency guarantee: always write
 func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
@@ -801,6 +809,7 @@ func (e *historyEngineImpl) ImportWorkflowExecution(
 		request.VersionHistory.Items,
 		historyEvents,
 		request.Token,
+		request.MutableState,
 	)
 	if err != nil {
 		return nil, err
@@ -922,6 +931,10 @@ func (e *historyEngineImpl) GetMaxReplicationTaskInfo() (int64, time.Time) {
 	return e.replicationAckMgr.GetMaxTaskInfo()
 }
 
+func (e *historyEngineImpl) GetPendingReplicationTasks() ([]tasks.Task, bool) {
+	return e.replicationAckMgr.GetPendingTasks()
+}
+
 func (e *historyEngineImpl) GetDLQReplicationMessages(
 	ctx context.Context,
 	taskInfos []*replicationspb.ReplicationTaskInfo,
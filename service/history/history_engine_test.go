@@ -667,6 +667,105 @@ func (s *engineSuite) TestQueryWorkflow_WorkflowTaskDispatch_Timeout() {
 	s.False(qr.HasFailedQuery())
 }
 
+func (s *engineSuite) TestQueryWorkflow_DirectlyThroughMatching_EventualConsistencyTimeout() {
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: "TestQueryWorkflow_DirectlyThroughMatching_EventualConsistencyTimeout",
+		RunId:      tests.RunID,
+	}
+	taskqueue := "testTaskQueue"
+	identity := "testIdentity"
+
+	ms := workflow.TestLocalMutableState(s.historyEngine.shardContext, s.eventsCache, tests.LocalNamespaceEntry, execution.GetWorkflowId(), execution.GetRunId(), log.NewTestLogger())
+	addWorkflowExecutionStartedEvent(ms, &execution, "wType", taskqueue, payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, identity)
+	wt := addWorkflowTaskScheduledEvent(ms)
+	startedEvent := addWorkflowTaskStartedEvent(ms, wt.ScheduledEventID, taskqueue, identity)
+	addWorkflowTaskCompletedEvent(&s.Suite, ms, wt.ScheduledEventID, startedEvent.EventId, identity)
+
+	s.config.QueryStrongConsistencyTimeout = func(string) time.Duration {
+		s.Fail("strong consistency timeout is not expected to be read for a query dispatched through matching")
+		return 0
+	}
+	s.config.QueryEventualConsistencyTimeout = func(namespaceName string) time.Duration {
+		s.Equal(tests.Namespace.String(), namespaceName)
+		return time.Minute
+	}
+
+	wfMs := workflow.TestCloneToProto(ms)
+	gweResponse := &persistence.GetWorkflowExecutionResponse{State: wfMs}
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(gweResponse, nil)
+	s.mockMatchingClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, _ *matchingservice.QueryWorkflowRequest, _ ...grpc.CallOption) (*matchingservice.QueryWorkflowResponse, error) {
+			// the configured timeout is shorter than the caller's deadline
+			deadline, ok := ctx.Deadline()
+			s.True(ok)
+			s.WithinDuration(time.Now().Add(time.Minute), deadline, 10*time.Second)
+			return &matchingservice.QueryWorkflowResponse{QueryResult: payloads.EncodeBytes([]byte{1, 2, 3})}, nil
+		},
+	)
+	s.historyEngine.matchingClient = s.mockMatchingClient
+	request := &historyservice.QueryWorkflowRequest{
+		NamespaceId: tests.NamespaceID.String(),
+		Request: &workflowservice.QueryWorkflowRequest{
+			Namespace: tests.Namespace.String(),
+			Execution: &execution,
+			Query:     &querypb.WorkflowQuery{},
+		},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+	resp, err := s.historyEngine.QueryWorkflow(ctx, request)
+	s.NoError(err)
+	s.NotNil(resp.GetResponse().QueryResult)
+}
+
+func (s *engineSuite) TestQueryWorkflow_WorkflowTaskDispatch_StrongConsistencyTimeout() {
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: "TestQueryWorkflow_WorkflowTaskDispatch_StrongConsistencyTimeout",
+		RunId:      tests.RunID,
+	}
+	taskqueue := "testTaskQueue"
+	identity := "testIdentity"
+	ms := workflow.TestLocalMutableState(s.historyEngine.shardContext, s.eventsCache, tests.LocalNamespaceEntry, execution.GetWorkflowId(), execution.GetRunId(), log.NewTestLogger())
+	addWorkflowExecutionStartedEvent(ms, &execution, "wType", taskqueue, payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, identity)
+	wt := addWorkflowTaskScheduledEvent(ms)
+	startedEvent := addWorkflowTaskStartedEvent(ms, wt.ScheduledEventID, taskqueue, identity)
+	addWorkflowTaskCompletedEvent(&s.Suite, ms, wt.ScheduledEventID, startedEvent.EventId, identity)
+	wt = addWorkflowTaskScheduledEvent(ms)
+	addWorkflowTaskStartedEvent(ms, wt.ScheduledEventID, taskqueue, identity)
+
+	s.config.QueryStrongConsistencyTimeout = func(namespaceName string) time.Duration {
+		s.Equal(tests.Namespace.String(), namespaceName)
+		return 100 * time.Millisecond
+	}
+	s.config.QueryEventualConsistencyTimeout = func(string) time.Duration {
+		s.Fail("eventual consistency timeout is not expected to be read for a query dispatched on a workflow task")
+		return 0
+	}
+
+	wfMs := workflow.TestCloneToProto(ms)
+	gweResponse := &persistence.GetWorkflowExecutionResponse{State: wfMs}
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(gweResponse, nil)
+	request := &historyservice.QueryWorkflowRequest{
+		NamespaceId: tests.NamespaceID.String(),
+		Request: &workflowservice.QueryWorkflowRequest{
+			Execution: &execution,
+			Query:     &querypb.WorkflowQuery{},
+		},
+	}
+
+	// the query times out after the configured timeout rather than at the caller's deadline
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+	startTime := time.Now()
+	resp, err := s.historyEngine.QueryWorkflow(ctx, request)
+	s.ErrorIs(err, context.DeadlineExceeded)
+	s.Nil(resp)
+	s.Less(time.Since(startTime), 10*time.Second)
+
+	qr := s.getMutableState(tests.NamespaceID, &execution).GetQueryRegistry()
+	s.False(qr.HasBufferedQuery())
+}
+
 func (s *engineSuite) TestQueryWorkflow_ConsistentQueryBufferFull() {
 	execution := commonpb.WorkflowExecution{
 		WorkflowId: "TestQueryWorkflow_ConsistentQueryBufferFull",
@@ -5340,7 +5439,9 @@ func (s *engineSuite) TestEagerWorkflowStart_DoesNotCreateTransferTask() {
 	i := interceptor.NewTelemetryInterceptor(s.mockShard.GetNamespaceRegistry(),
 		s.mockShard.GetMetricsHandler(),
 		s.mockShard.Resource.Logger,
-		s.config.LogAllReqErrors)
+		s.config.LogAllReqErrors,
+		s.config.SlowRequestLogThresholds,
+		s.config.NumberOfShards)
 	response, err := i.UnaryIntercept(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "StartWorkflowExecution"}, func(ctx context.Context, req interface{}) (interface{}, error) {
 		response, err := s.historyEngine.StartWorkflowExecution(ctx, &historyservice.StartWorkflowExecutionRequest{
 			NamespaceId: tests.NamespaceID.String(),
@@ -5377,7 +5478,9 @@ func (s *engineSuite) TestEagerWorkflowStart_FromCron_SkipsEager() {
 	i := interceptor.NewTelemetryInterceptor(s.mockShard.GetNamespaceRegistry(),
 		s.mockShard.GetMetricsHandler(),
 		s.mockShard.Resource.Logger,
-		s.config.LogAllReqErrors)
+		s.config.LogAllReqErrors,
+		s.config.SlowRequestLogThresholds,
+		s.config.NumberOfShards)
 	response, err := i.UnaryIntercept(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "StartWorkflowExecution"}, func(ctx context.Context, req interface{}) (interface{}, error) {
 		firstWorkflowTaskBackoff := time.Second
 		response, err := s.historyEngine.StartWorkflowExecution(ctx, &historyservice.StartWorkflowExecutionRequest{
@@ -136,10 +136,11 @@ func (s *historyAPISuite) TestDeleteWorkflowExecution_DeleteCurrentExecution() {
 		Status:         enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
 	}, nil)
 	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), &persistence.GetWorkflowExecutionRequest{
-		ShardID:     shardID,
-		NamespaceID: tests.NamespaceID.String(),
-		WorkflowID:  execution.WorkflowId,
-		RunID:       runID,
+		ShardID:         shardID,
+		NamespaceID:     tests.NamespaceID.String(),
+		WorkflowID:      execution.WorkflowId,
+		RunID:           runID,
+		OmitCollections: persistence.MutableStateAllCollections,
 	}).Return(&persistence.GetWorkflowExecutionResponse{State: mutableState}, nil)
 	s.mockExecutionMgr.EXPECT().DeleteCurrentWorkflowExecution(gomock.Any(), &persistence.DeleteCurrentWorkflowExecutionRequest{
 		ShardID:     shardID,
@@ -0,0 +1,378 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package hotspot
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/service/history/configs"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+type (
+	// Usage is the resource usage of a shard or a workflow within a detection window.
+	Usage struct {
+		LockWait     time.Duration
+		TaskCount    int64
+		HistoryBytes int64
+	}
+
+	// Tracker measures the resource usage of the shards and workflows of a history host over
+	// consecutive detection windows. At the end of each window, shards and workflows that used a
+	// disproportionate share of the host total are logged and counted in metrics.
+	//
+	// Recording on a nil Tracker is a no-op.
+	Tracker struct {
+		status            int32
+		config            *configs.Config
+		namespaceRegistry namespace.Registry
+		metricsHandler    metrics.Handler
+		logger            log.Logger
+		shutdownCh        chan struct{}
+
+		sync.RWMutex
+		shards map[int32]*shardUsage
+	}
+
+	shardUsage struct {
+		sync.Mutex
+		current  window
+		previous window
+	}
+
+	window struct {
+		shard     Usage
+		workflows map[workflowKey]*Usage
+	}
+
+	workflowKey struct {
+		namespaceID string
+		workflowID  string
+	}
+
+	hotWorkflow struct {
+		shardID int32
+		key     workflowKey
+		usage   Usage
+		score   float64
+	}
+)
+
+func NewTracker(
+	config *configs.Config,
+	namespaceRegistry namespace.Registry,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) *Tracker {
+	return &Tracker{
+		status:            common.DaemonStatusInitialized,
+		config:            config,
+		namespaceRegistry: namespaceRegistry,
+		metricsHandler:    metricsHandler,
+		logger:            logger,
+		shutdownCh:        make(chan struct{}),
+		shards:            make(map[int32]*shardUsage),
+	}
+}
+
+func (t *Tracker) Start() {
+	if !atomic.CompareAndSwapInt32(&t.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	go t.detectionLoop()
+}
+
+func (t *Tracker) Stop() {
+	if !atomic.CompareAndSwapInt32(&t.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	close(t.shutdownCh)
+}
+
+// RecordLockWait records the time spent waiting for the lock of a workflow.
+func (t *Tracker) RecordLockWait(shardID int32, namespaceID string, workflowID string, wait time.Duration) {
+	t.record(shardID, namespaceID, workflowID, Usage{LockWait: wait})
+}
+
+// RecordTask records the processing of a task of a workflow.
+func (t *Tracker) RecordTask(shardID int32, namespaceID string, workflowID string) {
+	t.record(shardID, namespaceID, workflowID, Usage{TaskCount: 1})
+}
+
+// RecordHistoryWrite records history events of the given size written for a workflow.
+func (t *Tracker) RecordHistoryWrite(shardID int32, namespaceID string, workflowID string, size int64) {
+	if size <= 0 {
+		return
+	}
+	t.record(shardID, namespaceID, workflowID, Usage{HistoryBytes: size})
+}
+
+func (t *Tracker) record(shardID int32, namespaceID string, workflowID string, delta Usage) {
+	if t == nil {
+		return
+	}
+
+	shard := t.getOrCreateShard(shardID)
+	shard.Lock()
+	defer shard.Unlock()
+
+	shard.current.shard.add(delta)
+	key := workflowKey{namespaceID: namespaceID, workflowID: workflowID}
+	usage, ok := shard.current.workflows[key]
+	if !ok {
+		if len(shard.current.workflows) >= t.config.HotspotMaxTrackedWorkflows() {
+			return
+		}
+		usage = &Usage{}
+		shard.current.workflows[key] = usage
+	}
+	usage.add(delta)
+}
+
+func (t *Tracker) getOrCreateShard(shardID int32) *shardUsage {
+	t.RLock()
+	shard, ok := t.shards[shardID]
+	t.RUnlock()
+	if ok {
+		return shard
+	}
+
+	t.Lock()
+	defer t.Unlock()
+	if shard, ok := t.shards[shardID]; ok {
+		return shard
+	}
+	shard = &shardUsage{current: newWindow()}
+	t.shards[shardID] = shard
+	return shard
+}
+
+// Report returns up to limit shards and up to limit workflows with the highest scores, based on
+// the usage of the last complete detection window and of the current one.
+func (t *Tracker) Report(limit int) ([]*historyspb.HotShard, []*historyspb.HotWorkflow) {
+	if t == nil || limit <= 0 {
+		return nil, nil
+	}
+
+	windows := make(map[int32]window)
+	t.RLock()
+	for shardID, shard := range t.shards {
+		shard.Lock()
+		windows[shardID] = shard.previous.merge(shard.current)
+		shard.Unlock()
+	}
+	t.RUnlock()
+
+	shardTotal := totalUsage(windows)
+	var shards []*historyspb.HotShard
+	var workflows []hotWorkflow
+	for shardID, w := range windows {
+		shards = append(shards, &historyspb.HotShard{
+			ShardId: shardID,
+			Usage:   w.shard.toProto(w.shard.score(shardTotal)),
+		})
+		for key, usage := range w.workflows {
+			workflows = append(workflows, hotWorkflow{
+				shardID: shardID,
+				key:     key,
+				usage:   *usage,
+				score:   usage.score(shardTotal),
+			})
+		}
+	}
+
+	slices.SortFunc(shards, func(a, b *historyspb.HotShard) int {
+		return cmp.Compare(b.GetUsage().GetScore(), a.GetUsage().GetScore())
+	})
+	slices.SortFunc(workflows, func(a, b hotWorkflow) int {
+		return cmp.Compare(b.score, a.score)
+	})
+
+	hotWorkflows := make([]*historyspb.HotWorkflow, 0, min(limit, len(workflows)))
+	for _, w := range workflows[:min(limit, len(workflows))] {
+		nsName, _ := t.namespaceRegistry.GetNamespaceName(namespace.ID(w.key.namespaceID))
+		hotWorkflows = append(hotWorkflows, &historyspb.HotWorkflow{
+			ShardId:     w.shardID,
+			NamespaceId: w.key.namespaceID,
+			Namespace:   nsName.String(),
+			WorkflowId:  w.key.workflowID,
+			Usage:       w.usage.toProto(w.score),
+		})
+	}
+	return shards[:min(limit, len(shards))], hotWorkflows
+}
+
+func (t *Tracker) detectionLoop() {
+	timer := time.NewTimer(t.config.HotspotDetectionWindow())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-t.shutdownCh:
+			return
+		case <-timer.C:
+			t.detect(t.rotate())
+			timer.Reset(t.config.HotspotDetectionWindow())
+		}
+	}
+}
+
+// rotate starts a new detection window for every shard and returns the windows that ended.
+// Shards without usage in the ended window are no longer tracked.
+func (t *Tracker) rotate() map[int32]window {
+	t.Lock()
+	defer t.Unlock()
+
+	windows := make(map[int32]window, len(t.shards))
+	for shardID, shard := range t.shards {
+		shard.Lock()
+		shard.previous = shard.current
+		shard.current = newWindow()
+		shard.Unlock()
+
+		if shard.previous.shard == (Usage{}) {
+			delete(t.shards, shardID)
+			continue
+		}
+		windows[shardID] = shard.previous
+	}
+	return windows
+}
+
+// detect logs and counts the shards and workflows of windows whose score is at least
+// HotspotScoreFactor times their even share of the host usage.
+func (t *Tracker) detect(windows map[int32]window) {
+	total := totalUsage(windows)
+	factor := t.config.HotspotScoreFactor()
+
+	numWorkflows := 0
+	for _, w := range windows {
+		numWorkflows += len(w.workflows)
+	}
+	minWorkflowScore := max(factor/float64(numWorkflows), t.config.HotWorkflowMinScore())
+
+	for shardID, w := range windows {
+		if len(windows) > 1 {
+			if score := w.shard.score(total); score >= factor/float64(len(windows)) {
+				metrics.HotShardsDetected.With(t.metricsHandler).Record(1)
+				t.logger.Warn("Hot shard detected.",
+					tag.ShardID(shardID),
+					tag.NewFloat64("hotspot-score", score),
+					tag.NewDurationTag("lock-wait", w.shard.LockWait),
+					tag.NewInt64("task-count", w.shard.TaskCount),
+					tag.NewInt64("history-bytes", w.shard.HistoryBytes),
+				)
+			}
+		}
+		if numWorkflows <= 1 {
+			continue
+		}
+		for key, usage := range w.workflows {
+			score := usage.score(total)
+			if score < minWorkflowScore {
+				continue
+			}
+			nsName, _ := t.namespaceRegistry.GetNamespaceName(namespace.ID(key.namespaceID))
+			metrics.HotWorkflowsDetected.With(t.metricsHandler).Record(1, metrics.NamespaceTag(nsName.String()))
+			t.logger.Warn("Hot workflow detected.",
+				tag.ShardID(shardID),
+				tag.WorkflowNamespaceID(key.namespaceID),
+				tag.WorkflowID(key.workflowID),
+				tag.NewFloat64("hotspot-score", score),
+				tag.NewDurationTag("lock-wait", usage.LockWait),
+				tag.NewInt64("task-count", usage.TaskCount),
+				tag.NewInt64("history-bytes", usage.HistoryBytes),
+			)
+		}
+	}
+}
+
+func newWindow() window {
+	return window{workflows: make(map[workflowKey]*Usage)}
+}
+
+// merge returns a new window with the usage of both w and other.
+func (w window) merge(other window) window {
+	merged := newWindow()
+	merged.shard.add(w.shard)
+	merged.shard.add(other.shard)
+	for _, workflows := range []map[workflowKey]*Usage{w.workflows, other.workflows} {
+		for key, usage := range workflows {
+			if _, ok := merged.workflows[key]; !ok {
+				merged.workflows[key] = &Usage{}
+			}
+			merged.workflows[key].add(*usage)
+		}
+	}
+	return merged
+}
+
+func totalUsage(windows map[int32]window) Usage {
+	var total Usage
+	for _, w := range windows {
+		total.add(w.shard)
+	}
+	return total
+}
+
+func (u *Usage) add(other Usage) {
+	u.LockWait += other.LockWait
+	u.TaskCount += other.TaskCount
+	u.HistoryBytes += other.HistoryBytes
+}
+
+// score returns the largest share of total that u accounts for across the tracked resources.
+func (u Usage) score(total Usage) float64 {
+	var score float64
+	if total.LockWait > 0 {
+		score = max(score, float64(u.LockWait)/float64(total.LockWait))
+	}
+	if total.TaskCount > 0 {
+		score = max(score, float64(u.TaskCount)/float64(total.TaskCount))
+	}
+	if total.HistoryBytes > 0 {
+		score = max(score, float64(u.HistoryBytes)/float64(total.HistoryBytes))
+	}
+	return score
+}
+
+func (u Usage) toProto(score float64) *historyspb.ResourceUsage {
+	return &historyspb.ResourceUsage{
+		LockWait:     durationpb.New(u.LockWait),
+		TaskCount:    u.TaskCount,
+		HistoryBytes: u.HistoryBytes,
+		Score:        score,
+	}
+}
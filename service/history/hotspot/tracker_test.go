@@ -0,0 +1,117 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package hotspot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/service/history/configs"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestTracker(t *testing.T, handler metrics.Handler) *Tracker {
+	config := configs.NewConfig(dynamicconfig.NewNoopCollection(), 4)
+	config.HotspotMaxTrackedWorkflows = dynamicconfig.GetIntPropertyFn(3)
+	config.HotspotScoreFactor = dynamicconfig.GetFloatPropertyFn(1.5)
+	config.HotWorkflowMinScore = dynamicconfig.GetFloatPropertyFn(0.5)
+
+	registry := namespace.NewMockRegistry(gomock.NewController(t))
+	registry.EXPECT().GetNamespaceName(namespace.ID("ns-id")).Return(namespace.Name("ns"), nil).AnyTimes()
+	return NewTracker(config, registry, handler, log.NewNoopLogger())
+}
+
+func TestTracker_Report(t *testing.T) {
+	tracker := newTestTracker(t, metrics.NoopMetricsHandler)
+
+	tracker.RecordTask(1, "ns-id", "wf-1")
+	tracker.RecordTask(1, "ns-id", "wf-1")
+	tracker.RecordLockWait(2, "ns-id", "wf-3", time.Second)
+	tracker.RecordTask(2, "ns-id", "wf-2")
+	tracker.rotate()
+	tracker.RecordHistoryWrite(2, "ns-id", "wf-2", 300)
+	tracker.RecordHistoryWrite(2, "ns-id", "wf-3", 100)
+
+	shards, workflows := tracker.Report(2)
+	require.Len(t, shards, 2)
+	require.Equal(t, int32(2), shards[0].GetShardId())
+	require.Equal(t, 1.0, shards[0].GetUsage().GetScore())
+	require.Equal(t, int64(400), shards[0].GetUsage().GetHistoryBytes())
+	require.Equal(t, int32(1), shards[1].GetShardId())
+	require.InDelta(t, 2.0/3, shards[1].GetUsage().GetScore(), 0.001)
+
+	require.Len(t, workflows, 2)
+	require.Equal(t, "wf-3", workflows[0].GetWorkflowId())
+	require.Equal(t, "ns", workflows[0].GetNamespace())
+	require.Equal(t, time.Second, workflows[0].GetUsage().GetLockWait().AsDuration())
+	require.Equal(t, "wf-2", workflows[1].GetWorkflowId())
+	require.Equal(t, 0.75, workflows[1].GetUsage().GetScore())
+
+	shards, workflows = tracker.Report(0)
+	require.Empty(t, shards)
+	require.Empty(t, workflows)
+}
+
+func TestTracker_MaxTrackedWorkflows(t *testing.T) {
+	tracker := newTestTracker(t, metrics.NoopMetricsHandler)
+
+	for _, workflowID := range []string{"wf-1", "wf-2", "wf-3", "wf-4"} {
+		tracker.RecordTask(1, "ns-id", workflowID)
+	}
+
+	shards, workflows := tracker.Report(10)
+	require.Len(t, shards, 1)
+	require.Equal(t, int64(4), shards[0].GetUsage().GetTaskCount())
+	require.Len(t, workflows, 3)
+}
+
+func TestTracker_Detect(t *testing.T) {
+	handler := metricstest.NewCaptureHandler()
+	capture := handler.StartCapture()
+	defer handler.StopCapture(capture)
+	tracker := newTestTracker(t, handler)
+
+	for range 8 {
+		tracker.RecordTask(1, "ns-id", "hot")
+	}
+	tracker.RecordTask(2, "ns-id", "cold-1")
+	tracker.RecordTask(3, "ns-id", "cold-2")
+	tracker.detect(tracker.rotate())
+
+	shardRecordings := capture.Snapshot()[metrics.HotShardsDetected.Name()]
+	require.Len(t, shardRecordings, 1)
+	workflowRecordings := capture.Snapshot()[metrics.HotWorkflowsDetected.Name()]
+	require.Len(t, workflowRecordings, 1)
+	require.Equal(t, "ns", workflowRecordings[0].Tags[metrics.NamespaceTag("").Key()])
+
+	// shards without usage in a window are no longer tracked
+	tracker.RecordTask(1, "ns-id", "hot")
+	tracker.rotate()
+	require.Len(t, tracker.shards, 1)
+}
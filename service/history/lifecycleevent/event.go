@@ -0,0 +1,87 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lifecycleevent
+
+import (
+	"fmt"
+	"time"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+)
+
+type (
+	// EventType identifies the lifecycle transition an Event records.
+	EventType string
+
+	// Event is a single workflow lifecycle event. It is serialized as JSON by all sinks.
+	//
+	// Events are delivered at least once. ID is the same for every delivery of an event and
+	// should be used by consumers to deduplicate.
+	Event struct {
+		ID           string    `json:"id"`
+		Type         EventType `json:"type"`
+		Time         time.Time `json:"time"`
+		Namespace    string    `json:"namespace"`
+		NamespaceID  string    `json:"namespaceId"`
+		WorkflowID   string    `json:"workflowId"`
+		RunID        string    `json:"runId"`
+		WorkflowType string    `json:"workflowType"`
+		TaskQueue    string    `json:"taskQueue"`
+		// Status is the workflow execution status, e.g. "Completed", "Terminated" or
+		// "ContinuedAsNew" for closed events.
+		Status    string     `json:"status"`
+		StartTime time.Time  `json:"startTime"`
+		CloseTime *time.Time `json:"closeTime,omitempty"`
+		// NewRunID is the run that continues a workflow closed as ContinuedAsNew.
+		NewRunID string `json:"newRunId,omitempty"`
+		// BaseRunID and Reason are set for reset events.
+		BaseRunID string `json:"baseRunId,omitempty"`
+		Reason    string `json:"reason,omitempty"`
+	}
+)
+
+const (
+	EventTypeStarted EventType = "started"
+	EventTypeClosed  EventType = "closed"
+	EventTypeReset   EventType = "reset"
+)
+
+// EventTypeFromProto converts the event type persisted with a lifecycle event task.
+func EventTypeFromProto(eventType enumsspb.WorkflowLifecycleEventType) (EventType, error) {
+	switch eventType {
+	case enumsspb.WORKFLOW_LIFECYCLE_EVENT_TYPE_STARTED:
+		return EventTypeStarted, nil
+	case enumsspb.WORKFLOW_LIFECYCLE_EVENT_TYPE_CLOSED:
+		return EventTypeClosed, nil
+	case enumsspb.WORKFLOW_LIFECYCLE_EVENT_TYPE_RESET:
+		return EventTypeReset, nil
+	default:
+		return "", fmt.Errorf("unknown workflow lifecycle event type: %v", eventType)
+	}
+}
+
+// EventID returns the ID of the event of the given type for a run. A run has at most one event
+// of every type.
+func EventID(runID string, eventType EventType) string {
+	return runID + "/" + string(eventType)
+}
@@ -0,0 +1,102 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lifecycleevent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+// Publisher delivers workflow lifecycle events to sinks selected by name.
+type Publisher struct {
+	sinks          map[string]Sink
+	logger         log.Logger
+	metricsHandler metrics.Handler
+}
+
+// NewPublisherFromConfig creates the sinks of cfg and returns a Publisher delivering to them.
+func NewPublisherFromConfig(
+	ctx context.Context,
+	cfg *config.WorkflowLifecycleEvents,
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+) (*Publisher, error) {
+	sinks := make(map[string]Sink, len(cfg.Sinks))
+	for name, sinkConfig := range cfg.Sinks {
+		sink, err := NewSink(ctx, sinkConfig)
+		if err != nil {
+			return nil, fmt.Errorf("workflow lifecycle event sink %q: %w", name, err)
+		}
+		sinks[name] = sink
+	}
+	return NewPublisher(sinks, logger, metricsHandler), nil
+}
+
+// NewPublisher returns a Publisher delivering to sinks, keyed by name.
+func NewPublisher(sinks map[string]Sink, logger log.Logger, metricsHandler metrics.Handler) *Publisher {
+	return &Publisher{
+		sinks:          sinks,
+		logger:         logger,
+		metricsHandler: metricsHandler,
+	}
+}
+
+// Publish delivers event to every sink in sinkNames. Names that are not configured are logged
+// and skipped. An error is returned if delivering to any sink failed, in which case the caller
+// retries the whole event, so sinks that succeeded may receive it again.
+func (p *Publisher) Publish(ctx context.Context, sinkNames []string, event *Event) error {
+	var errs []error
+	for _, name := range sinkNames {
+		sink, ok := p.sinks[name]
+		if !ok {
+			p.logger.Warn("Unknown workflow lifecycle event sink.",
+				tag.WorkflowNamespace(event.Namespace),
+				tag.NewStringTag("sink", name),
+			)
+			continue
+		}
+		handler := p.metricsHandler.WithTags(metrics.NamespaceTag(event.Namespace), metrics.DestinationTag(name))
+		if err := sink.Publish(ctx, event); err != nil {
+			metrics.WorkflowLifecycleEventPublishFailed.With(handler).Record(1)
+			errs = append(errs, fmt.Errorf("%s: %w", sink.Name(), err))
+			continue
+		}
+		metrics.WorkflowLifecycleEventPublished.With(handler).Record(1)
+	}
+	return errors.Join(errs...)
+}
+
+// Close releases the resources of all sinks.
+func (p *Publisher) Close() error {
+	var errs []error
+	for _, sink := range p.sinks {
+		errs = append(errs, sink.Close())
+	}
+	return errors.Join(errs...)
+}
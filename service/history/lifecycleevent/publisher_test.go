@@ -0,0 +1,68 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lifecycleevent
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+)
+
+type testSink struct {
+	err    error
+	events []*Event
+}
+
+func (s *testSink) Name() string { return "test" }
+
+func (s *testSink) Publish(_ context.Context, event *Event) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.events = append(s.events, event)
+	return nil
+}
+
+func (s *testSink) Close() error { return nil }
+
+func TestPublisher(t *testing.T) {
+	a, b, unused := &testSink{}, &testSink{}, &testSink{}
+	publisher := NewPublisher(map[string]Sink{"a": a, "b": b, "unused": unused}, log.NewNoopLogger(), metrics.NoopMetricsHandler)
+
+	require.NoError(t, publisher.Publish(context.Background(), []string{"a", "b", "missing"}, testEvent))
+	require.Equal(t, []*Event{testEvent}, a.events)
+	require.Equal(t, []*Event{testEvent}, b.events)
+	require.Empty(t, unused.events)
+}
+
+func TestPublisher_SinkError(t *testing.T) {
+	ok, failing := &testSink{}, &testSink{err: errors.New("unavailable")}
+	publisher := NewPublisher(map[string]Sink{"ok": ok, "failing": failing}, log.NewNoopLogger(), metrics.NoopMetricsHandler)
+
+	require.ErrorContains(t, publisher.Publish(context.Background(), []string{"failing", "ok"}, testEvent), "unavailable")
+	require.Equal(t, []*Event{testEvent}, ok.events)
+}
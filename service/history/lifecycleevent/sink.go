@@ -0,0 +1,156 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lifecycleevent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/eventsink"
+	"golang.org/x/oauth2/google"
+)
+
+const (
+	SinkTypeWebhook = "webhook"
+	SinkTypeKafka   = "kafka"
+	SinkTypePubSub  = "pubsub"
+
+	defaultPubSubURL = "https://pubsub.googleapis.com"
+	pubSubScope      = "https://www.googleapis.com/auth/pubsub"
+)
+
+type (
+	// Sink delivers workflow lifecycle events to an external system. Publish is retried by the
+	// lifecycle event queue on error, so a sink must tolerate receiving the same event more than once.
+	Sink interface {
+		Name() string
+		Publish(ctx context.Context, event *Event) error
+		Close() error
+	}
+
+	httpSink struct {
+		*eventsink.HTTPClient
+		encode func(event *Event) ([]byte, error)
+	}
+
+	pubSubMessage struct {
+		// Data is encoded as base64 as required by the Pub/Sub API.
+		Data       []byte            `json:"data"`
+		Attributes map[string]string `json:"attributes"`
+	}
+
+	pubSubPublishRequest struct {
+		Messages []pubSubMessage `json:"messages"`
+	}
+)
+
+// NewSink creates a sink from its config.
+func NewSink(ctx context.Context, cfg config.WorkflowLifecycleEventSink) (Sink, error) {
+	switch strings.ToLower(cfg.Type) {
+	case SinkTypeWebhook:
+		if cfg.URL == "" {
+			return nil, fmt.Errorf("workflow lifecycle event sink %q requires a url", cfg.Type)
+		}
+		return NewWebhookSink(cfg.URL, cfg.Headers, cfg.Timeout), nil
+	case SinkTypeKafka:
+		if cfg.URL == "" || cfg.Topic == "" {
+			return nil, fmt.Errorf("workflow lifecycle event sink %q requires a url and a topic", cfg.Type)
+		}
+		return NewKafkaSink(cfg.URL, cfg.Topic, cfg.Headers, cfg.Timeout), nil
+	case SinkTypePubSub:
+		if cfg.Topic == "" {
+			return nil, fmt.Errorf("workflow lifecycle event sink %q requires a topic", cfg.Type)
+		}
+		return NewPubSubSink(ctx, cfg.URL, cfg.Topic, cfg.Headers, cfg.Timeout)
+	default:
+		return nil, fmt.Errorf("unknown workflow lifecycle event sink type: %q", cfg.Type)
+	}
+}
+
+// NewWebhookSink returns a sink that POSTs every event to url as a JSON object.
+func NewWebhookSink(url string, headers map[string]string, timeout time.Duration) Sink {
+	return &httpSink{
+		HTTPClient: eventsink.NewHTTPClient(SinkTypeWebhook, url, "application/json", headers, nil, timeout),
+		encode: func(event *Event) ([]byte, error) {
+			return json.Marshal(event)
+		},
+	}
+}
+
+// NewKafkaSink returns a sink that produces every event as a record to topic through the
+// Kafka REST proxy listening at url. Records are keyed by workflow ID so that the events of a
+// workflow land on the same partition.
+func NewKafkaSink(url string, topic string, headers map[string]string, timeout time.Duration) Sink {
+	return &httpSink{
+		HTTPClient: eventsink.NewHTTPClient(SinkTypeKafka, eventsink.KafkaTopicURL(url, topic), eventsink.KafkaContentType, headers, nil, timeout),
+		encode: func(event *Event) ([]byte, error) {
+			return json.Marshal(eventsink.KafkaRecords[*Event]{Records: []eventsink.KafkaRecord[*Event]{{Key: event.WorkflowID, Value: event}}})
+		},
+	}
+}
+
+// NewPubSubSink returns a sink that publishes every event as a message to the Google Cloud
+// Pub/Sub topic, named "projects/<project>/topics/<topic>", through the Pub/Sub REST API.
+// Requests to the default endpoint are authorized with Google default credentials.
+func NewPubSubSink(ctx context.Context, url string, topic string, headers map[string]string, timeout time.Duration) (Sink, error) {
+	var client *http.Client
+	if url == "" {
+		url = defaultPubSubURL
+		var err error
+		if client, err = google.DefaultClient(ctx, pubSubScope); err != nil {
+			return nil, fmt.Errorf("unable to load Google credentials for Pub/Sub: %w", err)
+		}
+	}
+	url = strings.TrimSuffix(url, "/") + "/v1/" + topic + ":publish"
+	return &httpSink{
+		HTTPClient: eventsink.NewHTTPClient(SinkTypePubSub, url, "application/json", headers, client, timeout),
+		encode: func(event *Event) ([]byte, error) {
+			data, err := json.Marshal(event)
+			if err != nil {
+				return nil, err
+			}
+			return json.Marshal(pubSubPublishRequest{Messages: []pubSubMessage{{
+				Data: data,
+				Attributes: map[string]string{
+					"id":         event.ID,
+					"type":       string(event.Type),
+					"namespace":  event.Namespace,
+					"workflowId": event.WorkflowID,
+				},
+			}}})
+		},
+	}, nil
+}
+
+func (s *httpSink) Publish(ctx context.Context, event *Event) error {
+	body, err := s.encode(event)
+	if err != nil {
+		return err
+	}
+	return s.Post(ctx, body)
+}
@@ -0,0 +1,133 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lifecycleevent
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/eventsink"
+)
+
+var testEvent = &Event{
+	ID:           EventID("run-id", EventTypeClosed),
+	Type:         EventTypeClosed,
+	Time:         time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	Namespace:    "ns",
+	NamespaceID:  "ns-id",
+	WorkflowID:   "wf-id",
+	RunID:        "run-id",
+	WorkflowType: "wf-type",
+	TaskQueue:    "tq",
+	Status:       "ContinuedAsNew",
+	StartTime:    time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	NewRunID:     "new-run-id",
+}
+
+func TestWebhookSink(t *testing.T) {
+	var received *Event
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.Equal(t, "secret", r.Header.Get("X-Token"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+	}))
+	defer server.Close()
+
+	sink, err := NewSink(context.Background(), config.WorkflowLifecycleEventSink{
+		Type:    SinkTypeWebhook,
+		URL:     server.URL,
+		Headers: map[string]string{"X-Token": "secret"},
+	})
+	require.NoError(t, err)
+	require.NoError(t, sink.Publish(context.Background(), testEvent))
+	require.Equal(t, testEvent, received)
+}
+
+func TestKafkaSink(t *testing.T) {
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/topics/workflows", r.URL.Path)
+		require.Equal(t, eventsink.KafkaContentType, r.Header.Get("Content-Type"))
+		var err error
+		body, err = io.ReadAll(r.Body)
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	sink, err := NewSink(context.Background(), config.WorkflowLifecycleEventSink{
+		Type:  SinkTypeKafka,
+		URL:   server.URL + "/",
+		Topic: "workflows",
+	})
+	require.NoError(t, err)
+	require.NoError(t, sink.Publish(context.Background(), testEvent))
+
+	var records eventsink.KafkaRecords[*Event]
+	require.NoError(t, json.Unmarshal(body, &records))
+	require.Len(t, records.Records, 1)
+	require.Equal(t, "wf-id", records.Records[0].Key)
+	require.Equal(t, testEvent, records.Records[0].Value)
+}
+
+func TestPubSubSink(t *testing.T) {
+	var request pubSubPublishRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/projects/p/topics/workflows:publish", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+	}))
+	defer server.Close()
+
+	sink, err := NewSink(context.Background(), config.WorkflowLifecycleEventSink{
+		Type:  SinkTypePubSub,
+		URL:   server.URL,
+		Topic: "projects/p/topics/workflows",
+	})
+	require.NoError(t, err)
+	require.NoError(t, sink.Publish(context.Background(), testEvent))
+
+	require.Len(t, request.Messages, 1)
+	require.Equal(t, testEvent.ID, request.Messages[0].Attributes["id"])
+	require.Equal(t, "closed", request.Messages[0].Attributes["type"])
+	var event *Event
+	require.NoError(t, json.Unmarshal(request.Messages[0].Data, &event))
+	require.Equal(t, testEvent, event)
+}
+
+func TestNewSink_InvalidConfig(t *testing.T) {
+	for _, cfg := range []config.WorkflowLifecycleEventSink{
+		{Type: "unknown"},
+		{Type: SinkTypeWebhook},
+		{Type: SinkTypeKafka, URL: "http://localhost"},
+		{Type: SinkTypePubSub, URL: "http://localhost"},
+	} {
+		_, err := NewSink(context.Background(), cfg)
+		require.Error(t, err, cfg.Type)
+	}
+}
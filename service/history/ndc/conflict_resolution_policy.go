@@ -0,0 +1,63 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ndc
+
+import (
+	"go.temporal.io/server/common/namespace"
+)
+
+type ConflictResolutionPolicy string
+
+const (
+	// ConflictResolutionPolicyDataKey is the namespace data key used to select the conflict resolution policy.
+	// Namespace data is replicated along with the namespace, so all clusters resolve a conflict the same way.
+	ConflictResolutionPolicyDataKey = "temporal.replication.conflictResolutionPolicy"
+
+	// ConflictResolutionPolicyLastWriterWins keeps the workflow with the higher last write version and reapplies
+	// events of a losing branch to the current workflow. This is the default policy.
+	ConflictResolutionPolicyLastWriterWins ConflictResolutionPolicy = "last-writer-wins"
+	// ConflictResolutionPolicyPreferActiveCluster keeps the run started by the cluster owning the higher of the two
+	// last write versions when two runs of the same workflow conflict, falling back to last-writer-wins when neither
+	// or both were. The decision only uses replicated failover versions, never the local namespace active cluster.
+	// Branches within a single run are still resolved by version.
+	ConflictResolutionPolicyPreferActiveCluster ConflictResolutionPolicy = "prefer-active-cluster"
+	// ConflictResolutionPolicyTerminateAndReset resolves like last-writer-wins, but always resets the current
+	// workflow to reapply events of a losing branch, terminating the current run if it is still running.
+	ConflictResolutionPolicyTerminateAndReset ConflictResolutionPolicy = "terminate-and-reset"
+)
+
+// GetConflictResolutionPolicy returns the conflict resolution policy selected by the namespace.
+// Unknown values fall back to last-writer-wins.
+func GetConflictResolutionPolicy(ns *namespace.Namespace) ConflictResolutionPolicy {
+	if ns == nil {
+		return ConflictResolutionPolicyLastWriterWins
+	}
+	switch policy := ConflictResolutionPolicy(ns.GetCustomData(ConflictResolutionPolicyDataKey)); policy {
+	case ConflictResolutionPolicyPreferActiveCluster, ConflictResolutionPolicyTerminateAndReset:
+		return policy
+	default:
+		return ConflictResolutionPolicyLastWriterWins
+	}
+}
@@ -26,12 +26,14 @@ package ndc
 
 import (
 	"context"
+	"fmt"
 
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
 	historyspb "go.temporal.io/server/api/history/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/log"
@@ -51,6 +53,7 @@ type (
 			versionHistoryItems []*historyspb.VersionHistoryItem,
 			events [][]*historypb.HistoryEvent,
 			token []byte,
+			mutableState *persistencespb.WorkflowMutableState,
 		) ([]byte, bool, error)
 	}
 
@@ -130,10 +133,14 @@ func (r *HistoryImporterImpl) ImportWorkflow(
 	versionHistoryItems []*historyspb.VersionHistoryItem,
 	eventsSlice [][]*historypb.HistoryEvent,
 	token []byte,
+	mutableState *persistencespb.WorkflowMutableState,
 ) (_ []byte, _ bool, retError error) {
 	if len(eventsSlice) == 0 && len(token) == 0 {
 		return nil, false, serviceerror.NewInvalidArgument("ImportWorkflowExecution cannot import empty history events")
 	}
+	if len(eventsSlice) != 0 && mutableState != nil {
+		return nil, false, serviceerror.NewInvalidArgument("ImportWorkflowExecution can only import mutable state when committing")
+	}
 
 	ndcWorkflow, mutableStateSpec, err := r.mutableStateInitializer.Initialize(ctx, workflowKey, token)
 	if err != nil {
@@ -162,6 +169,7 @@ func (r *HistoryImporterImpl) ImportWorkflow(
 		ctx,
 		ndcWorkflow,
 		mutableStateSpec,
+		mutableState,
 	); err != nil {
 		return nil, false, err
 	}
@@ -341,12 +349,16 @@ func (r *HistoryImporterImpl) commit(
 	ctx context.Context,
 	memNDCWorkflow Workflow,
 	mutableStateSpec MutableStateInitializationSpec,
+	importedMutableState *persistencespb.WorkflowMutableState,
 ) (retError error) {
 	if mutableStateSpec.IsBrandNew {
 		return serviceerror.NewInvalidArgument("HistoryImporter::commit cannot create workflow without events")
 	}
 
 	if !mutableStateSpec.ExistsInDB {
+		if err := r.applyMutableState(memNDCWorkflow, importedMutableState); err != nil {
+			return err
+		}
 		// refresh tasks to be generated
 		if err := r.taskRefresher.Refresh(
 			ctx,
@@ -413,6 +425,9 @@ func (r *HistoryImporterImpl) commit(
 	}
 
 	if cmpResult < 0 {
+		if importedMutableState != nil {
+			return serviceerror.NewInvalidArgument("HistoryImporter::commit cannot import mutable state of a non-current branch")
+		}
 		// imported events does not belong to current branch, update DB mutable state with new version history
 		updated, _, err := versionhistory.AddAndSwitchVersionHistory(
 			dbNDCWorkflow.GetMutableState().GetExecutionInfo().GetVersionHistories(),
@@ -437,6 +452,9 @@ func (r *HistoryImporterImpl) commit(
 
 	// cmpResult > 0
 	dbNDCWorkflow.GetContext().Clear()
+	if err := r.applyMutableState(memNDCWorkflow, importedMutableState); err != nil {
+		return err
+	}
 	// imported events is the new current branch, update write to DB
 	// refresh tasks to be generated
 	if err := r.taskRefresher.Refresh(
@@ -459,3 +477,133 @@ func (r *HistoryImporterImpl) commit(
 	}
 	return nil
 }
+
+// applyMutableState replaces the state rebuilt from the imported history with the given mutable state, e.g. to keep
+// activity attempts and heartbeat details which are not recorded in history. The mutable state is only accepted if it
+// is consistent with the imported history, see validateImportedMutableState, and keeps the history branch and version
+// histories of the imported execution.
+func (r *HistoryImporterImpl) applyMutableState(
+	memNDCWorkflow Workflow,
+	importedMutableState *persistencespb.WorkflowMutableState,
+) error {
+	if importedMutableState == nil {
+		return nil
+	}
+
+	memMutableState := memNDCWorkflow.GetMutableState()
+	if err := validateImportedMutableState(memMutableState, importedMutableState); err != nil {
+		return err
+	}
+
+	// The execution may be imported into another namespace than the one it was read from.
+	snapshot := common.CloneProto(importedMutableState)
+	workflowKey := memNDCWorkflow.GetContext().GetWorkflowKey()
+	snapshot.ExecutionInfo.NamespaceId = workflowKey.NamespaceID
+	snapshot.ExecutionInfo.WorkflowId = workflowKey.WorkflowID
+	snapshot.ExecutionState.RunId = workflowKey.RunID
+
+	if err := memMutableState.ApplySnapshot(snapshot); err != nil {
+		return err
+	}
+	// tasks are refreshed by the caller
+	memMutableState.PopTasks()
+	return nil
+}
+
+// validateImportedMutableState checks that the imported mutable state describes the same execution as the mutable
+// state rebuilt from the imported history: it must end at the same event on the same version history, be in the same
+// state, have no buffered events, and have exactly the pending activities, timers, child executions, cancel and signal
+// requests that the history has.
+func validateImportedMutableState(
+	memMutableState workflow.MutableState,
+	importedMutableState *persistencespb.WorkflowMutableState,
+) error {
+	invalidArgument := func(format string, args ...any) error {
+		return serviceerror.NewInvalidArgument("HistoryImporter::commit invalid mutable state: " + fmt.Sprintf(format, args...))
+	}
+
+	if importedMutableState.GetExecutionInfo() == nil {
+		return invalidArgument("missing execution info")
+	}
+	if importedMutableState.GetExecutionState() == nil {
+		return invalidArgument("missing execution state")
+	}
+
+	memCurrentVersionHistory, err := versionhistory.GetCurrentVersionHistory(
+		memMutableState.GetExecutionInfo().GetVersionHistories(),
+	)
+	if err != nil {
+		return err
+	}
+	memLastItem, err := versionhistory.GetLastVersionHistoryItem(memCurrentVersionHistory)
+	if err != nil {
+		return err
+	}
+	importedCurrentVersionHistory, err := versionhistory.GetCurrentVersionHistory(
+		importedMutableState.GetExecutionInfo().GetVersionHistories(),
+	)
+	if err != nil {
+		return invalidArgument("%v", err)
+	}
+	importedLastItem, err := versionhistory.GetLastVersionHistoryItem(importedCurrentVersionHistory)
+	if err != nil {
+		return invalidArgument("%v", err)
+	}
+	if !versionhistory.IsEqualVersionHistoryItems(memCurrentVersionHistory.GetItems(), importedCurrentVersionHistory.GetItems()) {
+		return invalidArgument(
+			"mutable state ends at event %d version %d, but imported history ends at event %d version %d",
+			importedLastItem.GetEventId(),
+			importedLastItem.GetVersion(),
+			memLastItem.GetEventId(),
+			memLastItem.GetVersion(),
+		)
+	}
+	if nextEventID := importedMutableState.GetNextEventId(); nextEventID != 0 && nextEventID != memLastItem.GetEventId()+1 {
+		return invalidArgument("next event ID %d does not follow last imported event %d", nextEventID, memLastItem.GetEventId())
+	}
+
+	memExecutionState := memMutableState.GetExecutionState()
+	importedExecutionState := importedMutableState.GetExecutionState()
+	if importedExecutionState.GetState() != memExecutionState.GetState() ||
+		importedExecutionState.GetStatus() != memExecutionState.GetStatus() {
+		return invalidArgument(
+			"execution is %v/%v, but imported history is %v/%v",
+			importedExecutionState.GetState(),
+			importedExecutionState.GetStatus(),
+			memExecutionState.GetState(),
+			memExecutionState.GetStatus(),
+		)
+	}
+	if len(importedMutableState.GetBufferedEvents()) > 0 {
+		return invalidArgument("%d buffered events are not part of the imported history", len(importedMutableState.GetBufferedEvents()))
+	}
+
+	if !sameKeys(importedMutableState.GetActivityInfos(), memMutableState.GetPendingActivityInfos()) {
+		return invalidArgument("pending activities do not match imported history")
+	}
+	if !sameKeys(importedMutableState.GetTimerInfos(), memMutableState.GetPendingTimerInfos()) {
+		return invalidArgument("pending timers do not match imported history")
+	}
+	if !sameKeys(importedMutableState.GetChildExecutionInfos(), memMutableState.GetPendingChildExecutionInfos()) {
+		return invalidArgument("pending child executions do not match imported history")
+	}
+	if !sameKeys(importedMutableState.GetRequestCancelInfos(), memMutableState.GetPendingRequestCancelExternalInfos()) {
+		return invalidArgument("pending cancel requests do not match imported history")
+	}
+	if !sameKeys(importedMutableState.GetSignalInfos(), memMutableState.GetPendingSignalExternalInfos()) {
+		return invalidArgument("pending signal requests do not match imported history")
+	}
+	return nil
+}
+
+func sameKeys[K comparable, V1, V2 any](m1 map[K]V1, m2 map[K]V2) bool {
+	if len(m1) != len(m2) {
+		return false
+	}
+	for k := range m1 {
+		if _, ok := m2[k]; !ok {
+			return false
+		}
+	}
+	return true
+}
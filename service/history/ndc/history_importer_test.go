@@ -0,0 +1,152 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ndc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/service/history/workflow"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestValidateImportedMutableState(t *testing.T) {
+	versionHistories := func(lastEventID int64) *historyspb.VersionHistories {
+		return versionhistory.NewVersionHistories(versionhistory.NewVersionHistory(
+			[]byte("branch-token"),
+			[]*historyspb.VersionHistoryItem{versionhistory.NewVersionHistoryItem(lastEventID, 1)},
+		))
+	}
+	runningState := &persistencespb.WorkflowExecutionState{
+		State:  enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+		Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+	}
+	validMutableState := func() *persistencespb.WorkflowMutableState {
+		return &persistencespb.WorkflowMutableState{
+			ExecutionInfo:  &persistencespb.WorkflowExecutionInfo{VersionHistories: versionHistories(10)},
+			ExecutionState: common.CloneProto(runningState),
+			NextEventId:    11,
+			ActivityInfos:  map[int64]*persistencespb.ActivityInfo{5: {ScheduledEventId: 5, Attempt: 3}},
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		mutate        func(*persistencespb.WorkflowMutableState)
+		expectedError string
+	}{
+		{
+			name:   "valid",
+			mutate: func(*persistencespb.WorkflowMutableState) {},
+		},
+		{
+			name:          "missing execution info",
+			mutate:        func(ms *persistencespb.WorkflowMutableState) { ms.ExecutionInfo = nil },
+			expectedError: "missing execution info",
+		},
+		{
+			name:          "missing execution state",
+			mutate:        func(ms *persistencespb.WorkflowMutableState) { ms.ExecutionState = nil },
+			expectedError: "missing execution state",
+		},
+		{
+			name: "different version history",
+			mutate: func(ms *persistencespb.WorkflowMutableState) {
+				ms.ExecutionInfo.VersionHistories = versionHistories(12)
+			},
+			expectedError: "mutable state ends at event 12 version 1, but imported history ends at event 10 version 1",
+		},
+		{
+			name:          "next event ID after history",
+			mutate:        func(ms *persistencespb.WorkflowMutableState) { ms.NextEventId = 13 },
+			expectedError: "next event ID 13 does not follow last imported event 10",
+		},
+		{
+			name: "different state",
+			mutate: func(ms *persistencespb.WorkflowMutableState) {
+				ms.ExecutionState.State = enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED
+				ms.ExecutionState.Status = enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
+			},
+			expectedError: "execution is Completed/Completed, but imported history is Running/Running",
+		},
+		{
+			name: "buffered events",
+			mutate: func(ms *persistencespb.WorkflowMutableState) {
+				ms.BufferedEvents = append(ms.BufferedEvents, &historypb.HistoryEvent{EventId: 11})
+			},
+			expectedError: "1 buffered events are not part of the imported history",
+		},
+		{
+			name: "activity not in history",
+			mutate: func(ms *persistencespb.WorkflowMutableState) {
+				ms.ActivityInfos[7] = &persistencespb.ActivityInfo{ScheduledEventId: 7}
+			},
+			expectedError: "pending activities do not match imported history",
+		},
+		{
+			name: "timer not in history",
+			mutate: func(ms *persistencespb.WorkflowMutableState) {
+				ms.TimerInfos = map[string]*persistencespb.TimerInfo{"timer": {TimerId: "timer"}}
+			},
+			expectedError: "pending timers do not match imported history",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			memMutableState := workflow.NewMockMutableState(controller)
+			memMutableState.EXPECT().GetExecutionInfo().Return(
+				&persistencespb.WorkflowExecutionInfo{VersionHistories: versionHistories(10)},
+			).AnyTimes()
+			memMutableState.EXPECT().GetExecutionState().Return(runningState).AnyTimes()
+			memMutableState.EXPECT().GetPendingActivityInfos().Return(
+				map[int64]*persistencespb.ActivityInfo{5: {ScheduledEventId: 5, Attempt: 1}},
+			).AnyTimes()
+			memMutableState.EXPECT().GetPendingTimerInfos().Return(nil).AnyTimes()
+			memMutableState.EXPECT().GetPendingChildExecutionInfos().Return(nil).AnyTimes()
+			memMutableState.EXPECT().GetPendingRequestCancelExternalInfos().Return(nil).AnyTimes()
+			memMutableState.EXPECT().GetPendingSignalExternalInfos().Return(nil).AnyTimes()
+
+			importedMutableState := validMutableState()
+			tc.mutate(importedMutableState)
+			original := common.CloneProto(importedMutableState)
+
+			err := validateImportedMutableState(memMutableState, importedMutableState)
+			if tc.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectedError)
+			}
+			require.True(t, proto.Equal(original, importedMutableState))
+		})
+	}
+}
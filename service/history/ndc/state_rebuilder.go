@@ -254,12 +254,13 @@ func (r *StateRebuilderImpl) getPaginationFn(
 	nextEventID int64,
 	branchToken []byte,
 ) collection.PaginationFn[HistoryBlobsPaginationItem] {
+	pageSizer := persistence.NewHistoryPageSizer(defaultPageSize, r.shard.GetConfig().HistoryReadMaxPageBytes())
 	return func(paginationToken []byte) ([]HistoryBlobsPaginationItem, []byte, error) {
 		resp, err := r.executionMgr.ReadHistoryBranchByBatch(ctx, &persistence.ReadHistoryBranchRequest{
 			BranchToken:   branchToken,
 			MinEventID:    firstEventID,
 			MaxEventID:    nextEventID,
-			PageSize:      defaultPageSize,
+			PageSize:      pageSizer.PageSize(),
 			NextPageToken: paginationToken,
 			ShardID:       r.shard.GetShardID(),
 		})
@@ -268,6 +269,7 @@ func (r *StateRebuilderImpl) getPaginationFn(
 		}
 
 		r.rebuiltHistorySize += int64(resp.Size)
+		pageSizer.Record(len(resp.History), resp.Size)
 		paginateItems := make([]HistoryBlobsPaginationItem, 0, len(resp.History))
 		for i, history := range resp.History {
 			nextBatch := HistoryBlobsPaginationItem{
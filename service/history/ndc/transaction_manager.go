@@ -281,14 +281,16 @@ func (r *transactionMgrImpl) backfillWorkflowEventsReapply(
 		return 0, workflow.TransactionPolicyActive, err
 	}
 	isWorkflowRunning := targetWorkflow.GetMutableState().IsWorkflowExecutionRunning()
-	targetWorkflowActiveCluster := targetWorkflow.GetMutableState().GetNamespaceEntry().ActiveClusterName()
+	namespaceEntry := targetWorkflow.GetMutableState().GetNamespaceEntry()
+	targetWorkflowActiveCluster := namespaceEntry.ActiveClusterName()
 	currentCluster := r.clusterMetadata.GetCurrentClusterName()
 	isActiveCluster := targetWorkflowActiveCluster == currentCluster
+	conflictResolutionPolicy := GetConflictResolutionPolicy(namespaceEntry)
 
 	// workflow events reapplication
 	// we need to handle 3 cases
 	// 1. target workflow is self & self being current & active
-	//  a. workflow still running -> just reapply
+	//  a. workflow still running -> just reapply, unless namespace uses terminate-and-reset policy
 	//  b. workflow closed -> reset current workflow & reapply
 	// 2. anything not case 1 -> find the current & active workflow to reapply
 
@@ -300,7 +302,7 @@ func (r *transactionMgrImpl) backfillWorkflowEventsReapply(
 		}
 
 		// case 1.a
-		if isWorkflowRunning {
+		if isWorkflowRunning && conflictResolutionPolicy != ConflictResolutionPolicyTerminateAndReset {
 			if _, err := r.eventsReapplier.ReapplyEvents(
 				ctx,
 				targetWorkflow.GetMutableState(),
@@ -310,12 +312,13 @@ func (r *transactionMgrImpl) backfillWorkflowEventsReapply(
 			); err != nil {
 				return 0, workflow.TransactionPolicyActive, err
 			}
+			emitConflictResolvedMetric(r.metricsHandler, namespaceEntry)
 			return persistence.UpdateWorkflowModeUpdateCurrent, workflow.TransactionPolicyActive, nil
 		}
 
 		// case 1.b
 		// need to reset target workflow (which is also the current workflow)
-		// to accept events to be reapplied
+		// to accept events to be reapplied, the reset terminates it if still running
 		baseMutableState := targetWorkflow.GetMutableState()
 		namespaceID := namespace.ID(baseMutableState.GetExecutionInfo().NamespaceId)
 		workflowID := baseMutableState.GetExecutionInfo().WorkflowId
@@ -361,6 +364,7 @@ func (r *transactionMgrImpl) backfillWorkflowEventsReapply(
 		case nil:
 			// after the reset of target workflow (current workflow) with additional events to be reapplied
 			// target workflow is no longer the current workflow
+			emitConflictResolvedMetric(r.metricsHandler, namespaceEntry)
 			return persistence.UpdateWorkflowModeBypassCurrent, workflow.TransactionPolicyPassive, nil
 		default:
 			return 0, workflow.TransactionPolicyActive, err
@@ -383,6 +387,19 @@ func (r *transactionMgrImpl) backfillWorkflowEventsReapply(
 	return persistence.UpdateWorkflowModeBypassCurrent, workflow.TransactionPolicyPassive, nil
 }
 
+// emitConflictResolvedMetric records that a conflict between two branches or two runs of a workflow was
+// resolved, it must only be called once the losing side has actually been suppressed or reapplied.
+func emitConflictResolvedMetric(
+	metricsHandler metrics.Handler,
+	namespaceEntry *namespace.Namespace,
+) {
+	metrics.ReplicationConflictResolvedCount.With(metricsHandler).Record(
+		1,
+		metrics.NamespaceTag(namespaceEntry.Name().String()),
+		metrics.ConflictResolutionPolicyTag(string(GetConflictResolutionPolicy(namespaceEntry))),
+	)
+}
+
 func (r *transactionMgrImpl) CheckWorkflowExists(
 	ctx context.Context,
 	namespaceID namespace.ID,
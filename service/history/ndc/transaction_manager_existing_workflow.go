@@ -158,13 +158,21 @@ func (r *nDCTransactionMgrForExistingWorkflowImpl) dispatchForExistingWorkflow(
 
 	// isWorkflowRebuilt is irrelevant here, because the DB API to be used
 	// will set target workflow using snapshot
-	return r.executeTransaction(
+	currentWorkflowRunning := currentWorkflow.GetMutableState().IsWorkflowExecutionRunning()
+	if err := r.executeTransaction(
 		ctx,
 		nDCTransactionPolicySuppressCurrentAndUpdateAsCurrent,
 		currentWorkflow,
 		targetWorkflow,
 		newWorkflow,
-	)
+	); err != nil {
+		return err
+	}
+	if currentWorkflowRunning {
+		// a running current workflow lost to the target workflow
+		emitConflictResolvedMetric(r.shardContext.GetMetricsHandler(), targetWorkflow.GetMutableState().GetNamespaceEntry())
+	}
+	return nil
 }
 
 func (r *nDCTransactionMgrForExistingWorkflowImpl) dispatchWorkflowUpdateAsCurrent(
@@ -141,12 +141,16 @@ func (r *nDCTransactionMgrForNewWorkflowImpl) dispatchForNewWorkflow(
 	}
 
 	// current workflow is still running, need to suppress the current workflow
-	return r.executeTransaction(
+	if err := r.executeTransaction(
 		ctx,
 		nDCTransactionPolicySuppressCurrentAndCreateAsCurrent,
 		currentWorkflow,
 		targetWorkflow,
-	)
+	); err != nil {
+		return err
+	}
+	emitConflictResolvedMetric(r.shardContext.GetMetricsHandler(), targetWorkflow.GetMutableState().GetNamespaceEntry())
+	return nil
 }
 
 func (r *nDCTransactionMgrForNewWorkflowImpl) createAsCurrent(
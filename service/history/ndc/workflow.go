@@ -128,6 +128,43 @@ func (r *WorkflowImpl) HappensAfter(
 		return false, err
 	}
 
+	return r.happensAfter(
+		that,
+		thisLastWriteVersion,
+		thisLastEventTaskID,
+		thatLastWriteVersion,
+		thatLastEventTaskID,
+	)
+}
+
+// happensAfter is WorkflowHappensAfter with the namespace conflict resolution policy applied.
+func (r *WorkflowImpl) happensAfter(
+	that Workflow,
+	thisLastWriteVersion int64,
+	thisLastEventTaskID int64,
+	thatLastWriteVersion int64,
+	thatLastEventTaskID int64,
+) (bool, error) {
+
+	if GetConflictResolutionPolicy(r.mutableState.GetNamespaceEntry()) == ConflictResolutionPolicyPreferActiveCluster {
+		thisStartVersion, err := r.mutableState.GetStartVersion()
+		if err != nil {
+			return false, err
+		}
+		thatStartVersion, err := that.GetMutableState().GetStartVersion()
+		if err != nil {
+			return false, err
+		}
+		if preferred, ok := r.preferActiveCluster(
+			thisStartVersion,
+			thisLastWriteVersion,
+			thatStartVersion,
+			thatLastWriteVersion,
+		); ok {
+			return preferred, nil
+		}
+	}
+
 	return WorkflowHappensAfter(
 		thisLastWriteVersion,
 		thisLastEventTaskID,
@@ -136,6 +173,27 @@ func (r *WorkflowImpl) HappensAfter(
 	), nil
 }
 
+// preferActiveCluster resolves a conflict between two runs under the prefer-active-cluster policy.
+// The active cluster is the one owning the higher of the two last write versions, and the run started by
+// that cluster wins. Only replicated failover versions are used, so that every cluster reaches the same
+// result regardless of its local view of the namespace active cluster.
+// The second return value is false if neither or both runs were started by that cluster.
+func (r *WorkflowImpl) preferActiveCluster(
+	thisStartVersion int64,
+	thisLastWriteVersion int64,
+	thatStartVersion int64,
+	thatLastWriteVersion int64,
+) (bool, bool) {
+
+	activeCluster := r.clusterMetadata.ClusterNameForFailoverVersion(true, max(thisLastWriteVersion, thatLastWriteVersion))
+	thisStartedByActive := r.clusterMetadata.ClusterNameForFailoverVersion(true, thisStartVersion) == activeCluster
+	thatStartedByActive := r.clusterMetadata.ClusterNameForFailoverVersion(true, thatStartVersion) == activeCluster
+	if thisStartedByActive == thatStartedByActive {
+		return false, false
+	}
+	return thisStartedByActive, true
+}
+
 func (r *WorkflowImpl) Revive() error {
 
 	state, _ := r.mutableState.GetWorkflowStateStatus()
@@ -177,12 +235,17 @@ func (r *WorkflowImpl) SuppressBy(
 		return workflow.TransactionPolicyActive, err
 	}
 
-	if WorkflowHappensAfter(
+	happensAfter, err := r.happensAfter(
+		incomingWorkflow,
 		lastWriteVersion,
 		lastEventTaskID,
 		incomingLastWriteVersion,
 		incomingLastEventTaskID,
-	) {
+	)
+	if err != nil {
+		return workflow.TransactionPolicyActive, err
+	}
+	if happensAfter {
 		return workflow.TransactionPolicyActive, serviceerror.NewInternal("Workflow cannot suppress workflow by older workflow")
 	}
 
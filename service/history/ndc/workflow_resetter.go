@@ -333,6 +333,15 @@ func (r *workflowResetterImpl) prepareResetWorkflow(
 		return nil, err
 	}
 
+	if err := workflow.NewTaskGenerator(
+		r.namespaceRegistry,
+		resetMutableState,
+		r.shardContext.GetConfig(),
+		r.shardContext.GetArchivalMetadata(),
+	).GenerateWorkflowResetLifecycleEventTask(baseRunID, resetReason); err != nil {
+		return nil, err
+	}
+
 	return resetWorkflow, nil
 }
 
@@ -1061,19 +1070,21 @@ func (r *workflowResetterImpl) getPaginationFn(
 	branchToken []byte,
 ) collection.PaginationFn[*historypb.History] {
 
+	pageSizer := persistence.NewHistoryPageSizer(defaultPageSize, r.shardContext.GetConfig().HistoryReadMaxPageBytes())
 	return func(paginationToken []byte) ([]*historypb.History, []byte, error) {
 
 		resp, err := r.executionMgr.ReadHistoryBranchByBatch(ctx, &persistence.ReadHistoryBranchRequest{
 			BranchToken:   branchToken,
 			MinEventID:    firstEventID,
 			MaxEventID:    nextEventID,
-			PageSize:      defaultPageSize,
+			PageSize:      pageSizer.PageSize(),
 			NextPageToken: paginationToken,
 			ShardID:       r.shardContext.GetShardID(),
 		})
 		if err != nil {
 			return nil, nil, err
 		}
+		pageSizer.Record(len(resp.History), resp.Size)
 		return resp.History, resp.NextPageToken, nil
 	}
 }
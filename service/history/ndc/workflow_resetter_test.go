@@ -40,12 +40,14 @@ import (
 	updatepb "go.temporal.io/api/update/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	clockspb "go.temporal.io/server/api/clock/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/collection"
 	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/failure"
 	"go.temporal.io/server/common/locks"
 	"go.temporal.io/server/common/log"
@@ -59,6 +61,7 @@ import (
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/hsm"
 	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/history/tests"
 	"go.temporal.io/server/service/history/workflow"
 	wcache "go.temporal.io/server/service/history/workflow/cache"
@@ -1449,6 +1452,7 @@ func (s *workflowResetterSuite) TestWorkflowRestartAfterExecutionTimeout() {
 	resetMutableState.EXPECT().RefreshExpirationTimeoutTask(ctx).Return(nil).AnyTimes()
 	resetMutableState.EXPECT().GetPendingChildExecutionInfos().Return(executionInfos)
 	resetMutableState.EXPECT().GetPendingWorkflowTask().Return(workflowTaskSchedule).AnyTimes()
+	resetMutableState.EXPECT().GetNamespaceEntry().Return(tests.GlobalNamespaceEntry).AnyTimes()
 	smReg := hsm.NewRegistry()
 	s.NoError(workflow.RegisterStateMachine(smReg))
 	root, err := hsm.NewRoot(smReg, workflow.StateMachineType, nil, make(map[string]*persistencespb.StateMachineMap), nil)
@@ -1500,3 +1504,89 @@ func (s *workflowResetterSuite) TestWorkflowRestartAfterExecutionTimeout() {
 	s.NoError(err)
 	s.Equal(resetMutableState, resetWorkflow.GetMutableState())
 }
+
+func (s *workflowResetterSuite) TestPrepareResetWorkflow_WorkflowLifecycleEvent() {
+	ctx := context.Background()
+	baseBranchToken := []byte("some random base branch token")
+	baseRebuildLastEventID := int64(1234)
+	baseRebuildLastEventVersion := int64(12)
+	resetWorkflowVersion := int64(0)
+	resetReason := "some random reset reason"
+	resetBranchToken := []byte("some random reset branch token")
+	resetRequestID := uuid.New()
+	resetHistorySize := int64(4411)
+	resetMutableState := workflow.NewMockMutableState(s.controller)
+
+	s.mockShard.GetConfig().WorkflowLifecycleEventSinks = dynamicconfig.GetTypedPropertyFnFilteredByNamespace([]string{"kafka"})
+
+	s.mockExecutionMgr.EXPECT().ForkHistoryBranch(gomock.Any(), gomock.Any()).Return(
+		&persistence.ForkHistoryBranchResponse{NewBranchToken: resetBranchToken}, nil,
+	)
+	s.mockStateRebuilder.EXPECT().Rebuild(
+		ctx,
+		gomock.Any(),
+		gomock.Any(),
+		baseBranchToken,
+		baseRebuildLastEventID,
+		util.Ptr(baseRebuildLastEventVersion),
+		gomock.Any(),
+		resetBranchToken,
+		resetRequestID,
+	).Return(resetMutableState, resetHistorySize, nil)
+
+	resetWorkflowKey := definition.NewWorkflowKey(s.namespaceID.String(), s.workflowID, s.resetRunID)
+	resetMutableState.EXPECT().SetBaseWorkflow(s.baseRunID, baseRebuildLastEventID, baseRebuildLastEventVersion)
+	resetMutableState.EXPECT().AddHistorySize(resetHistorySize)
+	resetMutableState.EXPECT().GetCurrentVersion().Return(resetWorkflowVersion).AnyTimes()
+	resetMutableState.EXPECT().UpdateCurrentVersion(resetWorkflowVersion, false).Return(nil).AnyTimes()
+	resetMutableState.EXPECT().GetExecutionState().Return(&persistencespb.WorkflowExecutionState{
+		RunId:  s.resetRunID,
+		Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+	}).AnyTimes()
+	resetMutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{}).AnyTimes()
+	resetMutableState.EXPECT().GetPendingActivityInfos().Return(map[int64]*persistencespb.ActivityInfo{})
+	resetMutableState.EXPECT().RefreshExpirationTimeoutTask(ctx).Return(nil).AnyTimes()
+	resetMutableState.EXPECT().GetPendingChildExecutionInfos().Return(map[int64]*persistencespb.ChildExecutionInfo{})
+	workflowTask := &workflow.WorkflowTaskInfo{
+		ScheduledEventID: baseRebuildLastEventID - 1,
+		StartedEventID:   baseRebuildLastEventID,
+		RequestID:        uuid.New(),
+	}
+	resetMutableState.EXPECT().GetPendingWorkflowTask().Return(workflowTask).AnyTimes()
+	resetMutableState.EXPECT().AddWorkflowTaskFailedEvent(
+		workflowTask,
+		enumspb.WORKFLOW_TASK_FAILED_CAUSE_RESET_WORKFLOW,
+		gomock.Any(),
+		consts.IdentityHistoryService,
+		nil,
+		"",
+		s.baseRunID,
+		s.resetRunID,
+		baseRebuildLastEventVersion,
+	).Return(&historypb.HistoryEvent{}, nil)
+	resetMutableState.EXPECT().GetNamespaceEntry().Return(tests.GlobalNamespaceEntry).AnyTimes()
+	resetMutableState.EXPECT().GetWorkflowKey().Return(resetWorkflowKey).AnyTimes()
+	resetMutableState.EXPECT().AddTasks(&tasks.WorkflowLifecycleEventTask{
+		WorkflowKey: resetWorkflowKey,
+		Version:     resetWorkflowVersion,
+		EventType:   enumsspb.WORKFLOW_LIFECYCLE_EVENT_TYPE_RESET,
+		BaseRunID:   s.baseRunID,
+		Reason:      resetReason,
+	})
+
+	_, err := s.workflowResetter.prepareResetWorkflow(
+		ctx,
+		s.namespaceID,
+		s.workflowID,
+		s.baseRunID,
+		baseBranchToken,
+		baseRebuildLastEventID,
+		baseRebuildLastEventVersion,
+		s.resetRunID,
+		resetRequestID,
+		resetWorkflowVersion,
+		resetReason,
+		false, // allowResetWithPendingChildren
+	)
+	s.NoError(err)
+}
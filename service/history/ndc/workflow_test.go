@@ -37,7 +37,9 @@ import (
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/service/history/consts"
+	"go.temporal.io/server/service/history/tests"
 	"go.temporal.io/server/service/history/workflow"
 	wcache "go.temporal.io/server/service/history/workflow/cache"
 	"go.uber.org/mock/gomock"
@@ -70,6 +72,7 @@ func (s *workflowSuite) SetupTest() {
 	s.controller = gomock.NewController(s.T())
 	s.mockContext = workflow.NewMockContext(s.controller)
 	s.mockMutableState = workflow.NewMockMutableState(s.controller)
+	s.mockMutableState.EXPECT().GetNamespaceEntry().Return(tests.GlobalNamespaceEntry).AnyTimes()
 	s.mockClusterMetadata = cluster.NewMockMetadata(s.controller)
 	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
 
@@ -173,6 +176,63 @@ func (s *workflowSuite) TestHappensAfter_SameVersion_LatrgerTaskID() {
 	))
 }
 
+func (s *workflowSuite) TestHappensAfter_PreferActiveCluster() {
+	namespaceEntry := namespace.NewGlobalNamespaceForTest(
+		&persistencespb.NamespaceInfo{
+			Id:   s.namespaceID,
+			Name: "some random namespace name",
+			Data: map[string]string{ConflictResolutionPolicyDataKey: string(ConflictResolutionPolicyPreferActiveCluster)},
+		},
+		&persistencespb.NamespaceConfig{},
+		&persistencespb.NamespaceReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters:          []string{cluster.TestCurrentClusterName, cluster.TestAlternativeClusterName},
+		},
+		int64(1),
+	)
+
+	newWorkflow := func(startVersion int64, lastWriteVersion int64) *WorkflowImpl {
+		mutableState := workflow.NewMockMutableState(s.controller)
+		mutableState.EXPECT().GetNamespaceEntry().Return(namespaceEntry).AnyTimes()
+		mutableState.EXPECT().IsWorkflowExecutionRunning().Return(true).AnyTimes()
+		mutableState.EXPECT().GetStartVersion().Return(startVersion, nil).AnyTimes()
+		mutableState.EXPECT().GetLastWriteVersion().Return(lastWriteVersion, nil).AnyTimes()
+		mutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{
+			LastEventTaskId: int64(100),
+		}).AnyTimes()
+		return NewWorkflow(s.mockClusterMetadata, s.mockContext, mutableState, wcache.NoopReleaseFn)
+	}
+	s.mockClusterMetadata.EXPECT().ClusterNameForFailoverVersion(true, int64(1)).Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockClusterMetadata.EXPECT().ClusterNameForFailoverVersion(true, int64(2)).Return(cluster.TestAlternativeClusterName).AnyTimes()
+	s.mockClusterMetadata.EXPECT().ClusterNameForFailoverVersion(true, int64(11)).Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockClusterMetadata.EXPECT().ClusterNameForFailoverVersion(true, int64(12)).Return(cluster.TestAlternativeClusterName).AnyTimes()
+
+	// the run started by the cluster owning the highest write version wins over a run with a higher
+	// last write version, no matter which cluster the namespace entry says is active
+	startedByActive := newWorkflow(2, 2)
+	startedByPassive := newWorkflow(1, 12)
+
+	happensAfter, err := startedByActive.HappensAfter(startedByPassive)
+	s.NoError(err)
+	s.True(happensAfter)
+
+	happensAfter, err = startedByPassive.HappensAfter(startedByActive)
+	s.NoError(err)
+	s.False(happensAfter)
+
+	// both runs started by the same cluster fall back to last writer wins
+	older := newWorkflow(1, 1)
+	newer := newWorkflow(1, 11)
+
+	happensAfter, err = newer.HappensAfter(older)
+	s.NoError(err)
+	s.True(happensAfter)
+
+	happensAfter, err = older.HappensAfter(newer)
+	s.NoError(err)
+	s.False(happensAfter)
+}
+
 func (s *workflowSuite) TestSuppressWorkflowBy_Error() {
 	nDCWorkflow := NewWorkflow(
 		s.mockClusterMetadata,
@@ -270,6 +270,7 @@ func (f *outboundQueueFactory) CreateQueue(
 		f.Config.TaskDLQUnexpectedErrorAttempts,
 		f.Config.TaskDLQInternalErrors,
 		f.Config.TaskDLQErrorPattern,
+		f.executableTracingOption(shardContext.GetShardID()),
 	)
 	return queues.NewImmediateQueue(
 		shardContext,
@@ -27,6 +27,8 @@ package history
 import (
 	"context"
 
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/dynamicconfig"
@@ -41,6 +43,7 @@ import (
 	"go.temporal.io/server/common/quotas/calculator"
 	"go.temporal.io/server/service/history/circuitbreakerpool"
 	"go.temporal.io/server/service/history/configs"
+	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/queues"
 	"go.temporal.io/server/service/history/replication/eventhandler"
 	"go.temporal.io/server/service/history/shard"
@@ -80,6 +83,8 @@ type (
 		ExecutorWrapper      queues.ExecutorWrapper `optional:"true"`
 		Serializer           serialization.Serializer
 		RemoteHistoryFetcher eventhandler.HistoryPaginatedFetcher
+		TracerProvider       trace.TracerProvider `optional:"true"`
+		TaskSpanLinks        *tasks.SpanLinks     `optional:"true"`
 	}
 
 	QueueFactoryBase struct {
@@ -149,6 +154,7 @@ func getOptionalQueueFactories(
 	registry tasks.TaskCategoryRegistry,
 	archivalParams ArchivalQueueFactoryParams,
 	outboundParams outboundQueueFactoryParams,
+	workflowLifecycleEventParams workflowLifecycleEventQueueFactoryParams,
 	config *configs.Config,
 ) additionalQueueFactories {
 	factories := []QueueFactory{}
@@ -158,6 +164,9 @@ func getOptionalQueueFactories(
 	if config.EnableNexus() {
 		factories = append(factories, NewOutboundQueueFactory(outboundParams))
 	}
+	if _, ok := registry.GetCategoryByID(tasks.CategoryIDWorkflowLifecycleEvent); ok {
+		factories = append(factories, NewWorkflowLifecycleEventQueueFactory(workflowLifecycleEventParams))
+	}
 	return additionalQueueFactories{
 		Factories: factories,
 	}
@@ -226,6 +235,15 @@ func QueueFactoryLifetimeHooks(
 	)
 }
 
+// executableTracingOption returns the option that makes executables of the given shard emit spans.
+func (p QueueFactoryBaseParams) executableTracingOption(shardID int32) queues.ExecutableOption {
+	tracerProvider := p.TracerProvider
+	if tracerProvider == nil {
+		tracerProvider = noop.NewTracerProvider()
+	}
+	return queues.WithTracing(tracerProvider.Tracer(consts.LibraryName), p.TaskSpanLinks, shardID)
+}
+
 func (f *QueueFactoryBase) Start() {
 	if f.HostScheduler != nil {
 		f.HostScheduler.Start()
@@ -44,6 +44,7 @@ import (
 	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/service/history/archival"
 	"go.temporal.io/server/service/history/configs"
+	"go.temporal.io/server/service/history/lifecycleevent"
 	"go.temporal.io/server/service/history/replication/eventhandler"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/tasks"
@@ -100,6 +101,7 @@ func (c *moduleTestCase) Run(t *testing.T) {
 		tiq QueueFactory
 		viq QueueFactory
 		aq  QueueFactory
+		lq  QueueFactory
 	)
 	for _, f := range factories {
 		switch f.(type) {
@@ -115,11 +117,15 @@ func (c *moduleTestCase) Run(t *testing.T) {
 		case *archivalQueueFactory:
 			require.Nil(t, aq)
 			aq = f
+		case *workflowLifecycleEventQueueFactory:
+			require.Nil(t, lq)
+			lq = f
 		}
 	}
 	require.NotNil(t, txq)
 	require.NotNil(t, tiq)
 	require.NotNil(t, viq)
+	require.NotNil(t, lq)
 	if c.ExpectArchivalQueue {
 		require.NotNil(t, aq)
 	} else {
@@ -173,4 +179,5 @@ type unusedDependencies struct {
 	archival.Archiver
 	workflow.RelocatableAttributesFetcher
 	persistence.HistoryTaskQueueManager
+	*lifecycleevent.Publisher
 }
@@ -36,6 +36,10 @@ import (
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/common"
@@ -178,6 +182,8 @@ type (
 		maxUnexpectedErrorAttempts dynamicconfig.IntPropertyFn
 		dlqInternalErrors          dynamicconfig.BoolPropertyFn
 		dlqErrorPattern            dynamicconfig.StringPropertyFn
+		tracer                     trace.Tracer
+		spanLinks                  []trace.Link
 	}
 	ExecutableParams struct {
 		DLQEnabled                 dynamicconfig.BoolPropertyFn
@@ -185,6 +191,8 @@ type (
 		MaxUnexpectedErrorAttempts dynamicconfig.IntPropertyFn
 		DLQInternalErrors          dynamicconfig.BoolPropertyFn
 		DLQErrorPattern            dynamicconfig.StringPropertyFn
+		Tracer                     trace.Tracer
+		SpanLinks                  func(task tasks.Task) []trace.Link
 	}
 	ExecutableOption func(*ExecutableParams)
 )
@@ -217,6 +225,10 @@ func NewExecutable(
 		DLQErrorPattern: func() string {
 			return ""
 		},
+		Tracer: noop.NewTracerProvider().Tracer(""),
+		SpanLinks: func(tasks.Task) []trace.Link {
+			return nil
+		},
 	}
 	for _, opt := range opts {
 		opt(&params)
@@ -246,6 +258,8 @@ func NewExecutable(
 		maxUnexpectedErrorAttempts: params.MaxUnexpectedErrorAttempts,
 		dlqInternalErrors:          params.DLQInternalErrors,
 		dlqErrorPattern:            params.DLQErrorPattern,
+		tracer:                     params.Tracer,
+		spanLinks:                  params.SpanLinks(task),
 	}
 	executable.updatePriority()
 	return executable
@@ -277,6 +291,15 @@ func (e *executableImpl) Execute() (retErr error) {
 	)
 	e.Unlock()
 
+	ctx, span := e.startSpan(ctx)
+	defer func() {
+		if retErr != nil {
+			span.RecordError(retErr)
+			span.SetStatus(codes.Error, retErr.Error())
+		}
+		span.End()
+	}()
+
 	defer func() {
 		if panicObj := recover(); panicObj != nil {
 			err, ok := panicObj.(error)
@@ -348,6 +371,24 @@ func (e *executableImpl) Execute() (retErr error) {
 	return resp.ExecutionErr
 }
 
+// startSpan starts the span of an execution attempt. The span is linked to the span of the request which generated
+// the task, if known, and is the parent of all RPCs and persistence requests made by the attempt.
+func (e *executableImpl) startSpan(ctx context.Context) (context.Context, trace.Span) {
+	return e.tracer.Start(
+		ctx,
+		"queue/"+e.GetType().String(),
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithLinks(e.spanLinks...),
+		trace.WithAttributes(
+			attribute.String("temporalWorkflowID", e.GetWorkflowID()),
+			attribute.String("temporalRunID", e.GetRunID()),
+			attribute.String("temporal.task.category", e.GetCategory().Name()),
+			attribute.Int64("temporal.task.id", e.GetTaskID()),
+			attribute.Int("temporal.task.attempt", e.Attempt()),
+		),
+	)
+}
+
 func (e *executableImpl) writeToDLQ(ctx context.Context) error {
 
 	currentClusterName := e.clusterMetadata.GetCurrentClusterName()
@@ -25,6 +25,7 @@
 package queues
 
 import (
+	"go.opentelemetry.io/otel/trace"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/dynamicconfig"
@@ -57,6 +58,7 @@ type (
 		attemptsBeforeSendingToDlq dynamicconfig.IntPropertyFn
 		dlqInternalErrors          dynamicconfig.BoolPropertyFn
 		dlqErrorPattern            dynamicconfig.StringPropertyFn
+		opts                       []ExecutableOption
 	}
 )
 
@@ -79,6 +81,7 @@ func NewExecutableFactory(
 	attemptsBeforeSendingToDlq dynamicconfig.IntPropertyFn,
 	dlqInternalErrors dynamicconfig.BoolPropertyFn,
 	dlqErrorPattern dynamicconfig.StringPropertyFn,
+	opts ...ExecutableOption,
 ) *executableFactoryImpl {
 	return &executableFactoryImpl{
 		executor:                   executor,
@@ -95,10 +98,20 @@ func NewExecutableFactory(
 		attemptsBeforeSendingToDlq: attemptsBeforeSendingToDlq,
 		dlqInternalErrors:          dlqInternalErrors,
 		dlqErrorPattern:            dlqErrorPattern,
+		opts:                       opts,
 	}
 }
 
 func (f *executableFactoryImpl) NewExecutable(task tasks.Task, readerID int64) Executable {
+	opts := append([]ExecutableOption{
+		func(params *ExecutableParams) {
+			params.DLQEnabled = f.dlqEnabled
+			params.DLQWriter = f.dlqWriter
+			params.MaxUnexpectedErrorAttempts = f.attemptsBeforeSendingToDlq
+			params.DLQInternalErrors = f.dlqInternalErrors
+			params.DLQErrorPattern = f.dlqErrorPattern
+		},
+	}, f.opts...)
 	return NewExecutable(
 		readerID,
 		task,
@@ -111,12 +124,17 @@ func (f *executableFactoryImpl) NewExecutable(task tasks.Task, readerID int64) E
 		f.clusterMetadata,
 		f.logger,
 		f.metricsHandler,
-		func(params *ExecutableParams) {
-			params.DLQEnabled = f.dlqEnabled
-			params.DLQWriter = f.dlqWriter
-			params.MaxUnexpectedErrorAttempts = f.attemptsBeforeSendingToDlq
-			params.DLQInternalErrors = f.dlqInternalErrors
-			params.DLQErrorPattern = f.dlqErrorPattern
-		},
+		opts...,
 	)
 }
+
+// WithTracing makes executables emit a span for each execution attempt. The span is linked to the span of the
+// request that generated the task when it was recorded in spanLinks by the same host.
+func WithTracing(tracer trace.Tracer, spanLinks *tasks.SpanLinks, shardID int32) ExecutableOption {
+	return func(params *ExecutableParams) {
+		params.Tracer = tracer
+		params.SpanLinks = func(task tasks.Task) []trace.Link {
+			return spanLinks.Links(tasks.SpanLinkKey{ShardID: shardID, TaskID: task.GetTaskID()})
+		}
+	}
+}
@@ -34,6 +34,10 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/common/clock"
@@ -77,6 +81,7 @@ type (
 		maxUnexpectedErrorAttempts dynamicconfig.IntPropertyFn
 		dlqInternalErrors          dynamicconfig.BoolPropertyFn
 		dlqErrorPattern            dynamicconfig.StringPropertyFn
+		executableOptions          []queues.ExecutableOption
 	}
 	option func(*params)
 )
@@ -131,6 +136,41 @@ func (s *executableSuite) TestExecute_TaskExecuted() {
 	s.NoError(executable.Execute())
 }
 
+func (s *executableSuite) TestExecute_Tracing() {
+	exporter := tracetest.NewInMemoryExporter()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)).Tracer("test")
+	spanLinks := tasks.NewSpanLinks()
+
+	requestCtx, requestSpan := tracer.Start(context.Background(), "request")
+	spanLinks.Record(requestCtx, tasks.SpanLinkKey{ShardID: 1, TaskID: 0})
+	requestSpan.End()
+
+	executable := s.newTestExecutable(func(p *params) {
+		p.executableOptions = append(p.executableOptions, queues.WithTracing(tracer, spanLinks, 1))
+	})
+
+	var executionSpan trace.SpanContext
+	s.mockExecutor.EXPECT().Execute(gomock.Any(), executable).DoAndReturn(
+		func(ctx context.Context, _ queues.Executable) queues.ExecuteResponse {
+			executionSpan = trace.SpanContextFromContext(ctx)
+			return queues.ExecuteResponse{
+				ExecutedAsActive: true,
+				ExecutionErr:     errors.New("some random error"),
+			}
+		},
+	)
+	s.Error(executable.Execute())
+
+	spans := exporter.GetSpans()
+	s.Len(spans, 2)
+	span := spans[1]
+	s.Equal(executionSpan.SpanID(), span.SpanContext.SpanID())
+	s.Equal(trace.SpanKindConsumer, span.SpanKind)
+	s.Equal(codes.Error, span.Status.Code)
+	s.Len(span.Links, 1)
+	s.Equal(requestSpan.SpanContext(), span.Links[0].SpanContext)
+}
+
 func (s *executableSuite) TestExecute_InMemoryNoUserLatency_SingleAttempt() {
 	scheduleLatency := 100 * time.Millisecond
 	userLatency := 500 * time.Millisecond
@@ -1055,6 +1095,15 @@ func (s *executableSuite) newTestExecutable(opts ...option) queues.Executable {
 	for _, opt := range opts {
 		opt(&p)
 	}
+	executableOptions := append([]queues.ExecutableOption{
+		func(params *queues.ExecutableParams) {
+			params.DLQEnabled = p.dlqEnabled
+			params.DLQWriter = p.dlqWriter
+			params.MaxUnexpectedErrorAttempts = p.maxUnexpectedErrorAttempts
+			params.DLQInternalErrors = p.dlqInternalErrors
+			params.DLQErrorPattern = p.dlqErrorPattern
+		},
+	}, p.executableOptions...)
 	return queues.NewExecutable(
 		queues.DefaultReaderId,
 		tasks.NewFakeTask(
@@ -1075,13 +1124,7 @@ func (s *executableSuite) newTestExecutable(opts ...option) queues.Executable {
 		s.mockClusterMetadata,
 		log.NewTestLogger(),
 		s.metricsHandler,
-		func(params *queues.ExecutableParams) {
-			params.DLQEnabled = p.dlqEnabled
-			params.DLQWriter = p.dlqWriter
-			params.MaxUnexpectedErrorAttempts = p.maxUnexpectedErrorAttempts
-			params.DLQInternalErrors = p.dlqInternalErrors
-			params.DLQErrorPattern = p.dlqErrorPattern
-		},
+		executableOptions...,
 	)
 }
 
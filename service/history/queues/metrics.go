@@ -167,6 +167,17 @@ func GetArchivalTaskTypeTagValue(
 	}
 }
 
+func GetWorkflowLifecycleEventTaskTypeTagValue(
+	task tasks.Task,
+) string {
+	switch task.(type) {
+	case *tasks.WorkflowLifecycleEventTask:
+		return metrics.TaskTypeWorkflowLifecycleEventTask
+	default:
+		return task.GetType().String()
+	}
+}
+
 func GetOutboundTaskTypeTagValue(task tasks.Task, isActive bool) string {
 	var prefix string
 	if isActive {
@@ -214,6 +225,8 @@ func getTaskTypeTagValue(
 		return GetArchivalTaskTypeTagValue(task)
 	case tasks.CategoryOutbound:
 		return GetOutboundTaskTypeTagValue(task, isActive)
+	case tasks.CategoryWorkflowLifecycleEvent:
+		return GetWorkflowLifecycleEventTaskTypeTagValue(task)
 	default:
 		return task.GetType().String()
 	}
@@ -77,6 +77,9 @@ type (
 			minInclusiveTaskID int64,
 			maxExclusiveTaskID int64,
 		) (collection.Iterator[tasks.Task], error)
+		// GetPendingTasks returns the cached replication tasks not acknowledged by every remote cluster yet, sorted by
+		// task ID, and whether they were truncated. The tasks are read again in the background once they are stale.
+		GetPendingTasks() ([]tasks.Task, bool)
 	}
 
 	ackMgrImpl struct {
@@ -94,6 +97,7 @@ type (
 		syncVersionedTransitionTaskConverter *syncVersionedTransitionTaskConverter
 		pageSize                             dynamicconfig.IntPropertyFn
 		maxSkipTaskCount                     dynamicconfig.IntPropertyFn
+		pendingTasks                         *pendingTasksCache
 
 		sync.Mutex
 		// largest replication task ID generated
@@ -142,6 +146,7 @@ func NewAckManager(
 		syncVersionedTransitionTaskConverter: newSyncVersionedTransitionTaskConverter(shardContext, workflowCache, eventBlobCache, replicationProgressCache, executionMgr, syncStateRetriever, logger),
 		pageSize:                             config.ReplicatorProcessorFetchTasksBatchSize,
 		maxSkipTaskCount:                     config.ReplicatorProcessorMaxSkipTaskCount,
+		pendingTasks:                         newPendingTasksCache(shardContext, log.With(logger, tag.ComponentReplicatorQueue)),
 
 		maxTaskID:       nil,
 		sanityCheckTime: time.Time{},
@@ -201,6 +206,10 @@ func (p *ackMgrImpl) GetMaxTaskInfo() (int64, time.Time) {
 	return *maxTaskID, maxVisibilityTimestamp
 }
 
+func (p *ackMgrImpl) GetPendingTasks() ([]tasks.Task, bool) {
+	return p.pendingTasks.get()
+}
+
 // TODO: deprecate this method
 // It's only used by the replication DLQ v1 logic.
 func (p *ackMgrImpl) GetTask(
@@ -463,6 +472,8 @@ func (p *ackMgrImpl) ConvertTask(
 			task,
 			p.workflowCache,
 		)
+	case *tasks.WriteIntentTask:
+		return convertWriteIntentReplicationTask(task), nil
 	default:
 		return nil, errUnknownReplicationTask
 	}
@@ -112,6 +112,21 @@ func (mr *MockAckManagerMockRecorder) GetMaxTaskInfo() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaxTaskInfo", reflect.TypeOf((*MockAckManager)(nil).GetMaxTaskInfo))
 }
 
+// GetPendingTasks mocks base method.
+func (m *MockAckManager) GetPendingTasks() ([]tasks.Task, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingTasks")
+	ret0, _ := ret[0].([]tasks.Task)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetPendingTasks indicates an expected call of GetPendingTasks.
+func (mr *MockAckManagerMockRecorder) GetPendingTasks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingTasks", reflect.TypeOf((*MockAckManager)(nil).GetPendingTasks))
+}
+
 // GetReplicationTasksIter mocks base method.
 func (m *MockAckManager) GetReplicationTasksIter(ctx context.Context, pollingCluster string, minInclusiveTaskID, maxExclusiveTaskID int64) (collection.Iterator[tasks.Task], error) {
 	m.ctrl.T.Helper()
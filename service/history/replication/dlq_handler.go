@@ -29,6 +29,7 @@ package replication
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sync"
 
 	historypb "go.temporal.io/api/history/v1"
@@ -70,7 +71,16 @@ type (
 			lastMessageID int64,
 			pageSize int,
 			pageToken []byte,
+			filter DLQMessageFilter,
 		) ([]byte, error)
+		PreviewMergeMessages(
+			ctx context.Context,
+			sourceCluster string,
+			lastMessageID int64,
+			pageSize int,
+			pageToken []byte,
+			filter DLQMessageFilter,
+		) ([]*adminservice.ReplicationDLQTaskPreview, []byte, error)
 	}
 
 	dlqHandlerImpl struct {
@@ -175,6 +185,7 @@ func (r *dlqHandlerImpl) GetMessages(
 		lastMessageID,
 		pageSize,
 		pageToken,
+		DLQMessageFilter{},
 	)
 	return taskList, taskInfoList, token, err
 }
@@ -218,14 +229,16 @@ func (r *dlqHandlerImpl) MergeMessages(
 	lastMessageID int64,
 	pageSize int,
 	pageToken []byte,
+	filter DLQMessageFilter,
 ) ([]byte, error) {
 
-	replicationTasks, _, ackLevel, token, err := r.readMessagesWithAckLevel(
+	replicationTasks, taskInfos, ackLevel, token, err := r.readMessagesWithAckLevel(
 		ctx,
 		sourceCluster,
 		lastMessageID,
 		pageSize,
 		pageToken,
+		filter,
 	)
 	if err != nil {
 		return nil, err
@@ -246,6 +259,27 @@ func (r *dlqHandlerImpl) MergeMessages(
 		}
 	}
 
+	if !filter.IsEmpty() {
+		// tasks not matching the filter are kept in the DLQ, so only the merged tasks can be deleted and the
+		// ack level stays unchanged
+		for _, taskInfo := range taskInfos {
+			if err := r.shard.GetExecutionManager().DeleteReplicationTaskFromDLQ(
+				ctx,
+				&persistence.DeleteReplicationTaskFromDLQRequest{
+					CompleteHistoryTaskRequest: persistence.CompleteHistoryTaskRequest{
+						ShardID:      r.shard.GetShardID(),
+						TaskCategory: tasks.CategoryReplication,
+						TaskKey:      tasks.NewImmediateKey(taskInfo.GetTaskId()),
+					},
+					SourceClusterName: sourceCluster,
+				},
+			); err != nil {
+				return nil, err
+			}
+		}
+		return token, nil
+	}
+
 	err = r.shard.GetExecutionManager().RangeDeleteReplicationTaskFromDLQ(
 		ctx,
 		&persistence.RangeDeleteReplicationTaskFromDLQRequest{
@@ -272,14 +306,89 @@ func (r *dlqHandlerImpl) MergeMessages(
 	return token, nil
 }
 
+func (r *dlqHandlerImpl) PreviewMergeMessages(
+	ctx context.Context,
+	sourceCluster string,
+	lastMessageID int64,
+	pageSize int,
+	pageToken []byte,
+	filter DLQMessageFilter,
+) ([]*adminservice.ReplicationDLQTaskPreview, []byte, error) {
+
+	taskInfos, _, token, err := r.readTaskInfosWithAckLevel(
+		ctx,
+		sourceCluster,
+		lastMessageID,
+		pageSize,
+		pageToken,
+		filter,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	previews := make([]*adminservice.ReplicationDLQTaskPreview, 0, len(taskInfos))
+	for _, taskInfo := range taskInfos {
+		preview, err := r.previewTask(ctx, taskInfo)
+		if err != nil {
+			return nil, nil, err
+		}
+		previews = append(previews, preview)
+	}
+	return previews, token, nil
+}
+
 func (r *dlqHandlerImpl) readMessagesWithAckLevel(
 	ctx context.Context,
 	sourceCluster string,
 	lastMessageID int64,
 	pageSize int,
 	pageToken []byte,
+	filter DLQMessageFilter,
 ) ([]*replicationspb.ReplicationTask, []*replicationspb.ReplicationTaskInfo, int64, []byte, error) {
 
+	taskInfo, ackLevel, pageToken, err := r.readTaskInfosWithAckLevel(
+		ctx,
+		sourceCluster,
+		lastMessageID,
+		pageSize,
+		pageToken,
+		filter,
+	)
+	if err != nil {
+		return nil, nil, ackLevel, nil, err
+	}
+
+	if len(taskInfo) == 0 {
+		return nil, nil, ackLevel, pageToken, nil
+	}
+
+	remoteAdminClient, err := r.shard.GetRemoteAdminClient(sourceCluster)
+	if err != nil {
+		return nil, nil, ackLevel, nil, err
+	}
+	dlqResponse, err := remoteAdminClient.GetDLQReplicationMessages(
+		ctx,
+		&adminservice.GetDLQReplicationMessagesRequest{
+			TaskInfos: taskInfo,
+		},
+	)
+	if err != nil {
+		return nil, nil, ackLevel, nil, err
+	}
+
+	return dlqResponse.ReplicationTasks, taskInfo, ackLevel, pageToken, nil
+}
+
+func (r *dlqHandlerImpl) readTaskInfosWithAckLevel(
+	ctx context.Context,
+	sourceCluster string,
+	lastMessageID int64,
+	pageSize int,
+	pageToken []byte,
+	filter DLQMessageFilter,
+) ([]*replicationspb.ReplicationTaskInfo, int64, []byte, error) {
+
 	ackLevel := r.shard.GetReplicatorDLQAckLevel(sourceCluster)
 	resp, err := r.shard.GetExecutionManager().GetReplicationTasksFromDLQ(ctx, &persistence.GetReplicationTasksFromDLQRequest{
 		GetHistoryTasksRequest: persistence.GetHistoryTasksRequest{
@@ -293,14 +402,10 @@ func (r *dlqHandlerImpl) readMessagesWithAckLevel(
 		SourceClusterName: sourceCluster,
 	})
 	if err != nil {
-		return nil, nil, ackLevel, nil, err
+		return nil, ackLevel, nil, err
 	}
 	pageToken = resp.NextPageToken
 
-	remoteAdminClient, err := r.shard.GetRemoteAdminClient(sourceCluster)
-	if err != nil {
-		return nil, nil, ackLevel, nil, err
-	}
 	taskInfo := make([]*replicationspb.ReplicationTaskInfo, 0, len(resp.Tasks))
 	for _, task := range resp.Tasks {
 		switch task := task.(type) {
@@ -348,26 +453,22 @@ func (r *dlqHandlerImpl) readMessagesWithAckLevel(
 				TaskType:    enumsspb.TASK_TYPE_REPLICATION_SYNC_HSM,
 				TaskId:      task.TaskID,
 			})
+		case *tasks.WriteIntentTask:
+			taskInfo = append(taskInfo, &replicationspb.ReplicationTaskInfo{
+				NamespaceId: task.NamespaceID,
+				WorkflowId:  task.WorkflowID,
+				RunId:       task.RunID,
+				TaskType:    enumsspb.TASK_TYPE_REPLICATION_WRITE_INTENT,
+				TaskId:      task.TaskID,
+			})
 		default:
 			panic(fmt.Sprintf("Unknown repication task type: %v", task))
 		}
 	}
 
-	if len(taskInfo) == 0 {
-		return nil, nil, ackLevel, pageToken, nil
-	}
-
-	dlqResponse, err := remoteAdminClient.GetDLQReplicationMessages(
-		ctx,
-		&adminservice.GetDLQReplicationMessagesRequest{
-			TaskInfos: taskInfo,
-		},
-	)
-	if err != nil {
-		return nil, nil, ackLevel, nil, err
-	}
-
-	return dlqResponse.ReplicationTasks, taskInfo, ackLevel, pageToken, nil
+	return slices.DeleteFunc(taskInfo, func(info *replicationspb.ReplicationTaskInfo) bool {
+		return !filter.Matches(info)
+	}), ackLevel, pageToken, nil
 }
 
 func (r *dlqHandlerImpl) getOrCreateTaskExecutor(clusterName string) (TaskExecutor, error) {
@@ -37,6 +37,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	adminservice "go.temporal.io/server/api/adminservice/v1"
 	repication "go.temporal.io/server/api/replication/v1"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -82,18 +83,34 @@ func (mr *MockDLQHandlerMockRecorder) GetMessages(ctx, sourceCluster, lastMessag
 }
 
 // MergeMessages mocks base method.
-func (m *MockDLQHandler) MergeMessages(ctx context.Context, sourceCluster string, lastMessageID int64, pageSize int, pageToken []byte) ([]byte, error) {
+func (m *MockDLQHandler) MergeMessages(ctx context.Context, sourceCluster string, lastMessageID int64, pageSize int, pageToken []byte, filter DLQMessageFilter) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MergeMessages", ctx, sourceCluster, lastMessageID, pageSize, pageToken)
+	ret := m.ctrl.Call(m, "MergeMessages", ctx, sourceCluster, lastMessageID, pageSize, pageToken, filter)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // MergeMessages indicates an expected call of MergeMessages.
-func (mr *MockDLQHandlerMockRecorder) MergeMessages(ctx, sourceCluster, lastMessageID, pageSize, pageToken any) *gomock.Call {
+func (mr *MockDLQHandlerMockRecorder) MergeMessages(ctx, sourceCluster, lastMessageID, pageSize, pageToken, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeMessages", reflect.TypeOf((*MockDLQHandler)(nil).MergeMessages), ctx, sourceCluster, lastMessageID, pageSize, pageToken)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeMessages", reflect.TypeOf((*MockDLQHandler)(nil).MergeMessages), ctx, sourceCluster, lastMessageID, pageSize, pageToken, filter)
+}
+
+// PreviewMergeMessages mocks base method.
+func (m *MockDLQHandler) PreviewMergeMessages(ctx context.Context, sourceCluster string, lastMessageID int64, pageSize int, pageToken []byte, filter DLQMessageFilter) ([]*adminservice.ReplicationDLQTaskPreview, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewMergeMessages", ctx, sourceCluster, lastMessageID, pageSize, pageToken, filter)
+	ret0, _ := ret[0].([]*adminservice.ReplicationDLQTaskPreview)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// PreviewMergeMessages indicates an expected call of PreviewMergeMessages.
+func (mr *MockDLQHandlerMockRecorder) PreviewMergeMessages(ctx, sourceCluster, lastMessageID, pageSize, pageToken, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewMergeMessages", reflect.TypeOf((*MockDLQHandler)(nil).PreviewMergeMessages), ctx, sourceCluster, lastMessageID, pageSize, pageToken, filter)
 }
 
 // PurgeMessages mocks base method.
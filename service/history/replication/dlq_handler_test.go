@@ -308,7 +308,113 @@ func (s *dlqHandlerSuite) TestMergeMessages() {
 
 	s.shardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).Return(nil)
 
-	token, err := s.replicationMessageHandler.MergeMessages(ctx, s.sourceCluster, lastMessageID, pageSize, pageToken)
+	token, err := s.replicationMessageHandler.MergeMessages(ctx, s.sourceCluster, lastMessageID, pageSize, pageToken, DLQMessageFilter{})
 	s.NoError(err)
 	s.Equal(pageToken, token)
 }
+
+func (s *dlqHandlerSuite) TestMergeMessages_Filtered() {
+	ctx := context.Background()
+
+	namespaceID := uuid.New()
+	workflowID := uuid.New()
+	lastMessageID := int64(1394)
+	pageSize := 10
+
+	newTask := func(workflowID string, taskID int64) *tasks.HistoryReplicationTask {
+		return &tasks.HistoryReplicationTask{
+			WorkflowKey:  definition.NewWorkflowKey(namespaceID, workflowID, uuid.New()),
+			Version:      2333,
+			FirstEventID: 144,
+			NextEventID:  233,
+			TaskID:       taskID,
+		}
+	}
+	s.executionManager.EXPECT().GetReplicationTasksFromDLQ(gomock.Any(), gomock.Any()).Return(&persistence.GetHistoryTasksResponse{
+		Tasks: []tasks.Task{
+			newTask(workflowID, 1),
+			newTask(uuid.New(), 2),
+		},
+	}, nil)
+
+	remoteTask := &replicationspb.ReplicationTask{
+		TaskType:     enumsspb.REPLICATION_TASK_TYPE_HISTORY_TASK,
+		SourceTaskId: 1,
+	}
+	s.mockClientBean.EXPECT().GetRemoteAdminClient(s.sourceCluster).Return(s.adminClient, nil).AnyTimes()
+	s.adminClient.EXPECT().GetDLQReplicationMessages(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *adminservice.GetDLQReplicationMessagesRequest, _ ...interface{}) (*adminservice.GetDLQReplicationMessagesResponse, error) {
+			s.Len(request.TaskInfos, 1)
+			s.Equal(workflowID, request.TaskInfos[0].WorkflowId)
+			return &adminservice.GetDLQReplicationMessagesResponse{
+				ReplicationTasks: []*replicationspb.ReplicationTask{remoteTask},
+			}, nil
+		},
+	)
+	s.taskExecutor.EXPECT().Execute(gomock.Any(), remoteTask, true).Return(nil)
+	// only the merged task is deleted and the ack level is not updated
+	s.executionManager.EXPECT().DeleteReplicationTaskFromDLQ(gomock.Any(), &persistence.DeleteReplicationTaskFromDLQRequest{
+		CompleteHistoryTaskRequest: persistence.CompleteHistoryTaskRequest{
+			ShardID:      s.mockShard.GetShardID(),
+			TaskCategory: tasks.CategoryReplication,
+			TaskKey:      tasks.NewImmediateKey(1),
+		},
+		SourceClusterName: s.sourceCluster,
+	}).Return(nil)
+
+	token, err := s.replicationMessageHandler.MergeMessages(ctx, s.sourceCluster, lastMessageID, pageSize, nil, DLQMessageFilter{
+		NamespaceID: namespaceID,
+		WorkflowID:  workflowID,
+	})
+	s.NoError(err)
+	s.Nil(token)
+}
+
+func (s *dlqHandlerSuite) TestPreviewMergeMessages() {
+	ctx := context.Background()
+
+	namespaceID := uuid.New()
+	workflowID := uuid.New()
+	runID := uuid.New()
+	newTask := func(taskID int64, version int64, firstEventID int64, nextEventID int64) *tasks.HistoryReplicationTask {
+		return &tasks.HistoryReplicationTask{
+			WorkflowKey:  definition.NewWorkflowKey(namespaceID, workflowID, runID),
+			Version:      version,
+			FirstEventID: firstEventID,
+			NextEventID:  nextEventID,
+			TaskID:       taskID,
+		}
+	}
+	s.executionManager.EXPECT().GetReplicationTasksFromDLQ(gomock.Any(), gomock.Any()).Return(&persistence.GetHistoryTasksResponse{
+		Tasks: []tasks.Task{
+			newTask(1, 10, 1, 6),
+			newTask(2, 5, 6, 8),
+		},
+	}, nil)
+	s.executionManager.EXPECT().GetWorkflowExecution(gomock.Any(), &persistence.GetWorkflowExecutionRequest{
+		ShardID:         s.mockShard.GetShardID(),
+		NamespaceID:     namespaceID,
+		WorkflowID:      workflowID,
+		RunID:           runID,
+		OmitCollections: persistence.MutableStateAllCollections,
+	}).Return(&persistence.GetWorkflowExecutionResponse{
+		State: &persistencespb.WorkflowMutableState{
+			ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+				VersionHistories: &historyspb.VersionHistories{
+					Histories: []*historyspb.VersionHistory{{
+						Items: []*historyspb.VersionHistoryItem{{EventId: 10, Version: 10}},
+					}},
+				},
+			},
+		},
+	}, nil).Times(2)
+
+	previews, token, err := s.replicationMessageHandler.PreviewMergeMessages(ctx, s.sourceCluster, 100, 10, nil, DLQMessageFilter{})
+	s.NoError(err)
+	s.Nil(token)
+	s.Len(previews, 2)
+	s.Equal(int64(1), previews[0].GetTaskInfo().GetTaskId())
+	s.False(previews[0].GetConflict())
+	s.Equal(int64(2), previews[1].GetTaskInfo().GetTaskId())
+	s.True(previews[1].GetConflict())
+}
@@ -0,0 +1,137 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replication
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/versionhistory"
+)
+
+type (
+	// DLQMessageFilter selects the replication DLQ messages of a namespace or a workflow.
+	// An empty filter selects all messages.
+	DLQMessageFilter struct {
+		NamespaceID string
+		WorkflowID  string
+	}
+)
+
+func (f DLQMessageFilter) IsEmpty() bool {
+	return f.NamespaceID == "" && f.WorkflowID == ""
+}
+
+func (f DLQMessageFilter) Matches(taskInfo *replicationspb.ReplicationTaskInfo) bool {
+	if f.NamespaceID != "" && f.NamespaceID != taskInfo.GetNamespaceId() {
+		return false
+	}
+	return f.WorkflowID == "" || f.WorkflowID == taskInfo.GetWorkflowId()
+}
+
+// previewTask reports whether applying a DLQ task would conflict with the current state of its execution.
+func (r *dlqHandlerImpl) previewTask(
+	ctx context.Context,
+	taskInfo *replicationspb.ReplicationTaskInfo,
+) (*adminservice.ReplicationDLQTaskPreview, error) {
+	preview := &adminservice.ReplicationDLQTaskPreview{TaskInfo: taskInfo}
+
+	resp, err := r.shard.GetExecutionManager().GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		ShardID:     r.shard.GetShardID(),
+		NamespaceID: taskInfo.GetNamespaceId(),
+		WorkflowID:  taskInfo.GetWorkflowId(),
+		RunID:       taskInfo.GetRunId(),
+		// conflicts are detected from the version histories of the execution info alone
+		OmitCollections: persistence.MutableStateAllCollections,
+	})
+	var notFound *serviceerror.NotFound
+	switch {
+	case errors.As(err, &notFound):
+		preview.Reason = "workflow execution not found, missing history will be resent from the source cluster"
+		return preview, nil
+	case err != nil:
+		return nil, err
+	}
+
+	currentVersionHistory, err := versionhistory.GetCurrentVersionHistory(resp.State.GetExecutionInfo().GetVersionHistories())
+	if err != nil {
+		return nil, err
+	}
+	lastItem, err := versionhistory.GetLastVersionHistoryItem(currentVersionHistory)
+	if err != nil {
+		return nil, err
+	}
+
+	switch taskInfo.GetTaskType() {
+	case enumsspb.TASK_TYPE_REPLICATION_HISTORY:
+		preview.Conflict, preview.Reason = previewHistoryTask(currentVersionHistory, lastItem, taskInfo)
+	case enumsspb.TASK_TYPE_REPLICATION_SYNC_HSM:
+		// state machine tasks don't record the version they were generated with
+		preview.Reason = "state machines will be synced"
+	default:
+		if taskInfo.GetVersion() < lastItem.GetVersion() {
+			preview.Conflict = true
+			preview.Reason = fmt.Sprintf("task version %v is older than the current branch version %v", taskInfo.GetVersion(), lastItem.GetVersion())
+		} else {
+			preview.Reason = "execution state will be synced"
+		}
+	}
+	return preview, nil
+}
+
+// previewHistoryTask compares the events of a history replication task with the current branch of the execution.
+func previewHistoryTask(
+	currentVersionHistory *historyspb.VersionHistory,
+	lastItem *historyspb.VersionHistoryItem,
+	taskInfo *replicationspb.ReplicationTaskInfo,
+) (bool, string) {
+	taskItem := versionhistory.NewVersionHistoryItem(taskInfo.GetNextEventId()-1, taskInfo.GetVersion())
+	if versionhistory.ContainsVersionHistoryItem(currentVersionHistory, taskItem) {
+		return false, "events are already applied"
+	}
+	switch {
+	case taskInfo.GetVersion() < lastItem.GetVersion():
+		return true, fmt.Sprintf(
+			"version diverged: events with version %v would be applied to a non-current branch, the current branch has version %v",
+			taskInfo.GetVersion(), lastItem.GetVersion(),
+		)
+	case taskInfo.GetVersion() > lastItem.GetVersion() && taskInfo.GetFirstEventId() <= lastItem.GetEventId():
+		return true, fmt.Sprintf(
+			"branch diverged: events after event %v of the current branch would be replaced by events with version %v",
+			taskInfo.GetFirstEventId()-1, taskInfo.GetVersion(),
+		)
+	case taskInfo.GetFirstEventId() > lastItem.GetEventId()+1:
+		return false, fmt.Sprintf("events %v to %v are missing and will be resent from the source cluster", lastItem.GetEventId()+1, taskInfo.GetFirstEventId()-1)
+	default:
+		return false, "events will be appended to the current branch"
+	}
+}
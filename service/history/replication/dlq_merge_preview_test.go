@@ -0,0 +1,83 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replication
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
+)
+
+func TestDLQMessageFilter(t *testing.T) {
+	taskInfo := &replicationspb.ReplicationTaskInfo{NamespaceId: "ns", WorkflowId: "wf"}
+
+	require.True(t, DLQMessageFilter{}.IsEmpty())
+	require.True(t, DLQMessageFilter{}.Matches(taskInfo))
+	require.True(t, DLQMessageFilter{NamespaceID: "ns"}.Matches(taskInfo))
+	require.True(t, DLQMessageFilter{NamespaceID: "ns", WorkflowID: "wf"}.Matches(taskInfo))
+	require.False(t, DLQMessageFilter{NamespaceID: "other-ns"}.Matches(taskInfo))
+	require.False(t, DLQMessageFilter{NamespaceID: "ns", WorkflowID: "other-wf"}.Matches(taskInfo))
+}
+
+func TestPreviewHistoryTask(t *testing.T) {
+	// events 1-5 with version 1, events 6-10 with version 2
+	currentVersionHistory := &historyspb.VersionHistory{
+		Items: []*historyspb.VersionHistoryItem{
+			{EventId: 5, Version: 1},
+			{EventId: 10, Version: 2},
+		},
+	}
+	lastItem := currentVersionHistory.Items[1]
+
+	testCases := []struct {
+		name             string
+		version          int64
+		firstEventID     int64
+		nextEventID      int64
+		expectedConflict bool
+	}{
+		{name: "already applied", version: 1, firstEventID: 3, nextEventID: 5},
+		{name: "append", version: 2, firstEventID: 11, nextEventID: 13},
+		{name: "append with newer version", version: 3, firstEventID: 11, nextEventID: 13},
+		{name: "missing events", version: 2, firstEventID: 15, nextEventID: 17},
+		{name: "version diverged", version: 1, firstEventID: 6, nextEventID: 8, expectedConflict: true},
+		{name: "branch diverged", version: 3, firstEventID: 8, nextEventID: 12, expectedConflict: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			conflict, reason := previewHistoryTask(currentVersionHistory, lastItem, &replicationspb.ReplicationTaskInfo{
+				TaskType:     enumsspb.TASK_TYPE_REPLICATION_HISTORY,
+				Version:      tc.version,
+				FirstEventId: tc.firstEventID,
+				NextEventId:  tc.nextEventID,
+			})
+			require.Equal(t, tc.expectedConflict, conflict)
+			require.NotEmpty(t, reason)
+		})
+	}
+}
@@ -175,6 +175,17 @@ func (e *executableTaskConverterImpl) convertOne(
 			sourceShardKey,
 			replicationTask,
 		)
+	case enumsspb.REPLICATION_TASK_TYPE_WRITE_INTENT:
+		return NewExecutableWriteIntentTask(
+			e.processToolBox,
+			replicationTask.SourceTaskId,
+			taskCreationTime,
+			replicationTask.GetWriteIntentAttributes(),
+			sourceClusterName,
+			sourceShardKey,
+			replicationTask.GetPriority(),
+			replicationTask,
+		)
 	default:
 		e.processToolBox.Logger.Error(fmt.Sprintf("unknown replication task: %v", replicationTask))
 		return NewExecutableUnknownTask(
@@ -0,0 +1,208 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replication
+
+import (
+	"context"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	updatepb "go.temporal.io/api/update/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	ctasks "go.temporal.io/server/common/tasks"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	// ExecutableWriteIntentTask applies a signal or an update which a standby cluster accepted for a workflow type
+	// that opted into concurrent writes. Only the cluster which is active for the namespace applies the intent, the
+	// other clusters drop it.
+	ExecutableWriteIntentTask struct {
+		ProcessToolBox
+
+		definition.WorkflowKey
+		ExecutableTask
+
+		taskAttr *replicationspb.WriteIntentAttributes
+	}
+)
+
+var _ ctasks.Task = (*ExecutableWriteIntentTask)(nil)
+var _ TrackableExecutableTask = (*ExecutableWriteIntentTask)(nil)
+
+func NewExecutableWriteIntentTask(
+	processToolBox ProcessToolBox,
+	taskID int64,
+	taskCreationTime time.Time,
+	task *replicationspb.WriteIntentAttributes,
+	sourceClusterName string,
+	sourceShardKey ClusterShardKey,
+	priority enumsspb.TaskPriority,
+	replicationTask *replicationspb.ReplicationTask,
+) *ExecutableWriteIntentTask {
+	execution := task.GetSignalRequest().GetWorkflowExecution()
+	if task.GetUpdateRequest() != nil {
+		execution = task.GetUpdateRequest().GetWorkflowExecution()
+	}
+	return &ExecutableWriteIntentTask{
+		ProcessToolBox: processToolBox,
+
+		WorkflowKey: definition.NewWorkflowKey(task.GetNamespaceId(), execution.GetWorkflowId(), execution.GetRunId()),
+		ExecutableTask: NewExecutableTask(
+			processToolBox,
+			taskID,
+			metrics.WriteIntentTaskScope,
+			taskCreationTime,
+			time.Now().UTC(),
+			sourceClusterName,
+			sourceShardKey,
+			priority,
+			replicationTask,
+		),
+		taskAttr: task,
+	}
+}
+
+func (e *ExecutableWriteIntentTask) QueueID() interface{} {
+	return e.WorkflowKey
+}
+
+func (e *ExecutableWriteIntentTask) Execute() error {
+	if e.TerminalState() {
+		return nil
+	}
+
+	namespaceName, apply, nsError := e.GetNamespaceInfo(headers.SetCallerInfo(
+		context.Background(),
+		headers.SystemPreemptableCallerInfo,
+	), e.NamespaceID)
+	if nsError != nil {
+		return nsError
+	}
+	if apply {
+		namespaceEntry, err := e.NamespaceCache.GetNamespaceByID(namespace.ID(e.NamespaceID))
+		if err != nil {
+			return err
+		}
+		apply = namespaceEntry.ActiveInCluster(e.ClusterMetadata.GetCurrentClusterName())
+	}
+	if !apply {
+		e.Logger.Warn("Skipping the replication task",
+			tag.WorkflowNamespaceID(e.NamespaceID),
+			tag.WorkflowID(e.WorkflowID),
+			tag.WorkflowRunID(e.RunID),
+			tag.TaskID(e.ExecutableTask.TaskID()),
+		)
+		metrics.ReplicationTasksSkipped.With(e.MetricsHandler).Record(
+			1,
+			metrics.OperationTag(metrics.WriteIntentTaskScope),
+			metrics.NamespaceTag(namespaceName),
+		)
+		return nil
+	}
+	ctx, cancel := newTaskContext(namespaceName, e.Config.ReplicationTaskApplyTimeout())
+	defer cancel()
+
+	shardContext, err := e.ShardController.GetShardByNamespaceWorkflow(
+		namespace.ID(e.NamespaceID),
+		e.WorkflowID,
+	)
+	if err != nil {
+		return err
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return err
+	}
+
+	if updateRequest := e.taskAttr.GetUpdateRequest(); updateRequest != nil {
+		// The caller waits for the outcome in the standby cluster, so the update only needs to be admitted here.
+		updateRequest = common.CloneProto(updateRequest)
+		updateRequest.WaitPolicy = &updatepb.WaitPolicy{
+			LifecycleStage: enumspb.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_ADMITTED,
+		}
+		_, err = engine.UpdateWorkflowExecution(ctx, &historyservice.UpdateWorkflowExecutionRequest{
+			NamespaceId: e.NamespaceID,
+			Request:     updateRequest,
+		})
+		return err
+	}
+	_, err = engine.SignalWorkflowExecution(ctx, &historyservice.SignalWorkflowExecutionRequest{
+		NamespaceId:   e.NamespaceID,
+		SignalRequest: e.taskAttr.GetSignalRequest(),
+	})
+	return err
+}
+
+func (e *ExecutableWriteIntentTask) HandleErr(err error) error {
+	switch err.(type) {
+	case nil:
+		return nil
+	case *serviceerror.NotFound, *serviceerror.NamespaceNotActive:
+		// The execution completed, or the namespace failed over, before the intent was applied.
+		e.Logger.Warn("Dropping write intent replication task",
+			tag.WorkflowNamespaceID(e.NamespaceID),
+			tag.WorkflowID(e.WorkflowID),
+			tag.WorkflowRunID(e.RunID),
+			tag.TaskID(e.ExecutableTask.TaskID()),
+			tag.Error(err),
+		)
+		return nil
+	default:
+		e.Logger.Error("Write intent replication task encountered error",
+			tag.WorkflowNamespaceID(e.NamespaceID),
+			tag.WorkflowID(e.WorkflowID),
+			tag.WorkflowRunID(e.RunID),
+			tag.TaskID(e.ExecutableTask.TaskID()),
+			tag.Error(err),
+		)
+		return err
+	}
+}
+
+func (e *ExecutableWriteIntentTask) MarkPoisonPill() error {
+	if e.ReplicationTask().GetRawTaskInfo() == nil {
+		e.ReplicationTask().RawTaskInfo = &persistencespb.ReplicationTaskInfo{
+			NamespaceId:    e.NamespaceID,
+			WorkflowId:     e.WorkflowID,
+			RunId:          e.RunID,
+			TaskType:       enumsspb.TASK_TYPE_REPLICATION_WRITE_INTENT,
+			TaskId:         e.ExecutableTask.TaskID(),
+			VisibilityTime: timestamppb.New(e.TaskCreationTime()),
+			SignalRequest:  e.taskAttr.GetSignalRequest(),
+			UpdateRequest:  e.taskAttr.GetUpdateRequest(),
+		}
+	}
+
+	return e.ExecutableTask.MarkPoisonPill()
+}
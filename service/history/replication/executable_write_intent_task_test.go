@@ -0,0 +1,247 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replication
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	updatepb "go.temporal.io/api/update/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/testing/protorequire"
+	"go.temporal.io/server/service/history/configs"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tests"
+	"go.uber.org/mock/gomock"
+)
+
+type (
+	executableWriteIntentTaskSuite struct {
+		suite.Suite
+		*require.Assertions
+		protorequire.ProtoAssertions
+
+		controller      *gomock.Controller
+		clusterMetadata *cluster.MockMetadata
+		shardController *shard.MockController
+		namespaceCache  *namespace.MockRegistry
+		executableTask  *MockExecutableTask
+		config          *configs.Config
+
+		namespaceID       string
+		sourceClusterName string
+		sourceShardKey    ClusterShardKey
+
+		taskID int64
+	}
+)
+
+func TestExecutableWriteIntentTaskSuite(t *testing.T) {
+	s := new(executableWriteIntentTaskSuite)
+	suite.Run(t, s)
+}
+
+func (s *executableWriteIntentTaskSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.ProtoAssertions = protorequire.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.clusterMetadata = cluster.NewMockMetadata(s.controller)
+	s.shardController = shard.NewMockController(s.controller)
+	s.namespaceCache = namespace.NewMockRegistry(s.controller)
+	s.executableTask = NewMockExecutableTask(s.controller)
+	s.config = tests.NewDynamicConfig()
+
+	s.namespaceID = uuid.NewString()
+	s.sourceClusterName = cluster.TestAlternativeClusterName
+	s.sourceShardKey = ClusterShardKey{
+		ClusterID: int32(cluster.TestAlternativeClusterInitialFailoverVersion),
+		ShardID:   rand.Int31(),
+	}
+	s.taskID = rand.Int63()
+
+	s.clusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.executableTask.EXPECT().TaskID().Return(s.taskID).AnyTimes()
+	s.executableTask.EXPECT().SourceClusterName().Return(s.sourceClusterName).AnyTimes()
+}
+
+func (s *executableWriteIntentTaskSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *executableWriteIntentTaskSuite) TestExecute_Signal() {
+	signalRequest := &workflowservice.SignalWorkflowExecutionRequest{
+		WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: uuid.NewString()},
+		SignalName:        "signal",
+		RequestId:         uuid.NewString(),
+	}
+	task := s.newTask(&replicationspb.WriteIntentAttributes{
+		NamespaceId:   s.namespaceID,
+		SignalRequest: signalRequest,
+	})
+	s.mockActiveCluster(cluster.TestCurrentClusterName)
+
+	engine := s.mockEngine(task)
+	engine.EXPECT().SignalWorkflowExecution(gomock.Any(), &historyservice.SignalWorkflowExecutionRequest{
+		NamespaceId:   s.namespaceID,
+		SignalRequest: signalRequest,
+	}).Return(&historyservice.SignalWorkflowExecutionResponse{}, nil)
+
+	s.NoError(task.Execute())
+}
+
+func (s *executableWriteIntentTaskSuite) TestExecute_Update() {
+	updateRequest := &workflowservice.UpdateWorkflowExecutionRequest{
+		WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: uuid.NewString()},
+		WaitPolicy: &updatepb.WaitPolicy{
+			LifecycleStage: enumspb.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_COMPLETED,
+		},
+		Request: &updatepb.Request{
+			Meta:  &updatepb.Meta{UpdateId: uuid.NewString()},
+			Input: &updatepb.Input{Name: "update"},
+		},
+	}
+	task := s.newTask(&replicationspb.WriteIntentAttributes{
+		NamespaceId:   s.namespaceID,
+		UpdateRequest: updateRequest,
+	})
+	s.mockActiveCluster(cluster.TestCurrentClusterName)
+
+	engine := s.mockEngine(task)
+	engine.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ any, request *historyservice.UpdateWorkflowExecutionRequest) (*historyservice.UpdateWorkflowExecutionResponse, error) {
+			s.Equal(s.namespaceID, request.GetNamespaceId())
+			s.Equal(enumspb.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_ADMITTED, request.GetRequest().GetWaitPolicy().GetLifecycleStage())
+			s.ProtoEqual(updateRequest.GetRequest(), request.GetRequest().GetRequest())
+			return &historyservice.UpdateWorkflowExecutionResponse{}, nil
+		},
+	)
+
+	s.NoError(task.Execute())
+	// the replicated intent is not modified
+	s.Equal(enumspb.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_COMPLETED, updateRequest.GetWaitPolicy().GetLifecycleStage())
+}
+
+func (s *executableWriteIntentTaskSuite) TestExecute_Skip_NotActive() {
+	task := s.newTask(&replicationspb.WriteIntentAttributes{
+		NamespaceId: s.namespaceID,
+		SignalRequest: &workflowservice.SignalWorkflowExecutionRequest{
+			WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: uuid.NewString()},
+		},
+	})
+	s.mockActiveCluster(cluster.TestAlternativeClusterName)
+
+	s.NoError(task.Execute())
+}
+
+func (s *executableWriteIntentTaskSuite) TestExecute_Skip_Namespace() {
+	task := s.newTask(&replicationspb.WriteIntentAttributes{
+		NamespaceId: s.namespaceID,
+		SignalRequest: &workflowservice.SignalWorkflowExecutionRequest{
+			WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: uuid.NewString()},
+		},
+	})
+	s.executableTask.EXPECT().TerminalState().Return(false)
+	s.executableTask.EXPECT().GetNamespaceInfo(gomock.Any(), s.namespaceID).Return("", false, nil)
+
+	s.NoError(task.Execute())
+}
+
+func (s *executableWriteIntentTaskSuite) TestHandleErr() {
+	task := s.newTask(&replicationspb.WriteIntentAttributes{
+		NamespaceId: s.namespaceID,
+		SignalRequest: &workflowservice.SignalWorkflowExecutionRequest{
+			WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: uuid.NewString()},
+		},
+	})
+
+	s.NoError(task.HandleErr(nil))
+	s.NoError(task.HandleErr(serviceerror.NewNotFound("workflow execution already completed")))
+	s.NoError(task.HandleErr(serviceerror.NewNamespaceNotActive("namespace", cluster.TestCurrentClusterName, cluster.TestAlternativeClusterName)))
+	err := errors.New("OwO")
+	s.Equal(err, task.HandleErr(err))
+}
+
+func (s *executableWriteIntentTaskSuite) newTask(
+	attributes *replicationspb.WriteIntentAttributes,
+) *ExecutableWriteIntentTask {
+	task := NewExecutableWriteIntentTask(
+		ProcessToolBox{
+			ClusterMetadata: s.clusterMetadata,
+			ShardController: s.shardController,
+			NamespaceCache:  s.namespaceCache,
+			MetricsHandler:  metrics.NoopMetricsHandler,
+			Logger:          log.NewNoopLogger(),
+			Config:          s.config,
+		},
+		s.taskID,
+		time.Unix(0, rand.Int63()),
+		attributes,
+		s.sourceClusterName,
+		s.sourceShardKey,
+		enumsspb.TASK_PRIORITY_HIGH,
+		nil,
+	)
+	task.ExecutableTask = s.executableTask
+	return task
+}
+
+func (s *executableWriteIntentTaskSuite) mockActiveCluster(activeClusterName string) {
+	s.executableTask.EXPECT().TerminalState().Return(false)
+	s.executableTask.EXPECT().GetNamespaceInfo(gomock.Any(), s.namespaceID).Return("namespace", true, nil)
+	s.namespaceCache.EXPECT().GetNamespaceByID(namespace.ID(s.namespaceID)).Return(namespace.NewGlobalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: s.namespaceID, Name: "namespace"},
+		nil,
+		&persistencespb.NamespaceReplicationConfig{
+			ActiveClusterName: activeClusterName,
+			Clusters:          []string{cluster.TestCurrentClusterName, cluster.TestAlternativeClusterName},
+		},
+		1,
+	), nil)
+}
+
+func (s *executableWriteIntentTaskSuite) mockEngine(task *ExecutableWriteIntentTask) *shard.MockEngine {
+	shardContext := shard.NewMockContext(s.controller)
+	engine := shard.NewMockEngine(s.controller)
+	s.shardController.EXPECT().GetShardByNamespaceWorkflow(
+		namespace.ID(s.namespaceID),
+		task.WorkflowID,
+	).Return(shardContext, nil)
+	shardContext.EXPECT().GetEngine(gomock.Any()).Return(engine, nil)
+	return engine
+}
@@ -47,6 +47,8 @@ func TaskOperationTag(
 		return metrics.SyncWorkflowStateTaskScope
 	case enumsspb.REPLICATION_TASK_TYPE_HISTORY_V2_TASK:
 		return metrics.HistoryReplicationTaskScope
+	case enumsspb.REPLICATION_TASK_TYPE_WRITE_INTENT:
+		return metrics.WriteIntentTaskScope
 	default:
 		return metrics.NoopTaskScope
 	}
@@ -0,0 +1,186 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replication
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+const (
+	// PendingTasksScanLimit bounds the number of replication tasks read from a shard to find its oldest pending
+	// replication tasks.
+	PendingTasksScanLimit = 1000
+
+	pendingTasksScanPageSize = 100
+	// pendingTasksScanInterval bounds how often the replication queue is read to find the pending replication tasks.
+	pendingTasksScanInterval = 10 * time.Second
+)
+
+type (
+	// pendingTasks are the replication tasks of a shard not acknowledged by every remote cluster yet, as read at
+	// scanTime.
+	pendingTasks struct {
+		tasks     []tasks.Task
+		truncated bool
+		scanTime  time.Time
+	}
+
+	// pendingTasksCache reads the pending replication tasks of a shard in a separate goroutine, at most once per
+	// pendingTasksScanInterval, so that neither the replication stream nor the replication status API wait for the
+	// replication queue to be read. The cached tasks may be stale by up to the scan interval and the scan duration.
+	pendingTasksCache struct {
+		shardContext shard.Context
+		logger       log.Logger
+
+		scanning atomic.Bool
+		pending  atomic.Pointer[pendingTasks]
+	}
+)
+
+func newPendingTasksCache(
+	shardContext shard.Context,
+	logger log.Logger,
+) *pendingTasksCache {
+	return &pendingTasksCache{
+		shardContext: shardContext,
+		logger:       logger,
+	}
+}
+
+// get returns the cached pending tasks, which are empty until the first scan completed, and starts a new scan if the
+// cached tasks are older than the scan interval.
+func (c *pendingTasksCache) get() ([]tasks.Task, bool) {
+	pending := c.pending.Load()
+	if pending == nil || c.shardContext.GetTimeSource().Now().Sub(pending.scanTime) >= pendingTasksScanInterval {
+		if c.scanning.CompareAndSwap(false, true) {
+			go c.scan()
+		}
+	}
+	if pending == nil {
+		return nil, false
+	}
+	return pending.tasks, pending.truncated
+}
+
+func (c *pendingTasksCache) scan() {
+	defer c.scanning.Store(false)
+
+	ctx, cancel := context.WithTimeout(
+		headers.SetCallerInfo(context.Background(), headers.SystemPreemptableCallerInfo),
+		pendingTasksScanInterval,
+	)
+	defer cancel()
+
+	scanTime := c.shardContext.GetTimeSource().Now()
+	maxTaskID := c.shardContext.GetQueueExclusiveHighReadWatermark(tasks.CategoryReplication).TaskID - 1
+	remoteClusters, _, err := c.shardContext.GetReplicationStatus(nil)
+	if err != nil {
+		c.logger.Warn("Failed to get replication status to read pending replication tasks", tag.Error(err))
+		return
+	}
+	minAckedTaskID := maxTaskID
+	for _, status := range remoteClusters {
+		minAckedTaskID = min(minAckedTaskID, status.GetAckedTaskId())
+	}
+	pendingTaskList, truncated, err := ScanPendingTasks(ctx, c.shardContext, minAckedTaskID+1, maxTaskID+1, PendingTasksScanLimit)
+	if err != nil {
+		c.logger.Warn("Failed to read pending replication tasks", tag.Error(err))
+		return
+	}
+	c.pending.Store(&pendingTasks{
+		tasks:     pendingTaskList,
+		truncated: truncated,
+		scanTime:  scanTime,
+	})
+}
+
+// ScanPendingTasks returns, in task ID order, the replication tasks of a shard within [minTaskID, maxTaskID), up to
+// limit tasks. truncated is true if the limit was reached before the end of the range.
+func ScanPendingTasks(
+	ctx context.Context,
+	shardContext shard.Context,
+	minTaskID int64,
+	maxTaskID int64,
+	limit int,
+) (_ []tasks.Task, truncated bool, _ error) {
+	if minTaskID >= maxTaskID {
+		return nil, false, nil
+	}
+	var result []tasks.Task
+	var pageToken []byte
+	for {
+		resp, err := shardContext.GetExecutionManager().GetHistoryTasks(ctx, &persistence.GetHistoryTasksRequest{
+			ShardID:             shardContext.GetShardID(),
+			TaskCategory:        tasks.CategoryReplication,
+			InclusiveMinTaskKey: tasks.NewImmediateKey(minTaskID),
+			ExclusiveMaxTaskKey: tasks.NewImmediateKey(maxTaskID),
+			BatchSize:           min(pendingTasksScanPageSize, limit-len(result)),
+			NextPageToken:       pageToken,
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		result = append(result, resp.Tasks...)
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			return result, false, nil
+		}
+		if len(result) >= limit {
+			return result, true, nil
+		}
+	}
+}
+
+// OldestPendingTask returns the first task of the namespace, or of any namespace if namespaceID is empty, among tasks
+// sorted by task ID with an ID greater than ackedTaskID. If there is none and the tasks were truncated, the last task
+// is returned instead if it is not acked yet: the oldest pending task of the namespace is not older than it.
+func OldestPendingTask(
+	pendingTasks []tasks.Task,
+	truncated bool,
+	ackedTaskID int64,
+	namespaceID string,
+) (tasks.Task, bool) {
+	for _, task := range pendingTasks {
+		if task.GetTaskID() <= ackedTaskID {
+			continue
+		}
+		if namespaceID == "" || task.GetNamespaceID() == namespaceID {
+			return task, true
+		}
+	}
+	if truncated && len(pendingTasks) > 0 && pendingTasks[len(pendingTasks)-1].GetTaskID() > ackedTaskID {
+		return pendingTasks[len(pendingTasks)-1], true
+	}
+	return nil, false
+}
@@ -0,0 +1,118 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replication
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tasks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPendingTasksCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	shardContext := shard.NewMockContext(ctrl)
+	executionManager := persistence.NewMockExecutionManager(ctrl)
+	timeSource := clock.NewEventTimeSource()
+	timeSource.Update(time.Now())
+
+	pendingTask := &tasks.HistoryReplicationTask{
+		WorkflowKey: definition.NewWorkflowKey("ns", "wf", "run"),
+		TaskID:      11,
+	}
+	var scans atomic.Int32
+	shardContext.EXPECT().GetTimeSource().Return(timeSource).AnyTimes()
+	shardContext.EXPECT().GetShardID().Return(int32(1)).AnyTimes()
+	shardContext.EXPECT().GetExecutionManager().Return(executionManager).AnyTimes()
+	shardContext.EXPECT().GetQueueExclusiveHighReadWatermark(tasks.CategoryReplication).Return(tasks.NewImmediateKey(13)).AnyTimes()
+	shardContext.EXPECT().GetReplicationStatus(nil).Return(map[string]*historyservice.ShardReplicationStatusPerCluster{
+		"cluster-a": {AckedTaskId: 10},
+		"cluster-b": {AckedTaskId: 12},
+	}, nil, nil).AnyTimes()
+	// the tasks are read from the lowest acked task of all remote clusters
+	executionManager.EXPECT().GetHistoryTasks(gomock.Any(), &persistence.GetHistoryTasksRequest{
+		ShardID:             1,
+		TaskCategory:        tasks.CategoryReplication,
+		InclusiveMinTaskKey: tasks.NewImmediateKey(11),
+		ExclusiveMaxTaskKey: tasks.NewImmediateKey(13),
+		BatchSize:           pendingTasksScanPageSize,
+	}).DoAndReturn(func(_ any, _ *persistence.GetHistoryTasksRequest) (*persistence.GetHistoryTasksResponse, error) {
+		scans.Add(1)
+		return &persistence.GetHistoryTasksResponse{Tasks: []tasks.Task{pendingTask}}, nil
+	}).AnyTimes()
+
+	cache := newPendingTasksCache(shardContext, log.NewNoopLogger())
+	// nothing is cached before the first scan completed
+	pendingTasks, truncated := cache.get()
+	require.Empty(t, pendingTasks)
+	require.False(t, truncated)
+	require.Eventually(t, func() bool {
+		pendingTasks, _ := cache.get()
+		return len(pendingTasks) == 1
+	}, time.Second, time.Millisecond)
+	require.Equal(t, int32(1), scans.Load())
+
+	// the tasks are read again only once they are stale
+	cache.get()
+	require.Equal(t, int32(1), scans.Load())
+	timeSource.Update(timeSource.Now().Add(pendingTasksScanInterval))
+	require.Eventually(t, func() bool {
+		cache.get()
+		return scans.Load() == 2
+	}, time.Second, time.Millisecond)
+}
+
+func TestOldestPendingTask(t *testing.T) {
+	pendingTasks := []tasks.Task{
+		&tasks.HistoryReplicationTask{WorkflowKey: definition.NewWorkflowKey("ns-1", "wf", "run"), TaskID: 11},
+		&tasks.HistoryReplicationTask{WorkflowKey: definition.NewWorkflowKey("ns-2", "wf", "run"), TaskID: 12},
+	}
+
+	task, ok := OldestPendingTask(pendingTasks, false, 10, "")
+	require.True(t, ok)
+	require.Equal(t, int64(11), task.GetTaskID())
+	task, ok = OldestPendingTask(pendingTasks, false, 10, "ns-2")
+	require.True(t, ok)
+	require.Equal(t, int64(12), task.GetTaskID())
+	_, ok = OldestPendingTask(pendingTasks, false, 12, "")
+	require.False(t, ok)
+
+	// a truncated scan bounds the oldest pending task of a namespace not found in it
+	task, ok = OldestPendingTask(pendingTasks, true, 10, "ns-3")
+	require.True(t, ok)
+	require.Equal(t, int64(12), task.GetTaskID())
+	// but not for a cluster which acked all tasks of the scan
+	_, ok = OldestPendingTask(pendingTasks, true, 12, "")
+	require.False(t, ok)
+}
@@ -294,6 +294,25 @@ func convertSyncHSMReplicationTask(
 	)
 }
 
+// convertWriteIntentReplicationTask does not load the mutable state: the intent is applied by the active cluster
+// regardless of the state of the execution in the standby cluster.
+func convertWriteIntentReplicationTask(
+	taskInfo *tasks.WriteIntentTask,
+) *replicationspb.ReplicationTask {
+	return &replicationspb.ReplicationTask{
+		TaskType:     enumsspb.REPLICATION_TASK_TYPE_WRITE_INTENT,
+		SourceTaskId: taskInfo.TaskID,
+		Attributes: &replicationspb.ReplicationTask_WriteIntentAttributes{
+			WriteIntentAttributes: &replicationspb.WriteIntentAttributes{
+				NamespaceId:   taskInfo.NamespaceID,
+				SignalRequest: taskInfo.SignalRequest,
+				UpdateRequest: taskInfo.UpdateRequest,
+			},
+		},
+		VisibilityTime: timestamppb.New(taskInfo.VisibilityTimestamp),
+	}
+}
+
 func convertSyncVersionedTransitionTask(
 	ctx context.Context,
 	taskInfo *tasks.SyncVersionedTransitionTask,
@@ -36,6 +36,7 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
@@ -1074,6 +1075,40 @@ func (s *rawTaskConverterSuite) TestConvertSyncHSMTask_BufferedEvents() {
 	s.True(s.lockReleased)
 }
 
+func (s *rawTaskConverterSuite) TestConvertWriteIntentTask() {
+	signalRequest := &workflowservice.SignalWorkflowExecutionRequest{
+		WorkflowExecution: &commonpb.WorkflowExecution{
+			WorkflowId: s.workflowID,
+		},
+		SignalName: "signal",
+		RequestId:  uuid.New(),
+	}
+	visibilityTimestamp := time.Now().UTC()
+	task := &tasks.WriteIntentTask{
+		WorkflowKey: definition.NewWorkflowKey(
+			s.namespaceID,
+			s.workflowID,
+			s.runID,
+		),
+		VisibilityTimestamp: visibilityTimestamp,
+		TaskID:              1444,
+		SignalRequest:       signalRequest,
+	}
+
+	result := convertWriteIntentReplicationTask(task)
+	s.ProtoEqual(&replicationspb.ReplicationTask{
+		TaskType:     enumsspb.REPLICATION_TASK_TYPE_WRITE_INTENT,
+		SourceTaskId: 1444,
+		Attributes: &replicationspb.ReplicationTask_WriteIntentAttributes{
+			WriteIntentAttributes: &replicationspb.WriteIntentAttributes{
+				NamespaceId:   s.namespaceID,
+				SignalRequest: signalRequest,
+			},
+		},
+		VisibilityTime: timestamppb.New(visibilityTimestamp),
+	}, result)
+}
+
 func (s *rawTaskConverterSuite) TestConvertSyncVersionedTransitionTask_Backfill() {
 	ctx := context.Background()
 	shardID := int32(0)
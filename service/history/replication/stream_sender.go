@@ -51,10 +51,13 @@ import (
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/tasks"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-const TaskMaxSkipCount int = 1000
+const (
+	TaskMaxSkipCount int = 1000
+)
 
 type (
 	StreamSender interface {
@@ -341,20 +344,79 @@ func (s *StreamSenderImpl) recvSyncReplicationState(
 		return err
 	}
 
+	ackedTaskID := inclusiveLowWatermark - 1
+	ackedTaskTime := inclusiveLowWatermarkTime.AsTime()
 	if s.isTieredStackEnabled {
 		// RemoteReaderInfo is used for failover. It is to determine if remote cluster has caught up on replication tasks.
 		// In tiered stack, we will use high priority watermark to do failover as High Priority channel is supposed to be used for live traffic
 		// and Low Priority channel is used for force replication closed workflow.
-		return s.shardContext.UpdateRemoteReaderInfo(
-			readerID,
-			attr.HighPriorityState.InclusiveLowWatermark-1,
-			attr.HighPriorityState.InclusiveLowWatermarkTime.AsTime(),
-		)
+		ackedTaskID = attr.HighPriorityState.InclusiveLowWatermark - 1
+		ackedTaskTime = attr.HighPriorityState.InclusiveLowWatermarkTime.AsTime()
 	}
+	s.recordReplicationLag(ackedTaskID)
 	return s.shardContext.UpdateRemoteReaderInfo(
 		readerID,
-		inclusiveLowWatermark-1,
-		inclusiveLowWatermarkTime.AsTime(),
+		ackedTaskID,
+		ackedTaskTime,
+	)
+}
+
+// recordReplicationLag emits how far the remote cluster is behind on the replication tasks of this shard, both in
+// task ids and in time since the oldest task not acknowledged yet was created, overall and for each namespace with
+// such tasks. The time lag is zero when there is no pending task. The time lag is measured from the pending tasks
+// cached by the history engine, so the receiving event loop never waits for the replication queue to be read.
+func (s *StreamSenderImpl) recordReplicationLag(
+	ackedTaskID int64,
+) {
+	maxTaskID := s.shardContext.GetQueueExclusiveHighReadWatermark(tasks.CategoryReplication).TaskID - 1
+	taskLag := max(maxTaskID-ackedTaskID, 0)
+	metrics.ReplicationStreamTaskLag.With(s.metrics).Record(
+		taskLag,
+		metrics.FromClusterIDTag(s.serverShardKey.ClusterID),
+		metrics.ToClusterIDTag(s.clientShardKey.ClusterID),
+	)
+	if taskLag == 0 {
+		s.recordReplicationTimeLag(0)
+		return
+	}
+
+	pendingTasks, truncated := s.historyEngine.GetPendingReplicationTasks()
+	oldestTask, ok := OldestPendingTask(pendingTasks, truncated, ackedTaskID, "")
+	if !ok {
+		// the pending tasks were not read yet, or were read before the tasks pending now were created
+		return
+	}
+	now := s.shardContext.GetTimeSource().Now()
+	s.recordReplicationTimeLag(max(now.Sub(oldestTask.GetVisibilityTime()), 0))
+
+	oldestNamespaceTasks := make(map[string]time.Time)
+	for _, task := range pendingTasks {
+		if task.GetTaskID() <= ackedTaskID {
+			continue
+		}
+		if oldest, ok := oldestNamespaceTasks[task.GetNamespaceID()]; !ok || task.GetVisibilityTime().Before(oldest) {
+			oldestNamespaceTasks[task.GetNamespaceID()] = task.GetVisibilityTime()
+		}
+	}
+	for namespaceID, oldest := range oldestNamespaceTasks {
+		namespaceName, err := s.shardContext.GetNamespaceRegistry().GetNamespaceName(namespace.ID(namespaceID))
+		if err != nil {
+			continue
+		}
+		metrics.ReplicationStreamNamespaceTimeLag.With(s.metrics).Record(
+			max(now.Sub(oldest), 0),
+			metrics.NamespaceTag(namespaceName.String()),
+			metrics.FromClusterIDTag(s.serverShardKey.ClusterID),
+			metrics.ToClusterIDTag(s.clientShardKey.ClusterID),
+		)
+	}
+}
+
+func (s *StreamSenderImpl) recordReplicationTimeLag(timeLag time.Duration) {
+	metrics.ReplicationStreamTimeLag.With(s.metrics).Record(
+		timeLag,
+		metrics.FromClusterIDTag(s.serverShardKey.ClusterID),
+		metrics.ToClusterIDTag(s.clientShardKey.ClusterID),
 	)
 }
 
@@ -478,6 +540,43 @@ func (s *StreamSenderImpl) sendTasks(
 		return err
 	}
 	skipCount := 0
+	var batch []*replicationspb.ReplicationTask
+	batchBytes := 0
+	flushBatch := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		lastTask := batch[len(batch)-1]
+		if err := s.sendToStream(&historyservice.StreamWorkflowReplicationMessagesResponse{
+			Attributes: &historyservice.StreamWorkflowReplicationMessagesResponse_Messages{
+				Messages: &replicationspb.WorkflowReplicationMessages{
+					ReplicationTasks:           batch,
+					ExclusiveHighWatermark:     lastTask.SourceTaskId + 1,
+					ExclusiveHighWatermarkTime: lastTask.VisibilityTime,
+					Priority:                   priority,
+				},
+			},
+		}); err != nil {
+			return err
+		}
+		for _, task := range batch {
+			metrics.ReplicationTasksSend.With(s.metrics).Record(
+				int64(1),
+				metrics.FromClusterIDTag(s.serverShardKey.ClusterID),
+				metrics.ToClusterIDTag(s.clientShardKey.ClusterID),
+				metrics.OperationTag(TaskOperationTag(task)),
+			)
+		}
+		metrics.ReplicationTasksSendBatchSize.With(s.metrics).Record(
+			int64(len(batch)),
+			metrics.FromClusterIDTag(s.serverShardKey.ClusterID),
+			metrics.ToClusterIDTag(s.clientShardKey.ClusterID),
+		)
+		batch = nil
+		batchBytes = 0
+		skipCount = 0
+		return nil
+	}
 Loop:
 	for iter.HasNext() {
 		if s.shutdownChan.IsShutdown() {
@@ -498,6 +597,10 @@ Loop:
 		// so it will not ACK back to sender, sender will not update the ACK level.
 		// i.e. in tiered stack, if no low priority task in queue, we should still send watermark info to receiver to let it update ACK level.
 		if skipCount > TaskMaxSkipCount {
+			// pending tasks must be sent before the watermark moves past them
+			if err := flushBatch(); err != nil {
+				return err
+			}
 			if err := s.sendToStream(&historyservice.StreamWorkflowReplicationMessagesResponse{
 				Attributes: &historyservice.StreamWorkflowReplicationMessagesResponse_Messages{
 					Messages: &replicationspb.WorkflowReplicationMessages{
@@ -514,38 +617,11 @@ Loop:
 			priority != s.getTaskPriority(item) { // case: skip task with different priority than this loop
 			continue Loop
 		}
+		var task *replicationspb.ReplicationTask
 		operation := func() error {
-			task, err := s.taskConverter.Convert(item, s.clientShardKey.ClusterID)
-			if err != nil {
-				return err
-			}
-			if task == nil {
-				return nil
-			}
-			task.Priority = priority
-			if s.isTieredStackEnabled {
-				s.flowController.Wait(priority)
-			}
-			if err := s.sendToStream(&historyservice.StreamWorkflowReplicationMessagesResponse{
-				Attributes: &historyservice.StreamWorkflowReplicationMessagesResponse_Messages{
-					Messages: &replicationspb.WorkflowReplicationMessages{
-						ReplicationTasks:           []*replicationspb.ReplicationTask{task},
-						ExclusiveHighWatermark:     task.SourceTaskId + 1,
-						ExclusiveHighWatermarkTime: task.VisibilityTime,
-						Priority:                   priority,
-					},
-				},
-			}); err != nil {
-				return err
-			}
-			skipCount = 0
-			metrics.ReplicationTasksSend.With(s.metrics).Record(
-				int64(1),
-				metrics.FromClusterIDTag(s.serverShardKey.ClusterID),
-				metrics.ToClusterIDTag(s.clientShardKey.ClusterID),
-				metrics.OperationTag(TaskOperationTag(task)),
-			)
-			return nil
+			var err error
+			task, err = s.taskConverter.Convert(item, s.clientShardKey.ClusterID)
+			return err
 		}
 
 		retryPolicy := backoff.NewExponentialRetryPolicy(1 * time.Second).
@@ -557,6 +633,25 @@ Loop:
 		if err := backoff.ThrottleRetry(operation, retryPolicy, IsRetryableError); err != nil {
 			return fmt.Errorf("failed to send task: %v, cause: %w", item, err)
 		}
+		if task == nil {
+			continue Loop
+		}
+		task.Priority = priority
+		if s.isTieredStackEnabled {
+			s.flowController.Wait(priority)
+		}
+		batch = append(batch, task)
+		batchBytes += proto.Size(task)
+		if len(batch) >= s.config.ReplicationStreamSendBatchMaxCount() ||
+			batchBytes >= s.config.ReplicationStreamSendBatchMaxBytes() {
+			if err := flushBatch(); err != nil {
+				return fmt.Errorf("failed to send task: %v, cause: %w", item, err)
+			}
+		}
+	}
+	// the iterator is drained, so send whatever is pending instead of waiting for the batch to fill up
+	if err := flushBatch(); err != nil {
+		return err
 	}
 	return s.sendToStream(&historyservice.StreamWorkflowReplicationMessagesResponse{
 		Attributes: &historyservice.StreamWorkflowReplicationMessagesResponse_Messages{
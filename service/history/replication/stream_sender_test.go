@@ -38,10 +38,13 @@ import (
 	"go.temporal.io/server/api/historyservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/collection"
 	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
@@ -149,6 +152,10 @@ func (s *streamSenderSuite) TestRecvSyncReplicationState_SingleStack_Success() {
 			}},
 		},
 	).Return(nil)
+	s.shardContext.EXPECT().GetQueueExclusiveHighReadWatermark(tasks.CategoryReplication).Return(
+		tasks.NewImmediateKey(replicationState.InclusiveLowWatermark + 10),
+	)
+	s.historyEngine.EXPECT().GetPendingReplicationTasks().Return(nil, false)
 	s.shardContext.EXPECT().UpdateRemoteReaderInfo(
 		readerID,
 		replicationState.InclusiveLowWatermark-1,
@@ -159,6 +166,64 @@ func (s *streamSenderSuite) TestRecvSyncReplicationState_SingleStack_Success() {
 	s.NoError(err)
 }
 
+func (s *streamSenderSuite) TestRecordReplicationLag() {
+	metricsHandler := metricstest.NewCaptureHandler()
+	capture := metricsHandler.StartCapture()
+	defer metricsHandler.StopCapture(capture)
+	s.streamSender.metrics = metricsHandler
+
+	now := time.Now()
+	timeSource := clock.NewEventTimeSource()
+	timeSource.Update(now)
+	ackedTaskID := int64(100)
+	pendingTasks := []tasks.Task{
+		&tasks.HistoryReplicationTask{
+			WorkflowKey:         definition.NewWorkflowKey("ns-1", "wf-1", "run-1"),
+			TaskID:              ackedTaskID + 1,
+			VisibilityTimestamp: now.Add(-30 * time.Second),
+		},
+		&tasks.HistoryReplicationTask{
+			WorkflowKey:         definition.NewWorkflowKey("ns-2", "wf-2", "run-2"),
+			TaskID:              ackedTaskID + 2,
+			VisibilityTimestamp: now.Add(-10 * time.Second),
+		},
+	}
+	s.historyEngine.EXPECT().GetPendingReplicationTasks().Return(pendingTasks, false).Times(2)
+	namespaceRegistry := namespace.NewMockRegistry(s.controller)
+	namespaceRegistry.EXPECT().GetNamespaceName(namespace.ID("ns-1")).Return(namespace.Name("ns-1-name"), nil)
+	namespaceRegistry.EXPECT().GetNamespaceName(namespace.ID("ns-2")).Return(namespace.Name("ns-2-name"), nil).Times(2)
+	s.shardContext.EXPECT().GetNamespaceRegistry().Return(namespaceRegistry).Times(3)
+	s.shardContext.EXPECT().GetTimeSource().Return(timeSource).AnyTimes()
+	s.shardContext.EXPECT().GetQueueExclusiveHighReadWatermark(tasks.CategoryReplication).Return(
+		tasks.NewImmediateKey(ackedTaskID + 3),
+	).Times(3)
+
+	// the lag is measured from the oldest pending task, overall and for each namespace
+	s.streamSender.recordReplicationLag(ackedTaskID)
+
+	snapshot := capture.Snapshot()
+	s.Len(snapshot[metrics.ReplicationStreamTimeLag.Name()], 1)
+	s.Equal(30*time.Second, snapshot[metrics.ReplicationStreamTimeLag.Name()][0].Value)
+	namespaceLags := make(map[string]any)
+	for _, recording := range snapshot[metrics.ReplicationStreamNamespaceTimeLag.Name()] {
+		namespaceLags[recording.Tags[metrics.NamespaceTag("").Key()]] = recording.Value
+	}
+	s.Equal(map[string]any{"ns-1-name": 30 * time.Second, "ns-2-name": 10 * time.Second}, namespaceLags)
+
+	// cached tasks acked since they were read are ignored
+	s.streamSender.recordReplicationLag(ackedTaskID + 1)
+	snapshot = capture.Snapshot()
+	s.Len(snapshot[metrics.ReplicationStreamTimeLag.Name()], 2)
+	s.Equal(10*time.Second, snapshot[metrics.ReplicationStreamTimeLag.Name()][1].Value)
+	s.Len(snapshot[metrics.ReplicationStreamNamespaceTimeLag.Name()], 3)
+
+	// the lag is zero once nothing is pending
+	s.streamSender.recordReplicationLag(ackedTaskID + 2)
+	snapshot = capture.Snapshot()
+	s.Len(snapshot[metrics.ReplicationStreamTimeLag.Name()], 3)
+	s.Equal(time.Duration(0), snapshot[metrics.ReplicationStreamTimeLag.Name()][2].Value)
+}
+
 func (s *streamSenderSuite) TestRecvSyncReplicationState_SingleStack_Error() {
 	s.streamSender.isTieredStackEnabled = false
 	readerID := shard.ReplicationReaderIDFromClusterShardID(
@@ -275,6 +340,9 @@ func (s *streamSenderSuite) TestRecvSyncReplicationState_TieredStack_Success() {
 			},
 		},
 	).Return(nil)
+	s.shardContext.EXPECT().GetQueueExclusiveHighReadWatermark(tasks.CategoryReplication).Return(
+		tasks.NewImmediateKey(replicationState.HighPriorityState.InclusiveLowWatermark),
+	)
 	s.shardContext.EXPECT().UpdateRemoteReaderInfo(
 		readerID,
 		replicationState.HighPriorityState.InclusiveLowWatermark-1,
@@ -845,6 +913,77 @@ func (s *streamSenderSuite) TestSendTasks_WithTasks() {
 	s.NoError(err)
 }
 
+func (s *streamSenderSuite) TestSendTasks_Batched() {
+	s.streamSender.isTieredStackEnabled = false
+	s.config.ReplicationStreamSendBatchMaxCount = dynamicconfig.GetIntPropertyFn(2)
+	beginInclusiveWatermark := rand.Int63()
+	endExclusiveWatermark := beginInclusiveWatermark + 100
+	items := make([]tasks.Task, 3)
+	replicationTasks := make([]*replicationspb.ReplicationTask, 3)
+	for i := range items {
+		item := tasks.NewMockTask(s.controller)
+		item.EXPECT().GetNamespaceID().Return("1").AnyTimes()
+		item.EXPECT().GetWorkflowID().Return("1").AnyTimes()
+		items[i] = item
+		replicationTasks[i] = &replicationspb.ReplicationTask{
+			SourceTaskId:   beginInclusiveWatermark + int64(i),
+			VisibilityTime: timestamppb.New(time.Unix(0, rand.Int63())),
+		}
+		s.taskConverter.EXPECT().Convert(item, s.clientShardKey.ClusterID).Return(replicationTasks[i], nil)
+	}
+
+	iter := collection.NewPagingIterator[tasks.Task](
+		func(paginationToken []byte) ([]tasks.Task, []byte, error) {
+			return items, nil, nil
+		},
+	)
+	mockRegistry := namespace.NewMockRegistry(s.controller)
+	mockRegistry.EXPECT().GetNamespaceByID(namespace.ID("1")).Return(namespace.NewGlobalNamespaceForTest(
+		nil, nil, &persistencespb.NamespaceReplicationConfig{
+			Clusters: []string{"source_cluster", "target_cluster"},
+		}, 100), nil).AnyTimes()
+	s.shardContext.EXPECT().GetNamespaceRegistry().Return(mockRegistry).AnyTimes()
+	s.historyEngine.EXPECT().GetReplicationTasksIter(
+		gomock.Any(),
+		string(s.clientShardKey.ClusterID),
+		beginInclusiveWatermark,
+		endExclusiveWatermark,
+	).Return(iter, nil)
+	gomock.InOrder(
+		s.server.EXPECT().Send(&historyservice.StreamWorkflowReplicationMessagesResponse{
+			Attributes: &historyservice.StreamWorkflowReplicationMessagesResponse_Messages{
+				Messages: &replicationspb.WorkflowReplicationMessages{
+					ReplicationTasks:           replicationTasks[:2],
+					ExclusiveHighWatermark:     replicationTasks[1].SourceTaskId + 1,
+					ExclusiveHighWatermarkTime: replicationTasks[1].VisibilityTime,
+				},
+			},
+		}).Return(nil),
+		// the iterator is drained, so the partial batch is sent right away
+		s.server.EXPECT().Send(&historyservice.StreamWorkflowReplicationMessagesResponse{
+			Attributes: &historyservice.StreamWorkflowReplicationMessagesResponse_Messages{
+				Messages: &replicationspb.WorkflowReplicationMessages{
+					ReplicationTasks:           replicationTasks[2:],
+					ExclusiveHighWatermark:     replicationTasks[2].SourceTaskId + 1,
+					ExclusiveHighWatermarkTime: replicationTasks[2].VisibilityTime,
+				},
+			},
+		}).Return(nil),
+		s.server.EXPECT().Send(gomock.Any()).DoAndReturn(func(resp *historyservice.StreamWorkflowReplicationMessagesResponse) error {
+			s.Equal(endExclusiveWatermark, resp.GetMessages().ExclusiveHighWatermark)
+			s.Empty(resp.GetMessages().ReplicationTasks)
+			return nil
+		}),
+	)
+
+	err := s.streamSender.sendTasks(
+		enumsspb.TASK_PRIORITY_UNSPECIFIED,
+		beginInclusiveWatermark,
+		endExclusiveWatermark,
+	)
+	s.NoError(err)
+}
+
 func (s *streamSenderSuite) TestSendTasks_TieredStack_HighPriority() {
 	s.streamSender.isTieredStackEnabled = true
 	beginInclusiveWatermark := rand.Int63()
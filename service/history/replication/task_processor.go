@@ -30,6 +30,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -243,6 +244,7 @@ func (p *taskProcessorImpl) pollProcessReplicationTasks() (retError error) {
 		}
 	}()
 
+	var replicationTasks []*replicationspb.ReplicationTask
 	taskIterator := collection.NewPagingIterator(p.paginationFn)
 	for taskIterator.HasNext() && !p.isStopped() {
 		task, err := taskIterator.Next()
@@ -260,11 +262,10 @@ func (p *taskProcessorImpl) pollProcessReplicationTasks() (retError error) {
 				metrics.SourceClusterTag(p.sourceCluster),
 			)
 		}
-		if err = p.applyReplicationTask(replicationTask); err != nil {
-			return err
-		}
-		p.maxRxProcessedTaskID = replicationTask.GetSourceTaskId()
-		p.maxRxProcessedTimestamp = timestamp.TimeValue(replicationTask.GetVisibilityTime())
+		replicationTasks = append(replicationTasks, replicationTask)
+	}
+	if err := p.applyReplicationTasks(replicationTasks); err != nil {
+		return err
 	}
 
 	if !p.isStopped() {
@@ -277,6 +278,77 @@ func (p *taskProcessorImpl) pollProcessReplicationTasks() (retError error) {
 	return nil
 }
 
+// applyReplicationTasks applies the given tasks in parallel across workflows, but in order for each workflow, and
+// advances the max processed task up to the first task which wasn't applied.
+func (p *taskProcessorImpl) applyReplicationTasks(
+	replicationTasks []*replicationspb.ReplicationTask,
+) error {
+	parallelism := p.config.ReplicationTaskProcessorApplyParallelism(p.shard.GetShardID())
+	if parallelism <= 1 || len(replicationTasks) <= 1 {
+		for _, replicationTask := range replicationTasks {
+			if p.isStopped() {
+				return nil
+			}
+			if err := p.applyReplicationTask(replicationTask); err != nil {
+				return err
+			}
+			p.setMaxRxProcessedTask(replicationTask)
+		}
+		return nil
+	}
+
+	// Tasks are partitioned by workflow so that tasks of the same workflow are applied sequentially, in the order
+	// they were replicated.
+	partitions := make(map[int32][]int)
+	for index, replicationTask := range replicationTasks {
+		namespaceID, workflowID := replicationTaskWorkflowKey(replicationTask)
+		partition := common.WorkflowIDToHistoryShard(namespaceID, workflowID, int32(parallelism))
+		partitions[partition] = append(partitions[partition], index)
+	}
+
+	applied := make([]bool, len(replicationTasks))
+	errs := make([]error, 0, len(partitions))
+	var errsLock sync.Mutex
+	var wg sync.WaitGroup
+	for _, indexes := range partitions {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, index := range indexes {
+				if p.isStopped() {
+					return
+				}
+				if err := p.applyReplicationTask(replicationTasks[index]); err != nil {
+					errsLock.Lock()
+					errs = append(errs, err)
+					errsLock.Unlock()
+					return
+				}
+				applied[index] = true
+			}
+		}()
+	}
+	wg.Wait()
+
+	for index, replicationTask := range replicationTasks {
+		if !applied[index] {
+			break
+		}
+		p.setMaxRxProcessedTask(replicationTask)
+	}
+	if len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+func (p *taskProcessorImpl) setMaxRxProcessedTask(
+	replicationTask *replicationspb.ReplicationTask,
+) {
+	p.maxRxProcessedTaskID = replicationTask.GetSourceTaskId()
+	p.maxRxProcessedTimestamp = timestamp.TimeValue(replicationTask.GetVisibilityTime())
+}
+
 func (p *taskProcessorImpl) applyReplicationTask(
 	replicationTask *replicationspb.ReplicationTask,
 ) error {
@@ -615,3 +687,35 @@ func (p *taskProcessorImpl) isRetryableError(
 		return true
 	}
 }
+
+// replicationTaskWorkflowKey returns the namespace ID and workflow ID of the workflow a replication task applies to,
+// or empty strings if the task doesn't apply to a workflow.
+func replicationTaskWorkflowKey(
+	replicationTask *replicationspb.ReplicationTask,
+) (string, string) {
+	switch replicationTask.GetTaskType() {
+	case enumsspb.REPLICATION_TASK_TYPE_SYNC_ACTIVITY_TASK:
+		attributes := replicationTask.GetSyncActivityTaskAttributes()
+		return attributes.GetNamespaceId(), attributes.GetWorkflowId()
+	case enumsspb.REPLICATION_TASK_TYPE_HISTORY_V2_TASK:
+		attributes := replicationTask.GetHistoryTaskAttributes()
+		return attributes.GetNamespaceId(), attributes.GetWorkflowId()
+	case enumsspb.REPLICATION_TASK_TYPE_SYNC_WORKFLOW_STATE_TASK:
+		executionInfo := replicationTask.GetSyncWorkflowStateTaskAttributes().GetWorkflowState().GetExecutionInfo()
+		return executionInfo.GetNamespaceId(), executionInfo.GetWorkflowId()
+	case enumsspb.REPLICATION_TASK_TYPE_SYNC_HSM_TASK:
+		attributes := replicationTask.GetSyncHsmAttributes()
+		return attributes.GetNamespaceId(), attributes.GetWorkflowId()
+	case enumsspb.REPLICATION_TASK_TYPE_BACKFILL_HISTORY_TASK:
+		attributes := replicationTask.GetBackfillHistoryTaskAttributes()
+		return attributes.GetNamespaceId(), attributes.GetWorkflowId()
+	case enumsspb.REPLICATION_TASK_TYPE_VERIFY_VERSIONED_TRANSITION_TASK:
+		attributes := replicationTask.GetVerifyVersionedTransitionTaskAttributes()
+		return attributes.GetNamespaceId(), attributes.GetWorkflowId()
+	case enumsspb.REPLICATION_TASK_TYPE_SYNC_VERSIONED_TRANSITION_TASK:
+		attributes := replicationTask.GetSyncVersionedTransitionTaskAttributes()
+		return attributes.GetNamespaceId(), attributes.GetWorkflowId()
+	default:
+		return "", ""
+	}
+}
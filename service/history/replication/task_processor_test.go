@@ -27,6 +27,7 @@ package replication
 import (
 	"context"
 	"math/rand"
+	"sync"
 	"testing"
 	"time"
 
@@ -44,7 +45,9 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	"go.temporal.io/server/client"
+	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
@@ -253,6 +256,57 @@ func (s *taskProcessorSuite) TestHandleReplicationTask_Panic() {
 	s.Error(err)
 }
 
+func (s *taskProcessorSuite) TestApplyReplicationTasks_Parallel() {
+	s.config.ReplicationTaskProcessorApplyParallelism = dynamicconfig.GetIntPropertyFnFilteredByShardID(4)
+	s.replicationTaskProcessor.taskRetryPolicy = backoff.NewExponentialRetryPolicy(time.Millisecond).WithMaximumAttempts(1)
+	namespaceID := uuid.NewRandom().String()
+	newTask := func(taskID int64, workflowID string) *replicationspb.ReplicationTask {
+		return &replicationspb.ReplicationTask{
+			TaskType:     enumsspb.REPLICATION_TASK_TYPE_SYNC_ACTIVITY_TASK,
+			SourceTaskId: taskID,
+			Attributes: &replicationspb.ReplicationTask_SyncActivityTaskAttributes{
+				SyncActivityTaskAttributes: &replicationspb.SyncActivityTaskAttributes{
+					NamespaceId: namespaceID,
+					WorkflowId:  workflowID,
+					RunId:       uuid.NewRandom().String(),
+				},
+			},
+			VisibilityTime: timestamppb.New(time.Now()),
+		}
+	}
+	tasks := []*replicationspb.ReplicationTask{
+		newTask(1, "workflow-a"),
+		newTask(2, "workflow-b"),
+		newTask(3, "workflow-a"),
+		newTask(4, "workflow-b"),
+		newTask(5, "workflow-a"),
+	}
+
+	var lock sync.Mutex
+	var appliedA []int64
+	recordA := func(_ context.Context, task *replicationspb.ReplicationTask, _ bool) error {
+		lock.Lock()
+		defer lock.Unlock()
+		appliedA = append(appliedA, task.GetSourceTaskId())
+		return nil
+	}
+	s.mockReplicationTaskExecutor.EXPECT().Execute(gomock.Any(), tasks[0], false).DoAndReturn(recordA)
+	s.mockReplicationTaskExecutor.EXPECT().Execute(gomock.Any(), tasks[1], false).Return(nil)
+	s.mockReplicationTaskExecutor.EXPECT().Execute(gomock.Any(), tasks[2], false).DoAndReturn(
+		func(ctx context.Context, task *replicationspb.ReplicationTask, forceApply bool) error {
+			_ = recordA(ctx, task, forceApply)
+			return &persistence.ShardOwnershipLostError{ShardID: s.shardID}
+		},
+	)
+	// Applied only if workflow-b doesn't share a partition with the failing workflow-a.
+	s.mockReplicationTaskExecutor.EXPECT().Execute(gomock.Any(), tasks[3], false).Return(nil).MaxTimes(1)
+
+	err := s.replicationTaskProcessor.applyReplicationTasks(tasks)
+	s.IsType(&persistence.ShardOwnershipLostError{}, err)
+	s.Equal([]int64{1, 3}, appliedA, "tasks of the same workflow must be applied in order")
+	s.Equal(int64(2), s.replicationTaskProcessor.maxRxProcessedTaskID)
+}
+
 func (s *taskProcessorSuite) TestHandleReplicationDLQTask_SyncActivity() {
 	namespaceID := uuid.NewRandom().String()
 	workflowID := uuid.New()
@@ -32,6 +32,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/membership/drain"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/service/history/configs"
@@ -54,6 +55,7 @@ type (
 		membershipMonitor membership.Monitor
 		metricsHandler    metrics.Handler
 		healthServer      *health.Server
+		drainServer       *drain.Server
 	}
 )
 
@@ -67,6 +69,7 @@ func NewService(
 	membershipMonitor membership.Monitor,
 	metricsHandler metrics.Handler,
 	healthServer *health.Server,
+	drainServer *drain.Server,
 ) *Service {
 	return &Service{
 		server:            server,
@@ -78,6 +81,7 @@ func NewService(
 		membershipMonitor: membershipMonitor,
 		metricsHandler:    metricsHandler,
 		healthServer:      healthServer,
+		drainServer:       drainServer,
 	}
 }
 
@@ -115,6 +119,10 @@ func (s *Service) Start() {
 		}
 		s.membershipMonitor.Start()
 	}()
+
+	if s.drainServer != nil {
+		s.drainServer.Start()
+	}
 }
 
 // Stop stops the service
@@ -138,6 +146,9 @@ func (s *Service) Stop() {
 
 	s.handler.Stop()
 	s.visibilityManager.Close()
+	if s.drainServer != nil {
+		s.drainServer.Stop()
+	}
 
 	s.logger.Info("history stopped")
 }
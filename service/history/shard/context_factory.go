@@ -40,6 +40,7 @@ import (
 	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/events"
+	"go.temporal.io/server/service/history/hotspot"
 	"go.temporal.io/server/service/history/hsm"
 	"go.temporal.io/server/service/history/tasks"
 	"go.uber.org/fx"
@@ -80,6 +81,8 @@ type (
 		EventsCache                 events.Cache
 
 		StateMachineRegistry *hsm.Registry
+		TaskSpanLinks        *tasks.SpanLinks
+		HotspotTracker       *hotspot.Tracker
 	}
 
 	contextFactoryImpl struct {
@@ -121,6 +124,8 @@ func (c *contextFactoryImpl) CreateContext(
 		c.TaskCategoryRegistry,
 		c.EventsCache,
 		c.StateMachineRegistry,
+		c.TaskSpanLinks,
+		c.HotspotTracker,
 	)
 	if err != nil {
 		return nil, err
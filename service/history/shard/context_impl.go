@@ -35,6 +35,7 @@ import (
 	"time"
 
 	"github.com/pborman/uuid"
+	"go.opentelemetry.io/otel/trace"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
@@ -73,6 +74,7 @@ import (
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/events"
+	"go.temporal.io/server/service/history/hotspot"
 	"go.temporal.io/server/service/history/hsm"
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/history/vclock"
@@ -169,6 +171,8 @@ type (
 		acquireShardRetryPolicy backoff.RetryPolicy
 
 		stateMachineRegistry *hsm.Registry
+		taskSpanLinks        *tasks.SpanLinks
+		hotspotTracker       *hotspot.Tracker
 	}
 
 	remoteClusterInfo struct {
@@ -180,6 +184,18 @@ type (
 	namespaceHandOverInfo struct {
 		MaxReplicationTaskID int64
 		NotificationVersion  int64
+		// ClusterNames are the clusters of the namespace that must ack replication tasks
+		// up to MaxReplicationTaskID before the shard is considered drained.
+		ClusterNames []string
+		// AcceptedReplicationTaskID is the max replication taskID of writes accepted during
+		// the handover when fine-grained handover is enabled.
+		AcceptedReplicationTaskID int64
+		// PendingWorkflows are the workflows written during the handover, keyed by workflowID,
+		// with the max replication taskID of their writes. They are removed once remote clusters ack it.
+		PendingWorkflows map[string]int64
+		// HandoverPointFixed is set once remote clusters acked the replication tasks written
+		// before the handover. The shard then rejects all writes of the namespace.
+		HandoverPointFixed bool
 	}
 
 	// These are the requests that can be passed to transition to change state:
@@ -344,6 +360,10 @@ func (s *ContextImpl) GetFinalizer() *finalizer.Finalizer {
 	return s.finalizer
 }
 
+func (s *ContextImpl) GetHotspotTracker() *hotspot.Tracker {
+	return s.hotspotTracker
+}
+
 func (s *ContextImpl) GenerateTaskIDs(number int) ([]int64, error) {
 	s.wLock()
 	defer s.wUnlock()
@@ -516,11 +536,13 @@ func (s *ContextImpl) UpdateHandoverNamespace(ns *namespace.Namespace, deletedFr
 		if handover.NotificationVersion < ns.NotificationVersion() {
 			handover.NotificationVersion = ns.NotificationVersion()
 			handover.MaxReplicationTaskID = maxReplicationTaskID
+			handover.ClusterNames = ns.ClusterNames()
 		}
 	} else {
 		s.handoverNamespaces[nsName] = &namespaceHandOverInfo{
 			NotificationVersion:  ns.NotificationVersion(),
 			MaxReplicationTaskID: maxReplicationTaskID,
+			ClusterNames:         ns.ClusterNames(),
 		}
 	}
 
@@ -606,7 +628,8 @@ func (s *ContextImpl) CreateWorkflowExecution(
 		return nil, err
 	}
 
-	if err := s.errorByNamespaceStateLocked(namespaceEntry.Name()); err != nil {
+	workflowID := request.NewWorkflowSnapshot.ExecutionInfo.WorkflowId
+	if err := s.errorByNamespaceStateLocked(namespaceEntry.Name(), workflowID); err != nil {
 		s.wUnlock()
 		return nil, err
 	}
@@ -618,18 +641,23 @@ func (s *ContextImpl) CreateWorkflowExecution(
 		s.wUnlock()
 		return nil, err
 	}
+	s.trackHandoverNamespaceWritesLocked(namespaceEntry.Name(), workflowID, request.NewWorkflowSnapshot.Tasks)
 	s.updateCloseTaskIDs(request.NewWorkflowSnapshot.ExecutionInfo, request.NewWorkflowSnapshot.Tasks)
 
 	currentRangeID := s.getRangeIDLocked()
 	request.RangeID = currentRangeID
 
 	s.wUnlock()
+	s.recordTaskSpanLinks(ctx, request.NewWorkflowSnapshot.Tasks)
 	resp, err := s.executionManager.CreateWorkflowExecution(ctx, request)
 	requestCompletionFn(err)
 
 	if err = s.handleWriteError(request.RangeID, err); err != nil {
 		return nil, err
 	}
+	if resp != nil {
+		s.recordHistoryWrite(request.NewWorkflowSnapshot.ExecutionInfo, &resp.NewMutableStateStats)
+	}
 	return resp, nil
 }
 
@@ -664,7 +692,8 @@ func (s *ContextImpl) UpdateWorkflowExecution(
 		return nil, err
 	}
 
-	if err := s.errorByNamespaceStateLocked(namespaceEntry.Name()); err != nil {
+	workflowID := request.UpdateWorkflowMutation.ExecutionInfo.WorkflowId
+	if err := s.errorByNamespaceStateLocked(namespaceEntry.Name(), workflowID); err != nil {
 		s.wUnlock()
 		return nil, err
 	}
@@ -679,6 +708,7 @@ func (s *ContextImpl) UpdateWorkflowExecution(
 		s.wUnlock()
 		return nil, err
 	}
+	s.trackHandoverNamespaceWritesLocked(namespaceEntry.Name(), workflowID, taskMaps...)
 	s.updateCloseTaskIDs(request.UpdateWorkflowMutation.ExecutionInfo, request.UpdateWorkflowMutation.Tasks)
 	if request.NewWorkflowSnapshot != nil {
 		s.updateCloseTaskIDs(request.NewWorkflowSnapshot.ExecutionInfo, request.NewWorkflowSnapshot.Tasks)
@@ -687,14 +717,37 @@ func (s *ContextImpl) UpdateWorkflowExecution(
 	request.RangeID = s.getRangeIDLocked()
 	s.wUnlock()
 
+	s.recordTaskSpanLinks(ctx, taskMaps...)
 	resp, err := s.executionManager.UpdateWorkflowExecution(ctx, request)
 	requestCompletionFn(err)
 	if err = s.handleWriteError(request.RangeID, err); err != nil {
 		return nil, err
 	}
+	if resp != nil {
+		s.recordHistoryWrite(request.UpdateWorkflowMutation.ExecutionInfo, &resp.UpdateMutableStateStats)
+		if request.NewWorkflowSnapshot != nil {
+			s.recordHistoryWrite(request.NewWorkflowSnapshot.ExecutionInfo, resp.NewMutableStateStats)
+		}
+	}
 	return resp, nil
 }
 
+// recordHistoryWrite attributes the history events written for a workflow to it for hotspot detection.
+func (s *ContextImpl) recordHistoryWrite(
+	executionInfo *persistencespb.WorkflowExecutionInfo,
+	stats *persistence.MutableStateStatistics,
+) {
+	if stats == nil || stats.HistoryStatistics == nil {
+		return
+	}
+	s.hotspotTracker.RecordHistoryWrite(
+		s.shardID,
+		executionInfo.GetNamespaceId(),
+		executionInfo.GetWorkflowId(),
+		int64(stats.HistoryStatistics.SizeDiff),
+	)
+}
+
 func (s *ContextImpl) updateCloseTaskIDs(executionInfo *persistencespb.WorkflowExecutionInfo, tasksByCategory map[tasks.Category][]tasks.Task) {
 	for _, t := range tasksByCategory[tasks.CategoryTransfer] {
 		if t.GetType() == enumsspb.TASK_TYPE_TRANSFER_CLOSE_EXECUTION {
@@ -741,7 +794,8 @@ func (s *ContextImpl) ConflictResolveWorkflowExecution(
 		return nil, err
 	}
 
-	if err := s.errorByNamespaceStateLocked(namespaceEntry.Name()); err != nil {
+	workflowID := request.ResetWorkflowSnapshot.ExecutionInfo.WorkflowId
+	if err := s.errorByNamespaceStateLocked(namespaceEntry.Name(), workflowID); err != nil {
 		s.wUnlock()
 		return nil, err
 	}
@@ -760,10 +814,12 @@ func (s *ContextImpl) ConflictResolveWorkflowExecution(
 		s.wUnlock()
 		return nil, err
 	}
+	s.trackHandoverNamespaceWritesLocked(namespaceEntry.Name(), workflowID, taskMaps...)
 
 	request.RangeID = s.getRangeIDLocked()
 	s.wUnlock()
 
+	s.recordTaskSpanLinks(ctx, taskMaps...)
 	resp, err := s.executionManager.ConflictResolveWorkflowExecution(ctx, request)
 	requestCompletionFn(err)
 	if err = s.handleWriteError(request.RangeID, err); err != nil {
@@ -803,7 +859,8 @@ func (s *ContextImpl) SetWorkflowExecution(
 		return nil, err
 	}
 
-	if err := s.errorByNamespaceStateLocked(namespaceEntry.Name()); err != nil {
+	workflowID := request.SetWorkflowSnapshot.ExecutionInfo.WorkflowId
+	if err := s.errorByNamespaceStateLocked(namespaceEntry.Name(), workflowID); err != nil {
 		s.wUnlock()
 		return nil, err
 	}
@@ -815,10 +872,12 @@ func (s *ContextImpl) SetWorkflowExecution(
 		s.wUnlock()
 		return nil, err
 	}
+	s.trackHandoverNamespaceWritesLocked(namespaceEntry.Name(), workflowID, request.SetWorkflowSnapshot.Tasks)
 
 	request.RangeID = s.getRangeIDLocked()
 	s.wUnlock()
 
+	s.recordTaskSpanLinks(ctx, request.SetWorkflowSnapshot.Tasks)
 	resp, err := s.executionManager.SetWorkflowExecution(ctx, request)
 	snapShotRequestCompletionFn(err)
 	if err = s.handleWriteError(request.RangeID, err); err != nil {
@@ -859,6 +918,24 @@ func (s *ContextImpl) GetWorkflowExecution(
 	return resp, nil
 }
 
+// recordTaskSpanLinks remembers the span of the request generating the given tasks, so that the spans executing
+// those tasks can be linked back to it. Must be invoked after task keys are set.
+func (s *ContextImpl) recordTaskSpanLinks(
+	ctx context.Context,
+	taskMaps ...map[tasks.Category][]tasks.Task,
+) {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return
+	}
+	for _, taskMap := range taskMaps {
+		for _, categoryTasks := range taskMap {
+			for _, task := range categoryTasks {
+				s.taskSpanLinks.Record(ctx, tasks.SpanLinkKey{ShardID: s.shardID, TaskID: task.GetTaskID()})
+			}
+		}
+	}
+}
+
 func (s *ContextImpl) addTasksSemaphoreAcquired(
 	ctx context.Context,
 	request *persistence.AddHistoryTasksRequest,
@@ -885,7 +962,7 @@ func (s *ContextImpl) addTasksSemaphoreAcquired(
 		s.wUnlock()
 		return err
 	}
-	if err := s.errorByNamespaceStateLocked(namespaceEntry.Name()); err != nil {
+	if err := s.errorByNamespaceStateLocked(namespaceEntry.Name(), request.WorkflowID); err != nil {
 		s.wUnlock()
 		return err
 	}
@@ -897,10 +974,12 @@ func (s *ContextImpl) addTasksSemaphoreAcquired(
 		s.wUnlock()
 		return err
 	}
+	s.trackHandoverNamespaceWritesLocked(namespaceEntry.Name(), request.WorkflowID, request.Tasks)
 
 	request.RangeID = s.getRangeIDLocked()
 	s.wUnlock()
 
+	s.recordTaskSpanLinks(ctx, request.Tasks)
 	err = s.executionManager.AddHistoryTasks(ctx, request)
 	requestCompletionFn(err)
 	return s.handleWriteError(request.RangeID, err)
@@ -1150,15 +1229,99 @@ func (s *ContextImpl) errorByState() error {
 	}
 }
 
+// errorByNamespaceStateLocked rejects writes of a namespace in handover. With fine-grained handover, a shard keeps
+// accepting writes while remote clusters catch up with the replication tasks written before the handover, except
+// writes to workflows whose replication tasks accepted during the handover are not acked yet. Once the tasks from
+// before the handover are acked, the handover point of the shard is fixed and all writes are rejected, so that the
+// handover converges and no write races with the active cluster switch.
 func (s *ContextImpl) errorByNamespaceStateLocked(
 	namespaceName namespace.Name,
+	workflowID string,
 ) error {
-	if _, ok := s.handoverNamespaces[namespaceName]; ok {
+	handover, ok := s.handoverNamespaces[namespaceName]
+	if !ok {
+		return nil
+	}
+	if !s.config.EnableFineGrainedHandover(namespaceName.String()) || handover.HandoverPointFixed {
+		return consts.ErrNamespaceHandover
+	}
+	ackedTaskID, ok := s.handoverAckedReplicationTaskIDLocked(handover)
+	if !ok {
+		return consts.ErrNamespaceHandover
+	}
+	if ackedTaskID >= handover.MaxReplicationTaskID {
+		handover.HandoverPointFixed = true
+		return consts.ErrNamespaceHandover
+	}
+	for pendingWorkflowID, taskID := range handover.PendingWorkflows {
+		if taskID <= ackedTaskID {
+			delete(handover.PendingWorkflows, pendingWorkflowID)
+		}
+	}
+	if _, ok := handover.PendingWorkflows[workflowID]; ok {
+		// the workflow is not yet replicated
 		return consts.ErrNamespaceHandover
 	}
 	return nil
 }
 
+// handoverAckedReplicationTaskIDLocked returns the replication taskID of this shard acked by all remote
+// clusters of the namespace. It returns false if the handover point isn't known yet or a remote cluster
+// hasn't reported its ack level.
+func (s *ContextImpl) handoverAckedReplicationTaskIDLocked(
+	handover *namespaceHandOverInfo,
+) (int64, bool) {
+	if handover.MaxReplicationTaskID == pendingMaxReplicationTaskID {
+		return 0, false
+	}
+
+	ackedTaskID := int64(math.MaxInt64)
+	currentClusterName := s.clusterMetadata.GetCurrentClusterName()
+	clusterInfo := s.clusterMetadata.GetAllClusterInfo()
+	for _, clusterName := range handover.ClusterNames {
+		if clusterName == currentClusterName {
+			continue
+		}
+		if _, ok := clusterInfo[clusterName]; !ok {
+			continue
+		}
+		remoteClusterInfo, ok := s.remoteClusterInfos[clusterName]
+		if !ok {
+			return 0, false
+		}
+		for _, remoteShardID := range common.MapShardID(
+			clusterInfo[currentClusterName].ShardCount,
+			clusterInfo[clusterName].ShardCount,
+			s.shardID,
+		) {
+			ackedTaskID = min(ackedTaskID, remoteClusterInfo.AckedReplicationTaskIDs[remoteShardID])
+		}
+	}
+	return ackedTaskID, true
+}
+
+// trackHandoverNamespaceWritesLocked moves the handover point of a namespace forward to cover the
+// replication tasks of a write accepted during the handover, and marks the workflow as not yet replicated.
+func (s *ContextImpl) trackHandoverNamespaceWritesLocked(
+	namespaceName namespace.Name,
+	workflowID string,
+	taskMaps ...map[tasks.Category][]tasks.Task,
+) {
+	handover, ok := s.handoverNamespaces[namespaceName]
+	if !ok {
+		return
+	}
+	for _, taskMap := range taskMaps {
+		for _, task := range taskMap[tasks.CategoryReplication] {
+			handover.AcceptedReplicationTaskID = max(handover.AcceptedReplicationTaskID, task.GetTaskID())
+			if handover.PendingWorkflows == nil {
+				handover.PendingWorkflows = make(map[string]int64)
+			}
+			handover.PendingWorkflows[workflowID] = max(handover.PendingWorkflows[workflowID], task.GetTaskID())
+		}
+	}
+}
+
 func (s *ContextImpl) generateTaskIDLocked() (int64, error) {
 	taskKey, err := s.taskKeyManager.generateTaskKey(tasks.CategoryTransfer)
 	if err != nil {
@@ -1909,8 +2072,12 @@ func (s *ContextImpl) GetReplicationStatus(clusterNames []string) (map[string]*h
 	}
 
 	for k, v := range s.handoverNamespaces {
+		handoverReplicationTaskID := v.MaxReplicationTaskID
+		if handoverReplicationTaskID != pendingMaxReplicationTaskID {
+			handoverReplicationTaskID = max(handoverReplicationTaskID, v.AcceptedReplicationTaskID)
+		}
 		handoverNamespaces[k.String()] = &historyservice.HandoverNamespaceInfo{
-			HandoverReplicationTaskId: v.MaxReplicationTaskID,
+			HandoverReplicationTaskId: handoverReplicationTaskID,
 		}
 	}
 
@@ -2076,6 +2243,8 @@ func newContext(
 	taskCategoryRegistry tasks.TaskCategoryRegistry,
 	eventsCache events.Cache,
 	stateMachineRegistry *hsm.Registry,
+	taskSpanLinks *tasks.SpanLinks,
+	hotspotTracker *hotspot.Tracker,
 ) (*ContextImpl, error) {
 	hostIdentity := hostInfoProvider.HostInfo().Identity()
 	sequenceID := atomic.AddInt64(&shardContextSequenceID, 1)
@@ -2124,6 +2293,8 @@ func newContext(
 		queueMetricEmitter:      sync.Once{},
 		ioSemaphore:             locks.NewPrioritySemaphore(ioConcurrency),
 		stateMachineRegistry:    stateMachineRegistry,
+		taskSpanLinks:           taskSpanLinks,
+		hotspotTracker:          hotspotTracker,
 	}
 	shardContext.taskKeyManager = newTaskKeyManager(
 		shardContext.taskCategoryRegistry,
@@ -57,6 +57,7 @@ import (
 	searchattribute "go.temporal.io/server/common/searchattribute"
 	configs "go.temporal.io/server/service/history/configs"
 	events "go.temporal.io/server/service/history/events"
+	hotspot "go.temporal.io/server/service/history/hotspot"
 	hsm "go.temporal.io/server/service/history/hsm"
 	tasks "go.temporal.io/server/service/history/tasks"
 	gomock "go.uber.org/mock/gomock"
@@ -372,6 +373,20 @@ func (mr *MockContextMockRecorder) GetHistoryClient() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoryClient", reflect.TypeOf((*MockContext)(nil).GetHistoryClient))
 }
 
+// GetHotspotTracker mocks base method.
+func (m *MockContext) GetHotspotTracker() *hotspot.Tracker {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHotspotTracker")
+	ret0, _ := ret[0].(*hotspot.Tracker)
+	return ret0
+}
+
+// GetHotspotTracker indicates an expected call of GetHotspotTracker.
+func (mr *MockContextMockRecorder) GetHotspotTracker() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHotspotTracker", reflect.TypeOf((*MockContext)(nil).GetHotspotTracker))
+}
+
 // GetLogger mocks base method.
 func (m *MockContext) GetLogger() log.Logger {
 	m.ctrl.T.Helper()
@@ -1100,6 +1115,20 @@ func (mr *MockControllableContextMockRecorder) GetHistoryClient() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoryClient", reflect.TypeOf((*MockControllableContext)(nil).GetHistoryClient))
 }
 
+// GetHotspotTracker mocks base method.
+func (m *MockControllableContext) GetHotspotTracker() *hotspot.Tracker {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHotspotTracker")
+	ret0, _ := ret[0].(*hotspot.Tracker)
+	return ret0
+}
+
+// GetHotspotTracker indicates an expected call of GetHotspotTracker.
+func (mr *MockControllableContextMockRecorder) GetHotspotTracker() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHotspotTracker", reflect.TypeOf((*MockControllableContext)(nil).GetHotspotTracker))
+}
+
 // GetLogger mocks base method.
 func (m *MockControllableContext) GetLogger() log.Logger {
 	m.ctrl.T.Helper()
@@ -41,9 +41,11 @@ import (
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/history/tests"
 	"go.uber.org/mock/gomock"
@@ -488,6 +490,76 @@ func (s *contextSuite) TestHandoverNamespace() {
 	s.False(ok)
 }
 
+func (s *contextSuite) TestHandoverNamespace_FineGrained() {
+	s.mockHistoryEngine.EXPECT().NotifyNewTasks(gomock.Any()).AnyTimes()
+	s.mockExecutionManager.EXPECT().AddHistoryTasks(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	namespaceEntry := namespace.NewGlobalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: tests.NamespaceID.String(), Name: tests.Namespace.String()},
+		&persistencespb.NamespaceConfig{
+			Retention: timestamp.DurationFromDays(1),
+		},
+		&persistencespb.NamespaceReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters: []string{
+				cluster.TestCurrentClusterName,
+				cluster.TestAlternativeClusterName,
+			},
+			State: enumspb.REPLICATION_STATE_HANDOVER,
+		},
+		tests.Version,
+	)
+	s.mockShard.UpdateHandoverNamespace(namespaceEntry, false)
+	_, handoverNS, err := s.mockShard.GetReplicationStatus([]string{})
+	s.NoError(err)
+	handoverTaskID := handoverNS[namespaceEntry.Name().String()].HandoverReplicationTaskId
+
+	addTasks := func(workflowID string) (*tasks.HistoryReplicationTask, error) {
+		replicationTask := &tasks.HistoryReplicationTask{}
+		return replicationTask, s.mockShard.AddTasks(context.Background(), &persistence.AddHistoryTasksRequest{
+			ShardID:     s.mockShard.GetShardID(),
+			NamespaceID: tests.NamespaceID.String(),
+			WorkflowID:  workflowID,
+			Tasks: map[tasks.Category][]tasks.Task{
+				tasks.CategoryReplication: {replicationTask},
+			},
+		})
+	}
+
+	// fine-grained handover disabled, all writes are rejected
+	_, err = addTasks("workflow-1")
+	s.ErrorIs(err, consts.ErrNamespaceHandover)
+
+	// tasks from before the handover not drained yet, writes are accepted and move the handover point forward
+	s.mockShard.config.EnableFineGrainedHandover = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true)
+	s.mockShard.UpdateRemoteClusterInfo(cluster.TestAlternativeClusterName, handoverTaskID-1, time.Now())
+	replicationTask, err := addTasks("workflow-1")
+	s.NoError(err)
+	s.Greater(replicationTask.GetTaskID(), handoverTaskID)
+
+	_, handoverNS, err = s.mockShard.GetReplicationStatus([]string{})
+	s.NoError(err)
+	s.Equal(replicationTask.GetTaskID(), handoverNS[namespaceEntry.Name().String()].HandoverReplicationTaskId)
+
+	// writes to a workflow which is not yet replicated are rejected, other workflows are still accepted
+	_, err = addTasks("workflow-1")
+	s.ErrorIs(err, consts.ErrNamespaceHandover)
+	lastReplicationTask, err := addTasks("workflow-2")
+	s.NoError(err)
+
+	// tasks from before the handover drained, the handover point is fixed and all writes are rejected
+	s.mockShard.UpdateRemoteClusterInfo(cluster.TestAlternativeClusterName, handoverTaskID, time.Now())
+	_, err = addTasks("workflow-3")
+	s.ErrorIs(err, consts.ErrNamespaceHandover)
+	s.mockShard.UpdateRemoteClusterInfo(cluster.TestAlternativeClusterName, lastReplicationTask.GetTaskID(), time.Now())
+	_, err = addTasks("workflow-1")
+	s.ErrorIs(err, consts.ErrNamespaceHandover)
+
+	_, handoverNS, err = s.mockShard.GetReplicationStatus([]string{})
+	s.NoError(err)
+	s.Equal(lastReplicationTask.GetTaskID(), handoverNS[namespaceEntry.Name().String()].HandoverReplicationTaskId)
+}
+
 func (s *contextSuite) TestUpdateGetRemoteClusterInfo_Legacy_8_4() {
 	clusterMetadata := cluster.NewMockMetadata(s.controller)
 	clusterMetadata.EXPECT().GetClusterID().Return(cluster.TestCurrentClusterInitialFailoverVersion).AnyTimes()
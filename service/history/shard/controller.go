@@ -25,6 +25,7 @@
 package shard
 
 import (
+	clusterspb "go.temporal.io/server/api/cluster/v1"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/pingable"
 )
@@ -39,6 +40,9 @@ type (
 		GetShardByNamespaceWorkflow(namespaceID namespace.ID, workflowID string) (Context, error)
 		CloseShardByID(shardID int32)
 		ShardIDs() []int32
+		// ShardOwnership returns the shards owned by this host and the recent changes of the shards it owns, oldest
+		// first.
+		ShardOwnership() ([]*clusterspb.ShardOwnership, []*clusterspb.ShardOwnershipChange)
 		Start()
 		Stop()
 	}
@@ -25,14 +25,19 @@
 package shard
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"math/rand"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"go.temporal.io/api/serviceerror"
+	clusterspb "go.temporal.io/server/api/cluster/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
@@ -44,8 +49,10 @@ import (
 	"go.temporal.io/server/common/pingable"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
 	"go.temporal.io/server/service/history/configs"
+	"go.temporal.io/server/service/history/tasks"
 	"golang.org/x/sync/semaphore"
 	"golang.org/x/time/rate"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -61,6 +68,9 @@ type (
 	ControllerImpl struct {
 		sync.RWMutex
 		historyShards map[int32]ControllableContext
+		// acquireTimes and ownershipChanges are guarded by the same lock as historyShards.
+		acquireTimes     map[int32]time.Time
+		ownershipChanges []*clusterspb.ShardOwnershipChange
 
 		lingerState struct {
 			sync.Mutex
@@ -69,6 +79,7 @@ type (
 
 		config               *configs.Config
 		contextFactory       ContextFactory
+		shardManager         persistence.ShardManager
 		contextTaggedLogger  log.Logger
 		hostInfoProvider     membership.HostInfoProvider
 		ownership            *ownership
@@ -83,6 +94,13 @@ type (
 		controller *ControllerImpl
 		ch         chan int
 	}
+	// shardToAcquire is a shard to be processed by acquireShards, along with the result of verifying its ownership if
+	// it was verified ahead of time.
+	shardToAcquire struct {
+		shardID           int32
+		ownershipVerified bool
+		ownershipErr      error
+	}
 )
 
 var _ Controller = (*ControllerImpl)(nil)
@@ -94,6 +112,7 @@ func ControllerProvider(
 	metricsHandler metrics.Handler,
 	hostInfoProvider membership.HostInfoProvider,
 	contextFactory ContextFactory,
+	shardManager persistence.ShardManager,
 ) *ControllerImpl {
 	hostIdentity := hostInfoProvider.HostInfo().Identity()
 	contextTaggedLogger := log.With(logger, tag.ComponentShardController, tag.Address(hostIdentity))
@@ -110,8 +129,10 @@ func ControllerProvider(
 	c := &ControllerImpl{
 		config:                  config,
 		contextFactory:          contextFactory,
+		shardManager:            shardManager,
 		contextTaggedLogger:     contextTaggedLogger,
 		historyShards:           make(map[int32]ControllableContext),
+		acquireTimes:            make(map[int32]time.Time),
 		hostInfoProvider:        hostInfoProvider,
 		ownership:               ownership,
 		taggedMetricsHandler:    taggedMetricsHandler,
@@ -223,6 +244,52 @@ func (c *ControllerImpl) ShardIDs() []int32 {
 	return ids
 }
 
+func (c *ControllerImpl) ShardOwnership() ([]*clusterspb.ShardOwnership, []*clusterspb.ShardOwnershipChange) {
+	address := c.hostInfoProvider.HostInfo().GetAddress()
+
+	c.RLock()
+	shards := make(map[int32]ControllableContext, len(c.historyShards))
+	ownership := make([]*clusterspb.ShardOwnership, 0, len(c.historyShards))
+	for id, shard := range c.historyShards {
+		shards[id] = shard
+		ownership = append(ownership, &clusterspb.ShardOwnership{
+			ShardId:     id,
+			HostAddress: address,
+			AcquireTime: timestamppb.New(c.acquireTimes[id]),
+		})
+	}
+	changes := slices.Clone(c.ownershipChanges)
+	c.RUnlock()
+
+	// The range ID is read without holding the controller lock, as reading it takes the lock of the shard.
+	for _, o := range ownership {
+		o.RangeId = shards[o.ShardId].GetRangeID()
+	}
+	slices.SortFunc(ownership, func(a, b *clusterspb.ShardOwnership) int {
+		return cmp.Compare(a.ShardId, b.ShardId)
+	})
+	return ownership, changes
+}
+
+func (c *ControllerImpl) recordOwnershipChangeLocked(shardID int32, changeType enumsspb.ShardOwnershipChangeType) {
+	now := time.Now().UTC()
+	if changeType == enumsspb.SHARD_OWNERSHIP_CHANGE_TYPE_ACQUIRED {
+		c.acquireTimes[shardID] = now
+	} else {
+		delete(c.acquireTimes, shardID)
+	}
+
+	c.ownershipChanges = append(c.ownershipChanges, &clusterspb.ShardOwnershipChange{
+		ShardId:     shardID,
+		HostAddress: c.hostInfoProvider.HostInfo().GetAddress(),
+		Type:        changeType,
+		Time:        timestamppb.New(now),
+	})
+	if excess := len(c.ownershipChanges) - c.config.ShardOwnershipChangeLogSize(); excess > 0 {
+		c.ownershipChanges = slices.Delete(c.ownershipChanges, 0, min(excess, len(c.ownershipChanges)))
+	}
+}
+
 func (c *ControllerImpl) shardRemoveAndStop(shard ControllableContext) {
 	startTime := time.Now().UTC()
 	defer func() {
@@ -281,6 +348,7 @@ func (c *ControllerImpl) getOrCreateShardContext(shardID int32) (ControllableCon
 		return nil, err
 	}
 	c.historyShards[shardID] = shard
+	c.recordOwnershipChangeLocked(shardID, enumsspb.SHARD_OWNERSHIP_CHANGE_TYPE_ACQUIRED)
 	metrics.ShardContextCreatedCounter.With(c.taggedMetricsHandler).Record(1)
 	c.contextTaggedLogger.Info("", numShardsTag(len(c.historyShards)))
 
@@ -305,6 +373,7 @@ func (c *ControllerImpl) removeShardLocked(shardID int32, expected ControllableC
 	}
 
 	delete(c.historyShards, shardID)
+	c.recordOwnershipChangeLocked(shardID, enumsspb.SHARD_OWNERSHIP_CHANGE_TYPE_RELEASED)
 	c.contextTaggedLogger.Info("", numShardsTag(len(c.historyShards)))
 	metrics.ShardContextRemovedCounter.With(c.taggedMetricsHandler).Record(1)
 
@@ -401,8 +470,12 @@ func (c *ControllerImpl) acquireShards(ctx context.Context) {
 
 	ctx = headers.SetCallerInfo(ctx, headers.SystemBackgroundCallerInfo)
 
-	tryAcquire := func(shardID int32) {
-		if err := c.ownership.verifyOwnership(shardID); err != nil {
+	tryAcquire := func(toAcquire shardToAcquire) {
+		shardID, err := toAcquire.shardID, toAcquire.ownershipErr
+		if !toAcquire.ownershipVerified {
+			err = c.ownership.verifyOwnership(shardID)
+		}
+		if err != nil {
 			if IsShardOwnershipLostError(err) {
 				// current host is not owner of shard, unload it if it is already loaded.
 				if c.config.ShardLingerTimeLimit() > 0 {
@@ -430,16 +503,13 @@ func (c *ControllerImpl) acquireShards(ctx context.Context) {
 
 	concurrency := int64(max(c.config.AcquireShardConcurrency(), 1))
 	sem := semaphore.NewWeighted(concurrency)
-	numShards := c.config.NumberOfShards
-	randomStartOffset := rand.Int31n(numShards)
-	for index := int32(0); index < numShards; index++ {
-		shardID := (index+randomStartOffset)%numShards + 1
+	for _, shard := range c.shardAcquireOrder(ctx) {
 		if err := sem.Acquire(ctx, 1); err != nil {
 			break
 		}
 		go func() {
 			defer sem.Release(1)
-			tryAcquire(shardID)
+			tryAcquire(shard)
 		}()
 	}
 	_ = sem.Acquire(ctx, concurrency)
@@ -451,6 +521,107 @@ func (c *ControllerImpl) acquireShards(ctx context.Context) {
 	c.publishShardCountUpdate(numOfOwnedShards)
 }
 
+// shardAcquireOrder returns all shards in the order acquireShards should process them. Shards which are owned by this
+// host but not loaded come first, since they are unavailable until acquired, while loaded shards only need their
+// ownership verified. Owned shards which are not loaded are ordered by their oldest pending task, so the shards which
+// are most behind are acquired first. The order is otherwise randomized so that hosts starting at the same time don't
+// contend on the same shards.
+func (c *ControllerImpl) shardAcquireOrder(ctx context.Context) []shardToAcquire {
+	numShards := c.config.NumberOfShards
+	randomStartOffset := rand.Int31n(numShards)
+	var notLoaded, loaded []shardToAcquire
+
+	c.RLock()
+	for index := int32(0); index < numShards; index++ {
+		shardID := (index+randomStartOffset)%numShards + 1
+		if _, ok := c.historyShards[shardID]; ok {
+			loaded = append(loaded, shardToAcquire{shardID: shardID})
+		} else {
+			notLoaded = append(notLoaded, shardToAcquire{shardID: shardID})
+		}
+	}
+	c.RUnlock()
+
+	var owned, notOwned []shardToAcquire
+	for _, shard := range notLoaded {
+		shard.ownershipVerified = true
+		shard.ownershipErr = c.ownership.verifyOwnership(shard.shardID)
+		if shard.ownershipErr == nil {
+			owned = append(owned, shard)
+		} else {
+			notOwned = append(notOwned, shard)
+		}
+	}
+	if len(owned) > 1 && c.config.AcquireShardPrioritizeOldestTasks() {
+		c.sortByOldestPendingTask(ctx, owned)
+	}
+	return slices.Concat(owned, notOwned, loaded)
+}
+
+// sortByOldestPendingTask sorts the shards by the fire time of the oldest pending timer task persisted in their shard
+// info. Immediate tasks have no fire time, but the timer queue falls behind just as much while a shard isn't loaded,
+// so it approximates how far behind the shard is. Shards whose shard info can't be read keep their relative order
+// ahead of the others.
+func (c *ControllerImpl) sortByOldestPendingTask(ctx context.Context, shards []shardToAcquire) {
+	oldestPendingTaskTimes := make([]time.Time, len(shards))
+	concurrency := int64(max(c.config.AcquireShardConcurrency(), 1))
+	sem := semaphore.NewWeighted(concurrency)
+	for i, shard := range shards {
+		if err := sem.Acquire(ctx, 1); err != nil {
+			return
+		}
+		go func() {
+			defer sem.Release(1)
+			readCtx, cancel := context.WithTimeout(ctx, c.config.ShardIOTimeout())
+			defer cancel()
+			resp, err := c.shardManager.GetOrCreateShard(readCtx, &persistence.GetOrCreateShardRequest{
+				ShardID:          shard.shardID,
+				LifecycleContext: readCtx,
+			})
+			if err != nil {
+				c.contextTaggedLogger.Warn("Unable to read shard info to prioritize shard acquisition",
+					tag.ShardID(shard.shardID), tag.Error(err))
+				return
+			}
+			oldestPendingTaskTimes[i] = oldestPendingTimerTaskTime(resp.ShardInfo)
+		}()
+	}
+	if err := sem.Acquire(ctx, concurrency); err != nil {
+		return
+	}
+
+	indexes := make([]int, len(shards))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	slices.SortStableFunc(indexes, func(a, b int) int {
+		return oldestPendingTaskTimes[a].Compare(oldestPendingTaskTimes[b])
+	})
+	sorted := make([]shardToAcquire, len(shards))
+	for i, index := range indexes {
+		sorted[i] = shards[index]
+	}
+	copy(shards, sorted)
+}
+
+// oldestPendingTimerTaskTime returns the fire time of the oldest timer task which isn't acked yet according to the
+// persisted queue state of the shard, or the zero time if the shard has no timer queue state.
+func oldestPendingTimerTaskTime(shardInfo *persistencespb.ShardInfo) time.Time {
+	queueState, ok := shardInfo.GetQueueStates()[int32(tasks.CategoryTimer.ID())]
+	if !ok || queueState.GetExclusiveReaderHighWatermark() == nil {
+		return time.Time{}
+	}
+	oldest := queueState.GetExclusiveReaderHighWatermark().GetFireTime().AsTime()
+	for _, readerState := range queueState.GetReaderStates() {
+		for _, scope := range readerState.GetScopes() {
+			if minKey := scope.GetRange().GetInclusiveMin(); minKey != nil && minKey.GetFireTime().AsTime().Before(oldest) {
+				oldest = minKey.GetFireTime().AsTime()
+			}
+		}
+	}
+	return oldest
+}
+
 // publishShardCountUpdate publishes the current number of shards that this controller owns to all shard count
 // subscribers in a non-blocking manner.
 func (c *ControllerImpl) publishShardCountUpdate(shardCount int) {
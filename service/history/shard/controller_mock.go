@@ -36,6 +36,7 @@ package shard
 import (
 	reflect "reflect"
 
+	cluster "go.temporal.io/server/api/cluster/v1"
 	namespace "go.temporal.io/server/common/namespace"
 	pingable "go.temporal.io/server/common/pingable"
 	gomock "go.uber.org/mock/gomock"
@@ -134,6 +135,21 @@ func (mr *MockControllerMockRecorder) ShardIDs() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShardIDs", reflect.TypeOf((*MockController)(nil).ShardIDs))
 }
 
+// ShardOwnership mocks base method.
+func (m *MockController) ShardOwnership() ([]*cluster.ShardOwnership, []*cluster.ShardOwnershipChange) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShardOwnership")
+	ret0, _ := ret[0].([]*cluster.ShardOwnership)
+	ret1, _ := ret[1].([]*cluster.ShardOwnershipChange)
+	return ret0, ret1
+}
+
+// ShardOwnership indicates an expected call of ShardOwnership.
+func (mr *MockControllerMockRecorder) ShardOwnership() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShardOwnership", reflect.TypeOf((*MockController)(nil).ShardOwnership))
+}
+
 // Start mocks base method.
 func (m *MockController) Start() {
 	m.ctrl.T.Helper()
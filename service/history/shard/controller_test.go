@@ -120,6 +120,7 @@ func NewTestController(
 		metricsTestHandler,
 		resource.GetHostInfoProvider(),
 		contextFactory,
+		resource.GetShardManager(),
 	)
 }
 
@@ -237,6 +238,67 @@ func (s *controllerSuite) TestAcquireShardsConcurrently() {
 	s.Equal(2, count)
 }
 
+func (s *controllerSuite) TestShardAcquireOrder() {
+	numShards := int32(5)
+	s.config.NumberOfShards = numShards
+
+	loadedShards := []int32{2, 4}
+	for _, shardID := range loadedShards {
+		s.setupMocksForAcquireShard(shardID, NewMockEngine(s.controller), 5, 6, false)
+		_, err := s.shardController.GetShardByID(shardID)
+		s.NoError(err)
+	}
+
+	// shard 5 is owned by another host, shard 3 has older pending tasks than shard 1
+	now := time.Now()
+	oldestPendingTaskTimes := map[int32]time.Time{
+		1: now.Add(-time.Minute),
+		3: now.Add(-time.Hour),
+	}
+	for shardID, oldestPendingTaskTime := range oldestPendingTaskTimes {
+		s.mockServiceResolver.EXPECT().Lookup(convert.Int32ToString(shardID)).Return(s.hostInfo, nil)
+		s.mockShardManager.EXPECT().GetOrCreateShard(gomock.Any(), getOrCreateShardRequestMatcher(shardID)).Return(
+			&persistence.GetOrCreateShardResponse{
+				ShardInfo: &persistencespb.ShardInfo{
+					ShardId: shardID,
+					QueueStates: map[int32]*persistencespb.QueueState{
+						int32(tasks.CategoryTimer.ID()): {
+							ReaderStates: map[int64]*persistencespb.QueueReaderState{
+								0: {Scopes: []*persistencespb.QueueSliceScope{{
+									Range: &persistencespb.QueueSliceRange{
+										InclusiveMin: &persistencespb.TaskKey{FireTime: timestamppb.New(oldestPendingTaskTime)},
+										ExclusiveMax: &persistencespb.TaskKey{FireTime: timestamppb.New(now)},
+									},
+								}}},
+							},
+							ExclusiveReaderHighWatermark: &persistencespb.TaskKey{FireTime: timestamppb.New(now)},
+						},
+					},
+				},
+			}, nil)
+	}
+	s.mockServiceResolver.EXPECT().Lookup(convert.Int32ToString(5)).Return(membership.NewHostInfoFromAddress("another-host"), nil)
+
+	order := s.shardController.shardAcquireOrder(context.Background())
+	s.Len(order, int(numShards))
+	s.Equal(int32(3), order[0].shardID)
+	s.Equal(int32(1), order[1].shardID)
+	s.Equal(int32(5), order[2].shardID)
+	s.True(IsShardOwnershipLostError(order[2].ownershipErr))
+	s.ElementsMatch(loadedShards, []int32{order[3].shardID, order[4].shardID})
+	s.False(order[3].ownershipVerified)
+	s.False(order[4].ownershipVerified)
+
+	// without prioritization, shards which are not loaded are not read
+	s.config.AcquireShardPrioritizeOldestTasks = dynamicconfig.GetBoolPropertyFn(false)
+	for shardID := range oldestPendingTaskTimes {
+		s.mockServiceResolver.EXPECT().Lookup(convert.Int32ToString(shardID)).Return(s.hostInfo, nil)
+	}
+	s.mockServiceResolver.EXPECT().Lookup(convert.Int32ToString(5)).Return(membership.NewHostInfoFromAddress("another-host"), nil)
+	order = s.shardController.shardAcquireOrder(context.Background())
+	s.ElementsMatch([]int32{1, 3}, []int32{order[0].shardID, order[1].shardID})
+}
+
 func (s *controllerSuite) TestAcquireShardLookupFailure() {
 	numShards := int32(2)
 	s.config.NumberOfShards = numShards
@@ -489,6 +551,38 @@ func (s *controllerSuite) TestShardExplicitUnload() {
 	s.False(shard.IsValid())
 }
 
+func (s *controllerSuite) TestShardOwnership() {
+	s.config.NumberOfShards = 1
+	s.config.ShardOwnershipChangeLogSize = dynamicconfig.GetIntPropertyFn(1)
+
+	mockEngine := NewMockEngine(s.controller)
+	mockEngine.EXPECT().Stop().AnyTimes()
+	s.setupMocksForAcquireShard(1, mockEngine, 5, 6, false)
+
+	shard, err := s.shardController.getOrCreateShardContext(1)
+	s.NoError(err)
+	ownership, changes := s.shardController.ShardOwnership()
+	s.Len(ownership, 1)
+	s.Equal(int32(1), ownership[0].GetShardId())
+	s.Equal(s.hostInfo.GetAddress(), ownership[0].GetHostAddress())
+	s.NotNil(ownership[0].GetAcquireTime())
+	s.Len(changes, 1)
+	s.Equal(enumsspb.SHARD_OWNERSHIP_CHANGE_TYPE_ACQUIRED, changes[0].GetType())
+
+	shard.UnloadForOwnershipLost()
+
+	for tries := 0; tries < 100 && len(s.shardController.ShardIDs()) != 0; tries++ {
+		// removal from map happens asynchronously
+		time.Sleep(1 * time.Millisecond)
+	}
+	ownership, changes = s.shardController.ShardOwnership()
+	s.Empty(ownership)
+	// Only the most recent change is kept.
+	s.Len(changes, 1)
+	s.Equal(int32(1), changes[0].GetShardId())
+	s.Equal(enumsspb.SHARD_OWNERSHIP_CHANGE_TYPE_RELEASED, changes[0].GetType())
+}
+
 func (s *controllerSuite) TestShardExplicitUnloadCancelGetOrCreate() {
 	s.config.NumberOfShards = 1
 
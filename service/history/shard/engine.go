@@ -65,6 +65,7 @@ type (
 		RecordActivityTaskHeartbeat(ctx context.Context, request *historyservice.RecordActivityTaskHeartbeatRequest) (*historyservice.RecordActivityTaskHeartbeatResponse, error)
 		RequestCancelWorkflowExecution(ctx context.Context, request *historyservice.RequestCancelWorkflowExecutionRequest) (*historyservice.RequestCancelWorkflowExecutionResponse, error)
 		SignalWorkflowExecution(ctx context.Context, request *historyservice.SignalWorkflowExecutionRequest) (*historyservice.SignalWorkflowExecutionResponse, error)
+		MultiSignalWorkflowExecution(ctx context.Context, request *historyservice.MultiSignalWorkflowExecutionRequest) (*historyservice.MultiSignalWorkflowExecutionResponse, error)
 		SignalWithStartWorkflowExecution(ctx context.Context, request *historyservice.SignalWithStartWorkflowExecutionRequest) (*historyservice.SignalWithStartWorkflowExecutionResponse, error)
 		ExecuteMultiOperation(ctx context.Context, request *historyservice.ExecuteMultiOperationRequest) (*historyservice.ExecuteMultiOperationResponse, error)
 		RemoveSignalMutableState(ctx context.Context, request *historyservice.RemoveSignalMutableStateRequest) (*historyservice.RemoveSignalMutableStateResponse, error)
@@ -146,6 +147,7 @@ type (
 			maxExclusiveTaskID int64,
 		) (collection.Iterator[tasks.Task], error)
 		GetMaxReplicationTaskInfo() (int64, time.Time)
+		GetPendingReplicationTasks() ([]tasks.Task, bool)
 	}
 )
 
@@ -256,6 +256,21 @@ func (mr *MockEngineMockRecorder) GetMutableState(ctx, request any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMutableState", reflect.TypeOf((*MockEngine)(nil).GetMutableState), ctx, request)
 }
 
+// GetPendingReplicationTasks mocks base method.
+func (m *MockEngine) GetPendingReplicationTasks() ([]tasks.Task, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingReplicationTasks")
+	ret0, _ := ret[0].([]tasks.Task)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetPendingReplicationTasks indicates an expected call of GetPendingReplicationTasks.
+func (mr *MockEngineMockRecorder) GetPendingReplicationTasks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingReplicationTasks", reflect.TypeOf((*MockEngine)(nil).GetPendingReplicationTasks))
+}
+
 // GetReplicationMessages mocks base method.
 func (m *MockEngine) GetReplicationMessages(ctx context.Context, pollingCluster string, ackMessageID int64, ackTimestamp time.Time, queryMessageID int64) (*repication.ReplicationMessages, error) {
 	m.ctrl.T.Helper()
@@ -436,6 +451,21 @@ func (mr *MockEngineMockRecorder) MergeDLQMessages(ctx, messagesRequest any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockEngine)(nil).MergeDLQMessages), ctx, messagesRequest)
 }
 
+// MultiSignalWorkflowExecution mocks base method.
+func (m *MockEngine) MultiSignalWorkflowExecution(ctx context.Context, request *historyservice.MultiSignalWorkflowExecutionRequest) (*historyservice.MultiSignalWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MultiSignalWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(*historyservice.MultiSignalWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MultiSignalWorkflowExecution indicates an expected call of MultiSignalWorkflowExecution.
+func (mr *MockEngineMockRecorder) MultiSignalWorkflowExecution(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MultiSignalWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).MultiSignalWorkflowExecution), ctx, request)
+}
+
 // NotifyNewHistoryEvent mocks base method.
 func (m *MockEngine) NotifyNewHistoryEvent(event *events.Notification) {
 	m.ctrl.T.Helper()
@@ -1194,6 +1224,21 @@ func (mr *MockReplicationStreamMockRecorder) GetMaxReplicationTaskInfo() *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaxReplicationTaskInfo", reflect.TypeOf((*MockReplicationStream)(nil).GetMaxReplicationTaskInfo))
 }
 
+// GetPendingReplicationTasks mocks base method.
+func (m *MockReplicationStream) GetPendingReplicationTasks() ([]tasks.Task, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingReplicationTasks")
+	ret0, _ := ret[0].([]tasks.Task)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetPendingReplicationTasks indicates an expected call of GetPendingReplicationTasks.
+func (mr *MockReplicationStreamMockRecorder) GetPendingReplicationTasks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingReplicationTasks", reflect.TypeOf((*MockReplicationStream)(nil).GetPendingReplicationTasks))
+}
+
 // GetReplicationTasksIter mocks base method.
 func (m *MockReplicationStream) GetReplicationTasksIter(ctx context.Context, pollingCluster string, minInclusiveTaskID, maxExclusiveTaskID int64) (collection.Iterator[tasks.Task], error) {
 	m.ctrl.T.Helper()
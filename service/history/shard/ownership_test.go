@@ -77,6 +77,7 @@ func (s *ownershipSuite) newController(contextFactory ContextFactory) *Controlle
 		s.resource.GetMetricsHandler(),
 		s.resource.GetHostInfoProvider(),
 		contextFactory,
+		s.resource.GetShardManager(),
 	)
 }
 
@@ -48,6 +48,8 @@ const (
 	CategoryIDArchival    = 5
 	CategoryIDMemoryTimer = 6
 	CategoryIDOutbound    = 7
+
+	CategoryIDWorkflowLifecycleEvent = 8
 )
 
 const (
@@ -64,6 +66,8 @@ const (
 	categoryNameArchival    = "archival"
 	categoryNameMemoryTimer = "memory-timer"
 	categoryNameOutbound    = "outbound"
+
+	categoryNameWorkflowLifecycleEvent = "workflow-lifecycle-event"
 )
 
 var (
@@ -108,6 +112,12 @@ var (
 		cType: CategoryTypeImmediate,
 		name:  categoryNameOutbound,
 	}
+
+	CategoryWorkflowLifecycleEvent = Category{
+		id:    CategoryIDWorkflowLifecycleEvent,
+		cType: CategoryTypeImmediate,
+		name:  categoryNameWorkflowLifecycleEvent,
+	}
 )
 
 func NewCategory(id int, cType CategoryType, name string) Category {
@@ -0,0 +1,50 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tasks
+
+import (
+	"time"
+
+	"go.temporal.io/server/common/telemetry"
+)
+
+const (
+	spanLinksMaxSize = 10000
+	spanLinksTTL     = time.Hour
+)
+
+type (
+	// SpanLinkKey identifies a task on a host. Task IDs are unique within a shard across all task categories.
+	SpanLinkKey struct {
+		ShardID int32
+		TaskID  int64
+	}
+
+	// SpanLinks links the span executing a task to the span of the request that generated it.
+	SpanLinks = telemetry.SpanLinks[SpanLinkKey]
+)
+
+// NewSpanLinks creates the host level SpanLinks shared by all shards.
+func NewSpanLinks() *SpanLinks {
+	return telemetry.NewSpanLinks[SpanLinkKey](spanLinksMaxSize, spanLinksTTL)
+}
@@ -58,6 +58,8 @@ func NewDefaultTaskCategoryRegistry() *MutableTaskCategoryRegistry {
 			CategoryReplication.ID(): CategoryReplication,
 			CategoryMemoryTimer.ID(): CategoryMemoryTimer,
 			CategoryOutbound.ID():    CategoryOutbound,
+
+			CategoryWorkflowLifecycleEvent.ID(): CategoryWorkflowLifecycleEvent,
 		},
 	}
 }
@@ -0,0 +1,73 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tasks
+
+import (
+	"time"
+
+	"go.temporal.io/api/workflowservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/definition"
+)
+
+var _ Task = (*WriteIntentTask)(nil)
+
+type (
+	// WriteIntentTask carries a signal or an update accepted by a standby cluster to the cluster which is active for
+	// the namespace. Exactly one of SignalRequest and UpdateRequest is set.
+	WriteIntentTask struct {
+		definition.WorkflowKey
+		VisibilityTimestamp time.Time
+		TaskID              int64
+		SignalRequest       *workflowservice.SignalWorkflowExecutionRequest
+		UpdateRequest       *workflowservice.UpdateWorkflowExecutionRequest
+	}
+)
+
+func (a *WriteIntentTask) GetKey() Key {
+	return NewImmediateKey(a.TaskID)
+}
+
+func (a *WriteIntentTask) GetTaskID() int64 {
+	return a.TaskID
+}
+
+func (a *WriteIntentTask) SetTaskID(id int64) {
+	a.TaskID = id
+}
+
+func (a *WriteIntentTask) GetVisibilityTime() time.Time {
+	return a.VisibilityTimestamp
+}
+
+func (a *WriteIntentTask) SetVisibilityTime(timestamp time.Time) {
+	a.VisibilityTimestamp = timestamp
+}
+
+func (a *WriteIntentTask) GetCategory() Category {
+	return CategoryReplication
+}
+
+func (a *WriteIntentTask) GetType() enumsspb.TaskType {
+	return enumsspb.TASK_TYPE_REPLICATION_WRITE_INTENT
+}
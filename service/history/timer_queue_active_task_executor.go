@@ -543,6 +543,13 @@ func (t *timerQueueActiveTaskExecutor) executeActivityRetryTimerTask(
 		return consts.ErrWorkflowCompleted
 	}
 
+	if mutableState.GetNamespaceEntry().IsActivityTypePaused(activityInfo.ActivityType.GetName()) {
+		if err := workflow.PostponePausedActivity(t.shardContext, mutableState, activityInfo); err != nil {
+			return err
+		}
+		return t.updateWorkflowExecution(ctx, weContext, mutableState, false)
+	}
+
 	taskQueue := &taskqueuepb.TaskQueue{
 		Name: activityInfo.TaskQueue,
 		Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
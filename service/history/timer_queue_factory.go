@@ -181,6 +181,7 @@ func (f *timerQueueFactory) CreateQueue(
 		f.Config.TaskDLQUnexpectedErrorAttempts,
 		f.Config.TaskDLQInternalErrors,
 		f.Config.TaskDLQErrorPattern,
+		f.executableTracingOption(shardContext.GetShardID()),
 	)
 	return queues.NewScheduledQueue(
 		shardContext,
@@ -109,6 +109,7 @@ func newTransferQueueActiveTaskExecutor(
 			shard.GetLogger(),
 			sdkClientFactory,
 			config.NumParentClosePolicySystemWorkflows(),
+			config.ParentClosePolicyBatchSize(),
 		),
 	}
 }
@@ -214,6 +215,13 @@ func (t *transferQueueActiveTaskExecutor) processActivityTask(
 		return consts.ErrWorkflowCompleted
 	}
 
+	if mutableState.GetNamespaceEntry().IsActivityTypePaused(ai.ActivityType.GetName()) {
+		if err := workflow.PostponePausedActivity(t.shardContext, mutableState, ai); err != nil {
+			return err
+		}
+		return weContext.UpdateWorkflowExecutionAsActive(ctx, t.shardContext)
+	}
+
 	timeout := timestamp.DurationValue(ai.ScheduleToStartTimeout)
 	directive := MakeDirectiveForActivityTask(mutableState, ai)
 
@@ -1639,6 +1647,11 @@ func (t *transferQueueActiveTaskExecutor) processParentClosePolicy(
 				WorkflowID:  childInfo.StartedWorkflowId,
 				RunID:       childInfo.StartedRunId,
 				Policy:      childInfo.ParentClosePolicy,
+				ShardID: common.WorkflowIDToHistoryShard(
+					childNamespaceID.String(),
+					childInfo.StartedWorkflowId,
+					t.shardContext.GetConfig().NumberOfShards,
+				),
 			})
 		}
 
@@ -175,6 +175,7 @@ func (f *transferQueueFactory) CreateQueue(
 		f.Config.TaskDLQUnexpectedErrorAttempts,
 		f.Config.TaskDLQInternalErrors,
 		f.Config.TaskDLQErrorPattern,
+		f.executableTracingOption(shardContext.GetShardID()),
 	)
 	return queues.NewImmediateQueue(
 		shardContext,
@@ -32,6 +32,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
@@ -58,9 +59,19 @@ type (
 		ensureCloseBeforeDelete       dynamicconfig.BoolPropertyFn
 		enableCloseWorkflowCleanup    dynamicconfig.BoolPropertyFnWithNamespaceFilter
 		relocateAttributesMinBlobSize dynamicconfig.IntPropertyFnWithNamespaceFilter
+		coalesceUpserts               dynamicconfig.BoolPropertyFnWithNamespaceFilter
+
+		// upsertWatermarks maps the key of an execution to the task ID watermark of its last upsert: the upsert
+		// wrote a state which includes the changes of all upsert tasks of the execution with lower task IDs.
+		upsertWatermarks cache.Cache
 	}
 )
 
+const (
+	upsertWatermarksCacheSize = 1024
+	upsertWatermarksCacheTTL  = time.Minute
+)
+
 var errUnknownVisibilityTask = serviceerror.NewInternal("unknown visibility task")
 
 func newVisibilityQueueTaskExecutor(
@@ -72,6 +83,7 @@ func newVisibilityQueueTaskExecutor(
 	ensureCloseBeforeDelete dynamicconfig.BoolPropertyFn,
 	enableCloseWorkflowCleanup dynamicconfig.BoolPropertyFnWithNamespaceFilter,
 	relocateAttributesMinBlobSize dynamicconfig.IntPropertyFnWithNamespaceFilter,
+	coalesceUpserts dynamicconfig.BoolPropertyFnWithNamespaceFilter,
 ) queues.Executor {
 	return &visibilityQueueTaskExecutor{
 		shardContext:   shardContext,
@@ -83,6 +95,9 @@ func newVisibilityQueueTaskExecutor(
 		ensureCloseBeforeDelete:       ensureCloseBeforeDelete,
 		enableCloseWorkflowCleanup:    enableCloseWorkflowCleanup,
 		relocateAttributesMinBlobSize: relocateAttributesMinBlobSize,
+		coalesceUpserts:               coalesceUpserts,
+
+		upsertWatermarks: cache.New(upsertWatermarksCacheSize, &cache.Options{TTL: upsertWatermarksCacheTTL}),
 	}
 }
 
@@ -204,6 +219,16 @@ func (t *visibilityQueueTaskExecutor) processUpsertExecution(
 		return err
 	}
 
+	coalesce := t.coalesceUpserts(namespaceEntry.Name().String())
+	workflowKey := definition.NewWorkflowKey(task.GetNamespaceID(), task.GetWorkflowID(), task.GetRunID())
+	if coalesce {
+		if watermark, ok := t.upsertWatermarks.Get(workflowKey).(int64); ok && task.GetTaskID() < watermark {
+			// the state of the execution written by a later task already includes the changes of this task
+			metrics.VisibilityUpsertCoalesced.With(t.metricProvider).Record(1, metrics.NamespaceTag(namespaceEntry.Name().String()))
+			return nil
+		}
+	}
+
 	weContext, release, err := getWorkflowExecutionContextForTask(ctx, t.shardContext, t.cache, task)
 	if err != nil {
 		return err
@@ -218,6 +243,15 @@ func (t *visibilityQueueTaskExecutor) processUpsertExecution(
 		return nil
 	}
 
+	var watermark int64
+	if coalesce {
+		// All tasks of the execution generated so far were committed along with the changes of the mutable state,
+		// because the execution is locked. Tasks generated later get larger IDs than the watermark.
+		if watermark, err = t.shardContext.GenerateTaskID(); err != nil {
+			return err
+		}
+	}
+
 	requestBase := t.getVisibilityRequestBase(task, namespaceEntry, mutableState)
 
 	// NOTE: do not access anything related mutable state after this lock release
@@ -225,12 +259,18 @@ func (t *visibilityQueueTaskExecutor) processUpsertExecution(
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
 
-	return t.visibilityMgr.UpsertWorkflowExecution(
+	if err := t.visibilityMgr.UpsertWorkflowExecution(
 		ctx,
 		&manager.UpsertWorkflowExecutionRequest{
 			VisibilityRequestBase: requestBase,
 		},
-	)
+	); err != nil {
+		return err
+	}
+	if coalesce {
+		t.upsertWatermarks.Put(workflowKey, watermark)
+	}
+	return nil
 }
 
 func (t *visibilityQueueTaskExecutor) processCloseExecution(
@@ -88,6 +88,7 @@ type (
 		visibilityQueueTaskExecutor queues.Executor
 
 		enableCloseWorkflowCleanup bool
+		coalesceUpserts            bool
 	}
 )
 
@@ -168,6 +169,7 @@ func (s *visibilityQueueTaskExecutorSuite) SetupTest() {
 	s.mockShard.SetEngineForTesting(h)
 
 	s.enableCloseWorkflowCleanup = false
+	s.coalesceUpserts = false
 	s.visibilityQueueTaskExecutor = newVisibilityQueueTaskExecutor(
 		s.mockShard,
 		s.workflowCache,
@@ -177,6 +179,7 @@ func (s *visibilityQueueTaskExecutorSuite) SetupTest() {
 		config.VisibilityProcessorEnsureCloseBeforeDelete,
 		func(_ string) bool { return s.enableCloseWorkflowCleanup },
 		config.VisibilityProcessorRelocateAttributesMinBlobSize,
+		func(_ string) bool { return s.coalesceUpserts },
 	)
 }
 
@@ -458,6 +461,74 @@ func (s *visibilityQueueTaskExecutorSuite) TestProcessUpsertWorkflowSearchAttrib
 	s.Nil(resp.ExecutionErr)
 }
 
+func (s *visibilityQueueTaskExecutorSuite) TestProcessUpsertWorkflowSearchAttributes_Coalesced() {
+	s.coalesceUpserts = true
+
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      uuid.New(),
+	}
+	workflowType := "some random workflow type"
+	taskQueueName := "some random task queue"
+
+	mutableState := workflow.TestGlobalMutableState(s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetWorkflowId(), execution.GetRunId())
+
+	_, err := mutableState.AddWorkflowExecutionStartedEvent(
+		execution,
+		&historyservice.StartWorkflowExecutionRequest{
+			Attempt:     1,
+			NamespaceId: s.namespaceID.String(),
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+				WorkflowType:             &commonpb.WorkflowType{Name: workflowType},
+				TaskQueue:                &taskqueuepb.TaskQueue{Name: taskQueueName},
+				WorkflowExecutionTimeout: durationpb.New(2 * time.Second),
+				WorkflowTaskTimeout:      durationpb.New(1 * time.Second),
+			},
+		},
+	)
+	s.NoError(err)
+
+	wt := addWorkflowTaskScheduledEvent(mutableState)
+	workflowKey := definition.NewWorkflowKey(
+		s.namespaceID.String(),
+		execution.GetWorkflowId(),
+		execution.GetRunId(),
+	)
+	newVisibilityTask := func(taskID int64) *tasks.UpsertExecutionVisibilityTask {
+		return &tasks.UpsertExecutionVisibilityTask{
+			WorkflowKey: workflowKey,
+			TaskID:      taskID,
+		}
+	}
+
+	persistenceMutableState := s.createPersistenceMutableState(mutableState, wt.ScheduledEventID, wt.Version)
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+
+	// the first upsert writes the latest state of the execution
+	laterTask := newVisibilityTask(int64(59))
+	s.mockVisibilityMgr.EXPECT().UpsertWorkflowExecution(
+		gomock.Any(),
+		s.createUpsertWorkflowRequest(s.namespace, laterTask, mutableState, taskQueueName),
+	).Return(nil)
+	resp := s.visibilityQueueTaskExecutor.Execute(context.Background(), s.newTaskExecutable(laterTask))
+	s.Nil(resp.ExecutionErr)
+
+	// an earlier task of the same execution is already covered by the first upsert
+	resp = s.visibilityQueueTaskExecutor.Execute(context.Background(), s.newTaskExecutable(newVisibilityTask(int64(58))))
+	s.Nil(resp.ExecutionErr)
+
+	// a task generated after the first upsert is written
+	taskID, err := s.mockShard.GenerateTaskID()
+	s.NoError(err)
+	newerTask := newVisibilityTask(taskID)
+	s.mockVisibilityMgr.EXPECT().UpsertWorkflowExecution(
+		gomock.Any(),
+		s.createUpsertWorkflowRequest(s.namespace, newerTask, mutableState, taskQueueName),
+	).Return(nil)
+	resp = s.visibilityQueueTaskExecutor.Execute(context.Background(), s.newTaskExecutable(newerTask))
+	s.Nil(resp.ExecutionErr)
+}
+
 func (s *visibilityQueueTaskExecutorSuite) TestProcessModifyWorkflowProperties() {
 	execution := &commonpb.WorkflowExecution{
 		WorkflowId: "some random workflow ID",
@@ -318,6 +318,36 @@ func UnpauseActivityWithReset(
 	return &historyservice.UnpauseActivityResponse{}, nil
 }
 
+// PostponePausedActivity postpones the dispatch of a scheduled activity whose type is paused in the namespace by
+// history.pausedActivityTypeRecheckInterval, after which a retry timer checks the pause again. The schedule timeouts
+// of the activity are extended by the postponed time, since the activity couldn't be started meanwhile.
+func PostponePausedActivity(
+	shardContext shard.Context,
+	mutableState MutableState,
+	ai *persistencespb.ActivityInfo,
+) error {
+	recheckInterval := shardContext.GetConfig().PausedActivityTypeRecheckInterval(mutableState.GetNamespaceEntry().Name().String())
+	nextCheckTime := shardContext.GetTimeSource().Now().UTC().Add(recheckInterval)
+	return mutableState.UpdateActivity(ai.ScheduledEventId, func(activityInfo *persistencespb.ActivityInfo, ms MutableState) error {
+		if delay := nextCheckTime.Sub(activityInfo.ScheduledTime.AsTime()); delay > 0 {
+			if activityInfo.FirstScheduledTime != nil {
+				activityInfo.FirstScheduledTime = timestamppb.New(activityInfo.FirstScheduledTime.AsTime().Add(delay))
+			}
+			if activityInfo.RetryExpirationTime != nil {
+				activityInfo.RetryExpirationTime = timestamppb.New(activityInfo.RetryExpirationTime.AsTime().Add(delay))
+			}
+		}
+		// the stamp makes the pending retry timer and activity task of the activity obsolete
+		activityInfo.Stamp++
+		lastFailure := activityInfo.RetryLastFailure
+		if err := ms.RegenerateActivityRetryTask(activityInfo, nextCheckTime); err != nil {
+			return err
+		}
+		activityInfo.RetryLastFailure = lastFailure
+		return nil
+	})
+}
+
 func needRegenerateRetryTask(ai *persistencespb.ActivityInfo, scheduleNewRun bool) bool {
 	// if activity is Paused - we don't need to do anything
 	if ai.Paused {
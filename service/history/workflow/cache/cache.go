@@ -123,6 +123,7 @@ func NewHostLevelCache(
 		maxSize,
 		config.HistoryCacheTTL(),
 		config.HistoryCacheNonUserContextLockTimeout(),
+		config.HistoryCacheFairEviction,
 		logger,
 		handler,
 	)
@@ -141,6 +142,7 @@ func NewShardLevelCache(
 		maxSize,
 		config.HistoryCacheTTL(),
 		config.HistoryCacheNonUserContextLockTimeout(),
+		false,
 		logger,
 		handler,
 	)
@@ -150,6 +152,7 @@ func newCache(
 	size int,
 	ttl time.Duration,
 	nonUserContextLockTimeout time.Duration,
+	namespaceFairEviction bool,
 	logger log.Logger,
 	handler metrics.Handler,
 ) Cache {
@@ -192,6 +195,12 @@ func newCache(
 			}
 		},
 	}
+	if namespaceFairEviction {
+		opts.Group = func(key any) metrics.Tag {
+			//revive:disable-next-line:unchecked-type-assertion
+			return metrics.NamespaceIDTag(key.(Key).WorkflowKey.NamespaceID)
+		}
+	}
 
 	withMetrics := cache.NewWithMetrics(size, opts, handler.WithTags(metrics.CacheTypeTag(metrics.MutableStateCacheTypeTagValue)))
 
@@ -319,11 +328,13 @@ func (c *cacheImpl) getOrCreateWorkflowExecutionInternal(
 		workflowCtx = existing.(*cacheItem).wfContext
 	}
 
+	lockStart := time.Now()
 	if err := c.lockWorkflowExecution(ctx, workflowCtx, cacheKey, lockPriority); err != nil {
 		metrics.CacheFailures.With(handler).Record(1)
 		metrics.AcquireLockFailedCounter.With(handler).Record(1)
 		return nil, nil, err
 	}
+	c.recordHotspotUsage(ctx, shardContext, cacheKey.WorkflowKey, time.Since(lockStart))
 
 	// TODO This will create a closure on every request.
 	//  Consider revisiting this if it causes too much GC activity
@@ -332,6 +343,23 @@ func (c *cacheImpl) getOrCreateWorkflowExecutionInternal(
 	return workflowCtx, releaseFunc, nil
 }
 
+// recordHotspotUsage attributes the lock wait time, and the task when called for task processing, to the
+// workflow for hotspot detection.
+func (c *cacheImpl) recordHotspotUsage(
+	ctx context.Context,
+	shardContext shard.Context,
+	workflowKey definition.WorkflowKey,
+	lockWait time.Duration,
+) {
+	tracker := shardContext.GetHotspotTracker()
+	tracker.RecordLockWait(shardContext.GetShardID(), workflowKey.NamespaceID, workflowKey.WorkflowID, lockWait)
+	switch headers.GetCallerInfo(ctx).CallerType {
+	case headers.CallerTypeBackground, headers.CallerTypePreemptable:
+		// task processing runs with background or preemptable caller types
+		tracker.RecordTask(shardContext.GetShardID(), workflowKey.NamespaceID, workflowKey.WorkflowID)
+	}
+}
+
 func (c *cacheImpl) lockWorkflowExecution(
 	ctx context.Context,
 	workflowCtx workflow.Context,
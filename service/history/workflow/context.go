@@ -59,6 +59,14 @@ type (
 		GetWorkflowKey() definition.WorkflowKey
 
 		LoadMutableState(ctx context.Context, shardContext shard.Context) (MutableState, error)
+		// LoadMutableStateWithoutCollections is LoadMutableState for callers which don't need some collections of the
+		// mutable state. If the mutable state is not cached, it is loaded without them. A cached mutable state is
+		// reloaded if it lacks other collections. Buffered events are always loaded.
+		LoadMutableStateWithoutCollections(
+			ctx context.Context,
+			shardContext shard.Context,
+			omitCollections persistence.MutableStateCollections,
+		) (MutableState, error)
 		LoadExecutionStats(ctx context.Context, shardContext shard.Context) (*persistencespb.ExecutionStats, error)
 		Clear()
 
@@ -250,6 +258,14 @@ func (c *ContextImpl) LoadExecutionStats(ctx context.Context, shardContext shard
 }
 
 func (c *ContextImpl) LoadMutableState(ctx context.Context, shardContext shard.Context) (MutableState, error) {
+	return c.LoadMutableStateWithoutCollections(ctx, shardContext, 0)
+}
+
+func (c *ContextImpl) LoadMutableStateWithoutCollections(
+	ctx context.Context,
+	shardContext shard.Context,
+	omitCollections persistence.MutableStateCollections,
+) (MutableState, error) {
 	namespaceEntry, err := shardContext.GetNamespaceRegistry().GetNamespaceByID(
 		namespace.ID(c.workflowKey.NamespaceID),
 	)
@@ -257,24 +273,40 @@ func (c *ContextImpl) LoadMutableState(ctx context.Context, shardContext shard.C
 		return nil, err
 	}
 
+	// buffered events are flushed by any transaction
+	omitCollections &^= persistence.MutableStateBufferedEvents
+	if c.MutableState != nil {
+		if omitted := c.MutableState.GetOmittedCollections(); omitted != 0 &&
+			(!omitCollections.Contains(omitted) || c.disablingTransitionHistory(c.MutableState.GetExecutionInfo())) {
+			// the cached mutable state lacks collections needed by the caller
+			c.Clear()
+		}
+	}
+
 	if c.MutableState == nil {
 		response, err := getWorkflowExecution(ctx, shardContext, &persistence.GetWorkflowExecutionRequest{
-			ShardID:     shardContext.GetShardID(),
-			NamespaceID: c.workflowKey.NamespaceID,
-			WorkflowID:  c.workflowKey.WorkflowID,
-			RunID:       c.workflowKey.RunID,
+			ShardID:         shardContext.GetShardID(),
+			NamespaceID:     c.workflowKey.NamespaceID,
+			WorkflowID:      c.workflowKey.WorkflowID,
+			RunID:           c.workflowKey.RunID,
+			OmitCollections: omitCollections,
 		})
 		if err != nil {
 			return nil, err
 		}
+		if response.OmittedCollections != 0 && c.disablingTransitionHistory(response.State.ExecutionInfo) {
+			// disabling transition history replicates all pending activities
+			return c.LoadMutableState(ctx, shardContext)
+		}
 
-		c.MutableState, err = NewMutableStateFromDB(
+		c.MutableState, err = newMutableStateFromDB(
 			shardContext,
 			shardContext.GetEventsCache(),
 			c.logger,
 			namespaceEntry,
 			response.State,
 			response.DBRecordVersion,
+			response.OmittedCollections,
 		)
 		if err != nil {
 			return nil, err
@@ -307,6 +339,12 @@ func (c *ContextImpl) LoadMutableState(ctx context.Context, shardContext shard.C
 	return c.MutableState, nil
 }
 
+// disablingTransitionHistory returns true if the next update of the execution disables transition history, which
+// requires all collections of the mutable state.
+func (c *ContextImpl) disablingTransitionHistory(executionInfo *persistencespb.WorkflowExecutionInfo) bool {
+	return len(executionInfo.GetTransitionHistory()) != 0 && !c.config.EnableTransitionHistory()
+}
+
 func (c *ContextImpl) PersistWorkflowEvents(
 	ctx context.Context,
 	shardContext shard.Context,
@@ -975,6 +1013,11 @@ func (c *ContextImpl) UpdateRegistry(ctx context.Context) update.Registry {
 					return c.config.WorkflowExecutionMaxInFlightUpdates(nsIDStr)
 				},
 			),
+			update.WithBufferedLimit(
+				func() int {
+					return c.config.WorkflowExecutionMaxBufferedUpdates(nsIDStr)
+				},
+			),
 			update.WithTotalLimit(
 				func() int {
 					return c.config.WorkflowExecutionMaxTotalUpdates(nsIDStr)
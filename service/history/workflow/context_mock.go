@@ -167,6 +167,21 @@ func (mr *MockContextMockRecorder) LoadMutableState(ctx, shardContext any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadMutableState", reflect.TypeOf((*MockContext)(nil).LoadMutableState), ctx, shardContext)
 }
 
+// LoadMutableStateWithoutCollections mocks base method.
+func (m *MockContext) LoadMutableStateWithoutCollections(ctx context.Context, shardContext shard.Context, omitCollections persistence0.MutableStateCollections) (MutableState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadMutableStateWithoutCollections", ctx, shardContext, omitCollections)
+	ret0, _ := ret[0].(MutableState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoadMutableStateWithoutCollections indicates an expected call of LoadMutableStateWithoutCollections.
+func (mr *MockContextMockRecorder) LoadMutableStateWithoutCollections(ctx, shardContext, omitCollections any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadMutableStateWithoutCollections", reflect.TypeOf((*MockContext)(nil).LoadMutableStateWithoutCollections), ctx, shardContext, omitCollections)
+}
+
 // Lock mocks base method.
 func (m *MockContext) Lock(ctx context.Context, lockPriority locks.Priority) error {
 	m.ctrl.T.Helper()
@@ -602,3 +602,87 @@ func (s *contextSuite) TestRefreshTask() {
 		})
 	}
 }
+
+func (s *contextSuite) TestLoadMutableStateWithoutCollections() {
+	now := time.Now()
+	persistedMutableState := &persistencespb.WorkflowMutableState{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			NamespaceId:   tests.NamespaceID.String(),
+			WorkflowId:    tests.WorkflowID,
+			ExecutionTime: timestamppb.New(now),
+			VersionHistories: &historyspb.VersionHistories{
+				Histories: []*historyspb.VersionHistory{
+					{
+						BranchToken: []byte("token#1"),
+						Items: []*historyspb.VersionHistoryItem{
+							{EventId: 1, Version: common.EmptyVersion},
+						},
+					},
+				},
+			},
+			ExecutionStats: &persistencespb.ExecutionStats{},
+		},
+		ExecutionState: &persistencespb.WorkflowExecutionState{
+			RunId:     tests.RunID,
+			State:     enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+			Status:    enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+			StartTime: timestamppb.New(now),
+		},
+		TimerInfos: map[string]*persistencespb.TimerInfo{
+			"timer-id": {TimerId: "timer-id", StartedEventId: 5},
+		},
+		NextEventId: 2,
+	}
+	expectGetWorkflowExecution := func(omitCollections persistence.MutableStateCollections) {
+		state := common.CloneProto(persistedMutableState)
+		if omitCollections.Contains(persistence.MutableStateTimerInfos) {
+			state.TimerInfos = nil
+		}
+		s.mockShard.Resource.ExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), &persistence.GetWorkflowExecutionRequest{
+			ShardID:         s.mockShard.GetShardID(),
+			NamespaceID:     tests.NamespaceID.String(),
+			WorkflowID:      tests.WorkflowID,
+			RunID:           tests.RunID,
+			OmitCollections: omitCollections,
+		}).Return(&persistence.GetWorkflowExecutionResponse{
+			State:              state,
+			DBRecordVersion:    1,
+			OmittedCollections: omitCollections,
+		}, nil).Times(1)
+	}
+
+	// buffered events are always loaded
+	expectGetWorkflowExecution(persistence.MutableStateTimerInfos)
+	mutableState, err := s.workflowContext.LoadMutableStateWithoutCollections(
+		context.Background(),
+		s.mockShard,
+		persistence.MutableStateTimerInfos|persistence.MutableStateBufferedEvents,
+	)
+	s.NoError(err)
+	s.Equal(persistence.MutableStateTimerInfos, mutableState.GetOmittedCollections())
+	s.Empty(mutableState.GetPendingTimerInfos())
+
+	// the cached mutable state has the collections needed
+	cachedMutableState, err := s.workflowContext.LoadMutableStateWithoutCollections(
+		context.Background(),
+		s.mockShard,
+		persistence.MutableStateTimerInfos|persistence.MutableStateActivityInfos,
+	)
+	s.NoError(err)
+	s.Same(mutableState, cachedMutableState)
+
+	// the cached mutable state lacks timers
+	expectGetWorkflowExecution(0)
+	mutableState, err = s.workflowContext.LoadMutableState(context.Background(), s.mockShard)
+	s.NoError(err)
+	s.Zero(mutableState.GetOmittedCollections())
+	s.Len(mutableState.GetPendingTimerInfos(), 1)
+
+	cachedMutableState, err = s.workflowContext.LoadMutableStateWithoutCollections(
+		context.Background(),
+		s.mockShard,
+		persistence.MutableStateAllCollections,
+	)
+	s.NoError(err)
+	s.Same(mutableState, cachedMutableState)
+}
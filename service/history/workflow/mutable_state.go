@@ -249,6 +249,7 @@ type (
 		GetActivityByActivityID(string) (*persistencespb.ActivityInfo, bool)
 		GetActivityInfo(int64) (*persistencespb.ActivityInfo, bool)
 		GetActivityInfoWithTimerHeartbeat(scheduledEventID int64) (*persistencespb.ActivityInfo, time.Time, bool)
+		GetActivityLastPersistedHeartbeatTime(ai *persistencespb.ActivityInfo) time.Time
 		GetActivityType(context.Context, *persistencespb.ActivityInfo) (*commonpb.ActivityType, error)
 		GetActivityScheduledEvent(context.Context, int64) (*historypb.HistoryEvent, error)
 		GetRequesteCancelExternalInitiatedEvent(context.Context, int64) (*historypb.HistoryEvent, error)
@@ -284,6 +285,8 @@ type (
 		GetRequestCancelInfo(int64) (*persistencespb.RequestCancelInfo, bool)
 		GetRetryBackoffDuration(failure *failurepb.Failure) (time.Duration, enumspb.RetryState)
 		GetCronBackoffDuration() time.Duration
+		GetNextCronSchedule() (time.Time, time.Duration)
+		IsChainLengthLimitReached() bool
 		GetSignalInfo(int64) (*persistencespb.SignalInfo, bool)
 		GetUserTimerInfoByEventID(int64) (*persistencespb.TimerInfo, bool)
 		GetUserTimerInfo(string) (*persistencespb.TimerInfo, bool)
@@ -307,6 +310,9 @@ type (
 		IsCurrentWorkflowGuaranteed() bool
 		IsSignalRequested(requestID string) bool
 		GetApproximatePersistedSize() int
+		// GetOmittedCollections returns the collections which were not loaded with the mutable state. They are empty
+		// and not accounted for in its approximate size.
+		GetOmittedCollections() persistence.MutableStateCollections
 
 		CurrentTaskQueue() *taskqueuepb.TaskQueue
 		SetStickyTaskQueue(name string, scheduleToStartTimeout *durationpb.Duration)
@@ -376,6 +382,7 @@ type (
 		UpdateActivity(int64, ActivityUpdater) error
 		UpdateActivityTaskStatusWithTimerHeartbeat(scheduleEventId int64, timerTaskStatus int32, heartbeatTimeoutVisibility *time.Time) error
 		UpdateActivityProgress(ai *persistencespb.ActivityInfo, request *workflowservice.RecordActivityTaskHeartbeatRequest)
+		UpdateActivityHeartbeatTimeInMemory(ai *persistencespb.ActivityInfo)
 		UpdateUserTimer(*persistencespb.TimerInfo) error
 		UpdateUserTimerTaskStatus(timerId string, status int64) error
 		UpdateCurrentVersion(version int64, forceUpdate bool) error
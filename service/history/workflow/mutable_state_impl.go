@@ -73,12 +73,14 @@ import (
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/searchattribute"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
 	"go.temporal.io/server/common/util"
 	"go.temporal.io/server/common/worker_versioning"
 	"go.temporal.io/server/components/callbacks"
+	"go.temporal.io/server/components/nexusoperations"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/events"
@@ -137,6 +139,7 @@ var (
 type (
 	MutableStateImpl struct {
 		pendingActivityTimerHeartbeats map[int64]time.Time                    // Scheduled Event ID -> LastHeartbeatTimeoutVisibilityInSeconds.
+		inMemoryActivityHeartbeats     map[int64]time.Time                    // Scheduled Event ID -> LastHeartbeatUpdateTime persisted before the heartbeats kept in memory.
 		pendingActivityInfoIDs         map[int64]*persistencespb.ActivityInfo // Scheduled Event ID -> Activity Info.
 		pendingActivityIDToEventID     map[string]int64                       // Activity ID -> Scheduled Event ID of the activity.
 		updateActivityInfos            map[int64]*persistencespb.ActivityInfo // Modified activities from last update.
@@ -189,6 +192,8 @@ type (
 		versionedTransitionInDB *persistencespb.VersionedTransition
 		// Indicates the DB record version, for conditional update.
 		dbRecordVersion int64
+		// Collections which were not loaded from DB, see LoadMutableStateWithoutCollections.
+		omittedCollections persistence.MutableStateCollections
 		// Namespace entry contains a snapshot of namespace.
 		// NOTE: do not use the failover version inside, use currentVersion above.
 		namespaceEntry *namespace.Namespace
@@ -266,6 +271,7 @@ func NewMutableState(
 	s := &MutableStateImpl{
 		updateActivityInfos:            make(map[int64]*persistencespb.ActivityInfo),
 		pendingActivityTimerHeartbeats: make(map[int64]time.Time),
+		inMemoryActivityHeartbeats:     make(map[int64]time.Time),
 		pendingActivityInfoIDs:         make(map[int64]*persistencespb.ActivityInfo),
 		pendingActivityIDToEventID:     make(map[string]int64),
 		deleteActivityInfos:            make(map[int64]struct{}),
@@ -376,6 +382,20 @@ func NewMutableStateFromDB(
 	namespaceEntry *namespace.Namespace,
 	dbRecord *persistencespb.WorkflowMutableState,
 	dbRecordVersion int64,
+) (*MutableStateImpl, error) {
+	return newMutableStateFromDB(shard, eventsCache, logger, namespaceEntry, dbRecord, dbRecordVersion, 0)
+}
+
+// newMutableStateFromDB builds a mutable state from a DB record read without the given collections. Such a partial
+// mutable state can be updated as long as the omitted collections are left untouched.
+func newMutableStateFromDB(
+	shard shard.Context,
+	eventsCache events.Cache,
+	logger log.Logger,
+	namespaceEntry *namespace.Namespace,
+	dbRecord *persistencespb.WorkflowMutableState,
+	dbRecordVersion int64,
+	omittedCollections persistence.MutableStateCollections,
 ) (*MutableStateImpl, error) {
 	// startTime will be overridden by DB record
 	startTime := time.Time{}
@@ -470,10 +490,12 @@ func NewMutableStateFromDB(
 	mutableState.stateInDB = dbRecord.ExecutionState.State
 	mutableState.nextEventIDInDB = dbRecord.NextEventId
 	mutableState.dbRecordVersion = dbRecordVersion
+	mutableState.omittedCollections = omittedCollections
 	mutableState.checksum = dbRecord.Checksum
 	mutableState.initVersionedTransitionInDB()
 
-	if len(dbRecord.Checksum.GetValue()) > 0 {
+	// the checksum covers all collections, so it can't be verified against a partial mutable state
+	if len(dbRecord.Checksum.GetValue()) > 0 && omittedCollections == 0 {
 		switch {
 		case mutableState.shouldInvalidateCheckum():
 			mutableState.checksum = nil
@@ -546,6 +568,7 @@ func NewMutableStateInChain(
 
 	// carry over necessary fields from current mutable state
 	newMutableState.executionInfo.WorkflowExecutionTimerTaskStatus = currentMutableState.GetExecutionInfo().WorkflowExecutionTimerTaskStatus
+	newMutableState.executionInfo.ContinuedRunCount = currentMutableState.GetExecutionInfo().ContinuedRunCount + 1
 
 	// Copy completion callbacks to new run.
 	oldCallbacks := callbacks.MachineCollection(currentMutableState.HSM())
@@ -1120,11 +1143,14 @@ func (ms *MutableStateImpl) GetUpdateOutcome(
 	ctx context.Context,
 	updateID string,
 ) (*updatepb.Outcome, error) {
-	if ms.executionInfo.UpdateInfos == nil {
-		return nil, serviceerror.NewNotFound("update not found")
-	}
 	ui, ok := ms.executionInfo.UpdateInfos[updateID]
 	if !ok {
+		// The update might have been completed by a previous run in the continue-as-new chain.
+		for _, prev := range ms.executionInfo.PreviousRunUpdateOutcomes {
+			if prev.GetUpdateId() == updateID {
+				return prev.GetOutcome(), nil
+			}
+		}
 		return nil, serviceerror.NewNotFound("update not found")
 	}
 	completion := ui.GetCompletion()
@@ -1356,11 +1382,29 @@ func (ms *MutableStateImpl) GetRetryBackoffDuration(
 }
 
 func (ms *MutableStateImpl) GetCronBackoffDuration() time.Duration {
-	if ms.executionInfo.CronSchedule == "" {
-		return backoff.NoBackoff
+	_, backoffInterval := ms.GetNextCronSchedule()
+	return backoffInterval
+}
+
+// GetNextCronSchedule returns the scheduled time and the backoff time of the next cron run according to the cron
+// overlap policy of the namespace.
+func (ms *MutableStateImpl) GetNextCronSchedule() (time.Time, time.Duration) {
+	if ms.executionInfo.CronSchedule == "" || ms.IsChainLengthLimitReached() {
+		return time.Time{}, backoff.NoBackoff
+	}
+	scheduledTime := timestamp.TimeValue(ms.GetExecutionInfo().GetExecutionTime())
+	if ms.executionInfo.CronScheduledTime != nil {
+		scheduledTime = ms.executionInfo.CronScheduledTime.AsTime()
 	}
-	executionTime := timestamp.TimeValue(ms.GetExecutionInfo().GetExecutionTime())
-	return backoff.GetBackoffForNextSchedule(ms.executionInfo.CronSchedule, executionTime, ms.timeSource.Now())
+	overlapPolicy := backoff.CronOverlapPolicy(ms.config.CronOverlapPolicy(ms.GetNamespaceEntry().Name().String()))
+	return backoff.GetNextSchedule(ms.executionInfo.CronSchedule, scheduledTime, ms.timeSource.Now(), overlapPolicy)
+}
+
+// IsChainLengthLimitReached returns true if the run is the last one its cron, retry or continue-as-new chain is allowed
+// to have.
+func (ms *MutableStateImpl) IsChainLengthLimitReached() bool {
+	maxChainLength := ms.config.MaxWorkflowExecutionChainLength(ms.GetNamespaceEntry().Name().String())
+	return maxChainLength > 0 && ms.executionInfo.ContinuedRunCount+1 >= int64(maxChainLength)
 }
 
 // GetSignalInfo get the details about a signal request that is currently in progress.
@@ -1675,6 +1719,29 @@ func (ms *MutableStateImpl) UpdateActivityProgress(
 	ms.activityInfosUserDataUpdated[ai.ScheduledEventId] = struct{}{}
 	ms.approximateSize += ai.Size()
 	ms.syncActivityTasks[ai.ScheduledEventId] = struct{}{}
+	delete(ms.inMemoryActivityHeartbeats, ai.ScheduledEventId)
+}
+
+// UpdateActivityHeartbeatTimeInMemory records a heartbeat of an activity without persisting it, so that the heartbeat
+// timeout of the activity is measured from it while the mutable state stays in memory.
+func (ms *MutableStateImpl) UpdateActivityHeartbeatTimeInMemory(
+	ai *persistencespb.ActivityInfo,
+) {
+	if _, ok := ms.inMemoryActivityHeartbeats[ai.ScheduledEventId]; !ok {
+		ms.inMemoryActivityHeartbeats[ai.ScheduledEventId] = timestamp.TimeValue(ai.LastHeartbeatUpdateTime)
+	}
+	ai.LastHeartbeatUpdateTime = timestamppb.New(ms.timeSource.Now())
+}
+
+// GetActivityLastPersistedHeartbeatTime returns the last heartbeat time of an activity which is persisted, ignoring
+// the heartbeats recorded by UpdateActivityHeartbeatTimeInMemory since.
+func (ms *MutableStateImpl) GetActivityLastPersistedHeartbeatTime(
+	ai *persistencespb.ActivityInfo,
+) time.Time {
+	if lastHeartbeatTime, ok := ms.inMemoryActivityHeartbeats[ai.ScheduledEventId]; ok {
+		return lastHeartbeatTime
+	}
+	return timestamp.TimeValue(ai.LastHeartbeatUpdateTime)
 }
 
 // UpdateActivityInfo applies the necessary activity information
@@ -1762,6 +1829,7 @@ func (ms *MutableStateImpl) DeleteActivity(
 	if activityInfo, ok := ms.pendingActivityInfoIDs[scheduledEventID]; ok {
 		delete(ms.pendingActivityInfoIDs, scheduledEventID)
 		delete(ms.pendingActivityTimerHeartbeats, scheduledEventID)
+		delete(ms.inMemoryActivityHeartbeats, scheduledEventID)
 		ms.approximateSize -= activityInfo.Size() + int64SizeBytes
 
 		if _, ok = ms.pendingActivityIDToEventID[activityInfo.ActivityId]; ok {
@@ -2050,6 +2118,10 @@ func (ms *MutableStateImpl) IsWorkflowPendingOnWorkflowTaskBackoff() bool {
 
 // GetApproximatePersistedSize returns approximate size of in-memory objects that will be written to
 // persistence + size of buffered events in history builder if they will not be flushed
+func (ms *MutableStateImpl) GetOmittedCollections() persistence.MutableStateCollections {
+	return ms.omittedCollections
+}
+
 func (ms *MutableStateImpl) GetApproximatePersistedSize() int {
 	// include buffered events in the size if they will not be flushed
 	if ms.BufferSizeAcceptable() && ms.HasStartedWorkflowTask() {
@@ -2254,6 +2326,11 @@ func (ms *MutableStateImpl) AddWorkflowExecutionStartedEventWithOptions(
 		return nil, ms.createInternalServerError(opTag)
 	}
 
+	if prevRunID == "" {
+		// Only the first run of an execution chain, later runs inherit its expiration time.
+		startRequest = ms.capWorkflowExecutionExpirationTime(startRequest)
+	}
+
 	event := ms.hBuilder.AddWorkflowExecutionStartedEvent(
 		ms.executionState.StartTime.AsTime(),
 		startRequest,
@@ -2288,6 +2365,25 @@ func (ms *MutableStateImpl) AddWorkflowExecutionStartedEventWithOptions(
 	return event, nil
 }
 
+// capWorkflowExecutionExpirationTime returns a copy of the start request with the execution expiration time capped
+// by the max lifetime of workflow executions of the namespace, or the request itself if it isn't capped.
+func (ms *MutableStateImpl) capWorkflowExecutionExpirationTime(
+	startRequest *historyservice.StartWorkflowExecutionRequest,
+) *historyservice.StartWorkflowExecutionRequest {
+	maxLifetime := ms.config.WorkflowExecutionMaxLifetime(ms.namespaceEntry.Name().String())
+	if maxLifetime <= 0 {
+		return startRequest
+	}
+	deadline := ms.executionState.StartTime.AsTime().Add(maxLifetime).Round(time.Millisecond)
+	expirationTime := timestamp.TimeValue(startRequest.GetWorkflowExecutionExpirationTime())
+	if !expirationTime.IsZero() && !expirationTime.After(deadline) {
+		return startRequest
+	}
+	startRequest = common.CloneProtoSharingPayloads(startRequest)
+	startRequest.WorkflowExecutionExpirationTime = timestamppb.New(deadline)
+	return startRequest
+}
+
 func (ms *MutableStateImpl) ApplyWorkflowExecutionStartedEvent(
 	parentClock *clockspb.VectorClock,
 	execution *commonpb.WorkflowExecution,
@@ -2411,6 +2507,10 @@ func (ms *MutableStateImpl) ApplyWorkflowExecutionStartedEvent(
 		if !workflowExecutionTimeoutTime.IsZero() && workflowRunTimeoutTime.After(workflowExecutionTimeoutTime) {
 			workflowRunTimeoutTime = workflowExecutionTimeoutTime
 		}
+	} else if ms.executionInfo.WorkflowExecutionExpirationTime != nil {
+		// The run has no timeout of its own but the execution expires, e.g. because of
+		// history.workflowExecutionMaxLifetime: the run must not outlive the execution.
+		workflowRunTimeoutTime = timestamp.TimeValue(ms.executionInfo.WorkflowExecutionExpirationTime)
 	}
 	ms.executionInfo.WorkflowRunExpirationTime = timestamppb.New(workflowRunTimeoutTime)
 
@@ -2436,6 +2536,18 @@ func (ms *MutableStateImpl) ApplyWorkflowExecutionStartedEvent(
 	if event.SearchAttributes != nil {
 		ms.executionInfo.SearchAttributes = event.SearchAttributes.GetIndexedFields()
 	}
+	if ms.IsChainLengthLimitReached() {
+		// no visibility task needed, the search attribute is part of the started record
+		problemsPayload, err := searchattribute.EncodeValue(
+			[]string{reportedProblemChainLengthLimitReached},
+			enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST,
+		)
+		if err != nil {
+			return err
+		}
+		ms.updateSearchAttributes(map[string]*commonpb.Payload{searchattribute.TemporalReportedProblems: problemsPayload})
+	}
+	ms.executionInfo.ReplicationExcluded = ms.isReplicationExcluded()
 
 	if event.GetVersioningOverride() != nil {
 		ms.executionInfo.VersioningInfo = &workflowpb.WorkflowExecutionVersioningInfo{VersioningOverride: event.GetVersioningOverride()}
@@ -4238,6 +4350,10 @@ func (ms *MutableStateImpl) AddWorkflowExecutionUpdateAdmittedEvent(request *upd
 	if err := ms.checkMutability(tag.WorkflowActionUpdateAdmitted); err != nil {
 		return nil, err
 	}
+	request, err := ms.compressUpdateRequest(request)
+	if err != nil {
+		return nil, err
+	}
 	event, batchId := ms.hBuilder.AddWorkflowExecutionUpdateAdmittedEvent(request, origin)
 	if err := ms.ApplyWorkflowExecutionUpdateAdmittedEvent(event, batchId); err != nil {
 		return nil, err
@@ -4287,6 +4403,10 @@ func (ms *MutableStateImpl) AddWorkflowExecutionUpdateAcceptedEvent(
 	if err := ms.checkMutability(tag.WorkflowActionUpdateAccepted); err != nil {
 		return nil, err
 	}
+	acceptedRequest, err := ms.compressUpdateRequest(acceptedRequest)
+	if err != nil {
+		return nil, err
+	}
 	event := ms.hBuilder.AddWorkflowExecutionUpdateAcceptedEvent(protocolInstanceID, acceptedRequestMessageId, acceptedRequestSequencingEventId, acceptedRequest)
 	if err := ms.ApplyWorkflowExecutionUpdateAcceptedEvent(event); err != nil {
 		return nil, err
@@ -4511,6 +4631,10 @@ func (ms *MutableStateImpl) AddWorkflowExecutionSignaledEvent(
 	if err := ms.checkMutability(opTag); err != nil {
 		return nil, err
 	}
+	input, err := ms.compressHistoryPayloads(input)
+	if err != nil {
+		return nil, err
+	}
 
 	event := ms.hBuilder.AddWorkflowExecutionSignaledEvent(
 		signalName,
@@ -4527,13 +4651,49 @@ func (ms *MutableStateImpl) AddWorkflowExecutionSignaledEvent(
 }
 
 func (ms *MutableStateImpl) ApplyWorkflowExecutionSignaled(
-	_ *historypb.HistoryEvent,
+	event *historypb.HistoryEvent,
 ) error {
 	// Increment signal count in mutable state for this workflow execution
 	ms.executionInfo.SignalCount++
+
+	namespaceName := ms.namespaceEntry.Name().String()
+	dedupKeyTTL := ms.config.SignalDedupKeyTTL(namespaceName)
+	// The header was validated when the signal was accepted, a key which can't be decoded is not remembered.
+	dedupKey, _ := GetSignalDedupKey(event.GetWorkflowExecutionSignaledEventAttributes().GetHeader())
+	if dedupKey != "" && dedupKeyTTL > 0 {
+		rememberSignalDedupKey(
+			ms.executionInfo,
+			dedupKey,
+			event.GetEventTime().AsTime(),
+			dedupKeyTTL,
+			ms.config.SignalDedupKeysLimit(namespaceName),
+		)
+	}
 	return nil
 }
 
+// compressHistoryPayloads compresses payloads about to be written to history if they are larger than the namespace
+// threshold. They are decompressed again when history is read.
+func (ms *MutableStateImpl) compressHistoryPayloads(payloads *commonpb.Payloads) (*commonpb.Payloads, error) {
+	threshold := ms.config.HistoryPayloadCompressionThreshold(ms.namespaceEntry.Name().String())
+	if threshold <= 0 || payloads.Size() <= threshold {
+		return payloads, nil
+	}
+	return payload.Compress(payloads)
+}
+
+// compressUpdateRequest returns a copy of the Update request with compressed input if it needs compression. The
+// request itself is left untouched as it is shared with the Update registry.
+func (ms *MutableStateImpl) compressUpdateRequest(request *updatepb.Request) (*updatepb.Request, error) {
+	args, err := ms.compressHistoryPayloads(request.GetInput().GetArgs())
+	if err != nil || args == request.GetInput().GetArgs() {
+		return request, err
+	}
+	request = common.CloneProtoSharingPayloads(request)
+	request.Input.Args = args
+	return request, nil
+}
+
 func (ms *MutableStateImpl) AddContinueAsNewEvent(
 	ctx context.Context,
 	firstEventID int64,
@@ -4611,6 +4771,7 @@ func (ms *MutableStateImpl) AddContinueAsNewEvent(
 	); err != nil {
 		return nil, nil, err
 	}
+	newMutableState.executionInfo.PreviousRunUpdateOutcomes = ms.carriedOverUpdateOutcomes(ctx)
 
 	if err = newMutableState.SetHistoryTree(
 		newMutableState.executionInfo.WorkflowExecutionTimeout,
@@ -4637,6 +4798,66 @@ func (ms *MutableStateImpl) AddContinueAsNewEvent(
 	return continueAsNewEvent, newMutableState, nil
 }
 
+// carriedOverUpdateOutcomes returns the outcomes of the most recently completed updates of this run and of the
+// previous runs in the continue-as-new chain, from oldest to newest, within the configured count and size limits.
+func (ms *MutableStateImpl) carriedOverUpdateOutcomes(ctx context.Context) []*persistencespb.PreviousRunUpdateOutcome {
+	namespaceName := ms.GetNamespaceEntry().Name().String()
+	maxCount := ms.config.WorkflowExecutionMaxCarriedOverUpdateOutcomes(namespaceName)
+	sizeLimit := ms.config.WorkflowExecutionCarriedOverUpdateOutcomesSizeLimit(namespaceName)
+	if maxCount <= 0 {
+		return nil
+	}
+
+	type completedUpdate struct {
+		updateID string
+		eventID  int64
+	}
+	var completed []completedUpdate
+	for updateID, ui := range ms.executionInfo.UpdateInfos {
+		if completion := ui.GetCompletion(); completion != nil {
+			completed = append(completed, completedUpdate{updateID: updateID, eventID: completion.EventId})
+		}
+	}
+	// Newest first.
+	slices.SortFunc(completed, func(a, b completedUpdate) int {
+		return cmp.Compare(b.eventID, a.eventID)
+	})
+
+	// Collect the newest outcomes first, then reverse to keep them ordered from oldest to newest.
+	var outcomes []*persistencespb.PreviousRunUpdateOutcome
+	totalSize := 0
+	add := func(outcome *persistencespb.PreviousRunUpdateOutcome) bool {
+		totalSize += outcome.Size()
+		if len(outcomes) >= maxCount || totalSize > sizeLimit {
+			return false
+		}
+		outcomes = append(outcomes, outcome)
+		return true
+	}
+	for _, u := range completed {
+		outcome, err := ms.GetUpdateOutcome(ctx, u.updateID)
+		if err != nil {
+			ms.logger.Warn("Failed to load update outcome to carry over on continue-as-new.",
+				tag.WorkflowID(ms.executionInfo.WorkflowId),
+				tag.WorkflowRunID(ms.executionState.RunId),
+				tag.NewStringTag("update-id", u.updateID),
+				tag.Error(err),
+			)
+			continue
+		}
+		if !add(&persistencespb.PreviousRunUpdateOutcome{UpdateId: u.updateID, Outcome: outcome}) {
+			break
+		}
+	}
+	for i := len(ms.executionInfo.PreviousRunUpdateOutcomes) - 1; i >= 0; i-- {
+		if !add(ms.executionInfo.PreviousRunUpdateOutcomes[i]) {
+			break
+		}
+	}
+	slices.Reverse(outcomes)
+	return outcomes
+}
+
 func rolloverAutoResetPointsWithExpiringTime(
 	resetPoints *workflowpb.ResetPoints,
 	prevRunID string,
@@ -5267,6 +5488,97 @@ func (ms *MutableStateImpl) updatePauseInfoSearchAttribute() error {
 	return ms.taskGenerator.GenerateUpsertVisibilityTask()
 }
 
+// updateReportedProblemsSearchAttribute sets the TemporalReportedProblems search attribute, or removes it if there are
+// no problems. It returns true if the search attribute changed.
+func (ms *MutableStateImpl) updateReportedProblemsSearchAttribute(problems []string) (bool, error) {
+	exeInfo := ms.executionInfo
+	current, ok := exeInfo.SearchAttributes[searchattribute.TemporalReportedProblems]
+	if ms.IsChainLengthLimitReached() {
+		problems = append(problems, reportedProblemChainLengthLimitReached)
+	}
+	if len(problems) == 0 {
+		if !ok {
+			return false, nil
+		}
+		// an empty list removes the search attribute
+		problems = []string{}
+	}
+	problemsPayload, err := searchattribute.EncodeValue(problems, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST)
+	if err != nil {
+		return false, err
+	}
+	if proto.Equal(current, problemsPayload) {
+		return false, nil // unchanged
+	}
+
+	ms.updateSearchAttributes(map[string]*commonpb.Payload{searchattribute.TemporalReportedProblems: problemsPayload})
+	return true, ms.taskGenerator.GenerateUpsertVisibilityTask()
+}
+
+// closeTransactionUpdatePendingNexusOperations keeps the TemporalPendingNexusOperations search attribute in sync with
+// the Nexus operation state machines. The attribute is cleared once the workflow is closed.
+func (ms *MutableStateImpl) closeTransactionUpdatePendingNexusOperations() error {
+	if !ms.config.EnablePendingNexusOperationsSearchAttribute(ms.GetNamespaceEntry().Name().String()) {
+		return nil
+	}
+	running := ms.IsWorkflowExecutionRunning()
+	if running && !ms.HSM().Dirty() {
+		return nil
+	}
+
+	var entries []string
+	if running {
+		seen := make(map[string]struct{})
+		coll := nexusoperations.MachineCollection(ms.HSM())
+		for _, node := range coll.List() {
+			op, err := coll.Data(node.Key.ID)
+			if err != nil {
+				return err
+			}
+			switch op.GetState() {
+			case enumsspb.NEXUS_OPERATION_STATE_SCHEDULED,
+				enumsspb.NEXUS_OPERATION_STATE_BACKING_OFF,
+				enumsspb.NEXUS_OPERATION_STATE_STARTED:
+			default:
+				continue
+			}
+			for _, entry := range []string{
+				searchattribute.PendingNexusOperationEndpointPrefix + op.GetEndpoint(),
+				searchattribute.PendingNexusOperationServicePrefix + op.GetService(),
+				searchattribute.PendingNexusOperationOperationPrefix + op.GetOperation(),
+				searchattribute.PendingNexusOperationStatePrefix + op.GetState().String(),
+			} {
+				seen[entry] = struct{}{}
+			}
+		}
+		entries = make([]string, 0, len(seen))
+		for entry := range seen {
+			entries = append(entries, entry)
+		}
+		slices.Sort(entries)
+	}
+
+	exeInfo := ms.executionInfo
+	current, ok := exeInfo.SearchAttributes[searchattribute.TemporalPendingNexusOperations]
+	if len(entries) == 0 {
+		if !ok {
+			return nil
+		}
+		// an empty list removes the search attribute
+		entries = []string{}
+	}
+	entriesPayload, err := searchattribute.EncodeValue(entries, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST)
+	if err != nil {
+		return err
+	}
+	if proto.Equal(current, entriesPayload) {
+		return nil // unchanged
+	}
+
+	ms.updateSearchAttributes(map[string]*commonpb.Payload{searchattribute.TemporalPendingNexusOperations: entriesPayload})
+	return ms.taskGenerator.GenerateUpsertVisibilityTask()
+}
+
 func (ms *MutableStateImpl) truncateRetryableActivityFailure(
 	activityFailure *failurepb.Failure,
 ) *failurepb.Failure {
@@ -5531,6 +5843,9 @@ func (ms *MutableStateImpl) CloseTransactionAsSnapshot(
 		// TODO do we need the functionality to generate snapshot with buffered events?
 		return nil, nil, serviceerror.NewInternal("cannot generate workflow snapshot with buffered events")
 	}
+	if ms.omittedCollections != 0 {
+		return nil, nil, serviceerror.NewInternal("cannot generate workflow snapshot without all collections")
+	}
 
 	workflowSnapshot := &persistence.WorkflowSnapshot{
 		ExecutionInfo:  ms.executionInfo,
@@ -5619,6 +5934,10 @@ func (ms *MutableStateImpl) closeTransaction(
 		return closeTransactionResult{}, err
 	}
 
+	if err := ms.closeTransactionUpdatePendingNexusOperations(); err != nil {
+		return closeTransactionResult{}, err
+	}
+
 	if err := ms.closeTransactionUpdateTransitionHistory(
 		transactionPolicy,
 	); err != nil {
@@ -5628,6 +5947,10 @@ func (ms *MutableStateImpl) closeTransaction(
 	ms.closeTransactionTrackLastUpdateVersionedTransition(
 		transactionPolicy,
 	)
+	if ms.omittedCollections != 0 && ms.disablingTransitionHistory() {
+		// disabling transition history replicates all pending activities
+		return closeTransactionResult{}, serviceerror.NewInternal("cannot disable transition history without all collections")
+	}
 
 	workflowEventsSeq, eventBatches, bufferEvents, clearBuffer, err := ms.closeTransactionPrepareEvents(transactionPolicy)
 	if err != nil {
@@ -6675,7 +6998,33 @@ func (ms *MutableStateImpl) closeTransactionCollapseVisibilityTasks() {
 }
 
 func (ms *MutableStateImpl) generateReplicationTask() bool {
-	return len(ms.namespaceEntry.ClusterNames()) > 1
+	return len(ms.namespaceEntry.ClusterNames()) > 1 && !ms.executionInfo.ReplicationExcluded
+}
+
+// isReplicationExcluded checks the execution against the replication filter of its namespace.
+// The filter only applies on the active cluster: executions seen by passive clusters were replicated.
+func (ms *MutableStateImpl) isReplicationExcluded() bool {
+	if !ms.namespaceEntry.IsGlobalNamespace() ||
+		!ms.namespaceEntry.ActiveInCluster(ms.clusterMetadata.GetCurrentClusterName()) {
+		return false
+	}
+	// schedules are always replicated so that they keep running after failover
+	if strings.HasPrefix(ms.executionInfo.WorkflowId, primitives.ScheduleWorkflowIDPrefix) {
+		return false
+	}
+
+	namespaceName := ms.namespaceEntry.Name().String()
+	if saName := ms.config.ReplicationExcludedSearchAttribute(namespaceName); saName != "" {
+		if _, ok := ms.executionInfo.SearchAttributes[saName]; ok {
+			return true
+		}
+	}
+	workflowTypeRegexp, err := ms.config.ReplicationExcludedWorkflowTypesRegexp(namespaceName)
+	if err != nil {
+		ms.logWarn("invalid replication excluded workflow types", tag.Error(err))
+		return false
+	}
+	return workflowTypeRegexp != nil && workflowTypeRegexp.MatchString(ms.executionInfo.WorkflowTypeName)
 }
 
 func (ms *MutableStateImpl) checkMutability(
@@ -6707,7 +7056,7 @@ func (ms *MutableStateImpl) generateChecksum() *persistencespb.Checksum {
 }
 
 func (ms *MutableStateImpl) shouldGenerateChecksum() bool {
-	if ms.namespaceEntry == nil {
+	if ms.namespaceEntry == nil || ms.omittedCollections != 0 {
 		return false
 	}
 	return rand.Intn(100) < ms.config.MutableStateChecksumGenProbability(ms.namespaceEntry.Name().String())
@@ -29,6 +29,7 @@ import (
 	"fmt"
 	"reflect"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -54,14 +55,18 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/failure"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/searchattribute"
 	serviceerror2 "go.temporal.io/server/common/serviceerror"
@@ -69,6 +74,7 @@ import (
 	"go.temporal.io/server/common/tqid"
 	"go.temporal.io/server/common/worker_versioning"
 	"go.temporal.io/server/components/callbacks"
+	"go.temporal.io/server/components/nexusoperations"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/events"
 	"go.temporal.io/server/service/history/historybuilder"
@@ -234,6 +240,83 @@ func (s *mutableStateSuite) SetupSubTest() {
 	s.mutableState = NewMutableState(s.mockShard, s.mockEventsCache, s.logger, s.mutableState.GetNamespaceEntry(), tests.WorkflowID, tests.RunID, time.Now().UTC())
 }
 
+func (s *mutableStateSuite) TestReplicationExcluded() {
+	s.mockEventsCache.EXPECT().PutEvent(gomock.Any(), gomock.Any()).AnyTimes()
+	testCases := []struct {
+		name              string
+		workflowID        string
+		excludedTypes     []string
+		excludedSA        string
+		searchAttributes  map[string]*commonpb.Payload
+		expectedExclusion bool
+	}{
+		{
+			name:              "no filter",
+			expectedExclusion: false,
+		},
+		{
+			name:              "workflow type matches",
+			excludedTypes:     []string{"other-type", "low-value-*"},
+			expectedExclusion: true,
+		},
+		{
+			name:              "workflow type doesn't match",
+			excludedTypes:     []string{"other-type"},
+			expectedExclusion: false,
+		},
+		{
+			name:              "search attribute set",
+			excludedSA:        "CustomKeywordField",
+			searchAttributes:  map[string]*commonpb.Payload{"CustomKeywordField": payload.EncodeString("value")},
+			expectedExclusion: true,
+		},
+		{
+			name:              "search attribute not set",
+			excludedSA:        "CustomKeywordField",
+			expectedExclusion: false,
+		},
+		{
+			name:              "schedule never excluded",
+			workflowID:        primitives.ScheduleWorkflowIDPrefix + "my-schedule",
+			excludedTypes:     []string{"*"},
+			expectedExclusion: false,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			workflowID := tc.workflowID
+			if workflowID == "" {
+				workflowID = tests.WorkflowID
+			}
+			s.mutableState.executionInfo.WorkflowId = workflowID
+			s.mockConfig.ReplicationExcludedWorkflowTypes = dynamicconfig.GetTypedPropertyFnFilteredByNamespace(tc.excludedTypes)
+			s.mockConfig.ReplicationExcludedSearchAttribute = dynamicconfig.GetStringPropertyFnFilteredByNamespace(tc.excludedSA)
+
+			err := s.mutableState.ApplyWorkflowExecutionStartedEvent(
+				nil,
+				&commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: tests.RunID},
+				uuid.New(),
+				&historypb.HistoryEvent{
+					Version:   s.namespaceEntry.FailoverVersion(),
+					EventId:   common.FirstEventID,
+					EventTime: timestamppb.New(time.Now().UTC()),
+					EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+					Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+						WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+							WorkflowType:     &commonpb.WorkflowType{Name: "low-value-workflow"},
+							TaskQueue:        &taskqueuepb.TaskQueue{Name: "some random taskqueue"},
+							SearchAttributes: &commonpb.SearchAttributes{IndexedFields: tc.searchAttributes},
+						},
+					},
+				},
+			)
+			s.NoError(err)
+			s.Equal(tc.expectedExclusion, s.mutableState.GetExecutionInfo().GetReplicationExcluded())
+			s.Equal(!tc.expectedExclusion, s.mutableState.generateReplicationTask())
+		})
+	}
+}
+
 func (s *mutableStateSuite) TestTransientWorkflowTaskCompletionFirstBatchApplied_ApplyWorkflowTaskCompleted() {
 	version := int64(12)
 	workflowID := "some random workflow ID"
@@ -823,6 +906,268 @@ func (s *mutableStateSuite) TestUnpinnedTransitionTimeout() {
 	s.verifyEffectiveDeployment(deployment2, behavior)
 }
 
+func (s *mutableStateSuite) TestStuckWorkflowTaskReportedProblems() {
+	s.mockConfig.StuckWorkflowTaskFailureThreshold = dynamicconfig.GetIntPropertyFnFilteredByNamespace(2)
+	s.mockConfig.StuckWorkflowTaskMinDuration = dynamicconfig.GetDurationPropertyFnFilteredByNamespace(0)
+	tq := &taskqueuepb.TaskQueue{Name: "tq"}
+	s.createMutableStateWithVersioningBehavior(enumspb.VERSIONING_BEHAVIOR_UNSPECIFIED, nil, tq)
+
+	// transient workflow tasks are scheduled after the last event in the version history
+	versionHistory, err := versionhistory.GetCurrentVersionHistory(s.mutableState.GetExecutionInfo().GetVersionHistories())
+	s.NoError(err)
+	err = versionhistory.AddOrUpdateVersionHistoryItem(versionHistory, &historyspb.VersionHistoryItem{
+		EventId: s.mutableState.GetNextEventID() - 1,
+		Version: s.mutableState.GetCurrentVersion(),
+	})
+	s.NoError(err)
+
+	scheduleAndStart := func() *WorkflowTaskInfo {
+		wft, err := s.mutableState.AddWorkflowTaskScheduledEvent(true, enumsspb.WORKFLOW_TASK_TYPE_NORMAL)
+		s.NoError(err)
+		_, wft, err = s.mutableState.AddWorkflowTaskStartedEvent(wft.ScheduledEventID, "", tq, "", nil, nil, false)
+		s.NoError(err)
+		return wft
+	}
+	reportedProblems := func() *commonpb.Payload {
+		return s.mutableState.GetExecutionInfo().GetSearchAttributes()[searchattribute.TemporalReportedProblems]
+	}
+
+	for i := 0; i < 2; i++ {
+		_, err := s.mutableState.AddWorkflowTaskTimedOutEvent(scheduleAndStart())
+		s.NoError(err)
+	}
+	s.Equal(workflowTaskTimedOutFailureCause, s.mutableState.GetExecutionInfo().GetWorkflowTaskLastFailureCause())
+	s.Equal(int32(2), s.mutableState.GetExecutionInfo().GetWorkflowTaskSameFailureCount())
+	s.NotNil(reportedProblems())
+
+	// A different cause starts a new streak.
+	_, err = s.mutableState.AddWorkflowTaskFailedEvent(
+		scheduleAndStart(),
+		enumspb.WORKFLOW_TASK_FAILED_CAUSE_WORKFLOW_WORKER_UNHANDLED_FAILURE,
+		failure.NewServerFailure("some random workflow task failure details", false),
+		"some random workflow task failure identity",
+		nil,
+		"",
+		"",
+		"",
+		0,
+	)
+	s.NoError(err)
+	s.Equal(int32(1), s.mutableState.GetExecutionInfo().GetWorkflowTaskSameFailureCount())
+
+	_, err = s.mutableState.AddWorkflowTaskCompletedEvent(
+		scheduleAndStart(),
+		&workflowservice.RespondWorkflowTaskCompletedRequest{},
+		workflowTaskCompletionLimits,
+	)
+	s.NoError(err)
+	s.Empty(s.mutableState.GetExecutionInfo().GetWorkflowTaskLastFailureCause())
+	s.Zero(s.mutableState.GetExecutionInfo().GetWorkflowTaskSameFailureCount())
+	s.Nil(reportedProblems())
+}
+
+func (s *mutableStateSuite) TestPendingNexusOperationsSearchAttribute() {
+	s.mockConfig.EnablePendingNexusOperationsSearchAttribute = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true)
+	s.NoError(nexusoperations.RegisterStateMachines(s.mockShard.StateMachineRegistry()))
+	s.createMutableStateWithVersioningBehavior(enumspb.VERSIONING_BEHAVIOR_UNSPECIFIED, nil, &taskqueuepb.TaskQueue{Name: "tq"})
+
+	addOperation := func(id, endpoint string) *hsm.Node {
+		event := &historypb.HistoryEvent{
+			EventTime: timestamppb.Now(),
+			Attributes: &historypb.HistoryEvent_NexusOperationScheduledEventAttributes{
+				NexusOperationScheduledEventAttributes: &historypb.NexusOperationScheduledEventAttributes{
+					Endpoint:  endpoint,
+					Service:   "service",
+					Operation: "operation",
+				},
+			},
+		}
+		node, err := nexusoperations.AddChild(s.mutableState.HSM(), id, event, nil, false)
+		s.NoError(err)
+		return node
+	}
+	pendingOperations := func() []string {
+		payload := s.mutableState.GetExecutionInfo().GetSearchAttributes()[searchattribute.TemporalPendingNexusOperations]
+		if payload == nil {
+			return nil
+		}
+		decoded, err := searchattribute.DecodeValue(payload, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST, false)
+		s.NoError(err)
+		return decoded.([]string)
+	}
+
+	addOperation("1", "endpoint-a")
+	node := addOperation("2", "endpoint-b")
+	s.NoError(s.mutableState.closeTransactionUpdatePendingNexusOperations())
+	s.Equal([]string{
+		"endpoint=endpoint-a",
+		"endpoint=endpoint-b",
+		"operation=operation",
+		"service=service",
+		"state=Scheduled",
+	}, pendingOperations())
+
+	// completed operations are not indexed
+	s.NoError(hsm.MachineTransition(node, func(op nexusoperations.Operation) (hsm.TransitionOutput, error) {
+		return nexusoperations.TransitionSucceeded.Apply(op, nexusoperations.EventSucceeded{Node: node})
+	}))
+	s.NoError(s.mutableState.closeTransactionUpdatePendingNexusOperations())
+	s.Equal([]string{
+		"endpoint=endpoint-a",
+		"operation=operation",
+		"service=service",
+		"state=Scheduled",
+	}, pendingOperations())
+
+	// the search attribute is cleared once the workflow is closed
+	s.mutableState.GetExecutionState().State = enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED
+	s.NoError(s.mutableState.closeTransactionUpdatePendingNexusOperations())
+	s.Empty(pendingOperations())
+}
+
+func (s *mutableStateSuite) TestHistoryPayloadCompression() {
+	s.mockConfig.HistoryPayloadCompressionThreshold = dynamicconfig.GetIntPropertyFnFilteredByNamespace(100)
+	s.createMutableStateWithVersioningBehavior(enumspb.VERSIONING_BEHAVIOR_UNSPECIFIED, nil, &taskqueuepb.TaskQueue{Name: "tq"})
+	s.mockEventsCache.EXPECT().PutEvent(gomock.Any(), gomock.Any()).AnyTimes()
+
+	small := payloads.EncodeString("small")
+	large := payloads.EncodeString(strings.Repeat("large", 100))
+
+	event, err := s.mutableState.AddWorkflowExecutionSignaled("signal", small, "identity", nil, nil)
+	s.NoError(err)
+	s.False(payload.IsCompressed(event.GetWorkflowExecutionSignaledEventAttributes().GetInput().GetPayloads()[0]))
+
+	event, err = s.mutableState.AddWorkflowExecutionSignaled("signal", large, "identity", nil, nil)
+	s.NoError(err)
+	input := event.GetWorkflowExecutionSignaledEventAttributes().GetInput()
+	s.True(payload.IsCompressed(input.GetPayloads()[0]))
+	s.Less(input.Size(), large.Size())
+
+	request := &updatepb.Request{
+		Meta:  &updatepb.Meta{UpdateId: "update"},
+		Input: &updatepb.Input{Name: "update", Args: large},
+	}
+	event, err = s.mutableState.AddWorkflowExecutionUpdateAdmittedEvent(request, enumspb.UPDATE_ADMITTED_EVENT_ORIGIN_UNSPECIFIED)
+	s.NoError(err)
+	s.True(payload.IsCompressed(event.GetWorkflowExecutionUpdateAdmittedEventAttributes().GetRequest().GetInput().GetArgs().GetPayloads()[0]))
+	s.False(payload.IsCompressed(request.GetInput().GetArgs().GetPayloads()[0]), "the original request must not be modified")
+}
+
+func (s *mutableStateSuite) TestCarriedOverUpdateOutcomes() {
+	s.createMutableStateWithVersioningBehavior(enumspb.VERSIONING_BEHAVIOR_UNSPECIFIED, nil, &taskqueuepb.TaskQueue{Name: "tq"})
+
+	newOutcome := func(updateID string) *persistencespb.PreviousRunUpdateOutcome {
+		return &persistencespb.PreviousRunUpdateOutcome{
+			UpdateId: updateID,
+			Outcome: &updatepb.Outcome{
+				Value: &updatepb.Outcome_Success{Success: payloads.EncodeString(updateID)},
+			},
+		}
+	}
+	s.mutableState.GetExecutionInfo().PreviousRunUpdateOutcomes = []*persistencespb.PreviousRunUpdateOutcome{
+		newOutcome("update-1"), newOutcome("update-2"), newOutcome("update-3"),
+	}
+
+	outcome, err := s.mutableState.GetUpdateOutcome(context.Background(), "update-2")
+	s.NoError(err)
+	protorequire.ProtoEqual(s.T(), newOutcome("update-2").GetOutcome(), outcome)
+	_, err = s.mutableState.GetUpdateOutcome(context.Background(), "update-4")
+	var notFound *serviceerror.NotFound
+	s.ErrorAs(err, &notFound)
+
+	s.mockConfig.WorkflowExecutionMaxCarriedOverUpdateOutcomes = dynamicconfig.GetIntPropertyFnFilteredByNamespace(2)
+	outcomes := s.mutableState.carriedOverUpdateOutcomes(context.Background())
+	s.Len(outcomes, 2)
+	s.Equal("update-2", outcomes[0].GetUpdateId())
+	s.Equal("update-3", outcomes[1].GetUpdateId())
+
+	s.mockConfig.WorkflowExecutionCarriedOverUpdateOutcomesSizeLimit = dynamicconfig.GetIntPropertyFnFilteredByNamespace(newOutcome("update-3").Size())
+	outcomes = s.mutableState.carriedOverUpdateOutcomes(context.Background())
+	s.Len(outcomes, 1)
+	s.Equal("update-3", outcomes[0].GetUpdateId())
+
+	s.mockConfig.WorkflowExecutionMaxCarriedOverUpdateOutcomes = dynamicconfig.GetIntPropertyFnFilteredByNamespace(0)
+	s.Empty(s.mutableState.carriedOverUpdateOutcomes(context.Background()))
+}
+
+func (s *mutableStateSuite) TestGetNextCronSchedule() {
+	s.createMutableStateWithVersioningBehavior(enumspb.VERSIONING_BEHAVIOR_UNSPECIFIED, nil, &taskqueuepb.TaskQueue{Name: "tq"})
+	executionInfo := s.mutableState.GetExecutionInfo()
+	executionTime := time.Now().Add(-10*time.Minute - 30*time.Second).Truncate(time.Second)
+	executionInfo.CronSchedule = "@every 1m"
+	executionInfo.ExecutionTime = timestamppb.New(executionTime)
+
+	s.mockConfig.CronOverlapPolicy = dynamicconfig.GetStringPropertyFnFilteredByNamespace(string(backoff.CronOverlapPolicySkip))
+	scheduledTime, backoffInterval := s.mutableState.GetNextCronSchedule()
+	s.True(scheduledTime.After(time.Now()))
+	s.Positive(backoffInterval)
+
+	s.mockConfig.CronOverlapPolicy = dynamicconfig.GetStringPropertyFnFilteredByNamespace(string(backoff.CronOverlapPolicyAllowAll))
+	scheduledTime, backoffInterval = s.mutableState.GetNextCronSchedule()
+	s.Equal(executionTime.Add(time.Minute).UTC(), scheduledTime)
+	s.Zero(backoffInterval)
+
+	// A run making up for a missed scheduled time is scheduled relative to it.
+	executionInfo.CronScheduledTime = timestamppb.New(executionTime.Add(time.Minute))
+	scheduledTime, backoffInterval = s.mutableState.GetNextCronSchedule()
+	s.Equal(executionTime.Add(2*time.Minute).UTC(), scheduledTime)
+	s.Zero(backoffInterval)
+}
+
+func (s *mutableStateSuite) TestCapWorkflowExecutionExpirationTime() {
+	s.createMutableStateWithVersioningBehavior(enumspb.VERSIONING_BEHAVIOR_UNSPECIFIED, nil, &taskqueuepb.TaskQueue{Name: "tq"})
+	startTime := time.Now().UTC().Truncate(time.Millisecond)
+	s.mutableState.executionState.StartTime = timestamppb.New(startTime)
+	startRequest := &historyservice.StartWorkflowExecutionRequest{}
+
+	s.Same(startRequest, s.mutableState.capWorkflowExecutionExpirationTime(startRequest))
+
+	s.mockConfig.WorkflowExecutionMaxLifetime = dynamicconfig.GetDurationPropertyFnFilteredByNamespace(time.Hour)
+	capped := s.mutableState.capWorkflowExecutionExpirationTime(startRequest)
+	s.Equal(startTime.Add(time.Hour), capped.GetWorkflowExecutionExpirationTime().AsTime())
+	s.Nil(startRequest.GetWorkflowExecutionExpirationTime())
+
+	// An earlier expiration time requested by the user is kept.
+	startRequest.WorkflowExecutionExpirationTime = timestamppb.New(startTime.Add(time.Minute))
+	s.Same(startRequest, s.mutableState.capWorkflowExecutionExpirationTime(startRequest))
+
+	startRequest.WorkflowExecutionExpirationTime = timestamppb.New(startTime.Add(2 * time.Hour))
+	capped = s.mutableState.capWorkflowExecutionExpirationTime(startRequest)
+	s.Equal(startTime.Add(time.Hour), capped.GetWorkflowExecutionExpirationTime().AsTime())
+}
+
+func (s *mutableStateSuite) TestChainLengthLimit() {
+	s.createMutableStateWithVersioningBehavior(enumspb.VERSIONING_BEHAVIOR_UNSPECIFIED, nil, &taskqueuepb.TaskQueue{Name: "tq"})
+	executionInfo := s.mutableState.GetExecutionInfo()
+	executionInfo.CronSchedule = "@every 1m"
+	executionInfo.ExecutionTime = timestamppb.New(time.Now())
+	executionInfo.ContinuedRunCount = 1
+	s.False(s.mutableState.IsChainLengthLimitReached())
+	s.NotEqual(backoff.NoBackoff, s.mutableState.GetCronBackoffDuration())
+
+	s.mockConfig.MaxWorkflowExecutionChainLength = dynamicconfig.GetIntPropertyFnFilteredByNamespace(3)
+	s.False(s.mutableState.IsChainLengthLimitReached())
+
+	newMutableState, err := NewMutableStateInChain(
+		s.mockShard,
+		s.mockEventsCache,
+		s.logger,
+		tests.GlobalNamespaceEntry,
+		tests.WorkflowID,
+		uuid.New(),
+		time.Now().UTC(),
+		s.mutableState,
+	)
+	s.NoError(err)
+	s.Equal(int64(2), newMutableState.GetExecutionInfo().ContinuedRunCount)
+	s.True(newMutableState.IsChainLengthLimitReached())
+
+	// The last run of the chain does not schedule a next cron run.
+	executionInfo.ContinuedRunCount = 2
+	s.True(s.mutableState.IsChainLengthLimitReached())
+	s.Equal(backoff.NoBackoff, s.mutableState.GetCronBackoffDuration())
+}
+
 func (s *mutableStateSuite) verifyWorkflowOptionsUpdatedEvent(
 	event *historypb.HistoryEvent,
 	expectedOverride *workflowpb.VersioningOverride,
@@ -1085,6 +1430,45 @@ func (s *mutableStateSuite) TestChecksumShouldInvalidate() {
 	s.False(s.mutableState.shouldInvalidateCheckum())
 }
 
+func (s *mutableStateSuite) TestPartialMutableState() {
+	dbState := s.buildWorkflowMutableState()
+	dbState.BufferedEvents = nil
+	// the checksum of the complete mutable state doesn't match the partial one
+	checksum, err := generateMutableStateChecksum(s.mutableState)
+	s.NoError(err)
+	dbState.Checksum = checksum
+	dbState.ActivityInfos = nil
+	dbState.TimerInfos = nil
+
+	loadErrorsFunc := func() int64 {
+		counter := s.testScope.Snapshot().Counters()["test.mutable_state_checksum_mismatch+operation=WorkflowContext,service_name=history"]
+		if counter != nil {
+			return counter.Value()
+		}
+		return 0
+	}
+	loadErrors := loadErrorsFunc()
+	s.mockConfig.MutableStateChecksumVerifyProbability = func(namespace string) int { return 100 }
+	s.mutableState, err = newMutableStateFromDB(
+		s.mockShard,
+		s.mockEventsCache,
+		s.logger,
+		tests.LocalNamespaceEntry,
+		dbState,
+		123,
+		persistence.MutableStateActivityInfos|persistence.MutableStateTimerInfos,
+	)
+	s.NoError(err)
+	s.Equal(persistence.MutableStateActivityInfos|persistence.MutableStateTimerInfos, s.mutableState.GetOmittedCollections())
+	// the checksum isn't verified nor generated
+	s.Equal(loadErrors, loadErrorsFunc())
+	s.mutableState.namespaceEntry = s.newNamespaceCacheEntry()
+	s.False(s.mutableState.shouldGenerateChecksum())
+
+	_, _, err = s.mutableState.CloseTransactionAsSnapshot(TransactionPolicyPassive)
+	s.IsType(&serviceerror.Internal{}, err)
+}
+
 func (s *mutableStateSuite) TestContinueAsNewMinBackoff() {
 	// set ContinueAsNew min interval to 5s
 	s.mockConfig.WorkflowIdReuseMinimalInterval = func(namespace string) time.Duration {
@@ -1942,6 +2326,31 @@ func (s *mutableStateSuite) TestTotalEntitiesCount() {
 	s.Equal(int64(1), mutation.ExecutionInfo.UpdateCount)
 }
 
+func (s *mutableStateSuite) TestApplyWorkflowExecutionSignaled_RemembersDedupKey() {
+	eventTime := time.Now().UTC()
+	event := &historypb.HistoryEvent{
+		EventTime: timestamppb.New(eventTime),
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+		Attributes: &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{
+			WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{
+				SignalName: "signalName",
+				Header: &commonpb.Header{
+					Fields: map[string]*commonpb.Payload{SignalDedupKeyHeader: payload.EncodeString("dedup-key")},
+				},
+			},
+		},
+	}
+
+	s.NoError(s.mutableState.ApplyWorkflowExecutionSignaled(event))
+	s.Equal(int64(1), s.mutableState.GetExecutionInfo().SignalCount)
+	s.True(IsDuplicateSignal(s.mutableState.GetExecutionInfo(), "dedup-key", eventTime))
+	s.False(IsDuplicateSignal(
+		s.mutableState.GetExecutionInfo(),
+		"dedup-key",
+		eventTime.Add(s.mockConfig.SignalDedupKeyTTL(s.namespaceEntry.Name().String())),
+	))
+}
+
 func (s *mutableStateSuite) TestSpeculativeWorkflowTaskNotPersisted() {
 	testCases := []struct {
 		name                 string
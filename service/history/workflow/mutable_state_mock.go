@@ -1792,6 +1792,20 @@ func (mr *MockMutableStateMockRecorder) GetActivityInfoWithTimerHeartbeat(schedu
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityInfoWithTimerHeartbeat", reflect.TypeOf((*MockMutableState)(nil).GetActivityInfoWithTimerHeartbeat), scheduledEventID)
 }
 
+// GetActivityLastPersistedHeartbeatTime mocks base method.
+func (m *MockMutableState) GetActivityLastPersistedHeartbeatTime(ai *persistence.ActivityInfo) time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivityLastPersistedHeartbeatTime", ai)
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// GetActivityLastPersistedHeartbeatTime indicates an expected call of GetActivityLastPersistedHeartbeatTime.
+func (mr *MockMutableStateMockRecorder) GetActivityLastPersistedHeartbeatTime(ai any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityLastPersistedHeartbeatTime", reflect.TypeOf((*MockMutableState)(nil).GetActivityLastPersistedHeartbeatTime), ai)
+}
+
 // GetActivityScheduledEvent mocks base method.
 func (m *MockMutableState) GetActivityScheduledEvent(arg0 context.Context, arg1 int64) (*history.HistoryEvent, error) {
 	m.ctrl.T.Helper()
@@ -2182,6 +2196,21 @@ func (mr *MockMutableStateMockRecorder) GetNamespaceEntry() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceEntry", reflect.TypeOf((*MockMutableState)(nil).GetNamespaceEntry))
 }
 
+// GetNextCronSchedule mocks base method.
+func (m *MockMutableState) GetNextCronSchedule() (time.Time, time.Duration) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextCronSchedule")
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(time.Duration)
+	return ret0, ret1
+}
+
+// GetNextCronSchedule indicates an expected call of GetNextCronSchedule.
+func (mr *MockMutableStateMockRecorder) GetNextCronSchedule() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextCronSchedule", reflect.TypeOf((*MockMutableState)(nil).GetNextCronSchedule))
+}
+
 // GetNextEventID mocks base method.
 func (m *MockMutableState) GetNextEventID() int64 {
 	m.ctrl.T.Helper()
@@ -2211,6 +2240,20 @@ func (mr *MockMutableStateMockRecorder) GetNexusCompletion(ctx any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNexusCompletion", reflect.TypeOf((*MockMutableState)(nil).GetNexusCompletion), ctx)
 }
 
+// GetOmittedCollections mocks base method.
+func (m *MockMutableState) GetOmittedCollections() persistence0.MutableStateCollections {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOmittedCollections")
+	ret0, _ := ret[0].(persistence0.MutableStateCollections)
+	return ret0
+}
+
+// GetOmittedCollections indicates an expected call of GetOmittedCollections.
+func (mr *MockMutableStateMockRecorder) GetOmittedCollections() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOmittedCollections", reflect.TypeOf((*MockMutableState)(nil).GetOmittedCollections))
+}
+
 // GetPendingActivityInfos mocks base method.
 func (m *MockMutableState) GetPendingActivityInfos() map[int64]*persistence.ActivityInfo {
 	m.ctrl.T.Helper()
@@ -2741,6 +2784,20 @@ func (mr *MockMutableStateMockRecorder) IsCancelRequested() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsCancelRequested", reflect.TypeOf((*MockMutableState)(nil).IsCancelRequested))
 }
 
+// IsChainLengthLimitReached mocks base method.
+func (m *MockMutableState) IsChainLengthLimitReached() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsChainLengthLimitReached")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsChainLengthLimitReached indicates an expected call of IsChainLengthLimitReached.
+func (mr *MockMutableStateMockRecorder) IsChainLengthLimitReached() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsChainLengthLimitReached", reflect.TypeOf((*MockMutableState)(nil).IsChainLengthLimitReached))
+}
+
 // IsCurrentWorkflowGuaranteed mocks base method.
 func (m *MockMutableState) IsCurrentWorkflowGuaranteed() bool {
 	m.ctrl.T.Helper()
@@ -3181,6 +3238,18 @@ func (mr *MockMutableStateMockRecorder) UpdateActivity(arg0, arg1 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivity", reflect.TypeOf((*MockMutableState)(nil).UpdateActivity), arg0, arg1)
 }
 
+// UpdateActivityHeartbeatTimeInMemory mocks base method.
+func (m *MockMutableState) UpdateActivityHeartbeatTimeInMemory(ai *persistence.ActivityInfo) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdateActivityHeartbeatTimeInMemory", ai)
+}
+
+// UpdateActivityHeartbeatTimeInMemory indicates an expected call of UpdateActivityHeartbeatTimeInMemory.
+func (mr *MockMutableStateMockRecorder) UpdateActivityHeartbeatTimeInMemory(ai any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityHeartbeatTimeInMemory", reflect.TypeOf((*MockMutableState)(nil).UpdateActivityHeartbeatTimeInMemory), ai)
+}
+
 // UpdateActivityInfo mocks base method.
 func (m *MockMutableState) UpdateActivityInfo(arg0 *historyservice.ActivitySyncInfo, arg1 bool) error {
 	m.ctrl.T.Helper()
@@ -39,6 +39,11 @@ const (
 	QueryCompletionTypeFailed
 )
 
+// DurableQueryResultIDPrefix prefixes the IDs of query results which a workflow task reports without being asked for
+// them. If the task closes the workflow, these results are stored as the final results of the queries whose type is
+// the rest of the ID, and answer queries of the closed workflow without involving a worker.
+const DurableQueryResultIDPrefix = "__temporal_durable_query:"
+
 var (
 	errCompletionStateInvalid    = serviceerror.NewInternal("query completion state invalid")
 	errAlreadyInCompletionState  = serviceerror.NewInternal("query is already in completion state")
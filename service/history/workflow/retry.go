@@ -310,6 +310,13 @@ func SetupNewWorkflowForRetryOrCron(
 	if _, err = newMutableState.AddFirstWorkflowTaskScheduled(parentClock, event, false); err != nil {
 		return err
 	}
+	if initiator == enumspb.CONTINUE_AS_NEW_INITIATOR_CRON_SCHEDULE && backoffInterval == 0 {
+		// The new run makes up for a scheduled time missed by the previous run, remember it so that the run after
+		// the new one is scheduled relative to it.
+		if scheduledTime, _ := previousMutableState.GetNextCronSchedule(); !scheduledTime.IsZero() {
+			newMutableState.GetExecutionInfo().CronScheduledTime = timestamppb.New(scheduledTime)
+		}
+	}
 
 	return nil
 }
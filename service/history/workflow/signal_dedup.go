@@ -0,0 +1,100 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package workflow
+
+import (
+	"slices"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/payload"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SignalDedupKeyHeader is the signal header field carrying the optional user-supplied dedup key. The value is a
+// string payload. Signals whose key was already accepted by the execution within the dedup TTL are dropped.
+//
+// The key is remembered when the signaled event is applied to mutable state, so it is rebuilt from history by
+// replication and mutable state rebuilds and survives a namespace failover.
+const SignalDedupKeyHeader = "__temporal_signal_dedup_key"
+
+// GetSignalDedupKey returns the dedup key carried by a signal header, or an empty string if there is none.
+func GetSignalDedupKey(header *commonpb.Header) (string, error) {
+	p, ok := header.GetFields()[SignalDedupKeyHeader]
+	if !ok {
+		return "", nil
+	}
+	var dedupKey string
+	if err := payload.Decode(p, &dedupKey); err != nil {
+		return "", serviceerror.NewInvalidArgument("Signal dedup key header must be a string payload.")
+	}
+	return dedupKey, nil
+}
+
+// IsDuplicateSignal returns true if a signal with the dedup key was accepted by the execution and the key has not
+// expired yet.
+func IsDuplicateSignal(
+	executionInfo *persistencespb.WorkflowExecutionInfo,
+	dedupKey string,
+	now time.Time,
+) bool {
+	expirationTime, ok := executionInfo.GetSignalDedupKeys()[dedupKey]
+	return dedupKey != "" && ok && now.Before(expirationTime.AsTime())
+}
+
+// rememberSignalDedupKey records the dedup key of an accepted signal, dropping expired keys and, if there are still
+// more than limit keys, the ones closest to expiring.
+func rememberSignalDedupKey(
+	executionInfo *persistencespb.WorkflowExecutionInfo,
+	dedupKey string,
+	now time.Time,
+	ttl time.Duration,
+	limit int,
+) {
+	if executionInfo.SignalDedupKeys == nil {
+		executionInfo.SignalDedupKeys = make(map[string]*timestamppb.Timestamp)
+	}
+	dedupKeys := executionInfo.SignalDedupKeys
+	for key, expirationTime := range dedupKeys {
+		if !now.Before(expirationTime.AsTime()) {
+			delete(dedupKeys, key)
+		}
+	}
+	dedupKeys[dedupKey] = timestamppb.New(now.Add(ttl))
+
+	if len(dedupKeys) <= limit {
+		return
+	}
+	keys := make([]string, 0, len(dedupKeys))
+	for key := range dedupKeys {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b string) int {
+		return dedupKeys[a].AsTime().Compare(dedupKeys[b].AsTime())
+	})
+	for _, key := range keys[:len(keys)-max(limit, 0)] {
+		delete(dedupKeys, key)
+	}
+}
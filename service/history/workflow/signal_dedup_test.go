@@ -0,0 +1,74 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package workflow
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/payload"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGetSignalDedupKey(t *testing.T) {
+	dedupKey, err := GetSignalDedupKey(nil)
+	require.NoError(t, err)
+	require.Empty(t, dedupKey)
+
+	dedupKey, err = GetSignalDedupKey(&commonpb.Header{
+		Fields: map[string]*commonpb.Payload{SignalDedupKeyHeader: payload.EncodeString("key")},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "key", dedupKey)
+
+	_, err = GetSignalDedupKey(&commonpb.Header{
+		Fields: map[string]*commonpb.Payload{SignalDedupKeyHeader: payload.EncodeBytes([]byte("not-a-string"))},
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	require.ErrorAs(t, err, &invalidArgument)
+}
+
+func TestRememberSignalDedupKey(t *testing.T) {
+	now := time.Now()
+	executionInfo := &persistencespb.WorkflowExecutionInfo{
+		SignalDedupKeys: map[string]*timestamppb.Timestamp{
+			"expired": timestamppb.New(now.Add(-time.Second)),
+			"oldest":  timestamppb.New(now.Add(time.Minute)),
+			"newer":   timestamppb.New(now.Add(2 * time.Minute)),
+		},
+	}
+
+	rememberSignalDedupKey(executionInfo, "new", now, time.Hour, 2)
+	require.Len(t, executionInfo.SignalDedupKeys, 2)
+	require.Contains(t, executionInfo.SignalDedupKeys, "newer")
+	require.Contains(t, executionInfo.SignalDedupKeys, "new")
+
+	require.True(t, IsDuplicateSignal(executionInfo, "new", now))
+	require.False(t, IsDuplicateSignal(executionInfo, "new", now.Add(time.Hour)))
+	require.False(t, IsDuplicateSignal(executionInfo, "oldest", now))
+	require.False(t, IsDuplicateSignal(executionInfo, "", now))
+}
@@ -88,6 +88,9 @@ type (
 		) error
 		GenerateUpsertVisibilityTask() error
 		GenerateWorkflowResetTasks() error
+		// GenerateWorkflowResetLifecycleEventTask adds a tasks.WorkflowLifecycleEventTask publishing that the workflow
+		// was reset from baseRunID to the mutable state of the new run.
+		GenerateWorkflowResetLifecycleEventTask(baseRunID string, reason string) error
 
 		// these 2 APIs should only be called when mutable state transaction is being closed
 		GenerateActivityTimerTasks() error
@@ -179,6 +182,15 @@ func (r *TaskGeneratorImpl) GenerateWorkflowStartTasks(
 		executionTimeoutTimerTaskStatus = TimerTaskStatusCreated
 	}
 
+	if r.workflowLifecycleEventsEnabled() {
+		r.mutableState.AddTasks(&tasks.WorkflowLifecycleEventTask{
+			// TaskID, VisibilityTimestamp is set by shard
+			WorkflowKey: r.mutableState.GetWorkflowKey(),
+			Version:     startEvent.GetVersion(),
+			EventType:   enumsspb.WORKFLOW_LIFECYCLE_EVENT_TYPE_STARTED,
+		})
+	}
+
 	workflowRunExpirationTime := timestamp.TimeValue(
 		executionInfo.WorkflowRunExpirationTime,
 	)
@@ -268,13 +280,22 @@ func (r *TaskGeneratorImpl) GenerateWorkflowCloseTasks(
 	}
 
 	r.mutableState.AddTasks(closeTasks...)
+	if r.workflowLifecycleEventsEnabled() {
+		r.mutableState.AddTasks(&tasks.WorkflowLifecycleEventTask{
+			// TaskID, VisibilityTimestamp is set by shard
+			WorkflowKey: r.mutableState.GetWorkflowKey(),
+			Version:     closeVersion,
+			EventType:   enumsspb.WORKFLOW_LIFECYCLE_EVENT_TYPE_CLOSED,
+		})
+	}
 
 	return nil
 }
 
 // getRetention returns the retention period for this task generator's workflow execution.
 // The retention period represents how long the workflow data should exist in primary storage after the workflow closes.
-// If the workflow namespace is not found, the default retention period is returned.
+// The retention of the namespace can be overridden per workflow type with the history.workflowTypeRetention dynamic
+// config. If the workflow namespace is not found, the default retention period is returned.
 // This method returns an error when the GetNamespaceByID call fails with anything other than
 // serviceerror.NamespaceNotFound.
 func (r *TaskGeneratorImpl) getRetention() (time.Duration, error) {
@@ -284,6 +305,10 @@ func (r *TaskGeneratorImpl) getRetention() (time.Duration, error) {
 	switch err.(type) {
 	case nil:
 		retention = namespaceEntry.Retention()
+		typeRetention := r.config.WorkflowTypeRetention(namespaceEntry.Name().String())
+		if override, ok := typeRetention[executionInfo.WorkflowTypeName]; ok && override > 0 {
+			retention = override
+		}
 	case *serviceerror.NamespaceNotFound:
 		// namespace is not accessible, use default value above
 	default:
@@ -693,6 +718,25 @@ func (r *TaskGeneratorImpl) GenerateWorkflowResetTasks() error {
 	return nil
 }
 
+func (r *TaskGeneratorImpl) GenerateWorkflowResetLifecycleEventTask(
+	baseRunID string,
+	reason string,
+) error {
+	if !r.workflowLifecycleEventsEnabled() {
+		return nil
+	}
+
+	r.mutableState.AddTasks(&tasks.WorkflowLifecycleEventTask{
+		// TaskID, VisibilityTimestamp is set by shard
+		WorkflowKey: r.mutableState.GetWorkflowKey(),
+		Version:     r.mutableState.GetCurrentVersion(),
+		EventType:   enumsspb.WORKFLOW_LIFECYCLE_EVENT_TYPE_RESET,
+		BaseRunID:   baseRunID,
+		Reason:      reason,
+	})
+	return nil
+}
+
 func (r *TaskGeneratorImpl) GenerateActivityTimerTasks() error {
 	_, err := r.getTimerSequence().CreateNextActivityTimer()
 	return err
@@ -821,7 +865,10 @@ func (r *TaskGeneratorImpl) GenerateMigrationTasks() ([]tasks.Task, int64, error
 }
 
 func (r *TaskGeneratorImpl) getTimerSequence() TimerSequence {
-	return NewTimerSequence(r.mutableState)
+	return NewTimerSequenceWithUserTimerCoalescing(
+		r.mutableState,
+		r.config.UserTimerCoalescingWindow(r.mutableState.GetNamespaceEntry().Name().String()),
+	)
 }
 
 func (r *TaskGeneratorImpl) getTargetNamespaceID(
@@ -844,6 +891,12 @@ func (r *TaskGeneratorImpl) getTargetNamespaceID(
 	return namespace.ID(r.mutableState.GetExecutionInfo().NamespaceId), nil
 }
 
+// workflowLifecycleEventsEnabled returns true if the namespace publishes workflow lifecycle events to any sink.
+func (r *TaskGeneratorImpl) workflowLifecycleEventsEnabled() bool {
+	namespaceName := r.mutableState.GetNamespaceEntry().Name().String()
+	return len(r.config.WorkflowLifecycleEventSinks(namespaceName)) > 0
+}
+
 // archivalEnabled returns true if archival is enabled for either history or visibility.
 // For both history and visibility, we check that archival is enabled for both the cluster and the namespace.
 func (r *TaskGeneratorImpl) archivalEnabled() bool {
@@ -337,6 +337,20 @@ func (mr *MockTaskGeneratorMockRecorder) GenerateWorkflowCloseTasks(closedTime,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateWorkflowCloseTasks", reflect.TypeOf((*MockTaskGenerator)(nil).GenerateWorkflowCloseTasks), closedTime, deleteAfterClose)
 }
 
+// GenerateWorkflowResetLifecycleEventTask mocks base method.
+func (m *MockTaskGenerator) GenerateWorkflowResetLifecycleEventTask(baseRunID, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateWorkflowResetLifecycleEventTask", baseRunID, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GenerateWorkflowResetLifecycleEventTask indicates an expected call of GenerateWorkflowResetLifecycleEventTask.
+func (mr *MockTaskGeneratorMockRecorder) GenerateWorkflowResetLifecycleEventTask(baseRunID, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateWorkflowResetLifecycleEventTask", reflect.TypeOf((*MockTaskGenerator)(nil).GenerateWorkflowResetLifecycleEventTask), baseRunID, reason)
+}
+
 // GenerateWorkflowResetTasks mocks base method.
 func (m *MockTaskGenerator) GenerateWorkflowResetTasks() error {
 	m.ctrl.T.Helper()
@@ -66,17 +66,21 @@ type testParams struct {
 	DeleteAfterClose                     bool
 	CloseEventTime                       time.Time
 	Retention                            time.Duration
+	WorkflowTypeRetention                map[string]time.Duration
 	Logger                               *log.MockLogger
 	ArchivalProcessorArchiveDelay        time.Duration
 	HistoryArchivalEnabledInCluster      bool
 	HistoryArchivalEnabledInNamespace    bool
 	VisibilityArchivalEnabledForCluster  bool
 	VisibilityArchivalEnabledInNamespace bool
+	WorkflowLifecycleEventSinks          []string
 
 	ExpectCloseExecutionVisibilityTask              bool
+	ExpectWorkflowLifecycleEventTask                bool
 	ExpectArchiveExecutionTask                      bool
 	ExpectDeleteHistoryEventTask                    bool
 	ExpectedArchiveExecutionTaskVisibilityTimestamp time.Time
+	ExpectedRetention                               time.Duration
 }
 
 func TestTaskGeneratorImpl_GenerateWorkflowCloseTasks(t *testing.T) {
@@ -165,6 +169,31 @@ func TestTaskGeneratorImpl_GenerateWorkflowCloseTasks(t *testing.T) {
 				p.ExpectArchiveExecutionTask = false
 			},
 		},
+		{
+			Name: "retention overridden by workflow type",
+			ConfigFn: func(p *testParams) {
+				p.HistoryArchivalEnabledInCluster = false
+				p.VisibilityArchivalEnabledForCluster = false
+				p.WorkflowTypeRetention = map[string]time.Duration{
+					"test-workflow-type":  time.Hour,
+					"other-workflow-type": 48 * time.Hour,
+				}
+
+				p.ExpectCloseExecutionVisibilityTask = true
+				p.ExpectDeleteHistoryEventTask = true
+				p.ExpectedRetention = time.Hour
+			},
+		},
+		{
+			Name: "workflow lifecycle events enabled",
+			ConfigFn: func(p *testParams) {
+				p.WorkflowLifecycleEventSinks = []string{"kafka"}
+
+				p.ExpectCloseExecutionVisibilityTask = true
+				p.ExpectArchiveExecutionTask = true
+				p.ExpectWorkflowLifecycleEventTask = true
+			},
+		},
 		{
 			Name: "archival disabled in namespace",
 			ConfigFn: func(p *testParams) {
@@ -200,6 +229,9 @@ func TestTaskGeneratorImpl_GenerateWorkflowCloseTasks(t *testing.T) {
 				ExpectedArchiveExecutionTaskVisibilityTimestamp: now,
 			}
 			c.ConfigFn(&p)
+			if p.ExpectedRetention == 0 {
+				p.ExpectedRetention = p.Retention
+			}
 			namespaceRegistry := namespace.NewMockRegistry(ctrl)
 
 			namespaceConfig := &persistencespb.NamespaceConfig{
@@ -242,7 +274,8 @@ func TestTaskGeneratorImpl_GenerateWorkflowCloseTasks(t *testing.T) {
 			mutableState.EXPECT().GetCloseVersion().Return(int64(0), nil).AnyTimes()
 			mutableState.EXPECT().GetExecutionInfo().DoAndReturn(func() *persistencespb.WorkflowExecutionInfo {
 				return &persistencespb.WorkflowExecutionInfo{
-					NamespaceId: namespaceEntry.ID().String(),
+					NamespaceId:      namespaceEntry.ID().String(),
+					WorkflowTypeName: "test-workflow-type",
 				}
 			}).AnyTimes()
 			mutableState.EXPECT().GetWorkflowKey().Return(definition.NewWorkflowKey(
@@ -257,6 +290,8 @@ func TestTaskGeneratorImpl_GenerateWorkflowCloseTasks(t *testing.T) {
 				ArchivalProcessorArchiveDelay: func() time.Duration {
 					return p.ArchivalProcessorArchiveDelay
 				},
+				WorkflowTypeRetention:       dynamicconfig.GetTypedPropertyFnFilteredByNamespace(p.WorkflowTypeRetention),
+				WorkflowLifecycleEventSinks: dynamicconfig.GetTypedPropertyFnFilteredByNamespace(p.WorkflowLifecycleEventSinks),
 			}
 			closeTime := time.Unix(0, 0)
 			var allTasks []tasks.Task
@@ -285,6 +320,7 @@ func TestTaskGeneratorImpl_GenerateWorkflowCloseTasks(t *testing.T) {
 				deleteHistoryEventTask       *tasks.DeleteHistoryEventTask
 				closeExecutionVisibilityTask *tasks.CloseExecutionVisibilityTask
 				archiveExecutionTask         *tasks.ArchiveExecutionTask
+				lifecycleEventTask           *tasks.WorkflowLifecycleEventTask
 			)
 			for _, task := range allTasks {
 				switch t := task.(type) {
@@ -296,6 +332,8 @@ func TestTaskGeneratorImpl_GenerateWorkflowCloseTasks(t *testing.T) {
 					closeExecutionVisibilityTask = t
 				case *tasks.ArchiveExecutionTask:
 					archiveExecutionTask = t
+				case *tasks.WorkflowLifecycleEventTask:
+					lifecycleEventTask = t
 				}
 			}
 			require.NotNil(t, closeExecutionTask)
@@ -321,12 +359,19 @@ func TestTaskGeneratorImpl_GenerateWorkflowCloseTasks(t *testing.T) {
 				assert.Equal(t, deleteHistoryEventTask.NamespaceID, namespaceEntry.ID().String())
 				assert.Equal(t, deleteHistoryEventTask.WorkflowID, tests.WorkflowID)
 				assert.Equal(t, deleteHistoryEventTask.RunID, tests.RunID)
-				assert.GreaterOrEqual(t, deleteHistoryEventTask.VisibilityTimestamp, closeTime.Add(p.Retention))
+				assert.GreaterOrEqual(t, deleteHistoryEventTask.VisibilityTimestamp, closeTime.Add(p.ExpectedRetention))
 				assert.LessOrEqual(t, deleteHistoryEventTask.VisibilityTimestamp,
-					closeTime.Add(p.Retention).Add(retentionTimerDelay*2))
+					closeTime.Add(p.ExpectedRetention).Add(retentionTimerDelay*2))
 			} else {
 				assert.Nil(t, deleteHistoryEventTask)
 			}
+			if p.ExpectWorkflowLifecycleEventTask {
+				require.NotNil(t, lifecycleEventTask)
+				assert.Equal(t, enumsspb.WORKFLOW_LIFECYCLE_EVENT_TYPE_CLOSED, lifecycleEventTask.EventType)
+				assert.Equal(t, tests.RunID, lifecycleEventTask.RunID)
+			} else {
+				assert.Nil(t, lifecycleEventTask)
+			}
 		})
 	}
 }
@@ -537,6 +582,7 @@ func TestTaskGenerator_GenerateWorkflowStartTasks(t *testing.T) {
 		name string
 
 		executionTimerEnabled bool
+		lifecycleEventSinks   []string
 
 		isFirstRun                   bool
 		existingExecutionTimerStatus int32
@@ -633,6 +679,19 @@ func TestTaskGenerator_GenerateWorkflowStartTasks(t *testing.T) {
 				enumsspb.TASK_TYPE_WORKFLOW_RUN_TIMEOUT,
 			},
 		},
+		{
+			name:                         "workflow lifecycle events enabled",
+			executionTimerEnabled:        true,
+			lifecycleEventSinks:          []string{"webhook"},
+			isFirstRun:                   true,
+			existingExecutionTimerStatus: TimerTaskStatusNone,
+			executionExpirationTime:      time.Time{},
+			runExpirationTime:            time.Time{},
+			expectedExecutionTimerStatus: TimerTaskStatusNone,
+			expectedTaskTypes: []enumsspb.TaskType{
+				enumsspb.TASK_TYPE_WORKFLOW_LIFECYCLE_EVENT,
+			},
+		},
 		{
 			name:                         "execution timer enabled, no execution expiration",
 			executionTimerEnabled:        true,
@@ -655,6 +714,7 @@ func TestTaskGenerator_GenerateWorkflowStartTasks(t *testing.T) {
 			if !tc.executionTimerEnabled {
 				config.EnableWorkflowExecutionTimeoutTimer = dynamicconfig.GetBoolPropertyFn(false)
 			}
+			config.WorkflowLifecycleEventSinks = dynamicconfig.GetTypedPropertyFnFilteredByNamespace(tc.lifecycleEventSinks)
 
 			mockShard := shard.NewTestContext(
 				controller,
@@ -667,6 +727,7 @@ func TestTaskGenerator_GenerateWorkflowStartTasks(t *testing.T) {
 
 			mockMutableState := NewMockMutableState(controller)
 			mockMutableState.EXPECT().IsWorkflowExecutionRunning().Return(true).AnyTimes()
+			mockMutableState.EXPECT().GetNamespaceEntry().Return(tests.GlobalNamespaceEntry).AnyTimes()
 
 			firstRunID := uuid.New()
 			currentRunID := firstRunID
@@ -467,7 +467,10 @@ func (r *TaskRefresherImpl) refreshTasksForTimer(
 		return nil
 	}
 
-	_, err := NewTimerSequence(mutableState).CreateNextUserTimer()
+	_, err := NewTimerSequenceWithUserTimerCoalescing(
+		mutableState,
+		r.shard.GetConfig().UserTimerCoalescingWindow(mutableState.GetNamespaceEntry().Name().String()),
+	).CreateNextUserTimer()
 	return err
 }
 
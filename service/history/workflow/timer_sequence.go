@@ -77,6 +77,9 @@ type (
 
 	timerSequenceImpl struct {
 		mutableState MutableState
+		// userTimerCoalescingWindow is the window within which user timers
+		// firing after the next one share its timer task
+		userTimerCoalescingWindow time.Duration
 	}
 )
 
@@ -90,6 +93,19 @@ func NewTimerSequence(
 	}
 }
 
+// NewTimerSequenceWithUserTimerCoalescing creates a timer sequence whose user timer task also fires
+// the user timers expiring within the given window after the next user timer. The task is delayed
+// to the last of them, so that they are all fired together into a single workflow task.
+func NewTimerSequenceWithUserTimerCoalescing(
+	mutableState MutableState,
+	userTimerCoalescingWindow time.Duration,
+) *timerSequenceImpl {
+	return &timerSequenceImpl{
+		mutableState:              mutableState,
+		userTimerCoalescingWindow: userTimerCoalescingWindow,
+	}
+}
+
 func (t *timerSequenceImpl) CreateNextUserTimer() (bool, error) {
 
 	sequenceIDs := t.LoadAndSortUserTimers()
@@ -110,25 +126,49 @@ func (t *timerSequenceImpl) CreateNextUserTimer() (bool, error) {
 		return false, nil
 	}
 
-	timerInfo, ok := t.mutableState.GetUserTimerInfoByEventID(firstTimerTask.EventID)
-	if !ok {
-		return false, serviceerror.NewInternal(fmt.Sprintf("unable to load timer info %v", firstTimerTask.EventID))
+	coalescedTimers := sequenceIDs
+	if t.userTimerCoalescingWindow <= 0 {
+		coalescedTimers = sequenceIDs[:1]
 	}
-	// mark timer task mask as indication that timer task is generated
-	// here TaskID is misleading attr, should be called timer created flag or something
-	timerInfo.TaskStatus = TimerTaskStatusCreated
-	if err := t.mutableState.UpdateUserTimerTaskStatus(timerInfo.TimerId, TimerTaskStatusCreated); err != nil {
-		return false, err
+	visibilityTimestamp := firstTimerTask.Timestamp
+	for _, timerTask := range coalescedTimers {
+		if timerTask.Timestamp.Sub(firstTimerTask.Timestamp) > t.userTimerCoalescingWindow {
+			break
+		}
+		if !workflowRunExpirationTime.IsZero() && timerTask.Timestamp.After(workflowRunExpirationTime) {
+			break
+		}
+		if err := t.markUserTimerCreated(timerTask); err != nil {
+			return false, err
+		}
+		visibilityTimestamp = timerTask.Timestamp
 	}
+
 	t.mutableState.AddTasks(&tasks.UserTimerTask{
 		// TaskID is set by shard
 		WorkflowKey:         t.mutableState.GetWorkflowKey(),
-		VisibilityTimestamp: firstTimerTask.Timestamp,
+		VisibilityTimestamp: visibilityTimestamp,
 		EventID:             firstTimerTask.EventID,
 	})
 	return true, nil
 }
 
+func (t *timerSequenceImpl) markUserTimerCreated(
+	timerTask TimerSequenceID,
+) error {
+	if timerTask.TimerCreated {
+		return nil
+	}
+	timerInfo, ok := t.mutableState.GetUserTimerInfoByEventID(timerTask.EventID)
+	if !ok {
+		return serviceerror.NewInternal(fmt.Sprintf("unable to load timer info %v", timerTask.EventID))
+	}
+	// mark timer task mask as indication that timer task is generated
+	// here TaskID is misleading attr, should be called timer created flag or something
+	timerInfo.TaskStatus = TimerTaskStatusCreated
+	return t.mutableState.UpdateUserTimerTaskStatus(timerInfo.TimerId, TimerTaskStatusCreated)
+}
+
 func (t *timerSequenceImpl) CreateNextActivityTimer() (bool, error) {
 
 	sequenceIDs := t.LoadAndSortActivityTimers()
@@ -234,6 +234,57 @@ func (s *timerSequenceSuite) TestCreateNextUserTimer_NotCreated_NoWorkflowExpiry
 	s.True(modified)
 }
 
+func (s *timerSequenceSuite) TestCreateNextUserTimer_NotCreated_Coalesced() {
+	now := time.Now().UTC()
+	timerInfo1 := &persistencespb.TimerInfo{
+		Version:        123,
+		TimerId:        "some random timer ID",
+		StartedEventId: 456,
+		ExpiryTime:     timestamppb.New(now.Add(100 * time.Millisecond)),
+		TaskStatus:     TimerTaskStatusNone,
+	}
+	timerInfo2 := &persistencespb.TimerInfo{
+		Version:        123,
+		TimerId:        "other random timer ID",
+		StartedEventId: 567,
+		ExpiryTime:     timestamppb.New(now.Add(900 * time.Millisecond)),
+		TaskStatus:     TimerTaskStatusNone,
+	}
+	timerInfo3 := &persistencespb.TimerInfo{
+		Version:        123,
+		TimerId:        "late random timer ID",
+		StartedEventId: 678,
+		ExpiryTime:     timestamppb.New(now.Add(2 * time.Second)),
+		TaskStatus:     TimerTaskStatusNone,
+	}
+	timerInfos := map[string]*persistencespb.TimerInfo{
+		timerInfo1.TimerId: timerInfo1,
+		timerInfo2.TimerId: timerInfo2,
+		timerInfo3.TimerId: timerInfo3,
+	}
+	s.mockMutableState.EXPECT().GetPendingTimerInfos().Return(timerInfos)
+	s.mockMutableState.EXPECT().GetUserTimerInfoByEventID(timerInfo1.StartedEventId).Return(timerInfo1, true)
+	s.mockMutableState.EXPECT().GetUserTimerInfoByEventID(timerInfo2.StartedEventId).Return(timerInfo2, true)
+	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{
+		WorkflowRunExpirationTime: nil,
+	})
+
+	s.mockMutableState.EXPECT().UpdateUserTimerTaskStatus(timerInfo1.TimerId, int64(TimerTaskStatusCreated)).Return(nil)
+	s.mockMutableState.EXPECT().UpdateUserTimerTaskStatus(timerInfo2.TimerId, int64(TimerTaskStatusCreated)).Return(nil)
+	s.mockMutableState.EXPECT().AddTasks(&tasks.UserTimerTask{
+		// TaskID is set by shard
+		WorkflowKey:         s.workflowKey,
+		VisibilityTimestamp: timerInfo2.ExpiryTime.AsTime(),
+		EventID:             timerInfo1.GetStartedEventId(),
+	})
+
+	timerSequence := NewTimerSequenceWithUserTimerCoalescing(s.mockMutableState, time.Second)
+	modified, err := timerSequence.CreateNextUserTimer()
+	s.NoError(err)
+	s.True(modified)
+	s.Equal(int64(TimerTaskStatusNone), timerInfo3.TaskStatus)
+}
+
 func (s *timerSequenceSuite) TestCreateNextActivityTimer_AlreadyCreated_AfterWorkflowExpiry() {
 	now := time.Now().UTC()
 	activityInfo := &persistencespb.ActivityInfo{
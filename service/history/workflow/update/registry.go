@@ -112,6 +112,7 @@ type (
 
 		instrumentation instrumentation
 		maxInFlight     func() int
+		maxBuffered     func() int
 		maxTotal        func() int
 		completedCount  int
 		failoverVersion int64
@@ -130,6 +131,14 @@ func WithInFlightLimit(f func() int) Option {
 	}
 }
 
+// WithBufferedLimit provides an optional limit to the number of Updates that
+// a Registry instance will hold before they are sent to a worker.
+func WithBufferedLimit(f func() int) Option {
+	return func(r *registry) {
+		r.maxBuffered = f
+	}
+}
+
 // WithTotalLimit provides an optional limit to the total number of Updates for workflow run.
 func WithTotalLimit(f func() int) Option {
 	return func(r *registry) {
@@ -168,6 +177,7 @@ func NewRegistry(
 		store:           store,
 		instrumentation: noopInstrumentation,
 		maxInFlight:     func() int { return math.MaxInt },
+		maxBuffered:     func() int { return math.MaxInt },
 		maxTotal:        func() int { return math.MaxInt },
 		failoverVersion: store.GetCurrentVersion(),
 	}
@@ -382,18 +392,43 @@ func (r *registry) remover(id string) updateOpt {
 	)
 }
 
+// checkLimits rejects a new Update if the workflow already has too many in-flight or buffered Updates. The errors
+// have the BUSY_WORKFLOW cause, which tells clients that this particular workflow, and not the namespace, is
+// saturated and they should back off before retrying.
 func (r *registry) checkLimits() error {
-	if len(r.updates) >= r.maxInFlight() {
+	inFlight, buffered := len(r.updates), r.bufferedCount()
+	r.instrumentation.updateRegistryCounts(inFlight, buffered)
+
+	if inFlight >= r.maxInFlight() {
 		r.instrumentation.countRateLimited()
 		return &serviceerror.ResourceExhausted{
-			Cause:   enumspb.RESOURCE_EXHAUSTED_CAUSE_CONCURRENT_LIMIT,
+			Cause:   enumspb.RESOURCE_EXHAUSTED_CAUSE_BUSY_WORKFLOW,
 			Scope:   enumspb.RESOURCE_EXHAUSTED_SCOPE_NAMESPACE,
 			Message: fmt.Sprintf("limit on number of concurrent in-flight updates has been reached (%v)", r.maxInFlight()),
 		}
 	}
+	if buffered >= r.maxBuffered() {
+		r.instrumentation.countBufferFull()
+		return &serviceerror.ResourceExhausted{
+			Cause:   enumspb.RESOURCE_EXHAUSTED_CAUSE_BUSY_WORKFLOW,
+			Scope:   enumspb.RESOURCE_EXHAUSTED_SCOPE_NAMESPACE,
+			Message: fmt.Sprintf("limit on number of updates waiting to be sent to a worker has been reached (%v)", r.maxBuffered()),
+		}
+	}
 	return r.checkTotalLimit()
 }
 
+// bufferedCount returns the number of Updates which haven't been sent to a worker yet.
+func (r *registry) bufferedCount() int {
+	var count int
+	for _, upd := range r.updates {
+		if upd.isBuffered() {
+			count++
+		}
+	}
+	return count
+}
+
 func (r *registry) checkTotalLimit() error {
 	if len(r.updates)+r.completedCount >= r.maxTotal() {
 		r.instrumentation.countTooMany()
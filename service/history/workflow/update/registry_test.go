@@ -271,6 +271,7 @@ func TestFindOrCreate(t *testing.T) {
 			_, _, err = reg.FindOrCreate(context.Background(), tv.UpdateID("2"))
 			var resExh *serviceerror.ResourceExhausted
 			require.ErrorAs(t, err, &resExh, "creating update #2 should be denied")
+			require.Equal(t, enumspb.RESOURCE_EXHAUSTED_CAUSE_BUSY_WORKFLOW, resExh.Cause)
 			require.Equal(t, 1, reg.Len())
 		})
 
@@ -316,6 +317,35 @@ func TestFindOrCreate(t *testing.T) {
 		})
 	})
 
+	t.Run("enforce buffered update limit", func(t *testing.T) {
+		var (
+			reg = update.NewRegistry(
+				emptyUpdateStore,
+				update.WithBufferedLimit(
+					func() int { return 1 },
+				),
+			)
+			evStore = mockEventStore{Controller: effect.Immediate(context.Background())}
+		)
+
+		upd1, _, err := reg.FindOrCreate(context.Background(), tv.UpdateID("1"))
+		require.NoError(t, err, "creating update #1 should have beeen allowed")
+		mustAdmit(t, evStore, upd1)
+
+		_, _, err = reg.FindOrCreate(context.Background(), tv.UpdateID("2"))
+		var resExh *serviceerror.ResourceExhausted
+		require.ErrorAs(t, err, &resExh, "creating update #2 should be denied while update #1 is buffered")
+		require.Equal(t, enumspb.RESOURCE_EXHAUSTED_CAUSE_BUSY_WORKFLOW, resExh.Cause)
+		require.Equal(t, 1, reg.Len())
+
+		require.NotNil(t, send(t, upd1, includeAlreadySent), "update should be sent")
+
+		_, existed, err := reg.FindOrCreate(context.Background(), tv.UpdateID("2"))
+		require.NoError(t, err, "update #2 should be created after #1 was sent")
+		require.False(t, existed)
+		require.Equal(t, 2, reg.Len())
+	})
+
 	t.Run("enforce total update limit", func(t *testing.T) {
 		var limit = 1
 
@@ -467,6 +467,11 @@ func (u *Update) isSent() bool {
 	return u.state.Matches(stateSet(stateSent))
 }
 
+// isBuffered returns true if the Update is held by the server and wasn't sent to a worker yet.
+func (u *Update) isBuffered() bool {
+	return u.state.Matches(stateSet(stateCreated | stateProvisionallyAdmitted | stateAdmitted))
+}
+
 // outgoingMessageID returns the ID of the message that is used to Send the Update to the worker.
 func (u *Update) outgoingMessageID() string {
 	return u.id + "/request"
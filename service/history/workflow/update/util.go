@@ -81,6 +81,10 @@ func (i *instrumentation) countRateLimited() {
 	i.oneOf(metrics.WorkflowExecutionUpdateRequestRateLimited.Name())
 }
 
+func (i *instrumentation) countBufferFull() {
+	i.oneOf(metrics.WorkflowExecutionUpdateBufferFull.Name())
+}
+
 func (i *instrumentation) countTooMany() {
 	i.oneOf(metrics.WorkflowExecutionUpdateTooMany.Name())
 }
@@ -109,6 +113,11 @@ func (i *instrumentation) updateRegistrySize(size int) {
 	i.metrics.Histogram(metrics.WorkflowExecutionUpdateRegistrySize.Name(), metrics.Bytes).Record(int64(size))
 }
 
+func (i *instrumentation) updateRegistryCounts(inFlight, buffered int) {
+	i.metrics.Histogram(metrics.WorkflowExecutionUpdateInFlightCount.Name(), metrics.Dimensionless).Record(int64(inFlight))
+	i.metrics.Histogram(metrics.WorkflowExecutionUpdateBufferedCount.Name(), metrics.Dimensionless).Record(int64(buffered))
+}
+
 func (i *instrumentation) oneOf(counterName string) {
 	i.metrics.Counter(counterName).Record(1)
 }
@@ -0,0 +1,67 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package workflow
+
+import (
+	"slices"
+
+	sdkpb "go.temporal.io/api/sdk/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+// WorkflowMetadataQueryResultID is the ID under which a worker reports the workflow metadata, i.e. the answer to the
+// __temporal_workflow_metadata query, when completing a workflow task without being asked for it. The server records
+// the signal and update handlers it lists, see NewWorkflowHandlers.
+const WorkflowMetadataQueryResultID = "__temporal_workflow_metadata"
+
+// NewWorkflowHandlers extracts the signal and update handlers of a workflow from its metadata.
+func NewWorkflowHandlers(metadata *sdkpb.WorkflowMetadata) *persistencespb.WorkflowHandlers {
+	handlers := &persistencespb.WorkflowHandlers{}
+	for _, def := range metadata.GetDefinition().GetSignalDefinitions() {
+		if def.GetName() == "" {
+			handlers.DynamicSignalHandler = true
+		} else {
+			handlers.SignalNames = append(handlers.SignalNames, def.GetName())
+		}
+	}
+	for _, def := range metadata.GetDefinition().GetUpdateDefinitions() {
+		if def.GetName() == "" {
+			handlers.DynamicUpdateHandler = true
+		} else {
+			handlers.UpdateNames = append(handlers.UpdateNames, def.GetName())
+		}
+	}
+	return handlers
+}
+
+// HasSignalHandler returns false only if the workflow is known to have no handler for the signal.
+func HasSignalHandler(handlers *persistencespb.WorkflowHandlers, signalName string) bool {
+	return handlers == nil || handlers.GetDynamicSignalHandler() || slices.Contains(handlers.GetSignalNames(), signalName)
+}
+
+// HasUpdateHandler returns false only if the workflow is known to have no handler for the update.
+func HasUpdateHandler(handlers *persistencespb.WorkflowHandlers, updateName string) bool {
+	return handlers == nil || handlers.GetDynamicUpdateHandler() || slices.Contains(handlers.GetUpdateNames(), updateName)
+}
@@ -64,6 +64,13 @@ type (
 const (
 	workflowTaskRetryBackoffMinAttempts = 3
 	workflowTaskRetryInitialInterval    = 5 * time.Second
+
+	workflowTaskFailedCausePrefix     = "WorkflowTaskFailedCause"
+	workflowTaskTimedOutFailureCause  = "WorkflowTaskTimedOut"
+	reportedProblemWorkflowTaskFailed = "category=WorkflowTaskFailed"
+	reportedProblemCausePrefix        = "cause="
+
+	reportedProblemChainLengthLimitReached = "category=ChainLengthLimitReached"
 )
 
 func newWorkflowTaskStateMachine(
@@ -820,6 +827,17 @@ func (m *workflowTaskStateMachine) AddWorkflowTaskFailedEvent(
 	// Attempt counter was incremented directly in mutable state. Current WT attempt counter needs to be updated.
 	workflowTask.Attempt = m.ms.GetExecutionInfo().GetWorkflowTaskAttempt()
 
+	switch cause {
+	case enumspb.WORKFLOW_TASK_FAILED_CAUSE_RESET_WORKFLOW,
+		enumspb.WORKFLOW_TASK_FAILED_CAUSE_FAILOVER_CLOSE_COMMAND,
+		enumspb.WORKFLOW_TASK_FAILED_CAUSE_UNHANDLED_COMMAND:
+		// not a problem with the workflow
+	default:
+		if err := m.recordWorkflowTaskFailure(workflowTaskFailedCausePrefix + cause.String()); err != nil {
+			return nil, err
+		}
+	}
+
 	return event, nil
 }
 
@@ -865,9 +883,59 @@ func (m *workflowTaskStateMachine) AddWorkflowTaskTimedOutEvent(
 	if err := m.ApplyWorkflowTaskTimedOutEvent(enumspb.TIMEOUT_TYPE_START_TO_CLOSE); err != nil {
 		return nil, err
 	}
+	if err := m.recordWorkflowTaskFailure(workflowTaskTimedOutFailureCause); err != nil {
+		return nil, err
+	}
 	return event, nil
 }
 
+// recordWorkflowTaskFailure counts consecutive workflow task failures with the same cause, and reports the execution
+// as stuck in visibility once they cross the thresholds of the namespace.
+func (m *workflowTaskStateMachine) recordWorkflowTaskFailure(
+	cause string,
+) error {
+	executionInfo := m.ms.executionInfo
+	now := m.ms.timeSource.Now().UTC()
+	if executionInfo.WorkflowTaskLastFailureCause != cause {
+		executionInfo.WorkflowTaskLastFailureCause = cause
+		executionInfo.WorkflowTaskSameFailureCount = 0
+		executionInfo.WorkflowTaskFirstSameFailureTime = timestamppb.New(now)
+	}
+	executionInfo.WorkflowTaskSameFailureCount++
+
+	namespaceName := m.ms.GetNamespaceEntry().Name().String()
+	config := m.ms.shard.GetConfig()
+	if !common.IsWorkflowTaskStuck(
+		executionInfo,
+		now,
+		config.StuckWorkflowTaskFailureThreshold(namespaceName),
+		config.StuckWorkflowTaskMinDuration(namespaceName),
+	) {
+		return nil
+	}
+	updated, err := m.ms.updateReportedProblemsSearchAttribute([]string{
+		reportedProblemWorkflowTaskFailed,
+		reportedProblemCausePrefix + cause,
+	})
+	if updated {
+		metrics.StuckWorkflowTaskReported.With(m.metricsHandler).Record(1, metrics.NamespaceTag(namespaceName))
+	}
+	return err
+}
+
+// clearWorkflowTaskFailures resets the count of consecutive workflow task failures after a workflow task completed.
+func (m *workflowTaskStateMachine) clearWorkflowTaskFailures() error {
+	executionInfo := m.ms.executionInfo
+	if executionInfo.WorkflowTaskLastFailureCause == "" {
+		return nil
+	}
+	executionInfo.WorkflowTaskLastFailureCause = ""
+	executionInfo.WorkflowTaskSameFailureCount = 0
+	executionInfo.WorkflowTaskFirstSameFailureTime = nil
+	_, err := m.ms.updateReportedProblemsSearchAttribute(nil)
+	return err
+}
+
 func (m *workflowTaskStateMachine) failWorkflowTask(
 	incrementAttempt bool,
 ) {
@@ -1100,6 +1168,9 @@ func (m *workflowTaskStateMachine) afterAddWorkflowTaskCompletedEvent(
 ) error {
 	attrs := event.GetWorkflowTaskCompletedEventAttributes()
 	m.ms.executionInfo.LastCompletedWorkflowTaskStartedEventId = attrs.GetStartedEventId()
+	if err := m.clearWorkflowTaskFailures(); err != nil {
+		return err
+	}
 	m.ms.executionInfo.MostRecentWorkerVersionStamp = attrs.GetWorkerVersion()
 
 	wftDeployment := attrs.GetDeployment()
@@ -0,0 +1,190 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	ctasks "go.temporal.io/server/common/tasks"
+	"go.temporal.io/server/service/history/configs"
+	"go.temporal.io/server/service/history/lifecycleevent"
+	"go.temporal.io/server/service/history/queues"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tasks"
+	wcache "go.temporal.io/server/service/history/workflow/cache"
+	"go.uber.org/fx"
+)
+
+const (
+	// workflowLifecycleEventQueuePersistenceMaxRPSRatio is the hard-coded ratio of workflow lifecycle event queue
+	// persistence max RPS to the total persistence max RPS.
+	workflowLifecycleEventQueuePersistenceMaxRPSRatio = 0.15
+)
+
+var (
+	// WorkflowLifecycleEventTaskPriorities is the map of task priority to weight for the workflow lifecycle event
+	// queue. The queue only uses the low task priority, so we only define a weight for that priority.
+	WorkflowLifecycleEventTaskPriorities = configs.ConvertWeightsToDynamicConfigValue(map[ctasks.Priority]int{
+		ctasks.PriorityLow: 10,
+	})
+)
+
+type (
+	workflowLifecycleEventQueueFactoryParams struct {
+		fx.In
+
+		QueueFactoryBaseParams
+		Publisher *lifecycleevent.Publisher
+	}
+
+	// workflowLifecycleEventQueueFactory implements QueueFactory for the workflow lifecycle event queue.
+	workflowLifecycleEventQueueFactory struct {
+		QueueFactoryBase
+		workflowLifecycleEventQueueFactoryParams
+	}
+)
+
+// NewWorkflowLifecycleEventQueueFactory creates a new QueueFactory to construct workflow lifecycle event queues.
+func NewWorkflowLifecycleEventQueueFactory(
+	params workflowLifecycleEventQueueFactoryParams,
+) QueueFactory {
+	return &workflowLifecycleEventQueueFactory{
+		workflowLifecycleEventQueueFactoryParams: params,
+		QueueFactoryBase: QueueFactoryBase{
+			HostScheduler: queues.NewScheduler(
+				params.ClusterMetadata.GetCurrentClusterName(),
+				queues.SchedulerOptions{
+					WorkerCount:                    params.Config.WorkflowLifecycleEventProcessorSchedulerWorkerCount,
+					ActiveNamespaceWeights:         dynamicconfig.GetMapPropertyFnFilteredByNamespace(WorkflowLifecycleEventTaskPriorities),
+					StandbyNamespaceWeights:        dynamicconfig.GetMapPropertyFnFilteredByNamespace(WorkflowLifecycleEventTaskPriorities),
+					InactiveNamespaceDeletionDelay: params.Config.TaskSchedulerInactiveChannelDeletionDelay,
+				},
+				params.NamespaceRegistry,
+				params.Logger,
+			),
+			HostPriorityAssigner: queues.NewPriorityAssigner(),
+			HostReaderRateLimiter: queues.NewReaderPriorityRateLimiter(
+				NewHostRateLimiterRateFn(
+					params.Config.WorkflowLifecycleEventProcessorMaxPollHostRPS,
+					params.Config.PersistenceMaxQPS,
+					workflowLifecycleEventQueuePersistenceMaxRPSRatio,
+				),
+				int64(params.Config.WorkflowLifecycleEventQueueMaxReaderCount()),
+			),
+		},
+	}
+}
+
+// CreateQueue creates a new workflow lifecycle event queue for the given shard.
+func (f *workflowLifecycleEventQueueFactory) CreateQueue(
+	shard shard.Context,
+	workflowCache wcache.Cache,
+) queues.Queue {
+	logger := log.With(shard.GetLogger(), tag.ComponentLifecycleEventQueue)
+	metricsHandler := f.MetricsHandler.WithTags(metrics.OperationTag(metrics.OperationWorkflowLifecycleEventQueueProcessorScope))
+
+	executor := NewWorkflowLifecycleEventQueueTaskExecutor(
+		f.Publisher,
+		shard,
+		workflowCache,
+		f.MetricsHandler,
+		logger,
+	)
+	if f.ExecutorWrapper != nil {
+		executor = f.ExecutorWrapper.Wrap(executor)
+	}
+
+	var shardScheduler = f.HostScheduler
+	if f.Config.TaskSchedulerEnableRateLimiter() {
+		shardScheduler = queues.NewRateLimitedScheduler(
+			f.HostScheduler,
+			queues.RateLimitedSchedulerOptions{
+				EnableShadowMode: f.Config.TaskSchedulerEnableRateLimiterShadowMode,
+				StartupDelay:     f.Config.TaskSchedulerRateLimiterStartupDelay,
+			},
+			f.ClusterMetadata.GetCurrentClusterName(),
+			f.NamespaceRegistry,
+			f.SchedulerRateLimiter,
+			f.TimeSource,
+			logger,
+			metricsHandler,
+		)
+	}
+
+	rescheduler := queues.NewRescheduler(
+		shardScheduler,
+		shard.GetTimeSource(),
+		logger,
+		metricsHandler,
+	)
+
+	factory := queues.NewExecutableFactory(
+		executor,
+		shardScheduler,
+		rescheduler,
+		f.HostPriorityAssigner,
+		shard.GetTimeSource(),
+		shard.GetNamespaceRegistry(),
+		shard.GetClusterMetadata(),
+		logger,
+		metricsHandler,
+		f.DLQWriter,
+		f.Config.TaskDLQEnabled,
+		f.Config.TaskDLQUnexpectedErrorAttempts,
+		f.Config.TaskDLQInternalErrors,
+		f.Config.TaskDLQErrorPattern,
+		f.executableTracingOption(shard.GetShardID()),
+	)
+	return queues.NewImmediateQueue(
+		shard,
+		tasks.CategoryWorkflowLifecycleEvent,
+		shardScheduler,
+		rescheduler,
+		&queues.Options{
+			ReaderOptions: queues.ReaderOptions{
+				BatchSize:            f.Config.WorkflowLifecycleEventTaskBatchSize,
+				MaxPendingTasksCount: f.Config.QueuePendingTaskMaxCount,
+				PollBackoffInterval:  f.Config.WorkflowLifecycleEventProcessorPollBackoffInterval,
+				MaxPredicateSize:     f.Config.QueueMaxPredicateSize,
+			},
+			MonitorOptions: queues.MonitorOptions{
+				PendingTasksCriticalCount:   f.Config.QueuePendingTaskCriticalCount,
+				ReaderStuckCriticalAttempts: f.Config.QueueReaderStuckCriticalAttempts,
+				SliceCountCriticalThreshold: f.Config.QueueCriticalSlicesCount,
+			},
+			MaxPollRPS:                          f.Config.WorkflowLifecycleEventProcessorMaxPollRPS,
+			MaxPollInterval:                     f.Config.WorkflowLifecycleEventProcessorMaxPollInterval,
+			MaxPollIntervalJitterCoefficient:    f.Config.WorkflowLifecycleEventProcessorMaxPollIntervalJitterCoefficient,
+			CheckpointInterval:                  f.Config.WorkflowLifecycleEventProcessorUpdateAckInterval,
+			CheckpointIntervalJitterCoefficient: f.Config.WorkflowLifecycleEventProcessorUpdateAckIntervalJitterCoefficient,
+			MaxReaderCount:                      f.Config.WorkflowLifecycleEventQueueMaxReaderCount,
+		},
+		f.HostReaderRateLimiter,
+		queues.GrouperNamespaceID{},
+		logger,
+		metricsHandler,
+		factory,
+	)
+}
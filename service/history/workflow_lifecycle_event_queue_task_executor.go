@@ -0,0 +1,190 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"fmt"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/service/history/lifecycleevent"
+	"go.temporal.io/server/service/history/queues"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tasks"
+	"go.temporal.io/server/service/history/workflow/cache"
+)
+
+// NewWorkflowLifecycleEventQueueTaskExecutor creates a new queue task executor for the workflow lifecycle event queue.
+func NewWorkflowLifecycleEventQueueTaskExecutor(
+	publisher *lifecycleevent.Publisher,
+	shardContext shard.Context,
+	workflowCache cache.Cache,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) queues.Executor {
+	return &workflowLifecycleEventQueueTaskExecutor{
+		publisher:      publisher,
+		shardContext:   shardContext,
+		workflowCache:  workflowCache,
+		metricsHandler: metricsHandler,
+		logger:         logger,
+	}
+}
+
+// workflowLifecycleEventQueueTaskExecutor is an implementation of queues.Executor for the workflow lifecycle event
+// queue. Events are published by the cluster the namespace is active in only, and the task is retried until every
+// sink of the namespace has accepted the event.
+type workflowLifecycleEventQueueTaskExecutor struct {
+	publisher      *lifecycleevent.Publisher
+	shardContext   shard.Context
+	workflowCache  cache.Cache
+	metricsHandler metrics.Handler
+	logger         log.Logger
+}
+
+// Execute executes a task from the workflow lifecycle event queue.
+func (e *workflowLifecycleEventQueueTaskExecutor) Execute(
+	ctx context.Context,
+	executable queues.Executable,
+) queues.ExecuteResponse {
+	task := executable.GetTask()
+	taskType := queues.GetWorkflowLifecycleEventTaskTypeTagValue(task)
+	tags := []metrics.Tag{
+		getNamespaceTagByID(e.shardContext.GetNamespaceRegistry(), task.GetNamespaceID()),
+		metrics.TaskTypeTag(taskType),
+		// OperationTag is for consistency on tags with other executors,
+		// since those tags will be used to emit a common set of metrics.
+		metrics.OperationTag(taskType),
+	}
+
+	var err error
+	switch task := task.(type) {
+	case *tasks.WorkflowLifecycleEventTask:
+		err = e.processWorkflowLifecycleEventTask(ctx, task)
+	default:
+		err = fmt.Errorf("task with invalid type sent to workflow lifecycle event queue: %+v", task)
+	}
+	return queues.ExecuteResponse{
+		ExecutionMetricTags: tags,
+		ExecutedAsActive:    true,
+		ExecutionErr:        err,
+	}
+}
+
+// processWorkflowLifecycleEventTask builds the event from the mutable state of the run and, after releasing the
+// workflow lock, publishes it to the sinks of the namespace.
+func (e *workflowLifecycleEventQueueTaskExecutor) processWorkflowLifecycleEventTask(
+	ctx context.Context,
+	task *tasks.WorkflowLifecycleEventTask,
+) error {
+	namespaceEntry, err := e.shardContext.GetNamespaceRegistry().GetNamespaceByID(namespace.ID(task.NamespaceID))
+	if err != nil {
+		return err
+	}
+	sinks := e.shardContext.GetConfig().WorkflowLifecycleEventSinks(namespaceEntry.Name().String())
+	if len(sinks) == 0 {
+		return nil
+	}
+	if !namespaceEntry.ActiveInCluster(e.shardContext.GetClusterMetadata().GetCurrentClusterName()) {
+		return nil
+	}
+
+	event, err := e.getEvent(ctx, namespaceEntry, task)
+	if err != nil || event == nil {
+		return err
+	}
+	return e.publisher.Publish(ctx, sinks, event)
+}
+
+// getEvent returns the lifecycle event recorded by task, or nil if the task should be dropped.
+func (e *workflowLifecycleEventQueueTaskExecutor) getEvent(
+	ctx context.Context,
+	namespaceEntry *namespace.Namespace,
+	task *tasks.WorkflowLifecycleEventTask,
+) (_ *lifecycleevent.Event, retError error) {
+	logger := log.With(e.logger, tag.Task(task))
+	eventType, err := lifecycleevent.EventTypeFromProto(task.EventType)
+	if err != nil {
+		return nil, err
+	}
+
+	weContext, release, err := getWorkflowExecutionContextForTask(ctx, e.shardContext, e.workflowCache, task)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := weContext.LoadMutableState(ctx, e.shardContext)
+	if err != nil {
+		return nil, err
+	}
+	if mutableState == nil {
+		logger.Warn("Dropping workflow lifecycle event task because mutable state is nil.")
+		return nil, nil
+	}
+
+	executionInfo := mutableState.GetExecutionInfo()
+	executionState := mutableState.GetExecutionState()
+	event := &lifecycleevent.Event{
+		ID:           lifecycleevent.EventID(task.RunID, eventType),
+		Type:         eventType,
+		Time:         task.GetVisibilityTime(),
+		Namespace:    namespaceEntry.Name().String(),
+		NamespaceID:  task.NamespaceID,
+		WorkflowID:   task.WorkflowID,
+		RunID:        task.RunID,
+		WorkflowType: executionInfo.GetWorkflowTypeName(),
+		TaskQueue:    executionInfo.GetTaskQueue(),
+		Status:       enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING.String(),
+		StartTime:    executionState.GetStartTime().AsTime(),
+		BaseRunID:    task.BaseRunID,
+		Reason:       task.Reason,
+	}
+	if task.EventType != enumsspb.WORKFLOW_LIFECYCLE_EVENT_TYPE_CLOSED {
+		return event, nil
+	}
+
+	if mutableState.IsWorkflowExecutionRunning() {
+		logger.Warn("Dropping workflow lifecycle close event task because workflow is still running.")
+		return nil, nil
+	}
+	closeTime, err := mutableState.GetWorkflowCloseTime(ctx)
+	if err != nil {
+		return nil, err
+	}
+	event.Status = executionState.GetStatus().String()
+	event.CloseTime = &closeTime
+	if executionState.GetStatus() == enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW {
+		completionEvent, err := mutableState.GetCompletionEvent(ctx)
+		if err != nil {
+			return nil, err
+		}
+		event.NewRunID = completionEvent.GetWorkflowExecutionContinuedAsNewEventAttributes().GetNewExecutionRunId()
+	}
+	return event, nil
+}
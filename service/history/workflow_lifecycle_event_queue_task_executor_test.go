@@ -0,0 +1,225 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/service/history/lifecycleevent"
+	"go.temporal.io/server/service/history/queues"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tasks"
+	"go.temporal.io/server/service/history/tests"
+	"go.temporal.io/server/service/history/workflow"
+	"go.temporal.io/server/service/history/workflow/cache"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type recordingLifecycleEventSink struct {
+	err    error
+	events []*lifecycleevent.Event
+}
+
+func (s *recordingLifecycleEventSink) Name() string { return "recording" }
+
+func (s *recordingLifecycleEventSink) Publish(_ context.Context, event *lifecycleevent.Event) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.events = append(s.events, event)
+	return nil
+}
+
+func (s *recordingLifecycleEventSink) Close() error { return nil }
+
+func TestWorkflowLifecycleEventQueueTaskExecutor(t *testing.T) {
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	closeTime := startTime.Add(time.Hour)
+	taskTime := closeTime.Add(time.Second)
+
+	for _, c := range []struct {
+		name           string
+		namespaceEntry *namespace.Namespace
+		sinks          []string
+		eventType      enumsspb.WorkflowLifecycleEventType
+		status         enumspb.WorkflowExecutionStatus
+		sinkErr        error
+		expectedEvent  *lifecycleevent.Event
+		expectErr      bool
+	}{
+		{
+			name:           "started",
+			namespaceEntry: tests.GlobalNamespaceEntry,
+			sinks:          []string{"sink"},
+			eventType:      enumsspb.WORKFLOW_LIFECYCLE_EVENT_TYPE_STARTED,
+			status:         enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+			expectedEvent: &lifecycleevent.Event{
+				ID:     tests.RunID + "/started",
+				Type:   lifecycleevent.EventTypeStarted,
+				Status: "Running",
+			},
+		},
+		{
+			name:           "closed as continued-as-new",
+			namespaceEntry: tests.GlobalNamespaceEntry,
+			sinks:          []string{"sink"},
+			eventType:      enumsspb.WORKFLOW_LIFECYCLE_EVENT_TYPE_CLOSED,
+			status:         enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW,
+			expectedEvent: &lifecycleevent.Event{
+				ID:        tests.RunID + "/closed",
+				Type:      lifecycleevent.EventTypeClosed,
+				Status:    "ContinuedAsNew",
+				CloseTime: &closeTime,
+				NewRunID:  "new-run-id",
+			},
+		},
+		{
+			name:           "closed while running is dropped",
+			namespaceEntry: tests.GlobalNamespaceEntry,
+			sinks:          []string{"sink"},
+			eventType:      enumsspb.WORKFLOW_LIFECYCLE_EVENT_TYPE_CLOSED,
+			status:         enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		},
+		{
+			name:           "no sinks",
+			namespaceEntry: tests.GlobalNamespaceEntry,
+			eventType:      enumsspb.WORKFLOW_LIFECYCLE_EVENT_TYPE_STARTED,
+			status:         enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		},
+		{
+			name:           "standby namespace",
+			namespaceEntry: tests.GlobalStandbyNamespaceEntry,
+			sinks:          []string{"sink"},
+			eventType:      enumsspb.WORKFLOW_LIFECYCLE_EVENT_TYPE_STARTED,
+			status:         enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		},
+		{
+			name:           "sink error is retried",
+			namespaceEntry: tests.GlobalNamespaceEntry,
+			sinks:          []string{"sink"},
+			eventType:      enumsspb.WORKFLOW_LIFECYCLE_EVENT_TYPE_STARTED,
+			status:         enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+			sinkErr:        errors.New("unavailable"),
+			expectErr:      true,
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			workflowKey := definition.NewWorkflowKey(c.namespaceEntry.ID().String(), tests.WorkflowID, tests.RunID)
+
+			cfg := tests.NewDynamicConfig()
+			cfg.WorkflowLifecycleEventSinks = dynamicconfig.GetTypedPropertyFnFilteredByNamespace(c.sinks)
+			namespaceRegistry := namespace.NewMockRegistry(ctrl)
+			namespaceRegistry.EXPECT().GetNamespaceByID(c.namespaceEntry.ID()).Return(c.namespaceEntry, nil).AnyTimes()
+			namespaceRegistry.EXPECT().GetNamespaceName(c.namespaceEntry.ID()).Return(c.namespaceEntry.Name(), nil).AnyTimes()
+			clusterMetadata := cluster.NewMockMetadata(ctrl)
+			clusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+			shardContext := shard.NewMockContext(ctrl)
+			shardContext.EXPECT().GetConfig().Return(cfg).AnyTimes()
+			shardContext.EXPECT().GetNamespaceRegistry().Return(namespaceRegistry).AnyTimes()
+			shardContext.EXPECT().GetClusterMetadata().Return(clusterMetadata).AnyTimes()
+
+			mutableState := workflow.NewMockMutableState(ctrl)
+			mutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{
+				WorkflowTypeName: "workflow-type",
+				TaskQueue:        "task-queue",
+			}).AnyTimes()
+			mutableState.EXPECT().GetExecutionState().Return(&persistencespb.WorkflowExecutionState{
+				Status:    c.status,
+				StartTime: timestamppb.New(startTime),
+			}).AnyTimes()
+			mutableState.EXPECT().IsWorkflowExecutionRunning().Return(c.status == enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING).AnyTimes()
+			mutableState.EXPECT().GetWorkflowCloseTime(gomock.Any()).Return(closeTime, nil).AnyTimes()
+			mutableState.EXPECT().GetCompletionEvent(gomock.Any()).Return(&historypb.HistoryEvent{
+				Attributes: &historypb.HistoryEvent_WorkflowExecutionContinuedAsNewEventAttributes{
+					WorkflowExecutionContinuedAsNewEventAttributes: &historypb.WorkflowExecutionContinuedAsNewEventAttributes{
+						NewExecutionRunId: "new-run-id",
+					},
+				},
+			}, nil).AnyTimes()
+			workflowContext := workflow.NewMockContext(ctrl)
+			workflowContext.EXPECT().LoadMutableState(gomock.Any(), shardContext).Return(mutableState, nil).AnyTimes()
+			workflowCache := cache.NewMockCache(ctrl)
+			workflowCache.EXPECT().GetOrCreateWorkflowExecution(
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+			).Return(workflowContext, cache.ReleaseCacheFunc(func(error) {}), nil).AnyTimes()
+
+			sink := &recordingLifecycleEventSink{err: c.sinkErr}
+			publisher := lifecycleevent.NewPublisher(
+				map[string]lifecycleevent.Sink{"sink": sink},
+				log.NewNoopLogger(),
+				metrics.NoopMetricsHandler,
+			)
+			executor := NewWorkflowLifecycleEventQueueTaskExecutor(
+				publisher,
+				shardContext,
+				workflowCache,
+				metrics.NoopMetricsHandler,
+				log.NewNoopLogger(),
+			)
+
+			executable := queues.NewMockExecutable(ctrl)
+			executable.EXPECT().GetTask().Return(&tasks.WorkflowLifecycleEventTask{
+				WorkflowKey:         workflowKey,
+				VisibilityTimestamp: taskTime,
+				EventType:           c.eventType,
+			})
+			resp := executor.Execute(context.Background(), executable)
+			if c.expectErr {
+				require.Error(t, resp.ExecutionErr)
+				return
+			}
+			require.NoError(t, resp.ExecutionErr)
+			if c.expectedEvent == nil {
+				require.Empty(t, sink.events)
+				return
+			}
+
+			expected := *c.expectedEvent
+			expected.Time = taskTime
+			expected.Namespace = c.namespaceEntry.Name().String()
+			expected.NamespaceID = workflowKey.NamespaceID
+			expected.WorkflowID = workflowKey.WorkflowID
+			expected.RunID = workflowKey.RunID
+			expected.WorkflowType = "workflow-type"
+			expected.TaskQueue = "task-queue"
+			expected.StartTime = startTime
+			require.Equal(t, []*lifecycleevent.Event{&expected}, sink.events)
+		})
+	}
+}
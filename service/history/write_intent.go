@@ -0,0 +1,272 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/pborman/uuid"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	updatepb "go.temporal.io/api/update/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/util"
+	"go.temporal.io/server/service/history/consts"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+// updateIntentPollInterval is how long a standby cluster waits before polling again for an update it accepted as an
+// intent, while the update has not been replicated back from the active cluster yet.
+const updateIntentPollInterval = time.Second
+
+var errUpdateIntentNotObserved = serviceerror.NewDeadlineExceeded("Workflow Update was forwarded to the active cluster but was not replicated back in time.")
+
+// bufferSignalIntent records a signal rejected by this standby cluster as a write intent on the history replication
+// stream of the execution's shard, if the workflow type of the target execution opted into concurrent writes. The
+// active cluster applies the intent, deduplicating by request ID. It returns false if the signal is not eligible for
+// buffering.
+func (h *Handler) bufferSignalIntent(
+	ctx context.Context,
+	shardContext shard.Context,
+	engine shard.Engine,
+	request *historyservice.SignalWorkflowExecutionRequest,
+) (bool, error) {
+	if request.GetChildWorkflowOnly() {
+		return false, nil
+	}
+	namespaceEntry, mutableState, err := h.getWriteIntentTarget(
+		ctx,
+		engine,
+		namespace.ID(request.GetNamespaceId()),
+		request.GetSignalRequest().GetWorkflowExecution(),
+	)
+	if err != nil || mutableState == nil {
+		return false, err
+	}
+
+	signalRequest := common.CloneProtoSharingPayloads(request.GetSignalRequest())
+	if signalRequest.GetRequestId() == "" {
+		signalRequest.RequestId = uuid.New()
+	}
+	if err := h.addWriteIntentTask(ctx, shardContext, namespaceEntry.ID(), mutableState, &tasks.WriteIntentTask{
+		SignalRequest: signalRequest,
+	}); err != nil {
+		return false, err
+	}
+	metrics.WriteIntentBuffered.With(h.metricsHandler).Record(1, metrics.NamespaceTag(namespaceEntry.Name().String()))
+	return true, nil
+}
+
+// bufferUpdateIntent records an update sent to this standby cluster as a write intent on the history replication
+// stream of the execution's shard, if the workflow type of the target execution opted into concurrent writes. The
+// active cluster admits the update, and this cluster waits for its outcome to be replicated back. It returns false if
+// the namespace is active in this cluster or the update is not eligible for buffering.
+func (h *Handler) bufferUpdateIntent(
+	ctx context.Context,
+	shardContext shard.Context,
+	engine shard.Engine,
+	request *historyservice.UpdateWorkflowExecutionRequest,
+) (*historyservice.UpdateWorkflowExecutionResponse, bool, error) {
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	namespaceEntry, err := h.namespaceRegistry.GetNamespaceByID(namespaceID)
+	if err != nil {
+		return nil, false, err
+	}
+	if namespaceEntry.ActiveInCluster(h.clusterMetadata.GetCurrentClusterName()) {
+		return nil, false, nil
+	}
+	_, mutableState, err := h.getWriteIntentTarget(
+		ctx,
+		engine,
+		namespaceID,
+		request.GetRequest().GetWorkflowExecution(),
+	)
+	if err != nil || mutableState == nil {
+		return nil, false, err
+	}
+
+	updateRequest := common.CloneProtoSharingPayloads(request.GetRequest())
+	if updateRequest.GetRequest().GetMeta().GetUpdateId() == "" {
+		return nil, false, serviceerror.NewInvalidArgument("Update ID is not set on request.")
+	}
+	if err := h.addWriteIntentTask(ctx, shardContext, namespaceEntry.ID(), mutableState, &tasks.WriteIntentTask{
+		UpdateRequest: updateRequest,
+	}); err != nil {
+		return nil, false, err
+	}
+	metrics.WriteIntentBuffered.With(h.metricsHandler).Record(1, metrics.NamespaceTag(namespaceEntry.Name().String()))
+
+	resp, err := h.awaitUpdateIntentOutcome(ctx, engine, namespaceEntry, mutableState, updateRequest)
+	if err != nil {
+		return nil, false, err
+	}
+	return resp, true, nil
+}
+
+// awaitUpdateIntentOutcome polls this cluster until the update accepted as an intent is replicated back at the stage
+// the caller waits for. If that does not happen within the long poll expiration interval, the last stage observed on
+// this cluster is returned and the caller is expected to poll for the outcome. If the update was not observed at all,
+// it may still be in flight or have been dropped by the active cluster, so an error is returned instead.
+func (h *Handler) awaitUpdateIntentOutcome(
+	ctx context.Context,
+	engine shard.Engine,
+	namespaceEntry *namespace.Namespace,
+	mutableState *historyservice.GetMutableStateResponse,
+	updateRequest *workflowservice.UpdateWorkflowExecutionRequest,
+) (*historyservice.UpdateWorkflowExecutionResponse, error) {
+	updateRef := &updatepb.UpdateRef{
+		WorkflowExecution: &commonpb.WorkflowExecution{
+			WorkflowId: mutableState.GetExecution().GetWorkflowId(),
+			RunId:      mutableState.GetExecution().GetRunId(),
+		},
+		UpdateId: updateRequest.GetRequest().GetMeta().GetUpdateId(),
+	}
+	waitPolicy := updateRequest.GetWaitPolicy()
+
+	pollCtx, cancel := context.WithTimeout(ctx, h.config.LongPollExpirationInterval(namespaceEntry.Name().String()))
+	defer cancel()
+	lastStage := enumspb.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_UNSPECIFIED
+	for {
+		resp, err := engine.PollWorkflowExecutionUpdate(pollCtx, &historyservice.PollWorkflowExecutionUpdateRequest{
+			NamespaceId: namespaceEntry.ID().String(),
+			Request: &workflowservice.PollWorkflowExecutionUpdateRequest{
+				Namespace:  namespaceEntry.Name().String(),
+				UpdateRef:  updateRef,
+				Identity:   updateRequest.GetRequest().GetMeta().GetIdentity(),
+				WaitPolicy: waitPolicy,
+			},
+		})
+		var notFound *serviceerror.NotFound
+		switch {
+		case err == nil && resp.GetResponse().GetStage() >= waitPolicy.GetLifecycleStage():
+			return &historyservice.UpdateWorkflowExecutionResponse{
+				Response: &workflowservice.UpdateWorkflowExecutionResponse{
+					UpdateRef: updateRef,
+					Outcome:   resp.GetResponse().GetOutcome(),
+					Stage:     resp.GetResponse().GetStage(),
+				},
+			}, nil
+		case err == nil:
+			lastStage = max(lastStage, resp.GetResponse().GetStage())
+		case !errors.As(err, &notFound) && pollCtx.Err() == nil:
+			return nil, err
+		}
+
+		// The update is not replicated back yet, or has not reached the requested stage.
+		select {
+		case <-pollCtx.Done():
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			if lastStage == enumspb.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_UNSPECIFIED {
+				return nil, errUpdateIntentNotObserved
+			}
+			return &historyservice.UpdateWorkflowExecutionResponse{
+				Response: &workflowservice.UpdateWorkflowExecutionResponse{
+					UpdateRef: updateRef,
+					Stage:     lastStage,
+				},
+			}, nil
+		case <-time.After(updateIntentPollInterval):
+		}
+	}
+}
+
+// getWriteIntentTarget returns the mutable state of the execution a write intent targets, or nil if writes to it are
+// not accepted by standby clusters.
+func (h *Handler) getWriteIntentTarget(
+	ctx context.Context,
+	engine shard.Engine,
+	namespaceID namespace.ID,
+	execution *commonpb.WorkflowExecution,
+) (*namespace.Namespace, *historyservice.GetMutableStateResponse, error) {
+	if !h.clusterMetadata.IsGlobalNamespaceEnabled() {
+		return nil, nil, nil
+	}
+	namespaceEntry, err := h.namespaceRegistry.GetNamespaceByID(namespaceID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !namespaceEntry.IsGlobalNamespace() {
+		return nil, nil, nil
+	}
+	patterns := h.config.WriteIntentWorkflowTypes(namespaceEntry.Name().String())
+	if len(patterns) == 0 {
+		return nil, nil, nil
+	}
+	workflowTypeRegexp, err := util.WildCardStringsToRegexp(patterns)
+	if err != nil {
+		h.logger.Warn("invalid write intent workflow types", tag.WorkflowNamespace(namespaceEntry.Name().String()), tag.Error(err))
+		return nil, nil, nil
+	}
+
+	mutableState, err := engine.GetMutableState(ctx, &historyservice.GetMutableStateRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   execution,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if !workflowTypeRegexp.MatchString(mutableState.GetWorkflowType().GetName()) {
+		return nil, nil, nil
+	}
+	if mutableState.GetWorkflowStatus() != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+		return nil, nil, consts.ErrWorkflowCompleted
+	}
+	return namespaceEntry, mutableState, nil
+}
+
+// addWriteIntentTask persists a write intent as a replication task of the current run of the target execution.
+func (h *Handler) addWriteIntentTask(
+	ctx context.Context,
+	shardContext shard.Context,
+	namespaceID namespace.ID,
+	mutableState *historyservice.GetMutableStateResponse,
+	task *tasks.WriteIntentTask,
+) error {
+	task.WorkflowKey = definition.NewWorkflowKey(
+		namespaceID.String(),
+		mutableState.GetExecution().GetWorkflowId(),
+		mutableState.GetExecution().GetRunId(),
+	)
+	return shardContext.AddTasks(ctx, &persistence.AddHistoryTasksRequest{
+		ShardID: shardContext.GetShardID(),
+		// RangeID is set by shardContext
+		NamespaceID: namespaceID.String(),
+		WorkflowID:  task.WorkflowID,
+		Tasks: map[tasks.Category][]tasks.Task{
+			tasks.CategoryReplication: {task},
+		},
+	})
+}
@@ -0,0 +1,255 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	updatepb "go.temporal.io/api/update/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/history/configs"
+	"go.temporal.io/server/service/history/consts"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tasks"
+	"go.temporal.io/server/service/history/tests"
+	"go.uber.org/mock/gomock"
+)
+
+type (
+	writeIntentSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller            *gomock.Controller
+		mockShard             *shard.MockContext
+		mockEngine            *shard.MockEngine
+		mockNamespaceRegistry *namespace.MockRegistry
+		mockClusterMetadata   *cluster.MockMetadata
+		config                *configs.Config
+
+		handler *Handler
+	}
+)
+
+func TestWriteIntentSuite(t *testing.T) {
+	s := new(writeIntentSuite)
+	suite.Run(t, s)
+}
+
+func (s *writeIntentSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockShard = shard.NewMockContext(s.controller)
+	s.mockEngine = shard.NewMockEngine(s.controller)
+	s.mockNamespaceRegistry = namespace.NewMockRegistry(s.controller)
+	s.mockClusterMetadata = cluster.NewMockMetadata(s.controller)
+	s.config = tests.NewDynamicConfig()
+	s.config.WriteIntentWorkflowTypes = dynamicconfig.GetTypedPropertyFnFilteredByNamespace([]string{"concurrent-*"})
+
+	s.mockShard.EXPECT().GetShardID().Return(int32(1)).AnyTimes()
+	s.mockClusterMetadata.EXPECT().IsGlobalNamespaceEnabled().Return(true).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockNamespaceRegistry.EXPECT().GetNamespaceByID(tests.StandbyNamespaceID).Return(tests.GlobalStandbyNamespaceEntry, nil).AnyTimes()
+
+	s.handler = &Handler{
+		config:            s.config,
+		logger:            log.NewTestLogger(),
+		metricsHandler:    metrics.NoopMetricsHandler,
+		namespaceRegistry: s.mockNamespaceRegistry,
+		clusterMetadata:   s.mockClusterMetadata,
+	}
+}
+
+func (s *writeIntentSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *writeIntentSuite) TestBufferSignalIntent() {
+	request := &historyservice.SignalWorkflowExecutionRequest{
+		NamespaceId: tests.StandbyNamespaceID.String(),
+		SignalRequest: &workflowservice.SignalWorkflowExecutionRequest{
+			Namespace:         tests.StandbyNamespace.String(),
+			WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: tests.WorkflowID},
+			SignalName:        "some random signal name",
+		},
+	}
+	s.expectGetMutableState("concurrent-workflow", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+	s.mockShard.EXPECT().AddTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.AddHistoryTasksRequest) error {
+			s.Equal(tests.StandbyNamespaceID.String(), request.NamespaceID)
+			s.Equal(tests.WorkflowID, request.WorkflowID)
+			s.Len(request.Tasks[tasks.CategoryReplication], 1)
+			task, ok := request.Tasks[tasks.CategoryReplication][0].(*tasks.WriteIntentTask)
+			s.True(ok)
+			s.Equal(tests.RunID, task.RunID)
+			s.Equal("some random signal name", task.SignalRequest.GetSignalName())
+			s.NotEmpty(task.SignalRequest.GetRequestId())
+			s.Nil(task.UpdateRequest)
+			return nil
+		},
+	)
+
+	buffered, err := s.handler.bufferSignalIntent(context.Background(), s.mockShard, s.mockEngine, request)
+	s.NoError(err)
+	s.True(buffered)
+}
+
+func (s *writeIntentSuite) TestBufferSignalIntent_WorkflowTypeNotOptedIn() {
+	request := &historyservice.SignalWorkflowExecutionRequest{
+		NamespaceId: tests.StandbyNamespaceID.String(),
+		SignalRequest: &workflowservice.SignalWorkflowExecutionRequest{
+			WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: tests.WorkflowID},
+		},
+	}
+	s.expectGetMutableState("other-workflow", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+
+	buffered, err := s.handler.bufferSignalIntent(context.Background(), s.mockShard, s.mockEngine, request)
+	s.NoError(err)
+	s.False(buffered)
+}
+
+func (s *writeIntentSuite) TestBufferUpdateIntent_NamespaceActive() {
+	request := &historyservice.UpdateWorkflowExecutionRequest{
+		NamespaceId: tests.NamespaceID.String(),
+		Request: &workflowservice.UpdateWorkflowExecutionRequest{
+			WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: tests.WorkflowID},
+		},
+	}
+	s.mockNamespaceRegistry.EXPECT().GetNamespaceByID(tests.NamespaceID).Return(tests.GlobalNamespaceEntry, nil)
+
+	_, buffered, err := s.handler.bufferUpdateIntent(context.Background(), s.mockShard, s.mockEngine, request)
+	s.NoError(err)
+	s.False(buffered)
+}
+
+func (s *writeIntentSuite) TestBufferUpdateIntent_Completed() {
+	request := s.newUpdateRequest()
+	s.expectGetMutableState("concurrent-workflow", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+	s.mockShard.EXPECT().AddTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.AddHistoryTasksRequest) error {
+			task, ok := request.Tasks[tasks.CategoryReplication][0].(*tasks.WriteIntentTask)
+			s.True(ok)
+			s.Equal("update-id", task.UpdateRequest.GetRequest().GetMeta().GetUpdateId())
+			s.Nil(task.SignalRequest)
+			return nil
+		},
+	)
+	outcome := &updatepb.Outcome{Value: &updatepb.Outcome_Success{Success: &commonpb.Payloads{}}}
+	gomock.InOrder(
+		s.mockEngine.EXPECT().PollWorkflowExecutionUpdate(gomock.Any(), gomock.Any()).
+			Return(nil, serviceerror.NewNotFound("update not found")),
+		s.mockEngine.EXPECT().PollWorkflowExecutionUpdate(gomock.Any(), gomock.Any()).
+			Return(&historyservice.PollWorkflowExecutionUpdateResponse{
+				Response: &workflowservice.PollWorkflowExecutionUpdateResponse{
+					Outcome: outcome,
+					Stage:   enumspb.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_COMPLETED,
+				},
+			}, nil),
+	)
+
+	resp, buffered, err := s.handler.bufferUpdateIntent(context.Background(), s.mockShard, s.mockEngine, request)
+	s.NoError(err)
+	s.True(buffered)
+	s.Equal(enumspb.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_COMPLETED, resp.GetResponse().GetStage())
+	s.Equal(outcome, resp.GetResponse().GetOutcome())
+	s.Equal(tests.RunID, resp.GetResponse().GetUpdateRef().GetWorkflowExecution().GetRunId())
+}
+
+func (s *writeIntentSuite) TestBufferUpdateIntent_NotReplicatedBack() {
+	s.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByNamespace(10 * time.Millisecond)
+	request := s.newUpdateRequest()
+	s.expectGetMutableState("concurrent-workflow", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+	s.mockShard.EXPECT().AddTasks(gomock.Any(), gomock.Any()).Return(nil)
+	s.mockEngine.EXPECT().PollWorkflowExecutionUpdate(gomock.Any(), gomock.Any()).
+		Return(nil, serviceerror.NewNotFound("update not found")).AnyTimes()
+
+	_, _, err := s.handler.bufferUpdateIntent(context.Background(), s.mockShard, s.mockEngine, request)
+	s.ErrorIs(err, errUpdateIntentNotObserved)
+}
+
+func (s *writeIntentSuite) TestBufferUpdateIntent_LastObservedStage() {
+	s.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByNamespace(10 * time.Millisecond)
+	request := s.newUpdateRequest()
+	s.expectGetMutableState("concurrent-workflow", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+	s.mockShard.EXPECT().AddTasks(gomock.Any(), gomock.Any()).Return(nil)
+	s.mockEngine.EXPECT().PollWorkflowExecutionUpdate(gomock.Any(), gomock.Any()).
+		Return(&historyservice.PollWorkflowExecutionUpdateResponse{
+			Response: &workflowservice.PollWorkflowExecutionUpdateResponse{
+				Stage: enumspb.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_ACCEPTED,
+			},
+		}, nil).AnyTimes()
+
+	resp, buffered, err := s.handler.bufferUpdateIntent(context.Background(), s.mockShard, s.mockEngine, request)
+	s.NoError(err)
+	s.True(buffered)
+	s.Equal(enumspb.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_ACCEPTED, resp.GetResponse().GetStage())
+	s.Equal("update-id", resp.GetResponse().GetUpdateRef().GetUpdateId())
+}
+
+func (s *writeIntentSuite) TestBufferUpdateIntent_WorkflowCompleted() {
+	request := s.newUpdateRequest()
+	s.expectGetMutableState("concurrent-workflow", enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)
+
+	_, _, err := s.handler.bufferUpdateIntent(context.Background(), s.mockShard, s.mockEngine, request)
+	s.ErrorIs(err, consts.ErrWorkflowCompleted)
+}
+
+func (s *writeIntentSuite) newUpdateRequest() *historyservice.UpdateWorkflowExecutionRequest {
+	return &historyservice.UpdateWorkflowExecutionRequest{
+		NamespaceId: tests.StandbyNamespaceID.String(),
+		Request: &workflowservice.UpdateWorkflowExecutionRequest{
+			Namespace:         tests.StandbyNamespace.String(),
+			WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: tests.WorkflowID},
+			WaitPolicy: &updatepb.WaitPolicy{
+				LifecycleStage: enumspb.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_COMPLETED,
+			},
+			Request: &updatepb.Request{
+				Meta:  &updatepb.Meta{UpdateId: "update-id"},
+				Input: &updatepb.Input{Name: "update-name"},
+			},
+		},
+	}
+}
+
+func (s *writeIntentSuite) expectGetMutableState(workflowType string, status enumspb.WorkflowExecutionStatus) {
+	s.mockEngine.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).Return(&historyservice.GetMutableStateResponse{
+		Execution:      &commonpb.WorkflowExecution{WorkflowId: tests.WorkflowID, RunId: tests.RunID},
+		WorkflowType:   &commonpb.WorkflowType{Name: workflowType},
+		WorkflowStatus: status,
+	}, nil)
+}
@@ -154,6 +154,17 @@ func (m *ackManager) completeTask(taskID int64) int64 {
 	return m.ackLevel
 }
 
+// reconcileBacklogCount corrects the approximate backlog counter of the db using the tasks tracked
+// in outstandingTasks. This is exact only once every persisted task has been read, so the db
+// ignores it otherwise.
+func (m *ackManager) reconcileBacklogCount() {
+	m.Lock()
+	defer m.Unlock()
+	// outstandingTasks still holds acked tasks above the ack level; they are counted until the ack
+	// level moves past them, same as in completeTask.
+	m.backlogMgr.db.reconcileApproximateBacklogCount(m.readLevel, int64(m.outstandingTasks.Size()))
+}
+
 func (m *ackManager) getBacklogCountHint() int64 {
 	return m.backlogCountHint.Load()
 }
@@ -150,6 +150,7 @@ func (c *backlogManagerImpl) Stop() {
 		ctx, cancel := c.newIOContext()
 		defer cancel()
 
+		c.taskAckManager.reconcileBacklogCount()
 		_ = c.db.UpdateState(ctx, ackLevel)
 		c.taskGC.RunNow(ctx, ackLevel)
 	}
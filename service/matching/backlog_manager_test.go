@@ -226,6 +226,33 @@ func TestApproximateBacklogCounterDecrement_MultipleTasks(t *testing.T) {
 
 }
 
+func TestApproximateBacklogCounterReconcile(t *testing.T) {
+	controller := gomock.NewController(t)
+	backlogMgr := newBacklogMgr(controller, false)
+	logger := backlogMgr.logger.(*log.MockLogger) // nolint:revive
+	logger.EXPECT().Info("Reconciled ApproximateBacklogCounter.", gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+
+	backlogMgr.taskAckManager.addTask(int64(1))
+	backlogMgr.taskAckManager.addTask(int64(2))
+	backlogMgr.taskAckManager.addTask(int64(3))
+	// Over-count, as happens when a task write fails with an unknown outcome
+	backlogMgr.db.updateApproximateBacklogCount(int64(5))
+	backlogMgr.db.SetMaxReadLevel(5)
+
+	backlogMgr.taskAckManager.reconcileBacklogCount()
+	require.Equal(t, int64(5), backlogMgr.db.getApproximateBacklogCount(), "should not reconcile before the reader caught up")
+
+	backlogMgr.taskAckManager.setReadLevelAfterGap(5)
+	require.Equal(t, int64(-1), backlogMgr.taskAckManager.completeTask(2), "should not move the ack level")
+	backlogMgr.taskAckManager.reconcileBacklogCount()
+	require.Equal(t, int64(3), backlogMgr.db.getApproximateBacklogCount(), "should count all tasks above the ack level")
+
+	require.Equal(t, int64(2), backlogMgr.taskAckManager.completeTask(1), "should move the ack level")
+	require.Equal(t, int64(1), backlogMgr.db.getApproximateBacklogCount(), "1 task in the backlog")
+	backlogMgr.taskAckManager.reconcileBacklogCount()
+	require.Equal(t, int64(1), backlogMgr.db.getApproximateBacklogCount(), "should not change an accurate count")
+}
+
 // TestAddTasksValidateBacklogCounter uses the "backlogManager methods" to add a task to the backlog.
 func TestAddSingleTaskValidateBacklogCounter(t *testing.T) {
 	controller := gomock.NewController(t)
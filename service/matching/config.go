@@ -118,8 +118,11 @@ type (
 
 		ListNexusEndpointsLongPollTimeout dynamicconfig.DurationPropertyFn
 		NexusEndpointsRefreshInterval     dynamicconfig.DurationPropertyFn
+		EnableNexusEndpointReplication    dynamicconfig.BoolPropertyFn
+		NexusEndpointTombstoneTTL         dynamicconfig.DurationPropertyFn
 
-		LogAllReqErrors dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		LogAllReqErrors          dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		SlowRequestLogThresholds dynamicconfig.TypedPropertyFn[map[string]time.Duration]
 	}
 
 	forwarderConfig struct {
@@ -278,8 +281,11 @@ func NewConfig(
 
 		ListNexusEndpointsLongPollTimeout: dynamicconfig.MatchingListNexusEndpointsLongPollTimeout.Get(dc),
 		NexusEndpointsRefreshInterval:     dynamicconfig.MatchingNexusEndpointsRefreshInterval.Get(dc),
+		EnableNexusEndpointReplication:    dynamicconfig.MatchingEnableNexusEndpointReplication.Get(dc),
+		NexusEndpointTombstoneTTL:         dynamicconfig.MatchingNexusEndpointTombstoneTTL.Get(dc),
 
-		LogAllReqErrors: dynamicconfig.LogAllReqErrors.Get(dc),
+		LogAllReqErrors:          dynamicconfig.LogAllReqErrors.Get(dc),
+		SlowRequestLogThresholds: dynamicconfig.SlowRequestLogThresholds.Get(dc),
 	}
 }
 
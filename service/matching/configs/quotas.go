@@ -65,10 +65,12 @@ var (
 		"/temporal.server.api.matchingservice.v1.MatchingService/UpdateNexusEndpoint":                    1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/ListNexusEndpoints":                     1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/DeleteNexusEndpoint":                    1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/ApplyNexusEndpointReplicationEvent":     1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/UpdateWorkerVersioningRules":            1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/GetWorkerVersioningRules":               1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/DescribeTaskQueuePartition":             1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/SyncDeploymentUserData":                 1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/StreamPollTaskQueue":                    1,
 	}
 
 	APIPrioritiesOrdered = []int{0, 1}
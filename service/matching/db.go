@@ -192,12 +192,6 @@ func (db *taskQueueDB) UpdateState(
 	db.Lock()
 	defer db.Unlock()
 
-	// Reset approximateBacklogCounter to fix the count divergence issue
-	maxReadLevel := db.GetMaxReadLevel()
-	if ackLevel == maxReadLevel {
-		db.approximateBacklogCount.Store(0)
-	}
-
 	queueInfo := db.cachedQueueInfo()
 	queueInfo.AckLevel = ackLevel
 	_, err := db.store.UpdateTaskQueue(ctx, &persistence.UpdateTaskQueueRequest{
@@ -229,6 +223,28 @@ func (db *taskQueueDB) updateApproximateBacklogCount(
 	}
 }
 
+// reconcileApproximateBacklogCount replaces the in-memory backlog count with the number of tasks
+// the reader is still tracking, if the reader has caught up with all tasks written so far. This
+// fixes divergence from writes with unknown outcome, which are counted as created. The corrected
+// count is persisted with the next ack level update.
+func (db *taskQueueDB) reconcileApproximateBacklogCount(
+	readLevel int64,
+	outstandingTasks int64,
+) {
+	db.Lock()
+	defer db.Unlock()
+
+	if readLevel < db.GetMaxReadLevel() {
+		return
+	}
+	if count := db.approximateBacklogCount.Load(); count != outstandingTasks {
+		db.logger.Info("Reconciled ApproximateBacklogCounter.",
+			tag.Counter(int(count)),
+			tag.WorkerBuildId(db.queue.Version().MetricsTagValue()), tag.WorkflowNamespaceID(db.queue.Partition().NamespaceId()))
+		db.approximateBacklogCount.Store(outstandingTasks)
+	}
+}
+
 func (db *taskQueueDB) getApproximateBacklogCount() int64 {
 	return db.approximateBacklogCount.Load()
 }
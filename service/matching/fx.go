@@ -25,15 +25,19 @@
 package matching
 
 import (
+	"net"
+
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/membership/drain"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/payloadencryption"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/primitives"
@@ -66,6 +70,7 @@ var Module = fx.Options(
 	fx.Provide(NamespaceReplicationQueueProvider),
 	fx.Provide(ServiceResolverProvider),
 	fx.Provide(ServerProvider),
+	fx.Provide(DrainServerProvider),
 	fx.Provide(NewService),
 	fx.Invoke(ServiceLifetimeHooks),
 )
@@ -93,12 +98,15 @@ func TelemetryInterceptorProvider(
 	namespaceRegistry namespace.Registry,
 	metricsHandler metrics.Handler,
 	serviceConfig *Config,
+	persistenceConfig *config.Persistence,
 ) *interceptor.TelemetryInterceptor {
 	return interceptor.NewTelemetryInterceptor(
 		namespaceRegistry,
 		metricsHandler,
 		logger,
 		serviceConfig.LogAllReqErrors,
+		serviceConfig.SlowRequestLogThresholds,
+		persistenceConfig.NumHistoryShards,
 	)
 }
 
@@ -163,6 +171,7 @@ func VisibilityManagerProvider(
 	logger log.Logger,
 	persistenceConfig *config.Persistence,
 	customVisibilityStoreFactory visibility.VisibilityStoreFactory,
+	payloadKeyProvider payloadencryption.KeyProvider,
 	metricsHandler metrics.Handler,
 	serviceConfig *Config,
 	persistenceServiceResolver resolver.ServiceResolver,
@@ -174,6 +183,7 @@ func VisibilityManagerProvider(
 		*persistenceConfig,
 		persistenceServiceResolver,
 		customVisibilityStoreFactory,
+		payloadKeyProvider,
 		nil, // matching visibility never writes
 		saProvider,
 		searchAttributesMapperProvider,
@@ -192,6 +202,33 @@ func VisibilityManagerProvider(
 	)
 }
 
+type DrainServerParams struct {
+	fx.In
+
+	Config            *config.Config `optional:"true"`
+	ServiceName       primitives.ServiceName
+	GrpcListener      net.Listener
+	MembershipMonitor membership.Monitor
+	Handler           *Handler
+	Logger            log.Logger
+}
+
+// DrainServerProvider provides the server of the drain endpoint if enabled or nil otherwise. The endpoint is disabled
+// without a static config, e.g. in tests.
+func DrainServerProvider(params DrainServerParams) (*drain.Server, error) {
+	if params.Config == nil {
+		return nil, nil
+	}
+	return drain.NewServer(
+		params.Config.Services[string(params.ServiceName)].RPC,
+		params.GrpcListener,
+		params.MembershipMonitor,
+		"taskQueuePartitions",
+		params.Handler.engine.LoadedPartitionCount,
+		params.Logger,
+	)
+}
+
 func ServiceLifetimeHooks(lc fx.Lifecycle, svc *Service) {
 	lc.Append(fx.StartStopHook(svc.Start, svc.Stop))
 }
@@ -42,6 +42,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/resource"
+	"go.temporal.io/server/common/rpc/interceptor"
 	"go.temporal.io/server/common/tqid"
 	"go.temporal.io/server/service/worker/deployment"
 	"google.golang.org/protobuf/proto"
@@ -60,6 +61,10 @@ type (
 		startWG           sync.WaitGroup
 		throttledLogger   log.Logger
 		namespaceRegistry namespace.Registry
+		// interceptors applied to the polls received over StreamPollTaskQueue, which don't go through the
+		// unary interceptors of the gRPC server.
+		telemetryInterceptor *interceptor.TelemetryInterceptor
+		rateLimitInterceptor *interceptor.RateLimitInterceptor
 	}
 )
 
@@ -88,6 +93,8 @@ func NewHandler(
 	namespaceReplicationQueue persistence.NamespaceReplicationQueue,
 	visibilityManager manager.VisibilityManager,
 	nexusEndpointManager persistence.NexusEndpointManager,
+	telemetryInterceptor *interceptor.TelemetryInterceptor,
+	rateLimitInterceptor *interceptor.RateLimitInterceptor,
 ) *Handler {
 	handler := &Handler{
 		config:          config,
@@ -111,7 +118,9 @@ func NewHandler(
 			visibilityManager,
 			nexusEndpointManager,
 		),
-		namespaceRegistry: namespaceRegistry,
+		namespaceRegistry:    namespaceRegistry,
+		telemetryInterceptor: telemetryInterceptor,
+		rateLimitInterceptor: rateLimitInterceptor,
 	}
 
 	// prevent from serving requests before matching engine is started and ready
@@ -531,6 +540,11 @@ func (h *Handler) DeleteNexusEndpoint(ctx context.Context, request *matchingserv
 	return h.engine.DeleteNexusEndpoint(ctx, request)
 }
 
+func (h *Handler) ApplyNexusEndpointReplicationEvent(ctx context.Context, request *matchingservice.ApplyNexusEndpointReplicationEventRequest) (_ *matchingservice.ApplyNexusEndpointReplicationEventResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.ApplyNexusEndpointReplicationEvent(ctx, request)
+}
+
 func (h *Handler) ListNexusEndpoints(ctx context.Context, request *matchingservice.ListNexusEndpointsRequest) (_ *matchingservice.ListNexusEndpointsResponse, retError error) {
 	defer log.CapturePanic(h.logger, &retError)
 	return h.engine.ListNexusEndpoints(ctx, request)
@@ -37,6 +37,8 @@ import (
 
 	"github.com/nexus-rpc/sdk-go/nexus"
 	"github.com/pborman/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
@@ -182,6 +184,8 @@ var (
 
 	// The routing key for the single partition used to route Nexus endpoints CRUD RPCs to.
 	nexusEndpointsTablePartitionRoutingKey = tqid.MustNormalPartitionFromRpcName("not-applicable", "not-applicable", enumspb.TASK_QUEUE_TYPE_UNSPECIFIED).RoutingKey()
+
+	nexusEndpointReplicationRetryPolicy = backoff.NewExponentialRetryPolicy(100 * time.Millisecond).WithMaximumInterval(time.Second).WithMaximumAttempts(5)
 )
 
 var _ Engine = (*matchingEngineImpl)(nil) // Asserts that interface is indeed implemented
@@ -222,7 +226,7 @@ func NewEngine(
 		clusterMeta:                   clusterMeta,
 		timeSource:                    clock.NewRealTimeSource(), // No need to mock this at the moment
 		visibilityManager:             visibilityManager,
-		nexusEndpointClient:           newEndpointClient(config.NexusEndpointsRefreshInterval, nexusEndpointManager),
+		nexusEndpointClient:           newEndpointClient(config.NexusEndpointsRefreshInterval, config.NexusEndpointTombstoneTTL, nexusEndpointManager),
 		nexusEndpointsOwnershipLostCh: make(chan struct{}),
 		metricsHandler:                scopedMetricsHandler,
 		partitions:                    make(map[tqid.PartitionKey]taskQueuePartitionManager),
@@ -349,6 +353,12 @@ func (e *matchingEngineImpl) getTaskQueuePartitions(maxCount int) (lists []taskQ
 	return
 }
 
+func (e *matchingEngineImpl) LoadedPartitionCount() int {
+	e.partitionsLock.RLock()
+	defer e.partitionsLock.RUnlock()
+	return len(e.partitions)
+}
+
 func (e *matchingEngineImpl) String() string {
 	// Executes taskQueue.String() on each task queue outside of lock
 	buf := new(bytes.Buffer)
@@ -1099,6 +1109,9 @@ func (e *matchingEngineImpl) DescribeTaskQueue(
 								Pollers:        dedupPollers(append(physInfo.GetPollers(), vii.PhysicalTaskQueueInfo.GetPollers()...)),
 								TaskQueueStats: mergedStats,
 							}
+							if mergedStats != nil {
+								merged.ApproximateBacklogDrainTime = backlogDrainTime(mergedStats)
+							}
 							physicalInfoByBuildId[buildId][taskQueueType] = merged
 						}
 					}
@@ -2018,6 +2031,7 @@ func (e *matchingEngineImpl) CreateNexusEndpoint(ctx context.Context, request *m
 		e.logger.Error("Failed to create Nexus endpoint", tag.Error(err), tag.Endpoint(request.GetSpec().GetName()))
 	} else {
 		e.logger.Info("Created Nexus endpoint", tag.Endpoint(request.GetSpec().GetName()))
+		e.replicateNexusEndpoint(ctx, res.GetEntry().GetId(), res.GetEntry().GetEndpoint())
 	}
 	return res, err
 }
@@ -2035,21 +2049,63 @@ func (e *matchingEngineImpl) UpdateNexusEndpoint(ctx context.Context, request *m
 		e.logger.Error("Failed to update Nexus endpoint", tag.Error(err), tag.Endpoint(request.GetSpec().GetName()))
 	} else {
 		e.logger.Info("Updated Nexus endpoint", tag.Endpoint(request.GetSpec().GetName()))
+		e.replicateNexusEndpoint(ctx, res.GetEntry().GetId(), res.GetEntry().GetEndpoint())
 	}
 	return res, err
 }
 
 func (e *matchingEngineImpl) DeleteNexusEndpoint(ctx context.Context, request *matchingservice.DeleteNexusEndpointRequest) (*matchingservice.DeleteNexusEndpointResponse, error) {
 	// Write API, let persistence verify table ownership.
-	res, err := e.nexusEndpointClient.DeleteNexusEndpoint(ctx, request)
+	deleteClock, err := e.nexusEndpointClient.DeleteNexusEndpoint(ctx, &internalDeleteNexusEndpointRequest{
+		endpointID: request.GetId(),
+		timeSource: e.timeSource,
+	})
 	if err != nil {
 		e.logger.Error("Failed to delete Nexus endpoint", tag.Error(err), tag.Endpoint(request.GetId()))
-	} else {
-		e.logger.Info("Deleted Nexus endpoint", tag.Endpoint(request.GetId()))
+		return nil, err
+	}
+	e.logger.Info("Deleted Nexus endpoint", tag.Endpoint(request.GetId()))
+	// Deletions are replicated as an endpoint without a spec so that remote clusters can order them against updates.
+	e.replicateNexusEndpoint(ctx, request.GetId(), &persistencespb.NexusEndpoint{Clock: deleteClock})
+	return &matchingservice.DeleteNexusEndpointResponse{}, nil
+}
+
+func (e *matchingEngineImpl) ApplyNexusEndpointReplicationEvent(ctx context.Context, request *matchingservice.ApplyNexusEndpointReplicationEventRequest) (*matchingservice.ApplyNexusEndpointReplicationEventResponse, error) {
+	// Write API, let persistence verify table ownership.
+	res, err := e.nexusEndpointClient.ApplyNexusEndpointReplicationEvent(ctx, request)
+	if err != nil {
+		e.logger.Error("Failed to apply replicated Nexus endpoint", tag.Error(err), tag.Endpoint(request.GetId()))
 	}
 	return res, err
 }
 
+// replicateNexusEndpoint publishes an endpoint change to the namespace replication queue so that remote clusters have
+// the same set of endpoints. The change is already durable locally at this point, so the caller's request succeeds even
+// if publishing fails after retries: retrying a create would fail because the endpoint exists. Such failures are logged
+// and the endpoints can be published again with the ReplicateNexusEndpoints admin API.
+func (e *matchingEngineImpl) replicateNexusEndpoint(ctx context.Context, id string, endpoint *persistencespb.NexusEndpoint) {
+	if e.namespaceReplicationQueue == nil || !e.config.EnableNexusEndpointReplication() {
+		return
+	}
+	task := &replicationspb.ReplicationTask{
+		TaskType: enumsspb.REPLICATION_TASK_TYPE_NEXUS_ENDPOINT,
+		Attributes: &replicationspb.ReplicationTask_NexusEndpointAttributes{
+			NexusEndpointAttributes: &replicationspb.NexusEndpointAttributes{
+				Id:       id,
+				Endpoint: endpoint,
+			},
+		},
+	}
+	err := backoff.ThrottleRetryContext(ctx, func(ctx context.Context) error {
+		return e.namespaceReplicationQueue.Publish(ctx, task)
+	}, nexusEndpointReplicationRetryPolicy, func(err error) bool {
+		return !common.IsContextDeadlineExceededErr(err) && !common.IsContextCanceledErr(err)
+	})
+	if err != nil {
+		e.logger.Error("Failed to replicate Nexus endpoint", tag.Error(err), tag.Endpoint(id))
+	}
+}
+
 func (e *matchingEngineImpl) ListNexusEndpoints(ctx context.Context, request *matchingservice.ListNexusEndpointsRequest) (*matchingservice.ListNexusEndpointsResponse, error) {
 	lastKnownVersion := request.LastKnownTableVersion
 	// Read API, verify table ownership via membership.
@@ -2187,7 +2243,31 @@ func (e *matchingEngineImpl) pollTask(
 		e.outstandingPollers.Set(pollerID, cancel)
 		defer e.outstandingPollers.Delete(pollerID)
 	}
-	return pm.PollTask(ctx, pollMetadata)
+	task, versionSetUsed, err := pm.PollTask(ctx, pollMetadata)
+	if err == nil {
+		recordTaskDispatch(ctx, task)
+	}
+	return task, versionSetUsed, err
+}
+
+// recordTaskDispatch annotates the poll span with the dispatched task and links it to the span of the request that
+// added the task, if the task was sync matched on this host.
+func recordTaskDispatch(ctx context.Context, task *internalTask) {
+	span := trace.SpanFromContext(ctx)
+	if !span.IsRecording() {
+		return
+	}
+	if task.spanContext.IsValid() {
+		span.AddLink(trace.Link{SpanContext: task.spanContext})
+	}
+	execution := task.workflowExecution()
+	span.AddEvent("task dispatched", trace.WithAttributes(
+		attribute.String("temporalWorkflowID", execution.GetWorkflowId()),
+		attribute.String("temporalRunID", execution.GetRunId()),
+		attribute.String("temporal.task.source", task.source.String()),
+		attribute.Bool("temporal.task.sync_match", task.isSyncMatchTask()),
+		attribute.Bool("temporal.task.forwarded", task.isForwarded()),
+	))
 }
 
 // Unloads the given task queue partition. If it has already been unloaded (i.e. it's not present in the loaded
@@ -2347,6 +2427,7 @@ func (e *matchingEngineImpl) createPollWorkflowTaskQueueResponse(
 			recordStartResp.GetVersion(),
 		)
 		serializedToken, _ = e.tokenSerializer.Serialize(taskToken)
+		tasktoken.Release(taskToken)
 		if task.responseC == nil {
 			ct := timestamp.TimeValue(task.event.Data.CreateTime)
 			metrics.AsyncMatchLatencyPerTaskQueue.With(metricsHandler).Record(time.Since(ct))
@@ -2399,6 +2480,9 @@ func (e *matchingEngineImpl) createPollActivityTaskQueueResponse(
 		historyResponse.GetVersion(),
 	)
 	serializedToken, _ := e.tokenSerializer.Serialize(taskToken)
+	// The token goes back to the pool once the response is built, so take what the response needs from it first.
+	attempt := taskToken.Attempt
+	tasktoken.Release(taskToken)
 
 	// This is here to ensure that this field is never nil as expected by the TS SDK.
 	// This may happen if ScheduleActivityExecution was recorded in version 1.23.
@@ -2420,7 +2504,7 @@ func (e *matchingEngineImpl) createPollActivityTaskQueueResponse(
 		StartToCloseTimeout:         attributes.StartToCloseTimeout,
 		HeartbeatTimeout:            attributes.HeartbeatTimeout,
 		TaskToken:                   serializedToken,
-		Attempt:                     taskToken.Attempt,
+		Attempt:                     attempt,
 		HeartbeatDetails:            historyResponse.HeartbeatDetails,
 		WorkflowType:                historyResponse.WorkflowType,
 		WorkflowNamespace:           historyResponse.WorkflowNamespace,
@@ -2522,3 +2606,20 @@ func largerBacklogAge(rootBacklogAge *durationpb.Duration, currentPartitionAge *
 	}
 	return currentPartitionAge
 }
+
+// backlogDrainTime estimates how long the backlog takes to drain if tasks keep being added and
+// dispatched at the current rates. Returns nil if the backlog is not shrinking.
+func backlogDrainTime(stats *taskqueuepb.TaskQueueStats) *durationpb.Duration {
+	if stats.GetApproximateBacklogCount() <= 0 {
+		return durationpb.New(0)
+	}
+	drainRate := float64(stats.GetTasksDispatchRate()) - float64(stats.GetTasksAddRate())
+	if drainRate <= 0 {
+		return nil
+	}
+	drainTime := float64(stats.GetApproximateBacklogCount()) / drainRate * float64(time.Second)
+	if drainTime >= math.MaxInt64 {
+		return nil
+	}
+	return durationpb.New(time.Duration(drainTime))
+}
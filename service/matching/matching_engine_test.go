@@ -28,6 +28,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"math/rand"
 	"sync"
 	"testing"
@@ -238,7 +239,7 @@ func newMatchingEngine(
 		clusterMeta:                   clustertest.NewMetadataForTest(cluster.NewTestClusterMetadataConfig(false, true)),
 		timeSource:                    clock.NewRealTimeSource(),
 		visibilityManager:             mockVisibilityManager,
-		nexusEndpointClient:           newEndpointClient(config.NexusEndpointsRefreshInterval, nexusEndpointManager),
+		nexusEndpointClient:           newEndpointClient(config.NexusEndpointsRefreshInterval, config.NexusEndpointTombstoneTTL, nexusEndpointManager),
 		nexusEndpointsOwnershipLostCh: make(chan struct{}),
 	}
 }
@@ -357,6 +358,45 @@ func (s *matchingEngineSuite) TestAckManager_Sort() {
 	s.EqualValues(t5, m.getAckLevel())
 }
 
+func (s *matchingEngineSuite) TestCreatePollActivityTaskQueueResponse_AttemptWithTokenReuse() {
+	// Task tokens are pooled, so build many responses concurrently to make sure every response carries the attempt
+	// of its own task rather than a zeroed or reused token's.
+	const numTasks = 100
+	var wg sync.WaitGroup
+	for i := 1; i <= numTasks; i++ {
+		attempt := int32(i)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			task := newInternalTaskFromBacklog(&persistencespb.AllocatedTaskInfo{
+				Data: &persistencespb.TaskInfo{
+					NamespaceId:      uuid.New(),
+					WorkflowId:       "workflowID",
+					RunId:            "runID",
+					ScheduledEventId: int64(attempt),
+					CreateTime:       timestamppb.Now(),
+				},
+				TaskId: int64(attempt),
+			}, nil)
+			historyResponse := &historyservice.RecordActivityTaskStartedResponse{
+				ScheduledEvent: newActivityTaskScheduledEvent(int64(attempt), 0, &commandpb.ScheduleActivityTaskCommandAttributes{
+					ActivityId:   "activityID",
+					ActivityType: &commonpb.ActivityType{Name: "activityType"},
+				}),
+				Attempt: attempt,
+			}
+
+			resp := s.matchingEngine.createPollActivityTaskQueueResponse(task, historyResponse, metrics.NoopMetricsHandler)
+			s.Equal(attempt, resp.Attempt)
+			token, err := s.matchingEngine.tokenSerializer.Deserialize(resp.TaskToken)
+			s.NoError(err)
+			s.Equal(attempt, token.Attempt)
+			s.Equal(int64(attempt), token.ScheduledEventId)
+		}()
+	}
+	wg.Wait()
+}
+
 func (s *matchingEngineSuite) TestPollActivityTaskQueuesEmptyResult() {
 	s.PollForTasksEmptyResultTest(context.Background(), enumspb.TASK_QUEUE_TYPE_ACTIVITY)
 }
@@ -3392,6 +3432,24 @@ func (s *matchingEngineSuite) TestLargerBacklogAge() {
 	s.Same(thirdAge, largerBacklogAge(secondAge, thirdAge))
 }
 
+func (s *matchingEngineSuite) TestBacklogDrainTime() {
+	s.Equal(time.Duration(0), backlogDrainTime(&taskqueuepb.TaskQueueStats{}).AsDuration())
+	s.Equal(10*time.Second, backlogDrainTime(&taskqueuepb.TaskQueueStats{
+		ApproximateBacklogCount: 100,
+		TasksAddRate:            5,
+		TasksDispatchRate:       15,
+	}).AsDuration())
+	s.Nil(backlogDrainTime(&taskqueuepb.TaskQueueStats{
+		ApproximateBacklogCount: 100,
+		TasksAddRate:            15,
+		TasksDispatchRate:       15,
+	}))
+	s.Nil(backlogDrainTime(&taskqueuepb.TaskQueueStats{
+		ApproximateBacklogCount: math.MaxInt64,
+		TasksDispatchRate:       0.1,
+	}))
+}
+
 func (s *matchingEngineSuite) TestCheckNexusEndpointsOwnership() {
 	isOwner, _, err := s.matchingEngine.checkNexusEndpointsOwnership()
 	s.NoError(err)
@@ -35,6 +35,7 @@ import (
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/clock"
 	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
@@ -66,6 +67,11 @@ type (
 		timeSource clock.TimeSource
 	}
 
+	internalDeleteNexusEndpointRequest struct {
+		endpointID string
+		timeSource clock.TimeSource
+	}
+
 	// nexusEndpointClient manages cache and persistence access for Nexus endpoints.
 	// nexusEndpointClient contains a RWLock to enforce serial updates to prevent
 	// nexus_endpoints table version conflicts.
@@ -77,16 +83,21 @@ type (
 	nexusEndpointClient struct {
 		hasLoadedEndpoints atomic.Bool
 
-		sync.RWMutex        // protects tableVersion, endpoints, endpointsByID, endpointsByName, and tableVersionChanged
-		tableVersion        int64
-		endpointEntries     []*persistencespb.NexusEndpointEntry // sorted by ID to support pagination during ListNexusEndpoints
-		endpointsByID       map[string]*persistencespb.NexusEndpointEntry
-		endpointsByName     map[string]*persistencespb.NexusEndpointEntry
+		sync.RWMutex    // protects tableVersion, endpoints, endpointsByID, endpointsByName, tombstones, and tableVersionChanged
+		tableVersion    int64
+		endpointEntries []*persistencespb.NexusEndpointEntry // sorted by ID to support pagination during ListNexusEndpoints
+		endpointsByID   map[string]*persistencespb.NexusEndpointEntry
+		endpointsByName map[string]*persistencespb.NexusEndpointEntry
+		// tombstones are the entries of deleted endpoints. They are persisted in the endpoints table as entries without a
+		// spec whose clock is the clock of the deletion, so that replicated changes are ordered against deletions across
+		// restarts and table owner changes. Tombstones are removed once they are older than endpointTombstoneTTL.
+		tombstones          map[string]*persistencespb.NexusEndpointEntry
 		tableVersionChanged chan struct{}
 
 		refreshLock              sync.Mutex // protects refreshHandle which is updated whenever node gains/loses ownership
 		refreshHandle            *goro.Handle
 		endpointsRefreshInterval dynamicconfig.DurationPropertyFn
+		endpointTombstoneTTL     dynamicconfig.DurationPropertyFn
 
 		persistence p.NexusEndpointManager
 	}
@@ -94,11 +105,14 @@ type (
 
 func newEndpointClient(
 	endpointsRefreshInterval dynamicconfig.DurationPropertyFn,
+	endpointTombstoneTTL dynamicconfig.DurationPropertyFn,
 	persistence p.NexusEndpointManager,
 ) *nexusEndpointClient {
 	return &nexusEndpointClient{
 		endpointsRefreshInterval: endpointsRefreshInterval,
+		endpointTombstoneTTL:     endpointTombstoneTTL,
 		persistence:              persistence,
+		tombstones:               make(map[string]*persistencespb.NexusEndpointEntry),
 		tableVersionChanged:      make(chan struct{}),
 	}
 }
@@ -145,9 +159,7 @@ func (m *nexusEndpointClient) CreateNexusEndpoint(
 	m.endpointsByID[entry.Id] = entry
 	m.endpointsByName[entry.Endpoint.Spec.Name] = entry
 	m.insertEndpointLocked(entry)
-	ch := m.tableVersionChanged
-	m.tableVersionChanged = make(chan struct{})
-	close(ch)
+	m.notifyTableVersionChangedLocked()
 
 	return &matchingservice.CreateNexusEndpointResponse{
 		Entry: entry,
@@ -178,12 +190,27 @@ func (m *nexusEndpointClient) UpdateNexusEndpoint(
 		return nil, serviceerror.NewFailedPrecondition(fmt.Sprintf("nexus endpoint version mismatch. received: %v expected %v", request.version, previous.Version))
 	}
 
+	spec := request.spec
+	// Operation defaults and outbound auth are not part of the public endpoint spec, keep them on updates made through
+	// the operator API.
+	keepDefaults := spec.GetOperationDefaults() == nil && previous.Endpoint.Spec.GetOperationDefaults() != nil
+	keepAuth := spec.GetOutboundAuth() == nil && previous.Endpoint.Spec.GetOutboundAuth() != nil
+	if keepDefaults || keepAuth {
+		spec = common.CloneProto(spec)
+		if keepDefaults {
+			spec.OperationDefaults = previous.Endpoint.Spec.GetOperationDefaults()
+		}
+		if keepAuth {
+			spec.OutboundAuth = previous.Endpoint.Spec.GetOutboundAuth()
+		}
+	}
+
 	entry := &persistencespb.NexusEndpointEntry{
 		Version: previous.Version,
 		Id:      previous.Id,
 		Endpoint: &persistencespb.NexusEndpoint{
 			Clock: hlc.Next(previous.Endpoint.Clock, request.timeSource),
-			Spec:  request.spec,
+			Spec:  spec,
 		},
 	}
 
@@ -200,9 +227,7 @@ func (m *nexusEndpointClient) UpdateNexusEndpoint(
 	m.endpointsByID[entry.Id] = entry
 	m.endpointsByName[entry.Endpoint.Spec.Name] = entry
 	m.insertEndpointLocked(entry)
-	ch := m.tableVersionChanged
-	m.tableVersionChanged = make(chan struct{})
-	close(ch)
+	m.notifyTableVersionChangedLocked()
 
 	return &matchingservice.UpdateNexusEndpointResponse{
 		Entry: entry,
@@ -221,10 +246,12 @@ func (m *nexusEndpointClient) insertEndpointLocked(entry *persistencespb.NexusEn
 	}
 }
 
+// DeleteNexusEndpoint deletes an endpoint and returns the clock of the deletion, which is persisted as a tombstone so
+// that older changes replicated from remote clusters don't resurrect the endpoint.
 func (m *nexusEndpointClient) DeleteNexusEndpoint(
 	ctx context.Context,
-	request *matchingservice.DeleteNexusEndpointRequest,
-) (*matchingservice.DeleteNexusEndpointResponse, error) {
+	request *internalDeleteNexusEndpointRequest,
+) (*hlc.Clock, error) {
 	if !m.hasLoadedEndpoints.Load() {
 		// Endpoints must be loaded into memory before deletion so that the endpoint UUID can be looked up
 		if err := m.loadEndpoints(ctx); err != nil {
@@ -235,30 +262,158 @@ func (m *nexusEndpointClient) DeleteNexusEndpoint(
 	m.Lock()
 	defer m.Unlock()
 
-	entry, ok := m.endpointsByID[request.Id]
+	entry, ok := m.endpointsByID[request.endpointID]
 	if !ok {
-		return nil, serviceerror.NewNotFound(fmt.Sprintf("error deleting nexus endpoint with ID: %v", request.Id))
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("error deleting nexus endpoint with ID: %v", request.endpointID))
+	}
+
+	deleteClock := hlc.Next(entry.Endpoint.GetClock(), request.timeSource)
+	if err := m.writeTombstoneLocked(ctx, entry.Id, entry.Version, deleteClock); err != nil {
+		return nil, err
 	}
+	return deleteClock, nil
+}
 
-	err := m.persistence.DeleteNexusEndpoint(ctx, &p.DeleteNexusEndpointRequest{
+// writeTombstoneLocked replaces the persisted entry of an endpoint, or of a previous tombstone, with a tombstone
+// carrying the clock of the deletion. version is the version of the replaced entry, zero if there is none.
+func (m *nexusEndpointClient) writeTombstoneLocked(ctx context.Context, id string, version int64, deleteClock *hlc.Clock) error {
+	tombstone := &persistencespb.NexusEndpointEntry{
+		Version:  version,
+		Id:       id,
+		Endpoint: &persistencespb.NexusEndpoint{Clock: deleteClock},
+	}
+	resp, err := m.persistence.CreateOrUpdateNexusEndpoint(ctx, &p.CreateOrUpdateNexusEndpointRequest{
 		LastKnownTableVersion: m.tableVersion,
-		ID:                    entry.Id,
+		Entry:                 tombstone,
 	})
 	if err != nil {
-		return nil, err
+		return err
 	}
 
+	tombstone.Version = resp.Version
 	m.tableVersion++
-	delete(m.endpointsByID, request.Id)
-	delete(m.endpointsByName, entry.Endpoint.Spec.Name)
-	m.endpointEntries = slices.DeleteFunc(m.endpointEntries, func(entry *persistencespb.NexusEndpointEntry) bool {
-		return entry.Id == request.Id
+	if entry, ok := m.endpointsByID[id]; ok {
+		delete(m.endpointsByID, id)
+		delete(m.endpointsByName, entry.Endpoint.Spec.Name)
+		m.endpointEntries = slices.DeleteFunc(m.endpointEntries, func(e *persistencespb.NexusEndpointEntry) bool {
+			return e.Id == id
+		})
+	}
+	m.tombstones[id] = tombstone
+	m.notifyTableVersionChangedLocked()
+	return nil
+}
+
+// purgeExpiredTombstones removes the tombstones older than endpointTombstoneTTL from persistence. Changes replicated
+// from remote clusters are expected to arrive well within the TTL.
+func (m *nexusEndpointClient) purgeExpiredTombstones(ctx context.Context) {
+	m.Lock()
+	defer m.Unlock()
+
+	if !m.hasLoadedEndpoints.Load() {
+		return
+	}
+	ttl := m.endpointTombstoneTTL()
+	for id, tombstone := range m.tombstones {
+		if hlc.Since(tombstone.Endpoint.GetClock()) < ttl {
+			continue
+		}
+		if err := m.persistence.DeleteNexusEndpoint(ctx, &p.DeleteNexusEndpointRequest{
+			LastKnownTableVersion: m.tableVersion,
+			ID:                    id,
+		}); err != nil {
+			// retried on the next refresh, after a table version conflict is detected by checkTableVersion
+			return
+		}
+		m.tableVersion++
+		delete(m.tombstones, id)
+		m.notifyTableVersionChangedLocked()
+	}
+}
+
+// ApplyNexusEndpointReplicationEvent applies an endpoint change replicated from a remote cluster. Conflicting changes
+// made in different clusters are resolved by keeping the change with the greater hybrid logical clock. A deletion is
+// replicated as an endpoint without a spec whose clock is the clock of the deletion; it is persisted as a tombstone so
+// that older updates arriving afterwards are dropped instead of recreating the endpoint.
+func (m *nexusEndpointClient) ApplyNexusEndpointReplicationEvent(
+	ctx context.Context,
+	request *matchingservice.ApplyNexusEndpointReplicationEventRequest,
+) (*matchingservice.ApplyNexusEndpointReplicationEventResponse, error) {
+	endpoint := request.GetEndpoint()
+	if endpoint.GetClock() == nil {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("error applying replicated Nexus endpoint %v. Missing clock", request.GetId()))
+	}
+
+	if !m.hasLoadedEndpoints.Load() {
+		if err := m.loadEndpoints(ctx); err != nil {
+			return nil, fmt.Errorf("error loading nexus endpoints cache: %w", err)
+		}
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	previous, exists := m.endpointsByID[request.GetId()]
+	if exists && previous.Endpoint.GetClock() != nil && !hlc.Greater(endpoint.GetClock(), previous.Endpoint.GetClock()) {
+		// local endpoint is more recent
+		return &matchingservice.ApplyNexusEndpointReplicationEventResponse{}, nil
+	}
+	tombstone, deleted := m.tombstones[request.GetId()]
+	if deleted && !hlc.Greater(endpoint.GetClock(), tombstone.Endpoint.GetClock()) {
+		// endpoint was deleted after this change was made
+		return &matchingservice.ApplyNexusEndpointReplicationEventResponse{}, nil
+	}
+
+	var version int64
+	if exists {
+		version = previous.Version
+	} else if deleted {
+		version = tombstone.Version
+	}
+
+	if endpoint.GetSpec() == nil {
+		if err := m.writeTombstoneLocked(ctx, request.GetId(), version, endpoint.GetClock()); err != nil {
+			return nil, err
+		}
+		return &matchingservice.ApplyNexusEndpointReplicationEventResponse{}, nil
+	}
+
+	name := endpoint.GetSpec().GetName()
+	if other, ok := m.endpointsByName[name]; ok && other.Id != request.GetId() {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("error applying replicated Nexus endpoint %v. Endpoint with name %v already registered with ID %v", request.GetId(), name, other.Id))
+	}
+
+	entry := &persistencespb.NexusEndpointEntry{
+		Version:  version,
+		Id:       request.GetId(),
+		Endpoint: endpoint,
+	}
+	resp, err := m.persistence.CreateOrUpdateNexusEndpoint(ctx, &p.CreateOrUpdateNexusEndpointRequest{
+		LastKnownTableVersion: m.tableVersion,
+		Entry:                 entry,
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	entry.Version = resp.Version
+	m.tableVersion++
+	if exists {
+		delete(m.endpointsByName, previous.Endpoint.Spec.Name)
+	}
+	delete(m.tombstones, entry.Id)
+	m.endpointsByID[entry.Id] = entry
+	m.endpointsByName[name] = entry
+	m.insertEndpointLocked(entry)
+	m.notifyTableVersionChangedLocked()
+
+	return &matchingservice.ApplyNexusEndpointReplicationEventResponse{}, nil
+}
+
+func (m *nexusEndpointClient) notifyTableVersionChangedLocked() {
 	ch := m.tableVersionChanged
 	m.tableVersionChanged = make(chan struct{})
 	close(ch)
-
-	return &matchingservice.DeleteNexusEndpointResponse{}, nil
 }
 
 func (m *nexusEndpointClient) ListNexusEndpoints(
@@ -337,6 +492,7 @@ func (m *nexusEndpointClient) loadEndpoints(ctx context.Context) error {
 			LastKnownTableVersion: m.tableVersion,
 			NextPageToken:         pageToken,
 			PageSize:              loadEndpointsPageSize,
+			IncludeTombstones:     true,
 		})
 		if err != nil {
 			if errors.Is(err, p.ErrNexusTableVersionConflict) {
@@ -351,6 +507,10 @@ func (m *nexusEndpointClient) loadEndpoints(ctx context.Context) error {
 		pageToken = resp.NextPageToken
 		m.tableVersion = resp.TableVersion
 		for _, entry := range resp.Entries {
+			if entry.Endpoint.GetSpec() == nil {
+				m.tombstones[entry.Id] = entry
+				continue
+			}
 			m.endpointEntries = append(m.endpointEntries, entry)
 			m.endpointsByID[entry.Id] = entry
 			m.endpointsByName[entry.Endpoint.Spec.Name] = entry
@@ -370,6 +530,7 @@ func (m *nexusEndpointClient) resetCacheStateLocked() {
 	m.endpointEntries = []*persistencespb.NexusEndpointEntry{}
 	m.endpointsByID = make(map[string]*persistencespb.NexusEndpointEntry)
 	m.endpointsByName = make(map[string]*persistencespb.NexusEndpointEntry)
+	m.tombstones = make(map[string]*persistencespb.NexusEndpointEntry)
 }
 
 // notifyOwnershipChanged starts or stops a background routine which watches the Nexus endpoints table version for
@@ -402,6 +563,7 @@ func (m *nexusEndpointClient) notifyOwnershipChanged(isOwner bool) {
 func (m *nexusEndpointClient) refreshTableVersion(ctx context.Context) error {
 	for ctx.Err() == nil {
 		m.checkTableVersion(ctx)
+		m.purgeExpiredTombstones(ctx)
 		util.InterruptibleSleep(ctx, backoff.Jitter(m.endpointsRefreshInterval(), 0.2))
 	}
 	return ctx.Err()
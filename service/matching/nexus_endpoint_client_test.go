@@ -0,0 +1,386 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/testing/protorequire"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func newNexusEndpointClientForTest(t *testing.T, entries ...*persistencespb.NexusEndpointEntry) (*nexusEndpointClient, *p.MockNexusEndpointManager) {
+	ctrl := gomock.NewController(t)
+	persistence := p.NewMockNexusEndpointManager(ctrl)
+	persistence.EXPECT().ListNexusEndpoints(gomock.Any(), gomock.Any()).Return(&p.ListNexusEndpointsResponse{
+		TableVersion: int64(len(entries)),
+		Entries:      entries,
+	}, nil)
+	client := newEndpointClient(dynamicconfig.GetDurationPropertyFn(0), dynamicconfig.GetDurationPropertyFn(time.Hour), persistence)
+	require.NoError(t, client.loadEndpoints(context.Background()))
+	return client, persistence
+}
+
+func newNexusEndpointEntryForTest(id string, name string, clock *hlc.Clock) *persistencespb.NexusEndpointEntry {
+	return &persistencespb.NexusEndpointEntry{
+		Version: 1,
+		Id:      id,
+		Endpoint: &persistencespb.NexusEndpoint{
+			Clock: clock,
+			Spec:  &persistencespb.NexusEndpointSpec{Name: name},
+		},
+	}
+}
+
+func TestApplyNexusEndpointReplicationEvent_NewEndpoint(t *testing.T) {
+	client, persistence := newNexusEndpointClientForTest(t)
+
+	endpoint := &persistencespb.NexusEndpoint{
+		Clock: hlc.Zero(2),
+		Spec:  &persistencespb.NexusEndpointSpec{Name: "endpoint"},
+	}
+	persistence.EXPECT().CreateOrUpdateNexusEndpoint(gomock.Any(), &p.CreateOrUpdateNexusEndpointRequest{
+		LastKnownTableVersion: 0,
+		Entry: &persistencespb.NexusEndpointEntry{
+			Id:       "id",
+			Endpoint: endpoint,
+		},
+	}).Return(&p.CreateOrUpdateNexusEndpointResponse{Version: 1}, nil)
+
+	_, err := client.ApplyNexusEndpointReplicationEvent(context.Background(), &matchingservice.ApplyNexusEndpointReplicationEventRequest{
+		Id:       "id",
+		Endpoint: endpoint,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), client.tableVersion)
+	require.Equal(t, int64(1), client.endpointsByName["endpoint"].Version)
+	require.Len(t, client.endpointEntries, 1)
+}
+
+func TestApplyNexusEndpointReplicationEvent_LastWriterWins(t *testing.T) {
+	localClock := hlc.Zero(1)
+	localClock.WallClock = 100
+	client, persistence := newNexusEndpointClientForTest(t, newNexusEndpointEntryForTest("id", "endpoint", localClock))
+
+	// older remote change is ignored
+	olderClock := hlc.Zero(2)
+	olderClock.WallClock = 50
+	_, err := client.ApplyNexusEndpointReplicationEvent(context.Background(), &matchingservice.ApplyNexusEndpointReplicationEventRequest{
+		Id: "id",
+		Endpoint: &persistencespb.NexusEndpoint{
+			Clock: olderClock,
+			Spec:  &persistencespb.NexusEndpointSpec{Name: "older"},
+		},
+	})
+	require.NoError(t, err)
+	require.Contains(t, client.endpointsByName, "endpoint")
+
+	// newer remote change replaces the local endpoint
+	newerClock := hlc.Zero(2)
+	newerClock.WallClock = 200
+	persistence.EXPECT().CreateOrUpdateNexusEndpoint(gomock.Any(), gomock.Any()).Return(&p.CreateOrUpdateNexusEndpointResponse{Version: 2}, nil)
+	_, err = client.ApplyNexusEndpointReplicationEvent(context.Background(), &matchingservice.ApplyNexusEndpointReplicationEventRequest{
+		Id: "id",
+		Endpoint: &persistencespb.NexusEndpoint{
+			Clock: newerClock,
+			Spec:  &persistencespb.NexusEndpointSpec{Name: "renamed"},
+		},
+	})
+	require.NoError(t, err)
+	require.NotContains(t, client.endpointsByName, "endpoint")
+	require.Equal(t, int64(2), client.endpointsByID["id"].Version)
+	require.Equal(t, "renamed", client.endpointsByID["id"].Endpoint.Spec.Name)
+}
+
+func TestApplyNexusEndpointReplicationEvent_Delete(t *testing.T) {
+	localClock := hlc.Zero(1)
+	localClock.WallClock = 100
+	client, persistence := newNexusEndpointClientForTest(t, newNexusEndpointEntryForTest("id", "endpoint", localClock))
+
+	// deletion older than the local endpoint is ignored
+	olderClock := hlc.Zero(2)
+	olderClock.WallClock = 50
+	_, err := client.ApplyNexusEndpointReplicationEvent(context.Background(), &matchingservice.ApplyNexusEndpointReplicationEventRequest{
+		Id:       "id",
+		Endpoint: &persistencespb.NexusEndpoint{Clock: olderClock},
+	})
+	require.NoError(t, err)
+	require.Contains(t, client.endpointsByID, "id")
+
+	// newer deletion replaces the endpoint with a tombstone
+	deleteClock := hlc.Zero(2)
+	deleteClock.WallClock = 200
+	persistence.EXPECT().CreateOrUpdateNexusEndpoint(gomock.Any(), &p.CreateOrUpdateNexusEndpointRequest{
+		LastKnownTableVersion: 1,
+		Entry: &persistencespb.NexusEndpointEntry{
+			Version:  1,
+			Id:       "id",
+			Endpoint: &persistencespb.NexusEndpoint{Clock: deleteClock},
+		},
+	}).Return(&p.CreateOrUpdateNexusEndpointResponse{Version: 2}, nil)
+	_, err = client.ApplyNexusEndpointReplicationEvent(context.Background(), &matchingservice.ApplyNexusEndpointReplicationEventRequest{
+		Id:       "id",
+		Endpoint: &persistencespb.NexusEndpoint{Clock: deleteClock},
+	})
+	require.NoError(t, err)
+	require.Empty(t, client.endpointsByID)
+	require.Empty(t, client.endpointEntries)
+	protorequire.ProtoEqual(t, deleteClock, client.tombstones["id"].Endpoint.Clock)
+
+	// update made before the deletion doesn't resurrect the endpoint
+	updateClock := hlc.Zero(1)
+	updateClock.WallClock = 150
+	_, err = client.ApplyNexusEndpointReplicationEvent(context.Background(), &matchingservice.ApplyNexusEndpointReplicationEventRequest{
+		Id: "id",
+		Endpoint: &persistencespb.NexusEndpoint{
+			Clock: updateClock,
+			Spec:  &persistencespb.NexusEndpointSpec{Name: "endpoint"},
+		},
+	})
+	require.NoError(t, err)
+	require.Empty(t, client.endpointsByID)
+
+	// update made after the deletion recreates the endpoint and clears the tombstone
+	recreateClock := hlc.Zero(1)
+	recreateClock.WallClock = 300
+	persistence.EXPECT().CreateOrUpdateNexusEndpoint(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *p.CreateOrUpdateNexusEndpointRequest) (*p.CreateOrUpdateNexusEndpointResponse, error) {
+			// the tombstone entry is overwritten
+			require.Equal(t, int64(2), request.Entry.Version)
+			return &p.CreateOrUpdateNexusEndpointResponse{Version: 3}, nil
+		},
+	)
+	_, err = client.ApplyNexusEndpointReplicationEvent(context.Background(), &matchingservice.ApplyNexusEndpointReplicationEventRequest{
+		Id: "id",
+		Endpoint: &persistencespb.NexusEndpoint{
+			Clock: recreateClock,
+			Spec:  &persistencespb.NexusEndpointSpec{Name: "endpoint"},
+		},
+	})
+	require.NoError(t, err)
+	require.Contains(t, client.endpointsByID, "id")
+	require.NotContains(t, client.tombstones, "id")
+}
+
+func TestApplyNexusEndpointReplicationEvent_DeleteUnknownEndpoint(t *testing.T) {
+	client, persistence := newNexusEndpointClientForTest(t)
+
+	// deletion of an endpoint this cluster hasn't seen yet still leaves a tombstone for the creation arriving later
+	deleteClock := hlc.Zero(2)
+	deleteClock.WallClock = 200
+	persistence.EXPECT().CreateOrUpdateNexusEndpoint(gomock.Any(), &p.CreateOrUpdateNexusEndpointRequest{
+		Entry: &persistencespb.NexusEndpointEntry{
+			Id:       "id",
+			Endpoint: &persistencespb.NexusEndpoint{Clock: deleteClock},
+		},
+	}).Return(&p.CreateOrUpdateNexusEndpointResponse{Version: 1}, nil)
+	_, err := client.ApplyNexusEndpointReplicationEvent(context.Background(), &matchingservice.ApplyNexusEndpointReplicationEventRequest{
+		Id:       "id",
+		Endpoint: &persistencespb.NexusEndpoint{Clock: deleteClock},
+	})
+	require.NoError(t, err)
+
+	createClock := hlc.Zero(2)
+	createClock.WallClock = 100
+	_, err = client.ApplyNexusEndpointReplicationEvent(context.Background(), &matchingservice.ApplyNexusEndpointReplicationEventRequest{
+		Id: "id",
+		Endpoint: &persistencespb.NexusEndpoint{
+			Clock: createClock,
+			Spec:  &persistencespb.NexusEndpointSpec{Name: "endpoint"},
+		},
+	})
+	require.NoError(t, err)
+	require.Empty(t, client.endpointsByID)
+
+	// events without a clock can't be ordered
+	_, err = client.ApplyNexusEndpointReplicationEvent(context.Background(), &matchingservice.ApplyNexusEndpointReplicationEventRequest{
+		Id: "id",
+	})
+	var invalidArgErr *serviceerror.InvalidArgument
+	require.ErrorAs(t, err, &invalidArgErr)
+}
+
+func TestDeleteNexusEndpoint_LeavesTombstone(t *testing.T) {
+	localClock := hlc.Zero(1)
+	localClock.WallClock = 100
+	client, persistence := newNexusEndpointClientForTest(t, newNexusEndpointEntryForTest("id", "endpoint", localClock))
+
+	persistence.EXPECT().CreateOrUpdateNexusEndpoint(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *p.CreateOrUpdateNexusEndpointRequest) (*p.CreateOrUpdateNexusEndpointResponse, error) {
+			require.Equal(t, int64(1), request.Entry.Version)
+			require.Nil(t, request.Entry.Endpoint.Spec)
+			return &p.CreateOrUpdateNexusEndpointResponse{Version: 2}, nil
+		},
+	)
+	deleteClock, err := client.DeleteNexusEndpoint(context.Background(), &internalDeleteNexusEndpointRequest{
+		endpointID: "id",
+		timeSource: clock.NewEventTimeSource().Update(time.UnixMilli(50)),
+	})
+	require.NoError(t, err)
+	require.True(t, hlc.Greater(deleteClock, localClock))
+	protorequire.ProtoEqual(t, deleteClock, client.tombstones["id"].Endpoint.Clock)
+	require.Empty(t, client.endpointsByID)
+	require.Empty(t, client.endpointsByName)
+	require.Equal(t, int64(2), client.tableVersion)
+
+	// deleted endpoints are not found again
+	_, err = client.DeleteNexusEndpoint(context.Background(), &internalDeleteNexusEndpointRequest{
+		endpointID: "id",
+		timeSource: clock.NewRealTimeSource(),
+	})
+	var notFoundErr *serviceerror.NotFound
+	require.ErrorAs(t, err, &notFoundErr)
+}
+
+func TestLoadNexusEndpoints_Tombstones(t *testing.T) {
+	deleteClock := hlc.Zero(1)
+	deleteClock.WallClock = 200
+	client, _ := newNexusEndpointClientForTest(t,
+		newNexusEndpointEntryForTest("id-1", "endpoint", hlc.Zero(1)),
+		&persistencespb.NexusEndpointEntry{
+			Version:  2,
+			Id:       "id-2",
+			Endpoint: &persistencespb.NexusEndpoint{Clock: deleteClock},
+		},
+	)
+
+	// persisted tombstones are not listed
+	resp, _, err := client.ListNexusEndpoints(context.Background(), &matchingservice.ListNexusEndpointsRequest{PageSize: 10})
+	require.NoError(t, err)
+	require.Len(t, resp.Entries, 1)
+	require.Equal(t, "id-1", resp.Entries[0].Id)
+
+	// and still order replicated changes after a reload
+	createClock := hlc.Zero(2)
+	createClock.WallClock = 100
+	_, err = client.ApplyNexusEndpointReplicationEvent(context.Background(), &matchingservice.ApplyNexusEndpointReplicationEventRequest{
+		Id: "id-2",
+		Endpoint: &persistencespb.NexusEndpoint{
+			Clock: createClock,
+			Spec:  &persistencespb.NexusEndpointSpec{Name: "deleted"},
+		},
+	})
+	require.NoError(t, err)
+	require.NotContains(t, client.endpointsByID, "id-2")
+}
+
+func TestPurgeExpiredTombstones(t *testing.T) {
+	expiredClock := hlc.Zero(1)
+	expiredClock.WallClock = time.Now().Add(-2 * time.Hour).UnixMilli()
+	recentClock := hlc.Zero(1)
+	recentClock.WallClock = time.Now().UnixMilli()
+	client, persistence := newNexusEndpointClientForTest(t,
+		&persistencespb.NexusEndpointEntry{Version: 1, Id: "expired", Endpoint: &persistencespb.NexusEndpoint{Clock: expiredClock}},
+		&persistencespb.NexusEndpointEntry{Version: 1, Id: "recent", Endpoint: &persistencespb.NexusEndpoint{Clock: recentClock}},
+	)
+
+	persistence.EXPECT().DeleteNexusEndpoint(gomock.Any(), &p.DeleteNexusEndpointRequest{
+		LastKnownTableVersion: 2,
+		ID:                    "expired",
+	}).Return(nil)
+	client.purgeExpiredTombstones(context.Background())
+	require.NotContains(t, client.tombstones, "expired")
+	require.Contains(t, client.tombstones, "recent")
+	require.Equal(t, int64(3), client.tableVersion)
+}
+
+func TestUpdateNexusEndpoint_KeepsOperationDefaults(t *testing.T) {
+	entry := newNexusEndpointEntryForTest("id", "endpoint", hlc.Zero(1))
+	defaults := &persistencespb.NexusOperationDefaults{ScheduleToCloseTimeout: durationpb.New(time.Minute)}
+	entry.Endpoint.Spec.OperationDefaults = defaults
+	client, persistence := newNexusEndpointClientForTest(t, entry)
+	persistence.EXPECT().CreateOrUpdateNexusEndpoint(gomock.Any(), gomock.Any()).Return(&p.CreateOrUpdateNexusEndpointResponse{Version: 2}, nil).Times(2)
+
+	// updates through the operator API don't carry operation defaults
+	resp, err := client.UpdateNexusEndpoint(context.Background(), &internalUpdateNexusEndpointRequest{
+		endpointID: "id",
+		version:    1,
+		spec:       &persistencespb.NexusEndpointSpec{Name: "renamed"},
+		timeSource: clock.NewRealTimeSource(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "renamed", resp.Entry.Endpoint.Spec.Name)
+	protorequire.ProtoEqual(t, defaults, resp.Entry.Endpoint.Spec.OperationDefaults)
+
+	// explicitly set defaults replace the previous ones
+	resp, err = client.UpdateNexusEndpoint(context.Background(), &internalUpdateNexusEndpointRequest{
+		endpointID: "id",
+		version:    2,
+		spec: &persistencespb.NexusEndpointSpec{
+			Name:              "renamed",
+			OperationDefaults: &persistencespb.NexusOperationDefaults{},
+		},
+		timeSource: clock.NewRealTimeSource(),
+	})
+	require.NoError(t, err)
+	require.Nil(t, resp.Entry.Endpoint.Spec.OperationDefaults.GetScheduleToCloseTimeout())
+}
+
+func TestUpdateNexusEndpoint_KeepsOutboundAuth(t *testing.T) {
+	entry := newNexusEndpointEntryForTest("id", "endpoint", hlc.Zero(1))
+	auth := &persistencespb.NexusOutboundAuth{
+		Token: &persistencespb.NexusOutboundAuth_BearerToken_{
+			BearerToken: &persistencespb.NexusOutboundAuth_BearerToken{TokenSecretName: "token"},
+		},
+	}
+	entry.Endpoint.Spec.OutboundAuth = auth
+	client, persistence := newNexusEndpointClientForTest(t, entry)
+	persistence.EXPECT().CreateOrUpdateNexusEndpoint(gomock.Any(), gomock.Any()).Return(&p.CreateOrUpdateNexusEndpointResponse{Version: 2}, nil).Times(2)
+
+	// updates through the operator API don't carry outbound auth
+	resp, err := client.UpdateNexusEndpoint(context.Background(), &internalUpdateNexusEndpointRequest{
+		endpointID: "id",
+		version:    1,
+		spec:       &persistencespb.NexusEndpointSpec{Name: "renamed"},
+		timeSource: clock.NewRealTimeSource(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "renamed", resp.Entry.Endpoint.Spec.Name)
+	protorequire.ProtoEqual(t, auth, resp.Entry.Endpoint.Spec.OutboundAuth)
+
+	// explicitly set auth replaces the previous one
+	resp, err = client.UpdateNexusEndpoint(context.Background(), &internalUpdateNexusEndpointRequest{
+		endpointID: "id",
+		version:    2,
+		spec: &persistencespb.NexusEndpointSpec{
+			Name:         "renamed",
+			OutboundAuth: &persistencespb.NexusOutboundAuth{},
+		},
+		timeSource: clock.NewRealTimeSource(),
+	})
+	require.NoError(t, err)
+	require.Nil(t, resp.Entry.Endpoint.Spec.OutboundAuth.GetToken())
+}
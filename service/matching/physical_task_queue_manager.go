@@ -35,6 +35,7 @@ import (
 
 	"github.com/nexus-rpc/sdk-go/nexus"
 	"github.com/pborman/uuid"
+	"go.opentelemetry.io/otel/trace"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
@@ -422,6 +423,7 @@ func (c *physicalTaskQueueManagerImpl) DispatchQueryTask(
 	request *matchingservice.QueryWorkflowRequest,
 ) (*matchingservice.QueryWorkflowResponse, error) {
 	task := newInternalQueryTask(taskId, request)
+	task.spanContext = trace.SpanContextFromContext(ctx)
 	if !task.isForwarded() {
 		c.tasksAddedInIntervals.incrementTaskCount()
 	}
@@ -447,6 +449,7 @@ func (c *physicalTaskQueueManagerImpl) DispatchNexusTask(
 		}
 	}
 	task := newInternalNexusTask(taskId, deadline, opDeadline, request)
+	task.spanContext = trace.SpanContextFromContext(ctx)
 	if !task.isForwarded() {
 		c.tasksAddedInIntervals.incrementTaskCount()
 	}
@@ -0,0 +1,170 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/matchingservice/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// StreamPollTaskQueue serves the polls which the matching client multiplexes over one stream per task queue
+// partition. Every poll runs concurrently, through the same interceptors as the unary poll APIs, and its result
+// is sent back tagged with the ID of the poll. Polls still outstanding when the stream ends are canceled.
+func (h *Handler) StreamPollTaskQueue(server matchingservice.MatchingService_StreamPollTaskQueueServer) error {
+	var (
+		lock     sync.Mutex
+		sendLock sync.Mutex
+		polls    = make(map[int64]context.CancelFunc)
+		wg       sync.WaitGroup
+	)
+	defer func() {
+		lock.Lock()
+		for _, cancel := range polls {
+			cancel()
+		}
+		lock.Unlock()
+		wg.Wait()
+	}()
+
+	for {
+		request, err := server.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		pollID := request.GetPollId()
+		if request.GetCancel() {
+			lock.Lock()
+			if cancel, ok := polls[pollID]; ok {
+				cancel()
+			}
+			lock.Unlock()
+			continue
+		}
+
+		ctx, cancel := newStreamPollContext(server.Context(), request)
+		lock.Lock()
+		polls[pollID] = cancel
+		lock.Unlock()
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp := h.streamPoll(ctx, request)
+			resp.PollId = pollID
+
+			lock.Lock()
+			delete(polls, pollID)
+			lock.Unlock()
+			cancel()
+
+			sendLock.Lock()
+			defer sendLock.Unlock()
+			// A failed send means the stream is done, which is noticed by the receiving loop.
+			_ = server.Send(resp)
+		}()
+	}
+}
+
+// streamPoll runs a single poll received over StreamPollTaskQueue and converts its result to a stream response.
+func (h *Handler) streamPoll(
+	ctx context.Context,
+	request *matchingservice.StreamPollTaskQueueRequest,
+) *matchingservice.StreamPollTaskQueueResponse {
+	var (
+		pollRequest any
+		info        = &grpc.UnaryServerInfo{Server: h}
+		handler     grpc.UnaryHandler
+	)
+	switch r := request.GetRequest().(type) {
+	case *matchingservice.StreamPollTaskQueueRequest_PollWorkflowTaskQueue:
+		pollRequest = r.PollWorkflowTaskQueue
+		info.FullMethod = matchingservice.MatchingService_PollWorkflowTaskQueue_FullMethodName
+		handler = func(ctx context.Context, req any) (any, error) {
+			return h.PollWorkflowTaskQueue(ctx, req.(*matchingservice.PollWorkflowTaskQueueRequest))
+		}
+	case *matchingservice.StreamPollTaskQueueRequest_PollActivityTaskQueue:
+		pollRequest = r.PollActivityTaskQueue
+		info.FullMethod = matchingservice.MatchingService_PollActivityTaskQueue_FullMethodName
+		handler = func(ctx context.Context, req any) (any, error) {
+			return h.PollActivityTaskQueue(ctx, req.(*matchingservice.PollActivityTaskQueueRequest))
+		}
+	default:
+		return newStreamPollErrorResponse(serviceerror.NewInvalidArgument("unknown poll request type"))
+	}
+
+	resp, err := h.telemetryInterceptor.UnaryIntercept(ctx, pollRequest, info, func(ctx context.Context, req any) (any, error) {
+		return h.rateLimitInterceptor.Intercept(ctx, req, info, handler)
+	})
+	if err != nil {
+		return newStreamPollErrorResponse(err)
+	}
+
+	switch r := resp.(type) {
+	case *matchingservice.PollWorkflowTaskQueueResponse:
+		return &matchingservice.StreamPollTaskQueueResponse{
+			Response: &matchingservice.StreamPollTaskQueueResponse_PollWorkflowTaskQueue{PollWorkflowTaskQueue: r},
+		}
+	case *matchingservice.PollActivityTaskQueueResponse:
+		return &matchingservice.StreamPollTaskQueueResponse{
+			Response: &matchingservice.StreamPollTaskQueueResponse_PollActivityTaskQueue{PollActivityTaskQueue: r},
+		}
+	default:
+		return newStreamPollErrorResponse(serviceerror.NewInternal("unknown poll response type"))
+	}
+}
+
+// newStreamPollContext returns the context of a poll received over the stream: the stream context with the
+// deadline and headers that a unary poll would have carried.
+func newStreamPollContext(
+	streamCtx context.Context,
+	request *matchingservice.StreamPollTaskQueueRequest,
+) (context.Context, context.CancelFunc) {
+	md, _ := metadata.FromIncomingContext(streamCtx)
+	md = md.Copy()
+	for name, value := range request.GetHeaders() {
+		md.Set(name, value)
+	}
+	ctx := metadata.NewIncomingContext(streamCtx, md)
+	if request.GetTimeout() != nil {
+		return context.WithTimeout(ctx, request.GetTimeout().AsDuration())
+	}
+	return context.WithCancel(ctx)
+}
+
+func newStreamPollErrorResponse(err error) *matchingservice.StreamPollTaskQueueResponse {
+	return &matchingservice.StreamPollTaskQueueResponse{
+		Response: &matchingservice.StreamPollTaskQueueResponse_Error{Error: serviceerror.ToStatus(err).Proto()},
+	}
+}
@@ -0,0 +1,106 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/api/matchingservice/v1"
+	"go.temporal.io/server/common/headers"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+type fakePollStreamServer struct {
+	matchingservice.MatchingService_StreamPollTaskQueueServer
+	ctx       context.Context
+	requests  chan *matchingservice.StreamPollTaskQueueRequest
+	responses chan *matchingservice.StreamPollTaskQueueResponse
+}
+
+func (s *fakePollStreamServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakePollStreamServer) Recv() (*matchingservice.StreamPollTaskQueueRequest, error) {
+	request, ok := <-s.requests
+	if !ok {
+		return nil, io.EOF
+	}
+	return request, nil
+}
+
+func (s *fakePollStreamServer) Send(resp *matchingservice.StreamPollTaskQueueResponse) error {
+	s.responses <- resp
+	return nil
+}
+
+func TestNewStreamPollContext(t *testing.T) {
+	streamCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("stream-header", "stream-value"))
+
+	ctx, cancel := newStreamPollContext(streamCtx, &matchingservice.StreamPollTaskQueueRequest{
+		Timeout: durationpb.New(time.Minute),
+		Headers: map[string]string{headers.CallerNameHeaderName: "caller"},
+	})
+	defer cancel()
+
+	deadline, ok := ctx.Deadline()
+	require.True(t, ok)
+	require.WithinDuration(t, time.Now().Add(time.Minute), deadline, 10*time.Second)
+	require.Equal(t, "caller", headers.GetCallerInfo(ctx).CallerName)
+	require.Equal(t, []string{"stream-value"}, metadata.ValueFromIncomingContext(ctx, "stream-header"))
+
+	md, _ := metadata.FromIncomingContext(streamCtx)
+	require.Empty(t, md.Get(headers.CallerNameHeaderName), "stream metadata must not be modified")
+}
+
+func TestStreamPollTaskQueue_InvalidRequest(t *testing.T) {
+	h := &Handler{}
+	server := &fakePollStreamServer{
+		ctx:       context.Background(),
+		requests:  make(chan *matchingservice.StreamPollTaskQueueRequest, 2),
+		responses: make(chan *matchingservice.StreamPollTaskQueueResponse, 1),
+	}
+	// Canceling a poll which is not outstanding is a no-op.
+	server.requests <- &matchingservice.StreamPollTaskQueueRequest{
+		PollId:  1,
+		Request: &matchingservice.StreamPollTaskQueueRequest_Cancel{Cancel: true},
+	}
+	server.requests <- &matchingservice.StreamPollTaskQueueRequest{PollId: 2}
+	close(server.requests)
+
+	require.NoError(t, h.StreamPollTaskQueue(server))
+
+	resp := <-server.responses
+	require.Equal(t, int64(2), resp.GetPollId())
+	require.Equal(t, codes.InvalidArgument, status.FromProto(resp.GetError()).Code())
+	require.Empty(t, server.responses)
+}
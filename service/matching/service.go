@@ -32,6 +32,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/membership/drain"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/util"
@@ -53,6 +54,7 @@ type Service struct {
 	runtimeMetricsReporter *metrics.RuntimeMetricsReporter
 	metricsHandler         metrics.Handler
 	healthServer           *health.Server
+	drainServer            *drain.Server
 	visibilityManager      manager.VisibilityManager
 }
 
@@ -67,6 +69,7 @@ func NewService(
 	metricsHandler metrics.Handler,
 	healthServer *health.Server,
 	visibilityManager manager.VisibilityManager,
+	drainServer *drain.Server,
 ) *Service {
 	return &Service{
 		config:                 serviceConfig,
@@ -79,6 +82,7 @@ func NewService(
 		metricsHandler:         metricsHandler,
 		healthServer:           healthServer,
 		visibilityManager:      visibilityManager,
+		drainServer:            drainServer,
 	}
 }
 
@@ -105,6 +109,10 @@ func (s *Service) Start() {
 	}()
 
 	go s.membershipMonitor.Start()
+
+	if s.drainServer != nil {
+		s.drainServer.Start()
+	}
 }
 
 // Stop stops the service
@@ -144,6 +152,9 @@ func (s *Service) Stop() {
 	t.Stop()
 
 	s.visibilityManager.Close()
+	if s.drainServer != nil {
+		s.drainServer.Stop()
+	}
 
 	s.logger.Info("matching stopped")
 }
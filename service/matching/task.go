@@ -27,6 +27,7 @@ package matching
 import (
 	"time"
 
+	"go.opentelemetry.io/otel/trace"
 	commonpb "go.temporal.io/api/common/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
@@ -80,6 +81,9 @@ type (
 		// based on forwardInfo.
 		redirectInfo *taskqueuespb.BuildIdRedirectInfo
 		recycleToken func()
+		// spanContext is the span context of the request that added a locally sync matched task, used to link the
+		// poll that receives the task back to it.
+		spanContext trace.SpanContext
 	}
 )
 
@@ -327,6 +327,7 @@ func (tr *taskReader) addSingleTaskToBuffer(
 }
 
 func (tr *taskReader) persistAckBacklogCountLevel(ctx context.Context) error {
+	tr.backlogMgr.taskAckManager.reconcileBacklogCount()
 	ackLevel := tr.backlogMgr.taskAckManager.getAckLevel()
 	return tr.backlogMgr.db.UpdateState(ctx, ackLevel)
 }
@@ -28,17 +28,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"math"
 	"time"
 
 	"github.com/pborman/uuid"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/activity"
 	sdkclient "go.temporal.io/sdk/client"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -46,7 +49,7 @@ import (
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/sdk"
-	"golang.org/x/time/rate"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 const (
@@ -63,6 +66,9 @@ type activities struct {
 	namespaceID namespace.ID
 	rps         dynamicconfig.IntPropertyFnWithNamespaceFilter
 	concurrency dynamicconfig.IntPropertyFnWithNamespaceFilter
+
+	adaptiveRateLimit       dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	adaptiveRateLimitTarget dynamicconfig.DurationPropertyFnWithNamespaceFilter
 }
 
 func (a *activities) checkNamespace(namespace string) error {
@@ -123,32 +129,40 @@ func (a *activities) BatchActivity(ctx context.Context, batchParams BatchParams)
 		}
 		hbd.TotalEstimate = estimateCount
 	}
-	rps := a.getOperationRPS(batchParams.RPS)
-	rateLimit := rate.Limit(rps)
-	burstLimit := int(math.Ceil(rps)) // should never be zero because everything would be rejected
-	rateLimiter := rate.NewLimiter(rateLimit, burstLimit)
+	rateLimiter := newAdaptiveRateLimiter(
+		a.getOperationRPS(batchParams.RPS),
+		a.adaptiveRateLimit(a.namespace.String()),
+		a.adaptiveRateLimitTarget(a.namespace.String()),
+		clock.NewRealTimeSource(),
+		metricsHandler,
+	)
 	taskCh := make(chan taskDetail, pageSize)
 	respCh := make(chan error, pageSize)
 	for i := 0; i < a.getOperationConcurrency(batchParams.Concurrency); i++ {
-		go startTaskProcessor(ctx, batchParams, taskCh, respCh, rateLimiter, sdkClient, a.FrontendClient, metricsHandler, logger)
+		go startTaskProcessor(ctx, batchParams, a.namespaceID, taskCh, respCh, rateLimiter, sdkClient, a.FrontendClient, a.HistoryClient, metricsHandler, logger)
 	}
 
 	for {
 		executions := batchParams.Executions
 		pageToken := hbd.PageToken
 		if len(batchParams.Query) > 0 {
-			resp, err := sdkClient.ListWorkflow(ctx, &workflowservice.ListWorkflowExecutionsRequest{
-				PageSize:      int32(pageSize),
-				NextPageToken: pageToken,
-				Query:         batchParams.Query,
-			})
+			resp, err := a.listWorkflows(ctx, sdkClient, batchParams, pageToken)
 			if err != nil {
 				metrics.BatcherOperationFailures.With(metricsHandler).Record(1)
 				logger.Error("Failed to list workflow executions", tag.Error(err))
 				return HeartBeatDetails{}, err
 			}
 			pageToken = resp.NextPageToken
+			executions = make([]*commonpb.WorkflowExecution, 0, len(resp.Executions))
+			seenWorkflowIDs := make(map[string]struct{}, len(resp.Executions))
 			for _, wf := range resp.Executions {
+				if batchParams.BatchType == BatchTypeSignalWithStart {
+					// signal-with-start only targets workflow IDs, signal each of them once per page
+					if _, ok := seenWorkflowIDs[wf.Execution.GetWorkflowId()]; ok {
+						continue
+					}
+					seenWorkflowIDs[wf.Execution.GetWorkflowId()] = struct{}{}
+				}
 				executions = append(executions, wf.Execution)
 			}
 		}
@@ -212,6 +226,27 @@ func (a *activities) getActivityLogger(ctx context.Context) log.Logger {
 	)
 }
 
+// listWorkflows lists the next page of workflows matching the query of the batch operation. The workflows of a
+// signal-with-start operation may be derived from a query on another namespace.
+func (a *activities) listWorkflows(
+	ctx context.Context,
+	sdkClient sdkclient.Client,
+	batchParams BatchParams,
+	pageToken []byte,
+) (*workflowservice.ListWorkflowExecutionsResponse, error) {
+	request := &workflowservice.ListWorkflowExecutionsRequest{
+		PageSize:      int32(pageSize),
+		NextPageToken: pageToken,
+		Query:         batchParams.Query,
+	}
+	sourceNamespace := batchParams.SignalWithStartParams.SourceNamespace
+	if batchParams.BatchType == BatchTypeSignalWithStart && sourceNamespace != "" && sourceNamespace != batchParams.Namespace {
+		request.Namespace = sourceNamespace
+		return a.FrontendClient.ListWorkflowExecutions(ctx, request)
+	}
+	return sdkClient.ListWorkflow(ctx, request)
+}
+
 func (a *activities) getOperationRPS(requestedRPS float64) float64 {
 	maxRPS := float64(a.rps(a.namespace.String()))
 	if requestedRPS <= 0 || requestedRPS > maxRPS {
@@ -230,11 +265,13 @@ func (a *activities) getOperationConcurrency(concurrency int) int {
 func startTaskProcessor(
 	ctx context.Context,
 	batchParams BatchParams,
+	namespaceID namespace.ID,
 	taskCh chan taskDetail,
 	respCh chan error,
-	limiter *rate.Limiter,
+	limiter *adaptiveRateLimiter,
 	sdkClient sdkclient.Client,
 	frontendClient workflowservice.WorkflowServiceClient,
+	historyClient historyservice.HistoryServiceClient,
 	metricsHandler metrics.Handler,
 	logger log.Logger,
 ) {
@@ -336,6 +373,51 @@ func startTaskProcessor(
 						})
 						return err
 					})
+			case BatchTypeSignalWithStart:
+				err = processTask(ctx, limiter, task,
+					func(workflowID, _ string) error {
+						params := batchParams.SignalWithStartParams
+						_, err := frontendClient.SignalWithStartWorkflowExecution(ctx, &workflowservice.SignalWithStartWorkflowExecutionRequest{
+							Namespace:                batchParams.Namespace,
+							WorkflowId:               workflowID,
+							WorkflowType:             &commonpb.WorkflowType{Name: params.WorkflowType},
+							TaskQueue:                &taskqueuepb.TaskQueue{Name: params.TaskQueue, Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+							Input:                    params.Input,
+							WorkflowExecutionTimeout: durationpb.New(params.WorkflowExecutionTimeout),
+							WorkflowRunTimeout:       durationpb.New(params.WorkflowRunTimeout),
+							RequestId:                uuid.New(),
+							SignalName:               params.SignalName,
+							SignalInput:              params.SignalInput,
+						})
+						return err
+					})
+			case BatchTypeRebuildMutableState:
+				err = processTask(ctx, limiter, task,
+					func(workflowID, runID string) error {
+						_, err := historyClient.RebuildMutableState(ctx, &historyservice.RebuildMutableStateRequest{
+							NamespaceId: namespaceID.String(),
+							Execution: &commonpb.WorkflowExecution{
+								WorkflowId: workflowID,
+								RunId:      runID,
+							},
+						})
+						return err
+					})
+			case BatchTypeRefreshWorkflowTasks:
+				err = processTask(ctx, limiter, task,
+					func(workflowID, runID string) error {
+						_, err := historyClient.RefreshWorkflowTasks(ctx, &historyservice.RefreshWorkflowTasksRequest{
+							NamespaceId: namespaceID.String(),
+							Request: &adminservice.RefreshWorkflowTasksRequest{
+								NamespaceId: namespaceID.String(),
+								Execution: &commonpb.WorkflowExecution{
+									WorkflowId: workflowID,
+									RunId:      runID,
+								},
+							},
+						})
+						return err
+					})
 			}
 			if err != nil {
 				metrics.BatcherProcessorFailures.With(metricsHandler).Record(1)
@@ -359,7 +441,7 @@ func startTaskProcessor(
 
 func processTask(
 	ctx context.Context,
-	limiter *rate.Limiter,
+	limiter *adaptiveRateLimiter,
 	task taskDetail,
 	procFn func(string, string) error,
 ) error {
@@ -370,7 +452,9 @@ func processTask(
 	}
 	activity.RecordHeartbeat(ctx, task.hbd)
 
+	startTime := time.Now().UTC()
 	err = procFn(task.execution.GetWorkflowId(), task.execution.GetRunId())
+	limiter.Record(err, time.Since(startTime))
 	if err != nil {
 		// NotFound means wf is not running or deleted
 		if !common.IsNotFoundError(err) {
@@ -37,8 +37,15 @@ import (
 	historypb "go.temporal.io/api/history/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/activity"
 	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/testing/mockapi/workflowservicemock/v1"
 	"go.uber.org/mock/gomock"
@@ -312,3 +319,64 @@ func (s *activitiesSuite) TestGetResetPoint() {
 		})
 	}
 }
+
+func (s *activitiesSuite) TestStartTaskProcessor_RebuildMutableState() {
+	execution := &commonpb.WorkflowExecution{WorkflowId: "wid", RunId: "rid"}
+	mockHistoryClient := historyservicemock.NewMockHistoryServiceClient(s.controller)
+	mockHistoryClient.EXPECT().RebuildMutableState(gomock.Any(), &historyservice.RebuildMutableStateRequest{
+		NamespaceId: "test-namespace-id",
+		Execution:   execution,
+	}).Return(&historyservice.RebuildMutableStateResponse{}, nil)
+
+	s.NoError(s.processTask(BatchTypeRebuildMutableState, execution, mockHistoryClient))
+}
+
+func (s *activitiesSuite) TestStartTaskProcessor_RefreshWorkflowTasks() {
+	execution := &commonpb.WorkflowExecution{WorkflowId: "wid"}
+	mockHistoryClient := historyservicemock.NewMockHistoryServiceClient(s.controller)
+	mockHistoryClient.EXPECT().RefreshWorkflowTasks(gomock.Any(), &historyservice.RefreshWorkflowTasksRequest{
+		NamespaceId: "test-namespace-id",
+		Request: &adminservice.RefreshWorkflowTasksRequest{
+			NamespaceId: "test-namespace-id",
+			Execution:   execution,
+		},
+	}).Return(&historyservice.RefreshWorkflowTasksResponse{}, nil)
+
+	s.NoError(s.processTask(BatchTypeRefreshWorkflowTasks, execution, mockHistoryClient))
+}
+
+// processTask runs a task processor for a single execution of a batch operation of namespace test-namespace-id.
+func (s *activitiesSuite) processTask(
+	batchType string,
+	execution *commonpb.WorkflowExecution,
+	historyClient historyservice.HistoryServiceClient,
+) error {
+	// the processor heartbeats, so it needs to run in an activity
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivityWithOptions(func(ctx context.Context) error {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		taskCh := make(chan taskDetail, 1)
+		respCh := make(chan error, 1)
+		limiter := newAdaptiveRateLimiter(100, false, time.Second, clock.NewRealTimeSource(), metrics.NoopMetricsHandler)
+		go startTaskProcessor(
+			ctx,
+			BatchParams{Namespace: "test-namespace", BatchType: batchType},
+			namespace.ID("test-namespace-id"),
+			taskCh,
+			respCh,
+			limiter,
+			nil,
+			s.mockFrontendClient,
+			historyClient,
+			metrics.NoopMetricsHandler,
+			log.NewTestLogger(),
+		)
+		taskCh <- taskDetail{execution: execution, attempts: 1}
+		return <-respCh
+	}, activity.RegisterOptions{Name: "process-task"})
+
+	_, err := env.ExecuteActivity("process-task")
+	return err
+}
@@ -0,0 +1,151 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/metrics"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// adaptiveRateAdjustInterval is how often the rate of an adaptive limiter is re-evaluated.
+	adaptiveRateAdjustInterval = 5 * time.Second
+	// adaptiveRateMaxThrottledRatio is the ratio of throttled requests in an interval above which the rate is reduced.
+	adaptiveRateMaxThrottledRatio = 0.05
+	// adaptiveRateBackoffFactor is the factor the rate is multiplied by when backing off.
+	adaptiveRateBackoffFactor = 0.5
+	// adaptiveRateIncreaseRatio is the fraction of the max rate the rate grows by after a healthy interval.
+	adaptiveRateIncreaseRatio = 0.1
+	// adaptiveRateMinRPS is the lowest rate an adaptive limiter backs off to.
+	adaptiveRateMinRPS = 1.0
+)
+
+type (
+	// adaptiveRateLimiter is a rate limiter for batch operations which adjusts its rate based on the outcome of the
+	// requests it lets through, using additive increase / multiplicative decrease. Throttled requests
+	// (ResourceExhausted, Unavailable) and high average latency both indicate that the history service is under
+	// pressure, which makes the limiter back off. The rate never exceeds the configured max rps.
+	adaptiveRateLimiter struct {
+		limiter        *rate.Limiter
+		maxRPS         float64
+		targetLatency  time.Duration
+		enabled        bool
+		timeSource     clock.TimeSource
+		metricsHandler metrics.Handler
+
+		sync.Mutex
+		rps          float64
+		windowStart  time.Time
+		requests     int
+		throttled    int
+		totalLatency time.Duration
+	}
+)
+
+func newAdaptiveRateLimiter(
+	maxRPS float64,
+	enabled bool,
+	targetLatency time.Duration,
+	timeSource clock.TimeSource,
+	metricsHandler metrics.Handler,
+) *adaptiveRateLimiter {
+	return &adaptiveRateLimiter{
+		// burst should never be zero because everything would be rejected
+		limiter:        rate.NewLimiter(rate.Limit(maxRPS), int(math.Ceil(maxRPS))),
+		maxRPS:         maxRPS,
+		targetLatency:  targetLatency,
+		enabled:        enabled,
+		timeSource:     timeSource,
+		metricsHandler: metricsHandler,
+		rps:            maxRPS,
+		windowStart:    timeSource.Now(),
+	}
+}
+
+// Wait blocks until the limiter permits a request or the context is done.
+func (l *adaptiveRateLimiter) Wait(ctx context.Context) error {
+	return l.limiter.Wait(ctx)
+}
+
+// Record records the outcome of a request and adjusts the rate once per adjust interval.
+func (l *adaptiveRateLimiter) Record(err error, latency time.Duration) {
+	if !l.enabled {
+		return
+	}
+
+	l.Lock()
+	defer l.Unlock()
+
+	l.requests++
+	l.totalLatency += latency
+	if isThrottledError(err) {
+		l.throttled++
+	}
+
+	now := l.timeSource.Now()
+	if now.Sub(l.windowStart) < adaptiveRateAdjustInterval {
+		return
+	}
+
+	throttledRatio := float64(l.throttled) / float64(l.requests)
+	avgLatency := l.totalLatency / time.Duration(l.requests)
+	if throttledRatio > adaptiveRateMaxThrottledRatio || (l.targetLatency > 0 && avgLatency > l.targetLatency) {
+		l.rps = math.Max(adaptiveRateMinRPS, l.rps*adaptiveRateBackoffFactor)
+	} else {
+		l.rps = math.Min(l.maxRPS, l.rps+math.Max(1, l.maxRPS*adaptiveRateIncreaseRatio))
+	}
+	// never go above the configured rate, even if it is below the min rate
+	l.rps = math.Min(l.rps, l.maxRPS)
+	l.limiter.SetLimit(rate.Limit(l.rps))
+	l.limiter.SetBurst(int(math.Ceil(l.rps)))
+	metrics.BatcherOperationRPS.With(l.metricsHandler).Record(l.rps)
+
+	l.windowStart = now
+	l.requests = 0
+	l.throttled = 0
+	l.totalLatency = 0
+}
+
+// RPS returns the current rate of the limiter.
+func (l *adaptiveRateLimiter) RPS() float64 {
+	l.Lock()
+	defer l.Unlock()
+	return l.rps
+}
+
+func isThrottledError(err error) bool {
+	switch err.(type) {
+	case *serviceerror.ResourceExhausted, *serviceerror.Unavailable:
+		return true
+	}
+	return false
+}
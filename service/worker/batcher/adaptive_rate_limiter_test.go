@@ -0,0 +1,88 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/metrics"
+)
+
+func TestAdaptiveRateLimiter_BacksOffOnThrottling(t *testing.T) {
+	timeSource := clock.NewEventTimeSource()
+	limiter := newAdaptiveRateLimiter(100, true, time.Second, timeSource, metrics.NoopMetricsHandler)
+
+	limiter.Record(nil, time.Millisecond)
+	timeSource.Advance(adaptiveRateAdjustInterval)
+	limiter.Record(serviceerror.NewResourceExhausted(0, "busy"), time.Millisecond)
+	require.Equal(t, 50.0, limiter.RPS())
+
+	for i := 0; i < 10; i++ {
+		timeSource.Advance(adaptiveRateAdjustInterval)
+		limiter.Record(serviceerror.NewUnavailable("unavailable"), time.Millisecond)
+	}
+	require.Equal(t, adaptiveRateMinRPS, limiter.RPS())
+}
+
+func TestAdaptiveRateLimiter_BacksOffOnLatency(t *testing.T) {
+	timeSource := clock.NewEventTimeSource()
+	limiter := newAdaptiveRateLimiter(100, true, time.Second, timeSource, metrics.NoopMetricsHandler)
+
+	timeSource.Advance(adaptiveRateAdjustInterval)
+	limiter.Record(nil, 2*time.Second)
+	require.Equal(t, 50.0, limiter.RPS())
+}
+
+func TestAdaptiveRateLimiter_RecoversToMax(t *testing.T) {
+	timeSource := clock.NewEventTimeSource()
+	limiter := newAdaptiveRateLimiter(100, true, time.Second, timeSource, metrics.NoopMetricsHandler)
+
+	timeSource.Advance(adaptiveRateAdjustInterval)
+	limiter.Record(serviceerror.NewResourceExhausted(0, "busy"), time.Millisecond)
+	require.Equal(t, 50.0, limiter.RPS())
+
+	timeSource.Advance(adaptiveRateAdjustInterval)
+	limiter.Record(nil, time.Millisecond)
+	require.Equal(t, 60.0, limiter.RPS())
+
+	for i := 0; i < 10; i++ {
+		timeSource.Advance(adaptiveRateAdjustInterval)
+		limiter.Record(serviceerror.NewNotFound("not found"), time.Millisecond)
+	}
+	require.Equal(t, 100.0, limiter.RPS())
+}
+
+func TestAdaptiveRateLimiter_Disabled(t *testing.T) {
+	timeSource := clock.NewEventTimeSource()
+	limiter := newAdaptiveRateLimiter(100, false, time.Second, timeSource, metrics.NoopMetricsHandler)
+
+	timeSource.Advance(adaptiveRateAdjustInterval)
+	limiter.Record(serviceerror.NewResourceExhausted(0, "busy"), 2*time.Second)
+	require.Equal(t, 100.0, limiter.RPS())
+}
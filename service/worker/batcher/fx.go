@@ -32,6 +32,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/sdk"
 	workercommon "go.temporal.io/server/service/worker/common"
 	"go.uber.org/fx"
@@ -56,6 +57,7 @@ type (
 		Logger         log.Logger
 		ClientFactory  sdk.ClientFactory
 		FrontendClient workflowservice.WorkflowServiceClient
+		HistoryClient  resource.HistoryClient
 	}
 
 	fxResult struct {
@@ -102,5 +104,8 @@ func (s *workerComponent) activities(name namespace.Name, id namespace.ID) *acti
 		namespaceID:  id,
 		rps:          dynamicconfig.BatcherRPS.Get(s.dc),
 		concurrency:  dynamicconfig.BatcherConcurrency.Get(s.dc),
+
+		adaptiveRateLimit:       dynamicconfig.BatcherAdaptiveRateLimit.Get(s.dc),
+		adaptiveRateLimitTarget: dynamicconfig.BatcherAdaptiveRateLimitTargetLatency.Get(s.dc),
 	}
 }
@@ -64,6 +64,12 @@ const (
 	BatchTypeReset = "reset"
 	// BatchTypeUpdateOptions is batch type for updating the options of workflow executions
 	BatchTypeUpdateOptions = "update_options"
+	// BatchTypeSignalWithStart is batch type for signal-with-starting workflows
+	BatchTypeSignalWithStart = "signal_with_start"
+	// BatchTypeRebuildMutableState is batch type for rebuilding the mutable state of workflow executions from history
+	BatchTypeRebuildMutableState = "rebuild_mutable_state"
+	// BatchTypeRefreshWorkflowTasks is batch type for regenerating the tasks of workflow executions
+	BatchTypeRefreshWorkflowTasks = "refresh_workflow_tasks"
 )
 
 var (
@@ -112,6 +118,21 @@ type (
 		UpdateMask               *fieldmaskpb.FieldMask
 	}
 
+	// SignalWithStartParams is the parameters for signal-with-starting workflows
+	SignalWithStartParams struct {
+		SignalName  string
+		SignalInput *commonpb.Payloads
+		// Workflow type and task queue of the workflows started when they are not running
+		WorkflowType             string
+		TaskQueue                string
+		Input                    *commonpb.Payloads
+		WorkflowExecutionTimeout time.Duration
+		WorkflowRunTimeout       time.Duration
+		// Namespace the workflow IDs are derived from when Query is set.
+		// Defaults to the namespace of the batch operation.
+		SourceNamespace string
+	}
+
 	// BatchParams is the parameters for batch operation workflow
 	BatchParams struct {
 		// Target namespace to execute batch operation
@@ -122,7 +143,8 @@ type (
 		Executions []*commonpb.WorkflowExecution
 		// Reason for the operation
 		Reason string
-		// Supporting: signal,cancel,terminate,delete,reset
+		// Supporting: signal,cancel,terminate,delete,reset,update_options,signal_with_start,rebuild_mutable_state,
+		// refresh_workflow_tasks
 		BatchType string
 
 		// Below are all optional
@@ -138,6 +160,8 @@ type (
 		ResetParams ResetParams
 		// UpdateOptionsParams is params only for BatchTypeUpdateOptions
 		UpdateOptionsParams UpdateOptionsParams
+		// SignalWithStartParams is params only for BatchTypeSignalWithStart
+		SignalWithStartParams SignalWithStartParams
 		// RPS sets the requests-per-second limit for the batch.
 		// The default (and max) is defined by `worker.BatcherRPS` in the dynamic config.
 		RPS float64
@@ -256,7 +280,16 @@ func validateParams(params BatchParams) error {
 			return fmt.Errorf("must provide UpdateMask")
 		}
 		return worker_versioning.ValidateVersioningOverride(params.UpdateOptionsParams.WorkflowExecutionOptions.VersioningOverride)
-	case BatchTypeCancel, BatchTypeTerminate, BatchTypeDelete, BatchTypeReset:
+	case BatchTypeSignalWithStart:
+		if params.SignalWithStartParams.SignalName == "" {
+			return fmt.Errorf("must provide signal name")
+		}
+		if params.SignalWithStartParams.WorkflowType == "" || params.SignalWithStartParams.TaskQueue == "" {
+			return fmt.Errorf("must provide workflow type and task queue")
+		}
+		return nil
+	case BatchTypeCancel, BatchTypeTerminate, BatchTypeDelete, BatchTypeReset, BatchTypeRebuildMutableState,
+		BatchTypeRefreshWorkflowTasks:
 		return nil
 	default:
 		return fmt.Errorf("not supported batch type: %v", params.BatchType)
@@ -64,6 +64,21 @@ func (s *batcherSuite) TestBatchWorkflow_MissingParams() {
 	s.Contains(err.Error(), "must provide required parameters")
 }
 
+func (s *batcherSuite) TestBatchWorkflow_SignalWithStartMissingWorkflowType() {
+	s.env.ExecuteWorkflow(BatchWorkflow, BatchParams{
+		BatchType: BatchTypeSignalWithStart,
+		Reason:    "test-reason",
+		Namespace: "test-namespace",
+		Query:     "test-query",
+		SignalWithStartParams: SignalWithStartParams{
+			SignalName: "test-signal",
+		},
+	})
+	err := s.env.GetWorkflowError()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "must provide workflow type and task queue")
+}
+
 func (s *batcherSuite) TestBatchWorkflow_ValidParams_Query() {
 	var ac *activities
 	s.env.OnActivity(ac.BatchActivity, mock.Anything, mock.Anything).Return(HeartBeatDetails{
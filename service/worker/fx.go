@@ -38,6 +38,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/payloadencryption"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/primitives"
@@ -52,6 +53,8 @@ import (
 	"go.temporal.io/server/service/worker/deployment"
 	"go.temporal.io/server/service/worker/dlq"
 	"go.temporal.io/server/service/worker/migration"
+	"go.temporal.io/server/service/worker/rearchival"
+	"go.temporal.io/server/service/worker/reshard"
 	"go.temporal.io/server/service/worker/scheduler"
 	"go.uber.org/fx"
 )
@@ -65,6 +68,8 @@ var Module = fx.Options(
 	batcher.Module,
 	deployment.Module,
 	dlq.Module,
+	rearchival.Module,
+	reshard.Module,
 	dynamicconfig.Module,
 	fx.Provide(
 		func(c resource.HistoryClient) dlq.HistoryClient {
@@ -161,6 +166,7 @@ func VisibilityManagerProvider(
 	metricsHandler metrics.Handler,
 	persistenceConfig *config.Persistence,
 	customVisibilityStoreFactory visibility.VisibilityStoreFactory,
+	payloadKeyProvider payloadencryption.KeyProvider,
 	serviceConfig *Config,
 	persistenceServiceResolver resolver.ServiceResolver,
 	searchAttributesMapperProvider searchattribute.MapperProvider,
@@ -171,6 +177,7 @@ func VisibilityManagerProvider(
 		*persistenceConfig,
 		persistenceServiceResolver,
 		customVisibilityStoreFactory,
+		payloadKeyProvider,
 		nil, // worker visibility never write
 		saProvider,
 		searchAttributesMapperProvider,
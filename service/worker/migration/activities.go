@@ -41,6 +41,7 @@ import (
 	"go.temporal.io/server/api/adminservice/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	serverClient "go.temporal.io/server/client"
 	"go.temporal.io/server/client/admin"
@@ -51,6 +52,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/searchattribute"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -77,10 +79,16 @@ type (
 		Reason            string
 	}
 
+	MismatchedWorkflowExecution struct {
+		WorkflowExecution *commonpb.WorkflowExecution
+		Reason            string
+	}
+
 	replicationTasksHeartbeatDetails struct {
 		NextIndex                        int
 		CheckPoint                       time.Time
 		LastNotVerifiedWorkflowExecution *commonpb.WorkflowExecution
+		MismatchedWorkflowExecutions     []MismatchedWorkflowExecution
 	}
 
 	verifyStatus int
@@ -94,14 +102,18 @@ const (
 	reasonZombieWorkflow           = "Zombie workflow"
 	reasonWorkflowNotFound         = "Workflow not found"
 	reasonWorkflowCloseToRetention = "Workflow close to retention"
+	reasonHistoryDiverged          = "History diverged"
+	reasonTargetHistoryAhead       = "Target history ahead"
+	reasonExecutionStateMismatch   = "Execution state mismatch"
 
 	notVerified verifyStatus = 0
 	verified    verifyStatus = 1
 	skipped     verifyStatus = 2
+	mismatched  verifyStatus = 3
 )
 
 func (r verifyResult) isVerified() bool {
-	return r.status == verified || r.status == skipped
+	return r.status == verified || r.status == skipped || r.status == mismatched
 }
 
 // TODO: CallerTypePreemptablee should be set in activity background context for all migration activities.
@@ -633,7 +645,7 @@ func (a *activities) verifySingleReplicationTask(
 ) (result verifyResult, rerr error) {
 	s := time.Now()
 	// Check if execution exists on remote cluster
-	_, err := remoteClient.DescribeMutableState(ctx, &adminservice.DescribeMutableStateRequest{
+	remoteResp, err := remoteClient.DescribeMutableState(ctx, &adminservice.DescribeMutableStateRequest{
 		Namespace: request.Namespace,
 		Execution: we,
 	})
@@ -641,6 +653,9 @@ func (a *activities) verifySingleReplicationTask(
 
 	switch err.(type) {
 	case nil:
+		if request.CompareExecutionState {
+			return a.compareExecutionState(ctx, request, we, remoteResp.GetDatabaseMutableState())
+		}
 		metrics.VerifyReplicationTaskSuccess.With(a.forceReplicationMetricsHandler.WithTags(metrics.NamespaceTag(request.Namespace))).Record(1)
 		return verifyResult{
 			status: verified,
@@ -667,6 +682,101 @@ func (a *activities) verifySingleReplicationTask(
 	}
 }
 
+// compareExecutionState compares the mutable state of a closed workflow execution on source cluster with the one
+// on target cluster. Running executions are still changing and are not compared. A target which is behind source
+// is treated as replication lag and is not verified yet.
+func (a *activities) compareExecutionState(
+	ctx context.Context,
+	request *verifyReplicationTasksRequest,
+	we *commonpb.WorkflowExecution,
+	remoteState *persistencespb.WorkflowMutableState,
+) (verifyResult, error) {
+	localResp, err := a.historyClient.DescribeMutableState(ctx, &historyservice.DescribeMutableStateRequest{
+		NamespaceId: request.NamespaceID,
+		Execution:   we,
+	})
+	if err != nil {
+		if isNotFoundServiceError(err) {
+			// Deleted on source cluster after replication tasks were generated, there is nothing to compare.
+			return verifyResult{
+				status: verified,
+			}, nil
+		}
+		return verifyResult{
+			status: notVerified,
+		}, err
+	}
+
+	localState := localResp.GetDatabaseMutableState()
+	if localState.GetExecutionState().GetState() != enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED {
+		metrics.VerifyReplicationTaskSuccess.With(a.forceReplicationMetricsHandler.WithTags(metrics.NamespaceTag(request.Namespace))).Record(1)
+		return verifyResult{
+			status: verified,
+		}, nil
+	}
+
+	localHistory, err := versionhistory.GetCurrentVersionHistory(localState.GetExecutionInfo().GetVersionHistories())
+	if err != nil {
+		return verifyResult{
+			status: notVerified,
+		}, err
+	}
+	remoteHistory, err := versionhistory.GetCurrentVersionHistory(remoteState.GetExecutionInfo().GetVersionHistories())
+	if err != nil {
+		return verifyResult{
+			status: notVerified,
+		}, err
+	}
+	localLastItem, err := versionhistory.GetLastVersionHistoryItem(localHistory)
+	if err != nil {
+		return verifyResult{
+			status: notVerified,
+		}, err
+	}
+	remoteLastItem, err := versionhistory.GetLastVersionHistoryItem(remoteHistory)
+	if err != nil {
+		return verifyResult{
+			status: notVerified,
+		}, err
+	}
+
+	reason := ""
+	switch {
+	case versionhistory.IsEqualVersionHistoryItem(localLastItem, remoteLastItem):
+		if remoteState.GetExecutionState().GetState() != localState.GetExecutionState().GetState() ||
+			remoteState.GetExecutionState().GetStatus() != localState.GetExecutionState().GetStatus() {
+			reason = reasonExecutionStateMismatch
+		}
+	case versionhistory.ContainsVersionHistoryItem(localHistory, remoteLastItem):
+		// Target cluster has not caught up yet.
+		return verifyResult{
+			status: notVerified,
+		}, nil
+	case versionhistory.ContainsVersionHistoryItem(remoteHistory, localLastItem):
+		reason = reasonTargetHistoryAhead
+	default:
+		reason = reasonHistoryDiverged
+	}
+
+	if reason == "" {
+		metrics.VerifyReplicationTaskSuccess.With(a.forceReplicationMetricsHandler.WithTags(metrics.NamespaceTag(request.Namespace))).Record(1)
+		return verifyResult{
+			status: verified,
+		}, nil
+	}
+
+	a.logger.Warn("force-replication found mismatched workflow execution",
+		tag.WorkflowNamespaceID(request.NamespaceID),
+		tag.WorkflowID(we.GetWorkflowId()),
+		tag.WorkflowRunID(we.GetRunId()),
+		tag.NewStringTag("Reason", reason),
+	)
+	return verifyResult{
+		status: mismatched,
+		reason: reason,
+	}, nil
+}
+
 func (a *activities) verifyReplicationTasks(
 	ctx context.Context,
 	request *verifyReplicationTasksRequest,
@@ -699,6 +809,13 @@ func (a *activities) verifyReplicationTasks(
 			return false, nil
 		}
 
+		if r.status == mismatched {
+			details.MismatchedWorkflowExecutions = append(details.MismatchedWorkflowExecutions, MismatchedWorkflowExecution{
+				WorkflowExecution: we,
+				Reason:            r.reason,
+			})
+		}
+
 		heartbeat(*details)
 		progress = true
 	}
@@ -771,6 +888,7 @@ func (a *activities) VerifyReplicationTasks(ctx context.Context, request *verify
 		}
 
 		if verified == true {
+			response.MismatchedWorkflowExecutions = details.MismatchedWorkflowExecutions
 			return response, nil
 		}
 
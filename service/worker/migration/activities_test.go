@@ -31,6 +31,7 @@ import (
 
 	"github.com/stretchr/testify/suite"
 	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/sdk/interceptor"
 	"go.temporal.io/sdk/testsuite"
@@ -38,6 +39,7 @@ import (
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/adminservicemock/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
@@ -46,6 +48,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/testing/mockapi/workflowservicemock/v1"
 	"go.temporal.io/server/common/testing/protoassert"
 	"go.temporal.io/server/common/testing/protomock"
@@ -340,6 +343,70 @@ func (s *activitiesSuite) TestVerifyReplicationTasks_AlreadyVerified() {
 	s.Equal(len(iceptor.replicationRecordedHeartbeats), 1)
 }
 
+func (s *activitiesSuite) TestVerifyReplicationTasks_CompareExecutionState() {
+	env, iceptor := s.initEnv()
+	request := verifyReplicationTasksRequest{
+		Namespace:             mockedNamespace,
+		NamespaceID:           mockedNamespaceID,
+		TargetClusterName:     remoteCluster,
+		Executions:            []*commonpb.WorkflowExecution{&execution1, &execution2},
+		CompareExecutionState: true,
+	}
+
+	mutableState := func(lastEventID int64, version int64) *persistencespb.WorkflowMutableState {
+		return &persistencespb.WorkflowMutableState{
+			ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+				VersionHistories: versionhistory.NewVersionHistories(versionhistory.NewVersionHistory(nil, []*historyspb.VersionHistoryItem{
+					versionhistory.NewVersionHistoryItem(lastEventID, version),
+				})),
+			},
+			ExecutionState: &persistencespb.WorkflowExecutionState{
+				State:  enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED,
+				Status: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			},
+		}
+	}
+
+	// execution1 lags behind on target cluster at first, then catches up.
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), protomock.Eq(&historyservice.DescribeMutableStateRequest{
+		NamespaceId: mockedNamespaceID,
+		Execution:   &execution1,
+	})).Return(&historyservice.DescribeMutableStateResponse{DatabaseMutableState: mutableState(10, 1)}, nil).Times(2)
+	gomock.InOrder(
+		s.mockRemoteAdminClient.EXPECT().DescribeMutableState(gomock.Any(), protomock.Eq(&adminservice.DescribeMutableStateRequest{
+			Namespace: mockedNamespace,
+			Execution: &execution1,
+		})).Return(&adminservice.DescribeMutableStateResponse{DatabaseMutableState: mutableState(5, 1)}, nil),
+		s.mockRemoteAdminClient.EXPECT().DescribeMutableState(gomock.Any(), protomock.Eq(&adminservice.DescribeMutableStateRequest{
+			Namespace: mockedNamespace,
+			Execution: &execution1,
+		})).Return(&adminservice.DescribeMutableStateResponse{DatabaseMutableState: mutableState(10, 1)}, nil),
+	)
+
+	// execution2 diverged on target cluster.
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), protomock.Eq(&historyservice.DescribeMutableStateRequest{
+		NamespaceId: mockedNamespaceID,
+		Execution:   &execution2,
+	})).Return(&historyservice.DescribeMutableStateResponse{DatabaseMutableState: mutableState(10, 1)}, nil).Times(1)
+	s.mockRemoteAdminClient.EXPECT().DescribeMutableState(gomock.Any(), protomock.Eq(&adminservice.DescribeMutableStateRequest{
+		Namespace: mockedNamespace,
+		Execution: &execution2,
+	})).Return(&adminservice.DescribeMutableStateResponse{DatabaseMutableState: mutableState(10, 2)}, nil).Times(1)
+
+	f, err := env.ExecuteActivity(s.a.VerifyReplicationTasks, &request)
+	s.NoError(err)
+
+	var resp verifyReplicationTasksResponse
+	s.NoError(f.Get(&resp))
+	s.Len(resp.MismatchedWorkflowExecutions, 1)
+	s.ProtoEqual(&execution2, resp.MismatchedWorkflowExecutions[0].WorkflowExecution)
+	s.Equal(reasonHistoryDiverged, resp.MismatchedWorkflowExecutions[0].Reason)
+
+	lastHeartBeat := iceptor.replicationRecordedHeartbeats[len(iceptor.replicationRecordedHeartbeats)-1]
+	s.Equal(len(request.Executions), lastHeartBeat.NextIndex)
+	s.ProtoEqual(&execution1, lastHeartBeat.LastNotVerifiedWorkflowExecution)
+}
+
 func (s *activitiesSuite) Test_verifySingleReplicationTask() {
 	request := verifyReplicationTasksRequest{
 		Namespace:         mockedNamespace,
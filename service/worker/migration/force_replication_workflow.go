@@ -65,6 +65,9 @@ type (
 		TargetClusterEndpoint   string
 		TargetClusterName       string
 		VerifyIntervalInSeconds int `validate:"gte=0"`
+		// Compare the execution state of closed workflows between clusters during verification,
+		// and report executions that do not match.
+		EnableStateComparison bool
 
 		// Used by query handler to indicate overall progress of replication
 		LastCloseTime                      time.Time
@@ -74,6 +77,16 @@ type (
 
 		// Carry over the replication status after continue-as-new.
 		TaskQueueUserDataReplicationStatus TaskQueueUserDataReplicationStatus
+
+		// Page token of the first visibility page which is not fully processed yet. All pages before it have
+		// their replication tasks generated (and verified if enabled). A new force-replication workflow started
+		// with NextPageToken set to this value resumes from where an interrupted one stopped without rescanning.
+		CheckpointPageToken []byte
+		ProcessedPageCount  int
+
+		// Carry over the verification mismatches after continue-as-new.
+		MismatchedExecutionCount     int
+		MismatchedWorkflowExecutions []MismatchedWorkflowExecution
 	}
 
 	TaskQueueUserDataReplicationStatus struct {
@@ -86,6 +99,17 @@ type (
 		LastStartTime                      time.Time
 		TaskQueueUserDataReplicationStatus TaskQueueUserDataReplicationStatus
 		ContinuedAsNewCount                int
+		CheckpointPageToken                []byte
+		ProcessedPageCount                 int
+		MismatchedExecutionCount           int
+		MismatchedWorkflowExecutions       []MismatchedWorkflowExecution
+	}
+
+	ForceReplicationResult struct {
+		ProcessedPageCount       int
+		MismatchedExecutionCount int
+		// At most maxReportedMismatchedExecutions are reported.
+		MismatchedWorkflowExecutions []MismatchedWorkflowExecution
 	}
 
 	listWorkflowsResponse struct {
@@ -98,6 +122,13 @@ type (
 		LastStartTime time.Time
 	}
 
+	// workflowExecutionsPage is a visibility page along with the page tokens used to fetch it and the one after it.
+	workflowExecutionsPage struct {
+		Executions    []*commonpb.WorkflowExecution
+		PageToken     []byte
+		NextPageToken []byte
+	}
+
 	generateReplicationTasksRequest struct {
 		NamespaceID string
 		Executions  []*commonpb.WorkflowExecution
@@ -111,9 +142,12 @@ type (
 		TargetClusterName     string
 		VerifyInterval        time.Duration `validate:"gte=0"`
 		Executions            []*commonpb.WorkflowExecution
+		CompareExecutionState bool
 	}
 
-	verifyReplicationTasksResponse struct{}
+	verifyReplicationTasksResponse struct {
+		MismatchedWorkflowExecutions []MismatchedWorkflowExecution
+	}
 
 	metadataRequest struct {
 		Namespace string
@@ -144,9 +178,10 @@ const (
 	defaultPageSizeForTaskQueueUserDataReplication = 20
 	defaultRPSForTaskQueueUserDataReplication      = 1.0
 	defaultVerifyIntervalInSeconds                 = 5
+	maxReportedMismatchedExecutions                = 100
 )
 
-func ForceReplicationWorkflow(ctx workflow.Context, params ForceReplicationParams) error {
+func ForceReplicationWorkflow(ctx workflow.Context, params ForceReplicationParams) (ForceReplicationResult, error) {
 	ctx = workflow.WithTaskQueue(ctx, primitives.MigrationActivityTQ)
 
 	workflow.SetQueryHandler(ctx, forceReplicationStatusQueryType, func() (ForceReplicationStatus, error) {
@@ -155,16 +190,20 @@ func ForceReplicationWorkflow(ctx workflow.Context, params ForceReplicationParam
 			LastStartTime:                      params.LastStartTime,
 			ContinuedAsNewCount:                params.ContinuedAsNewCount,
 			TaskQueueUserDataReplicationStatus: params.TaskQueueUserDataReplicationStatus,
+			CheckpointPageToken:                params.CheckpointPageToken,
+			ProcessedPageCount:                 params.ProcessedPageCount,
+			MismatchedExecutionCount:           params.MismatchedExecutionCount,
+			MismatchedWorkflowExecutions:       params.MismatchedWorkflowExecutions,
 		}, nil
 	})
 
 	if err := validateAndSetForceReplicationParams(&params); err != nil {
-		return err
+		return ForceReplicationResult{}, err
 	}
 
 	metadataResp, err := getClusterMetadata(ctx, params)
 	if err != nil {
-		return err
+		return ForceReplicationResult{}, err
 	}
 
 	if !params.TaskQueueUserDataReplicationStatus.Done {
@@ -173,10 +212,11 @@ func ForceReplicationWorkflow(ctx workflow.Context, params ForceReplicationParam
 			params.TaskQueueUserDataReplicationStatus.Done = true
 		})
 		if err != nil {
-			return err
+			return ForceReplicationResult{}, err
 		}
 	}
 
+	params.CheckpointPageToken = params.NextPageToken
 	workflowExecutionsCh := workflow.NewBufferedChannel(ctx, params.PageCountPerExecution)
 	var listWorkflowsErr error
 	workflow.Go(ctx, func(ctx workflow.Context) {
@@ -187,32 +227,36 @@ func ForceReplicationWorkflow(ctx workflow.Context, params ForceReplicationParam
 		workflowExecutionsCh.Close()
 	})
 
-	if err := enqueueReplicationTasks(ctx, workflowExecutionsCh, metadataResp.NamespaceID, params); err != nil {
-		return err
+	if err := enqueueReplicationTasks(ctx, workflowExecutionsCh, metadataResp.NamespaceID, &params); err != nil {
+		return ForceReplicationResult{}, err
 	}
 
 	if listWorkflowsErr != nil {
-		return listWorkflowsErr
+		return ForceReplicationResult{}, listWorkflowsErr
 	}
 
 	if params.NextPageToken == nil {
 		if workflow.GetVersion(ctx, taskQueueUserDataReplicationVersionMarker, workflow.DefaultVersion, 1) > workflow.DefaultVersion {
 			err := workflow.Await(ctx, func() bool { return params.TaskQueueUserDataReplicationStatus.Done })
 			if err != nil {
-				return err
+				return ForceReplicationResult{}, err
 			}
 			if params.TaskQueueUserDataReplicationStatus.FailureMessage != "" {
-				return fmt.Errorf("task queue user data replication failed: %v", params.TaskQueueUserDataReplicationStatus.FailureMessage)
+				return ForceReplicationResult{}, fmt.Errorf("task queue user data replication failed: %v", params.TaskQueueUserDataReplicationStatus.FailureMessage)
 			}
 		}
-		return nil
+		return ForceReplicationResult{
+			ProcessedPageCount:           params.ProcessedPageCount,
+			MismatchedExecutionCount:     params.MismatchedExecutionCount,
+			MismatchedWorkflowExecutions: params.MismatchedWorkflowExecutions,
+		}, nil
 	}
 
 	params.ContinuedAsNewCount++
 
 	// There are still more workflows to replicate. Continue-as-new to process on a new run.
 	// This prevents history size from exceeding the server-defined limit
-	return workflow.NewContinueAsNewError(ctx, ForceReplicationWorkflow, params)
+	return ForceReplicationResult{}, workflow.NewContinueAsNewError(ctx, ForceReplicationWorkflow, params)
 }
 
 func maybeKickoffTaskQueueUserDataReplication(ctx workflow.Context, params ForceReplicationParams, onDone func(failureReason string)) error {
@@ -355,7 +399,11 @@ func listWorkflowsForReplication(ctx workflow.Context, workflowExecutionsCh work
 			return err
 		}
 
-		workflowExecutionsCh.Send(ctx, listResp.Executions)
+		workflowExecutionsCh.Send(ctx, workflowExecutionsPage{
+			Executions:    listResp.Executions,
+			PageToken:     params.NextPageToken,
+			NextPageToken: listResp.NextPageToken,
+		})
 
 		params.NextPageToken = listResp.NextPageToken
 		params.LastCloseTime = listResp.LastCloseTime
@@ -369,7 +417,7 @@ func listWorkflowsForReplication(ctx workflow.Context, workflowExecutionsCh work
 	return nil
 }
 
-func enqueueReplicationTasks(ctx workflow.Context, workflowExecutionsCh workflow.Channel, namespaceID string, params ForceReplicationParams) error {
+func enqueueReplicationTasks(ctx workflow.Context, workflowExecutionsCh workflow.Channel, namespaceID string, params *ForceReplicationParams) error {
 	selector := workflow.NewSelector(ctx)
 	pendingGenerateTasks := 0
 	pendingVerifyTasks := 0
@@ -382,14 +430,30 @@ func enqueueReplicationTasks(ctx workflow.Context, workflowExecutionsCh workflow
 
 	actx := workflow.WithActivityOptions(ctx, ao)
 	var a *activities
-	var futures []workflow.Future
-	var workflowExecutions []*commonpb.WorkflowExecution
+	var page workflowExecutionsPage
 	var lastActivityErr error
 
-	for workflowExecutionsCh.Receive(ctx, &workflowExecutions) {
+	// Pages may complete out of order, the checkpoint only moves past a page once all pages before it are done.
+	var pages []workflowExecutionsPage
+	var pagePendingTasks []int
+	checkpointIndex := 0
+	onPageTaskDone := func(pageIndex int) {
+		pagePendingTasks[pageIndex]--
+		for checkpointIndex < len(pages) && pagePendingTasks[checkpointIndex] == 0 {
+			params.CheckpointPageToken = pages[checkpointIndex].NextPageToken
+			params.ProcessedPageCount++
+			checkpointIndex++
+		}
+	}
+
+	for workflowExecutionsCh.Receive(ctx, &page) {
+		pageIndex := len(pages)
+		pages = append(pages, page)
+		pagePendingTasks = append(pagePendingTasks, 1)
+
 		generateTaskFuture := workflow.ExecuteActivity(actx, a.GenerateReplicationTasks, &generateReplicationTasksRequest{
 			NamespaceID: namespaceID,
-			Executions:  workflowExecutions,
+			Executions:  page.Executions,
 			RPS:         params.OverallRps / float64(params.ConcurrentActivityCount),
 		})
 
@@ -399,30 +463,41 @@ func enqueueReplicationTasks(ctx workflow.Context, workflowExecutionsCh workflow
 
 			if err := f.Get(ctx, nil); err != nil {
 				lastActivityErr = err
+				return
 			}
+			onPageTaskDone(pageIndex)
 		})
-		futures = append(futures, generateTaskFuture)
 
 		if params.EnableVerification {
+			pagePendingTasks[pageIndex]++
 			verifyTaskFuture := workflow.ExecuteActivity(actx, a.VerifyReplicationTasks, &verifyReplicationTasksRequest{
 				TargetClusterEndpoint: params.TargetClusterEndpoint,
 				TargetClusterName:     params.TargetClusterName,
 				Namespace:             params.Namespace,
 				NamespaceID:           namespaceID,
-				Executions:            workflowExecutions,
+				Executions:            page.Executions,
 				VerifyInterval:        time.Duration(params.VerifyIntervalInSeconds) * time.Second,
+				CompareExecutionState: params.EnableStateComparison,
 			})
 
 			pendingVerifyTasks++
 			selector.AddFuture(verifyTaskFuture, func(f workflow.Future) {
 				pendingVerifyTasks--
 
-				if err := f.Get(ctx, nil); err != nil {
+				var verifyResp verifyReplicationTasksResponse
+				if err := f.Get(ctx, &verifyResp); err != nil {
 					lastActivityErr = err
+					return
+				}
+				params.MismatchedExecutionCount += len(verifyResp.MismatchedWorkflowExecutions)
+				for _, mismatch := range verifyResp.MismatchedWorkflowExecutions {
+					if len(params.MismatchedWorkflowExecutions) >= maxReportedMismatchedExecutions {
+						break
+					}
+					params.MismatchedWorkflowExecutions = append(params.MismatchedWorkflowExecutions, mismatch)
 				}
+				onPageTaskDone(pageIndex)
 			})
-
-			futures = append(futures, verifyTaskFuture)
 		}
 
 		for pendingGenerateTasks >= params.ConcurrentActivityCount || pendingVerifyTasks >= params.ConcurrentActivityCount {
@@ -433,9 +508,10 @@ func enqueueReplicationTasks(ctx workflow.Context, workflowExecutionsCh workflow
 		}
 	}
 
-	for _, future := range futures {
-		if err := future.Get(ctx, nil); err != nil {
-			return err
+	for pendingGenerateTasks > 0 || pendingVerifyTasks > 0 {
+		selector.Select(ctx)
+		if lastActivityErr != nil {
+			return lastActivityErr
 		}
 	}
 
@@ -27,6 +27,7 @@ package migration
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -400,6 +401,109 @@ func TestForceReplicationWorkflow_VerifyReplicationTaskNonRetryableError(t *test
 	env.AssertExpectations(t)
 }
 
+func TestForceReplicationWorkflow_ReportMismatches(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	namespaceID := uuid.New()
+
+	var a *activities
+	env.OnActivity(a.GetMetadata, mock.Anything, metadataRequest{Namespace: "test-ns"}).Return(&metadataResponse{ShardCount: 4, NamespaceID: namespaceID}, nil)
+
+	env.OnActivity(a.ListWorkflows, mock.Anything, mock.Anything).Return(&listWorkflowsResponse{
+		Executions:    []*commonpb.WorkflowExecution{{WorkflowId: "wf-1"}},
+		NextPageToken: nil, // last page
+	}, nil).Times(1)
+
+	env.OnActivity(a.GenerateReplicationTasks, mock.Anything, mock.Anything).Return(nil).Times(1)
+	env.OnActivity(a.VerifyReplicationTasks, mock.Anything, mock.Anything).Return(func(ctx context.Context, request *verifyReplicationTasksRequest) (verifyReplicationTasksResponse, error) {
+		assert.True(t, request.CompareExecutionState)
+		return verifyReplicationTasksResponse{
+			MismatchedWorkflowExecutions: []MismatchedWorkflowExecution{
+				{WorkflowExecution: request.Executions[0], Reason: reasonHistoryDiverged},
+			},
+		}, nil
+	}).Times(1)
+
+	env.RegisterWorkflow(ForceTaskQueueUserDataReplicationWorkflow)
+	env.OnActivity(a.SeedReplicationQueueWithUserDataEntries, mock.Anything, mock.Anything).Return(nil)
+
+	env.ExecuteWorkflow(ForceReplicationWorkflow, ForceReplicationParams{
+		Namespace:               "test-ns",
+		Query:                   "",
+		ConcurrentActivityCount: 2,
+		OverallRps:              10,
+		ListWorkflowsPageSize:   1,
+		PageCountPerExecution:   4,
+		EnableVerification:      true,
+		EnableStateComparison:   true,
+		TargetClusterEndpoint:   "test-target",
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+
+	var result ForceReplicationResult
+	require.NoError(t, env.GetWorkflowResult(&result))
+	assert.Equal(t, 1, result.ProcessedPageCount)
+	assert.Equal(t, 1, result.MismatchedExecutionCount)
+	require.Len(t, result.MismatchedWorkflowExecutions, 1)
+	assert.Equal(t, "wf-1", result.MismatchedWorkflowExecutions[0].WorkflowExecution.GetWorkflowId())
+	assert.Equal(t, reasonHistoryDiverged, result.MismatchedWorkflowExecutions[0].Reason)
+}
+
+func TestForceReplicationWorkflow_CheckpointOnFailure(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	namespaceID := uuid.New()
+
+	var a *activities
+	env.OnActivity(a.GetMetadata, mock.Anything, metadataRequest{Namespace: "test-ns"}).Return(&metadataResponse{ShardCount: 4, NamespaceID: namespaceID}, nil)
+
+	pageTokens := [][]byte{[]byte("page-1"), []byte("page-2"), []byte("page-3"), nil}
+	currentPageCount := 0
+	env.OnActivity(a.ListWorkflows, mock.Anything, mock.Anything).Return(func(ctx context.Context, request *workflowservice.ListWorkflowExecutionsRequest) (*listWorkflowsResponse, error) {
+		currentPageCount++
+		return &listWorkflowsResponse{
+			Executions:    []*commonpb.WorkflowExecution{{WorkflowId: fmt.Sprintf("wf-%d", currentPageCount)}},
+			NextPageToken: pageTokens[currentPageCount-1],
+		}, nil
+	})
+
+	env.OnActivity(a.GenerateReplicationTasks, mock.Anything, mock.Anything).Return(func(ctx context.Context, request *generateReplicationTasksRequest) error {
+		if request.Executions[0].GetWorkflowId() == "wf-3" {
+			return temporal.NewNonRetryableApplicationError("mock generate replication tasks error", "", nil)
+		}
+		return nil
+	})
+
+	env.RegisterWorkflow(ForceTaskQueueUserDataReplicationWorkflow)
+	env.OnActivity(a.SeedReplicationQueueWithUserDataEntries, mock.Anything, mock.Anything).Return(nil)
+
+	env.ExecuteWorkflow(ForceReplicationWorkflow, ForceReplicationParams{
+		Namespace:               "test-ns",
+		Query:                   "",
+		ConcurrentActivityCount: 1,
+		OverallRps:              10,
+		ListWorkflowsPageSize:   1,
+		PageCountPerExecution:   4,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.ErrorContains(t, env.GetWorkflowError(), "mock generate replication tasks error")
+
+	envValue, err := env.QueryWorkflow(forceReplicationStatusQueryType)
+	require.NoError(t, err)
+
+	// The first two pages are done, a new workflow can resume from the third page.
+	var status ForceReplicationStatus
+	require.NoError(t, envValue.Get(&status))
+	assert.Equal(t, 2, status.ProcessedPageCount)
+	assert.Equal(t, []byte("page-2"), status.CheckpointPageToken)
+}
+
 func TestForceReplicationWorkflow_TaskQueueReplicationFailure(t *testing.T) {
 	testSuite := &testsuite.WorkflowTestSuite{}
 	env := testSuite.NewTestWorkflowEnvironment()
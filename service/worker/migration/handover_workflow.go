@@ -136,7 +136,9 @@ func NamespaceHandoverWorkflow(ctx workflow.Context, params NamespaceHandoverPar
 		return err
 	}
 
-	// ** Step 4: Initiate Handover (WARNING: Namespace cannot serve traffic while in this state)
+	// ** Step 4: Initiate Handover (WARNING: Namespace cannot serve traffic while in this state, unless
+	//             system.enableFineGrainedHandover is set, in which case only writes to shards that haven't
+	//             drained their replication tasks are rejected)
 	handoverRequest := updateStateRequest{
 		Namespace: params.Namespace,
 		NewState:  enumspb.REPLICATION_STATE_HANDOVER,
@@ -30,6 +30,7 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"slices"
 	"time"
 
 	enumspb "go.temporal.io/api/enums/v1"
@@ -41,7 +42,8 @@ import (
 
 type (
 
-	// Client is used to send request to processor workflow
+	// Client is used to send request to processor workflow. Requests are split into batches of children
+	// belonging to the same history shard, and each batch is sent to a processor workflow separately.
 	Client interface {
 		SendParentClosePolicyRequest(context.Context, Request) error
 	}
@@ -51,6 +53,7 @@ type (
 		logger           log.Logger
 		sdkClientFactory sdk.ClientFactory
 		numWorkflows     int
+		batchSize        int
 	}
 )
 
@@ -69,16 +72,29 @@ func NewClient(
 	logger log.Logger,
 	sdkClientFactory sdk.ClientFactory,
 	numWorkflows int,
+	batchSize int,
 ) Client {
 	return &clientImpl{
 		metricsHandler:   metricsHandler,
 		logger:           logger,
 		sdkClientFactory: sdkClientFactory,
 		numWorkflows:     numWorkflows,
+		batchSize:        batchSize,
 	}
 }
 
 func (c *clientImpl) SendParentClosePolicyRequest(ctx context.Context, request Request) error {
+	batches := splitRequest(request, c.batchSize)
+	metrics.ParentClosePolicyProcessorBatches.With(c.metricsHandler).Record(int64(len(batches)))
+	for _, batch := range batches {
+		if err := c.sendRequest(ctx, batch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *clientImpl) sendRequest(ctx context.Context, request Request) error {
 	workflowID := getWorkflowID(c.numWorkflows)
 	workflowOptions := sdkclient.StartWorkflowOptions{
 		ID:                    workflowID,
@@ -94,6 +110,33 @@ func (c *clientImpl) SendParentClosePolicyRequest(ctx context.Context, request R
 	return err
 }
 
+// splitRequest groups the executions of a request by history shard, and splits every group into requests of at
+// most batchSize executions, so that a single processor activity only talks to one shard at a time.
+func splitRequest(request Request, batchSize int) []Request {
+	if batchSize <= 0 {
+		batchSize = len(request.Executions)
+	}
+	var shardIDs []int32
+	executionsByShard := make(map[int32][]RequestDetail)
+	for _, execution := range request.Executions {
+		if _, ok := executionsByShard[execution.ShardID]; !ok {
+			shardIDs = append(shardIDs, execution.ShardID)
+		}
+		executionsByShard[execution.ShardID] = append(executionsByShard[execution.ShardID], execution)
+	}
+
+	var batches []Request
+	for _, shardID := range shardIDs {
+		for executions := range slices.Chunk(executionsByShard[shardID], batchSize) {
+			batches = append(batches, Request{
+				ParentExecution: request.ParentExecution,
+				Executions:      executions,
+			})
+		}
+	}
+	return batches
+}
+
 func getWorkflowID(numWorkflows int) string {
 	return fmt.Sprintf("%v-%v", workflowIDPrefix, rand.Intn(numWorkflows))
 }
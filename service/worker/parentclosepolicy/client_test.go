@@ -0,0 +1,64 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package parentclosepolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+)
+
+func TestSplitRequest(t *testing.T) {
+	parent := &commonpb.WorkflowExecution{WorkflowId: "parent", RunId: "parent-run"}
+	request := Request{
+		ParentExecution: parent,
+		Executions: []RequestDetail{
+			{WorkflowID: "child-1", ShardID: 2},
+			{WorkflowID: "child-2", ShardID: 1},
+			{WorkflowID: "child-3", ShardID: 2},
+			{WorkflowID: "child-4", ShardID: 2},
+			{WorkflowID: "child-5", ShardID: 1},
+		},
+	}
+
+	batches := splitRequest(request, 2)
+	require.Len(t, batches, 3)
+	var workflowIDs [][]string
+	for _, batch := range batches {
+		require.Equal(t, parent, batch.ParentExecution)
+		var ids []string
+		for _, execution := range batch.Executions {
+			require.Equal(t, batch.Executions[0].ShardID, execution.ShardID)
+			ids = append(ids, execution.WorkflowID)
+		}
+		workflowIDs = append(workflowIDs, ids)
+	}
+	require.Equal(t, [][]string{{"child-1", "child-3"}, {"child-4"}, {"child-2", "child-5"}}, workflowIDs)
+
+	// a non-positive batch size only groups by shard
+	require.Len(t, splitRequest(request, 0), 2)
+	require.Empty(t, splitRequest(Request{ParentExecution: parent}, 2))
+}
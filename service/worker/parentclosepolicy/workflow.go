@@ -55,6 +55,8 @@ const (
 	processorWFTypeName   = "temporal-sys-parent-close-policy-workflow"
 	processorActivityName = "temporal-sys-parent-close-policy-activity"
 	processorChannelName  = "ParentClosePolicyProcessorChannelName"
+	// processorProgressQueryName is the query returning the Progress of a processor workflow
+	processorProgressQueryName = "progress"
 )
 
 type (
@@ -65,6 +67,8 @@ type (
 		WorkflowID  string
 		RunID       string
 		Policy      enumspb.ParentClosePolicy
+		// ShardID is the history shard of the workflow, requests sent by older versions don't set it
+		ShardID int32
 	}
 
 	// Request defines the request for parent close policy
@@ -73,6 +77,20 @@ type (
 		Executions      []RequestDetail
 	}
 
+	// Progress is the progress of a processor workflow, returned by its progress query
+	Progress struct {
+		RequestsProcessed   int
+		RequestsFailed      int
+		ExecutionsProcessed int
+	}
+
+	// activityHeartbeatDetails is recorded by the processor activity so that retries don't send requests for
+	// executions that were already handled again
+	activityHeartbeatDetails struct {
+		Processed        int
+		RemoteExecutions map[string][]RequestDetail
+	}
+
 	processorContextKeyType struct{}
 )
 
@@ -94,6 +112,13 @@ var (
 
 // ProcessorWorkflow is the workflow that performs actions for ParentClosePolicy
 func ProcessorWorkflow(ctx workflow.Context) error {
+	var progress Progress
+	if err := workflow.SetQueryHandler(ctx, processorProgressQueryName, func() (Progress, error) {
+		return progress, nil
+	}); err != nil {
+		return err
+	}
+
 	requestCh := workflow.GetSignalChannel(ctx, processorChannelName)
 	for {
 		var request Request
@@ -103,7 +128,11 @@ func ProcessorWorkflow(ctx workflow.Context) error {
 		}
 
 		opt := workflow.WithActivityOptions(ctx, activityOptions)
-		_ = workflow.ExecuteActivity(opt, processorActivityName, request).Get(ctx, nil)
+		if err := workflow.ExecuteActivity(opt, processorActivityName, request).Get(ctx, nil); err != nil {
+			progress.RequestsFailed++
+		}
+		progress.RequestsProcessed++
+		progress.ExecutionsProcessed += len(request.Executions)
 	}
 	return nil
 }
@@ -119,8 +148,20 @@ func ProcessorActivity(ctx context.Context, request Request) error {
 	childWorkflowOnly := request.ParentExecution.GetWorkflowId() != "" &&
 		request.ParentExecution.GetRunId() != ""
 
-	remoteExecutions := make(map[string][]RequestDetail)
-	for _, execution := range request.Executions {
+	details := activityHeartbeatDetails{RemoteExecutions: make(map[string][]RequestDetail)}
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &details); err != nil {
+			getActivityLogger(ctx).Error("failed to get heartbeat details", tag.Error(err))
+			details = activityHeartbeatDetails{RemoteExecutions: make(map[string][]RequestDetail)}
+		}
+		if details.RemoteExecutions == nil {
+			details.RemoteExecutions = make(map[string][]RequestDetail)
+		}
+	}
+	remoteExecutions := details.RemoteExecutions
+
+	for i := details.Processed; i < len(request.Executions); i++ {
+		execution := request.Executions[i]
 		requestCtx := headers.SetCallerName(ctx, execution.Namespace)
 
 		var err error
@@ -171,6 +212,8 @@ func ProcessorActivity(ctx context.Context, request Request) error {
 			getActivityLogger(ctx).Error("failed to process parent close policy", tag.Error(err))
 			return err
 		}
+		details.Processed = i + 1
+		activity.RecordHeartbeat(ctx, details)
 	}
 
 	if err := signalRemoteCluster(
@@ -26,15 +26,19 @@ package parentclosepolicy
 
 import (
 	"context"
+	"errors"
 	"testing"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/activity"
 	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
 	"go.temporal.io/server/client"
@@ -193,3 +197,78 @@ func (s *parentClosePolicyWorkflowSuite) TestProcessorActivity_RemoteCluster() {
 	_, err := env.ExecuteActivity(ProcessorActivity, request)
 	s.NoError(err)
 }
+
+func (s *parentClosePolicyWorkflowSuite) TestProcessorActivity_ResumeFromHeartbeat() {
+	env := s.NewTestActivityEnvironment()
+	env.SetWorkerOptions(getWorkerOptions(s.processor))
+	env.RegisterActivity(ProcessorActivity)
+	env.SetHeartbeatDetails(activityHeartbeatDetails{Processed: 1})
+
+	request := Request{
+		ParentExecution: &commonpb.WorkflowExecution{
+			WorkflowId: "parent workflowID",
+			RunId:      "parent runID",
+		},
+		Executions: []RequestDetail{
+			{
+				Namespace:   tests.ChildNamespace.String(),
+				NamespaceID: tests.ChildNamespaceID.String(),
+				WorkflowID:  "child workflowID 1",
+				RunID:       "childworkflow runID 1",
+				Policy:      enumspb.PARENT_CLOSE_POLICY_TERMINATE,
+			},
+			{
+				Namespace:   tests.ChildNamespace.String(),
+				NamespaceID: tests.ChildNamespaceID.String(),
+				WorkflowID:  "child workflowID 2",
+				RunID:       "childworkflow runID 2",
+				Policy:      enumspb.PARENT_CLOSE_POLICY_TERMINATE,
+			},
+		},
+	}
+
+	// the first execution was processed by a previous attempt
+	s.mockHistoryClient.EXPECT().TerminateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(
+			_ context.Context,
+			request *historyservice.TerminateWorkflowExecutionRequest,
+			_ ...grpc.CallOption,
+		) (*historyservice.TerminateWorkflowExecutionResponse, error) {
+			s.Equal("child workflowID 2", request.TerminateRequest.WorkflowExecution.WorkflowId)
+			return &historyservice.TerminateWorkflowExecutionResponse{}, nil
+		},
+	).Times(1)
+
+	_, err := env.ExecuteActivity(ProcessorActivity, request)
+	s.NoError(err)
+}
+
+func (s *parentClosePolicyWorkflowSuite) TestProcessorWorkflow_Progress() {
+	env := s.NewTestWorkflowEnvironment()
+	env.RegisterWorkflowWithOptions(ProcessorWorkflow, workflow.RegisterOptions{Name: processorWFTypeName})
+	env.RegisterActivityWithOptions(ProcessorActivity, activity.RegisterOptions{Name: processorActivityName})
+
+	request := func(numExecutions int) Request {
+		executions := make([]RequestDetail, numExecutions)
+		for i := range executions {
+			executions[i] = RequestDetail{Policy: enumspb.PARENT_CLOSE_POLICY_ABANDON}
+		}
+		return Request{Executions: executions}
+	}
+	env.OnActivity(processorActivityName, mock.Anything, mock.Anything).Return(nil).Once()
+	env.OnActivity(processorActivityName, mock.Anything, mock.Anything).Return(errors.New("failed")).Once()
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(processorChannelName, request(3))
+		env.SignalWorkflow(processorChannelName, request(2))
+	}, 0)
+
+	env.ExecuteWorkflow(processorWFTypeName)
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+
+	encoded, err := env.QueryWorkflow(processorProgressQueryName)
+	s.NoError(err)
+	var progress Progress
+	s.NoError(encoded.Get(&progress))
+	s.Equal(Progress{RequestsProcessed: 2, RequestsFailed: 1, ExecutionsProcessed: 5}, progress)
+}
@@ -0,0 +1,346 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rearchival
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/activity"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/util"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+const (
+	// verifyArchivePageSize is the page size used to read existing archives when verifying them.
+	verifyArchivePageSize = 1000
+)
+
+type (
+	activities struct {
+		numShards         int32
+		historyClient     historyservice.HistoryServiceClient
+		frontendClient    workflowservice.WorkflowServiceClient
+		namespaceRegistry namespace.Registry
+		archiverProvider  provider.ArchiverProvider
+		rps               dynamicconfig.IntPropertyFnWithNamespaceFilter
+		serializer        *serialization.TaskSerializer
+		logger            log.Logger
+	}
+
+	// pageHeartbeatDetails is the progress of a page recorded as heartbeat details. The page size is kept so that a
+	// retried attempt reads the same page even if the RPS changed in the meantime.
+	pageHeartbeatDetails struct {
+		PageSize  int
+		Processed int
+		Response  pageResponse
+	}
+
+	// pacer spaces out archival tasks at a fixed interval. Its state is carried across pages through the workflow, so
+	// that the rate holds for the whole job rather than restarting with every page.
+	pacer struct {
+		interval     time.Duration
+		nextTaskTime time.Time
+	}
+)
+
+var errExecutionNotArchivable = errors.New("execution is not archivable")
+
+// ReArchivePage reads one page of executions from visibility and enqueues an archival task for each of them on the
+// owning history shard. Executions whose existing archive is complete are skipped, all others are re-archived, which
+// overwrites a missing, partial or unreadable archive. The progress within the page is recorded as heartbeat details,
+// so that a retried attempt continues after the last processed execution.
+func (a *activities) ReArchivePage(ctx context.Context, req pageRequest) (pageResponse, error) {
+	logger := log.With(a.logger, tag.WorkflowNamespace(req.Namespace))
+
+	historyArchiver, archiveURI, err := a.getHistoryArchiver(req.NamespaceID)
+	if err != nil {
+		return pageResponse{}, err
+	}
+
+	interval := a.taskInterval(req)
+	details := pageHeartbeatDetails{
+		PageSize: pageSizeForInterval(req.PageSize, interval),
+		Response: pageResponse{NextTaskTime: req.NextTaskTime},
+	}
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &details); err != nil {
+			return pageResponse{}, err
+		}
+	}
+
+	listResp, err := a.frontendClient.ListWorkflowExecutions(ctx, &workflowservice.ListWorkflowExecutionsRequest{
+		Namespace:     req.Namespace,
+		PageSize:      int32(details.PageSize),
+		NextPageToken: req.NextPageToken,
+		Query:         req.Query,
+	})
+	if err != nil {
+		return pageResponse{}, err
+	}
+
+	resp := &details.Response
+	resp.NextPageToken = listResp.NextPageToken
+	pace := &pacer{interval: interval, nextTaskTime: resp.NextTaskTime}
+	heartbeat := func() {
+		resp.NextTaskTime = pace.nextTaskTime
+		activity.RecordHeartbeat(ctx, details)
+	}
+	for i := details.Processed; i < len(listResp.Executions); i++ {
+		if err := pace.wait(ctx, heartbeat); err != nil {
+			return pageResponse{}, err
+		}
+		if err := a.reArchiveExecution(ctx, logger, historyArchiver, archiveURI, req.NamespaceID, listResp.Executions[i].GetExecution(), resp); err != nil {
+			return pageResponse{}, err
+		}
+		details.Processed = i + 1
+		heartbeat()
+	}
+	resp.NextTaskTime = pace.nextTaskTime
+	return *resp, nil
+}
+
+// reArchiveExecution enqueues an archival task for a single execution unless its existing archive is complete, and
+// counts the outcome in resp. Only errors which fail the whole page are returned.
+func (a *activities) reArchiveExecution(
+	ctx context.Context,
+	logger log.Logger,
+	historyArchiver archiver.HistoryArchiver,
+	archiveURI archiver.URI,
+	namespaceID string,
+	execution *commonpb.WorkflowExecution,
+	resp *pageResponse,
+) error {
+	task, lastEventID, err := a.newArchiveExecutionTask(ctx, namespaceID, execution)
+	switch {
+	case err == nil:
+	case errors.Is(err, errExecutionNotArchivable), common.IsNotFoundError(err):
+		resp.Skipped++
+		return nil
+	default:
+		logger.Warn("Unable to load execution for re-archival.",
+			tag.WorkflowID(execution.GetWorkflowId()),
+			tag.WorkflowRunID(execution.GetRunId()),
+			tag.Error(err),
+		)
+		resp.Failed++
+		return nil
+	}
+	if historyArchiver != nil {
+		complete, err := isArchiveComplete(ctx, historyArchiver, archiveURI, task, lastEventID)
+		if err != nil {
+			return err
+		}
+		if complete {
+			resp.Verified++
+			return nil
+		}
+	}
+	blob, err := a.serializer.SerializeTask(task)
+	if err != nil {
+		return err
+	}
+	shardID := common.WorkflowIDToHistoryShard(namespaceID, task.WorkflowID, a.numShards)
+	if _, err := a.historyClient.AddTasks(ctx, &historyservice.AddTasksRequest{
+		ShardId: shardID,
+		Tasks: []*historyservice.AddTasksRequest_Task{{
+			CategoryId: int32(tasks.CategoryIDArchival),
+			Blob:       blob,
+		}},
+	}); err != nil {
+		logger.Warn("Unable to enqueue archival task.", tag.ShardID(shardID), tag.WorkflowID(task.WorkflowID), tag.Error(err))
+		resp.Failed++
+		return nil
+	}
+	resp.Enqueued++
+	return nil
+}
+
+// getHistoryArchiver returns the archiver for the current history archival URI of the namespace, against which
+// existing archives are verified. It returns a nil archiver if the namespace has no history archival URI.
+func (a *activities) getHistoryArchiver(namespaceID string) (archiver.HistoryArchiver, archiver.URI, error) {
+	nsEntry, err := a.namespaceRegistry.GetNamespaceByID(namespace.ID(namespaceID))
+	if err != nil {
+		return nil, nil, err
+	}
+	uriString := nsEntry.HistoryArchivalState().URI
+	if uriString == "" {
+		return nil, nil, nil
+	}
+	uri, err := archiver.NewURI(uriString)
+	if err != nil {
+		return nil, nil, err
+	}
+	historyArchiver, err := a.archiverProvider.GetHistoryArchiver(uri.Scheme(), string(primitives.WorkerService))
+	if err != nil {
+		return nil, nil, err
+	}
+	return historyArchiver, uri, nil
+}
+
+// isArchiveComplete reads the existing archive of an execution and reports whether it was written for the close
+// version and contains the history up to the last event of the execution. An archive which can't be read is reported
+// as incomplete, so that it gets overwritten.
+func isArchiveComplete(
+	ctx context.Context,
+	historyArchiver archiver.HistoryArchiver,
+	uri archiver.URI,
+	task *tasks.ArchiveExecutionTask,
+	lastEventID int64,
+) (bool, error) {
+	request := &archiver.GetHistoryRequest{
+		NamespaceID:          task.NamespaceID,
+		WorkflowID:           task.WorkflowID,
+		RunID:                task.RunID,
+		CloseFailoverVersion: &task.Version,
+		PageSize:             verifyArchivePageSize,
+	}
+	var archivedLastEventID int64
+	for {
+		resp, err := historyArchiver.Get(ctx, uri, request)
+		if err != nil {
+			// the archive is missing or unreadable unless the activity itself is being canceled
+			return false, ctx.Err()
+		}
+		for _, batch := range resp.HistoryBatches {
+			if events := batch.GetEvents(); len(events) > 0 {
+				archivedLastEventID = events[len(events)-1].GetEventId()
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			return archivedLastEventID == lastEventID, nil
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+}
+
+// newArchiveExecutionTask verifies the execution is still closed and present in primary storage, and builds an
+// archival task carrying the close version so that the task passes the version check of the archival queue. It also
+// returns the ID of the last event of the execution.
+func (a *activities) newArchiveExecutionTask(
+	ctx context.Context,
+	namespaceID string,
+	execution *commonpb.WorkflowExecution,
+) (*tasks.ArchiveExecutionTask, int64, error) {
+	msResp, err := a.historyClient.DescribeMutableState(ctx, &historyservice.DescribeMutableStateRequest{
+		NamespaceId: namespaceID,
+		Execution:   execution,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	mutableState := msResp.GetDatabaseMutableState()
+	if mutableState.GetExecutionState().GetState() != enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED {
+		return nil, 0, errExecutionNotArchivable
+	}
+	closeVersion, err := getCloseVersion(mutableState)
+	if err != nil {
+		return nil, 0, err
+	}
+	return &tasks.ArchiveExecutionTask{
+		WorkflowKey: definition.NewWorkflowKey(
+			namespaceID,
+			execution.GetWorkflowId(),
+			mutableState.GetExecutionState().GetRunId(),
+		),
+		VisibilityTimestamp: time.Now().UTC(),
+		Version:             closeVersion,
+	}, mutableState.GetNextEventId() - 1, nil
+}
+
+// taskInterval returns the time between two archival tasks for the lower of the dynamic config and the request RPS,
+// where zero means no limit.
+func (a *activities) taskInterval(req pageRequest) time.Duration {
+	rps := float64(a.rps(req.Namespace))
+	if req.RPS > 0 && (rps <= 0 || req.RPS < rps) {
+		rps = req.RPS
+	}
+	if rps <= 0 {
+		return 0
+	}
+	return time.Duration(float64(time.Second) / rps)
+}
+
+// pageSizeForInterval limits the page size so that pacing a full page takes at most half the activity timeout, which
+// leaves the other half for the requests made for the executions of the page.
+func pageSizeForInterval(pageSize int, interval time.Duration) int {
+	if interval <= 0 {
+		return pageSize
+	}
+	return max(1, min(pageSize, int(reArchivePageTimeBudget/interval)))
+}
+
+// wait blocks until the next task may be started, calling heartbeat at least once per heartbeat interval while it
+// waits.
+func (p *pacer) wait(ctx context.Context, heartbeat func()) error {
+	for {
+		delay := time.Until(p.nextTaskTime)
+		if delay <= 0 {
+			break
+		}
+		util.InterruptibleSleep(ctx, min(delay, reArchivePageHeartbeatInterval))
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		heartbeat()
+	}
+	p.nextTaskTime = time.Now().Add(p.interval)
+	return nil
+}
+
+// getCloseVersion mirrors MutableState.GetCloseVersion for a persisted mutable state: closing an execution always
+// writes an event, so the version of the last event is the close version.
+func getCloseVersion(mutableState *persistencespb.WorkflowMutableState) (int64, error) {
+	versionHistories := mutableState.GetExecutionInfo().GetVersionHistories()
+	if versionHistories == nil {
+		return common.EmptyVersion, nil
+	}
+	currentHistory, err := versionhistory.GetCurrentVersionHistory(versionHistories)
+	if err != nil {
+		return 0, err
+	}
+	lastItem, err := versionhistory.GetLastVersionHistoryItem(currentHistory)
+	if err != nil {
+		return 0, err
+	}
+	return lastItem.GetVersion(), nil
+}
@@ -0,0 +1,193 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package rearchival contains the system workflow which re-archives closed workflow executions selected by a
+// visibility query. It is used to recover from archival target misconfiguration or after migrating to a different
+// archival provider.
+package rearchival
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+const (
+	// WorkflowName is the name of the re-archival system workflow.
+	WorkflowName = "temporal-sys-rearchival-workflow"
+	// QueryTypeProgress is the query to get the progress of the re-archival workflow.
+	QueryTypeProgress = "rearchival-progress-query"
+
+	// DefaultPageSize is the number of executions read from visibility and re-archived by a single activity.
+	DefaultPageSize = 100
+	// pagesPerRun is the number of pages processed before the workflow continues as new to keep history bounded.
+	pagesPerRun = 200
+
+	reArchivePageActivityTimeout   = 5 * time.Minute
+	reArchivePageHeartbeatTimeout  = 30 * time.Second
+	reArchivePageHeartbeatInterval = reArchivePageHeartbeatTimeout / 3
+	// reArchivePageTimeBudget is the time a page may spend waiting for the rate limit, see pageSizeForInterval.
+	reArchivePageTimeBudget = reArchivePageActivityTimeout / 2
+
+	// MinRPS is the lowest RPS at which a page of a single execution still completes within the activity timeout.
+	MinRPS = float64(time.Second) / float64(reArchivePageTimeBudget)
+)
+
+type (
+	// WorkflowParams is the single argument to the re-archival workflow.
+	WorkflowParams struct {
+		Namespace   string
+		NamespaceID string
+		// Query selects the executions to re-archive. It must already exclude running executions, see BuildQuery.
+		Query string
+		// RPS limits the rate at which archival tasks are enqueued. Zero means the dynamic config default, other values
+		// must not be lower than MinRPS.
+		RPS      float64
+		PageSize int
+		// Progress is carried over when the workflow continues as new.
+		Progress Progress
+	}
+
+	// Progress is the response to the progress query and the result of the workflow.
+	Progress struct {
+		NextPageToken  []byte
+		PagesProcessed int
+		// Enqueued is the number of executions for which an archival task was added.
+		Enqueued int64
+		// Skipped is the number of executions which were no longer eligible for archival, e.g. already deleted.
+		Skipped int64
+		// Failed is the number of executions for which enqueueing an archival task failed.
+		Failed int64
+		// Verified is the number of executions whose existing archive was complete and therefore not rewritten.
+		Verified int64
+		// NextTaskTime is the earliest time the next archival task may be enqueued, which keeps the rate across pages.
+		NextTaskTime time.Time
+	}
+
+	pageRequest struct {
+		Namespace     string
+		NamespaceID   string
+		Query         string
+		RPS           float64
+		PageSize      int
+		NextPageToken []byte
+		NextTaskTime  time.Time
+	}
+
+	pageResponse struct {
+		NextPageToken []byte
+		NextTaskTime  time.Time
+		Enqueued      int64
+		Skipped       int64
+		Failed        int64
+		Verified      int64
+	}
+)
+
+var (
+	ErrMissingNamespace = errors.New("namespace is required")
+
+	reArchivePageActivityOptions = workflow.ActivityOptions{
+		TaskQueue:           primitives.ReArchivalActivityTQ,
+		StartToCloseTimeout: reArchivePageActivityTimeout,
+		HeartbeatTimeout:    reArchivePageHeartbeatTimeout,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2,
+			MaximumInterval:    time.Minute,
+		},
+	}
+)
+
+// BuildQuery restricts the user supplied visibility query to closed executions, since only those can be archived.
+func BuildQuery(query string) string {
+	closed := fmt.Sprintf("%s != 'Running'", searchattribute.ExecutionStatus)
+	if query == "" {
+		return closed
+	}
+	return fmt.Sprintf("(%s) AND %s", query, closed)
+}
+
+// ReArchivalWorkflow pages through the executions matching the query and enqueues an archival task for each of them.
+// The archival queue of the owning history shard then performs the actual upload. Executions whose existing archive is
+// complete are skipped; archivers write to a deterministic location per execution, so any other archive is overwritten.
+func ReArchivalWorkflow(ctx workflow.Context, params WorkflowParams) (Progress, error) {
+	if params.Namespace == "" || params.NamespaceID == "" {
+		return Progress{}, temporal.NewNonRetryableApplicationError(ErrMissingNamespace.Error(), "InvalidArgument", nil)
+	}
+	if params.PageSize <= 0 {
+		params.PageSize = DefaultPageSize
+	}
+	progress := params.Progress
+	if err := workflow.SetQueryHandler(ctx, QueryTypeProgress, func() (Progress, error) {
+		return progress, nil
+	}); err != nil {
+		return progress, err
+	}
+
+	var a *activities
+	actx := workflow.WithActivityOptions(ctx, reArchivePageActivityOptions)
+	for page := 0; ; page++ {
+		if page >= pagesPerRun {
+			params.Progress = progress
+			return progress, workflow.NewContinueAsNewError(ctx, ReArchivalWorkflow, params)
+		}
+
+		var resp pageResponse
+		err := workflow.ExecuteActivity(actx, a.ReArchivePage, pageRequest{
+			Namespace:     params.Namespace,
+			NamespaceID:   params.NamespaceID,
+			Query:         params.Query,
+			RPS:           params.RPS,
+			PageSize:      params.PageSize,
+			NextPageToken: progress.NextPageToken,
+			NextTaskTime:  progress.NextTaskTime,
+		}).Get(ctx, &resp)
+		if err != nil {
+			return progress, err
+		}
+
+		progress.PagesProcessed++
+		progress.NextPageToken = resp.NextPageToken
+		progress.NextTaskTime = resp.NextTaskTime
+		progress.Enqueued += resp.Enqueued
+		progress.Skipped += resp.Skipped
+		progress.Failed += resp.Failed
+		progress.Verified += resp.Verified
+
+		if len(resp.NextPageToken) == 0 {
+			workflow.GetLogger(ctx).Info("Re-archival finished.",
+				tag.WorkflowNamespace(params.Namespace),
+				tag.Counter(int(progress.Enqueued)),
+			)
+			return progress, nil
+		}
+	}
+}
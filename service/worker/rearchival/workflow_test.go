@@ -0,0 +1,398 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rearchival
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/testsuite"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/testing/mockapi/workflowservicemock/v1"
+	"go.temporal.io/server/service/history/tasks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestBuildQuery(t *testing.T) {
+	require.Equal(t, "ExecutionStatus != 'Running'", BuildQuery(""))
+	require.Equal(t, "(WorkflowType = 'foo') AND ExecutionStatus != 'Running'", BuildQuery("WorkflowType = 'foo'"))
+}
+
+func TestReArchivalWorkflow_Pages(t *testing.T) {
+	var s testsuite.WorkflowTestSuite
+	env := s.NewTestWorkflowEnvironment()
+	var a *activities
+	env.RegisterActivity(a)
+	nextTaskTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	env.OnActivity(a.ReArchivePage, mock.Anything, mock.Anything).Return(func(_ context.Context, req pageRequest) (pageResponse, error) {
+		if req.NextPageToken == nil {
+			return pageResponse{NextPageToken: []byte("token"), NextTaskTime: nextTaskTime, Enqueued: 3, Skipped: 1}, nil
+		}
+		// pacing continues where the previous page stopped
+		require.Equal(t, nextTaskTime, req.NextTaskTime)
+		return pageResponse{NextTaskTime: nextTaskTime.Add(time.Second), Enqueued: 2, Failed: 1, Verified: 4}, nil
+	})
+
+	env.ExecuteWorkflow(ReArchivalWorkflow, WorkflowParams{
+		Namespace:   "ns",
+		NamespaceID: "ns-id",
+		Query:       BuildQuery(""),
+	})
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	var progress Progress
+	require.NoError(t, env.GetWorkflowResult(&progress))
+	require.Equal(t, Progress{
+		PagesProcessed: 2,
+		Enqueued:       5,
+		Skipped:        1,
+		Failed:         1,
+		Verified:       4,
+		NextTaskTime:   nextTaskTime.Add(time.Second),
+	}, progress)
+}
+
+func TestReArchivalWorkflow_MissingNamespace(t *testing.T) {
+	var s testsuite.WorkflowTestSuite
+	env := s.NewTestWorkflowEnvironment()
+
+	env.ExecuteWorkflow(ReArchivalWorkflow, WorkflowParams{})
+	require.True(t, env.IsWorkflowCompleted())
+	require.ErrorContains(t, env.GetWorkflowError(), ErrMissingNamespace.Error())
+}
+
+func TestReArchivePage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	historyClient := historyservicemock.NewMockHistoryServiceClient(ctrl)
+	frontendClient := workflowservicemock.NewMockWorkflowServiceClient(ctrl)
+	namespaceRegistry := namespace.NewMockRegistry(ctrl)
+	namespaceRegistry.EXPECT().GetNamespaceByID(namespace.ID("ns-id")).Return(newNamespaceForTest(""), nil)
+	a := &activities{
+		numShards:         1,
+		historyClient:     historyClient,
+		frontendClient:    frontendClient,
+		namespaceRegistry: namespaceRegistry,
+		rps:               dynamicconfig.GetIntPropertyFnFilteredByNamespace(1000),
+		serializer:        serialization.NewTaskSerializer(),
+		logger:            log.NewNoopLogger(),
+	}
+
+	closed := &commonpb.WorkflowExecution{WorkflowId: "closed", RunId: "run1"}
+	running := &commonpb.WorkflowExecution{WorkflowId: "running", RunId: "run2"}
+	deleted := &commonpb.WorkflowExecution{WorkflowId: "deleted", RunId: "run3"}
+	frontendClient.EXPECT().ListWorkflowExecutions(gomock.Any(), &workflowservice.ListWorkflowExecutionsRequest{
+		Namespace: "ns",
+		PageSize:  10,
+		Query:     "query",
+	}).Return(&workflowservice.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			{Execution: closed},
+			{Execution: running},
+			{Execution: deleted},
+		},
+		NextPageToken: []byte("next"),
+	}, nil)
+
+	historyClient.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *historyservice.DescribeMutableStateRequest, _ ...any) (*historyservice.DescribeMutableStateResponse, error) {
+			switch req.Execution.GetWorkflowId() {
+			case closed.WorkflowId:
+				return describeResponse(closed.RunId, enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, 7), nil
+			case running.WorkflowId:
+				return describeResponse(running.RunId, enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, 7), nil
+			default:
+				return nil, serviceerror.NewNotFound("not found")
+			}
+		},
+	).Times(3)
+	historyClient.EXPECT().AddTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *historyservice.AddTasksRequest, _ ...any) (*historyservice.AddTasksResponse, error) {
+			require.Equal(t, int32(1), req.ShardId)
+			require.Len(t, req.Tasks, 1)
+			require.Equal(t, int32(tasks.CategoryIDArchival), req.Tasks[0].CategoryId)
+			task, err := serialization.NewTaskSerializer().DeserializeTask(tasks.CategoryArchival, req.Tasks[0].Blob)
+			require.NoError(t, err)
+			require.Equal(t, closed.WorkflowId, task.GetWorkflowID())
+			require.Equal(t, closed.RunId, task.GetRunID())
+			require.Equal(t, int64(7), task.(*tasks.ArchiveExecutionTask).Version)
+			return &historyservice.AddTasksResponse{}, nil
+		},
+	)
+
+	var s testsuite.WorkflowTestSuite
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivity(a)
+	val, err := env.ExecuteActivity(a.ReArchivePage, pageRequest{
+		Namespace:   "ns",
+		NamespaceID: "ns-id",
+		Query:       "query",
+		PageSize:    10,
+	})
+	require.NoError(t, err)
+	var resp pageResponse
+	require.NoError(t, val.Get(&resp))
+	require.False(t, resp.NextTaskTime.IsZero())
+	resp.NextTaskTime = time.Time{}
+	require.Equal(t, pageResponse{NextPageToken: []byte("next"), Enqueued: 1, Skipped: 2}, resp)
+}
+
+func TestReArchivePage_VerifyExistingArchives(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	historyClient := historyservicemock.NewMockHistoryServiceClient(ctrl)
+	frontendClient := workflowservicemock.NewMockWorkflowServiceClient(ctrl)
+	namespaceRegistry := namespace.NewMockRegistry(ctrl)
+	archiverProvider := provider.NewMockArchiverProvider(ctrl)
+	historyArchiver := archiver.NewMockHistoryArchiver(ctrl)
+	a := &activities{
+		numShards:         1,
+		historyClient:     historyClient,
+		frontendClient:    frontendClient,
+		namespaceRegistry: namespaceRegistry,
+		archiverProvider:  archiverProvider,
+		rps:               dynamicconfig.GetIntPropertyFnFilteredByNamespace(0),
+		serializer:        serialization.NewTaskSerializer(),
+		logger:            log.NewNoopLogger(),
+	}
+	namespaceRegistry.EXPECT().GetNamespaceByID(namespace.ID("ns-id")).Return(newNamespaceForTest("test:///archive"), nil)
+	archiverProvider.EXPECT().GetHistoryArchiver("test", string(primitives.WorkerService)).Return(historyArchiver, nil)
+
+	complete := &commonpb.WorkflowExecution{WorkflowId: "complete", RunId: "run1"}
+	partial := &commonpb.WorkflowExecution{WorkflowId: "partial", RunId: "run2"}
+	missing := &commonpb.WorkflowExecution{WorkflowId: "missing", RunId: "run3"}
+	frontendClient.EXPECT().ListWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&workflowservice.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			{Execution: complete},
+			{Execution: partial},
+			{Execution: missing},
+		},
+	}, nil)
+	historyClient.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *historyservice.DescribeMutableStateRequest, _ ...any) (*historyservice.DescribeMutableStateResponse, error) {
+			resp := describeResponse(req.Execution.GetRunId(), enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, 7)
+			resp.DatabaseMutableState.NextEventId = 11
+			return resp, nil
+		},
+	).Times(3)
+	historyArchiver.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, uri archiver.URI, req *archiver.GetHistoryRequest) (*archiver.GetHistoryResponse, error) {
+			require.Equal(t, "test:///archive", uri.String())
+			require.Equal(t, int64(7), *req.CloseFailoverVersion)
+			switch req.WorkflowID {
+			case complete.WorkflowId:
+				if req.NextPageToken == nil {
+					return &archiver.GetHistoryResponse{
+						HistoryBatches: []*historypb.History{{Events: []*historypb.HistoryEvent{{EventId: 1}, {EventId: 5}}}},
+						NextPageToken:  []byte("next"),
+					}, nil
+				}
+				return &archiver.GetHistoryResponse{
+					HistoryBatches: []*historypb.History{{Events: []*historypb.HistoryEvent{{EventId: 6}, {EventId: 10}}}},
+				}, nil
+			case partial.WorkflowId:
+				return &archiver.GetHistoryResponse{
+					HistoryBatches: []*historypb.History{{Events: []*historypb.HistoryEvent{{EventId: 1}, {EventId: 5}}}},
+				}, nil
+			default:
+				return nil, serviceerror.NewNotFound("archive not found")
+			}
+		},
+	).Times(4)
+	var enqueued []string
+	historyClient.EXPECT().AddTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *historyservice.AddTasksRequest, _ ...any) (*historyservice.AddTasksResponse, error) {
+			require.Len(t, req.Tasks, 1)
+			task, err := serialization.NewTaskSerializer().DeserializeTask(tasks.CategoryArchival, req.Tasks[0].Blob)
+			require.NoError(t, err)
+			enqueued = append(enqueued, task.GetWorkflowID())
+			return &historyservice.AddTasksResponse{}, nil
+		},
+	).Times(2)
+
+	var s testsuite.WorkflowTestSuite
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivity(a)
+	val, err := env.ExecuteActivity(a.ReArchivePage, pageRequest{
+		Namespace:   "ns",
+		NamespaceID: "ns-id",
+		PageSize:    10,
+	})
+	require.NoError(t, err)
+	var resp pageResponse
+	require.NoError(t, val.Get(&resp))
+	require.Equal(t, int64(1), resp.Verified)
+	require.Equal(t, int64(2), resp.Enqueued)
+	require.Equal(t, []string{partial.WorkflowId, missing.WorkflowId}, enqueued)
+}
+
+func TestReArchivePage_ResumeFromHeartbeatDetails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	historyClient := historyservicemock.NewMockHistoryServiceClient(ctrl)
+	frontendClient := workflowservicemock.NewMockWorkflowServiceClient(ctrl)
+	namespaceRegistry := namespace.NewMockRegistry(ctrl)
+	namespaceRegistry.EXPECT().GetNamespaceByID(namespace.ID("ns-id")).Return(newNamespaceForTest(""), nil)
+	a := &activities{
+		numShards:         1,
+		historyClient:     historyClient,
+		frontendClient:    frontendClient,
+		namespaceRegistry: namespaceRegistry,
+		rps:               dynamicconfig.GetIntPropertyFnFilteredByNamespace(1000),
+		serializer:        serialization.NewTaskSerializer(),
+		logger:            log.NewNoopLogger(),
+	}
+
+	processed := &commonpb.WorkflowExecution{WorkflowId: "processed", RunId: "run1"}
+	remaining := &commonpb.WorkflowExecution{WorkflowId: "remaining", RunId: "run2"}
+	// the page is read again with the page size of the previous attempt
+	frontendClient.EXPECT().ListWorkflowExecutions(gomock.Any(), &workflowservice.ListWorkflowExecutionsRequest{
+		Namespace:     "ns",
+		PageSize:      5,
+		NextPageToken: []byte("token"),
+	}).Return(&workflowservice.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			{Execution: processed},
+			{Execution: remaining},
+		},
+	}, nil)
+	historyClient.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *historyservice.DescribeMutableStateRequest, _ ...any) (*historyservice.DescribeMutableStateResponse, error) {
+			require.Equal(t, remaining.WorkflowId, req.Execution.GetWorkflowId())
+			return describeResponse(remaining.RunId, enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, 7), nil
+		},
+	)
+	historyClient.EXPECT().AddTasks(gomock.Any(), gomock.Any()).Return(&historyservice.AddTasksResponse{}, nil)
+
+	var s testsuite.WorkflowTestSuite
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivity(a)
+	env.SetHeartbeatDetails(pageHeartbeatDetails{
+		PageSize:  5,
+		Processed: 1,
+		Response:  pageResponse{Enqueued: 1},
+	})
+	val, err := env.ExecuteActivity(a.ReArchivePage, pageRequest{
+		Namespace:     "ns",
+		NamespaceID:   "ns-id",
+		PageSize:      10,
+		NextPageToken: []byte("token"),
+	})
+	require.NoError(t, err)
+	var resp pageResponse
+	require.NoError(t, val.Get(&resp))
+	require.Equal(t, int64(2), resp.Enqueued)
+}
+
+func TestPageSizeForInterval(t *testing.T) {
+	require.Equal(t, 100, pageSizeForInterval(100, 0))
+	require.Equal(t, 100, pageSizeForInterval(100, time.Millisecond))
+	require.Equal(t, 15, pageSizeForInterval(100, 10*time.Second))
+	require.Equal(t, 1, pageSizeForInterval(100, time.Hour))
+}
+
+func TestTaskInterval(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		configRPS  int
+		requestRPS float64
+		interval   time.Duration
+	}{
+		{name: "config", configRPS: 50, interval: 20 * time.Millisecond},
+		{name: "lower request", configRPS: 50, requestRPS: 10, interval: 100 * time.Millisecond},
+		{name: "higher request", configRPS: 50, requestRPS: 100, interval: 20 * time.Millisecond},
+		{name: "fractional request", configRPS: 50, requestRPS: 0.5, interval: 2 * time.Second},
+		{name: "unlimited config", requestRPS: 10, interval: 100 * time.Millisecond},
+		{name: "unlimited"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			a := &activities{rps: dynamicconfig.GetIntPropertyFnFilteredByNamespace(tc.configRPS)}
+			require.Equal(t, tc.interval, a.taskInterval(pageRequest{Namespace: "ns", RPS: tc.requestRPS}))
+		})
+	}
+}
+
+func TestPacer(t *testing.T) {
+	// the first task of a page waits for the time carried over from the previous page
+	start := time.Now()
+	pace := &pacer{interval: 50 * time.Millisecond, nextTaskTime: start.Add(50 * time.Millisecond)}
+	heartbeats := 0
+	heartbeat := func() { heartbeats++ }
+	require.NoError(t, pace.wait(context.Background(), heartbeat))
+	require.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+	require.NoError(t, pace.wait(context.Background(), heartbeat))
+	require.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+	require.True(t, pace.nextTaskTime.After(start.Add(100*time.Millisecond)))
+	require.Equal(t, 2, heartbeats)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	pace.nextTaskTime = time.Now().Add(time.Hour)
+	require.ErrorIs(t, pace.wait(ctx, heartbeat), context.Canceled)
+}
+
+func newNamespaceForTest(historyArchivalURI string) *namespace.Namespace {
+	return namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: "ns-id", Name: "ns"},
+		&persistencespb.NamespaceConfig{HistoryArchivalUri: historyArchivalURI},
+		"active",
+	)
+}
+
+func describeResponse(runID string, state enumsspb.WorkflowExecutionState, version int64) *historyservice.DescribeMutableStateResponse {
+	return &historyservice.DescribeMutableStateResponse{
+		DatabaseMutableState: &persistencespb.WorkflowMutableState{
+			ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+				VersionHistories: &historyspb.VersionHistories{
+					Histories: []*historyspb.VersionHistory{{
+						Items: []*historyspb.VersionHistoryItem{{EventId: 10, Version: version}},
+					}},
+				},
+			},
+			ExecutionState: &persistencespb.WorkflowExecutionState{
+				RunId: runID,
+				State: state,
+			},
+		},
+	}
+}
@@ -220,6 +220,12 @@ func (p *namespaceReplicationMessageProcessor) putNamespaceReplicationTaskToDLQ(
 				metrics.ReplicationTaskTypeTag(task.TaskType),
 				metrics.NamespaceTag(ns.Name().String()),
 			)
+	case enumsspb.REPLICATION_TASK_TYPE_NEXUS_ENDPOINT:
+		metrics.NamespaceReplicationEnqueueDLQCount.With(p.metricsHandler).
+			Record(1,
+				metrics.ReplicationTaskTypeTag(task.TaskType),
+				metrics.NamespaceUnknownTag(),
+			)
 	default:
 		return serviceerror.NewUnavailable(
 			fmt.Sprintf("Namespace replication task type not supported: %v", task.TaskType),
@@ -256,6 +262,16 @@ func (p *namespaceReplicationMessageProcessor) handleNamespaceReplicationTask(
 				tag.WorkflowNamespaceID(attr.NamespaceId))
 		}
 		return err
+	case enumsspb.REPLICATION_TASK_TYPE_NEXUS_ENDPOINT:
+		attr := task.GetNexusEndpointAttributes()
+		_, err := p.matchingClient.ApplyNexusEndpointReplicationEvent(ctx, &matchingservice.ApplyNexusEndpointReplicationEventRequest{
+			Id:       attr.GetId(),
+			Endpoint: attr.GetEndpoint(),
+		})
+		if err != nil {
+			p.logger.Error("unable to process nexus endpoint replication task", tag.Endpoint(attr.GetId()))
+		}
+		return err
 	default:
 		return fmt.Errorf("cannot handle replication task of type %v", task.TaskType)
 	}
@@ -0,0 +1,694 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reshard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+const (
+	listExecutionsPageSize = 100
+	readHistoryPageSize    = 100
+	readTasksPageSize      = 100
+)
+
+type (
+	activities struct {
+		// historyPerShard is true if history branches are stored per shard and must be copied along with the
+		// executions. Otherwise the copies share the history of the original.
+		historyPerShard        bool
+		executionManager       persistence.ExecutionManager
+		shardManager           persistence.ShardManager
+		clusterMetadataManager persistence.ClusterMetadataManager
+		clusterMetadata        cluster.Metadata
+		historyClient          historyservice.HistoryServiceClient
+		logger                 log.Logger
+	}
+
+	executionKey struct {
+		namespaceID string
+		workflowID  string
+		runID       string
+	}
+)
+
+var (
+	errBufferedEvents = errors.New("execution has buffered events")
+
+	// copiedTaskCategories are the task categories copied along with the moved executions. Replication tasks aren't
+	// copied, remote clusters read them per shard and catch up through the regular resend.
+	copiedTaskCategories = []tasks.Category{
+		tasks.CategoryTransfer,
+		tasks.CategoryTimer,
+		tasks.CategoryVisibility,
+		tasks.CategoryArchival,
+		tasks.CategoryOutbound,
+	}
+)
+
+// CopyShard copies the executions of a source shard which move to the other half of the split to their new shard.
+// Executions which were copied before are only copied again if they changed since.
+func (a *activities) CopyShard(ctx context.Context, req shardRequest) (shardResponse, error) {
+	var resp shardResponse
+	rangeIDs := make(map[int32]int64)
+	err := a.forEachExecution(ctx, req.ShardID, func(key executionKey) {
+		targetShardID := common.WorkflowIDToHistoryShard(key.namespaceID, key.workflowID, req.TargetShardCount)
+		if targetShardID == req.ShardID || isSystemExecution(key.namespaceID) {
+			return
+		}
+		rangeID, ok := rangeIDs[targetShardID]
+		if !ok {
+			shardResp, err := a.shardManager.GetOrCreateShard(ctx, &persistence.GetOrCreateShardRequest{
+				ShardID: targetShardID,
+			})
+			if err != nil {
+				a.logFailure("Unable to load target shard.", req.ShardID, key, err)
+				resp.Failed++
+				return
+			}
+			rangeID = shardResp.ShardInfo.GetRangeId()
+			rangeIDs[targetShardID] = rangeID
+		}
+
+		copied, err := a.copyExecution(ctx, req.ShardID, targetShardID, rangeID, key, true)
+		switch {
+		case err != nil:
+			a.logFailure("Unable to copy execution to its new shard.", req.ShardID, key, err)
+			resp.Failed++
+		case copied:
+			resp.Copied++
+		default:
+			resp.Unchanged++
+		}
+	})
+	return resp, err
+}
+
+// CopyShardTasks copies the pending tasks of the executions moving off a source shard to their new shard, so that
+// the moved executions have their timers and transfer tasks as soon as the services restart with the new shard
+// count. It runs once the executions stopped changing. Task IDs are kept, and the range ID of the new shard is
+// raised to the one of the source shard, so that task IDs allocated on the new shard later on are larger.
+func (a *activities) CopyShardTasks(ctx context.Context, req shardRequest) (shardResponse, error) {
+	var resp shardResponse
+	source, err := a.shardManager.GetOrCreateShard(ctx, &persistence.GetOrCreateShardRequest{
+		ShardID: req.ShardID,
+	})
+	if err != nil {
+		return resp, err
+	}
+	// with a doubled shard count, executions of shard s either stay or move to shard s+N
+	targetShardID := req.ShardID + req.SourceShardCount
+	rangeID, err := a.raiseRangeID(ctx, targetShardID, source.ShardInfo.GetRangeId())
+	if err != nil {
+		return resp, err
+	}
+
+	for _, category := range copiedTaskCategories {
+		// tasks copied by a previous attempt are removed first, the new shard isn't in use yet
+		if err := a.executionManager.RangeCompleteHistoryTasks(ctx, &persistence.RangeCompleteHistoryTasksRequest{
+			ShardID:             targetShardID,
+			TaskCategory:        category,
+			InclusiveMinTaskKey: tasks.MinimumKey,
+			ExclusiveMaxTaskKey: tasks.MaximumKey,
+		}); err != nil {
+			return resp, err
+		}
+		copied, err := a.copyTasks(ctx, req, targetShardID, rangeID, category, minPendingTaskKey(source.ShardInfo, category))
+		resp.TasksCopied += copied
+		if err != nil {
+			return resp, err
+		}
+	}
+	return resp, nil
+}
+
+// DeleteMovedExecutions deletes the executions of a source shard which were copied to their new shard.
+func (a *activities) DeleteMovedExecutions(ctx context.Context, req shardRequest) (shardResponse, error) {
+	var resp shardResponse
+	err := a.forEachExecution(ctx, req.ShardID, func(key executionKey) {
+		if common.WorkflowIDToHistoryShard(key.namespaceID, key.workflowID, req.TargetShardCount) == req.ShardID ||
+			isSystemExecution(key.namespaceID) {
+			return
+		}
+		if err := a.deleteExecution(ctx, req.ShardID, key); err != nil {
+			a.logFailure("Unable to delete moved execution.", req.ShardID, key, err)
+			resp.Failed++
+			return
+		}
+		resp.Deleted++
+	})
+	if err == nil && resp.Failed > 0 {
+		err = fmt.Errorf("unable to delete %d moved executions of shard %d", resp.Failed, req.ShardID)
+	}
+	return resp, err
+}
+
+// UpdateShardCount persists the target shard count in the metadata of the current cluster. Services use it instead
+// of the configured shard count once restarted.
+func (a *activities) UpdateShardCount(ctx context.Context, req shardRequest) error {
+	for clusterName, info := range a.clusterMetadata.GetAllClusterInfo() {
+		if clusterName == a.clusterMetadata.GetCurrentClusterName() || !info.Enabled || info.ShardCount == 0 {
+			continue
+		}
+		if info.ShardCount%req.TargetShardCount != 0 && req.TargetShardCount%info.ShardCount != 0 {
+			return fmt.Errorf("shard count %d of connected cluster %q isn't compatible with %d shards",
+				info.ShardCount, clusterName, req.TargetShardCount)
+		}
+	}
+
+	record, err := a.clusterMetadataManager.GetClusterMetadata(ctx, &persistence.GetClusterMetadataRequest{
+		ClusterName: a.clusterMetadata.GetCurrentClusterName(),
+	})
+	if err != nil {
+		return err
+	}
+	switch record.HistoryShardCount {
+	case req.TargetShardCount:
+		return nil
+	case req.SourceShardCount:
+	default:
+		return fmt.Errorf("cluster metadata has %d shards, expected %d", record.HistoryShardCount, req.SourceShardCount)
+	}
+
+	record.HistoryShardCount = req.TargetShardCount
+	applied, err := a.clusterMetadataManager.SaveClusterMetadata(ctx, &persistence.SaveClusterMetadataRequest{
+		ClusterMetadata:                record.ClusterMetadata,
+		Version:                        record.Version,
+		AllowHistoryShardCountDoubling: true,
+	})
+	if err != nil {
+		return err
+	}
+	if !applied {
+		return errors.New("cluster metadata wasn't updated")
+	}
+	a.logger.Info("Updated history shard count in cluster metadata.",
+		tag.NewInt32("source-shard-count", req.SourceShardCount),
+		tag.NewInt32("target-shard-count", req.TargetShardCount),
+	)
+	return nil
+}
+
+// RefreshShard runs after the cutover. System executions keep running during the cutover, so they are only moved
+// now: they are copied to their new shard unless they exist there already, and their tasks are regenerated. It also
+// deletes executions that are left on a shard they no longer belong to.
+func (a *activities) RefreshShard(ctx context.Context, req shardRequest) (shardResponse, error) {
+	var resp shardResponse
+	err := a.forEachExecution(ctx, req.ShardID, func(key executionKey) {
+		targetShardID := common.WorkflowIDToHistoryShard(key.namespaceID, key.workflowID, req.TargetShardCount)
+		if targetShardID == req.ShardID {
+			return
+		}
+		if isSystemExecution(key.namespaceID) {
+			moved, err := a.moveSystemExecution(ctx, req.ShardID, targetShardID, key)
+			if err != nil {
+				a.logFailure("Unable to move system execution to its new shard.", req.ShardID, key, err)
+				resp.Failed++
+				return
+			}
+			if moved {
+				resp.Refreshed++
+			}
+		}
+		if err := a.deleteExecution(ctx, req.ShardID, key); err != nil {
+			a.logFailure("Unable to delete execution left on its old shard.", req.ShardID, key, err)
+			resp.Failed++
+			return
+		}
+		resp.Deleted++
+	})
+	return resp, err
+}
+
+// moveSystemExecution copies a system execution to its new shard and regenerates its tasks there. The new shard is
+// in use already, so an execution found there is never overwritten: it was either moved by a previous attempt or
+// started again since the restart. It returns whether the execution was moved.
+func (a *activities) moveSystemExecution(
+	ctx context.Context,
+	sourceShardID int32,
+	targetShardID int32,
+	key executionKey,
+) (bool, error) {
+	_, err := a.getExecution(ctx, targetShardID, key)
+	if err == nil {
+		return false, nil
+	} else if !common.IsNotFoundError(err) {
+		return false, err
+	}
+
+	shardResp, err := a.shardManager.GetOrCreateShard(ctx, &persistence.GetOrCreateShardRequest{
+		ShardID: targetShardID,
+	})
+	if err != nil {
+		return false, err
+	}
+	copied, err := a.copyExecution(ctx, sourceShardID, targetShardID, shardResp.ShardInfo.GetRangeId(), key, false)
+	if err != nil || !copied {
+		return false, err
+	}
+
+	_, err = a.historyClient.RefreshWorkflowTasks(ctx, &historyservice.RefreshWorkflowTasksRequest{
+		NamespaceId: key.namespaceID,
+		Request: &adminservice.RefreshWorkflowTasksRequest{
+			NamespaceId: key.namespaceID,
+			Execution:   &commonpb.WorkflowExecution{WorkflowId: key.workflowID, RunId: key.runID},
+		},
+	})
+	return err == nil, err
+}
+
+// raiseRangeID raises the range ID of a shard to at least minRangeID and returns the resulting range ID.
+func (a *activities) raiseRangeID(ctx context.Context, shardID int32, minRangeID int64) (int64, error) {
+	resp, err := a.shardManager.GetOrCreateShard(ctx, &persistence.GetOrCreateShardRequest{
+		ShardID: shardID,
+	})
+	if err != nil {
+		return 0, err
+	}
+	shardInfo := resp.ShardInfo
+	previousRangeID := shardInfo.GetRangeId()
+	if previousRangeID >= minRangeID {
+		return previousRangeID, nil
+	}
+	shardInfo.RangeId = minRangeID
+	if err := a.shardManager.UpdateShard(ctx, &persistence.UpdateShardRequest{
+		ShardInfo:       shardInfo,
+		PreviousRangeID: previousRangeID,
+	}); err != nil {
+		return 0, err
+	}
+	return minRangeID, nil
+}
+
+// copyTasks copies the tasks of a category from minTaskKey on which belong to executions moving to the target shard.
+// It returns the number of copied tasks.
+func (a *activities) copyTasks(
+	ctx context.Context,
+	req shardRequest,
+	targetShardID int32,
+	rangeID int64,
+	category tasks.Category,
+	minTaskKey tasks.Key,
+) (int64, error) {
+	var copied int64
+	var pageToken []byte
+	for {
+		resp, err := a.executionManager.GetHistoryTasks(ctx, &persistence.GetHistoryTasksRequest{
+			ShardID:             req.ShardID,
+			TaskCategory:        category,
+			InclusiveMinTaskKey: minTaskKey,
+			ExclusiveMaxTaskKey: tasks.MaximumKey,
+			BatchSize:           readTasksPageSize,
+			NextPageToken:       pageToken,
+		})
+		if err != nil {
+			return copied, err
+		}
+
+		type workflowKey struct{ namespaceID, workflowID string }
+		var keys []workflowKey
+		tasksByWorkflow := make(map[workflowKey][]tasks.Task)
+		for _, task := range resp.Tasks {
+			key := workflowKey{namespaceID: task.GetNamespaceID(), workflowID: task.GetWorkflowID()}
+			if isSystemExecution(key.namespaceID) ||
+				common.WorkflowIDToHistoryShard(key.namespaceID, key.workflowID, req.TargetShardCount) != targetShardID {
+				continue
+			}
+			if _, ok := tasksByWorkflow[key]; !ok {
+				keys = append(keys, key)
+			}
+			tasksByWorkflow[key] = append(tasksByWorkflow[key], task)
+		}
+		for _, key := range keys {
+			if err := a.executionManager.AddHistoryTasks(ctx, &persistence.AddHistoryTasksRequest{
+				ShardID:     targetShardID,
+				RangeID:     rangeID,
+				NamespaceID: key.namespaceID,
+				WorkflowID:  key.workflowID,
+				Tasks:       map[tasks.Category][]tasks.Task{category: tasksByWorkflow[key]},
+			}); err != nil {
+				return copied, err
+			}
+			copied += int64(len(tasksByWorkflow[key]))
+		}
+
+		pageToken = resp.NextPageToken
+		activity.RecordHeartbeat(ctx)
+		if len(pageToken) == 0 {
+			return copied, nil
+		}
+	}
+}
+
+// forEachExecution calls f for every execution of a shard. The page token is recorded as heartbeat details, so that
+// a retried activity resumes where the previous attempt stopped.
+func (a *activities) forEachExecution(ctx context.Context, shardID int32, f func(executionKey)) error {
+	var pageToken []byte
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &pageToken); err != nil {
+			return err
+		}
+	}
+	for {
+		resp, err := a.executionManager.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+			ShardID:   shardID,
+			PageSize:  listExecutionsPageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return err
+		}
+		for _, state := range resp.States {
+			f(executionKey{
+				namespaceID: state.GetExecutionInfo().GetNamespaceId(),
+				workflowID:  state.GetExecutionInfo().GetWorkflowId(),
+				runID:       state.GetExecutionState().GetRunId(),
+			})
+		}
+		pageToken = resp.PageToken
+		activity.RecordHeartbeat(ctx, pageToken)
+		if len(pageToken) == 0 {
+			return nil
+		}
+	}
+}
+
+// copyExecution copies an execution to the target shard, unless the copy there is up to date already. It returns
+// whether the execution was copied. Tasks are copied separately once the executions stopped changing. If
+// replaceTargetCurrent is false, a current record of another run on the target shard is kept.
+func (a *activities) copyExecution(
+	ctx context.Context,
+	sourceShardID int32,
+	targetShardID int32,
+	rangeID int64,
+	key executionKey,
+	replaceTargetCurrent bool,
+) (bool, error) {
+	source, err := a.getExecution(ctx, sourceShardID, key)
+	if common.IsNotFoundError(err) {
+		// deleted since it was listed
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	if len(source.State.BufferedEvents) > 0 {
+		// buffered events can't be written with a snapshot, the next pass will copy the execution
+		return false, errBufferedEvents
+	}
+
+	target, err := a.getExecution(ctx, targetShardID, key)
+	switch {
+	case err == nil && target.DBRecordVersion == source.DBRecordVersion:
+		return false, nil
+	case err == nil:
+		if err := a.deleteExecution(ctx, targetShardID, key); err != nil {
+			return false, err
+		}
+	case !common.IsNotFoundError(err):
+		return false, err
+	}
+
+	state := source.State
+	executionInfo := common.CloneProto(state.ExecutionInfo)
+	if a.historyPerShard {
+		if err := a.copyHistory(ctx, sourceShardID, targetShardID, key, executionInfo); err != nil {
+			return false, err
+		}
+	}
+
+	mode := persistence.CreateWorkflowModeBypassCurrent
+	current, err := a.executionManager.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+		ShardID:     sourceShardID,
+		NamespaceID: key.namespaceID,
+		WorkflowID:  key.workflowID,
+	})
+	if err != nil && !common.IsNotFoundError(err) {
+		return false, err
+	}
+	if err == nil && current.RunID == key.runID {
+		mode = persistence.CreateWorkflowModeBrandNew
+		// the target may point to a run that was current when it was copied
+		targetCurrent, err := a.executionManager.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+			ShardID:     targetShardID,
+			NamespaceID: key.namespaceID,
+			WorkflowID:  key.workflowID,
+		})
+		if err == nil && !replaceTargetCurrent {
+			mode = persistence.CreateWorkflowModeBypassCurrent
+		} else if err == nil {
+			err = a.executionManager.DeleteCurrentWorkflowExecution(ctx, &persistence.DeleteCurrentWorkflowExecutionRequest{
+				ShardID:     targetShardID,
+				NamespaceID: key.namespaceID,
+				WorkflowID:  key.workflowID,
+				RunID:       targetCurrent.RunID,
+			})
+		}
+		if err != nil && !common.IsNotFoundError(err) {
+			return false, err
+		}
+	}
+
+	signalRequestedIDs := make(map[string]struct{}, len(state.SignalRequestedIds))
+	for _, id := range state.SignalRequestedIds {
+		signalRequestedIDs[id] = struct{}{}
+	}
+	_, err = a.executionManager.CreateWorkflowExecution(ctx, &persistence.CreateWorkflowExecutionRequest{
+		ShardID: targetShardID,
+		RangeID: rangeID,
+		Mode:    mode,
+		NewWorkflowSnapshot: persistence.WorkflowSnapshot{
+			ExecutionInfo:       executionInfo,
+			ExecutionState:      state.ExecutionState,
+			NextEventID:         state.NextEventId,
+			ActivityInfos:       state.ActivityInfos,
+			TimerInfos:          state.TimerInfos,
+			ChildExecutionInfos: state.ChildExecutionInfos,
+			RequestCancelInfos:  state.RequestCancelInfos,
+			SignalInfos:         state.SignalInfos,
+			SignalRequestedIDs:  signalRequestedIDs,
+			Condition:           state.NextEventId,
+			DBRecordVersion:     source.DBRecordVersion,
+			Checksum:            state.Checksum,
+		},
+	})
+	return err == nil, err
+}
+
+// copyHistory copies every branch of the execution's history to the target shard and points the version histories
+// in executionInfo to the copies. Each branch is copied with the events of its ancestors, so the copies don't
+// depend on other branches. Transaction IDs are assigned so that the last batch of the current branch keeps the
+// transaction ID the mutable state refers to.
+func (a *activities) copyHistory(
+	ctx context.Context,
+	sourceShardID int32,
+	targetShardID int32,
+	key executionKey,
+	executionInfo *persistencespb.WorkflowExecutionInfo,
+) error {
+	branchUtil := a.executionManager.GetHistoryBranchUtil()
+	for _, versionHistory := range executionInfo.GetVersionHistories().GetHistories() {
+		items := versionHistory.GetItems()
+		if len(items) == 0 {
+			continue
+		}
+		branch, err := branchUtil.ParseHistoryBranchInfo(versionHistory.BranchToken)
+		if err != nil {
+			return err
+		}
+		targetToken, err := branchUtil.UpdateHistoryBranchInfo(
+			versionHistory.BranchToken,
+			&persistencespb.HistoryBranch{TreeId: branch.TreeId, BranchId: branch.BranchId},
+			key.runID,
+		)
+		if err != nil {
+			return err
+		}
+
+		var pending *persistence.AppendRawHistoryNodesRequest
+		var prevTransactionID int64
+		appendPending := func(transactionID int64) error {
+			pending.PrevTransactionID = prevTransactionID
+			pending.TransactionID = transactionID
+			prevTransactionID = transactionID
+			_, err := a.executionManager.AppendRawHistoryNodes(ctx, pending)
+			return err
+		}
+		var pageToken []byte
+		for {
+			resp, err := a.executionManager.ReadRawHistoryBranch(ctx, &persistence.ReadHistoryBranchRequest{
+				ShardID:       sourceShardID,
+				BranchToken:   versionHistory.BranchToken,
+				MinEventID:    common.FirstEventID,
+				MaxEventID:    items[len(items)-1].GetEventId() + 1,
+				PageSize:      readHistoryPageSize,
+				NextPageToken: pageToken,
+			})
+			if err != nil {
+				return err
+			}
+			for i, blob := range resp.HistoryEventBlobs {
+				if pending != nil {
+					if err := appendPending(prevTransactionID + 1); err != nil {
+						return err
+					}
+				}
+				pending = &persistence.AppendRawHistoryNodesRequest{
+					ShardID:     targetShardID,
+					IsNewBranch: prevTransactionID == 0,
+					Info:        persistence.BuildHistoryGarbageCleanupInfo(key.namespaceID, key.workflowID, key.runID),
+					BranchToken: targetToken,
+					History:     blob,
+					NodeID:      resp.NodeIDs[i],
+				}
+			}
+			pageToken = resp.NextPageToken
+			if len(pageToken) == 0 {
+				break
+			}
+		}
+		if pending != nil {
+			if err := appendPending(max(executionInfo.LastFirstEventTxnId, prevTransactionID+1)); err != nil {
+				return err
+			}
+		}
+		versionHistory.BranchToken = targetToken
+	}
+	return nil
+}
+
+// deleteExecution deletes an execution, its current record if it points to it, and its history if the history is
+// stored per shard.
+func (a *activities) deleteExecution(ctx context.Context, shardID int32, key executionKey) error {
+	resp, err := a.getExecution(ctx, shardID, key)
+	if common.IsNotFoundError(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	current, err := a.executionManager.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: key.namespaceID,
+		WorkflowID:  key.workflowID,
+	})
+	if err == nil && current.RunID == key.runID {
+		err = a.executionManager.DeleteCurrentWorkflowExecution(ctx, &persistence.DeleteCurrentWorkflowExecutionRequest{
+			ShardID:     shardID,
+			NamespaceID: key.namespaceID,
+			WorkflowID:  key.workflowID,
+			RunID:       key.runID,
+		})
+	}
+	if err != nil && !common.IsNotFoundError(err) {
+		return err
+	}
+
+	if err := a.executionManager.DeleteWorkflowExecution(ctx, &persistence.DeleteWorkflowExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: key.namespaceID,
+		WorkflowID:  key.workflowID,
+		RunID:       key.runID,
+	}); err != nil {
+		return err
+	}
+
+	if !a.historyPerShard {
+		// the history is shared with the copy on the other shard
+		return nil
+	}
+	for _, versionHistory := range resp.State.GetExecutionInfo().GetVersionHistories().GetHistories() {
+		if err := a.executionManager.DeleteHistoryBranch(ctx, &persistence.DeleteHistoryBranchRequest{
+			ShardID:     shardID,
+			BranchToken: versionHistory.BranchToken,
+		}); err != nil && !common.IsNotFoundError(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *activities) getExecution(
+	ctx context.Context,
+	shardID int32,
+	key executionKey,
+) (*persistence.GetWorkflowExecutionResponse, error) {
+	return a.executionManager.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: key.namespaceID,
+		WorkflowID:  key.workflowID,
+		RunID:       key.runID,
+	})
+}
+
+// minPendingTaskKey returns the key of the first task of a category the shard hasn't acknowledged yet. The queue
+// states are persisted periodically, so some acknowledged tasks may be returned again; task executors verify tasks
+// against the mutable state.
+func minPendingTaskKey(shardInfo *persistencespb.ShardInfo, category tasks.Category) tasks.Key {
+	queueState, ok := shardInfo.GetQueueStates()[int32(category.ID())]
+	if !ok || queueState.GetExclusiveReaderHighWatermark() == nil {
+		return tasks.MinimumKey
+	}
+	minKey := fromPersistenceTaskKey(queueState.GetExclusiveReaderHighWatermark())
+	for _, readerState := range queueState.GetReaderStates() {
+		for _, scope := range readerState.GetScopes() {
+			minKey = tasks.MinKey(minKey, fromPersistenceTaskKey(scope.GetRange().GetInclusiveMin()))
+		}
+	}
+	return minKey
+}
+
+func fromPersistenceTaskKey(key *persistencespb.TaskKey) tasks.Key {
+	return tasks.NewKey(timestamp.TimeValue(key.GetFireTime()), key.GetTaskId())
+}
+
+// isSystemExecution returns whether an execution belongs to the system namespace. System executions, including the
+// reshard workflow itself, keep running during the cutover and are only moved by the refresh.
+func isSystemExecution(namespaceID string) bool {
+	return namespaceID == primitives.SystemNamespaceID
+}
+
+func (a *activities) logFailure(msg string, shardID int32, key executionKey, err error) {
+	a.logger.Warn(msg,
+		tag.ShardID(shardID),
+		tag.WorkflowNamespaceID(key.namespaceID),
+		tag.WorkflowID(key.workflowID),
+		tag.WorkflowRunID(key.runID),
+		tag.Error(err),
+	)
+}
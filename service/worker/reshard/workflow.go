@@ -0,0 +1,268 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package reshard contains the system workflow which doubles the number of history shards of a cluster. Doubling
+// splits every shard in two: an execution on shard s either stays there or moves to shard s+N, where N is the old
+// shard count. The shards s+N don't exist before the cutover, so executions can be copied to them while the cluster
+// keeps serving traffic. The workflow has three phases, each started as a separate run:
+//
+//   - Copy copies the moving executions and their history to the new shards. It can be repeated, and only copies
+//     executions which changed since the last pass. The cluster keeps serving traffic.
+//   - Cutover must run after traffic to all user namespaces was stopped. It repeats the copy until nothing changes
+//     anymore, copies the pending tasks of the moved executions, deletes the moved executions from their old shards
+//     and persists the doubled shard count in the cluster metadata. All services must then be restarted; they pick
+//     up the shard count from the cluster metadata.
+//   - Refresh runs after the restart. It moves the executions of the system namespace, regenerating their tasks,
+//     and deletes executions left on a shard they no longer belong to.
+//
+// Executions of the system namespace, which include this workflow, aren't copied nor deleted by the copy and the
+// cutover, so that system workflows keep running while user traffic is stopped. They are unavailable from the
+// restart until the refresh moved them.
+package reshard
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/primitives"
+)
+
+const (
+	// WorkflowName is the name of the reshard system workflow.
+	WorkflowName = "temporal-sys-reshard-workflow"
+	// QueryTypeProgress is the query to get the progress of the reshard workflow.
+	QueryTypeProgress = "reshard-progress-query"
+
+	PhaseCopy    Phase = "Copy"
+	PhaseCutover Phase = "Cutover"
+	PhaseRefresh Phase = "Refresh"
+
+	// DefaultConcurrency is the number of shards processed at the same time.
+	DefaultConcurrency = 4
+	// shardsPerRun is the number of shards processed before the workflow continues as new to keep history bounded.
+	shardsPerRun = 1000
+	// maxCutoverPasses is the number of copy passes during the cutover before giving up waiting for executions to
+	// stop changing.
+	maxCutoverPasses = 5
+
+	shardActivityTimeout = time.Hour
+)
+
+type (
+	// Phase selects what a run of the reshard workflow does, see the package documentation.
+	Phase string
+
+	// WorkflowParams is the single argument to the reshard workflow.
+	WorkflowParams struct {
+		Phase Phase
+		// SourceShardCount is the shard count before resharding. TargetShardCount must be twice as large.
+		SourceShardCount int32
+		TargetShardCount int32
+		Concurrency      int
+		// Progress is carried over when the workflow continues as new.
+		Progress Progress
+	}
+
+	// Progress is the response to the progress query and the result of the workflow.
+	Progress struct {
+		// Pass is the number of the current pass over all shards, starting with 1.
+		Pass int
+		// NextShardID is the first shard not processed yet in the current pass.
+		NextShardID int32
+		// Counts of the current pass.
+		Copied    int64
+		Unchanged int64
+		Deleted   int64
+		Refreshed int64
+		Failed    int64
+		// TasksCopied is the number of tasks copied by the cutover.
+		TasksCopied int64
+		// ShardCountUpdated is set once the cutover persisted the new shard count.
+		ShardCountUpdated bool
+	}
+
+	shardRequest struct {
+		ShardID          int32
+		SourceShardCount int32
+		TargetShardCount int32
+	}
+
+	shardResponse struct {
+		Copied      int64
+		Unchanged   int64
+		Deleted     int64
+		Refreshed   int64
+		Failed      int64
+		TasksCopied int64
+	}
+)
+
+var (
+	ErrInvalidShardCount = errors.New("target shard count must be twice the source shard count")
+	ErrUnknownPhase      = errors.New("unknown phase")
+	ErrNotConverged      = errors.New("executions kept changing during the cutover, stop traffic to the cluster first")
+	ErrCopyFailures      = errors.New("some executions couldn't be copied, see the worker logs")
+
+	shardActivityOptions = workflow.ActivityOptions{
+		TaskQueue:           primitives.ReshardActivityTQ,
+		StartToCloseTimeout: shardActivityTimeout,
+		HeartbeatTimeout:    time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2,
+			MaximumInterval:    time.Minute,
+		},
+	}
+)
+
+// ReshardWorkflow runs one phase of doubling the history shard count.
+func ReshardWorkflow(ctx workflow.Context, params WorkflowParams) (Progress, error) {
+	if params.SourceShardCount <= 0 || params.TargetShardCount != 2*params.SourceShardCount {
+		return Progress{}, temporal.NewNonRetryableApplicationError(ErrInvalidShardCount.Error(), "InvalidArgument", nil)
+	}
+	if params.Concurrency <= 0 {
+		params.Concurrency = DefaultConcurrency
+	}
+	progress := params.Progress
+	if progress.Pass == 0 {
+		progress = Progress{Pass: 1, NextShardID: 1}
+	}
+	if err := workflow.SetQueryHandler(ctx, QueryTypeProgress, func() (Progress, error) {
+		return progress, nil
+	}); err != nil {
+		return progress, err
+	}
+
+	var a *activities
+	actx := workflow.WithActivityOptions(ctx, shardActivityOptions)
+	switch params.Phase {
+	case PhaseCopy:
+		done, err := runPass(ctx, params, &progress, params.SourceShardCount, a.CopyShard)
+		if err != nil || !done {
+			return progress, err
+		}
+		if progress.Failed > 0 {
+			return progress, temporal.NewNonRetryableApplicationError(ErrCopyFailures.Error(), "CopyFailed", nil)
+		}
+		return progress, nil
+
+	case PhaseCutover:
+		if progress.Pass <= maxCutoverPasses {
+			for {
+				done, err := runPass(ctx, params, &progress, params.SourceShardCount, a.CopyShard)
+				if err != nil || !done {
+					return progress, err
+				}
+				if progress.Copied == 0 && progress.Failed == 0 {
+					break
+				}
+				if progress.Pass == maxCutoverPasses {
+					return progress, temporal.NewNonRetryableApplicationError(ErrNotConverged.Error(), "NotConverged", nil)
+				}
+				workflow.GetLogger(ctx).Info("Executions changed during cutover copy pass, repeating.",
+					tag.Counter(int(progress.Copied)))
+				progress = Progress{Pass: progress.Pass + 1, NextShardID: 1}
+			}
+			// the task copy pass is numbered after the copy passes
+			progress = Progress{Pass: maxCutoverPasses + 1, NextShardID: 1}
+		}
+		if progress.Pass == maxCutoverPasses+1 {
+			done, err := runPass(ctx, params, &progress, params.SourceShardCount, a.CopyShardTasks)
+			if err != nil || !done {
+				return progress, err
+			}
+			// the deletion pass is numbered after the task copy pass
+			progress = Progress{Pass: maxCutoverPasses + 2, NextShardID: 1, TasksCopied: progress.TasksCopied}
+		}
+		if !progress.ShardCountUpdated {
+			done, err := runPass(ctx, params, &progress, params.SourceShardCount, a.DeleteMovedExecutions)
+			if err != nil || !done {
+				return progress, err
+			}
+			if err := workflow.ExecuteActivity(actx, a.UpdateShardCount, shardRequest{
+				SourceShardCount: params.SourceShardCount,
+				TargetShardCount: params.TargetShardCount,
+			}).Get(ctx, nil); err != nil {
+				return progress, err
+			}
+			progress.ShardCountUpdated = true
+		}
+		workflow.GetLogger(ctx).Info("Resharding cutover finished, restart all services.",
+			tag.NewInt32("target-shard-count", params.TargetShardCount))
+		return progress, nil
+
+	case PhaseRefresh:
+		_, err := runPass(ctx, params, &progress, params.TargetShardCount, a.RefreshShard)
+		return progress, err
+
+	default:
+		return progress, temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("%v: %q", ErrUnknownPhase, params.Phase), "InvalidArgument", nil)
+	}
+}
+
+// runPass runs the activity for the shards from progress.NextShardID up to shardCount, a batch of params.Concurrency
+// shards at a time. It returns false if the workflow continued as new before the pass was done.
+func runPass(
+	ctx workflow.Context,
+	params WorkflowParams,
+	progress *Progress,
+	shardCount int32,
+	shardActivity any,
+) (bool, error) {
+	actx := workflow.WithActivityOptions(ctx, shardActivityOptions)
+	var processed int32
+	for progress.NextShardID <= shardCount {
+		if processed >= shardsPerRun {
+			params.Progress = *progress
+			return false, workflow.NewContinueAsNewError(ctx, ReshardWorkflow, params)
+		}
+
+		var futures []workflow.Future
+		for i := 0; i < params.Concurrency && progress.NextShardID <= shardCount; i++ {
+			futures = append(futures, workflow.ExecuteActivity(actx, shardActivity, shardRequest{
+				ShardID:          progress.NextShardID,
+				SourceShardCount: params.SourceShardCount,
+				TargetShardCount: params.TargetShardCount,
+			}))
+			progress.NextShardID++
+			processed++
+		}
+		for _, future := range futures {
+			var resp shardResponse
+			if err := future.Get(ctx, &resp); err != nil {
+				return false, err
+			}
+			progress.Copied += resp.Copied
+			progress.Unchanged += resp.Unchanged
+			progress.Deleted += resp.Deleted
+			progress.Refreshed += resp.Refreshed
+			progress.Failed += resp.Failed
+			progress.TasksCopied += resp.TasksCopied
+		}
+	}
+	return true, nil
+}
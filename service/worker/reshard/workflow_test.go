@@ -0,0 +1,197 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reshard
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReshardWorkflow_Copy(t *testing.T) {
+	var s testsuite.WorkflowTestSuite
+	env := s.NewTestWorkflowEnvironment()
+	var a *activities
+	env.RegisterActivity(a)
+
+	var shardIDs []int32
+	env.OnActivity(a.CopyShard, mock.Anything, mock.Anything).Return(func(_ context.Context, req shardRequest) (shardResponse, error) {
+		require.Equal(t, int32(4), req.SourceShardCount)
+		require.Equal(t, int32(8), req.TargetShardCount)
+		shardIDs = append(shardIDs, req.ShardID)
+		return shardResponse{Copied: 2, Unchanged: 1}, nil
+	})
+
+	env.ExecuteWorkflow(ReshardWorkflow, WorkflowParams{
+		Phase:            PhaseCopy,
+		SourceShardCount: 4,
+		TargetShardCount: 8,
+		Concurrency:      3,
+	})
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	require.ElementsMatch(t, []int32{1, 2, 3, 4}, shardIDs)
+
+	var progress Progress
+	require.NoError(t, env.GetWorkflowResult(&progress))
+	require.Equal(t, Progress{Pass: 1, NextShardID: 5, Copied: 8, Unchanged: 4}, progress)
+}
+
+func TestReshardWorkflow_Cutover(t *testing.T) {
+	var s testsuite.WorkflowTestSuite
+	env := s.NewTestWorkflowEnvironment()
+	var a *activities
+	env.RegisterActivity(a)
+
+	copyCalls := 0
+	env.OnActivity(a.CopyShard, mock.Anything, mock.Anything).Return(func(_ context.Context, req shardRequest) (shardResponse, error) {
+		copyCalls++
+		// only the first pass finds changed executions
+		if copyCalls <= 2 {
+			return shardResponse{Copied: 1}, nil
+		}
+		return shardResponse{Unchanged: 1}, nil
+	})
+	env.OnActivity(a.CopyShardTasks, mock.Anything, mock.Anything).Return(shardResponse{TasksCopied: 5}, nil).Times(2)
+	env.OnActivity(a.DeleteMovedExecutions, mock.Anything, mock.Anything).Return(shardResponse{Deleted: 3}, nil).Times(2)
+	env.OnActivity(a.UpdateShardCount, mock.Anything, shardRequest{SourceShardCount: 2, TargetShardCount: 4}).Return(nil).Once()
+
+	env.ExecuteWorkflow(ReshardWorkflow, WorkflowParams{
+		Phase:            PhaseCutover,
+		SourceShardCount: 2,
+		TargetShardCount: 4,
+	})
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	require.Equal(t, 4, copyCalls)
+
+	var progress Progress
+	require.NoError(t, env.GetWorkflowResult(&progress))
+	require.Equal(t, Progress{Pass: maxCutoverPasses + 2, NextShardID: 3, Deleted: 6, TasksCopied: 10, ShardCountUpdated: true}, progress)
+}
+
+func TestReshardWorkflow_CutoverNotConverged(t *testing.T) {
+	var s testsuite.WorkflowTestSuite
+	env := s.NewTestWorkflowEnvironment()
+	var a *activities
+	env.RegisterActivity(a)
+
+	env.OnActivity(a.CopyShard, mock.Anything, mock.Anything).Return(shardResponse{Copied: 1}, nil)
+
+	env.ExecuteWorkflow(ReshardWorkflow, WorkflowParams{
+		Phase:            PhaseCutover,
+		SourceShardCount: 1,
+		TargetShardCount: 2,
+	})
+	require.True(t, env.IsWorkflowCompleted())
+	require.ErrorContains(t, env.GetWorkflowError(), ErrNotConverged.Error())
+}
+
+func TestReshardWorkflow_InvalidShardCount(t *testing.T) {
+	var s testsuite.WorkflowTestSuite
+	env := s.NewTestWorkflowEnvironment()
+
+	env.ExecuteWorkflow(ReshardWorkflow, WorkflowParams{
+		Phase:            PhaseCopy,
+		SourceShardCount: 4,
+		TargetShardCount: 12,
+	})
+	require.True(t, env.IsWorkflowCompleted())
+	require.ErrorContains(t, env.GetWorkflowError(), ErrInvalidShardCount.Error())
+}
+
+func TestUpdateShardCount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	clusterMetadata := cluster.NewMockMetadata(ctrl)
+	clusterMetadataManager := persistence.NewMockClusterMetadataManager(ctrl)
+	a := &activities{
+		clusterMetadataManager: clusterMetadataManager,
+		clusterMetadata:        clusterMetadata,
+		logger:                 log.NewNoopLogger(),
+	}
+
+	clusterMetadata.EXPECT().GetCurrentClusterName().Return("active").AnyTimes()
+	clusterMetadata.EXPECT().GetAllClusterInfo().Return(map[string]cluster.ClusterInformation{
+		"active":  {Enabled: true, ShardCount: 4},
+		"standby": {Enabled: true, ShardCount: 16},
+	}).AnyTimes()
+	clusterMetadataManager.EXPECT().GetClusterMetadata(gomock.Any(), &persistence.GetClusterMetadataRequest{
+		ClusterName: "active",
+	}).Return(&persistence.GetClusterMetadataResponse{
+		ClusterMetadata: &persistencespb.ClusterMetadata{ClusterName: "active", HistoryShardCount: 4},
+		Version:         3,
+	}, nil)
+	clusterMetadataManager.EXPECT().SaveClusterMetadata(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *persistence.SaveClusterMetadataRequest) (bool, error) {
+			require.True(t, req.AllowHistoryShardCountDoubling)
+			require.Equal(t, int64(3), req.Version)
+			require.Equal(t, int32(8), req.HistoryShardCount)
+			return true, nil
+		},
+	)
+
+	require.NoError(t, a.UpdateShardCount(context.Background(), shardRequest{SourceShardCount: 4, TargetShardCount: 8}))
+	require.ErrorContains(t, a.UpdateShardCount(context.Background(), shardRequest{SourceShardCount: 8, TargetShardCount: 12}),
+		`connected cluster "standby" isn't compatible`)
+}
+
+func TestCopyShard_SkipsSystemExecutions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	executionManager := persistence.NewMockExecutionManager(ctrl)
+	a := &activities{
+		executionManager: executionManager,
+		logger:           log.NewNoopLogger(),
+	}
+
+	// find a workflow ID of the system namespace which moves to the other half of the split
+	workflowID := "system-workflow"
+	for i := 0; common.WorkflowIDToHistoryShard(primitives.SystemNamespaceID, workflowID, 2) == 1; i++ {
+		workflowID = fmt.Sprintf("system-workflow-%d", i)
+	}
+	executionManager.EXPECT().ListConcreteExecutions(gomock.Any(), gomock.Any()).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{{
+			ExecutionInfo:  &persistencespb.WorkflowExecutionInfo{NamespaceId: primitives.SystemNamespaceID, WorkflowId: workflowID},
+			ExecutionState: &persistencespb.WorkflowExecutionState{RunId: "run-id"},
+		}},
+	}, nil)
+
+	var s testsuite.WorkflowTestSuite
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivity(a)
+	val, err := env.ExecuteActivity(a.CopyShard, shardRequest{ShardID: 1, SourceShardCount: 1, TargetShardCount: 2})
+	require.NoError(t, err)
+	var resp shardResponse
+	require.NoError(t, val.Get(&resp))
+	require.Equal(t, shardResponse{}, resp)
+}
@@ -92,6 +92,8 @@ func (v *historyEventIDValidator) Validate(
 			NamespaceID: mutableState.GetExecutionInfo().NamespaceId,
 			WorkflowID:  mutableState.GetExecutionInfo().WorkflowId,
 			RunID:       mutableState.GetExecutionState().RunId,
+			// only the presence of the execution matters
+			OmitCollections: persistence.MutableStateAllCollections,
 		})
 		switch err.(type) {
 		case nil:
@@ -40,6 +40,8 @@ type (
 		failureType string
 		// failure details used for logging
 		failureDetails string
+		// initiated event ID of the child execution, set for stale child info failures
+		childInitiatedEventID int64
 	}
 
 	Validator interface {
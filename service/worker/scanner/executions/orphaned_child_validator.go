@@ -0,0 +1,204 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package executions
+
+import (
+	"context"
+	"fmt"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common"
+)
+
+const (
+	orphanedChildFailureType  = "orphaned_child_validator"
+	staleChildInfoFailureType = "stale_child_info_validator"
+)
+
+type (
+	// orphanedChildValidator is a validator that checks a running parent of a running child execution still tracks it, and
+	// the children tracked by a running parent execution still exist
+	orphanedChildValidator struct {
+		historyClient historyservice.HistoryServiceClient
+	}
+)
+
+var _ Validator = (*orphanedChildValidator)(nil)
+
+// NewOrphanedChildValidator returns new instance.
+func NewOrphanedChildValidator(
+	historyClient historyservice.HistoryServiceClient,
+) *orphanedChildValidator {
+	return &orphanedChildValidator{
+		historyClient: historyClient,
+	}
+}
+
+func (v *orphanedChildValidator) Validate(
+	ctx context.Context,
+	mutableState *MutableState,
+) ([]MutableStateValidationResult, error) {
+	if mutableState.GetExecutionState().GetStatus() != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+		return nil, nil
+	}
+
+	var results []MutableStateValidationResult
+	if result, err := v.validateParent(ctx, mutableState); err != nil {
+		return nil, err
+	} else if result != nil {
+		results = append(results, *result)
+	}
+
+	childResults, err := v.validateChildren(ctx, mutableState)
+	if err != nil {
+		return nil, err
+	}
+	return append(results, childResults...), nil
+}
+
+func (v *orphanedChildValidator) validateParent(
+	ctx context.Context,
+	mutableState *MutableState,
+) (*MutableStateValidationResult, error) {
+	executionInfo := mutableState.GetExecutionInfo()
+	if executionInfo.GetParentWorkflowId() == "" {
+		return nil, nil
+	}
+
+	resp, err := v.historyClient.DescribeMutableState(ctx, &historyservice.DescribeMutableStateRequest{
+		NamespaceId: executionInfo.GetParentNamespaceId(),
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: executionInfo.GetParentWorkflowId(),
+			RunId:      executionInfo.GetParentRunId(),
+		},
+	})
+	switch err.(type) {
+	case nil:
+	case *serviceerror.NotFound:
+		// the parent is closed and deleted by retention, it has applied its parent close policy to its children, which
+		// may have been abandoned
+		return nil, nil
+	case *serviceerror.NamespaceNotFound:
+		// the parent namespace is deleted, the namespace deletion takes care of its executions
+		return nil, nil
+	default:
+		return nil, err
+	}
+
+	parent := resp.GetDatabaseMutableState()
+	if parent.GetExecutionState().GetStatus() != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+		// a closed parent has applied its parent close policy to its children
+		return nil, nil
+	}
+	// The run ID is not compared, as the parent tracks the first run of a child which continued as new.
+	childInfo, ok := parent.GetChildExecutionInfos()[executionInfo.GetParentInitiatedId()]
+	if ok && (childInfo.GetStartedWorkflowId() == executionInfo.GetWorkflowId() ||
+		childInfo.GetStartedEventId() == common.EmptyEventID) {
+		return nil, nil
+	}
+	return &MutableStateValidationResult{
+		failureType: orphanedChildFailureType,
+		failureDetails: fmt.Sprintf(
+			"running parent execution %v/%v does not track child initiated by event %v",
+			executionInfo.GetParentWorkflowId(),
+			executionInfo.GetParentRunId(),
+			executionInfo.GetParentInitiatedId(),
+		),
+	}, nil
+}
+
+func (v *orphanedChildValidator) validateChildren(
+	ctx context.Context,
+	mutableState *MutableState,
+) ([]MutableStateValidationResult, error) {
+	var results []MutableStateValidationResult
+	for initiatedEventID, childInfo := range mutableState.GetChildExecutionInfos() {
+		if childInfo.GetStartedEventId() == common.EmptyEventID {
+			// the child is not started yet, the transfer task which starts it tracks it
+			continue
+		}
+		namespaceID := childInfo.GetNamespaceId()
+		if namespaceID == "" {
+			namespaceID = mutableState.GetExecutionInfo().GetNamespaceId()
+		}
+
+		exists, err := v.childExists(ctx, namespaceID, mutableState, initiatedEventID, childInfo.GetStartedWorkflowId(), childInfo.GetStartedRunId())
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			results = append(results, MutableStateValidationResult{
+				failureType: staleChildInfoFailureType,
+				failureDetails: fmt.Sprintf(
+					"child execution %v/%v initiated by event %v not found",
+					childInfo.GetStartedWorkflowId(),
+					childInfo.GetStartedRunId(),
+					initiatedEventID,
+				),
+				childInitiatedEventID: initiatedEventID,
+			})
+		}
+	}
+	return results, nil
+}
+
+// childExists checks the run started by the parent exists, or the current run of the child workflow ID descends from
+// it, in case the child continued as new and the started run has been deleted by retention.
+func (v *orphanedChildValidator) childExists(
+	ctx context.Context,
+	namespaceID string,
+	parent *MutableState,
+	initiatedEventID int64,
+	workflowID string,
+	runID string,
+) (bool, error) {
+	for _, id := range []string{runID, ""} {
+		resp, err := v.historyClient.DescribeMutableState(ctx, &historyservice.DescribeMutableStateRequest{
+			NamespaceId: namespaceID,
+			Execution: &commonpb.WorkflowExecution{
+				WorkflowId: workflowID,
+				RunId:      id,
+			},
+		})
+		switch err.(type) {
+		case nil:
+			if id == runID {
+				return true, nil
+			}
+			executionInfo := resp.GetDatabaseMutableState().GetExecutionInfo()
+			return executionInfo.GetParentWorkflowId() == parent.GetExecutionInfo().GetWorkflowId() &&
+				executionInfo.GetParentRunId() == parent.GetExecutionState().GetRunId() &&
+				executionInfo.GetParentInitiatedId() == initiatedEventID, nil
+		case *serviceerror.NotFound, *serviceerror.NamespaceNotFound:
+			continue
+		default:
+			return false, err
+		}
+	}
+	return false, nil
+}
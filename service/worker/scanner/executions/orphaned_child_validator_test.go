@@ -0,0 +1,162 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package executions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.uber.org/mock/gomock"
+)
+
+func TestOrphanedChildValidator(t *testing.T) {
+	newMutableState := func(status enumspb.WorkflowExecutionStatus, runID string, executionInfo *persistencespb.WorkflowExecutionInfo) *persistencespb.WorkflowMutableState {
+		return &persistencespb.WorkflowMutableState{
+			ExecutionInfo:  executionInfo,
+			ExecutionState: &persistencespb.WorkflowExecutionState{Status: status, RunId: runID},
+		}
+	}
+	child := func() *MutableState {
+		return &MutableState{WorkflowMutableState: newMutableState(
+			enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+			"child-run",
+			&persistencespb.WorkflowExecutionInfo{
+				NamespaceId:       "ns",
+				WorkflowId:        "child",
+				ParentNamespaceId: "ns",
+				ParentWorkflowId:  "parent",
+				ParentRunId:       "parent-run",
+				ParentInitiatedId: 5,
+			},
+		)}
+	}
+	parent := func(status enumspb.WorkflowExecutionStatus, children map[int64]*persistencespb.ChildExecutionInfo) *persistencespb.WorkflowMutableState {
+		ms := newMutableState(status, "parent-run", &persistencespb.WorkflowExecutionInfo{
+			NamespaceId: "ns",
+			WorkflowId:  "parent",
+		})
+		ms.ChildExecutionInfos = children
+		return ms
+	}
+
+	testCases := []struct {
+		name          string
+		mutableState  *MutableState
+		setup         func(*historyservicemock.MockHistoryServiceClient)
+		expectedTypes []string
+	}{
+		{
+			name:         "parent tracks child",
+			mutableState: child(),
+			setup: func(client *historyservicemock.MockHistoryServiceClient) {
+				client.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).Return(&historyservice.DescribeMutableStateResponse{
+					DatabaseMutableState: parent(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, map[int64]*persistencespb.ChildExecutionInfo{
+						5: {StartedWorkflowId: "child", StartedRunId: "first-child-run", StartedEventId: 6},
+					}),
+				}, nil)
+			},
+		},
+		{
+			name:         "parent closed",
+			mutableState: child(),
+			setup: func(client *historyservicemock.MockHistoryServiceClient) {
+				client.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).Return(&historyservice.DescribeMutableStateResponse{
+					DatabaseMutableState: parent(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, nil),
+				}, nil)
+			},
+		},
+		{
+			name:         "parent not found",
+			mutableState: child(),
+			setup: func(client *historyservicemock.MockHistoryServiceClient) {
+				client.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("not found"))
+			},
+		},
+		{
+			name:         "running parent does not track child",
+			mutableState: child(),
+			setup: func(client *historyservicemock.MockHistoryServiceClient) {
+				client.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).Return(&historyservice.DescribeMutableStateResponse{
+					DatabaseMutableState: parent(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, nil),
+				}, nil)
+			},
+			expectedTypes: []string{orphanedChildFailureType},
+		},
+		{
+			name: "child not found",
+			mutableState: &MutableState{WorkflowMutableState: parent(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, map[int64]*persistencespb.ChildExecutionInfo{
+				5: {StartedWorkflowId: "child", StartedRunId: "child-run", StartedEventId: 6},
+				7: {StartedWorkflowId: "pending", StartedEventId: common.EmptyEventID},
+			})},
+			setup: func(client *historyservicemock.MockHistoryServiceClient) {
+				client.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("not found")).Times(2)
+			},
+			expectedTypes: []string{staleChildInfoFailureType},
+		},
+		{
+			name: "child continued as new",
+			mutableState: &MutableState{WorkflowMutableState: parent(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, map[int64]*persistencespb.ChildExecutionInfo{
+				5: {StartedWorkflowId: "child", StartedRunId: "first-child-run", StartedEventId: 6},
+			})},
+			setup: func(client *historyservicemock.MockHistoryServiceClient) {
+				client.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("not found"))
+				client.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).Return(&historyservice.DescribeMutableStateResponse{
+					DatabaseMutableState: child().WorkflowMutableState,
+				}, nil)
+			},
+		},
+		{
+			name: "closed execution is skipped",
+			mutableState: &MutableState{WorkflowMutableState: newMutableState(
+				enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED,
+				"child-run",
+				child().GetExecutionInfo(),
+			)},
+			setup: func(client *historyservicemock.MockHistoryServiceClient) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := historyservicemock.NewMockHistoryServiceClient(gomock.NewController(t))
+			tc.setup(client)
+
+			results, err := NewOrphanedChildValidator(client).Validate(context.Background(), tc.mutableState)
+			require.NoError(t, err)
+			var types []string
+			for _, result := range results {
+				types = append(types, result.failureType)
+			}
+			require.Equal(t, tc.expectedTypes, types)
+		})
+	}
+}
@@ -39,6 +39,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/service/worker/scanner/executor"
 )
@@ -56,6 +57,7 @@ type (
 		activityContext  context.Context
 
 		executionManager              persistence.ExecutionManager
+		visibilityManager             manager.VisibilityManager
 		registry                      namespace.Registry
 		historyClient                 historyservice.HistoryServiceClient
 		adminClient                   adminservice.AdminServiceClient
@@ -64,6 +66,16 @@ type (
 		perShardQPS                   dynamicconfig.IntPropertyFn
 		executionDataDurationBuffer   dynamicconfig.DurationPropertyFn
 		enableHistoryEventIDValidator dynamicconfig.BoolPropertyFn
+		enableOrphanedChildValidator  dynamicconfig.BoolPropertyFn
+		repairOrphanedChildren        dynamicconfig.BoolPropertyFn
+		enableStuckWorkflowValidator  dynamicconfig.BoolPropertyFn
+		resetStuckWorkflows           dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		stuckWorkflowMaxResets        dynamicconfig.IntPropertyFnWithNamespaceFilter
+		stuckWorkflowFailureThreshold dynamicconfig.IntPropertyFnWithNamespaceFilter
+		stuckWorkflowMinDuration      dynamicconfig.DurationPropertyFnWithNamespaceFilter
+		enableZombieValidator         dynamicconfig.BoolPropertyFn
+		repairZombies                 dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		zombieGracePeriod             dynamicconfig.DurationPropertyFn
 		metricsHandler                metrics.Handler
 		logger                        log.Logger
 
@@ -90,7 +102,18 @@ func NewScavenger(
 	executionDataDurationBuffer dynamicconfig.DurationPropertyFn,
 	executionTaskWorker dynamicconfig.IntPropertyFn,
 	enableHistoryEventIDValidator dynamicconfig.BoolPropertyFn,
+	enableOrphanedChildValidator dynamicconfig.BoolPropertyFn,
+	repairOrphanedChildren dynamicconfig.BoolPropertyFn,
+	enableStuckWorkflowValidator dynamicconfig.BoolPropertyFn,
+	resetStuckWorkflows dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+	stuckWorkflowMaxResets dynamicconfig.IntPropertyFnWithNamespaceFilter,
+	stuckWorkflowFailureThreshold dynamicconfig.IntPropertyFnWithNamespaceFilter,
+	stuckWorkflowMinDuration dynamicconfig.DurationPropertyFnWithNamespaceFilter,
+	enableZombieValidator dynamicconfig.BoolPropertyFn,
+	repairZombies dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+	zombieGracePeriod dynamicconfig.DurationPropertyFn,
 	executionManager persistence.ExecutionManager,
+	visibilityManager manager.VisibilityManager,
 	registry namespace.Registry,
 	historyClient historyservice.HistoryServiceClient,
 	adminClient adminservice.AdminServiceClient,
@@ -98,12 +121,13 @@ func NewScavenger(
 	logger log.Logger,
 ) *Scavenger {
 	return &Scavenger{
-		activityContext:  activityContext,
-		numHistoryShards: numHistoryShards,
-		executionManager: executionManager,
-		registry:         registry,
-		historyClient:    historyClient,
-		adminClient:      adminClient,
+		activityContext:   activityContext,
+		numHistoryShards:  numHistoryShards,
+		executionManager:  executionManager,
+		visibilityManager: visibilityManager,
+		registry:          registry,
+		historyClient:     historyClient,
+		adminClient:       adminClient,
 		executor: executor.NewFixedSizePoolExecutor(
 			executionTaskWorker(),
 			executorMaxDeferredTasks,
@@ -116,6 +140,16 @@ func NewScavenger(
 		perShardQPS:                   perShardQPS,
 		executionDataDurationBuffer:   executionDataDurationBuffer,
 		enableHistoryEventIDValidator: enableHistoryEventIDValidator,
+		enableOrphanedChildValidator:  enableOrphanedChildValidator,
+		repairOrphanedChildren:        repairOrphanedChildren,
+		enableStuckWorkflowValidator:  enableStuckWorkflowValidator,
+		resetStuckWorkflows:           resetStuckWorkflows,
+		stuckWorkflowMaxResets:        stuckWorkflowMaxResets,
+		stuckWorkflowFailureThreshold: stuckWorkflowFailureThreshold,
+		stuckWorkflowMinDuration:      stuckWorkflowMinDuration,
+		enableZombieValidator:         enableZombieValidator,
+		repairZombies:                 repairZombies,
+		zombieGracePeriod:             zombieGracePeriod,
 		metricsHandler:                metricsHandler.WithTags(metrics.OperationTag(metrics.ExecutionsScavengerScope)),
 		logger:                        logger,
 
@@ -174,6 +208,7 @@ func (s *Scavenger) run() {
 			s.activityContext,
 			shardID,
 			s.executionManager,
+			s.visibilityManager,
 			s.registry,
 			s.historyClient,
 			s.adminClient,
@@ -188,6 +223,16 @@ func (s *Scavenger) run() {
 			}),
 			s.executionDataDurationBuffer,
 			s.enableHistoryEventIDValidator,
+			s.enableOrphanedChildValidator,
+			s.repairOrphanedChildren,
+			s.enableStuckWorkflowValidator,
+			s.resetStuckWorkflows,
+			s.stuckWorkflowMaxResets,
+			s.stuckWorkflowFailureThreshold,
+			s.stuckWorkflowMinDuration,
+			s.enableZombieValidator,
+			s.repairZombies,
+			s.zombieGracePeriod,
 		))
 		if !submitted {
 			s.logger.Error("unable to submit task to executor", tag.ShardID(shardID))
@@ -0,0 +1,182 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package executions
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/versionhistory"
+)
+
+const (
+	stuckWorkflowFailureType = "stuck_workflow_validator"
+
+	// stuckWorkflowResetReasonPrefix marks the resets made by the scavenger, the attempt is appended to it.
+	stuckWorkflowResetReasonPrefix = "auto-reset: workflow tasks keep failing with the same cause"
+	stuckWorkflowHistoryPageSize   = 100
+)
+
+type (
+	// stuckWorkflowValidator is a validator that checks the workflow tasks of a running execution are not failing with
+	// the same cause over and over
+	stuckWorkflowValidator struct {
+		registry         namespace.Registry
+		timeSource       clock.TimeSource
+		failureThreshold dynamicconfig.IntPropertyFnWithNamespaceFilter
+		minDuration      dynamicconfig.DurationPropertyFnWithNamespaceFilter
+	}
+)
+
+var _ Validator = (*stuckWorkflowValidator)(nil)
+
+// NewStuckWorkflowValidator returns new instance.
+func NewStuckWorkflowValidator(
+	registry namespace.Registry,
+	timeSource clock.TimeSource,
+	failureThreshold dynamicconfig.IntPropertyFnWithNamespaceFilter,
+	minDuration dynamicconfig.DurationPropertyFnWithNamespaceFilter,
+) *stuckWorkflowValidator {
+	return &stuckWorkflowValidator{
+		registry:         registry,
+		timeSource:       timeSource,
+		failureThreshold: failureThreshold,
+		minDuration:      minDuration,
+	}
+}
+
+func (v *stuckWorkflowValidator) Validate(
+	_ context.Context,
+	mutableState *MutableState,
+) ([]MutableStateValidationResult, error) {
+	executionInfo := mutableState.GetExecutionInfo()
+	if mutableState.GetExecutionState().GetStatus() != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING ||
+		executionInfo.GetWorkflowTaskLastFailureCause() == "" {
+		return nil, nil
+	}
+
+	namespaceEntry, err := v.registry.GetNamespaceByID(namespace.ID(executionInfo.GetNamespaceId()))
+	switch err.(type) {
+	case nil:
+	case *serviceerror.NamespaceNotFound:
+		return nil, nil
+	default:
+		return nil, err
+	}
+
+	namespaceName := namespaceEntry.Name().String()
+	if !common.IsWorkflowTaskStuck(
+		executionInfo,
+		v.timeSource.Now(),
+		v.failureThreshold(namespaceName),
+		v.minDuration(namespaceName),
+	) {
+		return nil, nil
+	}
+	return []MutableStateValidationResult{{
+		failureType: stuckWorkflowFailureType,
+		failureDetails: fmt.Sprintf("workflow task failed %d times with cause %s since %v",
+			executionInfo.GetWorkflowTaskSameFailureCount(),
+			executionInfo.GetWorkflowTaskLastFailureCause(),
+			executionInfo.GetWorkflowTaskFirstSameFailureTime().AsTime(),
+		),
+	}}, nil
+}
+
+// stuckWorkflowResetReason returns the reset reason of the given auto-reset attempt.
+func stuckWorkflowResetReason(attempt int) string {
+	return fmt.Sprintf("%s (attempt %d)", stuckWorkflowResetReasonPrefix, attempt)
+}
+
+// parseStuckWorkflowResetAttempt returns the attempt recorded in the reason of an auto-reset, and false if the reason
+// is not one of an auto-reset.
+func parseStuckWorkflowResetAttempt(reason string) (int, bool) {
+	attempt, ok := strings.CutPrefix(reason, stuckWorkflowResetReasonPrefix+" (attempt ")
+	if !ok {
+		return 0, false
+	}
+	attempt, ok = strings.CutSuffix(attempt, ")")
+	if !ok {
+		return 0, false
+	}
+	n, err := strconv.Atoi(attempt)
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
+
+// getStuckWorkflowResetAttempts returns how many times the execution was already auto-reset without completing a
+// workflow task since. A reset fails the workflow task at the reset point with the reset reason, which is the first
+// event after the last completed workflow task of the new run, so only the events after that workflow task are read.
+func getStuckWorkflowResetAttempts(
+	ctx context.Context,
+	executionManager persistence.ExecutionManager,
+	shardID int32,
+	mutableState *MutableState,
+) (int, error) {
+	executionInfo := mutableState.GetExecutionInfo()
+	currentVersionHistory, err := versionhistory.GetCurrentVersionHistory(executionInfo.GetVersionHistories())
+	if err != nil {
+		return 0, err
+	}
+
+	request := &persistence.ReadHistoryBranchRequest{
+		ShardID:     shardID,
+		BranchToken: currentVersionHistory.GetBranchToken(),
+		MinEventID:  max(executionInfo.GetLastCompletedWorkflowTaskStartedEventId()+1, common.FirstEventID),
+		MaxEventID:  mutableState.GetNextEventId(),
+		PageSize:    stuckWorkflowHistoryPageSize,
+	}
+	attempts := 0
+	for {
+		resp, err := executionManager.ReadHistoryBranch(ctx, request)
+		if err != nil {
+			return 0, err
+		}
+		for _, event := range resp.HistoryEvents {
+			attributes := event.GetWorkflowTaskFailedEventAttributes()
+			if attributes.GetCause() != enumspb.WORKFLOW_TASK_FAILED_CAUSE_RESET_WORKFLOW {
+				continue
+			}
+			if attempt, ok := parseStuckWorkflowResetAttempt(attributes.GetFailure().GetMessage()); ok {
+				attempts = max(attempts, attempt)
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			return attempts, nil
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+}
@@ -0,0 +1,206 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package executions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	failurepb "go.temporal.io/api/failure/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	historyspb "go.temporal.io/server/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestStuckWorkflowValidator(t *testing.T) {
+	now := time.Now()
+	newMutableState := func(status enumspb.WorkflowExecutionStatus, cause string, count int32, since time.Duration) *MutableState {
+		return &MutableState{WorkflowMutableState: &persistencespb.WorkflowMutableState{
+			ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+				NamespaceId:                      "ns-id",
+				WorkflowId:                       "wf",
+				WorkflowTaskLastFailureCause:     cause,
+				WorkflowTaskSameFailureCount:     count,
+				WorkflowTaskFirstSameFailureTime: timestamppb.New(now.Add(-since)),
+			},
+			ExecutionState: &persistencespb.WorkflowExecutionState{Status: status, RunId: "run"},
+		}}
+	}
+	namespaceEntry := namespace.NewLocalNamespaceForTest(&persistencespb.NamespaceInfo{Id: "ns-id", Name: "ns"}, nil, "active")
+
+	testCases := []struct {
+		name          string
+		mutableState  *MutableState
+		threshold     int
+		registryErr   error
+		expectedTypes []string
+	}{
+		{
+			name:          "stuck",
+			mutableState:  newMutableState(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, "WorkflowTaskTimedOut", 5, time.Hour),
+			threshold:     5,
+			expectedTypes: []string{stuckWorkflowFailureType},
+		},
+		{
+			name:         "below failure threshold",
+			mutableState: newMutableState(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, "WorkflowTaskTimedOut", 4, time.Hour),
+			threshold:    5,
+		},
+		{
+			name:         "failing for less than min duration",
+			mutableState: newMutableState(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, "WorkflowTaskTimedOut", 5, time.Minute),
+			threshold:    5,
+		},
+		{
+			name:         "detection disabled",
+			mutableState: newMutableState(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, "WorkflowTaskTimedOut", 5, time.Hour),
+			threshold:    0,
+		},
+		{
+			name:         "namespace not found",
+			mutableState: newMutableState(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, "WorkflowTaskTimedOut", 5, time.Hour),
+			threshold:    5,
+			registryErr:  serviceerror.NewNamespaceNotFound("ns"),
+		},
+		{
+			name:         "closed execution is skipped",
+			mutableState: newMutableState(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED, "WorkflowTaskTimedOut", 5, time.Hour),
+			threshold:    5,
+		},
+		{
+			name:         "no failures",
+			mutableState: newMutableState(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, "", 0, 0),
+			threshold:    5,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			registry := namespace.NewMockRegistry(gomock.NewController(t))
+			if tc.registryErr != nil {
+				registry.EXPECT().GetNamespaceByID(namespace.ID("ns-id")).Return(nil, tc.registryErr).AnyTimes()
+			} else {
+				registry.EXPECT().GetNamespaceByID(namespace.ID("ns-id")).Return(namespaceEntry, nil).AnyTimes()
+			}
+			timeSource := clock.NewEventTimeSource()
+			timeSource.Update(now)
+
+			validator := NewStuckWorkflowValidator(
+				registry,
+				timeSource,
+				dynamicconfig.GetIntPropertyFnFilteredByNamespace(tc.threshold),
+				dynamicconfig.GetDurationPropertyFnFilteredByNamespace(10*time.Minute),
+			)
+			results, err := validator.Validate(context.Background(), tc.mutableState)
+			require.NoError(t, err)
+			var types []string
+			for _, result := range results {
+				types = append(types, result.failureType)
+			}
+			require.Equal(t, tc.expectedTypes, types)
+		})
+	}
+}
+
+func TestStuckWorkflowResetReason(t *testing.T) {
+	attempt, ok := parseStuckWorkflowResetAttempt(stuckWorkflowResetReason(3))
+	require.True(t, ok)
+	require.Equal(t, 3, attempt)
+
+	for _, reason := range []string{
+		"",
+		"reset by operator",
+		stuckWorkflowResetReasonPrefix,
+		stuckWorkflowResetReasonPrefix + " (attempt x)",
+	} {
+		_, ok := parseStuckWorkflowResetAttempt(reason)
+		require.False(t, ok, reason)
+	}
+}
+
+func TestGetStuckWorkflowResetAttempts(t *testing.T) {
+	mutableState := &MutableState{WorkflowMutableState: &persistencespb.WorkflowMutableState{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			LastCompletedWorkflowTaskStartedEventId: 10,
+			VersionHistories: &historyspb.VersionHistories{
+				Histories: []*historyspb.VersionHistory{{BranchToken: []byte("branch")}},
+			},
+		},
+		NextEventId: 20,
+	}}
+	resetFailedEvent := func(eventID int64, reason string) *historypb.HistoryEvent {
+		return &historypb.HistoryEvent{
+			EventId:   eventID,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_FAILED,
+			Attributes: &historypb.HistoryEvent_WorkflowTaskFailedEventAttributes{
+				WorkflowTaskFailedEventAttributes: &historypb.WorkflowTaskFailedEventAttributes{
+					Cause:   enumspb.WORKFLOW_TASK_FAILED_CAUSE_RESET_WORKFLOW,
+					Failure: &failurepb.Failure{Message: reason},
+				},
+			},
+		}
+	}
+
+	executionManager := persistence.NewMockExecutionManager(gomock.NewController(t))
+	executionManager.EXPECT().ReadHistoryBranch(gomock.Any(), &persistence.ReadHistoryBranchRequest{
+		ShardID:     1,
+		BranchToken: []byte("branch"),
+		MinEventID:  11,
+		MaxEventID:  20,
+		PageSize:    stuckWorkflowHistoryPageSize,
+	}).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*historypb.HistoryEvent{resetFailedEvent(11, "reset by operator")},
+		NextPageToken: []byte("next"),
+	}, nil)
+	executionManager.EXPECT().ReadHistoryBranch(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.ReadHistoryBranchRequest) (*persistence.ReadHistoryBranchResponse, error) {
+			require.Equal(t, []byte("next"), request.NextPageToken)
+			return &persistence.ReadHistoryBranchResponse{
+				HistoryEvents: []*historypb.HistoryEvent{resetFailedEvent(12, stuckWorkflowResetReason(2))},
+			}, nil
+		},
+	)
+	attempts, err := getStuckWorkflowResetAttempts(context.Background(), executionManager, 1, mutableState)
+	require.NoError(t, err)
+	require.Equal(t, 2, attempts)
+
+	// a run which was never auto-reset since its last completed workflow task
+	executionManager.EXPECT().ReadHistoryBranch(gomock.Any(), gomock.Any()).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*historypb.HistoryEvent{{EventId: 11, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED}},
+	}, nil)
+	attempts, err = getStuckWorkflowResetAttempts(context.Background(), executionManager, 1, mutableState)
+	require.NoError(t, err)
+	require.Equal(t, 0, attempts)
+}
@@ -28,12 +28,18 @@ import (
 	"context"
 	"time"
 
+	"github.com/pborman/uuid"
 	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/collection"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
@@ -41,6 +47,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/service/worker/scanner/executor"
 )
@@ -48,6 +55,11 @@ import (
 const (
 	executionsPageSize = 100
 
+	repairIdentity                = "temporal-executions-scavenger"
+	orphanedChildTerminateReason  = "parent execution no longer tracks this child execution"
+	staleChildInfoTerminateReason = "child execution no longer exists"
+	zombieTerminateReason         = "execution timed out but was not closed"
+
 	taskStartupDelayRatio              = 100 * time.Millisecond
 	taskStartupDelayRandomizationRatio = 1.0
 )
@@ -56,19 +68,30 @@ type (
 	// task is a runnable task that adheres to the executor.Task interface
 	// for the scavenger, each of this task processes a single workflow mutableState
 	task struct {
-		shardID          int32
-		executionManager persistence.ExecutionManager
-		registry         namespace.Registry
-		historyClient    historyservice.HistoryServiceClient
-		adminClient      adminservice.AdminServiceClient
-		metricsHandler   metrics.Handler
-		logger           log.Logger
-		scavenger        *Scavenger
+		shardID           int32
+		executionManager  persistence.ExecutionManager
+		visibilityManager manager.VisibilityManager
+		registry          namespace.Registry
+		historyClient     historyservice.HistoryServiceClient
+		adminClient       adminservice.AdminServiceClient
+		metricsHandler    metrics.Handler
+		logger            log.Logger
+		scavenger         *Scavenger
 
 		ctx                           context.Context
 		rateLimiter                   quotas.RateLimiter
 		executionDataDurationBuffer   dynamicconfig.DurationPropertyFn
 		enableHistoryEventIDValidator dynamicconfig.BoolPropertyFn
+		enableOrphanedChildValidator  dynamicconfig.BoolPropertyFn
+		repairOrphanedChildren        dynamicconfig.BoolPropertyFn
+		enableStuckWorkflowValidator  dynamicconfig.BoolPropertyFn
+		resetStuckWorkflows           dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		stuckWorkflowMaxResets        dynamicconfig.IntPropertyFnWithNamespaceFilter
+		stuckWorkflowFailureThreshold dynamicconfig.IntPropertyFnWithNamespaceFilter
+		stuckWorkflowMinDuration      dynamicconfig.DurationPropertyFnWithNamespaceFilter
+		enableZombieValidator         dynamicconfig.BoolPropertyFn
+		repairZombies                 dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		zombieGracePeriod             dynamicconfig.DurationPropertyFn
 		paginationToken               []byte
 	}
 )
@@ -78,6 +101,7 @@ func newTask(
 	ctx context.Context,
 	shardID int32,
 	executionManager persistence.ExecutionManager,
+	visibilityManager manager.VisibilityManager,
 	registry namespace.Registry,
 	historyClient historyservice.HistoryServiceClient,
 	adminClient adminservice.AdminServiceClient,
@@ -87,13 +111,24 @@ func newTask(
 	rateLimiter quotas.RateLimiter,
 	executionDataDurationBuffer dynamicconfig.DurationPropertyFn,
 	enableHistoryEventIDValidator dynamicconfig.BoolPropertyFn,
+	enableOrphanedChildValidator dynamicconfig.BoolPropertyFn,
+	repairOrphanedChildren dynamicconfig.BoolPropertyFn,
+	enableStuckWorkflowValidator dynamicconfig.BoolPropertyFn,
+	resetStuckWorkflows dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+	stuckWorkflowMaxResets dynamicconfig.IntPropertyFnWithNamespaceFilter,
+	stuckWorkflowFailureThreshold dynamicconfig.IntPropertyFnWithNamespaceFilter,
+	stuckWorkflowMinDuration dynamicconfig.DurationPropertyFnWithNamespaceFilter,
+	enableZombieValidator dynamicconfig.BoolPropertyFn,
+	repairZombies dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+	zombieGracePeriod dynamicconfig.DurationPropertyFn,
 ) executor.Task {
 	return &task{
-		shardID:          shardID,
-		executionManager: executionManager,
-		registry:         registry,
-		historyClient:    historyClient,
-		adminClient:      adminClient,
+		shardID:           shardID,
+		executionManager:  executionManager,
+		visibilityManager: visibilityManager,
+		registry:          registry,
+		historyClient:     historyClient,
+		adminClient:       adminClient,
 
 		metricsHandler: metricsHandler.WithTags(metrics.OperationTag(metrics.ExecutionsScavengerScope)),
 		logger:         logger,
@@ -103,6 +138,16 @@ func newTask(
 		rateLimiter:                   rateLimiter,
 		executionDataDurationBuffer:   executionDataDurationBuffer,
 		enableHistoryEventIDValidator: enableHistoryEventIDValidator,
+		enableOrphanedChildValidator:  enableOrphanedChildValidator,
+		repairOrphanedChildren:        repairOrphanedChildren,
+		enableStuckWorkflowValidator:  enableStuckWorkflowValidator,
+		resetStuckWorkflows:           resetStuckWorkflows,
+		stuckWorkflowMaxResets:        stuckWorkflowMaxResets,
+		stuckWorkflowFailureThreshold: stuckWorkflowFailureThreshold,
+		stuckWorkflowMinDuration:      stuckWorkflowMinDuration,
+		enableZombieValidator:         enableZombieValidator,
+		repairZombies:                 repairZombies,
+		zombieGracePeriod:             zombieGracePeriod,
 	}
 }
 
@@ -205,6 +250,60 @@ func (t *task) validate(
 		}
 	}
 
+	if t.enableOrphanedChildValidator() {
+		if validationResults, err := NewOrphanedChildValidator(
+			t.historyClient,
+		).Validate(t.ctx, mutableState); err != nil {
+			t.logger.Error("unable to validate parent and child executions",
+				tag.ShardID(t.shardID),
+				tag.WorkflowNamespaceID(mutableState.GetExecutionInfo().GetNamespaceId()),
+				tag.WorkflowID(mutableState.GetExecutionInfo().GetWorkflowId()),
+				tag.WorkflowRunID(mutableState.GetExecutionState().GetRunId()),
+				tag.Error(err),
+			)
+		} else {
+			results = append(results, validationResults...)
+		}
+	}
+
+	if t.enableStuckWorkflowValidator() {
+		if validationResults, err := NewStuckWorkflowValidator(
+			t.registry,
+			clock.NewRealTimeSource(),
+			t.stuckWorkflowFailureThreshold,
+			t.stuckWorkflowMinDuration,
+		).Validate(t.ctx, mutableState); err != nil {
+			t.logger.Error("unable to validate workflow task failures",
+				tag.ShardID(t.shardID),
+				tag.WorkflowNamespaceID(mutableState.GetExecutionInfo().GetNamespaceId()),
+				tag.WorkflowID(mutableState.GetExecutionInfo().GetWorkflowId()),
+				tag.WorkflowRunID(mutableState.GetExecutionState().GetRunId()),
+				tag.Error(err),
+			)
+		} else {
+			results = append(results, validationResults...)
+		}
+	}
+
+	if t.enableZombieValidator() {
+		if validationResults, err := NewZombieExecutionValidator(
+			t.registry,
+			t.visibilityManager,
+			clock.NewRealTimeSource(),
+			t.zombieGracePeriod,
+		).Validate(t.ctx, mutableState); err != nil {
+			t.logger.Error("unable to validate execution is not a zombie",
+				tag.ShardID(t.shardID),
+				tag.WorkflowNamespaceID(mutableState.GetExecutionInfo().GetNamespaceId()),
+				tag.WorkflowID(mutableState.GetExecutionInfo().GetWorkflowId()),
+				tag.WorkflowRunID(mutableState.GetExecutionState().GetRunId()),
+				tag.Error(err),
+			)
+		} else {
+			results = append(results, validationResults...)
+		}
+	}
+
 	return results
 }
 
@@ -263,6 +362,28 @@ func (t *task) handleFailures(
 			default:
 				return err
 			}
+		case orphanedChildFailureType:
+			if !t.repairOrphanedChildren() {
+				continue
+			}
+			if err := t.terminateOrphanedChild(mutableState); err != nil {
+				return err
+			}
+		case staleChildInfoFailureType:
+			if !t.repairOrphanedChildren() {
+				continue
+			}
+			if err := t.clearStaleChildInfo(mutableState, failure.childInitiatedEventID); err != nil {
+				return err
+			}
+		case stuckWorkflowFailureType:
+			if err := t.resetStuckWorkflow(mutableState); err != nil {
+				return err
+			}
+		case zombieOpenVisibilityFailureType, zombieExpiredRunFailureType:
+			if err := t.repairZombieExecution(mutableState, failure.failureType); err != nil {
+				return err
+			}
 		default:
 			// no-op
 			continue
@@ -271,6 +392,195 @@ func (t *task) handleFailures(
 	return nil
 }
 
+// terminateOrphanedChild terminates a running child execution whose running parent no longer tracks it.
+func (t *task) terminateOrphanedChild(
+	mutableState *MutableState,
+) error {
+	executionInfo := mutableState.GetExecutionInfo()
+	_, err := t.historyClient.TerminateWorkflowExecution(t.ctx, &historyservice.TerminateWorkflowExecutionRequest{
+		NamespaceId: executionInfo.GetNamespaceId(),
+		TerminateRequest: &workflowservice.TerminateWorkflowExecutionRequest{
+			WorkflowExecution: &commonpb.WorkflowExecution{
+				WorkflowId: executionInfo.GetWorkflowId(),
+				RunId:      mutableState.GetExecutionState().GetRunId(),
+			},
+			Reason:   orphanedChildTerminateReason,
+			Identity: repairIdentity,
+		},
+	})
+	switch err.(type) {
+	case nil, *serviceerror.NotFound, *serviceerror.NamespaceNotFound, *serviceerror.NamespaceNotActive:
+		return nil
+	default:
+		return err
+	}
+}
+
+// clearStaleChildInfo records a child execution which no longer exists as terminated in its running parent, so that
+// the parent stops waiting for it.
+func (t *task) clearStaleChildInfo(
+	mutableState *MutableState,
+	initiatedEventID int64,
+) error {
+	executionInfo := mutableState.GetExecutionInfo()
+	childInfo, ok := mutableState.GetChildExecutionInfos()[initiatedEventID]
+	if !ok {
+		return nil
+	}
+	childExecution := &commonpb.WorkflowExecution{
+		WorkflowId: childInfo.GetStartedWorkflowId(),
+		RunId:      childInfo.GetStartedRunId(),
+	}
+	_, err := t.historyClient.RecordChildExecutionCompleted(t.ctx, &historyservice.RecordChildExecutionCompletedRequest{
+		NamespaceId: executionInfo.GetNamespaceId(),
+		ParentExecution: &commonpb.WorkflowExecution{
+			WorkflowId: executionInfo.GetWorkflowId(),
+			RunId:      mutableState.GetExecutionState().GetRunId(),
+		},
+		ParentInitiatedId:      initiatedEventID,
+		ParentInitiatedVersion: childInfo.GetVersion(),
+		ChildExecution:         childExecution,
+		CompletionEvent: &historypb.HistoryEvent{
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionTerminatedEventAttributes{
+				WorkflowExecutionTerminatedEventAttributes: &historypb.WorkflowExecutionTerminatedEventAttributes{
+					Reason:   staleChildInfoTerminateReason,
+					Identity: repairIdentity,
+				},
+			},
+		},
+	})
+	switch err.(type) {
+	case nil, *serviceerror.NotFound, *serviceerror.NamespaceNotFound, *serviceerror.NamespaceNotActive:
+		return nil
+	default:
+		return err
+	}
+}
+
+// resetStuckWorkflow resets an execution whose workflow tasks keep failing to its last completed workflow task, if the
+// policy of its namespace allows it. Each reset records its attempt in the reset reason, and an execution which keeps
+// getting stuck without completing a workflow task is only reset up to the attempt limit of its namespace.
+func (t *task) resetStuckWorkflow(
+	mutableState *MutableState,
+) error {
+	executionInfo := mutableState.GetExecutionInfo()
+	namespaceEntry, err := t.registry.GetNamespaceByID(namespace.ID(executionInfo.GetNamespaceId()))
+	switch err.(type) {
+	case nil:
+	case *serviceerror.NamespaceNotFound:
+		return nil
+	default:
+		return err
+	}
+	namespaceName := namespaceEntry.Name().String()
+	maxResets := t.stuckWorkflowMaxResets(namespaceName)
+	if !t.resetStuckWorkflows(namespaceName) || maxResets <= 0 {
+		return nil
+	}
+	if executionInfo.GetResetRunId() != "" {
+		// the execution was already reset, its new run is scanned on its own
+		return nil
+	}
+	lastCompletedStartedEventID := executionInfo.GetLastCompletedWorkflowTaskStartedEventId()
+	if lastCompletedStartedEventID <= common.EmptyEventID {
+		// no workflow task ever completed, there is nothing good to reset to
+		return nil
+	}
+	attempts, err := getStuckWorkflowResetAttempts(t.ctx, t.executionManager, t.shardID, mutableState)
+	if err != nil {
+		return err
+	}
+	if attempts >= maxResets {
+		metrics.ScavengerStuckWorkflowResetLimitReachedCount.With(t.metricsHandler).Record(1,
+			metrics.NamespaceTag(namespaceName))
+		return nil
+	}
+
+	_, err = t.historyClient.ResetWorkflowExecution(t.ctx, &historyservice.ResetWorkflowExecutionRequest{
+		NamespaceId: executionInfo.GetNamespaceId(),
+		ResetRequest: &workflowservice.ResetWorkflowExecutionRequest{
+			Namespace: namespaceName,
+			WorkflowExecution: &commonpb.WorkflowExecution{
+				WorkflowId: executionInfo.GetWorkflowId(),
+				RunId:      mutableState.GetExecutionState().GetRunId(),
+			},
+			Reason: stuckWorkflowResetReason(attempts + 1),
+			// the completed event directly follows the started event of a workflow task
+			WorkflowTaskFinishEventId: lastCompletedStartedEventID + 1,
+			RequestId:                 uuid.New(),
+		},
+	})
+	switch err.(type) {
+	case nil:
+		metrics.ScavengerStuckWorkflowResetCount.With(t.metricsHandler).Record(1,
+			metrics.NamespaceTag(namespaceName))
+		return nil
+	case *serviceerror.NotFound, *serviceerror.NamespaceNotFound, *serviceerror.NamespaceNotActive,
+		*serviceerror.InvalidArgument:
+		return nil
+	default:
+		return err
+	}
+}
+
+// repairZombieExecution repairs an execution reported by the zombie execution validator, if the policy of its
+// namespace allows it: the close tasks of a closed execution are regenerated so that its visibility record gets closed,
+// and a running execution which outlived its timeout is terminated.
+func (t *task) repairZombieExecution(
+	mutableState *MutableState,
+	failureType string,
+) error {
+	executionInfo := mutableState.GetExecutionInfo()
+	namespaceEntry, err := t.registry.GetNamespaceByID(namespace.ID(executionInfo.GetNamespaceId()))
+	switch err.(type) {
+	case nil:
+	case *serviceerror.NamespaceNotFound:
+		return nil
+	default:
+		return err
+	}
+	if !t.repairZombies(namespaceEntry.Name().String()) {
+		return nil
+	}
+
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: executionInfo.GetWorkflowId(),
+		RunId:      mutableState.GetExecutionState().GetRunId(),
+	}
+	switch failureType {
+	case zombieOpenVisibilityFailureType:
+		_, err = t.historyClient.RefreshWorkflowTasks(t.ctx, &historyservice.RefreshWorkflowTasksRequest{
+			NamespaceId: executionInfo.GetNamespaceId(),
+			Request: &adminservice.RefreshWorkflowTasksRequest{
+				NamespaceId: executionInfo.GetNamespaceId(),
+				Execution:   execution,
+			},
+		})
+	case zombieExpiredRunFailureType:
+		_, err = t.historyClient.TerminateWorkflowExecution(t.ctx, &historyservice.TerminateWorkflowExecutionRequest{
+			NamespaceId: executionInfo.GetNamespaceId(),
+			TerminateRequest: &workflowservice.TerminateWorkflowExecutionRequest{
+				Namespace:         namespaceEntry.Name().String(),
+				WorkflowExecution: execution,
+				Reason:            zombieTerminateReason,
+				Identity:          repairIdentity,
+			},
+		})
+	}
+	switch err.(type) {
+	case nil:
+		metrics.ScavengerZombieExecutionRepairCount.With(t.metricsHandler).Record(1,
+			metrics.NamespaceTag(namespaceEntry.Name().String()),
+			metrics.FailureTag(failureType))
+		return nil
+	case *serviceerror.NotFound, *serviceerror.NamespaceNotFound, *serviceerror.NamespaceNotActive:
+		return nil
+	default:
+		return err
+	}
+}
+
 func printValidationResult(
 	mutableState *MutableState,
 	results []MutableStateValidationResult,
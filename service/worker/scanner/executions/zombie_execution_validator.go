@@ -0,0 +1,176 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package executions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+)
+
+const (
+	zombieOpenVisibilityFailureType = "zombie_open_visibility_validator"
+	zombieExpiredRunFailureType     = "zombie_expired_run_validator"
+)
+
+type (
+	// zombieExecutionValidator is a validator that checks an execution is not stuck in between running and closed:
+	// a closed execution must not be open in visibility and a running execution must not outlive its timeout
+	zombieExecutionValidator struct {
+		registry          namespace.Registry
+		visibilityManager manager.VisibilityManager
+		timeSource        clock.TimeSource
+		gracePeriod       dynamicconfig.DurationPropertyFn
+	}
+)
+
+var _ Validator = (*zombieExecutionValidator)(nil)
+
+// NewZombieExecutionValidator returns new instance.
+func NewZombieExecutionValidator(
+	registry namespace.Registry,
+	visibilityManager manager.VisibilityManager,
+	timeSource clock.TimeSource,
+	gracePeriod dynamicconfig.DurationPropertyFn,
+) *zombieExecutionValidator {
+	return &zombieExecutionValidator{
+		registry:          registry,
+		visibilityManager: visibilityManager,
+		timeSource:        timeSource,
+		gracePeriod:       gracePeriod,
+	}
+}
+
+func (v *zombieExecutionValidator) Validate(
+	ctx context.Context,
+	mutableState *MutableState,
+) ([]MutableStateValidationResult, error) {
+	switch mutableState.GetExecutionState().GetState() {
+	case enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED:
+		return v.validateClosed(ctx, mutableState)
+	case enumsspb.WORKFLOW_EXECUTION_STATE_CREATED, enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING:
+		return v.validateRunning(mutableState), nil
+	default:
+		// zombie executions from replication and corrupted states are not expected to be consistent
+		return nil, nil
+	}
+}
+
+func (v *zombieExecutionValidator) validateClosed(
+	ctx context.Context,
+	mutableState *MutableState,
+) ([]MutableStateValidationResult, error) {
+	executionInfo := mutableState.GetExecutionInfo()
+	closeTime := executionInfo.GetCloseTime()
+	if closeTime == nil || v.timeSource.Now().Before(closeTime.AsTime().Add(v.gracePeriod())) {
+		// the close visibility task may still be in flight
+		return nil, nil
+	}
+
+	namespaceEntry, err := v.registry.GetNamespaceByID(namespace.ID(executionInfo.GetNamespaceId()))
+	switch err.(type) {
+	case nil:
+	case *serviceerror.NamespaceNotFound:
+		return nil, nil
+	default:
+		return nil, err
+	}
+
+	resp, err := v.visibilityManager.GetWorkflowExecution(ctx, &manager.GetWorkflowExecutionRequest{
+		NamespaceID: namespaceEntry.ID(),
+		Namespace:   namespaceEntry.Name(),
+		WorkflowID:  executionInfo.GetWorkflowId(),
+		RunID:       mutableState.GetExecutionState().GetRunId(),
+	})
+	switch err.(type) {
+	case nil:
+	case *serviceerror.NotFound:
+		return nil, nil
+	default:
+		return nil, err
+	}
+
+	if resp.Execution.GetStatus() != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+		return nil, nil
+	}
+	return []MutableStateValidationResult{{
+		failureType: zombieOpenVisibilityFailureType,
+		failureDetails: fmt.Sprintf("execution closed with status %v at %v but is still running in visibility",
+			mutableState.GetExecutionState().GetStatus(),
+			closeTime.AsTime(),
+		),
+	}}, nil
+}
+
+func (v *zombieExecutionValidator) validateRunning(
+	mutableState *MutableState,
+) []MutableStateValidationResult {
+	executionInfo := mutableState.GetExecutionInfo()
+	if executionInfo.GetWorkflowTaskScheduledEventId() != common.EmptyEventID {
+		// the execution is still being processed, leave it to the workflow timeout
+		return nil
+	}
+
+	expirationTime, ok := workflowExpirationTime(executionInfo)
+	if !ok || v.timeSource.Now().Before(expirationTime.Add(v.gracePeriod())) {
+		return nil
+	}
+	return []MutableStateValidationResult{{
+		failureType: zombieExpiredRunFailureType,
+		failureDetails: fmt.Sprintf("execution is still running without a pending workflow task but timed out at %v",
+			expirationTime,
+		),
+	}}
+}
+
+// workflowExpirationTime returns the earliest of the run and execution expiration times of an execution, if any.
+func workflowExpirationTime(
+	executionInfo *persistencespb.WorkflowExecutionInfo,
+) (time.Time, bool) {
+	var expirationTime time.Time
+	for _, t := range []time.Time{
+		executionInfo.GetWorkflowRunExpirationTime().AsTime(),
+		executionInfo.GetWorkflowExecutionExpirationTime().AsTime(),
+	} {
+		if t.Unix() <= 0 {
+			// not set
+			continue
+		}
+		if expirationTime.IsZero() || t.Before(expirationTime) {
+			expirationTime = t
+		}
+	}
+	return expirationTime, !expirationTime.IsZero()
+}
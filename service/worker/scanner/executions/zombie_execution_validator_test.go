@@ -0,0 +1,172 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package executions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestZombieExecutionValidator(t *testing.T) {
+	now := time.Now()
+	newClosed := func(closedFor time.Duration) *MutableState {
+		return &MutableState{WorkflowMutableState: &persistencespb.WorkflowMutableState{
+			ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+				NamespaceId: "ns-id",
+				WorkflowId:  "wf",
+				CloseTime:   timestamppb.New(now.Add(-closedFor)),
+			},
+			ExecutionState: &persistencespb.WorkflowExecutionState{
+				State:  enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED,
+				Status: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+				RunId:  "run",
+			},
+		}}
+	}
+	newRunning := func(state enumsspb.WorkflowExecutionState, expiredFor time.Duration, workflowTaskScheduledEventID int64) *MutableState {
+		executionInfo := &persistencespb.WorkflowExecutionInfo{
+			NamespaceId:                  "ns-id",
+			WorkflowId:                   "wf",
+			WorkflowTaskScheduledEventId: workflowTaskScheduledEventID,
+		}
+		if expiredFor != 0 {
+			executionInfo.WorkflowRunExpirationTime = timestamppb.New(now.Add(-expiredFor))
+			executionInfo.WorkflowExecutionExpirationTime = timestamppb.New(now.Add(-expiredFor).Add(time.Hour))
+		}
+		return &MutableState{WorkflowMutableState: &persistencespb.WorkflowMutableState{
+			ExecutionInfo: executionInfo,
+			ExecutionState: &persistencespb.WorkflowExecutionState{
+				State:  state,
+				Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+				RunId:  "run",
+			},
+		}}
+	}
+	namespaceEntry := namespace.NewLocalNamespaceForTest(&persistencespb.NamespaceInfo{Id: "ns-id", Name: "ns"}, nil, "active")
+
+	testCases := []struct {
+		name             string
+		mutableState     *MutableState
+		visibilityStatus enumspb.WorkflowExecutionStatus
+		visibilityErr    error
+		expectedTypes    []string
+	}{
+		{
+			name:             "closed but open in visibility",
+			mutableState:     newClosed(2 * time.Hour),
+			visibilityStatus: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+			expectedTypes:    []string{zombieOpenVisibilityFailureType},
+		},
+		{
+			name:             "closed within grace period",
+			mutableState:     newClosed(time.Minute),
+			visibilityStatus: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		},
+		{
+			name:             "closed in visibility",
+			mutableState:     newClosed(2 * time.Hour),
+			visibilityStatus: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		},
+		{
+			name:          "not found in visibility",
+			mutableState:  newClosed(2 * time.Hour),
+			visibilityErr: serviceerror.NewNotFound("wf"),
+		},
+		{
+			name:          "running past timeout",
+			mutableState:  newRunning(enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, 2*time.Hour, common.EmptyEventID),
+			expectedTypes: []string{zombieExpiredRunFailureType},
+		},
+		{
+			name:         "running past timeout within grace period",
+			mutableState: newRunning(enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, time.Minute, common.EmptyEventID),
+		},
+		{
+			name:         "running past timeout with pending workflow task",
+			mutableState: newRunning(enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, 2*time.Hour, 5),
+		},
+		{
+			name:         "running without timeout",
+			mutableState: newRunning(enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, 0, common.EmptyEventID),
+		},
+		{
+			name:         "replication zombie is skipped",
+			mutableState: newRunning(enumsspb.WORKFLOW_EXECUTION_STATE_ZOMBIE, 2*time.Hour, common.EmptyEventID),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			registry := namespace.NewMockRegistry(ctrl)
+			registry.EXPECT().GetNamespaceByID(namespace.ID("ns-id")).Return(namespaceEntry, nil).AnyTimes()
+			visibilityManager := manager.NewMockVisibilityManager(ctrl)
+			visibilityManager.EXPECT().GetWorkflowExecution(gomock.Any(), &manager.GetWorkflowExecutionRequest{
+				NamespaceID: "ns-id",
+				Namespace:   "ns",
+				WorkflowID:  "wf",
+				RunID:       "run",
+			}).DoAndReturn(func(context.Context, *manager.GetWorkflowExecutionRequest) (*manager.GetWorkflowExecutionResponse, error) {
+				if tc.visibilityErr != nil {
+					return nil, tc.visibilityErr
+				}
+				return &manager.GetWorkflowExecutionResponse{
+					Execution: &workflowpb.WorkflowExecutionInfo{Status: tc.visibilityStatus},
+				}, nil
+			}).AnyTimes()
+			timeSource := clock.NewEventTimeSource()
+			timeSource.Update(now)
+
+			validator := NewZombieExecutionValidator(
+				registry,
+				visibilityManager,
+				timeSource,
+				dynamicconfig.GetDurationPropertyFn(time.Hour),
+			)
+			results, err := validator.Validate(context.Background(), tc.mutableState)
+			require.NoError(t, err)
+			var types []string
+			for _, result := range results {
+				types = append(types, result.failureType)
+			}
+			require.Equal(t, tc.expectedTypes, types)
+		})
+	}
+}
@@ -30,6 +30,7 @@ import (
 	"time"
 
 	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/sdk/activity"
 	"go.temporal.io/server/api/adminservice/v1"
@@ -56,6 +57,8 @@ type (
 		ErrorCount   int
 		SkipCount    int
 		CurrentPage  int
+		// GarbageCount is the number of garbage branches found, which are deleted unless in dry run mode.
+		GarbageCount int
 
 		NextPageToken []byte
 	}
@@ -76,6 +79,8 @@ type (
 		historyDataMinAge           dynamicconfig.DurationPropertyFn
 		executionDataDurationBuffer dynamicconfig.DurationPropertyFn
 		enableRetentionVerification dynamicconfig.BoolPropertyFn
+		dryRun                      dynamicconfig.BoolPropertyFn
+		deleteUnreferencedBranches  dynamicconfig.BoolPropertyFn
 
 		sync.WaitGroup
 		sync.Mutex
@@ -87,6 +92,7 @@ type (
 		namespaceID string
 		workflowID  string
 		runID       string
+		branchID    string
 		branchToken []byte
 	}
 )
@@ -103,6 +109,9 @@ const (
 // each branch, the scavenger will attempt
 //   - describe the corresponding workflow execution
 //   - deletion of history itself, if there are no workflow execution
+//     or, if enabled, the workflow execution does not reference the branch
+//
+// In dry run mode, garbage branches are only reported.
 func NewScavenger(
 	numShards int32,
 	db persistence.ExecutionManager,
@@ -114,6 +123,8 @@ func NewScavenger(
 	historyDataMinAge dynamicconfig.DurationPropertyFn,
 	executionDataDurationBuffer dynamicconfig.DurationPropertyFn,
 	enableRetentionVerification dynamicconfig.BoolPropertyFn,
+	dryRun dynamicconfig.BoolPropertyFn,
+	deleteUnreferencedBranches dynamicconfig.BoolPropertyFn,
 	metricsHandler metrics.Handler,
 	logger log.Logger,
 ) *Scavenger {
@@ -130,6 +141,8 @@ func NewScavenger(
 		historyDataMinAge:           historyDataMinAge,
 		executionDataDurationBuffer: executionDataDurationBuffer,
 		enableRetentionVerification: enableRetentionVerification,
+		dryRun:                      dryRun,
+		deleteUnreferencedBranches:  deleteUnreferencedBranches,
 		metricsHandler:              metricsHandler.WithTags(metrics.OperationTag(metrics.HistoryScavengerScope)),
 		logger:                      logger,
 
@@ -266,6 +279,7 @@ func (s *Scavenger) filterTask(
 		namespaceID: namespaceID,
 		workflowID:  workflowID,
 		runID:       runID,
+		branchID:    branch.BranchInfo.GetBranchId(),
 		branchToken: branchToken.Data,
 	}
 }
@@ -285,19 +299,44 @@ func (s *Scavenger) handleTask(
 	})
 	switch err.(type) {
 	case nil:
+		if !isBranchReferenced(ms.GetDatabaseMutableState(), task.branchID) {
+			if s.deleteUnreferencedBranches() {
+				return s.deleteGarbageBranch(ctx, task)
+			}
+			s.reportGarbageBranch()
+			s.logger.Info("found history branch not referenced by its execution", getTaskLoggingTags(nil, task)...)
+		}
 		if s.enableRetentionVerification() {
 			return s.cleanUpWorkflowPastRetention(ctx, ms.GetDatabaseMutableState())
 		}
 		return nil
 	case *serviceerror.NotFound, *serviceerror.NamespaceNotFound:
-		// case handled below
+		return s.deleteGarbageBranch(ctx, task)
 	default:
 		s.logger.Error("encounter error when describing the mutable state", getTaskLoggingTags(err, task)...)
 		return err
 	}
+}
+
+func (s *Scavenger) reportGarbageBranch() {
+	metrics.HistoryScavengerGarbageCount.With(s.metricsHandler).Record(1)
+	s.Lock()
+	s.hbd.GarbageCount++
+	s.Unlock()
+}
+
+func (s *Scavenger) deleteGarbageBranch(
+	ctx context.Context,
+	task taskDetail,
+) error {
+	s.reportGarbageBranch()
+	if s.dryRun() {
+		s.logger.Info("found history garbage in dry run mode", getTaskLoggingTags(nil, task)...)
+		return nil
+	}
 
 	//deleting history branch
-	err = s.db.DeleteHistoryBranch(ctx, &persistence.DeleteHistoryBranchRequest{
+	err := s.db.DeleteHistoryBranch(ctx, &persistence.DeleteHistoryBranchRequest{
 		ShardID:     task.shardID,
 		BranchToken: task.branchToken,
 	})
@@ -309,6 +348,25 @@ func (s *Scavenger) handleTask(
 	return err
 }
 
+// isBranchReferenced returns true if the branch is one of the version history branches of the execution. A branch is
+// considered referenced if the branches of the execution cannot be determined.
+func isBranchReferenced(
+	mutableState *persistencespb.WorkflowMutableState,
+	branchID string,
+) bool {
+	versionHistories := mutableState.GetExecutionInfo().GetVersionHistories().GetHistories()
+	if len(versionHistories) == 0 {
+		return true
+	}
+	for _, versionHistory := range versionHistories {
+		branch, err := serialization.HistoryBranchFromBlob(versionHistory.GetBranchToken(), enumspb.ENCODING_TYPE_PROTO3.String())
+		if err != nil || branch.GetBranchId() == branchID {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *Scavenger) handleErr(
 	err error,
 ) {
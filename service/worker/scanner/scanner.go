@@ -78,6 +78,11 @@ type (
 		HistoryScannerDataMinAge dynamicconfig.DurationPropertyFn
 		// HistoryScannerVerifyRetention indicates if the history scavenger to do retention verification
 		HistoryScannerVerifyRetention dynamicconfig.BoolPropertyFn
+		// HistoryScannerDryRun indicates if the history scavenger to only report garbage history branches
+		HistoryScannerDryRun dynamicconfig.BoolPropertyFn
+		// HistoryScannerDeleteUnreferenced indicates if the history scavenger to delete history branches not
+		// referenced by their execution
+		HistoryScannerDeleteUnreferenced dynamicconfig.BoolPropertyFn
 		// ExecutionScannerPerHostQPS the max rate of calls to scan execution data per host
 		ExecutionScannerPerHostQPS dynamicconfig.IntPropertyFn
 		// ExecutionScannerPerShardQPS the max rate of calls to scan execution data per shard
@@ -88,6 +93,26 @@ type (
 		ExecutionScannerWorkerCount dynamicconfig.IntPropertyFn
 		// ExecutionScannerHistoryEventIdValidator indicates if the execution scavenger to validate history event id.
 		ExecutionScannerHistoryEventIdValidator dynamicconfig.BoolPropertyFn
+		// ExecutionScannerOrphanedChildValidator indicates if the execution scavenger to validate parent/child links.
+		ExecutionScannerOrphanedChildValidator dynamicconfig.BoolPropertyFn
+		// ExecutionScannerRepairOrphanedChildren indicates if the execution scavenger to repair broken parent/child links.
+		ExecutionScannerRepairOrphanedChildren dynamicconfig.BoolPropertyFn
+		// ExecutionScannerStuckWorkflowValidator indicates if the execution scavenger to report stuck workflow tasks.
+		ExecutionScannerStuckWorkflowValidator dynamicconfig.BoolPropertyFn
+		// ExecutionScannerStuckWorkflowAutoReset indicates if the execution scavenger to reset stuck executions.
+		ExecutionScannerStuckWorkflowAutoReset dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		// ExecutionScannerStuckWorkflowMaxResets is the number of times a stuck execution is reset.
+		ExecutionScannerStuckWorkflowMaxResets dynamicconfig.IntPropertyFnWithNamespaceFilter
+		// StuckWorkflowTaskFailureThreshold is the number of same workflow task failures for an execution to be stuck.
+		StuckWorkflowTaskFailureThreshold dynamicconfig.IntPropertyFnWithNamespaceFilter
+		// StuckWorkflowTaskMinDuration is how long workflow tasks must be failing for an execution to be stuck.
+		StuckWorkflowTaskMinDuration dynamicconfig.DurationPropertyFnWithNamespaceFilter
+		// ExecutionScannerZombieValidator indicates if the execution scavenger to report zombie executions.
+		ExecutionScannerZombieValidator dynamicconfig.BoolPropertyFn
+		// ExecutionScannerRepairZombies indicates if the execution scavenger to repair zombie executions.
+		ExecutionScannerRepairZombies dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		// ExecutionScannerZombieGracePeriod is how long an execution must be inconsistent to be a zombie.
+		ExecutionScannerZombieGracePeriod dynamicconfig.DurationPropertyFn
 
 		// RemovableBuildIdDurationSinceDefault is the minimum duration since a build ID was last default in its
 		// containing set for it to be considered for removal.
@@ -155,6 +155,8 @@ func HistoryScavengerActivity(
 		ctx.cfg.HistoryScannerDataMinAge,
 		ctx.cfg.ExecutionDataDurationBuffer,
 		ctx.cfg.HistoryScannerVerifyRetention,
+		ctx.cfg.HistoryScannerDryRun,
+		ctx.cfg.HistoryScannerDeleteUnreferenced,
 		ctx.metricsHandler,
 		ctx.logger,
 	)
@@ -196,7 +198,18 @@ func ExecutionsScavengerActivity(
 		ctx.cfg.ExecutionDataDurationBuffer,
 		ctx.cfg.ExecutionScannerWorkerCount,
 		ctx.cfg.ExecutionScannerHistoryEventIdValidator,
+		ctx.cfg.ExecutionScannerOrphanedChildValidator,
+		ctx.cfg.ExecutionScannerRepairOrphanedChildren,
+		ctx.cfg.ExecutionScannerStuckWorkflowValidator,
+		ctx.cfg.ExecutionScannerStuckWorkflowAutoReset,
+		ctx.cfg.ExecutionScannerStuckWorkflowMaxResets,
+		ctx.cfg.StuckWorkflowTaskFailureThreshold,
+		ctx.cfg.StuckWorkflowTaskMinDuration,
+		ctx.cfg.ExecutionScannerZombieValidator,
+		ctx.cfg.ExecutionScannerRepairZombies,
+		ctx.cfg.ExecutionScannerZombieGracePeriod,
 		ctx.executionManager,
+		ctx.visibilityManager,
 		ctx.namespaceRegistry,
 		ctx.historyClient,
 		ctx.adminClient,
@@ -281,6 +281,20 @@ func (a *activities) TerminateWorkflow(ctx context.Context, req *schedulespb.Ter
 	return translateError(err, "TerminateWorkflowExecution")
 }
 
+// NotifyAutoPause logs the auto-pause of a schedule and forwards it to the AutoPauseNotifier, if any.
+func (a *activities) NotifyAutoPause(ctx context.Context, req *schedulespb.NotifyAutoPauseRequest) error {
+	a.Logger.Warn("Schedule paused after consecutive action failures",
+		tag.WorkflowNamespace(a.namespace.String()),
+		tag.ScheduleID(req.ScheduleId),
+		tag.Counter(int(req.ConsecutiveFailures)),
+		tag.NewStringTag("reason", req.Reason),
+	)
+	if a.AutoPauseNotifier == nil {
+		return nil
+	}
+	return a.AutoPauseNotifier.ScheduleAutoPaused(ctx, a.namespace, req)
+}
+
 func translateError(err error, msgPrefix string) error {
 	if err == nil {
 		return nil
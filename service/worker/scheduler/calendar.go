@@ -32,6 +32,7 @@ import (
 	"time"
 
 	schedulepb "go.temporal.io/api/schedule/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"google.golang.org/protobuf/types/known/durationpb"
 )
@@ -42,6 +43,8 @@ type (
 	compiledCalendar struct {
 		// Time zone that this calendar spec is interpreted in
 		tz *time.Location
+		// How local times affected by daylight saving transitions in tz are treated
+		dstPolicy enumsspb.ScheduleDaylightSavingPolicy
 
 		// Matching predicates for each field (two for date). As in Go's time
 		// library, months start on 1 == January, day of month starts at 1, day
@@ -103,9 +106,14 @@ var (
 	}
 )
 
-func newCompiledCalendar(cal *schedulepb.StructuredCalendarSpec, tz *time.Location) *compiledCalendar {
+func newCompiledCalendar(
+	cal *schedulepb.StructuredCalendarSpec,
+	tz *time.Location,
+	dstPolicy enumsspb.ScheduleDaylightSavingPolicy,
+) *compiledCalendar {
 	return &compiledCalendar{
 		tz:         tz,
+		dstPolicy:  dstPolicy,
 		year:       makeYearMatcher(cal.Year),
 		month:      makeBitMatcher(cal.Month),
 		dayOfMonth: makeBitMatcher(cal.DayOfMonth),
@@ -144,14 +152,20 @@ func (cc *compiledCalendar) next(ts time.Time) time.Time {
 	y, mo, d := ts.Date()
 	h, m, s := ts.Clock()
 	dstoffset := 0 * time.Hour
-	if ts.Add(-time.Hour).Hour() == h {
-		// we're in the second copy of a dst repeated hour
-		dstoffset = 1 * time.Hour
-	}
 
 	// looking for first matching time after ts, so add 1 second
 	s++
 
+	if ts.Add(-time.Hour).Hour() == h {
+		// we're in the second copy of a dst repeated hour
+		if cc.runAtBoth() {
+			dstoffset = 1 * time.Hour
+		} else {
+			// times in the second copy only run (if at all) in the first copy
+			h, m, s = h+1, 0, 0
+		}
+	}
+
 Outer:
 	for {
 		// normalize after carries
@@ -163,8 +177,9 @@ Outer:
 			h, m = h+1, 0
 			next := time.Date(y, mo, d, h, 0, 0, 0, cc.tz)
 			// if we moved to the next hour but it's two hours later, then we skipped over
-			// a dst repeated hour. try it again with an offset.
-			if dstoffset == 0 && next.Sub(prev) > time.Hour {
+			// a dst repeated hour. try it again with an offset, unless repeated times should
+			// only run once.
+			if dstoffset == 0 && next.Sub(prev) > time.Hour && cc.runAtBoth() {
 				h = h - 1
 				dstoffset = 1 * time.Hour
 			} else {
@@ -227,16 +242,37 @@ Outer:
 		// we might have reached a nonexistent time that got jumped over by a dst transition.
 		// we can tell if the hour is different from what we think it should be.
 		if nextTs.Hour() != h {
+			if cc.dstPolicy == enumsspb.SCHEDULE_DAYLIGHT_SAVING_POLICY_RUN_ONCE {
+				// run at the end of the transition instead, which is the start of the next hour
+				return time.Date(y, mo, d, h+1, 0, 0, 0, cc.tz)
+			}
 			h, m, s = h+1, 0, 0
 			continue Outer
 		}
-		return nextTs.Add(dstoffset)
+		nextTs = nextTs.Add(dstoffset)
+		if cc.dstPolicy == enumsspb.SCHEDULE_DAYLIGHT_SAVING_POLICY_SKIP && isRepeatedLocalTime(nextTs) {
+			// skip both copies of the repeated hour
+			h, m, s = h+1, 0, 0
+			dstoffset = 0
+			continue Outer
+		}
+		return nextTs
 	}
 
 	// no more matching times (up to max we checked)
 	return time.Time{}
 }
 
+func (cc *compiledCalendar) runAtBoth() bool {
+	return cc.dstPolicy == enumsspb.SCHEDULE_DAYLIGHT_SAVING_POLICY_UNSPECIFIED ||
+		cc.dstPolicy == enumsspb.SCHEDULE_DAYLIGHT_SAVING_POLICY_RUN_AT_BOTH
+}
+
+// isRepeatedLocalTime returns true if the local time of ts occurs twice because of a dst transition.
+func isRepeatedLocalTime(ts time.Time) bool {
+	return ts.Add(time.Hour).Hour() == ts.Hour() || ts.Add(-time.Hour).Hour() == ts.Hour()
+}
+
 func parseCalendarToStructured(cal *schedulepb.CalendarSpec) (*schedulepb.StructuredCalendarSpec, error) {
 	var errs []string
 	makeRangeOrNil := func(s, field, def string, minVal, maxVal int, parseMode parseMode) []*schedulepb.Range {
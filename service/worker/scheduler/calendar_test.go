@@ -32,6 +32,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	schedulepb "go.temporal.io/api/schedule/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"google.golang.org/protobuf/types/known/durationpb"
 )
 
@@ -53,7 +54,7 @@ func (s *calendarSuite) mustCompileCalendarSpec(cal *schedulepb.CalendarSpec, tz
 	s.NoError(err)
 	s.NotNil(scs)
 	s.NoError(validateStructuredCalendar(scs))
-	cc := newCompiledCalendar(scs, tz)
+	cc := newCompiledCalendar(scs, tz, enumsspb.SCHEDULE_DAYLIGHT_SAVING_POLICY_UNSPECIFIED)
 	return cc
 }
 
@@ -227,12 +228,22 @@ func (s *calendarSuite) TestGoDSTBehavior() {
 }
 
 func (s *calendarSuite) checkSequence(cs string, start time.Time, seq ...time.Time) {
+	s.T().Helper()
+	s.checkSequenceWithPolicy(cs, enumsspb.SCHEDULE_DAYLIGHT_SAVING_POLICY_UNSPECIFIED, start, seq...)
+}
+
+func (s *calendarSuite) checkSequenceWithPolicy(
+	cs string,
+	dstPolicy enumsspb.ScheduleDaylightSavingPolicy,
+	start time.Time,
+	seq ...time.Time,
+) {
 	s.T().Helper()
 	scs, _, tzName, err := parseCronString(cs)
 	s.NoError(err)
 	tz, err := time.LoadLocation(tzName)
 	s.NoError(err)
-	cc := newCompiledCalendar(scs, tz)
+	cc := newCompiledCalendar(scs, tz, dstPolicy)
 	for _, exp := range seq {
 		next := cc.next(start)
 		s.True(exp.Equal(next))
@@ -270,6 +281,58 @@ func (s *calendarSuite) TestCalendarNextDST() {
 	)
 }
 
+func (s *calendarSuite) TestCalendarNextDSTPolicies() {
+	pacific, err := time.LoadLocation("US/Pacific") // switches at 2am
+	s.NoError(err)
+
+	// spring forward
+	s.checkSequenceWithPolicy(
+		"CRON_TZ=US/Pacific 33 33 2 * * * *",
+		enumsspb.SCHEDULE_DAYLIGHT_SAVING_POLICY_SKIP,
+		time.Date(2022, time.March, 11, 20, 0, 0, 0, pacific),
+		time.Date(2022, time.March, 12, 2, 33, 33, 0, pacific),
+		time.Date(2022, time.March, 14, 2, 33, 33, 0, pacific),
+	)
+	s.checkSequenceWithPolicy(
+		"CRON_TZ=US/Pacific 33 33 2 * * * *",
+		enumsspb.SCHEDULE_DAYLIGHT_SAVING_POLICY_RUN_ONCE,
+		time.Date(2022, time.March, 11, 20, 0, 0, 0, pacific),
+		time.Date(2022, time.March, 12, 2, 33, 33, 0, pacific),
+		// march 13 has no 2:33:33, run at the end of the transition instead
+		time.Date(2022, time.March, 13, 3, 0, 0, 0, pacific),
+		time.Date(2022, time.March, 14, 2, 33, 33, 0, pacific),
+	)
+
+	// jump back
+	s.checkSequenceWithPolicy(
+		"CRON_TZ=US/Pacific 33 33,44 1 * * * *",
+		enumsspb.SCHEDULE_DAYLIGHT_SAVING_POLICY_SKIP,
+		time.Date(2021, time.November, 6, 0, 15, 15, 0, pacific),
+		time.Date(2021, time.November, 6, 1, 33, 33, 0, pacific),
+		time.Date(2021, time.November, 6, 1, 44, 33, 0, pacific),
+		// nov 7 has two 1:33:33s and 1:44:33s
+		time.Date(2021, time.November, 8, 1, 33, 33, 0, pacific),
+	)
+	s.checkSequenceWithPolicy(
+		"CRON_TZ=US/Pacific 33 33,44 1 * * * *",
+		enumsspb.SCHEDULE_DAYLIGHT_SAVING_POLICY_RUN_ONCE,
+		time.Date(2021, time.November, 7, 0, 15, 15, 0, pacific),
+		time.Date(2021, time.November, 7, 1, 33, 33, 0, pacific),
+		time.Date(2021, time.November, 7, 1, 44, 33, 0, pacific),
+		time.Date(2021, time.November, 8, 1, 33, 33, 0, pacific),
+	)
+	s.checkSequenceWithPolicy(
+		"CRON_TZ=US/Pacific 33 33,44 1 * * * *",
+		enumsspb.SCHEDULE_DAYLIGHT_SAVING_POLICY_RUN_AT_BOTH,
+		time.Date(2021, time.November, 7, 0, 15, 15, 0, pacific),
+		time.Date(2021, time.November, 7, 1, 33, 33, 0, pacific),
+		time.Date(2021, time.November, 7, 1, 44, 33, 0, pacific),
+		time.Date(2021, time.November, 7, 1, 33, 33, 0, pacific).Add(time.Hour),
+		time.Date(2021, time.November, 7, 1, 44, 33, 0, pacific).Add(time.Hour),
+		time.Date(2021, time.November, 8, 1, 33, 33, 0, pacific),
+	)
+}
+
 func (s *calendarSuite) TestCalendarDSTStartInRepeatedHourButNotEnd() {
 	loc, err := time.LoadLocation("Europe/London")
 	s.NoError(err)
@@ -428,7 +491,7 @@ func FuzzCalendar(f *testing.F) {
 		if err != nil {
 			return
 		}
-		cc := newCompiledCalendar(scs, loc)
+		cc := newCompiledCalendar(scs, loc, enumsspb.SCHEDULE_DAYLIGHT_SAVING_POLICY_UNSPECIFIED)
 		now := time.Unix(start, 0).In(loc)
 		next := cc.next(now)
 		if next.IsZero() {
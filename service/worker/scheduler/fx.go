@@ -25,6 +25,7 @@
 package scheduler
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"time"
@@ -78,6 +79,14 @@ type (
 		Logger         log.Logger
 		HistoryClient  resource.HistoryClient
 		FrontendClient workflowservice.WorkflowServiceClient
+		// AutoPauseNotifier is notified when schedules get paused after consecutive action failures. Optional.
+		AutoPauseNotifier AutoPauseNotifier `optional:"true"`
+	}
+
+	// AutoPauseNotifier is a hook for getting notified when a schedule is paused automatically because of
+	// consecutive failed or timed out actions, e.g. to page its owner.
+	AutoPauseNotifier interface {
+		ScheduleAutoPaused(ctx context.Context, namespace namespace.Name, request *schedulespb.NotifyAutoPauseRequest) error
 	}
 
 	fxResult struct {
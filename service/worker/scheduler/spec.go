@@ -33,6 +33,7 @@ import (
 
 	"github.com/dgryski/go-farm"
 	schedulepb "go.temporal.io/api/schedule/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -77,6 +78,15 @@ func NewSpecBuilder() *SpecBuilder {
 }
 
 func (b *SpecBuilder) NewCompiledSpec(spec *schedulepb.ScheduleSpec) (*CompiledSpec, error) {
+	return b.NewCompiledSpecWithDaylightSavingPolicy(spec, enumsspb.SCHEDULE_DAYLIGHT_SAVING_POLICY_UNSPECIFIED)
+}
+
+// NewCompiledSpecWithDaylightSavingPolicy is like NewCompiledSpec, but calendar specs treat local times affected by
+// daylight saving transitions according to the given policy.
+func (b *SpecBuilder) NewCompiledSpecWithDaylightSavingPolicy(
+	spec *schedulepb.ScheduleSpec,
+	dstPolicy enumsspb.ScheduleDaylightSavingPolicy,
+) (*CompiledSpec, error) {
 	spec, err := canonicalizeSpec(spec)
 	if err != nil {
 		return nil, err
@@ -91,13 +101,13 @@ func (b *SpecBuilder) NewCompiledSpec(spec *schedulepb.ScheduleSpec) (*CompiledS
 	// compile StructuredCalendarSpecs
 	ccs := make([]*compiledCalendar, len(spec.StructuredCalendar))
 	for i, structured := range spec.StructuredCalendar {
-		ccs[i] = newCompiledCalendar(structured, tz)
+		ccs[i] = newCompiledCalendar(structured, tz, dstPolicy)
 	}
 
 	// compile excludes
 	excludes := make([]*compiledCalendar, len(spec.ExcludeStructuredCalendar))
 	for i, excal := range spec.ExcludeStructuredCalendar {
-		excludes[i] = newCompiledCalendar(excal, tz)
+		excludes[i] = newCompiledCalendar(excal, tz, dstPolicy)
 	}
 
 	cspec := &CompiledSpec{
@@ -30,6 +30,7 @@ import (
 	"errors"
 	"fmt"
 	"slices"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
@@ -100,11 +101,18 @@ const (
 	SignalNamePatch    = "patch"
 	SignalNameRefresh  = "refresh"
 	SignalNameForceCAN = "force-continue-as-new"
+	// SignalNameCancelBackfill takes a schedulespb.CancelBackfillRequest.
+	SignalNameCancelBackfill = "cancel-backfill"
+	// SignalNameUpdatePolicies takes a schedulespb.ServerSchedulePolicies.
+	SignalNameUpdatePolicies = "update-policies"
 
 	QueryNameDescribe          = "describe"
 	QueryNameListMatchingTimes = "listMatchingTimes"
 
 	MemoFieldInfo = "ScheduleInfo"
+	// Memo field of DescribeSchedule responses which holds the progress of ongoing backfills, encoded as a
+	// schedulespb.ScheduleBackfills. It is never stored in the memo of the schedule itself.
+	MemoFieldBackfillProgress = "TemporalScheduleBackfillProgress"
 
 	InitialConflictToken = 1
 
@@ -203,6 +211,16 @@ var (
 		},
 	}
 
+	// The auto-pause notification is best effort, don't hold up the schedule for long if it fails.
+	notifyLocalActivityOptions = workflow.LocalActivityOptions{
+		ScheduleToCloseTimeout: 1 * time.Minute,
+		StartToCloseTimeout:    5 * time.Second,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval: 1 * time.Second,
+			MaximumInterval: 10 * time.Second,
+		},
+	}
+
 	// CurrentTweakablePolicies is  a handful of options in a static value and use it as a MutableSideEffect within
 	// the workflow so that we can change them without breaking existing executions or having
 	// to use versioning.
@@ -393,7 +411,7 @@ func (s *scheduler) compileSpec() {
 	s.nextTimeCacheV1 = nil
 	s.nextTimeCacheV2 = nil
 
-	cspec, err := s.specBuilder.NewCompiledSpec(s.Schedule.Spec)
+	cspec, err := s.specBuilder.NewCompiledSpecWithDaylightSavingPolicy(s.Schedule.Spec, s.State.DaylightSavingPolicy)
 	if err != nil {
 		if s.logger != nil {
 			s.logger.Error("Invalid schedule", "error", err)
@@ -436,6 +454,7 @@ func (s *scheduler) processPatch(patch *schedulepb.SchedulePatch) {
 	}
 
 	for _, bfr := range patch.BackfillRequest {
+		requestedStartTime := bfr.GetStartTime()
 		// In previous versions the backfill start time was exclusive, ie when
 		// the start time of the backfill matched the schedule's spec, it would
 		// not be executed. This new version makes it inclusive instead.
@@ -452,6 +471,8 @@ func (s *scheduler) processPatch(patch *schedulepb.SchedulePatch) {
 				continue
 			}
 			s.State.OngoingBackfills = append(s.State.OngoingBackfills, common.CloneProto(bfr))
+			s.syncBackfillProgress()
+			s.State.BackfillProgress[len(s.State.BackfillProgress)-1].StartTime = requestedStartTime
 		} else {
 			// Old version: process whole backfill synchronously
 			s.processTimeRange(
@@ -723,6 +744,12 @@ func (s *scheduler) sleep(nextWakeup time.Time) {
 	forceCAN := workflow.GetSignalChannel(s.ctx, SignalNameForceCAN)
 	sel.AddReceive(forceCAN, s.handleForceCANSignal)
 
+	cancelBackfillCh := workflow.GetSignalChannel(s.ctx, SignalNameCancelBackfill)
+	sel.AddReceive(cancelBackfillCh, s.handleCancelBackfillSignal)
+
+	updatePoliciesCh := workflow.GetSignalChannel(s.ctx, SignalNameUpdatePolicies)
+	sel.AddReceive(updatePoliciesCh, s.handleUpdatePoliciesSignal)
+
 	if s.hasMoreAllowAllBackfills() {
 		// if we have more allow-all backfills to do, do a short sleep and continue
 		nextWakeup = s.now().Add(1 * time.Second)
@@ -775,13 +802,16 @@ func (s *scheduler) sleep(nextWakeup time.Time) {
 func (s *scheduler) processBackfills() {
 	limit := s.tweakables.BackfillsPerIteration
 
+	s.syncBackfillProgress()
 	for len(s.State.OngoingBackfills) > 0 &&
 		limit > 0 &&
 		// use only half the buffer for backfills
 		len(s.State.BufferedStarts) < s.tweakables.MaxBufferSize/2 {
 		bfr := s.State.OngoingBackfills[0]
+		progress := s.State.BackfillProgress[0]
 		startTime := timestamp.TimeValue(bfr.GetStartTime())
 		endTime := timestamp.TimeValue(bfr.GetEndTime())
+		buffered := len(s.State.BufferedStarts)
 		next, _ := s.processTimeRange(
 			startTime,
 			endTime,
@@ -789,14 +819,84 @@ func (s *scheduler) processBackfills() {
 			true,
 			&limit,
 		)
+		// tag the starts added by this backfill so they can be dropped if it gets cancelled
+		for _, start := range s.State.BufferedStarts[buffered:] {
+			start.BackfillId = progress.BackfillId
+		}
+		progress.ActionsStarted += int64(len(s.State.BufferedStarts) - buffered)
 		if next.IsZero() || next.After(endTime) {
 			// done with this one
 			s.State.OngoingBackfills = s.State.OngoingBackfills[1:]
+			s.State.BackfillProgress = s.State.BackfillProgress[1:]
 		} else {
 			// adjust start time for next iteration
 			bfr.StartTime = timestamppb.New(next)
+			progress.CompletedThrough = bfr.StartTime
+		}
+	}
+}
+
+// syncBackfillProgress makes sure that every ongoing backfill has a progress entry. Backfills requested before
+// progress was tracked are only tracked from this point on.
+func (s *scheduler) syncBackfillProgress() {
+	if len(s.State.BackfillProgress) > len(s.State.OngoingBackfills) {
+		s.State.BackfillProgress = nil
+	}
+	for _, bfr := range s.State.OngoingBackfills[len(s.State.BackfillProgress):] {
+		s.State.LastBackfillId++
+		s.State.BackfillProgress = append(s.State.BackfillProgress, &schedulespb.BackfillProgress{
+			BackfillId:       strconv.FormatInt(s.State.LastBackfillId, 10),
+			StartTime:        bfr.GetStartTime(),
+			EndTime:          bfr.GetEndTime(),
+			CompletedThrough: bfr.GetStartTime(),
+		})
+	}
+}
+
+func (s *scheduler) handleCancelBackfillSignal(ch workflow.ReceiveChannel, _ bool) {
+	var req *schedulespb.CancelBackfillRequest
+	ch.Receive(s.ctx, &req)
+	s.logger.Debug("got cancel-backfill signal", "backfill-id", req.GetBackfillId())
+
+	s.syncBackfillProgress()
+	cancelled := make(map[string]struct{})
+	ongoing := s.State.OngoingBackfills[:0]
+	progress := s.State.BackfillProgress[:0]
+	for i, bfr := range s.State.OngoingBackfills {
+		id := s.State.BackfillProgress[i].GetBackfillId()
+		if req.GetBackfillId() == "" || req.GetBackfillId() == id {
+			cancelled[id] = struct{}{}
+			continue
 		}
+		ongoing = append(ongoing, bfr)
+		progress = append(progress, s.State.BackfillProgress[i])
+	}
+	if len(cancelled) == 0 {
+		s.logger.Warn("backfill to cancel not found", "backfill-id", req.GetBackfillId())
+		return
+	}
+	s.State.OngoingBackfills = ongoing
+	s.State.BackfillProgress = progress
+	// drop the starts the cancelled backfills buffered but didn't take yet
+	s.State.BufferedStarts = slices.DeleteFunc(s.State.BufferedStarts, func(start *schedulespb.BufferedStart) bool {
+		_, ok := cancelled[start.GetBackfillId()]
+		return ok
+	})
+	s.incSeqNo()
+}
+
+func (s *scheduler) handleUpdatePoliciesSignal(ch workflow.ReceiveChannel, _ bool) {
+	var policies *schedulespb.ServerSchedulePolicies
+	ch.Receive(s.ctx, &policies)
+	s.logger.Debug("got update-policies signal")
+
+	s.State.PauseAfterConsecutiveFailures = policies.GetPauseAfterConsecutiveFailures()
+	if s.State.DaylightSavingPolicy != policies.GetDaylightSavingPolicy() {
+		s.State.DaylightSavingPolicy = policies.GetDaylightSavingPolicy()
+		// the next wakeup is computed from the recompiled spec
+		s.compileSpec()
 	}
+	s.incSeqNo()
 }
 
 func (s *scheduler) hasMoreAllowAllBackfills() bool {
@@ -866,6 +966,9 @@ func (s *scheduler) processWatcherResult(id string, f workflow.Future, long bool
 		s.incSeqNo()
 	}
 
+	// handle auto-pause after consecutive failures
+	autoPause := s.processConsecutiveFailures(id, &res, failedStatus)
+
 	// handle last completion/failure
 	if res.GetResult() != nil {
 		s.State.LastCompletionResult = res.GetResult()
@@ -880,7 +983,53 @@ func (s *scheduler) processWatcherResult(id string, f workflow.Future, long bool
 		s.State.BufferedStarts[0].DesiredTime = res.CloseTime
 	}
 
-	s.logger.Debug("started workflow finished", "workflow", id, "status", res.Status, "pause-after-failure", pauseOnFailure, "long", long)
+	s.logger.Debug("started workflow finished", "workflow", id, "status", res.Status, "pause-after-failure", pauseOnFailure, "auto-pause", autoPause, "long", long)
+}
+
+// processConsecutiveFailures tracks the number of consecutive failed actions and pauses the schedule once it reaches
+// the configured limit. Returns true if the schedule was paused.
+func (s *scheduler) processConsecutiveFailures(id string, res *schedulespb.WatchWorkflowResponse, failedStatus bool) bool {
+	limit := s.State.PauseAfterConsecutiveFailures
+	if limit <= 0 {
+		return false
+	}
+	if !failedStatus {
+		s.State.ConsecutiveFailures = 0
+		return false
+	}
+	s.State.ConsecutiveFailures++
+	if s.State.ConsecutiveFailures < limit || s.Schedule.State.Paused {
+		return false
+	}
+
+	failures := s.State.ConsecutiveFailures
+	// start counting from scratch when the schedule gets unpaused
+	s.State.ConsecutiveFailures = 0
+	s.Schedule.State.Paused = true
+	if res.Status == enumspb.WORKFLOW_EXECUTION_STATUS_FAILED {
+		s.Schedule.State.Notes = fmt.Sprintf("paused after %d consecutive failures, last workflow failure: %s: %s", failures, id, res.GetFailure().GetMessage())
+	} else {
+		s.Schedule.State.Notes = fmt.Sprintf("paused after %d consecutive failures, last workflow %s: %s", failures, res.Status, id)
+	}
+	s.logger.Debug("paused after consecutive failures", "workflow", id, "failures", failures)
+	s.metrics.Counter(metrics.ScheduleAutoPaused.Name()).Inc(1)
+	s.incSeqNo()
+	s.notifyAutoPause(s.Schedule.State.Notes, failures)
+	return true
+}
+
+func (s *scheduler) notifyAutoPause(reason string, failures int32) {
+	ctx := workflow.WithLocalActivityOptions(s.ctx, notifyLocalActivityOptions)
+	req := &schedulespb.NotifyAutoPauseRequest{
+		ScheduleId:          s.State.ScheduleId,
+		Reason:              reason,
+		ConsecutiveFailures: failures,
+	}
+	err := workflow.ExecuteLocalActivity(ctx, s.a.NotifyAutoPause, req).Get(s.ctx, nil)
+	if err != nil {
+		// the schedule is paused either way, the notification is best effort
+		s.logger.Error("auto-pause notification failed", "error", err)
+	}
 }
 
 func (s *scheduler) processUpdate(req *schedulespb.FullUpdateRequest) {
@@ -1003,6 +1152,7 @@ func (s *scheduler) handleDescribeQuery() (*schedulespb.DescribeResponse, error)
 		Schedule:      s.Schedule,
 		Info:          infoCopy,
 		ConflictToken: s.State.ConflictToken,
+		Backfills:     &schedulespb.ScheduleBackfills{Ongoing: s.State.BackfillProgress},
 	}, nil
 }
 
@@ -27,6 +27,7 @@ package scheduler
 import (
 	"context"
 	"errors"
+	"fmt"
 	"math/rand"
 	"testing"
 	"time"
@@ -43,6 +44,7 @@ import (
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/sdk/testsuite"
 	"go.temporal.io/sdk/workflow"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	schedulespb "go.temporal.io/server/api/schedule/v1"
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
@@ -1136,6 +1138,132 @@ func (s *workflowSuite) TestPauseOnFailure() {
 	// doesn't end properly since it sleeps forever after pausing
 }
 
+func (s *workflowSuite) TestPauseAfterConsecutiveFailures() {
+	// written using low-level mocks so we can return failures
+
+	for _, minute := range []int{5, 10, 15} {
+		id := fmt.Sprintf("myid-2022-06-01T00:%02d:00Z", minute)
+		status := enumspb.WORKFLOW_EXECUTION_STATUS_FAILED
+		if minute == 5 {
+			// a success in between resets the count
+			status = enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
+		}
+		s.expectStart(func(req *schedulespb.StartWorkflowRequest) (*schedulespb.StartWorkflowResponse, error) {
+			s.Equal(id, req.Request.WorkflowId)
+			return nil, nil
+		})
+		s.expectWatch(func(req *schedulespb.WatchWorkflowRequest) (*schedulespb.WatchWorkflowResponse, error) {
+			s.Equal(id, req.Execution.WorkflowId)
+			return &schedulespb.WatchWorkflowResponse{
+				Status: status,
+				ResultFailure: &schedulespb.WatchWorkflowResponse_Failure{
+					Failure: &failurepb.Failure{Message: "oops"},
+				},
+			}, nil
+		})
+	}
+	s.env.OnActivity(new(activities).NotifyAutoPause, mock.Anything, mock.Anything).Once().Return(
+		func(_ context.Context, req *schedulespb.NotifyAutoPauseRequest) error {
+			s.Equal("myschedule", req.ScheduleId)
+			s.Equal(int32(2), req.ConsecutiveFailures)
+			s.Contains(req.Reason, "myid-2022-06-01T00:15:00Z")
+			return nil
+		})
+	schedule := &schedulepb.Schedule{
+		Spec: &schedulepb.ScheduleSpec{
+			Interval: []*schedulepb.IntervalSpec{{
+				Interval: durationpb.New(5 * time.Minute),
+			}},
+		},
+		Action: s.defaultAction("myid"),
+	}
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalNameUpdatePolicies, &schedulespb.ServerSchedulePolicies{
+			PauseAfterConsecutiveFailures: 2,
+		})
+	}, 1*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		// the policy is kept by schedule updates
+		s.env.SignalWorkflow(SignalNameUpdate, &schedulespb.FullUpdateRequest{
+			ConflictToken: s.describe().ConflictToken,
+			Schedule:      schedule,
+		})
+	}, 2*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		s.False(s.describe().Schedule.State.Paused)
+	}, 19*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.True(desc.Schedule.State.Paused)
+		s.Contains(desc.Schedule.State.Notes, "paused after 2 consecutive failures")
+		s.Contains(desc.Schedule.State.Notes, "oops")
+	}, 21*time.Minute)
+
+	s.env.SetStartTime(baseStartTime)
+	CurrentTweakablePolicies.IterationsBeforeContinueAsNew = 10
+	s.env.ExecuteWorkflow(SchedulerWorkflow, &schedulespb.StartScheduleArgs{
+		Schedule: schedule,
+		State: &schedulespb.InternalState{
+			Namespace:     "myns",
+			NamespaceId:   "mynsid",
+			ScheduleId:    "myschedule",
+			ConflictToken: InitialConflictToken,
+		},
+	})
+	s.True(s.env.IsWorkflowCompleted())
+	// doesn't end properly since it sleeps forever after pausing
+}
+
+func (s *workflowSuite) TestUpdateDaylightSavingPolicy() {
+	// written using low-level mocks since it sleeps forever
+
+	pacific, err := time.LoadLocation("US/Pacific")
+	s.NoError(err)
+	// nov 7 2021 has two 1:33s
+	countNov7 := func() int {
+		count := 0
+		for _, ts := range s.describe().Info.FutureActionTimes {
+			if ts.AsTime().In(pacific).Day() == 7 {
+				count++
+			}
+		}
+		return count
+	}
+	s.env.RegisterDelayedCallback(func() {
+		s.Equal(2, countNov7())
+		s.env.SignalWorkflow(SignalNameUpdatePolicies, &schedulespb.ServerSchedulePolicies{
+			DaylightSavingPolicy: enumsspb.SCHEDULE_DAYLIGHT_SAVING_POLICY_RUN_ONCE,
+		})
+	}, 1*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.Equal(int64(InitialConflictToken+1), desc.ConflictToken)
+		s.Equal(1, countNov7())
+	}, 2*time.Minute)
+
+	s.env.SetStartTime(time.Date(2021, time.November, 6, 12, 0, 0, 0, pacific))
+	s.env.ExecuteWorkflow(SchedulerWorkflow, &schedulespb.StartScheduleArgs{
+		Schedule: &schedulepb.Schedule{
+			Spec: &schedulepb.ScheduleSpec{
+				Calendar: []*schedulepb.CalendarSpec{{
+					Hour:   "1",
+					Minute: "33",
+				}},
+				TimezoneName: "US/Pacific",
+			},
+			Action: s.defaultAction("myid"),
+			State:  &schedulepb.ScheduleState{Paused: true},
+		},
+		State: &schedulespb.InternalState{
+			Namespace:     "myns",
+			NamespaceId:   "mynsid",
+			ScheduleId:    "myschedule",
+			ConflictToken: InitialConflictToken,
+		},
+	})
+	// doesn't end properly since it sleeps forever
+}
+
 func (s *workflowSuite) TestCompileError() {
 	// written using low-level mocks since it sleeps forever
 
@@ -1469,6 +1597,81 @@ func (s *workflowSuite) TestHugeBackfillBuffer() {
 	)
 }
 
+func (s *workflowSuite) TestBackfillProgressAndCancel() {
+	prevTweakables := CurrentTweakablePolicies
+	CurrentTweakablePolicies.MaxBufferSize = 6 // make smaller so the backfill takes several iterations
+	defer func() { CurrentTweakablePolicies = prevTweakables }()
+
+	const backfillRuns = 10
+	const cancelledAfter = 4
+
+	base := time.Date(2001, 8, 6, 0, 0, 0, 0, time.UTC)
+	backfillStart := baseStartTime.Add(time.Minute)
+	duration := 56 * time.Second
+	runs := make([]workflowRun, cancelledAfter)
+	for i := range runs {
+		t := base.Add(time.Duration(i) * time.Hour)
+		actual := backfillStart.Add(time.Duration(i) * duration)
+		runs[i] = workflowRun{
+			id:     "myid-" + t.Format(time.RFC3339),
+			start:  actual,
+			end:    actual.Add(duration),
+			result: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		}
+	}
+	cancelTime := backfillStart.Add(time.Duration(cancelledAfter-1) * duration).Add(30 * time.Second)
+
+	s.runAcrossContinue(
+		runs,
+		[]delayedCallback{
+			{
+				at: backfillStart,
+				f: func() {
+					s.env.SignalWorkflow(SignalNamePatch, &schedulepb.SchedulePatch{
+						BackfillRequest: []*schedulepb.BackfillRequest{{
+							StartTime:     timestamppb.New(base),
+							EndTime:       timestamppb.New(base.Add((backfillRuns - 1) * time.Hour)),
+							OverlapPolicy: enumspb.SCHEDULE_OVERLAP_POLICY_BUFFER_ALL,
+						}},
+					})
+				},
+			},
+			{
+				at: cancelTime,
+				f: func() {
+					backfills := s.describe().GetBackfills().GetOngoing()
+					s.Require().Len(backfills, 1)
+					s.NotEmpty(backfills[0].GetBackfillId())
+					s.Equal(base, backfills[0].GetStartTime().AsTime())
+					s.Equal(base.Add((backfillRuns-1)*time.Hour), backfills[0].GetEndTime().AsTime())
+					s.True(backfills[0].GetCompletedThrough().AsTime().After(base))
+					s.Greater(backfills[0].GetActionsStarted(), int64(cancelledAfter))
+					s.env.SignalWorkflow(SignalNameCancelBackfill, &schedulespb.CancelBackfillRequest{
+						BackfillId: backfills[0].GetBackfillId(),
+					})
+				},
+			},
+			{
+				at: cancelTime.Add(1113 * time.Millisecond),
+				f: func() {
+					desc := s.describe()
+					s.Empty(desc.GetBackfills().GetOngoing())
+					s.Zero(desc.GetInfo().GetBufferSize())
+				},
+			},
+			{
+				at:         baseStartTime.Add(50 * time.Minute),
+				finishTest: true,
+			},
+		},
+		&schedulepb.Schedule{
+			Spec: &schedulepb.ScheduleSpec{
+				Interval: []*schedulepb.IntervalSpec{{Interval: durationpb.New(time.Hour)}},
+			},
+		},
+	)
+}
+
 func (s *workflowSuite) TestPause() {
 	s.runAcrossContinue(
 		[]workflowRun{
@@ -201,11 +201,23 @@ func NewConfig(
 			ExecutionsScannerEnabled:                dynamicconfig.ExecutionsScannerEnabled.Get(dc),
 			HistoryScannerDataMinAge:                dynamicconfig.HistoryScannerDataMinAge.Get(dc),
 			HistoryScannerVerifyRetention:           dynamicconfig.HistoryScannerVerifyRetention.Get(dc),
+			HistoryScannerDryRun:                    dynamicconfig.HistoryScannerDryRun.Get(dc),
+			HistoryScannerDeleteUnreferenced:        dynamicconfig.HistoryScannerDeleteUnreferenced.Get(dc),
 			ExecutionScannerPerHostQPS:              dynamicconfig.ExecutionScannerPerHostQPS.Get(dc),
 			ExecutionScannerPerShardQPS:             dynamicconfig.ExecutionScannerPerShardQPS.Get(dc),
 			ExecutionDataDurationBuffer:             dynamicconfig.ExecutionDataDurationBuffer.Get(dc),
 			ExecutionScannerWorkerCount:             dynamicconfig.ExecutionScannerWorkerCount.Get(dc),
 			ExecutionScannerHistoryEventIdValidator: dynamicconfig.ExecutionScannerHistoryEventIdValidator.Get(dc),
+			ExecutionScannerOrphanedChildValidator:  dynamicconfig.ExecutionScannerOrphanedChildValidator.Get(dc),
+			ExecutionScannerRepairOrphanedChildren:  dynamicconfig.ExecutionScannerRepairOrphanedChildren.Get(dc),
+			ExecutionScannerStuckWorkflowValidator:  dynamicconfig.ExecutionScannerStuckWorkflowValidator.Get(dc),
+			ExecutionScannerStuckWorkflowAutoReset:  dynamicconfig.ExecutionScannerStuckWorkflowAutoReset.Get(dc),
+			ExecutionScannerStuckWorkflowMaxResets:  dynamicconfig.ExecutionScannerStuckWorkflowMaxResets.Get(dc),
+			StuckWorkflowTaskFailureThreshold:       dynamicconfig.StuckWorkflowTaskFailureThreshold.Get(dc),
+			StuckWorkflowTaskMinDuration:            dynamicconfig.StuckWorkflowTaskMinDuration.Get(dc),
+			ExecutionScannerZombieValidator:         dynamicconfig.ExecutionScannerZombieValidator.Get(dc),
+			ExecutionScannerRepairZombies:           dynamicconfig.ExecutionScannerRepairZombies.Get(dc),
+			ExecutionScannerZombieGracePeriod:       dynamicconfig.ExecutionScannerZombieGracePeriod.Get(dc),
 			RemovableBuildIdDurationSinceDefault:    dynamicconfig.RemovableBuildIdDurationSinceDefault.Get(dc),
 			BuildIdScavengerVisibilityRPS:           dynamicconfig.BuildIdScavengerVisibilityRPS.Get(dc),
 		},
@@ -57,6 +57,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/cassandra"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
+	"go.temporal.io/server/common/persistence/payloadencryption"
 	"go.temporal.io/server/common/persistence/sql"
 	"go.temporal.io/server/common/persistence/visibility"
 	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
@@ -124,6 +125,7 @@ type (
 		ServiceResolver        resolver.ServiceResolver
 		CustomDataStoreFactory persistenceClient.AbstractDataStoreFactory
 		CustomVisibilityStore  visibility.VisibilityStoreFactory
+		PayloadKeyProvider     payloadencryption.KeyProvider
 
 		SearchAttributesMapper     searchattribute.Mapper
 		CustomFrontendInterceptors []grpc.UnaryServerInterceptor
@@ -220,19 +222,27 @@ func ServerOptionsProvider(opts []ServerOption) (serverOptionsProvider, error) {
 	// DynamicConfigClient
 	dcClient := so.dynamicConfigClient
 	if dcClient == nil {
-		dcConfig := so.config.DynamicConfigClient
-		if dcConfig != nil {
-			dcClient, err = dynamicconfig.NewFileBasedClient(dcConfig, logger, stopChan)
-			if err != nil {
-				return serverOptionsProvider{}, fmt.Errorf("unable to create dynamic config client: %w", err)
-			}
-		} else {
+		switch {
+		case so.config.DynamicConfigClient != nil:
+			dcClient, err = dynamicconfig.NewFileBasedClient(so.config.DynamicConfigClient, logger, stopChan)
+		case so.config.DynamicConfigEtcd != nil:
+			dcClient, err = dynamicconfig.NewEtcdClient(so.config.DynamicConfigEtcd, logger, stopChan)
+		case so.config.DynamicConfigConsul != nil:
+			dcClient, err = dynamicconfig.NewConsulClient(so.config.DynamicConfigConsul, logger, stopChan)
+		default:
 			// noop client
 			logger.Info("Dynamic config client is not configured. Using default values.")
 			dcClient = dynamicconfig.NewNoopClient()
 		}
+		if err != nil {
+			return serverOptionsProvider{}, fmt.Errorf("unable to create dynamic config client: %w", err)
+		}
 	}
 
+	// Payloads are removed from logs unless the namespace opted out. The collection is only used for reads here,
+	// which don't require it to be started.
+	logger = log.NewRedactLogger(logger, dynamicconfig.RedactPayloads.Get(dynamicconfig.NewCollection(dcClient, logger)))
+
 	// TLSConfigProvider
 	tlsConfigProvider := so.tlsConfigProvider
 	if tlsConfigProvider == nil {
@@ -298,6 +308,7 @@ func ServerOptionsProvider(opts []ServerOption) (serverOptionsProvider, error) {
 		ServiceResolver:        so.persistenceServiceResolver,
 		CustomDataStoreFactory: so.customDataStoreFactory,
 		CustomVisibilityStore:  so.customVisibilityStoreFactory,
+		PayloadKeyProvider:     so.payloadKeyProvider,
 
 		SearchAttributesMapper:     so.searchAttributesMapper,
 		CustomFrontendInterceptors: so.customFrontendInterceptors,
@@ -372,6 +383,7 @@ type (
 		ClaimMapper                authorization.ClaimMapper
 		DataStoreFactory           persistenceClient.AbstractDataStoreFactory
 		VisibilityStoreFactory     visibility.VisibilityStoreFactory
+		PayloadKeyProvider         payloadencryption.KeyProvider
 		SpanExporters              []otelsdktrace.SpanExporter
 		InstanceID                 resource.InstanceID                     `optional:"true"`
 		StaticServiceHosts         map[primitives.ServiceName]static.Hosts `optional:"true"`
@@ -410,6 +422,9 @@ func (params ServiceProviderParamsCommon) GetCommonServiceOptions(serviceName pr
 			func() visibility.VisibilityStoreFactory {
 				return params.VisibilityStoreFactory
 			},
+			func() payloadencryption.KeyProvider {
+				return params.PayloadKeyProvider
+			},
 			func() client.FactoryProvider {
 				return params.ClientFactoryProvider
 			},
@@ -610,6 +625,7 @@ func ApplyClusterMetadataConfigProvider(
 		customDataStoreFactory,
 		logger,
 		metricsHandler,
+		nil,
 	)
 	factory := persistenceFactoryProvider(persistenceClient.NewFactoryParams{
 		DataStoreFactory:           dataStoreFactory,
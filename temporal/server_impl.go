@@ -178,6 +178,7 @@ func initSystemNamespaces(
 		customDataStoreFactory,
 		logger,
 		metricsHandler,
+		nil,
 	)
 	factory := persistenceFactoryProvider(persistenceClient.NewFactoryParams{
 		DataStoreFactory:           dataStoreFactory,
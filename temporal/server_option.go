@@ -35,6 +35,7 @@ import (
 	"go.temporal.io/server/common/membership/static"
 	"go.temporal.io/server/common/metrics"
 	persistenceclient "go.temporal.io/server/common/persistence/client"
+	"go.temporal.io/server/common/persistence/payloadencryption"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/resolver"
@@ -171,6 +172,18 @@ func WithCustomVisibilityStoreFactory(customFactory visibility.VisibilityStoreFa
 	})
 }
 
+// WithPayloadKeyProvider enables the encryption of the payloads of history events, including buffered events, and of
+// the memo of executions, in mutable state and visibility, with per-namespace keys provided by the given provider, e.g.
+// backed by a KMS. Search attributes and other payloads stored in mutable state, e.g. heartbeat details, are not
+// encrypted. Encrypted payloads are bound to their namespace, and the frontend rejects client payloads carrying the
+// metadata reserved for them.
+// NOTE: this option is experimental and may be changed or removed in future release.
+func WithPayloadKeyProvider(keyProvider payloadencryption.KeyProvider) ServerOption {
+	return applyFunc(func(s *serverOptions) {
+		s.payloadKeyProvider = keyProvider
+	})
+}
+
 // WithClientFactoryProvider sets a custom ClientFactoryProvider
 // NOTE: this option is experimental and may be changed or removed in future release.
 func WithClientFactoryProvider(clientFactoryProvider client.FactoryProvider) ServerOption {
@@ -37,6 +37,7 @@ import (
 	"go.temporal.io/server/common/membership/static"
 	"go.temporal.io/server/common/metrics"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
+	"go.temporal.io/server/common/persistence/payloadencryption"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/resolver"
@@ -73,6 +74,7 @@ type (
 		dynamicConfigClient          dynamicconfig.Client
 		customDataStoreFactory       persistenceClient.AbstractDataStoreFactory
 		customVisibilityStoreFactory visibility.VisibilityStoreFactory
+		payloadKeyProvider           payloadencryption.KeyProvider
 		clientFactoryProvider        client.FactoryProvider
 		searchAttributesMapper       searchattribute.Mapper
 		customFrontendInterceptors   []grpc.UnaryServerInterceptor
@@ -0,0 +1,157 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/urfave/cli/v2"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+)
+
+// batchProgressPollInterval is how often the progress of a batch operation is reported while waiting for it.
+var batchProgressPollInterval = 5 * time.Second
+
+// AdminRefreshWorkflowTasksBatch starts a batch operation which refreshes the tasks of the workflows matching a
+// query or listed in a file, and optionally waits for it to complete while reporting its progress.
+func AdminRefreshWorkflowTasksBatch(c *cli.Context, clientFactory ClientFactory) error {
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	reason, err := getRequiredOption(c, FlagReason)
+	if err != nil {
+		return err
+	}
+	query := c.String(FlagQuery)
+	inputFile := c.String(FlagInputFilename)
+	if (query == "") == (inputFile == "") {
+		return fmt.Errorf("exactly one of --%s and --%s must be provided", FlagQuery, FlagInputFilename)
+	}
+	var executions []*commonpb.WorkflowExecution
+	if inputFile != "" {
+		if executions, err = readWorkflowExecutions(inputFile); err != nil {
+			return err
+		}
+	}
+	jobID := c.String(FlagJobID)
+	if jobID == "" {
+		jobID = uuid.NewString()
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err = clientFactory.AdminClient(c).StartRefreshWorkflowTasksBatchOperation(ctx, &adminservice.StartRefreshWorkflowTasksBatchOperationRequest{
+		Namespace:              nsName,
+		JobId:                  jobID,
+		Reason:                 reason,
+		Identity:               "tdbg",
+		VisibilityQuery:        query,
+		Executions:             executions,
+		MaxOperationsPerSecond: float32(c.Float64(FlagRPS)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to start refresh tasks batch operation: %w", err)
+	}
+	fmt.Fprintf(c.App.Writer, "Started batch operation %s\n", jobID)
+
+	if !c.Bool(FlagWait) {
+		return nil
+	}
+	return waitForBatchOperation(c, clientFactory, nsName, jobID)
+}
+
+// waitForBatchOperation reports the progress of a batch operation until it isn't running anymore.
+func waitForBatchOperation(c *cli.Context, clientFactory ClientFactory, nsName string, jobID string) error {
+	wfClient := clientFactory.WorkflowClient(c)
+	for {
+		ctx, cancel := newContext(c)
+		resp, err := wfClient.DescribeBatchOperation(ctx, &workflowservice.DescribeBatchOperationRequest{
+			Namespace: nsName,
+			JobId:     jobID,
+		})
+		cancel()
+		if err != nil {
+			return fmt.Errorf("unable to describe batch operation: %w", err)
+		}
+
+		fmt.Fprintf(c.App.Writer, "%s: %d/%d executions done, %d failed\n",
+			resp.GetState(),
+			resp.GetCompleteOperationCount()+resp.GetFailureOperationCount(),
+			resp.GetTotalOperationCount(),
+			resp.GetFailureOperationCount(),
+		)
+		switch resp.GetState() {
+		case enumspb.BATCH_OPERATION_STATE_RUNNING, enumspb.BATCH_OPERATION_STATE_UNSPECIFIED:
+		case enumspb.BATCH_OPERATION_STATE_COMPLETED:
+			return nil
+		default:
+			return fmt.Errorf("batch operation %s ended in state %s", jobID, resp.GetState())
+		}
+
+		select {
+		case <-c.Context.Done():
+			return c.Context.Err()
+		case <-time.After(batchProgressPollInterval):
+		}
+	}
+}
+
+// readWorkflowExecutions reads executions from a file with one execution per line, given as a workflow ID
+// optionally followed by a run ID. Empty lines and lines starting with # are ignored.
+func readWorkflowExecutions(filename string) ([]*commonpb.WorkflowExecution, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open input file: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	var executions []*commonpb.WorkflowExecution
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		if len(fields) > 2 {
+			return nil, fmt.Errorf("invalid execution on line %d, expected a workflow ID and an optional run ID", line)
+		}
+		execution := &commonpb.WorkflowExecution{WorkflowId: fields[0]}
+		if len(fields) == 2 {
+			execution.RunId = fields[1]
+		}
+		executions = append(executions, execution)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read input file: %w", err)
+	}
+	return executions, nil
+}
@@ -0,0 +1,151 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	"google.golang.org/grpc"
+)
+
+type (
+	batchAdminClient struct {
+		adminservice.AdminServiceClient
+
+		refreshRequests []*adminservice.StartRefreshWorkflowTasksBatchOperationRequest
+		workflowClient  *batchWorkflowClient
+	}
+
+	batchWorkflowClient struct {
+		workflowservice.WorkflowServiceClient
+
+		describeResponses []*workflowservice.DescribeBatchOperationResponse
+	}
+)
+
+func (b *batchAdminClient) AdminClient(*cli.Context) adminservice.AdminServiceClient {
+	return b
+}
+
+func (b *batchAdminClient) WorkflowClient(*cli.Context) workflowservice.WorkflowServiceClient {
+	return b.workflowClient
+}
+
+func (b *batchAdminClient) StartRefreshWorkflowTasksBatchOperation(
+	_ context.Context,
+	request *adminservice.StartRefreshWorkflowTasksBatchOperationRequest,
+	_ ...grpc.CallOption,
+) (*adminservice.StartRefreshWorkflowTasksBatchOperationResponse, error) {
+	b.refreshRequests = append(b.refreshRequests, request)
+	return &adminservice.StartRefreshWorkflowTasksBatchOperationResponse{}, nil
+}
+
+func (b *batchWorkflowClient) DescribeBatchOperation(
+	context.Context,
+	*workflowservice.DescribeBatchOperationRequest,
+	...grpc.CallOption,
+) (*workflowservice.DescribeBatchOperationResponse, error) {
+	resp := b.describeResponses[0]
+	b.describeResponses = b.describeResponses[1:]
+	return resp, nil
+}
+
+func runBatchCommand(client *batchAdminClient, args ...string) (string, error) {
+	var out bytes.Buffer
+	app := NewCliApp(func(params *Params) {
+		params.ClientFactory = client
+	})
+	app.Writer = &out
+	app.ExitErrHandler = func(*cli.Context, error) {}
+	err := app.Run(append([]string{"tdbg", "--namespace", "test-namespace", "workflow", "refresh-tasks-batch"}, args...))
+	return out.String(), err
+}
+
+func TestRefreshWorkflowTasksBatch_InputFile(t *testing.T) {
+	inputFile := filepath.Join(t.TempDir(), "executions")
+	require.NoError(t, os.WriteFile(inputFile, []byte("# lost tasks\nworkflow-1\n\nworkflow-2 run-2\n"), 0644))
+	client := &batchAdminClient{}
+
+	out, err := runBatchCommand(client,
+		"--input-filename", inputFile,
+		"--reason", "recover lost tasks",
+		"--job-id", "job-id",
+		"--rps", "10",
+	)
+	require.NoError(t, err)
+	assert.Contains(t, out, "Started batch operation job-id")
+
+	require.Len(t, client.refreshRequests, 1)
+	request := client.refreshRequests[0]
+	assert.Equal(t, "test-namespace", request.GetNamespace())
+	assert.Equal(t, "job-id", request.GetJobId())
+	assert.Equal(t, float32(10), request.GetMaxOperationsPerSecond())
+	assert.Empty(t, request.GetVisibilityQuery())
+	require.Len(t, request.GetExecutions(), 2)
+	assert.Equal(t, "workflow-1", request.GetExecutions()[0].GetWorkflowId())
+	assert.Empty(t, request.GetExecutions()[0].GetRunId())
+	assert.Equal(t, "run-2", request.GetExecutions()[1].GetRunId())
+}
+
+func TestRefreshWorkflowTasksBatch_QueryAndWait(t *testing.T) {
+	pollInterval := batchProgressPollInterval
+	batchProgressPollInterval = time.Millisecond
+	defer func() { batchProgressPollInterval = pollInterval }()
+
+	client := &batchAdminClient{
+		workflowClient: &batchWorkflowClient{
+			describeResponses: []*workflowservice.DescribeBatchOperationResponse{
+				{State: enumspb.BATCH_OPERATION_STATE_RUNNING, TotalOperationCount: 10, CompleteOperationCount: 4},
+				{State: enumspb.BATCH_OPERATION_STATE_COMPLETED, TotalOperationCount: 10, CompleteOperationCount: 9, FailureOperationCount: 1},
+			},
+		},
+	}
+
+	out, err := runBatchCommand(client,
+		"--query", "WorkflowType = 'lost-tasks'",
+		"--reason", "recover lost tasks",
+		"--wait",
+	)
+	require.NoError(t, err)
+	require.Len(t, client.refreshRequests, 1)
+	assert.Equal(t, "WorkflowType = 'lost-tasks'", client.refreshRequests[0].GetVisibilityQuery())
+	assert.NotEmpty(t, client.refreshRequests[0].GetJobId())
+	assert.Contains(t, out, "Running: 4/10 executions done, 0 failed")
+	assert.Contains(t, out, "Completed: 10/10 executions done, 1 failed")
+}
+
+func TestRefreshWorkflowTasksBatch_QueryOrInputFile(t *testing.T) {
+	_, err := runBatchCommand(&batchAdminClient{}, "--reason", "recover lost tasks")
+	assert.ErrorContains(t, err, "exactly one of --query and --input-filename")
+}
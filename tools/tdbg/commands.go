@@ -25,6 +25,7 @@
 package tdbg
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -49,6 +50,7 @@ import (
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/service/history/tasks"
 	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -110,6 +112,13 @@ func AdminShowWorkflow(c *cli.Context, clientFactory ClientFactory) error {
 		token = resp.NextPageToken
 	}
 
+	var decoder *payloadDecoder
+	if !c.Bool(FlagRaw) {
+		if decoder, err = newPayloadDecoderFromFlags(c); err != nil {
+			return err
+		}
+	}
+
 	var historyBatches []*historypb.History
 	totalSize := 0
 	var errs []error
@@ -124,6 +133,17 @@ func AdminShowWorkflow(c *cli.Context, clientFactory ClientFactory) error {
 			continue
 		}
 		historyBatches = append(historyBatches, &historypb.History{Events: historyBatch})
+		if decoder != nil {
+			// the output file is written as stored, only the printed events are decoded
+			decoded := proto.Clone(historyBatches[len(historyBatches)-1])
+			if err := decoder.Decode(decoded); err != nil {
+				return err
+			}
+			if err := printDecodedMessage(c.App.Writer, decoded); err != nil {
+				return err
+			}
+			continue
+		}
 		encoder := codec.NewJSONPBEncoder()
 		data, err := encoder.EncodeHistoryEvents(historyBatch)
 		if err != nil {
@@ -179,9 +199,6 @@ func AdminImportWorkflow(c *cli.Context, clientFactory ClientFactory) error {
 
 	serializer := serialization.NewSerializer()
 
-	ctx, cancel := newContext(c)
-	defer cancel()
-
 	data, err := os.ReadFile(inputFileName)
 	if err != nil {
 		return fmt.Errorf("unable to read History data file: %s", err)
@@ -202,32 +219,62 @@ func AdminImportWorkflow(c *cli.Context, clientFactory ClientFactory) error {
 		}
 	}
 
+	blobs := make([]*commonpb.DataBlob, 0, len(historyBatches))
+	for _, historyBatch := range historyBatches {
+		blob, err := serializer.SerializeEvents(historyBatch.Events, enumspb.ENCODING_TYPE_PROTO3)
+		if err != nil {
+			return fmt.Errorf("unable to deserialize Events: %s", err)
+		}
+		blobs = append(blobs, blob)
+	}
+	return importWorkflowExecution(
+		c.Context,
+		client,
+		getContextTimeout(c, defaultContextTimeout),
+		nsName,
+		&commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		versionHistory,
+		blobs,
+		nil,
+	)
+}
+
+// importWorkflowExecution sends the serialized history batches of an execution in pages and commits the import, each
+// request with its own timeout. If mutableState is set, it replaces the state rebuilt from the history on commit.
+func importWorkflowExecution(
+	ctx context.Context,
+	client adminservice.AdminServiceClient,
+	rpcTimeout time.Duration,
+	nsName string,
+	execution *commonpb.WorkflowExecution,
+	versionHistory *historyspb.VersionHistory,
+	historyBatches []*commonpb.DataBlob,
+	mutableState *persistencespb.WorkflowMutableState,
+) error {
 	var token []byte
 	var blobs []*commonpb.DataBlob
 	blobSize := 0
 	for i := 0; i < len(historyBatches)+1; i++ {
 		if i < len(historyBatches) {
-			historyBatch := historyBatches[i]
-			blob, err := serializer.SerializeEvents(historyBatch.Events, enumspb.ENCODING_TYPE_PROTO3)
-			if err != nil {
-				return fmt.Errorf("unable to deserialize Events: %s", err)
-			}
+			blob := historyBatches[i]
 			blobSize += len(blob.Data)
 			blobs = append(blobs, blob)
 		}
 		if blobSize >= historyImportBlobSize ||
 			len(blobs) >= historyImportPageSize ||
 			(i == len(historyBatches) && len(blobs) > 0) {
-			resp, err := client.ImportWorkflowExecution(ctx, &adminservice.ImportWorkflowExecutionRequest{
-				Namespace: nsName,
-				Execution: &commonpb.WorkflowExecution{
-					WorkflowId: wid,
-					RunId:      rid,
-				},
+			importCtx, cancel := context.WithTimeout(ctx, rpcTimeout)
+			resp, err := client.ImportWorkflowExecution(importCtx, &adminservice.ImportWorkflowExecutionRequest{
+				Namespace:      nsName,
+				Execution:      execution,
 				HistoryBatches: blobs,
 				VersionHistory: versionHistory,
 				Token:          token,
 			})
+			cancel()
 			if err != nil {
 				return fmt.Errorf("unable to send History Branch: %s", err)
 			}
@@ -241,15 +288,15 @@ func AdminImportWorkflow(c *cli.Context, clientFactory ClientFactory) error {
 		return errors.New("unable to import workflow events, some events are not sent")
 	}
 	// call with empty history to commit
-	resp, err := client.ImportWorkflowExecution(ctx, &adminservice.ImportWorkflowExecutionRequest{
-		Namespace: nsName,
-		Execution: &commonpb.WorkflowExecution{
-			WorkflowId: wid,
-			RunId:      rid,
-		},
+	commitCtx, cancel := context.WithTimeout(ctx, rpcTimeout)
+	defer cancel()
+	resp, err := client.ImportWorkflowExecution(commitCtx, &adminservice.ImportWorkflowExecutionRequest{
+		Namespace:      nsName,
+		Execution:      execution,
 		HistoryBatches: []*commonpb.DataBlob{},
 		VersionHistory: versionHistory,
 		Token:          token,
+		MutableState:   mutableState,
 	})
 	if err != nil {
 		return fmt.Errorf("unable to import workflow events: %s", err)
@@ -268,12 +315,16 @@ func AdminDescribeWorkflow(c *cli.Context, clientFactory ClientFactory) error {
 	}
 
 	if resp != nil {
-		fmt.Fprintln(c.App.Writer, color.Green(c, "Cache mutable state:"))
-		if resp.GetCacheMutableState() != nil {
-			prettyPrintJSONObject(c, resp.GetCacheMutableState())
+		if c.Bool(FlagRaw) {
+			fmt.Fprintln(c.App.Writer, color.Green(c, "Cache mutable state:"))
+			if resp.GetCacheMutableState() != nil {
+				prettyPrintJSONObject(c, resp.GetCacheMutableState())
+			}
+			fmt.Fprintln(c.App.Writer, color.Green(c, "Database mutable state:"))
+			prettyPrintJSONObject(c, resp.GetDatabaseMutableState())
+		} else if err := printDecodedMutableStates(c, resp); err != nil {
+			return err
 		}
-		fmt.Fprintln(c.App.Writer, color.Green(c, "Database mutable state:"))
-		prettyPrintJSONObject(c, resp.GetDatabaseMutableState())
 
 		fmt.Fprintln(c.App.Writer, color.Green(c, "Current branch token:"))
 		versionHistories := resp.GetDatabaseMutableState().GetExecutionInfo().GetVersionHistories()
@@ -297,6 +348,58 @@ func AdminDescribeWorkflow(c *cli.Context, clientFactory ClientFactory) error {
 	return nil
 }
 
+func printDecodedMutableStates(c *cli.Context, resp *adminservice.DescribeMutableStateResponse) error {
+	decoder, err := newPayloadDecoderFromFlags(c)
+	if err != nil {
+		return err
+	}
+
+	var cacheMutableState *persistencespb.WorkflowMutableState
+	if resp.GetCacheMutableState() != nil {
+		cacheMutableState = proto.Clone(resp.GetCacheMutableState()).(*persistencespb.WorkflowMutableState)
+		if err := decoder.Decode(cacheMutableState); err != nil {
+			return err
+		}
+		fmt.Fprintln(c.App.Writer, color.Green(c, "Cache mutable state:"))
+		if err := printDecodedMessage(c.App.Writer, cacheMutableState); err != nil {
+			return err
+		}
+	}
+
+	dbMutableState := proto.Clone(resp.GetDatabaseMutableState()).(*persistencespb.WorkflowMutableState)
+	if err := decoder.Decode(dbMutableState); err != nil {
+		return err
+	}
+	fmt.Fprintln(c.App.Writer, color.Green(c, "Database mutable state:"))
+	if err := printDecodedMessage(c.App.Writer, dbMutableState); err != nil {
+		return err
+	}
+
+	// Pending state is taken from the cache if the workflow is loaded, since the
+	// database may lag behind it.
+	mutableState := dbMutableState
+	if cacheMutableState != nil {
+		mutableState = cacheMutableState
+	}
+	for _, section := range []struct {
+		title string
+		rows  []interface{}
+	}{
+		{title: "Pending activities:", rows: pendingActivityRows(mutableState)},
+		{title: "Pending timers:", rows: pendingTimerRows(mutableState)},
+		{title: "Pending child workflows:", rows: pendingChildRows(mutableState)},
+	} {
+		if len(section.rows) == 0 {
+			continue
+		}
+		fmt.Fprintln(c.App.Writer, color.Green(c, section.title))
+		if err := printTable(section.rows, c.App.Writer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func describeMutableState(c *cli.Context, clientFactory ClientFactory) (*adminservice.DescribeMutableStateResponse, error) {
 	adminClient := clientFactory.AdminClient(c)
 
@@ -94,6 +94,17 @@ func AdminDecodeProto(c *cli.Context) error {
 		return fmt.Errorf("unable to unmarshal to %s", protoType)
 	}
 
+	if !c.Bool(FlagRaw) {
+		decoder, err := newPayloadDecoderFromFlags(c)
+		if err != nil {
+			return err
+		}
+		if err := decoder.Decode(message); err != nil {
+			return err
+		}
+		return printDecodedMessage(c.App.Writer, message)
+	}
+
 	encoder := codec.NewJSONPBIndentEncoder(" ")
 	json, err := encoder.Encode(message)
 	if err != nil {
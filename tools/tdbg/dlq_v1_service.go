@@ -25,6 +25,7 @@
 package tdbg
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 
@@ -36,6 +37,7 @@ import (
 	"go.temporal.io/server/common/codec"
 	"go.temporal.io/server/common/collection"
 	"go.uber.org/multierr"
+	"google.golang.org/protobuf/proto"
 )
 
 type DLQV1Service struct {
@@ -81,6 +83,13 @@ func (ac *DLQV1Service) ReadMessages(c *cli.Context) (err error) {
 		lastMessageID = common.EndMessageID
 	}
 
+	var decoder *payloadDecoder
+	if !c.Bool(FlagRaw) {
+		if decoder, err = newPayloadDecoderFromFlags(c); err != nil {
+			return err
+		}
+	}
+
 	paginationFunc := func(paginationToken []byte) ([]interface{}, []byte, error) {
 		t, err := toQueueType(dlqType)
 		if err != nil {
@@ -113,10 +122,9 @@ func (ac *DLQV1Service) ReadMessages(c *cli.Context) (err error) {
 		}
 
 		task := item.(*replicationspb.ReplicationTask)
-		encoder := codec.NewJSONPBIndentEncoder(" ")
-		taskStr, err := encoder.Encode(task)
+		taskStr, err := encodeDLQMessage(decoder, task)
 		if err != nil {
-			return fmt.Errorf("unable to encode dlq message. Last read message id: %v", lastReadMessageID)
+			return fmt.Errorf("unable to encode dlq message. Last read message id: %v, Error: %v", lastReadMessageID, err)
 		}
 
 		lastReadMessageID = int(task.SourceTaskId)
@@ -189,6 +197,12 @@ func (ac *DLQV1Service) MergeMessages(c *cli.Context) error {
 		ShardId:               int32(shardID),
 		InclusiveEndMessageId: lastMessageID,
 		MaximumPageSize:       defaultPageSize,
+		Namespace:             c.String(FlagFilterNamespace),
+		WorkflowId:            c.String(FlagWorkflowID),
+		DryRun:                c.Bool(FlagDryRun),
+	}
+	if request.DryRun {
+		return ac.previewMergeMessages(c, request)
 	}
 
 	var response *adminservice.MergeDLQMessagesResponse
@@ -205,6 +219,55 @@ func (ac *DLQV1Service) MergeMessages(c *cli.Context) error {
 	return nil
 }
 
+func (ac *DLQV1Service) previewMergeMessages(c *cli.Context, request *adminservice.MergeDLQMessagesRequest) error {
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	adminClient := ac.clientFactory.AdminClient(c)
+	var decoder *payloadDecoder
+	if !c.Bool(FlagRaw) {
+		var err error
+		if decoder, err = newPayloadDecoderFromFlags(c); err != nil {
+			return err
+		}
+	}
+	var conflicts int
+	for {
+		response, err := adminClient.MergeDLQMessages(ctx, request)
+		if err != nil {
+			return fmt.Errorf("failed to preview DLQ message merge: %s", err)
+		}
+		for _, preview := range response.GetTaskPreviews() {
+			previewStr, err := encodeDLQMessage(decoder, preview)
+			if err != nil {
+				return fmt.Errorf("unable to encode DLQ message preview: %s", err)
+			}
+			fmt.Fprintln(c.App.Writer, string(previewStr))
+			if preview.GetConflict() {
+				conflicts++
+			}
+		}
+		if len(response.GetNextPageToken()) == 0 {
+			break
+		}
+		request.NextPageToken = response.GetNextPageToken()
+	}
+	fmt.Fprintf(c.App.Writer, "Dry run finished, %v messages would conflict with the current workflow state.\n", conflicts)
+	return nil
+}
+
 func (ac *DLQV1Service) ListQueues(c *cli.Context) error {
 	return serviceerror.NewUnimplemented("ListQueues is not implemented for DLQ v1")
 }
+
+// encodeDLQMessage encodes a DLQ message as indented JSON, with its payloads decoded if decoder is not nil.
+func encodeDLQMessage(decoder *payloadDecoder, message proto.Message) ([]byte, error) {
+	if decoder == nil {
+		return codec.NewJSONPBIndentEncoder(" ").Encode(message)
+	}
+	decoded := proto.Clone(message)
+	if err := decoder.Decode(decoded); err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(renderMessage(decoded.ProtoReflect()), "", " ")
+}
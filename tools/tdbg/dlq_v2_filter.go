@@ -0,0 +1,443 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli/v2"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	commonspb "go.temporal.io/server/api/common/v1"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/history/tasks"
+	"go.uber.org/multierr"
+)
+
+type (
+	// dlqMessageSummary is the decoded form of a DLQ v2 message that's shown when selecting messages to merge or
+	// purge. Only exported fields are printed.
+	dlqMessageSummary struct {
+		MessageID   int64
+		ShardID     int32
+		NamespaceID string
+		WorkflowID  string
+		RunID       string
+		TaskType    string
+
+		blob *commonpb.DataBlob
+	}
+	// dlqMessageFilter restricts the messages that are offered for selection. Empty fields match everything.
+	dlqMessageFilter struct {
+		namespaceID string
+		workflowID  string
+		taskType    string
+	}
+	// dlqAuditRecord is written as one JSON line to the audit log for every message that was merged or purged.
+	dlqAuditRecord struct {
+		Time          time.Time `json:"time"`
+		Action        string    `json:"action"`
+		TaskCategory  int       `json:"task_category"`
+		SourceCluster string    `json:"source_cluster"`
+		TargetCluster string    `json:"target_cluster"`
+		MessageID     int64     `json:"message_id"`
+		ShardID       int32     `json:"shard_id"`
+		NamespaceID   string    `json:"namespace_id"`
+		WorkflowID    string    `json:"workflow_id"`
+		RunID         string    `json:"run_id"`
+		TaskType      string    `json:"task_type"`
+		// RemovedFromDLQ is false if the message is still in the DLQ because unselected messages precede it.
+		RemovedFromDLQ bool `json:"removed_from_dlq"`
+	}
+)
+
+const (
+	dlqActionMerge = "merge"
+	dlqActionPurge = "purge"
+
+	dlqFilterDefaultPageSize = 100
+)
+
+// FilterMergeMessages re-enqueues the DLQ messages that match the filter flags and are selected by the user, and
+// deletes them from the DLQ if possible.
+func (ac *DLQV2Service) FilterMergeMessages(c *cli.Context) error {
+	if ac.category == tasks.CategoryReplication {
+		return fmt.Errorf("filtered merge is not supported for the replication DLQ, use merge instead")
+	}
+	return ac.filterMessages(c, dlqActionMerge)
+}
+
+// FilterPurgeMessages deletes the DLQ messages that match the filter flags and are selected by the user.
+func (ac *DLQV2Service) FilterPurgeMessages(c *cli.Context) error {
+	return ac.filterMessages(c, dlqActionPurge)
+}
+
+func (ac *DLQV2Service) filterMessages(c *cli.Context, action string) (err error) {
+	filter, err := ac.getMessageFilter(c)
+	if err != nil {
+		return err
+	}
+	maxMessageID, err := ac.getLastMessageID(c, action)
+	if err != nil {
+		return err
+	}
+	auditLog, err := os.OpenFile(c.String(FlagAuditLog), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("unable to open audit log: %w", err)
+	}
+	defer func() {
+		err = multierr.Append(err, auditLog.Close())
+	}()
+
+	scanned, selected, err := ac.selectMessages(c, action, filter, maxMessageID)
+	if err != nil {
+		return err
+	}
+	if len(selected) == 0 {
+		_, _ = fmt.Fprintln(ac.writer, "No messages selected.")
+		return nil
+	}
+	ac.prompter.Prompt(fmt.Sprintf("Are you sure you want to %s %d messages?", action, len(selected)))
+
+	if action == dlqActionMerge {
+		merged, err := ac.reEnqueueMessages(c, selected)
+		if err != nil {
+			return multierr.Append(err, ac.writeAuditRecords(auditLog, action, merged, persistence.FirstQueueMessageID-1))
+		}
+	}
+
+	// Messages can only be deleted by range, so only the selected messages at the head of the DLQ can be removed.
+	removedUpTo := selectedPrefixEnd(scanned, selected)
+	if removedUpTo >= persistence.FirstQueueMessageID {
+		ctx, cancel := newContext(c)
+		defer cancel()
+		response, err := ac.clientFactory.AdminClient(c).PurgeDLQTasks(ctx, &adminservice.PurgeDLQTasksRequest{
+			DlqKey: ac.getDLQKey(),
+			InclusiveMaxTaskMetadata: &commonspb.HistoryDLQTaskMetadata{
+				MessageId: removedUpTo,
+			},
+		})
+		if err != nil {
+			err = fmt.Errorf("call to PurgeDLQTasks failed: %w", err)
+			if action == dlqActionMerge {
+				// The messages were re-enqueued even though they couldn't be removed from the DLQ.
+				err = multierr.Append(err, ac.writeAuditRecords(auditLog, action, selected, persistence.FirstQueueMessageID-1))
+			}
+			return err
+		}
+		if err := newEncoder(ac.writer).Encode(response); err != nil {
+			return fmt.Errorf("unable to encode PurgeDLQTasks response: %w", err)
+		}
+	}
+	if err := ac.writeAuditRecords(auditLog, action, selected, removedUpTo); err != nil {
+		return err
+	}
+
+	var remaining []string
+	for _, message := range selected {
+		if message.MessageID > removedUpTo {
+			remaining = append(remaining, strconv.FormatInt(message.MessageID, 10))
+		}
+	}
+	if len(remaining) > 0 {
+		_, _ = fmt.Fprintf(
+			ac.writer,
+			"Messages %s are still in the DLQ because unselected messages precede them.\n",
+			strings.Join(remaining, ", "),
+		)
+	}
+	return nil
+}
+
+func (ac *DLQV2Service) getMessageFilter(c *cli.Context) (dlqMessageFilter, error) {
+	filter := dlqMessageFilter{
+		workflowID: c.String(FlagWorkflowID),
+		taskType:   normalizeTaskType(c.String(FlagTaskType)),
+	}
+	if nsName := c.String(FlagFilterNamespace); nsName != "" {
+		nsID, err := getNamespaceID(c, ac.clientFactory, namespace.Name(nsName))
+		if err != nil {
+			return filter, fmt.Errorf("unable to resolve namespace %q: %w", nsName, err)
+		}
+		filter.namespaceID = nsID.String()
+	}
+	return filter, nil
+}
+
+// selectMessages pages through the DLQ from its head and asks the user which of the messages that match filter to
+// act on. It returns all messages that were read, and the selected ones, both in order of message ID.
+func (ac *DLQV2Service) selectMessages(
+	c *cli.Context,
+	action string,
+	filter dlqMessageFilter,
+	maxMessageID int64,
+) ([]dlqMessageSummary, []dlqMessageSummary, error) {
+	adminClient := ac.clientFactory.AdminClient(c)
+	pageSize := c.Int(FlagPageSize)
+
+	var scanned, selected []dlqMessageSummary
+	var nextPageToken []byte
+	for {
+		ctx, cancel := newContext(c)
+		response, err := adminClient.GetDLQTasks(ctx, &adminservice.GetDLQTasksRequest{
+			DlqKey:        ac.getDLQKey(),
+			PageSize:      int32(pageSize),
+			NextPageToken: nextPageToken,
+		})
+		cancel()
+		if err != nil {
+			return nil, nil, fmt.Errorf("call to GetDLQTasks failed: %w", err)
+		}
+
+		done := len(response.NextPageToken) == 0
+		var matched []dlqMessageSummary
+		for _, dlqTask := range response.DlqTasks {
+			if dlqTask.Metadata.MessageId > maxMessageID {
+				done = true
+				break
+			}
+			summary, err := ac.summarizeMessage(dlqTask)
+			if err != nil {
+				return nil, nil, err
+			}
+			scanned = append(scanned, summary)
+			if filter.matches(summary) {
+				matched = append(matched, summary)
+			}
+		}
+
+		if len(matched) > 0 {
+			pageSelection, quit, err := ac.promptSelection(action, matched)
+			if err != nil {
+				return nil, nil, err
+			}
+			selected = append(selected, pageSelection...)
+			done = done || quit
+		}
+		if done {
+			return scanned, selected, nil
+		}
+		nextPageToken = response.NextPageToken
+	}
+}
+
+func (ac *DLQV2Service) summarizeMessage(dlqTask *commonspb.HistoryDLQTask) (dlqMessageSummary, error) {
+	blob := dlqTask.GetPayload().GetBlob()
+	if blob == nil {
+		return dlqMessageSummary{}, fmt.Errorf("DLQ task payload blob is nil: %+v", dlqTask)
+	}
+	var b bytes.Buffer
+	if err := ac.taskBlobEncoder.Encode(&b, ac.category.ID(), blob); err != nil {
+		return dlqMessageSummary{}, fmt.Errorf("unable to decode DLQ message %d: %w", dlqTask.Metadata.MessageId, err)
+	}
+	// These are the field names of the persistence task protos as printed by ProtoTaskBlobEncoder.
+	var task struct {
+		NamespaceID string `json:"namespace_id"`
+		WorkflowID  string `json:"workflow_id"`
+		RunID       string `json:"run_id"`
+		TaskType    string `json:"task_type"`
+	}
+	if err := json.Unmarshal(b.Bytes(), &task); err != nil {
+		return dlqMessageSummary{}, fmt.Errorf("unable to parse DLQ message %d: %w", dlqTask.Metadata.MessageId, err)
+	}
+	return dlqMessageSummary{
+		MessageID:   dlqTask.Metadata.MessageId,
+		ShardID:     dlqTask.Payload.ShardId,
+		NamespaceID: task.NamespaceID,
+		WorkflowID:  task.WorkflowID,
+		RunID:       task.RunID,
+		TaskType:    task.TaskType,
+		blob:        blob,
+	}, nil
+}
+
+func (ac *DLQV2Service) promptSelection(
+	action string,
+	messages []dlqMessageSummary,
+) ([]dlqMessageSummary, bool, error) {
+	items := make([]interface{}, len(messages))
+	for i, message := range messages {
+		items[i] = message
+	}
+	if err := printTable(items, ac.writer); err != nil {
+		return nil, false, err
+	}
+	for {
+		response := ac.prompter.Ask(
+			fmt.Sprintf("Select messages to %s (all, none, quit or message IDs like 3,5-7)", action),
+			"all",
+		)
+		selected, quit, err := parseMessageSelection(response, messages)
+		if err == nil {
+			return selected, quit, nil
+		}
+		_, _ = fmt.Fprintln(ac.writer, err)
+	}
+}
+
+// reEnqueueMessages adds the tasks of messages back to their shards. It returns the messages that were re-enqueued,
+// which are all of them unless there's an error.
+func (ac *DLQV2Service) reEnqueueMessages(c *cli.Context, messages []dlqMessageSummary) ([]dlqMessageSummary, error) {
+	adminClient := ac.clientFactory.AdminClient(c)
+	batchSize := c.Int(FlagPageSize)
+
+	messagesByShard := make(map[int32][]dlqMessageSummary)
+	for _, message := range messages {
+		messagesByShard[message.ShardID] = append(messagesByShard[message.ShardID], message)
+	}
+	var merged []dlqMessageSummary
+	for _, shardID := range sortedKeys(messagesByShard) {
+		for batch := range slices.Chunk(messagesByShard[shardID], batchSize) {
+			request := &adminservice.AddTasksRequest{ShardId: shardID}
+			for _, message := range batch {
+				request.Tasks = append(request.Tasks, &adminservice.AddTasksRequest_Task{
+					CategoryId: int32(ac.category.ID()),
+					Blob:       message.blob,
+				})
+			}
+			ctx, cancel := newContext(c)
+			_, err := adminClient.AddTasks(ctx, request)
+			cancel()
+			if err != nil {
+				return merged, fmt.Errorf("call to AddTasks for shard %d failed: %w", shardID, err)
+			}
+			merged = append(merged, batch...)
+		}
+	}
+	return merged, nil
+}
+
+func (ac *DLQV2Service) writeAuditRecords(
+	writer io.Writer,
+	action string,
+	messages []dlqMessageSummary,
+	removedUpTo int64,
+) error {
+	encoder := json.NewEncoder(writer)
+	now := time.Now().UTC()
+	for _, message := range messages {
+		err := encoder.Encode(dlqAuditRecord{
+			Time:           now,
+			Action:         action,
+			TaskCategory:   ac.category.ID(),
+			SourceCluster:  ac.sourceCluster,
+			TargetCluster:  ac.targetCluster,
+			MessageID:      message.MessageID,
+			ShardID:        message.ShardID,
+			NamespaceID:    message.NamespaceID,
+			WorkflowID:     message.WorkflowID,
+			RunID:          message.RunID,
+			TaskType:       message.TaskType,
+			RemovedFromDLQ: message.MessageID <= removedUpTo,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to write audit log: %w", err)
+		}
+	}
+	return nil
+}
+
+func (f dlqMessageFilter) matches(message dlqMessageSummary) bool {
+	if f.namespaceID != "" && message.NamespaceID != f.namespaceID {
+		return false
+	}
+	if f.workflowID != "" && message.WorkflowID != f.workflowID {
+		return false
+	}
+	if f.taskType != "" && normalizeTaskType(message.TaskType) != f.taskType {
+		return false
+	}
+	return true
+}
+
+// normalizeTaskType allows task types to be given with or without the enum prefix and in any case.
+func normalizeTaskType(taskType string) string {
+	return strings.TrimPrefix(strings.ToUpper(taskType), "TASK_TYPE_")
+}
+
+// parseMessageSelection parses the user's response to promptSelection. Ranges select every message in them, while
+// individual IDs must be one of messages.
+func parseMessageSelection(response string, messages []dlqMessageSummary) ([]dlqMessageSummary, bool, error) {
+	switch strings.ToLower(response) {
+	case "a", "all":
+		return messages, false, nil
+	case "", "n", "none":
+		return nil, false, nil
+	case "q", "quit":
+		return nil, true, nil
+	}
+
+	type idRange struct{ min, max int64 }
+	var ranges []idRange
+	for _, part := range strings.Split(response, ",") {
+		part = strings.TrimSpace(part)
+		lo, hi, isRange := strings.Cut(part, "-")
+		minID, err := strconv.ParseInt(strings.TrimSpace(lo), 10, 64)
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid message ID %q", part)
+		}
+		maxID := minID
+		if isRange {
+			maxID, err = strconv.ParseInt(strings.TrimSpace(hi), 10, 64)
+			if err != nil || maxID < minID {
+				return nil, false, fmt.Errorf("invalid message ID range %q", part)
+			}
+		} else if !slices.ContainsFunc(messages, func(m dlqMessageSummary) bool { return m.MessageID == minID }) {
+			return nil, false, fmt.Errorf("message %d is not one of the listed messages", minID)
+		}
+		ranges = append(ranges, idRange{min: minID, max: maxID})
+	}
+
+	var selected []dlqMessageSummary
+	for _, message := range messages {
+		if slices.ContainsFunc(ranges, func(r idRange) bool {
+			return message.MessageID >= r.min && message.MessageID <= r.max
+		}) {
+			selected = append(selected, message)
+		}
+	}
+	return selected, false, nil
+}
+
+// selectedPrefixEnd returns the largest message ID such that all scanned messages up to it were selected, or
+// persistence.FirstQueueMessageID-1 if the first scanned message wasn't selected. scanned must start at the head of
+// the DLQ.
+func selectedPrefixEnd(scanned []dlqMessageSummary, selected []dlqMessageSummary) int64 {
+	end := int64(persistence.FirstQueueMessageID - 1)
+	for i, message := range scanned {
+		if i >= len(selected) || selected[i].MessageID != message.MessageID {
+			break
+		}
+		end = message.MessageID
+	}
+	return end
+}
@@ -0,0 +1,220 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/api/adminservice/v1"
+	commonspb "go.temporal.io/server/api/common/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/service/history/tasks"
+	"go.temporal.io/server/tools/tdbg"
+	"go.temporal.io/server/tools/tdbg/tdbgtest"
+	"google.golang.org/grpc"
+)
+
+// filterAdminClient serves a fixed set of DLQ tasks and records the tasks that are re-enqueued and purged.
+type filterAdminClient struct {
+	adminservice.AdminServiceClient
+	dlqTasks []*commonspb.HistoryDLQTask
+
+	addTasksRequests     []*adminservice.AddTasksRequest
+	purgeDLQTasksRequest *adminservice.PurgeDLQTasksRequest
+}
+
+func newFilterDLQTask(t *testing.T, messageID int64, shardID int32, workflowID string, taskType enumsspb.TaskType) *commonspb.HistoryDLQTask {
+	blob, err := serialization.TransferTaskInfoToBlob(&persistencespb.TransferTaskInfo{
+		NamespaceId: "test-namespace-id",
+		WorkflowId:  workflowID,
+		RunId:       "test-run-id",
+		TaskType:    taskType,
+		TaskId:      messageID,
+	})
+	require.NoError(t, err)
+	return &commonspb.HistoryDLQTask{
+		Metadata: &commonspb.HistoryDLQTaskMetadata{MessageId: messageID},
+		Payload:  &commonspb.HistoryTask{ShardId: shardID, Blob: blob},
+	}
+}
+
+func runFilterDLQCommand(t *testing.T, client *filterAdminClient, command string, args ...string) (string, []map[string]any, error) {
+	auditLog := filepath.Join(t.TempDir(), "audit.log")
+	var stdout bytes.Buffer
+	app := tdbgtest.NewCliApp(func(params *tdbg.Params) {
+		params.ClientFactory = fakeClientFactory{adminClient: client}
+		params.Writer = &stdout
+	})
+	runArgs := append([]string{
+		"tdbg",
+		"--" + tdbg.FlagYes,
+		"dlq",
+		"--" + tdbg.FlagDLQVersion, "v2",
+		command,
+		"--" + tdbg.FlagDLQType, strconv.Itoa(tasks.CategoryTransfer.ID()),
+		"--" + tdbg.FlagTargetCluster, "test-cluster",
+		"--" + tdbg.FlagPageSize, "2",
+		"--" + tdbg.FlagAuditLog, auditLog,
+	}, args...)
+	err := app.Run(runArgs)
+
+	var records []map[string]any
+	if f, openErr := os.Open(auditLog); openErr == nil {
+		defer func() { _ = f.Close() }()
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			var record map[string]any
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+			records = append(records, record)
+		}
+	}
+	return stdout.String(), records, err
+}
+
+func TestDLQCommand_FilterMerge(t *testing.T) {
+	client := &filterAdminClient{
+		dlqTasks: []*commonspb.HistoryDLQTask{
+			newFilterDLQTask(t, 0, 1, "workflow-1", enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK),
+			newFilterDLQTask(t, 1, 2, "workflow-1", enumsspb.TASK_TYPE_TRANSFER_WORKFLOW_TASK),
+			newFilterDLQTask(t, 2, 1, "workflow-2", enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK),
+			newFilterDLQTask(t, 3, 1, "workflow-1", enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK),
+		},
+	}
+
+	stdout, records, err := runFilterDLQCommand(t, client, "filter-merge", "--"+tdbg.FlagWorkflowID, "workflow-1")
+	require.NoError(t, err)
+
+	// Tasks are re-enqueued per shard, in batches of the page size.
+	require.Len(t, client.addTasksRequests, 2)
+	assert.Equal(t, int32(1), client.addTasksRequests[0].ShardId)
+	assert.Len(t, client.addTasksRequests[0].Tasks, 2)
+	assert.Equal(t, int32(2), client.addTasksRequests[1].ShardId)
+	assert.Len(t, client.addTasksRequests[1].Tasks, 1)
+
+	// Message 3 can't be deleted because message 2 wasn't selected.
+	require.NotNil(t, client.purgeDLQTasksRequest)
+	assert.Equal(t, int64(1), client.purgeDLQTasksRequest.InclusiveMaxTaskMetadata.MessageId)
+	assert.Contains(t, stdout, "Messages 3 are still in the DLQ")
+
+	require.Len(t, records, 3)
+	for i, messageID := range []float64{0, 1, 3} {
+		assert.Equal(t, "merge", records[i]["action"])
+		assert.Equal(t, messageID, records[i]["message_id"])
+		assert.Equal(t, "workflow-1", records[i]["workflow_id"])
+		assert.Equal(t, messageID <= 1, records[i]["removed_from_dlq"])
+	}
+}
+
+func TestDLQCommand_FilterPurge(t *testing.T) {
+	client := &filterAdminClient{
+		dlqTasks: []*commonspb.HistoryDLQTask{
+			newFilterDLQTask(t, 0, 1, "workflow-1", enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK),
+			newFilterDLQTask(t, 1, 2, "workflow-2", enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK),
+			newFilterDLQTask(t, 2, 1, "workflow-1", enumsspb.TASK_TYPE_TRANSFER_WORKFLOW_TASK),
+		},
+	}
+
+	_, records, err := runFilterDLQCommand(t, client, "filter-purge", "--"+tdbg.FlagTaskType, "transfer_activity_task")
+	require.NoError(t, err)
+
+	assert.Empty(t, client.addTasksRequests)
+	require.NotNil(t, client.purgeDLQTasksRequest)
+	assert.Equal(t, int64(1), client.purgeDLQTasksRequest.InclusiveMaxTaskMetadata.MessageId)
+	require.Len(t, records, 2)
+	for i, messageID := range []float64{0, 1} {
+		assert.Equal(t, "purge", records[i]["action"])
+		assert.Equal(t, messageID, records[i]["message_id"])
+		assert.Equal(t, true, records[i]["removed_from_dlq"])
+	}
+}
+
+func TestDLQCommand_FilterNoMatch(t *testing.T) {
+	client := &filterAdminClient{
+		dlqTasks: []*commonspb.HistoryDLQTask{
+			newFilterDLQTask(t, 0, 1, "workflow-1", enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK),
+		},
+	}
+
+	stdout, records, err := runFilterDLQCommand(t, client, "filter-purge", "--"+tdbg.FlagWorkflowID, "workflow-2")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "No messages selected")
+	assert.Nil(t, client.purgeDLQTasksRequest)
+	assert.Empty(t, records)
+}
+
+func TestDLQCommand_FilterMergeReplication(t *testing.T) {
+	_, _, err := runFilterDLQCommand(
+		t,
+		&filterAdminClient{},
+		"filter-merge",
+		"--"+tdbg.FlagDLQType, strconv.Itoa(tasks.CategoryReplication.ID()),
+		"--"+tdbg.FlagCluster, "test-source-cluster",
+	)
+	assert.ErrorContains(t, err, "replication")
+}
+
+func (f *filterAdminClient) GetDLQTasks(
+	_ context.Context,
+	req *adminservice.GetDLQTasksRequest,
+	_ ...grpc.CallOption,
+) (*adminservice.GetDLQTasksResponse, error) {
+	start := 0
+	if len(req.NextPageToken) > 0 {
+		start, _ = strconv.Atoi(string(req.NextPageToken))
+	}
+	end := min(start+int(req.PageSize), len(f.dlqTasks))
+	response := &adminservice.GetDLQTasksResponse{DlqTasks: f.dlqTasks[start:end]}
+	if end < len(f.dlqTasks) {
+		response.NextPageToken = []byte(strconv.Itoa(end))
+	}
+	return response, nil
+}
+
+func (f *filterAdminClient) AddTasks(
+	_ context.Context,
+	req *adminservice.AddTasksRequest,
+	_ ...grpc.CallOption,
+) (*adminservice.AddTasksResponse, error) {
+	f.addTasksRequests = append(f.addTasksRequests, req)
+	return &adminservice.AddTasksResponse{}, nil
+}
+
+func (f *filterAdminClient) PurgeDLQTasks(
+	_ context.Context,
+	req *adminservice.PurgeDLQTasksRequest,
+	_ ...grpc.CallOption,
+) (*adminservice.PurgeDLQTasksResponse, error) {
+	f.purgeDLQTasksRequest = req
+	return &adminservice.PurgeDLQTasksResponse{JobToken: []byte("test-job-token")}, nil
+}
@@ -78,6 +78,8 @@ var (
 	FlagQueueType                  = "queue-type"
 	FlagDLQVersion                 = "dlq-version"
 	FlagMaxMessageCount            = "max-message-count"
+	FlagDryRun                     = "dry-run"
+	FlagFilterNamespace            = "filter-namespace"
 	FlagProtoType                  = "type"
 	FlagHexData                    = "hex-data"
 	FlagHexFile                    = "hex-file"
@@ -91,4 +93,19 @@ var (
 	FlagBuildIDs                   = "select-build-id"
 	FlagUnversioned                = "select-unversioned"
 	FlagAllActive                  = "select-all-active"
+	FlagCodecEndpoint              = "codec-endpoint"
+	FlagCodecAuth                  = "codec-auth"
+	FlagCodecHeader                = "codec-header"
+	FlagRaw                        = "raw"
+	FlagTaskType                   = "task-type"
+	FlagAuditLog                   = "audit-log"
+	FlagMaxTaskCount               = "max-task-count"
+	FlagSampleSize                 = "sample-size"
+	FlagSampleInterval             = "sample-interval"
+	FlagTopN                       = "top"
+	FlagSkipHistory                = "skip-history"
+	FlagQuery                      = "query"
+	FlagJobID                      = "job-id"
+	FlagRPS                        = "rps"
+	FlagWait                       = "wait"
 )
@@ -0,0 +1,366 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"cmp"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/urfave/cli/v2"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/sdk/converter"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/payload"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	// payloadDecoder turns messages such as mutable state or history events into a human
+	// readable form: payloads are decompressed, passed through the remote codec (if one is
+	// configured) and rendered as their values instead of base64 blobs.
+	payloadDecoder struct {
+		codec converter.PayloadCodec
+	}
+
+	pendingActivityRow struct {
+		ScheduledEventID int64
+		ActivityID       string
+		ActivityType     string
+		StartedEventID   int64
+		Attempt          int32
+		LastHeartbeat    string
+		LastFailure      string
+	}
+
+	pendingTimerRow struct {
+		TimerID        string
+		StartedEventID int64
+		ExpiryTime     string
+		TaskStatus     int64
+	}
+
+	pendingChildRow struct {
+		InitiatedEventID int64
+		StartedEventID   int64
+		Namespace        string
+		WorkflowType     string
+		WorkflowID       string
+		RunID            string
+	}
+)
+
+var (
+	payloadDescriptor   = (&commonpb.Payload{}).ProtoReflect().Descriptor()
+	payloadsDescriptor  = (&commonpb.Payloads{}).ProtoReflect().Descriptor()
+	timestampDescriptor = (&timestamppb.Timestamp{}).ProtoReflect().Descriptor()
+	durationDescriptor  = (&durationpb.Duration{}).ProtoReflect().Descriptor()
+)
+
+// newPayloadDecoder creates a decoder that sends payloads of the given namespace to the
+// codec server at codecEndpoint, with the given additional headers. Payloads are only
+// decompressed if codecEndpoint is empty.
+func newPayloadDecoder(codecEndpoint string, namespace string, headers http.Header) *payloadDecoder {
+	if codecEndpoint == "" {
+		return &payloadDecoder{}
+	}
+	return &payloadDecoder{
+		codec: converter.NewRemotePayloadCodec(converter.RemotePayloadCodecOptions{
+			Endpoint: codecEndpoint,
+			ModifyRequest: func(req *http.Request) error {
+				req.Header.Set("X-Namespace", namespace)
+				for key, values := range headers {
+					for _, value := range values {
+						req.Header.Add(key, value)
+					}
+				}
+				return nil
+			},
+		}),
+	}
+}
+
+// newPayloadDecoderFromFlags creates a decoder for the codec flags of a command, see getCodecFlags.
+func newPayloadDecoderFromFlags(c *cli.Context) (*payloadDecoder, error) {
+	headers := make(http.Header)
+	if auth := c.String(FlagCodecAuth); auth != "" {
+		headers.Set("Authorization", auth)
+	}
+	for _, header := range c.StringSlice(FlagCodecHeader) {
+		key, value, ok := strings.Cut(header, "=")
+		if !ok || strings.TrimSpace(key) == "" {
+			return nil, fmt.Errorf("invalid codec header %q, expected KEY=VALUE", header)
+		}
+		headers.Add(strings.TrimSpace(key), value)
+	}
+	return newPayloadDecoder(c.String(FlagCodecEndpoint), c.String(FlagNamespace), headers), nil
+}
+
+// Decode decompresses and decodes all payloads of messages in place, with a single request
+// to the remote codec.
+func (d *payloadDecoder) Decode(messages ...proto.Message) error {
+	var payloads []*commonpb.Payload
+	for _, m := range messages {
+		collectPayloads(m.ProtoReflect(), &payloads)
+	}
+	if len(payloads) == 0 {
+		return nil
+	}
+
+	for _, p := range payloads {
+		if payload.IsCompressed(p) {
+			compressed := &commonpb.Payloads{Payloads: []*commonpb.Payload{p}}
+			if err := payload.Decompress(compressed); err != nil {
+				return fmt.Errorf("unable to decompress payload: %w", err)
+			}
+		}
+	}
+
+	if d.codec == nil {
+		return nil
+	}
+	results, err := d.codec.Decode(payloads)
+	if err != nil {
+		return fmt.Errorf("unable to decode payloads with remote codec: %w", err)
+	}
+	if len(results) != len(payloads) {
+		return fmt.Errorf("remote codec returned %d payloads, expected %d", len(results), len(payloads))
+	}
+	for i, p := range payloads {
+		proto.Reset(p)
+		proto.Merge(p, results[i])
+	}
+	return nil
+}
+
+// collectPayloads appends every Payload reachable from m, so that they can be decoded in
+// a single codec request and replaced in place.
+func collectPayloads(m protoreflect.Message, payloads *[]*commonpb.Payload) {
+	if m.Descriptor() == payloadDescriptor {
+		if p, ok := m.Interface().(*commonpb.Payload); ok {
+			*payloads = append(*payloads, p)
+		}
+		return
+	}
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Kind() == protoreflect.MessageKind {
+				v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					collectPayloads(mv.Message(), payloads)
+					return true
+				})
+			}
+		case fd.IsList():
+			if fd.Kind() == protoreflect.MessageKind {
+				for i := 0; i < v.List().Len(); i++ {
+					collectPayloads(v.List().Get(i).Message(), payloads)
+				}
+			}
+		case fd.Kind() == protoreflect.MessageKind:
+			collectPayloads(v.Message(), payloads)
+		}
+		return true
+	})
+}
+
+// renderMessage converts m into values that encoding/json prints readably: field names as
+// in protojson, timestamps and durations as strings, enums by name and payloads as the
+// values they encode.
+func renderMessage(m protoreflect.Message) interface{} {
+	switch m.Descriptor() {
+	case payloadDescriptor:
+		return renderPayload(m.Interface().(*commonpb.Payload))
+	case payloadsDescriptor:
+		payloads := m.Interface().(*commonpb.Payloads).GetPayloads()
+		result := make([]interface{}, len(payloads))
+		for i, p := range payloads {
+			result[i] = renderPayload(p)
+		}
+		return result
+	case timestampDescriptor:
+		return m.Interface().(*timestamppb.Timestamp).AsTime().Format(time.RFC3339Nano)
+	case durationDescriptor:
+		return m.Interface().(*durationpb.Duration).AsDuration().String()
+	}
+
+	result := make(map[string]interface{})
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		result[fd.JSONName()] = renderValue(fd, v)
+		return true
+	})
+	return result
+}
+
+func renderValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) interface{} {
+	switch {
+	case fd.IsMap():
+		result := make(map[string]interface{}, v.Map().Len())
+		v.Map().Range(func(k protoreflect.MapKey, mv protoreflect.Value) bool {
+			result[k.String()] = renderSingular(fd.MapValue(), mv)
+			return true
+		})
+		return result
+	case fd.IsList():
+		result := make([]interface{}, v.List().Len())
+		for i := range result {
+			result[i] = renderSingular(fd, v.List().Get(i))
+		}
+		return result
+	default:
+		return renderSingular(fd, v)
+	}
+}
+
+func renderSingular(fd protoreflect.FieldDescriptor, v protoreflect.Value) interface{} {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return renderMessage(v.Message())
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name())
+		}
+		return v.Enum()
+	case protoreflect.BytesKind:
+		return base64.StdEncoding.EncodeToString(v.Bytes())
+	default:
+		return v.Interface()
+	}
+}
+
+// renderPayload returns the value encoded in p if its encoding is one of the SDK's
+// default encodings, and the raw payload otherwise.
+func renderPayload(p *commonpb.Payload) interface{} {
+	data := p.GetData()
+	encoding := string(p.GetMetadata()[converter.MetadataEncoding])
+	switch encoding {
+	case converter.MetadataEncodingNil:
+		return nil
+	case converter.MetadataEncodingJSON, converter.MetadataEncodingProtoJSON:
+		var value interface{}
+		if err := json.Unmarshal(data, &value); err == nil {
+			return value
+		}
+	case converter.MetadataEncodingBinary:
+		if utf8.Valid(data) {
+			return string(data)
+		}
+	case converter.MetadataEncodingProto:
+		messageType := protoreflect.FullName(p.GetMetadata()[converter.MetadataMessageType])
+		if mt, err := protoregistry.GlobalTypes.FindMessageByName(messageType); err == nil {
+			m := mt.New()
+			if err := proto.Unmarshal(data, m.Interface()); err == nil {
+				return renderMessage(m)
+			}
+		}
+	}
+	return map[string]interface{}{
+		"encoding": encoding,
+		"data":     base64.StdEncoding.EncodeToString(data),
+	}
+}
+
+// printDecodedMessage prints a message decoded by payloadDecoder, see renderMessage.
+func printDecodedMessage(writer io.Writer, message proto.Message) error {
+	b, err := json.MarshalIndent(renderMessage(message.ProtoReflect()), "", "  ")
+	if err != nil {
+		return err
+	}
+	_, _ = writer.Write(b)
+	_, _ = writer.Write([]byte("\n"))
+	return nil
+}
+
+func pendingActivityRows(mutableState *persistencespb.WorkflowMutableState) []interface{} {
+	var rows []interface{}
+	for _, id := range sortedKeys(mutableState.GetActivityInfos()) {
+		ai := mutableState.GetActivityInfos()[id]
+		rows = append(rows, pendingActivityRow{
+			ScheduledEventID: ai.GetScheduledEventId(),
+			ActivityID:       ai.GetActivityId(),
+			ActivityType:     ai.GetActivityType().GetName(),
+			StartedEventID:   ai.GetStartedEventId(),
+			Attempt:          ai.GetAttempt(),
+			LastHeartbeat:    formatPayloads(ai.GetLastHeartbeatDetails()),
+			LastFailure:      ai.GetRetryLastFailure().GetMessage(),
+		})
+	}
+	return rows
+}
+
+func pendingTimerRows(mutableState *persistencespb.WorkflowMutableState) []interface{} {
+	var rows []interface{}
+	for _, id := range sortedKeys(mutableState.GetTimerInfos()) {
+		ti := mutableState.GetTimerInfos()[id]
+		rows = append(rows, pendingTimerRow{
+			TimerID:        ti.GetTimerId(),
+			StartedEventID: ti.GetStartedEventId(),
+			ExpiryTime:     ti.GetExpiryTime().AsTime().Format(time.RFC3339Nano),
+			TaskStatus:     ti.GetTaskStatus(),
+		})
+	}
+	return rows
+}
+
+func pendingChildRows(mutableState *persistencespb.WorkflowMutableState) []interface{} {
+	var rows []interface{}
+	for _, id := range sortedKeys(mutableState.GetChildExecutionInfos()) {
+		ci := mutableState.GetChildExecutionInfos()[id]
+		rows = append(rows, pendingChildRow{
+			InitiatedEventID: ci.GetInitiatedEventId(),
+			StartedEventID:   ci.GetStartedEventId(),
+			Namespace:        ci.GetNamespace(),
+			WorkflowType:     ci.GetWorkflowTypeName(),
+			WorkflowID:       ci.GetStartedWorkflowId(),
+			RunID:            ci.GetStartedRunId(),
+		})
+	}
+	return rows
+}
+
+func formatPayloads(payloads *commonpb.Payloads) string {
+	if len(payloads.GetPayloads()) == 0 {
+		return ""
+	}
+	b, err := json.Marshal(renderMessage(payloads.ProtoReflect()))
+	if err != nil {
+		return err.Error()
+	}
+	return string(b)
+}
+
+func sortedKeys[K cmp.Ordered, V any](m map[K]V) []K {
+	return slices.Sorted(maps.Keys(m))
+}
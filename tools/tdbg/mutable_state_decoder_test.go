@@ -0,0 +1,274 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+	commonpb "go.temporal.io/api/common/v1"
+	failurepb "go.temporal.io/api/failure/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/sdk/converter"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/payload"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func encryptedPayload(data string) *commonpb.Payload {
+	return &commonpb.Payload{
+		Metadata: map[string][]byte{converter.MetadataEncoding: []byte("binary/encrypted")},
+		Data:     []byte(data),
+	}
+}
+
+func TestPayloadDecoder_RemoteCodec(t *testing.T) {
+	s := require.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.Equal("/decode", r.URL.Path)
+		s.Equal("test-namespace", r.Header.Get("X-Namespace"))
+		s.Equal("Bearer token", r.Header.Get("Authorization"))
+		s.Equal("tenant", r.Header.Get("X-Tenant"))
+
+		var payloads commonpb.Payloads
+		body, err := io.ReadAll(r.Body)
+		s.NoError(err)
+		s.NoError(protojson.Unmarshal(body, &payloads))
+		for i, p := range payloads.Payloads {
+			s.Equal("binary/encrypted", string(p.Metadata[converter.MetadataEncoding]))
+			payloads.Payloads[i] = payload.EncodeString("decoded " + string(p.Data))
+		}
+		b, err := protojson.Marshal(&payloads)
+		s.NoError(err)
+		_, _ = w.Write(b)
+	}))
+	defer server.Close()
+
+	mutableState := &persistencespb.WorkflowMutableState{
+		ActivityInfos: map[int64]*persistencespb.ActivityInfo{
+			5: {
+				ActivityId:           "activity",
+				LastHeartbeatDetails: &commonpb.Payloads{Payloads: []*commonpb.Payload{encryptedPayload("heartbeat")}},
+			},
+		},
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			Memo: map[string]*commonpb.Payload{"key": encryptedPayload("memo")},
+		},
+	}
+
+	event := &historypb.HistoryEvent{
+		Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+			WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+				Input: &commonpb.Payloads{Payloads: []*commonpb.Payload{encryptedPayload("input")}},
+			},
+		},
+	}
+
+	decoder := newPayloadDecoder(server.URL, "test-namespace", http.Header{
+		"Authorization": []string{"Bearer token"},
+		"X-Tenant":      []string{"tenant"},
+	})
+	s.NoError(decoder.Decode(mutableState, event))
+
+	s.Equal(`["decoded heartbeat"]`, formatPayloads(mutableState.ActivityInfos[5].LastHeartbeatDetails))
+	s.Equal("decoded memo", renderPayload(mutableState.ExecutionInfo.Memo["key"]))
+	s.Equal(`["decoded input"]`, formatPayloads(event.GetWorkflowExecutionStartedEventAttributes().GetInput()))
+}
+
+func TestPayloadDecoder_NoCodec(t *testing.T) {
+	s := require.New(t)
+
+	compressed, err := payload.Compress(&commonpb.Payloads{Payloads: []*commonpb.Payload{payload.EncodeString("result")}})
+	s.NoError(err)
+	mutableState := &persistencespb.WorkflowMutableState{
+		ActivityInfos: map[int64]*persistencespb.ActivityInfo{
+			5: {LastHeartbeatDetails: compressed},
+		},
+	}
+
+	s.NoError(newPayloadDecoder("", "test-namespace", nil).Decode(mutableState))
+	s.Equal(`["result"]`, formatPayloads(mutableState.ActivityInfos[5].LastHeartbeatDetails))
+}
+
+func TestAdminDecodeProto_CodecFlags(t *testing.T) {
+	s := require.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.Equal("Bearer token", r.Header.Get("Authorization"))
+		s.Equal([]string{"a", "b=c"}, r.Header.Values("X-Tenant"))
+
+		b, err := protojson.Marshal(&commonpb.Payloads{Payloads: []*commonpb.Payload{payload.EncodeString("decoded")}})
+		s.NoError(err)
+		_, _ = w.Write(b)
+	}))
+	defer server.Close()
+
+	data, err := proto.Marshal(&commonpb.Payloads{Payloads: []*commonpb.Payload{encryptedPayload("input")}})
+	s.NoError(err)
+	args := []string{
+		"tdbg", "decode", "proto",
+		"--" + FlagProtoType, "temporal.api.common.v1.Payloads",
+		"--" + FlagHexData, hex.EncodeToString(data),
+		"--" + FlagCodecEndpoint, server.URL,
+		"--" + FlagCodecAuth, "Bearer token",
+		"--" + FlagCodecHeader, "X-Tenant=a",
+		"--" + FlagCodecHeader, "X-Tenant=b=c",
+	}
+
+	var output bytes.Buffer
+	app := NewCliApp(func(params *Params) {
+		params.Writer = &output
+	})
+	app.ExitErrHandler = func(*cli.Context, error) {}
+	s.NoError(app.Run(args))
+	s.JSONEq(`["decoded"]`, output.String())
+
+	s.ErrorContains(app.Run(append(args, "--"+FlagCodecHeader, "X-Tenant")), "invalid codec header")
+}
+
+func TestRenderPayload(t *testing.T) {
+	jsonPayload, err := payload.Encode(map[string]int{"a": 1})
+	require.NoError(t, err)
+	nilPayload, err := payload.Encode(nil)
+	require.NoError(t, err)
+	protoPayload, err := converter.NewProtoPayloadConverter().ToPayload(&commonpb.WorkflowType{Name: "type"})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		payload  *commonpb.Payload
+		expected interface{}
+	}{
+		{
+			name:     "json",
+			payload:  jsonPayload,
+			expected: map[string]interface{}{"a": float64(1)},
+		},
+		{
+			name:     "nil",
+			payload:  nilPayload,
+			expected: nil,
+		},
+		{
+			name:     "binary",
+			payload:  payload.EncodeBytes([]byte("bytes")),
+			expected: "bytes",
+		},
+		{
+			name:     "proto",
+			payload:  protoPayload,
+			expected: map[string]interface{}{"name": "type"},
+		},
+		{
+			name:     "unknown encoding",
+			payload:  encryptedPayload("secret"),
+			expected: map[string]interface{}{"encoding": "binary/encrypted", "data": "c2VjcmV0"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, renderPayload(tc.payload))
+		})
+	}
+}
+
+func TestRenderMessage(t *testing.T) {
+	s := require.New(t)
+
+	expiry := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	mutableState := &persistencespb.WorkflowMutableState{
+		TimerInfos: map[string]*persistencespb.TimerInfo{
+			"timer": {TimerId: "timer", StartedEventId: 7, ExpiryTime: timestamppb.New(expiry)},
+		},
+	}
+
+	rendered := renderMessage(mutableState.ProtoReflect())
+	s.Equal(map[string]interface{}{
+		"timerInfos": map[string]interface{}{
+			"timer": map[string]interface{}{
+				"timerId":        "timer",
+				"startedEventId": int64(7),
+				"expiryTime":     "2024-01-02T03:04:05Z",
+			},
+		},
+	}, rendered)
+}
+
+func TestPendingRows(t *testing.T) {
+	s := require.New(t)
+
+	expiry := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	mutableState := &persistencespb.WorkflowMutableState{
+		ActivityInfos: map[int64]*persistencespb.ActivityInfo{
+			9: {ScheduledEventId: 9, ActivityId: "b", ActivityType: &commonpb.ActivityType{Name: "type"}, Attempt: 2},
+			5: {
+				ScheduledEventId: 5,
+				ActivityId:       "a",
+				StartedEventId:   6,
+				RetryLastFailure: &failurepb.Failure{Message: "boom"},
+			},
+		},
+		TimerInfos: map[string]*persistencespb.TimerInfo{
+			"timer": {TimerId: "timer", StartedEventId: 7, ExpiryTime: timestamppb.New(expiry), TaskStatus: 1},
+		},
+		ChildExecutionInfos: map[int64]*persistencespb.ChildExecutionInfo{
+			8: {
+				InitiatedEventId:  8,
+				StartedEventId:    10,
+				Namespace:         "child-namespace",
+				WorkflowTypeName:  "child-type",
+				StartedWorkflowId: "child-id",
+				StartedRunId:      "child-run",
+			},
+		},
+	}
+
+	s.Equal([]interface{}{
+		pendingActivityRow{ScheduledEventID: 5, ActivityID: "a", StartedEventID: 6, LastFailure: "boom"},
+		pendingActivityRow{ScheduledEventID: 9, ActivityID: "b", ActivityType: "type", Attempt: 2},
+	}, pendingActivityRows(mutableState))
+	s.Equal([]interface{}{
+		pendingTimerRow{TimerID: "timer", StartedEventID: 7, ExpiryTime: "2024-01-02T03:04:05Z", TaskStatus: 1},
+	}, pendingTimerRows(mutableState))
+	s.Equal([]interface{}{
+		pendingChildRow{
+			InitiatedEventID: 8,
+			StartedEventID:   10,
+			Namespace:        "child-namespace",
+			WorkflowType:     "child-type",
+			WorkflowID:       "child-id",
+			RunID:            "child-run",
+		},
+	}, pendingChildRows(mutableState))
+}
@@ -39,6 +39,8 @@ type (
 		reader     io.Reader
 		exiter     func(code int)
 		flagLookup BoolFlagLookup
+		// lineReader is shared by all prompts so that input buffered for one prompt isn't lost for the next.
+		lineReader *bufio.Reader
 	}
 	// PrompterParams is used to configure a new Prompter.
 	PrompterParams struct {
@@ -92,13 +94,32 @@ func (p *Prompter) Prompt(msg string) {
 	if err != nil {
 		panic(fmt.Errorf("failed to write prompt: %w", err))
 	}
-	reader := bufio.NewReader(p.reader)
-	text, err := reader.ReadString('\n')
-	if err != nil {
-		panic(fmt.Errorf("failed to read prompt: %w", err))
-	}
-	textLower := strings.ToLower(strings.TrimSpace(text))
+	textLower := strings.ToLower(p.readLine())
 	if textLower != "y" && textLower != "yes" {
 		p.exiter(1)
 	}
 }
+
+// Ask the user for free-form input and return their response without leading or trailing space. If the --yes flag is
+// set, defaultResponse is returned without prompting.
+func (p *Prompter) Ask(msg string, defaultResponse string) string {
+	if p.flagLookup.Bool(FlagYes) {
+		return defaultResponse
+	}
+	_, err := p.writer.Write([]byte(msg + ": "))
+	if err != nil {
+		panic(fmt.Errorf("failed to write prompt: %w", err))
+	}
+	return p.readLine()
+}
+
+func (p *Prompter) readLine() string {
+	if p.lineReader == nil {
+		p.lineReader = bufio.NewReader(p.reader)
+	}
+	text, err := p.lineReader.ReadString('\n')
+	if err != nil {
+		panic(fmt.Errorf("failed to read prompt: %w", err))
+	}
+	return strings.TrimSpace(text)
+}
@@ -139,6 +139,21 @@ func TestPrompter(t *testing.T) {
 	}
 }
 
+// TestPrompterAsk verifies that consecutive calls to Ask each consume one line of input and that --yes skips prompting.
+func TestPrompterAsk(t *testing.T) {
+	writer := &testWriter{}
+	prompter := tdbg.NewPrompter(testFlagLookup{t: t}, func(params *tdbg.PrompterParams) {
+		params.Writer = writer
+		params.Reader = &testReader{reader: bytes.NewBufferString(" 1,2 \nall\n")}
+	})
+	assert.Equal(t, "1,2", prompter.Ask("first", "none"))
+	assert.Equal(t, "all", prompter.Ask("second", "none"))
+	assert.Equal(t, "first: second: ", writer.buffer.String())
+
+	autoConfirmPrompter := tdbg.NewPrompter(testFlagLookup{t: t, autoConfirm: true})
+	assert.Equal(t, "none", autoConfirmPrompter.Ask("prompt", "none"))
+}
+
 // Write is the implementation of the io.Writer interface for testWriter.
 // It writes bytes to the buffer or returns an error if configured to do so.
 func (t *testWriter) Write(p []byte) (n int, err error) {
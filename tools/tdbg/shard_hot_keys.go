@@ -0,0 +1,366 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli/v2"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/service/history/tasks"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	shardHotKeyID struct {
+		NamespaceID string
+		WorkflowID  string
+	}
+
+	// shardHotKey is the load a single workflow ID puts on a shard.
+	shardHotKey struct {
+		NamespaceID     string         `json:"namespaceId"`
+		Namespace       string         `json:"namespace,omitempty"`
+		WorkflowID      string         `json:"workflowId"`
+		PendingTasks    int            `json:"pendingTasks"`
+		TasksByCategory map[string]int `json:"tasksByCategory"`
+
+		// Only set if the history of the workflow was sampled.
+		History *shardHotKeyHistory `json:"history,omitempty"`
+	}
+
+	shardHotKeyHistory struct {
+		RunID            string    `json:"runId"`
+		Events           int64     `json:"events"`
+		SizeBytes        int64     `json:"sizeBytes"`
+		StateTransitions int64     `json:"stateTransitions"`
+		LastUpdateTime   time.Time `json:"lastUpdateTime"`
+		// Events and state transitions appended while sampling, only set if a sample interval is given.
+		RecentEvents      *int64 `json:"recentEvents,omitempty"`
+		RecentTransitions *int64 `json:"recentTransitions,omitempty"`
+	}
+
+	shardHotKeyReport struct {
+		ShardID         int32          `json:"shardId"`
+		SampledTasks    map[string]int `json:"sampledTasks"`
+		TruncatedSample []string       `json:"truncatedSample,omitempty"`
+		HotKeys         []*shardHotKey `json:"hotKeys"`
+	}
+
+	shardHotKeyRow struct {
+		Rank              int
+		Namespace         string
+		WorkflowID        string
+		PendingTasks      int
+		Share             string
+		TasksByCategory   string
+		HistoryEvents     string
+		HistorySize       string
+		RecentEvents      string
+		RecentTransitions string
+	}
+)
+
+// AdminShardHotKeys samples the pending tasks of all task categories of a shard and the history of the
+// workflows owning most of them, and prints the workflow IDs ranked by their share of the shard's load.
+func AdminShardHotKeys(c *cli.Context, clientFactory ClientFactory, registry tasks.TaskCategoryRegistry) error {
+	shardID := int32(c.Int(FlagShardID))
+	sampleSize := c.Int(FlagSampleSize)
+	if sampleSize <= 0 {
+		return fmt.Errorf("--%s must be positive", FlagSampleSize)
+	}
+	pageSize := defaultPageSize
+	if c.IsSet(FlagPageSize) {
+		pageSize = c.Int(FlagPageSize)
+	}
+	topN := c.Int(FlagTopN)
+	adminClient := clientFactory.AdminClient(c)
+
+	report := &shardHotKeyReport{
+		ShardID:      shardID,
+		SampledTasks: make(map[string]int),
+	}
+	hotKeys := make(map[shardHotKeyID]*shardHotKey)
+	for _, categoryID := range sortedKeys(registry.GetCategories()) {
+		category := registry.GetCategories()[categoryID]
+		if category == tasks.CategoryMemoryTimer {
+			// memory timer tasks are never persisted
+			continue
+		}
+		truncated, err := sampleShardTasks(c, adminClient, shardID, category, sampleSize, pageSize, report, hotKeys)
+		if err != nil {
+			return fmt.Errorf("unable to list %s tasks of shard %d: %w", category.Name(), shardID, err)
+		}
+		if truncated {
+			report.TruncatedSample = append(report.TruncatedSample, category.Name())
+		}
+	}
+	report.HotKeys = rankShardHotKeys(hotKeys, topN)
+
+	if !c.Bool(FlagSkipHistory) {
+		if err := sampleHotKeyHistory(c, clientFactory, report.HotKeys, c.Duration(FlagSampleInterval)); err != nil {
+			return err
+		}
+	}
+
+	if c.Bool(FlagPrintJSON) {
+		prettyPrintJSONObject(c, report)
+		return nil
+	}
+	printShardHotKeyReport(c, report)
+	return nil
+}
+
+// sampleShardTasks reads up to sampleSize pending tasks of the category and adds them to the load of their
+// workflow IDs. It returns true if the shard has more pending tasks of the category than were sampled.
+func sampleShardTasks(
+	c *cli.Context,
+	adminClient adminservice.AdminServiceClient,
+	shardID int32,
+	category tasks.Category,
+	sampleSize int,
+	pageSize int,
+	report *shardHotKeyReport,
+	hotKeys map[shardHotKeyID]*shardHotKey,
+) (bool, error) {
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	req := &adminservice.ListHistoryTasksRequest{
+		ShardId:  shardID,
+		Category: int32(category.ID()),
+		TaskRange: &historyspb.TaskRange{
+			InclusiveMinTaskKey: &historyspb.TaskKey{
+				FireTime: timestamppb.New(tasks.MinimumKey.FireTime),
+				TaskId:   tasks.MinimumKey.TaskID,
+			},
+			ExclusiveMaxTaskKey: &historyspb.TaskKey{
+				FireTime: timestamppb.New(tasks.MaximumKey.FireTime),
+				TaskId:   tasks.MaximumKey.TaskID,
+			},
+		},
+	}
+	sampled := 0
+	for {
+		req.BatchSize = int32(min(pageSize, sampleSize-sampled))
+		resp, err := adminClient.ListHistoryTasks(ctx, req)
+		if err != nil {
+			return false, err
+		}
+		for _, task := range resp.GetTasks() {
+			id := shardHotKeyID{NamespaceID: task.GetNamespaceId(), WorkflowID: task.GetWorkflowId()}
+			hotKey, ok := hotKeys[id]
+			if !ok {
+				hotKey = &shardHotKey{
+					NamespaceID:     id.NamespaceID,
+					WorkflowID:      id.WorkflowID,
+					TasksByCategory: make(map[string]int),
+				}
+				hotKeys[id] = hotKey
+			}
+			hotKey.PendingTasks++
+			hotKey.TasksByCategory[category.Name()]++
+		}
+		sampled += len(resp.GetTasks())
+		report.SampledTasks[category.Name()] = sampled
+		if len(resp.GetNextPageToken()) == 0 {
+			return false, nil
+		}
+		if sampled >= sampleSize {
+			return true, nil
+		}
+		req.NextPageToken = resp.GetNextPageToken()
+	}
+}
+
+// rankShardHotKeys returns the topN workflow IDs with the most pending tasks. Ties are broken by namespace and
+// workflow ID so that the report is stable.
+func rankShardHotKeys(hotKeys map[shardHotKeyID]*shardHotKey, topN int) []*shardHotKey {
+	ranked := make([]*shardHotKey, 0, len(hotKeys))
+	for _, hotKey := range hotKeys {
+		ranked = append(ranked, hotKey)
+	}
+	slices.SortFunc(ranked, func(a, b *shardHotKey) int {
+		if a.PendingTasks != b.PendingTasks {
+			return b.PendingTasks - a.PendingTasks
+		}
+		if a.NamespaceID != b.NamespaceID {
+			return strings.Compare(a.NamespaceID, b.NamespaceID)
+		}
+		return strings.Compare(a.WorkflowID, b.WorkflowID)
+	})
+	if topN > 0 && len(ranked) > topN {
+		ranked = ranked[:topN]
+	}
+	return ranked
+}
+
+// sampleHotKeyHistory describes the current run of each hot key. If interval is positive, the runs are
+// described again after it passed, to measure how fast their history grows.
+func sampleHotKeyHistory(
+	c *cli.Context,
+	clientFactory ClientFactory,
+	hotKeys []*shardHotKey,
+	interval time.Duration,
+) error {
+	adminClient := clientFactory.AdminClient(c)
+	namespaces := make(map[string]string)
+	for _, hotKey := range hotKeys {
+		name, ok := namespaces[hotKey.NamespaceID]
+		if !ok {
+			ctx, cancel := newContext(c)
+			resp, err := clientFactory.WorkflowClient(c).DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{
+				Id: hotKey.NamespaceID,
+			})
+			cancel()
+			if err != nil {
+				// the namespace may have been deleted while its tasks are still pending
+				fmt.Fprintf(c.App.ErrWriter, "unable to describe namespace %s: %v\n", hotKey.NamespaceID, err)
+			}
+			name = resp.GetNamespaceInfo().GetName()
+			namespaces[hotKey.NamespaceID] = name
+		}
+		hotKey.Namespace = name
+	}
+
+	describe := func() map[*shardHotKey]*shardHotKeyHistory {
+		histories := make(map[*shardHotKey]*shardHotKeyHistory)
+		for _, hotKey := range hotKeys {
+			if hotKey.Namespace == "" {
+				continue
+			}
+			history, err := describeHotKeyHistory(c, adminClient, hotKey)
+			if err != nil {
+				fmt.Fprintf(c.App.ErrWriter, "unable to describe workflow %s: %v\n", hotKey.WorkflowID, err)
+				continue
+			}
+			histories[hotKey] = history
+		}
+		return histories
+	}
+
+	before := describe()
+	if interval <= 0 {
+		for hotKey, history := range before {
+			hotKey.History = history
+		}
+		return nil
+	}
+
+	select {
+	case <-c.Context.Done():
+		return c.Context.Err()
+	case <-time.After(interval):
+	}
+	for hotKey, history := range describe() {
+		recentEvents, recentTransitions := history.Events, history.StateTransitions
+		if previous, ok := before[hotKey]; ok && previous.RunID == history.RunID {
+			recentEvents -= previous.Events
+			recentTransitions -= previous.StateTransitions
+		}
+		history.RecentEvents = &recentEvents
+		history.RecentTransitions = &recentTransitions
+		hotKey.History = history
+	}
+	return nil
+}
+
+func describeHotKeyHistory(
+	c *cli.Context,
+	adminClient adminservice.AdminServiceClient,
+	hotKey *shardHotKey,
+) (*shardHotKeyHistory, error) {
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DescribeMutableState(ctx, &adminservice.DescribeMutableStateRequest{
+		Namespace: hotKey.Namespace,
+		Execution: &commonpb.WorkflowExecution{WorkflowId: hotKey.WorkflowID},
+	})
+	if err != nil {
+		return nil, err
+	}
+	mutableState := resp.GetDatabaseMutableState()
+	executionInfo := mutableState.GetExecutionInfo()
+	return &shardHotKeyHistory{
+		RunID:            mutableState.GetExecutionState().GetRunId(),
+		Events:           mutableState.GetNextEventId() - 1,
+		SizeBytes:        executionInfo.GetExecutionStats().GetHistorySize(),
+		StateTransitions: executionInfo.GetStateTransitionCount(),
+		LastUpdateTime:   executionInfo.GetLastUpdateTime().AsTime(),
+	}, nil
+}
+
+func printShardHotKeyReport(c *cli.Context, report *shardHotKeyReport) {
+	w := c.App.Writer
+	total := 0
+	for _, category := range sortedKeys(report.SampledTasks) {
+		fmt.Fprintf(w, "Sampled %d %s tasks\n", report.SampledTasks[category], category)
+		total += report.SampledTasks[category]
+	}
+	if len(report.TruncatedSample) > 0 {
+		fmt.Fprintf(w, "Sample size reached for %s, the shard has more pending tasks than were sampled\n",
+			strings.Join(report.TruncatedSample, ", "))
+	}
+	if len(report.HotKeys) == 0 {
+		fmt.Fprintf(w, "Shard %d has no pending tasks\n", report.ShardID)
+		return
+	}
+
+	var rows []interface{}
+	for i, hotKey := range report.HotKeys {
+		var byCategory []string
+		for _, category := range sortedKeys(hotKey.TasksByCategory) {
+			byCategory = append(byCategory, fmt.Sprintf("%s=%d", category, hotKey.TasksByCategory[category]))
+		}
+		row := &shardHotKeyRow{
+			Rank:            i + 1,
+			Namespace:       hotKey.Namespace,
+			WorkflowID:      hotKey.WorkflowID,
+			PendingTasks:    hotKey.PendingTasks,
+			Share:           fmt.Sprintf("%.1f%%", 100*float64(hotKey.PendingTasks)/float64(total)),
+			TasksByCategory: strings.Join(byCategory, ","),
+		}
+		if row.Namespace == "" {
+			row.Namespace = hotKey.NamespaceID
+		}
+		if history := hotKey.History; history != nil {
+			row.HistoryEvents = fmt.Sprint(history.Events)
+			row.HistorySize = fmt.Sprint(history.SizeBytes)
+			if history.RecentEvents != nil {
+				row.RecentEvents = fmt.Sprint(*history.RecentEvents)
+				row.RecentTransitions = fmt.Sprint(*history.RecentTransitions)
+			}
+		}
+		rows = append(rows, row)
+	}
+	if err := printTable(rows, w); err != nil {
+		fmt.Fprintf(c.App.ErrWriter, "unable to print hot keys: %v\n", err)
+	}
+}
@@ -0,0 +1,177 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+	namespacepb "go.temporal.io/api/namespace/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/service/history/tasks"
+	"google.golang.org/grpc"
+)
+
+type (
+	hotKeysClient struct {
+		adminservice.AdminServiceClient
+
+		tasks        map[int32][]*adminservice.Task
+		nextEventIDs map[string][]int64
+	}
+
+	hotKeysWorkflowClient struct {
+		workflowservice.WorkflowServiceClient
+	}
+)
+
+func (h *hotKeysClient) AdminClient(*cli.Context) adminservice.AdminServiceClient {
+	return h
+}
+
+func (h *hotKeysClient) WorkflowClient(*cli.Context) workflowservice.WorkflowServiceClient {
+	return &hotKeysWorkflowClient{}
+}
+
+func (h *hotKeysClient) ListHistoryTasks(
+	_ context.Context,
+	request *adminservice.ListHistoryTasksRequest,
+	_ ...grpc.CallOption,
+) (*adminservice.ListHistoryTasksResponse, error) {
+	categoryTasks := h.tasks[request.GetCategory()]
+	offset := 0
+	if len(request.GetNextPageToken()) > 0 {
+		offset = int(request.GetNextPageToken()[0])
+	}
+	end := min(offset+int(request.GetBatchSize()), len(categoryTasks))
+	resp := &adminservice.ListHistoryTasksResponse{Tasks: categoryTasks[offset:end]}
+	if end < len(categoryTasks) {
+		resp.NextPageToken = []byte{byte(end)}
+	}
+	return resp, nil
+}
+
+func (h *hotKeysWorkflowClient) DescribeNamespace(
+	_ context.Context,
+	request *workflowservice.DescribeNamespaceRequest,
+	_ ...grpc.CallOption,
+) (*workflowservice.DescribeNamespaceResponse, error) {
+	return &workflowservice.DescribeNamespaceResponse{
+		NamespaceInfo: &namespacepb.NamespaceInfo{Id: request.GetId(), Name: "ns-" + request.GetId()},
+	}, nil
+}
+
+func (h *hotKeysClient) DescribeMutableState(
+	_ context.Context,
+	request *adminservice.DescribeMutableStateRequest,
+	_ ...grpc.CallOption,
+) (*adminservice.DescribeMutableStateResponse, error) {
+	workflowID := request.GetExecution().GetWorkflowId()
+	nextEventID := h.nextEventIDs[workflowID][0]
+	h.nextEventIDs[workflowID] = h.nextEventIDs[workflowID][1:]
+	return &adminservice.DescribeMutableStateResponse{
+		DatabaseMutableState: &persistencespb.WorkflowMutableState{
+			ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+				StateTransitionCount: nextEventID,
+				ExecutionStats:       &persistencespb.ExecutionStats{HistorySize: 100 * nextEventID},
+			},
+			ExecutionState: &persistencespb.WorkflowExecutionState{RunId: "run-" + workflowID},
+			NextEventId:    nextEventID,
+		},
+	}, nil
+}
+
+func TestRankShardHotKeys(t *testing.T) {
+	hotKeys := map[shardHotKeyID]*shardHotKey{
+		{NamespaceID: "ns", WorkflowID: "b"}:  {NamespaceID: "ns", WorkflowID: "b", PendingTasks: 2},
+		{NamespaceID: "ns", WorkflowID: "a"}:  {NamespaceID: "ns", WorkflowID: "a", PendingTasks: 2},
+		{NamespaceID: "ns", WorkflowID: "c"}:  {NamespaceID: "ns", WorkflowID: "c", PendingTasks: 5},
+		{NamespaceID: "ns2", WorkflowID: "c"}: {NamespaceID: "ns2", WorkflowID: "c", PendingTasks: 1},
+	}
+
+	ranked := rankShardHotKeys(hotKeys, 3)
+	require.Len(t, ranked, 3)
+	assert.Equal(t, "c", ranked[0].WorkflowID)
+	assert.Equal(t, "a", ranked[1].WorkflowID)
+	assert.Equal(t, "b", ranked[2].WorkflowID)
+	assert.Len(t, rankShardHotKeys(hotKeys, 0), 4)
+}
+
+func TestShardHotKeys(t *testing.T) {
+	newTask := func(workflowID string) *adminservice.Task {
+		return &adminservice.Task{NamespaceId: "1", WorkflowId: workflowID, RunId: "run-" + workflowID}
+	}
+	client := &hotKeysClient{
+		tasks: map[int32][]*adminservice.Task{
+			int32(tasks.CategoryTransfer.ID()): {newTask("hot"), newTask("hot"), newTask("cold"), newTask("hot")},
+			int32(tasks.CategoryTimer.ID()):    {newTask("hot"), newTask("cold")},
+		},
+		nextEventIDs: map[string][]int64{
+			"hot":  {11, 31},
+			"cold": {3, 4},
+		},
+	}
+	var out bytes.Buffer
+	app := NewCliApp(func(params *Params) {
+		params.ClientFactory = client
+	})
+	app.Writer = &out
+
+	err := app.Run([]string{"tdbg", "shard", "hot-keys",
+		"--shard-id", "1",
+		"--sample-size", "3",
+		"--pagesize", "2",
+		"--sample-interval", "1ms",
+		"--print-json",
+	})
+	require.NoError(t, err)
+
+	var report shardHotKeyReport
+	require.NoError(t, json.Unmarshal(out.Bytes(), &report))
+	assert.Equal(t, 3, report.SampledTasks["transfer"])
+	assert.Equal(t, 2, report.SampledTasks["timer"])
+	assert.Equal(t, 0, report.SampledTasks["visibility"])
+	assert.Equal(t, []string{"transfer"}, report.TruncatedSample)
+	require.Len(t, report.HotKeys, 2)
+
+	hot := report.HotKeys[0]
+	assert.Equal(t, "hot", hot.WorkflowID)
+	assert.Equal(t, "ns-1", hot.Namespace)
+	assert.Equal(t, 3, hot.PendingTasks)
+	assert.Equal(t, map[string]int{"transfer": 2, "timer": 1}, hot.TasksByCategory)
+	require.NotNil(t, hot.History)
+	assert.Equal(t, int64(30), hot.History.Events)
+	assert.Equal(t, int64(20), *hot.History.RecentEvents)
+	assert.Equal(t, int64(20), *hot.History.RecentTransitions)
+
+	cold := report.HotKeys[1]
+	assert.Equal(t, "cold", cold.WorkflowID)
+	assert.Equal(t, int64(1), *cold.History.RecentEvents)
+}
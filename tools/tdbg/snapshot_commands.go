@@ -0,0 +1,470 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/urfave/cli/v2"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	clispb "go.temporal.io/server/api/cli/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	snapshotHistoryPageSize = 100
+)
+
+type (
+	// namespaceSnapshotter exports the executions of a namespace into a snapshot file and imports a snapshot file into
+	// a cluster. A snapshot seeds a new cluster with the mutable states and histories of all executions so that
+	// replication only has to catch up from the snapshot time, instead of force replicating every execution.
+	namespaceSnapshotter struct {
+		adminClient adminservice.AdminServiceClient
+		rpcTimeout  time.Duration
+		// pageSize is the number of executions exported per request, the server's limit if 0.
+		pageSize int32
+		progress io.Writer
+	}
+)
+
+func newAdminSnapshotCommands(clientFactory ClientFactory) []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "export",
+			Usage: "Export the mutable states and histories of all executions of a namespace to a snapshot file",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagOutputFilename,
+					Usage:    "Snapshot file to write",
+					Required: true,
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Usage: "Number of executions exported per request, defaults to the server's limit",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminExportNamespaceSnapshot(c, clientFactory)
+			},
+		},
+		{
+			Name:  "import",
+			Usage: "Import a snapshot file into the cluster, the namespace must already exist on the cluster",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagInputFilename,
+					Usage:    "Snapshot file to read",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminImportNamespaceSnapshot(c, clientFactory)
+			},
+		},
+	}
+}
+
+// AdminExportNamespaceSnapshot exports a namespace snapshot
+func AdminExportNamespaceSnapshot(c *cli.Context, clientFactory ClientFactory) error {
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	nsID, err := getNamespaceID(c, clientFactory, namespace.Name(nsName))
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Create(c.String(FlagOutputFilename))
+	if err != nil {
+		return fmt.Errorf("unable to create snapshot file: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+	writer := bufio.NewWriter(file)
+
+	snapshotter := newNamespaceSnapshotter(c, clientFactory)
+	count, err := snapshotter.export(c.Context, writer, nsName, nsID, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+	if err := writer.Flush(); err != nil {
+		return fmt.Errorf("unable to write snapshot file: %w", err)
+	}
+	fmt.Fprintf(c.App.Writer, "Exported %d executions.\n", count)
+	return nil
+}
+
+// AdminImportNamespaceSnapshot imports a namespace snapshot
+func AdminImportNamespaceSnapshot(c *cli.Context, clientFactory ClientFactory) error {
+	file, err := os.Open(c.String(FlagInputFilename))
+	if err != nil {
+		return fmt.Errorf("unable to open snapshot file: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	// The snapshot is imported into the namespace it was exported from, unless another one is set explicitly.
+	var nsName string
+	if c.IsSet(FlagNamespace) {
+		nsName = c.String(FlagNamespace)
+	}
+
+	snapshotter := newNamespaceSnapshotter(c, clientFactory)
+	count, err := snapshotter.importSnapshot(c.Context, file, nsName)
+	fmt.Fprintf(c.App.Writer, "Imported %d executions.\n", count)
+	return err
+}
+
+// AdminExportWorkflowHistory exports all history branches of a workflow execution. The file has the format of a
+// namespace snapshot, so it's imported the same way.
+func AdminExportWorkflowHistory(c *cli.Context, clientFactory ClientFactory) error {
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return err
+	}
+	nsID, err := getNamespaceID(c, clientFactory, namespace.Name(nsName))
+	if err != nil {
+		return err
+	}
+
+	snapshotter := newNamespaceSnapshotter(c, clientFactory)
+	snapshots, err := snapshotter.exportWorkflow(c.Context, nsName, nsID, &commonpb.WorkflowExecution{
+		WorkflowId: wid,
+		RunId:      c.String(FlagRunID),
+	})
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Create(c.String(FlagOutputFilename))
+	if err != nil {
+		return fmt.Errorf("unable to create history file: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+	writer := bufio.NewWriter(file)
+	if _, err := protodelim.MarshalTo(writer, &clispb.NamespaceSnapshotHeader{
+		Namespace:    nsName,
+		NamespaceId:  nsID.String(),
+		SnapshotTime: timestamppb.New(time.Now().UTC()),
+	}); err != nil {
+		return fmt.Errorf("unable to write history file header: %w", err)
+	}
+	for _, snapshot := range snapshots {
+		if _, err := protodelim.MarshalTo(writer, snapshot); err != nil {
+			return fmt.Errorf("unable to write history branch: %w", err)
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		return fmt.Errorf("unable to write history file: %w", err)
+	}
+	fmt.Fprintf(c.App.Writer, "Exported %d history branches of %v.\n", len(snapshots), snapshots[0].GetExecution())
+	return nil
+}
+
+func newNamespaceSnapshotter(c *cli.Context, clientFactory ClientFactory) *namespaceSnapshotter {
+	return &namespaceSnapshotter{
+		adminClient: clientFactory.AdminClient(c),
+		rpcTimeout:  getContextTimeout(c, defaultContextTimeout),
+		pageSize:    int32(c.Int(FlagPageSize)),
+		progress:    c.App.ErrWriter,
+	}
+}
+
+// export writes the snapshot header followed by one record per execution started before the snapshot time. The
+// executions are read in pages by the ExportWorkflowExecutions admin API, which keeps the snapshot time across pages.
+// Executions are read one after the other, so the records are not taken at a single point in time. Instead, each record
+// holds the mutable state of the execution together with the history up to the last event of that mutable state's
+// version history, read at or after the snapshot time. So every record is consistent on its own and no older than the
+// snapshot time, and replication catching up from the snapshot time brings all executions up to date.
+func (s *namespaceSnapshotter) export(
+	ctx context.Context,
+	w io.Writer,
+	nsName string,
+	nsID namespace.ID,
+	snapshotTime time.Time,
+) (int, error) {
+	if _, err := protodelim.MarshalTo(w, &clispb.NamespaceSnapshotHeader{
+		Namespace:    nsName,
+		NamespaceId:  nsID.String(),
+		SnapshotTime: timestamppb.New(snapshotTime),
+	}); err != nil {
+		return 0, fmt.Errorf("unable to write snapshot header: %w", err)
+	}
+
+	count := 0
+	var pageToken []byte
+	for {
+		exportCtx, cancel := context.WithTimeout(ctx, s.rpcTimeout)
+		resp, err := s.adminClient.ExportWorkflowExecutions(exportCtx, &adminservice.ExportWorkflowExecutionsRequest{
+			Namespace:       nsName,
+			SnapshotTime:    timestamppb.New(snapshotTime),
+			MaximumPageSize: s.pageSize,
+			NextPageToken:   pageToken,
+		})
+		cancel()
+		if err != nil {
+			return count, fmt.Errorf("unable to export workflow executions: %w", err)
+		}
+
+		for _, exported := range resp.GetExecutions() {
+			if _, err := protodelim.MarshalTo(w, &clispb.WorkflowExecutionSnapshot{
+				Execution:      exported.GetExecution(),
+				VersionHistory: exported.GetVersionHistory(),
+				HistoryBatches: exported.GetHistoryBatches(),
+				MutableState:   exported.GetMutableState(),
+			}); err != nil {
+				return count, fmt.Errorf("unable to write snapshot record: %w", err)
+			}
+			count++
+		}
+		fmt.Fprintf(s.progress, "Exported %d executions...\n", count)
+
+		pageToken = resp.GetNextPageToken()
+		if len(pageToken) == 0 {
+			return count, nil
+		}
+	}
+}
+
+// exportWorkflow returns one record per branch of the execution's history, the current branch first. This preserves
+// branches created by conflict resolution, which export drops.
+func (s *namespaceSnapshotter) exportWorkflow(
+	ctx context.Context,
+	nsName string,
+	nsID namespace.ID,
+	execution *commonpb.WorkflowExecution,
+) ([]*clispb.WorkflowExecutionSnapshot, error) {
+	describeCtx, cancel := context.WithTimeout(ctx, s.rpcTimeout)
+	describeResp, err := s.adminClient.DescribeMutableState(describeCtx, &adminservice.DescribeMutableStateRequest{
+		Namespace: nsName,
+		Execution: execution,
+	})
+	cancel()
+	if err != nil {
+		return nil, fmt.Errorf("unable to describe mutable state of %v: %w", execution, err)
+	}
+	mutableState := describeResp.GetDatabaseMutableState()
+	// The run ID may not have been given, so take it from the mutable state.
+	execution = &commonpb.WorkflowExecution{
+		WorkflowId: execution.GetWorkflowId(),
+		RunId:      mutableState.GetExecutionState().GetRunId(),
+	}
+
+	versionHistories := mutableState.GetExecutionInfo().GetVersionHistories()
+	currentIndex := int(versionHistories.GetCurrentVersionHistoryIndex())
+	if currentIndex >= len(versionHistories.GetHistories()) {
+		return nil, fmt.Errorf("invalid current version history index %d of %v", currentIndex, execution)
+	}
+	order := []int{currentIndex}
+	for i := range versionHistories.GetHistories() {
+		if i != currentIndex {
+			order = append(order, i)
+		}
+	}
+
+	snapshots := make([]*clispb.WorkflowExecutionSnapshot, 0, len(order))
+	for _, i := range order {
+		versionHistory := versionHistories.GetHistories()[i]
+		lastItem, err := versionhistory.GetLastVersionHistoryItem(versionHistory)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get version history of %v: %w", execution, err)
+		}
+		snapshot, err := s.exportBranch(ctx, nsID, execution, versionHistory, lastItem)
+		if err != nil {
+			return nil, err
+		}
+		if i == currentIndex {
+			snapshot.MutableState = mutableState
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots, nil
+}
+
+func (s *namespaceSnapshotter) exportBranch(
+	ctx context.Context,
+	nsID namespace.ID,
+	execution *commonpb.WorkflowExecution,
+	versionHistory *historyspb.VersionHistory,
+	lastItem *historyspb.VersionHistoryItem,
+) (*clispb.WorkflowExecutionSnapshot, error) {
+	snapshot := &clispb.WorkflowExecutionSnapshot{
+		Execution:      execution,
+		VersionHistory: versionhistory.CopyVersionHistory(versionHistory),
+	}
+	var pageToken []byte
+	for {
+		historyCtx, cancel := context.WithTimeout(ctx, s.rpcTimeout)
+		resp, err := s.adminClient.GetWorkflowExecutionRawHistory(historyCtx, &adminservice.GetWorkflowExecutionRawHistoryRequest{
+			NamespaceId:     nsID.String(),
+			Execution:       execution,
+			EndEventId:      lastItem.GetEventId(),
+			EndEventVersion: lastItem.GetVersion(),
+			MaximumPageSize: snapshotHistoryPageSize,
+			NextPageToken:   pageToken,
+		})
+		cancel()
+		if err != nil {
+			return nil, fmt.Errorf("unable to read history of %v: %w", execution, err)
+		}
+		snapshot.HistoryBatches = append(snapshot.HistoryBatches, resp.GetHistoryBatches()...)
+
+		pageToken = resp.GetNextPageToken()
+		if len(pageToken) == 0 {
+			return snapshot, nil
+		}
+	}
+}
+
+// importSnapshot imports every execution of the snapshot into nsName, or into the namespace the snapshot was exported
+// from if nsName is empty. The history of each execution is imported first, then its exported mutable state replaces
+// the one rebuilt from the history, so state which isn't recorded in history, like activity attempts and heartbeat
+// details, is kept. Failed executions are reported and skipped, so a partially failed import can be re-run.
+// Consecutive records of the same execution are further branches of its history, which are imported from the point
+// where they fork from the branches imported before them.
+func (s *namespaceSnapshotter) importSnapshot(
+	ctx context.Context,
+	r io.Reader,
+	nsName string,
+) (int, error) {
+	reader := bufio.NewReader(r)
+	header := &clispb.NamespaceSnapshotHeader{}
+	if err := protodelim.UnmarshalFrom(reader, header); err != nil {
+		return 0, fmt.Errorf("unable to read snapshot header: %w", err)
+	}
+	if nsName == "" {
+		nsName = header.GetNamespace()
+	}
+
+	count := 0
+	var errs []error
+	var previous *clispb.WorkflowExecutionSnapshot
+	// importedBranches is nil if importing the previous record failed.
+	var importedBranches *historyspb.VersionHistories
+	for {
+		snapshot := &clispb.WorkflowExecutionSnapshot{}
+		err := protodelim.UnmarshalFrom(reader, snapshot)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return count, fmt.Errorf("unable to read snapshot record: %w", err)
+		}
+
+		isBranch := previous != nil && proto.Equal(previous.GetExecution(), snapshot.GetExecution())
+		previous = snapshot
+		if isBranch && importedBranches == nil {
+			// The execution failed to import and was already reported.
+			continue
+		}
+
+		historyBatches := snapshot.GetHistoryBatches()
+		if isBranch {
+			historyBatches, err = forkedHistoryBatches(importedBranches, snapshot.GetVersionHistory(), historyBatches)
+		}
+		if err == nil && len(historyBatches) > 0 {
+			err = importWorkflowExecution(
+				ctx,
+				s.adminClient,
+				s.rpcTimeout,
+				nsName,
+				snapshot.GetExecution(),
+				snapshot.GetVersionHistory(),
+				historyBatches,
+				snapshot.GetMutableState(),
+			)
+		}
+		if err == nil {
+			if isBranch {
+				_, err = versionhistory.AddVersionHistory(importedBranches, snapshot.GetVersionHistory())
+			} else {
+				importedBranches = versionhistory.NewVersionHistories(snapshot.GetVersionHistory())
+			}
+		}
+		if err != nil {
+			fmt.Fprintf(s.progress, "Failed to import %v: %v\n", snapshot.GetExecution(), err)
+			errs = append(errs, err)
+			importedBranches = nil
+			continue
+		}
+		if !isBranch {
+			count++
+		}
+	}
+	if len(errs) > 0 {
+		return count, fmt.Errorf("failed to import %d executions: %w", len(errs), errors.Join(errs...))
+	}
+	return count, nil
+}
+
+// forkedHistoryBatches returns the batches of a branch that follow the point where it forks from the branches that
+// were already imported.
+func forkedHistoryBatches(
+	imported *historyspb.VersionHistories,
+	versionHistory *historyspb.VersionHistory,
+	historyBatches []*commonpb.DataBlob,
+) ([]*commonpb.DataBlob, error) {
+	lcaItem, _, err := versionhistory.FindLCAVersionHistoryItemAndIndex(imported, versionHistory)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find where the branch forks: %w", err)
+	}
+	serializer := serialization.NewSerializer()
+	for i, batch := range historyBatches {
+		events, err := serializer.DeserializeEvents(batch)
+		if err != nil {
+			return nil, fmt.Errorf("unable to deserialize history batch: %w", err)
+		}
+		if len(events) == 0 || events[len(events)-1].GetEventId() <= lcaItem.GetEventId() {
+			continue
+		}
+		if events[0].GetEventId() <= lcaItem.GetEventId() {
+			return nil, fmt.Errorf(
+				"branch forks after event %d, within the batch of events %d to %d",
+				lcaItem.GetEventId(),
+				events[0].GetEventId(),
+				events[len(events)-1].GetEventId(),
+			)
+		}
+		return historyBatches[i:], nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,251 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/adminservicemock/v1"
+	clispb "go.temporal.io/server/api/cli/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestNamespaceSnapshot_RoundTrip(t *testing.T) {
+	s := require.New(t)
+	ctrl := gomock.NewController(t)
+	adminClient := adminservicemock.NewMockAdminServiceClient(ctrl)
+	snapshotter := &namespaceSnapshotter{
+		adminClient: adminClient,
+		rpcTimeout:  time.Second,
+		pageSize:    1,
+		progress:    io.Discard,
+	}
+
+	nsID := namespace.ID("ns-id")
+	snapshotTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	running := &commonpb.WorkflowExecution{WorkflowId: "wid-1", RunId: "rid-1"}
+	completed := &commonpb.WorkflowExecution{WorkflowId: "wid-2", RunId: "rid-2"}
+	versionHistory := versionhistory.NewVersionHistory(nil, []*historyspb.VersionHistoryItem{
+		versionhistory.NewVersionHistoryItem(10, 2),
+	})
+	batches := []*commonpb.DataBlob{{Data: []byte("batch-1")}, {Data: []byte("batch-2")}}
+	mutableState := &persistencespb.WorkflowMutableState{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			VersionHistories: versionhistory.NewVersionHistories(versionHistory),
+		},
+		ExecutionState: &persistencespb.WorkflowExecutionState{RunId: running.RunId},
+		// activity attempts aren't recorded in history
+		ActivityInfos: map[int64]*persistencespb.ActivityInfo{5: {ScheduledEventId: 5, Attempt: 3}},
+	}
+
+	adminClient.EXPECT().ExportWorkflowExecutions(gomock.Any(), &adminservice.ExportWorkflowExecutionsRequest{
+		Namespace:       "ns",
+		SnapshotTime:    timestamppb.New(snapshotTime),
+		MaximumPageSize: 1,
+	}).Return(&adminservice.ExportWorkflowExecutionsResponse{
+		SnapshotTime: timestamppb.New(snapshotTime),
+		Executions: []*adminservice.ExportedWorkflowExecution{{
+			Execution:      running,
+			VersionHistory: versionHistory,
+			HistoryBatches: batches,
+			MutableState:   mutableState,
+		}},
+		NextPageToken: []byte("token"),
+	}, nil)
+	adminClient.EXPECT().ExportWorkflowExecutions(gomock.Any(), &adminservice.ExportWorkflowExecutionsRequest{
+		Namespace:       "ns",
+		SnapshotTime:    timestamppb.New(snapshotTime),
+		MaximumPageSize: 1,
+		NextPageToken:   []byte("token"),
+	}).Return(&adminservice.ExportWorkflowExecutionsResponse{
+		SnapshotTime: timestamppb.New(snapshotTime),
+		Executions: []*adminservice.ExportedWorkflowExecution{{
+			Execution:      completed,
+			VersionHistory: versionHistory,
+			HistoryBatches: batches,
+		}},
+	}, nil)
+
+	var buf bytes.Buffer
+	count, err := snapshotter.export(context.Background(), &buf, "ns", nsID, snapshotTime)
+	s.NoError(err)
+	s.Equal(2, count)
+
+	imported := make(map[string][]*commonpb.DataBlob)
+	importedMutableStates := make(map[string]*persistencespb.WorkflowMutableState)
+	adminClient.EXPECT().ImportWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *adminservice.ImportWorkflowExecutionRequest, _ ...any) (*adminservice.ImportWorkflowExecutionResponse, error) {
+			// every request gets the full timeout
+			deadline, ok := ctx.Deadline()
+			s.True(ok)
+			s.WithinDuration(time.Now().Add(snapshotter.rpcTimeout), deadline, 100*time.Millisecond)
+
+			s.Equal("ns", request.Namespace)
+			s.Equal(int64(10), request.VersionHistory.Items[0].EventId)
+			workflowID := request.Execution.WorkflowId
+			imported[workflowID] = append(imported[workflowID], request.HistoryBatches...)
+			if len(request.HistoryBatches) == 0 {
+				importedMutableStates[workflowID] = request.MutableState
+				return &adminservice.ImportWorkflowExecutionResponse{}, nil
+			}
+			s.Nil(request.MutableState, "mutable state must only be sent on commit")
+			return &adminservice.ImportWorkflowExecutionResponse{Token: []byte("token")}, nil
+		},
+	).MinTimes(4)
+
+	count, err = snapshotter.importSnapshot(context.Background(), &buf, "")
+	s.NoError(err)
+	s.Equal(2, count)
+	for _, execution := range []*commonpb.WorkflowExecution{running, completed} {
+		s.Len(imported[execution.WorkflowId], len(batches))
+		for i := range batches {
+			s.Equal(batches[i].Data, imported[execution.WorkflowId][i].Data)
+		}
+	}
+	s.True(proto.Equal(mutableState, importedMutableStates[running.WorkflowId]))
+	s.Nil(importedMutableStates[completed.WorkflowId])
+}
+
+func TestWorkflowHistory_ExportImportBranches(t *testing.T) {
+	s := require.New(t)
+	ctrl := gomock.NewController(t)
+	adminClient := adminservicemock.NewMockAdminServiceClient(ctrl)
+	snapshotter := &namespaceSnapshotter{
+		adminClient: adminClient,
+		rpcTimeout:  time.Second,
+		progress:    io.Discard,
+	}
+
+	serializer := serialization.NewSerializer()
+	newBatch := func(version int64, eventIDs ...int64) *commonpb.DataBlob {
+		var events []*historypb.HistoryEvent
+		for _, eventID := range eventIDs {
+			events = append(events, &historypb.HistoryEvent{EventId: eventID, Version: version})
+		}
+		blob, err := serializer.SerializeEvents(events, enumspb.ENCODING_TYPE_PROTO3)
+		s.NoError(err)
+		return blob
+	}
+	nsID := namespace.ID("ns-id")
+	execution := &commonpb.WorkflowExecution{WorkflowId: "wid", RunId: "rid"}
+	// The current branch was created by a failover to version 2 after event 3, the other branch by a failover to
+	// version 3 that lost the conflict resolution.
+	commonBatch := newBatch(1, 1, 2, 3)
+	currentBranch := versionhistory.NewVersionHistory([]byte("current"), []*historyspb.VersionHistoryItem{
+		versionhistory.NewVersionHistoryItem(3, 1),
+		versionhistory.NewVersionHistoryItem(5, 2),
+	})
+	currentBatches := []*commonpb.DataBlob{commonBatch, newBatch(2, 4, 5)}
+	otherBranch := versionhistory.NewVersionHistory([]byte("other"), []*historyspb.VersionHistoryItem{
+		versionhistory.NewVersionHistoryItem(3, 1),
+		versionhistory.NewVersionHistoryItem(4, 3),
+	})
+	otherBatches := []*commonpb.DataBlob{commonBatch, newBatch(3, 4)}
+
+	versionHistories := versionhistory.NewVersionHistories(otherBranch)
+	_, err := versionhistory.AddVersionHistory(versionHistories, currentBranch)
+	s.NoError(err)
+	s.NoError(versionhistory.SetCurrentVersionHistoryIndex(versionHistories, 1))
+	adminClient.EXPECT().DescribeMutableState(gomock.Any(), &adminservice.DescribeMutableStateRequest{
+		Namespace: "ns",
+		Execution: &commonpb.WorkflowExecution{WorkflowId: "wid"},
+	}).Return(&adminservice.DescribeMutableStateResponse{
+		DatabaseMutableState: &persistencespb.WorkflowMutableState{
+			ExecutionInfo:  &persistencespb.WorkflowExecutionInfo{VersionHistories: versionHistories},
+			ExecutionState: &persistencespb.WorkflowExecutionState{RunId: "rid"},
+		},
+	}, nil)
+	adminClient.EXPECT().GetWorkflowExecutionRawHistory(gomock.Any(), &adminservice.GetWorkflowExecutionRawHistoryRequest{
+		NamespaceId:     nsID.String(),
+		Execution:       execution,
+		EndEventId:      5,
+		EndEventVersion: 2,
+		MaximumPageSize: snapshotHistoryPageSize,
+	}).Return(&adminservice.GetWorkflowExecutionRawHistoryResponse{HistoryBatches: currentBatches}, nil)
+	adminClient.EXPECT().GetWorkflowExecutionRawHistory(gomock.Any(), &adminservice.GetWorkflowExecutionRawHistoryRequest{
+		NamespaceId:     nsID.String(),
+		Execution:       execution,
+		EndEventId:      4,
+		EndEventVersion: 3,
+		MaximumPageSize: snapshotHistoryPageSize,
+	}).Return(&adminservice.GetWorkflowExecutionRawHistoryResponse{HistoryBatches: otherBatches}, nil)
+
+	snapshots, err := snapshotter.exportWorkflow(context.Background(), "ns", nsID, &commonpb.WorkflowExecution{WorkflowId: "wid"})
+	s.NoError(err)
+	s.Len(snapshots, 2)
+	s.Equal(int64(2), snapshots[0].VersionHistory.Items[1].Version, "the current branch must be first")
+	s.NotNil(snapshots[0].MutableState)
+	s.Nil(snapshots[1].MutableState)
+
+	var buf bytes.Buffer
+	_, err = protodelim.MarshalTo(&buf, &clispb.NamespaceSnapshotHeader{Namespace: "ns", NamespaceId: nsID.String()})
+	s.NoError(err)
+	for _, snapshot := range snapshots {
+		_, err = protodelim.MarshalTo(&buf, snapshot)
+		s.NoError(err)
+	}
+
+	importedByVersion := make(map[int64][]*commonpb.DataBlob)
+	adminClient.EXPECT().ImportWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *adminservice.ImportWorkflowExecutionRequest, _ ...any) (*adminservice.ImportWorkflowExecutionResponse, error) {
+			s.Equal("ns", request.Namespace)
+			s.Equal(execution.RunId, request.Execution.RunId)
+			lastVersion := request.VersionHistory.Items[len(request.VersionHistory.Items)-1].Version
+			importedByVersion[lastVersion] = append(importedByVersion[lastVersion], request.HistoryBatches...)
+			if lastVersion != 2 || len(request.HistoryBatches) != 0 {
+				s.Nil(request.MutableState)
+			}
+			if len(request.HistoryBatches) == 0 {
+				return &adminservice.ImportWorkflowExecutionResponse{}, nil
+			}
+			return &adminservice.ImportWorkflowExecutionResponse{Token: []byte("token")}, nil
+		},
+	).MinTimes(4)
+
+	count, err := snapshotter.importSnapshot(context.Background(), &buf, "")
+	s.NoError(err)
+	s.Equal(1, count)
+	s.Len(importedByVersion[2], 2)
+	// Only the events after the fork are imported for the other branch.
+	s.Len(importedByVersion[3], 1)
+	s.Equal(otherBatches[1].Data, importedByVersion[3][0].Data)
+}
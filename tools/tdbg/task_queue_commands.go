@@ -27,12 +27,17 @@ package tdbg
 import (
 	"errors"
 	"fmt"
+	"math"
+	"time"
 
 	"github.com/urfave/cli/v2"
 	enumspb "go.temporal.io/api/enums/v1"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/server/api/adminservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/tqid"
 )
 
 // AdminListTaskQueueTasks displays task information
@@ -267,3 +272,177 @@ func AdminForceUnloadTaskQueuePartition(c *cli.Context, clientFactory ClientFact
 	}
 	return nil
 }
+
+type (
+	taskQueueBacklogSummary struct {
+		PersistedTasks  int
+		BacklogTasks    int
+		ExpiredTasks    int
+		OldestBacklog   *persistencespb.AllocatedTaskInfo
+		OldestCreatedAt time.Time
+	}
+
+	taskQueueBacklogRow struct {
+		TaskID           int64
+		WorkflowID       string
+		RunID            string
+		ScheduledEventID int64
+		CreateTime       string
+		ExpiryTime       string
+		Acked            bool
+		Expired          bool
+	}
+)
+
+// AdminDumpTaskQueueBacklog reads all persisted tasks of a task queue partition directly from persistence
+// and prints a summary of its backlog, so that it can be inspected even when matching is unhealthy.
+func AdminDumpTaskQueueBacklog(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	tqName, err := getRequiredOption(c, FlagTaskQueue)
+	if err != nil {
+		return err
+	}
+	tlTypeInt, err := StringToEnum(c.String(FlagTaskQueueType), enumspb.TaskQueueType_value)
+	if err != nil {
+		return fmt.Errorf("invalid task queue type: %w", err)
+	}
+	tqType := enumspb.TaskQueueType(tlTypeInt)
+	if tqType == enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		return errors.New("missing Task Queue type")
+	}
+	pageSize := defaultPageSize
+	if c.IsSet(FlagPageSize) {
+		pageSize = c.Int(FlagPageSize)
+	}
+	maxTaskCount := c.Int(FlagMaxTaskCount)
+
+	// tasks are persisted under the RPC name of the partition
+	taskQueue := tqid.UnsafeTaskQueueFamily("", tqName).TaskQueue(tqType)
+	persistenceName := taskQueue.NormalPartition(c.Int(FlagPartitionID)).RpcName()
+	if stickyName := c.String(FlagStickyName); stickyName != "" {
+		persistenceName = taskQueue.StickyPartition(stickyName).RpcName()
+	}
+
+	client := clientFactory.AdminClient(c)
+	req := &adminservice.GetTaskQueueTasksRequest{
+		Namespace:     namespace,
+		TaskQueue:     persistenceName,
+		TaskQueueType: tqType,
+		MinTaskId:     -12346, // include default task id
+		MaxTaskId:     math.MaxInt64,
+		BatchSize:     int32(pageSize),
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	var tasks []*persistencespb.AllocatedTaskInfo
+	var taskQueueInfo *persistencespb.TaskQueueInfo
+	for {
+		response, err := client.GetTaskQueueTasks(ctx, req)
+		if err != nil {
+			return fmt.Errorf("unable to read Task Queue Tasks: %w", err)
+		}
+		if req.NextPageToken == nil {
+			taskQueueInfo = response.GetTaskQueueInfo()
+		}
+		tasks = append(tasks, response.GetTasks()...)
+		if len(response.GetNextPageToken()) == 0 {
+			break
+		}
+		req.NextPageToken = response.GetNextPageToken()
+	}
+
+	now := time.Now().UTC()
+	summary := summarizeTaskQueueBacklog(tasks, taskQueueInfo, now)
+
+	w := c.App.Writer
+	fmt.Fprintf(w, "Task queue partition: %s (%s)\n", persistenceName, tqType)
+	if taskQueueInfo == nil {
+		fmt.Fprintln(w, "Task queue metadata: not found")
+	} else {
+		fmt.Fprintf(w, "Ack level: %d\n", taskQueueInfo.GetAckLevel())
+		fmt.Fprintf(w, "Approximate backlog count: %d\n", taskQueueInfo.GetApproximateBacklogCount())
+	}
+	fmt.Fprintf(w, "Persisted tasks: %d\n", summary.PersistedTasks)
+	fmt.Fprintf(w, "Backlog tasks: %d\n", summary.BacklogTasks)
+	fmt.Fprintf(w, "Expired tasks: %d\n", summary.ExpiredTasks)
+	if summary.OldestBacklog != nil {
+		fmt.Fprintf(w, "Oldest backlog task: %d (created %s, age %s)\n",
+			summary.OldestBacklog.GetTaskId(),
+			summary.OldestCreatedAt.Format(time.RFC3339),
+			now.Sub(summary.OldestCreatedAt).Truncate(time.Second),
+		)
+	}
+
+	rows := taskQueueBacklogRows(tasks, taskQueueInfo, now, maxTaskCount)
+	if len(rows) < len(tasks) {
+		fmt.Fprintf(w, "Showing the first %d of %d tasks\n", len(rows), len(tasks))
+	}
+	return printTable(rows, w)
+}
+
+// summarizeTaskQueueBacklog counts the tasks above the ack level of the task queue. Without metadata all
+// persisted tasks are considered backlog.
+func summarizeTaskQueueBacklog(
+	tasks []*persistencespb.AllocatedTaskInfo,
+	info *persistencespb.TaskQueueInfo,
+	now time.Time,
+) taskQueueBacklogSummary {
+	summary := taskQueueBacklogSummary{PersistedTasks: len(tasks)}
+	for _, task := range tasks {
+		if isTaskExpired(task, now) {
+			summary.ExpiredTasks++
+		}
+		if info != nil && task.GetTaskId() <= info.GetAckLevel() {
+			continue
+		}
+		summary.BacklogTasks++
+		createTime := task.GetData().GetCreateTime().AsTime()
+		if summary.OldestBacklog == nil || createTime.Before(summary.OldestCreatedAt) {
+			summary.OldestBacklog = task
+			summary.OldestCreatedAt = createTime
+		}
+	}
+	return summary
+}
+
+func taskQueueBacklogRows(
+	tasks []*persistencespb.AllocatedTaskInfo,
+	info *persistencespb.TaskQueueInfo,
+	now time.Time,
+	maxTaskCount int,
+) []interface{} {
+	var rows []interface{}
+	for _, task := range tasks {
+		if maxTaskCount > 0 && len(rows) >= maxTaskCount {
+			break
+		}
+		data := task.GetData()
+		row := &taskQueueBacklogRow{
+			TaskID:           task.GetTaskId(),
+			WorkflowID:       data.GetWorkflowId(),
+			RunID:            data.GetRunId(),
+			ScheduledEventID: data.GetScheduledEventId(),
+			Acked:            info != nil && task.GetTaskId() <= info.GetAckLevel(),
+			Expired:          isTaskExpired(task, now),
+		}
+		if data.GetCreateTime() != nil {
+			row.CreateTime = data.GetCreateTime().AsTime().Format(time.RFC3339)
+		}
+		if data.GetExpiryTime() != nil {
+			row.ExpiryTime = data.GetExpiryTime().AsTime().Format(time.RFC3339)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func isTaskExpired(task *persistencespb.AllocatedTaskInfo, now time.Time) bool {
+	// same as matching: tasks without an expiry time are persisted with a zero timestamp
+	expiry := timestamp.TimeValue(task.GetData().GetExpiryTime())
+	return expiry.Unix() > 0 && expiry.Before(now)
+}
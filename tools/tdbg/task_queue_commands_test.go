@@ -25,17 +25,22 @@
 package tdbg
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"github.com/urfave/cli/v2"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/server/api/adminservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type (
@@ -43,6 +48,7 @@ type (
 		adminservice.AdminServiceClient
 		describeTaskQueuePartitionFn    func(request *adminservice.DescribeTaskQueuePartitionRequest) (*adminservice.DescribeTaskQueuePartitionResponse, error)
 		forceUnloadTaskQueuePartitionFn func(request *adminservice.ForceUnloadTaskQueuePartitionRequest) (*adminservice.ForceUnloadTaskQueuePartitionResponse, error)
+		getTaskQueueTasksFn             func(request *adminservice.GetTaskQueueTasksRequest) (*adminservice.GetTaskQueueTasksResponse, error)
 	}
 )
 
@@ -106,6 +112,10 @@ func (t *testClient) ForceUnloadTaskQueuePartition(_ context.Context, request *a
 	return t.forceUnloadTaskQueuePartitionFn(request)
 }
 
+func (t *testClient) GetTaskQueueTasks(_ context.Context, request *adminservice.GetTaskQueueTasksRequest, opts ...grpc.CallOption) (*adminservice.GetTaskQueueTasksResponse, error) {
+	return t.getTaskQueueTasksFn(request)
+}
+
 func (s *taskQueueCommandTestSuite) SetupTest() {
 	s.Assertions = require.New(s.T())
 	s.controller = gomock.NewController(s.T())
@@ -119,6 +129,7 @@ func (s *taskQueueCommandTestSuite) SetupTest() {
 			return &adminservice.ForceUnloadTaskQueuePartitionResponse{}, nil
 		},
 	}
+	s.client = client
 	s.app = NewCliApp(func(params *Params) {
 		params.ClientFactory = client
 	})
@@ -133,6 +144,7 @@ type taskQueueCommandTestSuite struct {
 	suite.Suite
 	controller *gomock.Controller
 	app        *cli.App
+	client     *testClient
 }
 
 // TestDescribeTaskQueuePartitionWithArgs tests that the cli accepts the various arguments for
@@ -187,3 +199,58 @@ func (s *taskQueueCommandTestSuite) TestForceUnloadTaskQueuePartition() {
 		}
 	}
 }
+
+func (s *taskQueueCommandTestSuite) TestTaskQueueBacklog() {
+	now := time.Now().UTC()
+	newTask := func(taskID int64, age time.Duration, expiry time.Time) *persistencespb.AllocatedTaskInfo {
+		return &persistencespb.AllocatedTaskInfo{
+			TaskId: taskID,
+			Data: &persistencespb.TaskInfo{
+				WorkflowId: "wid",
+				RunId:      "rid",
+				CreateTime: timestamppb.New(now.Add(-age)),
+				ExpiryTime: timestamppb.New(expiry),
+			},
+		}
+	}
+	pages := []*adminservice.GetTaskQueueTasksResponse{
+		{
+			Tasks: []*persistencespb.AllocatedTaskInfo{
+				newTask(1, 3*time.Hour, time.Unix(0, 0)),
+				newTask(2, 2*time.Hour, now.Add(-time.Minute)),
+			},
+			NextPageToken: []byte("token"),
+			TaskQueueInfo: &persistencespb.TaskQueueInfo{AckLevel: 1},
+		},
+		{
+			Tasks: []*persistencespb.AllocatedTaskInfo{
+				newTask(3, time.Hour, time.Unix(0, 0)),
+			},
+		},
+	}
+
+	var requests []*adminservice.GetTaskQueueTasksRequest
+	s.client.getTaskQueueTasksFn = func(request *adminservice.GetTaskQueueTasksRequest) (*adminservice.GetTaskQueueTasksResponse, error) {
+		requests = append(requests, proto.Clone(request).(*adminservice.GetTaskQueueTasksRequest))
+		return pages[len(requests)-1], nil
+	}
+	var out bytes.Buffer
+	s.app.Writer = &out
+
+	err := s.app.Run([]string{"tdbg", "--namespace", "ns", "taskqueue", "backlog",
+		"--task-queue", "test", "--partition-id", "2", "--max-task-count", "2"})
+	s.NoError(err)
+	s.Len(requests, 2)
+	s.Equal("/_sys/test/2", requests[0].GetTaskQueue())
+	s.Empty(requests[0].GetNextPageToken())
+	s.Equal([]byte("token"), requests[1].GetNextPageToken())
+	s.Contains(out.String(), "Persisted tasks: 3")
+	s.Contains(out.String(), "Backlog tasks: 2")
+	s.Contains(out.String(), "Expired tasks: 1")
+	s.Contains(out.String(), "Oldest backlog task: 2")
+	s.Contains(out.String(), "Showing the first 2 of 3 tasks")
+
+	summary := summarizeTaskQueueBacklog(pages[1].Tasks, nil, now)
+	s.Equal(1, summary.BacklogTasks)
+	s.Equal(int64(3), summary.OldestBacklog.GetTaskId())
+}
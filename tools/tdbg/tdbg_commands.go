@@ -29,6 +29,7 @@ import (
 	"io"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/urfave/cli/v2"
 	commonpb "go.temporal.io/api/common/v1"
@@ -87,6 +88,11 @@ func getCommands(
 				},
 			},
 		},
+		{
+			Name:        "snapshot",
+			Usage:       "Export or import a snapshot of the executions of a namespace to bootstrap a cluster",
+			Subcommands: newAdminSnapshotCommands(clientFactory),
+		},
 		{
 			Name:        "decode",
 			Usage:       "Decode payload",
@@ -119,10 +125,49 @@ func newAdminWorkflowCommands(clientFactory ClientFactory, prompterFactory Promp
 				return AdminImportWorkflow(c, clientFactory)
 			},
 		},
+		{
+			Name:  "export-history",
+			Usage: "Export all branches of the history of a workflow execution to a file that can be imported into another cluster",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID, the current run if not provided",
+				},
+				&cli.StringFlag{
+					Name:     FlagOutputFilename,
+					Usage:    "File to write",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminExportWorkflowHistory(c, clientFactory)
+			},
+		},
+		{
+			Name: "import-history",
+			Usage: "Import a file written by export-history, preserving event IDs and versions. The file is imported " +
+				"into the namespace it was exported from unless --" + FlagNamespace + " is set, which must already exist",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagInputFilename,
+					Usage:    "File to read",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminImportNamespaceSnapshot(c, clientFactory)
+			},
+		},
 		{
 			Name:  "show",
 			Usage: "show workflow history from database",
-			Flags: []cli.Flag{
+			Flags: append([]cli.Flag{
 				&cli.StringFlag{
 					Name:    FlagWorkflowID,
 					Aliases: FlagWorkflowIDAlias,
@@ -152,8 +197,9 @@ func newAdminWorkflowCommands(clientFactory ClientFactory, prompterFactory Promp
 				},
 				&cli.StringFlag{
 					Name:  FlagOutputFilename,
-					Usage: "output file",
-				}},
+					Usage: "output file, the history is written as stored",
+				},
+			}, getCodecFlags()...),
 			Action: func(c *cli.Context) error {
 				return AdminShowWorkflow(c, clientFactory)
 			},
@@ -162,7 +208,7 @@ func newAdminWorkflowCommands(clientFactory ClientFactory, prompterFactory Promp
 			Name:    "describe",
 			Aliases: []string{"d"},
 			Usage:   "Describe internal information of workflow execution",
-			Flags: []cli.Flag{
+			Flags: append([]cli.Flag{
 				&cli.StringFlag{
 					Name:    FlagWorkflowID,
 					Aliases: FlagWorkflowIDAlias,
@@ -173,7 +219,7 @@ func newAdminWorkflowCommands(clientFactory ClientFactory, prompterFactory Promp
 					Aliases: FlagRunIDAlias,
 					Usage:   "Run ID",
 				},
-			},
+			}, getCodecFlags()...),
 			Action: func(c *cli.Context) error {
 				return AdminDescribeWorkflow(c, clientFactory)
 			},
@@ -198,6 +244,40 @@ func newAdminWorkflowCommands(clientFactory ClientFactory, prompterFactory Promp
 				return AdminRefreshWorkflowTasks(c, clientFactory)
 			},
 		},
+		{
+			Name:  "refresh-tasks-batch",
+			Usage: "Refreshes all the tasks of the workflows matching a query or listed in a file, using a batch operation",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagQuery,
+					Usage: "Visibility query selecting the workflows",
+				},
+				&cli.StringFlag{
+					Name:  FlagInputFilename,
+					Usage: "File listing the workflows, one per line as a workflow ID optionally followed by a run ID",
+				},
+				&cli.StringFlag{
+					Name:     FlagReason,
+					Usage:    "Reason for the batch operation",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagJobID,
+					Usage: "Job ID of the batch operation, generated if not provided",
+				},
+				&cli.Float64Flag{
+					Name:  FlagRPS,
+					Usage: "Maximum number of workflows refreshed per second, defaults to the batcher's limit",
+				},
+				&cli.BoolFlag{
+					Name:  FlagWait,
+					Usage: "Wait for the batch operation to complete, reporting its progress",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminRefreshWorkflowTasksBatch(c, clientFactory)
+			},
+		},
 		{
 			Name:    "rebuild",
 			Aliases: []string{},
@@ -333,6 +413,48 @@ func newAdminShardManagementCommands(clientFactory ClientFactory, taskCategoryRe
 				return AdminListShardTasks(c, clientFactory, taskCategoryRegistry)
 			},
 		},
+		{
+			Name:  "hot-keys",
+			Usage: "Rank the workflow IDs of a shard by their share of the shard's pending tasks and history growth",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:     FlagShardID,
+					Usage:    "The ID of the shard",
+					Required: true,
+				},
+				&cli.IntFlag{
+					Name:  FlagSampleSize,
+					Value: 10000,
+					Usage: "Maximum number of pending tasks to sample per task category",
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Value: 1000,
+					Usage: "Page size used to read tasks",
+				},
+				&cli.IntFlag{
+					Name:  FlagTopN,
+					Value: 20,
+					Usage: "Number of workflow IDs to report, 0 reports all of them",
+				},
+				&cli.BoolFlag{
+					Name:  FlagSkipHistory,
+					Usage: "Don't describe the history of the reported workflows",
+				},
+				&cli.DurationFlag{
+					Name:  FlagSampleInterval,
+					Value: 10 * time.Second,
+					Usage: "Time between two samples of the history of the reported workflows, used to measure recent history appends. 0 takes a single sample",
+				},
+				&cli.BoolFlag{
+					Name:  FlagPrintJSON,
+					Usage: "Print the report in json format",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminShardHotKeys(c, clientFactory, taskCategoryRegistry)
+			},
+		},
 		{
 			Name:  "close-shard",
 			Usage: "close a shard given a shard id",
@@ -524,6 +646,43 @@ func newAdminTaskQueueCommands(clientFactory ClientFactory) []*cli.Command {
 				return AdminListTaskQueueTasks(c, clientFactory)
 			},
 		},
+		{
+			Name:  "backlog",
+			Usage: "Summarize the backlog of a task queue partition as persisted, without going through matching",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Task Queue name",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskQueueType,
+					Value: "TASK_QUEUE_TYPE_ACTIVITY",
+					Usage: "Task Queue type: activity, workflow, nexus (experimental)",
+				},
+				&cli.IntFlag{
+					Name:  FlagPartitionID,
+					Usage: "Partition ID",
+				},
+				&cli.StringFlag{
+					Name:  FlagStickyName,
+					Usage: "Sticky Name of the task queue partition, if it's a sticky partition",
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Value: 1000,
+					Usage: "Page size used to read tasks",
+				},
+				&cli.IntFlag{
+					Name:  FlagMaxTaskCount,
+					Value: 100,
+					Usage: "Maximum number of tasks to print, all tasks are counted in the summary regardless. 0 prints all tasks",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDumpTaskQueueBacklog(c, clientFactory)
+			},
+		},
 		{
 			Name:  "describe-task-queue-partition",
 			Usage: "Describe information related to a task queue partition",
@@ -619,7 +778,7 @@ func newAdminDLQCommands(
 			Name:    "read",
 			Aliases: []string{"r"},
 			Usage:   "Read DLQ Messages",
-			Flags: append(
+			Flags: append(append(
 				getDLQFlags(taskCategoryRegistry),
 				&cli.IntFlag{
 					Name: FlagMaxMessageCount,
@@ -637,7 +796,7 @@ func newAdminDLQCommands(
 					Usage: "Page size to use when reading messages from the DB, v2 only",
 					Value: defaultPageSize,
 				},
-			),
+			), getCodecFlags()...),
 			Action: func(c *cli.Context) error {
 				ac, err := dlqServiceProvider.GetDLQService(c)
 				if err != nil {
@@ -664,13 +823,27 @@ func newAdminDLQCommands(
 			Aliases:     []string{"m"},
 			Usage:       "Merge DLQ messages with equal or smaller ids than the provided task id",
 			Description: "This command will delete messages after they've been re-enqueued if using v2.",
-			Flags: append(getDLQFlags(taskCategoryRegistry),
+			Flags: append(append(getDLQFlags(taskCategoryRegistry),
 				&cli.IntFlag{
 					Name: FlagPageSize,
 					Usage: "Batch size to use when purging messages from the DB, v2 only. Will use server default if " +
 						"not provided.",
 				},
-			),
+				&cli.BoolFlag{
+					Name: FlagDryRun,
+					Usage: "Print whether merging each message would conflict with the current workflow state " +
+						"instead of merging, replication DLQ v1 only",
+				},
+				&cli.StringFlag{
+					Name:  FlagFilterNamespace,
+					Usage: "Only merge messages of this namespace, replication DLQ v1 only",
+				},
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Only merge messages of this workflow, requires --" + FlagFilterNamespace + ", replication DLQ v1 only",
+				},
+			), getCodecFlags()...),
 			Action: func(c *cli.Context) error {
 				ac, err := dlqServiceProvider.GetDLQService(c)
 				if err != nil {
@@ -679,6 +852,36 @@ func newAdminDLQCommands(
 				return ac.MergeMessages(c)
 			},
 		},
+		{
+			Name:  "filter-merge",
+			Usage: "Interactively select DLQ messages to merge, v2 only",
+			Description: "This command will page through the DLQ, show the messages that match the filters and " +
+				"re-enqueue only the selected ones. Selected messages are deleted from the DLQ unless an unselected " +
+				"message precedes them. Every merged message is recorded in the audit log.",
+			Flags: getDLQFilterFlags(taskCategoryRegistry, dlqActionMerge),
+			Action: func(c *cli.Context) error {
+				ac, err := getDLQV2ServiceForFilter(c, dlqServiceProvider)
+				if err != nil {
+					return err
+				}
+				return ac.FilterMergeMessages(c)
+			},
+		},
+		{
+			Name:  "filter-purge",
+			Usage: "Interactively select DLQ messages to purge, v2 only",
+			Description: "This command will page through the DLQ, show the messages that match the filters and " +
+				"delete the selected ones. Only selected messages that aren't preceded by an unselected message can " +
+				"be deleted. Every purged message is recorded in the audit log.",
+			Flags: getDLQFilterFlags(taskCategoryRegistry, dlqActionPurge),
+			Action: func(c *cli.Context) error {
+				ac, err := getDLQV2ServiceForFilter(c, dlqServiceProvider)
+				if err != nil {
+					return err
+				}
+				return ac.FilterPurgeMessages(c)
+			},
+		},
 		{
 			Name:    "list",
 			Aliases: []string{"l"},
@@ -760,6 +963,30 @@ func newAdminDLQJobCommands(
 	}
 }
 
+// getCodecFlags returns the flags of the commands which print payloads, see newPayloadDecoderFromFlags.
+func getCodecFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:    FlagCodecEndpoint,
+			Usage:   "Remote codec server endpoint used to decode payloads",
+			EnvVars: []string{"TEMPORAL_CLI_CODEC_ENDPOINT"},
+		},
+		&cli.StringFlag{
+			Name:    FlagCodecAuth,
+			Usage:   "Authorization header to send to the remote codec server",
+			EnvVars: []string{"TEMPORAL_CLI_CODEC_AUTH"},
+		},
+		&cli.StringSliceFlag{
+			Name:  FlagCodecHeader,
+			Usage: "Additional header to send to the remote codec server, as KEY=VALUE. Can be passed multiple times",
+		},
+		&cli.BoolFlag{
+			Name:  FlagRaw,
+			Usage: "Print payloads as stored, without decoding them",
+		},
+	}
+}
+
 func getDLQFlags(taskCategoryRegistry tasks.TaskCategoryRegistry) []cli.Flag {
 	categoriesString := getCategoriesList(taskCategoryRegistry)
 	return []cli.Flag{
@@ -790,6 +1017,46 @@ func getDLQFlags(taskCategoryRegistry tasks.TaskCategoryRegistry) []cli.Flag {
 	}
 }
 
+func getDLQFilterFlags(taskCategoryRegistry tasks.TaskCategoryRegistry, action string) []cli.Flag {
+	return append(getDLQFlags(taskCategoryRegistry),
+		&cli.IntFlag{
+			Name:  FlagPageSize,
+			Usage: "Number of messages to read and show at a time",
+			Value: dlqFilterDefaultPageSize,
+		},
+		&cli.StringFlag{
+			Name:  FlagFilterNamespace,
+			Usage: "Only show messages of this namespace",
+		},
+		&cli.StringFlag{
+			Name:    FlagWorkflowID,
+			Aliases: FlagWorkflowIDAlias,
+			Usage:   "Only show messages of this workflow",
+		},
+		&cli.StringFlag{
+			Name:  FlagTaskType,
+			Usage: "Only show messages of this task type, e.g. TRANSFER_ACTIVITY_TASK or TASK_TYPE_TRANSFER_ACTIVITY_TASK",
+		},
+		&cli.StringFlag{
+			Name:  FlagAuditLog,
+			Usage: "File to append a JSON line to for every message that is " + action + "d",
+			Value: "tdbg_dlq_audit.log",
+		},
+	)
+}
+
+func getDLQV2ServiceForFilter(c *cli.Context, dlqServiceProvider *DLQServiceProvider) (*DLQV2Service, error) {
+	ac, err := dlqServiceProvider.GetDLQService(c)
+	if err != nil {
+		return nil, err
+	}
+	v2Service, ok := ac.(*DLQV2Service)
+	if !ok {
+		return nil, fmt.Errorf("--%s v2 is required to filter DLQ messages", FlagDLQVersion)
+	}
+	return v2Service, nil
+}
+
 func newDecodeCommands(
 	taskBlobEncoder TaskBlobEncoder,
 ) []*cli.Command {
@@ -797,7 +1064,7 @@ func newDecodeCommands(
 		{
 			Name:  "proto",
 			Usage: "Decode proto payload",
-			Flags: []cli.Flag{
+			Flags: append([]cli.Flag{
 				&cli.StringFlag{
 					Name:  FlagProtoType,
 					Usage: "full name of proto type to decode to (i.e. temporal.server.api.persistence.v1.WorkflowExecutionInfo).",
@@ -814,7 +1081,7 @@ func newDecodeCommands(
 					Name:  FlagBinaryFile,
 					Usage: "file with data in binary format.",
 				},
-			},
+			}, getCodecFlags()...),
 			Action: func(c *cli.Context) error {
 				return AdminDecodeProto(c)
 			},
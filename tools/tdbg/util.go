@@ -191,11 +191,15 @@ func newContext(c *cli.Context) (context.Context, context.CancelFunc) {
 }
 
 func newContextWithTimeout(c *cli.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(c.Context, getContextTimeout(c, timeout))
+}
+
+// getContextTimeout returns the timeout set by the context timeout flag, or the given default.
+func getContextTimeout(c *cli.Context, timeout time.Duration) time.Duration {
 	if c.IsSet(FlagContextTimeout) {
 		timeout = time.Duration(c.Int(FlagContextTimeout)) * time.Second
 	}
-
-	return context.WithTimeout(c.Context, timeout)
+	return timeout
 }
 
 func StringToEnum(search string, candidates map[string]int32) (int32, error) {